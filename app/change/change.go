@@ -10,6 +10,7 @@ type Change struct {
 	EffectSize  float64
 	Pre         Stats
 	Post        Stats
+	Significance
 }
 
 func (c *Change) Delta() float64 {
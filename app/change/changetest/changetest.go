@@ -40,12 +40,17 @@ func WriteCaseFile(filename string, c *Case) error {
 	return ioutil.WriteFile(filename, b, 0o644)
 }
 
-// WriteNewCaseFile writes a new test case with the "expect" field empty.
-func WriteNewCaseFile(filename string, series trace.Series) error {
-	return WriteCaseFile(filename, &Case{
+// NewCase builds a test case for series with the "expect" field empty.
+func NewCase(series trace.Series) *Case {
+	return &Case{
 		Expect: []int{},
 		Series: series,
-	})
+	}
+}
+
+// WriteNewCaseFile writes a new test case with the "expect" field empty.
+func WriteNewCaseFile(filename string, series trace.Series) error {
+	return WriteCaseFile(filename, NewCase(series))
 }
 
 // Filename returns a recommended test case filename for the given trace ID.
@@ -0,0 +1,177 @@
+package changetest
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+// NamedCase is a test case with an identifying name.
+type NamedCase struct {
+	Name string
+	*Case
+}
+
+// ReadCaseDir reads all test case files in the given directory, in sorted
+// order of filename.
+func ReadCaseDir(dir string) ([]NamedCase, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	cases := make([]NamedCase, 0, len(filenames))
+	for _, filename := range filenames {
+		c, err := ReadCaseFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(filepath.Base(filename), ".json")
+		cases = append(cases, NamedCase{Name: name, Case: c})
+	}
+
+	return cases, nil
+}
+
+// DetectFunc detects change points in a series, returning their commit
+// indexes in increasing order.
+type DetectFunc func(trace.Series) []int
+
+// Score summarizes the accuracy of detected change points compared to the
+// expected change points.
+type Score struct {
+	TruePositives  int // detected changes matched to an expected change
+	FalsePositives int // detected changes not matched to any expected change
+	FalseNegatives int // expected changes that were not detected
+
+	TotalError int // sum of commit index distance over true positives
+	MaxError   int // maximum commit index distance over true positives
+}
+
+// Precision is the fraction of detected changes that were expected. Defined
+// to be 1 if there were no detections.
+func (s Score) Precision() float64 {
+	return ratio(s.TruePositives, s.TruePositives+s.FalsePositives)
+}
+
+// Recall is the fraction of expected changes that were detected. Defined to
+// be 1 if there were no expected changes.
+func (s Score) Recall() float64 {
+	return ratio(s.TruePositives, s.TruePositives+s.FalseNegatives)
+}
+
+// F1 is the harmonic mean of precision and recall.
+func (s Score) F1() float64 {
+	p, r := s.Precision(), s.Recall()
+	if p+r == 0 {
+		return 0
+	}
+	return 2 * p * r / (p + r)
+}
+
+// MeanError is the mean commit index distance between true positive
+// detections and the corresponding expected change.
+func (s Score) MeanError() float64 {
+	if s.TruePositives == 0 {
+		return 0
+	}
+	return float64(s.TotalError) / float64(s.TruePositives)
+}
+
+// Add accumulates the counts from t into s.
+func (s *Score) Add(t Score) {
+	s.TruePositives += t.TruePositives
+	s.FalsePositives += t.FalsePositives
+	s.FalseNegatives += t.FalseNegatives
+	s.TotalError += t.TotalError
+	if t.MaxError > s.MaxError {
+		s.MaxError = t.MaxError
+	}
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 1
+	}
+	return float64(n) / float64(d)
+}
+
+// Match scores detected change points against expected change points. A
+// detection matches an expected change if it is within tolerance commits of
+// it, and each expected change may be matched at most once. Matching is
+// greedy, preferring the closest pairs first.
+func Match(expect, detected []int, tolerance int) Score {
+	type pair struct{ e, d, dist int }
+	var pairs []pair
+	for i, e := range expect {
+		for j, d := range detected {
+			if dist := abs(e - d); dist <= tolerance {
+				pairs = append(pairs, pair{e: i, d: j, dist: dist})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].dist < pairs[j].dist })
+
+	var s Score
+	matchede := make([]bool, len(expect))
+	matchedd := make([]bool, len(detected))
+	for _, p := range pairs {
+		if matchede[p.e] || matchedd[p.d] {
+			continue
+		}
+		matchede[p.e], matchedd[p.d] = true, true
+		s.TruePositives++
+		s.TotalError += p.dist
+		if p.dist > s.MaxError {
+			s.MaxError = p.dist
+		}
+	}
+
+	s.FalsePositives = len(detected) - s.TruePositives
+	s.FalseNegatives = len(expect) - s.TruePositives
+
+	return s
+}
+
+// CaseResult is the outcome of running a detector on one test case.
+type CaseResult struct {
+	Name     string
+	Expect   []int
+	Detected []int
+	Score
+}
+
+// Evaluation is the outcome of running a detector over a collection of test
+// cases.
+type Evaluation struct {
+	Cases []CaseResult
+	Score // aggregate over all cases
+}
+
+// Evaluate runs the detector over all cases and scores the results with the
+// given tolerance.
+func Evaluate(detect DetectFunc, cases []NamedCase, tolerance int) *Evaluation {
+	e := &Evaluation{}
+	for _, c := range cases {
+		detected := detect(c.Series)
+		s := Match(c.Expect, detected, tolerance)
+		e.Cases = append(e.Cases, CaseResult{
+			Name:     c.Name,
+			Expect:   c.Expect,
+			Detected: detected,
+			Score:    s,
+		})
+		e.Add(s)
+	}
+	return e
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
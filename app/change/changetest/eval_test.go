@@ -0,0 +1,109 @@
+package changetest
+
+import (
+	"testing"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Expect    []int
+		Detected  []int
+		Tolerance int
+		Score     Score
+	}{
+		{
+			Name:  "empty",
+			Score: Score{},
+		},
+		{
+			Name:      "exact",
+			Expect:    []int{10, 20},
+			Detected:  []int{10, 20},
+			Tolerance: 0,
+			Score:     Score{TruePositives: 2},
+		},
+		{
+			Name:      "off_by_one",
+			Expect:    []int{10, 20},
+			Detected:  []int{11, 20},
+			Tolerance: 1,
+			Score:     Score{TruePositives: 2, TotalError: 1, MaxError: 1},
+		},
+		{
+			Name:      "outside_tolerance",
+			Expect:    []int{10},
+			Detected:  []int{13},
+			Tolerance: 2,
+			Score:     Score{FalsePositives: 1, FalseNegatives: 1},
+		},
+		{
+			Name:      "closest_preferred",
+			Expect:    []int{10, 12},
+			Detected:  []int{11, 12},
+			Tolerance: 2,
+			Score:     Score{TruePositives: 2, TotalError: 1, MaxError: 1},
+		},
+		{
+			Name:      "expected_matched_once",
+			Expect:    []int{10},
+			Detected:  []int{9, 10, 11},
+			Tolerance: 1,
+			Score:     Score{TruePositives: 1, FalsePositives: 2},
+		},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			got := Match(c.Expect, c.Detected, c.Tolerance)
+			if got != c.Score {
+				t.Fatalf("Match(%v, %v, %d) = %+v; expect %+v", c.Expect, c.Detected, c.Tolerance, got, c.Score)
+			}
+		})
+	}
+}
+
+func TestScoreMetrics(t *testing.T) {
+	s := Score{TruePositives: 3, FalsePositives: 1, FalseNegatives: 3, TotalError: 6}
+	if p := s.Precision(); p != 0.75 {
+		t.Errorf("precision = %v; expect 0.75", p)
+	}
+	if r := s.Recall(); r != 0.5 {
+		t.Errorf("recall = %v; expect 0.5", r)
+	}
+	if f := s.F1(); f != 0.6 {
+		t.Errorf("f1 = %v; expect 0.6", f)
+	}
+	if e := s.MeanError(); e != 2 {
+		t.Errorf("mean error = %v; expect 2", e)
+	}
+}
+
+func TestScoreEmptyPerfect(t *testing.T) {
+	var s Score
+	if s.Precision() != 1 || s.Recall() != 1 || s.F1() != 1 {
+		t.Fatalf("empty score should be perfect: %+v", s)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	cases := []NamedCase{
+		{Name: "a", Case: &Case{Expect: []int{1}, Series: trace.Series{{CommitIndex: 1}}}},
+		{Name: "b", Case: &Case{Expect: []int{}, Series: trace.Series{{CommitIndex: 2}}}},
+	}
+	detect := func(s trace.Series) []int {
+		return []int{s[0].CommitIndex}
+	}
+
+	e := Evaluate(detect, cases, 0)
+
+	if len(e.Cases) != 2 {
+		t.Fatalf("expected 2 case results")
+	}
+	expect := Score{TruePositives: 1, FalsePositives: 1}
+	if e.Score != expect {
+		t.Fatalf("aggregate score %+v; expect %+v", e.Score, expect)
+	}
+}
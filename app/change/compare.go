@@ -0,0 +1,54 @@
+package change
+
+import "math"
+
+// Comparison summarizes the difference between two samples of the same
+// benchmark, such as measurements at two commits.
+type Comparison struct {
+	Base Stats
+	Head Stats
+	Significance
+}
+
+// Compare the samples base and head.
+func Compare(base, head []float64) Comparison {
+	sbase, shead := samplestats(base), samplestats(head)
+	return Comparison{
+		Base:         sbase,
+		Head:         shead,
+		Significance: significance(base, head, sbase, shead),
+	}
+}
+
+// Delta returns the difference in means from base to head.
+func (c Comparison) Delta() float64 {
+	return c.Head.Mean - c.Base.Mean
+}
+
+// Percent returns the percent change in mean from base to head. Returns NaN if
+// the base mean is zero, since the percent change is undefined.
+func (c Comparison) Percent() float64 {
+	if c.Base.Mean == 0 {
+		return math.NaN()
+	}
+	return 100 * c.Delta() / c.Base.Mean
+}
+
+// Significant reports whether the difference is statistically significant at
+// the given level alpha. Only the p-value is required, so samples too small
+// for a confidence interval may still be significant.
+func (c Comparison) Significant(alpha float64) bool {
+	return !math.IsNaN(c.PValue) && c.PValue < alpha
+}
+
+// samplestats computes summary statistics for the sample xs. The variance of
+// a sample with fewer than two values is defined to be zero.
+func samplestats(xs []float64) Stats {
+	w := newwindows()
+	w.push(xs...)
+	s := w.stats(0, len(xs))
+	if len(xs) < 2 {
+		s.Variance = 0
+	}
+	return s
+}
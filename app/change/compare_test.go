@@ -0,0 +1,50 @@
+package change
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompareSignificant(t *testing.T) {
+	base := []float64{100, 101, 99, 100, 102, 98, 100, 101, 99, 100}
+	head := []float64{110, 111, 109, 110, 112, 108, 110, 111, 109, 110}
+
+	c := Compare(base, head)
+	t.Logf("p=%v ci=%v", c.PValue, c.PercentCI)
+
+	if c.Base.N != 10 || c.Head.N != 10 {
+		t.Fatalf("unexpected sample sizes %d and %d", c.Base.N, c.Head.N)
+	}
+	if c.Percent() != 10 {
+		t.Errorf("percent = %v; expect 10", c.Percent())
+	}
+	if !c.Significant(0.05) {
+		t.Errorf("expected significant difference")
+	}
+	if !c.PercentCI.Contains(c.Percent()) {
+		t.Errorf("confidence interval %v does not contain observed percent change %v", c.PercentCI, c.Percent())
+	}
+}
+
+func TestCompareZeroBase(t *testing.T) {
+	for _, head := range [][]float64{{0, 0}, {1, 2}} {
+		c := Compare([]float64{0, 0}, head)
+		if p := c.Percent(); !math.IsNaN(p) {
+			t.Errorf("percent from zero base to %v = %v; expect NaN", head, p)
+		}
+	}
+}
+
+func TestCompareSingleSamples(t *testing.T) {
+	c := Compare([]float64{100}, []float64{120})
+
+	if c.Base.Variance != 0 || c.Head.Variance != 0 {
+		t.Errorf("single sample variance should be zero")
+	}
+	if c.Significant(0.05) {
+		t.Errorf("single samples should not be significant")
+	}
+	if !math.IsNaN(c.PercentCI.Lower) {
+		t.Errorf("confidence interval %v should be unknown", c.PercentCI)
+	}
+}
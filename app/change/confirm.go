@@ -0,0 +1,78 @@
+package change
+
+import "math"
+
+// Confirmation is the outcome of independently repeating the measurements
+// either side of a change.
+type Confirmation uint
+
+// Supported confirmation values.
+const (
+	ConfirmationUnconfirmed Confirmation = iota + 1 // insufficient repeat samples
+	ConfirmationConfirmed                           // repeat samples agree with the change
+	ConfirmationRefuted                             // repeat samples disagree with the change
+)
+
+//go:generate enumer -type Confirmation -output confirmation_enum.go -trimprefix Confirmation -transform snake
+
+// ConfirmFraction is the minimum fraction of the original percent change that
+// repeat samples must show for the change to be confirmed.
+const ConfirmFraction = 0.5
+
+// ConfirmMinSamples is the minimum number of repeat samples required either
+// side of a change, across all repeats, to confirm or refute it.
+const ConfirmMinSamples = 5
+
+// Repeat is a set of samples either side of a change, taken independently of
+// the samples the change was detected in. For example, on a different machine.
+type Repeat struct {
+	Pre  []float64
+	Post []float64
+}
+
+// Percent returns the percent change in mean from pre to post samples. Returns
+// NaN if either side has no samples.
+func (r Repeat) Percent() float64 {
+	if len(r.Pre) == 0 || len(r.Post) == 0 {
+		return math.NaN()
+	}
+	pre, post := mean(r.Pre), mean(r.Post)
+	return 100 * (post - pre) / pre
+}
+
+func mean(xs []float64) float64 {
+	s := 0.0
+	for _, x := range xs {
+		s += x
+	}
+	return s / float64(len(xs))
+}
+
+// Confirm decides whether repeats independently confirm the change c. Each
+// repeat with samples on both sides gives an estimate of the percent change.
+// The change is confirmed if the mean estimate has the same sign as the
+// original and at least ConfirmFraction of its magnitude, and refuted
+// otherwise. The change remains unconfirmed if these repeats have fewer than
+// ConfirmMinSamples samples on either side.
+func Confirm(c Change, repeats []Repeat) Confirmation {
+	sum, n := 0.0, 0
+	pre, post := 0, 0
+	for _, r := range repeats {
+		if p := r.Percent(); !math.IsNaN(p) && !math.IsInf(p, 0) {
+			sum += p
+			n++
+			pre += len(r.Pre)
+			post += len(r.Post)
+		}
+	}
+	if n == 0 || pre < ConfirmMinSamples || post < ConfirmMinSamples {
+		return ConfirmationUnconfirmed
+	}
+
+	expect := c.Percent()
+	got := sum / float64(n)
+	if math.Signbit(got) == math.Signbit(expect) && math.Abs(got) >= ConfirmFraction*math.Abs(expect) {
+		return ConfirmationConfirmed
+	}
+	return ConfirmationRefuted
+}
@@ -0,0 +1,76 @@
+package change
+
+import "testing"
+
+func TestConfirm(t *testing.T) {
+	// Change of +10%.
+	chg := Change{
+		Pre:  Stats{N: 20, Mean: 100},
+		Post: Stats{N: 20, Mean: 110},
+	}
+	cases := []struct {
+		Name    string
+		Repeats []Repeat
+		Expect  Confirmation
+	}{
+		{
+			Name:   "none",
+			Expect: ConfirmationUnconfirmed,
+		},
+		{
+			Name:    "missing_post",
+			Repeats: []Repeat{{Pre: []float64{50}}},
+			Expect:  ConfirmationUnconfirmed,
+		},
+		{
+			Name:    "insufficient_samples",
+			Repeats: []Repeat{{Pre: []float64{50}, Post: []float64{55}}},
+			Expect:  ConfirmationUnconfirmed,
+		},
+		{
+			Name:    "insufficient_post_samples",
+			Repeats: []Repeat{{Pre: samples(50, 10), Post: samples(55, ConfirmMinSamples-1)}},
+			Expect:  ConfirmationUnconfirmed,
+		},
+		{
+			Name:    "agree",
+			Repeats: []Repeat{{Pre: samples(50, ConfirmMinSamples), Post: samples(55, ConfirmMinSamples)}},
+			Expect:  ConfirmationConfirmed,
+		},
+		{
+			Name:    "too_small",
+			Repeats: []Repeat{{Pre: samples(50, ConfirmMinSamples), Post: samples(51, ConfirmMinSamples)}},
+			Expect:  ConfirmationRefuted,
+		},
+		{
+			Name:    "opposite",
+			Repeats: []Repeat{{Pre: samples(50, ConfirmMinSamples), Post: samples(45, ConfirmMinSamples)}},
+			Expect:  ConfirmationRefuted,
+		},
+		{
+			Name: "mean_of_repeats",
+			Repeats: []Repeat{
+				{Pre: samples(50, 3), Post: samples(60, 3)},
+				{Pre: samples(200, 3), Post: samples(200, 3)},
+			},
+			Expect: ConfirmationConfirmed,
+		},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			if got := Confirm(chg, c.Repeats); got != c.Expect {
+				t.Fatalf("Confirm() = %s; expect %s", got, c.Expect)
+			}
+		})
+	}
+}
+
+// samples returns n copies of x.
+func samples(x float64, n int) []float64 {
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = x
+	}
+	return xs
+}
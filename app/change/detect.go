@@ -1,14 +1,56 @@
 package change
 
 import (
+	"fmt"
 	"math"
+	"sort"
 
 	analysis "golang.org/x/perf/analysis/app"
 
 	"github.com/mmcloughlin/goperf/app/trace"
 )
 
-// Detector is a change detector.
+// Detector is a change detection algorithm.
+type Detector interface {
+	// Detect changes in series.
+	Detect(series trace.Series) []Change
+}
+
+// DefaultDetector is the change detector used when none is specified.
+var DefaultDetector Detector = DefaultHybrid
+
+// detectors is the registry of named change detectors.
+var detectors = map[string]Detector{
+	"hybrid": DefaultHybrid,
+	"edm":    DefaultEDivisive,
+	"pelt":   DefaultPELT,
+}
+
+// DetectorNames returns the names of all registered change detectors, in
+// sorted order.
+func DetectorNames() []string {
+	names := make([]string, 0, len(detectors))
+	for name := range detectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NamedDetector looks up a change detector by name. The empty string returns
+// the default detector.
+func NamedDetector(name string) (Detector, error) {
+	if name == "" {
+		return DefaultDetector, nil
+	}
+	d, ok := detectors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown change detector %q", name)
+	}
+	return d, nil
+}
+
+// Hybrid is a change detector.
 //
 // Uses a hybrid approach. A first pass Adaptive Kolmogorov-Zurbenko (KZA)
 // filter is applied to identify structural breaks in the timeseries. This is
@@ -17,7 +59,11 @@ import (
 // few points around the candidate and compare distributions of a windows either
 // size. The point with the largest effect size (Cohen's d) is taken as the
 // change point.
-type Detector struct {
+//
+// The adaptive filter normalizes window sizes by the largest difference in the
+// whole series, so a change may depend on any point. Hybrid is therefore not
+// Local, and incremental detection applies it to the whole series.
+type Hybrid struct {
 	// Adaptive Kolmogorov-Zurbenko pass.
 	M, K             int     // KZA parameters
 	PercentThreshold float64 // threshold for KZA pass
@@ -28,8 +74,8 @@ type Detector struct {
 	MinEffectSize float64 // Cohen's d threshold
 }
 
-// DefaultDetector has sensible default parameter choices.
-var DefaultDetector = &Detector{
+// DefaultHybrid has sensible default parameter choices.
+var DefaultHybrid = &Hybrid{
 	WindowSize:    20,
 	MinEffectSize: 3,
 
@@ -40,7 +86,7 @@ var DefaultDetector = &Detector{
 }
 
 // Detect changes in series.
-func (d *Detector) Detect(series trace.Series) []Change {
+func (d *Hybrid) Detect(series trace.Series) []Change {
 	var changes []Change
 
 	values := series.Values()
@@ -60,19 +106,23 @@ func (d *Detector) Detect(series trace.Series) []Change {
 
 		// Find largest effect size in a small window around this candidate.
 		chg := Change{}
+		var l, m, r int
 		for j := max(i-d.Context, 0); j <= i+d.Context && j < len(values); j++ {
-			pre := w.stats(max(j-d.WindowSize, 0), j)
-			post := w.stats(j, min(j+d.WindowSize, len(values)))
+			pl, pr := max(j-d.WindowSize, 0), min(j+d.WindowSize, len(values))
+			pre := w.stats(pl, j)
+			post := w.stats(j, pr)
 			effect := cohen(post, pre)
 			if math.Abs(effect) > math.Abs(chg.EffectSize) {
 				chg.CommitIndex = series[j].CommitIndex
 				chg.EffectSize = effect
 				chg.Pre = pre
 				chg.Post = post
+				l, m, r = pl, j, pr
 			}
 		}
 
 		if math.Abs(chg.EffectSize) > d.MinEffectSize && !hasChange[chg.CommitIndex] {
+			chg.Significance = significance(values[l:m], values[m:r], chg.Pre, chg.Post)
 			changes = append(changes, chg)
 			hasChange[chg.CommitIndex] = true
 		}
@@ -81,6 +131,48 @@ func (d *Detector) Detect(series trace.Series) []Change {
 	return changes
 }
 
+// segmentchanges converts the change points cps (indexes into series, in
+// increasing order) into changes. Each change point is refined by taking the
+// point with largest effect size within context points either side. Statistics
+// either side of the change point are computed over the adjacent segments,
+// clipped to at most windowsize points. Change points with effect size below
+// minEffectSize are discarded.
+func segmentchanges(series trace.Series, cps []int, context, windowsize int, minEffectSize float64) []Change {
+	var changes []Change
+
+	values := series.Values()
+
+	w := newwindows()
+	w.push(values...)
+
+	bounds := append(append([]int{0}, cps...), len(series))
+	for i := 1; i+1 < len(bounds); i++ {
+		l, r := bounds[i-1], bounds[i+1]
+		chg := Change{}
+		var wl, wm, wr int
+		for j := max(bounds[i]-context, l+2); j <= bounds[i]+context && j <= r-2; j++ {
+			pl, pr := max(l, j-windowsize), min(r, j+windowsize)
+			pre := w.stats(pl, j)
+			post := w.stats(j, pr)
+			effect := cohen(post, pre)
+			if math.Abs(effect) > math.Abs(chg.EffectSize) {
+				chg.CommitIndex = series[j].CommitIndex
+				chg.EffectSize = effect
+				chg.Pre = pre
+				chg.Post = post
+				wl, wm, wr = pl, j, pr
+			}
+		}
+
+		if math.Abs(chg.EffectSize) > minEffectSize {
+			chg.Significance = significance(values[wl:wm], values[wm:wr], chg.Pre, chg.Post)
+			changes = append(changes, chg)
+		}
+	}
+
+	return changes
+}
+
 func min(x, y int) int {
 	if x < y {
 		return x
@@ -21,7 +21,7 @@ func TestDetectTestData(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	detector := DefaultDetector
+	detector := DefaultHybrid
 
 	for _, filename := range filenames {
 		filename := filename // scopelint
@@ -72,8 +72,35 @@ func TestDetectGenerated(t *testing.T) {
 	AssertOneChangeAt(t, changes, 100)
 }
 
+func TestDetectorsGenerated(t *testing.T) {
+	for _, name := range DetectorNames() {
+		name := name // scopelint
+		t.Run(name, func(t *testing.T) {
+			detector, err := NamedDetector(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var series trace.Series
+			series = AppendRandNormSeries(series, 17, 1, 100)
+			series = AppendRandNormSeries(series, 42, 1, 100)
+
+			changes := detector.Detect(series)
+			LogChanges(t, changes)
+
+			AssertOneChangeAt(t, changes, 100)
+		})
+	}
+}
+
+func TestNamedDetectorUnknown(t *testing.T) {
+	if _, err := NamedDetector("idonotexist"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestDetectWindowClipped(t *testing.T) {
-	detector := DefaultDetector
+	detector := DefaultHybrid
 
 	// Test case with *massive* step change, but a window on one side that's
 	// "clipped", meaning smaller than the window of the detector. It's possible
@@ -0,0 +1,156 @@
+package change
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+// EDivisive is a change detector implementing E-Divisive with Medians (EDM).
+//
+// The series is recursively bisected at the point maximizing a robust
+// divergence statistic, the difference in medians of the two sides weighted by
+// segment sizes. A candidate split is accepted only if it is significant
+// according to a permutation test. Since the median statistic is flat close to
+// a step change, the split point is refined by maximizing the difference in
+// means in a small neighborhood. Reference: "Leveraging Cloud Data to
+// Mitigate User Experience from 'Breaking Bad'", James, Kejariwal and
+// Matteson, 2016.
+type EDivisive struct {
+	MinSize      int     // minimum number of points in a segment
+	Permutations int     // number of permutations in significance test
+	Alpha        float64 // significance level
+
+	// Distribution comparison.
+	Context       int     // number of points to consider either side
+	WindowSize    int     // window to consider either side
+	MinEffectSize float64 // Cohen's d threshold
+}
+
+// DefaultEDivisive has sensible default parameter choices.
+var DefaultEDivisive = &EDivisive{
+	MinSize:      10,
+	Permutations: 99,
+	Alpha:        0.01,
+
+	Context:       2,
+	WindowSize:    20,
+	MinEffectSize: 3,
+}
+
+// Detect changes in series.
+func (d *EDivisive) Detect(series trace.Series) []Change {
+	values := series.Values()
+
+	w := newwindows()
+	w.push(values...)
+
+	// Seed deterministically so results are reproducible.
+	rnd := rand.New(rand.NewSource(int64(len(values))))
+
+	// Bisect segments until no further significant splits are found.
+	var cps []int
+	segments := [][2]int{{0, len(values)}}
+	for len(segments) > 0 {
+		l, r := segments[0][0], segments[0][1]
+		segments = segments[1:]
+
+		tau, stat := d.split(values[l:r])
+		if tau < 0 || !d.significant(values[l:r], stat, rnd) {
+			continue
+		}
+
+		tau = d.refine(w, l, r, l+tau)
+		cps = append(cps, tau)
+		segments = append(segments, [2]int{l, tau}, [2]int{tau, r})
+	}
+	sort.Ints(cps)
+
+	return segmentchanges(series, cps, d.Context, d.WindowSize, d.MinEffectSize)
+}
+
+// split returns the best split point of xs and the corresponding statistic.
+// Returns a negative index if xs is too short to split.
+func (d *EDivisive) split(xs []float64) (int, float64) {
+	n := len(xs)
+	if n < 2*d.MinSize {
+		return -1, 0
+	}
+
+	// Compute prefix and suffix medians.
+	pre := runningmedians(xs)
+	rev := make([]float64, n)
+	for i := range xs {
+		rev[n-1-i] = xs[i]
+	}
+	suf := runningmedians(rev)
+
+	best, stat := -1, math.Inf(-1)
+	for tau := d.MinSize; tau <= n-d.MinSize; tau++ {
+		m1, m2 := pre[tau-1], suf[n-tau-1]
+		q := float64(tau*(n-tau)) / float64(n) * math.Abs(m1-m2)
+		if q > stat {
+			best, stat = tau, q
+		}
+	}
+
+	return best, stat
+}
+
+// refine the split point tau of the segment x[l:r] by selecting the point
+// within MinSize/2 either side that maximizes the weighted squared difference
+// in means.
+func (d *EDivisive) refine(w *windows, l, r, tau int) int {
+	n := r - l
+	best, stat := tau, math.Inf(-1)
+	for j := max(tau-d.MinSize/2, l+d.MinSize); j <= min(tau+d.MinSize/2, r-d.MinSize); j++ {
+		n1, n2 := j-l, r-j
+		delta := w.mean(l, j) - w.mean(j, r)
+		q := float64(n1*n2) / float64(n) * delta * delta
+		if q > stat {
+			best, stat = j, q
+		}
+	}
+	return best
+}
+
+// significant reports whether the statistic is significant for xs, by
+// comparison with the best split statistic of random permutations of xs.
+func (d *EDivisive) significant(xs []float64, stat float64, rnd *rand.Rand) bool {
+	perm := append([]float64(nil), xs...)
+	over := 0
+	for i := 0; i < d.Permutations; i++ {
+		rnd.Shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+		if _, s := d.split(perm); s >= stat {
+			over++
+		}
+	}
+	p := float64(over+1) / float64(d.Permutations+1)
+	return p <= d.Alpha
+}
+
+// runningmedians returns medians of all prefixes of xs: the result at index i
+// is the median of xs[:i+1].
+func runningmedians(xs []float64) []float64 {
+	medians := make([]float64, len(xs))
+	sorted := make([]float64, 0, len(xs))
+	for i, x := range xs {
+		j := sort.SearchFloat64s(sorted, x)
+		sorted = append(sorted, 0)
+		copy(sorted[j+1:], sorted[j:])
+		sorted[j] = x
+		medians[i] = median(sorted)
+	}
+	return medians
+}
+
+// median of the sorted list xs.
+func median(xs []float64) float64 {
+	n := len(xs)
+	if n%2 == 1 {
+		return xs[n/2]
+	}
+	return (xs[n/2-1] + xs[n/2]) / 2
+}
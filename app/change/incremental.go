@@ -0,0 +1,93 @@
+package change
+
+import (
+	"sort"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+// Local is implemented by detectors for which a change depends only on points
+// within a bounded number of positions either side of it.
+type Local interface {
+	// Radius returns the number of points either side of a change that may
+	// influence it.
+	Radius() int
+}
+
+// Incremental applies a detector to the region of a series affected by new
+// points, rather than to the whole series. It assumes a change depends only on
+// points within Radius points of it. Therefore points added in a commit range
+// may only alter changes within Radius points of that range, and those changes
+// may be recomputed from points within 2*Radius points. Radius is counted in
+// points rather than commits, since series are sparse.
+//
+// If Radius is zero, changes may depend on the entire series, and detection is
+// not restricted.
+type Incremental struct {
+	Detector Detector
+	Radius   int
+}
+
+// NewIncremental builds incremental detection for d. The radius is taken from
+// d if it implements Local; otherwise d is assumed to be global.
+func NewIncremental(d Detector) *Incremental {
+	inc := &Incremental{Detector: d}
+	if l, ok := d.(Local); ok {
+		inc.Radius = l.Radius()
+	}
+	return inc
+}
+
+// Global reports whether detection requires the whole series.
+func (i *Incremental) Global() bool {
+	return i.Radius <= 0
+}
+
+// Context returns the number of points required either side of points added
+// to a series, in order to recompute affected changes. Not meaningful if
+// detection is global.
+func (i *Incremental) Context() int {
+	return 2 * i.Radius
+}
+
+// Affected returns the commit index range [lo, hi] of changes that may be
+// altered by points added in the range [from, to]. The series should contain
+// at least Context points either side of the range, where they exist.
+func (i *Incremental) Affected(series trace.Series, from, to int) (lo, hi int) {
+	lo, hi = from, to
+	if len(series) == 0 {
+		return
+	}
+
+	// Positions of the first point at or after from, and the first point
+	// after to.
+	a := sort.Search(len(series), func(j int) bool { return series[j].CommitIndex >= from })
+	b := sort.Search(len(series), func(j int) bool { return series[j].CommitIndex > to })
+
+	if i.Global() {
+		return min(lo, series[0].CommitIndex), max(hi, series[len(series)-1].CommitIndex)
+	}
+
+	if j := max(a-i.Radius, 0); j < len(series) {
+		lo = min(lo, series[j].CommitIndex)
+	}
+	if j := min(b-1+i.Radius, len(series)-1); j >= 0 {
+		hi = max(hi, series[j].CommitIndex)
+	}
+	return lo, hi
+}
+
+// Detect changes in series that may be affected by points added in the commit
+// index range [from, to]. The series should contain at least Context points
+// either side of the range, or the whole series if detection is global.
+// Changes outside the Affected range are discarded.
+func (i *Incremental) Detect(series trace.Series, from, to int) []Change {
+	lo, hi := i.Affected(series, from, to)
+	var changes []Change
+	for _, c := range i.Detector.Detect(series) {
+		if lo <= c.CommitIndex && c.CommitIndex <= hi {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
@@ -0,0 +1,172 @@
+package change
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+// shift is a local change detector for testing. It reports the points where
+// the effect size between windows either side is largest within a window, and
+// exceeds a threshold.
+type shift struct {
+	Window        int
+	MinEffectSize float64
+}
+
+// Radius returns 2*Window, since effect sizes within Window positions of a
+// change are compared, and each depends on points within Window of it.
+func (d *shift) Radius() int { return 2 * d.Window }
+
+func (d *shift) Detect(series trace.Series) []Change {
+	w := newwindows()
+	w.push(series.Values()...)
+
+	effects := make([]float64, len(series))
+	for j := d.Window; j+d.Window <= len(series); j++ {
+		effects[j] = cohen(w.stats(j, j+d.Window), w.stats(j-d.Window, j))
+	}
+
+	var changes []Change
+	for j, e := range effects {
+		if math.Abs(e) <= d.MinEffectSize {
+			continue
+		}
+		peak := true
+		for k := max(j-d.Window, 0); k <= j+d.Window && k < len(effects); k++ {
+			if math.Abs(effects[k]) > math.Abs(e) {
+				peak = false
+			}
+		}
+		if peak {
+			changes = append(changes, Change{CommitIndex: series[j].CommitIndex, EffectSize: e})
+		}
+	}
+	return changes
+}
+
+func TestIncrementalMatchesFull(t *testing.T) {
+	var series trace.Series
+	series = AppendRandNormSeries(series, 100, 1, 150)
+	series = AppendRandNormSeries(series, 110, 1, 150)
+	series = AppendRandNormSeries(series, 100, 1, 150)
+
+	d := &shift{Window: 20, MinEffectSize: 3}
+	inc := NewIncremental(d)
+
+	// Points added around the second change.
+	from, to := 290, 310
+
+	changes := inc.Detect(required(series, from, to, inc.Context()), from, to)
+	AssertOneChangeAt(t, changes, 300)
+
+	// Compare with full detection.
+	lo, hi := inc.Affected(series, from, to)
+	for _, c := range d.Detect(series) {
+		if lo <= c.CommitIndex && c.CommitIndex <= hi && c.CommitIndex != changes[0].CommitIndex {
+			t.Fatalf("full detection found change at %d missed by incremental detection", c.CommitIndex)
+		}
+	}
+}
+
+func TestIncrementalMatchesFullSparse(t *testing.T) {
+	// Build a sparse series with a few points per thousand commits, as for
+	// traces that are only measured at some commits. Changes have different
+	// magnitudes.
+	var dense trace.Series
+	dense = AppendRandNormSeries(dense, 100, 1, 200)
+	dense = AppendRandNormSeries(dense, 110, 1, 200)
+	dense = AppendRandNormSeries(dense, 100, 1, 200)
+	dense = AppendRandNormSeries(dense, 150, 1, 200)
+
+	rnd := rand.New(rand.NewSource(1))
+	series := make(trace.Series, len(dense))
+	idx := 0
+	for i, p := range dense {
+		idx += 1 + rnd.Intn(20)
+		series[i] = trace.IndexedValue{CommitIndex: idx, Value: p.Value}
+	}
+
+	d := &shift{Window: 20, MinEffectSize: 3}
+	inc := NewIncremental(d)
+
+	// Simulate updates at various positions, including near changes.
+	for _, pos := range []int{10, 195, 200, 205, 390, 410, 600, 790} {
+		from, to := series[pos].CommitIndex, series[pos+5].CommitIndex
+
+		got := inc.Detect(required(series, from, to, inc.Context()), from, to)
+
+		lo, hi := inc.Affected(series, from, to)
+		var expect []Change
+		for _, c := range d.Detect(series) {
+			if lo <= c.CommitIndex && c.CommitIndex <= hi {
+				expect = append(expect, c)
+			}
+		}
+
+		if len(got) != len(expect) {
+			t.Fatalf("update at position %d: incremental found %d changes; full found %d", pos, len(got), len(expect))
+		}
+		for i := range got {
+			if got[i].CommitIndex != expect[i].CommitIndex {
+				t.Errorf("update at position %d: incremental change at %d; full change at %d", pos, got[i].CommitIndex, expect[i].CommitIndex)
+			}
+		}
+	}
+}
+
+func TestIncrementalAffectedSparse(t *testing.T) {
+	// Points every 10 commits.
+	var series trace.Series
+	for i := 0; i < 100; i++ {
+		series = append(series, trace.IndexedValue{CommitIndex: 10 * i})
+	}
+
+	inc := &Incremental{Detector: &shift{}, Radius: 3}
+	cases := []struct {
+		From, To int
+		Lo, Hi   int
+	}{
+		{From: 500, To: 500, Lo: 470, Hi: 530},
+		{From: 495, To: 515, Lo: 470, Hi: 540},
+		{From: 10, To: 10, Lo: 0, Hi: 40},
+		{From: 985, To: 990, Lo: 960, Hi: 990},
+	}
+	for _, c := range cases {
+		lo, hi := inc.Affected(series, c.From, c.To)
+		if lo != c.Lo || hi != c.Hi {
+			t.Errorf("Affected(%d, %d) = [%d, %d]; expect [%d, %d]", c.From, c.To, lo, hi, c.Lo, c.Hi)
+		}
+	}
+}
+
+func TestIncrementalGlobal(t *testing.T) {
+	for _, d := range []Detector{DefaultHybrid, DefaultEDivisive, DefaultPELT} {
+		inc := NewIncremental(d)
+		if !inc.Global() {
+			t.Fatalf("expected %T to be global", d)
+		}
+	}
+
+	series := trace.Series{{CommitIndex: 10}, {CommitIndex: 20}, {CommitIndex: 30}}
+	inc := NewIncremental(DefaultPELT)
+	lo, hi := inc.Affected(series, 20, 20)
+	if !reflect.DeepEqual([]int{lo, hi}, []int{10, 30}) {
+		t.Fatalf("Affected() = [%d, %d]; expect whole series", lo, hi)
+	}
+}
+
+// required returns the points of series within n points either side of the
+// commit index range [from, to].
+func required(series trace.Series, from, to, n int) trace.Series {
+	a, b := len(series), 0
+	for i, p := range series {
+		if from <= p.CommitIndex && p.CommitIndex <= to {
+			a, b = min(a, i), max(b, i+1)
+		}
+	}
+	return series[max(a-n, 0):min(b+n, len(series))]
+}
@@ -0,0 +1,75 @@
+package change
+
+import "sort"
+
+// Match identifies changes in next that are the same change as one in prev,
+// possibly at a different commit. Changes move as bisection fills in untested
+// commits, or as re-detection over new data shifts a change point.
+//
+// Changes match if their effects have the same direction and no other change
+// in either list lies between them. Each change matches at most one other,
+// with closer pairs preferred. Returns a map from commit index in prev to
+// commit index in next.
+func Match(prev, next []Change) map[int]int {
+	// Merge changes in commit order.
+	type item struct {
+		Change
+		next bool
+	}
+	var items []item
+	for _, c := range prev {
+		items = append(items, item{Change: c})
+	}
+	for _, c := range next {
+		items = append(items, item{Change: c, next: true})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CommitIndex < items[j].CommitIndex
+	})
+
+	// Candidate pairs are adjacent changes from different lists.
+	type pair struct{ prev, next, distance int }
+	var pairs []pair
+	for i := 1; i < len(items); i++ {
+		a, b := items[i-1], items[i]
+		if a.next == b.next || !samedirection(a.EffectSize, b.EffectSize) {
+			continue
+		}
+		if a.next {
+			a, b = b, a
+		}
+		pairs = append(pairs, pair{
+			prev:     a.CommitIndex,
+			next:     b.CommitIndex,
+			distance: abs(b.CommitIndex - a.CommitIndex),
+		})
+	}
+
+	// Greedily take closest pairs first.
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].distance < pairs[j].distance
+	})
+
+	m := map[int]int{}
+	used := map[int]bool{}
+	for _, p := range pairs {
+		if _, ok := m[p.prev]; ok || used[p.next] {
+			continue
+		}
+		m[p.prev] = p.next
+		used[p.next] = true
+	}
+
+	return m
+}
+
+func samedirection(a, b float64) bool {
+	return (a > 0 && b > 0) || (a < 0 && b < 0)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,75 @@
+package change
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	c := func(idx int, effect float64) Change {
+		return Change{CommitIndex: idx, EffectSize: effect}
+	}
+	cases := []struct {
+		Name       string
+		Prev, Next []Change
+		Expect     map[int]int
+	}{
+		{
+			Name:   "unmoved",
+			Prev:   []Change{c(10, 5), c(20, -5)},
+			Next:   []Change{c(10, 5), c(20, -5)},
+			Expect: map[int]int{10: 10, 20: 20},
+		},
+		{
+			Name:   "bisected",
+			Prev:   []Change{c(20, 5)},
+			Next:   []Change{c(14, 6)},
+			Expect: map[int]int{20: 14},
+		},
+		{
+			Name:   "opposite_direction",
+			Prev:   []Change{c(20, 5)},
+			Next:   []Change{c(14, -6)},
+			Expect: map[int]int{},
+		},
+		{
+			Name:   "added",
+			Prev:   []Change{c(20, 5)},
+			Next:   []Change{c(20, 5), c(40, 3)},
+			Expect: map[int]int{20: 20},
+		},
+		{
+			Name:   "removed",
+			Prev:   []Change{c(20, 5), c(40, 3)},
+			Next:   []Change{c(38, 3)},
+			Expect: map[int]int{40: 38},
+		},
+		{
+			Name:   "closest",
+			Prev:   []Change{c(20, 5)},
+			Next:   []Change{c(12, 5), c(21, 5)},
+			Expect: map[int]int{20: 21},
+		},
+		{
+			Name:   "intervening",
+			Prev:   []Change{c(10, 5), c(30, 5)},
+			Next:   []Change{c(20, -5)},
+			Expect: map[int]int{},
+		},
+		{
+			Name:   "both_moved",
+			Prev:   []Change{c(10, 5), c(30, -5)},
+			Next:   []Change{c(8, 5), c(27, -5)},
+			Expect: map[int]int{10: 8, 30: 27},
+		},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			got := Match(c.Prev, c.Next)
+			if !reflect.DeepEqual(got, c.Expect) {
+				t.Fatalf("Match() = %v; expect %v", got, c.Expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package change
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+// PELT is a change detector using the Pruned Exact Linear Time method.
+//
+// Finds the segmentation of the series minimizing the total within-segment
+// squared error plus a penalty per change point, assuming changes in mean
+// with constant variance. The variance is estimated robustly from the
+// median absolute deviation of successive differences, so the penalty is
+// expressed relative to the noise level of the series. Reference: "Optimal
+// Detection of Changepoints With a Linear Computational Cost", Killick,
+// Fearnhead and Eckley, 2012.
+type PELT struct {
+	MinSize int     // minimum number of points in a segment
+	Penalty float64 // penalty per change point, as a multiple of log(n)
+
+	// Distribution comparison.
+	Context       int     // number of points to consider either side
+	WindowSize    int     // window to consider either side
+	MinEffectSize float64 // Cohen's d threshold
+}
+
+// DefaultPELT has sensible default parameter choices.
+var DefaultPELT = &PELT{
+	MinSize: 5,
+	Penalty: 3,
+
+	Context:       2,
+	WindowSize:    20,
+	MinEffectSize: 3,
+}
+
+// Detect changes in series.
+func (d *PELT) Detect(series trace.Series) []Change {
+	values := series.Values()
+	n := len(values)
+	if n < 2*d.MinSize {
+		return nil
+	}
+
+	// Normalize cost by noise variance estimate.
+	sigma2 := noisevariance(values)
+	if sigma2 == 0 {
+		return nil
+	}
+
+	w := newwindows()
+	w.push(values...)
+	cost := func(l, r int) float64 {
+		sum := w.sum(l, r)
+		return (w.sumsq(l, r) - sum*sum/float64(r-l)) / sigma2
+	}
+	beta := d.Penalty * math.Log(float64(n))
+
+	// F[t] is the optimal cost of segmenting values[:t], and last[t] is the
+	// final change point in that segmentation.
+	F := make([]float64, n+1)
+	last := make([]int, n+1)
+	for t := range F {
+		F[t] = math.Inf(1)
+	}
+	F[0] = -beta
+
+	var candidates []int
+	for t := d.MinSize; t <= n; t++ {
+		// Admit new candidate whose final segment would be of minimum size.
+		if s := t - d.MinSize; s == 0 || s >= d.MinSize {
+			candidates = append(candidates, s)
+		}
+
+		// Find optimal last change point.
+		costs := make([]float64, len(candidates))
+		for i, s := range candidates {
+			costs[i] = F[s] + cost(s, t)
+			if c := costs[i] + beta; c < F[t] {
+				F[t] = c
+				last[t] = s
+			}
+		}
+
+		// Prune candidates that can never be optimal.
+		keep := candidates[:0]
+		for i, s := range candidates {
+			if costs[i] <= F[t] {
+				keep = append(keep, s)
+			}
+		}
+		candidates = keep
+	}
+
+	// Backtrack to recover change points.
+	var cps []int
+	for t := last[n]; t > 0; t = last[t] {
+		cps = append(cps, t)
+	}
+	sort.Ints(cps)
+
+	return segmentchanges(series, cps, d.Context, d.WindowSize, d.MinEffectSize)
+}
+
+// noisevariance estimates the noise variance of xs from the median absolute
+// deviation of successive differences, which is insensitive to level shifts.
+func noisevariance(xs []float64) float64 {
+	diffs := make([]float64, len(xs)-1)
+	for i := 1; i < len(xs); i++ {
+		diffs[i-1] = xs[i] - xs[i-1]
+	}
+	sort.Float64s(diffs)
+	m := median(diffs)
+
+	devs := make([]float64, len(diffs))
+	for i, x := range diffs {
+		devs[i] = math.Abs(x - m)
+	}
+	sort.Float64s(devs)
+
+	// Scale MAD to a standard deviation estimate, assuming normality. The
+	// differences have twice the variance of the underlying noise.
+	sigma := 1.4826 * median(devs)
+	return sigma * sigma / 2
+}
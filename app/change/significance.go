@@ -0,0 +1,87 @@
+package change
+
+import (
+	"math"
+
+	"github.com/aclements/go-moremath/stats"
+)
+
+// ConfidenceLevel is the level used for confidence intervals on changes.
+const ConfidenceLevel = 0.95
+
+// Interval is a closed interval of real numbers.
+type Interval struct {
+	Lower float64
+	Upper float64
+}
+
+// Contains reports whether x is in the interval.
+func (i Interval) Contains(x float64) bool {
+	return i.Lower <= x && x <= i.Upper
+}
+
+// Known reports whether both bounds of the interval are available.
+func (i Interval) Known() bool {
+	return !math.IsNaN(i.Lower) && !math.IsNaN(i.Upper)
+}
+
+// Significance quantifies the statistical strength of a change.
+type Significance struct {
+	PValue    float64  // two-sided Mann-Whitney U test p-value
+	PercentCI Interval // confidence interval for percent change
+}
+
+// Known reports whether significance values are available. Both the p-value
+// and the confidence interval are required.
+func (s Significance) Known() bool {
+	return !math.IsNaN(s.PValue) && s.PercentCI.Known()
+}
+
+// significance computes statistical significance of the change from samples
+// pre to post, with corresponding summary statistics spre and spost.
+func significance(pre, post []float64, spre, spost Stats) Significance {
+	return Significance{
+		PValue:    utest(pre, post),
+		PercentCI: percentci(spre, spost, ConfidenceLevel),
+	}
+}
+
+// utest returns the p-value of a two-sided Mann-Whitney U test on samples x1
+// and x2. Returns 1 when the test is not applicable, for example if all
+// samples are equal.
+func utest(x1, x2 []float64) float64 {
+	res, err := stats.MannWhitneyUTest(x1, x2, stats.LocationDiffers)
+	if err != nil {
+		return 1
+	}
+	return res.P
+}
+
+// percentci returns a confidence interval at the given level for the percent
+// change in mean from pre to post. The interval for the difference in means is
+// derived from Welch's t-test, then expressed relative to the pre mean.
+func percentci(pre, post Stats, level float64) Interval {
+	nan := Interval{Lower: math.NaN(), Upper: math.NaN()}
+	if pre.N < 2 || post.N < 2 || pre.Mean == 0 {
+		return nan
+	}
+
+	n1, n2 := float64(pre.N), float64(post.N)
+	v1, v2 := pre.Variance/n1, post.Variance/n2
+	se := math.Sqrt(v1 + v2)
+	if se == 0 {
+		p := 100 * (post.Mean - pre.Mean) / pre.Mean
+		return Interval{Lower: p, Upper: p}
+	}
+
+	// Welch-Satterthwaite degrees of freedom.
+	dof := (v1 + v2) * (v1 + v2) / (v1*v1/(n1-1) + v2*v2/(n2-1))
+	t := stats.InvCDF(stats.TDist{V: dof})(1 - (1-level)/2)
+
+	delta := post.Mean - pre.Mean
+	scale := 100 / math.Abs(pre.Mean)
+	return Interval{
+		Lower: scale * (delta - t*se),
+		Upper: scale * (delta + t*se),
+	}
+}
@@ -0,0 +1,76 @@
+package change
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+func TestDetectSignificance(t *testing.T) {
+	var series trace.Series
+	series = AppendRandNormSeries(series, 100, 1, 100)
+	series = AppendRandNormSeries(series, 110, 1, 100)
+
+	changes := DefaultHybrid.Detect(series)
+	AssertOneChangeAt(t, changes, 100)
+
+	c := changes[0]
+	t.Logf("p=%v ci=%v", c.PValue, c.PercentCI)
+
+	if c.PValue > 1e-3 {
+		t.Errorf("p-value %v unexpectedly large", c.PValue)
+	}
+
+	if c.PercentCI.Lower <= 0 {
+		t.Errorf("confidence interval %v should exclude zero", c.PercentCI)
+	}
+
+	if !c.PercentCI.Contains(c.Percent()) {
+		t.Errorf("confidence interval %v does not contain observed percent change %v", c.PercentCI, c.Percent())
+	}
+}
+
+func TestUTestEqualSamples(t *testing.T) {
+	xs := []float64{1, 1, 1, 1}
+	if p := utest(xs, xs); p != 1 {
+		t.Fatalf("utest on equal samples returned p=%v; expect 1", p)
+	}
+}
+
+func TestPercentCIZeroVariance(t *testing.T) {
+	pre := Stats{N: 10, Mean: 100}
+	post := Stats{N: 10, Mean: 110}
+	ci := percentci(pre, post, ConfidenceLevel)
+	if ci.Lower != 10 || ci.Upper != 10 {
+		t.Fatalf("percentci = %v; expect degenerate interval at 10", ci)
+	}
+}
+
+func TestPercentCISmallSample(t *testing.T) {
+	pre := Stats{N: 1, Mean: 100}
+	post := Stats{N: 10, Mean: 110, Variance: 1}
+	ci := percentci(pre, post, ConfidenceLevel)
+	if !math.IsNaN(ci.Lower) || !math.IsNaN(ci.Upper) {
+		t.Fatalf("percentci = %v; expect NaN interval", ci)
+	}
+}
+
+func TestSignificanceKnown(t *testing.T) {
+	nan := math.NaN()
+	cases := []struct {
+		Name         string
+		Significance Significance
+		Expect       bool
+	}{
+		{"known", Significance{PValue: 0.01, PercentCI: Interval{Lower: 1, Upper: 2}}, true},
+		{"nan_pvalue", Significance{PValue: nan, PercentCI: Interval{Lower: 1, Upper: 2}}, false},
+		{"nan_ci", Significance{PValue: 0.01, PercentCI: Interval{Lower: nan, Upper: nan}}, false},
+		{"nan_ci_lower", Significance{PValue: 0.01, PercentCI: Interval{Lower: nan, Upper: 2}}, false},
+	}
+	for _, c := range cases {
+		if got := c.Significance.Known(); got != c.Expect {
+			t.Errorf("%s: Known() = %v; expect %v", c.Name, got, c.Expect)
+		}
+	}
+}
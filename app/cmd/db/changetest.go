@@ -5,11 +5,13 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/google/subcommands"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/mmcloughlin/goperf/app/change"
 	"github.com/mmcloughlin/goperf/app/change/changetest"
 	"github.com/mmcloughlin/goperf/app/db"
 	"github.com/mmcloughlin/goperf/app/entity"
@@ -24,12 +26,17 @@ type ChangeTest struct {
 	environmentUUID string
 	index           int
 	context         int
+	detector        string
 	output          string
+
+	// Nested subcommands.
+	eval *ChangeTestEval
 }
 
 func NewChangeTest(b command.Base) *ChangeTest {
 	return &ChangeTest{
 		Base: b,
+		eval: NewChangeTestEval(b),
 	}
 }
 
@@ -40,7 +47,13 @@ func (*ChangeTest) Synopsis() string {
 }
 
 func (*ChangeTest) Usage() string {
-	return ""
+	return `Usage: changetest [flags]
+       changetest eval [flags] <dir>
+
+Initialize a change detection test case from a trace in the database, or
+evaluate a change detector against a directory of test cases.
+
+`
 }
 
 func (cmd *ChangeTest) SetFlags(f *flag.FlagSet) {
@@ -48,10 +61,18 @@ func (cmd *ChangeTest) SetFlags(f *flag.FlagSet) {
 	f.StringVar(&cmd.environmentUUID, "environment-uuid", "", "environment uuid")
 	f.IntVar(&cmd.index, "commit-index", -1, "commit index")
 	f.IntVar(&cmd.context, "context", 150, "number of commits either side")
+	f.StringVar(&cmd.detector, "detector", "", "populate expected changes with named detector ("+strings.Join(change.DetectorNames(), ", ")+")")
 	f.StringVar(&cmd.output, "output", ".", "output directory")
 }
 
-func (cmd *ChangeTest) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
+func (cmd *ChangeTest) Execute(ctx context.Context, f *flag.FlagSet, args ...interface{}) (status subcommands.ExitStatus) {
+	// Dispatch to nested subcommands.
+	if f.NArg() > 0 {
+		cdr := subcommands.NewCommander(f, cmd.Name())
+		cdr.Register(cmd.eval, "")
+		return cdr.Execute(ctx, args...)
+	}
+
 	// Parse options.
 	benchmarkUUID, err := uuid.Parse(cmd.benchmarkUUID)
 	if err != nil {
@@ -71,6 +92,14 @@ func (cmd *ChangeTest) Execute(ctx context.Context, f *flag.FlagSet, _ ...interf
 		return cmd.UsageError("must specify output directory")
 	}
 
+	var detector change.Detector
+	if cmd.detector != "" {
+		detector, err = change.NamedDetector(cmd.detector)
+		if err != nil {
+			return cmd.UsageError("detector: %v", err)
+		}
+	}
+
 	id := trace.ID{
 		BenchmarkUUID:   benchmarkUUID,
 		EnvironmentUUID: environmentUUID,
@@ -104,10 +133,22 @@ func (cmd *ChangeTest) Execute(ctx context.Context, f *flag.FlagSet, _ ...interf
 		return cmd.Error(err)
 	}
 
+	// Build test case, optionally seeding expected changes from a detector.
+	tc := changetest.NewCase(t.Series)
+	if detector != nil {
+		for _, chg := range detector.Detect(t.Series) {
+			tc.Expect = append(tc.Expect, chg.CommitIndex)
+		}
+		cmd.Log.Info("detected changes",
+			zap.String("detector", cmd.detector),
+			zap.Ints("commit_indexes", tc.Expect),
+		)
+	}
+
 	// Write to file.
 	filename := filepath.Join(cmd.output, changetest.Filename(id))
 	cmd.Log.Info("write file", zap.String("output", filename))
-	if err := changetest.WriteNewCaseFile(filename, t.Series); err != nil {
+	if err := changetest.WriteCaseFile(filename, tc); err != nil {
 		return cmd.Error(err)
 	}
 
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/subcommands"
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/change/changetest"
+	"github.com/mmcloughlin/goperf/app/trace"
+	"github.com/mmcloughlin/goperf/internal/flags"
+	"github.com/mmcloughlin/goperf/pkg/command"
+)
+
+// ChangeTestEval scores change detectors against a directory of test cases.
+// Registered as the "eval" subcommand of ChangeTest.
+type ChangeTestEval struct {
+	command.Base
+
+	detector  string
+	tolerance int
+	verbose   bool
+
+	// Grid search parameters.
+	m, k             flags.Strings
+	percentThreshold flags.Strings
+	windowSize       flags.Strings
+	minEffectSize    flags.Strings
+}
+
+func NewChangeTestEval(b command.Base) *ChangeTestEval {
+	return &ChangeTestEval{
+		Base: b,
+	}
+}
+
+func (*ChangeTestEval) Name() string { return "eval" }
+
+func (*ChangeTestEval) Synopsis() string {
+	return "evaluate change detection on test cases"
+}
+
+func (*ChangeTestEval) Usage() string {
+	return `Usage: changetest eval [flags] <dir>
+
+Evaluate a change detector on the test cases in <dir>. If any grid search
+parameters are given, evaluate every combination and report aggregate scores
+sorted by F1.
+
+`
+}
+
+func (cmd *ChangeTestEval) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.detector, "detector", "", "change detector ("+strings.Join(change.DetectorNames(), ", ")+")")
+	f.IntVar(&cmd.tolerance, "tolerance", 2, "maximum commit index distance for a detection to match")
+	f.BoolVar(&cmd.verbose, "v", false, "report per-case scores in grid search mode")
+	f.Var(&cmd.m, "m", "grid search KZA window `values`")
+	f.Var(&cmd.k, "k", "grid search KZA iteration `values`")
+	f.Var(&cmd.percentThreshold, "pct", "grid search KZA percent threshold `values`")
+	f.Var(&cmd.windowSize, "window", "grid search window size `values`")
+	f.Var(&cmd.minEffectSize, "effect", "grid search minimum effect size `values`")
+}
+
+func (cmd *ChangeTestEval) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	if f.NArg() != 1 {
+		return cmd.UsageError("expected test case directory")
+	}
+	dir := f.Arg(0)
+
+	base, err := change.NamedDetector(cmd.detector)
+	if err != nil {
+		return cmd.UsageError("detector: %v", err)
+	}
+
+	// Configure a detector at every grid point.
+	points := cmd.grid()
+	detectors := make([]change.Detector, len(points))
+	for i, p := range points {
+		detectors[i], err = p.configure(base)
+		if err != nil {
+			return cmd.UsageError("grid: %v", err)
+		}
+	}
+
+	// Load test cases.
+	cases, err := changetest.ReadCaseDir(dir)
+	if err != nil {
+		return cmd.Error(err)
+	}
+	cmd.Log.Info("loaded test cases", zap.Int("num_cases", len(cases)))
+
+	// Evaluate at every grid point.
+	type result struct {
+		params gridpoint
+		eval   *changetest.Evaluation
+	}
+	var results []result
+	for i, p := range points {
+		results = append(results, result{
+			params: p,
+			eval:   changetest.Evaluate(detectfunc(detectors[i]), cases, cmd.tolerance),
+		})
+	}
+
+	// Report.
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+	if len(results) == 1 {
+		writeCaseResults(w, results[0].eval)
+		return cmd.Status(w.Flush())
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		si, sj := results[i].eval.Score, results[j].eval.Score
+		if si.F1() != sj.F1() {
+			return si.F1() > sj.F1()
+		}
+		return si.MeanError() < sj.MeanError()
+	})
+
+	fmt.Fprintln(w, "params\ttp\tfp\tfn\tprecision\trecall\tf1\tmean_err\tmax_err")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t", r.params)
+		writeScore(w, r.eval.Score)
+	}
+	if err := w.Flush(); err != nil {
+		return cmd.Error(err)
+	}
+
+	if cmd.verbose {
+		for _, r := range results {
+			fmt.Printf("\n# %s\n", r.params)
+			writeCaseResults(w, r.eval)
+			if err := w.Flush(); err != nil {
+				return cmd.Error(err)
+			}
+		}
+	}
+
+	return subcommands.ExitSuccess
+}
+
+// grid returns all combinations of grid search parameters.
+func (cmd *ChangeTestEval) grid() []gridpoint {
+	points := []gridpoint{{}}
+	dims := []struct {
+		Name   string
+		Values []string
+	}{
+		{"M", cmd.m},
+		{"K", cmd.k},
+		{"PercentThreshold", cmd.percentThreshold},
+		{"WindowSize", cmd.windowSize},
+		{"MinEffectSize", cmd.minEffectSize},
+	}
+	for _, dim := range dims {
+		if len(dim.Values) == 0 {
+			continue
+		}
+		var next []gridpoint
+		for _, p := range points {
+			for _, v := range dim.Values {
+				next = append(next, p.with(dim.Name, v))
+			}
+		}
+		points = next
+	}
+	return points
+}
+
+// gridpoint is an assignment of values to detector parameters. Values are
+// parsed according to the type of the parameter when applied to a detector.
+type gridpoint []struct {
+	Name  string
+	Value string
+}
+
+func (p gridpoint) with(name, value string) gridpoint {
+	q := append(gridpoint{}, p...)
+	return append(q, struct {
+		Name  string
+		Value string
+	}{name, value})
+}
+
+func (p gridpoint) String() string {
+	if len(p) == 0 {
+		return "default"
+	}
+	fields := make([]string, len(p))
+	for i, v := range p {
+		fields[i] = v.Name + "=" + v.Value
+	}
+	return strings.Join(fields, ",")
+}
+
+// configure returns a copy of the base detector with parameters applied.
+func (p gridpoint) configure(base change.Detector) (change.Detector, error) {
+	var ints map[string]*int
+	var floats map[string]*float64
+	var d change.Detector
+
+	switch b := base.(type) {
+	case *change.Hybrid:
+		h := *b
+		ints = map[string]*int{"M": &h.M, "K": &h.K, "WindowSize": &h.WindowSize}
+		floats = map[string]*float64{"PercentThreshold": &h.PercentThreshold, "MinEffectSize": &h.MinEffectSize}
+		d = &h
+	case *change.EDivisive:
+		e := *b
+		ints = map[string]*int{"WindowSize": &e.WindowSize}
+		floats = map[string]*float64{"MinEffectSize": &e.MinEffectSize}
+		d = &e
+	case *change.PELT:
+		q := *b
+		ints = map[string]*int{"WindowSize": &q.WindowSize}
+		floats = map[string]*float64{"MinEffectSize": &q.MinEffectSize}
+		d = &q
+	default:
+		if len(p) > 0 {
+			return nil, errors.New("detector does not support grid search")
+		}
+		return base, nil
+	}
+
+	for _, v := range p {
+		switch {
+		case ints[v.Name] != nil:
+			x, err := strconv.Atoi(v.Value)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", v.Name, err)
+			}
+			*ints[v.Name] = x
+		case floats[v.Name] != nil:
+			x, err := strconv.ParseFloat(v.Value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", v.Name, err)
+			}
+			*floats[v.Name] = x
+		default:
+			return nil, fmt.Errorf("detector does not have parameter %s", v.Name)
+		}
+	}
+
+	return d, nil
+}
+
+// detectfunc adapts a change detector to the evaluation interface.
+func detectfunc(d change.Detector) changetest.DetectFunc {
+	return func(s trace.Series) []int {
+		var points []int
+		for _, c := range d.Detect(s) {
+			points = append(points, c.CommitIndex)
+		}
+		sort.Ints(points)
+		return points
+	}
+}
+
+func writeCaseResults(w io.Writer, e *changetest.Evaluation) {
+	fmt.Fprintln(w, "case\texpect\tdetected\ttp\tfp\tfn\tprecision\trecall\tf1\tmean_err\tmax_err")
+	for _, c := range e.Cases {
+		fmt.Fprintf(w, "%s\t%v\t%v\t", c.Name, c.Expect, c.Detected)
+		writeScore(w, c.Score)
+	}
+	fmt.Fprint(w, "total\t\t\t")
+	writeScore(w, e.Score)
+}
+
+func writeScore(w io.Writer, s changetest.Score) {
+	fmt.Fprintf(w, "%d\t%d\t%d\t%.3f\t%.3f\t%.3f\t%.2f\t%d\n",
+		s.TruePositives, s.FalsePositives, s.FalseNegatives,
+		s.Precision(), s.Recall(), s.F1(),
+		s.MeanError(), s.MaxError,
+	)
+}
@@ -18,6 +18,8 @@ import (
 type Commits struct {
 	command.Base
 
+	repo  string
+	dir   string
 	batch int
 }
 
@@ -30,7 +32,7 @@ func NewCommits(b command.Base) *Commits {
 func (*Commits) Name() string { return "commits" }
 
 func (*Commits) Synopsis() string {
-	return "import all go repository commits"
+	return "import all repository commits"
 }
 
 func (*Commits) Usage() string {
@@ -38,6 +40,8 @@ func (*Commits) Usage() string {
 }
 
 func (cmd *Commits) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.repo, "repo", repo.GoURL, "repository url")
+	f.StringVar(&cmd.dir, "dir", "", "read from local git checkout instead of cloning")
 	f.IntVar(&cmd.batch, "batch", 1024, "number of inserts per batch")
 }
 
@@ -54,9 +58,14 @@ func (cmd *Commits) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface
 	}
 	defer cmd.CheckClose(&status, d)
 
-	// Clone the Go repository.
+	r, err := findRepository(ctx, d, cmd.repo)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	// Clone the repository.
 	scope := lg.Scope(cmd.Log, "clone")
-	g, err := repo.Clone(ctx, repo.GoURL)
+	g, err := checkout(ctx, r.URL, cmd.dir)
 	scope()
 	if err != nil {
 		return cmd.Error(err)
@@ -65,7 +74,7 @@ func (cmd *Commits) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface
 
 	// "git log"
 	scope = lg.Scope(cmd.Log, "git log")
-	it, err := g.Log()
+	it, err := g.Commits("HEAD")
 	scope()
 	if err != nil {
 		return cmd.Error(err)
@@ -84,7 +93,7 @@ func (cmd *Commits) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface
 
 		// Insert batch.
 		cmd.Log.Info("inserting commits", zap.Int("num_commits", len(batch)))
-		if err := d.StoreCommits(ctx, batch); err != nil {
+		if err := d.StoreCommits(ctx, r.UUID(), batch); err != nil {
 			return cmd.Error(err)
 		}
 	}
@@ -95,6 +104,8 @@ func (cmd *Commits) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface
 type Refs struct {
 	command.Base
 
+	repo  string
+	dir   string
 	batch int
 }
 
@@ -115,6 +126,8 @@ func (*Refs) Usage() string {
 }
 
 func (cmd *Refs) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.repo, "repo", repo.GoURL, "repository url")
+	f.StringVar(&cmd.dir, "dir", "", "read from local git checkout instead of cloning")
 	f.IntVar(&cmd.batch, "batch", 1024, "number of inserts per batch")
 }
 
@@ -131,9 +144,14 @@ func (cmd *Refs) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 	}
 	defer cmd.CheckClose(&status, d)
 
-	// Clone the Go repository.
+	r, err := findRepository(ctx, d, cmd.repo)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	// Clone the repository.
 	scope := lg.Scope(cmd.Log, "clone")
-	g, err := repo.Clone(ctx, repo.GoURL)
+	g, err := checkout(ctx, r.URL, cmd.dir, repo.WithFirstParent())
 	scope()
 	if err != nil {
 		return cmd.Error(err)
@@ -142,16 +160,13 @@ func (cmd *Refs) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 
 	// "git log --first-parent"
 	scope = lg.Scope(cmd.Log, "git log")
-	it, err := g.Log()
+	it, err := g.Commits(r.Ref)
 	scope()
 	if err != nil {
 		return cmd.Error(err)
 	}
-
-	it = repo.FirstParent(it)
 	defer it.Close()
 
-	ref := "master"
 	batches := NewBatchIterator(it, cmd.batch)
 	for {
 		batch, err := batches.Next()
@@ -167,12 +182,12 @@ func (cmd *Refs) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 		for i, c := range batch {
 			refs[i] = &entity.CommitRef{
 				SHA: c.SHA,
-				Ref: ref,
+				Ref: r.Ref,
 			}
 		}
 
 		cmd.Log.Info("inserting commit refs", zap.Int("num_commit_refs", len(refs)))
-		if err := d.StoreCommitRefs(ctx, refs); err != nil {
+		if err := d.StoreCommitRefs(ctx, r.UUID(), refs); err != nil {
 			return cmd.Error(err)
 		}
 	}
@@ -180,6 +195,14 @@ func (cmd *Refs) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 	return subcommands.ExitSuccess
 }
 
+// checkout opens the git repository in dir if provided, otherwise clones url.
+func checkout(ctx context.Context, url, dir string, opts ...repo.GitOption) (*repo.Git, error) {
+	if dir != "" {
+		return repo.Open(dir, opts...)
+	}
+	return repo.Clone(ctx, url, opts...)
+}
+
 // BatchIterator is a helper for batching a commit sequence.
 type BatchIterator struct {
 	commits repo.CommitIterator
@@ -215,6 +238,8 @@ func (b *BatchIterator) Next() ([]*entity.Commit, error) {
 
 type Positions struct {
 	command.Base
+
+	repo string
 }
 
 func NewPositions(b command.Base) *Positions {
@@ -233,6 +258,10 @@ func (*Positions) Usage() string {
 	return ""
 }
 
+func (cmd *Positions) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.repo, "repo", repo.GoURL, "repository url")
+}
+
 func (cmd *Positions) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
 	// Open database.
 	sqldb, err := open()
@@ -246,8 +275,13 @@ func (cmd *Positions) Execute(ctx context.Context, f *flag.FlagSet, _ ...interfa
 	}
 	defer cmd.CheckClose(&status, d)
 
+	r, err := findRepository(ctx, d, cmd.repo)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
 	// Rebuild.
-	if err := d.BuildCommitPositions(ctx); err != nil {
+	if err := d.BuildCommitPositions(ctx, r); err != nil {
 		return cmd.Error(err)
 	}
 
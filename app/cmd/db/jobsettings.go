@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/subcommands"
+
+	"github.com/mmcloughlin/goperf/app/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/pkg/command"
+	"github.com/mmcloughlin/goperf/pkg/mod"
+)
+
+type JobSettings struct {
+	command.Base
+
+	version string
+
+	short          bool
+	benchtime      time.Duration
+	count          int
+	interleave     bool
+	tests          string
+	benchmarks     string
+	benchmarksskip string
+	timeout        time.Duration
+	requires       string
+}
+
+func NewJobSettings(b command.Base) *JobSettings {
+	return &JobSettings{
+		Base: b,
+	}
+}
+
+func (*JobSettings) Name() string { return "jobsettings" }
+
+func (*JobSettings) Synopsis() string {
+	return "view or update benchmark job settings for a module"
+}
+
+func (*JobSettings) Usage() string {
+	return `Usage: jobsettings [flags] <module path>
+
+Display benchmark job settings for a module. Settings given by flags are
+updated; all others are left unchanged.
+
+The requires flag names a file listing pinned module versions, one
+"path@version" per line, such as the output of "bench vendor". An empty value
+clears the pinned modules.
+
+`
+}
+
+func (cmd *JobSettings) SetFlags(f *flag.FlagSet) {
+	d := entity.DefaultJobSettings
+	f.StringVar(&cmd.version, "version", "", "module version")
+	f.BoolVar(&cmd.short, "short", d.Short, "run tests in short mode")
+	f.DurationVar(&cmd.benchtime, "benchtime", d.BenchTime, "minimum benchmark time")
+	f.IntVar(&cmd.count, "count", d.Count, "number of times to run each benchmark")
+	f.BoolVar(&cmd.interleave, "interleave", d.Interleave, "interleave benchmark repetitions across packages")
+	f.StringVar(&cmd.tests, "tests", d.Tests, "tests regular expression")
+	f.StringVar(&cmd.benchmarks, "benchmarks", d.Benchmarks, "benchmarks regular expression")
+	f.StringVar(&cmd.benchmarksskip, "skip", d.BenchmarksSkip, "regular expression for tests and benchmarks to skip")
+	f.DurationVar(&cmd.timeout, "timeout", d.Timeout, "job timeout")
+	f.StringVar(&cmd.requires, "requires", "", "file listing pinned module versions")
+}
+
+func (cmd *JobSettings) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
+	// Process arguments.
+	path := f.Arg(0)
+	if path == "" {
+		return cmd.UsageError("no module path provided")
+	}
+
+	if cmd.count < 1 {
+		return cmd.UsageError("count must be positive")
+	}
+
+	m := &entity.Module{
+		Path:    path,
+		Version: cmd.version,
+	}
+
+	// Open database.
+	sqldb, err := open()
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	d, err := db.New(ctx, sqldb)
+	if err != nil {
+		return cmd.Error(err)
+	}
+	defer cmd.CheckClose(&status, d)
+
+	// Confirm the module exists.
+	if _, err := d.FindModuleByUUID(ctx, m.UUID()); err != nil {
+		return cmd.Error(fmt.Errorf("find module %s: %w", m, err))
+	}
+
+	// Load current settings and apply any provided flags.
+	s, err := d.FindModuleJobSettings(ctx, m.UUID())
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	var requires []string
+	if cmd.requires != "" {
+		requires, err = readrequires(cmd.requires)
+		if err != nil {
+			return cmd.Error(err)
+		}
+	}
+
+	updated := false
+	f.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "short":
+			s.Short = cmd.short
+		case "benchtime":
+			s.BenchTime = cmd.benchtime
+		case "count":
+			s.Count = cmd.count
+		case "interleave":
+			s.Interleave = cmd.interleave
+		case "tests":
+			s.Tests = cmd.tests
+		case "benchmarks":
+			s.Benchmarks = cmd.benchmarks
+		case "skip":
+			s.BenchmarksSkip = cmd.benchmarksskip
+		case "timeout":
+			s.Timeout = cmd.timeout
+		case "requires":
+			s.Requires = requires
+		default:
+			return
+		}
+		updated = true
+	})
+
+	if updated {
+		if err := d.StoreModuleJobSettings(ctx, m.UUID(), s); err != nil {
+			return cmd.Error(err)
+		}
+		cmd.Log.Info("job settings updated")
+	}
+
+	// Report.
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "module\t%s\n", m)
+	fmt.Fprintf(w, "short\t%v\n", s.Short)
+	fmt.Fprintf(w, "benchtime\t%s\n", s.BenchTime)
+	fmt.Fprintf(w, "count\t%d\n", s.Count)
+	fmt.Fprintf(w, "interleave\t%v\n", s.Interleave)
+	fmt.Fprintf(w, "tests\t%q\n", s.Tests)
+	fmt.Fprintf(w, "benchmarks\t%q\n", s.Benchmarks)
+	fmt.Fprintf(w, "skip\t%q\n", s.BenchmarksSkip)
+	fmt.Fprintf(w, "timeout\t%s\n", s.Timeout)
+	for _, r := range s.Requires {
+		fmt.Fprintf(w, "requires\t%s\n", r)
+	}
+	return cmd.Status(w.Flush())
+}
+
+// readrequires reads pinned module versions from the named file, one
+// "path@version" per line. Blank lines and lines starting with "#" are ignored.
+func readrequires(filename string) ([]string, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var requires []string
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if v := mod.Parse(line); v.Path == "" || v.Version == "" {
+			return nil, fmt.Errorf("invalid module version %q", line)
+		}
+		requires = append(requires, line)
+	}
+
+	return requires, nil
+}
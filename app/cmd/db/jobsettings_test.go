@@ -0,0 +1,61 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+)
+
+func TestReadRequires(t *testing.T) {
+	filename := filepath.Join(test.TempDir(t), "requires.txt")
+	data := `# pinned by bench vendor
+golang.org/x/text@v0.3.2
+
+  github.com/pkg/errors@v0.9.1  
+`
+	if err := ioutil.WriteFile(filename, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readrequires(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{
+		"golang.org/x/text@v0.3.2",
+		"github.com/pkg/errors@v0.9.1",
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("readrequires() = %q; expect %q", got, expect)
+	}
+}
+
+func TestReadRequiresErrors(t *testing.T) {
+	cases := map[string]string{
+		"no version":    "golang.org/x/text\n",
+		"empty version": "golang.org/x/text@\n",
+		"no path":       "@v0.3.2\n",
+		"go.mod line":   "golang.org/x/text v0.3.2\n",
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(test.TempDir(t), "requires.txt")
+			if err := ioutil.WriteFile(filename, []byte(data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := readrequires(filename); err == nil {
+				t.Fatalf("expected error for %q", data)
+			}
+		})
+	}
+}
+
+func TestReadRequiresMissingFile(t *testing.T) {
+	if _, err := readrequires(filepath.Join(test.TempDir(t), "missing.txt")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
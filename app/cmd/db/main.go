@@ -31,6 +31,8 @@ func run(ctx context.Context, l *zap.Logger) int {
 	// Database commands.
 	subcommands.Register(NewMigrate(base), "database admin")
 	subcommands.Register(NewTruncate(base), "database admin")
+	subcommands.Register(NewJobSettings(base), "database admin")
+	subcommands.Register(NewRepository(base), "database admin")
 
 	subcommands.Register(NewCommits(base), "data ingestion")
 	subcommands.Register(NewRefs(base), "data ingestion")
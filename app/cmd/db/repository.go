@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/google/subcommands"
+
+	"github.com/mmcloughlin/goperf/app/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/pkg/command"
+)
+
+type Repository struct {
+	command.Base
+
+	ref       string
+	module    string
+	toolchain string
+}
+
+func NewRepository(b command.Base) *Repository {
+	return &Repository{
+		Base: b,
+	}
+}
+
+func (*Repository) Name() string { return "repository" }
+
+func (*Repository) Synopsis() string {
+	return "register or view a repository under test"
+}
+
+func (*Repository) Usage() string {
+	return `Usage: repository [flags] <url>
+
+Display configuration for the repository with the given git URL. Settings given
+by flags are updated; all others are left unchanged. Repositories that do not
+exist are registered, in which case the module path is required.
+
+`
+}
+
+func (cmd *Repository) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.ref, "ref", "master", "branch to follow")
+	f.StringVar(&cmd.module, "module", "", "module path at the repository root")
+	f.StringVar(&cmd.toolchain, "toolchain", "", "go toolchain commit sha or ref to benchmark with")
+}
+
+func (cmd *Repository) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
+	// Process arguments.
+	url := f.Arg(0)
+	if url == "" {
+		return cmd.UsageError("no repository url provided")
+	}
+
+	// Open database.
+	sqldb, err := open()
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	d, err := db.New(ctx, sqldb)
+	if err != nil {
+		return cmd.Error(err)
+	}
+	defer cmd.CheckClose(&status, d)
+
+	// Load the existing repository, if any.
+	r := &entity.Repository{URL: url}
+	existing, err := d.FindRepositoryByUUID(ctx, r.UUID())
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		r.Ref = cmd.ref
+	case err != nil:
+		return cmd.Error(err)
+	default:
+		r = existing
+	}
+
+	// Apply provided flags.
+	updated := existing == nil
+	f.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "ref":
+			r.Ref = cmd.ref
+		case "module":
+			r.ModulePath = cmd.module
+		case "toolchain":
+			r.ToolchainRef = cmd.toolchain
+		default:
+			return
+		}
+		updated = true
+	})
+
+	if r.ModulePath == "" {
+		return cmd.UsageError("module path required")
+	}
+
+	if r.ToolchainRef != "" {
+		ok, err := toolchainExists(ctx, d, r.ToolchainRef)
+		if err != nil {
+			return cmd.Error(err)
+		}
+		if !ok {
+			return cmd.UsageError("toolchain must be a go repository commit sha or ref")
+		}
+	}
+
+	if updated {
+		if err := d.StoreRepository(ctx, r); err != nil {
+			return cmd.Error(err)
+		}
+		cmd.Log.Info("repository updated")
+	}
+
+	// Report.
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "uuid\t%s\n", r.UUID())
+	fmt.Fprintf(w, "url\t%s\n", r.URL)
+	fmt.Fprintf(w, "ref\t%s\n", r.Ref)
+	fmt.Fprintf(w, "module\t%s\n", r.ModulePath)
+	fmt.Fprintf(w, "toolchain\t%s\n", r.ToolchainRef)
+	return cmd.Status(w.Flush())
+}
+
+// findRepository looks up the registered repository with the given URL.
+func findRepository(ctx context.Context, d *db.DB, url string) (*entity.Repository, error) {
+	r := &entity.Repository{URL: url}
+	existing, err := d.FindRepositoryByUUID(ctx, r.UUID())
+	if err != nil {
+		return nil, fmt.Errorf("find repository %s: %w", url, err)
+	}
+	return existing, nil
+}
+
+// toolchainExists reports whether ref is a known commit sha or ref in the Go
+// repository.
+func toolchainExists(ctx context.Context, d *db.DB, ref string) (bool, error) {
+	_, err := d.FindCommitBySHA(ctx, ref)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	_, err = d.MostRecentCommitWithRef(ctx, entity.GoRepository.UUID(), ref)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
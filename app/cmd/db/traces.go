@@ -55,7 +55,8 @@ func (cmd *Traces) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{
 	defer cmd.CheckClose(&status, d)
 
 	// Determine commit index.
-	idx, err := d.MostRecentCommitIndex(ctx)
+	repo := entity.GoRepository.UUID()
+	idx, err := d.MostRecentCommitIndex(ctx, repo)
 	if err != nil {
 		return cmd.Error(err)
 	}
@@ -72,7 +73,7 @@ func (cmd *Traces) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{
 		zap.Int("max_commit_index", r.Max),
 	)
 
-	ps, err := d.ListTracePoints(ctx, r)
+	ps, err := d.ListTracePoints(ctx, repo, r)
 	if err != nil {
 		return cmd.Error(err)
 	}
@@ -28,6 +28,7 @@ var (
 	conn    = flag.String("conn", "", "database connection string")
 	bucket  = flag.String("bucket", "", "data files bucket")
 	nocache = flag.Bool("nocache", false, "disable asset caches")
+	admin   = flag.String("adminpassword", "", "password for editing change triage (read-only if empty)")
 )
 
 func run(ctx context.Context, l *zap.Logger) (err error) {
@@ -61,6 +62,10 @@ func run(ctx context.Context, l *zap.Logger) (err error) {
 		opts = append(opts, dashboard.WithStaticFileSystem(fs.NewLocal(*static)))
 	}
 
+	if *admin != "" {
+		opts = append(opts, dashboard.WithAdminPassword(*admin))
+	}
+
 	h := dashboard.NewHandlers(d, opts...)
 
 	if err := h.Init(ctx); err != nil {
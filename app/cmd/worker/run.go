@@ -27,6 +27,11 @@ type Run struct {
 	coordinatorURL string
 	artifacts      string
 	goproxy        string
+	modproxy       string
+	slots          int
+
+	toolchaincache     string
+	toolchaincachesize int64
 }
 
 func NewRun(b command.Base, p *platform.Platform) *Run {
@@ -58,9 +63,17 @@ func (cmd *Run) SetFlags(f *flag.FlagSet) {
 	f.StringVar(&cmd.coordinatorURL, "coordinator", "", "coordinator address")
 	f.StringVar(&cmd.artifacts, "artifacts", "", "artifacts storage directory")
 	f.StringVar(&cmd.goproxy, "goproxy", "proxy.golang.org", "GOPROXY environment variable")
+	f.StringVar(&cmd.modproxy, "modproxy", "", "file-based module proxy directory for offline runs (overrides -goproxy)")
+	f.IntVar(&cmd.slots, "slots", 1, "number of jobs to run concurrently, each in an isolated slot (incompatible with quietness gates)")
+	f.StringVar(&cmd.toolchaincache, "toolchaincache", "", "toolchain cache directory (disabled if empty)")
+	f.Int64Var(&cmd.toolchaincachesize, "toolchaincachesize", 8<<10, "toolchain cache size limit in megabytes")
 }
 
-func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
+	if cmd.slots < 1 {
+		return cmd.UsageError("number of slots must be positive")
+	}
+
 	c := coordinator.NewClient(http.DefaultClient, cmd.coordinatorURL, cmd.name)
 
 	artifacts := fs.NewLocal(cmd.artifacts)
@@ -69,25 +82,51 @@ func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 		platform:  cmd.Platform,
 		artifacts: artifacts,
 		goproxy:   cmd.goproxy,
+		modproxy:  cmd.modproxy,
 		log:       cmd.Log,
 	}
 
-	w := worker.New(c, p, worker.WithLogger(cmd.Log))
+	if cmd.toolchaincache != "" {
+		p.tccache = runner.NewToolchainCache(cmd.toolchaincache, cmd.toolchaincachesize<<20)
+	}
+
+	// Partition the machine for concurrent jobs.
+	if cmd.slots > 1 {
+		slots, err := cmd.Platform.Slots(cmd.slots)
+		if err != nil {
+			return cmd.Error(err)
+		}
+		defer func() {
+			if err := slots.Reset(); err != nil {
+				status = cmd.Error(err)
+			}
+		}()
+		p.slots = slots
+	}
+
+	w := worker.New(c, p,
+		worker.WithSlots(cmd.slots),
+		worker.WithLogger(cmd.Log),
+	)
 
 	return cmd.Status(w.Run(ctx))
 }
 
 type Processor struct {
 	platform  *platform.Platform
+	slots     *platform.Slots // nil if running a single slot
 	artifacts fs.Interface
 	goproxy   string
+	modproxy  string
+	tccache   *runner.ToolchainCache
 	log       *zap.Logger
 }
 
-func (p *Processor) Process(ctx context.Context, j *coordinator.Job) (io.ReadCloser, error) {
-	// TODO(mbm): make runner context aware
+func (p *Processor) Process(ctx context.Context, slot int, j *coordinator.Job) (_ io.ReadCloser, err error) {
 	// TODO(mbm): reduce duplication with cmd/benchrun
 
+	log := p.log.With(zap.Int("slot", slot))
+
 	// Build toolchain.
 	builderType, ok := runner.HostSnapshotBuilderType()
 	if !ok {
@@ -95,11 +134,11 @@ func (p *Processor) Process(ctx context.Context, j *coordinator.Job) (io.ReadClo
 	}
 	tc := runner.NewSnapshot(builderType, j.CommitSHA)
 
-	p.log.Info("constructed toolchain", zap.Stringer("toolchain", tc))
+	log.Info("constructed toolchain", zap.Stringer("toolchain", tc))
 
 	// Construct workspace.
 	w, err := runner.NewWorkspace(
-		runner.WithLogger(p.log),
+		runner.WithLogger(log),
 		runner.WithArtifactStore(p.artifacts),
 	)
 	if err != nil {
@@ -109,24 +148,54 @@ func (p *Processor) Process(ctx context.Context, j *coordinator.Job) (io.ReadClo
 	// Initialize runner.
 	r := runner.NewRunner(w, tc)
 	r.SetGoProxy(p.goproxy)
-	if err := p.platform.ConfigureRunner(r); err != nil {
+	if p.modproxy != "" {
+		if err := r.SetModuleProxy(p.modproxy); err != nil {
+			return nil, err
+		}
+	}
+	if p.tccache != nil {
+		r.SetToolchainCache(p.tccache)
+	}
+	if err := p.configure(r, slot); err != nil {
 		return nil, err
 	}
 
-	r.Init(ctx)
+	// Cleanup. Performed even if the job was cancelled.
+	defer func() {
+		if cerr := r.Clean(context.Background()); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if err := r.Init(ctx); err != nil {
+		return nil, err
+	}
 
 	// Run benchmark.
 	output := j.UUID.String()
-	r.Benchmark(ctx, j.Suite, output)
-
-	// Cleanup.
-	r.Clean(ctx)
-
-	// Bail if there was some error.
-	if err := w.Error(); err != nil {
+	if err := r.Benchmark(ctx, j.Suite, output); err != nil {
 		return nil, err
 	}
 
-	// Otherwise return a handle to the output.
+	// Return a handle to the output.
 	return p.artifacts.Open(ctx, output)
 }
+
+// Telemetry opens the telemetry sidecar recorded by the runner for the job, if
+// there is one.
+func (p *Processor) Telemetry(ctx context.Context, j *coordinator.Job) (io.ReadCloser, error) {
+	name := runner.TelemetryArtifactName(j.UUID.String())
+	r, err := p.artifacts.Open(ctx, name)
+	if os.IsNotExist(err) || errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return r, err
+}
+
+// configure platform-specific runner options for a job in the given slot.
+func (p *Processor) configure(r *runner.Runner, slot int) error {
+	if p.slots == nil {
+		return p.platform.ConfigureRunner(r)
+	}
+	return p.slots.ConfigureRunner(r, slot)
+}
@@ -46,6 +46,30 @@ func (r *StatusChangeRequest) Validate() error {
 	return validateWorker(r.Worker)
 }
 
+type JobStatusRequest struct {
+	Worker string
+	UUID   uuid.UUID
+}
+
+func (r *JobStatusRequest) Validate() error {
+	return validateWorker(r.Worker)
+}
+
+type JobStatusResponse struct {
+	Status string `json:"status"`
+}
+
+type HeartbeatRequest struct {
+	Worker   string      `json:"-"`
+	Platform string      `json:"platform"`
+	Version  string      `json:"version"`
+	Jobs     []uuid.UUID `json:"jobs,omitempty"` // in-progress jobs
+}
+
+func (r *HeartbeatRequest) Validate() error {
+	return validateWorker(r.Worker)
+}
+
 type ResultRequest struct {
 	io.Reader // data file
 
@@ -57,6 +81,17 @@ func (r *ResultRequest) Validate() error {
 	return validateWorker(r.Worker)
 }
 
+type TelemetryRequest struct {
+	io.Reader // telemetry sidecar file
+
+	Worker string
+	UUID   uuid.UUID
+}
+
+func (r *TelemetryRequest) Validate() error {
+	return validateWorker(r.Worker)
+}
+
 var workerRegexp = regexp.MustCompile(`^[a-z][a-z0-9\-]*$`)
 
 func validateWorker(worker string) error {
@@ -1,12 +1,15 @@
 package coordinator
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 
+	"github.com/mmcloughlin/goperf/app/entity"
 	"github.com/mmcloughlin/goperf/app/httputil"
 	"github.com/mmcloughlin/goperf/internal/errutil"
 )
@@ -38,6 +41,39 @@ func (c *Client) Jobs(ctx context.Context) (*JobsResponse, error) {
 	return payload, nil
 }
 
+// Heartbeat reports that the worker is alive, running the given platform and
+// software version, with the given jobs in progress.
+func (c *Client) Heartbeat(ctx context.Context, platform, version string, jobs []uuid.UUID) error {
+	body, err := json.Marshal(&HeartbeatRequest{
+		Platform: platform,
+		Version:  version,
+		Jobs:     jobs,
+	})
+	if err != nil {
+		return err
+	}
+	return c.request(ctx, params{
+		Method:         http.MethodPut,
+		Path:           "/workers/" + c.worker + "/heartbeat",
+		Body:           bytes.NewReader(body),
+		AcceptStatuses: []int{http.StatusNoContent},
+	})
+}
+
+// Status fetches the current status of the given job.
+func (c *Client) Status(ctx context.Context, id uuid.UUID) (entity.TaskStatus, error) {
+	payload := &JobStatusResponse{}
+	if err := c.request(ctx, params{
+		Method:         http.MethodGet,
+		Path:           "/workers/" + c.worker + "/jobs/" + id.String(),
+		AcceptStatuses: []int{http.StatusOK},
+		Payload:        payload,
+	}); err != nil {
+		return 0, err
+	}
+	return entity.TaskStatusString(payload.Status)
+}
+
 func (c *Client) Start(ctx context.Context, id uuid.UUID) error {
 	return c.request(ctx, params{
 		Method:         http.MethodPut,
@@ -57,6 +93,18 @@ func (c *Client) UploadResult(ctx context.Context, id uuid.UUID, r io.Reader) er
 	})
 }
 
+// UploadTelemetry uploads the system telemetry recorded while running the given
+// job. Must be called before the result is uploaded. Note the reader will be
+// closed if it is an io.ReadCloser.
+func (c *Client) UploadTelemetry(ctx context.Context, id uuid.UUID, r io.Reader) error {
+	return c.request(ctx, params{
+		Method:         http.MethodPut,
+		Path:           "/workers/" + c.worker + "/jobs/" + id.String() + "/telemetry",
+		Body:           r,
+		AcceptStatuses: []int{http.StatusNoContent},
+	})
+}
+
 func (c *Client) Fail(ctx context.Context, id uuid.UUID) error {
 	return c.request(ctx, params{
 		Method:         http.MethodPut,
@@ -73,6 +121,16 @@ func (c *Client) Halt(ctx context.Context, id uuid.UUID) error {
 	})
 }
 
+// Noisy reports that the job could not be run because the worker system never
+// became quiet enough to benchmark.
+func (c *Client) Noisy(ctx context.Context, id uuid.UUID) error {
+	return c.request(ctx, params{
+		Method:         http.MethodPut,
+		Path:           "/workers/" + c.worker + "/jobs/" + id.String() + "/noisy",
+		AcceptStatuses: []int{http.StatusNoContent},
+	})
+}
+
 type params struct {
 	Method         string
 	Path           string
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,6 +24,7 @@ import (
 	"github.com/mmcloughlin/goperf/pkg/cfg"
 	"github.com/mmcloughlin/goperf/pkg/fs"
 	"github.com/mmcloughlin/goperf/pkg/job"
+	"github.com/mmcloughlin/goperf/pkg/mod"
 )
 
 type Coordinator struct {
@@ -114,6 +117,12 @@ func (c *Coordinator) job(ctx context.Context, s entity.TaskSpec) (*Job, error)
 	switch s.Type {
 	case entity.TaskTypeModule:
 		return c.modulejob(ctx, s)
+	case entity.TaskTypePackage:
+		return c.packagejob(ctx, s)
+	case entity.TaskTypeBenchmark:
+		return c.benchmarkjob(ctx, s)
+	case entity.TaskTypeRepository:
+		return c.repositoryjob(ctx, s)
 	default:
 		return nil, errutil.UnhandledCase(s.Type)
 	}
@@ -127,21 +136,166 @@ func (c *Coordinator) modulejob(ctx context.Context, s entity.TaskSpec) (*Job, e
 		return nil, fmt.Errorf("find module: %w", err)
 	}
 
-	// TODO(mbm): configurable job defaults
+	suite, err := c.suite(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		CommitSHA: s.CommitSHA,
+		Suite:     suite,
+	}, nil
+}
+
+// packagejob maps a TaskTypePackage task to a job definition.
+func (c *Coordinator) packagejob(ctx context.Context, s entity.TaskSpec) (*Job, error) {
+	// Lookup the package.
+	p, err := c.db.FindPackageByUUID(ctx, s.TargetUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find package: %w", err)
+	}
+
+	suite, err := c.suite(ctx, p.Module)
+	if err != nil {
+		return nil, err
+	}
+	suite.Package = p.RelativePath
+
 	return &Job{
 		CommitSHA: s.CommitSHA,
-		Suite: job.Suite{
-			Module: job.Module{
-				Path:    m.Path,
-				Version: m.Version,
-			},
-			Short:     true,
-			BenchTime: 100 * time.Millisecond,
-			Timeout:   2 * time.Hour,
+		Suite:     suite,
+	}, nil
+}
+
+// benchmarkjob maps a TaskTypeBenchmark task to a job definition.
+func (c *Coordinator) benchmarkjob(ctx context.Context, s entity.TaskSpec) (*Job, error) {
+	// Lookup the benchmark.
+	b, err := c.db.FindBenchmarkByUUID(ctx, s.TargetUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find benchmark: %w", err)
+	}
+
+	suite, err := c.suite(ctx, b.Package.Module)
+	if err != nil {
+		return nil, err
+	}
+	suite.Package = b.Package.RelativePath
+	suite.Benchmarks = benchmarkregex(b.FullName)
+	suite.Skip = ""
+
+	return &Job{
+		CommitSHA: s.CommitSHA,
+		Suite:     suite,
+	}, nil
+}
+
+// repositoryjob maps a TaskTypeRepository task to a job definition. The task
+// commit is the version of the repository module under test, and the toolchain
+// is pinned to the Go commit the repository's toolchain ref resolves to.
+func (c *Coordinator) repositoryjob(ctx context.Context, s entity.TaskSpec) (*Job, error) {
+	// Lookup the repository.
+	r, err := c.db.FindRepositoryByUUID(ctx, s.TargetUUID)
+	if err != nil {
+		return nil, fmt.Errorf("find repository: %w", err)
+	}
+
+	if r.IsToolchain() {
+		return nil, fmt.Errorf("repository %s has no pinned toolchain", r)
+	}
+
+	suite, err := c.suite(ctx, r.Module())
+	if err != nil {
+		return nil, err
+	}
+	suite.Module.Version = s.CommitSHA
+
+	toolchain, err := c.toolchain(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		CommitSHA: toolchain,
+		Suite:     suite,
+	}, nil
+}
+
+// shaRegexp matches a full git commit sha.
+var shaRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// toolchain resolves the toolchain ref of repository r to a Go repository
+// commit sha. Refs other than a full sha resolve to the most recent commit
+// with that ref.
+func (c *Coordinator) toolchain(ctx context.Context, r *entity.Repository) (string, error) {
+	if shaRegexp.MatchString(r.ToolchainRef) {
+		return r.ToolchainRef, nil
+	}
+
+	commit, err := c.db.MostRecentCommitWithRef(ctx, entity.GoRepository.UUID(), r.ToolchainRef)
+	if err != nil {
+		return "", fmt.Errorf("resolve toolchain ref %q: %w", r.ToolchainRef, err)
+	}
+
+	return commit.SHA, nil
+}
+
+// suite builds a benchmark suite for module m, according to the job settings
+// configured for the module.
+func (c *Coordinator) suite(ctx context.Context, m *entity.Module) (job.Suite, error) {
+	settings, err := c.db.FindModuleJobSettings(ctx, m.UUID())
+	if err != nil {
+		return job.Suite{}, fmt.Errorf("find module job settings: %w", err)
+	}
+
+	return job.Suite{
+		Module: job.Module{
+			Path:    m.Path,
+			Version: m.Version,
 		},
+		Tests:      settings.Tests,
+		Short:      settings.Short,
+		Benchmarks: settings.Benchmarks,
+		Skip:       settings.BenchmarksSkip,
+		BenchTime:  settings.BenchTime,
+		Count:      settings.Count,
+		Interleave: settings.Interleave,
+		Timeout:    settings.Timeout,
+		Requires:   requires(settings.Requires),
 	}, nil
 }
 
+// requires converts "path@version" strings to pinned modules.
+func requires(vs []string) []job.Module {
+	var ms []job.Module
+	for _, v := range vs {
+		m := mod.Parse(v)
+		ms = append(ms, job.Module{
+			Path:    m.Path,
+			Version: m.Version,
+		})
+	}
+	return ms
+}
+
+// procssuffix matches the GOMAXPROCS suffix of a benchmark name.
+var procssuffix = regexp.MustCompile(`-\d+$`)
+
+// benchmarkregex returns a benchmark regular expression that matches exactly
+// the benchmark with the given full name. The go test -bench flag splits the
+// expression on slashes and matches each part against the corresponding level
+// of sub-benchmark name, so each part is anchored separately. Recorded names
+// carry the "-N" GOMAXPROCS suffix added by the testing package, which is not
+// part of the name matched by -bench, so it is removed.
+func benchmarkregex(fullname string) string {
+	parts := strings.Split(fullname, "/")
+	last := len(parts) - 1
+	parts[last] = procssuffix.ReplaceAllString(parts[last], "")
+	for i := range parts {
+		parts[i] = "^" + regexp.QuoteMeta(parts[i]) + "$"
+	}
+	return strings.Join(parts, "/")
+}
+
 // tasksContainSpec reports whether any of the tasks have the given spec.
 func tasksContainSpec(tasks []*entity.Task, s entity.TaskSpec) bool {
 	for _, task := range tasks {
@@ -152,6 +306,39 @@ func tasksContainSpec(tasks []*entity.Task, s entity.TaskSpec) bool {
 	return false
 }
 
+// Heartbeat records that a worker is alive, and that the jobs it reports are
+// still in progress.
+func (c *Coordinator) Heartbeat(ctx context.Context, req *HeartbeatRequest) error {
+	c.log.Debug("heartbeat", zap.String("worker", req.Worker))
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	return c.db.RecordWorkerHeartbeat(ctx, &entity.Worker{
+		Name:          req.Worker,
+		Platform:      req.Platform,
+		Version:       req.Version,
+		LastHeartbeat: time.Now(),
+	}, req.Jobs)
+}
+
+// JobStatus reports the current status of a job.
+func (c *Coordinator) JobStatus(ctx context.Context, req *JobStatusRequest) (*JobStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	task, err := c.findWorkerTask(ctx, req.Worker, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobStatusResponse{
+		Status: task.Status.String(),
+	}, nil
+}
+
 // StatusChange records a job status change.
 func (c *Coordinator) StatusChange(ctx context.Context, req *StatusChangeRequest) error {
 	log := c.log.With(
@@ -224,10 +411,49 @@ func (c *Coordinator) Result(ctx context.Context, req *ResultRequest) error {
 	return nil
 }
 
+// Telemetry processes an upload of the system telemetry recorded while a job
+// ran. Telemetry must be uploaded before the result, so that the data file can
+// reference it.
+func (c *Coordinator) Telemetry(ctx context.Context, req *TelemetryRequest) (err error) {
+	log := c.log.With(
+		zap.String("worker", req.Worker),
+		zap.Stringer("job_uuid", req.UUID),
+	)
+	log.Debug("telemetry upload")
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	// Find the task.
+	task, err := c.findWorkerTask(ctx, req.Worker, req.UUID)
+	if err != nil {
+		return fmt.Errorf("find task: %w", err)
+	}
+
+	if task.Status != entity.TaskStatusInProgress {
+		return fmt.Errorf("task has status %s", task.Status)
+	}
+
+	// Write the file.
+	w, err := c.datafs.Create(ctx, TelemetryFileName(dataFileName(task)))
+	if err != nil {
+		return fmt.Errorf("telemetry upload: %w", err)
+	}
+	defer errutil.CheckClose(&err, w)
+
+	if _, err := io.Copy(w, req); err != nil {
+		return fmt.Errorf("telemetry upload: %w", err)
+	}
+
+	return nil
+}
+
 // write results file to filesystem.
 func (c *Coordinator) write(ctx context.Context, r io.Reader, task *entity.Task) (_ *entity.DataFile, err error) {
 	// Create config header.
-	config := taskConfig(task)
+	telemetry, _ := c.telemetry(ctx, task)
+	config := taskConfig(task, telemetry)
 	hdr := bytes.NewBuffer(nil)
 	if err := cfg.Write(hdr, config); err != nil {
 		return nil, err
@@ -269,6 +495,35 @@ func dataFileName(task *entity.Task) string {
 	)
 }
 
+// TelemetryFileExt is the extension of telemetry sidecar files, which are
+// stored alongside the data file for the same task.
+const TelemetryFileExt = ".telemetry.jsonl"
+
+// TelemetryFileName returns the name of the telemetry sidecar file for the
+// given data file.
+func TelemetryFileName(datafile string) string {
+	return datafile + TelemetryFileExt
+}
+
+// IsTelemetryFile reports whether name is a telemetry sidecar file.
+func IsTelemetryFile(name string) bool {
+	return strings.HasSuffix(name, TelemetryFileExt)
+}
+
+// telemetry returns the name of the telemetry file uploaded for task, if any.
+// Requires a readable filesystem.
+func (c *Coordinator) telemetry(ctx context.Context, task *entity.Task) (string, bool) {
+	r, ok := c.datafs.(fs.Readable)
+	if !ok {
+		return "", false
+	}
+	name := TelemetryFileName(dataFileName(task))
+	if _, err := r.Stat(ctx, name); err != nil {
+		return "", false
+	}
+	return name, true
+}
+
 // findWorkerTask looks up a task by ID, verifying that it belongs to worker.
 func (c *Coordinator) findWorkerTask(ctx context.Context, worker string, id uuid.UUID) (*entity.Task, error) {
 	task, err := c.db.FindTaskByUUID(ctx, id)
@@ -284,16 +539,27 @@ func (c *Coordinator) findWorkerTask(ctx context.Context, worker string, id uuid
 }
 
 // taskConfig generates configuration lines with metadata about the task.
-func taskConfig(t *entity.Task) cfg.Configuration {
+func taskConfig(t *entity.Task, telemetry string) cfg.Configuration {
+	props := []cfg.Entry{
+		cfg.Property("uuid", "task unique identifier", t.UUID),
+		cfg.Property("worker", "name of worker that executed the task", cfg.StringValue(t.Worker)),
+		cfg.Property("type", "task type", t.Spec.Type),
+		cfg.Property("target", "unique identifier of target under test", t.Spec.TargetUUID),
+		cfg.Property("commitsha", "commit sha the task was for", cfg.StringValue(t.Spec.CommitSHA)),
+	}
+
+	// Repository tasks pin the toolchain, so results should be attributed to
+	// the repository commit instead.
+	if t.Spec.Type == entity.TaskTypeRepository {
+		props = append(props, cfg.Property("commitref", "repository commit under test", cfg.StringValue(t.Spec.CommitSHA)))
+	}
+
+	// Reference the telemetry sidecar, if one was uploaded.
+	if telemetry != "" {
+		props = append(props, cfg.Property("telemetry", "system telemetry file recorded during the task", cfg.StringValue(telemetry)))
+	}
+
 	return cfg.Configuration{
-		cfg.Section(
-			"task",
-			"task properties",
-			cfg.Property("uuid", "task unique identifier", t.UUID),
-			cfg.Property("worker", "name of worker that executed the task", cfg.StringValue(t.Worker)),
-			cfg.Property("type", "task type", t.Spec.Type),
-			cfg.Property("target", "unique identifier of target under test", t.Spec.TargetUUID),
-			cfg.Property("commitsha", "commit sha the task was for", cfg.StringValue(t.Spec.CommitSHA)),
-		),
+		cfg.Section("task", "task properties", props...),
 	}
 }
@@ -0,0 +1,91 @@
+package coordinator
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/pkg/job"
+)
+
+func TestBenchmarkRegex(t *testing.T) {
+	fullname := "BenchmarkCompress1X/reuse=none/corpus=pngdata.001"
+	expr := benchmarkregex(fullname)
+
+	expect := `^BenchmarkCompress1X$/^reuse=none$/^corpus=pngdata\.001$`
+	if expr != expect {
+		t.Fatalf("benchmarkregex(%q) = %q; expect %q", fullname, expr, expect)
+	}
+
+	// Confirm each level matches exactly the corresponding name part.
+	parts := strings.Split(fullname, "/")
+	for i, e := range strings.Split(expr, "/") {
+		re := regexp.MustCompile(e)
+		if !re.MatchString(parts[i]) {
+			t.Errorf("level %d expression %q does not match %q", i, e, parts[i])
+		}
+		if re.MatchString(parts[i] + "x") {
+			t.Errorf("level %d expression %q is not anchored", i, e)
+		}
+	}
+}
+
+func TestBenchmarkRegexProcsSuffix(t *testing.T) {
+	cases := []struct {
+		FullName string
+		Expect   string
+		Name     string
+	}{
+		{
+			FullName: "BenchmarkY-4",
+			Expect:   `^BenchmarkY$`,
+			Name:     "BenchmarkY",
+		},
+		{
+			FullName: "BenchmarkX/k1=v1/size=1-8",
+			Expect:   `^BenchmarkX$/^k1=v1$/^size=1$`,
+			Name:     "BenchmarkX/k1=v1/size=1",
+		},
+		{
+			FullName: "BenchmarkZ/n-10-16",
+			Expect:   `^BenchmarkZ$/^n-10$`,
+			Name:     "BenchmarkZ/n-10",
+		},
+	}
+	for _, c := range cases {
+		expr := benchmarkregex(c.FullName)
+		if expr != c.Expect {
+			t.Errorf("benchmarkregex(%q) = %q; expect %q", c.FullName, expr, c.Expect)
+			continue
+		}
+
+		// The go test -bench flag matches names without the suffix.
+		parts := strings.Split(c.Name, "/")
+		for i, e := range strings.Split(expr, "/") {
+			if !regexp.MustCompile(e).MatchString(parts[i]) {
+				t.Errorf("level %d expression %q does not match %q", i, e, parts[i])
+			}
+		}
+	}
+}
+
+func TestRequires(t *testing.T) {
+	got := requires([]string{
+		"golang.org/x/text@v0.3.2",
+		"github.com/pkg/errors@v0.9.1",
+	})
+	expect := []job.Module{
+		{Path: "golang.org/x/text", Version: "v0.3.2"},
+		{Path: "github.com/pkg/errors", Version: "v0.9.1"},
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("requires() = %v; expect %v", got, expect)
+	}
+}
+
+func TestRequiresEmpty(t *testing.T) {
+	if got := requires(nil); len(got) != 0 {
+		t.Fatalf("requires(nil) = %v; expect empty", got)
+	}
+}
@@ -16,6 +16,7 @@ type Handlers struct {
 	c *Coordinator
 
 	router  *httprouter.Router
+	jsondec *httputil.JSONDecoder
 	jsonenc *httputil.JSONEncoder
 	log     *zap.Logger
 }
@@ -24,6 +25,7 @@ func NewHandlers(c *Coordinator, l *zap.Logger) *Handlers {
 	// Configure.
 	h := &Handlers{
 		c:       c,
+		jsondec: &httputil.JSONDecoder{MaxRequestSize: 1 << 10},
 		jsonenc: &httputil.JSONEncoder{Debug: true},
 		router:  httprouter.New(),
 		log:     l,
@@ -35,6 +37,16 @@ func NewHandlers(c *Coordinator, l *zap.Logger) *Handlers {
 		Log:     h.log,
 	})
 
+	h.router.Handler(http.MethodPut, "/workers/:worker/heartbeat", httputil.ErrorHandler{
+		Handler: httputil.HandlerFunc(h.heartbeat),
+		Log:     h.log,
+	})
+
+	h.router.Handler(http.MethodGet, "/workers/:worker/jobs/:job", httputil.ErrorHandler{
+		Handler: httputil.HandlerFunc(h.jobStatus),
+		Log:     h.log,
+	})
+
 	h.router.Handler(http.MethodPut, "/workers/:worker/jobs/:job/start", httputil.ErrorHandler{
 		Handler: h.statusChange(
 			[]entity.TaskStatus{entity.TaskStatusCreated},
@@ -48,6 +60,11 @@ func NewHandlers(c *Coordinator, l *zap.Logger) *Handlers {
 		Log:     h.log,
 	})
 
+	h.router.Handler(http.MethodPut, "/workers/:worker/jobs/:job/telemetry", httputil.ErrorHandler{
+		Handler: httputil.HandlerFunc(h.telemetry),
+		Log:     h.log,
+	})
+
 	h.router.Handler(http.MethodPut, "/workers/:worker/jobs/:job/fail", httputil.ErrorHandler{
 		Handler: h.statusChange(
 			[]entity.TaskStatus{entity.TaskStatusInProgress},
@@ -64,6 +81,14 @@ func NewHandlers(c *Coordinator, l *zap.Logger) *Handlers {
 		Log: h.log,
 	})
 
+	h.router.Handler(http.MethodPut, "/workers/:worker/jobs/:job/noisy", httputil.ErrorHandler{
+		Handler: h.statusChange(
+			[]entity.TaskStatus{entity.TaskStatusInProgress},
+			entity.TaskStatusNoisy,
+		),
+		Log: h.log,
+	})
+
 	h.router.HandlerFunc(http.MethodGet, "/health", h.health)
 
 	return h
@@ -95,6 +120,51 @@ func (h *Handlers) requestJobs(w http.ResponseWriter, r *http.Request) error {
 	return h.jsonenc.EncodeResponse(w, res)
 }
 
+func (h *Handlers) heartbeat(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(r.Context())
+
+	// Build heartbeat request.
+	req := &HeartbeatRequest{}
+	if err := h.jsondec.DecodeRequest(w, r, req); err != nil {
+		return httputil.BadRequest(fmt.Errorf("decode heartbeat: %w", err))
+	}
+	req.Worker = params.ByName("worker")
+
+	// Delegate to Coordinator.
+	if err := h.c.Heartbeat(ctx, req); err != nil {
+		return err
+	}
+
+	// Return success with no body.
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handlers) jobStatus(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(r.Context())
+
+	// Build status request.
+	id, err := uuid.Parse(params.ByName("job"))
+	if err != nil {
+		return httputil.BadRequest(fmt.Errorf("bad job uuid: %w", err))
+	}
+
+	req := &JobStatusRequest{
+		Worker: params.ByName("worker"),
+		UUID:   id,
+	}
+
+	// Delegate to Coordinator.
+	res, err := h.c.JobStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
 func (h *Handlers) statusChange(from []entity.TaskStatus, to entity.TaskStatus) httputil.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := r.Context()
@@ -150,6 +220,32 @@ func (h *Handlers) result(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+func (h *Handlers) telemetry(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(r.Context())
+
+	// Build telemetry request.
+	id, err := uuid.Parse(params.ByName("job"))
+	if err != nil {
+		return httputil.BadRequest(fmt.Errorf("bad job uuid: %w", err))
+	}
+
+	req := &TelemetryRequest{
+		Reader: r.Body,
+		Worker: params.ByName("worker"),
+		UUID:   id,
+	}
+
+	// Delegate to Coordinator.
+	if err := h.c.Telemetry(ctx, req); err != nil {
+		return err
+	}
+
+	// Return success with no body.
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
 func (h *Handlers) health(w http.ResponseWriter, r *http.Request) {
 	h.log.Debug("respond to health request")
 	httputil.OK(w)
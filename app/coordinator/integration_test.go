@@ -203,10 +203,68 @@ func TestIntegrationJobHalt(t *testing.T) {
 			return err
 		}
 
+		// Confirm the worker sees the halted status.
+		status, err := client.Status(ctx, j.UUID)
+		if err != nil {
+			return err
+		}
+		if status != entity.TaskStatusHalted {
+			return fmt.Errorf("job status %s; expect %s", status, entity.TaskStatusHalted)
+		}
+
+		return nil
+	})
+}
+
+func TestIntegrationJobNoisy(t *testing.T) {
+	VerifyStatusChange(t, entity.TaskStatusNoisy, func(ctx context.Context, client *coordinator.Client, j *coordinator.Job) error {
+		// Start it.
+		if err := client.Start(ctx, j.UUID); err != nil {
+			return err
+		}
+
+		// Report the system was too noisy to run it.
+		if err := client.Noisy(ctx, j.UUID); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
 
+func TestIntegrationHeartbeat(t *testing.T) {
+	i := NewIntegration(t)
+	ctx := i.Context()
+	worker := "test-heartbeat"
+	client := i.NewClient(worker)
+
+	// Send heartbeat.
+	start := time.Now()
+	if err := client.Heartbeat(ctx, "linux/amd64", "v1.2.3", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Confirm it was recorded.
+	got, err := i.DB.FindWorkerByName(ctx, worker)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := &entity.Worker{
+		Name:          worker,
+		Platform:      "linux/amd64",
+		Version:       "v1.2.3",
+		LastHeartbeat: got.LastHeartbeat,
+	}
+	if diff := cmp.Diff(expect, got); diff != "" {
+		t.Fatalf("worker mismatch\n%s", diff)
+	}
+
+	if got.LastHeartbeat.Before(start.Add(-time.Minute)) {
+		t.Fatalf("last heartbeat %s too old", got.LastHeartbeat)
+	}
+}
+
 func TestIntegrationJobResultUpload(t *testing.T) {
 	i := NewIntegration(t)
 	ctx := i.Context()
@@ -278,3 +336,71 @@ func TestIntegrationJobResultUpload(t *testing.T) {
 		t.Fatal("sha256 mismatch")
 	}
 }
+
+func TestIntegrationJobTelemetryUpload(t *testing.T) {
+	i := NewIntegration(t)
+	ctx := i.Context()
+	worker := "test-telemetry-upload"
+	client := i.NewClient(worker)
+
+	// Request and start work.
+	res, err := client.Jobs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Jobs) != 1 {
+		t.Fatalf("expected 1 job; got %d", len(res.Jobs))
+	}
+	j := res.Jobs[0]
+
+	if err := client.Start(ctx, j.UUID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Upload telemetry, then result.
+	telemetry := []byte(`{"sampler":"throttle","readings":{"core":1}}` + "\n")
+	if err := client.UploadTelemetry(ctx, j.UUID, bytes.NewReader(telemetry)); err != nil {
+		t.Fatal(err)
+	}
+
+	expect, err := ioutil.ReadFile("testdata/result.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.UploadResult(ctx, j.UUID, bytes.NewReader(expect)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Confirm the data file references the telemetry file.
+	task, err := i.DB.FindTaskByUUID(ctx, j.UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := i.DB.FindDataFileByUUID(ctx, task.DatafileUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ioutil.ReadFile(filepath.Join(i.DataDir, f.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := coordinator.TelemetryFileName(f.Name)
+	if !bytes.Contains(got, []byte("task-telemetry: "+name+"\n")) {
+		t.Fatalf("data file does not reference telemetry file %q", name)
+	}
+
+	// Confirm the telemetry file was written.
+	gottelemetry, err := ioutil.ReadFile(filepath.Join(i.DataDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gottelemetry, telemetry) {
+		t.Fatal("telemetry upload mismatch")
+	}
+}
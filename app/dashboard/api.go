@@ -0,0 +1,589 @@
+package dashboard
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/app/httputil"
+)
+
+// APIPrefix is the path prefix for the JSON API.
+const APIPrefix = "/api/v1/"
+
+// registerAPI adds JSON API routes to the mux.
+func (h *Handlers) registerAPI() {
+	routes := map[string]httputil.HandlerFunc{
+		"mods/":    h.APIModules,
+		"mod/":     h.APIModule,
+		"pkg/":     h.APIPackage,
+		"bench/":   h.APIBenchmark,
+		"result/":  h.APIResult,
+		"commit/":  h.APICommit,
+		"chgs/":    h.APIChanges,
+		"env/":     h.APIEnvironment,
+		"compare/": h.APICompare,
+	}
+	for route, handler := range routes {
+		h.mux.Handle(APIPrefix+route, h.handlerFunc(apihandler(handler)))
+	}
+}
+
+// apihandler maps missing database records to not found errors.
+func apihandler(handler httputil.HandlerFunc) httputil.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		err := handler(w, r)
+		if errors.Is(err, sql.ErrNoRows) {
+			return httputil.NotFound()
+		}
+		return err
+	}
+}
+
+func (h *Handlers) APIModules(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	mods, err := h.db.ListModules(ctx)
+	if err != nil {
+		return err
+	}
+
+	res := &APIModulesResponse{Modules: []*APIModule{}}
+	for _, mod := range mods {
+		res.Modules = append(res.Modules, apimodule(mod))
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+func (h *Handlers) APIModule(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := parseuuid(r.URL.Path, APIPrefix+"mod/")
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	mod, err := h.db.FindModuleByUUID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	pkgs, err := h.db.ListModulePackages(ctx, mod)
+	if err != nil {
+		return err
+	}
+
+	res := &APIModuleResponse{
+		Module:   apimodule(mod),
+		Packages: []*APIPackage{},
+	}
+	for _, pkg := range pkgs {
+		res.Packages = append(res.Packages, apipackage(pkg))
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+func (h *Handlers) APIPackage(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := parseuuid(r.URL.Path, APIPrefix+"pkg/")
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	pkg, err := h.db.FindPackageByUUID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	benchs, err := h.db.ListPackageBenchmarks(ctx, pkg)
+	if err != nil {
+		return err
+	}
+
+	res := &APIPackageResponse{
+		Package:    apipackage(pkg),
+		Benchmarks: []*APIBenchmark{},
+	}
+	for _, bench := range benchs {
+		res.Benchmarks = append(res.Benchmarks, apibenchmark(bench))
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+func (h *Handlers) APIBenchmark(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := parseuuid(r.URL.Path, APIPrefix+"bench/")
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	cr, err := h.commitRange(r)
+	if err != nil {
+		return err
+	}
+
+	bench, err := h.db.FindBenchmarkByUUID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	points, err := h.db.ListBenchmarkPoints(ctx, bench, cr)
+	if err != nil {
+		return err
+	}
+
+	last, err := h.latest(r)
+	if err != nil {
+		return err
+	}
+
+	res := &APIBenchmarkResponse{
+		Benchmark: apibenchmark(bench),
+		Range:     apirange(r, cr, last),
+		Points:    []*APIPoint{},
+	}
+	for _, p := range points {
+		res.Points = append(res.Points, &APIPoint{
+			ResultUUID:      p.ResultUUID,
+			EnvironmentUUID: p.EnvironmentUUID,
+			CommitSHA:       p.CommitSHA,
+			CommitIndex:     p.CommitIndex,
+			Value:           p.Value,
+		})
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+func (h *Handlers) APIResult(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := parseuuid(r.URL.Path, APIPrefix+"result/")
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	result, err := h.db.FindResultByUUID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return h.jsonenc.EncodeResponse(w, &APIResult{
+		UUID:            result.UUID(),
+		Benchmark:       apibenchmark(result.Benchmark),
+		CommitSHA:       result.Commit.SHA,
+		EnvironmentUUID: result.Environment.UUID(),
+		Environment:     result.Environment,
+		Metadata:        result.Metadata,
+		FileUUID:        result.File.UUID(),
+		Line:            result.Line,
+		Iterations:      result.Iterations,
+		Value:           result.Value,
+	})
+}
+
+func (h *Handlers) APICommit(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	sha, err := stripprefix(r.URL.Path, APIPrefix+"commit/")
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	commit, err := h.db.FindCommitBySHA(ctx, sha)
+	if err != nil {
+		return err
+	}
+
+	res := &APICommitResponse{
+		Commit:  apicommit(commit),
+		Changes: []*APIChange{},
+	}
+
+	// Changes are only available for commits with a known index.
+	idx, err := h.db.FindCommitIndexBySHA(ctx, sha)
+	if err == nil {
+		res.Index = &idx
+
+		repo, err := h.db.FindRepositoryByCommitSHA(ctx, sha)
+		if err != nil {
+			return err
+		}
+
+		chgs, err := h.db.ListChangeSummariesForCommitIndex(ctx, idx, db.ChangeFilter{
+			RepositoryUUID: repo.UUID(),
+			MinEffectSize:  0, // everything
+			MaxPValue:      floatparam(r, "pmax", 0),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range chgs {
+			res.Changes = append(res.Changes, apichange(c))
+		}
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+func (h *Handlers) APIChanges(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	cr, err := h.commitRange(r)
+	if err != nil {
+		return err
+	}
+
+	filter := db.ChangeFilter{
+		MinEffectSize:             floatparam(r, "effect", 10),
+		MaxRankByEffectSize:       intparam(r, "rank", 5),
+		MaxRankByAbsPercentChange: intparam(r, "rankpct", 0),
+		MaxPValue:                 floatparam(r, "pmax", 0),
+	}
+	if err := changefilterparams(r, &filter); err != nil {
+		return err
+	}
+
+	chgs, err := h.db.ListChangeSummaries(ctx, cr, filter)
+	if err != nil {
+		return err
+	}
+
+	last, err := h.latest(r)
+	if err != nil {
+		return err
+	}
+
+	res := &APIChangesResponse{
+		Range:   apirange(r, cr, last),
+		Changes: []*APIChange{},
+	}
+	for _, c := range chgs {
+		res.Changes = append(res.Changes, apichange(c))
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+func (h *Handlers) APIEnvironment(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := parseuuid(r.URL.Path, APIPrefix+"env/")
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	e, err := h.env(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return h.jsonenc.EncodeResponse(w, &APIEnvironment{
+		UUID:       id,
+		Properties: e,
+	})
+}
+
+func (h *Handlers) APICompare(w http.ResponseWriter, r *http.Request) error {
+	c, err := h.comparison(r)
+	if err != nil {
+		return err
+	}
+
+	res := &APICompareResponse{
+		Base:        c.Base.SHA,
+		Head:        c.Head.SHA,
+		Alpha:       c.Alpha,
+		Comparisons: []*APIBenchmarkComparison{},
+	}
+	for _, b := range c.Benchmarks {
+		res.Comparisons = append(res.Comparisons, &APIBenchmarkComparison{
+			Benchmark:       apibenchmark(b.Benchmark),
+			EnvironmentUUID: b.EnvironmentUUID,
+			Environment:     b.Environment,
+			Base:            apistats(b.Base),
+			Head:            apistats(b.Head),
+			Percent:         httputil.Finite(b.Percent()),
+			PercentCILower:  httputil.Finite(b.PercentCI.Lower),
+			PercentCIUpper:  httputil.Finite(b.PercentCI.Upper),
+			PValue:          httputil.Finite(b.PValue),
+			Significant:     b.Significant,
+			Type:            b.Type().String(),
+		})
+	}
+
+	return h.jsonenc.EncodeResponse(w, res)
+}
+
+// API response types. Statistics that are not available, for example
+// confidence intervals for small samples, are represented as null.
+
+type APIModulesResponse struct {
+	Modules []*APIModule `json:"modules"`
+}
+
+type APIModuleResponse struct {
+	Module   *APIModule    `json:"module"`
+	Packages []*APIPackage `json:"packages"`
+}
+
+type APIPackageResponse struct {
+	Package    *APIPackage     `json:"package"`
+	Benchmarks []*APIBenchmark `json:"benchmarks"`
+}
+
+type APIBenchmarkResponse struct {
+	Benchmark *APIBenchmark `json:"benchmark"`
+	Range     APIRange      `json:"range"`
+	Points    []*APIPoint   `json:"points"`
+}
+
+type APICommitResponse struct {
+	Commit  *APICommit   `json:"commit"`
+	Index   *int         `json:"index"`
+	Changes []*APIChange `json:"changes"`
+}
+
+type APIChangesResponse struct {
+	Range   APIRange     `json:"range"`
+	Changes []*APIChange `json:"changes"`
+}
+
+type APICompareResponse struct {
+	Base        string                    `json:"base"`
+	Head        string                    `json:"head"`
+	Alpha       float64                   `json:"alpha"`
+	Comparisons []*APIBenchmarkComparison `json:"comparisons"`
+}
+
+type APIModule struct {
+	UUID    uuid.UUID `json:"uuid"`
+	Path    string    `json:"path"`
+	Version string    `json:"version"`
+}
+
+func apimodule(m *entity.Module) *APIModule {
+	return &APIModule{
+		UUID:    m.UUID(),
+		Path:    m.Path,
+		Version: m.Version,
+	}
+}
+
+type APIPackage struct {
+	UUID         uuid.UUID `json:"uuid"`
+	ModuleUUID   uuid.UUID `json:"module_uuid"`
+	RelativePath string    `json:"relative_path"`
+	ImportPath   string    `json:"import_path"`
+}
+
+func apipackage(p *entity.Package) *APIPackage {
+	return &APIPackage{
+		UUID:         p.UUID(),
+		ModuleUUID:   p.Module.UUID(),
+		RelativePath: p.RelativePath,
+		ImportPath:   p.ImportPath(),
+	}
+}
+
+type APIBenchmark struct {
+	UUID        uuid.UUID         `json:"uuid"`
+	PackageUUID uuid.UUID         `json:"package_uuid"`
+	ImportPath  string            `json:"import_path"`
+	FullName    string            `json:"full_name"`
+	Name        string            `json:"name"`
+	Parameters  map[string]string `json:"parameters"`
+	Unit        string            `json:"unit"`
+}
+
+func apibenchmark(b *entity.Benchmark) *APIBenchmark {
+	return &APIBenchmark{
+		UUID:        b.UUID(),
+		PackageUUID: b.Package.UUID(),
+		ImportPath:  b.Package.ImportPath(),
+		FullName:    b.FullName,
+		Name:        b.Name,
+		Parameters:  b.Parameters,
+		Unit:        b.Unit,
+	}
+}
+
+type APIPoint struct {
+	ResultUUID      uuid.UUID `json:"result_uuid"`
+	EnvironmentUUID uuid.UUID `json:"environment_uuid"`
+	CommitSHA       string    `json:"commit_sha"`
+	CommitIndex     int       `json:"commit_index"`
+	Value           float64   `json:"value"`
+}
+
+type APIResult struct {
+	UUID            uuid.UUID         `json:"uuid"`
+	Benchmark       *APIBenchmark     `json:"benchmark"`
+	CommitSHA       string            `json:"commit_sha"`
+	EnvironmentUUID uuid.UUID         `json:"environment_uuid"`
+	Environment     entity.Properties `json:"environment"`
+	Metadata        entity.Properties `json:"metadata"`
+	FileUUID        uuid.UUID         `json:"file_uuid"`
+	Line            int               `json:"line"`
+	Iterations      uint64            `json:"iterations"`
+	Value           float64           `json:"value"`
+}
+
+type APIEnvironment struct {
+	UUID       uuid.UUID         `json:"uuid"`
+	Properties entity.Properties `json:"properties"`
+}
+
+type APIPerson struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type APICommit struct {
+	SHA        string    `json:"sha"`
+	Tree       string    `json:"tree"`
+	Parents    []string  `json:"parents"`
+	Author     APIPerson `json:"author"`
+	AuthorTime time.Time `json:"author_time"`
+	Committer  APIPerson `json:"committer"`
+	CommitTime time.Time `json:"commit_time"`
+	Message    string    `json:"message"`
+}
+
+func apicommit(c *entity.Commit) *APICommit {
+	return &APICommit{
+		SHA:        c.SHA,
+		Tree:       c.Tree,
+		Parents:    c.Parents,
+		Author:     APIPerson(c.Author),
+		AuthorTime: c.AuthorTime,
+		Committer:  APIPerson(c.Committer),
+		CommitTime: c.CommitTime,
+		Message:    c.Message,
+	}
+}
+
+type APIChange struct {
+	Benchmark       *APIBenchmark `json:"benchmark"`
+	EnvironmentUUID uuid.UUID     `json:"environment_uuid"`
+	CommitIndex     int           `json:"commit_index"`
+	CommitSHA       string        `json:"commit_sha"`
+	CommitSubject   string        `json:"commit_subject"`
+	EffectSize      float64       `json:"effect_size"`
+	Pre             APIStats      `json:"pre"`
+	Post            APIStats      `json:"post"`
+	Percent         *float64      `json:"percent"`
+	PercentCILower  *float64      `json:"percent_ci_lower"`
+	PercentCIUpper  *float64      `json:"percent_ci_upper"`
+	PValue          *float64      `json:"p_value"`
+	Confirmation    string        `json:"confirmation"`
+	Type            string        `json:"type"`
+	Triage          string        `json:"triage"`
+	IssueURL        string        `json:"issue_url,omitempty"`
+	Note            string        `json:"note,omitempty"`
+}
+
+func apichange(c *entity.ChangeSummary) *APIChange {
+	return &APIChange{
+		Benchmark:       apibenchmark(c.Benchmark),
+		EnvironmentUUID: c.EnvironmentUUID,
+		CommitIndex:     c.CommitIndex,
+		CommitSHA:       c.CommitSHA,
+		CommitSubject:   c.CommitSubject,
+		EffectSize:      c.EffectSize,
+		Pre:             apistats(c.Pre),
+		Post:            apistats(c.Post),
+		Percent:         httputil.Finite(c.Percent()),
+		PercentCILower:  httputil.Finite(c.PercentCI.Lower),
+		PercentCIUpper:  httputil.Finite(c.PercentCI.Upper),
+		PValue:          httputil.Finite(c.PValue),
+		Confirmation:    c.Confirmation.String(),
+		Type:            c.Type().String(),
+		Triage:          c.Triage.State.String(),
+		IssueURL:        c.Triage.IssueURL,
+		Note:            c.Triage.Note,
+	}
+}
+
+type APIBenchmarkComparison struct {
+	Benchmark       *APIBenchmark `json:"benchmark"`
+	EnvironmentUUID uuid.UUID     `json:"environment_uuid"`
+	Environment     string        `json:"environment"`
+	Base            APIStats      `json:"base"`
+	Head            APIStats      `json:"head"`
+	Percent         *float64      `json:"percent"`
+	PercentCILower  *float64      `json:"percent_ci_lower"`
+	PercentCIUpper  *float64      `json:"percent_ci_upper"`
+	PValue          *float64      `json:"p_value"`
+	Significant     bool          `json:"significant"`
+	Type            string        `json:"type"`
+}
+
+type APIStats struct {
+	N      int      `json:"n"`
+	Mean   *float64 `json:"mean"`
+	Stddev *float64 `json:"stddev"`
+}
+
+func apistats(s change.Stats) APIStats {
+	return APIStats{
+		N:      s.N,
+		Mean:   httputil.Finite(s.Mean),
+		Stddev: httputil.Finite(s.Stddev()),
+	}
+}
+
+// APIRange is a commit index range, with links to the adjacent ranges of the
+// same size for pagination. Links are omitted at either end of the commit
+// history.
+type APIRange struct {
+	Min  int    `json:"min"`
+	Max  int    `json:"max"`
+	Prev string `json:"prev,omitempty"`
+	Next string `json:"next,omitempty"`
+}
+
+// apirange builds the range cr with pagination links, where last is the most
+// recent commit index.
+func apirange(r *http.Request, cr entity.CommitIndexRange, last int) APIRange {
+	link := func(min, max int) string {
+		u := *r.URL
+		q := u.Query()
+		q.Del("c")
+		q.Del("n")
+		q.Set("min", strconv.Itoa(min))
+		q.Set("max", strconv.Itoa(max))
+		u.RawQuery = q.Encode()
+		return u.RequestURI()
+	}
+
+	n := cr.Max - cr.Min
+	rng := APIRange{
+		Min: cr.Min,
+		Max: cr.Max,
+	}
+	if cr.Max < last {
+		rng.Next = link(cr.Max+1, cr.Max+1+n)
+	}
+	if cr.Min > 0 {
+		min := cr.Min - 1 - n
+		if min < 0 {
+			min = 0
+		}
+		rng.Prev = link(min, cr.Min-1)
+	}
+	return rng
+}
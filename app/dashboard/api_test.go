@@ -0,0 +1,64 @@
+package dashboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/app/entity"
+)
+
+func TestAPIRange(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/v1/chgs/?c=150&n=100&pmax=0.01", nil)
+	got := apirange(r, entity.CommitIndexRange{Min: 100, Max: 200}, 1000)
+
+	expect := APIRange{
+		Min:  100,
+		Max:  200,
+		Prev: "/api/v1/chgs/?max=99&min=0&pmax=0.01",
+		Next: "/api/v1/chgs/?max=301&min=201&pmax=0.01",
+	}
+	if got != expect {
+		t.Fatalf("apirange = %#v; expect %#v", got, expect)
+	}
+}
+
+func TestAPIRangeStart(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/v1/chgs/?min=0&max=10", nil)
+	got := apirange(r, entity.CommitIndexRange{Min: 0, Max: 10}, 1000)
+	if got.Prev != "" {
+		t.Fatalf("expected no previous range at start; got %q", got.Prev)
+	}
+}
+
+func TestAPIRangeSecond(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/v1/chgs/?min=1&max=10", nil)
+	got := apirange(r, entity.CommitIndexRange{Min: 1, Max: 10}, 1000)
+	if expect := "/api/v1/chgs/?max=0&min=0"; got.Prev != expect {
+		t.Fatalf("got previous range %q; expect %q", got.Prev, expect)
+	}
+}
+
+func TestAPIRangeEnd(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/v1/chgs/?min=900&max=1000", nil)
+	got := apirange(r, entity.CommitIndexRange{Min: 900, Max: 1000}, 1000)
+	if got.Next != "" {
+		t.Fatalf("expected no next range at end; got %q", got.Next)
+	}
+}
+
+func TestCompareJSONRedirect(t *testing.T) {
+	h := &Handlers{}
+	r := httptest.NewRequest("GET", "/compare/?base=abc&format=json&head=def", nil)
+	w := httptest.NewRecorder()
+	if err := h.Compare(w, r); err != nil {
+		t.Fatal(err)
+	}
+
+	if w.Code != http.StatusMovedPermanently {
+		t.Fatalf("status %d; expect %d", w.Code, http.StatusMovedPermanently)
+	}
+	if loc, expect := w.Header().Get("Location"), "/api/v1/compare/?base=abc&head=def"; loc != expect {
+		t.Fatalf("location %q; expect %q", loc, expect)
+	}
+}
@@ -0,0 +1,211 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/app/env"
+	"github.com/mmcloughlin/goperf/app/httputil"
+)
+
+// DefaultCompareAlpha is the default significance level for commit
+// comparisons.
+const DefaultCompareAlpha = 0.05
+
+// Compare renders a comparison of all benchmark results between base and head
+// commits. The deprecated "format=json" parameter redirects to the JSON API.
+func (h *Handlers) Compare(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	if q := r.URL.Query(); q.Get("format") == "json" {
+		q.Del("format")
+		u := url.URL{Path: APIPrefix + "compare/", RawQuery: q.Encode()}
+		http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+		return nil
+	}
+
+	c, err := h.comparison(r)
+	if err != nil {
+		return err
+	}
+
+	// Write response.
+	return h.render(ctx, w, "compare", map[string]interface{}{
+		"Base":        c.Base,
+		"Head":        c.Head,
+		"Alpha":       c.Alpha,
+		"Comparisons": c.Benchmarks,
+	})
+}
+
+// Comparison is a comparison of benchmark results at two commits.
+type Comparison struct {
+	Base       *entity.Commit
+	Head       *entity.Commit
+	Alpha      float64
+	Benchmarks []*BenchmarkComparison
+}
+
+// comparison builds the commit comparison specified by the "base", "head" and
+// "alpha" request parameters.
+func (h *Handlers) comparison(r *http.Request) (*Comparison, error) {
+	ctx := r.Context()
+
+	// Parse parameters.
+	q := r.URL.Query()
+	basesha, headsha := q.Get("base"), q.Get("head")
+	if basesha == "" || headsha == "" {
+		return nil, httputil.BadRequest(errors.New("base and head commits required"))
+	}
+
+	alpha := floatparam(r, "alpha", DefaultCompareAlpha)
+
+	// Fetch commits.
+	base, err := h.db.FindCommitBySHA(ctx, basesha)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := h.db.FindCommitBySHA(ctx, headsha)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch results at each commit.
+	basepoints, err := h.db.ListCommitBenchmarkPoints(ctx, base.SHA)
+	if err != nil {
+		return nil, err
+	}
+
+	headpoints, err := h.db.ListCommitBenchmarkPoints(ctx, head.SHA)
+	if err != nil {
+		return nil, err
+	}
+
+	cmps, err := h.compare(ctx, basepoints, headpoints, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Comparison{
+		Base:       base,
+		Head:       head,
+		Alpha:      alpha,
+		Benchmarks: cmps,
+	}, nil
+}
+
+// BenchmarkComparison is a comparison between results for a benchmark in one
+// environment at two commits.
+type BenchmarkComparison struct {
+	Benchmark       *entity.Benchmark
+	EnvironmentUUID uuid.UUID
+	Environment     string
+	Significant     bool
+	change.Comparison
+}
+
+// Type classifies the change from base to head.
+func (c *BenchmarkComparison) Type() change.Type {
+	return change.Classify(c.Base.Mean, c.Head.Mean, c.Benchmark.Unit)
+}
+
+// PercentKnown reports whether the percent change is defined. It is undefined
+// for a zero base mean.
+func (c *BenchmarkComparison) PercentKnown() bool {
+	return !math.IsNaN(c.Percent())
+}
+
+// PercentCIKnown reports whether the confidence interval for percent change is
+// available.
+func (c *BenchmarkComparison) PercentCIKnown() bool {
+	return c.PercentCI.Known()
+}
+
+// compare joins base and head points by benchmark and environment, and
+// compares each pair. Results are sorted with significant changes first, in
+// order of decreasing magnitude.
+func (h *Handlers) compare(ctx context.Context, base, head []*entity.BenchmarkPoint, alpha float64) ([]*BenchmarkComparison, error) {
+	// Gather values by benchmark and environment.
+	type key struct {
+		BenchmarkUUID   uuid.UUID
+		EnvironmentUUID uuid.UUID
+	}
+	type samples struct {
+		benchmark  *entity.Benchmark
+		base, head []float64
+	}
+	bykey := map[key]*samples{}
+	gather := func(ps []*entity.BenchmarkPoint, head bool) {
+		for _, p := range ps {
+			k := key{BenchmarkUUID: p.BenchmarkUUID, EnvironmentUUID: p.EnvironmentUUID}
+			if bykey[k] == nil {
+				bykey[k] = &samples{benchmark: p.Benchmark}
+			}
+			s := bykey[k]
+			if head {
+				s.head = append(s.head, p.Value)
+			} else {
+				s.base = append(s.base, p.Value)
+			}
+		}
+	}
+	gather(base, false)
+	gather(head, true)
+
+	// Compare benchmarks present at both commits.
+	var cmps []*BenchmarkComparison
+	for k, s := range bykey {
+		if len(s.base) == 0 || len(s.head) == 0 {
+			continue
+		}
+
+		e, err := h.env(ctx, k.EnvironmentUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		c := change.Compare(s.base, s.head)
+		cmps = append(cmps, &BenchmarkComparison{
+			Benchmark:       s.benchmark,
+			EnvironmentUUID: k.EnvironmentUUID,
+			Environment:     env.Short(e),
+			Significant:     c.Significant(alpha),
+			Comparison:      c,
+		})
+	}
+
+	// Significant changes first, then by magnitude.
+	sort.Slice(cmps, func(i, j int) bool {
+		a, b := cmps[i], cmps[j]
+		if a.Significant != b.Significant {
+			return a.Significant
+		}
+		if pa, pb := magnitude(a), magnitude(b); pa != pb {
+			return pa > pb
+		}
+		if a.Benchmark.FullName != b.Benchmark.FullName {
+			return a.Benchmark.FullName < b.Benchmark.FullName
+		}
+		return a.Environment < b.Environment
+	})
+
+	return cmps, nil
+}
+
+// magnitude returns the absolute percent change of c, or -1 if it is
+// undefined, so that comparisons with a zero base sort after all others.
+func magnitude(c *BenchmarkComparison) float64 {
+	if !c.PercentKnown() {
+		return -1
+	}
+	return math.Abs(c.Percent())
+}
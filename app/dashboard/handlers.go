@@ -23,6 +23,7 @@ import (
 	"github.com/mmcloughlin/goperf/app/entity"
 	"github.com/mmcloughlin/goperf/app/env"
 	"github.com/mmcloughlin/goperf/app/httputil"
+	"github.com/mmcloughlin/goperf/app/trace"
 	"github.com/mmcloughlin/goperf/internal/errutil"
 	"github.com/mmcloughlin/goperf/pkg/fs"
 	"github.com/mmcloughlin/goperf/pkg/units"
@@ -33,10 +34,12 @@ type Handlers struct {
 	staticfs fs.Readable
 	datafs   fs.Readable
 	cc       httputil.CacheControl
+	admin    string
 
 	mux       *http.ServeMux
 	static    *httputil.Static
 	templates *Templates
+	jsonenc   *httputil.JSONEncoder
 	envcache  sync.Map
 	log       *zap.Logger
 }
@@ -59,6 +62,12 @@ func WithCacheControl(cc httputil.CacheControl) Option {
 	return func(h *Handlers) { h.cc = cc }
 }
 
+// WithAdminPassword enables editing of change triage, for users who
+// authenticate with the given password. Triage is read-only without it.
+func WithAdminPassword(password string) Option {
+	return func(h *Handlers) { h.admin = password }
+}
+
 func WithLogger(l *zap.Logger) Option {
 	return func(h *Handlers) { h.log = l.Named("handlers") }
 }
@@ -72,6 +81,7 @@ func NewHandlers(d *db.DB, opts ...Option) *Handlers {
 		cc:        httputil.CacheControlNever,
 		mux:       http.NewServeMux(),
 		templates: NewTemplates(TemplateFileSystem),
+		jsonenc:   &httputil.JSONEncoder{},
 		log:       zap.NewNop(),
 	}
 	for _, opt := range opts {
@@ -88,9 +98,16 @@ func NewHandlers(d *db.DB, opts ...Option) *Handlers {
 	h.mux.Handle("/file/", h.handlerFunc(h.File))
 	h.mux.Handle("/commit/", h.handlerFunc(h.Commit))
 	h.mux.Handle("/chgs/", h.handlerFunc(h.Changes))
+	h.mux.Handle("/compare/", h.handlerFunc(h.Compare))
+	if h.admin != "" {
+		h.mux.Handle("/triage/", h.authenticate(h.handlerFunc(h.Triage)))
+	}
 
 	h.mux.Handle("/about/", h.handlerFunc(h.About))
 
+	// JSON API.
+	h.registerAPI()
+
 	// Static assets.
 	h.static = httputil.NewStatic(h.staticfs)
 	h.static.SetLogger(h.log)
@@ -124,6 +141,10 @@ func (h *Handlers) Init(ctx context.Context) error {
 	// Linkify.
 	h.templates.Func("linkify", linkify)
 
+	// Triage states for forms.
+	h.templates.Func("triagestates", entity.TriageStateValues)
+	h.templates.Func("triageeditable", func() bool { return h.admin != "" })
+
 	return h.templates.Init(ctx)
 }
 
@@ -292,9 +313,16 @@ type PointsGroup struct {
 	Environment entity.Properties
 	Points      entity.Points
 	Filtered    []float64
+	Spread      []Interval
 	Quantities  []units.Quantity
 }
 
+// Interval is a range of values. Invalid intervals are not displayed.
+type Interval struct {
+	Valid        bool
+	Lower, Upper float64
+}
+
 func (h *Handlers) groups(ctx context.Context, points entity.Points, unit string) ([]*PointsGroup, error) {
 	// Group by environment.
 	byenv := map[uuid.UUID]entity.Points{}
@@ -316,9 +344,29 @@ func (h *Handlers) groups(ctx context.Context, points entity.Points, unit string
 		})
 	}
 
-	// Apply KZA filtering.
+	// Apply KZA filtering to the per-commit means, and determine the
+	// within-commit spread of repeated measurements.
 	for _, group := range groups {
-		group.Filtered = analysis.AdaptiveKolmogorovZurbenko(group.Points.Values(), 31, 5)
+		series := commitseries(group.Points)
+		filtered := analysis.AdaptiveKolmogorovZurbenko(series.Values(), 31, 5)
+
+		byidx := map[int]int{}
+		for i, v := range series {
+			byidx[v.CommitIndex] = i
+		}
+
+		for _, p := range group.Points {
+			i := byidx[p.CommitIndex]
+			group.Filtered = append(group.Filtered, filtered[i])
+
+			v := series[i]
+			var spread Interval
+			if v.N > 1 {
+				sd := v.Stddev()
+				spread = Interval{Valid: true, Lower: v.Value - sd, Upper: v.Value + sd}
+			}
+			group.Spread = append(group.Spread, spread)
+		}
 	}
 
 	// Convert to quantity.
@@ -337,6 +385,29 @@ func (h *Handlers) groups(ctx context.Context, points entity.Points, unit string
 	return groups, nil
 }
 
+// commitseries aggregates points from a single trace into a series of
+// per-commit means.
+func commitseries(points entity.Points) trace.Series {
+	ps := make([]trace.Point, len(points))
+	for i, p := range points {
+		ps[i] = trace.Point{
+			ID: trace.ID{
+				BenchmarkUUID:   p.BenchmarkUUID,
+				EnvironmentUUID: p.EnvironmentUUID,
+			},
+			IndexedValue: trace.IndexedValue{
+				CommitIndex: p.CommitIndex,
+				Value:       p.Value,
+			},
+		}
+	}
+
+	for _, t := range trace.Traces(ps) {
+		return t.Series
+	}
+	return nil
+}
+
 func (h *Handlers) Result(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
@@ -444,7 +515,16 @@ func (h *Handlers) Commit(w http.ResponseWriter, r *http.Request) error {
 	// threshold to return everything.
 	var changes []*Change
 	if idx >= 0 {
-		changes, err = h.changesForCommit(ctx, idx)
+		repo, err := h.db.FindRepositoryByCommitSHA(ctx, sha)
+		if err != nil {
+			return err
+		}
+
+		changes, err = h.changesForCommit(ctx, idx, db.ChangeFilter{
+			RepositoryUUID: repo.UUID(),
+			MinEffectSize:  0, // everything
+			MaxPValue:      floatparam(r, "pmax", 0),
+		})
 		if err != nil {
 			return err
 		}
@@ -458,10 +538,8 @@ func (h *Handlers) Commit(w http.ResponseWriter, r *http.Request) error {
 	})
 }
 
-func (h *Handlers) changesForCommit(ctx context.Context, idx int) ([]*Change, error) {
-	chgs, err := h.db.ListChangeSummariesForCommitIndex(ctx, idx, db.ChangeFilter{
-		MinEffectSize: 0, // everything
-	})
+func (h *Handlers) changesForCommit(ctx context.Context, idx int, filter db.ChangeFilter) ([]*Change, error) {
+	chgs, err := h.db.ListChangeSummariesForCommitIndex(ctx, idx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -489,6 +567,8 @@ func (h *Handlers) Changes(w http.ResponseWriter, r *http.Request) error {
 	return h.changes(w, r, "chgs", db.ChangeFilter{
 		MinEffectSize:       10,
 		MaxRankByEffectSize: 5,
+		TriageStates:        []entity.TriageState{entity.TriageStateUntriaged},
+		Types:               []change.Type{change.TypeRegression},
 	}, byindex)
 }
 
@@ -501,6 +581,14 @@ func (h *Handlers) changes(w http.ResponseWriter, r *http.Request, tmpl string,
 		return err
 	}
 
+	// Optional significance threshold.
+	filter.MaxPValue = floatparam(r, "pmax", filter.MaxPValue)
+
+	// Optional triage state and change type filters.
+	if err := changefilterparams(r, &filter); err != nil {
+		return err
+	}
+
 	// Fetch changes.
 	chgs, err := h.db.ListChangeSummaries(ctx, cr, filter)
 	if err != nil {
@@ -540,8 +628,11 @@ func (g *CommitChangeGroup) MaxAbsPercentChange() float64 {
 
 // Change is a single change.
 type Change struct {
-	Benchmark   *entity.Benchmark
-	Environment string
+	Benchmark       *entity.Benchmark
+	EnvironmentUUID uuid.UUID
+	Environment     string
+	Confirmation    change.Confirmation
+	Triage          entity.Triage
 	change.Change
 }
 
@@ -568,9 +659,12 @@ func (h *Handlers) groupChanges(ctx context.Context, cs []*entity.ChangeSummary)
 
 		byidx := byidx[c.CommitIndex]
 		byidx.Changes = append(byidx.Changes, &Change{
-			Benchmark:   c.Benchmark,
-			Environment: env.Short(e),
-			Change:      c.Change,
+			Benchmark:       c.Benchmark,
+			EnvironmentUUID: c.EnvironmentUUID,
+			Environment:     env.Short(e),
+			Confirmation:    c.Confirmation,
+			Triage:          c.Triage,
+			Change:          c.Change,
 		})
 	}
 
@@ -598,12 +692,10 @@ func (h *Handlers) groupChanges(ctx context.Context, cs []*entity.ChangeSummary)
 
 // commitRange determines a specified commit range for the given request.
 func (h *Handlers) commitRange(r *http.Request) (entity.CommitIndexRange, error) {
-	ctx := r.Context()
-
 	// Look for explicit min/max.
 	min := intparam(r, "min", -1)
 	max := intparam(r, "max", -1)
-	if 0 <= min && min < max {
+	if 0 <= min && min <= max {
 		return entity.CommitIndexRange{
 			Min: min,
 			Max: max,
@@ -622,7 +714,7 @@ func (h *Handlers) commitRange(r *http.Request) (entity.CommitIndexRange, error)
 	}
 
 	// Determine commit index.
-	idx, err := h.db.MostRecentCommitIndex(ctx)
+	idx, err := h.latest(r)
 	if err != nil {
 		return entity.CommitIndexRange{}, err
 	}
@@ -633,6 +725,15 @@ func (h *Handlers) commitRange(r *http.Request) (entity.CommitIndexRange, error)
 	}, nil
 }
 
+// latest returns the most recent commit index in the repository requested by r.
+func (h *Handlers) latest(r *http.Request) (int, error) {
+	repo, err := repositoryparam(r)
+	if err != nil {
+		return 0, err
+	}
+	return h.db.MostRecentCommitIndex(r.Context(), repo)
+}
+
 func (h *Handlers) About(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	return h.render(ctx, w, "about", nil)
@@ -680,3 +781,25 @@ func intparam(r *http.Request, key string, dflt int) int {
 	}
 	return v
 }
+
+// repositoryparam returns the repository UUID given by the "repo" request
+// parameter, defaulting to the Go repository.
+func repositoryparam(r *http.Request) (uuid.UUID, error) {
+	s := r.URL.Query().Get("repo")
+	if s == "" {
+		return entity.GoRepository.UUID(), nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, httputil.BadRequest(err)
+	}
+	return id, nil
+}
+
+func floatparam(r *http.Request, key string, dflt float64) float64 {
+	v, err := strconv.ParseFloat(r.URL.Query().Get(key), 64)
+	if err != nil {
+		return dflt
+	}
+	return v
+}
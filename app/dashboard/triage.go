@@ -0,0 +1,181 @@
+package dashboard
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/app/httputil"
+	"github.com/mmcloughlin/goperf/app/trace"
+)
+
+// adminuser is the username for admin authentication. Only the password is
+// checked.
+const adminuser = "admin"
+
+// authenticate wraps handler, requiring HTTP basic authentication with the
+// admin password.
+func (h *Handlers) authenticate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, password, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(password), []byte(h.admin)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="admin", charset="UTF-8"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Triage records the triage of a change submitted by form, and redirects back
+// to the referring page. Requires admin authentication.
+func (h *Handlers) Triage(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		return httputil.MethodNotAllowed(r.Method)
+	}
+
+	// Browsers send basic authentication credentials automatically, so forms
+	// submitted from other sites must be rejected.
+	if !sameorigin(r) {
+		return httputil.Error{
+			Code: http.StatusForbidden,
+			Err:  errors.New("cross-origin request"),
+		}
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	// Identify the change.
+	benchmarkUUID, err := uuid.Parse(r.PostForm.Get("benchmark"))
+	if err != nil {
+		return httputil.BadRequest(fmt.Errorf("benchmark: %w", err))
+	}
+
+	environmentUUID, err := uuid.Parse(r.PostForm.Get("environment"))
+	if err != nil {
+		return httputil.BadRequest(fmt.Errorf("environment: %w", err))
+	}
+
+	idx, err := strconv.Atoi(r.PostForm.Get("commit_index"))
+	if err != nil {
+		return httputil.BadRequest(fmt.Errorf("commit index: %w", err))
+	}
+
+	// Parse triage.
+	t, err := parsetriage(r.PostForm)
+	if err != nil {
+		return httputil.BadRequest(err)
+	}
+
+	// Store.
+	id := trace.ID{
+		BenchmarkUUID:   benchmarkUUID,
+		EnvironmentUUID: environmentUUID,
+	}
+	if err := h.db.SetChangeTriage(ctx, id, idx, t); err != nil {
+		return err
+	}
+
+	// Return to the page the form was submitted from.
+	redirect := "/chgs/"
+	if ref, err := url.Parse(r.Referer()); err == nil && ref.Path != "" {
+		redirect = ref.RequestURI()
+	}
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
+
+	return nil
+}
+
+// sameorigin reports whether the request was made from a page on the same host,
+// according to the Origin header or, failing that, the Referer.
+func sameorigin(r *http.Request) bool {
+	source := r.Header.Get("Origin")
+	if source == "" {
+		source = r.Referer()
+	}
+	u, err := url.Parse(source)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Host == r.Host
+}
+
+// parsetriage parses triage fields from form values.
+func parsetriage(v url.Values) (*entity.Triage, error) {
+	state, err := entity.TriageStateString(v.Get("state"))
+	if err != nil {
+		return nil, err
+	}
+
+	issue := strings.TrimSpace(v.Get("issue"))
+	if issue != "" {
+		u, err := url.Parse(issue)
+		if err != nil {
+			return nil, fmt.Errorf("issue url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, errors.New("issue url must be http or https")
+		}
+	}
+
+	return &entity.Triage{
+		State:    state,
+		IssueURL: issue,
+		Note:     strings.TrimSpace(v.Get("note")),
+	}, nil
+}
+
+// changefilterparams applies the "repo", "triage" and "type" request parameters
+// to filter. Triage and type accept comma-separated lists of values, or "all"
+// to disable filtering. Absent parameters leave the filter unchanged, except
+// that the repository defaults to Go.
+func changefilterparams(r *http.Request, filter *db.ChangeFilter) error {
+	q := r.URL.Query()
+
+	repo, err := repositoryparam(r)
+	if err != nil {
+		return err
+	}
+	filter.RepositoryUUID = repo
+
+	if s := q.Get("triage"); s != "" {
+		filter.TriageStates = nil
+		if s != "all" {
+			for _, name := range strings.Split(s, ",") {
+				state, err := entity.TriageStateString(name)
+				if err != nil {
+					return httputil.BadRequest(err)
+				}
+				filter.TriageStates = append(filter.TriageStates, state)
+			}
+		}
+	}
+
+	if s := q.Get("type"); s != "" {
+		filter.Types = nil
+		if s != "all" {
+			for _, name := range strings.Split(s, ",") {
+				typ, err := change.TypeString(name)
+				if err != nil {
+					return httputil.BadRequest(err)
+				}
+				filter.Types = append(filter.Types, typ)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package dashboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestTriageReadOnly(t *testing.T) {
+	h := NewHandlers(nil)
+
+	r := httptest.NewRequest("POST", "/triage/", nil)
+	if _, pattern := h.mux.Handler(r); pattern == "/triage/" {
+		t.Fatal("triage handler registered without admin password")
+	}
+}
+
+func TestTriageAuthentication(t *testing.T) {
+	h := NewHandlers(nil, WithAdminPassword("secret"))
+
+	cases := []struct {
+		Name     string
+		Password string
+		Origin   string
+		Expect   int
+	}{
+		{Name: "none", Expect: http.StatusUnauthorized},
+		{Name: "wrong_password", Password: "guess", Origin: "http://example.com", Expect: http.StatusUnauthorized},
+		{Name: "cross_origin", Password: "secret", Origin: "http://evil.com", Expect: http.StatusForbidden},
+		{Name: "no_origin", Password: "secret", Expect: http.StatusForbidden},
+		{Name: "authenticated", Password: "secret", Origin: "http://example.com", Expect: http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			form := url.Values{"benchmark": {"invalid"}}
+			r := httptest.NewRequest("POST", "http://example.com/triage/", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if c.Password != "" {
+				r.SetBasicAuth(adminuser, c.Password)
+			}
+			if c.Origin != "" {
+				r.Header.Set("Origin", c.Origin)
+			}
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != c.Expect {
+				t.Fatalf("got status %d; expect %d", w.Code, c.Expect)
+			}
+			if c.Expect == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Fatal("missing WWW-Authenticate header")
+			}
+		})
+	}
+}
+
+func TestSameOriginReferer(t *testing.T) {
+	r := httptest.NewRequest("POST", "http://example.com/triage/", nil)
+	r.Header.Set("Referer", "http://example.com/chgs/")
+	if !sameorigin(r) {
+		t.Fatal("expected same origin")
+	}
+
+	r.Header.Set("Referer", "http://example.com.evil.com/chgs/")
+	if sameorigin(r) {
+		t.Fatal("expected cross origin")
+	}
+}
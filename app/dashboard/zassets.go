@@ -4,20 +4,21 @@ package dashboard
 
 var assets = map[string][]byte{
 	"templates/about.gohtml":             []byte("{{ define \"title\" }}About{{ end }}\n\n{{ define \"content\" }}\n<h1>About</h1>\n\n<p>GoPerf evaluates the performance of programs produced by the <a\nhref=\"https://golang.org\">Go</a> compiler by running a <a href=\"/mods/\">fixed\nbenchmark suite</a> against every commit and identifying <a\nhref=\"/chgs/\">significant changes</a>.</p>\n\n<p class=\"warn\">GoPerf is not an official Go project.</p>\n\n<h2>Feedback</h2>\n\n<p>Bug reports and feedback are welcome on the <a\nhref=\"https://github.com/mmcloughlin/goperf/issues\">Github issue tracker</a>.</p>\n\n<h2>Methodology</h1>\n\n<h3>Benchmarks</h3>\n\n<p>GoPerf watches the <a href=\"https://go.googlesource.com/go/\">Go git\nrepository</a> for new commits. The <em>coordinator</em> server distributes\nbenchmark jobs to benchmark runners, with the goal of running benchmarks on\nevery recent commit in the Go project. Each benchmark job installs the target\nGo version and runs <code>go test -bench .</code> on a specified Go\nmodule.</p>\n\n<p>The <a href=\"/mods/\">benchmark suites</a> are a fixed set of Go modules,\nincluding the standard library, <code>golang.org/x</code> sub-repos and open\nsource third-party packages. Modules were selected based on their prominence\nin the Go ecosystem, as well as the size, quality and stability of their\nbenchmark tests. Apart from the special-case of the standard library, module\nversions are fixed, allowing us to judge the effects of changes in the Go\ncompiler.</p>\n\n<h3>Execution Environment</h3>\n\n<p>Benchmark variance reduction is critical for evaluating performance\nchanges. This project employs a number of benchmark isolation strategies,\nrelying on low-level Linux features.</p>\n\n<ul>\n\n    <li><em>Simultaneous multi-threading</em> (known as HyperThreading on Intel\n    processors) is disabled via the <code>/sys/devices/system/cpu/smt</code>\n    filesystem.</li>\n\n    <li><em>Frequency</em> of all online CPUs is pinned to 20% of the range\n    between the allowed minimum and maximum (or the nearest available\n    frequency when the governor only supports fixed values). This is the same\n    method as the <a\n    href=\"https://github.com/aclements/perflock\"><code>perflock</code>\n    tool</a>.</li>\n\n    <li><em>Intel Turbo</em> is disabled through the\n    <code>/sys/devices/system/cpu/intel_pstate/no_turbo</code>\n    file.</li>\n\n    <li>CPU <em>scaling governor</em> on all CPUs is set to\n    <code>performance</code>.</li>\n\n    <li>CPUSets are used to setup a <em>CPU shield</em>: benchmarks are run\n    in a CPUSet with exclusive use of assigned CPUs, while all other system\n    processes are moved to a disjoint CPUSet. This is the same technique as\n    the <a\n    href=\"https://github.com/lpechacek/cpuset\"><code>lpechacek/cpuset</code></a>\n    tool.</li>\n\n</ul>\n\n<p>In addition to performance isolation, the execution system also prepends\nextensive configuration lines about the execution environment in accordance\nwith the <a\nhref=\"https://go.googlesource.com/proposal/+/refs/heads/master/design/14313-benchmark-format.md\">Go\nBenchmark Data Format</a>. These are divided into <em>environment</em> and\n<em>metadata</em> properties, where environment properties are considered\nperformance-critical. GoPerf will only consider results comparable if they\nagree on <em>all</em> environment properties. In benchmark output files,\nenvironment property values are distinguished by a <code>[perf]</code>\nsuffix.</p>\n\n<h2>Runners</h2>\n\n<p>Standard cloud virtual machines give high-variance results, and instance\ntypes offering CPU frequency control were well outside the budget of the\nGoPerf project. Therefore, cheap dedicated machines were acquired for\nbenchmark runners.</p>\n\n<ul>\n\n    <li><code>gopherplex</code> is a Dell Optiplex 9020 with the quad core <a\n    href=\"https://ark.intel.com/content/www/us/en/ark/products/80808/intel-core-i7-4790s-processor-8m-cache-up-to-4-00-ghz.html\">Intel\n    i7-4790S</a> and 4 GiB RAM, used for <code>amd64</code> benchmarks.</li>\n\n    <li><code>gopherpi</code> is a <a\n    href=\"https://www.raspberrypi.org/products/raspberry-pi-4-model-b/\">Raspberry\n    Pi 4 Model B</a> with quad core Cortex-A72 64-bit ARM processor, used for\n    <code>arm64</code> benchmarks.</li>\n\n</ul>\n\n<p>These benchmark runners are housed in a <del>state-of-the-art data\ncenter</del> <ins>closet</ins> in San Francisco.</p>\n\n<figure>\n    <img src=\"{{ static \"img/gopherpi.jpg\" }}\" alt=\"Photograph of gopherpi, the Raspberry Pi arm64 benchmark runner\"\n    /><img src=\"{{ static \"img/closet.jpg\" }}\" alt=\"Photograph of gopherplex and gopherpi in their closet\" />\n    <figcaption>Benchmark runners <code>gopherpi</code> and <code>gopherplex</code> nestled in the closet.</figcaption>\n</figure>\n\n<h2>License</h2>\n\n<p>The GoPerf project is open source under the <a\nhref=\"https://github.com/mmcloughlin/goperf/blob/master/LICENSE\">BSD 3-Clause\nLicense</a>.</p>\n\n{{ end }}\n"),
-	"templates/bench.gohtml":             []byte("{{ define \"title\" }}{{ .Benchmark.FullName }} {{ .Benchmark.Unit }}{{ end }}\n\n{{ define \"head\" }}\n<script type=\"text/javascript\" src=\"https://www.gstatic.com/charts/loader.js\"></script>\n<script type=\"text/javascript\">\n  google.charts.load('current', {'packages':['corechart']});\n\n  function drawChart (element, data, meta) {\n    var options = {\n      chartArea: {\n        width: '85%',\n        height: '80%'\n      },\n      hAxis: {\n        viewWindow: {\n          min: {{ .CommitIndexRange.Min }},\n          max: {{ .CommitIndexRange.Max }}\n        },\n        textPosition: 'out'\n      },\n      axisTitlesPosition: 'none',\n      legend: { position: 'none' },\n      series: [\n        { color: {{ color \"gopher-blue\" | js }}, dataOpacity: 0.5, pointSize: 8 },\n        { color: {{ color \"fuchsia\" | js }}, lineWidth: 3, pointSize: 0, enableInteractivity: false },\n      ],\n      tooltip: { trigger: 'selection' },\n      explorer: {\n        actions: ['dragToZoom', 'rightClickToReset'],\n        axis: 'horizontal',\n        keepInBounds: true,\n        maxZoomIn: 0.01\n      }\n    };\n\n    var chart = new google.visualization.ScatterChart(element)\n\n    chart.setAction({\n      id: 'result',\n      text: 'View Result',\n      action: function() {\n        selection = chart.getSelection();\n        idx = selection[0].row;\n        window.location.href = '/result/' + meta[idx].resultUUID;\n      }\n    });\n\n    chart.setAction({\n      id: 'commit',\n      text: 'View Commit',\n      action: function() {\n        selection = chart.getSelection();\n        idx = selection[0].row;\n        window.location.href = '/commit/' + meta[idx].commitSHA;\n      }\n    });\n\n    chart.draw(data, options);\n  }\n\n  {{ range $idx, $group := .PointsGroups }}\n  google.charts.setOnLoadCallback(function () {\n    var element = document.getElementById('chart{{ $idx }}');\n\n    var data = new google.visualization.DataTable();\n    data.addColumn('number', 'Commit Index');\n    data.addColumn('number', 'Value');\n    data.addColumn('number', 'Filtered');\n    data.addRows([\n      {{ range $idx, $point := $group.Points -}}\n      [{v: {{ .CommitIndex }}, f: {{ printf \"#%d\" .CommitIndex }}}, {v: {{ $point.Value }}, f: {{ index $group.Quantities $idx }} }, {{ index $group.Filtered $idx }}],\n      {{ end }}\n    ]);\n\n    var meta = [\n      {{ range $group.Points -}}\n      { resultUUID: {{ .ResultUUID | js }}, commitSHA: {{ .CommitSHA | js }} },\n      {{ end }}\n    ]\n\n    drawChart(element, data, meta);\n  });\n  {{ end }}\n</script>\n{{ end }}\n\n{{ define \"content\" }}\n<h1>{{ .Benchmark.FullName }}{{ template \"sep\" }}{{ .Benchmark.Unit }}</h1>\n\n<dl class=\"meta\">\n  <div><dt>Package</dt><dd>{{ template \"pkg\" .Benchmark.Package }}</dd></div>\n  <div><dt>Module</dt><dd>{{ template \"mod\" .Benchmark.Package.Module }}</dd></div>\n  <div><dt>Version</dt><dd>{{ template \"modver\" .Benchmark.Package.Module }}</dd></div>\n</dl>\n\n<p class=\"note\">Click and drag left-right to zoom in. Click a dot to see\nresults and commit. Right click to zoom out.</p>\n\n{{ range $idx, $group := .PointsGroups }}\n<h2>environment {{ $group.Title }}</h2>\n<div id=\"chart{{ $idx }}\" class=\"chart\"></div>\n{{ end }}\n\n{{ end }}\n"),
-	"templates/chgs.gohtml":              []byte("{{ define \"title\" }}Changes{{ end }}\n\n{{ define \"content\" }}\n<h1>Changes</h1>\n\n<p class=\"warn\">Change detection is non-trivial and subject to mistakes. Some\nreal changes can be misattributed to nearby commits. Noisy benchmarks can\nalso produce false positives. Please <a\nhref=\"https://github.com/mmcloughlin/goperf/issues/new\">report false changes</a>\nso we can refine the detection algorithm.</p>\n\n<details class=\"note\">\n<summary>Interpreting Changes List</summary>\n\n<p>Changes are listed by commit in <code>git log</code> order, omitting\ncommits for which no significant changes were identified.</p>\n\n<p>Changes for a given commit are ordered by <dfn>effect size</dfn>, a\nmeasure of confidence in the change calculated with <a\nhref=\"https://en.wikipedia.org/wiki/Effect_size#Cohen's_d\">Cohen's d</a>.\nNote that the effect size is <em>not the same as percentage change</em>:\neffect size could be very high for a small percentage change if the variance\nis low.</p>\n\n</details>\n\n{{ range .CommitChangeGroups }}\n<h2>{{ template \"sha\" .SHA }} <code>{{ .Subject }}</code></h2>\n{{ template \"changes\" .Changes }}\n{{ end }}\n\n{{ end }}\n"),
-	"templates/commit.gohtml":            []byte("{{ define \"title\" }}Commit {{ .Commit.SHA }}{{ end }}\n\n{{ define \"content\" }}\n<h1>Commit {{ .Commit.SHA }}</h1>\n\n<h2>Changes</h2>\n{{ if .Changes }}\n{{ template \"changes\" .Changes }}\n{{ else }}\n<p class=\"empty\">No significant changes identified.</p>\n{{ end }}\n\n{{ with .Commit }}\n<h2>Metadata</h2>\n\n<table class=\"properties\">\n    <tr><td class=\"key code\">author</td><td class=\"value\">{{ .Author.Name }} &lt;{{ .Author.Email }}&gt;</td></tr>\n    <tr><td class=\"key code\">author time</td><td class=\"value\">{{ .AuthorTime }}</td></tr>\n    <tr><td class=\"key code\">committer</td><td class=\"value\">{{ .Committer.Name }} &lt;{{ .Committer.Email }}&gt;</td></tr>\n    <tr><td class=\"key code\">commit time</td><td class=\"value\">{{ .CommitTime }}</td></tr>\n    {{ if ge $.CommitIndex 0 }}<tr><td class=\"key code\">commit index</td><td class=\"value\">{{ $.CommitIndex }}</td></tr>{{ end }}\n    <tr>\n        <td class=\"key code\">parent</td>\n        <td class=\"value\">{{ range .Parents }}{{ template \"sha\" . }} {{ end }}</td>\n    </tr>\n    <tr>\n        <td class=\"key code\">browse</td>\n        <td class=\"value\">\n            <a href=\"https://go.googlesource.com/go/+/{{ .SHA }}\">gitiles</a>\n            &middot;\n            <a href=\"https://github.com/golang/go/commit/{{ .SHA }}\">github</a>\n        </td>\n    </tr>\n</table>\n\n<pre>{{ linkify .Message }}</pre>\n{{ end }}\n\n{{ end }}\n"),
+	"templates/bench.gohtml":             []byte("{{ define \"title\" }}{{ .Benchmark.FullName }} {{ .Benchmark.Unit }}{{ end }}\n\n{{ define \"head\" }}\n<script type=\"text/javascript\" src=\"https://www.gstatic.com/charts/loader.js\"></script>\n<script type=\"text/javascript\">\n  google.charts.load('current', {'packages':['corechart']});\n\n  function drawChart (element, data, meta) {\n    var options = {\n      chartArea: {\n        width: '85%',\n        height: '80%'\n      },\n      hAxis: {\n        viewWindow: {\n          min: {{ .CommitIndexRange.Min }},\n          max: {{ .CommitIndexRange.Max }}\n        },\n        textPosition: 'out'\n      },\n      axisTitlesPosition: 'none',\n      legend: { position: 'none' },\n      series: [\n        { color: {{ color \"gopher-blue\" | js }}, dataOpacity: 0.5, pointSize: 8 },\n        { color: {{ color \"fuchsia\" | js }}, lineWidth: 3, pointSize: 0, enableInteractivity: false },\n      ],\n      intervals: { style: 'bars', barWidth: 0.5, lineWidth: 1, color: {{ color \"gopher-blue\" | js }} },\n      tooltip: { trigger: 'selection' },\n      explorer: {\n        actions: ['dragToZoom', 'rightClickToReset'],\n        axis: 'horizontal',\n        keepInBounds: true,\n        maxZoomIn: 0.01\n      }\n    };\n\n    var chart = new google.visualization.ScatterChart(element)\n\n    chart.setAction({\n      id: 'result',\n      text: 'View Result',\n      action: function() {\n        selection = chart.getSelection();\n        idx = selection[0].row;\n        window.location.href = '/result/' + meta[idx].resultUUID;\n      }\n    });\n\n    chart.setAction({\n      id: 'commit',\n      text: 'View Commit',\n      action: function() {\n        selection = chart.getSelection();\n        idx = selection[0].row;\n        window.location.href = '/commit/' + meta[idx].commitSHA;\n      }\n    });\n\n    chart.draw(data, options);\n  }\n\n  {{ range $idx, $group := .PointsGroups }}\n  google.charts.setOnLoadCallback(function () {\n    var element = document.getElementById('chart{{ $idx }}');\n\n    var data = new google.visualization.DataTable();\n    data.addColumn('number', 'Commit Index');\n    data.addColumn('number', 'Value');\n    data.addColumn({type: 'number', role: 'interval'});\n    data.addColumn({type: 'number', role: 'interval'});\n    data.addColumn('number', 'Filtered');\n    data.addRows([\n      {{ range $idx, $point := $group.Points -}}\n      [{v: {{ .CommitIndex }}, f: {{ printf \"#%d\" .CommitIndex }}}, {v: {{ $point.Value }}, f: {{ index $group.Quantities $idx }} }, {{ with index $group.Spread $idx }}{{ if .Valid }}{{ .Lower }}, {{ .Upper }}{{ else }}null, null{{ end }}{{ end }}, {{ index $group.Filtered $idx }}],\n      {{ end }}\n    ]);\n\n    var meta = [\n      {{ range $group.Points -}}\n      { resultUUID: {{ .ResultUUID | js }}, commitSHA: {{ .CommitSHA | js }} },\n      {{ end }}\n    ]\n\n    drawChart(element, data, meta);\n  });\n  {{ end }}\n</script>\n{{ end }}\n\n{{ define \"content\" }}\n<h1>{{ .Benchmark.FullName }}{{ template \"sep\" }}{{ .Benchmark.Unit }}</h1>\n\n<dl class=\"meta\">\n  <div><dt>Package</dt><dd>{{ template \"pkg\" .Benchmark.Package }}</dd></div>\n  <div><dt>Module</dt><dd>{{ template \"mod\" .Benchmark.Package.Module }}</dd></div>\n  <div><dt>Version</dt><dd>{{ template \"modver\" .Benchmark.Package.Module }}</dd></div>\n</dl>\n\n<p class=\"note\">Click and drag left-right to zoom in. Click a dot to see\nresults and commit. Right click to zoom out. Bars show one standard deviation\neither side of the mean for commits with repeated measurements.</p>\n\n{{ range $idx, $group := .PointsGroups }}\n<h2>environment {{ $group.Title }}</h2>\n<div id=\"chart{{ $idx }}\" class=\"chart\"></div>\n{{ end }}\n\n{{ end }}\n"),
+	"templates/chgs.gohtml":              []byte("{{ define \"title\" }}Changes{{ end }}\n\n{{ define \"content\" }}\n<h1>Changes</h1>\n\n<p class=\"warn\">Change detection is non-trivial and subject to mistakes. Some\nreal changes can be misattributed to nearby commits. Noisy benchmarks can\nalso produce false positives. Please <a\nhref=\"https://github.com/mmcloughlin/goperf/issues/new\">report false changes</a>\nso we can refine the detection algorithm.</p>\n\n<details class=\"note\">\n<summary>Interpreting Changes List</summary>\n\n<p>Changes are listed by commit in <code>git log</code> order, omitting\ncommits for which no significant changes were identified.</p>\n\n<p>Changes for a given commit are ordered by <dfn>effect size</dfn>, a\nmeasure of confidence in the change calculated with <a\nhref=\"https://en.wikipedia.org/wiki/Effect_size#Cohen's_d\">Cohen's d</a>.\nNote that the effect size is <em>not the same as percentage change</em>:\neffect size could be very high for a small percentage change if the variance\nis low.</p>\n\n<p>Each change also reports a <dfn>p-value</dfn> from a two-sided <a\nhref=\"https://en.wikipedia.org/wiki/Mann%E2%80%93Whitney_U_test\">Mann-Whitney\nU test</a> comparing the windows either side of the change, and a 95%\nconfidence interval for the percentage change derived from <a\nhref=\"https://en.wikipedia.org/wiki/Welch%27s_t-test\">Welch's t-test</a>.\nWeak changes can be hidden by setting a maximum p-value with the\n<code>pmax</code> query parameter, for example <a\nhref=\"/chgs/?pmax=0.001\"><code>?pmax=0.001</code></a>.</p>\n\n<p>By default only <dfn>untriaged regressions</dfn> are listed. Each change\nmay be triaged by an administrator as acknowledged, expected, a false positive or fixed, with an\noptional issue link and note. Use the <code>triage</code> and\n<code>type</code> query parameters to select other changes, for example <a\nhref=\"/chgs/?triage=all&amp;type=all\"><code>?triage=all&amp;type=all</code></a>\nor <a\nhref=\"/chgs/?triage=expected,fixed\"><code>?triage=expected,fixed</code></a>.</p>\n\n</details>\n\n{{ range .CommitChangeGroups }}\n<h2>{{ template \"sha\" .SHA }} <code>{{ .Subject }}</code></h2>\n{{ template \"changes\" .Changes }}\n{{ end }}\n\n{{ end }}\n"),
+	"templates/commit.gohtml":            []byte("{{ define \"title\" }}Commit {{ .Commit.SHA }}{{ end }}\n\n{{ define \"content\" }}\n<h1>Commit {{ .Commit.SHA }}</h1>\n\n<h2>Changes</h2>\n{{ if .Changes }}\n{{ template \"changes\" .Changes }}\n{{ else }}\n<p class=\"empty\">No significant changes identified.</p>\n{{ end }}\n\n{{ with .Commit }}\n<h2>Metadata</h2>\n\n<table class=\"properties\">\n    <tr><td class=\"key code\">author</td><td class=\"value\">{{ .Author.Name }} &lt;{{ .Author.Email }}&gt;</td></tr>\n    <tr><td class=\"key code\">author time</td><td class=\"value\">{{ .AuthorTime }}</td></tr>\n    <tr><td class=\"key code\">committer</td><td class=\"value\">{{ .Committer.Name }} &lt;{{ .Committer.Email }}&gt;</td></tr>\n    <tr><td class=\"key code\">commit time</td><td class=\"value\">{{ .CommitTime }}</td></tr>\n    {{ if ge $.CommitIndex 0 }}<tr><td class=\"key code\">commit index</td><td class=\"value\">{{ $.CommitIndex }}</td></tr>{{ end }}\n    <tr>\n        <td class=\"key code\">parent</td>\n        <td class=\"value\">{{ range .Parents }}{{ template \"sha\" . }} {{ end }}</td>\n    </tr>\n    <tr>\n        <td class=\"key code\">browse</td>\n        <td class=\"value\">\n            <a href=\"https://go.googlesource.com/go/+/{{ .SHA }}\">gitiles</a>\n            &middot;\n            <a href=\"https://github.com/golang/go/commit/{{ .SHA }}\">github</a>\n            {{ if .Parents }}&middot;\n            <a href=\"/compare/?base={{ index .Parents 0 }}&head={{ .SHA }}\">compare to parent</a>{{ end }}\n        </td>\n    </tr>\n</table>\n\n<pre>{{ linkify .Message }}</pre>\n{{ end }}\n\n{{ end }}\n"),
+	"templates/compare.gohtml":           []byte("{{ define \"title\" }}Compare {{ slice .Base.SHA 0 10 }}...{{ slice .Head.SHA 0 10 }}{{ end }}\n\n{{ define \"content\" }}\n<h1>Compare {{ template \"commit\" .Base }}{{ template \"sep\" }}{{ template \"commit\" .Head }}</h1>\n\n<dl class=\"meta\">\n  <div><dt>Base</dt><dd>{{ template \"commit\" .Base }} ({{ .Base.CommitTime }})</dd></div>\n  <div><dt>Head</dt><dd>{{ template \"commit\" .Head }} ({{ .Head.CommitTime }})</dd></div>\n  <div><dt>Significance</dt><dd>p &lt; {{ .Alpha }}</dd></div>\n</dl>\n\n<p class=\"note\">Benchmarks measured at both commits, with significant changes\nfirst. Insignificant changes are shown as ~. Also available as <a\nhref=\"/api/v1/compare/?base={{ .Base.SHA }}&head={{ .Head.SHA }}&alpha={{ .Alpha }}\">JSON</a>.</p>\n\n{{ if .Comparisons }}\n<table class=\"changes\">\n  <tr>\n    <th>Benchmark</th>\n    <th>Env</th>\n    <th class=\"numeric\">Base</th>\n    <th class=\"numeric\">Head</th>\n    <th class=\"numeric\">Change</th>\n    <th class=\"numeric\">95% CI</th>\n    <th class=\"numeric\">p-value</th>\n    <th class=\"numeric\">n</th>\n  </tr>\n  {{ range .Comparisons }}\n  <tr>\n    <td>{{ template \"bench\" .Benchmark }}<br /><code>{{ .Benchmark.Package.ImportPath }}</code></td>\n    <td class=\"env\"><code class=\"env {{ .Environment }}\">{{ .Environment }}</code></td>\n    <td class=\"numeric\">{{ printf \"%.2f\" .Base.Mean }} &plusmn; {{ printf \"%.2f\" .Base.Stddev }}</td>\n    <td class=\"numeric\">{{ printf \"%.2f\" .Head.Mean }} &plusmn; {{ printf \"%.2f\" .Head.Stddev }}</td>\n    {{ if not .Significant -}}\n    <td class=\"numeric empty\">~</td>\n    {{- else if .PercentKnown -}}\n    <td class=\"numeric change {{ .Type }}\">{{ printf \"%+.2f\" .Percent }}%</td>\n    {{- else -}}\n    <td class=\"numeric empty\">n/a</td>\n    {{- end }}\n    {{ if .PercentCIKnown -}}\n    <td class=\"numeric\">[{{ printf \"%.2f\" .PercentCI.Lower }}%, {{ printf \"%.2f\" .PercentCI.Upper }}%]</td>\n    {{- else -}}\n    <td class=\"numeric empty\">n/a</td>\n    {{- end }}\n    <td class=\"numeric\">{{ printf \"%.3g\" .PValue }}</td>\n    <td class=\"numeric\">{{ .Base.N }}+{{ .Head.N }}</td>\n  </tr>\n  {{ end }}\n</table>\n{{ else }}\n<p class=\"empty\">No benchmarks with results at both commits.</p>\n{{ end }}\n\n{{ end }}\n"),
 	"templates/file.gohtml":              []byte("{{ define \"title\" }}File {{ .File.UUID }}{{ end }}\n\n{{ define \"content\" }}\n<h1>File {{ .File.UUID }}</h1>\n<pre>\n  {{ range .Lines -}}\n  <span class=\"ln\" id=\"L{{ .Num }}\">{{ .Num }}</span>\n  {{- if .Highlight -}}\n  <span class=\"hl\">{{ .Contents }}</span>\n  {{- else -}}\n  {{ .Contents }}\n  {{- end }}\n  {{ end }}\n</pre>\n{{ end }}\n"),
 	"templates/index.gohtml":             []byte("{{ define \"title\" }}Go Performance Dashboard{{ end }}\n\n{{ define \"content\" }}\n<h1>Change Highlights</h1>\n\n<p class=\"note\">The following list shows a selection of the most significant\nrecent changes, sorted by max percentage change observed. See the <a\nhref=\"/chgs/\">changes page</a> for a more extensive list in <code>git\nlog</code> order.</p>\n\n{{ range .CommitChangeGroups }}\n<h2>{{ template \"sha\" .SHA }} <code>{{ .Subject }}</code></h2>\n{{ template \"changes\" .Changes }}\n{{ end }}\n\n{{ end }}\n"),
-	"templates/layout/components.gohtml": []byte("{{ define \"mod\" }}<a href=\"/mod/{{ .UUID }}\">{{ .Path }}</a>{{ end }}\n{{ define \"modver\" }}{{ if .Version }}{{ .Version }}{{ else }}<span class=\"empty\">n/a</span>{{ end }}{{ end }}\n{{ define \"pkg\" }}<a href=\"/pkg/{{ .UUID }}\">{{ .ImportPath }}</a>{{ end }}\n{{ define \"bench\" }}<a href=\"/bench/{{ .UUID }}\">{{ .FullName }} {{ .Unit }}</a>{{ end }}\n{{ define \"change\" }}<a href=\"/bench/{{ .Benchmark.UUID }}?c={{ .Change.CommitIndex }}\">{{ .Benchmark.FullName }}{{ template \"sep\" }}{{ .Benchmark.Unit }}</a>{{ end }}\n{{ define \"sha\" }}<a href=\"/commit/{{ . }}\" class=\"code\">{{ slice . 0 10 }}</a>{{ end }}\n{{ define \"commit\" }}{{ template \"sha\" .SHA }}{{ end }}\n{{ define \"file\" }}<a href=\"/file/{{ .UUID }}\" class=\"code\">{{ template \"uuidshort\" .UUID }}</a>{{ end }}\n{{ define \"loc\" }}<a href=\"/file/{{ .File.UUID }}?hl={{ .Line }}#L{{ .Line }}\" class=\"code\">{{ template \"uuidshort\" .File.UUID }}#{{ .Line }}</a>{{ end }}\n\n{{ define \"uuidshort\" }}{{ slice .String 0 8 }}{{ end }}\n{{ define \"sep\" }} <span class=\"sep\">/</span> {{ end }}\n\n\n{{ define \"properties\" }}\n<table class=\"properties\">\n{{ range $key, $value := . }}\n    <tr>\n        <td class=\"key code\">{{ $key }}</td>\n        <td class=\"value\">{{ $value }}</td>\n    </tr>\n{{ end }}\n</table>\n{{ end }}\n\n{{ define \"changes\" }}\n<table class=\"changes\">\n  <tr>\n    <th>Benchmark</th>\n    <th>Env</th>\n    <th class=\"numeric\">Effect Size</th>\n    <th class=\"numeric\">Pre</th>\n    <th class=\"numeric\">Post</th>\n    <th class=\"numeric\">Change</th>\n  </tr>\n  {{ range . }}\n  <tr>\n    <td>{{ template \"change\" . }}<br /><code>{{ .Benchmark.Package.ImportPath }}</td>\n    <td class=\"env\"><code class=\"env {{ .Environment }}\">{{ .Environment }}</code></td>\n    <td class=\"numeric\">{{ printf \"%+.2f\" .EffectSize }}</td>\n    <td class=\"numeric\">{{ printf \"%.2f\" .Pre.Mean }}</td>\n    <td class=\"numeric\">{{ printf \"%.2f\" .Post.Mean }}</td>\n    <td class=\"numeric change {{ .Type }}\">{{ printf \"%.2f\" .Percent }}%</td>\n  </tr>\n  {{ end }}\n</table>\n{{ end }}\n\n{{ define \"googleanalytics\" }}\n<script async src=\"https://www.googletagmanager.com/gtag/js?id={{ . }}\"></script>\n<script>\n  window.dataLayer = window.dataLayer || [];\n  function gtag(){dataLayer.push(arguments);}\n  gtag('js', new Date());\n  gtag('config', '{{ . }}');\n</script>\n{{ end }}\n"),
+	"templates/layout/components.gohtml": []byte("{{ define \"mod\" }}<a href=\"/mod/{{ .UUID }}\">{{ .Path }}</a>{{ end }}\n{{ define \"modver\" }}{{ if .Version }}{{ .Version }}{{ else }}<span class=\"empty\">n/a</span>{{ end }}{{ end }}\n{{ define \"pkg\" }}<a href=\"/pkg/{{ .UUID }}\">{{ .ImportPath }}</a>{{ end }}\n{{ define \"bench\" }}<a href=\"/bench/{{ .UUID }}\">{{ .FullName }} {{ .Unit }}</a>{{ end }}\n{{ define \"change\" }}<a href=\"/bench/{{ .Benchmark.UUID }}?c={{ .Change.CommitIndex }}\">{{ .Benchmark.FullName }}{{ template \"sep\" }}{{ .Benchmark.Unit }}</a>{{ end }}\n{{ define \"sha\" }}<a href=\"/commit/{{ . }}\" class=\"code\">{{ slice . 0 10 }}</a>{{ end }}\n{{ define \"commit\" }}{{ template \"sha\" .SHA }}{{ end }}\n{{ define \"file\" }}<a href=\"/file/{{ .UUID }}\" class=\"code\">{{ template \"uuidshort\" .UUID }}</a>{{ end }}\n{{ define \"loc\" }}<a href=\"/file/{{ .File.UUID }}?hl={{ .Line }}#L{{ .Line }}\" class=\"code\">{{ template \"uuidshort\" .File.UUID }}#{{ .Line }}</a>{{ end }}\n\n{{ define \"uuidshort\" }}{{ slice .String 0 8 }}{{ end }}\n{{ define \"sep\" }} <span class=\"sep\">/</span> {{ end }}\n\n\n{{ define \"properties\" }}\n<table class=\"properties\">\n{{ range $key, $value := . }}\n    <tr>\n        <td class=\"key code\">{{ $key }}</td>\n        <td class=\"value\">{{ $value }}</td>\n    </tr>\n{{ end }}\n</table>\n{{ end }}\n\n{{ define \"changes\" }}\n<table class=\"changes\">\n  <tr>\n    <th>Benchmark</th>\n    <th>Env</th>\n    <th class=\"numeric\">Effect Size</th>\n    <th class=\"numeric\">Pre</th>\n    <th class=\"numeric\">Post</th>\n    <th class=\"numeric\">Change</th>\n    <th class=\"numeric\">95% CI</th>\n    <th class=\"numeric\">p-value</th>\n    <th>Confirmation</th>\n    <th>Triage</th>\n  </tr>\n  {{ range . }}\n  <tr>\n    <td>{{ template \"change\" . }}<br /><code>{{ .Benchmark.Package.ImportPath }}</td>\n    <td class=\"env\"><code class=\"env {{ .Environment }}\">{{ .Environment }}</code></td>\n    <td class=\"numeric\">{{ printf \"%+.2f\" .EffectSize }}</td>\n    <td class=\"numeric\">{{ printf \"%.2f\" .Pre.Mean }}</td>\n    <td class=\"numeric\">{{ printf \"%.2f\" .Post.Mean }}</td>\n    <td class=\"numeric change {{ .Type }}\">{{ printf \"%.2f\" .Percent }}%</td>\n    {{ if .Significance.Known -}}\n    <td class=\"numeric\">[{{ printf \"%.2f\" .PercentCI.Lower }}%, {{ printf \"%.2f\" .PercentCI.Upper }}%]</td>\n    <td class=\"numeric\">{{ printf \"%.3g\" .PValue }}</td>\n    {{- else -}}\n    <td class=\"numeric empty\">n/a</td>\n    <td class=\"numeric empty\">n/a</td>\n    {{- end }}\n    <td class=\"confirmation {{ .Confirmation }}\">{{ .Confirmation }}</td>\n    <td class=\"triage\">{{ template \"triage\" . }}</td>\n  </tr>\n  {{ end }}\n</table>\n{{ end }}\n\n{{ define \"triage\" }}\n<details>\n  <summary class=\"triage {{ .Triage.State }}\">{{ .Triage.State }}</summary>\n  {{ with .Triage.IssueURL }}<a href=\"{{ . }}\">issue</a>{{ end }}\n  {{ with .Triage.Note }}<p>{{ . }}</p>{{ end }}\n  {{ if triageeditable }}\n  <form class=\"triage\" method=\"post\" action=\"/triage/\">\n    <input type=\"hidden\" name=\"benchmark\" value=\"{{ .Benchmark.UUID }}\" />\n    <input type=\"hidden\" name=\"environment\" value=\"{{ .EnvironmentUUID }}\" />\n    <input type=\"hidden\" name=\"commit_index\" value=\"{{ .CommitIndex }}\" />\n    <label>State\n      <select name=\"state\">\n        {{ $state := .Triage.State }}\n        {{ range triagestates }}<option value=\"{{ . }}\"{{ if eq . $state }} selected{{ end }}>{{ . }}</option>{{ end }}\n      </select>\n    </label>\n    <label>Issue <input type=\"url\" name=\"issue\" value=\"{{ .Triage.IssueURL }}\" /></label>\n    <label>Note <textarea name=\"note\">{{ .Triage.Note }}</textarea></label>\n    <input type=\"submit\" value=\"Save\" />\n  </form>\n  {{ end }}\n</details>\n{{ end }}\n\n{{ define \"googleanalytics\" }}\n<script async src=\"https://www.googletagmanager.com/gtag/js?id={{ . }}\"></script>\n<script>\n  window.dataLayer = window.dataLayer || [];\n  function gtag(){dataLayer.push(arguments);}\n  gtag('js', new Date());\n  gtag('config', '{{ . }}');\n</script>\n{{ end }}\n"),
 	"templates/layout/main.gohtml":       []byte("{{ define \"main\" }}\n<!DOCTYPE html>\n<html>\n  <head>\n    {{ template \"googleanalytics\" \"UA-165439096-1\" }}\n    <link href=\"https://fonts.googleapis.com/css?family=Work+Sans:600|Roboto:400,700|Source+Code+Pro\" rel=\"stylesheet\" />\n    <link href=\"{{ static \"css/style.css\" }}\" rel=\"stylesheet\" />\n    <link rel=\"icon\" href=\"{{ static \"img/favicon.ico\" }}\" type=\"image/x-icon\" />\n    {{ block \"head\" . }}{{ end }}\n    <title>{{ block \"title\" . }}{{ end }} - GoPerf</title>\n  </head>\n  <body>\n    <header>\n      <nav>\n        <img class=\"logo\" src=\"{{ static \"img/go-logo-white.svg\" }}\" alt=\"Go\" />\n        <a href=\"/\" class=\"banner\">Performance Dashboard <em class=\"badge\">unofficial</em></a>\n        <ul class=\"menu\">\n          <li><a href=\"/chgs/\">Changes</a></li>\n          <li><a href=\"/mods/\">Modules</a></li>\n          <li><a href=\"/about/\">About</a></li>\n        </ul>\n      </nav>\n    </header>\n    <main>\n    {{ block \"content\" . }}{{ end }}\n    </main>\n  </body>\n</html>\n{{ end }}\n"),
 	"templates/mod.gohtml":               []byte("{{ define \"title\" }}{{ .Module.Path }}{{ end }}\n\n{{ define \"content\" }}\n<h1>Module {{ .Module.Path }}</h1>\n\n<dl class=\"meta\">\n  <div><dt>Version</dt> <dd>{{ template \"modver\" .Module }}</dd></div>\n</dl>\n\n<table>\n  <tr>\n    <th>Package</th>\n  </tr>\n  {{ range .Packages }}\n  <tr>\n    <td>{{ template \"pkg\" . }}</td>\n  </tr>\n  {{ end }}\n</table>\n{{ end }}\n"),
 	"templates/mods.gohtml":              []byte("{{ define \"title\" }}Modules{{ end }}\n\n{{ define \"content\" }}\n<h1>Modules</h1>\n<table>\n  <tr>\n    <th>Module</th>\n    <th>Version</th>\n  </tr>\n  {{ range .Modules }}\n  <tr>\n    <td>{{ template \"mod\" . }}</td>\n    <td>{{ template \"modver\" . }}</td>\n  </tr>\n  {{ end }}\n</table>\n{{ end }}\n"),
 	"templates/pkg.gohtml":               []byte("{{ define \"title\" }}{{ .Package.ImportPath }}{{ end }}\n\n{{ define \"content\" }}\n<h1>Package {{ .Package.ImportPath }}</h1>\n\n<dl class=\"meta\">\n  <div><dt>Module</dt><dd>{{ template \"mod\" .Package.Module }}</dd></div>\n  <div><dt>Version</dt><dd>{{ template \"modver\" .Package.Module }}</dd></div>\n</dl>\n\n<ul>\n</ul>\n<table>\n  <tr>\n    <th>Benchmark</th>\n    <th>Units</th>\n  </tr>\n  {{ range .BenchmarkGroups }}\n  <tr>\n    <td>{{ .Name }}</td>\n    <td>\n      {{ range $i, $bench := .Units }}\n      {{ if ne $i 0 }}&middot;{{ end }}\n      <a href=\"/bench/{{ $bench.UUID }}\">{{ $bench.Unit }}</a>\n      {{ end }}\n    </td>\n  </tr>\n  {{ end }}\n</table>\n{{ end }}\n"),
 	"templates/result.gohtml":            []byte("{{ define \"title\" }}{{ .Result.Benchmark.FullName }} Result{{ end }}\n\n{{ define \"content\" }}\n\n<h1>{{ .Result.Benchmark.FullName }} {{ template \"sep\" }} Result</h1>\n\n<dl class=\"meta\">\n  <div><dt>Benchmark</dt><dd>{{ template \"bench\" .Result.Benchmark }}</dd></div>\n  <div><dt>Package</dt><dd>{{ template \"pkg\" .Result.Benchmark.Package }}</dd></div>\n  <div><dt>Module</dt><dd>{{ template \"mod\" .Result.Benchmark.Package.Module }}</dd></div>\n  <div><dt>Version</dt><dd>{{ template \"modver\" .Result.Benchmark.Package.Module }}</dd></div>\n  <div><dt>Commit</dt><dd>{{ template \"commit\" .Result.Commit }}</dd></div>\n  <div><dt>Source</dt><dd>{{ template \"loc\" .Result }}</dd></div>\n</dl>\n\n{{ with .Quantity }}\n<div class=\"bignumber\">\n    <p class=\"number\">{{ .FormatValue }}</p>\n    <p class=\"unit\">{{ .Unit }}</p>\n</div>\n{{ end }}\n\n{{ with .Result }}\n{{ if .Environment }}\n<h2>Environment</h2>\n{{ template \"properties\" .Environment }}\n{{ end }}\n\n{{ if .Metadata }}\n<h2>Metadata</h2>\n{{ template \"properties\" .Metadata }}\n{{ end }}\n{{ end }}\n\n{{ end }}\n"),
-	"static/css/style.css":               []byte("/*\n\nStyle derived from https://pkg.go.dev/static/css/stylesheet.css and\nhttps://blog.golang.org/go-brand.\n\nOriginal work is Copyright 2019 The Go Authors and BSD-3 licensed\n(https://golang.org/LICENSE).\n\n*/\n\n/* Palette generated by make_palette.go. DO NOT EDIT. */\n:root {\n  --aqua: #00a29c;\n  --aqua-1: #00dcd4;\n  --aqua-2: #17fff6;\n  --aqua-3: #51fff9;\n  --aqua-4: #8bfffb;\n  --aqua-5: #c5fffd;\n  --black: #000000;\n  --black-1: #2b2b2b;\n  --black-2: #555555;\n  --black-3: #808080;\n  --black-4: #aaaaaa;\n  --black-5: #d5d5d5;\n  --cool-gray: #dbd9d6;\n  --cool-gray-1: #e1dfdd;\n  --cool-gray-2: #e7e6e4;\n  --cool-gray-3: #edeceb;\n  --cool-gray-4: #f3f2f1;\n  --cool-gray-5: #f9f9f8;\n  --fail: #ff5630;\n  --fail-1: #ff7253;\n  --fail-2: #ff8e75;\n  --fail-3: #ffab98;\n  --fail-4: #ffc7ba;\n  --fail-5: #ffe3dd;\n  --fuchsia: #ce3262;\n  --fuchsia-1: #d6547c;\n  --fuchsia-2: #de7696;\n  --fuchsia-3: #e799b0;\n  --fuchsia-4: #efbbcb;\n  --fuchsia-5: #f7dde5;\n  --gopher-blue: #00add8;\n  --gopher-blue-1: #0aceff;\n  --gopher-blue-2: #3bd8ff;\n  --gopher-blue-3: #6ce2ff;\n  --gopher-blue-4: #9debff;\n  --gopher-blue-5: #cef5ff;\n  --light-blue: #5dc9e2;\n  --light-blue-1: #78d2e7;\n  --light-blue-2: #93dbec;\n  --light-blue-3: #aee4f1;\n  --light-blue-4: #c9edf5;\n  --light-blue-5: #e4f6fa;\n  --pass: #36b37e;\n  --pass-1: #4eca95;\n  --pass-2: #71d4aa;\n  --pass-3: #95dfbf;\n  --pass-4: #b8ead5;\n  --pass-5: #dcf4ea;\n  --purple: #402b56;\n  --purple-1: #604080;\n  --purple-2: #7f56aa;\n  --purple-3: #9f80c0;\n  --purple-4: #bfaad5;\n  --purple-5: #dfd5ea;\n  --slate: #555759;\n  --slate-1: #707376;\n  --slate-2: #8c8f92;\n  --slate-3: #a9abad;\n  --slate-4: #c6c7c8;\n  --slate-5: #e2e3e4;\n  --turquoise: #00758d;\n  --turquoise-1: #00a8cb;\n  --turquoise-2: #09d5ff;\n  --turquoise-3: #46e0ff;\n  --turquoise-4: #84eaff;\n  --turquoise-5: #c2f5ff;\n  --warn: #ffab00;\n  --warn-1: #ffb92b;\n  --warn-2: #ffc755;\n  --warn-3: #ffd580;\n  --warn-4: #ffe3aa;\n  --warn-5: #fff1d5;\n  --yellow: #fddd00;\n  --yellow-1: #ffe429;\n  --yellow-2: #ffe954;\n  --yellow-3: #ffef7f;\n  --yellow-4: #fff4a9;\n  --yellow-5: #fffad4;\n}\n\nhtml {\n  height: 100%;\n}\n\nbody {\n  font-family: Roboto, Arial, sans-serif;\n  line-height: 1.5;\n  margin: 0;\n  padding-bottom: 2em;\n}\n\na,\na:link,\na:visited {\n  color: var(--turquoise);\n  text-decoration: none;\n}\n\na:hover {\n  text-decoration: underline;\n}\n\nh1,\nh2,\nh3,\nh4,\nh5,\nh6 {\n  font-family: \"Work Sans\", Arial, sans-serif;\n  margin: 1.5rem 0 0.5rem;\n}\n\nh1,\nh2,\nh3 {\n  font-weight: bold;\n}\n\nh1 {\n  font-size: 1.728rem;\n}\n\nh2 {\n  font-size: 1.44rem;\n}\n\nh3 {\n  font-size: 1.2rem;\n}\n\np {\n  font-size: 1rem;\n}\n\ncode,\npre,\n.code {\n  font-family: \"Go Mono\", \"Source Code Pro\", monospace;\n}\n\npre {\n  background-color: var(--cool-gray-4);\n  overflow-x: auto;\n  padding: 0.625rem;\n  border-radius: 0.3em;\n  border: 0.0625rem solid var(--cool-gray);\n}\n\n.ln {\n  color: var(--slate);\n  display: inline-block;\n  text-align: right;\n  width: 6ch;\n  padding-right: 2ch;\n  user-select: none;\n}\n\n.hl {\n  background-color: var(--yellow);\n}\n\ntable {\n  border-collapse: collapse;\n  width: 100%;\n  margin: 1rem 0;\n}\n\ntd,\nth {\n  border-bottom: 1px solid var(--cool-gray);\n  padding: 0.75rem 0;\n  padding-right: 1rem;\n}\n\nth {\n  color: var(--slate);\n  text-align: left;\n}\n\ntable code {\n  color: var(--slate);\n}\n\ntable .numeric {\n  text-align: right;\n}\n\n.sep {\n  color: var(--slate);\n  font-weight: bold;\n}\n\nheader {\n  background: var(--black-1);\n  border: none;\n  margin: 0;\n  padding: 0;\n}\n\nheader nav {\n  margin: 0 auto;\n  padding: 0;\n  max-width: 72em;\n  display: flex;\n  justify-content: flex-end;\n}\n\nnav .logo {\n  display: block;\n  width: 5rem;\n  margin: 0;\n  padding: 0;\n}\n\nnav a.banner {\n  display: block;\n  color: white;\n  font-size: 1.44rem;\n  margin: auto;\n  margin-left: 1rem;\n  text-decoration: none;\n}\n\nnav .badge {\n  display: inline-block;\n  font-size: 0.8rem;\n  font-style: normal;\n  vertical-align: middle;\n  border-radius: 0.3rem;\n  padding: 0.2rem;\n  background-color: var(--fuchsia);\n}\n\nnav ul.menu {\n  display: flex;\n  justify-content: flex-end;\n  list-style: none;\n  margin: 0 6em;\n  padding: 0;\n}\n\nnav ul.menu li {\n  display: inline-flex;\n  flex: none;\n  margin: 0;\n  padding: 0;\n}\n\nnav ul.menu li a {\n  border-bottom: 5px solid transparent;\n  border-top: 5px solid transparent;\n  color: white;\n  display: inline-block;\n  margin: 0 15px;\n  padding: 20px 15px;\n  text-align: center;\n  text-decoration: none;\n  width: 100%;\n}\n\nnav ul.menu li a:hover {\n  border-top-color: var(--fuchsia);\n  background-color: var(--black-2);\n}\n\nmain {\n  margin: 0 auto;\n  max-width: 60em;\n}\n\ndetails summary {\n  cursor: pointer;\n}\n\n.note,\n.warn {\n  border-left-width: 5px;\n  border-left-style: solid;\n  padding: 1rem;\n}\n\n.note {\n  background-color: var(--light-blue-5);\n  border-left-color: var(--light-blue);\n}\n\n.warn {\n  background-color: var(--yellow-5);\n  border-left-color: var(--yellow);\n}\n\n.empty {\n  font-style: italic;\n  color: var(--slate-2);\n}\n\nfigure {\n  background-color: var(--cool-gray-4);\n  margin: 0;\n  padding: 1em;\n}\n\nfigure img {\n  margin-left: 1em;\n}\n\nfigure img:first-child {\n  margin-left: 0;\n}\n\nfigure figcaption {\n  color: var(--slate);\n  margin-top: 0.5em;\n}\n\ndiv.chart {\n  width: 100%;\n  height: 400px;\n}\n\ndl.meta div {\n  display: inline-block;\n  padding-right: 0.5rem;\n}\n\ndl.meta dt,\ndl.meta dd {\n  display: inline-block;\n  padding: 0;\n  margin: 0;\n}\n\ndl.meta div:not(:last-child)::after {\n  content: \"\\b7\";\n  padding-left: 0.6rem;\n}\n\ndl.meta dt::after {\n  content: \":\";\n  padding-right: 0.3rem;\n}\n\n.bignumber {\n  text-align: center;\n}\n\n.bignumber .number {\n  font-size: 10rem;\n  margin: 0.2em 0;\n}\n\n.bignumber .unit {\n  font-size: 5rem;\n  color: var(--slate);\n  margin-top: 0;\n}\n\ntable.properties td.key {\n  color: var(--slate);\n  white-space: nowrap;\n  font-weight: bold;\n  text-transform: lowercase;\n}\n\ntable.properties td.value {\n  overflow-wrap: anywhere;\n}\n\ntable.changes .numeric {\n  width: 5rem;\n}\n\ntable.changes .change {\n  font-weight: bold;\n}\n\ntable.changes .regression {\n  color: var(--fail);\n}\n\ntable.changes .improvement {\n  color: var(--pass);\n}\n\ntable.changes .unknown {\n  color: var(--warn);\n}\n\ntable.changes td.env {\n  width: 3rem;\n}\n\ncode.env {\n  border-radius: 0.2rem;\n  font-size: 0.7rem;\n  padding: 0.2rem;\n}\n\ncode.env.amd64 {\n  color: white;\n  background-color: var(--black-2);\n}\n\ncode.env.arm64 {\n  color: white;\n  background-color: #0091bd;\n}\n"),
-	"static/img/closet.jpg":              []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x01\x00H\x00H\x00\x00\xff\xe1R\xc2Exif\x00\x00II*\x00\b\x00\x00\x00\v\x00\x0f\x01\x02\x00\a\x00\x00\x00\x92\x00\x00\x00\x10\x01\x02\x00\b\x00\x00\x00\x9a\x00\x00\x00\x12\x01\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x1a\x01\x05\x00\x01\x00\x00\x00\xa2\x00\x00\x00\x1b\x01\x05\x00\x01\x00\x00\x00\xaa\x00\x00\x00(\x01\x03\x00\x01\x00\x00\x00\x02\x00\x00\x001\x01\x02\x00\f\x00\x00\x00\xb2\x00\x00\x002\x01\x02\x00\x14\x00\x00\x00\xbe\x00\x00\x00\x13\x02\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00i\x87\x04\x00\x01\x00\x00\x00\xd2\x00\x00\x00%\x88\x04\x00\x01\x00\x00\x00\xbeQ\x00\x00\x00\x00\x00\x00Google\x00\x00Pixel 3\x00H\x00\x00\x00\x01\x00\x00\x00H\x00\x00\x00\x01\x00\x00\x00GIMP 2.8.22\x002020:05:08 19:45:07\x00&\x00\x9a\x82\x05\x00\x01\x00\x00\x00\xa0\x02\x00\x00\x9d\x82\x05\x00\x01\x00\x00\x00\xa8\x02\x00\x00\"\x88\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00'\x88\x03\x00\x01\x00\x00\x00\x10\x04\x00\x00\x00\x90\a\x00\x04\x00\x00\x000231\x03\x90\x02\x00\x14\x00\x00\x00\xb0\x02\x00\x00\x04\x90\x02\x00\x14\x00\x00\x00\xc4\x02\x00\x00\x01\x91\a\x00\x04\x00\x00\x00\x01\x02\x03\x00\x01\x92\n\x00\x01\x00\x00\x00\xd8\x02\x00\x00\x02\x92\x05\x00\x01\x00\x00\x00\xe0\x02\x00\x00\x03\x92\n\x00\x01\x00\x00\x00\xe8\x02\x00\x00\x04\x92\n\x00\x01\x00\x00\x00\xf0\x02\x00\x00\x05\x92\x05\x00\x01\x00\x00\x00\xf8\x02\x00\x00\x06\x92\x05\x00\x01\x00\x00\x00\x00\x03\x00\x00\a\x92\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\t\x92\x03\x00\x01\x00\x00\x00\x10\x00\x00\x00\n\x92\x05\x00\x01\x00\x00\x00\b\x03\x00\x00|\x92\a\x00oN\x00\x00\x10\x03\x00\x00\x90\x92\x02\x00\a\x00\x00\x00\x80Q\x00\x00\x91\x92\x02\x00\a\x00\x00\x00\x88Q\x00\x00\x92\x92\x02\x00\a\x00\x00\x00\x90Q\x00\x00\x00\xa0\a\x00\x04\x00\x00\x000100\x01\xa0\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa0\x04\x00\x01\x00\x00\x00\xc8\x01\x00\x00\x03\xa0\x04\x00\x01\x00\x00\x00V\x01\x00\x00\x05\xa0\x04\x00\x01\x00\x00\x00\xa0Q\x00\x00\x17\xa2\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\x01\xa3\a\x00\x01\x00\x00\x00\x01\x00\x00\x00\x01\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x03\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x04\xa4\x05\x00\x01\x00\x00\x00\x98Q\x00\x00\x05\xa4\x03\x00\x01\x00\x00\x00\x1b\x00\x00\x00\x06\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\b\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\t\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\n\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\f\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00{\x04\x01\x00@B\x0f\x00\xb4\x00\x00\x00d\x00\x00\x002020:04:10 21:28:40\x002020:04:10 21:28:40\x00\x87\x01\x00\x00d\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00\xea\xfe\xff\xffd\x00\x00\x00\x00\x00\x00\x00\x06\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00\xdd\x01\x00\x00\xe8\x03\x00\x00X\x11\x00\x00\xe8\x03\x00\x00HDRP\x02\xefd5m^p\x1e,\xea\xe3LW@\x88Q\xd7\xce5k\x05\xf3z\xa5?dU*\xbd\v\xa2ߐ\xb5\xb2\xf3r\xd7\xc1\x17\x14)\xff\xf6\x01\x97@y\xeef\xa1e\a\x92\xeb\xd3%a\xe8\a)\xa7\x05A\xfet\x99b \xcbZ+\x19\xb5\x1d\x91\x80*\x17d\x81\xb2/5e\x12\u007f?\f\x9c\xfa\xb0\x86F4\xfd\xe7\x9dX\xc3\xfe\x9c\xa0K\xb9jTim\xcd\xd2\xe0e\xa4\xa7#\x99\xc0:\u007f\xfc\xc7\x1c\xaf\xd1G\x1c\x82\xdfP\xf9\x04\x06\xe9X\xfe|\x90e\x9c1!\xfa\xc2\x13 \xc7\xf5\xe7\xa2WD\xfc\xa2F\x96\xcd3\x91K\xab\xa3\xc7\xdb\xf0\xb2D\x92\xda\xc7z\b\x9c\xcc\x18<d\\\xb0\x0f\x98\x1dQ]\xaf\xf2\x82\xe5\xc0\xe2\x9a\x11]\xf3C\x0e%\xa9\x9a\xbd\xb4\xa3\xe7\x11\xcbA=\xf7\xa4\xd1I\xc4\x03+P\xea\x92p\x98\b\x9fb*\xf4$&\xb6\xa6\xec\xadѩh\xe1\x9a\xf9%]>\xd6)\x88\x9f\xa1\x95\x91\x89\xdf\xfddiϊrMr\xbfG\x80\x98\xe5\xec廀/-\xc6[\x19\xc7t\t\xa9z\x8c\r\xbd)\b\xe9\x84\u007f\xd0\xf0\xd6\xf3\f\xc3\\\xed\xfb\x84\x89\xe0r5\x0e\xfa<\x1cO\xd9ԣ\xea\x18ݻ/\x92\x06\x85\xa2\xf1\b\xdcEސX~\xb0\xeb\x05\xc9Z\x82}\xfc\xa8 \xd3A\"h/\xfc\xc2)\x94\xcaJ4V\x99\x0e\x97s\xf6\x1e\xbe\x96\u007f\x8b\x1a\xa9V'M\xf1\x04\xa4ŽWA3\x91\x96;ޠ1\x13\xbe\x90\f3\xe4N\x05\xc0z!\xf7m\xc1D\xa8\xf7K\xe64\x90\xc4\xec\x80;~\x8bQ\xac\x8f\xfa\x8c\fs\xec5WZ\u007f)\xe7\xc8^\x9aؕ\xb0\a^\x95f\xdde\n]\xc0Ȩ\xf4T\x9d\xa5\x8a<\x16\x10 aÃ\xfd\xfb\xaa\xbc\xc2\xe7\xb0,\xad6\x97V9\xa0\x19\xd5Rı\xcf\xffA\xc4\xe8>U.\xce\xf7>\v7\xc4Ϩ\xc3 j\xe0\rF;E\x1f\x86\x90\xd2\xde\f\x82\xb2\xa1\\.\xf8\x96\x1e\x01\x04\xe1\x81I\xe7݊\x16\xba,\xd4\f\xcbT|\xb1\r\x8f8B\xeb\xd6.\x17d\x92:\x15\xfdRo^ɜ+\xbb\x8d\xa7\vy\xbd\xfa\xbct\xec/\xef\x11\xf0\x87\xa2\x1b\x10\x0f\xd8q\x1f\x82ֲ\x13\x91Q\x16\\I\x97\xfez\xa4\\\xe7:=\x8f\xc1+\xf5+\x87:/\x89yht\xf9\x9bT\xf4\xda=u\xe0\x9d\xf0\x82y!\xdf\xef\x16\xcb\xd8H\xb2\x1az\xc5\x1b\xfc\x9ez^%\x01Y\x8cϐ\xe7\x91\xd9\x00\xb4x5A\xe0\xb2\xf7\xb4\\\xa2\x89\x93\xbb\xf7D٧\x1c:\\w\x1f\xef\xe0\xcc\xc9\xc68\xd41\xb6\x97ME\x00\x84R\xfa?\x0e[w/\xbf\xca\xfa\xa5\x17\xcc\xf5!1C\x9a\xb3\xb0X\x9aA\r\x10\xacK\x17\xec\x1a\xfa\xf6~\x90\xf0\xdd\xce\xf2<K\xb76A\xe9\xccPl=B\xc1\xe9\xf3*@B\xecd\xa6d\xb4P\\\xf7\xf3@\xabd\x9e\xb5yS\xc6F\x11\x05h\x17\xa7߭ͻ\x93%\xd2\t\xa37Ev\xcc/I\xed\v\xb0\xf4\x94\xca5_$\xb6\x00:g\x17\xd1\xc4f\x8a\x063\x90\xb6<\xe5I\xf4G\x9f\xabAD\xa9\xa3z\x8e\x8a0A\x90x%\xaa\x97\xd6$\x05\xeaϦ\xecڜ<A\xf4\xf0\x17\xb4+\xa8Kq!\xffI\x97QJrzq&(\xef@\xfb\xd8ろ\x1a\x16\xa4\xec\xfeK\x80ǶP\x03g)1tnL\xc1\x17\x12Q\x1b\x86\xf8\xc5\xc8~\xbb\xc9\xf0\xcb\r\xb7Mq\xddXXPӑj_\xe2\xf8\x81\x81Cu0\xb8\n8\xdf!3\x80U\xa0\xed\xef\x18\xe0|\xe1\xe1/R_\xb2\xc2B\xf7\xdc/\xe1\xff-\xe3\x96\x14b\xbe\x19%\\\x86\x0e\xd3I\x81\xde\x1b\x91 vH\xc9T\x0f8\xc19\xe8\xca\xe2O\xb3\x8d7N\xf2l\xa7\xc0\xe9\xcb\"0\x80\x95߉\xec\a\xa4\x13ȣ\x93z\x80\xe8Q\x15\x98&\x12\x14\x82\x8fy\xfewd\xa4sos\xf1>\x95j}\x89\xfcDD\x0e\x8fZD7FWL\xd0\xe2\xf7\x0e\x15\x9cbҪ\xa9\x8c\x87\x10\xe67\x03g\xb4$L\x13\x96O\x8b\xa2\x89z'%\b\xcf0m_\xfe\x8fd${\x8cr\x0e\xde\xc4\xd9;&\x06\xe1j\xc0\x81\xb1\xb7\aPR\x8d\xb2p\xe3\xfauf\xdeB\x10\x0eʤݤ\xe8\x1c\xa0\x81\x86\x13]w:k\xa4\x88\x9a\xb8\xe2}N\xdctzA\xe8\r=\xe7B\xb8\xf6\xba\xf1u^-\x0f3lB\x87n/\x83P>˗b\x91o\xf1\x17\xbd\xf6\xae\xaaf\r\xae\\\xeb\xed\xa3\x14\xc6\xc6\xe1\x86\xf28\x82\x10\xc0Ln\xbf\x9a\xca5S\x00\x8eq\v\x8c\x01\x8e\xb7\xb2f\xda\xfaf\xec\t\x83\xc2\xd1\xdd\xf6\x86\xfb\xe0\xed{\xa2\x14\x17x\xb7\x06\xbfI\xfa\a\x06\x82T8\xbb\xee\xbb\xfa\x98\x19pwi\xdf\x0e\x1eri\xaa\f6\x04\xf5rA\xa7\t\xa9\x19\xeeW,\xafzYY\x05x\xf7\xd0\xdd\x1d\x99\xfc\x93\xa5e\x0f\x91s\x8f\xafy\xf99\x8f\x8es 3\xa6\xff\v\xfa\x1d\xbb\xb1x\x94l#mXF\xa7k\xe7{-c\xab\x177?ek\xa7\xd3@]#\xb1w\xe3\xfeV\x06\u0601g\x12D\x13\xdc\xe3\f\xc5#\x82\xc5\xfbp\xf8\r\x00\xe0\xf2\x9c@\xca\xd2v\x84\xdb\x05\xf1B\xf6\xf1H\xb1#)\r:䗦\xfe\xc5\\\x92\xabm\xeb\xd9\xe5\xd9;\x14\x8c\xdd\x05,a\x14g\x05\xb5k\xf0vh\xc4\xef\x13}k\xb9\x9b\x9c\xaebD(e\xde\xe6\x9a\xe6'\xe1\xdcFK\xdcз\xdc\xe5%\x02h\xb3\xd4G\xac\n\xf2\xed\xab\xb0\x14\x895\xffY[\xe6N\x9a\xa4\x04\x98\xf8\xafMr\xc9B\x9czF\x1e\xba\x98\u007f\xac\u007f\xe8\xff\xc4\x13\xdd\xceZ\x89k\x18\xd1^\xc2\x02\t\x19\x9e\xef\xbd~\xb2\xd7\xe9\x94\xce \"\xd4[\f\x02\x80\xdd/\x1fU\xbec\x11\xf47U\xf2_\x93\x86p8k\xe7\x0e\x9b\xfbDKH[\xab\xf6\xdb=\x8d\xa3\xb1\xdc@\xf49:|\x8f\x19X\x993\x8e\x88\xa6\xa7a{\x86(\x0e\xb1\xeej\x13\xd6j\xb5\x9c\xe9\xe3\xae\x05\xd6\x0eSc\x9dN\xb7\xb18\xba\xb0\xc9C2\xc3\xf6L*\r\x80\xfePV\xff\xe1\xdb\xd5f1\x1f\xe1h\xdb\xcf\\v\xcbjX\u0082&\x84\xc18\xe8\xf3'n\xd6\x105\xbe\x86-\xf3L\xc7\xd3ra\x82<\xb3\x8eMhd\xa6\x90 ز\x03ʥ[VJV\xd7+\x1c\x93S\xe9\x87\bo]\rn\xe0\xf3^\xa7m\x9eI\\\xd1/\xce\xfa(|\x899S\x89;\x8dbԄ\x93j$ \x92\xea,E\x1f\xa4\x92|ZuG\x02\xd9/\xe3%\xaf@y\x81\x90M\xbadA\x8a=\xee\xeak\x01?T\x18\xfe\xfbJţ?\xfa#\xad\x9c\x9f(\xba\x9eT\xe5)\xbd\xfc\xa5\x1a]\x04\\\x10\x06G\x9aǒ\x87\x01\x91\xea\x1c=\xc7\xfb)\xc8\u007f\x0f9qiDI\x986v\xe9-f8\\̈́\x80\xc8\x12\xf1\x02e\xf3H\"o\xb6\xe0t3\xfe\xc5w K\xb0\xd7\xf32X\x06Ƙ&\xf1\xecuUJKr,\x02N&N\xbf\x94:?\xce?a\r\xf1\xd0\xcd\x17\x93x\xa4\xd0\xfd{\x98\xdc\\8\x8bD\x8e\x80'0\x92h\x1a\xae\xcf\xe1\xfa\x86\xeb|\xe6¨\xb2\x97\x881\x0f\x02kSp\xb4\xe8\x13e\x1f\x93\xe7t\xc8\x1d=\xe5\xf8\xed\x95JR\xce\xd0I\b\xc5N\x8f\x12,\x13I\x98\xc2wM\x89J\x1b`\xb78\xbcFl\xe0\x12\x14;\x91O\\\x19\x9e\xab\xf8\x85\xa8n\xb5\xb6\x11\x82\xf6\x0f^ݲs°\xca\xe7\xf5\xd9\xce@\xb6\xcdR\x90'\xc9\"\x1el?1\x98L\xdf&~gZR\f\x19\xd4\x02\xa3yW\xe3\xc6c\x14]l\xd73h(\xefP_p\xb1\xc4}\x80$\x14\xc7\x1bpoA\x96\xce\"\t\x9f\xcbQ\xdb\x14F\xb5ێ7\xb2\x04\xea\xadlsf,\x1b\xb2\xec\x16\x9c̎R\x97܆Q\x9d\xa34ӷ\x90\x9ai1g7\xba2\\\xc2\xe3\x19B'n\xfe.\x8b\xd8\v\xdc\x1b\xd9#\x93;۞\xf6\xa0\n_\xaa\xfa\x9e\r\xff\x06R$x\xa7\xc3\x17;\xf20\xcbB\x1f3\xb97KN\xc2H\x1d\xa9f\xe3O\x8f^\xab\xf2Г֦6\xea7kqF\x9e^\xa2i\xe8`\xed2\xfe\u007f`2m~@?u{\x80\xa7\xa1\x93O%\xe4\xbf\x1c\xbb\b\xfd8\x80G1\xcf\aa\x80E\"\x9a\xcb\x03\xf3t\x9f\xac\xf3\xb9\x01o\xbb\xf4\xbc\x8f\xad\xfc\xbc\xb6NN\x9a\x92\x9f\"\xaa0\xe2/\x88\xe0\x1f\xc7W(M\xca\x19|\xe7f\x02\xd1\xe6\xe3\xd4\x01d\r\u009a\xbf\xf6\xaa\x8d;\r/n`F\xaa\x9c\xf8\xe9!\x0f\x0f{t\a\xcbÔ\xa9\x80.\x15|\xbe`\xb3\xe6\xbb\xedW\xf5\f\xd2w\xc9/¸\x1e\xbd\xdej\xca1\xf3\x12\x89\xde\x1d-\xa4\xb7\x1a\xc8\\Y\xe9\x1d\x97\vR\xd0{\x9bd\x1f\x8c\xbd\x9aܙgb\x15\xc8{\xdeS0\xa1J\xe2E*\xc6Gu\xce\u007fNB\xff(\xed\xcf\xcc\x0f\x93\x10\xe5#A\x80\f5C\x06\xa5S]W\xbf\xa5\x04\xdfh\x1f\x04\x17\x05]*\xaadv\xc3V\xd3\xe3\xf4\x15\xcfC!\xd7\xf2>\xf0S}\xb5& ϋ\xffd\xe3\xe0\xefV\xc5>\x1d\x00\xc1\x17\xfe\xa0\xed\xab\x06R\xd6\xe8eQ\v\xd2n蓓O\xfcT\x0eYE\xa369\xcd\x12\xb1\xaf\xec\xfa\xde\xc1\x8b\x03\xa0\x8fI\xd1H\x9d\xe8\xbb\xdaBi\x80\t5u\x1f\xdeE\x82R\xfb\xfb\x97\xbd\x9a!_e\xb1;ćJ\x02\xbf\x98\x88\xd0\xe3\xdf~\x05\x95\x87\x15\v\x98\xd8Hj\x18sa4:\xcdƼ٪/\xc8\rM\xa7\xbft\x05\xb9\xacg\xfe\\\xba~\xfd\xc1,\xaegR93\x90T\xc4\x01Գ\"\x16\x1e\x97=W\xa2r\xe6]\xb4C\xe5_\x82\x11\xf4\xcdKV\x94\xad\vт\x11x\xfd\x84\xdd\xf0\xba\xc6+Qُ\v\xb7\x1f\xf1\xbe\x8e\xc1\v\x1b\xab\x0e\xbc\x93=.P\x8d\x19\xb14\n9\xbfcH\x19U\xf0\xe6\x1c34\xfb\x83\xd3\xccb+|\xbcR\x15,Os\xe3\x86M\x1cD\x97\xa6\x00\xb65]`\x94\x19k^?\x04؞\xf9\xa3\xee\xe7\x06s\n58\x1dT\x06\t\xc7H\x8d(\x1a' \xefӻ\xd1\f\xfc\xe5ac\xa0:-c{\xf1z\xb7\xf6|\xcfAH\x93؊\x1d\xab\xed\x88\xf9P\x9dc\xa3!\x9f\x1e\x00\xd3\xe4\xe1\x1c\xc1Z\x92t$HZ\xeb\x03j\xc0ǢR\x9aK\x1e\xd9K\xea.\x03\x11~\xb1\xf1빿\"\xce\x10\x19\xa1Ӣb\x05\x90\xf5\xb7\xe0\xf4\xfc+\xd3\xe6\xf2\xef\xe4\x00\xe3\x17\\\xb3τP\xc1rQ\xfbL_\xef']\x8f5\xc2N2ߗ\xc8\xf0\x1et\xdd:\xc98D\xc2\xc2k\xe8q`wr\x12\x19\x17o\x8f\x81\xcbs\xa2:m㚯\xfeHY\xa2\xef\xea\vw\x9e@\xd0F\xfb\x93\xd9_k\xa1T\x8bw)\xeav\x87\xf2\x0fY\xe10\xf9ϛ\xa4B\x16<<\xc7]\xf0Ƞ\xf4#\x05\xa5\a\x94\xf6>\x8b\xf9\x81-,%(Y\xc1`s\xd9pw\x88\xe0\xe4ӭ'\xe0#~?\x9dԷ\xec\xe6\xdb>\xbe\xcc\xe5\xa0\aҜ\xf6\xbb,IĹ\xfa\x95\xe9\xbf\xcc\xf6\xca-h\x87m~\\׳\x89\xe7\xb50D\xe7 !ЮS\x8e\x90\x00^N~;\x9b\xb5\x8d\an\xe5iŻ\x81P\xb4\xb8\x1e\xe9/\xb4\xbc\xe3V\xefq\xb3\xa5D\xff%Z\xc4\x15\xa0\xa2\xe2V\ue94f\x147\x87\xaa\xe6(\xd0\xf1\xeag=\x81\xa1:|ڲ\x02\b\xbd\xe3\xb6k\x02s\xc0O\xb0\xf3\xde\xd6\x15\x16\xe5\xea\x1cysV/\xfe(\xc4.\x95\x8d\xfa\xdf\x0ff\xb1\t\xd0\xf31\x1b5\xd4$1\x04x\x87\xb6v\xda\xf8\xa4q\x1a\x1f\x1a\xd3\x1d$\x06\x98c\xfd\xa1\xe5\x11\xb1\xb3\xca\xf6X\xac\xe4\xc6@\x14\x1f9\xfe\x04\xf6\xab)\xe2\xeb\xba\xc7\x1al\xa9X;\xe0\xaf\xedic]3\x81p.\x9c\xed\xca\x04\x04P\xb3!\xc7\xf8\xe0I\xd4\xeeJ\xbc\xf9:N\xeb\xf0\x8f\xfdq\xcb\xed\x94\xe3\x1f\xff9d\xa4%\xe8\xcaAP\x00\x0e\xc9\vh\xc20\nQ\xb3z\xd1Ba\xf4\xe2\xed\x95j`\xed\xa82'n'\xc1\xc3n#l\xb2\xd7\x1cB\x95\xeb\xfdM\x8c\xc6t\xafֳyH6\x12\x93\x1f\xc7\xefB*_aR\x81-\xa0\r)\xf9\xf9\xb1ӽJQ,Y\xbf\x16\x1f\xe0\x93\xb1\x1a\x155\xf1\xaa\v\x920\xcc!\xfe`ٸ\xa6n\xa8\xdb\x17%\x83\x87\x8ep4\xfbe\x031Ͻ\xe0\x9al^\xa1\x99.A\x96O;\xff\xb3\x19ف\x9fú\xbb\xd1o\xb2\x90\x1c\x8e\xce\a\xf9g\\q\xdf\xcd]7\xed\xbc\xe7\xce\x13x.\r\x02AEb\x1cU\x10t\xe4\xcb\xf1G\v3cU\xa5{\xe1t\xce\xd81h\x93f\xed\xe7\xe5\xb9e\t\v\xa6\x04.\xd5/\xe1\xe9'\x8b\xc9\xf5\xf9ǜ\xfcQ\xe9\xda\xc1\x8a;`:\xdci\x01\xfe=\xfe\xfd\x92\xa1\xf7f\x14nt*\x92\xc68\x93'\xa4\x06\x82\xa2wd\x1e5<\xbe\xfe\x03\xfaŝ#FT\x12zos\xb3\xcfP̭w\xbfG\x18\x12\x89\x83\x04\xe5\xdb-\x05\xc3Ql\x13\xe4\xdbQ\x92\x8b\xb0\xcd\xd8\uf6af?ә\xe4]JDa\x96@\xc1\xda\xfd\x83j\x1fx\xa7\xab\x9d\x15{\xa9\x1e\xaf'\xb4\x01%@M\a\xf6M\xb2\xab\x88҉\xfc\x8f\xff{\x06^\xb3\xae\xa8\xac\x95\xa5\x03\xbe\xa4\xee 涯|\xad\x92\xdaSV5\xc5\x17?~\xed\xa0O\x89\xbc\x9c\xab\xee\xc3\xe2\xec2Q\xe0\x1b\u007f\x131Cc\xb2\xa3\x03\x18\xf8\x9cWr<#\xed\x8dW\xbf6I\xf9j4\x93\x94\x8a\xab\x03+\xb3\xb7\x16\xe9]\xf8l\u007f\xd0Sv\x90\xfb\b\xaf3\xf0\x04\x10-\xa5\x98\x06\xac\xe7\xcd\xf6\x80\x8d\xd8{\xf2\xdd\xf5\xeb\xd5\xe4(\xba\xb6d\x8c\xb29z\x8deҜ\xa6+\xa03\xf1\xba\xc3\xfa\xcfD\xfa\xba\xdd\x1f/\x9b\x874\x93\xf78=\xbdZRs\xe7\x04\xb8Xnε\xdd\x19e5\x81\x9e\xac\u007f\xacV\t8z\xab\x1cT \x99SM\xe6\x1e9\x05\x1d\x9fn\xee\xd9\xe5{\xbeo\r\xfd\xd6Ьq\x00\x95nz\xa9SaȔ\xf5\xd9c\xae\xd4\xdb\xfdl\xea\x8f_U\x00\xa0\xa7\x88\xc8c\xa0\xba\x97`\xbe\xae\xa5Ș\x01\x1f\x14lď\x19?i_\x8b*\xd0e\x94\x86\r\x0e\xcd\xd3\x00\xe0\x99~\xd3fB\x12\xba}\xefH\x9d\xdd\x1c\xac\x04\t\x8d\x96p3\x10\x02\xa58|\x86,e\xf8\x1c\xe1r@\xcbSVgz5!\u05ca\xccPGa\x90a/\xe9\xc6E\xdfzs\x96\xf0\x19CKف\x9d\x9b\x956\x94\xa3\x91\x11T\xf3\x856ZR\xf4F\xebn\x15\xfb\xc0Ɵ:CP\xed\xdbC)pay\xb6#\xb2\xe1\x8eP\nU\x05I!M\xe0\a\xb6\xd6!\x91\xdex\xect\x1b\xf4\xe3u\xf6\xb9\xa4V(\x05\x83Kjʻ\t\xe3߸\u007f\xb7\x1a\xa0(\x90Օ\xf5z\xa0Z\xe50\xb2J\x8b \xb3\x1c\x13咃\xf7\bCz\x02[\x1d\x01w\xb6\xb1\x8dX\\\x81\x90\xff\xf1\xf5]ըu\x0ed>\x01r\x1b\xb6\xb7\xbfy\x9b\xc8\xfa\x00\x14\x94\xaa\xb8&\xeaK\x9a\x9eh\xa7\x95z=WLF*\x1eڳ\xc6_hk+,\x9c\xa2C߁\x8ao\xae\x1a\xfe\xa5\x12\x1e\xcdmĶSi\xb6փ\xa4R\tBJ\xf16\x88b\xe3\xb9Y\x1d6B\xdd\x14\xe5h\x88F\xa7\x04\xbe\xf7\xf0\xf5\x1d&{\xae\x94u\xc0\xfb\x11\rR\x97{\v\xee\x1f\tmf\x96r'\xb4\xe8\x10\ah\xa5\xa4\xf4\xf7\x12\xff\xf0\xfe\x98\xeaړ\x9ax\x1fr\x95\x03\xbc-E_\nu\x04\x8f\xc1\xe2\x03\xb4\xf6\xeb\x1f{|\u007f\x9d\x97\xcf\xfd\x15\xbd(\xd7*xz3\xb6\xad\xe8\xdcP\xb1\x93\xd9\xc0\xdc|\xfb\xb4\xf2B\xe6\x89x\x14Y>\x00\xaf\xff\x1e\x06j\xa9i\x8f7ܪ\x99\x19ļ\x91Y^\xb5:~H\xd3\x02\xab$\x81\xb2\x95\xa6\x0e*(\xf1\x9d\xe9\xa4\x11\x8b\xa1co\x8ba\x9b\x06ɘ\x87'\xff\x1d\xe3,\x85\"\xb6\x06\x14hw\x95\xf6n\x89\"\x84\x98\xdaT\x88a|\xca,\x1d\xc8.\x1a\x11\x04\xc3%v\xd9{q\x8f\xd9t_\xd2\x05\xcc*\x89\x10\xec`\x87\xb40\\ \nu\xa9\xae\xff\xf6\xb3b\xc7U\xe9\x99\fR\xe2Iw\b\xbd\xf0\x01\xa9\x176\xfd\x8d5%\x9d\x00\xa0\xe9\xbew\xa5\x9b\x8d\f'\f\xb0\f\xbf\xa2\xf2\xb2\xb0a,\xfa\xc2%i\xaf~if\x9b5\xbf-V/1\xf5Q\xcbt(ѤY\bI\fҴ%\xbb*\x9c\v\x9d\x96-\x03D(\x96#\xd8\xe4z\x8b\xc6L\x88\x1c\x8d\f\xe7\xd6I\bb\b\xaax\xe1m\xc41\xe7E}&'\xa1\x14\xedV\x8c\x0e\x98\xbbS\x05\xd9\x1f\x89\xcdF\xe2\xe5\xa3-H\xf1\xc6V\x8cx^(\xeb\x12+ \xaa\xa7\x1fp\x85\xda\xdc9\x04\xb8S\r\x9a(\xc4\xf6\x02\xb7C&ћ\x9c\x1dY\x84\x8b\x1d\xd38\xc5\x00\x98/\x0f\xce\xe2*\xac\xdd\xea\xc3\xedK\x9a\xf3XĄ\xd3Q:\x02\xbfe,\v##\x8c`>9\x17\x1e\xb5\xa9xM\x8e&i\xc8m&\v\xf1vP\fɢ~\xa7\x1d\x89\x9cM\xd8(\r\x02\xfb<N,\xa8\xbe\xd8\xfd\xfa*}Ȑ\x81\xd9u`\x1b\x8e\x8fn\xdf\t}\xd5E,,\xd8G\x1c贼=\xfdO\x9co\xb3\xec\x99\\\xee\t\xc2<\x8cel\xe9\x0f'ASq\x0eBD\xa1H\xad\xe257)\xed}+8\xb6\xfa\xb9\x00\xa5\xed\xac*a\xc7\x01\x871\xac.x\x97\xed\xc2<\xb2\xeee\xf7qtj\x1djB\a\xc0\xd0\xdd@\xd8?M\xbc\xa6\xa4\x98'[a\x85\x9e\x02\xce\xe2\x00=\x8d\xce\xdb(\x91\x94\x80\xef/\xf3\xc4_\x89u\xeb5\xf7b\xbfC\xa6\x11O\x9aQ\x8fy\xcft\xc9ݯ\xfa\ad\x8dI\al\x8a\x19ns\xca\x0e\x88n\x83\f\xff\x18c,\x8a\x954\x18\xbaS\x88\n\xe4\x1dڞ{\xb9-7\x19\xd7ƺ/\xab\r\xb3:9\xf7zڢr_l\xa5R\x14\x93\xbf\xd4/\xa3\x1ea\xea\x8e\xdc\xdet\xba~y\xb2\xee\xb7;\x1e%\x10\x8e)\xd1\x12\x93$>\x8f\xc3\xc1V\x00-\xc4뱱\xb5\x9b\xce\xcau\xb9\xc4\x19\xb4\xb5YD\x13\xdel\xe7r\x1d\xe7\x15\u061d\x14\xea'}4\xcb'M]+p\xea>\x10\xacP\xf2\xa5\x05\xa0\xa9\x8cReq\x05\xeb\xe8\xeb\xa7\xc9f\xbez\x14\xcf\x16\xe1\xe2K\xac\x1f\x95WsV^\xd4b\xaf6p\x81\xd4}j\x11\xfdK\xf5\xd6ڞ\xb9\u05eb\xb5\xa6 \u008aGJ\xa3uTL\xe4\xd2\x10\xe7墧\x9d&\x1b\xb3ϵt4\xa1\xab\x8c\xdd\x06\x06\x16\xcc\xc0@\xb8\x1e\xb95\\\xa5\xc0-\xba!\xfb\xca\xee\xf4F\x9d\x17u(n\x94v\xb1g\xd3\xedW+~\xf2+\xf5Q\xe8쯸7\x165\xfe'\x13\xb3\x01PR\xf7\x127\xacX\x84\xe0W\xa0\r`q\xc3\x03\x80=B\xaa\xe9F\xe0Y\xa6\x98*\xe8櫘\xd2\xe2Al,\xbc\xa9Wv~\xddٺY!\xa4\x0e\xacN\x88\x1b,Eh\xc5\a\n\xdd`\xb5X\x00\xd0`\xbfMVS)W_\u007f\xbe\xb3\x89#\xf1vBj\x9f\x96=\xf4\xc6`\xf2\f\xfa\x82\xdc\"g\xadf\xf8\v*\x9b\xe4Q\xfb\xf2\xa0$\xb3S\b\\|\xae'\x8f\x8c\x9e\x1a\xbe\xf8d>\x852\xb1\x83\x04\xc8{\x9e\x86\xbd\xb3%\x80\xa81\x1c\x87lĀ\x0e̾\xc1\xffc\xa8\x19\x86j\xedQT\n\xef\xad\xcb\bnnE\xf1\xbf\x9e\xf2\x837\x9e\xe5\x1bm\xd5*S\xb1\xf087\xb5\xed\xc3\xe7{\x8e\xbd\xb0\x99\xd6h\xedh\xf8\xc2\xdb\xf3\x8b剫Гn\nc8\xccP\xec5\xe8\xea-\xdb\xddU/\xff\x8eB\xc7\xe7\xf7\x06\x80\xbe\x0fE\x91\x04\xces\vF\xed\"w۴亍\a\x9ed\x84.N\x1e\xb6\vnSL֏\x12\xceh\x9f\x97[ر\xc1\xbb\xd5]D\xe8\x81c2\v\xc1֡>b\xb6w0\x89\xe3\x80ˮ\xc4{\xd1\xe8\xe8N\xf1wB1m^ \x12z\x96ٜ\xeb\xdcG@\xb8m+S\xf9Q\xbb\\\n\xd0~\xa3v)\x83&\x87$\xb1QS\x92\x16ղ\x1cbl\x1f\xea\xc4\aR\x96\xf4\xaaׅ\x11\xdf8#EbK\a\xa4\tfkA\xf4:b\xc3\xdf\xf3\xa2S\xf7(^\xa9\xfe\x83\xc1\xd1H5IZ\xeeē\xf3\xb5\x9a\xed \xb1\x91\x94I.\xbeE\xa4ƽ\xb0\"aR\x0e\u0383|\xb5\xbeߧ\tL\x1c\xd8\r\x95\x19ʰ\xf2Rl0\xd8\x16\xfd5\x15\xa1\xecJ\x12\u007fA\x11&\xbdoy1\x82\xc3h\x1d\xf0\b\x0f\xb6\xdaf͠9\x1e\xe4\xce+\xa14\xb5\xf4Oh\t\x10\xc7y3\xdbX\xcbEV$\v\x03R7\xac\xc6\xefV\xedׁ\xcc\n\x91\b\xceI\x12.\xe0\x81\xff}\xd3C2\x04\xbd^\x195\x82_\xaeN\xffL\xae\x84\x88e\x8e\xa9\xe6\x81Y5F\xa8 \x16\xd1\xc7\x1c\xf3;Ɯ\x96\x81 ,\x88̉\xe7\vJ\x1f}\xbf~D\x91\x10\x02\xc7\xed\x1e\xa7\xb8#\b]\xb5\xe9\xf4\x0f\xc1u\x1d<`\xbb\xe9\xd8)RF\xe3Q\x1a\xdec\xfc\xb1\x19R\xf4\x86\x97\xf0\xeb\xc4\xef\xd1\x10\xb2x\x90\xfau٢|\xa0\x1f-\xc1O\v\xd9h\xe7\xfdd\x95\x05\xbb\x8a\x02S\x86¡-E\xe6ܘoH_\xe3\xd5e\x92>\xd1T}Wt\x01\x87ye\x86\xf1<\xee>\x8b\xe0Q]\xc9nR7ًQ\xfez\xad*\xc9\xf4\u007f\xc8\xf7\x16\xb7\xe8\xb6j\x1b\x0f)\x91\x97\x83\xae_d\x8eN\u05cb\x8b\xfb\xc1L\xd4\x10\xf9/ny\x8ek\xac 5\xefq\xf9,\xebkU\xe8\xdd\xf5\x17G^-\x1a\x90l+\x15\x92\x02\xc0\xa1.\xe9\x02%\x13\xbd\xf1\xe1\x1a\x02\xff\x05\x9c\xeb77\x9cmu\xed\xc0\xea\xa0Ux\x9f\x93\xf9\xc2b\xa5\x8fք\n\x9b\xdak\xed\xd8\xd8.\t;\x81\xd3\xe9\x8c\xec\xfe\xd4'\x8c侹\x1a\xe7D\xf1$MN\xb9eN\x1cGv@rʚ\xa0\xd8%\xb3uo\x8a\xf9\xe2\xcd^\xa3\xefx\xaf\xfeJ\xb1\x8b\a\xfd\xcb-\x1c_\x1a\xf1\xf9\x9c\x8f\x01W\xd7ˡ\x10Q\xe5EAa\x92\xe5\x1cXJ\xa50t\xd7:\x10\xda\x17\x9f\x03\xce7S\xf8\xa3M\xbc\xcc5)H\x8b\v\x80Um3\xd4\xf2\x8dp\xf2\x80\x85w]Aݚ\tt\xf1\x97?nbZ5\x0e\xbb\x1a\xa69YAP\x0e\xa8\xcf\xd0ڥ\x1fw\xb9\x14\xed?䰵\xf2\x03\x8c\xad\x99\xdc\xda\xe7\x00<\xb6\xbd\xf3\vP\xbc@\x1d\xc0\xe3\xc8!\xadd/T\"\xc28O\t\xbf\xa4\xe7?%\x9a\xf3\xf6GBo\xfa\xf3!\xb0Z\xa1\xba\x90vf\xb2\xebp\x99r!\x19U&!h\xb4\xafU\x1awcV\x89\x1a\xf1\xddau\x1e\xdea5gbO\x01\t\xf6\n\xb7z\xc1B\x1e\x95\x95y\xc6y\xe2GQ\x1e\xb5^U\xa8\xb8M~:\x17&\x8a\xfdP\xc8X;a\xb2\x80\xbe\t\xe2\x18\x01\xb8\xb2\xed\xa7z\xea\f*\x10k*L\n1\x06\xd8!\x88\xe0\x04H3\xe4Ϫ\x1c\x88Y\xa5\x83y\tW\xcd#8\x97BpQ\x8bJ\xa9\xebA\x9d!\xb9\u07b7\x93\x8eG\xda\x05\xb2~i\xed\xfbP\xe9\xbeP\xe4\x1e\x86\xfc:\x11\x8e\xca\xf4\xabCv\x84\xfa\xd61$#\x85\xd8g\xae\xc0|\xad\xd1\xf7\xd7\x1e%\x1f\xbc\xd5\xe1\xdba\x1e\x04\xca\x04j\xa8\xa2\t{\x8f\v\x93\xe7~lp7\xd7\x1f\x1d\xfa\xc1\xbf\xef\xbeY\x0e\xb0\xe9?\xe0\xe8\xafh1\v\xe1-\xef*M'\x94@\x9aO\xba;\x00\xc5p\xcb\xd5\xcd[\xa5\xe2\xc6!\xcbnr\xf0Eo_\xb0^\x9b\xb9\x98\xa2\x91\xb3\x8a\x9b\x03\xbaS\x15m\x82[\x0e\xf0\x06{\xef\x19d\x91%\r\xf02+\x85D\x1c\xec\u007f5w\xd7\xd0EZL\xf8\x1eS\xd0\x1c\x0eBir\x8b\xae\xf3\xb7:x+\xeb\x9e˂\x05\x84\xaf\xbal\xa7x\u007f\xd6\xc8\U001097c2x\xe8\x9b\xef(\x19\x10\x19\x85\x93:L\x8ee\xf8GV\xb6wL\x87\u05ed\xa4\x15\x94M\x02\x119Q\x16\xbdr\xccԖ҅~(x\xa0\x1a\x80\xd5T\xbd\x00B\v\x13veƸ}<\x1e\x93[/-;\x02\xd1|lX\xea\x86p\x05\x98\xe0y\x99Qr§:pl#\x9a\xff{\xdfl\v\xba\xb3A3\xfeF\x85\xf8\x17\xca\xd3\x17\xfe\x1e\x90\xf2x\x15\xe9\xd2,\xa0\xa9(\x81\xcfh\x10;a\xa0Y\xb1)E\x0fw\xb1%ֻ&\xc4v\xf2`\xd0\xe1\x1f\x01ld+\xf5\xa3\xcc\xf1\xcdb\"\xf5dNb\a\xe9\x8fo'\xbaWB\x87y\x97\xf8s\xccc\x93S!\xa0\xf8[h\x06\x8d\xa5ܵh\xb37%\x85\xf7\xceZQ\xe4\xa4OW\xe3\xf8\xf5l\x19\x04\xed\xbc\u007f\x88F\xcb\xd1N\xa3\x90\xc2c\x1d\xc0w\x9d\x1f\x9a\xb98l\xbb.\nnJi>\xd5\x01\xe1\"T\xf51\x12gT\x9e\x03\xc7l\x02\xf0\xe5\xf8\x96b\xb1\x8c\xfd[\x84\ax\xd0\b\xbbb\x86\xb4\xcft\xdbN\x9e\xa7\xd7-R\x15\x80\xb7)\x00\x95\xae\x8c\xff\x17\xcej\x9f\a \xfe\x96\xab\x13\x82YR\x99e\x0e\x82\x9ca\b!,{&\x9c\xf2\xf8\a\xb5\xa9%\vS\xfd\n=A\u007f\x91\x81g7s\xe8.\xf8b\x10!T\u007f˓*JN\x18\x9aj\xb6\xf2\xc2\x11D\x0e\xba\xa6\xb9l\xb4\f\xcfi孂z\xd9\xc8\xd4}ⷩ\x8a\xbb\xcenz\xf7{a\x1c\xa4OÆL\x06Z\xac./\x93V\xcd\xfd\x88\xa3DƩ\xba\x02\xce\xf3\x80_o,zf\\\xa5k\x8e\xf8\xeaߊھ\xcf\xcag\xff\xa4\xca\xd2̅7\x00x qK\xb4\xacَ\xa9\x15\v\xb5\x12\xbd:\xedu\x97\xfd;\x955\xda\u007fQ{\xf7\xe0O5\xc9~\xdb\xc2?\x8d#\xc0!\x89K\x97\xf0k\xaf̂\xa9\xc8h\xa9\xe9\xf5(\xd2\x15\f\x02\xb6d\x00$\xfb\xe6\xc9\\\xd8iDY\x90\x89\x19b\x8e\x8d\xe1$|\xe4K\xf8^Vٻ\xe4\fnK\x93u\xbf\xad\xb7\a\xfc6\xe5Ι\xee\xe7;=\x94\x02\xc9\a\xb9\xa9\x18-\xefԛ>al\xed\xc6 \r\xdb\xda\x19{,\xa1\xf6\x19\x05\x1c\x04\xba2b?Z4\xc7xR)\xe4!\x12\xf4\xb5?9*\xb0\xa11ZUR\xb0\x124\xb4u\xb1\xc8\xfc\x89ˋu\xa1\xe4`\xc3\x01\xa6\xc6\f̻\x9e\x1ae \x8b%\xc0o\xb2\xf9w\xe3\xdb:UB\xa3h;1)\xe7\xf2\xa5v>\x1cD\xf4\xabM\x06^~\x84i\x8f\x99i\x88\x0e\xb5\x1cV\x98D!\xe6\xcaN\a\x8b\xd3)\xfd3&$\x16\x92Z\xa6\x10@\xebh6V\x1cX\xd7\xc63\xae\xcd\xd4h\xf9\xd7\xe3\xa8r\xc1\x13\x1e\xe2\x1b5\xfd}\x14\xf6\xcfV\xaa:\xeb\xbf`\x16\xb0䔬\xee\x96o\xa9]ֆ?\x0ew4\xe8\xc2v\xd3)u\xff;O\xe7X\x912ƎZ\x9c]\xae\xee\xb7t\x81\xbd\x97\xb0\xa6Y\xa9x>\x00\x8d|\xe4\xd1\a\xb0\xf5\x8c\x9a\x93\xd63\xad\xd4\x04\f}\be\x1b\xfb\xad\x1a섲\xb5\xbcY\xc6\xd6O\xf9\x83\x17@\xcaS3kM܅/.\xb1\xd6s\x18 \xb7=\xfa\x00\xb1\x8b\xe7/\xac\x8cn\xf4\xc2W#%%J\x93\xc0`E\xb4n\r\xb7iY\f\xbb8U\x1e۔6yD\xd9\xe6G\xf4\xa7\x0f\x99pbn\x89\xb9\xf2>&E]\x90\x03Tf\x88\x14\n3%\x93\xa4\a:`\x9c\x9aA\x95\xc8\x12-o\xf7_\xd13\xdb\"\xea\xf1b\xcc\xc4x\x00zD\xc3\xe5\x15\x82\x92C}\x91*\x87\xb9}\xf0\x8bu\xe3\x0eO\xbd\xc0|\xa6\x1e^\xd7\xc22\x15\x84\xff\x04\xbb\x8e\xae.\v\x81\x89\xd3L\xb9GU5w\xb9c\x19\xb6\x14\xa4\xebB\x82\x95\xe3[Zdg3\xb9|\xb0!\xab\x0e\xbc\x14Xb\r\x91\xeaC\x1a|\xa35\xcdp\xeaX\x1b\xea^@P\f\xab\x14N\xf7\xe7\x10\xd5I\x84;\x04^qk\xe2j\xc4k\xb9\xaf\x11\xefn\x94VΠ\x8a\xeaۑfo\xc0\xee܄+\xd4jS\x91\xa5p\xed\xcf\x02F\x03˱\xbd\xdaڏ1\xfd!\xfd#\xc0\xbc \t)\xa18\x933^͙f\xd0\xe9\xf2dtX\xa9y\x1b\x83\xb2\xe4\xd4\xde\x1d\xa2\xd9\a0\xf6\x9f\x11\xb4\xe7<\x8eT\xed=\xff\xb1c<\xbbX\xab\xf1\xa2,\xc9\xc4\xe3\x8f\xe7Ƀ\xfc6ܦo\xaaK\xdf{\xfc\xb2\xa3\x82\x12\x99\b\xd2ث\x18\xe9\xea\x11\xa67\xeaP\xadʆ\xa30\x98\xb4ɭ\xc4`TԲ\xf1ߕw\xcb\f\x17dM_\xe1\xa2x\x8bS\x11\xe0\x96Z(\xa4\xa2\xd5\x01\xd5\xf4-Q\xa6\xd4\x01C\xbe\xf2\xc9\x19\x8e\x85\xdc\xfb\x1bi\x1ap\xaeÌ%\xee\xe8\xbfӦ\xf5\x06q\x9f\xebTTE\xf6\x17\xbf\x88\x1f\xc3|\ay\xfe:,uٿ\xc1\u0557\x9f\x9b\x0e\xc6\xce\xeb\x8da]riiD\x94҅\x82\xfc\xefL\x04\xa3\x1e\xb7Q\xe1\xf1\xe8\xbb\xdbպ\v\xca\x17\xcc\xeb\x1cD\a\x13\xfe\x18\x00\xfb7\xfa!\xef\x1f\x06\aC\xae\f\x1b\x87\xbc\b\x15\x84\xf2ϟK\x94}\xf6ј\x15\xf6\xeft5\x9f\xa24R\x97\xdf\xf1\x88\x1b\xc9LU\xc0\xf6\x92l\x84\"hb\x82G\xdf^E\xbf\xae\xc8\x05&0\x8d\xb8X\xbf\x9a\xc7\x00\xb9\xfe~c\x0e\xcd\xdf$\x1a\x81\xb0$Է\x16\x94\x91\xa4qb\x0fe%(\x1c\xb5h\xd4U\x8d|\xf4\xd2\xe3\x14W\x13\xb3\xa3(8\xa4x\xca\xd6y\xec\x0523\x8a`b\x0e\xc0 \xe4\x14\x14\x0f\x90U\xa3\xab\xfc\x9c.\xc4(\x84\xe6[\x1d\xa2\x8e\x9c|w0\xae%\xb7\xa1a\xec\xe4s\x9b3\x13bPfI8\x88r\x97\x96g̹D\xbe\xde\x1b\xac5\xcf\xe6\x8en\xc2\xd6\xed\xea\xc5ŝ\x93\xe6v\xc8A\x8f\x889\xfa\x9f{\x16l\xf9\x8f\x91]֖6\xb2\xef.\xa6ʕ\x9b͟\x88v\xba\xd1a\xfa3\xb7~\x8doP\xc3v\xe5ۖ\xfd\xe7\xfd\xaf\xf63s\xb7\x91({\xe7\x1c\xb1M\x83I\xc4rS\xbfw\n\x8d\x17\xb0\x90\x9e\xd9\xd26\xbc\xe1\xf4@.\xed\x1d]=\xf6\xa5Љ\xf5\x99\xb2\xf7\xe6\xc11R\xa0\xbd\xba\x824\xdd\x05X\xcdM\x84\x8at3\xbf\x94\v\x98\xf1\xd4\x1ajx\x02Co\xee\x01=W`\x82'\xe2N\x02 P\x97\xa1\x1cW\xffՔ\xb1\xc9\xed\x00P\x92\x97\xe6T+\x8et\xecm\x95U\x8b\xe3\xfe\xbf\xe6\xd1\x1e\xae\xd7s\xca\xdb>\xa1\xb3©\xd0-\x14-\x11\xd8j\xc7\xce\rd\xf9\xac/\x84ĵ\xc3t\xfe\xfa\x9d\xbb`\xa5\x9b'\xe1O\x99`B\xaa\xaa韏\x16\xc3+p;bW\x98M\xbfH\xb7\x80\xfb\xcer\xc0\x16\xbd\xee\x86\xe4Ms\xc8\\#\xf7d%χs\xf5\x9c\x96\x948\xcb\xfb\xd6\\3\x11\x85rB\x870&\xceu\x10\x99\x1c\xb0\x1e\xb81\xa2\x12M\xf58\xf21YS\xa7H\xacpk\x1e:T6\xc4٢X\xf6\x8d\xed\x14\xb0\x06y\xcc\x1ds|iO\xa4\xcd\xd4\xc0)R\xa1\xf2m\xb4\xb7O\x10`\xdd>\x91\xdbG\xf5P\xa8\xf6\xb8;\xbf7\x1c\x9at\xf41O\x01#x2g\xc5\x10\v\x9d\xa5Z[\x82\xc4\f\x89\x19KYd\xfaR^\xb6\xab=\xa8\x91\x10u\xe8N!\x12l\xb2\xac\xad=\\\f\x9e\x80n\xa3RZ\xdfHƔ\xe9\x18~\x98n\xe9b\x8d\"\xc2\xeeb-!\xa0V\xcf\x05\xf8k\x052\x13\x91\xb0<K\xc7\xe3-PC\xc9\v\xbe\xef岲=\x8fE\x1f%ia\x85f%\xb7QXc\xcb\xef!@oݢ\x14\x00S\xf0Z\xbb\x88\x8e\x16*\xe2\x8a\x1e\x1a\x9b\xb6\x9f\xe2aG\xd8\xf9/쵎f\xa1\xfd\xd6\x1cS>T\xd3#\xe5\a\x94\xca\xe09^%!\xae\x85r\u007f\x83\xbd\xe9n\xb7vDJ=D\"\xb3J\x1e\x88\xcb\xc9\xeeb\xef\x15\x06&v\xed[\x16\n\xf8x\xa2\xb1\xae\xf7\xa2\x95\xaa졑m\x89\x8b'\xa5^\xde2\xc8\"#\xb95\xc7)\v]\xae\xe7\xba\t\xa2\xc0\xbd6\xe8\xf6l\\K\xc0\x81\xec\x06\x83Л\xcb_\xd7ռ86d\xc9\xecÈ\xe1\x12!\xf7\xdbF\x1c\xf7߳Em\xe7@\xa1\xa1\x8b\x83T\xa7\x10l4\xb4\x15\x1e\x89+\xc8\xfe\xa6\x85\x05\xb9\x05|${\xc8K\xe7\a\xe7m\xf4\f\xabS\xc6\xf9\xa4\xcb\xf9*\xe43\x9c\xf6\xb3c\xaa\x90 5\x8e\n\x8b\xc3ߓ%\xa4\x97\x93\xd1 ڙ\x96<\xac\xe38b\xa6\xfes\xe6\x84L\x88O\\\xb3\x9f|1\xb2\xa7\x97\xf3\x95\xc2\xe8\x87\bS\x8ek<\xc7,Uu\xe2ئ\x82曅a\x8e\x84\xe0m\xa7\x0f}\x88j^\x83yCh'\"\x06\xcc\xf8\x81\xbb\xefX\x05\x12\x05\x02]\xb9\x1ea\xf5O\x85y\xbdI5ڡ\x89T\xb1\x9b\xb1\x10\xe1\x17\xa1\xd8'ԣ~\x91\xbe\xa3\xff\xfc\xff\bR!C\xda\xcf\x16\x0f\x1a%\xddmI}@\xe0H\x86o\xf72\xc0\xb1\xfa\xe5C\xe8^{\xb4\xea\xc6\"#\xd1t\xebES\xc1\xd6\x13d\x87z\xd0\f\xa3HK\xad\bE\xd6V\x12@6$P\xb9\xe9\xf0\x82\xbd\xb3\xfd\u05cbD\x030\xa9\bI\xd6gC\xfbZ\t\xa9\xaas\xb6!\xd7f\xca\xe1\xee\xb1(\xa5\xb7\x1fs\xa0\xbf̛)~\x97\xe3\xddoG\xaa\xa0G\x8c=\xe5\xe2\xfd:\xb23\x15w\x04ڏ\x9f\x10\x80\xc7ީ#\xc2_&ZlΊ*nՙ\x02\xe1\xa0~V\xf6a\xa3\x1cw\x16\xbb\x80\xe6j\xf2s\x8f]\xdb\r\x17!U\xb4\x18\xd4]\xd9P\xdf\xfa''<\r\\P\xa8\xea%\xb5S\x80\x9c\x01\x9b\xd5\xdeB\xec\x87\xfc\x98\xc6!\n\x06\x11\x8a\xb1\x06\x14oL5)\x04v\xc7\n\x82\x88}\x84\xd0\x00\x98|sY\xe2\xc2J\x9aN\bb\x95\x84\xf1\xcb\xcclݠ;co_M\x04\xd2\x13Z_>7\x89|\xef\xfc\xad\x155\xc8Q\xa2\x05\xba\xef\n\x84cL\xeb-\xb1\xc0\xa9\xe0\xddj\x00\xdd\xee)\x1d5\x8b\xe8\x16Æ\x87\xe8Ҡ\xbc\xf9&_\xaa\u0085:\u007f\xaawv(\xc2\xef'E\xf7\xe6C\x9724\x13PJY\x02\xa8u\x18q\xa1\x16x\xfe\xf29\x16\xf5k\xe1<\x92\xd6\xfb\x97\xbc\xee\xb9\xe8Wp\xa5\x1d\xa5\xa2ה\x12\xcd\xf5\xee\xd0\xc9\n\x8e\x9f\xd1}\x85\xf2\x1a\xaf\x15ֿC\xd2uz\x97\xe1\xdf\x06\x86\xc7a\x11\xc0\xef3\xa4Y\xd3|g\xa0\xd76\xe4\x11\xfaAe\xf9\xd0Ȧd\xef\x11\xd6R;\x1f\n\xdf\xedRy*3\xf5\xa8ftb\x1f\xa7XN|{\x95\xa8\x1b\xfap\xa6\x0fx\xdd\xf9p(g\x9b\x1a\f\u007f>s\x01\xfc0\xe7:C\xac\xb9<\xfdk\xe9;\xb2\xf2d\xe6\xc3z\xa95^f\xfb\xdcSx?SMT\x89\x05F\xd6F\xb9\x01\xbcѭ\x85p\xff\v\x18G\x10߅(\xbb\xd6E;p\v\x99\xbbwS\x99\xa9@\x96\xd1g\x86\xce|6Cw\x9d\xe7V\x0fG\x8e\xb9H\x12E\xb7\xe9&:\x91\xae\u07be\xbb\xd6%4\\k\xc8M\xb3\xc0\x023\xe1!\xaa\xe3\xbe#\xb7؆(\x03@Z\xfd\x13\xbbU*$\x00\xbd\x9bw\"\x86Z\xa4\x13\xe8\xf8\xb2i\xbf\xe1\x89h\x1eH]\x83du\xf8\xa0}\xd5J\x98\xe1\x96\xc5\xe5K\xbe\x89\x06\x02\xc1\xb6(\x15峅^\xa9j\xfc\\\xb0\x1c&=N\xff: \x81\x15\x93\xa8j\xb3M\xcd\n\xaa(\xfdi\xc2\xce\xe5|\xe7g\xf1\x95\xc4z\x97&\x16W\x02\x80G\x02\x04\xde\xc1\xfazA+(\xff\xfa)v1U\r\xe5\x03\xfa\x19ST7,13\xb8l\xbb\x17]h\x9d\xde|ގ>\x83<\xe3\x91y\x11tg\xa5\x83N\xad\x1b\a\xac#\x05\x01;\xbcH\xa6\xb4\xcfwA\xf4\xa5K\xd6&F*\xfb\xe4'OK\xf22_\x9e\xf1\xebN4\xf5\xb1=uT,\x1b)\xca|\xfbݻ\xd7L\xf3\xaa\x90c\x16\xbb\x90\x05\vf\xf7\x81^\xb8%Sg\xbe:\xb3j\xac\xd8$$\xd5آ\xa5w\xc2\xe1\xb8,%\xef\x11k\xe5\xab\xd8{3\x1d\x16\xb7OgQ'\x18\x1a\xa6\x19\x9e^H\xadP\x85\xaf\x95\xf4g\x8b~\xc4N\x8b\x9a!\xea\xce2\x14\x81\x04^\xa5\xe9#\xc5\xe4\xc7H\xee\xfa\rf\u007f\xd8z\x0fƬ\xb1\x01;E\x1b\x8a\xb9\xfd\xab+mG &\x00\xa1\x8f\x92\x99\x82z\xa6,\x18\xb2\x91Ns-#\x01\xe9,\xd0VE`\xaa\xa4\x8e\x89\xe0\x89\xf14(\xbb\x11\x98xE/p\xf9<\x9e\x17pS\x93\xd6pri)T\x93\xa0H\x85\x82\vل\x8c\x99\xd5k[\x1a\x94\x14\xe5W\x99p.\xaeq\xfc]\xb9=\x9e\xa6J \x8d\xe6\xf1z\x98\xe5(\xf3ߡ\xb9\xb5\xf5^7~\x9fC\xa52\xa8y\x18\xb4Y\"=S+\x8bACh\xd5o\xf5\f\x85\xcejA\x95\xcaݍ+$\xde_\xe7i/D\xefm\x95V7\xc1\v\u007f \x9f;\xb2Q(\xd6\x18\xb8\xe2\xf0\xeaةAQ\xf6\xd2`|\x9e\xa6\n\xead\"c\xcdS\x8c\xfb\xac\xfa\xc7\xcb<\b\x97\xe2\x0e\x95\xe3\a-\x11X\xfd\x99\x13-c?\xc5\xef\xe3\xf7:c\xfe)\xf1\xde\x0fm\x96n\xaa\x9a*ӭ\x87\x94\x97j*\t\xa8\xfe\x89s\xe1\xf8\xe0\xb8\xc1dMW\x8eؙ\xc4):\xd5̤$D\xb7\x04\xd8o\xe8\x96\xef§]\xa6;$AU\"ЦT\xe1\xd0\b\x18GȈ\xbb\x06\x89\xb1M\xfc\v~M\x18W0\xeb`\x1fp\xddR\xb1\xa2\x01\x13H\xdb#;\x9cu\x00NQl\x8fK#\xd1\xcb:5i.\xd3\xeb^\x97\xa3\x9c\xa3\x17\x01\xc2\xd0f\x95\xacè\xdaрV\xde\xfaqͱ\xe3ؿܬm>\xf9\x9c\xdf94ԃv\aU\u007f\x9bT\x05}\xf5\xaaU\\$\xd72\x0e\xb4\x16I\xab'\xcevjÒ\xaa\x98n\xf8\n-\x02A\x15^\x14\xde\xfa\x05t\xef\xe2\xc1\x9d\x1f^\xebR\xa9\fB\xe3\xdeEC\x15\x9e\xbc\xac\xcdC˒\xb1\x05\x1fm1\xb3\xc0mּu\xeacی\x02\xdc\xfay\x02\xbd\t\x91p\xfbF\xcb\xf0c\xbd#˾M\x8dzƷG\xb1s㚯?\x95]\xce\r\x11\xae\xbb~\x14\x80Z\xc5\x1fT\xba\xaa\xfe%׀㙸Y\xa1\xef\xa7;\xb3\xe7\r\x0f\x00Dh\x84\xa2\x19\x8d\xe6_t[\xfd\vh\x8c\xed\xd5\xd7.\xd9'gb\x90\x86wf\x93 \xa2\x86\xcb\xef\xd8\t\\\x02\x1c\x86!#T\xbd\xdfH\x1ax\x90\xfe\x91\xd7\xe3\xfb\x93\x19\x91v\xfdb\x9b\x19\x84\xbd\rNJ\xfb[\xa2\xeaѐ\xe4\xec\xe8\xf1\xfeH\x95\x9fł\xdf\x1e)\\\xaa\x82V\x892/6J\x80fSL\fz\x18\x89P\xd8o\xa7嫵\x91G᤹?\x17\xf1B2]\x8fǺ\x97\xc4\xddz\xc0{\x92ZH\x15\xb0cw\x91\xd7F\xab\x8aa\x1b\xdf\xc4\xe3xь\xac\xbd\x97t,\xfc\xf3ta\f\x94\xbc\xa2\x8b\xa6\xb5\x92\xc4\xd1{\xce\xf1nI\r\xfcB\xf1\xddl\xc0dK\x11\x04\xaa\xc5\xd6XP\x94g\xcb=2\xbd\xcd4\x00\xbd\xcc\xceCbn\x91\r\x86\xb7\x1b\"\xb8\v\xc1oq\x85B\xacF\x94p\x84q\xe7- \xf3A\xb9\x03\xfd\xe7'\xcbR\x91\x80\xc5\u0603\x83\xb0\xb3\x0f\x88\x8db\xdeS\xc7^\xf9\xe7N!;\x90\xe7\x05<\xae\xb9\xd7it/\xf3\xa2\x1d\x93\u007f\xfeT(!6!Y~\x9e</\xe8;\x1aWE0\x86N\x91DT1\x97\x97\x9a\xd0L\xcf7a\xbd\x90;\x9ebM\x1c\xeeڳ\r\x94-^3`ɠ\xcfc\xdb7\xc1\x8eJ|Ֆ5~j\xf0!\xb1\xe2)\x81mV\x03\xb2Xlp\x94\vަ*\xfbt\xdfn\x8b?0\xf4\x1b\xb4o\xb6\xe4\x13s\xb2\n%\xc2\xe8\b\xbax\xff\xfdR\xef\xb8\xd5%\xce>\xd8\xc3\xef\x14\xf2!P\xa3\xb1\b\xcdX\xdf\xf0\xe596v\xb8u\x1bU\xd9\xe3\xed\xda֨<'R8W\x8e\xe3\xe9hL\xb5.!I1\xb4\xd7w\xa5ܚ*\t\xc6b\x1b\xd6\xcco\xdf\xd6\xe8\t\x10\x95.\xda\xf2\xf4N\x9b\x8f\x16\x12\u007f\x92\x8fX\xdf\"u-|\xaaG\a\x86\xb1\xc2.\xa7\xe5Dy\x11\b _\u00889H\xa2c\xda\xeb\xd0`\xf3\x0e\x04\xb0\xa3\xfdJ\x06:\x01\x87\x05\xc1{XB=\xfc\xedjp\x1b[qd\xe8S1\xcbe\xe9\xb8\x15\b\xc8\xda}L\x85oP\x00\xeb\x92¶~(l\x1a\xfc\xde\xef\x80\x05/\x1a\x88荊,_\xb9\t\xb8*+t)\xb5\xc2E\xaa\x0f\xdf\xf6\xbb\xe0\xc1A\xc9s\xc1*|\x18|\x94\x14\xd0}\xbf'\xa1a\"\xe8\xcaXe\x01\x1995\x13\x9a\x89U҉?\xcfr\x0e\x14l\xc9(\x86\xfd\xda)\xc2.\x84q\x9c\xf10\xe9\xf6\x8bS\x81E\xc1Hb4\x8dhB\xee\x17\x95\x18\x865\xae(\x10UO\xae\xb4\xbb\xc8h\b\x81\xa6Rd\xefVu\x9fQ \xf8\xae9%;\xc9\x1e\x1dzԶ\x05\x80_k(Ȝ\xd0S\xc7a\xc2\xd9\x03\x9e\xa0\x93\x99\xb6\xdam8)\xe4HP\x92\xa7\x1c\x84\x86o\x8f\xdc\xca\xc4\x05\x9am\xca`\u007f\xae\xcf5 \xf7\xc5\xd2%1\xd3\xe6\x10\xb3\xa4\xe4\xeb!fv\xdb\xedT\xc0\xfc\xe9@\x80m\x8e\xe1\xa0\x13\f\xa7\x90\xc7_\u007f<Y\xda\xd5Nޓtt\x1b>\x8b\x19j\xf2\x1fq\xffe\x8c\x9ey8k1cM\x01ԭ\xf4\xf1\x0e:\xa59HÙ<\x05\x92\xd4\xf9\xaa(p\xa4\xe0,m\x88i\x96\x96\xc4GJ\xe0\xed\xe6z\x9c\x15\xeb\u007f][P5J/0#3\x91L\xe1\xfe\xf9.Tj\xba\x82r\x98\xef\x16\x84\akc-h\x17ধ[>\x0f7\x14%.8U\xc1^\u007f\xd6y&t\xefj\x8as\x00\x87\xac\xc2UH\r\xae\v\xa0\xe7\x0f\xbb\x86χGg\xe1\x13\xb3\xbfQ7x@\xad\x9b\xe2Fo\x15s\xdc;\xe2\xf1\xcb+ \xaa\x8e+\xc0\x91k\xd5t\xdc.\xd1\xe7\xf4\xc8kx\x1a'\xe3\tp\bb8\u05f9OrR\x01{iqb\x88\xbb\x11\xce.I\xb3֓X!x\x8dD\xb3Yq\a\x81\x95Eц\xd0t>oL\xb6\xa0=\b\xf9!\x9b\xa0틪&\xd9\"B\x99q3\xc4ۺc\x90\xdbVo\x81\xbaH0\x8e\x9c\x18ž\xac\xc7\xf1'\x85\x02\xa8\xf3\xef\x8d\xe4h\xbd\x95C\x16\xea\xc3/\x91\xc2K\xa2R\x1e\xc4\xd8Ҕ\x85\x10kS\t%\x12VO\xcaL\xc9\xe6U\x1a\xa5\xf7l1\xbc\x13\xe2\xe2\x8b\xd5xM\xdf\xc8\xce\x13\xe7\x8f٬\x16H\x9b\xe4\xa6|NgdZ\x1e\x0f\xc6\xe2\b3\xf5\x9e\x89=\x88~\x00\xac\x19\xe8\x14\x12PI\xae\xdc\xf5\nP\xec\xd4c\xf6\xd8\xdfFL\x8c\x9a\x06'm\xc3\x06)\u05f8\xa3H\xe2Ǣ=\xef\x18\xeco|p\xe9i냪$\xd5\xf6\xa3nG\x05\x01\":\x99чM\x85|\xfc\xce~\x19\xc6R\xea\xc3|\xfb\x96\u007f{\xc3\x14\b\x12\x90\u007ff\x1f\xb2\x9d\xde\xca\x112̊\xb04\xaf\xe3s\xd9WV\x98\xfa\xfb\x0e^2~\xcd\"sE\r\xa3m\x84\xf6)\xc4K:T\x82\xa6\xc4ȧp-Z\x00\x9b\x9b%\x0e\xb9\xe7\xbc\r\x00iPR\xc1Ƃdɧ\x1a\xfa9|\x8cK\xcd^+\xcdz\xeeњ\xae3H1_\xaa\xcc\\}\xb8\xde \xce*\xa9\x8b͚\xb7\x8b\xa6\xd7Ϯe\xdd\xcc\xf10\x1b7\xfa\xbf\x9ax2\xbfn\x17Le\x1d7\xd2\xee\xe2\xce\x01\xf5\"va\x8aj\xb8E\xde\x0e\xb3\x1bk6\x14\xeb\x93\n\xe1p\xf87\xc2Pt\xbf\x03W\xffY*\xdf9\xa5O!7{\xf8\xc6R\xc9d\x98\xa4\xa7^\xa2\xef\xbe(\xe0\xe9\x1b\U0004a705\r\xdf\xd9\x1c\xddշx\x18\xf0\x05\x15\xc9i\xaf\xf7\x1b7(%e\xac\xe8\xe2\xdc\xfa!\x92T\xd0*P\x9d\xcfi\xf0Z-#\xc1\xe76m\x11\xee>\x1dx\xaf+\xa0~\x10\xa5+\xadj\x93\x86\xa1sw|\xefr.\xd9\x05\b\x80\x82H\xec\x9a\xc0\xc0\x03\x05\x11\xf0\x04'\xaeIJ\v\xc8\xc4\xc7\xcbY\xd0\xe4|\xbdᥲM\xfdk(\x8f\x87\x95GN\xe7\x00r0~\x9e\xee\xe7Ge\v\xb9\uf528|\x9a\xa9!I\xcf\x1cg;\x96\xe0\x19\xa22\xd5\t\xd1\x063\xad\x8bq*\x02y(Z?j\t\x18\x02_\x1f\xaa^-2\xb3͔4i\xe7!\xe2\x8d\xcd\xc1@\x91P\"0 ^=\xed\xdd\x04\x1d\x989\xdf\rA\xaf\xfaF\xc4\x05\xbf\xaf\xda6U\xf6a.O\"\x80[\xae=q\x8d\x16\x8e\x19\x12\x86\xc7\xc5\xd5aW;\x05\xe3{p\xea\xef\xc1\xb0]\xc8\r\xabo\xea@ڙ\"\xec\x11\xf6{d\xdb@\x9e\xbf}\x1d\xf8\xfd\v\x81\xca\xf4ɓ<\x84@S\xd7)@\x18\xe3T\x14zF\xd8`V8I\xe8(\xa0XH\xc3{\x85D\x0e:\x94\xb1\xb9\xec+\xb5\r\x05\x952\x1e\x8dͥ\x00[\x98\x96\xf2[fy\xb1\x83\x10\xb227\x05\xd1շ-J+\x194A\xea\xb3<\x89\xfe\x93\x8e\x9d}\xbf\x01\xc1\xfa/\xa0v\xe0Tg\xa6\x9cv2\b5!\nP\xe9\xf8:j|b\x96\xdbq2\xda]\xbaA\xe6\xec\xb4\x0f\xcdғ\xb3k\xac\x15\xdcxL\xc6A\xd9\r9\xe1\x10\xa5\xec\xc5\xdb\x1f5\xc0\x15\xa9\xd1\x13\x86ѽ\x13p\r\xa9\x19\x19\xe5O\xc0\xbb\xbf\x1e\xca\x18\xadB\xc2\xfcD\xc9y\x8dP\xa2\by\u007fo\x1f5\xa5\xadn\xad\xbbm<\xbaK\xdc\x12\x05\x00I]{\xaf\xb8F\xf0\x86\xe3+[\x93\xf4\u007f@>\x85\xd0wtS\x032\xcf\x12\v\xd9\u05fdN\xe3\xcf\xf00#\x91(z\x95\x98:\xe5\x1c)\xe7\xb6k\xa1\xf31(\xcd/\x91\xeaw\x95A\xd2l\xa2/#|\xe1\xd0\xd8\x1fF\xbc\x026\xfd\xfb\xe3nކd\xa7\xfe\x82\xfb.m\x84\xd0l\x93\xeb\xd7+\x0f\x19\xbf\x10\xd4IC\x8d\xea\xf7V\x1c0\x97\x166#\xcf*o\xfa\x9e\xafn\x93\x8d\x90g\xb3]\xd3ld\xe9^8\xdc|z\xe6r[\u007f\xa5>\x86\f\x96C\xffV[z\xc3\xf1\xf6\x904GO\u007f\x16K&KV\bj\xd8ª\xe5i\xf9ԑʁӃ\xf3\xe3&*\r\xf1b\x11\xf1'\x1d)\xe3A\x98\xc7\x12QS\xda%\x90\x8aE\x1aH{\x9aL\xab\xe6\xdbȗֱ\xac\x8cR\xab涻\x15\t\xb7!^\xa6iQ\x15Й\xebgX\xa5\x16\xfb\xb0\xa4\x88\xb0׆\xb3p,\xa1\xa41ֈ\xe9\tw\xb6\"\x9c\x88ȃ\x16ph+\xbeMXM\xfe\xde\xc5\xebC\x98\x8a߿\xa5\xe1\x1f\x9e\x86\u07b7C\xc5ḱ\x13\xe6\x1b\x98\xab\xf94\xf0%vi\x03\xd9nw\bט#\xf3\xe4#K{\xb3\xb8\xb6j\xcf\x1d\xf7g\xf4f\x1a\xa9\x10\xaa\xd9n\xee\xf2\x8al\xa9\v\x16\x8b\xdba\xe6L/\xa8\xeb\xb4\xf2\xa7\x17m\xa6Y>\x88,\xa1\x98\xee̖\xba>\xd0\xd5\xf3ʩ\x1f\x86\xd2\xcb\xee\x9d\xf2\x00#\xfa٧\xa9\x98\xbb\x91\xe5\xfd/\xa5xl\xa5S'\xd9SLF\xc8\xd1\x12\xdd\xd2}\x0f\xafRy{R\xfb\t\x94\xc1\xf8\xa6\xb1\xea2\xe3\xb6B\x89\u007f\xbd\xb0\xbd\xd2\xc9C[\xe6\xd3\xd8\xfe#=\x01\xabR\xc6*\xcaF\x15\xe1\xef\r\x1d(\x1c-N!\t\x9eIX'\r\x9aͩ\x05\x82.\x8b\xafs\xb5\xcf\xe8dZI\xe6U\x8f\xb1\x8a\xf5Z\xab䥭T\x91*-A+zֿ\x06:-\xae\x9e\x82x\x8d\xc4\xc4\x00U@\xb2l\xb7m%\xa8+\x93\xcd\xfe.\xbb\xca\xc4\fa\x91<G*wp\xb5\xe7\xe5\xb9\xcd[8\x1b\xbe\xf8\xd9k\xee\xd8\xe3\xbf\xd0|_\xaf\x87\xcev\xc85t(\x01\xf4NqH\xf9K_;$\x17͖\x87IϮ{P\x85i\x11\xde¼\xe8\bf\x9f֔&T\xdc\xea\xe3\xcb+\x94\xf2G\x01\xeb\xcdӕ\x87\x85g \xb8V\x88\x03&\xfbY\xf9\x8a\x98\xc9\x16D\x96\x88\xbe\x98\xb5\x86\x89\x83\xc4\x18<\xe9\r'\xa8u\xf0\x18\xa0\xf6\xb1F\x85\x008L\xe4\f\x90\fSp3U\bF\xb2\xafU\x8enKT\x12K(\xf8X\xa2\x86\x9dK\x05\xd5i閰\t\x16\x17\xc7\xd2\xf8Ep4?JZ\xb3\xbd/\xa0\x9f\xe4\x83\xe2\x02\xcd,\xa4w\x93\xaf\xdf\xd31 \xf5\xc6\xfd\x05!\x9f\x9dv\xef\xf5\u05f9?@\x00\xec\xb6\u007f\xff\xb9\n\xfbA$)ɀ$\x1cT\x0f\xb3\x9f\x97N\xf1\a\xd6\xea:\xc10)o\xbc\xbc\xd6\x10,&p\xb8v\"\x013ȭQ\x0e\a\xc3>\xc9\xcb\xf91{=\xf1\xd0\x1c\x89\x1cZ9\tP\xc4]P7\xc2W\x15S\xd5\\x\x1a\xd9\x1b\xc1\xadD<\xd4<M\x99\x82 \u0605\xb1\xe3\x1dzB[/>K\x12\xf70'\x92\xc9\x05z\x95D!`{\xea\xec\xf9\x12\xd7D\x81\xfaY\xef\x9aX\xb1\"\x932\x9e>G\x00\xb7\xa6\x80\xc8\xf0\xb9l҈\xd6]\xaf\x83\xd0jtu\x81\xe1\xb9\xd3TI\n|e`b*\xb5\xb9\x1b\x80Ƣ\x1e-+\xcf\x03\xbf>\x02\xceO|pQ\xd2R\xf2\a~\xb1_\x14=\xb6\x95N\xa2\uf77c5\xe4\x11\x00u\xa1\xc3\x13b\xf2\xf2Ҽb\xe7\x92=\xbe\xa48\x9dR/\x17\x81\x1c\xcf\x05\\\x8d\x9f\xa7a*\xb6Ǔ\xef_\xd1\x10E\xf0\x976\xa4\xf0\xa3C}\xf8\x9e\x8fM?\x04\x9c]\x0f\xb9J0@Y\rI\xbe\xe4\xf3[\xc7<\x01\x9f\x8e<V\xf4)\x99F-\x1b\xa6\xcb\xde\x141[=\xe8\xdb\xed\xb1^w\xd7z\\\xcb\xf8e>Ph\xcf+\xea\xf9\xe4\xf1 \x1dS)\\\xb5C#\xc0\xfd\xca\xe9ECP-\xee\x14;Az\xc9\xcc\xea\xfb\xbe\x88W\x05\xe7km\xd1.\x83\x95('\xc1\xa8\xb1\x188\xa5\xd9[\x1d\xd7\xeb\x88\xfd\xa1\fFa\xe5Ї\xc7\xcc9\x00\xb8\xf0a&\xd0e\xca\x1dZ\xd2g\x9f\xe7lC\xb2\xb0\x0f\xd2*\x1b<\xacl8\xbb\xfcg\xbe$\xb7\xab\xdf\x0f̻\xdbWW!\xf3\x06R\xb5&$\x99v\t\nMi\xa0f\x1aպq\x1f\xba==\x19\x16\x1aB\xd1\xdc4\xa9\xac\xaa{\xf3\xff\x14{\x1047\x10\xb6\xd9\xf9\x00J\xe3̞\x18z\xe7\\\x03\xa1\x89%\x80X\x06ٲߪw\xeb\xc4\xe0\x02H\xef\xf3D\x05 \x1a@]\xd21Q\xc7\x14\x9bY\x8bJyd\xbaW]6?:\xd40SW\x93\xdd<\xb2ѶE \x13uݞ\xeb\xc7\xddR\x84\xc9,\xfe\x01\xf6c\x1eG\xf2p,\xa8\x9a\xf2\x18I\xa7\xea\xc3z\"\xf7\xe0\x15\x03\xf0\x05\x97,\xe4\xb3\xfa\xf6\xe4\x179\xb8\xd8\xcb\xd5]\x9c\xa39\xd7\xf0\x8eg\x90\xf3\xc2^\xd9F\xd3pb\xc3o\x96k_\xc8.\xa6\xbe\xd1\\=C\xafjIӵkwS\xa1'\x05u\\U\xe3\xb6\xd5\x1bY\x992\x88\xc0卟Ⱥ\b\xc36\xb4\xf4\xfa\xf7\x90[C=\xe9\x9f\xc8N1\xd6\x00=~30M87\xde\x17\xae\x06\xec\xc0\xf3m\acv22\xef\xd78\x19\x90*b\xf0\x80\x1cH\x0e\x90̓\xf4\n+rr\xa5(\xe6\f\x82\x02\xea\xe1\x00vܷ\xcf4}\xc6v\x1d\x84\x9aa6klG\xef9\xd1\r\x96Փb\x99@\xc1r\x96\x0f=\xd8:\fIӦ3\xe8:\t\x06?߾\xa6-\x9a\x8a\xdaՋfs\x18&\x9fG\xeb\xd6R!\xe7\x9a UL\xf8A\xbc\xed#\x8dg\xadQM#y\xee}\xd1:\x9eg%\x86=\xbf\xf2\xf7.\x1f\xe0z\xdeu\xf5\xf8W\xb3\xbf\xe4[A\x02*\x84B\x14}\x81\xf3\x02\xc1\xe9l\xe2\x8aX\x86Ǌ\x82\xc7֨\x0f?\x9d\x1b\xea\\\x8e&yTь\x81\x8f>kH=\xeb\xf1n\x96\x88R\xb1\x8a\x96s#sI\u007f0uyK\xcc\xd9\x18\x90x\x1b\x11\n\xf6\xa43\x13Ӱ)\x99\x1d\xa4i8F\xb2\xe0V\xbd\x0eKnk\xbc'.\x04\xe7Tϥ\\\x03\xde0\xf6\x9b\xf7;\xf2Q\x85\t܊\xdbm;\xfe\xf3wA\xbf\x00q=j\xd0\xfd8DHG\xee\xa8y\xe4\xaeDA&p\x11\x13\x8d\xea\xceR\xcd\xc5->\xb2Y'\x16O\xbe\xd5\x14qɗye\xfd7\xd2\x1f'M\x94\xaa4\x11L\x17\x033\xd9m:\xbc\xc5߱b\x88y\xa2\xfbI\x8f\xb7|\fi\"\x98z끮\xcd;\x18\xbc\xea\x19\xca\xc0zu\xfd\x039\f\x9b07\x04ʾ\xaf\x91\x99\xee'\xbd\x12\xf7\x9chNw^\xe4\xb0G\xbd\xadmD\f\x1d6\x11\u007feD\x95\xce%\xe97\xe1a\xc0U\x02%\"66J\x0f\xf9\xf9\x1f\xf3!An(\xfa\xb9\xcfd\x96\xde\xe1\x14Ȯ\xf8\xe0\":\xb3>W\x9b\x90\xe2,\xf9\x05\x9cU\xf0\x9c^\x85U\x12\xa9yW\xd0\x120\xd1\x18\xdc~F\xafF'\xe4|\x86\xd8Y}\xa7\xe7Rx\x12\x02\xcbl\xb7\vI\\\xee\v\x12㿡\xb4\x154\xa8J\x1ew\xaaHi\v^3\x86t\xff}\xbe;\x0e\xf2\a\xac(\xb3K\x1b\x9d\r\x06\xb3R\xfb\xf31\xb6<`+p]\xcb\xff\xd7_Kʡg\xb5a\x182\xe0\xac4\xa6\xf7\xe2\xcdEl\xcbz\x1bl\x8eW\xbaْDA2\xd4u\x82\xb7D\xdb\x1d\xf4b\x9e\xb0\xc7_\x1al\xa1\r\u007fH\xa1\ad\xaf\x05\xc7\x06\xbcFN\xae\xe1H\x90\xa4\xc7\b:\x85\xd1(o\xab\xe0g\x8aT\xfb\u007fӋ'\xfd0B\xae\xea$\x13\x9dYG\"W\xba}`\xdd˞<\x9fl\x06\xbc\xff\xf9\xa3\x84\xb5\xef\xa2\xd7$l4X\x1f\xa3\a,\xbe\xba\x8cE*L\xda\x1d\x8b\xe8\xdd;'\x9d\x17Ai\x8fLU!\xb4\xf8\xf8!&\x8eg\x98\xb4 \x19W\xd2>M\"\xfc\xa9r\xa4>\xd8\xdb\x1d1pC8\xe4\xedTZ\x89\xc0\xc8\u05f8\x81C]\x921C\x86\xd4\r\xf9W\xf6B\xbfeH\xa2\x1a\xce\u007fBg\xa8\xc1\x14\x19\r/\x98.\xfe\x91\xd0}H\x9a\x12\xd1\xd5\xe3\xe8&,\xee\xe7\x1b\x0e7?b\x9d\x91\xc4\x16\x95\xb3\xe9_ܣr\x15\xf1\xb5\xac\xfb\n8\xdf\xf6\x90\x19\x8e_\xa4\xaf\xb2Ӹ8\x97\x95\xdf\xef\a\xfcY\t\x80v`\xbd֢\xe6\xf2\xb8\xd9\xf9SV\xb0\xa7\x1f'\x8bi\xa6\f\xcd%߃r\xed\xfc\x9a!]\xa2\t\x80\x83q\xd3c\x88\xad\x89\x8d#\x91\xe8\xea\x8d\xf8:\xdf\xeb\x9b\xed\xbe\xac\xe1᭵c\x8f\xa5\xe9\x1e-\xc6\xd5(7WNz?[\xec\xcb\xe1\xbaX\xb7\xd0m\xcc\xd4\xe9\xf0>\x18\xef\x8f\xffu\x10-\xff\x9d\xa0\xbf\x9e\x14z>\xe4&\x1d\re\xba.\x035\r\x93\x80:\xc1\x1b\xb4tA\xa7e/\x81\xce\xdc\xc3Jv\b\xdf\xc7\xc4f\xa1'٩\x87P_BƏ\xa6\x9c\"v5\x85\xb9\b\xa6_(\x00\x02G^\xed\xb1x\xfc\x8f\xf91=\xfc\xe5ݥ\r\xb4\x14o\xd9\xfcaX\xfe$\x02P\xf2t\xe2\xb4\x1b\x9d\xe9\xee\x8d\x1f\xd2+\xbd\xf9\xe7\xb0\xda\xee}\xfe\xa9\xe2x2\xe5iD\xeb}[]\x06\xcd[\x83\xf4N\xb8X\xff\xf1Oa\x14\xb8ФJ3\x86yz\xbc^\xfeDԅ\x14\xb8\xe5\x18\x16w\xc10\xa5\f܄\xda\x1fPf`\r\xb7;'6\x9aڞ\x93E\xcd\xf5&\x96:\xcfY|\xd50\xd8#t\xd5\f\x95\x17r\xb1\x1a\xe3\x02S\xe1q\xf5R\xc1!H\x9fӚJ\xab\xc6\xdc4L\xdb\xc78\x8fO7R(\xef\x96\t\xc0p\x12@\xa0\x9a\xff\x88\xee\x89`\x8b\x92vd;s\xce'(\xbb\xce\xfbe\x84X\xc0\xa5kkq\x03\x05L(-\xc1\x93%\x83\xc2\x10\xdd\xdc\xc0I\x8d\xbc\x1b[`-\x96\x11c\x01\xaa\x8d\xa2^d`\xff\x8av\x0evUw\xf4m<\r\x88\xfb%U\x95\xd2r\x905\xbc\x1c\xb0A\xc0\xefd\x8d\x86\xd0\xc2\xf4=C\xa2`\xff\v\xd7\xc65\xae\xea\x14\x05\xa4\xf0\xdf1\xc2\x1f\x11\x8c.\xbfu\f\xaaN\x93Q\x1a5\x06\x18!\xca\xf2\xf96\xba\xd1\"\xad\x99\xd6\x1c\xd5X\xb6\xa9\x85\\W\xaf筰\xf7\xef\xac\x16\x9d\x82\xe0\xd5!\xc7\x1cʝ\x87ilB\x81\xf3!\x99\x1a\"\xbc^\xb0\xcb\xc1\x17\xe3\xcbų\xe6Gzo\xcat\xc3\xc3R\xd5\xd3\x1a\x03`\xe4lz\x95:\x1a\x04\xa1\\\xfc\xcd\x1f\xc6\xeb\xbddP*\xa1\x1f\xefu\xa8\x19\x9b\x82$\xd1\x02q\xd3[%(\xd3\xef\x129Z\xa0\xd5\xeb@\xab\xc7\xf6\x83\nW\xff\xa12\xfe\xfe\xf4\xca\x1f{\xc3\nT\xbb\x81bi\x18\xa0i\x05\xb2\"\b\xec\xb3\xda\x01\xfe\xb0$\x86\xfeH$\x96\x93%\x91ó\xc6(\x9b\xf9k\xacr\xe6\fp\x03pHH\xa6\x12\x14n\x1bV\x9b\xcez\xc7\x18 \x8b\xe4\a\x91\x18\xdaF\xa5\xc6ͮU\xc1\xda\v#\xef_\xa8\xb1-\xc0\xb5\x9fϥ%\x8bqZ4<\xed\x95\v\xa5R\xe3x\x94H\xa1\x85{\x8f\xa8y\x97\x95VĴ\xbd\x809m\xb6ס\u05ce\xd4ak\v5\\+8\x14]\xc4\xf6\xbc\x86\xb4\x15\xf6\xef\xf0\xb9\xffU˟\xd2\xef\x16\\\xb6\x02u1\x1f\x83/\x8e\xbazI\xcd%\xa7b;\xf1Y\xc8\xd4\xde(\x14\xe8[^\x84\xf0nf\xa9\xa5\xe2z\xbd\x1a\xd2.\x90H}\x12\xe4\xc0\x80\x02H\xea\xbeF\x97\xd7\xe5H\xbd0\x98\xfc\xa0~B\xd7\xd6!c\xb4\x98\xe2Y\xceܹ\x92q$\xa9\xc8W\x88\x9b \xbbn(\xa8\xdb\x02\x86\x8c\u007f\xd1˗C\xfaޖ\xed\x05\xb5\xad\x01\x19\x16&ݨt\x96Uy\xb1U\xbfe\xe5A\xb7\xabU\x18\xb2u\xb5&\xe4j\x15\xc7.\x1dz\xbb\xf0\xed\xaa\x19\x02\xf8\x80ͷ\x1c\xe5\xb4:7j\xb9\xd7\xe2\"#\x97\xcb\b\xcbg!\xffN\x93\xaf\x96\x88:pR룱k.ԩ\xa6\xeb\xeb4e\u007f\xc18\xa5\r\x99\xdb\xed\xa2\xf3\u007f\xb3\x98kQ\xdbY9\x89\xc8\xd2'\x99\xc8e#\xcb\x13\x18\xcf\xc3f\v \ffjW\x8a\x06\bM/\xcf\x0eȑ\xde\u007fO%s\x02\xa5@\x1ff.\x9cs''\x1a\xda\xe94Dr\xc2\xe2U}\ag\x97\x10\"\xf6t\xa6\xb9\x89a\x8a\xd6\xf8\xf6\xf1\xe8\xb6E\xb7\xe5S\x125\xe5\x06*\xd2ذ\x11\x95\xfd\x03\xfb\x8e\xdf\xcd\x1a~&\xa1\xb7\xf9mq`t_\xe1x$\xddq \xefw`\xab\xcfY\x15k\xe7\xca\x17x\xc1Tw\x03\x9b\x1d\xd3\x1fcn&{\xf7\xd5\xcc@3!k\xa8\x80Jݠ\xa0\x8dKyR\xa8\xa9\xbe\x06\xf1\xdd\xff\xbdO\f\xc6e\xc2Ŷ\xa2+\x13g\xc5F\b?.\xa6\x88\xcf7;eu8A\x02u\xd8\xed\xee>\xa7\x1e\x02\x90\xaf\xf2\xbb\u07bcƝ\x93\xd2*4\x9b\x11I\x90*\xf8\xde\xcfn\xe8\xa0\\\x9b\xba5\x88\xcfG4=\bZL\xcc\xfa\x9c\xa0d\xde\xcb\x16\xe0\xe0#\x8f\x8e\xae\xa6} \xfa\x17}\xf3U?I/\x13\x86`6il7\xe0\xd3~\u007f\x80\x8f]#\x80#\xd6Ð\xea\xbe\x13:\xb39A0_\x11U\x1c\x95\xb4\xeaB\xa2\xe3\x0f\x06R\v\xe7\xe2\xb9(DqC\xf3}a\x04$\xc5\n\x90\xd1i/\x86\x9c\xa6)\xe6\xdf\x03\xf8\x89d\xa6?\xc7\xf7{\xebpO\xb6\xfb\x87\xfa\xc10(\f\x87Wd3\xe9\xc0\xa1\xed\x0e\x8a\xc8ǽϧ\x06\xee\xfd\xbd9\xe67H-:&4\x17\xc8V3\x98\"S\v\x1e,\xba\xb1(\xea\x9a4\rs\x92\u007f*\xd3(g\xa9\xe6\x9aI\x980\xac\xf9\u007f\x97\x97\xf8bb\xb4fQ#\x12U\x1e4\x17\xeb\xa5\xcc\x04$\xfa:C\x91u\xc2vS\xbe\xd7H4\xc7\xcf\x06p\x12\x05\x85\x00\xa8n|\xbdL\xec\xf1\x17K\x1bX$ƻ\x86N\xfe\xf2u\x03\x1cم\xe3\xe7нD\xa6\x1bc\xf0\x14\xd7Cu8>6\x98\xa3\x1c\xfb\xe2ũ\xe3!hNm䧌\x82&\xd4\xe8D\xe7z\xf0\xcd\x1d\xdb\f\x85\xfb+\xa1\u007fJ\xff\x91\xdeh\x00H\xe9\xed\xb5P\x01\x9d\x83\xb3\x94Z\x1fb(Na\x92\x95\xa5\xb3\x1b\xf0\x17ݤ\x0e\x14~\xb4\xf9\xf8>Z\xbe*?\xb5ŏ8\xbf\x85\x81g:#\xaf\xe6\xde\f\x9b\xfd:I\xf9\xceo3۞\xb8L;Q\xd7n\x84=ތÙ\x9f.\xf4K\x94\x12*\xba-\xb9\xf1\x8c3\xde\xfe`\xb2R\xd7m!\x92n\x8b)\xa9\xb2\v@\x9d\xdf{9\x04\tad\x99&G\xcbYV\xb8\xfb\xc4\f\x17\xa2\xbb*\xb5\xf0\xb7\xf8\xe3\xa0A>\xb1\xd2\x0f\xfa2L\xa3\x84^f6m\x93EQm\xc8\xdbN\xe1\xb3\x02\xdc?0\aC\xb6\x18yH\\o\xf1\xe6\xaa#䊮\f\xe6؏`\xa3(|\xafR!CQ\xce\x01\xcewԱ~R\x87\x8b\x98\xe4\xfaS\xb8,3-G\xd3\xc5\xf2I)\xb2\x10N\x0f\xf7\xda^\xdb\ac\xbc\xe6\n\x18I\x0e\xf5\x8a· W\xdcC\x92\xb9>\xc9|b\x97G\x92\xdaӥ\xa9r\xec\xc5!\xee\n\xc7\xf8\xfb\xd8_o\x0e\xa7\x81\xf4y\xdax\xe5[le\xc8!\xa6~\xeds\x9eP4\xf9*\x06\xd1ol\xb5\xdbX\xe7\xf9\xa0Y\xf2\x96|I\x82\x99\xeanP\x1a%\xc3\xf8\x8c=\x14\xe6nвB\x97\xb5\x1d\xc7ߛqŖ\x19\u007f]\x95#9\xcf\xc3\x1c\xb5n\xb0.\xfaŝ\xd1\xeeU\x89\x8c\x91\xe5~P\xf1\xfd\xa2\xe5q1]\x0e\xb1\xc2\x1c\xf30\x01Kw\x84\xba\xe6f\x0e\xf0#q\xbd\xafW\xf3\f3\x06Z\x03\x83\x0fP(ڟx\xde\xde\xd8ɰ\x85\xb7\x98\xfc\xf9\x05\x8aD\xb0e=;\xc1K\x98lF\xa6I\"\x14^\xa1E\xed۽K\x8c\x10ΎX\u193c\x8b\x89\xbfR`\x81w\xd8\x0e!\xaa\xcc\\\xb4\x8a\x87&UB\xffr-\xf0\xa5V+\xa8se0\x9dn\xb1\x94\x90\x96\xfd\x1d\x17\x01\xc0x\x97_\x98\x9c\x80\x15\x80\"\x823\xbc5\xbbZk\xa3\x1b\xdaԂ\f\xa1\xa5\x1a\xd0#\xa3\x0f?8\xd7ݮk\n\x9e\xb7\xa2'\xb4%\x83+\x9b\x1b\x81\f\xd5t\x8b\"J\xc85A[\x80錏\xd3f\x97-\xefۑ\x85p\xf4\"(\x85\xaf\xf4\x04\xa1f\xf54\xe0\xfd\x1by\xa1K'\xecg\x04\xb2\xf4\xe1\xf0\x1a?\xd4\x10\xa7\x13\xa8%|\x06\xcd\xc0:h\xd7A\xe9\a\x96L\x88\xfb\x17\x05\xa9K\n4Ę\xf4\x9dQ\u007f\xb4\x82\xe9!\xa7\x10\x8e\xc2>\xfb\xfc\x9b\xb1\x01\u007f%\xf4fJ8~\xfc#\x1a\xcez\xa9j#&\x14\xea\"/^\x98\xa5?\x92\x19}[Y\xf8þ!\xcd;;rj\x82\x94\xb9\U000ac211J\xda\x17P\x8cN\u007f`\x9c\x864\xdbK\xacY\x96\xccP\x17\xe7sa\xfd9\x1e\xf6v\xa5\x13fq~ \x19\x1d;%6\x1fJ\x137\x1b\x84\x82\xcb6\x194\x87\xfe\nP\xf8J\x99\x8d]I\x03\x01\xb0q\"\xe9\x1e\xd35\xae\xf4\xa0\xe8,\xe5\xd7]\b,\xfd\xd1xK\xc8\a\xb83\xf0Ҟ@V\xff\x8as\xc7\xd2r\xb4\x1e\x13\b*UHFo\x8as\a\r\x1a\xb4<\xb7\x87ޞ\xb1\x14\x06\x9b66\xe6\x80\xd0\xd44\xb7K\xb1\xf7\xa6\xc3\x04\xd4\xe3\x19\xf7\xf2)\xa4a\\\f\x95@\xc9\xe5\x11iO\x1f\xec\xecA~\x06\x869\x18\xff\x99Ao\x02\xa2F\x9aYa=m\x16\xfb\xcfU\x02\"03\xe4\x82\xe8͛zz\xa8F9\xb9\f\xa7\xf2\xf0N\xf9\xdf\xd0T^\xe0\x92&\xe4V\x9e\x17Q\x1b\x82\xe5V_\x94\xe9\x16\x15~\x14\x1b\x84\xa3\x17\xab\x98BE<C`\xae\xe7?q/WKk˱}!؍\xa5\xfe,\xdeu\xc1\xffw\xf3\v\x11\x81g\xe8\n\u10c6\xc5ә1<z\x1cΏ9\xe3t\xd9U\x89\xc3BH\xc3`\xd6\xe9b\xfb)`]\xab5\xefݿ\xe4\xb3w:8\xb0Rz\x18AC\xbesU\\(\xe23\n]\x8d\xe8\xf3\xd3m\xf1\x96\x9b}\x8epU1\xd4\x06\xa1\x8cex\x96\x9e\tk\xe8\x16\xbe\xdaaG\x8a|\xd8x\x9fyG\x94\xd6:\x91\xfa\xa8xK\xb5\x15`\xc3\xc9\x04\xdc\f\xc9ԛ0DTs[זs\x8a\xb6\x8fal~\x84\xb4bE\xe4S\xdd\xcef(E\xa8\xee\xec}\x00z\xaeZ\xbf\x9d/2\x96\x00o\x0fͿ\xf7f-ԥ\x12\xb7\xb5\x86\v\x8e\x94:\x1a\u007f\b\x11\x1a\x03\xa2/<\xc0Ȉ\fS\xe6ghl\xa4\xfcR\xf5\x88'f\xb3\x00o\xeb\xc5\x19\xf0RQq<\x86\xa5\xb0\xeb\xfc[\x04a\x9d \x0fF\xbc`\ae\xea\xf0\xb3\xd4\xde\x1a\x1d\xd8\x01觚\x18a\x8d5\x98\b\xd2P\x0fڅ\xf5\xbd\xb3{\x1e\x9b+d=\xa3d\x95ʼ,\x1b\xa4\x8d@6h\xb7\xa1\xaf\x1b\t\x15`\xa6\xb1ދx\x01z\xa7\xc6\xfd:a\x937T\x93Tj\xed\xf1\x03\x04\\2\xbb\xdf]\xaaq\x12\x89\x17\xab\xb2\xd5˴\x88z\xe6\x91\xddt\x96\x13\xect\xa2\xb0\xf3\aG\xf2\xf6\xb4\x06\xc5G\xb9\xb8ٞ\x16\x0f$\xc2o\xc5\xc4~\n}뤴\x10\x18\xb3\\\xe4\xfb\xed\x02\xcc{\xc3{\xd9\xc2;\xf4\x9b\xcc_\x02\xed\xf1\xf16\x1a<n\xb7)\x94Y[\x93\x892CL\xaf\x80\xb5\xaf\f@i\xce\xf5=\xb8\x9c=\xddrd\xb5\xff|\x17K\x8e~z5\xc1\xe27}\x00j\x9e85\x1c\xc1a\xc47\xf2\xae\xd9\xf7ڨ\u007f\xfb\x91\x84D\xa27e9\"\xc0O\x14\xfa\x04\x06\xc2\x18j=\x94G\xf3@L(\x94\x987\xff\u007f\xe8\xd2j\xfd\x1f&\xd4=qZ\xbc!\xdb\xe4e\xd2\xeae\u0602nB58ce\xf6\x85\b\xa1\xado\xca\xd7\xf2\x94ZL\xe6\xe0\x1e\f\xab\xe3GAn\x9e7i\xe8W\x84\xfa\x1fm\xad|\xc4&\xb9\a\xb7X\x8cD-\x1f@H\xe8\xa9>\xc4\xe24@\x14k\x9d[rG\xa8\xe8uK\x91mr\b@\b\xab$\x11\xef\x8d\xe9\xd3\\i\x11\xa51\xf1ul\xef\xe0\x80\xd8D\xf0\x92=S\xf5\x16\x9am\xf1X\xbd\x8e\xa95\x8f\xf1y\xf4Bf\x04\x89\xe5\xdb*\x15mAE!\xaf\x1c\x05'+\xce3`\xed\xc8ڹfE\x86\x82\x10#\xfa\xab\x80\x98\xa1\xba*ߑ\xe1\x91o\x9bBN9\x84>\xab\x96\xc8\x1c\xbd\xc1.wT\aI\xe2\xab\xf4\xed\x16ثCf\xde\x17=]F\xd8|9\xca\x11\x8c\xe8\xf6\xe9\xaa\b9\x01\x92\x96\xdaŜ\x16) \xccp\xb6\x11O\xf4\x9c,\xff\x006\xe2\x91\xc8m3lH\xae\xee\x1f\a\xe6x\xd3Fl\xd4\f\x95ы\xae͗\x125q\xb1$\xb1aL9\xe9em`P1\xbe\xfb=\xb6\xea\xee\xd1&\xf6:\x04oa\xf9\xb3H:\x87o\xb2\xb9I\xf2\xb8\x16\v\x16m\x8d ,\n۔\x82P\x80\xbc)S\x82\xfd\xaf\xa5\xe6C}\x10/A3?\xca\xc3\xd9*ϣr\x1d\xe4\x80tT\xc9N\t\xfd\xc4T\xc0\x98\x8eWpwĐ+v\x0f[\x84S\x18Nu,\xbd\x9c\xf2\xa1շ\nQ\x13 \xd0\xd7<ʞ\x06\x98!\x81\xba\x1cx\x9c*\x1b\xb9Ľ\a\xfeI\xab0\xb2FP\x91\x1e\xea\xa9\x120\x92\xb7E*\x87\xf8\xb1\xbfT\x91\xf5rW\xb2\x81\xe4$-\x82\xa2\xf7+[ǵ\xb0\xba7\xba\x83\xad<\x1e\xfa\xc0\x9e\x16j.\x118q\xbbg3\xd0A\x0f\xfcP\xb3\x03\x18!P\xfe\xcf\xder\x82\xb8\xde\naHM#\x9f\xa1\"]\x82\xfd\xdcn\x8eW_\xc1\x1bt%\xc7\xeaH&{9Ӊg\xa3:f1\xf9\xd5}\xbb\r\x9f\x88섔hY\x92\b,\x8a4-\xc8Jh@c2V\x9c\xb7\xfa]\x0e\xa2fG\x98\xafWi\t\r\x01\u007f\xd2h\x8dp7\xf9(\x1cGJ>-\x9ag鵿\x92\x9f\xca\xea\x15\xccW\xb9öAC\xda6\xc6c\x92\x89\xa2\xe3\x98\xfd\xa39\x97\xbd\xf0Q\xa1\x18\xfbJ\"-\x02Řg\xe0\x18\u007fx\xe3\xc3F'\x85\xce\xf1\x03\xf5\xa0\xbd\x03wl\x9e\x9a\x8an\aз\x1c\x95ĕeW\xfe\x98\xcc\xd8*\x98H\n=\xbe\xba\x12с\xc0\x87\xf2k\x19\xfe\xa2\xa8:t\x1c!~\xcdHr\xb6>\x88Lg\x16y\x10\xf7\x1a\xcdd\x14\x8d\x85\xa6\xf7\xdaؘ\u0089%3rm\xc4\x15\xb6\xe8η\x03U\xa1@X2}\xba\xf3\x05\xe3\xae)[>\xca\xcf_\x85\xffP۾\\\xf6\x9b\x1b7X\x9b\x91\xfc\xe3)\xfe&\xf3q-!\xd5\xf7\xfdr\xd9_it:\n?\xf1\x98\xeai\xea2\xa7\xc0\"\xa5\x0e\x91:?4\x96\x15\x9d>\xc3e\xbd\xed\xc6J\x11\x96\xa9t\x16\xaa\xd4\xe2\xd4\x11$w\x99\x02iL\x04ޡ\xedu[\xef\x02g\xd8d\"uC\xf7\x9a\xfb8\x8eu\x82\x03\xd75քx<\x0e\x8c!\xf7%66\xe1!\x00\xe5\\T\xeaD\xcb\v\xfet\xe4\x12\t\xc7c\xdd\x10\x83\x00\x01\xee\xe3\xb2\b\xfd\x05\xae\xb0\x90\x12\xf9\xd6\x156i(Ob\xb4)z5\x9f\xf8>\x1d>\x12\xac4\x19`\xf3\x0f\x05\x12\x8fХjĘ\xfe\x8b\xf0\xabU߮\xf7\x8fK'\x1f\b\xd6E`\xebd\xeawk0\xb9F\xbe\xd3\xc7\xd0\xfbB\x90\x88S}\f\xc2\xf32\x9e&\xaa\x10\xb0Ǎ\xfc\xba\x16\x01\x9b\x99!\xfbXU_\xef~\xafVD\xa6\x86v/wI\x99cc\x88\xda@FB\xf7M=Tr=\x12\xd3pBx\xcc<\xc3\xd5\x01\"\xe0]\xb8\xc7Az\xbd\xb2}\x0e2g<5\x87\x19l+\x13\x11\xe6Z\xe8˩\xe8\xc5x\x94\x0e\xb1O\xedP\xeb\x96r\x9dl\xd9\x0f\x15\xb9J\xd8!\x87G\xb8f\x9d5\xab\xe0F\x01\xfb\x11w7\x84\xf0\x13\xc1\xbf\xa9E\xafӢ\x9f\x10\xcb\xe7D\xe1vB\xbdw\xc7\x047o\x96\x13Fm\xa9\xcf\x125\xbe\xddZ\x1f\xc7:\x16(gՉK\xf5(&D5\x8e\xbb\x0e\x1c\xe1\xa3\r\x11\x11:y\xa0[\xca\xf1\xb4\xc4\u007f\xe0U\xcb\x06\xe3\xbbZ1V\xbf\n\xf5\xe4\x02\xa0\v{9n\x99d\x1a\xe1\xb8?\x19wP\x0e\xc3m\xbb_\ts\x16\xdc\x10?\x98p\x95\x84\xeb\xba\x1b\x0fў\xb1Z\xf5'\xeb\xa7#\x9a\xab\xb7H\x9bD7\xf3l\x11#\xc7H:nc\xdb\xc0w\xf5\xb0\f\x8a^\x88+\xdc\xf8\"\x1c\xd8\xe7\xb4\xea\x0f,\x16Eъ\xa7t\xd8Θ\xffzw\xfeP!\xa1-9f}\x1d4\x8a\x87+\x81\xe7x\x97\xec,\xc6\xf4\xd8\xf9\xe1qt\xc28\xfc\x80ũ\u007f\x1a\xd7\xd1\xedfJ;\x99\xfbh%U\x8f\xd4\xd5h6\xaf\xcc\xcc1\xd2\xdf>D۾j\x0e\xa5\x84\x8cVD\xd0_s\xc84\x1d\xb4\x9fW\x01\xef}$+&\x8e\x05\xcdΣ\x96h\x9b\x1f\x9dZNV`\xad0y6\x19]\x10*\rq1\xb1\x01l;=\x19\xaf=\x1f:Sm\xfc\xf4\xb5ƭ\xe5U\x19\x04\x19\x13\xf6\x89\x8dG\x9f\x13\xe2\x1a\xfbw\xf7ԧϧ\xef\xbc\xdf;e8U\xa7\xb4\x83S\x85\xc0 \xb8\xda\xe9\x19f\xe2\r\x8b\x1a\xce\xcc\xc4\xf1T'\xf68\xfb\x9b\x8a\x92\x92\x0e\x89\xac\xb6N}P&\xa8\x17\xaf\x9f\x19\x9d\xdf,\xb4w4\x18P\xfa]\x81\xa0o\xef<4Ϗ\xb6\xc5\x1d\t\xd6b\xfbW\xaf\x95\xce\xe6\x16\xf4\x17s\x01\xf1\xe9\xf3:\xd1*\xd1\xf5zɤ\xfe\xc5|\x02\x8et\x9b\x13\x92\xf1\xccGKy;\x97\x88\x8d\xfaBi0\x95\x8b\xb8\xe4\xed\x05\xe9-\xe8\xc2\b|\xb8\xf4C\x95\x9e\xa9U\xae\xa9\xaa\f\x9e\v\xb0\x95\xf2V:\x9aR\xa1\x98\">\x1b\xbd\xc5Z\x18\xfbK\x02\xa2K\x92s\xf4.\x10\xb2\x8d\xe5\nǚk\xb7>\xd8\u07b8\xf4\xa3ً:\x85\xe0\xc7\xc18\xe5\x8a\u007f\xfd\u007f\xb0\x96T\x06\xbe\xbea\x91چU\xc5u\xb83\x90đ\xc7\xd2p\xd3\u007f\xf3\xc18\xa2\xa9\xa2k\x04p\xec\xae&A=\xb6\xb3\x1f\xe6o\x97u\xb4v\xbb\xf2p2I\x1cڻ*\xa5T\x9c9-\xbb\x9fI1B\b\xb1\x8f\xb7\xf1\xe5\xaf\xdes\x18\xba_\xff\x90Fɽ]\xc1zs\x86\x86\x9b\xea\x0e\xa43^e\xb9\x977\u007f\xd6\x19s\xc9\x03\xc5ր\x85^\xc6\xe4oq\xb6\xb3\xf0j\x05R\xd6\u007fVø\x9b\xd9\\y<e\xd5\xe1z\x12\xfc\x89\xe7J|3\x91\t%\xdd\xce$\x1e$\xf1$S\x15\xad\xf2\xb8\xde\xf7\x1ekmF\x14\xd5ә\xb0\x96\xd1\x04Vj\x91\xb4\x86W\xd1\x0f|)\bYb\xf1\xb8 J\xe0R C\xcdç|\xc1<4]0h9\xbe\x01CF\xf3cى\x03\xccŧ\xb1\xaej\x0f\x95\x83\x9e\xc4M\xcb\vBҹM\xbfi\xe9\x8bh\"\x99k{\x9cCX\x95\xf4\x19\xb0\xaft\xef\x05\xab<\xeeř\xd9`Ϭˋ\n6\tA\xd5\x1e\xa1H \xa1\x88\xc5\x1e2V\xfc\xec|\x0f\x926\xf7K\xfbJ\xc2\xe8\xfe\xb4\xee\x94\xda\xcb\x05\xb30\xe7x\xec\xffp\v\x10r\x02\xb6\xbbz\xc7\xfe\"\xdc\xc9\xc6\xf7`g\xe3\x16\xd1\xec\xe4m\xc2\xff\x0e\"\x18\xc2ī\x19э\xddA\xfe4X8f\xa8[\x1c\xd5p\xf9\xfdU\xf5\x92j\xbe\x92\xa0\x057y\xbe\x81\xeb\x8bU(\x00\xbfIFeՇܾ\xd7ǖ\xbeTGw\x98Q[\xcdu\xd2o\x1b\xd0\x1c\xccO~\xc3(\x9eؐ7튗\xbd\a^\xeb-\x97ైB\x93\xe3\n\b~\xc0\xf2rȡ|3\x04\x1d\x14l\xf1\xf7(\xbf\xa6\x14\xb6\x1e\x82v\xf4\x04\xbbL\xdeB\xae\x9b\xde.\xcb\xe3Z*47\xf5}\x0f\x8fwT\xdc\xe6$5V0\xa1L\x1apӽ*$\xf4\xa8?H`\xe7\xe7\xfe\x8c\x1f\xb8\xff\xfcJ`\x1dd\xc9X\xa2\xe3\xefk\xa0\xae$\xb8D\x88R[<\xf1_\x91K\xb1\x9a Ɲ\x14~^I\x13;\x80\xc5{\xdc\xee\xfḛ\xaa<r\xb5\x876\x83z\x12ؿ\xb9\x9fǁ\xaa9\x0e\\e\x8fO'<Ѭ&4\xb1\x88m\x97\xa5\x9f\"\xcb\xee\xacc\xb5\xf1\x8ez\x8e5\xd2\x0f--ۅ\xee8\xee\xf9\fg\x86\x03>\xb7\x1c_\x91\xe2\xfe\xee\xeb\xa0HZ\xcd\xd8\xffr5\xb0\x80\xb5\xe6\xca\xf6\xefo\x9f\x1b\x81\x86\xda\xceQE\xb3̑\xc2\xe2\xb4\xf4\x89ų\xbc7Gw's\xf9\xa8t/vK3\xa0\xc8kU\xbaB\x91[\x80lMǏ\x00(\x16)\xac\xd1\xd0Faʇ\xb1\xfe\x97\n߰\xa9?\x15y\x1eғ\x04\xba\x9a\x1bcɩ\xea\xbcZ~\xe0\xe9B{\xcf\n\xf3\xf8\xa7\x8d\xe1\x16\xa4C\x93\xab\x886ե\x9a^x2t\xc3\xc1~\xd8\x03jxs)\xdaeg\xd3\xfb\x1fի\x10h\xae\xa4?\x19bmz\xa6?\xe3\xd2\xc0\xff\xbb+o|\xee\xee\r\xb1\x12\xd4Ӕ\xb6\xf8\x11\xb9\xb9p\xe1 9S\xea?\xab\xa5\xa3p\x91\x9e=\x1dǤ\ri\xd6=G\xe6\b\xb8ג\x8c)oh\xeaA\xfb\x03\xa3[\x15\xe6\xd0#\r\x9bd3\x8d\x05\xfeUW9\xb7\x92T\x19\xed\xcf\xeaܗ\xe9\u007f۰M1\x8a\x90\xfd\x1f\x836)-dz\xfd\xfb\xef1\xdf\x1b\xad\x03Y\\p{z:Q\xfa\xa7\xf3#\x17\xb4\xac\x04\x0f\x80\xd9\xdf\x1c9dr\xcb'\x15h{\xe4a\xb4C\xf7\x81:\u007f\xdc=ǼF\xc3\xfb\x00\xf0\x03\x9bh\x90}\u007f\xffķ\x01\x80\x85\"\xd5\xe4\xe7\xc2\xc9*\xd2y\x11\n\x86\xfd\x06\x82\xac\xa3X2\b\xc15\t3X\x80\x1b\xccS){\xe9RL\xe1Q(\x85\xc0\xd1!Ds\x04Q\xaf1\x10\xecw\xedi\xb8\xde\x1a\n\x8b\xb7\x8b\x0f.[\xd0\xe8\x9f2Y\x1a\xa3\x1f\x9e#Tu\xed\xf3\x12\xdfU\xa4\xc7]Q\xa1\x8a\rs$-\xdc\xf2\n\xea\xe8;\x11\x80\xdaK\t^\xa6\xe8\x8cpEi\xd2+I\x05I\xb4\x92x\x83\xa8H\x95\xac\xd72\xbd\xe5\x9f\x1b\xf2\xbc\xee\xff\xd3!*|\x1f\xc9\xfb7o\x16K\xc1Z\x88H\xc8|\x8e6\xce\r\xcdT\xd3)\x14\xa2Ҫ\x12j/kH\x8e\x8dtцF\xa1Ź.uۧ3V5\xe9X\xd4f\xbc\xae\xdbR`_LI\x8f\"E\xf3\xb9u\x82\x98\xa8d<\xe1\b\nqE\x8d\\M6\\N\a8\xcaP~\xfb\x18Ԝ>\xa3#\xa4\x15\x85٫IRƱ\x90,\x80%\x8f\x10\xac;]!\nE\x1a\x85W\xffX\x95\t]\xf5b\xed\x9ea\xf54\x8d\xd0\t\xe6\xd7=\xfe\xa0}m2{\xe0\x80@-\xfb;+\xdf\x1b(\u007f\xcbn\xe7\xd6%\xe9\t\x90\x92~i\x1f\x8e\xf4g\xb3aT]#\xca{\xe7\x81K\xa5\x00\x85\xf5\xdc\xf0\xc2\xd2.\x8d?E\xf3t\x93\t\x16\x8c\xec\xdd\v\xa8c0\xd1}\x92%K\xcb\b\xf8Q\xc2\xfax\x81\b\v\xf4\x9f\xfey\x04\xf1n<\xf7)\xd6\xc6.\x99\x15h\x99\x17pmٙM\xb9N\x05\x17\xc4[\xc0T\xa4\xa3\xa7\xden\xa4\xffeYt\x81z\xfe\x04\x13|\xe7\xe8\f\x01S\x88u\xddl\xf4\xea\xf9\xef\xd5ej\x01(*9\x9b\xe7'\xd5k\x92b\xa4\x9c|9\x10݃p\xeam\xdce\xf6\xe5\x14\xf9'\x9dnj\xe27N\xbb\xda\xc8g|\xa1۴\xdb}4\xe53Z\x00\x89\x85\xa7\x9a\xb9,j'\xcb,\xbfI\xdb\x02\x86+\x90\x88\xc8(p\xfbQ\xa1\xf5`Y}\x96SƧX\xa2\"\u007f)\xadͪ\x83ˆ(\xb9\f\x02\x8e\xf4+ȓ\xd6\x0f\xba^\x86JLcqw\x16dc\xbe\x05\ne\x05\n\xe2H\x99[\x0f\xb9\xbd\xc3\xd0\xff\x1e\xb8\x8d\xaf\x81\xb3\xbd\xae\x01\x1ci@\x11I!\xd0u]\xaa\x92όj\x8e\x18Eo䏂\xf3\xba\x96\xf8\xb6)\x9cl\x80\xa5\xd5q\xef\x1b\x98|\xfe\x05\xd9+\xe1\xc7 6\x10\xc8Y\xd9-<9\xa7\xb2=\xf3ԓw5\xe7>\xd4ʧ\x10\x10\x10\xcfl\xe6i\xa8:\x9e\xa8'\x8c\x84\xde\xd4\xff\xfed\xdbU\xd6\xe8\x1d\x8bӴ<\x8dTx\xca/o\xf2+-$\x90l\xcb$5S\xc8\xfe\x19wvP8\xb8vj\xbb\x8f}\xbfZ\x04\xe1\xa1\xe1vF\x1c\x1f-j|\x1a_\xcfН\xd1\xcfX\xf5\xaaqFGi\x1d\xfdk\xaeV=\x15\xb2\b<7\xfd\x8df\x9b7\xfa\x87\xd0\xed\x1bX\xb1\xaep\xb9@X\xfb\xc2I_B\x10\xba\xdb\xf7\x9c\x9f\x90\xae%\xe8u\xb5\x12PM݀7\xc3\xeb\x12\x92c\x88\xf0\x1b\xb8JA\xdeS\x03v\xd1\xd3ά\xf7\xfeG\xcc҄\x90%\xaf\xff\xa6\xb3\xd4\xee\u0605\\\x19ۛ\xbdѝ\xc7*Y^\xa6\x14\x16\x81\x83\xdbMm\v\xe5\xac\u007f\x1f\xc35@\xc2\xf6\xe3ȟ\xfe0q\a\x9bx\x17oN.d\u007fH٢g\xe0y\xcfI\xef}b\xb6Qt\xa6\x02=ˢ3\xe5\x1b\a\xa0A\xcbӃQ\x84\x85\x1f\xe4\xfa^\xc91ht\xce\xf7\x10\xc0?\x186\n\x10\xc8M9<\xd4V}\x8f(\xb3\x8eNh\x1c\x8c\xae\x9a\xfc@\x02A\xfa\xfb!\xd8L\x17$\n6\x1fh\x93K8QeM\x92\xeb\xd1\xfc\x90\xaaY\xf7\xd28\xdag.\xaa\xca;\x1a\xf8\xb4\xc7\xee\xf4\x1a\"\xcch`ْ\xc0\x12\xc6U\xb7\xf5\xcd4q?\u007f\xd5\xd84`\xc82\xa7\xb1\aA\xf3\xab)\x12\xce\xed\xadC?\xf5\x10r\xe4\x13\x84\xa2ǚ\xa9\xc5\x15\xa9\x91\xc8*\xfa$҃\x19\x8b\x85\xf5\xa0t+\xca\xdbኑ\xfd\x82\x12\xf8/#\xc2x\xed\xafm\xba\xdd\xd9\x1e\x9dӪQL&\xd8\t\x89\x19\xb2o2{b\x97\xb7\x1a\xb3\xf1\xa7:\xd7\x14Un\xb5\xbf\x99\ff\xa1B\xa3\x97\xc9_\xe1\x1cP\xf91\xde\xc9m\x9dB\xac\x81\xccL\x1c\x1e\xb8\xf2\x18K\xfctJ\v\xcb7+G\xb7\xf5\xf4K˴\xd0,\xe7A\xe9ϯ\xaf\xe1M|~\xeb\xf77\x1f\x01\xc2;G\xb8f\xcd\xca\x16\xe4Z\xf6|\x13\xd6\xc1\x94%\xddY`\xe5\x1fb\x8dX\xa4s\xd8~a\x14\xb4U\xad\x8ftd\x03\xe7띹\x8e\x15\x91\xfa'\xdd\b\xc3h;\x95vG\xda\x15m\x84!\xa0cZ\a\x85p\x14W\xf8\xf2\xb0\xfb\xaf\xef\xf9\r^o\xcekQY\x93\x04\xae\tJ\x89\x8b\x84\xc8\xf0̴\x80\xf0\xc8fVh\xcb\fM7k)\x11a\x1b\xd1i\x12\xb2K\xba\xf2\xf6\x92\x84J\xbdW\xa3\xcfW\xba\xb9\xc2\xc0 õ\xc2@0\x00\x06\x13(\xefnx\x97%\xd7ltY\xffV\xd3\xe2\x81\x14\xfe\x8c&\x93\xcb|\xb2\x9dp\xce\x05(\xf0i1\x00736799\x00\x00736799\x00\x00736799\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\x00\x04\x00\x00\x00R98\x00\x02\x00\a\x00\x04\x00\x00\x000100\x00\x00\x00\x00\v\x00\x00\x00\x01\x00\x04\x00\x00\x00\x02\x02\x00\x00\x01\x00\x02\x00\x02\x00\x00\x00N\x00\x00\x00\x02\x00\x05\x00\x03\x00\x00\x00HR\x00\x00\x03\x00\x02\x00\x02\x00\x00\x00W\x00\x00\x00\x04\x00\x05\x00\x03\x00\x00\x00`R\x00\x00\x05\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x06\x00\x05\x00\x01\x00\x00\x00xR\x00\x00\a\x00\x05\x00\x03\x00\x00\x00\x80R\x00\x00\v\x00\x05\x00\x01\x00\x00\x00\x98R\x00\x00\x1b\x00\a\x00\r\x00\x00\x00\xa0R\x00\x00\x1d\x00\x02\x00\v\x00\x00\x00\xaeR\x00\x00\x00\x00\x00\x00%\x00\x00\x00\x01\x00\x00\x00/\x00\x00\x00\x01\x00\x00\x00\xb5\x01\x00\x00d\x00\x00\x00z\x00\x00\x00\x01\x00\x00\x00\x1a\x00\x00\x00\x01\x00\x00\x00\xad\x0f\x00\x00d\x00\x00\x00\x90\v\x00\x00d\x00\x00\x00\x04\x00\x00\x00\x01\x00\x00\x00\x1c\x00\x00\x00\x01\x00\x00\x00(\x00\x00\x00\x01\x00\x00\x00\x05A\x00\x00\xe8\x03\x00\x00ASCII\x00\x00\x00fused\x002020:04:11\x00\x00\xff\xe1\x0e\xcchttp://ns.adobe.com/xap/1.0/\x00<?xpacket begin='\ufeff' id='W5M0MpCehiHzreSzNTczkc9d'?>\n<x:xmpmeta xmlns:x='adobe:ns:meta/'>\n<rdf:RDF xmlns:rdf='http://www.w3.org/1999/02/22-rdf-syntax-ns#'>\n\n <rdf:Description xmlns:exif='http://ns.adobe.com/exif/1.0/'>\n  <exif:ImageWidth>4032</exif:ImageWidth>\n  <exif:ImageLength>3024</exif:ImageLength>\n  <exif:Make>Google</exif:Make>\n  <exif:Model>Pixel 3</exif:Model>\n  <exif:Orientation>Right-top</exif:Orientation>\n  <exif:XResolution>72</exif:XResolution>\n  <exif:YResolution>72</exif:YResolution>\n  <exif:ResolutionUnit>Inch</exif:ResolutionUnit>\n  <exif:Software>HDR+ 1.0.300173574zdh</exif:Software>\n  <exif:DateTime>2020:04:10 21:28:40</exif:DateTime>\n  <exif:YCbCrPositioning>Centered</exif:YCbCrPositioning>\n  <exif:ExposureTime>1/15 sec.</exif:ExposureTime>\n  <exif:FNumber>f/1.8</exif:FNumber>\n  <exif:ExposureProgram>Normal program</exif:ExposureProgram>\n  <exif:ISOSpeedRatings>\n   <rdf:Seq>\n    <rdf:li>1040</rdf:li>\n   </rdf:Seq>\n  </exif:ISOSpeedRatings>\n  <exif:ExifVersion>Unknown Exif Version</exif:ExifVersion>\n  <exif:DateTimeOriginal>2020:04:10 21:28:40</exif:DateTimeOriginal>\n  <exif:DateTimeDigitized>2020:04:10 21:28:40</exif:DateTimeDigitized>\n  <exif:ComponentsConfiguration>\n   <rdf:Seq>\n    <rdf:li>Y Cb Cr -</rdf:li>\n   </rdf:Seq>\n  </exif:ComponentsConfiguration>\n  <exif:ShutterSpeedValue>3.91 EV (1/15 sec.)</exif:ShutterSpeedValue>\n  <exif:ApertureValue>1.70 EV (f/1.8)</exif:ApertureValue>\n  <exif:BrightnessValue>-2.78 EV (0.50 cd/m^2)</exif:BrightnessValue>\n  <exif:ExposureBiasValue>0.00 EV</exif:ExposureBiasValue>\n  <exif:MaxApertureValue>1.70 EV (f/1.8)</exif:MaxApertureValue>\n  <exif:SubjectDistance>0.5 m</exif:SubjectDistance>\n  <exif:MeteringMode>Center-weighted average</exif:MeteringMode>\n  <exif:Flash rdf:parseType='Resource'>\n  </exif:Flash>\n  <exif:FocalLength>4.4 mm</exif:FocalLength>\n  <exif:MakerNote>20079 bytes undefined data</exif:MakerNote>\n  <exif:SubsecTime>736799</exif:SubsecTime>\n  <exif:SubSecTimeOriginal>736799</exif:SubSecTimeOriginal>\n  <exif:SubSecTimeDigitized>736799</exif:SubSecTimeDigitized>\n  <exif:FlashPixVersion>FlashPix Version 1.0</exif:FlashPixVersion>\n  <exif:ColorSpace>sRGB</exif:ColorSpace>\n  <exif:PixelXDimension>4032</exif:PixelXDimension>\n  <exif:PixelYDimension>3024</exif:PixelYDimension>\n  <exif:SensingMethod>One-chip color area sensor</exif:SensingMethod>\n  <exif:SceneType>Directly photographed</exif:SceneType>\n  <exif:CustomRendered>Custom process</exif:CustomRendered>\n  <exif:ExposureMode>Auto exposure</exif:ExposureMode>\n  <exif:WhiteBalance>Auto white balance</exif:WhiteBalance>\n  <exif:DigitalZoomRatio> 0</exif:DigitalZoomRatio>\n  <exif:FocalLengthIn35mmFilm>27</exif:FocalLengthIn35mmFilm>\n  <exif:SceneCaptureType>Standard</exif:SceneCaptureType>\n  <exif:Contrast>Normal</exif:Contrast>\n  <exif:Saturation>Normal</exif:Saturation>\n  <exif:Sharpness>Normal</exif:Sharpness>\n  <exif:SubjectDistanceRange>Macro</exif:SubjectDistanceRange>\n  <exif:GPSVersionID>2.2.0.0</exif:GPSVersionID>\n  <exif:InteroperabilityIndex>N</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>37, 47, 4.37</exif:InteroperabilityVersion>\n  <exif:GPSLongitudeRef>W</exif:GPSLongitudeRef>\n  <exif:GPSLongitude>122, 26, 40.13</exif:GPSLongitude>\n  <exif:GPSAltitudeRef>Sea level</exif:GPSAltitudeRef>\n  <exif:GPSAltitude>29.60</exif:GPSAltitude>\n  <exif:GPSTimeStamp>04:28:40.00</exif:GPSTimeStamp>\n  <exif:GPSDOP>16.645</exif:GPSDOP>\n  <exif:GPSProcessingMethod>13 bytes undefined data</exif:GPSProcessingMethod>\n  <exif:GPSDateStamp>2020:04:11</exif:GPSDateStamp>\n  <exif:InteroperabilityIndex>R98</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>0100</exif:InteroperabilityVersion>\n </rdf:Description>\n\n</rdf:RDF>\n</x:xmpmeta>\n<?xpacket end='r'?>\n\xff\xdb\x00C\x00\x06\x04\x05\x06\x05\x04\x06\x06\x05\x06\a\a\x06\b\n\x10\n\n\t\t\n\x14\x0e\x0f\f\x10\x17\x14\x18\x18\x17\x14\x16\x16\x1a\x1d%\x1f\x1a\x1b#\x1c\x16\x16 , #&')*)\x19\x1f-0-(0%()(\xff\xdb\x00C\x01\a\a\a\n\b\n\x13\n\n\x13(\x1a\x16\x1a((((((((((((((((((((((((((((((((((((((((((((((((((\xff\xc2\x00\x11\b\x01V\x01\xc8\x03\x01\x11\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00\x1c\x00\x00\x02\x03\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x03\x00\x01\x04\x05\x06\a\b\xff\xc4\x00\x19\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\x05\xff\xda\x00\f\x03\x01\x00\x02\x10\x03\x10\x00\x00\x01\xf6\x9cmT$\rB\x8a\x14\xa2\x8b\x16)V,YeP\xc4  \xa8\x1c\xbb7KG\x8e\xd4\xef\xe7\\k3ٿ:\x8b\xc1\xde\x14i\xc7E\xeb\"2i\x17+\xd6\x1b5\x97X\x02\x10\xb2\xc3\x1d\b\xa0.VJ\x16<J~\x84\xe5j\xa0%E\xd5\x04$P\xb0\x05\nU\x8b(\xba\x904\x02c\x81\xa7\x9e\xb3\x1dk\x8fM\x9dr,\xecg^wY\xdb/*\x97J\xb9t\x14\xd6z\v\x015\xe7\xa6ms\x10,\xb0\xcb,\x81\t\x1d\x14E\x00,!\xb1\xf7\xeetHB\x88P\x00\x80,X\xa1 (\x14P\x06\x1a\xf3vp\xa8j\xe2P\x94X\xf8q̮\x97>\xc3f}\xf2\xb9tʫ2\x94\x1c\xb9\xf5\x83U\xd9\x06\fB)TYd\x0e[\x14@\xd3\xef\x9c\xed\x10\x85\x14Ad(\xce(U\x04(\x15YƳ\xceW'K\b\x91T%\x1b#B\x9clγ\xcdek6\xb1\x8f|\xcc\x11\xf8\xe9b5\x83\x97&\xf1\xa3:V\xb2$\x1a=\x00J\xb4\xb2@\x86\n汇\xe8\x0e6\xaa\x14T\xb0\xbb\x04\x01\x00\x80*\x95\x19\x0f=\xa7\x9b\xaceՐ\x80\x8f\x8d\xe7g7\x9fI:y\xd6\xec\xeb\x1aqzc\x8b\xac\x94ތ\xe9\x1b\xe4ɶgJ\xd4M¬d\xb7b\xc8Q\xa9\x01ha\v\x96\x02\x8c\x13jS\xf4\x0f\x1b(K$\xa2]\f\x8b\xa1\x16*\xb9g\x99\xaf=I\xaa*%PGF^\xb6u\xadq\xdcs\xe8\xe0\x0fC\x8d\x81D\x8e-\x99\xe7Wc\xb25ϑ\xdb\xca4\u0084Y\xa7;\xab\x94\xd8\xd0E\x96i\xcef\xb4x\xc6m\xeeD4g)֓_\u007f\xe3a*ʖ\x15`\xa2\x80^\x05yz\xe3\xd0\x14U\x01qԕ\xa7r\\\xf9\xeb\x97\\\xd3:\x06\xb8\xf5%ӝf\xbc\xf9նj\xe5\xc3Z!\x95\xe65\x06ivK\x0e\xe6\x86\x19\xc1\x94l]\x85%\x15n\x9csM݁\xaa\xc8\x14\xebr㋧uW\xde9YP\xa2\x92\x10\xcey\xab|\xb5a\xa0\xa1!P6\x82t\xb3\xae\x87>\x81\xbeo\xcfL\xfa\xe6ɮܸnz\bգ\x87q\x8e\u0379\xde\xec\xef\x05\x83X\xaey\x94\xa5\xa9F\xc4\xeb\x98֩i\x02iv*\xcb\x04\x85\x16P\xfcη\x93\x96\x9e|\xb9ޞ\xc3ۯ\xdbyؒ\x80#\x98y\x9b|\xf6\x99ֈ\x94P \x03@\\\xbe\x9b\x8f^g^},\xe9\x16\x16w\xe89\xfatt\xf3U\xc7.ξn{0\xde8f;ua\xae>\xb2\xf9\xae\x97.˱Vhι\x9dxE$\xbb\x02ʕ\x16B\x10\x84\n;\x1e_26OoB\x8f\xbcb\xd2Q÷\xcb\xdbǥ-\x96B\x92\x81\x06\x86\x02\x80\x03~7\xe8\xb3-\x83\xbdE\x88\xd7k\x1e\x84k\x9664/\x1e\xe6\xec\xe8a\xc1ܬ\xceV\xee\x1d\x13a\xcb\xd5\xe5\xdd\xf3x\xb5\xcf>\xf9\xf49\xf5\xe7t櫂Q\xb91!\x06i\x81\x12\x1eKMܹd鯶g\xa7\x9e\xd3\xcbێ\xd1,\x84!\b\n\b\x14 \x8b\x04\xee\xf3ޤ\xe9\xcf.\x89\xec\xd3yq5\x19\xcfף\\\xf9Zᥧg\xa7\x13|\xc3\x17&\xf9q\xb5\xbe\xcevÅ\xbcQF\xactN\xb3\xd2\xe7ڥ\xa1\x1a\xc6}\xf3\x14\xab\x1a\x10Pfaa\x05\x15P\xf49\xa8\xa8\x12\xc2\x10\x84(\x85 \x83A\x03@\x00\xc9tK\xdc˹\x9dd\xbc\x94\xa8\xb7\xa3*\xd3$\x9c\xc9Ӊێk\xa6ƵA\x87Y\xb2\x88\bR\xee\xc7ggVQV*\xe7\x1e\xf9\r\x8e\x8d\x02L\xe1\r\x96\x81A\xb7\xb7\x10\xb2\xd6\x10\x84(\xa2\x14\x94QBh@\x00\xd3.{=G\x0fV\xb9uk\x8dY\x85\xe6\xe6g\xd9\xc3\xed\xe6ū\xb2]2\xe5\xb3-\x96Y\bQ\n!\xb3\x1dNZ(U\xc25\x96X\x92\x1ae\xd5 \xcd(\x85ғ\xb0\xb1,\x8be\x10\x85\x10\xa2\x90K\x16\r,\x10\x05\xa8\x9d<\xeff7\xe98\xf4\xf3\xfe\x8f?\x13X\xe7\xeat3^\xb8\xac\xcfe\xc5T,\x84(\x84(\x85\xcb\xd0\xc7R\x97&\xf9\xe7\xd6\x0eU\xd9\r\xdc\xfaحeW6\x19%ꡐ\x8b\bB\xca\"\x01\x01(\x10(@\x05@\x92\xf6\xf1\xbe]˳\xbe\u007f^;\x16\xa4\xcdY\xac\xb2\xc9-Y\bB\x14B\x10\xa2\x83\x95\xb3H\xd6\x0eQ*\xc6g]N]\x95f}Ó6\xf9\xaa\xc7\xcbف\f\xa2,,\xb2\xcaE\x82B\x96\x85 \xd0\x02\xb0X\xdc\xeb\xd1\xf1\xf5b\xe9\xe7Ǯ|\xddF\x99\x92\xd6\x14B\xac\x84!\v(\xa2\x14@K5g\xa1E\xae}s~w\xb7\x9fP\xb1\x1a\xc8\xd8\xc8\re6zL\xb0X\xc2\x10\x8ba\xa2H@J \xb0hA\x05j%\xa0n\xe7\xd7\x0e\xf8\xe6\xb1v4d\xa2QD(\x96B\x14B\x10\xa2\x88P\xf9w㣳\xbc\xda\xce}bK\xd0\xe7\xd5t\xaddn\niz\xc1\xcd{,\xe3\xcfnQ\bB\x10\xb5\xa0JA\x04\xa0h\n)h\xb9\xa3o%\xe5Ij\x9b\x95\x9a\v\x94\n!,\xa2\x10\x85\x14B\x14Q\x06˫;\x146\x96\x88\xd6:\xbc}\b\xd6so\x01s\aM\fz\xcc9\xbd0$\x16Ye\x10\xa2\x81(\xa0hA(\xa5\xa8\x8aM\xa1ά\xb5]\x8aJ5(@\x14U\x90\x84(\x84(\xa2\x88CNu\xd7\xe7\xdf.\xb3\xcf\xdf'gY\xb7\xcfN64ԩ\xb6\xca\x15#\xb9\x99\x97\xa72 \xb2\x11bQ\x14PJ\x06\xa8\x13\xbb/11Z1\x16\x85\xdc\xc2\x14\b\x16Q\xa2V\x19\x80\xb2\x10\xa2\x10\x12\x10\x84(\xeb\xf2\xef\xaf\x1d2o\x18w\xca\v\xb9dӦ\xad$\xd4,\x8b\xe89\xdc=x\xcb!\x05\x16E\xa2\x90J\x04\xaa\xb3\xd3'\xa7^\u007fLs\xb3y8\xdf!r\xd8)E\x90\x12\x80\xb2\x88i\x96 \xad\x94U\x00\x00\xa4!\n;\\}-γo\x19u\xcd:\xc9\xc25\x97\xe7n\xce\xceZ\xaa!\xdd\xe7r\xf4\xe6\x1aŐ\x00HE\xa4\x10K\xafD\x9d\x8e\xdcs\xfax^\xf2\x19\xd23\xa6y\xfd\x1e\x87\xc5\xea\xf97~ B\x8a!D*\x85\x04\xa0\x81,\xb9H1\xe5\x8b\x16\x01\xaf= \x9b,\xa0\xe36\xf1q\xd3\xe7\xda\xe5UY\x97|\xbdO\x0e\xb8\xb5\x9c\xddy\x11e\x89\xaa\x88\b'\xaaO[5羇\x86\xb7\x8c\xd9֝\xe7\a\x9fӿ\xb7\x9f&w|:\xf2|\xfd\xfc\xf5\x01\bB\x02J\x85\a\x02\x01D!\bB\xcd9\xdfg\x87\xa4\x95\x1a\xc6-\xe2\"u\x96K\x8f|\x9f5Q\xb3\x1de.\xe7&\xf9{\xcf/\xa3\x87\xb8\xbd\xe1\x1b\xe6d\x04UH\x12\x8fqb}\xbe3\xe9\x88\"i\xbc\xf4r\xe6\xad\xf2\xf7\xf8z\x95\x8b\xf2\xdc\xcemQd(\xa2Q\xc5\x00\t\bQ\bBΟ.\xfdn>\x80\xb1z\xca5\x9c\xda\xc5\xc2u\x97ˋ\xa7\x1dx\xeb\x8b|ݝ\xbf:\xc5ӕ3\xf4/'\xa7\x81\xb5\xa6\x0e\xfcI!\x05U\x02H\xfa\x86s\xcd\xe5'\xd1\xc6\xdf\x17\xb5\x1e\xbf\x0f\xb4\xf3\xf5ͭު\xb3/3-\xbeE>z\td( \xc0\x00\xa5\xa2\x10\x89E\x8c\x9a\xeep\xf5i\xc7L\xfa\xc2w\x82\x95w*\xb1\xa2\xecZ'|ٝjι}9\x12\x85\xcd\xd9\xf6_/_\x0f:\xaa\xcc]9\xa7\xa79R\"gXuS\xdd\uf7dc\xf6y\xb7\xf9=\xc3\u05cf\xa8\xe3\xd7s|\xeez=緎~^\xbb\xd1\xe7n\xbc\nyʵ\x83\x02\x00\x05\xa2\x14B\x14\x90َ\xbd\xce\x1e\xa9*\xb5\x8c\xfaɭ\xc25\x81\xb1\x92\xdad\xd6\x17\xac\x8d\x9d\x1e]n9]\xb8\xabQ\xd2}ǅ\xf0\xdc\xfd\x1c\xad\\ڙ{p\xb4\xb4\xb1*\xba\xfb6r\xbco\xc9\xfa\xb8_\xb3\xc9\xdb\xf3z\xb7\xf9}\xba\xb7ϛ\xe7\xeb\xa3\xd1ǿ\x8c,I\xc1\xe9Ӆ\x8c\xfc\xda\xc3V\x8b \xb5\x8bD\"B\x8a:\xdc{\xf5yw]\x8b\xb1vB\x14\x06\xb2\xb4qb\xaes\xeb9wϻ\xe6\xf4?\x17\x99ߟ+\xb7\x17I\xf6\xeeS\x8b\xcb\xd1\xe4[\xcdY\xbasWNF\x90\xa3*\xf5+\xd6I\xe9\xd9\xc9\xd6\xf5\xf3\xbd\x18ͦ\ri\xba\xb9\xb3\x18e\xba\xf1yǈ\x1e\xab(\tB\xd8B\x14Q\x06K\xdb\xe1\xe9Ս\xa6\xc1IBB\xa8Ib\xee\fl\x01\x83\xa7<\xbb\xe5\xd4\xe3إ\xc3גu\x94\xd9\xf7nQ\x18\xe9\xe2y\xfa9\x9ag\xd6s\xf6\xf3\x90IB\x95T'\xb8\x93\xd9o{sϕ\x1e\x9aNf\xb5[\xd5\t^\x063\xf3\x14\xd3*A\\\xabD!\n\xaa!\xbf\x9fN\xf7\x9f\xd1J4\x9b\x04\xa2\x14U\x02\x05\xc8k-\x96\x04\xcf3\xaf\x10\xd4\xedy{ݼn\xfc\x93\xacF~\xedʋ>c\x87\xb3\xccj\xe5\xd6st\xe5[\xe4Uq+*\x88G\xd6S\xa8\x9a35W7}\x19j\xa3\xcdg\x1e\x1e\x12\xa0!\xa4\xa8\x94\x90\xa2\x8a\xa2\x8e\xdf\x0fG[\x9f\\\xe2\xa8RU\x11\x01HU@.B\xe6\xc7\xc6>\x9c\xbb^~\xdc_O\x99sE5\x8fxF\xb1v}\xef\x8dU\xce>=\xfc,\xed\x97R\x1c\xfe\xfea\xd6L\xb0L\x8b\x13z\xfd=\x9d\x1a\xdbȞ\u007f3\xcbG,B\xb1q\xcdQ@\xd5\x02\nQ\xa3:\xf4\x9c=\x054\x02\xc0)n\xc5\\\xea\xc9\r\r,!z\xce}cw=^\xb1\x13W>\x9c\xbfO\x9f\r\f\xd25\x8a\xb9\v>\xfbƍ\x8bƼߟ\xd7\xe7\xf6\xe7t\xcen\x9c\x83\xa7\x13,\x82\x04\x10\xfa\xa2n\xb7\xe7G\x1e4\xc1-\n\x9bA`\x91E\x02\x84\xa4\xe9\xf3\xeb\xe88w\x1b3X2\xd1\x14\x01\xb1\x8a\xb8\xb2\xc0\x02\xa5\xcd̲^\u007fn;q\xba\\\xbdx\"\xd4.}a\x96D\xfb\xbf\x1b\x04\xdc\xf1\xfc\u07bf\x1d\xad\xe3\xd6S\xac\xa3\xb7\tre\x94c\x01H\x962^\x92g\x1d\x9dc\x9a\xeb'u2\xaf\x93\xb4V\x91uG\xa1\xe1۱Ϧqv.\xa4%a\x00\xa1\x06V-\"\xe9ل\x88\xb0\xce\x0f\xa7\xcd\xd9\xe3\xdc\x15]|\xf9\xad\tro\a`\xa7\xdex\xdb\x17s\x83\x97o)\xcb\xd1\xcb\xe93Y\x9b\xa70\xeb\xc6\xd2Ì\xf6\xe4A\xae\x94Y%\x93A/\xb2\xd7>\xaf\u007f*q\xd3>zx,v\x05\xa4fu\xea8\xf6v7\x11Z\x81be\x11kBʨ\fդMx\xa7\"w\x11b\xb5\x9eGn=N]\xa8\xcfۆs6\xa0\xd9\x12\x8f\xbeq\xb0\x1a˗#\xcf\xe9\xf2\xfb\xd73qv\a^\x03\xac(\xcfF2]r*\xd6\xe6\xe5k\xb7'\xad\xeb\xe7\xd3׃\xbc\xbe\xc4o\x1c\xfc\xef\xe7\xf3\xa8\xd7W\x97OEǫ,\x13),H\n\x00\xaa\n(\xaa\x92\xb2M\xdcԡ\xa8\x16c\xe9\x9c;\xca5\x9d\xfc\xf7\x0e\u007f\u007f?>\xcdd*\xc1>\xeb\xc6\xd9K.|\xc7>\xdc\x1c\xf5\xe5\xd2i=9\xe3\xe9\xc1uE\x1b\xa5lT\xd1/\xb6r\xebz|\x89[\x15\xe7\xf5\x0eu\x86k\xc67\xe8\xf8\xf5\xebc@\xaa\xb3:\xe7\x95T\xb2\xd0J\xa4\x965\x04~.\x9c[\x11\xa8\x8dE\xef8\xf52o97\xcf^7\xab:\xe4\xf7\xe1\x92\xcfE\x89@\xd2O\xb2\xe2\x9c\r\xb6\x9c\xe8\xf2\xfc=\\\x1d\xdc\xf6.\xcc\xfdx'xI\xa6W\xe7Ymd\xbe\xbd\xcf\xd1z\xbc,\xb9\xb5^w\x83\xcb\xec\xe5g|9\xbfS\xe7\xef\xa0QU\x92iFj\x80\x14\x81aQ\b\x19\x1b9\x99\x9bT\xab3\xf4ʷ\x10d\xd4#\x17Nk\xd62ܰR{\\KZ\x01>\x93+%\xabl\x06<\xf7.\xfe_=\xb0h\xbb3\xf4殜FS\x9b\x11I\xd4>\x83\x9ee\xed\xf0ܹ\xb8\xfa<\xf7\x1fO\x11\xae\x9f\x1e\x9e\x8f\x97a\"(Ϋ\\\xf6E\x82\x91:\x84D\nц\x9ev\xc8g\xdcN\xb0\xbd0\xee&\x9f.McL\xd2.pt\xe4\x8dd\x0e\xac\x9d\x98\xeaCc\xdb-\x96R\x938s\xaf5\xc3\xd5\xe75r\xea+Y\xae\x9c\xb3\x04]\bG\xa6\x93\xb6ϛk\x9b4Yק\xe3ۥ\xcbi\xb1\x94\xb1B\x95b\xe5X\xbd@E\xd4J\xb3^\fű\x92+Lۉ\xd6rm\xcf\xe9\x9dY\xb95\x98P\x16+X\xc1\xbcB\x14\x86\xbb\xe4\xfb\x8f*6\x12\xc2\x11\x9f7\xc7\xd5\xe5s\xd7\x0e\xf3\x9fQZ\xc2u\x82[-\x15Z\xe5\x10\xa5\xe9\xf3ߨ\xf3\xf7\xd1*졖 P\xb13Bf.\xc1\xa4\xd8\xc95bII\x0e\x15Y\xf7\x15\xa9\x8bdj29\xfd0)\x9fp\x11w%bJ\x06\xcaK!\xfa\v\x8d\x1b,\xb5\xb9j\xe7\x067\xe48\xfb8\xdb\xce]D\xeb\n\xde(\x81\x01c\xa5d\xbe\x87\x87oAǭ\x05b\x89\t\xa5o\x15\xac+\x9fS\x9a\x00Q`\xd1ɯ\x19]\th\xad2j\rc\xd14\xc0lϼ\xe5\xb9ɼ\xa6\xe4l1CTQw\"Y\xf7\xee\"\xab.[-j\xe7\xcap\xf5y\xd9ם\xbc\xa3YϾce\x95c%ۍ\xfa\x9f7\xa3\xa7\v\x95vU\x85\x01(R7ͼ\xfaZ\xac\xb1t\x94݃&Se\xd0\"\xab>\x99\xeb.\x8b\xb2P\x8b\xd6F\xb2k95\x94k#`\xa0%\xac\t\n\x84\xfb\xbf\x18\xea\x85\xc1,Ys\xca\xc7_-\xc3\xd5\xc1\xe9\x94j'xF\xb0í\xcfM\xc7_Kô\x1br\xb2\xac0\xa1sK\n)Wc\x05P\xc7G\x9c\x96)\fN\xb3\x9c\x1dD5\x87eRЬ˩t\x9b\x02\xccZ\xcau\x95ܢ\xe6-\xad\x83sH\a\xff\xc4\x00,\x10\x00\x02\x02\x02\x01\x03\x03\x04\x02\x02\x03\x01\x00\x00\x00\x00\x01\x02\x00\x03\x04\x11\x12\x10!1\x05\x13 \"02A\x14#\x06@$3B4\xff\xda\x00\b\x01\x01\x00\x01\x05\x02\xfb\a\xa9\x86\x1e\x9d\xb9t\xd8\xf9]\x9fEeX:\x93ځ\xfc\x9c\xc2w3/\xe1*\xc2&\n\x91\a\xbc\xa8\xden\u05f8\xff\x00\xc7Y\xa6I\xcd\f\xf6Q\x81\xa5\xd6o\x8c/\xb5o=u5\x01\"vxW]\x03\x119\xb4\xe4eGq\xa7o\x9f\xea\x1f\x81\xe8`\xef5<u\xb1\xd5\x05\xfe\xa6\x8b.ɶ驏{\xd0˔\xb7\xd5駴\xa9\xd7\xdf9\xd5\xea̢\xe6\xb7\xe4\xe4\xfdU\xe3\xd9b\xb6=\xeb7j\xc7bb1Xo\xecw\xa5\x10\xc0&\xbak\xa2~P6\xe1\x1a\x9a\x9cz/b\xff\x00\x13\xd5\xd8\xeb\xa1\xe8z\x18O\xd1_u\xe4&\xe5\xf9US/\xf5Gh\xec\xd66\xbe\x1b\xd1\fVQY\xba\xcc\xea=\xa6\xad\v\x9fmD1\x87(\x87\x8c\xf7\xdcG\xc8\xdc6\x93\x15\vF\xabCpy=\x01\xeaz\xebp\x1dO\x13\xc8\xf1;\x18\x87_a\x8f\x186\xcaa Bǡ\x87\xa3˽B\x9a\xa5\xf9\xf7\xdb\xf3\xf3\x063@\xb5\xa4\xe4ye2\x1b\x116\xac\xf1\xad\x8e\xdb!\xb78w\x15\x81\r{\x9dԵ\x8d\xd1\a\xd2\xd0tSѢ\xf9\xe9\xad\xc1\xd8\xea\x11\xc8A\xdf\xec\xd8xO=L2\xdbR\xa1\u007f\xaa\x01/Ȳ\xef\x9dt\xd9l\xaf\x1961\xcdk`\xa9\xa5u\xb5\x911@\x80\x05k\xf1\xc7\v@\xb6>\xc1J\xcbF\xab\xe9\xe4T\a\x9c\xe5\xbfT;\xe8\fh&\xa0\x8ac\xc4\xf1\xd4\xf7\x88\xc5Y\x86\xa3M}\x96?\v\xf2\xe9\xa6dz\x95\x8d\x1d˟\x88\x04\x95\xc4i\x8f\x88\f\xb1j\xac\u007f.\xd7\x05W\x931\x02\xa2Uŋ\xc4Y\xeeCV\xe7\x14u\xca\x15\xbeA]0]K)\r\x1d8\x9d\x91\x06\x8c`u+\x1b\x1a\x86'xˣ\xfb'r\x95-;B\x8e)\xdcc<\xadd\xac\xb58\xc0~\xc1\x84wb\x00\xbf\xd4jI\x91\x9dm\xb0\xb1\xf8\xaa\x96k=>\xd4z\xf1k\x051\x9dU\xef\xc5\xc77>U\xf3\x8dT\x0f\xec\xb4\u05cc)g\xab\xddo`\xf3nF\xdaZ\xaew\xe4\xf0\x9cn\xba&:$\\Z\xa5\xabj\x90o\xd3{\xae\x16\xbb\x04\x15\xd6\xf1\x85\x94\xc0\xb5\xdd\x19^\x87w\xd9=ǃݧ\x1e\x98\xf7561\xd9\aJb\x9f\xa7s#1\xadƩ\x81\x97Vkn\xa4\xeao\xad\xd6\xd7H\xc8\xf5I\x91\x92\xf7\x16%\xbeFa녽\xeb\xa6\xfb*\x01Q!\xbb\x8c\xc4U7Y\x8fO/\xa6ˁF\xb2\xb1\xb0\xad\xf5e!wjΪ~o\xbdK2PJV\xcb\xcb\xdbEx\xe9c\xb078\x9f\xc91\xae\f\x16\xd6P\xcc\fK\xc8SO!\xa4\x01\xd6\bO\xc3}*\x00\xbdt+O`$ʫ\x84\xadç_\xfdv\x13#6\x9a&O\xaaZŭ,|\xfd\x95b\x8dW\x1bi\x86\xcc~.\x9c%CqT\x10\x87\x8b\xa2{g\x88ݬ9\aU\xc8\xcaՌk\x11\xe9\xb2\xc47\xe3c\x9br\xef\xc8\a\xcdVp\x81\xc3\x02\x01\x9e؊\xaa%\x9fU\x8f_\x13V\xb6\xc4\x19\xf8\xc6_\x98\x89xAfe\x8eY\x91\x89\a\xa9 \v}J\xaa\xe6F}\xb6\xcefk\xeeb]\xed5T*\xb6E\b킅\x1f2\x9dL7\x81\x83\x1a\xf8\xa2Y\x94\xcek\xb6\xbe7]\xbc\x8aŰ:ַ{\x99.q\xa9\xaa\xab\x1d6\xcaG@u*\xb1Z\x11/~\"\x85%\xb44鮞@ڒ\x01\xe9\xda\x0fnq\xaeq\x05v\x90\x87aY*K(\x0e\xe6\xc9m\xa9J\xe4z\xac\xbf%\xee$\x92>\xf5t\xa5\xf5\xd5j\xd6J)\x88\x17i\xc6\xf1\x91W\xb4h\xc9\xfe˯\xacV\xadc\x1a\xa9\b\xf9V\x11G\xf3\ue88c\\\x93\xabs\xcc$\xb1\xf4\xea\xf9>C\xe3\x81\xd7\xden(90#[\xe8\xc9\b\xd7O\x13a\xa1\f\xb0\x13\x01J\xe5\x8e\xcf\xd3\xea\x9ac8\xc0\xc4G\xb0\xb3\x1d\x93\xfe\x85o\xc6\t\x84֪/\xf6K\x98-\xb9O\xd9)b\xbe\xcd\\\xaf\xb9)\x8d}\x8f]\xb7\x04\\\x9c\x9fzT\xe5eX\xee\xf3\xfe=\x12ܛ-\x1f\x10u\x13G\xae\xa7\x18k\x11\x86\x88\x05\x88s[\x02\x96G\xa9\x90~\xc1\x9c\xb5Й\xe7\xefkq\x86\xbe\x06U\x90j[\xb2-\xb5\xf1r\x85\xf5V\x81Ϋ\xaa_\xef=i_\tvBq\xb7%\xddeX\xefd\x06\x8a%\xb7=\xbfin\xed\xef\xac\xf7\xc4\xf7\xe1\xb4\xc2a:\x1d*\xb1\xd0\xf3\xaa\xc8\xc9\xc6kS\x9f~;\x9c{\x8e\xe3\xeeo\xb1\xed\x0fS\xd3E\x8dX\xefA\xfem\x8du\x87ٙY\xae\xe5\xb9\x11*ı\xc7,z%\xd7=\xa7\xee\rmiF\x1e\xcaKx\x88#\x1e\xb5\b\xeb4D\xe57\x1d\xb6ߣ\xd8\xfd\x80\t\xfbh\xc5\x1c\xbf4\xb0is.kj\xa9\xfa\a\x14\xb5\xb7Yq\xfb\xea\xc5g\xb8OC\xdak\xa2\r\x91\xda?y\xe0\x90\x1a\x15Џ\xda\x1e\xe1~@\x13;\x02\xccH\xea;\x97\xd7/\x89\x94\xbf\x06\xaf\x1d-\x16\xe1q\x9e\xf6=#\xdd:\xfcƿ\xd2\x03@U\xdd\xc0\x03R\x88:\x1e\xa1\a.\xccr\xab\xf6n=\x8f\xc3p\xb1? t~djW\x96Ջ,kX\xf4V\xd4#\xfd\x1aW\x95\x8e\x90h\x8b\x16?\xe3A\xfa\xff\x00P\xf4އ-\xa9\x03W\x0f\xe4\xe2E\x10\xf9\xff\x00G\xf4GB!\x1d+0\x8d\x1f\xbfZ\xf25.\xd5X\xd4\xccܣ\xfe\ntߦ`#<\xe7>\xa6\x9f\x88\x98\xf7\xfb,\xc7m\x0fp?\xd1\xdf\xc0\xf5R\be\xe3\xf7\xe8\xac\xd8V\xbfm,\x1c\xa3\x0e'\xcaNlG\bQEj>\x16w\x1dOc\xf6\xe8\xf4\xac\x9bk\xc9Ʒ\x1aΧ\xec%\x9d\x8d[\x84k\xee\xe2v\xac\xc7\xef\x1e/h{J\x8f\u007f,Ǜ|\x0e\xa1\xec~\xe0\x04\x9cOL\xb0>NmMu\x97\xd5}O\x89\x81d\xca\xf4\xcbiK)\xb2\xb1\xf6w\xa9\xef\x1dr\xa8\xcd$\xf6\xc4\xf6\x8c\xf6\xda{o8\x9f\x968\xfe\xa3\x0e\xa3\xf4\xb1\x02\x98\xbd\xfak\xae\xa6\xa3|\x1f\xe7\xa31}-\x99\x05\xd5\xe3\x01\xbb'\x11\x1b\x88\x9ac1nt\xbb(\xef\x1c\xfd\xadM@'\xeenr0Z\xe2\u007f&\xc9\xef)\x84\xd4a\t4 \xbbC\xddc>\xa3\n\xf6Yg\x8dE:\x8a!\xea\xc7q\xf4\xac\xc2~\xfa\xf8?\x0fA\xc4K\xed\xb73ڮ\xc7{\xac+\xb5\xe5\xa2Oa\xb3q\n\x92\xd6\xe6\xd8\xf7\x1cw\xf5\\/\xe3?\xda\xec>\xd5U\x1b\"V\xa9ѡ\xf2|Mr\x1b\x97&\x8dVu\xb0\xe9fo\x15\xb0\xc7\xf1Ն\xc7\xc3\xfcyl\xa8\xbbsu\xf0#\xf64\xd3c\x87ƵW\x8b\xccL{\b\xa2\x84Iz'\x1c\xfcV\xc4\xc8\xf9\x81\t\xfbT\xe3n\x01\xd8\xf4n\x9ea\xf2\x83f\xe4(\xe9\xdd,N&\x96\x8f\xda1ٞ\xa6\x9cnn\xd3[S\xd8\xf5>z\xb5\xc1}&\xfb)\xb2\xaa*\x16J\x856\x0fO\xc6\x19\x17\xb5\xaa\x80\x86s\xd8\r\x92\xc3\x1d\xa3\xd5jOV\xa9n\xc0\xf8\x81\xb9\xd9a\xef\xf6Q\v\x9ahZᜡ$\x91\xe0\x9e\x80F\x11;K\xb6_\x1f\xba\x90\fu\xe0A\xdc\xfd\xcc\xccqm6\r;l\x1b~\x1ez\xe1`\xe4e\xcc\xea\x1e\xafL\x1ei\xbf\xdb\\\x9c\xb6\xb1p\xe95\xe3\x9dV\x87!\xf9\v\x8bT\x88+OR\xf5\x1b\xa8ʥ\x8d\x94ڋ\xfc\x8c\xefLlz\xfa\xf1\xd4\xe5<C\xdf\xecQA\xb2\"-jL&\x1e\xa7\xa0\x84o\xa3\xcc\u007f\xfb\f\xf6\xb9-\x89\xc0\xfe\xc7s\xff\x00\x9c\xd5\xdd\xc7\xc3w\xf8\xbc\x1f\x95\xb52V\xa1\xf5\x96\xcc\xd9IQq\xe9\xf8\xcb\xcf\x1b\x93Y\x92\xac\xf5\x1a\xad$\xd7\xedP\xac\x1dY\x15\x8d\xd6\nԫ\xeb\xd5\xdf\xd8Ċ\xa5\xa7ң\xf2\x9b\xed\xf6(ƞ!0\xfc[\xa0\xe8V[\xf8\x8eք\x02\x11.\xf0{Ds\\\x13\xd4h\x96/\x13\x1c|u\xa9F~U)\x8b\xeb\x8b`\xbb\x81\xab\x17\x18\x18Ou\xc7]65&\x10j\xb6\x04(K\\a\xd2\xcfZ\xcdzYػ\n\xb4\x19\xb9\x9e!#\x12\xc7\xe6\x88\xcei\xa0$\xf1\x0f\xcb\xf6g\xee\b\xe2?`ҷ\xb8\x06\xc8e\x8c\xfc\xa5\x9a\"4\xbcr\xaf&\xbe.Ѿ.:\xff\x00\x8e\xe5퐑0\xbb\xa6_\xabՏ\x933;\xd9ю\x87\xa9\xe6\u007f\x0e\xbe/k\x0e\x14\xcd=\xa0\xb8\x03\xecQ\x8c\xd6EE\xaco\xe1\xfb\xf8\x1f\x83\x90\x8a\x11\xae\x9c{c\xb7\xf4\xd8\xf2Ӹz\x1f\t=B\x93\f1\xa0\xf80ш\xc5\x1b\x0f'\xf9\xd8\xfe\x9fj\xf1\x18t\v\xec\xb5k\x03e\xb77\xc8\xfa\x87\xaaW\x8b\x19\x1e\xcb\x1aݏlTlf\xb0\xfc\xc0$\xe3\xe2jn7ǋ0\x1d\x0fC\xe0t\x03\xb2/\xbdqX\xa9\xcb*\x97\xd2\x16\xd4x`=<\x1c\xef\xa9.]0M\xc6@\x01\xf80\xd8酕f%\xcaə\x87\xb0\x19SQ\xf6\x83#\xd5q*\x99\x1e\xa1\x95\x9b7]1U\xef\x9e\xe0\xac}\x8aikM4-!\x9b\xbe\xe1;\xe9\xe4Ƃ\xe7\xf6\x80\xd40\xcdvi\xad\x94^2\xddk\x1f\xc1mJ\xbe\xac\xff\x00\xc1\xf7\x18\xc1\xde\x11\xae\x8d\n\xf2\xaf\"\x81\xc2\xc6\v\x1d\x8bC\U00073be40\xc8\xf4\x9b\x9e\xbcQ\u007f\xaa\xe5[e\x963\x94\xa3\xb5\x97n{B\xb9c\xb5\x9d\x18\x11\xf3\xc6\xc5/\x15B)\x84k\xae\xa1\x83\xbc\xf1\xd7P\x88'\x1d\xc0 3!\xb5)\xedS\x1d\xca\a\xfc\xf6\x1b\xb5\xa1\x89\xe0\xf5\xfdds\x02\xd4\xe2\xc4B!\xf8kp\xf9\x8ā\xb1%\x10\xd8\xe3\xdb\xc6 Y\x93\x03\xadBc\xfa}\xd7\ni\xa3\x0ez\xc5\x17[{)V\xea\x01'\x1b\x10,#\xb1\x1b\x86\x15\x87\xb7O\xdc\xdfQ\t\x9f\x94D0\xf8#dK~\xbb\xfc\xc3\xdac\u007f\xf7\xd7\xdd\xee\xd0-\xe5|\x1e\xa7\xceJL\xb4\xdc\xe0a\xed\x1b\xa8\x04\xc2ʑ\xff\x00\xb4t\xab\x18\x99fF\x80\xa8$\xb2\xc2\xf1T\xb3a`-\x11\xcfw\a\x81\xb0ж\xb9\xb6ޔ\xd2ֵ\x18\xebJ멄v\xfdt=\f\x1d\x15w\x02\x05\x86\x18c6\x97\x1cr\xb3\x94\xe3*`2\xaa:O3]\x0fS/\x1b\xa8\r\f\x82vf\xb6[\xce\xc0\x86\xc3\fV\xe2\xde\xd33qJ'\xf6\xe4\x95t\xa7\xa6'\xa7\xd9xƢ\xbcp{G\xc7G\xae\xe2\xbcl\xa8dRA\x04\xcc|FyZ\xaa,n\xeb\xb8a\xe8u\xbf$\xf4\x02\x1e\x8b^\xe2\xafha\x8f,\xfc1\xff\x00\xeb\v\xa8>\xa9\xb1\xc8x\xe358\xcd|mڶP\x9a\xe8c\xcf\x1d@>\xd2ԫ-\xb4\xb8ƥ\xb2.\xa7\x13\x1a\xa6\xf2Y9=\xaaX[u\x93\x1a\x93\xbc\xfc\xb1M\xaa\xadc\xe2\xe1\x04\x85{\x05\xee\xda\x11\x9f\xb1\x9c\x8fO\x1d?Fx\x9eg\x1e\xea\x9d\xf8\x81ь&\x18\xe61\xe5+\xb3\xdb\x02\xf5hX\x18{V\xbe}\xbe\xde\xdc5\xc3_\xc3\xf5}\\\xe5\xa3a\x94\xc2!\x84w\xb3\xa2U\xb0\xcf\xf4W\xd3\xd0\xebdU\x1a\xea\xee\x128\xadf_\xa9\x93)\xa2\xcbޚ\x92\x95c\x0e\xf7̈\xdd\xcb\x0e0\xcfў!\xe8|J՚\x05\xe9\xe2\x13\x1b\xa36\xe1\xfa\xa0\x00KT\x97\f\xb1\xb4%\x87\xe9\xaf\xf2\x03c\x84\xe1\x1a\xbf\x80\x84OP\xaf\x83^\xad\b\x84C8\x1d*\x85\x84\x93\xd0\xf6l*?\x91\x92\xea\xc9X!\x86\xe5\xf9\x15\xd3\x1fԭݖ=\xad\x8b\x84I\x00\x01?Nf\xa3O\xdf\x19\xa8|\xf41\xbbĮk\xa1\x8caޏh\xcd\x06\xec-\xa5_\xcavP\xca\x1e=\\\r\xdeD\xc7\xcc5\x8a\xf3ih\x8c\x8f8\xc3\xf03\"\xafq-\xac\x18\xfeL1\xd8\xfb}XlT\xec\xb3\xd2/\xb8\xe5f\xdbU\ro\xa8\\\xf3̭\x1aÍ\x88*\x9f\xa6&k\xa3MMv=\xba\x915\xd0\r\x95P\xb3\xc1\x80Fhg\x88\xde?#\xc8\"\x9f\xaar\xd4\xe59wcȟ=C\x11+̾\xbf\x90\xecs++fB\xf7u+\xd0\xfcGgGj\xc9%\x8c\xc6\xc5{\xa5u-Ja\xf0D\xe7ٚ~\x89Q\x0e\xcc0\xf7\xe8z\"r\x81u\xd3]\xf5\x18\xcf%\xfe\x98\xc6~q\x86\x81\ad\xc2g)\xb8<}\xb33S\x9d\x17'!c\x16$C\x0f\xc1\x87@710u\xd3}\xf9}0\x9dC\x19\xfb\x94\xd4T\xdc=\xcc\xd7c\xe7\xc9Jf\xb4\x18nk\xa3\x18{\x97m)3\\\xa1\xfaz7\xd5\x18C\x0fC\xf8\xceӎ\xe1R:\x8f\x9d\x9fM\xb6\xa6\xac0\xc3ԝ\x05;\x14\xd2\xf766:R5\xd8\xf6\x84\xc07\xd6\xce\xc1<\xb0\x1b\x9eH\x9b\xd8\xd6\xe2\xd1\xc6?i\xa2f\xa6\x840\xf91\xfb@\xb0\x99\xf8\xcf1\xa3w\x84C\x00\xee\xfez\x87e\x9c\x81\x9a\x10w\xf9\x19\x9a\xba\\\x94\xfaXMG\x10JJ\x9b+Ī\xc9F\x00\xe4\x11kA\u074b蘃\xa6\xa7\xe8\x8d\xc3\xd8\xfe\xd6kq\xbf\x15^N\x88\xa8l\xfaJ\xa4\xf1ѥ\x8d؈\xe7QV1\xefǂ\xf9\xe8~\xa9\xe6?x\xc3_25\xd3\xff\xc4\x00(\x11\x00\x02\x02\x01\x04\x02\x01\x04\x03\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x11\x10\x03\x12 !01@\x04\x13AQ\"Pa2q\xff\xda\x00\b\x01\x03\x01\x01?\x01\xf9\xad\x94VR\xb6}\xb2\x9a-\x1b\x1365\x8d\xde;72\xfer\xc2-\x1b\x8d٦\\\x90\xdf\xecN\x87?\xeb\xda\x12\xb3j\x1a\xc2śĬp\xfe\xbe_\xe1\x167F\xf1\xbc\xedC\x81\xff\x00&\xfc%\xfdeb\xb2\xa0\xd8\xe1\xfa\u0091\xb8\x97y\xbf\xe9\xa8Pm_\x05\x9a\xc7V.ʢzw\xd8\xd3^\xf2\xf1\x15|ܔ}\xe3zݷ\x86\xe4\xfd|Z(J\xfa>ڇ\xfd1\x8d}\xb5\xfe\xf0e\x88\xb3\xb6QF\xe9\x171\xef~͒\xe0\x9d\x12w\x9b.\xbd\xe3R\x1b\x90\x8a\xee\xf8CKo\xc4D\xbb\x8e!=\x97^\xf1\x1f\xd8ӿ\xe4uź\x17x\xbc:\x16\xef\xc1\xbeH\xfb\xa3\x9a|ixn\x8d]e\xa6\xac\xd3\xfa\xa5\xa9膪\x93\xa5\xf1\x17k\x1db\x13\x8dm\x90\xa7\x05\xed\x12\xa6\xfa\xc2B\xe8d\xd7\xe4\x8f\xfa{=\x17\x88ʋ\xb2\x91\xb4QD\xbbc\x8d\tx\xe7\xa5-ER4\xfe\x96\x10BJ=#r\xf5\xf0\xe2\xc7%\x17ٽ7B$\x88\xb3k\x9a\xe8\xff\x00ѱv1\xae\xa8\xf4\xba\x13\xb7G\xf8.\xb2\x9d\x11\x92x\x9c\xab\xa2\v\x12\x8dx\x1b\xae\f\xb9>\xa2B\x1b\u007f\xf7\x15\xf0P\xd5\xf4ͮ:\x9d\x96\x97r\x1c\xf7\x89\xd1\xf4\xda\xdbglՒ\xbe\x8bl]\fR.^\x90\xa0\x96\":\xe1\xf7\x1d\t_\a\x11\xfc\xf5\x8a\xdcG\xbfc\x87}\x1b\r\xbf\xa1\xa8\xfeI\xef\x8a\xe9\x1a\x1a\x89?\xe4k5/BU\xeb\x14t\x8b\xe4\x9d\x11\xef\x85\x0e\x03\\\xef\xe3\xd9f\x9b\x8c\x95\xfeHR鎆\x9b]\vW\xf9\xf4O[r\xa1*\xc5\x1d\"\xfcKP\xfb\x88\xfb\x88\xfb\x86\xf7ʳe|j\xbe\x90\xa2\xe3إl\xfa\x84\xb4\xe5Hr\xfdf\x8e\x97\x9dA3b'K\xd6\x1eb\x89D\xdb\xfa\xf9)\xd1\xef\x1a\xba\x8e~\xfe\"too\x1e\xb3\x15xxjƾDY\xa9\x18m\xeb\xd8\xd2:E\xfcJ\xa1DcD81\r\x15\xf1\xbd\x12\xd5\xeb\xa2\xfe*\xcb$i\xfb\xcbϲ\x87\xf1\x17\xc8Jȡ=\xa5\x92\xf4.\x9e\x1c\x92\x1c\x8d\xc7l\xf5\x89|[\xf8\xf1\x8d\x91T\x89!\xf4{X\xdc\xfd\x1bJ\x12\xe1/\xeb4\xd7Xc\x17X\x8e\x17'\xe6l\x8e\xbc#\xecz\xd1\x14\xe2\xfeT?\xe72ĕaw\xceK\xc8\xdd\x0fS\xf4I\x96+e\xa3FܨՊ\x8fK\xe4-Fogl\xda\"XN\xb8\xb7\x86\xbcz\xbbt\xe3d\xa4\xd9e\x14I(\xc7\v\xa2\x12\xf8\xd1\xd3r#\x05\x12\x87\xcaH\x8c\xb3'\xd7\t/\v'-\xcf\f_\xa1&\xfbC\x8c߳d\x8f\xb5)2\x1aj\x03_\x12\x1a_\x97\x87\x87ŋ\xb45D_\xe0ln\xf0\xbde\xaa\xf0oP\xed\xa3[SNP\xfe+\xb3C\xe9\xdc\xfb\xa2z\vM\x0e\tϢ\x8aś\x85!\xfc\x15\x16\xfd\x10\xd3QŞ\xf0\xf2\xf1/d0\xd5\x17\x98K3^\t\xabCTCꢢKY˨\x91\x85,F2\x91/\xe3\xc3\xf1\xf0!\xa7\xb8IG\xd6/\x9bĈ{\xc6\xdb\x1ck1\x16\x18\xf9\xe9\xc9E\xdb>\xa6{\xe47F\x84\x1f\xfd2X\x96\xa7\xe1\x0f\x87\xa1\xf7憗\xe5\xe2\xf9>\x14Kн\x95\x89e\x10y\x9a\xe7X\xfbk\xde/\t\xe2\x8a=y\x94\\\xbd\x10\x86\xdf\v\xe3,FR>\xe7\xf89^Y\xa6\xf2\xc7͈y\\\x1b\xbe\x15ᆞ\xe1*\xf5\xe2|\x1b\xa2\x9c\xb1\a\xd0\xd9,\xb1\x10v\xb3%\xe0]\x0f)b\xe8\xf7¼0\xd3\xfd\xf9\x1f\x05\xfc\x9e%\xec\x8b\xeb\x12\x1f\r&2\x86\x89*\xf0\"\x91h\xdc[\xe0\x97\x861r#\x15\x12\xf9>O3\xf4i\x96O\xd9\x1c>\f\x83\xa7\x96Ix=1\xbb+\x85\txc\xa7~\xf0\xfc\xaf:\x8c\x8fK\x13\xf6Dc\xe2\xba \xef,\x92\xaf\"Xl\xbb\x17(½\xfc9w,\xcf\xd9\x12\\\x99\xa7#\xdef\xbcicSSi\xfc\xb5\x19\xf6i\x1am˄bؒ_\r\xb3M[̅\xeb\x9b\"\xfb\x16h\x9a\xa7\xe0K\x0eTK[\xf4+\x9b\"\xb6\xfa5']\x10\x8e\u0558\xe9\xfe\xfe+\xf4C\xd6_\x85\x91b̗:\x199\xedV\xc9M\xc8R\xa8\xd1\tmd%\xbc\xd9\x14!!F\xb8QExk\x85\xe5\xf6)Q\xbe\xcb\xf12,\x8f\t.\x1bE\x89\xbaD\xa4\xe4\xed\xe60r\xf4F;U\x14(أY\xaf\x80\xf8\xcdwk/\xc7\x06{̑B\\\x18\xfdaE\xbfD4?b\x8db0\xfd\xfc\u007f|\x1aLq\xa1\xf8\x99\aB\xcb\x1a\xe4Ͷ(\xa5\x84\xacP\xaf\x91\xe8\xf7\x8b,\x93\xbf,$5\x97\xe1\x8c,J\xb8Wȿ34\xdfb\xcb\x1f8\xe9\xfe\xf9\xb7F\xe6%\xf2\xab\xc5臡Ꮜ`\xe4(\xa8\x97ɛlI.o\x95s|\x1f\x95\x9al]\xac1\xe1\x11\x8e\xe7B\xd2\x17\xcfc^\x1f\xff\xc4\x00)\x11\x00\x02\x02\x01\x04\x02\x02\x02\x02\x03\x01\x01\x00\x00\x00\x00\x00\x01\x02\x11\x10\x12 01\x03!@Q\x04A\x13P\"2aqR\xff\xda\x00\b\x01\x02\x01\x01?\x01\xf9\x15\x97\xeb\t\x1a\x8b\xc7\xe8\xe8\xd6vS53R\xc5rR+\xe4\xd1X\xacK\f\xd2\xcd%b\xcbG\xa1!\xab4\xff\x00M\\\x1d\t\x8d\xd1lXx\xa3H\xdd\x1a\xbf\xa3\xa2\xb8\xe3\u007f\xb1\x89\x1avj5\x1d\x9a\u007f\xa1\xa2\xb8/6^-ذ\xe4)a\xa3H\xbd\u007fA\\\x16Yee\xecw\xfa<z\xb4\xff\x00\x97c\xf4]\x91\x95\t\xde\u05fd\xc9.\xf1\xad^\x9d\x97\xf5\xcbEo\xd4X\xd9m\xf5\x8bՇ\x85\u05fc2\x8fH\xb2\xcaEDZQ\xa9l\xecKe\xe2qԄ\x8a\xcd\x10\xf1\xe9:⢸\x18\xbb\xc3W\x86\u007f\xe6ղ\x8e\x84\x87F\x94h\x14k5\x8b|2t\x89\xf9\xa4\x85\xf9\r\x9e\x1f#\x97\xae\n䗧\x8fxw\xfa)\x8b\x17\xba\x9b+\x12\x8d\x95E\x966/HN\xc7\xc7/\v\x97d?\x1a\x11\x12\xa2\xd7[k\x9ahn\xd1\x1f\xfa1<Z]\xe5\xe7Q\xd1m\xbfG\xfe\xe6\x87\x1a\xc4U\x92\xc2|\x17\x9bD\x95\x9a_\xe8\x8cTq_\x05\x95\x86,N>\x88\xe5\x1aI\xc0\xd3\xf7\x89\n\xf6hC\xf5\xb1>/G\xa2\xca\xf8o\x0f(\xbc*d\xd3\xfd\x10\x8bBC,\xf6\xca\xde\xf6ط\xd7Ǣ\x89E\xa1\xbcX\xfd\xf6C\xc5N\xc5\x1cY\xed\x95Ť\xd2i4\x9awVk\xe47c^\x8f\x1b\xbfأ\xf7\x9b=\xb2\xb9\xb53S\x16\xd912\xfe\xca\xf9~?\x1a\x8ejī\xe0Q\xa7ku\x85\x9b\xf9\f\xb7e\xb1&\xfb\xf8\xd6,K\x81|m\x1f\x19\xe5\b\x9f\x02\xfe\xb1\x8d\x8f\xde\x17c\xc2BEl_\xd67Cvŏ\xde4\xa2\xf1{#\xf0/\xe6O\xbc!\x0f\x12\xc3څ\xcd\xdfC\xfcy\xc8^\t\x0f\xc75\xf2\xa5\xdeV\x13\xbe\x18\xbeD\x9b菇\xff\x00\xa21E\f\xa6y\xabM\x9e99|\x8d\x06\x94z/\v\x0fj\x10\x9f\x1f\x89?#\xa21Q\xf4\x8a/\xf6X\x9br\xc3<\x90\xb5\xf1\xa5*\x1c\x9b\xc2ܘ\xd6V\x16\"\xf8\u007f\xe1\b\xe9T!\x0f\xecr\x8a\xf4\xc58\x1f\xc9\x13\xf9\x12C\x9b\x91%\xf1%\xe4\xfaض\xa1\x89\xd9$!,~\xf1b\xf7\xc1?\x14\xbc\xabL%L\xfc\x1f\xc6\xfc\x9f\x0f\x99\xff\x00$\xae'\x9bϣ\xd2?\x96Rc\xf28\xc3\xdfxӆ\xcb5|&\xe8\x94\xef\x15\xc0\x89\x16'{$\xb3\x0e\x0f\x14\x92\x90\x9a}\x12\xfci9Y\x1f\fa\xed\x8f\xfc\x9d\xbcKʣإ\xa9Z\xc2\xf8R\x9d\r\xde+\x85\x12\xeb\x1a\xa8N\xf3,\xae\x0f$u*?\x16\n\x10\xa2\x8f,\x97H\x88\xfa\xf4G\xc1\xfbel\xe8\xef\x9a^O\xaeX\x8f\xa1\xcb\x10\xd8\xf3\x17\xbe\xb0\xa4ꇔ\xf1F\x93\xaef\xd2\xec\x94\xef\x85lBģ\x11x\xd7أ[$\xb2\xb8\x18\x9d\x8cK\vcw\xcb/%\x16\xdf2\xf6Z\x8e&\xbd\x91B\xd8\xc7\xe9\xe6/\x83\xa1\xe5,]\x1d\x97\x8b◓\xeb\u007f\xa5\xc1czc\x85\xfe\xb6I{([f\x84X\x98\xb8:e\"ѫ\xe8\xf6u\x97\xc3)i%'\"\xb7һ\xde\xd9\x0e\xcf!B\xff\x00Q\xe1m\x9a,J\xc4G\x82\xfd\xd9w\x97\x8b◒\xba\x1f\xbf\x83\xe3D\xbd\xbc.\x89\vs$\xa8O\bO\x8f\xae9\xce\xfa网\xf5\x1c\xae\x89\v|\xd1\x1fY\x8b\xe0\xbc^c\x17#\xd4\x11\xfc\x96M%\xb2R\xd297\xcde\xecJɿY]\x0f\xbe\t/C#\x8b\"\xef\u007f[\x17\x8b\xec\xf5\x149j\xec\x84\u007fd\xe5o2\x9f\xd7;\x95\x17\x95\x85\xd9>\xf2\xb8\x9a؟\fS\x93#\x15\x11\xc6ݒV\x89\xc7B5\xb6\xb1t9\xde\xdb\xe2r\xca\x12\x16\x17\xa1\xc6\xcd\x14W\x1bC؞o\x0f\x15~\x84\xab\xd6e%\x1e\xc7-X\x94\xa8n\xf9\x9b\xa2\xf3B\xdb\x17\xeb\xdf3[\x13,oj\xef\r\xa5\xd8\xfc\xbfC\xf7\x89y>\xb8/{\x97\xd6\xc4!\x14?[\x13hR\xb1b\x8a\xe0\x92\x1eP\xb7\xa6\xf2\xdd\x12\x9d\xf3\xb7{\x12\xca:;\xc5\x14P\x95n\xad\xf2B\xca\xe1\x94\xe8n\xf6_\x13t]\xecK\v\x1dm\xaey,,.\ty>\xb7\xa4P\xde\xe7-\xa9b\xbeD\x84\xf0\xb7Jj$\xa6\xe4V\xe4j\x1b\xbd\xceB࿏#\xa7\x95\x86Jn#\xf3\x0f\x99\xfa.\xfbܰ\xb0\xb7!p\xff\x00\xff\xc4\x009\x10\x00\x01\x03\x01\x05\x05\x05\a\x04\x02\x02\x03\x00\x00\x00\x00\x01\x00\x02\x11!\x03\x10\x121Q \"0Aa\x132Rq\x91\x04#Bb\x81\xc1\xd1@\xa1\xb1\xe1Sr\x143C\x92\xf0\xff\xda\x00\b\x01\x01\x00\x06?\x02\xe3\xe7S\xc1\x8cX\xbc\x96&\x99\x17:\xd1\xfd\xd1[\xb03\xbc\xb1[\x9a\xe8\xa8\xc0\x15Q\x95\x02\x81P\x90VX\xba\x85\x0eh[\xabt\xcdۜ\\\xd1n\xaaE\x156\xebƗ\x90\aU\x16C\x11ԭ\xf7SK\xa5\x86\x9c\xc2v\x1a>2V\x8a\xab\xb4\xb4p\x034hn\xc9Qba\x1e\xab\x9f\xaa\xe6\xb7\xdbUE\xd5b<\t9\n\xa9檺l\xe2\x1c\xf8\x1082\xa9v\xfb\xab\xa2\x8b\x16\xe1\x1a\x956\x8e$\xec\xc8[\xa4\x84\xdcX\xb0\xcd\\\xb7d\xb4s*\x99,\x96\xe8T\xa1Q\x92\x82\xe5Q&\xfa*\xf0\xea\xa9un\x87dx\x12\x81p\x8b\xe8\x15v*`\x05\r\xdf=\x15\x0e\x06\xfc\xbbt[\xe43\xcf5F\xc9\xd5ɤ\xd6\x106Ya\xaa\xdc\xe5\xc9URT\xaa\xae\x8b[\xaa\xa2.\x9e\x1c\x15\x89\xbfP\xa9v|\x101g\xb5/p\x01E\x8bg\xab\x97\xbcq=6\xf7\x1bMy-\xe2m\x1d\xe1b\x97\x96{;?t\u007f㼺3\x95\xba\x16\xf9\x94p\x88\x05\x1bK>\\\x82\xd2\xd7\xf9PEWEE\xa8T\xbe\x0f\x1f\x13{\xbcx.\x97x[U\xee៹Rd\x9dN\xd4\x01%{\xd2,\xc7\\\xfd\x17\xb9\xb2\xc5\xf3\xda\"}\xa2۴-\xf8,\xf9\"=\x8e\xc4Y3\xc5\xfd\xacV\xf6\x8e\xb5wOʆ\xb4Y\xb7@3R\xd5.\x86\xfdW\xba\x13\xf3\x15\xbeq \xdbF\xe4\x8c\xd3B\xa2E\xd23Z*\xec\x1dT]\x1b\x10\x017\vM\xdc$\xc0Y\xaa]P\xa9\xdd\xe0V\xe9Rh\x11\x16^\xf0\xf4\xcb\xd5w\xe0h\xca-6CFf\x884\x96e9«\x8d\xab\xbc,U\xc1\xec\xec>\xaa\x18\xc3mi\xd5{\xe7\xf6,\xf0\xff\x00Ku\xa5֟7\xe1\x00M9h\x83\x9e渏\x84U5\xcf\r\x11\x9cs(\xe0\x18D\x95\x86֕\xad\x11e\x8e&\xbf\xe6\xf8\x94\x06\x99[\xd4j8\xc4\xf9\xa7\a\xb8\xbd\xbf\f6J\xa0-\v\xe2U\a\xd1w}Tb\xecߣ\xb2P\xf1C\xe8U\x0ff\xfe\xb9-\xf6\x90U\x14\xdcQ\x8b\xb15\xce\x1c\x8c\x18\xbb\xcdJ\xfe\x95\x15\x95\x91\x16qg\x91\x02\xab\v\xb2Qˑ\xdb\xe6Tڼ5{\x96S\xc4\xff\x00\xc2\xdfq\u007f\x9e^\x8a\xbbo\x86\xb4\xbck\xa2\x048P\xa7\v7a\xc5\xcd\x17\xfbE\xb3\xadm4g\xe5E\x8b\x05\x98韪\x06\xd8\xd3\xf9AӋvO\x9ap\fv\xef9Vl\x88kE`'\xbaϹ\xcda\xf8\x80\x93E-\xab\xb2!7\x18-\xd1ɭ\xb6\x81l;\xae\xd5\x10\xec\xc2ݩD\x96K5)\xc3\x196\xa6`7 \xa7\x03]\xd4P\xae\u007fUV\xaa\xb1\x10ӻ\xa1YG\x92\xc0\xf1\x8e\xcfB\xb1Y\x19\xf9y\xa9\x0e\xfa*p \xac\xae\x0e\xc8\xff\x00+\x03\xf2\xe5\xd3j\x1c\xe9w\x85\xb5*\x1b\ue1eb\x94\xfc^#R\xab\xc1\x0ena8\xb7#\xcbCwgٞ\xae*'\xaa\xc8\xeb+\f\x90\xe4:\xe7\x89<\xb9\xecl\x93\x88\x87\x85\x82ȼ\xb3\x17w\x92\x06~\x8a\xd1ϜO9\x95\x89\x82\x87\x9a\xdelƇ%\xbf-n\xae[\x83\xb6~\xa7$q\xbbs\xc22\xbb\xa2\x95\xdd\v \xb2\n\x97w\xb0\x95\x0e\x1fP\xab\x92\x91\xb7U\xbb.\xa7\xa2\xd0)\xc2\xf7\x1f4\\\xd6@\x17\xc9DY\xfb\xd7\xf4\xc8}U_\x03\xc3g\xf9P7GN/\xcb\xcc \xf2q30\x9e\xdb8kM\x05\x13\xdbi\xbcA\x9a\xa2\xfb9\xc3\xcdG4\x01\x12\x8b\x9e\xd18\x8cs\x80\xa0~\xca;\xceUc\xbc\xa2\xa1o\x1ah\x11\xec,\xf7\xf9\xbd\xc8\xe3.\xce<\x97\xbev\x12\xa2\xc8CJ\x9eWQh밌ԭꝚ]\xcdW\x12\xee\xbf\x16\x92\xa43-J\xa3j\xb3\xfa.\xec\xa9y\xfa\x05\x00CtSh\xf0\xd1\xd5{\x86O\xcc\xfc\x97\xbcy\u007fL\x82\xa9\xa7\x1c{\xc6Y\xe9+\xb3\xc4\x1dg\x96!\xaa\xc3\xea\xa5\xe0\xf6\x8c\x15\x8ecU\xd0rM\xb4a\xcdo\x8f\xaa\xc3\x12|\xd41\xb8OE6\x93:\x04\xf7Y\xd0\xfd\x93Y\xf1\x19\x97\x15m\xdb>'\xeaTX\xb7\x0f\xccsR\xe2I\xea\xb7\xecˬ\xf5\x14V\x81\xb5q\xa0\x03!\xb1\n\xaa\x94\x17\xd3b\xaa\x85Cj\xedc%]\xf7\xfe\xcbx\xdd\xce\xf8X\x8c\xb9ں\xaaM\u007fC\a\xba\x9cҀ\xb5i\x11\xdcq\xe7\xd14\x83\x85\xc3\"\x9c\xd9\f1$\x03*\xa2IC\b2\x98\xd7:_\xfc\"\x18'\xec\xa5\xee\xdc\xd5\x01l\xd7a<\xb9\xfa(\f\ro\xee\x9c Ts\v\xa6\xa5\u007f\x99\xff\x00\xb2\x82a\xbe\x11\x96\xdd6\xe0*\x19U8eLH\xd4*\xdf\xcc~\x82\x8b0|\xb6h\xd0]\xc9\xcb\x13\xde\xe2S\xa7\xbe\x06\xf0\xd57G|\xbf\x95B\xebg\xb6\xb4\xc8.\xf0h\xf9P\f\xa3\xe2L\xe4\xe4\xed\xe6\x86\xf2\x19\x92\xb0\x8d\xc6h.\x9c\x9b\xa9T\xf7\xaf\xfd\x96\xf1\xa6\x83.\x15BȬ\x96W\x8c7\ue57e;7j2\\\x88\xd4d\xb5U_N<rہR\xbbG?\x03\xc6M\x19\xab'\xbc\xcbg$\x1d\xec\xec\r\x11\xbc9\",\xb7\x19\xfb\xa21\x1a݈\xee\xb3\xc4\xe5\xb8;W\xear[\xe7\xe9ŮJA7@\x1b{\x85u\xba\x88\x9e\x158aÒݠTM\xc5k\x8b\xe5\n.ǅ\xae?2\xf7\x8e\x9f\xd0S\x8a\x05\xddv\xa8\xab_\xaa\x8eZl\x9c&F\xbbu\xee\x9c\xd4ck]\xd4\xf2N\xde\x18\x19\xf1\x1ek\xdc\xd9c\u007f\x89\xf7u\xfd\x1fU[\xa5\x1d\x9f5\xd32\x8c\xe6\x8byrS\xb5\xd3N8\r\x03\xcc\xf2R\xf2I؟ЀHoR\xbb\xc2\x14\x8b\x86\xcc]\x84s\xcdof\x83\x87\xfd\x8c\xba\x0f\xea`\xe5\xfa\x1c\xe19A\xc9gK\x86\xd4\v\x8e,\xb4D\xb4@\xba?S\x85ު\xbcs\x19(U\u0605T5<\x19\xe2\a\xc3X\xd7e\x8cĬ\x16\xcd\xc2\xee$>\xadSfqtU\xe2\xf9\xec\xd2\xf9\xd9\u05cd\x02\xa52\xd3\xda\xf0\xd8\xd9\xcc\xc3\xf3?D\xe2֗\b\x84\xd6{O\xb3\xe3\r;\xa7\x1dB\xddu\xbd\x89\xeb\xbc\x17h\xc2-\xac\xbcL@\xbe\xcd\xed\aQ¢\x87ÇU\xdd!Q\xeb\xbe\x16ad\xbb\xa5e\xb46#\x92\x11Ş\x00\xb5\xf6\xa7\xf66G)\xcd\xdeAa\xf6+>Ο\xf6\xba\xaeX\x9eN.wUR\x89\xbd\x93\xb0\xb8\x98:/i\xed_\x8e\xccY\xd6u\xe5ƥ\xf9\xaa8\xaa\xc1\xf3h+z\xc6\xcc\xfa\x85\xdcp\xf2r\xa1w\xa2\xcd\x01J.~\x8b\xf3}2\xbeyl\x96\xf3\xe7\xb5\x1b6\xb6\x96\xad\xc6,\x84\xe0Ԧ\xeeٿ\x10\xa3\"\x81by\xc4\xe5\x1f\xba\xc4s\xc9\xc2\xedg%\xef7\x9d\xe1\n\xa2N\x80),qa\xa3ۇ0\x85\xa5\x96\xf7\xb3\xbf\xbat\xe9į\a\xa2\xa6\xd4 T\xb5A\xca\xfa\\pp\xed}\xa5\xd2\xdb\x00\xda\xfc\xc9\xcf9\xb8\xcd\xf8\x87\xd5C\x18\xe77\xe5\n\xb66\x80\u007f\xa9D\v;I\x1f!Ta\xc4s.\x10\x8f\xc4\xf1\xcd=\xb6\x82l\x1f\xdf\x1auN\xb3w\x98:\x8e\r8X\x9fN\x8a\x9bp3Nk\xb3\x05\x05\xd1A٭\xd1\xc0\xf6)d\x83\xf0\xfd\x13pY\x86Zb\xe49)\xb4\u007fgd>-|\x91\x16>\xca\u05ceo\xb4~CTE{\x16\xd7\xe9\xc9\x06Y\x0f \x14\xbc\xfd\x02\x81@\xb0\xb0I\xfe\x11\x97\xc7\xfa\x85\"-\x06\x99\x14\\\xdc\xecj\xdf\xf5<\r\a\x06\x1a\x14\x9a\xbbb\xbbD\x9ej\x14f\xba(7\xcdd#\x88UV\x9c\x0fr\xc9\x1a\xf2^\xca\x1d\x9d\x99\x83v\x12\xd2i\x86\x8712\xb0\xc3Yg\xfe6sA\xb9M]Lӌe_4;<6\x83\x9cd>\xa8\x98\x87\xe2\xc1\xadPhM\xb0\xb0\xb1\x0ftMS\x1e\xe6\xe1$Lh\xbd\xa5\x8e\x9e\xcd\xe0\xb4\xc0\x98\x90?\xb4m\x18\xf6\xda\xd9\f\xc8屽衣\xd1jUx\x1f*\x81\xc3\b\x89\x8b\xb7\xb2U\xca\xe8\b\xa7;\x91\xaeܡ*\xca\xcf\xd9\xdb\xee@\xe4\x9aֻ\x1e\x1a\xe0v\xe9Os\xd8X\xed\x0e\x889\xc6\x01\xe4\x17i\x87u\xb9u)\xce$\xa2\x19\x9a\x8c\x10\xce@:\x9fT\xcc5\xec\xc8w\x9a\x0ei\x90P.h$eE'>CTpI\xb4\x9cN\x80\xadE\xa9\xf7\xb6\xa047\xefu\x15*uRh\xd5\r\xa0\xe0ͧ\xa2\xfbpk\xb0<֥uU\xba[[\xa8>\xbc\x10\xdb;w\x86\x8eH3۬\xe7\xe7jk\xcc[{;\xb2.\x18\xb0\xe8\xb1\xdb1\x80\xf8Z!\xbep\x83Z%\xc7 \xbd\xe1\xc5\xfb\x05\xdc\x03ʋ\x03\x8e&\xbb\xba~\xd7M\x93\x8b:r]\xf6\x8f&\xa2\xf3.w\xeeS}\x9e\xc2е\xe3z\xd1\xcd<\xf4R\xe2\\u*m?\xf5\xe6\x83Z<\x9a\x16\xfd]\xa2\xaf\x02\x1a\x17]V\x83\x89\x9a2\x81D\x86b\x1a\xaaِ\xaa\xe8\x1d\x15\x05\xf4\xcc)ׄ\xefc\xb6>\xee\xd0n\xa2\xc7\xf7\xd9O\xed\x1bNn?\xb2\xecp9\xd1\xde#\x95\xd6\x03\x9e,_\xb7\xf7}rS\xff\x00\x9c\xf7\x19\xe1\xeaQ9\x9c\xc9*\x95w\x8b\xf1\xf9E\xc7r\xcejO\xff\x00UE\x88 sq\xcc\xf0dѪ\x00\xbaA\xe2IR\xec\xb9\"4M\xdb\xcb.\x10sL\x10\x9bmg\xff\x00{(\xf6\xea\xbb)\xa8\x98\xea\x13\xed\xb0o\xbf5\xbd\x9f!\xaa/\u007fx\xfe\xc2\xe8n\xf1Xl\x88\xb6\xf6\x8d~\x16#i\xed$\x97:\xb1\xcc\xfe\x10e\x98\xf2\x03/\xed{\xed\xebO\a\xe5K\xbe\x83\x90\xe0@Sk\x9e\x97}\x95k\xb0p\xb4\xe1\x19\xedV\xecN@\xbb\xbb\xa5\xd85*.\x9d\x99'\x86-,\x8d\u007f\x94\xdfjk0:r\xd5\x16\xf6\xb6\x94\x13\xde*\x96o\x956\x85\x96M\xd5\xeeTs\xedݠ\xa3Q\xb3dY\xd9x[@\aU\xbb\xbc\xef\x17\xe3\xf2\x89\x9c6\u007f\x13\x9c\xb0\xfb<\x8d^{\xc7\xf1\xc1\xdd\xf5]u\xd9\xfb߀@n\xcf[\xb5*\x1c\xa6\xe6\xff\x00\xb0V\x83B\xa4\xed8\x1d\x17Q\xc0\x9b\xec\xec\xec\x9c;K3V\x9f4m}\xa5\xcdo<3%\xc9\xc4[=\xad' rR\xf7\x17\x1e\xa5b\xb6\xdd\x19\x86\xf3+\x05\x90\xdd\xe4\x06_ڟh\xab\xff\x00\xc7\xf9C\x16C 2\x17TFܾ\x8dP\xd1K\xba\xdf]\x89\xe5}=U\x15\x152TP\x87\xad\xcc\x03<A[\x1f\x9bl\xac\xb3G\x85\xbaH=\x14\xb8\xc9Qf%x\x9f\xe2\xfc\x0f\xba'\xba\xc1\x9b\x8f\xddE\x86\u007f\xe49\xfd4\xbb\x19\x8b;?\x13\x96\xe1\x16\x96\xa7\xe2<\x93\v\x19\x89\xb1HE\xae\x10F\xc4\f\xd0u\xa5N\x9b\x15\xd8\xd5S5\xd5}\xef褘]\x15}.\xfa\xdffO\x88+C\xd5B\xa6ӫL\u0091\xb7\xa9E\xfc\xf9ߊ\xdbu\xbas+\x05\x8ba\x8b\x17\xb4\xce/\a?\xae\x88L\x06\x8c\x9a2\b\x06\x82IA\xf6\xc0:\xd7\xc3ɪ]R\xbbM\xc2Ή\xe4\x9fv\xca\xc7\xd99\xee̛ᾪ\x83{[\xabç\xaa\xa0UU\xf4Rn*tT\xbb\x11\xc8\x14\xe7p\x0e\xa1oU\x19\xbe\x86UT\v\xbasP\xc12\xa7\x10s\xd1\xf0\xf3%{\x8e\xff\x00\xf9\x0f\xda\xecn\xf7vz\x95\xeeD\x1f\x1b\xb3^k\x03\xeb9\xf5]\x93`4f\x9fd\xf0\xf6\xb8\xef\x05\a1t\xbe\x83E\x00e\xb5\x9a\xa5V\xea\xfb__K\xaa\xa9EM\x82\xa9\xce\xe99'\x9f5\x1c\n\xe4\x9dgT \xf9\xf01\x02wV+s\xe4\xc6\xe6\u007f\b\n\x06\f\x9a2\t\xb6l̠\xdeϵx\xf8\x8a\x92\x81<\xb2C\t\x87\x03!5\xaes[:,v\x87pd\x14\x87M\xa4@n\x9dJ\xa4\x97\x14\x1diW,\x96\xab1vJ\x8a/\xfb\xdd\xd6\xedn\xae\xd4\x05\x11U\xbdE\r\"\x11\xebp\xe0\xd3o\x13\xcc\x05\x85\x94o\xf2\x88\xba\xd2\xdc\xe4wGTu57\xd5v\xb6\xcf\x16o\x99o5\x86§\xc4~\xca}\\V絛\xa2\x89\xfa\"\x1a\xb5*\xa7o@\xb2\xfa-\x02\xa6Z\xaal\xf4T\xca\xecL\t\xa1\xed\xf3T@!\xc2%\xa8;c%Z\x95[\x81Mg.j,\xd803 \xa4]\xef\x1d\a\xc2*Q\xec\x83X=T\xbc\x979\x03m衠B\xfb\xaf\bZ\x05\xd3K\xb5\xba\x8b\xaa\xeb\xad\xd5\xf4\xba^\xabF\xe8\xbe\xd7\xcd\xd5\xc9t\xbb\xa5\xd2\xe4+|8HU1\xe6\xb7H;~H\xb7`7\x96\xc8sI\x0eXqbi\xab\xa5\x17Z\x01\x8b\x93\a5\f\x8b6\xfc\xb7C\x02\x93\xdePr\xd1eM\x14\xe7\xd5juR\xbe\xd7s\xfc\xdd\xd3[\xe9\x9d\xddUjT\x9d\xae\x97I\xd8\x1b40\xbb\xf2:\xed\xd7+\xaa6\xe3U,q\a\xa2\x97\x197hՅ\xa1h\xb2\x8f\xe5}\x90\x1a]\x9a\xaf\xa5\xd5_e\xf7[\xb9kvJ\x1a\xa1\xbe\xbbRWK\xa4\xaalO\x16y\xa2\xab\xe5\xb7L\xc5\xd4A\xd6\xd9\xf2j\x8d9*z\xa8\a\xcc\xdd\x1f\xb5\xc2\x14\xbd\xf1Ӛ\x95L\xb5T\xa7U\xa0_k\xb19hՠي*\xae\x8b\xaa\x93\x9a\xe9\xc0\xa1U\x1c\x18Et\xe0CG\xd5Nn\xf1**\xfa*\xfa*\xaaz\xdfOU'3\xea\x84\xfa-V\xa5}\xd45oz/\xb2\x93uW\xda\xfe\xaaJ\x81\x9a\xaejN\\,\xd5G\xa2\xa1\xe0Hڂ\x170\xaa\xed\xc40\x8a(X[t\xa8\xbb@\x82\x81\xde\xd5S=WE\xa0S\xcbE\n\x02\xa6jN\xc3[Js\xbeJ\x8b\xa4\xdf'%\x1c/\xff\xc4\x00)\x10\x01\x00\x02\x01\x03\x03\x03\x05\x01\x01\x01\x01\x00\x00\x00\x00\x01\x00\x11!1AQ\x10aq\x81\x91\xc1 \xa1\xb1\xd1\xf0\xe10\xf1@\xff\xda\x00\b\x01\x01\x00\x01?!\xea\xe9\r%\xcf\x11\n\xe8c\xf4\x03V4\x1b\r#Ѳ\x8c\xb2\xafxѤYd]\xdd Ev\xfa\xc2\x1f\x1a蒥\xc4\xd6^\x8b\xf0O\x00\x8b\xbd{\xf121Y\xab\xf9eKҳ0\x99$)\xdb\xee)L\x1dh\x8d\x03\xdf\x19\x97\x1b#\xc1\xf6\x87\xbe\n\x8d¯\r̀{G\x02\x85\xf0\x93\x85\x1cnEs}+\xa9\xdfH!ߘ\xcad\x88agzs\xa9\xa9\xb4}\xe6\xafTq;\x97\xd5|\xc3\u05ed\x8b\xa2\xf1\x1a\x8a1\x8fC4႗\xb4\xf2\x98\x1cK\x8b;\xee\xa5R\xc9~\x91\x1b\xcf\xda\xc1\x00i5xj\xe8\xc2\xcdf߈T\xef\x87\xf3\x14\xbc\x83̹\x12\xb3|\xcc\x11S\x93Yq\xd0\xe0\xdaR\x1e\xbcó\xb1\x94\v\x17MR\xcbUkV\x9f\xcdsD;\xaa\x98\xa5\xb6mJPSNv\x94#5u\x15В\xa5k\xca&o(\xa9Q\xa83\xf8J$A\xd1d\xde\x1b\xad-^c\x99\xe6\\'.\xbd\xe7\x89Fc\xd0\xc7\xcc[Ӣ\x9b:KTl\xe66es&\x04\xd7\xd9\xcb\xed.=Q1\xe3\x17,)Ղ\x04DܗJ\xae,`\xc2\vAu-\n\xe1\x83[\x81\x00\xf2f\xcc\xfa\xe6\x1a\xcd[\xe9\t\xde\x0e\xb1\xf5\x1bGnM\xb5\x99oڦ\x85D\xc9\xd8\x11lj\x93\xb41\x88\xab\xf15B\xf6\x96t\b\"\xe2\xb9eF\x88\ue8d6\x8dɉ9P\x95\voz\xfaXg\xa1\v\x17P\xe6#5\x01;\xf4v\x81\x11X\xd1ж\a\x11\xd8\xf8ӈe\xbd\xad>\xf2д\xdb\x0f\xbc\xad\xdc\xc0\xea\xb2\xe1h\x02\xae\xc4֑\xfcb\u007fe\x1d\xa0\xfa\xc1\x81\xd2j\xb9:\\3G\x97\xfc\xd4#/\xb1\a4\xa4P\xc0N!\x9a\xc1\x96\xdd\x1b\x15\xbb\xcdq\x87\xb40\xf0yٙB\x8cg\x9c\x83/EͶ\x11P̢\xba F\xfbC\x16\x0e\xc4\n\xddQ\xb3Y\xb3\xf7}\x17\x16\xb6\x9b\xf5q\x99\xc02\xc6\xf5J6=c)\x15\xf8\x9ek\xf4\xb8\xb0\xf1\x1e\xd1<'\xa0=:\x92\xe2ř\xa4w,{\xa0\x81\xc7\x1f\x98\x97\xee7\xe6[6H\xd3\xe4\x8b\xeb\x06\xaf\x12\xbd\xb3\xc1\x82\x1f\x90\xb4\x80\xb4\x11\xa1\x0e\xb7ɡ\xe7\xde$0\x1c̻\x88\xc4\xdem\nǱ\t\xd5]\x99\xaf1`\x11\x00\xd44\x8c*\xać\xe8\xb5\\\xd56#\xc7\xd2\nS*O\xa4\xd3\xf9jpʠ\xf3;&\x19}/\xcb>ӿLK\x98\xc5yǈ\xb1\xcfw\x89b\x01\xfe;\x11\xab\xa3r\xdf\u007f\xa8\x92\x93`\x98\xef\x1a\xca\x19T\xf6\x9e\x87\xfeĠ\x0f\xe1\xff\x00\x93e\n\xfe\xcb\x11]\xa8<{\xbe%\xe0\x82\xb0\xa9\xddՇ5x\xe6Z=:\x8c-\xb0\x8ch\x9eڿi\xaeu\xadm\xed(\x80\xa3T\x98\x84`\x03\x8f\xe6R;5\x81\r\xd0\x1fk\x1a\x94W\f1r\"\xc24\xf7\x84\xc8k\x9e\x84m\x93r\x98\xb4C]\x1e\xadF\xdb\xf4n_@\x16ٮ\"\x96\x96\x99\xaf\xb6kʝ\xa0\xb7c\xe2\x1dRn\x00\x88d\xde\xe7\x05:\xde\xe4\xb9y-%\x1d=f\xb3N|ʵ\xe0\xde[TF\xf8\\\xb4\xdc1\x14\xa8z\xac\xafHb\xd5z\xe3\x18\xfa\x19\xea\xea\xc4\x14P\xe0\xdeT\xa9Q\x9aΠ\x96=9Z\x83M\xe0Ӌ\x94{\xeb\b[\x19F\xbf\x97\xdelt/<̡\xaf\x83^\x19}em\xc3u\xb7\x84\x05C\xa2\xcd\x0f\x1bL̏A\xef\xa4\xe0³,\xde6ft/\x97\xfc\x9b_\xad\xa8T\rUt\xd39oo\xbd\xa0\xfb\xbf\x13\x8f\xb4\n\x81\xad\u007f\x98\xfb\xc2:\xa5ý=\xa5\xcf;\xd1q\xe6\xf4`\xf0\xb1\xcd\\\xc2<\xef\x19\xac\xcaғ\x02^\xa2\xf5\xdb\xfb1q{\x1b\x87gF/\x8f\x83U\xf8\x82N\x04\xeeLب\n\x90r\r$\xe4]g,\xb2С\x9fN\x99\x80E.S8\xca0K\xdb1\xbb\x86gZ\x96Q\xb3ذ!V\xab\xbc\xc3\xea\xb3Qyg*\xb5\x96\xb6VCD\x99\xb9d\xb8\x02ף\xd6{\x8f\x12\xb7\xee\xee\xb3\x0e\x01\x18\xc2y52\xc1\xc1\xe9\x1e\x04B\xd5\xfcJ\x95+\xa3\xd1\xcb\r\x85\xa7d'\x1b\x00o\xef\x00j\x15b\xea\x1c\xeaŚz\xfe\t\x91\xfc\xbd\x96b\xe4\xcbNPi\xa3\x02\xaa\xbc\xb7\x95BxS\xe1R\xde\x13h\xbe\xae\x1b\xf5\xd2kt\xb4\xafO1,-\"\v\xb4\xcc\xc0\xad\xe5\xc4i\x05\xb1U\xfeC\xfeR'\xf78\xd8\xe5f:ݩ}cz\xb0w\xde\x18k\xccL\xbb\xc4+k\xfam~\xf3\x8d\xf6?0\xa3\a\xbc\xa9}\xe8\x91/\xde=\xa5\n_\xbd\xb1?\x1aU\xe3\x88IA\xaa\x9c?r\x8d+\xba&\xe4`\xe6h\xf8\xae\x98\xac\x10\x8aJ\xbct$\x17s\x15\xaa&\xd2\x00\x14M\a\xee\x96\xff\x00\xb9˧\xa7D\xbd\x1aDM\b\x94,b\t?ѡ5m\xe4\xfd\xd8ڵo,\xa9]^\x8ccqPa\xd8s\u008c\x1dV\xa0\xab\xf1\xebS\t\x16\xcfVj2\fCJ\xcb\xf2\xb7(\xe5\xf1\x06\x88\xd9\n5\xd7\xf3̽\x1f%\xa6\xd4\vq\xddԡ\x8f\x9e\u007f\x11\x17z\xe4kR\xe3Dܺ#' \xa6\xbd\xe1\x87\x17C\x93\xfcCJ\r\x1d\x89Pp\x86\xe0M\x98cR+\xad^\xa4\xa8\x1b\x8djzK\x1d\"\t\x8fj;\xcd\xedF\xe69\x9f\x90\x1d&0\xdc\v\xe6r\xddӡ\xd4\xe9\xa8\xd9\n'X\x95\x1b\a\x80\x99!\xf5P|D)\x1b=\xa6k\x12\xb7\x8a\x90\xa3\x9cL\xb9\xd7_\xbe\x8b\xf1\xdc\xe0\xf7\xfe\x11\xa1\x11u7z\xeb\xd0\x1fSьc3\xaa\xc716\xa9\rk\xe6!\xd9\x17\xde?b1\x96\u009c\xebQ_ \x1cw\x87fT\xd4\xf9\x86\xe8\ty\xa4\xcf9\xfcK\xac\\\x17\xa8\xc0\xb2\xb9Q\xc4f\x98sC\x19\xb5\xab\xccs+\xab㐶\xbb\xcaJ\xa1\xbd.\x12i:\x99$Rz\xe7/\xf7i\xea\t\xf6\x87Xˣ\xbbP\xda|\x12\xc6Z.z\xf6\x95\x01\xa4\x15\xfa\b\xef\x19%\xb5Z\x90\xaa\x87ю\x92cr\x03~ؕ\x02\xb9\x9e/\xa6\xa6\x90:һ\xfcK\fmN\x0fx\xeb]\xc1Й\x88\xae8%\x04+\xb9\x04j7\xed\xff\x00#\xe5kA<\x83\x040\xd0x\xbd\x06\xac\xbe\xe4\xee\xdfd\x87\x8c\r\r\x03\xd2T\xaf\xa9\x8cc\x18\xc6b1\xe0<\xcb|\x8d\x16;\xbcK\x84/#n\xf0H\x1a\x91\x96Ca֧.\b\x0e\x00\xb1\xa7}\xccm\n\xd5\x03\xa0\xda(Z\xda\xd0~\xe3\xa0jm\xf7\xff\x00e\xc1+ T\xa6\x01\xa3%\xd72P@\"\x05\xbe\x92\xb1\xbc*[\xe3\xda2\xe9<\xbf\xa1\x1f\xb1\xea\xabYݜ\xa6\x1e\xb3Y\x91\xa2\x9d\xdd\xd9]C\xb1\xb7e\x9b\xb6\xb7\x86\xaf\x88tf\x1b\x9c\x18\xfa\x88\xdb\x05Vk\x05mf\xb8T\xc2\x01`\x0e\xd7hp\xf3\xccTn\xad\r\x03\xc1\x0fYt\xe1\rK\xee\xc5\xf9\x84p\x8f\x95\x1a\xb5\xa2\xb4\xab\x96\x12\xa5}oF1\x8ce\xcd\xf3\xed\xde\x1fY,\x91+\x13\x9f\xf8\x98lߏ\xb7\x89[\x8c\x82\x0f\xf7\x11k\x9d#m\xb7\xde#e\xad\x1cL@\xb0\xa0q\xdc\xd4,\x9ac&\x175\xbccY\x1ar\x1d\xaa\x15!:\xa9\xfeA\xe6\xa3\xe0&\x9b\xfb\xa0\x00\xb8\xae\xca\xf12!G\\\x04\xb0߃C\xfb\x98\xa3t\xc3\a\xd3w\xb4Gj\x15<Kt3\xb4\xc1J\xc1l\xda\xc3rYX\xf5Q\x8fi\xf7\x90Ĥ@\xccS\x1bM\xade\xdb~\xa5D1\x1eR\xbe\xaa\xfa\x1e\xa2T\x15\x97\xea\xc7u\xc61\x9a%\x14#\x93h\x10\xe9\xb38<\x13\xf8\x88\x174<\xb6\xd1\x16\x9b\xae\xa9f\xf7\xa0\xd5\xc4\v\x14\xb6y\t\xab\x03\x90\u007f$%9\x82\xcfo\xb7\x97\xdaa\x9f\x83\xf7\x99^X5\x03œ\xee\x9d\xc1\xfdĿ\x1a\xea>\xa1F\xc8\x06\xad\xe8]ܧ?x\xed\x00\x966\xc0&\x1c\xf7\x9b\xcf\x12\xd4\xdd\xfb\xc45\xd8[\xf6m\xe9\xed\x04n7\xef\xfcG\x05j\xe2\tD\x81SA\x9ef\x03\x88\xb2o\xd6\xff\x00\xe2鈭J\xe0o\x02\xa9)\xe8cЙ\x81\x06\xa6\x81\nk\x0eC\x982Eְ\xeb\x1d\x107V8\xfe\xe2*/\xf0\xd6*!\xb8\xbdbSL\xa9\x81j\x94N/\xf6Ϧ\xf2\xcdy\xb0\xc0zC\xa5\u007f˙\xf0\x97\xc2<\xcay\xf5a\xdfSVe\xf4t\t\x87Hnk\x1c\xcb\xf6\xf3\xbc\xae\xc8\x06\xaf\xd5!݁\xda\rzI\xab\xd9\xd7\xfe(и\xbe\xf3\xf3Ռz1&\xa0\x8a\xe5{\x9b\xad|\xcb@M[Ud\x95\xe7iCi\\M2\xda\x01~\xb2\xe0\xb4\xd0\xd8\xf4\xfaϭ腨\xf6\xf3+\x99\x86\xb7\x9a-\xd63\xd3\xe1\x04\xbf*\x85\xc0\xac\x99\xed+\x9b\xcc\xcc\xc2\xfeX0Ge:#\xf4\xbbF\xe7\t\xe3\x85\xc1k\x03f\x9d+\x13B\x88)\bAP\xb8\xe5.=j\t\x8f\xcbA\x06[\xdcb\x1eP\x06\x16%h]\xa2\xd9tgL\xf0JHa\xe6&\xa7\x83,J\xff\x00\x8e\xdfYш\xdej\x06\xb1\xa0wN9\x8b\xa5Ǵ1\xbaƄ4ɝS\xb0\x82\b\xa5\xc5\x17rg\xc2b\x1d4~\x9f\x02\x13M\x1c\x18>\x96f\xf6ь~\x90\xb8\xd9K\x18M\x02\xfd\x11\xe7r\xa1\xe8\xa7\x1e\x9d\xa5\x18d\u007f\xf8H\xe4\xf4\b\x05X\f\xdcZ\xb3\xbc\xdct\x9a-\xaeT\x8eH$:\xc2Mk\xd4\xc4@l9V\xbf\x04\xc5e\xf8\xcf*\x8f\x99W\x88\xe7cy\x87!\xfb}$z,^\x8c~\x92%\xf4\x010u\a\xb8\x8dS\xf4?\xf4\xc1\xb4\x05\xcaf\xa5\xd1\x18L\v \x8d\x1e̺w\x89\xab\x1e&\xaa\xe6p\x90u\x830\x13?x|\x8c\xa5e\x9a\xeb25\x87hN\x0e:\x1b-]\xe3\xb3Jw>\x97\xa3ь~\x91\x9a3\x1dzm\x04I\xa4\xf6\xd1\f\xab\xa1\xfa\xde\xff\x00P\x06\x1b\x99R[u\xb9\u007f@\x9b\xc5\xc9*Ε\a\xc6&\x1a\xfd\t\x81\v~\xdfߙk(\x1e\x9a\xe9\xa4\x05\xbbè\xec\x9c?S\xd1\xea\xcdǣ쑛u\x0e\x13\xb3\x1e\xab\x1fC\x12'@\xc2\xf8%\x1c'\xb8\x89\x80G\xfe\xa2\xe5\xdd\x1b\xbcF\x1a\xc1Lk\xb3Gh\xc8V\x87yX'`\x18:\xeb\x1a%\xd92fi\xd5\xc9\\\xc3\x18u>\xab\xeaHTؔ\xadJ\\f\xa1\xaaS\x97Ŕoz\xfaKb5\x15\x0e4\x98\x04\x1b\x80=\xb3;\x91^\xebɴ\xd3\xec\v\x83\x1c\xfdU\x12m\x04\xad#\fc\xb2GS\xe1\xb7/\xf9\tf\x91\xee\x1e\x18\xcc\xc8GU\xed+\xe9\xf6K\xb8l\xce\tCN\tUÈ\"\x96\xaeSh\fщ\xf9\xc0㦳*0\xa1R\x13\x10\xeb\xb0m\xaf\x8f\xa5\xe9\x8a\xe9\xa8E6\xc5ȯV\xec[\xe0\x89\x14/Wm\xb9ؔa\xba\xf8G3\x13:N\xaf<\xcau\x87\\\xb3\xd9\xc7\xfeA\xff\x00\x14\xearʆMแ覌\xfdaU3\xfe\x8b\x87\xbe\xe3\xe0g\xcbs\xe2|\x91\x0f\xfe8\xa24\x15T\xc5x\xb3\xda2i^ST\xaa\xc0h\x93\n\v.\xb1\xb3\xde^\xfc\xcb@z\xa06\xcfyY\x95\xccO\x0e!\x03\x90\xfa\"-\xe8k\x1cu\x11ͳn\x8b.3\x16\x05r\xb4\xfcGܣBs\xe2(zn\xed\x0f`\xed\xc1\x97\xc0\x03\x03\xf3\xf3\xe2\x06W\x89\x9a\xa7\x85\xf8\x9a\x93ؽe6 \xa2\xd5\x11\b^\xea\xaf\xdd3\x03'\xf2\x91\xff\x00\x83\x02\xe7-\xdcs\x9c\r\x88\xbe\x87\xfc\x1e\xe3\x9c%=|\xfbǜ|Ff\xf5\x83T\x06\x12\xb3\x0fB\x9e\xc2\x16żB\x9b\xf5\x97zJ\x9eE\x88\xc4H˩\xb1\x15\xb9\x87*1\a\xa5\xcd\x03SIw\x1e\xae\xa2}\x1a\xb8#\f\x04P\xd0\xed0\xee\x98\x12\xfa\xd5`rEZ\xfc(\x87if9\xbc\x1c\xd3\xd47=\xa6\x10u`\x1d\xb3\xf1\x12^t\xd3\xc1\xb4G\xbc\xe9\xe3\xf3\xef2v|9\xff\x00\x87/\x99\x905\xf3\x1a;\xbfh\xf7\xff\x00\x82S\v\xee\x84TQ*kU.\xf9\xe9\xdf2\xb4T<%@Zt\x80\x95h&y\xcd\xcd/_\xda\x1d\xfb,G\xe9\x177K\x8a\xdfx\x14\x83:L\xc1*\x15\xd7[\x98\xf6?\x98\xf4\xac\xc6\x10\xa8-\xa1\x05\xf2\f\x13\x15\x91Q\x83\xbe\xfbDҲ\x9aZ\xb8\x13T\x04O\xc8\xff\x00%\xccH\xa5\xde\xd8z\xc1\xe2\xd1A\xc0v8\xf6;\xcb\x03\xf2|\xfe\xab\xd6\x00\x84\r\x02]S\xf5\xcd\x1eL\x06\r\xb6\xfd\xb7\rW\xb3\u007f\x8b\xf6\x8a(\xa8\xdb\xe9W\xa2}\xba\x8fTѶ\xaf\x10Su\xe7\xf5\xfb\x89\xd7ዱ\x83\xfe\x19,a\x18y6\"\xce\xf7\x12c\xff\x00&⩇\a\xdao_\xac\x0ec\xbbE\xcdL\x9e%\xc1Tݳ3p\xc3\x16\x1f\x81\x16\x97+FX\xb7\xc2\xc7\xd9\x12T\x16\xd0\x0ec2\x85\xb4\n3\x13\x9di\x0e\xa9D\x99\xcdˊ\xaa\x8e\xaf\x1e\xe8c\x95\xab\u03a2~\xa3\x1c]\x1a\x94B\x86\xa9\v\x9c1\xac\xec)\x85\v\xbf1\xa2\xe4\xbbs\xc3\xf3\xed\xbcgR\x05V\\>\xecQ\x17\x1c)\xf9\xfbM\x90p\xbaY\xa3\xd33l\x1dWU\xe5\x8c\xe8^\xdf&t\xf6\xd6<\x03\xd3\xfb#\xb3\x01\xb2\t\xd0\xf5z\xc6\xce\x15\x82\xf9\x9fA\xc98\xdd\xfeE)q\xd8\xfe}\xe3K)\xf6\xcfܲ\xb5o\xfc\x17\xbc\x9c\xa5\x03\xf3\xfe\xb3\x0e\xb3\x0e=\xa5.\xd8GM\xa0\xdf\xf3\b;\xc6ٙ\x91\x99\xaf\xd2$\x0eB-\xa8;MW\xfa\xc5\xd6_Fh/H\x12\xc8\xc378\xad;\xc4\x02\xdc\x06\xa8\x94\xf2\xe6`l\xc7y\xa3Pz\x8ai\xeb3\r\x17\xac\xb7\x19\xe8z\xf9\xfc\xfb\xc1S\xd9K/%\xed\xae4γE\x02\x0e\x9ao5\x89\x82\x14\x95\x9a\x97^k\xed\xfd\xa4ă~\xecF\x8b&\x16\xac\xbc\x97\x110\x15\x8c\xf9=#\x1b=\x00lm\xf9\x81HV&\xf3^\xa5\x1b$υ`uQ!c`\xaaݛ\xaf\xde!\x88i\xc2\xe8mU\xed\xd2\xf3a\x95t<\xc5\xca\xf8?\x83\xe6R,ͫ\xbb\xf2\xc71[\xaf/\x99_Y\x96\x82d\x03ĵ\x87\xd9еY\x8b\xf7\x9e\x93\xbc\xdb\xe2k\xd0bw\xa8\x99L-~c9jR\xf5\xa9\x99\x9e!\x91\xae\xc7\x12\xa2\x9c\xf1\xe2f\xb5J:rK\x90-\xa5\xed0s\x00\xba\xb9\x17\x12\xd0\xde\xf7\x95\xc97\xba\x1d\x12\xcc\xc5*\x9a0\x1a\xd6\x10+\xf0\x0f\xbd~\xa6\x17F\xf6:\xb9O\xbcas\xc5\xcc\v\xa9\xce#\xfa\x19\x1e\xafi\xaa\vZ?\a\xee\v\xaf\xe77\xb9\x03W\n\xfa\xe3U\xd15\xb1\xb6\xb6\xdf\x1f\xaa\x80S\xdeg\xfb\xac9@Ź\xe0\"\x10\vQo\xe0|D\x8f:\xa5\xb2\x9a\xf0֚\x8e{\x1d\u07fcl\x05~O\xcb\x15\xec\xf6\xb8<\xbf\x12\xd0\xefc\xb7\x8f\xa6\xba3;O\xe2\x0fm?\xd6\"\xec\xf9\x19k\xf8\x9bۯؖ\xea\xdcOi\xfd]\x13\b\xef\xbdC9k\v\x95\xd1\xcb<\xaf\x1a\x11g\xb5\xdeh\x1dp\xc7\x00\x9c*\t]\xf9\x86\xf0p\x05e&:\xddr\xc3\x10S+Q\xdcx\x976\xe5\x986\x99t\x1e\xb8/\x89w\xd0,\xb4Qtw=eԴ\xb3ɷ\xa8\x99~\xab\xd8\xd1\xfb\xf5\x99X\xaa\xfe\v\x83e\xcc\aB\xf4\b\xfc\x8e\x8c4U\b6i<\xdfɁ\xf5\xe7{N\xeb\x19\x97\xf1\xff\x00\x17\xf2\xf4 \xd1\a$\xd5\xfeTl\xc4W\xf0ñ\xf7\x95\xf5=(<\xff\x003\v\xdf\xdb\xcdW\xb7<D\xb2\x86q\x9dc\xc1\xfeϏbWw\xf73\xd7\xd6W\x04Z\xdfM^#i\x81\x9a1\xef\x030\xed\xef;m\xf7c]=\xbbĒJA\x95\xd4?iz\xbb\x15\x06\x93^\xd3y\x1c\bn5V8\xcc\xdd\x01\xb5CK2\xb8i达\a\xa2\x98]\x89\xb3\x02\xa5M}\xbf\xd9=I{\xac\xab\xf2|h\xc1&\x05g\x87\xd2\x16VZ:¦\xb4\x00\xf6'l\xba\r\x1aև\x96Yh\xe5?\x85\xfe\xed2'\x8d\xb1s\xd9\xdd\xf4\x19H\xa9x\x1a\xbcn\xee\xc5G\xd74\xf3\xf83\xe2\x18M\n\f\a\x01\xb4\xa8\xca\xfaI\x02\xae\xc4\n\xd4\xfb'\x98\x8a+Ҿ&\xbarî\xe7\x1b\x11tW\x1c\xc4\xf6\xe2=\xdcK\x822 \xd3\xcc\xca5U\xa7m\xe6\x8b_\x1f\xe4Ε\xe91\xd5\xeb)\u007f\x84u\x95\xe9O\xcc@z\xb1\xd8\u007f\x13\ax(\xb0)\xeeD\x13\xa9\x89S\xbb\aT\b\xea\xcc\x1cM\x12_\x9e\xd1\xf3pLc\xdebѤ>\xa0\xa2\x98\xd4t\x1c1\U000a8d62\xb0\xff\x00\xc7X\xa4\x0e\x83b\xf9\x88\x96\xe3U2\xfa\xb1'\x8c\x17\xe3\xfd\x9a|\xff\x00\x9b\xff\x00f\x1c\x13>\xa8%s\xd4\xcf\xc5\xfc\xbd\xa5\xa6\t\xb55}\xf7_\xbc\xbc\vq\x85\xe0\xe1\xf7\xef+\xa5D\x95\x18\xf4\xa2\x8c\x1a\xec \xea\x15\xee\xd6QLG\x1dk\xbcL\x0fi\xa7\u007f\x89y\xb5\xee\xf8\x82\a<\xcbX\xeb\n\x9d\x1a\x84\xf5\x9c\xef8U\xbcNK\xeb\xfa\x86\rs\xb4\xd3\xcf\xe1\x05A\xac B\x90\x96\xb9{\x06e\x15\xc0\xa7ί\xe7\xed7\x93C\xdehs\x89\x1c\xa1f\xb0R2\xe8q\x03x}\xd38\x04\x13\xa3,\xd44\xe2\x0e\x87Q\x93\xa2\xe6i\x1a\x8a\xbdS&$\xaa\xe8\xd0\xf1\x1ab\x12\xb08\x9d\xcd\xedҰ\xcd44\xf9\xecw~\xf3\x00\x99aj\xf9w}%\xac\xdcg'\x9e\xde5\xf1\x1c\xc0`5xN\x8a\xd3.\xfdXǥ=ߒ\x14\x10#]\xff\x00r\xe6r=\x88\xf3\xafvy\xb3\xb6\xf1\xc2\xf4q\x06\xb0\"\xcfy@\xf3\xeeǖ\x04.\xb2W\xe5\x98b\xb5\xdar\x0f\xc0\x89q\u007f;\xb0B\xb0\r{@\x1d\x9b\x97y\x8d19\xe6_\xef+\xe7\xe0\x9d\xb5r\xf5\x80]o\x98۪\x9fx\x15\x98\xb4.\xf3OB\x8fJ\xb2:\x1b\xeaLKZ\xdb]\xe5am\x8c\xf4\x84\x18f8\x94\x14\xc1H\x8c\xbcw¥\xc8r,\xbfl-\xbd\xbb\xbcC}C\xe1g\xad\xf9{E1e7\a\x96\xec\xa5)\xbc)\xff\x00\x1ez\x19\xf4C\xecJ\x05\x12\x83\xa2P&=7\xe6\xe3`\xc2GiQ#\x05\x8a\xa9ZwN\x100\x0e\xf7\xb1\x13\xd10q\xf7\xd6f\xa5\xed\x06A\x94\xde\x06\xab\x03F\xb3\x15˝\x89b\xe1\xf9A\xbc\x9bn\x94\x0f\x97Vl>ƬmgF\xdb\x10\x16\xc1\xa65H7ci\xd2\x01\x1d\x81\xbd#\x85m\x10#\xdb\x01c\xa0ʹ\xa93\xbc\xab\xc1\xd0u\xd7r_m\x00䋢\xc4@\xb4\xc4'wEM\"8\x9a\xdc\xf0\f\x0e\x86\x103]\x9b/\x1b\x1d\xd8\x14\xcb49\xe5\xe5\x8fX\x96B\xe5\xe5\xff\x00\xa9G\xd86\xbf\x8eu\x89\x99\xa8\r\xe6\xe0\f\xd5\xe4\xe5\x82Y\xdf\xceߩV9v\xb6%J\xfc\x97\xa7\xbaoD\xba2\x9cc}\x84\t\x99\x19\xd5q\xae\xa7\xa4\xad\xff\x00\xc4L\xeb\x9e`\xe2\xe5\x19F\xf1q{n\xfe\xa5c2\xa8\xd1\xf126\xf4\xd2X\\\xd1\xf1,\xb7\xf7t\"\x81\x96\xb0\x92T9'\xb1\x0e\xf9;AWs\xf10a;\x89z\xf4\xca; \xdc\xc2\xfb\xe3\xed\x0fz\\Fձ\xe1\f}\f\xae5+\xf8`o\x8e&\xae4\xd4W4\x96\x1d\xd8B\x9edu\x1c\xf1\x1ch\x1d\xba5\xec\xe9\x8a\x16k\x15\xc4)\xd4\xf2Qg\xa1\xf3)C\x03.\xa0\xee\xb0Z+\xb8\xb2x\xed\xe7_\x10\xd6\xf5\xb8UOW|\x1b\xcar\xae\x1do\x8e%H\x1a\xaa;\xb1p\xa1\n\xba\xc3\xe2\x1e\xad\x0e\x1a\x01\xb4\xc8\x15\x04\xab\xad\"\xb1\xa4\xa4\xe8P7\xdd2\x80\xeb\xd9\xfe\xc5\xdc\xc7x\\\x06%\x80\xbeh\b\xb3z\xb1\xd2P\xcd\\\x83\xbf\x10\x8d\xc4\xcdB\xdf\xed&-#\x98\xac\xecW\xb15\x1c\xf9\xe6\x1a\xc6'\xdd*\xe8q\xda&w?2\x8c4\x8ai\xee\xe8혹\xf7\x99\xbe\x85Ly\x94\v\xb7\x99}\x81\x99\x99\x88j/\x8b\x9a%\xe3\x0fK\rcWɆ-\x1b\x1b\xbe\xd0\xe9\xe0\xd4\x1b2\x91\x97\xda\x01\xd3Y\xdcC\xcdE\xb8\xca\x03\xf7\x8c<\xbfyG\xb8\xec\x94g\x85\xff\x00\xc73\xba\x00\xf0s\v\vQ\xa3~#Ȼpx\x97\xc7\x14\xa7\xaf\xfeD\x02\xb2\x12k\x01\xd2\xec\xf5\x81C\xf4-u\x96\xc0\x15z[\xf8\xa9N*\\V\x96\xd7iE\x0fA\xbf\x98ԿA\xa1\x15\xae\x0ef\xc2\xea+ҝ\xa7\x88s-\xfd?s\v\x1as\xfa\x95\xad{?2\xf9@\xbd/\xe5\x97j\xab\xe1\xb1\x0e\x19]Ⓠ\xda\xf2\xc1\x06\xeeա\x0eI\xe29\xd7\xda\x01\xbcˈ\xdb\xce\x03\xef.l\xc1*2d\xce]\xe3Z\x8c>\xd2\x17D\x8d\x95G\xb6`\xc4\xc1\b\xc6j\xa5\a\x19\b/\xabm%\xc4q]\x11/\x81U]?\xd8\x1c\xd0\\\xfc\xc2\xc2j=\x05<T\xf2\xb87Qe\x86\xefB\x05\x96]\bc\xbb\x12\x8f\xa6\xf0\f\x9c\x15W\x87\xee2WKh\xd7\xf7+&\xb9z\xb1kQ\xf71\xa1`kO\x99[q\xa8hE\x90\xaf\xb2Mu\xaf\xf6\x84\xee\xd2V\xac\xd4\xf0\xf4\xfd\xc7s-E\x9e\x907\xd8\xde+i\xf3\xb1/\x81\xb0\xde(\xb7\xdd\xda\x17n\xe5\r(\xfa\xcd\x1d%0\r\xcdC\x81.\x9fr~\xf6 \xb5Fj;\x04\x1a\xb7\x85\x05\xba\xe9*\x93\xee!\x8aM\x11\x9e\xcc:'Fa\xdby\x8dU\xdad\x00\fm\xe6\v\x82*\xf2f\xc2\xfbq\x9c\xaeT\xed\xd7\f\xbe\xday]\xa1\x80\xa9\xde\xc7!\xcc \xa2;\xca\ro\xc1\xac\a\xee\x83\xfa\x99\x92{[Ռ^\xb7aeC\xa7?0\xddSm\x88\xb9\xbb\xf5\xfc@\xb5\xfe\xcc\xd4h~5b\xb4\xaa\xd87\x88F\xab\x1e\xc4m\xca\xd73U \xa6\xdb\x11\x15\x96]\xff\x00P\xd5*\xf5W\x12\xf7s\xba(\xc7\xf5̝\x0f\x1c\"\xb7\xf6 \x86\x91\xc6\xec\n^&\x9c\xa3\x9a\xb2\x8d\xa2\xfcb5\xf1\x03T\xc1\x18-qĶ\xd5\x0f\x80\"\x80\xbe\xc8!\xc7\x19З\xbc\xed\xd2h\x9d\xa6\x9b\x82\xe4\xab\v\xb0\x17\xb32\xa9\x04:\x8b&oSY\nB\x99\x86[K]0\xca\xfd\xab\xdb\xde\x10\xe9~J\x83X\xb3Y\x9b\x1c\x93\xeb\x17)\xebs\xdc\xc0۞Ɵxj\\\xac\xc9\xe3\xce\xc4\xdf\xf7\xbag\xd2!L<:\xbee\x8a1m\xdaY\x00[N$u\xb3\xc8ЃUk\xce\xf1\xb6\x1f1\xbd\x8c{\x10p\x17\xbe\xf1W\xa2c^?\xd8\xdaq\xef3@\n\xedϘ\xe5Z\xbf\x88c\x96\xe66\x92\xfc\xcaU\xce\xd0\xda%\x1e\x82\x02\xa2\xdb7\x1a\x99(טqw\x175_\x11\xea\\L\xc7a\x1f\xc1\x88\x1aGs1\xb5\xe6s$\xcc*\xa1\xd76˴\xd3\x17\x06Q!Ԟ\xe7\f@\xe4\xb6#\xe7TJ\x01\x8cY|D\x9b\x88\x1c\xfd\x19\xfb2\x9d\uf489P\x9b\xac\"\x16\x8f/0\x80\x83[t\xff\x00en\xf7\x95{\xb1U\f\x9e\xe9\xd9\xf4|\xc1[J(\x1b\x10\x1b\xac~\t[\x15\xba\xf3\t\xcb\xc1\x8a\x9e>\xc4\xc2\xf3\xce۲Գ\xc1Е\\/\xb0\x82\xefF\xe5\x1c\x15\x90\xfb\xb1|\x1e\xc4;\xfa\x8b//\xa9\x00\xf5o\xd1ݶ.(\x8dY{M\xc2e[\xe9\x83dgB\xa8z5w\x8a\x9c\x19^\x8f\xd1q\x87\\\x89\x82Zq\x88\x9fe3\x1a\xd1K\xd8\xe8\x19\xaf\xa9\x19,^By\x8e\x80*\xec@\x02\xb5\n\x03@lhy\x99[>\xed\xbcC6\x12\xdaA\xce\xe7.\xec\xa7c\xd1\xf3\x12\xea\x18\xdbbQ\xaab\u0605p2\xa5\xeb\x1a\u007ff+\x1a\x83\xd0x\x9a\xdeaݫ\x1aQ\u007f+\x01K<Bjf\xbd\x88\x99J>\xf1\xa8 \x11+\xb1\xb4\xc0\xc1\f\x16\x98\x96\xd84s\n\x01g\x99\xa2\x00q\a/\xb2-\xdcPQ\xe8\x80\x0e\xacoi\x1b&&\xb4'f\x91Ƒ\xd0!)\x99\xfbCj{i5\xb8\xe9\xb4Q\x87\xd1\xcaэ\xd2c\t\n\xcd\xd0\xc3\xf4N\xc9Y^\xf3\xd5\va\f\x0e\xe2\xf8\x96˗\xab(>\xc1\x03\x97\x84K\xba\r\xb6!\xfe\xa2\x9d\xaf;E\xaf\x97\x83B\a!\x97U\xf1\x05a}\xc4x=:DpR\xe3b`\xb4\xf7v#b\x9cn\xe1xI~\xec%y\xf0&\xe3\xae\xc2\v\xa28w\x806\xe2]O\x11\xb4U\x9fh\xd2\xc3YN\xf9ｦ\xc1q@\\\xa0\x1d\xb4j\xbd*\\\xde\xd3\u007fBe\xa4\xb6U\xbf\xa3HÉ\x87\x87\xd9\x14\xf9c\x83\xa1Ђ\x02\x18)\x98O|\xa26\x981\xd1bݥ\xfe\x1cη\t{F\x86\xf0\x8c\x0e\x04N¢\xe8\x1c\xb0\xa5\xe75\xac\x1aTr\x9e\xc3hj\xed\nna\xe3V\x05\x9blEW\xe9%-\x17r\xd6\x01\x1a\xc5\xfdX\x1a\xbe\x894\x16\xa6\x816\xbf\xea\x1f\x06\xdd\xe2\x1bRݖ\"\xda\xc7\x1b\xad\x1a̻\xcdv\x81\xc5j\xcd\vn\f\xb2\xad&\xd3^`r1(ub-2\xeb\x03=-\x89\xdd\xcf\x11P\xe3\x88\x00t;E~\xe8x\x9a\xc3\x02\xcdX\xeb4\x95\x8b\x81x负\xff\xda\x00\f\x03\x01\x00\x02\x00\x03\x00\x00\x00\x10@ -\x85,\xb2Sm\xd299\xa9+\xd0F0\xc1 ,\x8c\xb4\xfa\x19\x80\x84\xe5\xf2J!\x1c4\xaa\x1d\xc25\x05\x85@\xb2\x16?\xb5>@\x00\x04\x9d\xbb\xdb-2\xbe\xd2}\xa0S\xab]\xa270ZVG\x81%\xb4\x93\xb1\u007fn\xc5,\xd9\xc5--\xa6_\x96h\xd6\xca{\xfa\x14\t\xc8\xda\xfa\x8dӤ\xb6\xbbٸ\x1f8\x1c)H\x10\xc8\x0e\u05ca\xcc+N\x16\x9c\xfa|\x80@&\xb5\n<]6Q)I\xe3\x1cYZ\x87*Z߾k<\x144\xd5\xf7=\xceH\xa5z\xf9\x0e\xd0Y\xbb Z}\xfe\xd2\xe1-cy\xf4+\x12\x9eܽ\f\xa9\x04\x92\x1f\xab\xcc-\xd1[\xb6\xfd\xa9\xcd2&\xf9\x15e\x0e\xb3a\xa3\xa4\x82E\x04\xd2ЪAw˦\x91Ă\xb1\xa6\x1c\xaa\xbdƋ-\x92\x896\r7\x94\x02E\xfd\xa6\x92\x17y\x97EG\xa0m\xd0-\x8a4\xb1\xfcD\xbbҰ\r\x9f<\x9a\x03\x1e\x96[C\xd5M7Nٴ;ա\xcbH\x82I3m\xd2I\xa5\xf8\xcc\x02\x1aM4\xdaV\xdfX\x04m\xce)\x82A\x94\xfd\xf3m\x10P\xdc\xf1\x1fi\xa6\x93n\x0e\x96-\xa2\n\xadkL\x05\xed\xb7M\x957\x9db\x92\xa9\xb6\xdam\x8e\x97\x8e^sM-\xac\xa4\xbf\xb4\x99\xb0\x9d\xf1K\xa5\x95\xa4\xd2m\xa6\t\xb5\b\xbd\xc4\x17\xb6\xb3\xfc\xff\x00\xdd&{\u007f\xd0\xe4\x92ԛi\xb0E\xd2(\x88\xf2\x8d\xbe\xdb\xe8C\xff\x006\x82\xa40ʶƒm\xa4\xc8\xc3(\x9b$\x8d\xde\xdf\u007f\xff\x00\xfb&\x90\xdcF\nFZ\x92i$\xc98\x13\xd6\xdd\xf1<\xdb\xf9\xf4۶\xef\x1aT܉\xd3Ri\xb6\xdbmv\xed7\xbf\xa4\x95\xbd\xad\xfbf\xff\x00?\xc0\u07b6\xb6\xa7\x96\x02RI\xbe\xb1J|db]\xfe\x9b\xf5\xf7q\x04\xd3\xf6\xca[M)agR\xe2\x00eE\x8f\xad\x93\xdbd\xaf\xca\xc3\xe3\xb2[@2Y$\xb3,t\xcc\xf7\xe0p\x83\xb4\xafJ7\xe3\xe3c\xb6Ia\xb3\xed\xff\x00\xfb\xfc\x98x\x14\b\xa7\xf3ǵ\x11\b6 \xfd\x82\xcb%\xbf\xbbg\xdaK\t\xc1\x1a⳱^\xa2y~\xf8\x98Tb\xedl\x98\xb6\x84ܙ\xa0\xbd\xcf\xfa=\r\xb3\x06\xefH\xd9m\xbe\x03\t\xa1\x89\x12Rc4\x96\xf9Ɲ\x01\x03k\xdaO\xf5\x176R|s\xa1\x1c\x80I$)\x94\x80\x0e\xb5\x98MSL\x87?\x84J\xe7-\xbe\xec\x96\xc9\xc9\x0fd\xed\xb0\xfe\xa6\xfcbF\xbc\x1b;\xcc\x12\x1bAֆf\xed\xf0uћ\x91\x80\xaf`n\xbeX$\x97M\xba\xe8A\xef\xe2_\xb6\u007f\xe0\xd8ꚺ\x9a\xa5\x81\xcd\xc7\x19\x18i$\xff\x00LzymN\xc6q\xabl\xef'\xb4\xd7\"\x92\x05^\x88\xbd\xa4ߵT\x97\xb4!\x8aOն\xcdp\x9fի2\x80\xbd\x98F\xc0\x97=#\xde7\x94\x1cr\x95\xfb\xfc\xa9\xdd\xe3\xef\xf8\xc0h\xb9\xf9\x98\xbe\xcec\x1cyw\x06\xca\xef\xee?\xd7W\x8a\x12_uV\xf6\x81\v\x0f\xb6?\x87H\x92\xcf\xd5=\v\xef/ٞ\xccٴ\xfc\xe8\xc0\x1aM\xea\x18K\x9e\x9f\xdd\xf8\xd7,\xfd\x8b\x149\x19\x19\x97\xef#\\\x1e\xb8E`!\xf6H9/\xed\x89\xc0a,'\xed.[m\xd8\x041ֶ\xbd\x91 \xf7\f\xe5\xc0\xbb\\v\xb9\xe8f\x1a\xbd\xb2\a\x8a \x964\x9f\xec\xd6\xc9h$\b\uf636\xae\xd6\x10;\xe2e(\xddF\x85ؒ\x95\x95\xea\n\a\x10\xa0\x9b\x13\x81d\xdd#Y\xe6\xe9\xafn\xdc\xf0`+\x95\xc9\x1b%i\xb1\xf4\xe2av͍e\xf8'\xf2#+\xd6!8\x14\xb2\x12\x00\xc8<\xd4\x1c+LEe\x82\xb4\xb6\xb6$\u007f\xff\xc4\x00(\x11\x01\x00\x02\x03\x00\x02\x02\x02\x01\x04\x03\x01\x00\x00\x00\x00\x01\x00\x11\x10!1 A0Q@aq\x81\x91\xb1\xf0\xa1\xd1\xe1\xc1\xff\xda\x00\b\x01\x03\x01\x01?\x10\xfc\x8b\f\x9bo\x14h\x85\xfb\x00s\x05\\\xd1\x11>\x99\xf5n\x0f\xa4\x95\xac\x8f\x04u\x1d(\x8e\xfc\xfb+\x029\x82\xcc\x18\xfe\xbf\x1a\xe5˼\rA\xbc\xd1v\xe6\x96g\xb8\x0f\xa9\xfb0\x15\xe2?\x13N\xa25l\x0f\x92\xb2\xfco\xc5~cP-\x94Gz\x81z\x9fQ\x1d\xc4q\x8d\x10$SȳAd\xb8|\xb74\xc7^U\xf2_\xc3X\x1d\xca\xd9\x05U\a\xa8\x9fR\xcdˁl$\x0f&\xd6\xe2\x9d\x18\xd1q\xfc\xeb\xf8jWܯ\xa8\v\n\xed\x8ct\xb2-ś\x86j\x8a\xa6\xe2\x10>\xe3\xf4\x9b;\x83J\x8f\x83\xf1W\x89\xf8!p>\xa2\x02Xv_\xd6mz\x97]\x97|\x9f\xb4\xa1\xec}&\xa5]\f\f\x06\xae\xc5(K\xa87\x16\x00D\xaf\"-V\x06C\xa6\x1c\b\xa5\x81\xaf+\x8b\xf1\xd4\x1b)\x15P\xdc~\xd5\xf4m\xff\x00\xa9\xb3\xad\x10E\x14_\xe3\xf7\v\x81\x00\xbb`oPOb\x1eD\x9a\xf7*\x01&\x92\x86\x86\x1f\xbeQ\xa1?DJ\x9b;4\xc4W-Y;\x84\x15q\x14\xfbcVw\xd7\xfb\xfb\xe44F\xe3\v\x89eK\xff\x00\xdf\xfew\x03\xc2\xe5\xcb\xcdJ\x87\xc1Z\x96\x16\xf4A\x9f\xb2=\xfd}\xd7\xf3.\x14\xfe2\xf1\xeal`b\\t\xc0Q\xf4ˉ\x82\xb1\t\xaa\xe3܂\xf6BvK\xc5\xe1\x02\xc6'\xc0\x8d\x9eK\x80\x9c\x85D[+\xb5\xcc\\\xbf\x1a\x95\xf1\r7\x18\xa6.\xb5[\x89P<\x1b\xb1%v\xc3\xefܸM\x12\x97ԡ\x82\x003e!B\x01\x8b K\x1e\xc5\xfe0\"ȷ\xa9_\xa8\x0fQ\x1a\xf1\xc1\xd5\xd4J\xec\xe4O4\xb2*\xd5(Fឈ\x82'l\xbc\xd4\t_-Z\x80\xbd&\x85\xc6\n7)ܰ\x94\xe1\xe7\xf7\xff\x00\xd8(T}QzEl\xb2\x17r9;\xceű\x1d\xfb\xf5\x0e\x82\xdcL\"\xb2k}ĕ\xa9ٲ\xe5\x0fgF*\xf99\xe2\x02\xdeG\x90\xd1X\x16vl\x87\xf5y\xff\x00o\xfb\xb8;-\xaf\u007f\xef\f\xab\xf0)P\x8c\x03\x05\xa2\x1a\xe3_\xa8J\u0089\xd1\f\xb4\x10m\xf6\x97h#7bB\xc9a\xa9\xa1\xe0\xf6~\xeev\vc\xf0\x1a\x12\xee\xe1AF}\xe4\t\xd8\xf8\xd1\xee8\xa9R\xbf\x11S\x8dT\xf4\xe4H\xfb\x11Xҷ\xd8z@\x9a\xeaz\xa0eD\xc4\a_\xf7\xea\x19\xa8\x1a\x83g\xf5\"\xdf0\x81\xaf\x102\x86\xb05\x93r\xf0\u007f\x18\xa1\x15\xd9h\xf1\xff\x000Y\xc1\xf7\x1f\x04\xfb\xa6()O\xee5\xf2\x88\x1c`l\xfe\xa4S\xf0\x8al\x9a6O\xd5?T\xaf\xd4[\x91m\xb6%d\x94\x1b!N\xa2T \xb4\xaf\xc5\x12\xa3sjw*o\x8c\xa6\u007fW\x10a/x\x1b?\xa9\x15{\xf2\x95{\x83X\xe3\x1a .,\x90K%\x88\xb9r\xff\x00\x10\xc3;#\xa5\xc7\\\x95J\xfeڃ\x8b\xad\xc5^\xfe\x03\xf3\x186\xc6+W\x8b\x10 \x86\xa0\tF/\xe2\xaf\x00\xbdF\x87^U*i\xe4\x01\xff\x00B \"\xf0ÿ\x86Q-m\x84\xe4\xd1\x0f`F$Pkp\aP\f~[\xaf0\x88\xed\x01\x00\xdcS\xd8\xe2\xff\x00\v\xb8\x9e\xe1\xb3PN\b\xb8Á\xb6-Ch\x87\xb0S_\x84c\xa8\x96TL$L\x1f\x83r\xa5\x83\x11S\x10\xe0t1\xc0[\xc8)] pJ\x80\xef\xe2\x13B?\x8cΉ\xa6\x96@\xac\x97\x14\x87\xde\x01`J\x95\x81e\xfe\x05J\xaf\x05\xf8\a\xe7\xedøw\x13\x0f\xea:c\f<\x12\n~`\r\xb0\xd4\xda)\xd0\xd4S\xb5=Y\x87\xe2\xbf\n\x95*W\x91\xa1\x125\x10\xc3mAZ\x9c\x89\x80\x95+)>\x8f\x90E\xb2\xf2\x15w\xb9i\xe8@ \x00\xe3\x1b:|u*Vn\\\xb9y\xd4\xd4\x00\x04\xbf\x98\x9a\x1b\x80\x9cJ\xb9r\x1e\f˹dJ~\x15\xf5\x0e\xc3lF\xd8Q\xb8\xdb_\xda\th\x952m\xe4\xb8\xd4[\xa1\xb8?\x8b\xb9\xf5\x02\xa0\x880H\x9a\xc2YPkr\xbd\x92\xad9\xaa\x16\x06\xa3=\xc7\xc2ñ.c\xb8\xad\x9b\x10\xd5{\x88X\x83t\x85\x12Q\a\xd1=\xe4\xbf¨\xach4D\x1d\x97\x95G\xb84\xb3B^\xfdOzQ\x1b\xac;\x84\x89eK\x14\xfc\x1e\xc2%\xbe\x8f\xe1\a\xba~\x83\xff\x00\xb3\xa5/\x95_\xe1\xf7\x16<\x1f\xe6\t\x81;\x84\x11o'\xdb\x05:\xf8C\xe0f\xa3h\xed\x8b\x1a\xea*\xa1\xa8\xa5J\x82\x10²\xa2\tLwP\xb6\x98\xe19\a\x1e\xcf5\xa8\xcfDM\x12\x0f*\x93\x9f\xadW\xdd\u007f\x17?\xc9\xcf\u007f\xf2U\x13\x93\x9a@\xa4b\xdc\fU\xda~\xbc\xaaW\x85x>ΈeB\xc6\x18F&E\xe0q\x8a\xa9\x8d\x1b\x8c\xf7\xcc1\xeeq\x81d\x14ׁ\x1cVȷֽAT\x10\x11`\x01\x04\x1d\xcb}$\xdb \xba\x96\x1a\x8a\xd7\xe2\x1f\x05[S\xfe\x96Xj0\xe2\xb2<\x1c-\x04\t\x19\xce㨗\x152\xc2\x1bƛ\xf2\xa1\x8f\xd6U\xc0[\x1b\x8bQl\xb6Y\x84;Ɓ:\xdb\xe0\x17+\xe0b\xa0v\xf7.9\xac\u05c9\"\xa2/\xb9F\xea\xe2\x8e\xc3B>\xf0w)i\x81\x18,\x83~\x06~\xd1Y\x15\xb0/\x1d\xe4+r\xc5\x19\xfda_\x02l\xe8\x80k\xe6\b[:\x19Z\xa9a\x97`\xe0Y\x150 \x92\xa5{\xf8\x15\xa1\xa7\x01|\x94J\x88\x85`\xd4\xfd\xc0\xb8\x02W\x98.\x89N\xe4×QXxs\x9em\x81\xbd䩢\x9c\x84u\a\xbf\x03\xa6o\xac\vA\xa9cϺgT\xca \\\x89\xf5,\x81X\xfe'\xdb\xf0\xa9\xa9\xc4\xc2\xe5\xf8\xc1\xaa\x81^.\xc0\xa8\xea\x90P\xb1\x04[MH\xbe\xd8\xee8!\xf7* \xdcZ\x89e\xc7\xc0\xf4\x9cD\x01\x81ޡ\x04\xc0>\x1e\xc8\x00(\x8a\x19\xb9p\x95\x0f\v\x83;\xaa*e\\\x00\xa1ә\xc1\x12ȭ4\x88\x91 \x96>\x15\x8e\xf1\xf6\xceA#Ј\xf1\x05h\x87\xb4\x11p0߅\xc2\x19c\xccT\"\xd1*]Gj:\xb8\x88\xb7\x1f\x0e\xe5TGH\xc6X|\x17*\x05\xf2S.\xa0\tt\b\xadN\xe0\x9b\xf0\xe2\xf2se\xdcX\xb8<\x1c\x10\x8b/\fqY6\x1fX\xa9\xba\xc7I\xf2 \xb2T\b\xae:\xc3h4\x1e\x04q\xfcK{\x02\xa0G\xafh(\xbd\x86j(S\xac\xa8\x1cٸJ\xd1*\x1ac\xdcV\v\x9c\xf0%\xf81bô\xd7YU.\xe3\xb6\xc8>\xbe\r\x1b\x96\x83,\xed\xc3,#\xe0B\xde@\x11Qx\xa3\xa3\r\x81\xb6l\x19v\x8d\x11e\x9d\x82Ȏ\x88\u007f\xcb\v\xa9W\t\x13)*W\x8b/\x03\vQ\x85\xbdF*Q+\xd2@\xf5\xa9G\x8ca/\xccjS\xa8\xec\xa6&\xe5D\x94\xb1\xc5CٟL\x1dϯ\x00\rК\xe6\xe1\xf7\x8c\xa8\x84u*\xa5J\xbd\x81\x8a\x95\x1f*\xb9U\x95\x8b-\xb0\x02+B_\xa6?\xac\x0f\x05\xf1Jc\xd4\x1a\\\xa8\x91\x18\xaea\x00æ\xe2\xa2n\xeb\xb1+LԈ\x8c\x99\xa2\x05h\x8a\xee+ъ\x95\x80\x95*Tr\xe2\xb7?\x8c\xac\\\\\x18Z%\xdc\xe1:\x93]\xb9\xdc9\x10\x95\xe6.\\\xa9Σ\xa8\xc1+o\xc1,\x86\xc8J\x94\xec\x9cL;\xa2\x16\U00083c5e\xf0d\x8e\x19Xa;?\x8c\\p\xeau\x96\b\xbe\xd2\xeb%\x88\xed\x84qo\x99\xa6\\K\x01\x9ac\x05\xeb\xc4\xd3*\x18m\xfdB\x1a\x95\x19R\xbd\xfb\xcdכ9?\x99r\xe2\xd6=\xe3\xb1*?\xb8\xb2\xf0\xbc\x1f\x18\x94\xed\x10\x95*\xa5A\x06\xfc\x13\x00\xba'\xb2\r\x15\x86\x96\xe5បQ\xa2)\xb7\x0eX\xb2\xf2\xcb\xc1\xbc;\x9c\x8b\x12\xe2F?\xa9\xc8\xc2P\xc6*\xb3~F\xd1] \xa8\xc1\xe0X1\x8dC5)u=E\xfb\x95pqļ\x1bDs^nch\xf8v8$eay\xb6Xʉ\x93\"l\xa8\xa0\x98\x1c\x8e\xc4\x11\xb5ԠQ\x84\x8eBz\x87\x85\xc3\f]@\xbd\xb0kq\xc2n\xe1\x1d@\x8f\x83;6ԣ\x06\xe5b\xa5D\xac\u007f\xff\xc4\x00'\x11\x01\x01\x01\x00\x03\x00\x02\x03\x00\x02\x02\x02\x03\x00\x00\x00\x01\x00\x11\x10!1 A0@QaqP\x81\x91\xa1\xb1\xc1\xe1\xff\xda\x00\b\x01\x02\x01\x01?\x10\xfd\x81<\xf4g\x1av\xce<\xb4ݳ\xd7Iq\xad\xbd초v6N0\x9e\xde\xc7n\xff\x00\x1a\x0f\xbcX\x93\xf6;\xc08Cf<\x83\x98p\x15\xc9\xc2<o\xf0X\xe0<`\xf5\x1d\xfb\x8c\xf0\xfc\xbb\xc9\xfa\x87~G\xf7y\xf2A\xf6P[@-\xa5\xfd\x88opo\x10A\r=\xff\x00\xc1\x89\xb1\xf86\xd6N\xae\xa6od|\x8f\xea\f\xb2ܕ\x18\xf6\xe8@\xfb\xe1{\xcf\xde\b\xfe\xa0\x1f5\x0e\x1b\u007f\xb9\a\xb3\xbbg \x1d}\xb2\xcf\xf5\x1f\xd8\x0e\xae\xce\xec\xdb^\a\xed{\xc6~D\x87\xf3\x86\xc4\f\xf9\xbfĿ\xb9\xc2\x12>\xed\xfa \x80\x9d\xd9\xfc\xb3=\xb7<\x96\x1d\xf7\x02G\xbfi=\xa5E\x18\xd96\xcb#\x85\x8c<\xbc\xf7+-\xfb\x84cӕF\xca\xf6\x87\u007f \x9e\x19\xf1ܱjö;c\xa8\xc0\xfe\xc2Y\xf5u/\xa9\xdc\xc2\xd7I\x81\xe4#}\xdb\xe1-\xb7\xf5\v\xdaO\xdbz\x06\xff\x00$7M\xa9 ,\x0eW=\x90f\xf1\xd7\xfd\xd9\x13\xa4b}\x9d{\n\xff\x00\xcf\xfe\xe4\xf5\x0e\xfcs\xe0C\xf0\x0fs\fgm\x87\xdd\xd5>\xac\xfe\xdd\xfa!\x03&\xb6m\x96\xe4\x8b\xdf'\xaf,\x86\xc8D]\xb1q\xb7\xf2O\xf6O\r\x9cz\xdba\x1cN^\xfeM\xa1v\xb7\x86\xda(\x9d<\xf9f\xc6\xe0\xfcI\xa6I'\x8c\x83\xb1\xf55\xecm\x1e\xdb+&!\xc9u\xea\xd0t\x8fD\x82<\x82\xe9c\v\xfbl\xfb\x91\xfb\x8f\xa7\x19'y\x0e\xfe\x16w\x10@\xff\x00X\x0ey=I\xf7\xe1\x9b\x1f\x99\xa7r`I\b\xf7\xcb\xe8\x93-\x13\xa5\xea\v\xa4\x1d]2\x04\x1b\xae\xd7\xf8ŋ\xdc\x1e\x10\xfb7o\xa8\xb5k\xe4\xba\xcbQ\xea\u05cd\xcbߋ\x83[`s\xb6\xc9\xc8\x0f\xb9\b\xe0\xff\x00\xb7\xcf\xff\x00auu\xfe\xc4\u007fP\a\xe84gD6\xbe\xa1\x97\xb6\xfd \xe4\x81\xdc\xf7aq\x80\xac\n!\xdcu\x80\x1d\x12\xc2?\x03\xa6\xcb\x1dO}\xbc\xff\x00XG\xe4\x9b\x06q\x93G\x96$=\xc1\x9f\xa46l\xfaC\x9d^\x16\x96`\xa7r\\\x1e\xe4\x1f\xdcvn\xd8\xf6\xb0\xd9%\xff\x00D\x13\xe4\x9b\x04\xf8l2Zsݶ\xef\x92mی\xbc\xfc\xfb\xf1t\xc0̙\xc3\xc9\x1e\xcfn\xfe\xec\x0fwD\xec\v\xb0\x1d\xbd\xf0\x82\xff\x00\xa2\x01\xf8}\x9f\xe6ݻ\u007f\xd80g\x9f\x04\x1fn\x9eN\xde\xdd|\x97-\xd3\xf5T;a\xebb\xac]\xea\x1ff\x1f\xce\x12ug\xa7P\x0f?+\xb9ԗO\x03^ك\x9f\xa3\x8f\x1c\x19g\xeb&\x97\x8e1\xefrn\x12p\xe1\x90x\xfd\x04>\xc0//m\xe3$\xb7\xa9\xee\x14\x8dpw\xdc~W\xa2>cH=}EvJ\xcf\xd4\xddg=\x12mޯ\xaf\x04A0\xa9y\xe4\xb4\xf9\xbf\x9b\xdb֬\x01\x1f\xa8\xb0\xb7\x19\xf6W\xa8u\xbc\x11=\x10l\x99\f\xb3\xf6\x0f\xd4XY$\x03\xa4\x19\xc04\xce\x15\xb1\xf6E\xa1=\xfbm\xa6:;\xff\x00\x8b9\xdcO!\xdb\xce\x18:\xdf\xe3k3yX\xfe\x86\bG\xcfɖ\xff\x00\u007f2\xe1\xd2P؆\x91,\xf8\xa9i\xf9\x81X6\xec\x0e\xa1\x1d\xa6\xc6y\xb0\xeb\x8fO\xe4˾6\xdf\xc2\xfbC\x1b\x16\u007f\xb3z\xe1\xc2[~\x03\xf1\x0f\xc0\x86\r\xbb{D:\xeaŃ\xd9O.\xca;-\xa5\xf3\xeb\xf4\xb2\xcb.\xee\xf8Cݝ\xa3\x82\xde\xf8\x1aK\xf5\x1c\x8c\xb5\xecD:~\x15\xc2Ǝ\xbf\xb1\x90\xf4\xc8\xc7\xff\x00)\x01\xad\xb2n\x8fl>\xc1\xa7\x89\x0e\xfe\xa8\xff\x00\xbc\xcfp\xbc\b{\xb6\xdcl\xb6\xe9\xb7\xec\xe4\xebǨ\xbb1\xf8\x1f\x10^\x91\xe6\xbaC\v\xa3\r\xde\x19\x83u\xf4\xdd\xf0\xeb;\xdd\xf6\x90\xe9\xf87\xf1\xe1\xd4U\xf6;\xb3\x96\xc33\xd0n\x8f\\\x04\xf4\x86\xd8y\xc0\xf8\x860쁿\x81\xd2=\x84\xfa\x86\x9b?Wu\xff\x00_P\xaf\xba;\x05\xff\x00\xcf\xff\x00PAv\xb1|\x81\xf7\xc66\xfe\xa3\xfb\x93\x1f\xd1\x11\xac\x9dO,\x8f\xbc\x00Ol\x16\xf03>\xae\xae\xc2\x1d,\x12g\x91\xc0\x0e\x91\x1d\xcb\xeb\xe7\xd1\xed\xa3\\.\xf1i0\xd7M\xf7\x8c֎\x8e\x0eC\xe4˰=f76\x1f\xa7ῌ\xba\x9e\xca\xf5\x829\x1e\xfc\x0e\xafo\xe6>\xb8\xef\xeb\xd8\xcf#\xa8q\xce\x160\xe9\xf2\xf7ٳX\xa3\xaf\xdch\xd6u\xedU\x8a\xa3Ճ\xea\xdd\f9\rm\x0eK\xad9\xcd\xf7\xf1\xfdw\xb6\f\x8e7\x91\xe1x\x1b\xd5\xd9M\xe4{\xc3vM\x93Hco\x1fW\xcf\u0590\xbb\x9fpvz\x96\x16\xb6\xa7ݷO\tl@\b\xef\xbe6\f\xb7|\xfc\"\xecn\xbfP|\xb6>\x06Ww\x86\xb8\xb9+\xb2\x14&-\xbb\x87\xea\x1b\xa3\x1f>Ńgݧ\x1e\xf9\xf3\xdb\xfe\x84\xb9v\xde_\xef\xf0\x97S\xb6S\xaf\xccx#\x87\x80\xac$\x99oݛ\xc9N\xf8\x93\xc0\xd2pwm\xa1\xf8\x0e\xfb\xf4ý\xb2\x05\xf2\xc0\xb2DW\xd4\xeb\xc8?\xb7\xf8[\xf8\x14\rfz\xe3\xf5\xf1\\5\x98\x88\x8e]8^W\xb0\xe4\xbaA\xecƸ\x12q\xe9g9$\xfb=>\u007fY\x06\x01\xe7\x16Wh\xab\xd7.\xa0/\xb7D\xbf\b\x8doS\x86O\x1epOw\xec\xbb\x10\xdb\x17E\xa4}OP\x84\xc71\x1d\x83\xe8\x86|\vB\x10\xe4\xd0\x04\xb3\xf0t\x85u'<\x0e\x17\xd1g\xdb?Ϳ\x84\xbaz\x92\xb5\x83\xe2M\xbb<m\xb7\xf9e\xc9Vn\xad\x8e\xb0d\xb7r\xf3\x81\x1f\x01\xa5\xa9m\x88el|\xfc\xe3\xcfd[\b\xbb\xef\x1b\xde\x16?q\xd7\xc7r^\x91\x8f\x96\xf0\xcc\xc1\x1dOԏm\x98e,\x1b\x1c\xcf\x13\xb1\x1f\x02\xdeZF\x11\x86\xcb\xe1\x8b\x1du\xcb\xf4 \xfbg\xf8\x97\x87z\xb3u\x97\x899\xf0\x11\xdd\xec\xd9d\x1f#\x96\t\a\xb2؆mŖ\x0f\xbe7\xea\xf0\x8e\x82:\x8f\x89m\x06\x1081\xdc\xc3^3\x84\xb7\xfbj\xd8G\xbfg\xb8\xedɞ\xea\xf4\xa6[7u\xe5\xd8\x16\xf0'QUׇ\xc8\xe5\xfc\x1fBS\xecD6\r\xbc\xae\xd1vz\x86\x01\u007f\x9e7\x8d\xf8{\x18\xf7\x0e9lYG\xc1\xe9\x97莥\xcb$^$\x83\xae\x8b\xae\x83O[\xae\xb3\xa9\x1d\x99\xfa\x1d\x1cgv峋m\xb7\xe4\xa1i\xd1/\x03xF\x1b\x045\xb6\xdd\xd9^;\xb4{\xca\xdb\f\xfc\v\ueebaC\xd6\xc3\r\xd5\x1c?ſ|\x8dA\xf7\x19\xc7 lu\xb9d&Wݻn\xdb,<o'\xc0D\xb7\xde\b\xd4x?\x91\x81ܻ\x11\x89\x8f\xa3\xc1<\xec\xbf\x1fo\xef\x1dul1\x108\x17\x83\xb2\\'\xd5|\x87{/u}Qk[\xea,ɿ|o\xd7\vm\xb8L\x18\xf9m\xe4X\xe0@@\xd6\xf2\xedn\x90\xe2x\xe1չ\xd9c\xe0[K\x18\xee8-\xf8\x0eI0:\x19w\xde\f\xeb?S\xcbWȎw\x87\x829\xd0;\x9f\xa1\xc3Ƒ\x06\xf0\xf1b\xac\xcf.ܙ>9%\x8f\x8b\xe5\xdf<\xea!\xbf\xaf\x8b\xd9\xc3\xc1\xf5=\x9dklq\xaf9\r\xe4냔\xaf_\r,\xc8\xefp}\x1c\x05a\xeb\b,។\xb6\xbb\x1b\xa4<\x0f\x81\u0087m\xbfW\xefx4둵\xf6\xc4>\x1c\xfb\xc0\xe5\xf4\x93\xdfm\xbc\xfd\xactD\br\xf6#\xa8b89\xdbG\xf0\xbd\x91\xc6ЉG$\xc6w\xec\xa7~^7\x80\xfe^yϩ\x04\xf0\xf8.\x1a\xca\xf4Bz\xe4\" \xbf\x88#\xbe\xaf:\x88\x86-\x8f>\x19\xbcl;\xf2#\xd7\x00\xc3.5\x9aBl\xe9\x81ܕ\xd6:\x87\xab>\x1fr\xddg|g\t,l\xa8\xce\xe5Έ6\f\x8bż\x16\x1fp\xeb\x16G\x1d;\x96\xfe\x1f\xff\xc4\x00'\x10\x01\x00\x02\x02\x02\x02\x01\x04\x03\x01\x01\x01\x00\x00\x00\x00\x01\x00\x11!1AQaq\x81\x91\xa1\xc1\xd1\x10\xb1\xf0\xe1\xf1 \xff\xda\x00\b\x01\x01\x00\x01?\x10ۦ9\xdc,\xab\xe7\xe0\x99R\x9a\x87\x05U\xe6{\x12\x82\xda\x17\xb7P\x18.~\xf3\v\x03\x1c\xf1\x00\xe5\x98\xeb\fWw\x89G\xd4\xd3\xfeC[U9c\x11\xe0\x85\xd6<\x9a\x96\xe5\x80;\x88\xc9쨵T\x0ej\x1a\xad(\xfd\xc6\xec\xebĸR\x86mfs\xedS\xf5\x9a}\x13l\xafv2\xf0\xe0\x16\xbe%T\xbb\x90\xc6\xeb\xd4U\xfcG\xc8\xf0<\xb1jZ\x022\xdbA\xe6\x06v`?g\x83\xeb\x02X\xb4\xd2\xdf.e\x029\b\x16z\x88\xdcrRs7$\xc0\x18\xef\x1beZ.j\x17\xd4R\xad\xce\xd1\xfd>\xdf1\r\x87\x86\xbd\x9cK-\x0e\x9a\x91\xc5.\x81\xb3\xf0\xe23v0\x99\x1b\xde#\x01\xb9\x9f\xcd\xe4\xf3.\x1bZ\xee.e\x83H)\x836\xb7\xd12[\xa7\xcb\xfeʇ\xd1\xe1\x9ef\x16\xad]E\x8cg\x15y\xb2\xb2\x8e\v8d\xfb\xe3\xe5\x80(>Fѡk\xc3U\xfd\xc6Ϳ\x98\xadi_\x10*\xc4G\x94^Œ\xeb\xafq\xce \x89\x03\x14u\xf136\xbe\xa0\x9a\xc1/\xd5\x12\xa6\xf35\xcd\x11\xdd\xdf\xf7\x18\x17\xa3\xccB\xd5*\x9c4n0\xabSe1\x15V\xa8\x84\x00\xfc\xe2\x9d4K[\x1b\x8a\x03\xf8\x03\xfe\xc5\b<\t\xee\x1b~j6\x00\xbf\xf3\f}nf\x06{rͳ\x1c\x83\xf2w\xe6[\xd6af\xfavB5ޯ\x10 \x9b\xa2\xd5sY\x03\x17\x93E\x1c\xe1~\x93\xa5\x19\x80\xfb\xf1\x16Q\xb6ƣ\xc2\r|\x16ѕx\xc4E\xb0\xb1N\xe3\x14\x88\xc8`\xf2\x04v\ue76a\u007f\xa9\x96)}\x1a\x8f\x83K\xbf\xe9+\xe0\xed8}\xcd^UM\x84\xb4\b\xbd,\xa9\xdc\xcc{\xbcx\xa8-@v\xc3\xe5\v\xfe\xa1\x940\x84\x83\"\x87\rh}\xb4|\xc2\xe8+6\xf9\x84\xc6/79\xff\x00(\xee8\xef\x923\xefW\x11\xba3\xe1\x80\x19\x02\x18\xe9ǯ\xfa\xdf\xd6+[\x97\xb9\x81]\x0e%\xfe|M\x86߭\xc4]\x9aoܴ\xcaߙ~73PV\x86\xb5\x10\r\xa7j\xfd\xc4|\xfdfE\x82ʆ\xd4u8ۖ\x0e\x17\xd5\xc6e\xa5e\xd0c\xb9T\xaan\xd8+\xb8\x85\x83\xd84\xc6\xca\xf1\x962\x1c\x96%\xbf\x0f\xcd\x11\x93\x93\x18~\x13G\xdere\xe6\u007f\xdf\x12\xa6\x00\xf5\x02\xb7\x1f\xe2\xd8\xdaĤc\xc5\x1d\x94Y\x06\xb1\x82Ts\xed\xfa\xc4$\x99`@\xbb\xaa08\xba\x8b\xc5j\xe0\x0fp\xde\xf5Ɂ)PY(y\xd7\x11\xafR<\xf8\u007fs-\xb0Q\xbea\x82\x0eC\xfd\x18,,ԡN\xa3\xc8G\xc1\u007f\xdc˘v\xbf\x82\x18\xcaF\xf1~\xa2\x97\xec\x95\xfdB\x94S\x91\u007fy\x8b8\xb8\x03yB\xdb\xef\x03\x98\x91\x03\x11,W\x98: \x00A\x87\x93|0\xae\xa7K\xaa\x88\x84\xaf\xe9|\x90/\x19\x1f\xb4v\xde8\xee\x00ߧ\x92W;\xc7O\xec\x8e\xee\xeb\xc1\x1f\xb4\x1d\xe8\x85տ\x1ee\x1cY\x9f\xb4\xc06\xafu\x1cs\x98ߚU\\\n\x95\xd0\x14\xd4!\x94\x1fq\x8br\xe8\x88\xd0\x1ee\x91/\xbd\x10|%\f(\xbe%I\xf5J\xe2e%\x14\xf3\x98\xb8C\x8e\x1f\xbd~\x97\f;r\xc4\xfb\xdb\xe9Qj\u061c\xab;\xbf\x8d\x12\x88\xc0\xc7F\x02\xd7\xe2U6\x95;_\x1c\xfdj\t\x9bŹ\xf0q\xf5\xb8\xe0\xe2*L\x1d\x01Tz\x8d\xb6\x1cپ\xcc\xe5>\xd2\xd4\x06t\x94\xbeG>\xb7\xee\x0e-\xe0s\xae\b\n)\x14\x97Bz\xe6\x06\x037\xcab\x8fdX]\x13\xb8p\xfb\x1f\xa4=SӤ!A\\\u007fc\xf7\x06\xd3[^\"U]\xdc\x15\x17\f\xbe\xa5D8\xb8\x9e\x1dj\xa6\xd6a\xfe\xe0\xaf\v\xf6\x94H\x91\x1b\x97\x89B\x12\xa2\xfdz\x82,\x18fD\xc88\xf3\xea\x1bL5\xfa\x96(RB\x8cOV\xa8\xe4\xd6\xe5\xde\x0f\xb10D8\xb5X\x038w\xdc\x12١\xcd:\x8b\xc1\x13\xb4\"\xb9\xa5\x8b\x9eΥ\xb5\xb0=\xee!\xc9\xe5\x98\xd3\x19\xaf\xa1\x10\xb4\xf9\xcc[V;\\E\xb4\x85\xd2\xfa6\xfc\x10f\xf5\x17{\x03\x94\xf7R\xcfk\xb0}\x10\xc7̬\xc0\xa9\x87\xf0\xb0\x98e\xc0o\b\xbd\xaa#\x97:e_\x96[\xf0|\xca\xca<\xb7c\xee\xec\xf8\xbf\x89I\x88\xbb\x00\xf4\x16D%\xc0\x11\xa2\xd1\xf5F\x9f\xb6.ߴ5\x06\u007fߙ\x91\a:\x8d\xee\xef\x05g\x03ԣ\xa7\x9b\xfa\x0f.y\xee;T\xa4\x19\x19M\x9eͻ}M\x02ƞ\xc7\xee\x10\xab\xb5Ǐ\x11̏\xfdS\x04R\xe5le\xae'l\x02h2\xf1ש\xa7\xbc\xc3X!)\xa6\xa1\xa0\xdaf*\x16$6\x19\x81\xb9\x86ͧ\x99\x83N\xe6-\xe5g}\xc70kP\\8\xfa0V7I\xe4\xeb\xd4,\xa5\xb4\u007fJ\x12/\x87\x98\xdb0ُq\xa0\x8b/\x17\xa8\xe1A\xd9DE0\x83\xc1\x96( \xcdx\x8a\xa61\x12\xc5\xda\xca\x14,\xd6Y\x81\xf1\xa6\x10\xa0\x03\xfc̭@\xae\xf3\xb9QY(2\xf5F\xbeR\x18\xe36\x03\xff\x00\x8fd\xdeD\x96\xff\x00/\xc5F\xd5]\xb9\x9a\x8es\x16\xa2\xcd\b!J\xfa\bqz^\xc4\xf02|\xd44(\x19_\xacq\x16\xc2\xe3\x98\xf6\xe8\x83dA\x85\xe3\xe4=\xdb\x1fj5\xa7\xa2\x17^\x9f3u\xec\x05\xe4/\xe5R[\xa2\x13\xc3\xc2\"\xd0p\xd6x9~\t\x88hͥ\xf08 \xa8\xe6\xd6\xf4s\xa3/\xbd\xcbG\\\xc1\xb3\xacn\xa8\xc1Z\xbe\xe0\xcd=\xd1\x02\xb4\x8c%\u07b5\x16\xe3L\x0eO\x1d\xc0(\x0f3\xb5B7\xf2\xd7\xf79\xc6?\xa1\xd3\x12\xa2\x8e\x1f\xc3\x19<\v\xf6\x99\xf2.)\x8b\x8d\xbb\x8fLd\xf8\x82>@\xca(Qq\xd0%:?\xb4\x15v;\x01\xf6\x97-\x18UW3\r%\xa5\xb4et\xca\b\fUY\xf5\xb28\x00@\b\x02\x9c\xb4\x99q2\x82\xfc\x06\xbf\xb9J\xc8wm\u007fqz\x94\nپ\"e\x94\xd0\x18\x92\v\xb9\r\xe3Ǹ\x9b\xddAR\xf0\x1eb\xf1\xed)ڒ\x0e\x14[\xe0B\xe1\xbeKNQ\xe2\xf2\xc4\x02\x9d\x95d\xf2\x15\xe3p\x80\xa86w\tdZ\x00\x0f+\x82\x10\x91\b\x04\xbd\x98\xfa\\\xbds\xf7\x01\xd2\xfc\x86\xa2\x1b\x89H\xd1\xcb<\xbf+\x18q\x8d\u007f\x80\"%+E\xad\x19\xf9\x97TtA\x1c\xae\x96^1\x97\xa8\xf3'.\xdfbǠ\x98\x17a\x95]\x17v=6\xf1\x062\xe0U|\roœ\xa1h\x95\xb8~\x80B\xa0j\x18\xc2)t\x168\x15\xef\xc4>WI\x81a\x90>\"\xd6\xeae\x1e\a\xcdUK+\xd7\b\xa5$\xdbT\xe5(\xab\x021\x15\xc6\xd8Q\x8a\xe0-y\xa4j\xb3\x15\x9d\xc4\x14\x1d\xd0\x04Y\xa9\xd6\xd1tg\x0f#G\xcc$e\xa0\x9a\x13\xa1\xed\x87R\xd7!~\x19~e0l\x95\f\xa1\xb1\f\x15֟3\x04VJD^\x8d\xfc\x04n]\x85h\b\xa2\x89sGX\x83\x10\xe2\xc0\x03\xf3S9\xf6\u007f-\xd4EB\xf0\xd8\xc7\xe2\x1d\xe1\xb3\xe9\x12\xfeBv%6U\xb6\x8a\xe4,=)*\xad\f}忼\xf4Jy\v\x06\x86\xe4t\x8f\t\x88\x03Xj\x18\xc2\xf2D\x04\"\xc4Ⴊ\xc8[e_\xdfp\x1eJ\xa449b\x81U\x9e\xe1p,\xda\x15%\x99\xf8\x8dfV\xa0U\x96\xb7\xe7|\xc4\bYEr\x1d}\u007f\xd5/\x9a\xd9_w\x01\xed\xa2\xd3_XK \xe3\x19\x16\xdb\x053\n\xab\x9bM\xa9n\xa0\xc8\xde?\x0f$\x0e\xa1\xf6\n䍚\xb6uQ.E\xe8\x95vW\x97\x11\x1dn\x0eע\x18շ\xba\x97n\xdf\x10\xa2Σ\f{Y\xb2\xc7A_\x80m\xf8\x87q\x9c\xdfsT/\xdb\xc71\n\xe2\xbfFG\xb5l\xd9,\x8e\x9a4x\x01\x80\xf0F\xb1\xfeAs\x94\t\xd4\v\xf9\x80\xb8ÿdj\x06\x86\xf44\x16\xa7\x1bǏP\xd1)\x16\xc1Ñ\xed\xfa\xc1\x8d\xf4]\x17e8\xb3\r\x94X9\xc3\n\xfc\x96\x15\x9en\xf8\bx\x8b\x02X\f\xb4\xd8g\x8a.\xdf\x17\f:\f&\xfd#\x18\xd5W\x9b\xa3`\x05\xb4\xe3\x80\xd5\x1a9q\xd4I\xb3\xea,:\xec+B\xab\n\xc9`\x8f7\xd7g\b\xcc\x19\xa7\rs\xd4>\x11r*\x95\x8a\x1d՚V\xb7>\x8f\xcajכ\xc4\x14c$!śl9\xe3g2\x82N\xac\x10\xd5W\xe1O\xbe\xcc-)x_n\xfc_PF\x95\xa4P|\xb2\xac(\xca&\n\xca\xc5aۃU\t\x06\b\xcd\xc1E\x0f\xd5\xf4J\xa2\x19\"5\xcbp^R\x1f\xbeMw\xec\xab\xfaLR_\b\x0f\x89T\v\xc2\xe6|\xd4\x06%\xb6\xa7{\xb1W\xe6\xae$Y\xec\x96\xf2\x0e\xbe\xb0ɮ\xda\xfc\x9e߭\xf2:\x83F\xba\x05~\xb8zς\t\x91i\xf1?\x10\xef\x12=Jڪ\xba\x8a\x80\xd1\xe0\xbc\xf3\x06\xee\xe2\xd0P\xf2\xdcI\n$\x8c\x84\x1cTX\x80\xd1W\xbf\x89J\x0f\xc2\xe3\v!\xce!mQ\xc2GC\xaf:\x9b\x9e\xb7N{\xbdEG\xb8\x05\xdcYy\xdc3\xbb\xa3\x17\xe6\\2Ww\x1d\xe7\xf4d\x17\x8a5\xf3S0\x8a)\xf2ݎ\x8arq\xccZ\x15\xaa\xbd\xbeX+\x84\a̹b\xe5K_\x9f\xe6H\x90D\x9a\xe3\u007f\xc1\xc02\xc7\xf1\xeaPV\xf9*\x89j\xbaxX\x1a\xb2\x0e5\xabX-\r.\xf2\x0e\f\xbd@8\x8b#{0\xf8\xccG\t\xcd`u\x92\xcb\x12\xa0\xfc\xb1\xa6\f\xd5\x15\x03z\x98\xdd\x04ʀd\xee\xd9\x1bQ\xf3\bt%\x01t\x18h\x03c\xbcZ\x8d\x15t\xd4%6\xa8|J\a\x96<,j\x97\xfe\x1fR \xcc\n\xd9\u0fffp\xb5d\t\xd3\x1a-\xad|\xe1\xb8\x1c\x99c\x1e\x8c9_^\xa1\xc5\x01\xdela\xa5\xf6\u05f9~[f\x10\xf8\xe3\xe8>\xe2\xc9>\x85\xac&ݛX\xed5\x98\xd1\x17.\xde{\x94tu]{#\xc5W\xacJ~\x02\x17-wK\x83\xac\x00\xfe\xa5I\xb5\xd4\n\xab\xa3\x97\xe7,\x06n.\xb7\xf7/\x91\xc8X/$Js\xfc\x06$\v\xa8\x95\xbd\xc6\f\x149.\xae?X\xd0-Ƚy\xfb@\t[`\xab\xed\xdf\xf5-}Q\x15\xf4'\xef\aǒ*eY^\xd89\x16;\x8eJ\xdfk\x00\x10\xadU\x03\xcb\x1e\"\xadJ\xf6\x18\x0f%\x91\xcc\xd3ڹ\xe1s\xf0Z\xf1\x1b\xa1\xc1\xa7\x0eU\xae\xe9k\xc1\n\x12\x89Q.V\"D\x82&\x18!\xa7\xf8*l\xa9\x9a\xb3\xf2l\x81z\x81\x8d\a\x14\xe7\xe3ķ\bY`S\"\x90]\x9b:\xe3\x1a\x98h\xd7\x0f)nj\xe6Z\n\x1f\xd1\xe9\x13d\x03sk\xe1\xeb\x92e.\x15T\x17@T\x9b\xc9+\x17\x98K1 [Qb\x85j\xe2q\x06\x00\xaf\xb1\xb8\x00*\x0e\n\x9b\xa5\xd7\xfb2\x84\x1a\x01d\xc0\xddՕ\xe3'rˣ(qn\x98\xbc\xed\xb7\x1a\x8ddPZ\x91\xaa\x1b\xddu\\\xc4&\xbb\xfa\xc0\x14%u\xf7\xe1\xb5L\x8eX\xd9FSͶd\x97\r\xc2y\U000bad97\xe6Z\xe8\x8d;\xfa\xf5\xfc\x1fp\xf3\xe7\xdc,f\xae\x8f\xa8\xf8 \xdc!H\xe1-1tz\xf3qZ\x89\x81\xe4\x0e\xfd\xc4N\xcf\x1c@\xb4t0\"\xab\xc3\xff\x00QE\x85\xb7\x98\x839\xca2Nt\xae\xa8y\xa0O\xee-Q\xec!K\xc2\x00\xde\xf4}\xbb\x8b3uV|.\v⡑\t̟zߖ\x1f\xa8rR\x1f\x06\"d<U\xa9\xea\x1b\x9e\xc7S\xcaY\xf2ψ\x0e\x17\x9daڼ\xaf\u007fJ1\x16\x13\xe6\xea\xbf\x01\xb5\xf0\x11ɐ\xa2\xef}\x9f\xd1\xea\x17\x1a0\xa8\xfaS뇲*eTA\x0e\xd0`~ !\x84L\xca̩Q\x83\xcf\xf0?\xc1\x17\x88y\x83\xe6\x1c\xd2\x14(\xdc)\xee\xcc\xdf\xeam5Z\x86\x13\xda\xd3\xe3Q\x8b\x89\x0e\x0f`\xec\xaa|\x90\xe2\xbc\xd1\xd4\v\xe3WZ\xdde\xa3F\xb1\x11DR\xa9^Ԯ\x92\x89\x8a\x045a\x11b+\xc0՝\x91\x86C\x80\xfbY\xd6>=iЋ\x8e\xc0\xb6\rZ\xc9\xe0\xf3/ 45\xc1\x85y:\xcfp\xce\x19\xd1%e\xd7\xf4}b$\x02\xd4\xecR\xcd\xe6\xab{pp\xae\xa6\x1bm\bq\xf6\xacj\x0f4\xedY\xa3D@\xd3.3(\x0fQG\xc6~\xc7\xcco#j#\xca\xcb:\xfbM\xb4\x13|U.\t~9K\xe7\nd/\xea|\xff\x00\x04\xa9hٲ9%U\x94\x9f\xb9p\xa7\xb26\xc4\xfdu\xdb\xf2\xb3\xc772\xbb\xc9\x14i\xfb\x13\xb2\xbb\"\x00\xbb\xad\\\xbc\xc9؆Ox\xd3\x04G\x1c\x9c\x91\xc0-h\a\xc3\x1b\xf3\xff\x00\xb2\x94\x1b+.\xf23\xeac\xcb\x13\xbd\x14`\x1e\x83\x01\xea^\xb0|\b\x1e\x14\xf4\x98\xf5\xaf\xf1\x88r\xc4]E\xff\x00A !\x00\xfa:8\x0f\x19\xae㤚\xcboF\xe0?\x81\x84\xe3\xf8\xacėSl\xda0\\\x19\x99\xb9\xcd`\x870\xb5Po\xee\xf6s}\u007f\xd4o\x19\xd2t\xc2\f\x19\xf4\x19\xb6\xfd*\xd6\xc9C`\x14*\xf7J;j\xb8q\xcdG\x99\x8ah\xe8Ak9\xb5\xa6.1\x8d\xb4\xe3aˇ\xc1\xb8}D݂\x9eZ(O\x98ՊKl-i\\Z\xd4Mm\xedN%\x81\xa6v\xe3[\xd4D8\x8a\xe8稳U\xe4\xd3\va\x02\x16\xb0\xa2l\x1a@j\xc1m\x88\x9bBK\xcf/\xfa*Z\xe8\x98\x17\x9at\xf9\x87\xa7\xccU\aw\xfa\x86\x17'#+\xfc\xf2z\x82k\xc0\x15A\xac\x1b\xf9\xb9L\xcd\xc6!\x12\xa2\xd8g\x91\xdc\xdaG#\xb2\x00P\\V\xb0\x96q2\x1bψc\x81x\x8e\x96\xbe\xa1\x1bG\xdb\xcc\"\bV#\xf6\x88\x93JW'\x8b\x1f\x8f\xa47J\xe9\xad\xf94\xf8i\x97\x04\x1e\x12\xaafV\xf0\xee\x03\xb3\xc5\x0f\xe6\t\xe9ND\xeb\xe6\xa6@\xbd\xfcL\\\x9e\xf9\x8c\xd5BR\xeaTJ\x99J\x8b\x1c\xc1\xaas\xccu\x19\x83\x10\xb4\x1a;\x8a\xacM\xe0\x0fVa\xf8\xb3\xcc\x10K\xc29J\xc7#\x9a\x93\x8a\xe6f\x82\x02\x89\xe0\xc1\xf1\x1e\x06؎\r\x15\r\xf9\xafr\xda\x1c\x17\x10\x11\xa5k\x8a0W\x17\x91\x86\xeb\x91u,\xa6\x05\xa0v\xeb\x12\x9f\x8a\x8aڼ\xe1_\x14_\xcc,f\xf2³\x8bP\x8b\x9dk\a7\x9d\xa90\x05U0Um;E\x89\xe9E\xe8֭\xb7\xfa\xf1\x1bJ\xa9\x95a\xba\x86֬\xeeك):\xadx\xff\x00=\xc7\x16\r\xb3\x01\xf1\xf9\u007f\x8a\xf3\xfc+\xf8\u007f\x84\x84\x95\x13\x92\x00\x13\xb4e\x01\x8b\xe4\x8f\x17\xd7\"\xfa\xf7A\xf4\x17\xcb\x15\x96\xd6\x10\bJN~\u007f\xa9\xa7m\xc5\xcd\xe1\x17\x1a\xa5\x03 \xe94\x9e\x19\x97\xd3\x1f{\xbc\xe7ޢ\x88\xd0\t\xf7\xb6ׄ\x18m1ik\xa9\x82$\xb2\xb5P\x02\x80'\x87_j\x972\x8b\xddJ\xe5\xe0\xf8c\bp\xc4\x1a\xfe/\x13\x98\xca\xee1\xde5\x1bd*K{90\v\xb7\xbf\x99\xdb\xd1N\xea\x10\xc9\xcc\x1d\xc1\x880\xcbUW\xd6(\x10\xa0Z\xc5\x04\xea\xbcM\x94k\x8d\xe1\xceb\x94\xd0\xc00\x01\xcey\xe7\xe9\b\xdc\xc7(v\x942\x89\x9biV\xc4apR(\xf3\xc3\xe2\x19\x8d\\\xda\xeb}\xc7`\xa4\x88E\x03A\x9d\xe6\x18\rxB\u05cd\xbf\xcc\xcbQ-\x0f\x841\x04\xac\u007f\"W\xf0\xe7\xf8s\xfc\x18\x82\xbf{\x1b \x05O\x0f\xa3\x89I\xc3H\n\x18\xddmR\x1b4\xb2%\xca\xc1X\x89[\x96!\xcb\xc4<\x91\xf4\x8eb\xae\xf9\x10o:S\x9a_\x1eb\xad\xf2N\x1fR&\x00\x16x\x1e\xa5'*\x00\xe1\x8d\x1e\xb5\xf1\x19m\xd5=\xb3\a-~\x0f\x11:\x867.3\x10\x8cJ\x8e\xd6\xc2\xda4v\xf4J\x8a)Z\x8e\xe9\xa4C*\x1a9\x8d[Y\"C\x189\x9eS#\x11\u007f\x85\xd1\xdbC\x04e\xab\x05C\x06\xf9\x16ߖb\x02\xb2}n;ذ\a\x1c\xa2\x85\x9dn \xad\x9c\\\x00X\xc37\x93i\x01\xe0\aPǭ\x9fP\xc1*T\n\x8f\xf2\xab2\xff\x00\xf8\u007f\x8d\xa3-\xe8\xec\xe1\x8cU\x9d\"/%\xbc\xac\xa0\u007f\xe3\n\xdcˉe#W\x9c \x9b(\r\x12\x89\x84\x8c\x157\x06%*\xbaa:\xf6\xcc\xf9^P4p\xce\x18\xdcTj\xa2\x8e\xd7\xfd\xf7\x84\xcbI\x157\xb4\xf9\xf3\x06\xbf\x9a\xf58\x89\x92\x82\xd4\xc0\x1d\xae\x83\xcb)!Z(\xa8tZ\xc9\xe9\xf4Ɛ\xb6\x87B\xf9\xae]e\xb7\x06q\x15\n\xadgSam\xbby\x94i\x0f\x12\xd9U\xb9z9bn\xacb\xa9\xddq\x1fH\xa2Gq\xb4\xe5%\x85\xd8}\x1eO$F\x98a r\xf6\xfd\xa5ߌZ\xc9\xc9\xe9\xbc~a&\xa8\x15\xd8g\xf7\xe3\xe6\x15,\\\x8d\u05ce\xa5݇{(\xff\x00{˘\xa2\x91\x1f%L#\xaa\xff\x00\xed\x8a \n\xa8\x05Z\xc4\xcd\xff\x00\ftF\x05@\xdb+B\x95\xafq\x8aQ\xd7r\x91\xbd\x98\x85b\xbaB\xe8\xcbD\vX\xb8\x94\xdf[\xc4\x19\xe1x\xbd\xdb\xe0\x86\xdes\xe7lV\xd02\xa5\xe1\x8f4\xb7zu\xea,\xa3g\xd6\aQ YO\xc1)nk\xfbz\xfak\xe2^\xb0h\xf8\xee-\xe9\xb9\u007fŐ\x05f\xae\x1ea\xa5\x03`\x81\xf0s\xe7~c8\xc92\x11\xf7\x17s8\xb5\b\r(\x94\xd4\xd3Ps\x18\xa8\xcdD\xeaX:\xe5\x88\x00k\x866&K\x82\xd3m\xd6u\x14\xbav\xf0z4G\xe61\xb1E\xe4\xfd\xc4:\"\x1bU\xe62\xbf\x96?\xfcq8\x8e\xe5\xd1?\xa8\xbf\xc2]-\xadT\x17\xf8\x96}\x00#g\x8a\x82\x10\f\x15YCGq\xe3\u007f\x829<\xc7\xfb\xef\x1c\x9b\xba\xe5\xd4b\x1a\xb5\xe5\xfc@\vU\x9cʲ\x14^/\xb1,\xd7U\x1a\x821;\r\x1e9\xfau2\x89N\x03c\xb5\xfcB-b\xe1\xb4?#1E@\xf0\xd4\rF\xb4\xecB\x91h\xb1\x8d\x8e\x17\xcf\xf3\xe6,yp\\W\x17\xf8,\"\xca\x11\xdcc\xdf1ܩ\x93\x01\aS\"u)a\xbd\x99S\x12\xa2-\xff\x00\x038\xc5\xc3\xdcIQ\x8a\xdc\xfd\x8a\xfe1\xc7\xff\x00\x1c˸\xb1\u007f\x91BX\x19\xbfM\xfe\xef\xea\x14\vӯ$\x17`\xe7-Gfn8\x96\xc3NɽEk\x81\xfbc\x88\aK\xb6\n\x99\x0eG\x984\n\x9bY\x95P儰ՙFYQB\xf3\f\xd2`Y<=\u007f\xec\xb3J6\xdd/W\x06!\x96:\xe8\x9ab\xde\xf0z\x1eI\xcf\xf1qh\xa8\xa2\xf7\xd4Q\x8a/\xe0\xc62\xead\x988Wj\x8c]%\xd3~!j\x1bZ>bS\xcfI\xa4\xf170c7+\xf9\xba\x82\x9e\x1e\"\xdf\xff\x00)QVR\xeb\xd1ܯ\xc5.\r\xb7\xff\x00!\x1a\x06\xa0\xea\xbae\x1bG\xadB\x8c\xa6\xcc>\xa0]Kި\x00\xdb\xeb\r\xcdpAl\x89e\xa0\xaa\xcf\xd3\xe2\aHgAS\x14e\xf3\x13;\xbe\xa2\xe4a\xa5\x1f\x98\xb88\xe4\x83\x12\xbf\x8a\x80k\xf0\xbc?\x8f\xa4e\xcd\xff\x00\n&7\x15\xc5\xee(\xb1\x83:\x98\x97j\xb7}Sy\xe3\x19\x84\xda\x193\xda\f$5\x1b\\ˈ\xc2ϙpYd>\x02\v\xeeR0V\xec\xf7\x9f!\x80\xc4\xe5\xd3\xe2\xe7\xe24\x94\xe1\x12\x92?\xc2ˀ\xb7\xd1\xccZ\xd7\xd6e\u007f\x9a\x95\xfc\\j\x17\x15\xba v\xf8\xe7\xe6g\x02\x03F\x02V\xa2\xa0s\x9a\x84\xa9p?\x91\x16J/}@\xdc\x0e\x1f0\xa8\xad\xb79\xcds\xb8\xf8\b`tx\xff\x00j\r\x14\xe0\x8a\xb8̠UR\x1cs\xee`\x17ƪ\xaa+\xedƪ\xaa~\xb9\x82\x8a\xb0\xa6\xee\\\x1c@:Ц6\xcf½\xf4\xc5\xc4q\x17\x12\xccŌ,\\\xdc~!@Z\xbd\x01\x11\xda\x10\xab\b6\xb1\x8c\x86\xe3\x02\xef\x9bSEd\x19\xe1\xdcG\xc9\x1eG\xb6\xf5\xafA\xd5\xc5\xcc,\xbdޡ\x99ژ\x9f\xbe_S\xcc\xfbT\x9b\x11L\xc5I\xcb0\xe8\x85\xf5\x14\x8b}D36'\fg\xbe\xe7\x98\x001\xe0#Y\x93\xe7\u007fy\x90G\xd6\xfa?\xb8\xee\xe7\x87?\xab\x86c}\xb5\x1b,\xf0\x86,\x02\xc1\xa0n\x00\xcfК_\xee\x14l\xa9_\xc5D\x8e8\"\xa4\xb8\xcb,lvrʀG}\xb1\xe3T\xe4\xd1\r\xc9Zy1\xce\xf5\xf2\xdea{\xe95,\xb8\xed߈\xb7[k\xb8\xabv\x871[\xb9\xf5-F(Z\x1f\xd4\xc0\x9c\x1b\xbf\xf7\xda\x19(W\x18\xafp\x16\fp\x04VCP\xfb@\x80\xbc]\xff\x00\xc6\xfe\xb0\xea9'\xf5\x169\xb8 \xf6\x95\x89\x99S$\x15_g\xcb\xdd\xe6%Ѣ\x99\x97/\xd1.Ê\x8c\xad\xd8\u007fqr\xf8\xf1Q=}Q\x8d\x01m\x01\xb5\xf0\x10\x84\x03a\xb7\xcf\xfc\xfaİ@\x96\x17\x9aX\n\x1a\xd3\xd21\x1a\x1b\xb4\xa7Te\x87\x05mTB\xfb\x89\x9b\x83Cܿ\xa4s*&\"\x1b\x94&\xbf\x85\x85w\x15\x15Pq\aaXf\xf5\x1b0\x93\xb8=)EO\xd2Ϸ\xb3\x9e\xa0o\xbc\x85\x9b-{_\xe8\xfbG\xef'\xa4\x1f\x0f\xe5\x11\xad\xea\xfc3&\x97̶\x81\x06\xd5w\x92+q\x98+\xaf\xee&qW\x96\xc6S\xe0\x9f\x04s\x91\x9e\xa6r.\x85\xb3\x1a\x98MR\xc8\xf9\xee\x9c\b\x86\v\x1e\x95*\x85Ѿ\x1e\x82PX\xb5\xe3\xf7\x1c\xaf'\x8fQ\x8bqÁ*\x19\xa0\x03T\xf3[\x97\xb0+\x85\xe0\x81J\xd5Ը3\v\xf2s\x05\x87\x9b\xf1\xd7ļ\xca#h\xaf\x99ZL\xe0X\xa3ٖ;\xafR\xcc\x01 \x950k\x83Xw\xc5e7\xab\xbdC\xa0\xd0\x1d\x11\xad\xdc\bl4\x9e\xa0\xcb'\xe0\x82\xe8s_s\xb2\v\v\v\xbf\x11\x151Q\xec\x1d\xb0i~\xb3\x1a5Y\x94\x1e\xdb=Ѽ2\xfdh.H\x97\xac\xbcg^\v\x99\xa2\x1aW\x84\xa4\xad\x9a\xfas\r\xbfd\x94\xe7\xc0\x99\xab뱊\xe5\xd4Y\xf3\x89w\xfc-\xc1\xc4\xd2\"z2\xabA\x01_V\x8ao\xe7_ߩq\x82\xac\x1c\xfa\xfd\xb1\x12\x83\xc0J\x95\xfc0\xfe*\fK\xe6\xadߣ\x98}\x8a\x18;{І\x80o\x91\xac=v\xf9\x84\x12D\xda^~X\x96\xb2\x1dVX\xe4>o_/3\v\x16\x0fՊ\x83*\xa8\xff\x00\u007fpCRX\xba\x95\n\xe9\x88ḵ>\x96\xfd\x1e\xa3\x9a\xdf'\xe2\aX\x03\xbd\x12\xca\xd9\xd8\xed\x89\xe5\xb2\"lٲp\x1d\xb2\xb7#\x8cjP\xc0к\xe33\x1dA\xa9\xda'r\xbf\xd3\xe6\x15\b\xe29}\xcb\xc93\xc2\xf3\x81jͣ\xc9\xca\x1cł\x95\xb6\xabO\x05\xccw\x94MKyܨ&\x8aY\xf2\xd7f\xfd_r\x89\xec\xed\x8bp\xe3\x8f\x0f\x88\x89F<dp\xd8n\x15L\x1a\x95\x16s\xdb\xdec{\xb0D~,-\xafl\xae\xaf\x16E\xc1i\n\xa9\xeao\xb7\xcaJ\x1e\n\xff\x00\xc4+\x18tpmү\b\xc2\xe8\xfe\x9f#.\xe2\xe2\x19-\x94\x19\x97\x95\x8f\x99\xc6u\x15\xba\xa5\x165Gk\xc7灁\x89O\x02\x83\xc9\xfbs\xeapS\x9d\xe1\xfb\xfe\xbdĪ\xab\\\xab\xfc{\x8c\xaf\xe2\xa0*\x00\xdb\xc1\f\xec\xa7(\xb4\x12*\x8d\xbe\x17\x89H\x10\xf1\xba\xbf\a,Xi\xbb\xbb\xdb\xed\xe3\xd1\x10`\xcai\xe3\xfeͲ\xc2\xf1\xca~\"p\f\x1a\xe0\u007f,\xd3\xc0>\x84\xb4B\x8ar\xf9\xf1\xe2Y&\x85qy\"5r\x9b1\x8c|\xb1\x19\r\x98\xfc\x184\xf4\xd5~(%\x15\xe3\xf6\x80׃D\xeekgjm\xbd\x15ў\xbdK\xdb\x16\xc2d\xf1= d\x9bP4\xf7\x06\xe0\xd4r\xe6\x12𬿗\xfb\xdcX\xdcێ!c-\x93=[կ\x00\xf7\x1f\xe2S\x02\xa6\x9d\xb9f\xf8\xa9q\x18df\xc8*\x98\xb6\xa8\xbe\xe1A\"\x11R\x8d%\x1a\xd7Ù\x98\xe1\xb8me\xbb\x10wt9\xbaf\x1df\x9c\fQG\xb5\xf0e\x880\xcal\xd0ƑO\xc0\x0e\x98\x103C\xa0:\b\xe1\x13w/\xa5\xe8\x17\xc5f$\xac\xd7L\xafj\xb7Є4\xe55\x0ei\xbb|}P\x18k\x06\xce\xdbz\xb8W\x017qj\xee/\x9f\xe0X\xfd\xe5\xdf\x11h\xd0<\xbcB\xce\x03\x93\x87\xe0\xeb\xdb=\x04\xce;\x9b\x06m\xec\xed\xf2\xfde\xa2>\x1b\xcb\xed\xe61\xfe+\xb8\xc3q\x85\xd4\xfdh\xf7\x04\x80\xb3{\xf8&\x90\xa4h\xdf\xe8\"\xa8n\xb3\x8c\x0f\xdc\x1e\xdfWp\xab\x91\xb6t\xf7\xdc\x1alz\xe6\xfa'0\xaf\x9f\xee1C\xd5\xf4\xff\x00\xc2\x19\xc0\x1d\xfe\xbfqޭ*\xcasq\x0e\x19v\xd5\xd4e[\xa5[\xa7\xae\xbd\xcb\x1dFG\xfb{\x97\xa3\xa4\xc7\x00\x96\u007f\xd1+Pb\x83\x19\x94\x19*\xa1}k\xe3\xfd\x96?\xb7ae\xbb\xdb\xdc\xd3x\\\xde\x10L\xfc\xf1\r\xa5c\xf0\xc4X\x98\xfe\x01V\xaf\x9e\x9e\xe2\x84\nF\x92a\xcc@GO\t\xea\xd8_\x06a)\x11\xba\x00\x88\xfb\xaf\xcc\x11BاOL['\x8fE\x06ӕ\x1a\xd8q3J'F\x01\xc9\xeb\x1a\xf1\x02o\x00\x955\x93\x01@\xf04c;Ȳ\x8eK\x94\x0ee\xcc\xdbk\xc5\x16\xb3\x98Ь@\xf1!\xa2\"\x0e.\xc1u\xa4\xe2]\xdae\xb4\xb7خX\x19Ոl\x14J\x01*h\xf8\x9bE\xea\xe9-^\xa3@\x1e\x88Ϲ*\xdb;\xf3\x85?fZu}\xd6\xcey\x95\x98Y\xc4Ln\x19\x16\x89c\x86v\xf4\xf2\xfc\f\xe8T\x86\x87:6\xbb\xca\xd9\xcfGCm\xe5\xe5\xf6\xf2\xea;\xb11o\xfbP39\xb8\xca\xeb\xff\x00\x82\xac\xb5\x9a\xe5\xf5\xfb\x94\xe4\x06h\xc0\x84ҵ\x8f\xc1\x17\x1b\x9e;}\xb0v\x9e\xb0x\x0ea\x1b\x11|\x1b\xf9ḙ\x8ctM\xa3(]\xff\x00\x98!\xb4͝\x87\xf5\x1f;Vk\x83\xdb\xcco\n\x87\xac~!kG>\b-Jm\xa5g'\xfc\x940T\xd75\xc4!A\x0e\xff\x00u\x8d\xa2\x05\xb5ߡħ\x05\xbf\xc3\xf3\x05fV\xdc\x00D\xfby\xf5\x10U\xb20\xda\xd7\xfb\xcc?K\xd0\x19\xcf\xc6\xe2\xa4LU\xb5\xddp\x10*\xe86\xaf\xb4m\x97\x1c\xff\x00\x01\x98G\xe0\x1c~\x0f\xe3\xe9\t\x880=\v\x94\x88\xf0\x99\xe6\xdbn\xc4\r\"\xe5J\"\xed\xbdNkT`\x86\xc6\x01\xc3\x00\x19\x16\xad\xc1Awv\xb6^\xc2\xf1\x17\xc0\x82\xa9GV\xfe>\xf1\a\xb1$\xb4ve\x05\xd7\x16\xe2)\x90\x17l'\x1f\x1f\xa83\f)$\x14\x19,\xb3\xe6\x1bg\x04iʌ\xb9\xc0\xaa\x0et\x03\xa8\xd9\x00i;L\x0e\xea\x1a\x87=`t\x93@\x9doEu(j\xff\x00E\xc1\xfd\xba\f\xb8\x99$\xbf\x9ea\xb1T\x00\x94\f\xed\xe3\xder\x12\x06Q\x97FUk\x98\x988m\xa8n\xad8\vC\xdb\x17{\x95\x1f\x9b^\xd9\xd5\x04\xb65\xad\u038b\xaer\x1f\\\xd1\fE\xb8߽׃\x1e\xea\xe1\x14\xcc\xdcHW1\xf9\x8c\b\xa1V\x80#Yoa\xfd\xb1\x10Q\xa2\xb4\x84\xbc\xb1(σԫ\xc0-\xca\xf5\n1o7\xf9\u007f\x12\x8cW5\x86\xbf\xa2\x18\x06\x83\x83\xfd\xb2\xecV\x83\xe5\xf5\x02\x98\fg\xc1\xfbbQ\x86߫\xfa\x81\xbb\xa1\xf1\x83\xf74\x00\xb4\x1b\x84\x04\x01\x8cq\x1a\xabT\x83kN߉{n\x86\x1aQ\xc7\xe6=R\xd5<\x8b\xf3\xee\x05m٦\xbd\xa1\x19\x97\xed\x16\xdcq\xc9+&\x19\v\xb7\x0f\x9a\xfa\\x\xd0\x0e\x1b\x95\x9a\xa3uB\x8c\x81\x19[n\xcd\xc58k\xd4t~\xe6>\xf3,\x9b\x98\x11! ,J\x8cU\xe1\xdf~be\x9f\xb5\x00\xde<B\x8d\x98\xca\x0fm\x87\xca\xf8\x96$\xd6OH\xd1ei\xbc\x14\xcd]U\x04\x05\xe0\f\x8d\x8bR\x1a1r\x94\b\xe4\xa0\x1bN\x05\x99\xf2\x05\xb1\x19\x1d\xb3#\xe0\x0eOfQ\x024u\xfa\xa1\xfb\xc7\xfd\xc8/\xc0aA\xb1\xe4\x1b\xc9jB25c[o\xaf\x95\xb9\x8e\xc6\xf3XzA\xf6\x8e\x12\xa2\xc5š\xea\xda\xc1E\xb1\xaaI\xf2\f\x04n\xa8?b<\xfa\xb4\xd3\xdaʤ\xe0*\f,Wf\xac\x19\xb1\b\xae~\xaa\xa5\xb5\\\xf9\x9bx1Dq\x83\xa9\xb9\xbfS\x97X|\xa2T%p\x06\n\x01\xa0\x18\x0f\x04\xa7\xa8\xf8\x8e%[\x9e\xa3\xe5\b.\x1bg3\xc0\xf3.u\x1c\xbd\x0f_\xb4QW\xe0\x82U\xac\xba._r\x9e@\x86\x8c\x8fYO\a\a\x99^\n\xf4\xd1\xfbbf\xb3u\xed\xf3*Ы\f\xe3G\xee1\x9d/a\xb4\xfc\x10AU\xc1Z?ql[\xe3\xb8)\x84}\xe8\x82Ԩc9}\xf4J),\xe5_\xe5\x84j\x00\x96\xe66Ʃ`s\f\xba\x88\x8bJ໙\xa1v({\xba\xcc\xc7\xeb\x9b#\xea\x04\xac\xf6\xd7=\xfc\x1e\x88\x1du\x02\xceb\x1c*\xbd\x1e\x17\x8d\xd7\xd6f\x82\x1bZߟ\xbca0\xa3\x9e\xa0\xafF\xb3,\x1b\xe6\x12\x13\x88\x92\xc0mw\r\xa4n1@`\xea7\xdcr\x84(\xb0\xb4<R\xfeO1\x8f*+w\xd2\x1fvq\x00M\xae\x174\xa1<a\xf6Qk>L\x04\x97Aȼ\xeaT\r9\x95\x19h\xbc7~\x87\xe6V \xa2r\xc4\x0f\u007f\xbfP1\xa1\xc4P\x94\x97\xc8\x0f\x8e\xe8\xd9v\x88\xb3\x97`/\x95\xe6<r.\xd3<,zo\xa1-\xb5\xad\x166^\\\xa6\xb4[\xce\v\x8e\xc3\x15\x145wX\xf3\x1c\xa2\xf0\xebR\xb2^&\v\xa9X\x8c\xf5\x89\xa9\x03\x99B\x17\xe6gЅD+F\xdf*\"\x86\x91\xcb\a\xa7p\b\x81e\x87Ê\u007f\x12\x86\x14\xbc\xd0\xe5\xed\xe2\x15V\xd5-\xff\x00Gl\xcb\x0f*_\xba\xfcB\xa9r5\xfa\x84r\xa7o`\xe7\xe5\x86Z\x1c8<\x0e\xe5\x91A\xe1r\xfb\xeb\xd4i\xcb_\x03\xd1\b\xe5\x9e9}\xb0\xa8i_\bX\xe0\x9a\u007f\x8d\x11O\xaabs\xe8\xfc\xb3y\x8cU\x17\xc0@u6/jS\xde\xd1\xe3\x13\n[\xc3\x04Eh\xb5\xb0\x80\x80n\xe5\x04\xb0\x8d\xc6'\x86\x94\xcd\x16\x04\xaf\x15\u007f\xe7\xe6 \x14,\xda@\v7N\x03\x99\xc2\xd5\xf0q\x1aoZ\x99C$N&8\xc2\xc8~ \xc0\bW\xa9\x06Ăz+\xc0\xecǛ\xbf#\xc9q,<V]%\x1b\xb4ձ3\xb2\x11\f\xc5n\x9d\xe9\x9a/\x86\\\x87\x06Kt\x1f\x9d\x1c\xd4gA\x89XZ=\xb9U\xe5\xf0\x11\x06\x97-\xd7H\xab퇭\xf41\"\x85\x8c\x8d\xa6\xbd\x95\xf5\xc9\x14g\xa4\x91\xdaK\x8a\xeb\xc1P0\xbf\x16(\xd2\xc4^^N\x80!\xfe\x8bu\xc5aï\xcc\x16D\xcaU%\xd5\xd2\xc0\xbb\xd6ܶ\xe6i\x02Uje\x13\x12\xbb\x8eJ\x8e\xe2\xa3j2+\x06\xa6\xf2\u007fa\xf8\x80OT*\xbc\te\n\xb5C\x9f+\x12\xe6\a\x86&\xd7j\xb9c\xe0&\n\xaa\\\x1c\xbe\xe0\x04@\xd0x<\ap\x0f\x9dD\x80ڦ\x12\xdcw\xfa\x8e\xc2'\xa3\x97\xb7\x88D\x90\xa7*\xc7\xc3\xf3\x00\x0e\x03\xa3\xbf+\b\x0e\x15\xd3\x1e\x8e\xe5\xc3:t6\xbec\xb7H\xa6\x0e\x8f]\xc1d\xac\xb4\xac\x83\xb8\x94P\xbap5\xf5m\xf3\x01\x17T0\x1a\x0f\x11\x9e\xcbU\xe5\x8e\x1d*\xba\xda\xfee\xe8\xb7\xe4\xdc\x06\x96\x1b\xe6%\xaa\xe2q\xac\xb0H\x12\x0eaq\xc7S\x02\x98~u\x11܉~\xb1;\x0f\\aʮ؆\xd4\xf8E\fè\f]<>e\xd6\x1dé\xa5p\xce\xe6\aP\"t\xec<T\xe744=b\"x\x1bl\x90V\xcbeUޣMW\u007f㵷\xe5\x94\xf4\xda\a\xe8i\xfa Ս9o.\xd3\xe8\x83\xd9{O\xbc^=\xa1\xe2\f\x95\xc3v\xc3\x06\x969\xc3y\x10%\xcejt\x15\x9bY^\xe8\xb5\xc1-\xc2\x1a#\x91\xc3p\xe8U\xcd\x1a\x80\n\n\"\\`#\xe5\a\xf0\ve.sX\x82\xc0= [\xd1\xc1r\x99P\x1dp{\xed\x86%EŶ\xf8\x94\x00\x87M\xa7\x99n\x01\x83``\xf6`\x90\xb6q\xd1\xe9\xcb\xe66\xa0\xf2~XQU8?\xe1\xc4\xd3y\xa5b\xaa\x97\xaa\xe2\x01\xab\xb5\xd3/]K\x16\x8d\xbah\xf6\xf32ܧ\x87>\x13\x00\u007fe\xf2\xc1H\x00\vz\x91ī+\u007fꀁ\x86\x8f\x11\x84\x10\x9d\xe9X\xf6\xd0|\xca\xcf`\x9d\xabO\xa8 r\xc9\xe0\xe6$n\xd2Y\xe6\x89h/+\xdd1\xb0}G\xaf\x0e*Zc_\xc0\tm\xc8ɔ\xc1|\x03\t\xf5\xfb\xcfk\x15Z\x93J\xbe\aQ\xec\xeb`h\x99\x1e\xa0稳\x8dø\xd0T\xa4\x81\x9c>\xe5\xfd\bU\xde#\xd6\xed\xe4\x15\x1d\xb4\x8e\x1a\xd9*\x0e\x93{$!A\xcd\x18\xbc\xa9\x9bn\xfa=\x87\x02\xba#\xd5m\xb1\xfdV;\x94\x14\x025\x96\x1f\xf5\x11\x88:,\x91\xc1\xa79S̀Gw\xd7E\xb3\xf55\xdc_F:\"p%\xd7\x17\x97n\xd5e7@\xac\xe5\xfaP\xa9X\xc4\xdc\x19\x9bG\x98\xfa\x8b\x89Y\r#\xdf\x04\xc1\xfbLPz\xed\xf3-\f\x17A\x95祖\xccY\xfe7\x05Q\xcb\xfcQ*\x82\xb6>\xc8K\x02\xb8\xa3\x8f\x9e\xfdM\xc4C\x80k\x1f\x82Z\x00\ac\a\xa2q\x0e\x19,\xf6_\x13<\x9c\x0e\x87\xc1\xcb\x1b\x16\x176\xc1Ÿ[\xc9\xf2¥F2\xb1\xeb\xee\x11u\x11n_#G^c\xa1\xe6\x1d.\xde\xd9jUmf\x11%|[b\n\x1a\xc4\xfc\x17he\x1a\rN*ן\xac\x176\x94t\x9c\x8f\xfb\xfe\x0e\x9f`\xd5\xd2X\x02\x10.\xf9e\xa2\x94\ue860J\xd9up\x16rC\x11\x19`\xec\x84\xf1\xa4(\x01\xb6WYa\xfa3\x15\x06i3\xb8\xa6\xb1\b1\xb2(\xe3_\xc5a\x00\x1b\x96ac8\xf1 \xe1\x94\x19\xb9E\xf6\x8f\x93\xb6\xa5~X\x9e\xadb\x80M\xa6\x85\xf2\xb5\x01d`\xeac\x81\x9fMub\xe1Ab\xd03\xcaڔѕ\xd0q-\xba\x15\xc7\f\x0e>v9-\"}\xdb\xf6\xc2%y\xb3#\xbd\x8f\xbc\x1eba\xcf\xc0\xd5\x17\x8fwu\xf7ʛfCw\xe0\xd9Q\x89\xf5=+\xcc\x1735j\x1e6\xf8\x8c\x91(\x02V\xa5=\x0f\x87\xee[\x9f\xa5\xbe\x0f?\xf2$\xa3\xcb.+Ԣ\x85/\x9d\x91.\x16\xaa\x85ar\xe8\x8e\x14\xb3\xd11\xe0mU\xc9\xed\x80\x01\x87A\xbf\x8e\b\x8dP\x13\xb3\v8\x1aa\xf1\xe0\x89\x9ar\x16\x9c|\xb0\x1d\x83\xa5\x18=\x1c\xc5m\xbb\u007f\xf08\x824a0\xd8<\xc1\xaa\xd0\u007f\x95\xb0\x15\xb8\xa7\x05\x14\x9e\x04k\xd3\x00\b\xabN.1\"\x83CG\xfd\x94\xd4\xf0\xdc\xfdk\xfa\x81l\x03+f\xf5+F\xc4^\x01\xb9R0Nv\xb0\b\x04殢\xb1es\bcw-g}F\x10Ц\xa6<|\xd2W\xfd\xf5\x8d \xc5K\xcb\xe6=Q\xdbR\xaa\xb2\xfbL\xaf\x8b\x87]\xc1\xa6% _n\x83\xdb\x01i\xc5U\x81\xf5\xfb\xfaL\x01\xc7f\xab\x8d\xfd&\x87\x11[\x8c\xbcLf\x02V\x05ر\xa3A\x9c^\xa5\x16`\x97\"9\x1c\xee˂\xf0\x10\x19\xf8\xdd\xed9)\xe3+\xa0n\x0e1\xeb\xe19\xcbڵ\xca\xc3JA\xedN\x80\x87&\xe9o\xb5\xfdM\u007fbD\xd4ـ\r\xb9\xc0;\x8b'*\xec\x05ՊS\xeb\xfb\x82^R\x01r\x00\xff\x00\x85\xfd6\xa9\x98h\xbe\x0fP\xc120\x03\bm\xb2}\xc6r\x91\x99oB5F\xcf\a?,p\xc0\xbb7H͜\x8d+A\xe0\x84.\xa4\xa56\xcb\x17R\xf1x=\xf9\xf16\x87\x03\xf6\x17\xd2!:\a\x8c|v\xf9\x80\x1c\x17~O\x95\xe0\x88\x02\xd0l\x14?p\xc0%\xbc\xf2\xf8\x83d\xbcr\xc7\xcb\xf8\x85@\xb3\xd5虙k(\xb5\xf5\x17\xbbK\x06\x9b_+\x0f\xa7\x98\x1f\x82\x16-M\x1a\x8b(xV\xa2\x9f%K\xcd@U\xbf\x1a\x95\xd3\xd2\xe7\x8f̻\x9dv\x90+\xaeh\a\xa0\xdd\x11h\xe9\xa7\xd3\n2+\x96%\xec\xcb*Ի\xb6]\x90\xc3P#\xb1\x15\xb0\v\xfeu\x12\xb86\xd45\x8c$\xa62\xa9q댑\x02\xac\xf2PEm\x03Xn\acƭW\xb7\x8f\xee\\\x1f\xa2\xfa\u007f\xae,ջ\xc83P\xb7\a\t\x17a\tg\x81j\xf4\x06U\xc6⯨\x81\xa9\xc8e\x1eX\xb3\x03\x86X\xee\x0e\xdd\xed\xed\xfbމ\x80\x8d\xa2\xfe\x83\xe5\xc3\x11e\xa5J\xd5m^U\xe6#V\x98\xf0\xff\x00\xa7\a\x98\x94#\x88G\xa1\xa1\xf0kN\xe2\xd16\xc7c\xcb\xf9\x86C\xac\x92V\n}\x90\x96\x00\"\xb2\x81\xf3\x82\xfc{\x96\x03\xd1\x03Gk2(Ә\x83XF\xc4\xc2K\x18\xccq\xcf%?\xf3!\xd3\xd3F\x87\x97\x94;\xd6\xed\xb7ĸ\xa8M\x86_lG\x1e\xc1y\xe6d\x83\x91\x13\x11\xc4ǧ\u007f2̄\xbb\xeb\xd0\xfc\xc5b\xa0\xc1\xab{b\x01\x80Z\xedkt\xfc\xcb!\x8a\xc0\xdb\xed\x8a\xc5\xf0\u007f\xb7\xcc:\xc0\xb7}\xfatJ\xd6;\xc1\xb8p\x90\x1a\x1a=\xf6̦\\\x1d?\x11\xeb\x1fO\x10\xd8(6\xf9\xf5,=\x0fsJ\xe5\xc4]B\xa1\x80`\xc2&\xac\x9a\xbfԽ\a&\xe6\xc1\xbdO0\xc1g\x85q\x8a \x83\x95\x04\x96\xb3W,\xe2\xbc\xce\xc3\xed)\xc6*]\x10Y\x89\x94\x02\xf6\x17\xd0\xc2\xe3\xc8\x18\xe4{\xc3+1\xa2\x17%R\xfa\b\xd1y\b\xf7\x00[\xd5\xf2@, pw.\xe1e멃j\xa6\x98\x82\x9f\x9e#\x1b\xe4\x00X\xc0m\xf1w\x1d:!T\x92\xc7!\x93\x9b|F\xc2e\x16W!\xb5\xf2\xb6\x1c9r\x96\x16ׂ\x1cXU\x8dM7l\x17|:wQ\xa6\x94i\xc1\xd0\xe2\r\xc8\xec8K?Z\x81\x94\v\x81g\t\xd2J\xca \x1b\x87j\xd5\xd5\x1a|\xc5\xdfF\xb2\x98`\xcf^\xee\xef\xa8\xc5\v:\xb0\x95{\xc1\\9\xdcp\xd6.V\xf6\xaf\xe6$\x89,\xec~\x0e_,\x1bB\xf7}\xce`N\xaf*V|\xaceZ\x97l\x9e\x80\x88\x91\a\x8c\xed\xf1\x015 V٣\xb9}\x8f\x81\x06\xfc\x04Złڟ\xed\x04\bm\x87Ǩ̠\x1d\xd9a\xedϨ](\x1c\x97ߢa\x00ށ\xc1\xda\xf0@\x81\x02⑻\x17j\xad\xa1\xf1;F\x15\xd7\xc9\xc1\xe6*c\x84+\xc2~\xd8 \xa1\xf4c]֍\r\x1f\xb8\xa5\xe5\xd5\u007f\xbf\xde%\xd4\xc9f\x05ڶ\x9c\xa8L\x14u\x10\x92\b\x91M\xdcUD&\x11<\xe6\xe5$\xb2\xd4N\xa0)so\xbb\n\x8a\xee\xa6\x04B\xb4\xf7\x16\xceL\"\x9bF4\x8e\xf14*,\xe6%\x0eb\xa0\xbdK7\x8d|n)\xaa\"\x86\x94\x9bĨ\x14Z\x94m\xfaM\x85\\\xd5\xe9\xdc\v\x06n\x96\xf1\x06\xee\x8c\xc3 [Wπr\xc3-ء\u007fY\xeb\xc1\xf3q\x00\xbbݜGl\x14\x05\x9cf\xa6\x9e\xae\x8f\xafR\x84\x05\x81Vm\x86`\x80<S\xb7\xf4yq2 \xc3\x10\x01g\x12a\xc7\x18\xee \xa2\xe2\xb8\xf2\xe1\xf7f:A\x9b\x12\xf2۵\xb1A\xc2\xe2ߧ\xbf\xea%\xa0\xc2\xe9Jp1h\x9b`\xcfȃ\xe6\xdc\x11Y! \v\x19\xc1\xcc\x03k\xf3\xfa<\xc3n\x06WW\xf7^f\x04\xa18N}Bd\xe1\xb4\xd0y_\x88ܼ&\x1d>\x83\x82\x15\x81\xe8\xe0\x0e\xd6\r-9\x1e\x8f\x04\xadV\x05└\x86흉\xf3\xa1S\x03\xa3\xb6$B\xec\xe4JZ\x91\xab\xed\xf5(K\u007f\xef̦\xc5\xe3/\x99p҃\xdb\x1bfӇ\xcf\xfc\x84,\xa1\xae\xe1*\xe0\xfb%\xc5P\xf2\xa3RT1\xb7\xf2\xc5\xe9\n \x8d\xe1\x85\f\xaa\xedi\x9cJ\x87p\x97\xb8)o\xcc\b\x04B\x92\x1a\xaa\x98-\xe1_Q\xa8\xfb\xa2F\x19\v\x80\x93\xa4#\x98Ǥ:\x15d\x03\x97\x18>\xcc\xe2\x89\xd8ݴ?\x98,}R\xb5Iu\xf1r\xa6*a\fF\xd6\xc3a\xaf\xee\bQ\x18:\x0e\x83\x82\v\xcea\xbe\xed\xba\xeaY\xb3\x13l\x05\xb5\xe5\xd7\xcc\xc2\x03\x13`o\x91E\xdf\xe6k*\xc1\xb26\xb6\xf8\x95\xaf\x831\xd0\a\x92\xbf`\xd7\xceYtP\xdd\xf2\x9bf2\x17e\xbe\x8f\xd4V\f)i\xfc\a\xde\x10I\x98c\xaf\xdc\xc9ۛ\xfe\x91,MlU\x81\"d\x18d\x8a\x06\xb7#>\xcf\x12\x84\xb7\x9fW\xf6\xc1\x94a\xc3/\xae\x88\x10w\xab~E\x84\x16\xb8-\x87\xf0\b\x04@\xe8\xc9\xe4\xb2\xc8\xd1\xf1\a\xa2%\x90\xd2\xdd\x1b\xf6\x94\x1c\xc3N\xa2\xf9\xdf\x05\xe4\x8c\xe5\xe3\xb7\xd4\xeb\xd4ͷ]\x99\x8d\xaa\x17\x03\xfd\xfe\xa5\x02\xe3\xf4@Q\xbf\x16!\x12\xc0\xb2\xe9(c\xa4\xb5A\xb4BҪ\xbeYRR\xba\x8dл7{\x95\xbe5\xe5\x88e\xb3\x06\x12\x15\tcF~\xb1\x15:|J\x9d\xdcE\x8c.\x1b\xa4\x97 \x9c\fAI\xa9x\xae,\"\xe1\x947\x15\x930\xc4\xf4\fA\xa2\x16\x8d\xff\x00\xbfR\xa3^\xb9\x0f8\x86\xc1%\x92\xa3\xf2\x1cD\xc5J\xf0\x14&\xd2\x15\u007fM@\n\xea\v|@\xa8V\x94\xf0\xf4\xc7\xf2\xfeT\x0f1y\"\xdbh\x1c<\xe8\xf9\x88s\xaa\x80\xbbM\x1c\xe7.\x0e\xe3\xba\xc6o\a\x9d\xbf\xa9p\x97)VՃ\xddq\u007f\xd8esԲ¾\x0f\x18\xe5\x83\x06\xec\xdf~D@W/|\x03\xb7\xf5\x12\x8e\xea-\xc6Z\xefg\xda\x13 7\xf4\x9f\xf2aR0\xd7\xe8\x0e\"\x9bܘ\x1e<\xb3$\x90xW\ue01a\xe0\x91\xf4\x8e\x0f2\x82\x80\x1b\xcb\a\xcb\xcaY\"\xabj\xfb3[\a\x1d\xe1Mu\xc2\x1a\x84$\\K\xa3\xc1+\x16\x1e\r\xfb<\x12\xb1\br\x8fI`}' ΐ\x00h\xf5\xe7\xcf\xf7\x04m\xb5(\u007f\xbf\xbf\xee5-w\xe0\x1e\xbfr\x8amoye\x169\xc2e\xaa\xb7M\xdcA(\x81\xd7d\xa0P!\xae\xa2;\x9a\xe2\x05`<\x04U\xae\xfa.[d\xcdq2\xab\n\x97\xb8Ϻ\x96\n\xea$0FN\xecHŀ\x87\a\xf00jq\x139\x81\xb4\xe2<kF\xe1\xf7\x8a\xd6H\x98)H:\xc0{\x97hݩbJ*U\xa2\xc7\xe4\x96Ce\xb8pĴ\b\x9b\x868\xfe\x12ȕ.\xba{\xe6]G\x13\x11\xae\xb17\x84\xc5+\xf3\x10\x10\xfe\xee\xbe\x11\xe2\xf8\xf30\xbb\x8c\xb0\xbd>^bV\xb4\x05\\AX8\xee5\x80\xeb\xcc-\x01-\x99\u007f\xba\x8d\xa9i\x00-\xad\xe3+\u007f\u0530*\x05R\xbf\x01)p\x03c\x9ev|Eu\xab\xb1k\xed\x84,SV}.\xd9L\x9b\xe5G\xea\xf4x\x85\x11\xaf쌲)\xe4#a\xa2n-\x15\xb0\xc0m\xbc`\xcb\x1d\xc6\x18\xdf\xf8\xb6W\x946\xeb\xfb̪.\xe93\x00\x02\xd7\xe4\u007f\xddG\x15\x15\xcd#\xbb\xfd\xf8\xfa\xf5\x00\xf3=\xd7\xef\xfa\x89e2\xe6\xdc{X\xa2 \xec\x15\x87\xfeFȁ\xfa\xfa\x81\xae\x87\x1d\xa6?\xa45(U\x06A\x8e\x88-\xfe\xa6C+߈\xa4i\x8b\xb56\xb7\x13\x9ah#\xb8\xf3\xcchu\xf6\x83\x13\x98\xd172\x18)\x8a\xea3\x89{\x971\xaa\xd1){|bQYw\xdb\x10\xf3E\x92\xba4\x1e%\x18'f\xe0@\xb7\xd4/\xa9W<\xae\x1bb\x809\xf17Z,L\xcd@Z\xb1#d\a\x1e\xe6&rE{\x9c\xfa\x82\xb4\x16\xb8}\x1f\x98\xf5\x8cQ\xc3_\x8f\x1a\x89zA\u009fL\xe0\x80\x14/O\x97\xdaq\xa3*5\xee\u007f\x13F\xab\x86\xb4\xf8&X\x90\xaeW\x06>\xff\x00H\t\xf3\x8e9\xcb\xef\xc1(\xc6\xd5\xe0<!\x85\bk\xbfDrW\t\xfd\xbe#\xa8tR\x94y`%\x8a\x1d\u007f\xa5\x8e\xb0\xb2\xb3\xb7\xe8\x8e\b\x1d\xd2\xfcKBk\x03\x928g]\x18\xafpш\xdd\xe0\xf9\xe8\xfb\xb0K,ec\x1f\xaf\x12\x98\x84\xdf/\x89a\xa8\ne\xaef1.\xb0\xb8\x81\x1b\xe0r\xc4@\x15\x80D\v\x82\xbc\x107\xc3E\xfe\xa2\x06\xaf,\xab\xaa\xe5%\xf4j\xfb\x88\x01\x1c\xc3e\xc7NF\xa3t\x9daY\xfaEޮ\xac\xdb\uf3fcF\xbd\xb8\x90\xcc\xe5Q\xd71Y\x82a\x12\xe1p\x04\x94\x06\xa3[\xaaxc\xe1P`o7U\xf1.\xecd\aQ\xad\xa9\x81\xb4\x83\xa3\xef\a\x12\xf2DJ\xb1\x06\x9c\xb4\xc8\xe7\xf5\f\"\x87'ܕau\a\a\xa4\xbd\xde\xda\x13\xee=\x13\x14Ɯ\f{\xf2\xc5-\x17[\x1fqJ&s\xfb\x1d\xb1\"چ\xac\xfe\x89\x99\x00VC\x94\xf2\xf1\x02\xa4\x8e\x86&)l\xa7\x82\xf8\x1c\xb2\xf7\x8bU\u007f\xa4\xf1\x10\x95Kld\xf4j0*.\x97\xfbf2\xfb+\x89\xd0\xc0\xbd\x87\xa3\xb8£8\xbf{\xc1\nR\xf7\xa5\xf3\x10\x8aycT\u0090\x9a<s\x98\x9c\x04h\xe0\u007f\u007f\xecG\x17^\x8dEҡ\xd0\xe7\xdb\xfb\x99\xdd]\x1a\xff\x00\xa8jռ\xee$\f\xb6+\xa8\xa8\xe59v\x82*\x10\xe3\x89`\xed\xac\xb1\xc6\xdb,4\xb1\x16T4\xb9z\xf1\xf8x\x80\xdb?$\xcdVμJ\xc1\xbc\xbc\xccI\xb3\xb88\xcc\x1a7\x03\x19\x9b\x81<\xf2?\x0e%\xaat2\xbc\xbf\x10b\xac\xecƥC\xb81\a\fy\x89\x99\xd2XM\xe7\xa6>\xbf/\xee&\xad\v\xbf\xf7\xa9f\x9c\xb0\x93z!\x16\xc0\x1c\xc5vFNxk\xe6R\x00WJ\x1f\x87\x99mʱ)\xf8Y@vb\xa2\xfc\xf7\x11\x17\x85\u007f]AE`˘\x86\xc0-y\xbdL\xeesWya\xeb\x1ch\x18\xe6wz\xadg\xe2%\x05\x84\xae\x04R\x9aX\xe3=ũ\b\xb1\x18\xf4#\x81K=\xe7Q\b$\xac\x1fe\xb0P\x0e\x1cW\xee;\x045\x00sE\xc5\"\x8a/&\aA, \xb3\xbb\x04\x03f\xb8\b[Sf2\x90L\f\xad\x89\xd1\xe6\x00\xb6YE莯0R\x967\x97\x9d\xe2RL^}\xcb-.\xb5h\xebO\xc1\x1d\x83\xe4\xea<\xc8\x16c\xde\xde\xdd\x12\x94\x16\x81c\xa8\xc8p\xad<%\xe8i\x0f/\x98\xea@\xe1\xd4\x18\xb1\xd9e\xf9J\xa8fW\xcc1@\xbf\x10\x1e\v\xf9\x97\xf4̹\xbd\xc5yO\xff\xd9"),
-	"static/img/favicon.ico":             []byte("\x00\x00\x01\x00\x02\x00  \x00\x00\x01\x00 \x00\xa8\x10\x00\x00&\x00\x00\x00\x10\x10\x00\x00\x01\x00\b\x00h\x05\x00\x00\xce\x10\x00\x00(\x00\x00\x00 \x00\x00\x00@\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xda\xc1e\xffư\\\xffư\\\xff\xdf\xc6h\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xe6\xe1\xcd\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xff\xe2ڼ\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdcs\xff\xe6\xcck\xff\xf1\xf0\xea\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xff\xe9\xe5\xd7\xff\xe4\xcaj\xff\xf8\xdcs\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfb\xdeu\xff\xa9\x9a`\xff\x94\x93|\xff\x94\x9f\xb7\xff\x9a\xa6\xc1\xff\x9b\xa7\xc2\xff\x93\x9c\xb0\xff\x96\x93z\xff\xb0\x9f_\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdcy\xffv\x8d\xc0\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xff|\x8f\xb7\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe2{\xff\xfe\xee\xb1\xff\xff\xf7\xd9\xff\xff\xf9\xe3\xff\xff\xf5\xcf\xff\xa0\xa9\xb5\xfft\x8c\xc3\xffSb\x85\xff39I\xff5<L\xffYj\x90\xfft\x8c\xc3\xff\xb0\xb4\xb2\xff\xff\xf2\xc3\xff\xff\xf3\xc9\xff\xfe\xee\xaf\xff\xfe\xe3\u007f\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe4\x84\xff\xff\xfa\xe8\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfe\xff\xff\xff\xccʽ\xff\x1f #\xff\x1f #\xff\x1f #\xff'(*\xff\xdd\xde\xd7\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf4\xff\xfe\xe8\x98\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xf8\xde\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xa0\x97v\xffLG4\xffQK5\xff\xb3\xad\x9a\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf7\xff\xfe\xe4\x85\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xf3TN8\xff\xf8\xdct\xff\xfe\xe8\x95\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf6\xf6\xf6\xff_ac\xff(*.\xff{}\u007f\xff\xfe\xfb\xef\xff\xfe\xe1w\xff\xfe\xe7\x91\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xd4\xd4\xd5\xff@AD\xff126\xff\xb3\xb4\xb5\xff\xff\xf1\xc1\xff\xfe\xe1v\xff\xf8\xdct\xffTN8\xffTN8\xf3\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xdaTN8\xff\xeb\xd0o\xff\xfe\xee\xb3\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x97\x98\x9a\xff\x11\x13\x17\xff\x11\x13\x17\xff\x11\x13\x17\xff\xc1\xc2\xc3\xff\xfe\xe5\x86\xff\xfe\xee\xb1\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xffPQT\xff\x11\x13\x17\xff\x11\x13\x17\xff\x1f!%\xff\xfc\xf5\xdd\xff\xfe\xe1v\xff\xea\xd0o\xffTN8\xffSN8\xd9\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00UO7\xafTN8\xffʵc\xff\xfe\xef\xb4\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x96\x96\x98\xff\x11\x13\x17\xff\x11\x13\x17\xff\x11\x13\x17\xff\xc0\xc0\xc1\xff\xfe\xe5\x87\xff\xfe\xee\xb1\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xffOPS\xff\x11\x13\x17\xff\x11\x13\x17\xff\x1e $\xff\xfb\xf4\xdc\xff\xfe\xe1v\xffɳb\xffTN8\xffTN8\xb1\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN9UWR9\xf4\x90\x81N\xff\xe2\xc9l\xff\xfe\xe8\x97\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf5\xf5\xf5\xff[\\_\xff%&*\xffvwy\xff\xfe\xfb\xf1\xff\xfe\xe1w\xff\xfe\xe7\x93\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xd1\xd2\xd2\xff;=@\xff,.1\xff\xb0\xb0\xb2\xff\xff\xf2\xc2\xff\xfe\xe1v\xff\xd8\xc0h\xffxmE\xffTN8\xf8TO9g\x00\x00\x00\x00TL9CWP9\xfeһf\xff\xed\xd2p\xff\xfc\xdfv\xff\xfd\xe0v\xff\xff\xf8\xe0\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfe\xee\xb3\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xf7\xd9\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf8\xff\xfe\xe5\x86\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf7\xdbs\xff\xa2\x91T\xffTN8\xffUO8WUN8\xbb\x82vI\xff\xf3\xd8s\xff\x9d\x8dS\xff\x9d\x8eR\xff\xf6\xdas\xff\xfd\xe3\x84\xff\xff\xfa\xea\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf4\xcb\xff\xfe\xe1w\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe3\x81\xff\xff\xf8\xe0\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf6\xff\xfe\xe9\x9a\xff\xfe\xe0v\xff־g\xff\x98\x89Q\xff\xb1\x9fY\xff\xf5\xd9s\xffTN8\xffUN8\xd0TO8\xe0kb@\xff\xfa\xdet\xff\xf9\xddu\xff\xfe\xe1v\xffɳb\xff\x92\x83N\xff\xf5\xdby\xff\xfe\xef\xb4\xff\xff\xf7\xdd\xff\xff\xf9\xe5\xff\xff\xf5\xd2\xff\xfe\xea\xa0\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1w\xff\xfe\xeb\xa3\xff\xff\xf3\xc7\xff\xff\xf4\xcc\xff\xfe\xee\xb3\xff\xfe\xe3\x80\xff\xf5\xd9s\xff\xa1\x91T\xff\xe9\xcfn\xff\xf7\xdbs\xff\xed\xd2p\xff\xdb\xc3i\xffTN8\xffTN8\xf5UN7\xc1TN8\xff~rG\xff\xb2\xa0[\xff\x8c~M\xffTN8\xffTN8\xffh_?\xff\xc0\xac_\xff\xf9\xdct\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdct\xff\xc0\xac_\xffg_?\xffTN8\xff]U;\xff\xa3\x92U\xff\xaa\x99W\xffe]>\xffTN8\xffSM8\xd6TL9CTN8\xfcTN8\xffTN8\xffTN8\xffTN8\xffTO8\xe3TN8\xffTN8\xff_W;\xff\x92\x84O\xff\xb4\xa1[\xffԽg\xff\xe7\xcdm\xff\xf1\xd6q\xff\xfa\xdet\xff\xfa\xdet\xff\xf1\xd6q\xff\xe7\xcdm\xffԽg\xff\xb4\xa1[\xff\x92\x84O\xff_W;\xffTN8\xffTN8\xffUN9\xdcTN8\xffTN8\xffTN8\xffTN8\xffTN8\xfeUO8W\x00\x00\x00\x00TO6=TO9\xb9TN8\xdeUN8\xcaUN8i\x00\x00\x00\x02SM9YSN8\xdfTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffSN8\xdcSM8V\x00\x00\x00\x01TN8[TN8\xc3SN8\xdfTN8\xc0TM8I\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00UUU\x03RN7ATO8\x92TO8\xbcTN8\xdeTN8\xeeTN8\xeeTN8\xffTN8\xffTN8\xf1TN8\xeeTN8\xe3TO8\xbcTN7\x8fTO6=\x80\x80\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\x80\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x01\xff\x00\x00\xff\xff\xff\xff\xff(\x00\x00\x00\x10\x00\x00\x00 \x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x13\x17\x00\x1f #\x00.,'\x00WN1\x00TN8\x00ue7\x00xh4\x00{sN\x00\xaa\x92H\x00\xa5\x92T\x00t\x8c\xc3\x00ȧN\x00\x8e\x99\xa6\x00\xa6\xa3\x89\x00ȳr\x00²z\x00ϻy\x00ʿ\x8f\x00\xcc\xc1\x96\x00\xf3\xd5t\x00\xff\xddw\x00\xff\xdfw\x00\xff\xdfx\x00\xff\xe1u\x00\xff\xe0y\x00\xfe\xe1v\x00\xe7\xe1\xd2\x00\xf5\xf6\xfb\x00\xf7\xfa\xff\x00\xfb\xfc\xff\x00\xfb\xfe\xff\x00\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x10\x0f\x19\x15\x14\x14\x19\x04  \x04\x19\x19\x19\x18\n\x1d\x1d\n\x19\x14\x14\x19\x04  \x04\x19\x15\x19\x19\r\n\n\f\x19\x17\x16\x19\x04  \x04\x19\x13\x1f\x1f\x0e\x01\x01\x0e\x1f\x1f\x13\x19\x04  \x04\x19\x1a\x1e\x00\x02\x19\x19\x1a\x1d\x00\x02\x19\x04 \x04\x06\v\x1a\x1f\x00\x02\x19\x19\x1a\x1f\x00\x02\v\x05\x04\x04\v\b\x0e\x1b\x1c\x0e\x19\x19\x0e\x1f\x1f\x0e\b\v\x04 \x04\a\b\v\v\x19\x19\x19\x19\x12\x11\t\a\x04     \a\a\x03\x04\x04\x03\a\a                    \x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x01\x00\x00\xf0\x0f\x00\x00\xff\xff\x00\x00"),
+	"static/css/style.css":               []byte("/*\n\nStyle derived from https://pkg.go.dev/static/css/stylesheet.css and\nhttps://blog.golang.org/go-brand.\n\nOriginal work is Copyright 2019 The Go Authors and BSD-3 licensed\n(https://golang.org/LICENSE).\n\n*/\n\n/* Palette generated by make_palette.go. DO NOT EDIT. */\n:root {\n  --aqua: #00a29c;\n  --aqua-1: #00dcd4;\n  --aqua-2: #17fff6;\n  --aqua-3: #51fff9;\n  --aqua-4: #8bfffb;\n  --aqua-5: #c5fffd;\n  --black: #000000;\n  --black-1: #2b2b2b;\n  --black-2: #555555;\n  --black-3: #808080;\n  --black-4: #aaaaaa;\n  --black-5: #d5d5d5;\n  --cool-gray: #dbd9d6;\n  --cool-gray-1: #e1dfdd;\n  --cool-gray-2: #e7e6e4;\n  --cool-gray-3: #edeceb;\n  --cool-gray-4: #f3f2f1;\n  --cool-gray-5: #f9f9f8;\n  --fail: #ff5630;\n  --fail-1: #ff7253;\n  --fail-2: #ff8e75;\n  --fail-3: #ffab98;\n  --fail-4: #ffc7ba;\n  --fail-5: #ffe3dd;\n  --fuchsia: #ce3262;\n  --fuchsia-1: #d6547c;\n  --fuchsia-2: #de7696;\n  --fuchsia-3: #e799b0;\n  --fuchsia-4: #efbbcb;\n  --fuchsia-5: #f7dde5;\n  --gopher-blue: #00add8;\n  --gopher-blue-1: #0aceff;\n  --gopher-blue-2: #3bd8ff;\n  --gopher-blue-3: #6ce2ff;\n  --gopher-blue-4: #9debff;\n  --gopher-blue-5: #cef5ff;\n  --light-blue: #5dc9e2;\n  --light-blue-1: #78d2e7;\n  --light-blue-2: #93dbec;\n  --light-blue-3: #aee4f1;\n  --light-blue-4: #c9edf5;\n  --light-blue-5: #e4f6fa;\n  --pass: #36b37e;\n  --pass-1: #4eca95;\n  --pass-2: #71d4aa;\n  --pass-3: #95dfbf;\n  --pass-4: #b8ead5;\n  --pass-5: #dcf4ea;\n  --purple: #402b56;\n  --purple-1: #604080;\n  --purple-2: #7f56aa;\n  --purple-3: #9f80c0;\n  --purple-4: #bfaad5;\n  --purple-5: #dfd5ea;\n  --slate: #555759;\n  --slate-1: #707376;\n  --slate-2: #8c8f92;\n  --slate-3: #a9abad;\n  --slate-4: #c6c7c8;\n  --slate-5: #e2e3e4;\n  --turquoise: #00758d;\n  --turquoise-1: #00a8cb;\n  --turquoise-2: #09d5ff;\n  --turquoise-3: #46e0ff;\n  --turquoise-4: #84eaff;\n  --turquoise-5: #c2f5ff;\n  --warn: #ffab00;\n  --warn-1: #ffb92b;\n  --warn-2: #ffc755;\n  --warn-3: #ffd580;\n  --warn-4: #ffe3aa;\n  --warn-5: #fff1d5;\n  --yellow: #fddd00;\n  --yellow-1: #ffe429;\n  --yellow-2: #ffe954;\n  --yellow-3: #ffef7f;\n  --yellow-4: #fff4a9;\n  --yellow-5: #fffad4;\n}\n\nhtml {\n  height: 100%;\n}\n\nbody {\n  font-family: Roboto, Arial, sans-serif;\n  line-height: 1.5;\n  margin: 0;\n  padding-bottom: 2em;\n}\n\na,\na:link,\na:visited {\n  color: var(--turquoise);\n  text-decoration: none;\n}\n\na:hover {\n  text-decoration: underline;\n}\n\nh1,\nh2,\nh3,\nh4,\nh5,\nh6 {\n  font-family: \"Work Sans\", Arial, sans-serif;\n  margin: 1.5rem 0 0.5rem;\n}\n\nh1,\nh2,\nh3 {\n  font-weight: bold;\n}\n\nh1 {\n  font-size: 1.728rem;\n}\n\nh2 {\n  font-size: 1.44rem;\n}\n\nh3 {\n  font-size: 1.2rem;\n}\n\np {\n  font-size: 1rem;\n}\n\ncode,\npre,\n.code {\n  font-family: \"Go Mono\", \"Source Code Pro\", monospace;\n}\n\npre {\n  background-color: var(--cool-gray-4);\n  overflow-x: auto;\n  padding: 0.625rem;\n  border-radius: 0.3em;\n  border: 0.0625rem solid var(--cool-gray);\n}\n\n.ln {\n  color: var(--slate);\n  display: inline-block;\n  text-align: right;\n  width: 6ch;\n  padding-right: 2ch;\n  user-select: none;\n}\n\n.hl {\n  background-color: var(--yellow);\n}\n\ntable {\n  border-collapse: collapse;\n  width: 100%;\n  margin: 1rem 0;\n}\n\ntd,\nth {\n  border-bottom: 1px solid var(--cool-gray);\n  padding: 0.75rem 0;\n  padding-right: 1rem;\n}\n\nth {\n  color: var(--slate);\n  text-align: left;\n}\n\ntable code {\n  color: var(--slate);\n}\n\ntable .numeric {\n  text-align: right;\n}\n\n.sep {\n  color: var(--slate);\n  font-weight: bold;\n}\n\nheader {\n  background: var(--black-1);\n  border: none;\n  margin: 0;\n  padding: 0;\n}\n\nheader nav {\n  margin: 0 auto;\n  padding: 0;\n  max-width: 72em;\n  display: flex;\n  justify-content: flex-end;\n}\n\nnav .logo {\n  display: block;\n  width: 5rem;\n  margin: 0;\n  padding: 0;\n}\n\nnav a.banner {\n  display: block;\n  color: white;\n  font-size: 1.44rem;\n  margin: auto;\n  margin-left: 1rem;\n  text-decoration: none;\n}\n\nnav .badge {\n  display: inline-block;\n  font-size: 0.8rem;\n  font-style: normal;\n  vertical-align: middle;\n  border-radius: 0.3rem;\n  padding: 0.2rem;\n  background-color: var(--fuchsia);\n}\n\nnav ul.menu {\n  display: flex;\n  justify-content: flex-end;\n  list-style: none;\n  margin: 0 6em;\n  padding: 0;\n}\n\nnav ul.menu li {\n  display: inline-flex;\n  flex: none;\n  margin: 0;\n  padding: 0;\n}\n\nnav ul.menu li a {\n  border-bottom: 5px solid transparent;\n  border-top: 5px solid transparent;\n  color: white;\n  display: inline-block;\n  margin: 0 15px;\n  padding: 20px 15px;\n  text-align: center;\n  text-decoration: none;\n  width: 100%;\n}\n\nnav ul.menu li a:hover {\n  border-top-color: var(--fuchsia);\n  background-color: var(--black-2);\n}\n\nmain {\n  margin: 0 auto;\n  max-width: 60em;\n}\n\ndetails summary {\n  cursor: pointer;\n}\n\n.note,\n.warn {\n  border-left-width: 5px;\n  border-left-style: solid;\n  padding: 1rem;\n}\n\n.note {\n  background-color: var(--light-blue-5);\n  border-left-color: var(--light-blue);\n}\n\n.warn {\n  background-color: var(--yellow-5);\n  border-left-color: var(--yellow);\n}\n\n.empty {\n  font-style: italic;\n  color: var(--slate-2);\n}\n\nfigure {\n  background-color: var(--cool-gray-4);\n  margin: 0;\n  padding: 1em;\n}\n\nfigure img {\n  margin-left: 1em;\n}\n\nfigure img:first-child {\n  margin-left: 0;\n}\n\nfigure figcaption {\n  color: var(--slate);\n  margin-top: 0.5em;\n}\n\ndiv.chart {\n  width: 100%;\n  height: 400px;\n}\n\ndl.meta div {\n  display: inline-block;\n  padding-right: 0.5rem;\n}\n\ndl.meta dt,\ndl.meta dd {\n  display: inline-block;\n  padding: 0;\n  margin: 0;\n}\n\ndl.meta div:not(:last-child)::after {\n  content: \"\\b7\";\n  padding-left: 0.6rem;\n}\n\ndl.meta dt::after {\n  content: \":\";\n  padding-right: 0.3rem;\n}\n\n.bignumber {\n  text-align: center;\n}\n\n.bignumber .number {\n  font-size: 10rem;\n  margin: 0.2em 0;\n}\n\n.bignumber .unit {\n  font-size: 5rem;\n  color: var(--slate);\n  margin-top: 0;\n}\n\ntable.properties td.key {\n  color: var(--slate);\n  white-space: nowrap;\n  font-weight: bold;\n  text-transform: lowercase;\n}\n\ntable.properties td.value {\n  overflow-wrap: anywhere;\n}\n\ntable.changes .numeric {\n  width: 5rem;\n}\n\ntable.changes .change {\n  font-weight: bold;\n}\n\ntable.changes .regression {\n  color: var(--fail);\n}\n\ntable.changes .improvement {\n  color: var(--pass);\n}\n\ntable.changes .unknown {\n  color: var(--warn);\n}\n\ntable.changes td.confirmation {\n  width: 6rem;\n}\n\ntable.changes .confirmation.unconfirmed {\n  color: var(--black-3);\n}\n\ntable.changes .confirmation.refuted {\n  color: var(--warn);\n  text-decoration: line-through;\n}\n\ntable.changes td.env {\n  width: 3rem;\n}\n\ntable.changes td.triage {\n  width: 8rem;\n}\n\ntable.changes .triage.untriaged {\n  color: var(--black-3);\n}\n\ntable.changes .triage.false_positive {\n  text-decoration: line-through;\n}\n\ntable.changes form.triage label {\n  display: block;\n  margin-top: 0.3rem;\n}\n\ntable.changes form.triage input,\ntable.changes form.triage select,\ntable.changes form.triage textarea {\n  width: 100%;\n}\n\ncode.env {\n  border-radius: 0.2rem;\n  font-size: 0.7rem;\n  padding: 0.2rem;\n}\n\ncode.env.amd64 {\n  color: white;\n  background-color: var(--black-2);\n}\n\ncode.env.arm64 {\n  color: white;\n  background-color: #0091bd;\n}\n"),
+	"static/img/closet.jpg":              []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x01\x00H\x00H\x00\x00\xff\xe1R\xc2Exif\x00\x00II*\x00\b\x00\x00\x00\v\x00\x0f\x01\x02\x00\a\x00\x00\x00\x92\x00\x00\x00\x10\x01\x02\x00\b\x00\x00\x00\x9a\x00\x00\x00\x12\x01\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x1a\x01\x05\x00\x01\x00\x00\x00\xa2\x00\x00\x00\x1b\x01\x05\x00\x01\x00\x00\x00\xaa\x00\x00\x00(\x01\x03\x00\x01\x00\x00\x00\x02\x00\x00\x001\x01\x02\x00\f\x00\x00\x00\xb2\x00\x00\x002\x01\x02\x00\x14\x00\x00\x00\xbe\x00\x00\x00\x13\x02\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00i\x87\x04\x00\x01\x00\x00\x00\xd2\x00\x00\x00%\x88\x04\x00\x01\x00\x00\x00\xbeQ\x00\x00\x00\x00\x00\x00Google\x00\x00Pixel 3\x00H\x00\x00\x00\x01\x00\x00\x00H\x00\x00\x00\x01\x00\x00\x00GIMP 2.8.22\x002020:05:08 19:45:07\x00&\x00\x9a\x82\x05\x00\x01\x00\x00\x00\xa0\x02\x00\x00\x9d\x82\x05\x00\x01\x00\x00\x00\xa8\x02\x00\x00\"\x88\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00'\x88\x03\x00\x01\x00\x00\x00\x10\x04\x00\x00\x00\x90\a\x00\x04\x00\x00\x000231\x03\x90\x02\x00\x14\x00\x00\x00\xb0\x02\x00\x00\x04\x90\x02\x00\x14\x00\x00\x00\xc4\x02\x00\x00\x01\x91\a\x00\x04\x00\x00\x00\x01\x02\x03\x00\x01\x92\n\x00\x01\x00\x00\x00\xd8\x02\x00\x00\x02\x92\x05\x00\x01\x00\x00\x00\xe0\x02\x00\x00\x03\x92\n\x00\x01\x00\x00\x00\xe8\x02\x00\x00\x04\x92\n\x00\x01\x00\x00\x00\xf0\x02\x00\x00\x05\x92\x05\x00\x01\x00\x00\x00\xf8\x02\x00\x00\x06\x92\x05\x00\x01\x00\x00\x00\x00\x03\x00\x00\a\x92\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\t\x92\x03\x00\x01\x00\x00\x00\x10\x00\x00\x00\n\x92\x05\x00\x01\x00\x00\x00\b\x03\x00\x00|\x92\a\x00oN\x00\x00\x10\x03\x00\x00\x90\x92\x02\x00\a\x00\x00\x00\x80Q\x00\x00\x91\x92\x02\x00\a\x00\x00\x00\x88Q\x00\x00\x92\x92\x02\x00\a\x00\x00\x00\x90Q\x00\x00\x00\xa0\a\x00\x04\x00\x00\x000100\x01\xa0\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa0\x04\x00\x01\x00\x00\x00\xc8\x01\x00\x00\x03\xa0\x04\x00\x01\x00\x00\x00V\x01\x00\x00\x05\xa0\x04\x00\x01\x00\x00\x00\xa0Q\x00\x00\x17\xa2\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\x01\xa3\a\x00\x01\x00\x00\x00\x01\x00\x00\x00\x01\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x03\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x04\xa4\x05\x00\x01\x00\x00\x00\x98Q\x00\x00\x05\xa4\x03\x00\x01\x00\x00\x00\x1b\x00\x00\x00\x06\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\b\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\t\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\n\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\f\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00{\x04\x01\x00@B\x0f\x00\xb4\x00\x00\x00d\x00\x00\x002020:04:10 21:28:40\x002020:04:10 21:28:40\x00\x87\x01\x00\x00d\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00\xea\xfe\xff\xffd\x00\x00\x00\x00\x00\x00\x00\x06\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00\xdd\x01\x00\x00\xe8\x03\x00\x00X\x11\x00\x00\xe8\x03\x00\x00HDRP\x02\xefd5m^p\x1e,\xea\xe3LW@\x88Q\xd7\xce5k\x05\xf3z\xa5?dU*\xbd\v\xa2ߐ\xb5\xb2\xf3r\xd7\xc1\x17\x14)\xff\xf6\x01\x97@y\xeef\xa1e\a\x92\xeb\xd3%a\xe8\a)\xa7\x05A\xfet\x99b \xcbZ+\x19\xb5\x1d\x91\x80*\x17d\x81\xb2/5e\x12\x7f?\f\x9c\xfa\xb0\x86F4\xfd\xe7\x9dX\xc3\xfe\x9c\xa0K\xb9jTim\xcd\xd2\xe0e\xa4\xa7#\x99\xc0:\x7f\xfc\xc7\x1c\xaf\xd1G\x1c\x82\xdfP\xf9\x04\x06\xe9X\xfe|\x90e\x9c1!\xfa\xc2\x13 \xc7\xf5\xe7\xa2WD\xfc\xa2F\x96\xcd3\x91K\xab\xa3\xc7\xdb\xf0\xb2D\x92\xda\xc7z\b\x9c\xcc\x18<d\\\xb0\x0f\x98\x1dQ]\xaf\xf2\x82\xe5\xc0\xe2\x9a\x11]\xf3C\x0e%\xa9\x9a\xbd\xb4\xa3\xe7\x11\xcbA=\xf7\xa4\xd1I\xc4\x03+P\xea\x92p\x98\b\x9fb*\xf4$&\xb6\xa6\xec\xadѩh\xe1\x9a\xf9%]>\xd6)\x88\x9f\xa1\x95\x91\x89\xdf\xfddiϊrMr\xbfG\x80\x98\xe5\xec廀/-\xc6[\x19\xc7t\t\xa9z\x8c\r\xbd)\b\xe9\x84\x7f\xd0\xf0\xd6\xf3\f\xc3\\\xed\xfb\x84\x89\xe0r5\x0e\xfa<\x1cO\xd9ԣ\xea\x18ݻ/\x92\x06\x85\xa2\xf1\b\xdcEސX~\xb0\xeb\x05\xc9Z\x82}\xfc\xa8 \xd3A\"h/\xfc\xc2)\x94\xcaJ4V\x99\x0e\x97s\xf6\x1e\xbe\x96\x7f\x8b\x1a\xa9V'M\xf1\x04\xa4ŽWA3\x91\x96;ޠ1\x13\xbe\x90\f3\xe4N\x05\xc0z!\xf7m\xc1D\xa8\xf7K\xe64\x90\xc4\xec\x80;~\x8bQ\xac\x8f\xfa\x8c\fs\xec5WZ\x7f)\xe7\xc8^\x9aؕ\xb0\a^\x95f\xdde\n]\xc0Ȩ\xf4T\x9d\xa5\x8a<\x16\x10 aÃ\xfd\xfb\xaa\xbc\xc2\xe7\xb0,\xad6\x97V9\xa0\x19\xd5Rı\xcf\xffA\xc4\xe8>U.\xce\xf7>\v7\xc4Ϩ\xc3 j\xe0\rF;E\x1f\x86\x90\xd2\xde\f\x82\xb2\xa1\\.\xf8\x96\x1e\x01\x04\xe1\x81I\xe7݊\x16\xba,\xd4\f\xcbT|\xb1\r\x8f8B\xeb\xd6.\x17d\x92:\x15\xfdRo^ɜ+\xbb\x8d\xa7\vy\xbd\xfa\xbct\xec/\xef\x11\xf0\x87\xa2\x1b\x10\x0f\xd8q\x1f\x82ֲ\x13\x91Q\x16\\I\x97\xfez\xa4\\\xe7:=\x8f\xc1+\xf5+\x87:/\x89yht\xf9\x9bT\xf4\xda=u\xe0\x9d\xf0\x82y!\xdf\xef\x16\xcb\xd8H\xb2\x1az\xc5\x1b\xfc\x9ez^%\x01Y\x8cϐ\xe7\x91\xd9\x00\xb4x5A\xe0\xb2\xf7\xb4\\\xa2\x89\x93\xbb\xf7D٧\x1c:\\w\x1f\xef\xe0\xcc\xc9\xc68\xd41\xb6\x97ME\x00\x84R\xfa?\x0e[w/\xbf\xca\xfa\xa5\x17\xcc\xf5!1C\x9a\xb3\xb0X\x9aA\r\x10\xacK\x17\xec\x1a\xfa\xf6~\x90\xf0\xdd\xce\xf2<K\xb76A\xe9\xccPl=B\xc1\xe9\xf3*@B\xecd\xa6d\xb4P\\\xf7\xf3@\xabd\x9e\xb5yS\xc6F\x11\x05h\x17\xa7߭ͻ\x93%\xd2\t\xa37Ev\xcc/I\xed\v\xb0\xf4\x94\xca5_$\xb6\x00:g\x17\xd1\xc4f\x8a\x063\x90\xb6<\xe5I\xf4G\x9f\xabAD\xa9\xa3z\x8e\x8a0A\x90x%\xaa\x97\xd6$\x05\xeaϦ\xecڜ<A\xf4\xf0\x17\xb4+\xa8Kq!\xffI\x97QJrzq&(\xef@\xfb\xd8ろ\x1a\x16\xa4\xec\xfeK\x80ǶP\x03g)1tnL\xc1\x17\x12Q\x1b\x86\xf8\xc5\xc8~\xbb\xc9\xf0\xcb\r\xb7Mq\xddXXPӑj_\xe2\xf8\x81\x81Cu0\xb8\n8\xdf!3\x80U\xa0\xed\xef\x18\xe0|\xe1\xe1/R_\xb2\xc2B\xf7\xdc/\xe1\xff-\xe3\x96\x14b\xbe\x19%\\\x86\x0e\xd3I\x81\xde\x1b\x91 vH\xc9T\x0f8\xc19\xe8\xca\xe2O\xb3\x8d7N\xf2l\xa7\xc0\xe9\xcb\"0\x80\x95߉\xec\a\xa4\x13ȣ\x93z\x80\xe8Q\x15\x98&\x12\x14\x82\x8fy\xfewd\xa4sos\xf1>\x95j}\x89\xfcDD\x0e\x8fZD7FWL\xd0\xe2\xf7\x0e\x15\x9cbҪ\xa9\x8c\x87\x10\xe67\x03g\xb4$L\x13\x96O\x8b\xa2\x89z'%\b\xcf0m_\xfe\x8fd${\x8cr\x0e\xde\xc4\xd9;&\x06\xe1j\xc0\x81\xb1\xb7\aPR\x8d\xb2p\xe3\xfauf\xdeB\x10\x0eʤݤ\xe8\x1c\xa0\x81\x86\x13]w:k\xa4\x88\x9a\xb8\xe2}N\xdctzA\xe8\r=\xe7B\xb8\xf6\xba\xf1u^-\x0f3lB\x87n/\x83P>˗b\x91o\xf1\x17\xbd\xf6\xae\xaaf\r\xae\\\xeb\xed\xa3\x14\xc6\xc6\xe1\x86\xf28\x82\x10\xc0Ln\xbf\x9a\xca5S\x00\x8eq\v\x8c\x01\x8e\xb7\xb2f\xda\xfaf\xec\t\x83\xc2\xd1\xdd\xf6\x86\xfb\xe0\xed{\xa2\x14\x17x\xb7\x06\xbfI\xfa\a\x06\x82T8\xbb\xee\xbb\xfa\x98\x19pwi\xdf\x0e\x1eri\xaa\f6\x04\xf5rA\xa7\t\xa9\x19\xeeW,\xafzYY\x05x\xf7\xd0\xdd\x1d\x99\xfc\x93\xa5e\x0f\x91s\x8f\xafy\xf99\x8f\x8es 3\xa6\xff\v\xfa\x1d\xbb\xb1x\x94l#mXF\xa7k\xe7{-c\xab\x177?ek\xa7\xd3@]#\xb1w\xe3\xfeV\x06\u0601g\x12D\x13\xdc\xe3\f\xc5#\x82\xc5\xfbp\xf8\r\x00\xe0\xf2\x9c@\xca\xd2v\x84\xdb\x05\xf1B\xf6\xf1H\xb1#)\r:䗦\xfe\xc5\\\x92\xabm\xeb\xd9\xe5\xd9;\x14\x8c\xdd\x05,a\x14g\x05\xb5k\xf0vh\xc4\xef\x13}k\xb9\x9b\x9c\xaebD(e\xde\xe6\x9a\xe6'\xe1\xdcFK\xdcз\xdc\xe5%\x02h\xb3\xd4G\xac\n\xf2\xed\xab\xb0\x14\x895\xffY[\xe6N\x9a\xa4\x04\x98\xf8\xafMr\xc9B\x9czF\x1e\xba\x98\x7f\xac\x7f\xe8\xff\xc4\x13\xdd\xceZ\x89k\x18\xd1^\xc2\x02\t\x19\x9e\xef\xbd~\xb2\xd7\xe9\x94\xce \"\xd4[\f\x02\x80\xdd/\x1fU\xbec\x11\xf47U\xf2_\x93\x86p8k\xe7\x0e\x9b\xfbDKH[\xab\xf6\xdb=\x8d\xa3\xb1\xdc@\xf49:|\x8f\x19X\x993\x8e\x88\xa6\xa7a{\x86(\x0e\xb1\xeej\x13\xd6j\xb5\x9c\xe9\xe3\xae\x05\xd6\x0eSc\x9dN\xb7\xb18\xba\xb0\xc9C2\xc3\xf6L*\r\x80\xfePV\xff\xe1\xdb\xd5f1\x1f\xe1h\xdb\xcf\\v\xcbjX\u0082&\x84\xc18\xe8\xf3'n\xd6\x105\xbe\x86-\xf3L\xc7\xd3ra\x82<\xb3\x8eMhd\xa6\x90 ز\x03ʥ[VJV\xd7+\x1c\x93S\xe9\x87\bo]\rn\xe0\xf3^\xa7m\x9eI\\\xd1/\xce\xfa(|\x899S\x89;\x8dbԄ\x93j$ \x92\xea,E\x1f\xa4\x92|ZuG\x02\xd9/\xe3%\xaf@y\x81\x90M\xbadA\x8a=\xee\xeak\x01?T\x18\xfe\xfbJţ?\xfa#\xad\x9c\x9f(\xba\x9eT\xe5)\xbd\xfc\xa5\x1a]\x04\\\x10\x06G\x9aǒ\x87\x01\x91\xea\x1c=\xc7\xfb)\xc8\x7f\x0f9qiDI\x986v\xe9-f8\\̈́\x80\xc8\x12\xf1\x02e\xf3H\"o\xb6\xe0t3\xfe\xc5w K\xb0\xd7\xf32X\x06Ƙ&\xf1\xecuUJKr,\x02N&N\xbf\x94:?\xce?a\r\xf1\xd0\xcd\x17\x93x\xa4\xd0\xfd{\x98\xdc\\8\x8bD\x8e\x80'0\x92h\x1a\xae\xcf\xe1\xfa\x86\xeb|\xe6¨\xb2\x97\x881\x0f\x02kSp\xb4\xe8\x13e\x1f\x93\xe7t\xc8\x1d=\xe5\xf8\xed\x95JR\xce\xd0I\b\xc5N\x8f\x12,\x13I\x98\xc2wM\x89J\x1b`\xb78\xbcFl\xe0\x12\x14;\x91O\\\x19\x9e\xab\xf8\x85\xa8n\xb5\xb6\x11\x82\xf6\x0f^ݲs°\xca\xe7\xf5\xd9\xce@\xb6\xcdR\x90'\xc9\"\x1el?1\x98L\xdf&~gZR\f\x19\xd4\x02\xa3yW\xe3\xc6c\x14]l\xd73h(\xefP_p\xb1\xc4}\x80$\x14\xc7\x1bpoA\x96\xce\"\t\x9f\xcbQ\xdb\x14F\xb5ێ7\xb2\x04\xea\xadlsf,\x1b\xb2\xec\x16\x9c̎R\x97܆Q\x9d\xa34ӷ\x90\x9ai1g7\xba2\\\xc2\xe3\x19B'n\xfe.\x8b\xd8\v\xdc\x1b\xd9#\x93;۞\xf6\xa0\n_\xaa\xfa\x9e\r\xff\x06R$x\xa7\xc3\x17;\xf20\xcbB\x1f3\xb97KN\xc2H\x1d\xa9f\xe3O\x8f^\xab\xf2Г֦6\xea7kqF\x9e^\xa2i\xe8`\xed2\xfe\x7f`2m~@?u{\x80\xa7\xa1\x93O%\xe4\xbf\x1c\xbb\b\xfd8\x80G1\xcf\aa\x80E\"\x9a\xcb\x03\xf3t\x9f\xac\xf3\xb9\x01o\xbb\xf4\xbc\x8f\xad\xfc\xbc\xb6NN\x9a\x92\x9f\"\xaa0\xe2/\x88\xe0\x1f\xc7W(M\xca\x19|\xe7f\x02\xd1\xe6\xe3\xd4\x01d\r\u009a\xbf\xf6\xaa\x8d;\r/n`F\xaa\x9c\xf8\xe9!\x0f\x0f{t\a\xcbÔ\xa9\x80.\x15|\xbe`\xb3\xe6\xbb\xedW\xf5\f\xd2w\xc9/¸\x1e\xbd\xdej\xca1\xf3\x12\x89\xde\x1d-\xa4\xb7\x1a\xc8\\Y\xe9\x1d\x97\vR\xd0{\x9bd\x1f\x8c\xbd\x9aܙgb\x15\xc8{\xdeS0\xa1J\xe2E*\xc6Gu\xce\x7fNB\xff(\xed\xcf\xcc\x0f\x93\x10\xe5#A\x80\f5C\x06\xa5S]W\xbf\xa5\x04\xdfh\x1f\x04\x17\x05]*\xaadv\xc3V\xd3\xe3\xf4\x15\xcfC!\xd7\xf2>\xf0S}\xb5& ϋ\xffd\xe3\xe0\xefV\xc5>\x1d\x00\xc1\x17\xfe\xa0\xed\xab\x06R\xd6\xe8eQ\v\xd2n蓓O\xfcT\x0eYE\xa369\xcd\x12\xb1\xaf\xec\xfa\xde\xc1\x8b\x03\xa0\x8fI\xd1H\x9d\xe8\xbb\xdaBi\x80\t5u\x1f\xdeE\x82R\xfb\xfb\x97\xbd\x9a!_e\xb1;ćJ\x02\xbf\x98\x88\xd0\xe3\xdf~\x05\x95\x87\x15\v\x98\xd8Hj\x18sa4:\xcdƼ٪/\xc8\rM\xa7\xbft\x05\xb9\xacg\xfe\\\xba~\xfd\xc1,\xaegR93\x90T\xc4\x01Գ\"\x16\x1e\x97=W\xa2r\xe6]\xb4C\xe5_\x82\x11\xf4\xcdKV\x94\xad\vт\x11x\xfd\x84\xdd\xf0\xba\xc6+Qُ\v\xb7\x1f\xf1\xbe\x8e\xc1\v\x1b\xab\x0e\xbc\x93=.P\x8d\x19\xb14\n9\xbfcH\x19U\xf0\xe6\x1c34\xfb\x83\xd3\xccb+|\xbcR\x15,Os\xe3\x86M\x1cD\x97\xa6\x00\xb65]`\x94\x19k^?\x04؞\xf9\xa3\xee\xe7\x06s\n58\x1dT\x06\t\xc7H\x8d(\x1a' \xefӻ\xd1\f\xfc\xe5ac\xa0:-c{\xf1z\xb7\xf6|\xcfAH\x93؊\x1d\xab\xed\x88\xf9P\x9dc\xa3!\x9f\x1e\x00\xd3\xe4\xe1\x1c\xc1Z\x92t$HZ\xeb\x03j\xc0ǢR\x9aK\x1e\xd9K\xea.\x03\x11~\xb1\xf1빿\"\xce\x10\x19\xa1Ӣb\x05\x90\xf5\xb7\xe0\xf4\xfc+\xd3\xe6\xf2\xef\xe4\x00\xe3\x17\\\xb3τP\xc1rQ\xfbL_\xef']\x8f5\xc2N2ߗ\xc8\xf0\x1et\xdd:\xc98D\xc2\xc2k\xe8q`wr\x12\x19\x17o\x8f\x81\xcbs\xa2:m㚯\xfeHY\xa2\xef\xea\vw\x9e@\xd0F\xfb\x93\xd9_k\xa1T\x8bw)\xeav\x87\xf2\x0fY\xe10\xf9ϛ\xa4B\x16<<\xc7]\xf0Ƞ\xf4#\x05\xa5\a\x94\xf6>\x8b\xf9\x81-,%(Y\xc1`s\xd9pw\x88\xe0\xe4ӭ'\xe0#~?\x9dԷ\xec\xe6\xdb>\xbe\xcc\xe5\xa0\aҜ\xf6\xbb,IĹ\xfa\x95\xe9\xbf\xcc\xf6\xca-h\x87m~\\׳\x89\xe7\xb50D\xe7 !ЮS\x8e\x90\x00^N~;\x9b\xb5\x8d\an\xe5iŻ\x81P\xb4\xb8\x1e\xe9/\xb4\xbc\xe3V\xefq\xb3\xa5D\xff%Z\xc4\x15\xa0\xa2\xe2V\ue94f\x147\x87\xaa\xe6(\xd0\xf1\xeag=\x81\xa1:|ڲ\x02\b\xbd\xe3\xb6k\x02s\xc0O\xb0\xf3\xde\xd6\x15\x16\xe5\xea\x1cysV/\xfe(\xc4.\x95\x8d\xfa\xdf\x0ff\xb1\t\xd0\xf31\x1b5\xd4$1\x04x\x87\xb6v\xda\xf8\xa4q\x1a\x1f\x1a\xd3\x1d$\x06\x98c\xfd\xa1\xe5\x11\xb1\xb3\xca\xf6X\xac\xe4\xc6@\x14\x1f9\xfe\x04\xf6\xab)\xe2\xeb\xba\xc7\x1al\xa9X;\xe0\xaf\xedic]3\x81p.\x9c\xed\xca\x04\x04P\xb3!\xc7\xf8\xe0I\xd4\xeeJ\xbc\xf9:N\xeb\xf0\x8f\xfdq\xcb\xed\x94\xe3\x1f\xff9d\xa4%\xe8\xcaAP\x00\x0e\xc9\vh\xc20\nQ\xb3z\xd1Ba\xf4\xe2\xed\x95j`\xed\xa82'n'\xc1\xc3n#l\xb2\xd7\x1cB\x95\xeb\xfdM\x8c\xc6t\xafֳyH6\x12\x93\x1f\xc7\xefB*_aR\x81-\xa0\r)\xf9\xf9\xb1ӽJQ,Y\xbf\x16\x1f\xe0\x93\xb1\x1a\x155\xf1\xaa\v\x920\xcc!\xfe`ٸ\xa6n\xa8\xdb\x17%\x83\x87\x8ep4\xfbe\x031Ͻ\xe0\x9al^\xa1\x99.A\x96O;\xff\xb3\x19ف\x9fú\xbb\xd1o\xb2\x90\x1c\x8e\xce\a\xf9g\\q\xdf\xcd]7\xed\xbc\xe7\xce\x13x.\r\x02AEb\x1cU\x10t\xe4\xcb\xf1G\v3cU\xa5{\xe1t\xce\xd81h\x93f\xed\xe7\xe5\xb9e\t\v\xa6\x04.\xd5/\xe1\xe9'\x8b\xc9\xf5\xf9ǜ\xfcQ\xe9\xda\xc1\x8a;`:\xdci\x01\xfe=\xfe\xfd\x92\xa1\xf7f\x14nt*\x92\xc68\x93'\xa4\x06\x82\xa2wd\x1e5<\xbe\xfe\x03\xfaŝ#FT\x12zos\xb3\xcfP̭w\xbfG\x18\x12\x89\x83\x04\xe5\xdb-\x05\xc3Ql\x13\xe4\xdbQ\x92\x8b\xb0\xcd\xd8\uf6af?ә\xe4]JDa\x96@\xc1\xda\xfd\x83j\x1fx\xa7\xab\x9d\x15{\xa9\x1e\xaf'\xb4\x01%@M\a\xf6M\xb2\xab\x88҉\xfc\x8f\xff{\x06^\xb3\xae\xa8\xac\x95\xa5\x03\xbe\xa4\xee 涯|\xad\x92\xdaSV5\xc5\x17?~\xed\xa0O\x89\xbc\x9c\xab\xee\xc3\xe2\xec2Q\xe0\x1b\x7f\x131Cc\xb2\xa3\x03\x18\xf8\x9cWr<#\xed\x8dW\xbf6I\xf9j4\x93\x94\x8a\xab\x03+\xb3\xb7\x16\xe9]\xf8l\x7f\xd0Sv\x90\xfb\b\xaf3\xf0\x04\x10-\xa5\x98\x06\xac\xe7\xcd\xf6\x80\x8d\xd8{\xf2\xdd\xf5\xeb\xd5\xe4(\xba\xb6d\x8c\xb29z\x8deҜ\xa6+\xa03\xf1\xba\xc3\xfa\xcfD\xfa\xba\xdd\x1f/\x9b\x874\x93\xf78=\xbdZRs\xe7\x04\xb8Xnε\xdd\x19e5\x81\x9e\xac\x7f\xacV\t8z\xab\x1cT \x99SM\xe6\x1e9\x05\x1d\x9fn\xee\xd9\xe5{\xbeo\r\xfd\xd6Ьq\x00\x95nz\xa9SaȔ\xf5\xd9c\xae\xd4\xdb\xfdl\xea\x8f_U\x00\xa0\xa7\x88\xc8c\xa0\xba\x97`\xbe\xae\xa5Ș\x01\x1f\x14lď\x19?i_\x8b*\xd0e\x94\x86\r\x0e\xcd\xd3\x00\xe0\x99~\xd3fB\x12\xba}\xefH\x9d\xdd\x1c\xac\x04\t\x8d\x96p3\x10\x02\xa58|\x86,e\xf8\x1c\xe1r@\xcbSVgz5!\u05ca\xccPGa\x90a/\xe9\xc6E\xdfzs\x96\xf0\x19CKف\x9d\x9b\x956\x94\xa3\x91\x11T\xf3\x856ZR\xf4F\xebn\x15\xfb\xc0Ɵ:CP\xed\xdbC)pay\xb6#\xb2\xe1\x8eP\nU\x05I!M\xe0\a\xb6\xd6!\x91\xdex\xect\x1b\xf4\xe3u\xf6\xb9\xa4V(\x05\x83Kjʻ\t\xe3߸\x7f\xb7\x1a\xa0(\x90Օ\xf5z\xa0Z\xe50\xb2J\x8b \xb3\x1c\x13咃\xf7\bCz\x02[\x1d\x01w\xb6\xb1\x8dX\\\x81\x90\xff\xf1\xf5]ըu\x0ed>\x01r\x1b\xb6\xb7\xbfy\x9b\xc8\xfa\x00\x14\x94\xaa\xb8&\xeaK\x9a\x9eh\xa7\x95z=WLF*\x1eڳ\xc6_hk+,\x9c\xa2C߁\x8ao\xae\x1a\xfe\xa5\x12\x1e\xcdmĶSi\xb6փ\xa4R\tBJ\xf16\x88b\xe3\xb9Y\x1d6B\xdd\x14\xe5h\x88F\xa7\x04\xbe\xf7\xf0\xf5\x1d&{\xae\x94u\xc0\xfb\x11\rR\x97{\v\xee\x1f\tmf\x96r'\xb4\xe8\x10\ah\xa5\xa4\xf4\xf7\x12\xff\xf0\xfe\x98\xeaړ\x9ax\x1fr\x95\x03\xbc-E_\nu\x04\x8f\xc1\xe2\x03\xb4\xf6\xeb\x1f{|\x7f\x9d\x97\xcf\xfd\x15\xbd(\xd7*xz3\xb6\xad\xe8\xdcP\xb1\x93\xd9\xc0\xdc|\xfb\xb4\xf2B\xe6\x89x\x14Y>\x00\xaf\xff\x1e\x06j\xa9i\x8f7ܪ\x99\x19ļ\x91Y^\xb5:~H\xd3\x02\xab$\x81\xb2\x95\xa6\x0e*(\xf1\x9d\xe9\xa4\x11\x8b\xa1co\x8ba\x9b\x06ɘ\x87'\xff\x1d\xe3,\x85\"\xb6\x06\x14hw\x95\xf6n\x89\"\x84\x98\xdaT\x88a|\xca,\x1d\xc8.\x1a\x11\x04\xc3%v\xd9{q\x8f\xd9t_\xd2\x05\xcc*\x89\x10\xec`\x87\xb40\\ \nu\xa9\xae\xff\xf6\xb3b\xc7U\xe9\x99\fR\xe2Iw\b\xbd\xf0\x01\xa9\x176\xfd\x8d5%\x9d\x00\xa0\xe9\xbew\xa5\x9b\x8d\f'\f\xb0\f\xbf\xa2\xf2\xb2\xb0a,\xfa\xc2%i\xaf~if\x9b5\xbf-V/1\xf5Q\xcbt(ѤY\bI\fҴ%\xbb*\x9c\v\x9d\x96-\x03D(\x96#\xd8\xe4z\x8b\xc6L\x88\x1c\x8d\f\xe7\xd6I\bb\b\xaax\xe1m\xc41\xe7E}&'\xa1\x14\xedV\x8c\x0e\x98\xbbS\x05\xd9\x1f\x89\xcdF\xe2\xe5\xa3-H\xf1\xc6V\x8cx^(\xeb\x12+ \xaa\xa7\x1fp\x85\xda\xdc9\x04\xb8S\r\x9a(\xc4\xf6\x02\xb7C&ћ\x9c\x1dY\x84\x8b\x1d\xd38\xc5\x00\x98/\x0f\xce\xe2*\xac\xdd\xea\xc3\xedK\x9a\xf3XĄ\xd3Q:\x02\xbfe,\v##\x8c`>9\x17\x1e\xb5\xa9xM\x8e&i\xc8m&\v\xf1vP\fɢ~\xa7\x1d\x89\x9cM\xd8(\r\x02\xfb<N,\xa8\xbe\xd8\xfd\xfa*}Ȑ\x81\xd9u`\x1b\x8e\x8fn\xdf\t}\xd5E,,\xd8G\x1c贼=\xfdO\x9co\xb3\xec\x99\\\xee\t\xc2<\x8cel\xe9\x0f'ASq\x0eBD\xa1H\xad\xe257)\xed}+8\xb6\xfa\xb9\x00\xa5\xed\xac*a\xc7\x01\x871\xac.x\x97\xed\xc2<\xb2\xeee\xf7qtj\x1djB\a\xc0\xd0\xdd@\xd8?M\xbc\xa6\xa4\x98'[a\x85\x9e\x02\xce\xe2\x00=\x8d\xce\xdb(\x91\x94\x80\xef/\xf3\xc4_\x89u\xeb5\xf7b\xbfC\xa6\x11O\x9aQ\x8fy\xcft\xc9ݯ\xfa\ad\x8dI\al\x8a\x19ns\xca\x0e\x88n\x83\f\xff\x18c,\x8a\x954\x18\xbaS\x88\n\xe4\x1dڞ{\xb9-7\x19\xd7ƺ/\xab\r\xb3:9\xf7zڢr_l\xa5R\x14\x93\xbf\xd4/\xa3\x1ea\xea\x8e\xdc\xdet\xba~y\xb2\xee\xb7;\x1e%\x10\x8e)\xd1\x12\x93$>\x8f\xc3\xc1V\x00-\xc4뱱\xb5\x9b\xce\xcau\xb9\xc4\x19\xb4\xb5YD\x13\xdel\xe7r\x1d\xe7\x15؝\x14\xea'}4\xcb'M]+p\xea>\x10\xacP\xf2\xa5\x05\xa0\xa9\x8cReq\x05\xeb\xe8\xeb\xa7\xc9f\xbez\x14\xcf\x16\xe1\xe2K\xac\x1f\x95WsV^\xd4b\xaf6p\x81\xd4}j\x11\xfdK\xf5\xd6ڞ\xb9\u05eb\xb5\xa6 \u008aGJ\xa3uTL\xe4\xd2\x10\xe7墧\x9d&\x1b\xb3ϵt4\xa1\xab\x8c\xdd\x06\x06\x16\xcc\xc0@\xb8\x1e\xb95\\\xa5\xc0-\xba!\xfb\xca\xee\xf4F\x9d\x17u(n\x94v\xb1g\xd3\xedW+~\xf2+\xf5Q\xe8쯸7\x165\xfe'\x13\xb3\x01PR\xf7\x127\xacX\x84\xe0W\xa0\r`q\xc3\x03\x80=B\xaa\xe9F\xe0Y\xa6\x98*\xe8櫘\xd2\xe2Al,\xbc\xa9Wv~\xddٺY!\xa4\x0e\xacN\x88\x1b,Eh\xc5\a\n\xdd`\xb5X\x00\xd0`\xbfMVS)W_\x7f\xbe\xb3\x89#\xf1vBj\x9f\x96=\xf4\xc6`\xf2\f\xfa\x82\xdc\"g\xadf\xf8\v*\x9b\xe4Q\xfb\xf2\xa0$\xb3S\b\\|\xae'\x8f\x8c\x9e\x1a\xbe\xf8d>\x852\xb1\x83\x04\xc8{\x9e\x86\xbd\xb3%\x80\xa81\x1c\x87lĀ\x0e̾\xc1\xffc\xa8\x19\x86j\xedQT\n\xef\xad\xcb\bnnE\xf1\xbf\x9e\xf2\x837\x9e\xe5\x1bm\xd5*S\xb1\xf087\xb5\xed\xc3\xe7{\x8e\xbd\xb0\x99\xd6h\xedh\xf8\xc2\xdb\xf3\x8b剫Гn\nc8\xccP\xec5\xe8\xea-\xdb\xddU/\xff\x8eB\xc7\xe7\xf7\x06\x80\xbe\x0fE\x91\x04\xces\vF\xed\"w۴亍\a\x9ed\x84.N\x1e\xb6\vnSL֏\x12\xceh\x9f\x97[ر\xc1\xbb\xd5]D\xe8\x81c2\v\xc1֡>b\xb6w0\x89\xe3\x80ˮ\xc4{\xd1\xe8\xe8N\xf1wB1m^ \x12z\x96ٜ\xeb\xdcG@\xb8m+S\xf9Q\xbb\\\n\xd0~\xa3v)\x83&\x87$\xb1QS\x92\x16ղ\x1cbl\x1f\xea\xc4\aR\x96\xf4\xaaׅ\x11\xdf8#EbK\a\xa4\tfkA\xf4:b\xc3\xdf\xf3\xa2S\xf7(^\xa9\xfe\x83\xc1\xd1H5IZ\xeeē\xf3\xb5\x9a\xed \xb1\x91\x94I.\xbeE\xa4ƽ\xb0\"aR\x0e\u0383|\xb5\xbeߧ\tL\x1c\xd8\r\x95\x19ʰ\xf2Rl0\xd8\x16\xfd5\x15\xa1\xecJ\x12\x7fA\x11&\xbdoy1\x82\xc3h\x1d\xf0\b\x0f\xb6\xdaf͠9\x1e\xe4\xce+\xa14\xb5\xf4Oh\t\x10\xc7y3\xdbX\xcbEV$\v\x03R7\xac\xc6\xefV\xedׁ\xcc\n\x91\b\xceI\x12.\xe0\x81\xff}\xd3C2\x04\xbd^\x195\x82_\xaeN\xffL\xae\x84\x88e\x8e\xa9\xe6\x81Y5F\xa8 \x16\xd1\xc7\x1c\xf3;Ɯ\x96\x81 ,\x88̉\xe7\vJ\x1f}\xbf~D\x91\x10\x02\xc7\xed\x1e\xa7\xb8#\b]\xb5\xe9\xf4\x0f\xc1u\x1d<`\xbb\xe9\xd8)RF\xe3Q\x1a\xdec\xfc\xb1\x19R\xf4\x86\x97\xf0\xeb\xc4\xef\xd1\x10\xb2x\x90\xfau٢|\xa0\x1f-\xc1O\v\xd9h\xe7\xfdd\x95\x05\xbb\x8a\x02S\x86¡-E\xe6ܘoH_\xe3\xd5e\x92>\xd1T}Wt\x01\x87ye\x86\xf1<\xee>\x8b\xe0Q]\xc9nR7ًQ\xfez\xad*\xc9\xf4\x7f\xc8\xf7\x16\xb7\xe8\xb6j\x1b\x0f)\x91\x97\x83\xae_d\x8eN\u05cb\x8b\xfb\xc1L\xd4\x10\xf9/ny\x8ek\xac 5\xefq\xf9,\xebkU\xe8\xdd\xf5\x17G^-\x1a\x90l+\x15\x92\x02\xc0\xa1.\xe9\x02%\x13\xbd\xf1\xe1\x1a\x02\xff\x05\x9c\xeb77\x9cmu\xed\xc0\xea\xa0Ux\x9f\x93\xf9\xc2b\xa5\x8fք\n\x9b\xdak\xed\xd8\xd8.\t;\x81\xd3\xe9\x8c\xec\xfe\xd4'\x8c侹\x1a\xe7D\xf1$MN\xb9eN\x1cGv@rʚ\xa0\xd8%\xb3uo\x8a\xf9\xe2\xcd^\xa3\xefx\xaf\xfeJ\xb1\x8b\a\xfd\xcb-\x1c_\x1a\xf1\xf9\x9c\x8f\x01W\xd7ˡ\x10Q\xe5EAa\x92\xe5\x1cXJ\xa50t\xd7:\x10\xda\x17\x9f\x03\xce7S\xf8\xa3M\xbc\xcc5)H\x8b\v\x80Um3\xd4\xf2\x8dp\xf2\x80\x85w]Aݚ\tt\xf1\x97?nbZ5\x0e\xbb\x1a\xa69YAP\x0e\xa8\xcf\xd0ڥ\x1fw\xb9\x14\xed?䰵\xf2\x03\x8c\xad\x99\xdc\xda\xe7\x00<\xb6\xbd\xf3\vP\xbc@\x1d\xc0\xe3\xc8!\xadd/T\"\xc28O\t\xbf\xa4\xe7?%\x9a\xf3\xf6GBo\xfa\xf3!\xb0Z\xa1\xba\x90vf\xb2\xebp\x99r!\x19U&!h\xb4\xafU\x1awcV\x89\x1a\xf1\xddau\x1e\xdea5gbO\x01\t\xf6\n\xb7z\xc1B\x1e\x95\x95y\xc6y\xe2GQ\x1e\xb5^U\xa8\xb8M~:\x17&\x8a\xfdP\xc8X;a\xb2\x80\xbe\t\xe2\x18\x01\xb8\xb2\xed\xa7z\xea\f*\x10k*L\n1\x06\xd8!\x88\xe0\x04H3\xe4Ϫ\x1c\x88Y\xa5\x83y\tW\xcd#8\x97BpQ\x8bJ\xa9\xebA\x9d!\xb9\u07b7\x93\x8eG\xda\x05\xb2~i\xed\xfbP\xe9\xbeP\xe4\x1e\x86\xfc:\x11\x8e\xca\xf4\xabCv\x84\xfa\xd61$#\x85\xd8g\xae\xc0|\xad\xd1\xf7\xd7\x1e%\x1f\xbc\xd5\xe1\xdba\x1e\x04\xca\x04j\xa8\xa2\t{\x8f\v\x93\xe7~lp7\xd7\x1f\x1d\xfa\xc1\xbf\xef\xbeY\x0e\xb0\xe9?\xe0\xe8\xafh1\v\xe1-\xef*M'\x94@\x9aO\xba;\x00\xc5p\xcb\xd5\xcd[\xa5\xe2\xc6!\xcbnr\xf0Eo_\xb0^\x9b\xb9\x98\xa2\x91\xb3\x8a\x9b\x03\xbaS\x15m\x82[\x0e\xf0\x06{\xef\x19d\x91%\r\xf02+\x85D\x1c\xec\x7f5w\xd7\xd0EZL\xf8\x1eS\xd0\x1c\x0eBir\x8b\xae\xf3\xb7:x+\xeb\x9e˂\x05\x84\xaf\xbal\xa7x\x7f\xd6\xc8\U001097c2x\xe8\x9b\xef(\x19\x10\x19\x85\x93:L\x8ee\xf8GV\xb6wL\x87\u05ed\xa4\x15\x94M\x02\x119Q\x16\xbdr\xccԖ҅~(x\xa0\x1a\x80\xd5T\xbd\x00B\v\x13veƸ}<\x1e\x93[/-;\x02\xd1|lX\xea\x86p\x05\x98\xe0y\x99Qr§:pl#\x9a\xff{\xdfl\v\xba\xb3A3\xfeF\x85\xf8\x17\xca\xd3\x17\xfe\x1e\x90\xf2x\x15\xe9\xd2,\xa0\xa9(\x81\xcfh\x10;a\xa0Y\xb1)E\x0fw\xb1%ֻ&\xc4v\xf2`\xd0\xe1\x1f\x01ld+\xf5\xa3\xcc\xf1\xcdb\"\xf5dNb\a\xe9\x8fo'\xbaWB\x87y\x97\xf8s\xccc\x93S!\xa0\xf8[h\x06\x8d\xa5ܵh\xb37%\x85\xf7\xceZQ\xe4\xa4OW\xe3\xf8\xf5l\x19\x04\xed\xbc\x7f\x88F\xcb\xd1N\xa3\x90\xc2c\x1d\xc0w\x9d\x1f\x9a\xb98l\xbb.\nnJi>\xd5\x01\xe1\"T\xf51\x12gT\x9e\x03\xc7l\x02\xf0\xe5\xf8\x96b\xb1\x8c\xfd[\x84\ax\xd0\b\xbbb\x86\xb4\xcft\xdbN\x9e\xa7\xd7-R\x15\x80\xb7)\x00\x95\xae\x8c\xff\x17\xcej\x9f\a \xfe\x96\xab\x13\x82YR\x99e\x0e\x82\x9ca\b!,{&\x9c\xf2\xf8\a\xb5\xa9%\vS\xfd\n=A\x7f\x91\x81g7s\xe8.\xf8b\x10!T\x7f˓*JN\x18\x9aj\xb6\xf2\xc2\x11D\x0e\xba\xa6\xb9l\xb4\f\xcfi孂z\xd9\xc8\xd4}ⷩ\x8a\xbb\xcenz\xf7{a\x1c\xa4OÆL\x06Z\xac./\x93V\xcd\xfd\x88\xa3DƩ\xba\x02\xce\xf3\x80_o,zf\\\xa5k\x8e\xf8\xeaߊھ\xcf\xcag\xff\xa4\xca\xd2̅7\x00x qK\xb4\xacَ\xa9\x15\v\xb5\x12\xbd:\xedu\x97\xfd;\x955\xda\x7fQ{\xf7\xe0O5\xc9~\xdb\xc2?\x8d#\xc0!\x89K\x97\xf0k\xaf̂\xa9\xc8h\xa9\xe9\xf5(\xd2\x15\f\x02\xb6d\x00$\xfb\xe6\xc9\\\xd8iDY\x90\x89\x19b\x8e\x8d\xe1$|\xe4K\xf8^Vٻ\xe4\fnK\x93u\xbf\xad\xb7\a\xfc6\xe5Ι\xee\xe7;=\x94\x02\xc9\a\xb9\xa9\x18-\xefԛ>al\xed\xc6 \r\xdb\xda\x19{,\xa1\xf6\x19\x05\x1c\x04\xba2b?Z4\xc7xR)\xe4!\x12\xf4\xb5?9*\xb0\xa11ZUR\xb0\x124\xb4u\xb1\xc8\xfc\x89ˋu\xa1\xe4`\xc3\x01\xa6\xc6\f̻\x9e\x1ae \x8b%\xc0o\xb2\xf9w\xe3\xdb:UB\xa3h;1)\xe7\xf2\xa5v>\x1cD\xf4\xabM\x06^~\x84i\x8f\x99i\x88\x0e\xb5\x1cV\x98D!\xe6\xcaN\a\x8b\xd3)\xfd3&$\x16\x92Z\xa6\x10@\xebh6V\x1cX\xd7\xc63\xae\xcd\xd4h\xf9\xd7\xe3\xa8r\xc1\x13\x1e\xe2\x1b5\xfd}\x14\xf6\xcfV\xaa:\xeb\xbf`\x16\xb0䔬\xee\x96o\xa9]ֆ?\x0ew4\xe8\xc2v\xd3)u\xff;O\xe7X\x912ƎZ\x9c]\xae\xee\xb7t\x81\xbd\x97\xb0\xa6Y\xa9x>\x00\x8d|\xe4\xd1\a\xb0\xf5\x8c\x9a\x93\xd63\xad\xd4\x04\f}\be\x1b\xfb\xad\x1a섲\xb5\xbcY\xc6\xd6O\xf9\x83\x17@\xcaS3kM܅/.\xb1\xd6s\x18 \xb7=\xfa\x00\xb1\x8b\xe7/\xac\x8cn\xf4\xc2W#%%J\x93\xc0`E\xb4n\r\xb7iY\f\xbb8U\x1e۔6yD\xd9\xe6G\xf4\xa7\x0f\x99pbn\x89\xb9\xf2>&E]\x90\x03Tf\x88\x14\n3%\x93\xa4\a:`\x9c\x9aA\x95\xc8\x12-o\xf7_\xd13\xdb\"\xea\xf1b\xcc\xc4x\x00zD\xc3\xe5\x15\x82\x92C}\x91*\x87\xb9}\xf0\x8bu\xe3\x0eO\xbd\xc0|\xa6\x1e^\xd7\xc22\x15\x84\xff\x04\xbb\x8e\xae.\v\x81\x89\xd3L\xb9GU5w\xb9c\x19\xb6\x14\xa4\xebB\x82\x95\xe3[Zdg3\xb9|\xb0!\xab\x0e\xbc\x14Xb\r\x91\xeaC\x1a|\xa35\xcdp\xeaX\x1b\xea^@P\f\xab\x14N\xf7\xe7\x10\xd5I\x84;\x04^qk\xe2j\xc4k\xb9\xaf\x11\xefn\x94VΠ\x8a\xeaۑfo\xc0\xee܄+\xd4jS\x91\xa5p\xed\xcf\x02F\x03˱\xbd\xdaڏ1\xfd!\xfd#\xc0\xbc \t)\xa18\x933^͙f\xd0\xe9\xf2dtX\xa9y\x1b\x83\xb2\xe4\xd4\xde\x1d\xa2\xd9\a0\xf6\x9f\x11\xb4\xe7<\x8eT\xed=\xff\xb1c<\xbbX\xab\xf1\xa2,\xc9\xc4\xe3\x8f\xe7Ƀ\xfc6ܦo\xaaK\xdf{\xfc\xb2\xa3\x82\x12\x99\b\xd2ث\x18\xe9\xea\x11\xa67\xeaP\xadʆ\xa30\x98\xb4ɭ\xc4`TԲ\xf1ߕw\xcb\f\x17dM_\xe1\xa2x\x8bS\x11\xe0\x96Z(\xa4\xa2\xd5\x01\xd5\xf4-Q\xa6\xd4\x01C\xbe\xf2\xc9\x19\x8e\x85\xdc\xfb\x1bi\x1ap\xaeÌ%\xee\xe8\xbfӦ\xf5\x06q\x9f\xebTTE\xf6\x17\xbf\x88\x1f\xc3|\ay\xfe:,uٿ\xc1\u0557\x9f\x9b\x0e\xc6\xce\xeb\x8da]riiD\x94҅\x82\xfc\xefL\x04\xa3\x1e\xb7Q\xe1\xf1\xe8\xbb\xdbպ\v\xca\x17\xcc\xeb\x1cD\a\x13\xfe\x18\x00\xfb7\xfa!\xef\x1f\x06\aC\xae\f\x1b\x87\xbc\b\x15\x84\xf2ϟK\x94}\xf6ј\x15\xf6\xeft5\x9f\xa24R\x97\xdf\xf1\x88\x1b\xc9LU\xc0\xf6\x92l\x84\"hb\x82G\xdf^E\xbf\xae\xc8\x05&0\x8d\xb8X\xbf\x9a\xc7\x00\xb9\xfe~c\x0e\xcd\xdf$\x1a\x81\xb0$Է\x16\x94\x91\xa4qb\x0fe%(\x1c\xb5h\xd4U\x8d|\xf4\xd2\xe3\x14W\x13\xb3\xa3(8\xa4x\xca\xd6y\xec\x0523\x8a`b\x0e\xc0 \xe4\x14\x14\x0f\x90U\xa3\xab\xfc\x9c.\xc4(\x84\xe6[\x1d\xa2\x8e\x9c|w0\xae%\xb7\xa1a\xec\xe4s\x9b3\x13bPfI8\x88r\x97\x96g̹D\xbe\xde\x1b\xac5\xcf\xe6\x8en\xc2\xd6\xed\xea\xc5ŝ\x93\xe6v\xc8A\x8f\x889\xfa\x9f{\x16l\xf9\x8f\x91]֖6\xb2\xef.\xa6ʕ\x9b͟\x88v\xba\xd1a\xfa3\xb7~\x8doP\xc3v\xe5ۖ\xfd\xe7\xfd\xaf\xf63s\xb7\x91({\xe7\x1c\xb1M\x83I\xc4rS\xbfw\n\x8d\x17\xb0\x90\x9e\xd9\xd26\xbc\xe1\xf4@.\xed\x1d]=\xf6\xa5Љ\xf5\x99\xb2\xf7\xe6\xc11R\xa0\xbd\xba\x824\xdd\x05X\xcdM\x84\x8at3\xbf\x94\v\x98\xf1\xd4\x1ajx\x02Co\xee\x01=W`\x82'\xe2N\x02 P\x97\xa1\x1cW\xffՔ\xb1\xc9\xed\x00P\x92\x97\xe6T+\x8et\xecm\x95U\x8b\xe3\xfe\xbf\xe6\xd1\x1e\xae\xd7s\xca\xdb>\xa1\xb3©\xd0-\x14-\x11\xd8j\xc7\xce\rd\xf9\xac/\x84ĵ\xc3t\xfe\xfa\x9d\xbb`\xa5\x9b'\xe1O\x99`B\xaa\xaa韏\x16\xc3+p;bW\x98M\xbfH\xb7\x80\xfb\xcer\xc0\x16\xbd\xee\x86\xe4Ms\xc8\\#\xf7d%χs\xf5\x9c\x96\x948\xcb\xfb\xd6\\3\x11\x85rB\x870&\xceu\x10\x99\x1c\xb0\x1e\xb81\xa2\x12M\xf58\xf21YS\xa7H\xacpk\x1e:T6\xc4٢X\xf6\x8d\xed\x14\xb0\x06y\xcc\x1ds|iO\xa4\xcd\xd4\xc0)R\xa1\xf2m\xb4\xb7O\x10`\xdd>\x91\xdbG\xf5P\xa8\xf6\xb8;\xbf7\x1c\x9at\xf41O\x01#x2g\xc5\x10\v\x9d\xa5Z[\x82\xc4\f\x89\x19KYd\xfaR^\xb6\xab=\xa8\x91\x10u\xe8N!\x12l\xb2\xac\xad=\\\f\x9e\x80n\xa3RZ\xdfHƔ\xe9\x18~\x98n\xe9b\x8d\"\xc2\xeeb-!\xa0V\xcf\x05\xf8k\x052\x13\x91\xb0<K\xc7\xe3-PC\xc9\v\xbe\xef岲=\x8fE\x1f%ia\x85f%\xb7QXc\xcb\xef!@oݢ\x14\x00S\xf0Z\xbb\x88\x8e\x16*\xe2\x8a\x1e\x1a\x9b\xb6\x9f\xe2aG\xd8\xf9/쵎f\xa1\xfd\xd6\x1cS>T\xd3#\xe5\a\x94\xca\xe09^%!\xae\x85r\x7f\x83\xbd\xe9n\xb7vDJ=D\"\xb3J\x1e\x88\xcb\xc9\xeeb\xef\x15\x06&v\xed[\x16\n\xf8x\xa2\xb1\xae\xf7\xa2\x95\xaa졑m\x89\x8b'\xa5^\xde2\xc8\"#\xb95\xc7)\v]\xae\xe7\xba\t\xa2\xc0\xbd6\xe8\xf6l\\K\xc0\x81\xec\x06\x83Л\xcb_\xd7ռ86d\xc9\xecÈ\xe1\x12!\xf7\xdbF\x1c\xf7߳Em\xe7@\xa1\xa1\x8b\x83T\xa7\x10l4\xb4\x15\x1e\x89+\xc8\xfe\xa6\x85\x05\xb9\x05|${\xc8K\xe7\a\xe7m\xf4\f\xabS\xc6\xf9\xa4\xcb\xf9*\xe43\x9c\xf6\xb3c\xaa\x90 5\x8e\n\x8b\xc3ߓ%\xa4\x97\x93\xd1 ڙ\x96<\xac\xe38b\xa6\xfes\xe6\x84L\x88O\\\xb3\x9f|1\xb2\xa7\x97\xf3\x95\xc2\xe8\x87\bS\x8ek<\xc7,Uu\xe2ئ\x82曅a\x8e\x84\xe0m\xa7\x0f}\x88j^\x83yCh'\"\x06\xcc\xf8\x81\xbb\xefX\x05\x12\x05\x02]\xb9\x1ea\xf5O\x85y\xbdI5ڡ\x89T\xb1\x9b\xb1\x10\xe1\x17\xa1\xd8'ԣ~\x91\xbe\xa3\xff\xfc\xff\bR!C\xda\xcf\x16\x0f\x1a%\xddmI}@\xe0H\x86o\xf72\xc0\xb1\xfa\xe5C\xe8^{\xb4\xea\xc6\"#\xd1t\xebES\xc1\xd6\x13d\x87z\xd0\f\xa3HK\xad\bE\xd6V\x12@6$P\xb9\xe9\xf0\x82\xbd\xb3\xfd\u05cbD\x030\xa9\bI\xd6gC\xfbZ\t\xa9\xaas\xb6!\xd7f\xca\xe1\xee\xb1(\xa5\xb7\x1fs\xa0\xbf̛)~\x97\xe3\xddoG\xaa\xa0G\x8c=\xe5\xe2\xfd:\xb23\x15w\x04ڏ\x9f\x10\x80\xc7ީ#\xc2_&ZlΊ*nՙ\x02\xe1\xa0~V\xf6a\xa3\x1cw\x16\xbb\x80\xe6j\xf2s\x8f]\xdb\r\x17!U\xb4\x18\xd4]\xd9P\xdf\xfa''<\r\\P\xa8\xea%\xb5S\x80\x9c\x01\x9b\xd5\xdeB\xec\x87\xfc\x98\xc6!\n\x06\x11\x8a\xb1\x06\x14oL5)\x04v\xc7\n\x82\x88}\x84\xd0\x00\x98|sY\xe2\xc2J\x9aN\bb\x95\x84\xf1\xcb\xcclݠ;co_M\x04\xd2\x13Z_>7\x89|\xef\xfc\xad\x155\xc8Q\xa2\x05\xba\xef\n\x84cL\xeb-\xb1\xc0\xa9\xe0\xddj\x00\xdd\xee)\x1d5\x8b\xe8\x16Æ\x87\xe8Ҡ\xbc\xf9&_\xaa\u0085:\x7f\xaawv(\xc2\xef'E\xf7\xe6C\x9724\x13PJY\x02\xa8u\x18q\xa1\x16x\xfe\xf29\x16\xf5k\xe1<\x92\xd6\xfb\x97\xbc\xee\xb9\xe8Wp\xa5\x1d\xa5\xa2ה\x12\xcd\xf5\xee\xd0\xc9\n\x8e\x9f\xd1}\x85\xf2\x1a\xaf\x15ֿC\xd2uz\x97\xe1\xdf\x06\x86\xc7a\x11\xc0\xef3\xa4Y\xd3|g\xa0\xd76\xe4\x11\xfaAe\xf9\xd0Ȧd\xef\x11\xd6R;\x1f\n\xdf\xedRy*3\xf5\xa8ftb\x1f\xa7XN|{\x95\xa8\x1b\xfap\xa6\x0fx\xdd\xf9p(g\x9b\x1a\f\x7f>s\x01\xfc0\xe7:C\xac\xb9<\xfdk\xe9;\xb2\xf2d\xe6\xc3z\xa95^f\xfb\xdcSx?SMT\x89\x05F\xd6F\xb9\x01\xbcѭ\x85p\xff\v\x18G\x10߅(\xbb\xd6E;p\v\x99\xbbwS\x99\xa9@\x96\xd1g\x86\xce|6Cw\x9d\xe7V\x0fG\x8e\xb9H\x12E\xb7\xe9&:\x91\xae\u07be\xbb\xd6%4\\k\xc8M\xb3\xc0\x023\xe1!\xaa\xe3\xbe#\xb7؆(\x03@Z\xfd\x13\xbbU*$\x00\xbd\x9bw\"\x86Z\xa4\x13\xe8\xf8\xb2i\xbf\xe1\x89h\x1eH]\x83du\xf8\xa0}\xd5J\x98\xe1\x96\xc5\xe5K\xbe\x89\x06\x02\xc1\xb6(\x15峅^\xa9j\xfc\\\xb0\x1c&=N\xff: \x81\x15\x93\xa8j\xb3M\xcd\n\xaa(\xfdi\xc2\xce\xe5|\xe7g\xf1\x95\xc4z\x97&\x16W\x02\x80G\x02\x04\xde\xc1\xfazA+(\xff\xfa)v1U\r\xe5\x03\xfa\x19ST7,13\xb8l\xbb\x17]h\x9d\xde|ގ>\x83<\xe3\x91y\x11tg\xa5\x83N\xad\x1b\a\xac#\x05\x01;\xbcH\xa6\xb4\xcfwA\xf4\xa5K\xd6&F*\xfb\xe4'OK\xf22_\x9e\xf1\xebN4\xf5\xb1=uT,\x1b)\xca|\xfbݻ\xd7L\xf3\xaa\x90c\x16\xbb\x90\x05\vf\xf7\x81^\xb8%Sg\xbe:\xb3j\xac\xd8$$\xd5آ\xa5w\xc2\xe1\xb8,%\xef\x11k\xe5\xab\xd8{3\x1d\x16\xb7OgQ'\x18\x1a\xa6\x19\x9e^H\xadP\x85\xaf\x95\xf4g\x8b~\xc4N\x8b\x9a!\xea\xce2\x14\x81\x04^\xa5\xe9#\xc5\xe4\xc7H\xee\xfa\rf\x7f\xd8z\x0fƬ\xb1\x01;E\x1b\x8a\xb9\xfd\xab+mG &\x00\xa1\x8f\x92\x99\x82z\xa6,\x18\xb2\x91Ns-#\x01\xe9,\xd0VE`\xaa\xa4\x8e\x89\xe0\x89\xf14(\xbb\x11\x98xE/p\xf9<\x9e\x17pS\x93\xd6pri)T\x93\xa0H\x85\x82\vل\x8c\x99\xd5k[\x1a\x94\x14\xe5W\x99p.\xaeq\xfc]\xb9=\x9e\xa6J \x8d\xe6\xf1z\x98\xe5(\xf3ߡ\xb9\xb5\xf5^7~\x9fC\xa52\xa8y\x18\xb4Y\"=S+\x8bACh\xd5o\xf5\f\x85\xcejA\x95\xcaݍ+$\xde_\xe7i/D\xefm\x95V7\xc1\v\x7f \x9f;\xb2Q(\xd6\x18\xb8\xe2\xf0\xeaةAQ\xf6\xd2`|\x9e\xa6\n\xead\"c\xcdS\x8c\xfb\xac\xfa\xc7\xcb<\b\x97\xe2\x0e\x95\xe3\a-\x11X\xfd\x99\x13-c?\xc5\xef\xe3\xf7:c\xfe)\xf1\xde\x0fm\x96n\xaa\x9a*ӭ\x87\x94\x97j*\t\xa8\xfe\x89s\xe1\xf8\xe0\xb8\xc1dMW\x8eؙ\xc4):\xd5̤$D\xb7\x04\xd8o\xe8\x96\xef§]\xa6;$AU\"ЦT\xe1\xd0\b\x18GȈ\xbb\x06\x89\xb1M\xfc\v~M\x18W0\xeb`\x1fp\xddR\xb1\xa2\x01\x13H\xdb#;\x9cu\x00NQl\x8fK#\xd1\xcb:5i.\xd3\xeb^\x97\xa3\x9c\xa3\x17\x01\xc2\xd0f\x95\xacè\xdaрV\xde\xfaqͱ\xe3ؿܬm>\xf9\x9c\xdf94ԃv\aU\x7f\x9bT\x05}\xf5\xaaU\\$\xd72\x0e\xb4\x16I\xab'\xcevjÒ\xaa\x98n\xf8\n-\x02A\x15^\x14\xde\xfa\x05t\xef\xe2\xc1\x9d\x1f^\xebR\xa9\fB\xe3\xdeEC\x15\x9e\xbc\xac\xcdC˒\xb1\x05\x1fm1\xb3\xc0mּu\xeacی\x02\xdc\xfay\x02\xbd\t\x91p\xfbF\xcb\xf0c\xbd#˾M\x8dzƷG\xb1s㚯?\x95]\xce\r\x11\xae\xbb~\x14\x80Z\xc5\x1fT\xba\xaa\xfe%׀㙸Y\xa1\xef\xa7;\xb3\xe7\r\x0f\x00Dh\x84\xa2\x19\x8d\xe6_t[\xfd\vh\x8c\xed\xd5\xd7.\xd9'gb\x90\x86wf\x93 \xa2\x86\xcb\xef\xd8\t\\\x02\x1c\x86!#T\xbd\xdfH\x1ax\x90\xfe\x91\xd7\xe3\xfb\x93\x19\x91v\xfdb\x9b\x19\x84\xbd\rNJ\xfb[\xa2\xeaѐ\xe4\xec\xe8\xf1\xfeH\x95\x9fł\xdf\x1e)\\\xaa\x82V\x892/6J\x80fSL\fz\x18\x89P\xd8o\xa7嫵\x91G᤹?\x17\xf1B2]\x8fǺ\x97\xc4\xddz\xc0{\x92ZH\x15\xb0cw\x91\xd7F\xab\x8aa\x1b\xdf\xc4\xe3xь\xac\xbd\x97t,\xfc\xf3ta\f\x94\xbc\xa2\x8b\xa6\xb5\x92\xc4\xd1{\xce\xf1nI\r\xfcB\xf1\xddl\xc0dK\x11\x04\xaa\xc5\xd6XP\x94g\xcb=2\xbd\xcd4\x00\xbd\xcc\xceCbn\x91\r\x86\xb7\x1b\"\xb8\v\xc1oq\x85B\xacF\x94p\x84q\xe7- \xf3A\xb9\x03\xfd\xe7'\xcbR\x91\x80\xc5\u0603\x83\xb0\xb3\x0f\x88\x8db\xdeS\xc7^\xf9\xe7N!;\x90\xe7\x05<\xae\xb9\xd7it/\xf3\xa2\x1d\x93\x7f\xfeT(!6!Y~\x9e</\xe8;\x1aWE0\x86N\x91DT1\x97\x97\x9a\xd0L\xcf7a\xbd\x90;\x9ebM\x1c\xeeڳ\r\x94-^3`ɠ\xcfc\xdb7\xc1\x8eJ|Ֆ5~j\xf0!\xb1\xe2)\x81mV\x03\xb2Xlp\x94\vަ*\xfbt\xdfn\x8b?0\xf4\x1b\xb4o\xb6\xe4\x13s\xb2\n%\xc2\xe8\b\xbax\xff\xfdR\xef\xb8\xd5%\xce>\xd8\xc3\xef\x14\xf2!P\xa3\xb1\b\xcdX\xdf\xf0\xe596v\xb8u\x1bU\xd9\xe3\xed\xda֨<'R8W\x8e\xe3\xe9hL\xb5.!I1\xb4\xd7w\xa5ܚ*\t\xc6b\x1b\xd6\xcco\xdf\xd6\xe8\t\x10\x95.\xda\xf2\xf4N\x9b\x8f\x16\x12\x7f\x92\x8fX\xdf\"u-|\xaaG\a\x86\xb1\xc2.\xa7\xe5Dy\x11\b _\u00889H\xa2c\xda\xeb\xd0`\xf3\x0e\x04\xb0\xa3\xfdJ\x06:\x01\x87\x05\xc1{XB=\xfc\xedjp\x1b[qd\xe8S1\xcbe\xe9\xb8\x15\b\xc8\xda}L\x85oP\x00\xeb\x92¶~(l\x1a\xfc\xde\xef\x80\x05/\x1a\x88荊,_\xb9\t\xb8*+t)\xb5\xc2E\xaa\x0f\xdf\xf6\xbb\xe0\xc1A\xc9s\xc1*|\x18|\x94\x14\xd0}\xbf'\xa1a\"\xe8\xcaXe\x01\x1995\x13\x9a\x89U҉?\xcfr\x0e\x14l\xc9(\x86\xfd\xda)\xc2.\x84q\x9c\xf10\xe9\xf6\x8bS\x81E\xc1Hb4\x8dhB\xee\x17\x95\x18\x865\xae(\x10UO\xae\xb4\xbb\xc8h\b\x81\xa6Rd\xefVu\x9fQ \xf8\xae9%;\xc9\x1e\x1dzԶ\x05\x80_k(Ȝ\xd0S\xc7a\xc2\xd9\x03\x9e\xa0\x93\x99\xb6\xdam8)\xe4HP\x92\xa7\x1c\x84\x86o\x8f\xdc\xca\xc4\x05\x9am\xca`\x7f\xae\xcf5 \xf7\xc5\xd2%1\xd3\xe6\x10\xb3\xa4\xe4\xeb!fv\xdb\xedT\xc0\xfc\xe9@\x80m\x8e\xe1\xa0\x13\f\xa7\x90\xc7_\x7f<Y\xda\xd5Nޓtt\x1b>\x8b\x19j\xf2\x1fq\xffe\x8c\x9ey8k1cM\x01ԭ\xf4\xf1\x0e:\xa59HÙ<\x05\x92\xd4\xf9\xaa(p\xa4\xe0,m\x88i\x96\x96\xc4GJ\xe0\xed\xe6z\x9c\x15\xeb\x7f][P5J/0#3\x91L\xe1\xfe\xf9.Tj\xba\x82r\x98\xef\x16\x84\akc-h\x17ধ[>\x0f7\x14%.8U\xc1^\x7f\xd6y&t\xefj\x8as\x00\x87\xac\xc2UH\r\xae\v\xa0\xe7\x0f\xbb\x86χGg\xe1\x13\xb3\xbfQ7x@\xad\x9b\xe2Fo\x15s\xdc;\xe2\xf1\xcb+ \xaa\x8e+\xc0\x91k\xd5t\xdc.\xd1\xe7\xf4\xc8kx\x1a'\xe3\tp\bb8\u05f9OrR\x01{iqb\x88\xbb\x11\xce.I\xb3֓X!x\x8dD\xb3Yq\a\x81\x95Eц\xd0t>oL\xb6\xa0=\b\xf9!\x9b\xa0틪&\xd9\"B\x99q3\xc4ۺc\x90\xdbVo\x81\xbaH0\x8e\x9c\x18ž\xac\xc7\xf1'\x85\x02\xa8\xf3\xef\x8d\xe4h\xbd\x95C\x16\xea\xc3/\x91\xc2K\xa2R\x1e\xc4\xd8Ҕ\x85\x10kS\t%\x12VO\xcaL\xc9\xe6U\x1a\xa5\xf7l1\xbc\x13\xe2\xe2\x8b\xd5xM\xdf\xc8\xce\x13\xe7\x8f٬\x16H\x9b\xe4\xa6|NgdZ\x1e\x0f\xc6\xe2\b3\xf5\x9e\x89=\x88~\x00\xac\x19\xe8\x14\x12PI\xae\xdc\xf5\nP\xec\xd4c\xf6\xd8\xdfFL\x8c\x9a\x06'm\xc3\x06)\u05f8\xa3H\xe2Ǣ=\xef\x18\xeco|p\xe9i냪$\xd5\xf6\xa3nG\x05\x01\":\x99чM\x85|\xfc\xce~\x19\xc6R\xea\xc3|\xfb\x96\x7f{\xc3\x14\b\x12\x90\x7ff\x1f\xb2\x9d\xde\xca\x112̊\xb04\xaf\xe3s\xd9WV\x98\xfa\xfb\x0e^2~\xcd\"sE\r\xa3m\x84\xf6)\xc4K:T\x82\xa6\xc4ȧp-Z\x00\x9b\x9b%\x0e\xb9\xe7\xbc\r\x00iPR\xc1Ƃdɧ\x1a\xfa9|\x8cK\xcd^+\xcdz\xeeњ\xae3H1_\xaa\xcc\\}\xb8\xde \xce*\xa9\x8b͚\xb7\x8b\xa6\xd7Ϯe\xdd\xcc\xf10\x1b7\xfa\xbf\x9ax2\xbfn\x17Le\x1d7\xd2\xee\xe2\xce\x01\xf5\"va\x8aj\xb8E\xde\x0e\xb3\x1bk6\x14\xeb\x93\n\xe1p\xf87\xc2Pt\xbf\x03W\xffY*\xdf9\xa5O!7{\xf8\xc6R\xc9d\x98\xa4\xa7^\xa2\xef\xbe(\xe0\xe9\x1b\U0004a705\r\xdf\xd9\x1c\xddշx\x18\xf0\x05\x15\xc9i\xaf\xf7\x1b7(%e\xac\xe8\xe2\xdc\xfa!\x92T\xd0*P\x9d\xcfi\xf0Z-#\xc1\xe76m\x11\xee>\x1dx\xaf+\xa0~\x10\xa5+\xadj\x93\x86\xa1sw|\xefr.\xd9\x05\b\x80\x82H\xec\x9a\xc0\xc0\x03\x05\x11\xf0\x04'\xaeIJ\v\xc8\xc4\xc7\xcbY\xd0\xe4|\xbdᥲM\xfdk(\x8f\x87\x95GN\xe7\x00r0~\x9e\xee\xe7Ge\v\xb9\uf528|\x9a\xa9!I\xcf\x1cg;\x96\xe0\x19\xa22\xd5\t\xd1\x063\xad\x8bq*\x02y(Z?j\t\x18\x02_\x1f\xaa^-2\xb3͔4i\xe7!\xe2\x8d\xcd\xc1@\x91P\"0 ^=\xed\xdd\x04\x1d\x989\xdf\rA\xaf\xfaF\xc4\x05\xbf\xaf\xda6U\xf6a.O\"\x80[\xae=q\x8d\x16\x8e\x19\x12\x86\xc7\xc5\xd5aW;\x05\xe3{p\xea\xef\xc1\xb0]\xc8\r\xabo\xea@ڙ\"\xec\x11\xf6{d\xdb@\x9e\xbf}\x1d\xf8\xfd\v\x81\xca\xf4ɓ<\x84@S\xd7)@\x18\xe3T\x14zF\xd8`V8I\xe8(\xa0XH\xc3{\x85D\x0e:\x94\xb1\xb9\xec+\xb5\r\x05\x952\x1e\x8dͥ\x00[\x98\x96\xf2[fy\xb1\x83\x10\xb227\x05\xd1շ-J+\x194A\xea\xb3<\x89\xfe\x93\x8e\x9d}\xbf\x01\xc1\xfa/\xa0v\xe0Tg\xa6\x9cv2\b5!\nP\xe9\xf8:j|b\x96\xdbq2\xda]\xbaA\xe6\xec\xb4\x0f\xcdғ\xb3k\xac\x15\xdcxL\xc6A\xd9\r9\xe1\x10\xa5\xec\xc5\xdb\x1f5\xc0\x15\xa9\xd1\x13\x86ѽ\x13p\r\xa9\x19\x19\xe5O\xc0\xbb\xbf\x1e\xca\x18\xadB\xc2\xfcD\xc9y\x8dP\xa2\by\x7fo\x1f5\xa5\xadn\xad\xbbm<\xbaK\xdc\x12\x05\x00I]{\xaf\xb8F\xf0\x86\xe3+[\x93\xf4\x7f@>\x85\xd0wtS\x032\xcf\x12\v\xd9\u05fdN\xe3\xcf\xf00#\x91(z\x95\x98:\xe5\x1c)\xe7\xb6k\xa1\xf31(\xcd/\x91\xeaw\x95A\xd2l\xa2/#|\xe1\xd0\xd8\x1fF\xbc\x026\xfd\xfb\xe3nކd\xa7\xfe\x82\xfb.m\x84\xd0l\x93\xeb\xd7+\x0f\x19\xbf\x10\xd4IC\x8d\xea\xf7V\x1c0\x97\x166#\xcf*o\xfa\x9e\xafn\x93\x8d\x90g\xb3]\xd3ld\xe9^8\xdc|z\xe6r[\x7f\xa5>\x86\f\x96C\xffV[z\xc3\xf1\xf6\x904GO\x7f\x16K&KV\bj\xd8ª\xe5i\xf9ԑʁӃ\xf3\xe3&*\r\xf1b\x11\xf1'\x1d)\xe3A\x98\xc7\x12QS\xda%\x90\x8aE\x1aH{\x9aL\xab\xe6\xdbȗֱ\xac\x8cR\xab涻\x15\t\xb7!^\xa6iQ\x15Й\xebgX\xa5\x16\xfb\xb0\xa4\x88\xb0׆\xb3p,\xa1\xa41ֈ\xe9\tw\xb6\"\x9c\x88ȃ\x16ph+\xbeMXM\xfe\xde\xc5\xebC\x98\x8a߿\xa5\xe1\x1f\x9e\x86\u07b7C\xc5ḱ\x13\xe6\x1b\x98\xab\xf94\xf0%vi\x03\xd9nw\bט#\xf3\xe4#K{\xb3\xb8\xb6j\xcf\x1d\xf7g\xf4f\x1a\xa9\x10\xaa\xd9n\xee\xf2\x8al\xa9\v\x16\x8b\xdba\xe6L/\xa8\xeb\xb4\xf2\xa7\x17m\xa6Y>\x88,\xa1\x98\xee̖\xba>\xd0\xd5\xf3ʩ\x1f\x86\xd2\xcb\xee\x9d\xf2\x00#\xfa٧\xa9\x98\xbb\x91\xe5\xfd/\xa5xl\xa5S'\xd9SLF\xc8\xd1\x12\xdd\xd2}\x0f\xafRy{R\xfb\t\x94\xc1\xf8\xa6\xb1\xea2\xe3\xb6B\x89\x7f\xbd\xb0\xbd\xd2\xc9C[\xe6\xd3\xd8\xfe#=\x01\xabR\xc6*\xcaF\x15\xe1\xef\r\x1d(\x1c-N!\t\x9eIX'\r\x9aͩ\x05\x82.\x8b\xafs\xb5\xcf\xe8dZI\xe6U\x8f\xb1\x8a\xf5Z\xab䥭T\x91*-A+zֿ\x06:-\xae\x9e\x82x\x8d\xc4\xc4\x00U@\xb2l\xb7m%\xa8+\x93\xcd\xfe.\xbb\xca\xc4\fa\x91<G*wp\xb5\xe7\xe5\xb9\xcd[8\x1b\xbe\xf8\xd9k\xee\xd8\xe3\xbf\xd0|_\xaf\x87\xcev\xc85t(\x01\xf4NqH\xf9K_;$\x17͖\x87IϮ{P\x85i\x11\xde¼\xe8\bf\x9f֔&T\xdc\xea\xe3\xcb+\x94\xf2G\x01\xeb\xcdӕ\x87\x85g \xb8V\x88\x03&\xfbY\xf9\x8a\x98\xc9\x16D\x96\x88\xbe\x98\xb5\x86\x89\x83\xc4\x18<\xe9\r'\xa8u\xf0\x18\xa0\xf6\xb1F\x85\x008L\xe4\f\x90\fSp3U\bF\xb2\xafU\x8enKT\x12K(\xf8X\xa2\x86\x9dK\x05\xd5i閰\t\x16\x17\xc7\xd2\xf8Ep4?JZ\xb3\xbd/\xa0\x9f\xe4\x83\xe2\x02\xcd,\xa4w\x93\xaf\xdf\xd31 \xf5\xc6\xfd\x05!\x9f\x9dv\xef\xf5\u05f9?@\x00\xec\xb6\x7f\xff\xb9\n\xfbA$)ɀ$\x1cT\x0f\xb3\x9f\x97N\xf1\a\xd6\xea:\xc10)o\xbc\xbc\xd6\x10,&p\xb8v\"\x013ȭQ\x0e\a\xc3>\xc9\xcb\xf91{=\xf1\xd0\x1c\x89\x1cZ9\tP\xc4]P7\xc2W\x15S\xd5\\x\x1a\xd9\x1b\xc1\xadD<\xd4<M\x99\x82 \u0605\xb1\xe3\x1dzB[/>K\x12\xf70'\x92\xc9\x05z\x95D!`{\xea\xec\xf9\x12\xd7D\x81\xfaY\xef\x9aX\xb1\"\x932\x9e>G\x00\xb7\xa6\x80\xc8\xf0\xb9l҈\xd6]\xaf\x83\xd0jtu\x81\xe1\xb9\xd3TI\n|e`b*\xb5\xb9\x1b\x80Ƣ\x1e-+\xcf\x03\xbf>\x02\xceO|pQ\xd2R\xf2\a~\xb1_\x14=\xb6\x95N\xa2\uf77c5\xe4\x11\x00u\xa1\xc3\x13b\xf2\xf2Ҽb\xe7\x92=\xbe\xa48\x9dR/\x17\x81\x1c\xcf\x05\\\x8d\x9f\xa7a*\xb6Ǔ\xef_\xd1\x10E\xf0\x976\xa4\xf0\xa3C}\xf8\x9e\x8fM?\x04\x9c]\x0f\xb9J0@Y\rI\xbe\xe4\xf3[\xc7<\x01\x9f\x8e<V\xf4)\x99F-\x1b\xa6\xcb\xde\x141[=\xe8\xdb\xed\xb1^w\xd7z\\\xcb\xf8e>Ph\xcf+\xea\xf9\xe4\xf1 \x1dS)\\\xb5C#\xc0\xfd\xca\xe9ECP-\xee\x14;Az\xc9\xcc\xea\xfb\xbe\x88W\x05\xe7km\xd1.\x83\x95('\xc1\xa8\xb1\x188\xa5\xd9[\x1d\xd7\xeb\x88\xfd\xa1\fFa\xe5Ї\xc7\xcc9\x00\xb8\xf0a&\xd0e\xca\x1dZ\xd2g\x9f\xe7lC\xb2\xb0\x0f\xd2*\x1b<\xacl8\xbb\xfcg\xbe$\xb7\xab\xdf\x0f̻\xdbWW!\xf3\x06R\xb5&$\x99v\t\nMi\xa0f\x1aպq\x1f\xba==\x19\x16\x1aB\xd1\xdc4\xa9\xac\xaa{\xf3\xff\x14{\x1047\x10\xb6\xd9\xf9\x00J\xe3̞\x18z\xe7\\\x03\xa1\x89%\x80X\x06ٲߪw\xeb\xc4\xe0\x02H\xef\xf3D\x05 \x1a@]\xd21Q\xc7\x14\x9bY\x8bJyd\xbaW]6?:\xd40SW\x93\xdd<\xb2ѶE \x13uݞ\xeb\xc7\xddR\x84\xc9,\xfe\x01\xf6c\x1eG\xf2p,\xa8\x9a\xf2\x18I\xa7\xea\xc3z\"\xf7\xe0\x15\x03\xf0\x05\x97,\xe4\xb3\xfa\xf6\xe4\x179\xb8\xd8\xcb\xd5]\x9c\xa39\xd7\xf0\x8eg\x90\xf3\xc2^\xd9F\xd3pb\xc3o\x96k_\xc8.\xa6\xbe\xd1\\=C\xafjIӵkwS\xa1'\x05u\\U\xe3\xb6\xd5\x1bY\x992\x88\xc0卟Ⱥ\b\xc36\xb4\xf4\xfa\xf7\x90[C=\xe9\x9f\xc8N1\xd6\x00=~30M87\xde\x17\xae\x06\xec\xc0\xf3m\acv22\xef\xd78\x19\x90*b\xf0\x80\x1cH\x0e\x90̓\xf4\n+rr\xa5(\xe6\f\x82\x02\xea\xe1\x00vܷ\xcf4}\xc6v\x1d\x84\x9aa6klG\xef9\xd1\r\x96Փb\x99@\xc1r\x96\x0f=\xd8:\fIӦ3\xe8:\t\x06?߾\xa6-\x9a\x8a\xdaՋfs\x18&\x9fG\xeb\xd6R!\xe7\x9a UL\xf8A\xbc\xed#\x8dg\xadQM#y\xee}\xd1:\x9eg%\x86=\xbf\xf2\xf7.\x1f\xe0z\xdeu\xf5\xf8W\xb3\xbf\xe4[A\x02*\x84B\x14}\x81\xf3\x02\xc1\xe9l\xe2\x8aX\x86Ǌ\x82\xc7֨\x0f?\x9d\x1b\xea\\\x8e&yTь\x81\x8f>kH=\xeb\xf1n\x96\x88R\xb1\x8a\x96s#sI\x7f0uyK\xcc\xd9\x18\x90x\x1b\x11\n\xf6\xa43\x13Ӱ)\x99\x1d\xa4i8F\xb2\xe0V\xbd\x0eKnk\xbc'.\x04\xe7Tϥ\\\x03\xde0\xf6\x9b\xf7;\xf2Q\x85\t܊\xdbm;\xfe\xf3wA\xbf\x00q=j\xd0\xfd8DHG\xee\xa8y\xe4\xaeDA&p\x11\x13\x8d\xea\xceR\xcd\xc5->\xb2Y'\x16O\xbe\xd5\x14qɗye\xfd7\xd2\x1f'M\x94\xaa4\x11L\x17\x033\xd9m:\xbc\xc5߱b\x88y\xa2\xfbI\x8f\xb7|\fi\"\x98z끮\xcd;\x18\xbc\xea\x19\xca\xc0zu\xfd\x039\f\x9b07\x04ʾ\xaf\x91\x99\xee'\xbd\x12\xf7\x9chNw^\xe4\xb0G\xbd\xadmD\f\x1d6\x11\x7feD\x95\xce%\xe97\xe1a\xc0U\x02%\"66J\x0f\xf9\xf9\x1f\xf3!An(\xfa\xb9\xcfd\x96\xde\xe1\x14Ȯ\xf8\xe0\":\xb3>W\x9b\x90\xe2,\xf9\x05\x9cU\xf0\x9c^\x85U\x12\xa9yW\xd0\x120\xd1\x18\xdc~F\xafF'\xe4|\x86\xd8Y}\xa7\xe7Rx\x12\x02\xcbl\xb7\vI\\\xee\v\x12㿡\xb4\x154\xa8J\x1ew\xaaHi\v^3\x86t\xff}\xbe;\x0e\xf2\a\xac(\xb3K\x1b\x9d\r\x06\xb3R\xfb\xf31\xb6<`+p]\xcb\xff\xd7_Kʡg\xb5a\x182\xe0\xac4\xa6\xf7\xe2\xcdEl\xcbz\x1bl\x8eW\xbaْDA2\xd4u\x82\xb7D\xdb\x1d\xf4b\x9e\xb0\xc7_\x1al\xa1\r\x7fH\xa1\ad\xaf\x05\xc7\x06\xbcFN\xae\xe1H\x90\xa4\xc7\b:\x85\xd1(o\xab\xe0g\x8aT\xfb\x7fӋ'\xfd0B\xae\xea$\x13\x9dYG\"W\xba}`\xdd˞<\x9fl\x06\xbc\xff\xf9\xa3\x84\xb5\xef\xa2\xd7$l4X\x1f\xa3\a,\xbe\xba\x8cE*L\xda\x1d\x8b\xe8\xdd;'\x9d\x17Ai\x8fLU!\xb4\xf8\xf8!&\x8eg\x98\xb4 \x19W\xd2>M\"\xfc\xa9r\xa4>\xd8\xdb\x1d1pC8\xe4\xedTZ\x89\xc0\xc8\u05f8\x81C]\x921C\x86\xd4\r\xf9W\xf6B\xbfeH\xa2\x1a\xce\x7fBg\xa8\xc1\x14\x19\r/\x98.\xfe\x91\xd0}H\x9a\x12\xd1\xd5\xe3\xe8&,\xee\xe7\x1b\x0e7?b\x9d\x91\xc4\x16\x95\xb3\xe9_ܣr\x15\xf1\xb5\xac\xfb\n8\xdf\xf6\x90\x19\x8e_\xa4\xaf\xb2Ӹ8\x97\x95\xdf\xef\a\xfcY\t\x80v`\xbd֢\xe6\xf2\xb8\xd9\xf9SV\xb0\xa7\x1f'\x8bi\xa6\f\xcd%߃r\xed\xfc\x9a!]\xa2\t\x80\x83q\xd3c\x88\xad\x89\x8d#\x91\xe8\xea\x8d\xf8:\xdf\xeb\x9b\xed\xbe\xac\xe1᭵c\x8f\xa5\xe9\x1e-\xc6\xd5(7WNz?[\xec\xcb\xe1\xbaX\xb7\xd0m\xcc\xd4\xe9\xf0>\x18\xef\x8f\xffu\x10-\xff\x9d\xa0\xbf\x9e\x14z>\xe4&\x1d\re\xba.\x035\r\x93\x80:\xc1\x1b\xb4tA\xa7e/\x81\xce\xdc\xc3Jv\b\xdf\xc7\xc4f\xa1'٩\x87P_BƏ\xa6\x9c\"v5\x85\xb9\b\xa6_(\x00\x02G^\xed\xb1x\xfc\x8f\xf91=\xfc\xe5ݥ\r\xb4\x14o\xd9\xfcaX\xfe$\x02P\xf2t\xe2\xb4\x1b\x9d\xe9\xee\x8d\x1f\xd2+\xbd\xf9\xe7\xb0\xda\xee}\xfe\xa9\xe2x2\xe5iD\xeb}[]\x06\xcd[\x83\xf4N\xb8X\xff\xf1Oa\x14\xb8ФJ3\x86yz\xbc^\xfeDԅ\x14\xb8\xe5\x18\x16w\xc10\xa5\f܄\xda\x1fPf`\r\xb7;'6\x9aڞ\x93E\xcd\xf5&\x96:\xcfY|\xd50\xd8#t\xd5\f\x95\x17r\xb1\x1a\xe3\x02S\xe1q\xf5R\xc1!H\x9fӚJ\xab\xc6\xdc4L\xdb\xc78\x8fO7R(\xef\x96\t\xc0p\x12@\xa0\x9a\xff\x88\xee\x89`\x8b\x92vd;s\xce'(\xbb\xce\xfbe\x84X\xc0\xa5kkq\x03\x05L(-\xc1\x93%\x83\xc2\x10\xdd\xdc\xc0I\x8d\xbc\x1b[`-\x96\x11c\x01\xaa\x8d\xa2^d`\xff\x8av\x0evUw\xf4m<\r\x88\xfb%U\x95\xd2r\x905\xbc\x1c\xb0A\xc0\xefd\x8d\x86\xd0\xc2\xf4=C\xa2`\xff\v\xd7\xc65\xae\xea\x14\x05\xa4\xf0\xdf1\xc2\x1f\x11\x8c.\xbfu\f\xaaN\x93Q\x1a5\x06\x18!\xca\xf2\xf96\xba\xd1\"\xad\x99\xd6\x1c\xd5X\xb6\xa9\x85\\W\xaf筰\xf7\xef\xac\x16\x9d\x82\xe0\xd5!\xc7\x1cʝ\x87ilB\x81\xf3!\x99\x1a\"\xbc^\xb0\xcb\xc1\x17\xe3\xcbų\xe6Gzo\xcat\xc3\xc3R\xd5\xd3\x1a\x03`\xe4lz\x95:\x1a\x04\xa1\\\xfc\xcd\x1f\xc6\xeb\xbddP*\xa1\x1f\xefu\xa8\x19\x9b\x82$\xd1\x02q\xd3[%(\xd3\xef\x129Z\xa0\xd5\xeb@\xab\xc7\xf6\x83\nW\xff\xa12\xfe\xfe\xf4\xca\x1f{\xc3\nT\xbb\x81bi\x18\xa0i\x05\xb2\"\b\xec\xb3\xda\x01\xfe\xb0$\x86\xfeH$\x96\x93%\x91ó\xc6(\x9b\xf9k\xacr\xe6\fp\x03pHH\xa6\x12\x14n\x1bV\x9b\xcez\xc7\x18 \x8b\xe4\a\x91\x18\xdaF\xa5\xc6ͮU\xc1\xda\v#\xef_\xa8\xb1-\xc0\xb5\x9fϥ%\x8bqZ4<\xed\x95\v\xa5R\xe3x\x94H\xa1\x85{\x8f\xa8y\x97\x95VĴ\xbd\x809m\xb6ס\u05ce\xd4ak\v5\\+8\x14]\xc4\xf6\xbc\x86\xb4\x15\xf6\xef\xf0\xb9\xffU˟\xd2\xef\x16\\\xb6\x02u1\x1f\x83/\x8e\xbazI\xcd%\xa7b;\xf1Y\xc8\xd4\xde(\x14\xe8[^\x84\xf0nf\xa9\xa5\xe2z\xbd\x1a\xd2.\x90H}\x12\xe4\xc0\x80\x02H\xea\xbeF\x97\xd7\xe5H\xbd0\x98\xfc\xa0~B\xd7\xd6!c\xb4\x98\xe2Y\xceܹ\x92q$\xa9\xc8W\x88\x9b \xbbn(\xa8\xdb\x02\x86\x8c\x7f\xd1˗C\xfaޖ\xed\x05\xb5\xad\x01\x19\x16&ݨt\x96Uy\xb1U\xbfe\xe5A\xb7\xabU\x18\xb2u\xb5&\xe4j\x15\xc7.\x1dz\xbb\xf0\xed\xaa\x19\x02\xf8\x80ͷ\x1c\xe5\xb4:7j\xb9\xd7\xe2\"#\x97\xcb\b\xcbg!\xffN\x93\xaf\x96\x88:pR룱k.ԩ\xa6\xeb\xeb4e\x7f\xc18\xa5\r\x99\xdb\xed\xa2\xf3\x7f\xb3\x98kQ\xdbY9\x89\xc8\xd2'\x99\xc8e#\xcb\x13\x18\xcf\xc3f\v \ffjW\x8a\x06\bM/\xcf\x0eȑ\xde\x7fO%s\x02\xa5@\x1ff.\x9cs''\x1a\xda\xe94Dr\xc2\xe2U}\ag\x97\x10\"\xf6t\xa6\xb9\x89a\x8a\xd6\xf8\xf6\xf1\xe8\xb6E\xb7\xe5S\x125\xe5\x06*\xd2ذ\x11\x95\xfd\x03\xfb\x8e\xdf\xcd\x1a~&\xa1\xb7\xf9mq`t_\xe1x$\xddq \xefw`\xab\xcfY\x15k\xe7\xca\x17x\xc1Tw\x03\x9b\x1d\xd3\x1fcn&{\xf7\xd5\xcc@3!k\xa8\x80Jݠ\xa0\x8dKyR\xa8\xa9\xbe\x06\xf1\xdd\xff\xbdO\f\xc6e\xc2Ŷ\xa2+\x13g\xc5F\b?.\xa6\x88\xcf7;eu8A\x02u\xd8\xed\xee>\xa7\x1e\x02\x90\xaf\xf2\xbb\u07bcƝ\x93\xd2*4\x9b\x11I\x90*\xf8\xde\xcfn\xe8\xa0\\\x9b\xba5\x88\xcfG4=\bZL\xcc\xfa\x9c\xa0d\xde\xcb\x16\xe0\xe0#\x8f\x8e\xae\xa6} \xfa\x17}\xf3U?I/\x13\x86`6il7\xe0\xd3~\x7f\x80\x8f]#\x80#\xd6Ð\xea\xbe\x13:\xb39A0_\x11U\x1c\x95\xb4\xeaB\xa2\xe3\x0f\x06R\v\xe7\xe2\xb9(DqC\xf3}a\x04$\xc5\n\x90\xd1i/\x86\x9c\xa6)\xe6\xdf\x03\xf8\x89d\xa6?\xc7\xf7{\xebpO\xb6\xfb\x87\xfa\xc10(\f\x87Wd3\xe9\xc0\xa1\xed\x0e\x8a\xc8ǽϧ\x06\xee\xfd\xbd9\xe67H-:&4\x17\xc8V3\x98\"S\v\x1e,\xba\xb1(\xea\x9a4\rs\x92\x7f*\xd3(g\xa9\xe6\x9aI\x980\xac\xf9\x7f\x97\x97\xf8bb\xb4fQ#\x12U\x1e4\x17\xeb\xa5\xcc\x04$\xfa:C\x91u\xc2vS\xbe\xd7H4\xc7\xcf\x06p\x12\x05\x85\x00\xa8n|\xbdL\xec\xf1\x17K\x1bX$ƻ\x86N\xfe\xf2u\x03\x1cم\xe3\xe7нD\xa6\x1bc\xf0\x14\xd7Cu8>6\x98\xa3\x1c\xfb\xe2ũ\xe3!hNm䧌\x82&\xd4\xe8D\xe7z\xf0\xcd\x1d\xdb\f\x85\xfb+\xa1\x7fJ\xff\x91\xdeh\x00H\xe9\xed\xb5P\x01\x9d\x83\xb3\x94Z\x1fb(Na\x92\x95\xa5\xb3\x1b\xf0\x17ݤ\x0e\x14~\xb4\xf9\xf8>Z\xbe*?\xb5ŏ8\xbf\x85\x81g:#\xaf\xe6\xde\f\x9b\xfd:I\xf9\xceo3۞\xb8L;Q\xd7n\x84=ތÙ\x9f.\xf4K\x94\x12*\xba-\xb9\xf1\x8c3\xde\xfe`\xb2R\xd7m!\x92n\x8b)\xa9\xb2\v@\x9d\xdf{9\x04\tad\x99&G\xcbYV\xb8\xfb\xc4\f\x17\xa2\xbb*\xb5\xf0\xb7\xf8\xe3\xa0A>\xb1\xd2\x0f\xfa2L\xa3\x84^f6m\x93EQm\xc8\xdbN\xe1\xb3\x02\xdc?0\aC\xb6\x18yH\\o\xf1\xe6\xaa#䊮\f\xe6؏`\xa3(|\xafR!CQ\xce\x01\xcewԱ~R\x87\x8b\x98\xe4\xfaS\xb8,3-G\xd3\xc5\xf2I)\xb2\x10N\x0f\xf7\xda^\xdb\ac\xbc\xe6\n\x18I\x0e\xf5\x8a· W\xdcC\x92\xb9>\xc9|b\x97G\x92\xdaӥ\xa9r\xec\xc5!\xee\n\xc7\xf8\xfb\xd8_o\x0e\xa7\x81\xf4y\xdax\xe5[le\xc8!\xa6~\xeds\x9eP4\xf9*\x06\xd1ol\xb5\xdbX\xe7\xf9\xa0Y\xf2\x96|I\x82\x99\xeanP\x1a%\xc3\xf8\x8c=\x14\xe6nвB\x97\xb5\x1d\xc7ߛqŖ\x19\x7f]\x95#9\xcf\xc3\x1c\xb5n\xb0.\xfaŝ\xd1\xeeU\x89\x8c\x91\xe5~P\xf1\xfd\xa2\xe5q1]\x0e\xb1\xc2\x1c\xf30\x01Kw\x84\xba\xe6f\x0e\xf0#q\xbd\xafW\xf3\f3\x06Z\x03\x83\x0fP(ڟx\xde\xde\xd8ɰ\x85\xb7\x98\xfc\xf9\x05\x8aD\xb0e=;\xc1K\x98lF\xa6I\"\x14^\xa1E\xed۽K\x8c\x10ΎX\u193c\x8b\x89\xbfR`\x81w\xd8\x0e!\xaa\xcc\\\xb4\x8a\x87&UB\xffr-\xf0\xa5V+\xa8se0\x9dn\xb1\x94\x90\x96\xfd\x1d\x17\x01\xc0x\x97_\x98\x9c\x80\x15\x80\"\x823\xbc5\xbbZk\xa3\x1b\xdaԂ\f\xa1\xa5\x1a\xd0#\xa3\x0f?8\xd7ݮk\n\x9e\xb7\xa2'\xb4%\x83+\x9b\x1b\x81\f\xd5t\x8b\"J\xc85A[\x80錏\xd3f\x97-\xefۑ\x85p\xf4\"(\x85\xaf\xf4\x04\xa1f\xf54\xe0\xfd\x1by\xa1K'\xecg\x04\xb2\xf4\xe1\xf0\x1a?\xd4\x10\xa7\x13\xa8%|\x06\xcd\xc0:h\xd7A\xe9\a\x96L\x88\xfb\x17\x05\xa9K\n4Ę\xf4\x9dQ\x7f\xb4\x82\xe9!\xa7\x10\x8e\xc2>\xfb\xfc\x9b\xb1\x01\x7f%\xf4fJ8~\xfc#\x1a\xcez\xa9j#&\x14\xea\"/^\x98\xa5?\x92\x19}[Y\xf8þ!\xcd;;rj\x82\x94\xb9\U000ac211J\xda\x17P\x8cN\x7f`\x9c\x864\xdbK\xacY\x96\xccP\x17\xe7sa\xfd9\x1e\xf6v\xa5\x13fq~ \x19\x1d;%6\x1fJ\x137\x1b\x84\x82\xcb6\x194\x87\xfe\nP\xf8J\x99\x8d]I\x03\x01\xb0q\"\xe9\x1e\xd35\xae\xf4\xa0\xe8,\xe5\xd7]\b,\xfd\xd1xK\xc8\a\xb83\xf0Ҟ@V\xff\x8as\xc7\xd2r\xb4\x1e\x13\b*UHFo\x8as\a\r\x1a\xb4<\xb7\x87ޞ\xb1\x14\x06\x9b66\xe6\x80\xd0\xd44\xb7K\xb1\xf7\xa6\xc3\x04\xd4\xe3\x19\xf7\xf2)\xa4a\\\f\x95@\xc9\xe5\x11iO\x1f\xec\xecA~\x06\x869\x18\xff\x99Ao\x02\xa2F\x9aYa=m\x16\xfb\xcfU\x02\"03\xe4\x82\xe8͛zz\xa8F9\xb9\f\xa7\xf2\xf0N\xf9\xdf\xd0T^\xe0\x92&\xe4V\x9e\x17Q\x1b\x82\xe5V_\x94\xe9\x16\x15~\x14\x1b\x84\xa3\x17\xab\x98BE<C`\xae\xe7?q/WKk˱}!؍\xa5\xfe,\xdeu\xc1\xffw\xf3\v\x11\x81g\xe8\n\u10c6\xc5ә1<z\x1cΏ9\xe3t\xd9U\x89\xc3BH\xc3`\xd6\xe9b\xfb)`]\xab5\xefݿ\xe4\xb3w:8\xb0Rz\x18AC\xbesU\\(\xe23\n]\x8d\xe8\xf3\xd3m\xf1\x96\x9b}\x8epU1\xd4\x06\xa1\x8cex\x96\x9e\tk\xe8\x16\xbe\xdaaG\x8a|\xd8x\x9fyG\x94\xd6:\x91\xfa\xa8xK\xb5\x15`\xc3\xc9\x04\xdc\f\xc9ԛ0DTs[זs\x8a\xb6\x8fal~\x84\xb4bE\xe4S\xdd\xcef(E\xa8\xee\xec}\x00z\xaeZ\xbf\x9d/2\x96\x00o\x0fͿ\xf7f-ԥ\x12\xb7\xb5\x86\v\x8e\x94:\x1a\x7f\b\x11\x1a\x03\xa2/<\xc0Ȉ\fS\xe6ghl\xa4\xfcR\xf5\x88'f\xb3\x00o\xeb\xc5\x19\xf0RQq<\x86\xa5\xb0\xeb\xfc[\x04a\x9d \x0fF\xbc`\ae\xea\xf0\xb3\xd4\xde\x1a\x1d\xd8\x01觚\x18a\x8d5\x98\b\xd2P\x0fڅ\xf5\xbd\xb3{\x1e\x9b+d=\xa3d\x95ʼ,\x1b\xa4\x8d@6h\xb7\xa1\xaf\x1b\t\x15`\xa6\xb1ދx\x01z\xa7\xc6\xfd:a\x937T\x93Tj\xed\xf1\x03\x04\\2\xbb\xdf]\xaaq\x12\x89\x17\xab\xb2\xd5˴\x88z\xe6\x91\xddt\x96\x13\xect\xa2\xb0\xf3\aG\xf2\xf6\xb4\x06\xc5G\xb9\xb8ٞ\x16\x0f$\xc2o\xc5\xc4~\n}뤴\x10\x18\xb3\\\xe4\xfb\xed\x02\xcc{\xc3{\xd9\xc2;\xf4\x9b\xcc_\x02\xed\xf1\xf16\x1a<n\xb7)\x94Y[\x93\x892CL\xaf\x80\xb5\xaf\f@i\xce\xf5=\xb8\x9c=\xddrd\xb5\xff|\x17K\x8e~z5\xc1\xe27}\x00j\x9e85\x1c\xc1a\xc47\xf2\xae\xd9\xf7ڨ\x7f\xfb\x91\x84D\xa27e9\"\xc0O\x14\xfa\x04\x06\xc2\x18j=\x94G\xf3@L(\x94\x987\xff\x7f\xe8\xd2j\xfd\x1f&\xd4=qZ\xbc!\xdb\xe4e\xd2\xeae\u0602nB58ce\xf6\x85\b\xa1\xado\xca\xd7\xf2\x94ZL\xe6\xe0\x1e\f\xab\xe3GAn\x9e7i\xe8W\x84\xfa\x1fm\xad|\xc4&\xb9\a\xb7X\x8cD-\x1f@H\xe8\xa9>\xc4\xe24@\x14k\x9d[rG\xa8\xe8uK\x91mr\b@\b\xab$\x11\xef\x8d\xe9\xd3\\i\x11\xa51\xf1ul\xef\xe0\x80\xd8D\xf0\x92=S\xf5\x16\x9am\xf1X\xbd\x8e\xa95\x8f\xf1y\xf4Bf\x04\x89\xe5\xdb*\x15mAE!\xaf\x1c\x05'+\xce3`\xed\xc8ڹfE\x86\x82\x10#\xfa\xab\x80\x98\xa1\xba*ߑ\xe1\x91o\x9bBN9\x84>\xab\x96\xc8\x1c\xbd\xc1.wT\aI\xe2\xab\xf4\xed\x16ثCf\xde\x17=]F\xd8|9\xca\x11\x8c\xe8\xf6\xe9\xaa\b9\x01\x92\x96\xdaŜ\x16) \xccp\xb6\x11O\xf4\x9c,\xff\x006\xe2\x91\xc8m3lH\xae\xee\x1f\a\xe6x\xd3Fl\xd4\f\x95ы\xae͗\x125q\xb1$\xb1aL9\xe9em`P1\xbe\xfb=\xb6\xea\xee\xd1&\xf6:\x04oa\xf9\xb3H:\x87o\xb2\xb9I\xf2\xb8\x16\v\x16m\x8d ,\n۔\x82P\x80\xbc)S\x82\xfd\xaf\xa5\xe6C}\x10/A3?\xca\xc3\xd9*ϣr\x1d\xe4\x80tT\xc9N\t\xfd\xc4T\xc0\x98\x8eWpwĐ+v\x0f[\x84S\x18Nu,\xbd\x9c\xf2\xa1շ\nQ\x13 \xd0\xd7<ʞ\x06\x98!\x81\xba\x1cx\x9c*\x1b\xb9Ľ\a\xfeI\xab0\xb2FP\x91\x1e\xea\xa9\x120\x92\xb7E*\x87\xf8\xb1\xbfT\x91\xf5rW\xb2\x81\xe4$-\x82\xa2\xf7+[ǵ\xb0\xba7\xba\x83\xad<\x1e\xfa\xc0\x9e\x16j.\x118q\xbbg3\xd0A\x0f\xfcP\xb3\x03\x18!P\xfe\xcf\xder\x82\xb8\xde\naHM#\x9f\xa1\"]\x82\xfd\xdcn\x8eW_\xc1\x1bt%\xc7\xeaH&{9Ӊg\xa3:f1\xf9\xd5}\xbb\r\x9f\x88섔hY\x92\b,\x8a4-\xc8Jh@c2V\x9c\xb7\xfa]\x0e\xa2fG\x98\xafWi\t\r\x01\x7f\xd2h\x8dp7\xf9(\x1cGJ>-\x9ag鵿\x92\x9f\xca\xea\x15\xccW\xb9öAC\xda6\xc6c\x92\x89\xa2\xe3\x98\xfd\xa39\x97\xbd\xf0Q\xa1\x18\xfbJ\"-\x02Řg\xe0\x18\x7fx\xe3\xc3F'\x85\xce\xf1\x03\xf5\xa0\xbd\x03wl\x9e\x9a\x8an\aз\x1c\x95ĕeW\xfe\x98\xcc\xd8*\x98H\n=\xbe\xba\x12с\xc0\x87\xf2k\x19\xfe\xa2\xa8:t\x1c!~\xcdHr\xb6>\x88Lg\x16y\x10\xf7\x1a\xcdd\x14\x8d\x85\xa6\xf7\xdaؘ\u0089%3rm\xc4\x15\xb6\xe8η\x03U\xa1@X2}\xba\xf3\x05\xe3\xae)[>\xca\xcf_\x85\xffP۾\\\xf6\x9b\x1b7X\x9b\x91\xfc\xe3)\xfe&\xf3q-!\xd5\xf7\xfdr\xd9_it:\n?\xf1\x98\xeai\xea2\xa7\xc0\"\xa5\x0e\x91:?4\x96\x15\x9d>\xc3e\xbd\xed\xc6J\x11\x96\xa9t\x16\xaa\xd4\xe2\xd4\x11$w\x99\x02iL\x04ޡ\xedu[\xef\x02g\xd8d\"uC\xf7\x9a\xfb8\x8eu\x82\x03\xd75քx<\x0e\x8c!\xf7%66\xe1!\x00\xe5\\T\xeaD\xcb\v\xfet\xe4\x12\t\xc7c\xdd\x10\x83\x00\x01\xee\xe3\xb2\b\xfd\x05\xae\xb0\x90\x12\xf9\xd6\x156i(Ob\xb4)z5\x9f\xf8>\x1d>\x12\xac4\x19`\xf3\x0f\x05\x12\x8fХjĘ\xfe\x8b\xf0\xabU߮\xf7\x8fK'\x1f\b\xd6E`\xebd\xeawk0\xb9F\xbe\xd3\xc7\xd0\xfbB\x90\x88S}\f\xc2\xf32\x9e&\xaa\x10\xb0Ǎ\xfc\xba\x16\x01\x9b\x99!\xfbXU_\xef~\xafVD\xa6\x86v/wI\x99cc\x88\xda@FB\xf7M=Tr=\x12\xd3pBx\xcc<\xc3\xd5\x01\"\xe0]\xb8\xc7Az\xbd\xb2}\x0e2g<5\x87\x19l+\x13\x11\xe6Z\xe8˩\xe8\xc5x\x94\x0e\xb1O\xedP\xeb\x96r\x9dl\xd9\x0f\x15\xb9J\xd8!\x87G\xb8f\x9d5\xab\xe0F\x01\xfb\x11w7\x84\xf0\x13\xc1\xbf\xa9E\xafӢ\x9f\x10\xcb\xe7D\xe1vB\xbdw\xc7\x047o\x96\x13Fm\xa9\xcf\x125\xbe\xddZ\x1f\xc7:\x16(gՉK\xf5(&D5\x8e\xbb\x0e\x1c\xe1\xa3\r\x11\x11:y\xa0[\xca\xf1\xb4\xc4\x7f\xe0U\xcb\x06\xe3\xbbZ1V\xbf\n\xf5\xe4\x02\xa0\v{9n\x99d\x1a\xe1\xb8?\x19wP\x0e\xc3m\xbb_\ts\x16\xdc\x10?\x98p\x95\x84\xeb\xba\x1b\x0fў\xb1Z\xf5'\xeb\xa7#\x9a\xab\xb7H\x9bD7\xf3l\x11#\xc7H:nc\xdb\xc0w\xf5\xb0\f\x8a^\x88+\xdc\xf8\"\x1c\xd8\xe7\xb4\xea\x0f,\x16Eъ\xa7t\xd8Θ\xffzw\xfeP!\xa1-9f}\x1d4\x8a\x87+\x81\xe7x\x97\xec,\xc6\xf4\xd8\xf9\xe1qt\xc28\xfc\x80ũ\x7f\x1a\xd7\xd1\xedfJ;\x99\xfbh%U\x8f\xd4\xd5h6\xaf\xcc\xcc1\xd2\xdf>D۾j\x0e\xa5\x84\x8cVD\xd0_s\xc84\x1d\xb4\x9fW\x01\xef}$+&\x8e\x05\xcdΣ\x96h\x9b\x1f\x9dZNV`\xad0y6\x19]\x10*\rq1\xb1\x01l;=\x19\xaf=\x1f:Sm\xfc\xf4\xb5ƭ\xe5U\x19\x04\x19\x13\xf6\x89\x8dG\x9f\x13\xe2\x1a\xfbw\xf7ԧϧ\xef\xbc\xdf;e8U\xa7\xb4\x83S\x85\xc0 \xb8\xda\xe9\x19f\xe2\r\x8b\x1a\xce\xcc\xc4\xf1T'\xf68\xfb\x9b\x8a\x92\x92\x0e\x89\xac\xb6N}P&\xa8\x17\xaf\x9f\x19\x9d\xdf,\xb4w4\x18P\xfa]\x81\xa0o\xef<4Ϗ\xb6\xc5\x1d\t\xd6b\xfbW\xaf\x95\xce\xe6\x16\xf4\x17s\x01\xf1\xe9\xf3:\xd1*\xd1\xf5zɤ\xfe\xc5|\x02\x8et\x9b\x13\x92\xf1\xccGKy;\x97\x88\x8d\xfaBi0\x95\x8b\xb8\xe4\xed\x05\xe9-\xe8\xc2\b|\xb8\xf4C\x95\x9e\xa9U\xae\xa9\xaa\f\x9e\v\xb0\x95\xf2V:\x9aR\xa1\x98\">\x1b\xbd\xc5Z\x18\xfbK\x02\xa2K\x92s\xf4.\x10\xb2\x8d\xe5\nǚk\xb7>\xd8\u07b8\xf4\xa3ً:\x85\xe0\xc7\xc18\xe5\x8a\x7f\xfd\x7f\xb0\x96T\x06\xbe\xbea\x91چU\xc5u\xb83\x90đ\xc7\xd2p\xd3\x7f\xf3\xc18\xa2\xa9\xa2k\x04p\xec\xae&A=\xb6\xb3\x1f\xe6o\x97u\xb4v\xbb\xf2p2I\x1cڻ*\xa5T\x9c9-\xbb\x9fI1B\b\xb1\x8f\xb7\xf1\xe5\xaf\xdes\x18\xba_\xff\x90Fɽ]\xc1zs\x86\x86\x9b\xea\x0e\xa43^e\xb9\x977\x7f\xd6\x19s\xc9\x03\xc5ր\x85^\xc6\xe4oq\xb6\xb3\xf0j\x05R\xd6\x7fVø\x9b\xd9\\y<e\xd5\xe1z\x12\xfc\x89\xe7J|3\x91\t%\xdd\xce$\x1e$\xf1$S\x15\xad\xf2\xb8\xde\xf7\x1ekmF\x14\xd5ә\xb0\x96\xd1\x04Vj\x91\xb4\x86W\xd1\x0f|)\bYb\xf1\xb8 J\xe0R C\xcdç|\xc1<4]0h9\xbe\x01CF\xf3cى\x03\xccŧ\xb1\xaej\x0f\x95\x83\x9e\xc4M\xcb\vBҹM\xbfi\xe9\x8bh\"\x99k{\x9cCX\x95\xf4\x19\xb0\xaft\xef\x05\xab<\xeeř\xd9`Ϭˋ\n6\tA\xd5\x1e\xa1H \xa1\x88\xc5\x1e2V\xfc\xec|\x0f\x926\xf7K\xfbJ\xc2\xe8\xfe\xb4\xee\x94\xda\xcb\x05\xb30\xe7x\xec\xffp\v\x10r\x02\xb6\xbbz\xc7\xfe\"\xdc\xc9\xc6\xf7`g\xe3\x16\xd1\xec\xe4m\xc2\xff\x0e\"\x18\xc2ī\x19э\xddA\xfe4X8f\xa8[\x1c\xd5p\xf9\xfdU\xf5\x92j\xbe\x92\xa0\x057y\xbe\x81\xeb\x8bU(\x00\xbfIFeՇܾ\xd7ǖ\xbeTGw\x98Q[\xcdu\xd2o\x1b\xd0\x1c\xccO~\xc3(\x9eؐ7튗\xbd\a^\xeb-\x97ైB\x93\xe3\n\b~\xc0\xf2rȡ|3\x04\x1d\x14l\xf1\xf7(\xbf\xa6\x14\xb6\x1e\x82v\xf4\x04\xbbL\xdeB\xae\x9b\xde.\xcb\xe3Z*47\xf5}\x0f\x8fwT\xdc\xe6$5V0\xa1L\x1apӽ*$\xf4\xa8?H`\xe7\xe7\xfe\x8c\x1f\xb8\xff\xfcJ`\x1dd\xc9X\xa2\xe3\xefk\xa0\xae$\xb8D\x88R[<\xf1_\x91K\xb1\x9a Ɲ\x14~^I\x13;\x80\xc5{\xdc\xee\xfḛ\xaa<r\xb5\x876\x83z\x12ؿ\xb9\x9fǁ\xaa9\x0e\\e\x8fO'<Ѭ&4\xb1\x88m\x97\xa5\x9f\"\xcb\xee\xacc\xb5\xf1\x8ez\x8e5\xd2\x0f--ۅ\xee8\xee\xf9\fg\x86\x03>\xb7\x1c_\x91\xe2\xfe\xee\xeb\xa0HZ\xcd\xd8\xffr5\xb0\x80\xb5\xe6\xca\xf6\xefo\x9f\x1b\x81\x86\xda\xceQE\xb3̑\xc2\xe2\xb4\xf4\x89ų\xbc7Gw's\xf9\xa8t/vK3\xa0\xc8kU\xbaB\x91[\x80lMǏ\x00(\x16)\xac\xd1\xd0Faʇ\xb1\xfe\x97\n߰\xa9?\x15y\x1eғ\x04\xba\x9a\x1bcɩ\xea\xbcZ~\xe0\xe9B{\xcf\n\xf3\xf8\xa7\x8d\xe1\x16\xa4C\x93\xab\x886ե\x9a^x2t\xc3\xc1~\xd8\x03jxs)\xdaeg\xd3\xfb\x1fի\x10h\xae\xa4?\x19bmz\xa6?\xe3\xd2\xc0\xff\xbb+o|\xee\xee\r\xb1\x12\xd4Ӕ\xb6\xf8\x11\xb9\xb9p\xe1 9S\xea?\xab\xa5\xa3p\x91\x9e=\x1dǤ\ri\xd6=G\xe6\b\xb8ג\x8c)oh\xeaA\xfb\x03\xa3[\x15\xe6\xd0#\r\x9bd3\x8d\x05\xfeUW9\xb7\x92T\x19\xed\xcf\xeaܗ\xe9\x7f۰M1\x8a\x90\xfd\x1f\x836)-dz\xfd\xfb\xef1\xdf\x1b\xad\x03Y\\p{z:Q\xfa\xa7\xf3#\x17\xb4\xac\x04\x0f\x80\xd9\xdf\x1c9dr\xcb'\x15h{\xe4a\xb4C\xf7\x81:\x7f\xdc=ǼF\xc3\xfb\x00\xf0\x03\x9bh\x90}\x7f\xffķ\x01\x80\x85\"\xd5\xe4\xe7\xc2\xc9*\xd2y\x11\n\x86\xfd\x06\x82\xac\xa3X2\b\xc15\t3X\x80\x1b\xccS){\xe9RL\xe1Q(\x85\xc0\xd1!Ds\x04Q\xaf1\x10\xecw\xedi\xb8\xde\x1a\n\x8b\xb7\x8b\x0f.[\xd0\xe8\x9f2Y\x1a\xa3\x1f\x9e#Tu\xed\xf3\x12\xdfU\xa4\xc7]Q\xa1\x8a\rs$-\xdc\xf2\n\xea\xe8;\x11\x80\xdaK\t^\xa6\xe8\x8cpEi\xd2+I\x05I\xb4\x92x\x83\xa8H\x95\xac\xd72\xbd\xe5\x9f\x1b\xf2\xbc\xee\xff\xd3!*|\x1f\xc9\xfb7o\x16K\xc1Z\x88H\xc8|\x8e6\xce\r\xcdT\xd3)\x14\xa2Ҫ\x12j/kH\x8e\x8dtцF\xa1Ź.uۧ3V5\xe9X\xd4f\xbc\xae\xdbR`_LI\x8f\"E\xf3\xb9u\x82\x98\xa8d<\xe1\b\nqE\x8d\\M6\\N\a8\xcaP~\xfb\x18Ԝ>\xa3#\xa4\x15\x85٫IRƱ\x90,\x80%\x8f\x10\xac;]!\nE\x1a\x85W\xffX\x95\t]\xf5b\xed\x9ea\xf54\x8d\xd0\t\xe6\xd7=\xfe\xa0}m2{\xe0\x80@-\xfb;+\xdf\x1b(\x7f\xcbn\xe7\xd6%\xe9\t\x90\x92~i\x1f\x8e\xf4g\xb3aT]#\xca{\xe7\x81K\xa5\x00\x85\xf5\xdc\xf0\xc2\xd2.\x8d?E\xf3t\x93\t\x16\x8c\xec\xdd\v\xa8c0\xd1}\x92%K\xcb\b\xf8Q\xc2\xfax\x81\b\v\xf4\x9f\xfey\x04\xf1n<\xf7)\xd6\xc6.\x99\x15h\x99\x17pmٙM\xb9N\x05\x17\xc4[\xc0T\xa4\xa3\xa7\xden\xa4\xffeYt\x81z\xfe\x04\x13|\xe7\xe8\f\x01S\x88u\xddl\xf4\xea\xf9\xef\xd5ej\x01(*9\x9b\xe7'\xd5k\x92b\xa4\x9c|9\x10݃p\xeam\xdce\xf6\xe5\x14\xf9'\x9dnj\xe27N\xbb\xda\xc8g|\xa1۴\xdb}4\xe53Z\x00\x89\x85\xa7\x9a\xb9,j'\xcb,\xbfI\xdb\x02\x86+\x90\x88\xc8(p\xfbQ\xa1\xf5`Y}\x96SƧX\xa2\"\x7f)\xadͪ\x83ˆ(\xb9\f\x02\x8e\xf4+ȓ\xd6\x0f\xba^\x86JLcqw\x16dc\xbe\x05\ne\x05\n\xe2H\x99[\x0f\xb9\xbd\xc3\xd0\xff\x1e\xb8\x8d\xaf\x81\xb3\xbd\xae\x01\x1ci@\x11I!\xd0u]\xaa\x92όj\x8e\x18Eo䏂\xf3\xba\x96\xf8\xb6)\x9cl\x80\xa5\xd5q\xef\x1b\x98|\xfe\x05\xd9+\xe1\xc7 6\x10\xc8Y\xd9-<9\xa7\xb2=\xf3ԓw5\xe7>\xd4ʧ\x10\x10\x10\xcfl\xe6i\xa8:\x9e\xa8'\x8c\x84\xde\xd4\xff\xfed\xdbU\xd6\xe8\x1d\x8bӴ<\x8dTx\xca/o\xf2+-$\x90l\xcb$5S\xc8\xfe\x19wvP8\xb8vj\xbb\x8f}\xbfZ\x04\xe1\xa1\xe1vF\x1c\x1f-j|\x1a_\xcfН\xd1\xcfX\xf5\xaaqFGi\x1d\xfdk\xaeV=\x15\xb2\b<7\xfd\x8df\x9b7\xfa\x87\xd0\xed\x1bX\xb1\xaep\xb9@X\xfb\xc2I_B\x10\xba\xdb\xf7\x9c\x9f\x90\xae%\xe8u\xb5\x12PM݀7\xc3\xeb\x12\x92c\x88\xf0\x1b\xb8JA\xdeS\x03v\xd1\xd3ά\xf7\xfeG\xcc҄\x90%\xaf\xff\xa6\xb3\xd4\xee\u0605\\\x19ۛ\xbdѝ\xc7*Y^\xa6\x14\x16\x81\x83\xdbMm\v\xe5\xac\x7f\x1f\xc35@\xc2\xf6\xe3ȟ\xfe0q\a\x9bx\x17oN.d\x7fH٢g\xe0y\xcfI\xef}b\xb6Qt\xa6\x02=ˢ3\xe5\x1b\a\xa0A\xcbӃQ\x84\x85\x1f\xe4\xfa^\xc91ht\xce\xf7\x10\xc0?\x186\n\x10\xc8M9<\xd4V}\x8f(\xb3\x8eNh\x1c\x8c\xae\x9a\xfc@\x02A\xfa\xfb!\xd8L\x17$\n6\x1fh\x93K8QeM\x92\xeb\xd1\xfc\x90\xaaY\xf7\xd28\xdag.\xaa\xca;\x1a\xf8\xb4\xc7\xee\xf4\x1a\"\xcch`ْ\xc0\x12\xc6U\xb7\xf5\xcd4q?\x7f\xd5\xd84`\xc82\xa7\xb1\aA\xf3\xab)\x12\xce\xed\xadC?\xf5\x10r\xe4\x13\x84\xa2ǚ\xa9\xc5\x15\xa9\x91\xc8*\xfa$҃\x19\x8b\x85\xf5\xa0t+\xca\xdbኑ\xfd\x82\x12\xf8/#\xc2x\xed\xafm\xba\xdd\xd9\x1e\x9dӪQL&\xd8\t\x89\x19\xb2o2{b\x97\xb7\x1a\xb3\xf1\xa7:\xd7\x14Un\xb5\xbf\x99\ff\xa1B\xa3\x97\xc9_\xe1\x1cP\xf91\xde\xc9m\x9dB\xac\x81\xccL\x1c\x1e\xb8\xf2\x18K\xfctJ\v\xcb7+G\xb7\xf5\xf4K˴\xd0,\xe7A\xe9ϯ\xaf\xe1M|~\xeb\xf77\x1f\x01\xc2;G\xb8f\xcd\xca\x16\xe4Z\xf6|\x13\xd6\xc1\x94%\xddY`\xe5\x1fb\x8dX\xa4s\xd8~a\x14\xb4U\xad\x8ftd\x03\xe7띹\x8e\x15\x91\xfa'\xdd\b\xc3h;\x95vG\xda\x15m\x84!\xa0cZ\a\x85p\x14W\xf8\xf2\xb0\xfb\xaf\xef\xf9\r^o\xcekQY\x93\x04\xae\tJ\x89\x8b\x84\xc8\xf0̴\x80\xf0\xc8fVh\xcb\fM7k)\x11a\x1b\xd1i\x12\xb2K\xba\xf2\xf6\x92\x84J\xbdW\xa3\xcfW\xba\xb9\xc2\xc0 õ\xc2@0\x00\x06\x13(\xefnx\x97%\xd7ltY\xffV\xd3\xe2\x81\x14\xfe\x8c&\x93\xcb|\xb2\x9dp\xce\x05(\xf0i1\x00736799\x00\x00736799\x00\x00736799\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\x00\x04\x00\x00\x00R98\x00\x02\x00\a\x00\x04\x00\x00\x000100\x00\x00\x00\x00\v\x00\x00\x00\x01\x00\x04\x00\x00\x00\x02\x02\x00\x00\x01\x00\x02\x00\x02\x00\x00\x00N\x00\x00\x00\x02\x00\x05\x00\x03\x00\x00\x00HR\x00\x00\x03\x00\x02\x00\x02\x00\x00\x00W\x00\x00\x00\x04\x00\x05\x00\x03\x00\x00\x00`R\x00\x00\x05\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x06\x00\x05\x00\x01\x00\x00\x00xR\x00\x00\a\x00\x05\x00\x03\x00\x00\x00\x80R\x00\x00\v\x00\x05\x00\x01\x00\x00\x00\x98R\x00\x00\x1b\x00\a\x00\r\x00\x00\x00\xa0R\x00\x00\x1d\x00\x02\x00\v\x00\x00\x00\xaeR\x00\x00\x00\x00\x00\x00%\x00\x00\x00\x01\x00\x00\x00/\x00\x00\x00\x01\x00\x00\x00\xb5\x01\x00\x00d\x00\x00\x00z\x00\x00\x00\x01\x00\x00\x00\x1a\x00\x00\x00\x01\x00\x00\x00\xad\x0f\x00\x00d\x00\x00\x00\x90\v\x00\x00d\x00\x00\x00\x04\x00\x00\x00\x01\x00\x00\x00\x1c\x00\x00\x00\x01\x00\x00\x00(\x00\x00\x00\x01\x00\x00\x00\x05A\x00\x00\xe8\x03\x00\x00ASCII\x00\x00\x00fused\x002020:04:11\x00\x00\xff\xe1\x0e\xcchttp://ns.adobe.com/xap/1.0/\x00<?xpacket begin='\ufeff' id='W5M0MpCehiHzreSzNTczkc9d'?>\n<x:xmpmeta xmlns:x='adobe:ns:meta/'>\n<rdf:RDF xmlns:rdf='http://www.w3.org/1999/02/22-rdf-syntax-ns#'>\n\n <rdf:Description xmlns:exif='http://ns.adobe.com/exif/1.0/'>\n  <exif:ImageWidth>4032</exif:ImageWidth>\n  <exif:ImageLength>3024</exif:ImageLength>\n  <exif:Make>Google</exif:Make>\n  <exif:Model>Pixel 3</exif:Model>\n  <exif:Orientation>Right-top</exif:Orientation>\n  <exif:XResolution>72</exif:XResolution>\n  <exif:YResolution>72</exif:YResolution>\n  <exif:ResolutionUnit>Inch</exif:ResolutionUnit>\n  <exif:Software>HDR+ 1.0.300173574zdh</exif:Software>\n  <exif:DateTime>2020:04:10 21:28:40</exif:DateTime>\n  <exif:YCbCrPositioning>Centered</exif:YCbCrPositioning>\n  <exif:ExposureTime>1/15 sec.</exif:ExposureTime>\n  <exif:FNumber>f/1.8</exif:FNumber>\n  <exif:ExposureProgram>Normal program</exif:ExposureProgram>\n  <exif:ISOSpeedRatings>\n   <rdf:Seq>\n    <rdf:li>1040</rdf:li>\n   </rdf:Seq>\n  </exif:ISOSpeedRatings>\n  <exif:ExifVersion>Unknown Exif Version</exif:ExifVersion>\n  <exif:DateTimeOriginal>2020:04:10 21:28:40</exif:DateTimeOriginal>\n  <exif:DateTimeDigitized>2020:04:10 21:28:40</exif:DateTimeDigitized>\n  <exif:ComponentsConfiguration>\n   <rdf:Seq>\n    <rdf:li>Y Cb Cr -</rdf:li>\n   </rdf:Seq>\n  </exif:ComponentsConfiguration>\n  <exif:ShutterSpeedValue>3.91 EV (1/15 sec.)</exif:ShutterSpeedValue>\n  <exif:ApertureValue>1.70 EV (f/1.8)</exif:ApertureValue>\n  <exif:BrightnessValue>-2.78 EV (0.50 cd/m^2)</exif:BrightnessValue>\n  <exif:ExposureBiasValue>0.00 EV</exif:ExposureBiasValue>\n  <exif:MaxApertureValue>1.70 EV (f/1.8)</exif:MaxApertureValue>\n  <exif:SubjectDistance>0.5 m</exif:SubjectDistance>\n  <exif:MeteringMode>Center-weighted average</exif:MeteringMode>\n  <exif:Flash rdf:parseType='Resource'>\n  </exif:Flash>\n  <exif:FocalLength>4.4 mm</exif:FocalLength>\n  <exif:MakerNote>20079 bytes undefined data</exif:MakerNote>\n  <exif:SubsecTime>736799</exif:SubsecTime>\n  <exif:SubSecTimeOriginal>736799</exif:SubSecTimeOriginal>\n  <exif:SubSecTimeDigitized>736799</exif:SubSecTimeDigitized>\n  <exif:FlashPixVersion>FlashPix Version 1.0</exif:FlashPixVersion>\n  <exif:ColorSpace>sRGB</exif:ColorSpace>\n  <exif:PixelXDimension>4032</exif:PixelXDimension>\n  <exif:PixelYDimension>3024</exif:PixelYDimension>\n  <exif:SensingMethod>One-chip color area sensor</exif:SensingMethod>\n  <exif:SceneType>Directly photographed</exif:SceneType>\n  <exif:CustomRendered>Custom process</exif:CustomRendered>\n  <exif:ExposureMode>Auto exposure</exif:ExposureMode>\n  <exif:WhiteBalance>Auto white balance</exif:WhiteBalance>\n  <exif:DigitalZoomRatio> 0</exif:DigitalZoomRatio>\n  <exif:FocalLengthIn35mmFilm>27</exif:FocalLengthIn35mmFilm>\n  <exif:SceneCaptureType>Standard</exif:SceneCaptureType>\n  <exif:Contrast>Normal</exif:Contrast>\n  <exif:Saturation>Normal</exif:Saturation>\n  <exif:Sharpness>Normal</exif:Sharpness>\n  <exif:SubjectDistanceRange>Macro</exif:SubjectDistanceRange>\n  <exif:GPSVersionID>2.2.0.0</exif:GPSVersionID>\n  <exif:InteroperabilityIndex>N</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>37, 47, 4.37</exif:InteroperabilityVersion>\n  <exif:GPSLongitudeRef>W</exif:GPSLongitudeRef>\n  <exif:GPSLongitude>122, 26, 40.13</exif:GPSLongitude>\n  <exif:GPSAltitudeRef>Sea level</exif:GPSAltitudeRef>\n  <exif:GPSAltitude>29.60</exif:GPSAltitude>\n  <exif:GPSTimeStamp>04:28:40.00</exif:GPSTimeStamp>\n  <exif:GPSDOP>16.645</exif:GPSDOP>\n  <exif:GPSProcessingMethod>13 bytes undefined data</exif:GPSProcessingMethod>\n  <exif:GPSDateStamp>2020:04:11</exif:GPSDateStamp>\n  <exif:InteroperabilityIndex>R98</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>0100</exif:InteroperabilityVersion>\n </rdf:Description>\n\n</rdf:RDF>\n</x:xmpmeta>\n<?xpacket end='r'?>\n\xff\xdb\x00C\x00\x06\x04\x05\x06\x05\x04\x06\x06\x05\x06\a\a\x06\b\n\x10\n\n\t\t\n\x14\x0e\x0f\f\x10\x17\x14\x18\x18\x17\x14\x16\x16\x1a\x1d%\x1f\x1a\x1b#\x1c\x16\x16 , #&')*)\x19\x1f-0-(0%()(\xff\xdb\x00C\x01\a\a\a\n\b\n\x13\n\n\x13(\x1a\x16\x1a((((((((((((((((((((((((((((((((((((((((((((((((((\xff\xc2\x00\x11\b\x01V\x01\xc8\x03\x01\x11\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00\x1c\x00\x00\x02\x03\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x03\x00\x01\x04\x05\x06\a\b\xff\xc4\x00\x19\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\x05\xff\xda\x00\f\x03\x01\x00\x02\x10\x03\x10\x00\x00\x01\xf6\x9cmT$\rB\x8a\x14\xa2\x8b\x16)V,YeP\xc4  \xa8\x1c\xbb7KG\x8e\xd4\xef\xe7\\k3ٿ:\x8b\xc1\xde\x14i\xc7E\xeb\"2i\x17+\xd6\x1b5\x97X\x02\x10\xb2\xc3\x1d\b\xa0.VJ\x16<J~\x84\xe5j\xa0%E\xd5\x04$P\xb0\x05\nU\x8b(\xba\x904\x02c\x81\xa7\x9e\xb3\x1dk\x8fM\x9dr,\xecg^wY\xdb/*\x97J\xb9t\x14\xd6z\v\x015\xe7\xa6ms\x10,\xb0\xcb,\x81\t\x1d\x14E\x00,!\xb1\xf7\xeetHB\x88P\x00\x80,X\xa1 (\x14P\x06\x1a\xf3vp\xa8j\xe2P\x94X\xf8q̮\x97>\xc3f}\xf2\xb9tʫ2\x94\x1c\xb9\xf5\x83U\xd9\x06\fB)TYd\x0e[\x14@\xd3\xef\x9c\xed\x10\x85\x14Ad(\xce(U\x04(\x15YƳ\xceW'K\b\x91T%\x1b#B\x9clγ\xcdek6\xb1\x8f|\xcc\x11\xf8\xe9b5\x83\x97&\xf1\xa3:V\xb2$\x1a=\x00J\xb4\xb2@\x86\n汇\xe8\x0e6\xaa\x14T\xb0\xbb\x04\x01\x00\x80*\x95\x19\x0f=\xa7\x9b\xaceՐ\x80\x8f\x8d\xe7g7\x9fI:y\xd6\xec\xeb\x1aqzc\x8b\xac\x94ތ\xe9\x1b\xe4ɶgJ\xd4M¬d\xb7b\xc8Q\xa9\x01ha\v\x96\x02\x8c\x13jS\xf4\x0f\x1b(K$\xa2]\f\x8b\xa1\x16*\xb9g\x99\xaf=I\xaa*%PGF^\xb6u\xadq\xdcs\xe8\xe0\x0fC\x8d\x81D\x8e-\x99\xe7Wc\xb25ϑ\xdb\xca4\u0084Y\xa7;\xab\x94\xd8\xd0E\x96i\xcef\xb4x\xc6m\xeeD4g)֓_\x7f\xe3a*ʖ\x15`\xa2\x80^\x05yz\xe3\xd0\x14U\x01qԕ\xa7r\\\xf9\xeb\x97\\\xd3:\x06\xb8\xf5%ӝf\xbc\xf9նj\xe5\xc3Z!\x95\xe65\x06ivK\x0e\xe6\x86\x19\xc1\x94l]\x85%\x15n\x9csM݁\xaa\xc8\x14\xebr㋧uW\xde9YP\xa2\x92\x10\xcey\xab|\xb5a\xa0\xa1!P6\x82t\xb3\xae\x87>\x81\xbeo\xcfL\xfa\xe6ɮܸnz\bգ\x87q\x8e\u0379\xde\xec\xef\x05\x83X\xaey\x94\xa5\xa9F\xc4\xeb\x98֩i\x02iv*\xcb\x04\x85\x16P\xfcη\x93\x96\x9e|\xb9ޞ\xc3ۯ\xdbyؒ\x80#\x98y\x9b|\xf6\x99ֈ\x94P \x03@\\\xbe\x9b\x8f^g^},\xe9\x16\x16w\xe89\xfatt\xf3U\xc7.ξn{0\xde8f;ua\xae>\xb2\xf9\xae\x97.˱Vhι\x9dxE$\xbb\x02ʕ\x16B\x10\x84\n;\x1e_26OoB\x8f\xbcb\xd2Q÷\xcb\xdbǥ-\x96B\x92\x81\x06\x86\x02\x80\x03~7\xe8\xb3-\x83\xbdE\x88\xd7k\x1e\x84k\x9664/\x1e\xe6\xec\xe8a\xc1ܬ\xceV\xee\x1d\x13a\xcb\xd5\xe5\xdd\xf3x\xb5\xcf>\xf9\xf49\xf5\xe7t櫂Q\xb91!\x06i\x81\x12\x1eKMܹd鯶g\xa7\x9e\xd3\xcbێ\xd1,\x84!\b\n\b\x14 \x8b\x04\xee\xf3ޤ\xe9\xcf.\x89\xec\xd3yq5\x19\xcfף\\\xf9Zᥧg\xa7\x13|\xc3\x17&\xf9q\xb5\xbe\xcevÅ\xbcQF\xactN\xb3\xd2\xe7ڥ\xa1\x1a\xc6}\xf3\x14\xab\x1a\x10Pfaa\x05\x15P\xf49\xa8\xa8\x12\xc2\x10\x84(\x85 \x83A\x03@\x00\xc9tK\xdc˹\x9dd\xbc\x94\xa8\xb7\xa3*\xd3$\x9c\xc9Ӊێk\xa6ƵA\x87Y\xb2\x88\bR\xee\xc7ggVQV*\xe7\x1e\xf9\r\x8e\x8d\x02L\xe1\r\x96\x81A\xb7\xb7\x10\xb2\xd6\x10\x84(\xa2\x14\x94QBh@\x00\xd3.{=G\x0fV\xb9uk\x8dY\x85\xe6\xe6g\xd9\xc3\xed\xe6ū\xb2]2\xe5\xb3-\x96Y\bQ\n!\xb3\x1dNZ(U\xc25\x96X\x92\x1ae\xd5 \xcd(\x85ғ\xb0\xb1,\x8be\x10\x85\x10\xa2\x90K\x16\r,\x10\x05\xa8\x9d<\xeff7\xe98\xf4\xf3\xfe\x8f?\x13X\xe7\xeat3^\xb8\xac\xcfe\xc5T,\x84(\x84(\x85\xcb\xd0\xc7R\x97&\xf9\xe7\xd6\x0eU\xd9\r\xdc\xfaحeW6\x19%ꡐ\x8b\bB\xca\"\x01\x01(\x10(@\x05@\x92\xf6\xf1\xbe]˳\xbe\x7f^;\x16\xa4\xcdY\xac\xb2\xc9-Y\bB\x14B\x10\xa2\x83\x95\xb3H\xd6\x0eQ*\xc6g]N]\x95f}Ó6\xf9\xaa\xc7\xcbف\f\xa2,,\xb2\xcaE\x82B\x96\x85 \xd0\x02\xb0X\xdc\xeb\xd1\xf1\xf5b\xe9\xe7Ǯ|\xddF\x99\x92\xd6\x14B\xac\x84!\v(\xa2\x14@K5g\xa1E\xae}s~w\xb7\x9fP\xb1\x1a\xc8\xd8\xc8\re6zL\xb0X\xc2\x10\x8ba\xa2H@J \xb0hA\x05j%\xa0n\xe7\xd7\x0e\xf8\xe6\xb1v4d\xa2QD(\x96B\x14B\x10\xa2\x88P\xf9w㣳\xbc\xda\xce}bK\xd0\xe7\xd5t\xaddn\niz\xc1\xcd{,\xe3\xcfnQ\bB\x10\xb5\xa0JA\x04\xa0h\n)h\xb9\xa3o%\xe5Ij\x9b\x95\x9a\v\x94\n!,\xa2\x10\x85\x14B\x14Q\x06˫;\x146\x96\x88\xd6:\xbc}\b\xd6so\x01s\aM\fz\xcc9\xbd0$\x16Ye\x10\xa2\x81(\xa0hA(\xa5\xa8\x8aM\xa1ά\xb5]\x8aJ5(@\x14U\x90\x84(\x84(\xa2\x88CNu\xd7\xe7\xdf.\xb3\xcf\xdf'gY\xb7\xcfN64ԩ\xb6\xca\x15#\xb9\x99\x97\xa72 \xb2\x11bQ\x14PJ\x06\xa8\x13\xbb/11Z1\x16\x85\xdc\xc2\x14\b\x16Q\xa2V\x19\x80\xb2\x10\xa2\x10\x12\x10\x84(\xeb\xf2\xef\xaf\x1d2o\x18w\xca\v\xb9dӦ\xad$\xd4,\x8b\xe89\xdc=x\xcb!\x05\x16E\xa2\x90J\x04\xaa\xb3\xd3'\xa7^\x7fLs\xb3y8\xdf!r\xd8)E\x90\x12\x80\xb2\x88i\x96 \xad\x94U\x00\x00\xa4!\n;\\}-γo\x19u\xcd:\xc9\xc25\x97\xe7n\xce\xceZ\xaa!\xdd\xe7r\xf4\xe6\x1aŐ\x00HE\xa4\x10K\xafD\x9d\x8e\xdcs\xfax^\xf2\x19\xd23\xa6y\xfd\x1e\x87\xc5\xea\xf97~ B\x8a!D*\x85\x04\xa0\x81,\xb9H1\xe5\x8b\x16\x01\xaf= \x9b,\xa0\xe36\xf1q\xd3\xe7\xda\xe5UY\x97|\xbdO\x0e\xb8\xb5\x9c\xddy\x11e\x89\xaa\x88\b'\xaaO[5羇\x86\xb7\x8c\xd9֝\xe7\a\x9fӿ\xb7\x9f&w|:\xf2|\xfd\xfc\xf5\x01\bB\x02J\x85\a\x02\x01D!\bB\xcd9\xdfg\x87\xa4\x95\x1a\xc6-\xe2\"u\x96K\x8f|\x9f5Q\xb3\x1de.\xe7&\xf9{\xcf/\xa3\x87\xb8\xbd\xe1\x1b\xe6d\x04UH\x12\x8fqb}\xbe3\xe9\x88\"i\xbc\xf4r\xe6\xad\xf2\xf7\xf8z\x95\x8b\xf2\xdc\xcemQd(\xa2Q\xc5\x00\t\bQ\bBΟ.\xfdn>\x80\xb1z\xca5\x9c\xda\xc5\xc2u\x97ˋ\xa7\x1dx\xeb\x8b|ݝ\xbf:\xc5ӕ3\xf4/'\xa7\x81\xb5\xa6\x0e\xfcI!\x05U\x02H\xfa\x86s\xcd\xe5'\xd1\xc6\xdf\x17\xb5\x1e\xbf\x0f\xb4\xf3\xf5ͭު\xb3/3-\xbeE>z\td( \xc0\x00\xa5\xa2\x10\x89E\x8c\x9a\xeep\xf5i\xc7L\xfa\xc2w\x82\x95w*\xb1\xa2\xecZ'|ٝjι}9\x12\x85\xcd\xd9\xf6_/_\x0f:\xaa\xcc]9\xa7\xa79R\"gXuS\xdd\uf7dc\xf6y\xb7\xf9=\xc3\u05cf\xa8\xe3\xd7s|\xeez=緎~^\xbb\xd1\xe7n\xbc\nyʵ\x83\x02\x00\x05\xa2\x14B\x14\x90َ\xbd\xce\x1e\xa9*\xb5\x8c\xfaɭ\xc25\x81\xb1\x92\xdad\xd6\x17\xac\x8d\x9d\x1e]n9]\xb8\xabQ\xd2}ǅ\xf0\xdc\xfd\x1c\xad\\ڙ{p\xb4\xb4\xb1*\xba\xfb6r\xbco\xc9\xfa\xb8_\xb3\xc9\xdb\xf3z\xb7\xf9}\xba\xb7ϛ\xe7\xeb\xa3\xd1ǿ\x8c,I\xc1\xe9Ӆ\x8c\xfc\xda\xc3V\x8b \xb5\x8bD\"B\x8a:\xdc{\xf5yw]\x8b\xb1vB\x14\x06\xb2\xb4qb\xaes\xeb9wϻ\xe6\xf4?\x17\x99ߟ+\xb7\x17I\xf6\xeeS\x8b\xcb\xd1\xe4[\xcdY\xbasWNF\x90\xa3*\xf5+\xd6I\xe9\xd9\xc9\xd6\xf5\xf3\xbd\x18ͦ\ri\xba\xb9\xb3\x18e\xba\xf1yǈ\x1e\xab(\tB\xd8B\x14Q\x06K\xdb\xe1\xe9Ս\xa6\xc1IBB\xa8Ib\xee\fl\x01\x83\xa7<\xbb\xe5\xd4\xe3إ\xc3גu\x94\xd9\xf7nQ\x18\xe9\xe2y\xfa9\x9ag\xd6s\xf6\xf3\x90IB\x95T'\xb8\x93\xd9o{sϕ\x1e\x9aNf\xb5[\xd5\t^\x063\xf3\x14\xd3*A\\\xabD!\n\xaa!\xbf\x9fN\xf7\x9f\xd1J4\x9b\x04\xa2\x14U\x02\x05\xc8k-\x96\x04\xcf3\xaf\x10\xd4\xedy{ݼn\xfc\x93\xacF~\xedʋ>c\x87\xb3\xccj\xe5\xd6st\xe5[\xe4Uq+*\x88G\xd6S\xa8\x9a35W7}\x19j\xa3\xcdg\x1e\x1e\x12\xa0!\xa4\xa8\x94\x90\xa2\x8a\xa2\x8e\xdf\x0fG[\x9f\\\xe2\xa8RU\x11\x01HU@.B\xe6\xc7\xc6>\x9c\xbb^~\xdc_O\x99sE5\x8fxF\xb1v}\xef\x8dU\xce>=\xfc,\xed\x97R\x1c\xfe\xfea\xd6L\xb0L\x8b\x13z\xfd=\x9d\x1a\xdbȞ\x7f3\xcbG,B\xb1q\xcdQ@\xd5\x02\nQ\xa3:\xf4\x9c=\x054\x02\xc0)n\xc5\\\xea\xc9\r\r,!z\xce}cw=^\xb1\x13W>\x9c\xbfO\x9f\r\f\xd25\x8a\xb9\v>\xfbƍ\x8bƼߟ\xd7\xe7\xf6\xe7t\xcen\x9c\x83\xa7\x13,\x82\x04\x10\xfa\xa2n\xb7\xe7G\x1e4\xc1-\n\x9bA`\x91E\x02\x84\xa4\xe9\xf3\xeb\xe88w\x1b3X2\xd1\x14\x01\xb1\x8a\xb8\xb2\xc0\x02\xa5\xcd̲^\x7fn;q\xba\\\xbdx\"\xd4.}a\x96D\xfb\xbf\x1b\x04\xdc\xf1\xfc\u07bf\x1d\xad\xe3\xd6S\xac\xa3\xb7\tre\x94c\x01H\x962^\x92g\x1d\x9dc\x9a\xeb'u2\xaf\x93\xb4V\x91uG\xa1\xe1۱Ϧqv.\xa4%a\x00\xa1\x06V-\"\xe9ل\x88\xb0\xce\x0f\xa7\xcd\xd9\xe3\xdc\x15]|\xf9\xad\tro\a`\xa7\xdex\xdb\x17s\x83\x97o)\xcb\xd1\xcb\xe93Y\x9b\xa70\xeb\xc6\xd2Ì\xf6\xe4A\xae\x94Y%\x93A/\xb2\xd7>\xaf\x7f*q\xd3>zx,v\x05\xa4fu\xea8\xf6v7\x11Z\x81be\x11kBʨ\fդMx\xa7\"w\x11b\xb5\x9eGn=N]\xa8\xcfۆs6\xa0\xd9\x12\x8f\xbeq\xb0\x1a˗#\xcf\xe9\xf2\xfb\xd73qv\a^\x03\xac(\xcfF2]r*\xd6\xe6\xe5k\xb7'\xad\xeb\xe7\xd3׃\xbc\xbe\xc4o\x1c\xfc\xef\xe7\xf3\xa8\xd7W\x97OEǫ,\x13),H\n\x00\xaa\n(\xaa\x92\xb2M\xdcԡ\xa8\x16c\xe9\x9c;\xca5\x9d\xfc\xf7\x0e\x7f\x7f?>\xcdd*\xc1>\xeb\xc6\xd9K.|\xc7>\xdc\x1c\xf5\xe5\xd2i=9\xe3\xe9\xc1uE\x1b\xa5lT\xd1/\xb6r\xebz|\x89[\x15\xe7\xf5\x0eu\x86k\xc67\xe8\xf8\xf5\xebc@\xaa\xb3:\xe7\x95T\xb2\xd0J\xa4\x965\x04~.\x9c[\x11\xa8\x8dE\xef8\xf52o97\xcf^7\xab:\xe4\xf7\xe1\x92\xcfE\x89@\xd2O\xb2\xe2\x9c\r\xb6\x9c\xe8\xf2\xfc=\\\x1d\xdc\xf6.\xcc\xfdx'xI\xa6W\xe7Ymd\xbe\xbd\xcf\xd1z\xbc,\xb9\xb5^w\x83\xcb\xec\xe5g|9\xbfS\xe7\xef\xa0QU\x92iFj\x80\x14\x81aQ\b\x19\x1b9\x99\x9bT\xab3\xf4ʷ\x10d\xd4#\x17Nk\xd62ܰR{\\KZ\x01>\x93+%\xabl\x06<\xf7.\xfe_=\xb0h\xbb3\xf4殜FS\x9b\x11I\xd4>\x83\x9ee\xed\xf0ܹ\xb8\xfa<\xf7\x1fO\x11\xae\x9f\x1e\x9e\x8f\x97a\"(Ϋ\\\xf6E\x82\x91:\x84D\nц\x9ev\xc8g\xdcN\xb0\xbd0\xee&\x9f.McL\xd2.pt\xe4\x8dd\x0e\xac\x9d\x98\xeaCc\xdb-\x96R\x938s\xaf5\xc3\xd5\xe75r\xea+Y\xae\x9c\xb3\x04]\bG\xa6\x93\xb6ϛk\x9b4Yק\xe3ۥ\xcbi\xb1\x94\xb1B\x95b\xe5X\xbd@E\xd4J\xb3^\fű\x92+Lۉ\xd6rm\xcf\xe9\x9dY\xb95\x98P\x16+X\xc1\xbcB\x14\x86\xbb\xe4\xfb\x8f*6\x12\xc2\x11\x9f7\xc7\xd5\xe5s\xd7\x0e\xf3\x9fQZ\xc2u\x82[-\x15Z\xe5\x10\xa5\xe9\xf3ߨ\xf3\xf7\xd1*졖 P\xb13Bf.\xc1\xa4\xd8\xc95bII\x0e\x15Y\xf7\x15\xa9\x8bdj29\xfd0)\x9fp\x11w%bJ\x06\xcaK!\xfa\v\x8d\x1b,\xb5\xb9j\xe7\x067\xe48\xfb8\xdb\xce]D\xeb\n\xde(\x81\x01c\xa5d\xbe\x87\x87oAǭ\x05b\x89\t\xa5o\x15\xac+\x9fS\x9a\x00Q`\xd1ɯ\x19]\th\xad2j\rc\xd14\xc0lϼ\xe5\xb9ɼ\xa6\xe4l1CTQw\"Y\xf7\xee\"\xab.[-j\xe7\xcap\xf5y\xd9ם\xbc\xa3YϾce\x95c%ۍ\xfa\x9f7\xa3\xa7\v\x95vU\x85\x01(R7ͼ\xfaZ\xac\xb1t\x94݃&Se\xd0\"\xab>\x99\xeb.\x8b\xb2P\x8b\xd6F\xb2k95\x94k#`\xa0%\xac\t\n\x84\xfb\xbf\x18\xea\x85\xc1,Ys\xca\xc7_-\xc3\xd5\xc1\xe9\x94j'xF\xb0í\xcfM\xc7_Kô\x1br\xb2\xac0\xa1sK\n)Wc\x05P\xc7G\x9c\x96)\fN\xb3\x9c\x1dD5\x87eRЬ˩t\x9b\x02\xccZ\xcau\x95ܢ\xe6-\xad\x83sH\a\xff\xc4\x00,\x10\x00\x02\x02\x02\x01\x03\x03\x04\x02\x02\x03\x01\x00\x00\x00\x00\x01\x02\x00\x03\x04\x11\x12\x10!1\x05\x13 \"02A\x14#\x06@$3B4\xff\xda\x00\b\x01\x01\x00\x01\x05\x02\xfb\a\xa9\x86\x1e\x9d\xb9t\xd8\xf9]\x9fEeX:\x93ځ\xfc\x9c\xc2w3/\xe1*\xc2&\n\x91\a\xbc\xa8\xden\u05f8\xff\x00\xc7Y\xa6I\xcd\f\xf6Q\x81\xa5\xd6o\x8c/\xb5o=u5\x01\"vxW]\x03\x119\xb4\xe4eGq\xa7o\x9f\xea\x1f\x81\xe8`\xef5<u\xb1\xd5\x05\xfe\xa6\x8b.ɶ驏{\xd0˔\xb7\xd5駴\xa9\xd7\xdf9\xd5\xea̢\xe6\xb7\xe4\xe4\xfdU\xe3\xd9b\xb6=\xeb7j\xc7bb1Xo\xecw\xa5\x10\xc0&\xbak\xa2~P6\xe1\x1a\x9a\x9cz/b\xff\x00\x13\xd5\xd8\xeb\xa1\xe8z\x18O\xd1_u\xe4&\xe5\xf9US/\xf5Gh\xec\xd66\xbe\x1b\xd1\fVQY\xba\xcc\xea=\xa6\xad\v\x9fmD1\x87(\x87\x8c\xf7\xdcG\xc8\xdc6\x93\x15\vF\xabCpy=\x01\xeaz\xebp\x1dO\x13\xc8\xf1;\x18\x87_a\x8f\x186\xcaa Bǡ\x87\xa3˽B\x9a\xa5\xf9\xf7\xdb\xf3\xf3\x063@\xb5\xa4\xe4ye2\x1b\x116\xac\xf1\xad\x8e\xdb!\xb78w\x15\x81\r{\x9dԵ\x8d\xd1\a\xd2\xd0tSѢ\xf9\xe9\xad\xc1\xd8\xea\x11\xc8A\xdf\xec\xd8xO=L2\xdbR\xa1\x7f\xaa\x01/Ȳ\xef\x9dt\xd9l\xaf\x1961\xcdk`\xa9\xa5u\xb5\x911@\x80\x05k\xf1\xc7\v@\xb6>\xc1J\xcbF\xab\xe9\xe4T\a\x9c\xe5\xbfT;\xe8\fh&\xa0\x8ac\xc4\xf1\xd4\xf7\x88\xc5Y\x86\xa3M}\x96?\v\xf2\xe9\xa6dz\x95\x8d\x1d˟\x88\x04\x95\xc4i\x8f\x88\f\xb1j\xac\x7f.\xd7\x05W\x931\x02\xa2Uŋ\xc4Y\xeeCV\xe7\x14u\xca\x15\xbeA]0]K)\r\x1d8\x9d\x91\x06\x8c`u+\x1b\x1a\x86'xˣ\xfb'r\x95-;B\x8e)\xdcc<\xadd\xac\xb58\xc0~\xc1\x84wb\x00\xbf\xd4jI\x91\x9dm\xb0\xb1\xf8\xaa\x96k=>\xd4z\xf1k\x051\x9dU\xef\xc5\xc77>U\xf3\x8dT\x0f\xec\xb4\u05cc)g\xab\xddo`\xf3nF\xdaZ\xaew\xe4\xf0\x9cn\xba&:$\\Z\xa5\xabj\x90o\xd3{\xae\x16\xbb\x04\x15\xd6\xf1\x85\x94\xc0\xb5\xdd\x19^\x87w\xd9=ǃݧ\x1e\x98\xf7561\xd9\aJb\x9f\xa7s#1\xadƩ\x81\x97Vkn\xa4\xeao\xad\xd6\xd7H\xc8\xf5I\x91\x92\xf7\x16%\xbeFa녽\xeb\xa6\xfb*\x01Q!\xbb\x8c\xc4U7Y\x8fO/\xa6ˁF\xb2\xb1\xb0\xad\xf5e!wjΪ~o\xbdK2PJV\xcb\xcb\xdbEx\xe9c\xb078\x9f\xc91\xae\f\x16\xd6P\xcc\fK\xc8SO!\xa4\x01\xd6\bO\xc3}*\x00\xbdt+O`$ʫ\x84\xadç_\xfdv\x13#6\x9a&O\xaaZŭ,|\xfd\x95b\x8dW\x1bi\x86\xcc~.\x9c%CqT\x10\x87\x8b\xa2{g\x88ݬ9\aU\xc8\xcaՌk\x11\xe9\xb2\xc47\xe3c\x9br\xef\xc8\a\xcdVp\x81\xc3\x02\x01\x9e؊\xaa%\x9fU\x8f_\x13V\xb6\xc4\x19\xf8\xc6_\x98\x89xAfe\x8eY\x91\x89\a\xa9 \v}J\xaa\xe6F}\xb6\xcefk\xeeb]\xed5T*\xb6E\b킅\x1f2\x9dL7\x81\x83\x1a\xf8\xa2Y\x94\xcek\xb6\xbe7]\xbc\x8aŰ:ַ{\x99.q\xa9\xaa\xab\x1d6\xcaG@u*\xb1Z\x11/~\"\x85%\xb44鮞@ڒ\x01\xe9\xda\x0fnq\xaeq\x05v\x90\x87aY*K(\x0e\xe6\xc9m\xa9J\xe4z\xac\xbf%\xee$\x92>\xf5t\xa5\xf5\xd5j\xd6J)\x88\x17i\xc6\xf1\x91W\xb4h\xc9\xfe˯\xacV\xadc\x1a\xa9\b\xf9V\x11G\xf3\ue88c\\\x93\xabs\xcc$\xb1\xf4\xea\xf9>C\xe3\x81\xd7\xden(90#[\xe8\xc9\b\xd7O\x13a\xa1\f\xb0\x13\x01J\xe5\x8e\xcf\xd3\xea\x9ac8\xc0\xc4G\xb0\xb3\x1d\x93\xfe\x85o\xc6\t\x84֪/\xf6K\x98-\xb9O\xd9)b\xbe\xcd\\\xaf\xb9)\x8d}\x8f]\xb7\x04\\\x9c\x9fzT\xe5eX\xee\xf3\xfe=\x12ܛ-\x1f\x10u\x13G\xae\xa7\x18k\x11\x86\x88\x05\x88s[\x02\x96G\xa9\x90~\xc1\x9c\xb5Й\xe7\xefkq\x86\xbe\x06U\x90j[\xb2-\xb5\xf1r\x85\xf5V\x81Ϋ\xaa_\xef=i_\tvBq\xb7%\xddeX\xefd\x06\x8a%\xb7=\xbfin\xed\xef\xac\xf7\xc4\xf7\xe1\xb4\xc2a:\x1d*\xb1\xd0\xf3\xaa\xc8\xc9\xc6kS\x9f~;\x9c{\x8e\xe3\xeeo\xb1\xed\x0fS\xd3E\x8dX\xefA\xfem\x8du\x87ٙY\xae\xe5\xb9\x11*ı\xc7,z%\xd7=\xa7\xee\rmiF\x1e\xcaKx\x88#\x1e\xb5\b\xeb4D\xe57\x1d\xb6ߣ\xd8\xfd\x80\t\xfbh\xc5\x1c\xbf4\xb0is.kj\xa9\xfa\a\x14\xb5\xb7Yq\xfb\xea\xc5g\xb8OC\xdak\xa2\r\x91\xda?y\xe0\x90\x1a\x15Џ\xda\x1e\xe1~@\x13;\x02\xccH\xea;\x97\xd7/\x89\x94\xbf\x06\xaf\x1d-\x16\xe1q\x9e\xf6=#\xdd:\xfcƿ\xd2\x03@U\xdd\xc0\x03R\x88:\x1e\xa1\a.\xccr\xab\xf6n=\x8f\xc3p\xb1? t~djW\x96Ջ,kX\xf4V\xd4#\xfd\x1aW\x95\x8e\x90h\x8b\x16?\xe3A\xfa\xff\x00P\xf4އ-\xa9\x03W\x0f\xe4\xe2E\x10\xf9\xff\x00G\xf4GB!\x1d+0\x8d\x1f\xbfZ\xf25.\xd5X\xd4\xccܣ\xfe\ntߦ`#<\xe7>\xa6\x9f\x88\x98\xf7\xfb,\xc7m\x0fp?\xd1\xdf\xc0\xf5R\be\xe3\xf7\xe8\xac\xd8V\xbfm,\x1c\xa3\x0e'\xcaNlG\bQEj>\x16w\x1dOc\xf6\xe8\xf4\xac\x9bk\xc9Ʒ\x1aΧ\xec%\x9d\x8d[\x84k\xee\xe2v\xac\xc7\xef\x1e/h{J\x8f\x7f,Ǜ|\x0e\xa1\xec~\xe0\x04\x9cOL\xb0>NmMu\x97\xd5}O\x89\x81d\xca\xf4\xcbiK)\xb2\xb1\xf6w\xa9\xef\x1dr\xa8\xcd$\xf6\xc4\xf6\x8c\xf6\xda{o8\x9f\x968\xfe\xa3\x0e\xa3\xf4\xb1\x02\x98\xbd\xfak\xae\xa6\xa3|\x1f\xe7\xa31}-\x99\x05\xd5\xe3\x01\xbb'\x11\x1b\x88\x9ac1nt\xbb(\xef\x1c\xfd\xadM@'\xeenr0Z\xe2\x7f&\xc9\xef)\x84\xd4a\t4 \xbbC\xddc>\xa3\n\xf6Yg\x8dE:\x8a!\xea\xc7q\xf4\xac\xc2~\xfa\xf8?\x0fA\xc4K\xed\xb73ڮ\xc7{\xac+\xb5\xe5\xa2Oa\xb3q\n\x92\xd6\xe6\xd8\xf7\x1cw\xf5\\/\xe3?\xda\xec>\xd5U\x1b\"V\xa9ѡ\xf2|Mr\x1b\x97&\x8dVu\xb0\xe9fo\x15\xb0\xc7\xf1Ն\xc7\xc3\xfcyl\xa8\xbbsu\xf0#\xf64\xd3c\x87ƵW\x8b\xccL{\b\xa2\x84Iz'\x1c\xfcV\xc4\xc8\xf9\x81\t\xfbT\xe3n\x01\xd8\xf4n\x9ea\xf2\x83f\xe4(\xe9\xdd,N&\x96\x8f\xda1ٞ\xa6\x9cnn\xd3[S\xd8\xf5>z\xb5\xc1}&\xfb)\xb2\xaa*\x16J\x856\x0fO\xc6\x19\x17\xb5\xaa\x80\x86s\xd8\r\x92\xc3\x1d\xa3\xd5jOV\xa9n\xc0\xf8\x81\xb9\xd9a\xef\xf6Q\v\x9ahZᜡ$\x91\xe0\x9e\x80F\x11;K\xb6_\x1f\xba\x90\fu\xe0A\xdc\xfd\xcc\xccqm6\r;l\x1b~\x1ez\xe1`\xe4e\xcc\xea\x1e\xafL\x1ei\xbf\xdb\\\x9c\xb6\xb1p\xe95\xe3\x9dV\x87!\xf9\v\x8bT\x88+OR\xf5\x1b\xa8ʥ\x8d\x94ڋ\xfc\x8c\xefLlz\xfa\xf1\xd4\xe5<C\xdf\xecQA\xb2\"-jL&\x1e\xa7\xa0\x84o\xa3\xcc\x7f\xfb\f\xf6\xb9-\x89\xc0\xfe\xc7s\xff\x00\x9c\xd5\xdd\xc7\xc3w\xf8\xbc\x1f\x95\xb52V\xa1\xf5\x96\xcc\xd9IQq\xe9\xf8\xcb\xcf\x1b\x93Y\x92\xac\xf5\x1a\xad$\xd7\xedP\xac\x1dY\x15\x8d\xd6\nԫ\xeb\xd5\xdf\xd8Ċ\xa5\xa7ң\xf2\x9b\xed\xf6(ƞ!0\xfc[\xa0\xe8V[\xf8\x8eք\x02\x11.\xf0{Ds\\\x13\xd4h\x96/\x13\x1c|u\xa9F~U)\x8b\xeb\x8b`\xbb\x81\xab\x17\x18\x18Ou\xc7]65&\x10j\xb6\x04(K\\a\xd2\xcfZ\xcdzYػ\n\xb4\x19\xb9\x9e!#\x12\xc7\xe6\x88\xcei\xa0$\xf1\x0f\xcb\xf6g\xee\b\xe2?`ҷ\xb8\x06\xc8e\x8c\xfc\xa5\x9a\"4\xbcr\xaf&\xbe.Ѿ.:\xff\x00\x8e\xe5퐑0\xbb\xa6_\xabՏ\x933;\xd9ю\x87\xa9\xe6\x7f\x0e\xbe/k\x0e\x14\xcd=\xa0\xb8\x03\xecQ\x8c\xd6EE\xaco\xe1\xfb\xf8\x1f\x83\x90\x8a\x11\xae\x9c{c\xb7\xf4\xd8\xf2Ӹz\x1f\t=B\x93\f1\xa0\xf80ш\xc5\x1b\x0f'\xf9\xd8\xfe\x9fj\xf1\x18t\v\xec\xb5k\x03e\xb77\xc8\xfa\x87\xaaW\x8b\x19\x1e\xcb\x1aݏlTlf\xb0\xfc\xc0$\xe3\xe2jn7ǋ0\x1d\x0fC\xe0t\x03\xb2/\xbdqX\xa9\xcb*\x97\xd2\x16\xd4x`=<\x1c\xef\xa9.]0M\xc6@\x01\xf80\xd8酕f%\xcaə\x87\xb0\x19SQ\xf6\x83#\xd5q*\x99\x1e\xa1\x95\x9b7]1U\xef\x9e\xe0\xac}\x8aikM4-!\x9b\xbe\xe1;\xe9\xe4Ƃ\xe7\xf6\x80\xd40\xcdvi\xad\x94^2\xddk\x1f\xc1mJ\xbe\xac\xff\x00\xc1\xf7\x18\xc1\xde\x11\xae\x8d\n\xf2\xaf\"\x81\xc2\xc6\v\x1d\x8bC\U00073be40\xc8\xf4\x9b\x9e\xbcQ\x7f\xaa\xe5[e\x963\x94\xa3\xb5\x97n{B\xb9c\xb5\x9d\x18\x11\xf3\xc6\xc5/\x15B)\x84k\xae\xa1\x83\xbc\xf1\xd7P\x88'\x1d\xc0 3!\xb5)\xedS\x1d\xca\a\xfc\xf6\x1b\xb5\xa1\x89\xe0\xf5\xfdds\x02\xd4\xe2\xc4B!\xf8kp\xf9\x8ā\xb1%\x10\xd8\xe3\xdb\xc6 Y\x93\x03\xadBc\xfa}\xd7\ni\xa3\x0ez\xc5\x17[{)V\xea\x01'\x1b\x10,#\xb1\x1b\x86\x15\x87\xb7O\xdc\xdfQ\t\x9f\x94D0\xf8#dK~\xbb\xfc\xc3\xdac\x7f\xf7\xd7\xdd\xee\xd0-\xe5|\x1e\xa7\xceJL\xb4\xdc\xe0a\xed\x1b\xa8\x04\xc2ʑ\xff\x00\xb4t\xab\x18\x99fF\x80\xa8$\xb2\xc2\xf1T\xb3a`-\x11\xcfw\a\x81\xb0ж\xb9\xb6ޔ\xd2ֵ\x18\xebJ멄v\xfdt=\f\x1d\x15w\x02\x05\x86\x18c6\x97\x1cr\xb3\x94\xe3*`2\xaa:O3]\x0fS/\x1b\xa8\r\f\x82vf\xb6[\xce\xc0\x86\xc3\fV\xe2\xde\xd33qJ'\xf6\xe4\x95t\xa7\xa6'\xa7\xd9xƢ\xbcp{G\xc7G\xae\xe2\xbcl\xa8dRA\x04\xcc|FyZ\xaa,n\xeb\xb8a\xe8u\xbf$\xf4\x02\x1e\x8b^\xe2\xafha\x8f,\xfc1\xff\x00\xeb\v\xa8>\xa9\xb1\xc8x\xe358\xcd|mڶP\x9a\xe8c\xcf\x1d@>\xd2ԫ-\xb4\xb8ƥ\xb2.\xa7\x13\x1a\xa6\xf2Y9=\xaaX[u\x93\x1a\x93\xbc\xfc\xb1M\xaa\xadc\xe2\xe1\x04\x85{\x05\xee\xda\x11\x9f\xb1\x9c\x8fO\x1d?Fx\x9eg\x1e\xea\x9d\xf8\x81ь&\x18\xe61\xe5+\xb3\xdb\x02\xf5hX\x18{V\xbe}\xbe\xde\xdc5\xc3_\xc3\xf5}\\\xe5\xa3a\x94\xc2!\x84w\xb3\xa2U\xb0\xcf\xf4W\xd3\xd0\xebdU\x1a\xea\xee\x128\xadf_\xa9\x93)\xa2\xcbޚ\x92\x95c\x0e\xf7̈\xdd\xcb\x0e0\xcfў!\xe8|J՚\x05\xe9\xe2\x13\x1b\xa36\xe1\xfa\xa0\x00KT\x97\f\xb1\xb4%\x87\xe9\xaf\xf2\x03c\x84\xe1\x1a\xbf\x80\x84OP\xaf\x83^\xad\b\x84C8\x1d*\x85\x84\x93\xd0\xf6l*?\x91\x92\xea\xc9X!\x86\xe5\xf9\x15\xd3\x1fԭݖ=\xad\x8b\x84I\x00\x01?Nf\xa3O\xdf\x19\xa8|\xf41\xbbĮk\xa1\x8caޏh\xcd\x06\xec-\xa5_\xcavP\xca\x1e=\\\r\xdeD\xc7\xcc5\x8a\xf3ih\x8c\x8f8\xc3\xf03\"\xafq-\xac\x18\xfeL1\xd8\xfb}XlT\xec\xb3\xd2/\xb8\xe5f\xdbU\ro\xa8\\\xf3̭\x1aÍ\x88*\x9f\xa6&k\xa3MMv=\xba\x915\xd0\r\x95P\xb3\xc1\x80Fhg\x88\xde?#\xc8\"\x9f\xaar\xd4\xe59wcȟ=C\x11+̾\xbf\x90\xecs++fB\xf7u+\xd0\xfcGgGj\xc9%\x8c\xc6\xc5{\xa5u-Ja\xf0D\xe7ٚ~\x89Q\x0e\xcc0\xf7\xe8z\"r\x81u\xd3]\xf5\x18\xcf%\xfe\x98\xc6~q\x86\x81\ad\xc2g)\xb8<}\xb33S\x9d\x17'!c\x16$C\x0f\xc1\x87@710u\xd3}\xf9}0\x9dC\x19\xfb\x94\xd4T\xdc=\xcc\xd7c\xe7\xc9Jf\xb4\x18nk\xa3\x18{\x97m)3\\\xa1\xfaz7\xd5\x18C\x0fC\xf8\xceӎ\xe1R:\x8f\x9d\x9fM\xb6\xa6\xac0\xc3ԝ\x05;\x14\xd2\xf766:R5\xd8\xf6\x84\xc07\xd6\xce\xc1<\xb0\x1b\x9eH\x9b\xd8\xd6\xe2\xd1\xc6?i\xa2f\xa6\x840\xf91\xfb@\xb0\x99\xf8\xcf1\xa3w\x84C\x00\xee\xfez\x87e\x9c\x81\x9a\x10w\xf9\x19\x9a\xba\\\x94\xfaXMG\x10JJ\x9b+Ī\xc9F\x00\xe4\x11kA\u074b蘃\xa6\xa7\xe8\x8d\xc3\xd8\xfe\xd6kq\xbf\x15^N\x88\xa8l\xfaJ\xa4\xf1ѥ\x8d؈\xe7QV1\xefǂ\xf9\xe8~\xa9\xe6?x\xc3_25\xd3\xff\xc4\x00(\x11\x00\x02\x02\x01\x04\x02\x01\x04\x03\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x11\x10\x03\x12 !01@\x04\x13AQ\"Pa2q\xff\xda\x00\b\x01\x03\x01\x01?\x01\xf9\xad\x94VR\xb6}\xb2\x9a-\x1b\x1365\x8d\xde;72\xfer\xc2-\x1b\x8d٦\\\x90\xdf\xecN\x87?\xeb\xda\x12\xb3j\x1a\xc2śĬp\xfe\xbe_\xe1\x167F\xf1\xbc\xedC\x81\xff\x00&\xfc%\xfdeb\xb2\xa0\xd8\xe1\xfa\u0091\xb8\x97y\xbf\xe9\xa8Pm_\x05\x9a\xc7V.ʢzw\xd8\xd3^\xf2\xf1\x15|ܔ}\xe3zݷ\x86\xe4\xfd|Z(J\xfa>ڇ\xfd1\x8d}\xb5\xfe\xf0e\x88\xb3\xb6QF\xe9\x171\xef~͒\xe0\x9d\x12w\x9b.\xbd\xe3R\x1b\x90\x8a\xee\xf8CKo\xc4D\xbb\x8e!=\x97^\xf1\x1f\xd8ӿ\xe4uź\x17x\xbc:\x16\xef\xc1\xbeH\xfb\xa3\x9a|ixn\x8d]e\xa6\xac\xd3\xfa\xa5\xa9膪\x93\xa5\xf1\x17k\x1db\x13\x8dm\x90\xa7\x05\xed\x12\xa6\xfa\xc2B\xe8d\xd7\xe4\x8f\xfa{=\x17\x88ʋ\xb2\x91\xb4QD\xbbc\x8d\tx\xe7\xa5-ER4\xfe\x96\x10BJ=#r\xf5\xf0\xe2\xc7%\x17ٽ7B$\x88\xb3k\x9a\xe8\xff\x00ѱv1\xae\xa8\xf4\xba\x13\xb7G\xf8.\xb2\x9d\x11\x92x\x9c\xab\xa2\v\x12\x8dx\x1b\xae\f\xb9>\xa2B\x1b\x7f\xf7\x15\xf0P\xd5\xf4ͮ:\x9d\x96\x97r\x1c\xf7\x89\xd1\xf4\xda\xdbglՒ\xbe\x8bl]\fR.^\x90\xa0\x96\":\xe1\xf7\x1d\t_\a\x11\xfc\xf5\x8a\xdcG\xbfc\x87}\x1b\r\xbf\xa1\xa8\xfeI\xef\x8a\xe9\x1a\x1a\x89?\xe4k5/BU\xeb\x14t\x8b\xe4\x9d\x11\xef\x85\x0e\x03\\\xef\xe3\xd9f\x9b\x8c\x95\xfeHR鎆\x9b]\vW\xf9\xf4O[r\xa1*\xc5\x1d\"\xfcKP\xfb\x88\xfb\x88\xfb\x86\xf7ʳe|j\xbe\x90\xa2\xe3إl\xfa\x84\xb4\xe5Hr\xfdf\x8e\x97\x9dA3b'K\xd6\x1eb\x89D\xdb\xfa\xf9)\xd1\xef\x1a\xba\x8e~\xfe\"too\x1e\xb3\x15xxjƾDY\xa9\x18m\xeb\xd8\xd2:E\xfcJ\xa1DcD81\r\x15\xf1\xbd\x12\xd5\xeb\xa2\xfe*\xcb$i\xfb\xcbϲ\x87\xf1\x17\xc8Jȡ=\xa5\x92\xf4.\x9e\x1c\x92\x1c\x8d\xc7l\xf5\x89|[\xf8\xf1\x8d\x91T\x89!\xf4{X\xdc\xfd\x1bJ\x12\xe1/\xeb4\xd7Xc\x17X\x8e\x17'\xe6l\x8e\xbc#\xecz\xd1\x14\xe2\xfeT?\xe72ĕaw\xceK\xc8\xdd\x0fS\xf4I\x96+e\xa3FܨՊ\x8fK\xe4-Fogl\xda\"XN\xb8\xb7\x86\xbcz\xbbt\xe3d\xa4\xd9e\x14I(\xc7\v\xa2\x12\xf8\xd1\xd3r#\x05\x12\x87\xcaH\x8c\xb3'\xd7\t/\v'-\xcf\f_\xa1&\xfbC\x8c߳d\x8f\xb5)2\x1aj\x03_\x12\x1a_\x97\x87\x87ŋ\xb45D_\xe0ln\xf0\xbde\xaa\xf0oP\xed\xa3[SNP\xfe+\xb3C\xe9\xdc\xfb\xa2z\vM\x0e\tϢ\x8aś\x85!\xfc\x15\x16\xfd\x10\xd3QŞ\xf0\xf2\xf1/d0\xd5\x17\x98K3^\t\xabCTCꢢKY˨\x91\x85,F2\x91/\xe3\xc3\xf1\xf0!\xa7\xb8IG\xd6/\x9bĈ{\xc6\xdb\x1ck1\x16\x18\xf9\xe9\xc9E\xdb>\xa6{\xe47F\x84\x1f\xfd2X\x96\xa7\xe1\x0f\x87\xa1\xf7憗\xe5\xe2\xf9>\x14Kн\x95\x89e\x10y\x9a\xe7X\xfbk\xde/\t\xe2\x8a=y\x94\\\xbd\x10\x86\xdf\v\xe3,FR>\xe7\xf89^Y\xa6\xf2\xc7͈y\\\x1b\xbe\x15ᆞ\xe1*\xf5\xe2|\x1b\xa2\x9c\xb1\a\xd0\xd9,\xb1\x10v\xb3%\xe0]\x0f)b\xe8\xf7¼0\xd3\xfd\xf9\x1f\x05\xfc\x9e%\xec\x8b\xeb\x12\x1f\r&2\x86\x89*\xf0\"\x91h\xdc[\xe0\x97\x861r#\x15\x12\xf9>O3\xf4i\x96O\xd9\x1c>\f\x83\xa7\x96Ix=1\xbb+\x85\txc\xa7~\xf0\xfc\xaf:\x8c\x8fK\x13\xf6Dc\xe2\xba \xef,\x92\xaf\"Xl\xbb\x17(½\xfc9w,\xcf\xd9\x12\\\x99\xa7#\xdef\xbcicSSi\xfc\xb5\x19\xf6i\x1am˄bؒ_\r\xb3M[̅\xeb\x9b\"\xfb\x16h\x9a\xa7\xe0K\x0eTK[\xf4+\x9b\"\xb6\xfa5']\x10\x8e\u0558\xe9\xfe\xfe+\xf4C\xd6_\x85\x91b̗:\x199\xedV\xc9M\xc8R\xa8\xd1\tmd%\xbc\xd9\x14!!F\xb8QExk\x85\xe5\xf6)Q\xbe\xcb\xf12,\x8f\t.\x1bE\x89\xbaD\xa4\xe4\xed\xe60r\xf4F;U\x14(أY\xaf\x80\xf8\xcdwk/\xc7\x06{̑B\\\x18\xfdaE\xbfD4?b\x8db0\xfd\xfc\x7f|\x1aLq\xa1\xf8\x99\aB\xcb\x1a\xe4Ͷ(\xa5\x84\xacP\xaf\x91\xe8\xf7\x8b,\x93\xbf,$5\x97\xe1\x8c,J\xb8Wȿ34\xdfb\xcb\x1f8\xe9\xfe\xf9\xb7F\xe6%\xf2\xab\xc5臡Ꮜ`\xe4(\xa8\x97ɛlI.o\x95s|\x1f\x95\x9al]\xac1\xe1\x11\x8e\xe7B\xd2\x17\xcfc^\x1f\xff\xc4\x00)\x11\x00\x02\x02\x01\x04\x02\x02\x02\x02\x03\x01\x01\x00\x00\x00\x00\x00\x01\x02\x11\x10\x12 01\x03!@Q\x04A\x13P\"2aqR\xff\xda\x00\b\x01\x02\x01\x01?\x01\xf9\x15\x97\xeb\t\x1a\x8b\xc7\xe8\xe8\xd6vS53R\xc5rR+\xe4\xd1X\xacK\f\xd2\xcd%b\xcbG\xa1!\xab4\xff\x00M\\\x1d\t\x8d\xd1lXx\xa3H\xdd\x1a\xbf\xa3\xa2\xb8\xe3\x7f\xb1\x89\x1avj5\x1d\x9a\x7f\xa1\xa2\xb8/6^-ذ\xe4)a\xa3H\xbd\x7fA\\\x16Yee\xecw\xfa<z\xb4\xff\x00\x97c\xf4]\x91\x95\t\xde\u05fd\xc9.\xf1\xad^\x9d\x97\xf5\xcbEo\xd4X\xd9m\xf5\x8bՇ\x85\u05fc2\x8fH\xb2\xcaEDZQ\xa9l\xecKe\xe2qԄ\x8a\xcd\x10\xf1\xe9:⢸\x18\xbb\xc3W\x86\x7f\xe6ղ\x8e\x84\x87F\x94h\x14k5\x8b|2t\x89\xf9\xa4\x85\xf9\r\x9e\x1f#\x97\xae\n䗧\x8fxw\xfa)\x8b\x17\xba\x9b+\x12\x8d\x95E\x966/HN\xc7\xc7/\v\x97d?\x1a\x11\x12\xa2\xd7[k\x9ahn\xd1\x1f\xfa1<Z]\xe5\xe7Q\xd1m\xbfG\xfe\xe6\x87\x1a\xc4U\x92\xc2|\x17\x9bD\x95\x9a_\xe8\x8cTq_\x05\x95\x86,N>\x88\xe5\x1aI\xc0\xd3\xf7\x89\n\xf6hC\xf5\xb1>/G\xa2\xca\xf8o\x0f(\xbc*d\xd3\xfd\x10\x8bBC,\xf6\xca\xde\xf6ط\xd7Ǣ\x89E\xa1\xbcX\xfd\xf6C\xc5N\xc5\x1cY\xed\x95Ť\xd2i4\x9awVk\xe47c^\x8f\x1b\xbfأ\xf7\x9b=\xb2\xb9\xb53S\x16\xd912\xfe\xca\xf9~?\x1a\x8ejī\xe0Q\xa7ku\x85\x9b\xf9\f\xb7e\xb1&\xfb\xf8\xd6,K\x81|m\x1f\x19\xe5\b\x9f\x02\xfe\xb1\x8d\x8f\xde\x17c\xc2BEl_\xd67Cvŏ\xde4\xa2\xf1{#\xf0/\xe6O\xbc!\x0f\x12\xc3څ\xcd\xdfC\xfcy\xc8^\t\x0f\xc75\xf2\xa5\xdeV\x13\xbe\x18\xbeD\x9b菇\xff\x00\xa21E\f\xa6y\xabM\x9e99|\x8d\x06\x94z/\v\x0fj\x10\x9f\x1f\x89?#\xa21Q\xf4\x8a/\xf6X\x9br\xc3<\x90\xb5\xf1\xa5*\x1c\x9b\xc2ܘ\xd6V\x16\"\xf8\x7f\xe1\b\xe9T!\x0f\xecr\x8a\xf4\xc58\x1f\xc9\x13\xf9\x12C\x9b\x91%\xf1%\xe4\xfaض\xa1\x89\xd9$!,~\xf1b\xf7\xc1?\x14\xbc\xabL%L\xfc\x1f\xc6\xfc\x9f\x0f\x99\xff\x00$\xae'\x9bϣ\xd2?\x96Rc\xf28\xc3\xdfxӆ\xcb5|&\xe8\x94\xef\x15\xc0\x89\x16'{$\xb3\x0e\x0f\x14\x92\x90\x9a}\x12\xfci9Y\x1f\fa\xed\x8f\xfc\x9d\xbcKʣإ\xa9Z\xc2\xf8R\x9d\r\xde+\x85\x12\xeb\x1a\xa8N\xf3,\xae\x0f$u*?\x16\n\x10\xa2\x8f,\x97H\x88\xfa\xf4G\xc1\xfbel\xe8\xef\x9a^O\xaeX\x8f\xa1\xcb\x10\xd8\xf3\x17\xbe\xb0\xa4ꇔ\xf1F\x93\xaef\xd2\xec\x94\xef\x85lBģ\x11x\xd7أ[$\xb2\xb8\x18\x9d\x8cK\vcw\xcb/%\x16\xdf2\xf6Z\x8e&\xbd\x91B\xd8\xc7\xe9\xe6/\x83\xa1\xe5,]\x1d\x97\x8b◓\xeb\x7f\xa5\xc1czc\x85\xfe\xb6I{([f\x84X\x98\xb8:e\"ѫ\xe8\xf6u\x97\xc3)i%'\"\xb7һ\xde\xd9\x0e\xcf!B\xff\x00Q\xe1m\x9a,J\xc4G\x82\xfd\xd9w\x97\x8b◒\xba\x1f\xbf\x83\xe3D\xbd\xbc.\x89\vs$\xa8O\bO\x8f\xae9\xce\xfa网\xf5\x1c\xae\x89\v|\xd1\x1fY\x8b\xe0\xbc^c\x17#\xd4\x11\xfc\x96M%\xb2R\xd297\xcde\xecJɿY]\x0f\xbe\t/C#\x8b\"\xef\x7f[\x17\x8b\xec\xf5\x149j\xec\x84\x7fd\xe5o2\x9f\xd7;\x95\x17\x95\x85\xd9>\xf2\xb8\x9a؟\fS\x93#\x15\x11\xc6ݒV\x89\xc7B5\xb6\xb1t9\xde\xdb\xe2r\xca\x12\x16\x17\xa1\xc6\xcd\x14W\x1bC؞o\x0f\x15~\x84\xab\xd6e%\x1e\xc7-X\x94\xa8n\xf9\x9b\xa2\xf3B\xdb\x17\xeb\xdf3[\x13,oj\xef\r\xa5\xd8\xfc\xbfC\xf7\x89y>\xb8/{\x97\xd6\xc4!\x14?[\x13hR\xb1b\x8a\xe0\x92\x1eP\xb7\xa6\xf2\xdd\x12\x9d\xf3\xb7{\x12\xca:;\xc5\x14P\x95n\xad\xf2B\xca\xe1\x94\xe8n\xf6_\x13t]\xecK\v\x1dm\xaey,,.\ty>\xb7\xa4P\xde\xe7-\xa9b\xbeD\x84\xf0\xb7Jj$\xa6\xe4V\xe4j\x1b\xbd\xceB࿏#\xa7\x95\x86Jn#\xf3\x0f\x99\xfa.\xfbܰ\xb0\xb7!p\xff\x00\xff\xc4\x009\x10\x00\x01\x03\x01\x05\x05\x05\a\x04\x02\x02\x03\x00\x00\x00\x00\x01\x00\x02\x11!\x03\x10\x121Q \"0Aa\x132Rq\x91\x04#Bb\x81\xc1\xd1@\xa1\xb1\xe1Sr\x143C\x92\xf0\xff\xda\x00\b\x01\x01\x00\x06?\x02\xe3\xe7S\xc1\x8cX\xbc\x96&\x99\x17:\xd1\xfd\xd1[\xb03\xbc\xb1[\x9a\xe8\xa8\xc0\x15Q\x95\x02\x81P\x90VX\xba\x85\x0eh[\xabt\xcdۜ\\\xd1n\xaaE\x156\xebƗ\x90\aU\x16C\x11ԭ\xf7SK\xa5\x86\x9c\xc2v\x1a>2V\x8a\xab\xb4\xb4p\x034hn\xc9Qba\x1e\xab\x9f\xaa\xe6\xb7\xdbUE\xd5b<\t9\n\xa9檺l\xe2\x1c\xf8\x1082\xa9v\xfb\xab\xa2\x8b\x16\xe1\x1a\x956\x8e$\xec\xc8[\xa4\x84\xdcX\xb0\xcd\\\xb7d\xb4s*\x99,\x96\xe8T\xa1Q\x92\x82\xe5Q&\xfa*\xf0\xea\xa9un\x87dx\x12\x81p\x8b\xe8\x15v*`\x05\r\xdf=\x15\x0e\x06\xfc\xbbt[\xe43\xcf5F\xc9\xd5ɤ\xd6\x106Ya\xaa\xdc\xe5\xc9URT\xaa\xae\x8b[\xaa\xa2.\x9e\x1c\x15\x89\xbfP\xa9v|\x101g\xb5/p\x01E\x8bg\xab\x97\xbcq=6\xf7\x1bMy-\xe2m\x1d\xe1b\x97\x96{;?t\x7f㼺3\x95\xba\x16\xf9\x94p\x88\x05\x1bK>\\\x82\xd2\xd7\xf9PEWEE\xa8T\xbe\x0f\x1f\x13{\xbcx.\x97x[U\xee៹Rd\x9dN\xd4\x01%{\xd2,\xc7\\\xfd\x17\xb9\xb2\xc5\xf3\xda\"}\xa2۴-\xf8,\xf9\"=\x8e\xc4Y3\xc5\xfd\xacV\xf6\x8e\xb5wOʆ\xb4Y\xb7@3R\xd5.\x86\xfdW\xba\x13\xf3\x15\xbeq \xdbF\xe4\x8c\xd3B\xa2E\xd23Z*\xec\x1dT]\x1b\x10\x017\vM\xdc$\xc0Y\xaa]P\xa9\xdd\xe0V\xe9Rh\x11\x16^\xf0\xf4\xcb\xd5w\xe0h\xca-6CFf\x884\x96e9«\x8d\xab\xbc,U\xc1\xec\xec>\xaa\x18\xc3mi\xd5{\xe7\xf6,\xf0\xff\x00Ku\xa5֟7\xe1\x00M9h\x83\x9e渏\x84U5\xcf\r\x11\x9cs(\xe0\x18D\x95\x86֕\xad\x11e\x8e&\xbf\xe6\xf8\x94\x06\x99[\xd4j8\xc4\xf9\xa7\a\xb8\xbd\xbf\f6J\xa0-\v\xe2U\a\xd1w}Tb\xecߣ\xb2P\xf1C\xe8U\x0ff\xfe\xb9-\xf6\x90U\x14\xdcQ\x8b\xb15\xce\x1c\x8c\x18\xbb\xcdJ\xfe\x95\x15\x95\x91\x16qg\x91\x02\xab\v\xb2Qˑ\xdb\xe6Tڼ5{\x96S\xc4\xff\x00\xc2\xdfq\x7f\x9e^\x8a\xbbo\x86\xb4\xbck\xa2\x048P\xa7\v7a\xc5\xcd\x17\xfbE\xb3\xadm4g\xe5E\x8b\x05\x98韪\x06\xd8\xd3\xf9AӋvO\x9ap\fv\xef9Vl\x88kE`'\xbaϹ\xcda\xf8\x80\x93E-\xab\xb2!7\x18-\xd1ɭ\xb6\x81l;\xae\xd5\x10\xec\xc2ݩD\x96K5)\xc3\x196\xa6`7 \xa7\x03]\xd4P\xae\x7fUV\xaa\xb1\x10ӻ\xa1YG\x92\xc0\xf1\x8e\xcfB\xb1Y\x19\xf9y\xa9\x0e\xfa*p \xac\xae\x0e\xc8\xff\x00+\x03\xf2\xe5\xd3j\x1c\xe9w\x85\xb5*\x1b\ue1eb\x94\xfc^#R\xab\xc1\x0ena8\xb7#\xcbCwgٞ\xae*'\xaa\xc8\xeb+\f\x90\xe4:\xe7\x89<\xb9\xecl\x93\x88\x87\x85\x82ȼ\xb3\x17w\x92\x06~\x8a\xd1ϜO9\x95\x89\x82\x87\x9a\xdelƇ%\xbf-n\xae[\x83\xb6~\xa7$q\xbbs\xc22\xbb\xa2\x95\xdd\v \xb2\n\x97w\xb0\x95\x0e\x1fP\xab\x92\x91\xb7U\xbb.\xa7\xa2\xd0)\xc2\xf7\x1f4\\\xd6@\x17\xc9DY\xfb\xd7\xf4\xc8}U_\x03\xc3g\xf9P7GN/\xcb\xcc \xf2q30\x9e\xdb8kM\x05\x13\xdbi\xbcA\x9a\xa2\xfb9\xc3\xcdG4\x01\x12\x8b\x9e\xd18\x8cs\x80\xa0~\xca;\xceUc\xbc\xa2\xa1o\x1ah\x11\xec,\xf7\xf9\xbd\xc8\xe3.\xce<\x97\xbev\x12\xa2\xc8CJ\x9eWQh밌ԭꝚ]\xcdW\x12\xee\xbf\x16\x92\xa43-J\xa3j\xb3\xfa.\xec\xa9y\xfa\x05\x00CtSh\xf0\xd1\xd5{\x86O\xcc\xfc\x97\xbcy\x7fL\x82\xa9\xa7\x1c{\xc6Y\xe9+\xb3\xc4\x1dg\x96!\xaa\xc3\xea\xa5\xe0\xf6\x8c\x15\x8ecU\xd0rM\xb4a\xcdo\x8f\xaa\xc3\x12|\xd41\xb8OE6\x93:\x04\xf7Y\xd0\xfd\x93Y\xf1\x19\x97\x15m\xdb>'\xeaTX\xb7\x0f\xccsR\xe2I\xea\xb7\xecˬ\xf5\x14V\x81\xb5q\xa0\x03!\xb1\n\xaa\x94\x17\xd3b\xaa\x85Cj\xedc%]\xf7\xfe\xcbx\xdd\xce\xf8X\x8c\xb9ں\xaaM\x7fC\a\xba\x9cҀ\xb5i\x11\xdcq\xe7\xd14\x83\x85\xc3\"\x9c\xd9\f1$\x03*\xa2IC\b2\x98\xd7:_\xfc\"\x18'\xec\xa5\xee\xdc\xd5\x01l\xd7a<\xb9\xfa(\f\ro\xee\x9c Ts\v\xa6\xa5\x7f\x99\xff\x00\xb2\x82a\xbe\x11\x96\xdd6\xe0*\x19U8eLH\xd4*\xdf\xcc~\x82\x8b0|\xb6h\xd0]\xc9\xcb\x13\xde\xe2S\xa7\xbe\x06\xf0\xd57G|\xbf\x95B\xebg\xb6\xb4\xc8.\xf0h\xf9P\f\xa3\xe2L\xe4\xe4\xed\xe6\x86\xf2\x19\x92\xb0\x8d\xc6h.\x9c\x9b\xa9T\xf7\xaf\xfd\x96\xf1\xa6\x83.\x15BȬ\x96W\x8c7\ue57e;7j2\\\x88\xd4d\xb5U_N<rہR\xbbG?\x03\xc6M\x19\xab'\xbc\xcbg$\x1d\xec\xec\r\x11\xbc9\",\xb7\x19\xfb\xa21\x1a݈\xee\xb3\xc4\xe5\xb8;W\xear[\xe7\xe9ŮJA7@\x1b{\x85u\xba\x88\x9e\x158aÒݠTM\xc5k\x8b\xe5\n.ǅ\xae?2\xf7\x8e\x9f\xd0S\x8a\x05\xddv\xa8\xab_\xaa\x8eZl\x9c&F\xbbu\xee\x9c\xd4ck]\xd4\xf2N\xde\x18\x19\xf1\x1ek\xdc\xd9c\x7f\x89\xf7u\xfd\x1fU[\xa5\x1d\x9f5\xd32\x8c\xe6\x8byrS\xb5\xd3N8\r\x03\xcc\xf2R\xf2I؟ЀHoR\xbb\xc2\x14\x8b\x86\xcc]\x84s\xcdof\x83\x87\xfd\x8c\xba\x0f\xea`\xe5\xfa\x1c\xe19A\xc9gK\x86\xd4\v\x8e,\xb4D\xb4@\xba?S\x85ު\xbcs\x19(U\u0605T5<\x19\xe2\a\xc3X\xd7e\x8cĬ\x16\xcd\xc2\xee$>\xadSfqtU\xe2\xf9\xec\xd2\xf9\xd9\u05cd\x02\xa52\xd3\xda\xf0\xd8\xd9\xcc\xc3\xf3?D\xe2֗\b\x84\xd6{O\xb3\xe3\r;\xa7\x1dB\xddu\xbd\x89\xeb\xbc\x17h\xc2-\xac\xbcL@\xbe\xcd\xed\aQ¢\x87ÇU\xdd!Q\xeb\xbe\x16ad\xbb\xa5e\xb46#\x92\x11Ş\x00\xb5\xf6\xa7\xf66G)\xcd\xdeAa\xf6+>Ο\xf6\xba\xaeX\x9eN.wUR\x89\xbd\x93\xb0\xb8\x98:/i\xed_\x8e\xccY\xd6u\xe5ƥ\xf9\xaa8\xaa\xc1\xf3h+z\xc6\xcc\xfa\x85\xdcp\xf2r\xa1w\xa2\xcd\x01J.~\x8b\xf3}2\xbeyl\x96\xf3\xe7\xb5\x1b6\xb6\x96\xad\xc6,\x84\xe0Ԧ\xeeٿ\x10\xa3\"\x81by\xc4\xe5\x1f\xba\xc4s\xc9\xc2\xedg%\xef7\x9d\xe1\n\xa2N\x80),qa\xa3ۇ0\x85\xa5\x96\xf7\xb3\xbf\xbat\xe9į\a\xa2\xa6\xd4 T\xb5A\xca\xfa\\pp\xed}\xa5\xd2\xdb\x00\xda\xfc\xc9\xcf9\xb8\xcd\xf8\x87\xd5C\x18\xe77\xe5\n\xb66\x80\x7f\xa9D\v;I\x1f!Ta\xc4s.\x10\x8f\xc4\xf1\xcd=\xb6\x82l\x1f\xdf\x1auN\xb3w\x98:\x8e\r8X\x9fN\x8a\x9bp3Nk\xb3\x05\x05\xd1A٭\xd1\xc0\xf6)d\x83\xf0\xfd\x13pY\x86Zb\xe49)\xb4\x7fgd>-|\x91\x16>\xca\u05ceo\xb4~CTE{\x16\xd7\xe9\xc9\x06Y\x0f \x14\xbc\xfd\x02\x81@\xb0\xb0I\xfe\x11\x97\xc7\xfa\x85\"-\x06\x99\x14\\\xdc\xecj\xdf\xf5<\r\a\x06\x1a\x14\x9a\xbbb\xbbD\x9ej\x14f\xba(7\xcdd#\x88UV\x9c\x0fr\xc9\x1a\xf2^\xca\x1d\x9d\x99\x83v\x12\xd2i\x86\x8712\xb0\xc3Yg\xfe6sA\xb9M]Lӌe_4;<6\x83\x9cd>\xa8\x98\x87\xe2\xc1\xadPhM\xb0\xb0\xb1\x0ftMS\x1e\xe6\xe1$Lh\xbd\xa5\x8e\x9e\xcd\xe0\xb4\xc0\x98\x90?\xb4m\x18\xf6\xda\xd9\f\xc8屽衣\xd1jUx\x1f*\x81\xc3\b\x89\x8b\xb7\xb2U\xca\xe8\b\xa7;\x91\xaeܡ*\xca\xcf\xd9\xdb\xee@\xe4\x9aֻ\x1e\x1a\xe0v\xe9Os\xd8X\xed\x0e\x889\xc6\x01\xe4\x17i\x87u\xb9u)\xce$\xa2\x19\x9a\x8c\x10\xce@:\x9fT\xcc5\xec\xc8w\x9a\x0ei\x90P.h$eE'>CTpI\xb4\x9cN\x80\xadE\xa9\xf7\xb6\xa047\xefu\x15*uRh\xd5\r\xa0\xe0ͧ\xa2\xfbpk\xb0<֥uU\xba[[\xa8>\xbc\x10\xdb;w\x86\x8eH3۬\xe7\xe7jk\xcc[{;\xb2.\x18\xb0\xe8\xb1\xdb1\x80\xf8Z!\xbep\x83Z%\xc7 \xbd\xe1\xc5\xfb\x05\xdc\x03ʋ\x03\x8e&\xbb\xba~\xd7M\x93\x8b:r]\xf6\x8f&\xa2\xf3.w\xeeS}\x9e\xc2е\xe3z\xd1\xcd<\xf4R\xe2\\u*m?\xf5\xe6\x83Z<\x9a\x16\xfd]\xa2\xaf\x02\x1a\x17]V\x83\x89\x9a2\x81D\x86b\x1a\xaaِ\xaa\xe8\x1d\x15\x05\xf4\xcc)ׄ\xefc\xb6>\xee\xd0n\xa2\xc7\xf7\xd9O\xed\x1bNn?\xb2\xecp9\xd1\xde#\x95\xd6\x03\x9e,_\xb7\xf7}rS\xff\x00\x9c\xf7\x19\xe1\xeaQ9\x9c\xc9*\x95w\x8b\xf1\xf9E\xc7r\xcejO\xff\x00UE\x88 sq\xcc\xf0dѪ\x00\xbaA\xe2IR\xec\xb9\"4M\xdb\xcb.\x10sL\x10\x9bmg\xff\x00{(\xf6\xea\xbb)\xa8\x98\xea\x13\xed\xb0o\xbf5\xbd\x9f!\xaa/\x7fx\xfe\xc2\xe8n\xf1Xl\x88\xb6\xf6\x8d~\x16#i\xed$\x97:\xb1\xcc\xfe\x10e\x98\xf2\x03/\xed{\xed\xebO\a\xe5K\xbe\x83\x90\xe0@Sk\x9e\x97}\x95k\xb0p\xb4\xe1\x19\xedV\xecN@\xbb\xbb\xa5\xd85*.\x9d\x99'\x86-,\x8d\x7f\x94\xdfjk0:r\xd5\x16\xf6\xb6\x94\x13\xde*\x96o\x956\x85\x96M\xd5\xeeTs\xedݠ\xa3Q\xb3dY\xd9x[@\aU\xbb\xbc\xef\x17\xe3\xf2\x89\x9c6\x7f\x13\x9c\xb0\xfb<\x8d^{\xc7\xf1\xc1\xdd\xf5]u\xd9\xfb߀@n\xcf[\xb5*\x1c\xa6\xe6\xff\x00\xb0V\x83B\xa4\xed8\x1d\x17Q\xc0\x9b\xec\xec\xec\x9c;K3V\x9f4m}\xa5\xcdo<3%\xc9\xc4[=\xad' rR\xf7\x17\x1e\xa5b\xb6\xdd\x19\x86\xf3+\x05\x90\xdd\xe4\x06_ڟh\xab\xff\x00\xc7\xf9C\x16C 2\x17TFܾ\x8dP\xd1K\xba\xdf]\x89\xe5}=U\x15\x152TP\x87\xad\xcc\x03<A[\x1f\x9bl\xac\xb3G\x85\xbaH=\x14\xb8\xc9Qf%x\x9f\xe2\xfc\x0f\xba'\xba\xc1\x9b\x8f\xddE\x86\x7f\xe49\xfd4\xbb\x19\x8b;?\x13\x96\xe1\x16\x96\xa7\xe2<\x93\v\x19\x89\xb1HE\xae\x10F\xc4\f\xd0u\xa5N\x9b\x15\xd8\xd5S5\xd5}\xef褘]\x15}.\xfa\xdffO\x88+C\xd5B\xa6ӫL\u0091\xb7\xa9E\xfc\xf9ߊ\xdbu\xbas+\x05\x8ba\x8b\x17\xb4\xce/\a?\xae\x88L\x06\x8c\x9a2\b\x06\x82IA\xf6\xc0:\xd7\xc3ɪ]R\xbbM\xc2Ή\xe4\x9fv\xca\xc7\xd99\xee̛ᾪ\x83{[\xabç\xaa\xa0UU\xf4Rn*tT\xbb\x11\xc8\x14\xe7p\x0e\xa1oU\x19\xbe\x86UT\v\xbasP\xc12\xa7\x10s\xd1\xf0\xf3%{\x8e\xff\x00\xf9\x0f\xda\xecn\xf7vz\x95\xeeD\x1f\x1b\xb3^k\x03\xeb9\xf5]\x93`4f\x9fd\xf0\xf6\xb8\xef\x05\a1t\xbe\x83E\x00e\xb5\x9a\xa5V\xea\xfb__K\xaa\xa9EM\x82\xa9\xce\xe99'\x9f5\x1c\n\xe4\x9dgT \xf9\xf01\x02wV+s\xe4\xc6\xe6\x7f\b\n\x06\f\x9a2\t\xb6l̠\xdeϵx\xf8\x8a\x92\x81<\xb2C\t\x87\x03!5\xaes[:,v\x87pd\x14\x87M\xa4@n\x9dJ\xa4\x97\x14\x1diW,\x96\xab1vJ\x8a/\xfb\xdd\xd6\xedn\xae\xd4\x05\x11U\xbdE\r\"\x11\xebp\xe0\xd3o\x13\xcc\x05\x85\x94o\xf2\x88\xba\xd2\xdc\xe4wGTu57\xd5v\xb6\xcf\x16o\x99o5\x86§\xc4~\xca}\\V絛\xa2\x89\xfa\"\x1a\xb5*\xa7o@\xb2\xfa-\x02\xa6Z\xaal\xf4T\xca\xecL\t\xa1\xed\xf3T@!\xc2%\xa8;c%Z\x95[\x81Mg.j,\xd803 \xa4]\xef\x1d\a\xc2*Q\xec\x83X=T\xbc\x979\x03m衠B\xfb\xaf\bZ\x05\xd3K\xb5\xba\x8b\xaa\xeb\xad\xd5\xf4\xba^\xabF\xe8\xbe\xd7\xcd\xd5\xc9t\xbb\xa5\xd2\xe4+|8HU1\xe6\xb7H;~H\xb7`7\x96\xc8sI\x0eXqbi\xab\xa5\x17Z\x01\x8b\x93\a5\f\x8b6\xfc\xb7C\x02\x93\xdePr\xd1eM\x14\xe7\xd5juR\xbe\xd7s\xfc\xdd\xd3[\xe9\x9d\xddUjT\x9d\xae\x97I\xd8\x1b40\xbb\xf2:\xed\xd7+\xaa6\xe3U,q\a\xa2\x97\x197hՅ\xa1h\xb2\x8f\xe5}\x90\x1a]\x9a\xaf\xa5\xd5_e\xf7[\xb9kvJ\x1a\xa1\xbe\xbbRWK\xa4\xaalO\x16y\xa2\xab\xe5\xb7L\xc5\xd4A\xd6\xd9\xf2j\x8d9*z\xa8\a\xcc\xdd\x1f\xb5\xc2\x14\xbd\xf1Ӛ\x95L\xb5T\xa7U\xa0_k\xb19hՠي*\xae\x8b\xaa\x93\x9a\xe9\xc0\xa1U\x1c\x18Et\xe0CG\xd5Nn\xf1**\xfa*\xfa*\xaaz\xdfOU'3\xea\x84\xfa-V\xa5}\xd45oz/\xb2\x93uW\xda\xfe\xaaJ\x81\x9a\xaejN\\,\xd5G\xa2\xa1\xe0Hڂ\x170\xaa\xed\xc40\x8a(X[t\xa8\xbb@\x82\x81\xde\xd5S=WE\xa0S\xcbE\n\x02\xa6jN\xc3[Js\xbeJ\x8b\xa4\xdf'%\x1c/\xff\xc4\x00)\x10\x01\x00\x02\x01\x03\x03\x03\x05\x01\x01\x01\x01\x00\x00\x00\x00\x01\x00\x11!1AQ\x10aq\x81\x91\xc1 \xa1\xb1\xd1\xf0\xe10\xf1@\xff\xda\x00\b\x01\x01\x00\x01?!\xea\xe9\r%\xcf\x11\n\xe8c\xf4\x03V4\x1b\r#Ѳ\x8c\xb2\xafxѤYd]\xdd Ev\xfa\xc2\x1f\x1a蒥\xc4\xd6^\x8b\xf0O\x00\x8b\xbd{\xf121Y\xab\xf9eKҳ0\x99$)\xdb\xee)L\x1dh\x8d\x03\xdf\x19\x97\x1b#\xc1\xf6\x87\xbe\n\x8d¯\r̀{G\x02\x85\xf0\x93\x85\x1cnEs}+\xa9\xdfH!ߘ\xcad\x88agzs\xa9\xa9\xb4}\xe6\xafTq;\x97\xd5|\xc3\u05ed\x8b\xa2\xf1\x1a\x8a1\x8fC4႗\xb4\xf2\x98\x1cK\x8b;\xee\xa5R\xc9~\x91\x1b\xcf\xda\xc1\x00i5xj\xe8\xc2\xcdf߈T\xef\x87\xf3\x14\xbc\x83̹\x12\xb3|\xcc\x11S\x93Yq\xd0\xe0\xdaR\x1e\xbcó\xb1\x94\v\x17MR\xcbUkV\x9f\xcdsD;\xaa\x98\xa5\xb6mJPSNv\x94#5u\x15В\xa5k\xca&o(\xa9Q\xa83\xf8J$A\xd1d\xde\x1b\xad-^c\x99\xe6\\'.\xbd\xe7\x89Fc\xd0\xc7\xcc[Ӣ\x9b:KTl\xe66es&\x04\xd7\xd9\xcb\xed.=Q1\xe3\x17,)Ղ\x04DܗJ\xae,`\xc2\vAu-\n\xe1\x83[\x81\x00\xf2f\xcc\xfa\xe6\x1a\xcd[\xe9\t\xde\x0e\xb1\xf5\x1bGnM\xb5\x99oڦ\x85D\xc9\xd8\x11lj\x93\xb41\x88\xab\xf15B\xf6\x96t\b\"\xe2\xb9eF\x88\ue8d6\x8dɉ9P\x95\voz\xfaXg\xa1\v\x17P\xe6#5\x01;\xf4v\x81\x11X\xd1ж\a\x11\xd8\xf8ӈe\xbd\xad>\xf2д\xdb\x0f\xbc\xad\xdc\xc0\xea\xb2\xe1h\x02\xae\xc4֑\xfcb\x7fe\x1d\xa0\xfa\xc1\x81\xd2j\xb9:\\3G\x97\xfc\xd4#/\xb1\a4\xa4P\xc0N!\x9a\xc1\x96\xdd\x1b\x15\xbb\xcdq\x87\xb40\xf0yٙB\x8cg\x9c\x83/EͶ\x11P̢\xba F\xfbC\x16\x0e\xc4\n\xddQ\xb3Y\xb3\xf7}\x17\x16\xb6\x9b\xf5q\x99\xc02\xc6\xf5J6=c)\x15\xf8\x9ek\xf4\xb8\xb0\xf1\x1e\xd1<'\xa0=:\x92\xe2ř\xa4w,{\xa0\x81\xc7\x1f\x98\x97\xee7\xe6[6H\xd3\xe4\x8b\xeb\x06\xaf\x12\xbd\xb3\xc1\x82\x1f\x90\xb4\x80\xb4\x11\xa1\x0e\xb7ɡ\xe7\xde$0\x1c̻\x88\xc4\xdem\nǱ\t\xd5]\x99\xaf1`\x11\x00\xd44\x8c*\xać\xe8\xb5\\\xd56#\xc7\xd2\nS*O\xa4\xd3\xf9jpʠ\xf3;&\x19}/\xcb>ӿLK\x98\xc5yǈ\xb1\xcfw\x89b\x01\xfe;\x11\xab\xa3r\xdf\x7f\xa8\x92\x93`\x98\xef\x1a\xca\x19T\xf6\x9e\x87\xfeĠ\x0f\xe1\xff\x00\x93e\n\xfe\xcb\x11]\xa8<{\xbe%\xe0\x82\xb0\xa9\xddՇ5x\xe6Z=:\x8c-\xb0\x8ch\x9eڿi\xaeu\xadm\xed(\x80\xa3T\x98\x84`\x03\x8f\xe6R;5\x81\r\xd0\x1fk\x1a\x94W\f1r\"\xc24\xf7\x84\xc8k\x9e\x84m\x93r\x98\xb4C]\x1e\xadF\xdb\xf4n_@\x16ٮ\"\x96\x96\x99\xaf\xb6kʝ\xa0\xb7c\xe2\x1dRn\x00\x88d\xde\xe7\x05:\xde\xe4\xb9y-%\x1d=f\xb3N|ʵ\xe0\xde[TF\xf8\\\xb4\xdc1\x14\xa8z\xac\xafHb\xd5z\xe3\x18\xfa\x19\xea\xea\xc4\x14P\xe0\xdeT\xa9Q\x9aΠ\x96=9Z\x83M\xe0Ӌ\x94{\xeb\b[\x19F\xbf\x97\xdelt/<̡\xaf\x83^\x19}em\xc3u\xb7\x84\x05C\xa2\xcd\x0f\x1bL̏A\xef\xa4\xe0³,\xde6ft/\x97\xfc\x9b_\xad\xa8T\rUt\xd39oo\xbd\xa0\xfb\xbf\x13\x8f\xb4\n\x81\xad\x7f\x98\xfb\xc2:\xa5ý=\xa5\xcf;\xd1q\xe6\xf4`\xf0\xb1\xcd\\\xc2<\xef\x19\xac\xcaғ\x02^\xa2\xf5\xdb\xfb1q{\x1b\x87gF/\x8f\x83U\xf8\x82N\x04\xeeLب\n\x90r\r$\xe4]g,\xb2С\x9fN\x99\x80E.S8\xca0K\xdb1\xbb\x86gZ\x96Q\xb3ذ!V\xab\xbc\xc3\xea\xb3Qyg*\xb5\x96\xb6VCD\x99\xb9d\xb8\x02ף\xd6{\x8f\x12\xb7\xee\xee\xb3\x0e\x01\x18\xc2y52\xc1\xc1\xe9\x1e\x04B\xd5\xfcJ\x95+\xa3\xd1\xcb\r\x85\xa7d'\x1b\x00o\xef\x00j\x15b\xea\x1c\xeaŚz\xfe\t\x91\xfc\xbd\x96b\xe4\xcbNPi\xa3\x02\xaa\xbc\xb7\x95BxS\xe1R\xde\x13h\xbe\xae\x1b\xf5\xd2kt\xb4\xafO1,-\"\v\xb4\xcc\xc0\xad\xe5\xc4i\x05\xb1U\xfeC\xfeR'\xf78\xd8\xe5f:ݩ}cz\xb0w\xde\x18k\xccL\xbb\xc4+k\xfam~\xf3\x8d\xf6?0\xa3\a\xbc\xa9}\xe8\x91/\xde=\xa5\n_\xbd\xb1?\x1aU\xe3\x88IA\xaa\x9c?r\x8d+\xba&\xe4`\xe6h\xf8\xae\x98\xac\x10\x8aJ\xbct$\x17s\x15\xaa&\xd2\x00\x14M\a\xee\x96\xff\x00\xb9˧\xa7D\xbd\x1aDM\b\x94,b\t?ѡ5m\xe4\xfd\xd8ڵo,\xa9]^\x8ccqPa\xd8s\u008c\x1dV\xa0\xab\xf1\xebS\t\x16\xcfVj2\fCJ\xcb\xf2\xb7(\xe5\xf1\x06\x88\xd9\n5\xd7\xf3̽\x1f%\xa6\xd4\vq\xddԡ\x8f\x9e\x7f\x11\x17z\xe4kR\xe3Dܺ#' \xa6\xbd\xe1\x87\x17C\x93\xfcCJ\r\x1d\x89Pp\x86\xe0M\x98cR+\xad^\xa4\xa8\x1b\x8djzK\x1d\"\t\x8fj;\xcd\xedF\xe69\x9f\x90\x1d&0\xdc\v\xe6r\xddӡ\xd4\xe9\xa8\xd9\n'X\x95\x1b\a\x80\x99!\xf5P|D)\x1b=\xa6k\x12\xb7\x8a\x90\xa3\x9cL\xb9\xd7_\xbe\x8b\xf1\xdc\xe0\xf7\xfe\x11\xa1\x11u7z\xeb\xd0\x1fSьc3\xaa\xc716\xa9\rk\xe6!\xd9\x17\xde?b1\x96\u009c\xebQ_ \x1cw\x87fT\xd4\xf9\x86\xe8\ty\xa4\xcf9\xfcK\xac\\\x17\xa8\xc0\xb2\xb9Q\xc4f\x98sC\x19\xb5\xab\xccs+\xab㐶\xbb\xcaJ\xa1\xbd.\x12i:\x99$Rz\xe7/\xf7i\xea\t\xf6\x87Xˣ\xbbP\xda|\x12\xc6Z.z\xf6\x95\x01\xa4\x15\xfa\b\xef\x19%\xb5Z\x90\xaa\x87ю\x92cr\x03~ؕ\x02\xb9\x9e/\xa6\xa6\x90:һ\xfcK\fmN\x0fx\xeb]\xc1Й\x88\xae8%\x04+\xb9\x04j7\xed\xff\x00#\xe5kA<\x83\x040\xd0x\xbd\x06\xac\xbe\xe4\xee\xdfd\x87\x8c\r\r\x03\xd2T\xaf\xa9\x8cc\x18\xc6b1\xe0<\xcb|\x8d\x16;\xbcK\x84/#n\xf0H\x1a\x91\x96Ca֧.\b\x0e\x00\xb1\xa7}\xccm\n\xd5\x03\xa0\xda(Z\xda\xd0~\xe3\xa0jm\xf7\xff\x00e\xc1+ T\xa6\x01\xa3%\xd72P@\"\x05\xbe\x92\xb1\xbc*[\xe3\xda2\xe9<\xbf\xa1\x1f\xb1\xea\xabYݜ\xa6\x1e\xb3Y\x91\xa2\x9d\xdd\xd9]C\xb1\xb7e\x9b\xb6\xb7\x86\xaf\x88tf\x1b\x9c\x18\xfa\x88\xdb\x05Vk\x05mf\xb8T\xc2\x01`\x0e\xd7hp\xf3\xccTn\xad\r\x03\xc1\x0fYt\xe1\rK\xee\xc5\xf9\x84p\x8f\x95\x1a\xb5\xa2\xb4\xab\x96\x12\xa5}oF1\x8ce\xcd\xf3\xed\xde\x1fY,\x91+\x13\x9f\xf8\x98lߏ\xb7\x89[\x8c\x82\x0f\xf7\x11k\x9d#m\xb7\xde#e\xad\x1cL@\xb0\xa0q\xdc\xd4,\x9ac&\x175\xbccY\x1ar\x1d\xaa\x15!:\xa9\xfeA\xe6\xa3\xe0&\x9b\xfb\xa0\x00\xb8\xae\xca\xf12!G\\\x04\xb0߃C\xfb\x98\xa3t\xc3\a\xd3w\xb4Gj\x15<Kt3\xb4\xc1J\xc1l\xda\xc3rYX\xf5Q\x8fi\xf7\x90Ĥ@\xccS\x1bM\xade\xdb~\xa5D1\x1eR\xbe\xaa\xfa\x1e\xa2T\x15\x97\xea\xc7u\xc61\x9a%\x14#\x93h\x10\xe9\xb38<\x13\xf8\x88\x174<\xb6\xd1\x16\x9b\xae\xa9f\xf7\xa0\xd5\xc4\v\x14\xb6y\t\xab\x03\x90\x7f$%9\x82\xcfo\xb7\x97\xdaa\x9f\x83\xf7\x99^X5\x03œ\xee\x9d\xc1\xfdĿ\x1a\xea>\xa1F\xc8\x06\xad\xe8]ܧ?x\xed\x00\x966\xc0&\x1c\xf7\x9b\xcf\x12\xd4\xdd\xfb\xc45\xd8[\xf6m\xe9\xed\x04n7\xef\xfcG\x05j\xe2\tD\x81SA\x9ef\x03\x88\xb2o\xd6\xff\x00\xe2鈭J\xe0o\x02\xa9)\xe8cЙ\x81\x06\xa6\x81\nk\x0eC\x982Eְ\xeb\x1d\x107V8\xfe\xe2*/\xf0\xd6*!\xb8\xbdbSL\xa9\x81j\x94N/\xf6Ϧ\xf2\xcdy\xb0\xc0zC\xa5\x7f˙\xf0\x97\xc2<\xcay\xf5a\xdfSVe\xf4t\t\x87Hnk\x1c\xcb\xf6\xf3\xbc\xae\xc8\x06\xaf\xd5!݁\xda\rzI\xab\xd9\xd7\xfe(и\xbe\xf3\xf3Ռz1&\xa0\x8a\xe5{\x9b\xad|\xcb@M[Ud\x95\xe7iCi\\M2\xda\x01~\xb2\xe0\xb4\xd0\xd8\xf4\xfaϭ腨\xf6\xf3+\x99\x86\xb7\x9a-\xd63\xd3\xe1\x04\xbf*\x85\xc0\xac\x99\xed+\x9b\xcc\xcc\xc2\xfeX0Ge:#\xf4\xbbF\xe7\t\xe3\x85\xc1k\x03f\x9d+\x13B\x88)\bAP\xb8\xe5.=j\t\x8f\xcbA\x06[\xdcb\x1eP\x06\x16%h]\xa2\xd9tgL\xf0JHa\xe6&\xa7\x83,J\xff\x00\x8e\xdfYш\xdej\x06\xb1\xa0wN9\x8b\xa5Ǵ1\xbaƄ4ɝS\xb0\x82\b\xa5\xc5\x17rg\xc2b\x1d4~\x9f\x02\x13M\x1c\x18>\x96f\xf6ь~\x90\xb8\xd9K\x18M\x02\xfd\x11\xe7r\xa1\xe8\xa7\x1e\x9d\xa5\x18d\x7f\xf8H\xe4\xf4\b\x05X\f\xdcZ\xb3\xbc\xdct\x9a-\xaeT\x8eH$:\xc2Mk\xd4\xc4@l9V\xbf\x04\xc5e\xf8\xcf*\x8f\x99W\x88\xe7cy\x87!\xfb}$z,^\x8c~\x92%\xf4\x010u\a\xb8\x8dS\xf4?\xf4\xc1\xb4\x05\xcaf\xa5\xd1\x18L\v \x8d\x1e̺w\x89\xab\x1e&\xaa\xe6p\x90u\x830\x13?x|\x8c\xa5e\x9a\xeb25\x87hN\x0e:\x1b-]\xe3\xb3Jw>\x97\xa3ь~\x91\x9a3\x1dzm\x04I\xa4\xf6\xd1\f\xab\xa1\xfa\xde\xff\x00P\x06\x1b\x99R[u\xb9\x7f@\x9b\xc5\xc9*Ε\a\xc6&\x1a\xfd\t\x81\v~\xdfߙk(\x1e\x9a\xe9\xa4\x05\xbbè\xec\x9c?S\xd1\xea\xcdǣ쑛u\x0e\x13\xb3\x1e\xab\x1fC\x12'@\xc2\xf8%\x1c'\xb8\x89\x80G\xfe\xa2\xe5\xdd\x1b\xbcF\x1a\xc1Lk\xb3Gh\xc8V\x87yX'`\x18:\xeb\x1a%\xd92fi\xd5\xc9\\\xc3\x18u>\xab\xeaHTؔ\xadJ\\f\xa1\xaaS\x97Ŕoz\xfaKb5\x15\x0e4\x98\x04\x1b\x80=\xb3;\x91^\xebɴ\xd3\xec\v\x83\x1c\xfdU\x12m\x04\xad#\fc\xb2GS\xe1\xb7/\xf9\tf\x91\xee\x1e\x18\xcc\xc8GU\xed+\xe9\xf6K\xb8l\xce\tCN\tUÈ\"\x96\xaeSh\fщ\xf9\xc0㦳*0\xa1R\x13\x10\xeb\xb0m\xaf\x8f\xa5\xe9\x8a\xe9\xa8E6\xc5ȯV\xec[\xe0\x89\x14/Wm\xb9ؔa\xba\xf8G3\x13:N\xaf<\xcau\x87\\\xb3\xd9\xc7\xfeA\xff\x00\x14\xearʆMแ覌\xfdaU3\xfe\x8b\x87\xbe\xe3\xe0g\xcbs\xe2|\x91\x0f\xfe8\xa24\x15T\xc5x\xb3\xda2i^ST\xaa\xc0h\x93\n\v.\xb1\xb3\xde^\xfc\xcb@z\xa06\xcfyY\x95\xccO\x0e!\x03\x90\xfa\"-\xe8k\x1cu\x11ͳn\x8b.3\x16\x05r\xb4\xfcGܣBs\xe2(zn\xed\x0f`\xed\xc1\x97\xc0\x03\x03\xf3\xf3\xe2\x06W\x89\x9a\xa7\x85\xf8\x9a\x93ؽe6 \xa2\xd5\x11\b^\xea\xaf\xdd3\x03'\xf2\x91\xff\x00\x83\x02\xe7-\xdcs\x9c\r\x88\xbe\x87\xfc\x1e\xe3\x9c%=|\xfbǜ|Ff\xf5\x83T\x06\x12\xb3\x0fB\x9e\xc2\x16żB\x9b\xf5\x97zJ\x9eE\x88\xc4H˩\xb1\x15\xb9\x87*1\a\xa5\xcd\x03SIw\x1e\xae\xa2}\x1a\xb8#\f\x04P\xd0\xed0\xee\x98\x12\xfa\xd5`rEZ\xfc(\x87if9\xbc\x1c\xd3\xd47=\xa6\x10u`\x1d\xb3\xf1\x12^t\xd3\xc1\xb4G\xbc\xe9\xe3\xf3\xef2v|9\xff\x00\x87/\x99\x905\xf3\x1a;\xbfh\xf7\xff\x00\x82S\v\xee\x84TQ*kU.\xf9\xe9\xdf2\xb4T<%@Zt\x80\x95h&y\xcd\xcd/_\xda\x1d\xfb,G\xe9\x177K\x8a\xdfx\x14\x83:L\xc1*\x15\xd7[\x98\xf6?\x98\xf4\xac\xc6\x10\xa8-\xa1\x05\xf2\f\x13\x15\x91Q\x83\xbe\xfbDҲ\x9aZ\xb8\x13T\x04O\xc8\xff\x00%\xccH\xa5\xde\xd8z\xc1\xe2\xd1A\xc0v8\xf6;\xcb\x03\xf2|\xfe\xab\xd6\x00\x84\r\x02]S\xf5\xcd\x1eL\x06\r\xb6\xfd\xb7\rW\xb3\x7f\x8b\xf6\x8a(\xa8\xdb\xe9W\xa2}\xba\x8fTѶ\xaf\x10Su\xe7\xf5\xfb\x89\xd7ዱ\x83\xfe\x19,a\x18y6\"\xce\xf7\x12c\xff\x00&⩇\a\xdao_\xac\x0ec\xbbE\xcdL\x9e%\xc1Tݳ3p\xc3\x16\x1f\x81\x16\x97+FX\xb7\xc2\xc7\xd9\x12T\x16\xd0\x0ec2\x85\xb4\n3\x13\x9di\x0e\xa9D\x99\xcdˊ\xaa\x8e\xaf\x1e\xe8c\x95\xab\u03a2~\xa3\x1c]\x1a\x94B\x86\xa9\v\x9c1\xac\xec)\x85\v\xbf1\xa2\xe4\xbbs\xc3\xf3\xed\xbcgR\x05V\\>\xecQ\x17\x1c)\xf9\xfbM\x90p\xbaY\xa3\xd33l\x1dWU\xe5\x8c\xe8^\xdf&t\xf6\xd6<\x03\xd3\xfb#\xb3\x01\xb2\t\xd0\xf5z\xc6\xce\x15\x82\xf9\x9fA\xc98\xdd\xfeE)q\xd8\xfe}\xe3K)\xf6\xcfܲ\xb5o\xfc\x17\xbc\x9c\xa5\x03\xf3\xfe\xb3\x0e\xb3\x0e=\xa5.\xd8GM\xa0\xdf\xf3\b;\xc6ٙ\x91\x99\xaf\xd2$\x0eB-\xa8;MW\xfa\xc5\xd6_Fh/H\x12\xc8\xc378\xad;\xc4\x02\xdc\x06\xa8\x94\xf2\xe6`l\xc7y\xa3Pz\x8ai\xeb3\r\x17\xac\xb7\x19\xe8z\xf9\xfc\xfb\xc1S\xd9K/%\xed\xae4γE\x02\x0e\x9ao5\x89\x82\x14\x95\x9a\x97^k\xed\xfd\xa4ă~\xecF\x8b&\x16\xac\xbc\x97\x110\x15\x8c\xf9=#\x1b=\x00lm\xf9\x81HV&\xf3^\xa5\x1b$υ`uQ!c`\xaaݛ\xaf\xde!\x88i\xc2\xe8mU\xed\xd2\xf3a\x95t<\xc5\xca\xf8?\x83\xe6R,ͫ\xbb\xf2\xc71[\xaf/\x99_Y\x96\x82d\x03ĵ\x87\xd9еY\x8b\xf7\x9e\x93\xbc\xdb\xe2k\xd0bw\xa8\x99L-~c9jR\xf5\xa9\x99\x9e!\x91\xae\xc7\x12\xa2\x9c\xf1\xe2f\xb5J:rK\x90-\xa5\xed0s\x00\xba\xb9\x17\x12\xd0\xde\xf7\x95\xc97\xba\x1d\x12\xcc\xc5*\x9a0\x1a\xd6\x10+\xf0\x0f\xbd~\xa6\x17F\xf6:\xb9O\xbcas\xc5\xcc\v\xa9\xce#\xfa\x19\x1e\xafi\xaa\vZ?\a\xee\v\xaf\xe77\xb9\x03W\n\xfa\xe3U\xd15\xb1\xb6\xb6\xdf\x1f\xaa\x80S\xdeg\xfb\xac9@Ź\xe0\"\x10\vQo\xe0|D\x8f:\xa5\xb2\x9a\xf0֚\x8e{\x1d\u07fcl\x05~O\xcb\x15\xec\xf6\xb8<\xbf\x12\xd0\xefc\xb7\x8f\xa6\xba3;O\xe2\x0fm?\xd6\"\xec\xf9\x19k\xf8\x9bۯؖ\xea\xdcOi\xfd]\x13\b\xef\xbdC9k\v\x95\xd1\xcb<\xaf\x1a\x11g\xb5\xdeh\x1dp\xc7\x00\x9c*\t]\xf9\x86\xf0p\x05e&:\xddr\xc3\x10S+Q\xdcx\x976\xe5\x986\x99t\x1e\xb8/\x89w\xd0,\xb4Qtw=eԴ\xb3ɷ\xa8\x99~\xab\xd8\xd1\xfb\xf5\x99X\xaa\xfe\v\x83e\xcc\aB\xf4\b\xfc\x8e\x8c4U\b6i<\xdfɁ\xf5\xe7{N\xeb\x19\x97\xf1\xff\x00\x17\xf2\xf4 \xd1\a$\xd5\xfeTl\xc4W\xf0ñ\xf7\x95\xf5=(<\xff\x003\v\xdf\xdb\xcdW\xb7<D\xb2\x86q\x9dc\xc1\xfeϏbWw\xf73\xd7\xd6W\x04Z\xdfM^#i\x81\x9a1\xef\x030\xed\xef;m\xf7c]=\xbbĒJA\x95\xd4?iz\xbb\x15\x06\x93^\xd3y\x1c\bn5V8\xcc\xdd\x01\xb5CK2\xb8i达\a\xa2\x98]\x89\xb3\x02\xa5M}\xbf\xd9=I{\xac\xab\xf2|h\xc1&\x05g\x87\xd2\x16VZ:¦\xb4\x00\xf6'l\xba\r\x1aև\x96Yh\xe5?\x85\xfe\xed2'\x8d\xb1s\xd9\xdd\xf4\x19H\xa9x\x1a\xbcn\xee\xc5G\xd74\xf3\xf83\xe2\x18M\n\f\a\x01\xb4\xa8\xca\xfaI\x02\xae\xc4\n\xd4\xfb'\x98\x8a+Ҿ&\xbarî\xe7\x1b\x11tW\x1c\xc4\xf6\xe2=\xdcK\x822 \xd3\xcc\xca5U\xa7m\xe6\x8b_\x1f\xe4Ε\xe91\xd5\xeb)\x7f\x84u\x95\xe9O\xcc@z\xb1\xd8\x7f\x13\ax(\xb0)\xeeD\x13\xa9\x89S\xbb\aT\b\xea\xcc\x1cM\x12_\x9e\xd1\xf3pLc\xdebѤ>\xa0\xa2\x98\xd4t\x1c1\U000a8d62\xb0\xff\x00\xc7X\xa4\x0e\x83b\xf9\x88\x96\xe3U2\xfa\xb1'\x8c\x17\xe3\xfd\x9a|\xff\x00\x9b\xff\x00f\x1c\x13>\xa8%s\xd4\xcf\xc5\xfc\xbd\xa5\xa6\t\xb55}\xf7_\xbc\xbc\vq\x85\xe0\xe1\xf7\xef+\xa5D\x95\x18\xf4\xa2\x8c\x1a\xec \xea\x15\xee\xd6QLG\x1dk\xbcL\x0fi\xa7\x7f\x89y\xb5\xee\xf8\x82\a<\xcbX\xeb\n\x9d\x1a\x84\xf5\x9c\xef8U\xbcNK\xeb\xfa\x86\rs\xb4\xd3\xcf\xe1\x05A\xac B\x90\x96\xb9{\x06e\x15\xc0\xa7ί\xe7\xed7\x93C\xdehs\x89\x1c\xa1f\xb0R2\xe8q\x03x}\xd38\x04\x13\xa3,\xd44\xe2\x0e\x87Q\x93\xa2\xe6i\x1a\x8a\xbdS&$\xaa\xe8\xd0\xf1\x1ab\x12\xb08\x9d\xcd\xedҰ\xcd44\xf9\xecw~\xf3\x00\x99aj\xf9w}%\xac\xdcg'\x9e\xde5\xf1\x1c\xc0`5xN\x8a\xd3.\xfdXǥ=ߒ\x14\x10#]\xff\x00r\xe6r=\x88\xf3\xafvy\xb3\xb6\xf1\xc2\xf4q\x06\xb0\"\xcfy@\xf3\xeeǖ\x04.\xb2W\xe5\x98b\xb5\xdar\x0f\xc0\x89q\x7f;\xb0B\xb0\r{@\x1d\x9b\x97y\x8d19\xe6_\xef+\xe7\xe0\x9d\xb5r\xf5\x80]o\x98۪\x9fx\x15\x98\xb4.\xf3OB\x8fJ\xb2:\x1b\xeaLKZ\xdb]\xe5am\x8c\xf4\x84\x18f8\x94\x14\xc1H\x8c\xbcw¥\xc8r,\xbfl-\xbd\xbb\xbcC}C\xe1g\xad\xf9{E1e7\a\x96\xec\xa5)\xbc)\xff\x00\x1ez\x19\xf4C\xecJ\x05\x12\x83\xa2P&=7\xe6\xe3`\xc2GiQ#\x05\x8a\xa9ZwN\x100\x0e\xf7\xb1\x13\xd10q\xf7\xd6f\xa5\xed\x06A\x94\xde\x06\xab\x03F\xb3\x15˝\x89b\xe1\xf9A\xbc\x9bn\x94\x0f\x97Vl>ƬmgF\xdb\x10\x16\xc1\xa65H7ci\xd2\x01\x1d\x81\xbd#\x85m\x10#\xdb\x01c\xa0ʹ\xa93\xbc\xab\xc1\xd0u\xd7r_m\x00䋢\xc4@\xb4\xc4'wEM\"8\x9a\xdc\xf0\f\x0e\x86\x103]\x9b/\x1b\x1d\xd8\x14\xcb49\xe5\xe5\x8fX\x96B\xe5\xe5\xff\x00\xa9G\xd86\xbf\x8eu\x89\x99\xa8\r\xe6\xe0\f\xd5\xe4\xe5\x82Y\xdf\xceߩV9v\xb6%J\xfc\x97\xa7\xbaoD\xba2\x9cc}\x84\t\x99\x19\xd5q\xae\xa7\xa4\xad\xff\x00\xc4L\xeb\x9e`\xe2\xe5\x19F\xf1q{n\xfe\xa5c2\xa8\xd1\xf126\xf4\xd2X\\\xd1\xf1,\xb7\xf7t\"\x81\x96\xb0\x92T9'\xb1\x0e\xf9;AWs\xf10a;\x89z\xf4\xca; \xdc\xc2\xfb\xe3\xed\x0fz\\Fձ\xe1\f}\f\xae5+\xf8`o\x8e&\xae4\xd4W4\x96\x1d\xd8B\x9edu\x1c\xf1\x1ch\x1d\xba5\xec\xe9\x8a\x16k\x15\xc4)\xd4\xf2Qg\xa1\xf3)C\x03.\xa0\xee\xb0Z+\xb8\xb2x\xed\xe7_\x10\xd6\xf5\xb8UOW|\x1b\xcar\xae\x1do\x8e%H\x1a\xaa;\xb1p\xa1\n\xba\xc3\xe2\x1e\xad\x0e\x1a\x01\xb4\xc8\x15\x04\xab\xad\"\xb1\xa4\xa4\xe8P7\xdd2\x80\xeb\xd9\xfe\xc5\xdc\xc7x\\\x06%\x80\xbeh\b\xb3z\xb1\xd2P\xcd\\\x83\xbf\x10\x8d\xc4\xcdB\xdf\xed&-#\x98\xac\xecW\xb15\x1c\xf9\xe6\x1a\xc6'\xdd*\xe8q\xda&w?2\x8c4\x8ai\xee\xe8혹\xf7\x99\xbe\x85Ly\x94\v\xb7\x99}\x81\x99\x99\x88j/\x8b\x9a%\xe3\x0fK\rcWɆ-\x1b\x1b\xbe\xd0\xe9\xe0\xd4\x1b2\x91\x97\xda\x01\xd3Y\xdcC\xcdE\xb8\xca\x03\xf7\x8c<\xbfyG\xb8\xec\x94g\x85\xff\x00\xc73\xba\x00\xf0s\v\vQ\xa3~#Ȼpx\x97\xc7\x14\xa7\xaf\xfeD\x02\xb2\x12k\x01\xd2\xec\xf5\x81C\xf4-u\x96\xc0\x15z[\xf8\xa9N*\\V\x96\xd7iE\x0fA\xbf\x98ԿA\xa1\x15\xae\x0ef\xc2\xea+ҝ\xa7\x88s-\xfd?s\v\x1as\xfa\x95\xad{?2\xf9@\xbd/\xe5\x97j\xab\xe1\xb1\x0e\x19]Ⓠ\xda\xf2\xc1\x06\xeeա\x0eI\xe29\xd7\xda\x01\xbcˈ\xdb\xce\x03\xef.l\xc1*2d\xce]\xe3Z\x8c>\xd2\x17D\x8d\x95G\xb6`\xc4\xc1\b\xc6j\xa5\a\x19\b/\xabm%\xc4q]\x11/\x81U]?\xd8\x1c\xd0\\\xfc\xc2\xc2j=\x05<T\xf2\xb87Qe\x86\xefB\x05\x96]\bc\xbb\x12\x8f\xa6\xf0\f\x9c\x15W\x87\xee2WKh\xd7\xf7+&\xb9z\xb1kQ\xf71\xa1`kO\x99[q\xa8hE\x90\xaf\xb2Mu\xaf\xf6\x84\xee\xd2V\xac\xd4\xf0\xf4\xfd\xc7s-E\x9e\x907\xd8\xde+i\xf3\xb1/\x81\xb0\xde(\xb7\xdd\xda\x17n\xe5\r(\xfa\xcd\x1d%0\r\xcdC\x81.\x9fr~\xf6 \xb5Fj;\x04\x1a\xb7\x85\x05\xba\xe9*\x93\xee!\x8aM\x11\x9e\xcc:'Fa\xdby\x8dU\xdad\x00\fm\xe6\v\x82*\xf2f\xc2\xfbq\x9c\xaeT\xed\xd7\f\xbe\xday]\xa1\x80\xa9\xde\xc7!\xcc \xa2;\xca\ro\xc1\xac\a\xee\x83\xfa\x99\x92{[Ռ^\xb7aeC\xa7?0\xddSm\x88\xb9\xbb\xf5\xfc@\xb5\xfe\xcc\xd4h~5b\xb4\xaa\xd87\x88F\xab\x1e\xc4m\xca\xd73U \xa6\xdb\x11\x15\x96]\xff\x00P\xd5*\xf5W\x12\xf7s\xba(\xc7\xf5̝\x0f\x1c\"\xb7\xf6 \x86\x91\xc6\xec\n^&\x9c\xa3\x9a\xb2\x8d\xa2\xfcb5\xf1\x03T\xc1\x18-qĶ\xd5\x0f\x80\"\x80\xbe\xc8!\xc7\x19З\xbc\xed\xd2h\x9d\xa6\x9b\x82\xe4\xab\v\xb0\x17\xb32\xa9\x04:\x8b&oSY\nB\x99\x86[K]0\xca\xfd\xab\xdb\xde\x10\xe9~J\x83X\xb3Y\x9b\x1c\x93\xeb\x17)\xebs\xdc\xc0۞Ɵxj\\\xac\xc9\xe3\xce\xc4\xdf\xf7\xbag\xd2!L<:\xbee\x8a1m\xdaY\x00[N$u\xb3\xc8ЃUk\xce\xf1\xb6\x1f1\xbd\x8c{\x10p\x17\xbe\xf1W\xa2c^?\xd8\xdaq\xef3@\n\xedϘ\xe5Z\xbf\x88c\x96\xe66\x92\xfc\xcaU\xce\xd0\xda%\x1e\x82\x02\xa2\xdb7\x1a\x99(טqw\x175_\x11\xea\\L\xc7a\x1f\xc1\x88\x1aGs1\xb5\xe6s$\xcc*\xa1\xd76˴\xd3\x17\x06Q!Ԟ\xe7\f@\xe4\xb6#\xe7TJ\x01\x8cY|D\x9b\x88\x1c\xfd\x19\xfb2\x9d\uf489P\x9b\xac\"\x16\x8f/0\x80\x83[t\xff\x00en\xf7\x95{\xb1U\f\x9e\xe9\xd9\xf4|\xc1[J(\x1b\x10\x1b\xac~\t[\x15\xba\xf3\t\xcb\xc1\x8a\x9e>\xc4\xc2\xf3\xce۲Գ\xc1Е\\/\xb0\x82\xefF\xe5\x1c\x15\x90\xfb\xb1|\x1e\xc4;\xfa\x8b//\xa9\x00\xf5o\xd1ݶ.(\x8dY{M\xc2e[\xe9\x83dgB\xa8z5w\x8a\x9c\x19^\x8f\xd1q\x87\\\x89\x82Zq\x88\x9fe3\x1a\xd1K\xd8\xe8\x19\xaf\xa9\x19,^By\x8e\x80*\xec@\x02\xb5\n\x03@lhy\x99[>\xed\xbcC6\x12\xdaA\xce\xe7.\xec\xa7c\xd1\xf3\x12\xea\x18\xdbbQ\xaab\u0605p2\xa5\xeb\x1a\x7ff+\x1a\x83\xd0x\x9a\xdeaݫ\x1aQ\x7f+\x01K<Bjf\xbd\x88\x99J>\xf1\xa8 \x11+\xb1\xb4\xc0\xc1\f\x16\x98\x96\xd84s\n\x01g\x99\xa2\x00q\a/\xb2-\xdcPQ\xe8\x80\x0e\xacoi\x1b&&\xb4'f\x91Ƒ\xd0!)\x99\xfbCj{i5\xb8\xe9\xb4Q\x87\xd1\xcaэ\xd2c\t\n\xcd\xd0\xc3\xf4N\xc9Y^\xf3\xd5\va\f\x0e\xe2\xf8\x96˗\xab(>\xc1\x03\x97\x84K\xba\r\xb6!\xfe\xa2\x9d\xaf;E\xaf\x97\x83B\a!\x97U\xf1\x05a}\xc4x=:DpR\xe3b`\xb4\xf7v#b\x9cn\xe1xI~\xec%y\xf0&\xe3\xae\xc2\v\xa28w\x806\xe2]O\x11\xb4U\x9fh\xd2\xc3YN\xf9ｦ\xc1q@\\\xa0\x1d\xb4j\xbd*\\\xde\xd3\x7fBe\xa4\xb6U\xbf\xa3HÉ\x87\x87\xd9\x14\xf9c\x83\xa1Ђ\x02\x18)\x98O|\xa26\x981\xd1bݥ\xfe\x1cη\t{F\x86\xf0\x8c\x0e\x04N¢\xe8\x1c\xb0\xa5\xe75\xac\x1aTr\x9e\xc3hj\xed\nna\xe3V\x05\x9blEW\xe9%-\x17r\xd6\x01\x1a\xc5\xfdX\x1a\xbe\x894\x16\xa6\x816\xbf\xea\x1f\x06\xdd\xe2\x1bRݖ\"\xda\xc7\x1b\xad\x1a̻\xcdv\x81\xc5j\xcd\vn\f\xb2\xad&\xd3^`r1(ub-2\xeb\x03=-\x89\xdd\xcf\x11P\xe3\x88\x00t;E~\xe8x\x9a\xc3\x02\xcdX\xeb4\x95\x8b\x81x负\xff\xda\x00\f\x03\x01\x00\x02\x00\x03\x00\x00\x00\x10@ -\x85,\xb2Sm\xd299\xa9+\xd0F0\xc1 ,\x8c\xb4\xfa\x19\x80\x84\xe5\xf2J!\x1c4\xaa\x1d\xc25\x05\x85@\xb2\x16?\xb5>@\x00\x04\x9d\xbb\xdb-2\xbe\xd2}\xa0S\xab]\xa270ZVG\x81%\xb4\x93\xb1\x7fn\xc5,\xd9\xc5--\xa6_\x96h\xd6\xca{\xfa\x14\t\xc8\xda\xfa\x8dӤ\xb6\xbbٸ\x1f8\x1c)H\x10\xc8\x0e\u05ca\xcc+N\x16\x9c\xfa|\x80@&\xb5\n<]6Q)I\xe3\x1cYZ\x87*Z߾k<\x144\xd5\xf7=\xceH\xa5z\xf9\x0e\xd0Y\xbb Z}\xfe\xd2\xe1-cy\xf4+\x12\x9eܽ\f\xa9\x04\x92\x1f\xab\xcc-\xd1[\xb6\xfd\xa9\xcd2&\xf9\x15e\x0e\xb3a\xa3\xa4\x82E\x04\xd2ЪAw˦\x91Ă\xb1\xa6\x1c\xaa\xbdƋ-\x92\x896\r7\x94\x02E\xfd\xa6\x92\x17y\x97EG\xa0m\xd0-\x8a4\xb1\xfcD\xbbҰ\r\x9f<\x9a\x03\x1e\x96[C\xd5M7Nٴ;ա\xcbH\x82I3m\xd2I\xa5\xf8\xcc\x02\x1aM4\xdaV\xdfX\x04m\xce)\x82A\x94\xfd\xf3m\x10P\xdc\xf1\x1fi\xa6\x93n\x0e\x96-\xa2\n\xadkL\x05\xed\xb7M\x957\x9db\x92\xa9\xb6\xdam\x8e\x97\x8e^sM-\xac\xa4\xbf\xb4\x99\xb0\x9d\xf1K\xa5\x95\xa4\xd2m\xa6\t\xb5\b\xbd\xc4\x17\xb6\xb3\xfc\xff\x00\xdd&{\x7f\xd0\xe4\x92ԛi\xb0E\xd2(\x88\xf2\x8d\xbe\xdb\xe8C\xff\x006\x82\xa40ʶƒm\xa4\xc8\xc3(\x9b$\x8d\xde\xdf\x7f\xff\x00\xfb&\x90\xdcF\nFZ\x92i$\xc98\x13\xd6\xdd\xf1<\xdb\xf9\xf4۶\xef\x1aT܉\xd3Ri\xb6\xdbmv\xed7\xbf\xa4\x95\xbd\xad\xfbf\xff\x00?\xc0\u07b6\xb6\xa7\x96\x02RI\xbe\xb1J|db]\xfe\x9b\xf5\xf7q\x04\xd3\xf6\xca[M)agR\xe2\x00eE\x8f\xad\x93\xdbd\xaf\xca\xc3\xe3\xb2[@2Y$\xb3,t\xcc\xf7\xe0p\x83\xb4\xafJ7\xe3\xe3c\xb6Ia\xb3\xed\xff\x00\xfb\xfc\x98x\x14\b\xa7\xf3ǵ\x11\b6 \xfd\x82\xcb%\xbf\xbbg\xdaK\t\xc1\x1a⳱^\xa2y~\xf8\x98Tb\xedl\x98\xb6\x84ܙ\xa0\xbd\xcf\xfa=\r\xb3\x06\xefH\xd9m\xbe\x03\t\xa1\x89\x12Rc4\x96\xf9Ɲ\x01\x03k\xdaO\xf5\x176R|s\xa1\x1c\x80I$)\x94\x80\x0e\xb5\x98MSL\x87?\x84J\xe7-\xbe\xec\x96\xc9\xc9\x0fd\xed\xb0\xfe\xa6\xfcbF\xbc\x1b;\xcc\x12\x1bAֆf\xed\xf0uћ\x91\x80\xaf`n\xbeX$\x97M\xba\xe8A\xef\xe2_\xb6\x7f\xe0\xd8ꚺ\x9a\xa5\x81\xcd\xc7\x19\x18i$\xff\x00LzymN\xc6q\xabl\xef'\xb4\xd7\"\x92\x05^\x88\xbd\xa4ߵT\x97\xb4!\x8aOն\xcdp\x9fի2\x80\xbd\x98F\xc0\x97=#\xde7\x94\x1cr\x95\xfb\xfc\xa9\xdd\xe3\xef\xf8\xc0h\xb9\xf9\x98\xbe\xcec\x1cyw\x06\xca\xef\xee?\xd7W\x8a\x12_uV\xf6\x81\v\x0f\xb6?\x87H\x92\xcf\xd5=\v\xef/ٞ\xccٴ\xfc\xe8\xc0\x1aM\xea\x18K\x9e\x9f\xdd\xf8\xd7,\xfd\x8b\x149\x19\x19\x97\xef#\\\x1e\xb8E`!\xf6H9/\xed\x89\xc0a,'\xed.[m\xd8\x041ֶ\xbd\x91 \xf7\f\xe5\xc0\xbb\\v\xb9\xe8f\x1a\xbd\xb2\a\x8a \x964\x9f\xec\xd6\xc9h$\b\uf636\xae\xd6\x10;\xe2e(\xddF\x85ؒ\x95\x95\xea\n\a\x10\xa0\x9b\x13\x81d\xdd#Y\xe6\xe9\xafn\xdc\xf0`+\x95\xc9\x1b%i\xb1\xf4\xe2av͍e\xf8'\xf2#+\xd6!8\x14\xb2\x12\x00\xc8<\xd4\x1c+LEe\x82\xb4\xb6\xb6$\x7f\xff\xc4\x00(\x11\x01\x00\x02\x03\x00\x02\x02\x02\x01\x04\x03\x01\x00\x00\x00\x00\x01\x00\x11\x10!1 A0Q@aq\x81\x91\xb1\xf0\xa1\xd1\xe1\xc1\xff\xda\x00\b\x01\x03\x01\x01?\x10\xfc\x8b\f\x9bo\x14h\x85\xfb\x00s\x05\\\xd1\x11>\x99\xf5n\x0f\xa4\x95\xac\x8f\x04u\x1d(\x8e\xfc\xfb+\x029\x82\xcc\x18\xfe\xbf\x1a\xe5˼\rA\xbc\xd1v\xe6\x96g\xb8\x0f\xa9\xfb0\x15\xe2?\x13N\xa25l\x0f\x92\xb2\xfco\xc5~cP-\x94Gz\x81z\x9fQ\x1d\xc4q\x8d\x10$SȳAd\xb8|\xb74\xc7^U\xf2_\xc3X\x1d\xca\xd9\x05U\a\xa8\x9fR\xcdˁl$\x0f&\xd6\xe2\x9d\x18\xd1q\xfc\xeb\xf8jWܯ\xa8\v\n\xed\x8ct\xb2-ś\x86j\x8a\xa6\xe2\x10>\xe3\xf4\x9b;\x83J\x8f\x83\xf1W\x89\xf8!p>\xa2\x02Xv_\xd6mz\x97]\x97|\x9f\xb4\xa1\xec}&\xa5]\f\f\x06\xae\xc5(K\xa87\x16\x00D\xaf\"-V\x06C\xa6\x1c\b\xa5\x81\xaf+\x8b\xf1\xd4\x1b)\x15P\xdc~\xd5\xf4m\xff\x00\xa9\xb3\xad\x10E\x14_\xe3\xf7\v\x81\x00\xbb`oPOb\x1eD\x9a\xf7*\x01&\x92\x86\x86\x1f\xbeQ\xa1?DJ\x9b;4\xc4W-Y;\x84\x15q\x14\xfbcVw\xd7\xfb\xfb\xe44F\xe3\v\x89eK\xff\x00\xdf\xfew\x03\xc2\xe5\xcb\xcdJ\x87\xc1Z\x96\x16\xf4A\x9f\xb2=\xfd}\xd7\xf3.\x14\xfe2\xf1\xeal`b\\t\xc0Q\xf4ˉ\x82\xb1\t\xaa\xe3܂\xf6BvK\xc5\xe1\x02\xc6'\xc0\x8d\x9eK\x80\x9c\x85D[+\xb5\xcc\\\xbf\x1a\x95\xf1\r7\x18\xa6.\xb5[\x89P<\x1b\xb1%v\xc3\xefܸM\x12\x97ԡ\x82\x003e!B\x01\x8b K\x1e\xc5\xfe0\"ȷ\xa9_\xa8\x0fQ\x1a\xf1\xc1\xd5\xd4J\xec\xe4O4\xb2*\xd5(Fឈ\x82'l\xbc\xd4\t_-Z\x80\xbd&\x85\xc6\n7)ܰ\x94\xe1\xe7\xf7\xff\x00\xd8(T}QzEl\xb2\x17r9;\xceű\x1d\xfb\xf5\x0e\x82\xdcL\"\xb2k}ĕ\xa9ٲ\xe5\x0fgF*\xf99\xe2\x02\xdeG\x90\xd1X\x16vl\x87\xf5y\xff\x00o\xfb\xb8;-\xaf\x7f\xef\f\xab\xf0)P\x8c\x03\x05\xa2\x1a\xe3_\xa8J\u0089\xd1\f\xb4\x10m\xf6\x97h#7bB\xc9a\xa9\xa1\xe0\xf6~\xeev\vc\xf0\x1a\x12\xee\xe1AF}\xe4\t\xd8\xf8\xd1\xee8\xa9R\xbf\x11S\x8dT\xf4\xe4H\xfb\x11Xҷ\xd8z@\x9a\xeaz\xa0eD\xc4\a_\xf7\xea\x19\xa8\x1a\x83g\xf5\"\xdf0\x81\xaf\x102\x86\xb05\x93r\xf0\x7f\x18\xa1\x15\xd9h\xf1\xff\x000Y\xc1\xf7\x1f\x04\xfb\xa6()O\xee5\xf2\x88\x1c`l\xfe\xa4S\xf0\x8al\x9a6O\xd5?T\xaf\xd4[\x91m\xb6%d\x94\x1b!N\xa2T \xb4\xaf\xc5\x12\xa3sjw*o\x8c\xa6\x7fW\x10a/x\x1b?\xa9\x15{\xf2\x95{\x83X\xe3\x1a .,\x90K%\x88\xb9r\xff\x00\x10\xc3;#\xa5\xc7\\\x95J\xfeڃ\x8b\xad\xc5^\xfe\x03\xf3\x186\xc6+W\x8b\x10 \x86\xa0\tF/\xe2\xaf\x00\xbdF\x87^U*i\xe4\x01\xff\x00B \"\xf0ÿ\x86Q-m\x84\xe4\xd1\x0f`F$Pkp\aP\f~[\xaf0\x88\xed\x01\x00\xdcS\xd8\xe2\xff\x00\v\xb8\x9e\xe1\xb3PN\b\xb8Á\xb6-Ch\x87\xb0S_\x84c\xa8\x96TL$L\x1f\x83r\xa5\x83\x11S\x10\xe0t1\xc0[\xc8)] pJ\x80\xef\xe2\x13B?\x8cΉ\xa6\x96@\xac\x97\x14\x87\xde\x01`J\x95\x81e\xfe\x05J\xaf\x05\xf8\a\xe7\xedøw\x13\x0f\xea:c\f<\x12\n~`\r\xb0\xd4\xda)\xd0\xd4S\xb5=Y\x87\xe2\xbf\n\x95*W\x91\xa1\x125\x10\xc3mAZ\x9c\x89\x80\x95+)>\x8f\x90E\xb2\xf2\x15w\xb9i\xe8@ \x00\xe3\x1b:|u*Vn\\\xb9y\xd4\xd4\x00\x04\xbf\x98\x9a\x1b\x80\x9cJ\xb9r\x1e\f˹dJ~\x15\xf5\x0e\xc3lF\xd8Q\xb8\xdb_\xda\th\x952m\xe4\xb8\xd4[\xa1\xb8?\x8b\xb9\xf5\x02\xa0\x880H\x9a\xc2YPkr\xbd\x92\xad9\xaa\x16\x06\xa3=\xc7\xc2ñ.c\xb8\xad\x9b\x10\xd5{\x88X\x83t\x85\x12Q\a\xd1=\xe4\xbf¨\xach4D\x1d\x97\x95G\xb84\xb3B^\xfdOzQ\x1b\xac;\x84\x89eK\x14\xfc\x1e\xc2%\xbe\x8f\xe1\a\xba~\x83\xff\x00\xb3\xa5/\x95_\xe1\xf7\x16<\x1f\xe6\t\x81;\x84\x11o'\xdb\x05:\xf8C\xe0f\xa3h\xed\x8b\x1a\xea*\xa1\xa8\xa5J\x82\x10²\xa2\tLwP\xb6\x98\xe19\a\x1e\xcf5\xa8\xcfDM\x12\x0f*\x93\x9f\xadW\xdd\x7f\x17?\xc9\xcf\x7f\xf2U\x13\x93\x9a@\xa4b\xdc\fU\xda~\xbc\xaaW\x85x>ΈeB\xc6\x18F&E\xe0q\x8a\xa9\x8d\x1b\x8c\xf7\xcc1\xeeq\x81d\x14ׁ\x1cVȷֽAT\x10\x11`\x01\x04\x1d\xcb}$\xdb \xba\x96\x1a\x8a\xd7\xe2\x1f\x05[S\xfe\x96Xj0\xe2\xb2<\x1c-\x04\t\x19\xce㨗\x152\xc2\x1bƛ\xf2\xa1\x8f\xd6U\xc0[\x1b\x8bQl\xb6Y\x84;Ɓ:\xdb\xe0\x17+\xe0b\xa0v\xf7.9\xac\u05c9\"\xa2/\xb9F\xea\xe2\x8e\xc3B>\xf0w)i\x81\x18,\x83~\x06~\xd1Y\x15\xb0/\x1d\xe4+r\xc5\x19\xfda_\x02l\xe8\x80k\xe6\b[:\x19Z\xa9a\x97`\xe0Y\x150 \x92\xa5{\xf8\x15\xa1\xa7\x01|\x94J\x88\x85`\xd4\xfd\xc0\xb8\x02W\x98.\x89N\xe4×QXxs\x9em\x81\xbd䩢\x9c\x84u\a\xbf\x03\xa6o\xac\vA\xa9cϺgT\xca \\\x89\xf5,\x81X\xfe'\xdb\xf0\xa9\xa9\xc4\xc2\xe5\xf8\xc1\xaa\x81^.\xc0\xa8\xea\x90P\xb1\x04[MH\xbe\xd8\xee8!\xf7* \xdcZ\x89e\xc7\xc0\xf4\x9cD\x01\x81ޡ\x04\xc0>\x1e\xc8\x00(\x8a\x19\xb9p\x95\x0f\v\x83;\xaa*e\\\x00\xa1ә\xc1\x12ȭ4\x88\x91 \x96>\x15\x8e\xf1\xf6\xceA#Ј\xf1\x05h\x87\xb4\x11p0߅\xc2\x19c\xccT\"\xd1*]Gj:\xb8\x88\xb7\x1f\x0e\xe5TGH\xc6X|\x17*\x05\xf2S.\xa0\tt\b\xadN\xe0\x9b\xf0\xe2\xf2se\xdcX\xb8<\x1c\x10\x8b/\fqY6\x1fX\xa9\xba\xc7I\xf2 \xb2T\b\xae:\xc3h4\x1e\x04q\xfcK{\x02\xa0G\xafh(\xbd\x86j(S\xac\xa8\x1cٸJ\xd1*\x1ac\xdcV\v\x9c\xf0%\xf81bô\xd7YU.\xe3\xb6\xc8>\xbe\r\x1b\x96\x83,\xed\xc3,#\xe0B\xde@\x11Qx\xa3\xa3\r\x81\xb6l\x19v\x8d\x11e\x9d\x82Ȏ\x88\x7f\xcb\v\xa9W\t\x13)*W\x8b/\x03\vQ\x85\xbdF*Q+\xd2@\xf5\xa9G\x8ca/\xccjS\xa8\xec\xa6&\xe5D\x94\xb1\xc5CٟL\x1dϯ\x00\rК\xe6\xe1\xf7\x8c\xa8\x84u*\xa5J\xbd\x81\x8a\x95\x1f*\xb9U\x95\x8b-\xb0\x02+B_\xa6?\xac\x0f\x05\xf1Jc\xd4\x1a\\\xa8\x91\x18\xaea\x00æ\xe2\xa2n\xeb\xb1+LԈ\x8c\x99\xa2\x05h\x8a\xee+ъ\x95\x80\x95*Tr\xe2\xb7?\x8c\xac\\\\\x18Z%\xdc\xe1:\x93]\xb9\xdc9\x10\x95\xe6.\\\xa9Σ\xa8\xc1+o\xc1,\x86\xc8J\x94\xec\x9cL;\xa2\x16\U00083c5e\xf0d\x8e\x19Xa;?\x8c\\p\xeau\x96\b\xbe\xd2\xeb%\x88\xed\x84qo\x99\xa6\\K\x01\x9ac\x05\xeb\xc4\xd3*\x18m\xfdB\x1a\x95\x19R\xbd\xfb\xcdכ9?\x99r\xe2\xd6=\xe3\xb1*?\xb8\xb2\xf0\xbc\x1f\x18\x94\xed\x10\x95*\xa5A\x06\xfc\x13\x00\xba'\xb2\r\x15\x86\x96\xe5បQ\xa2)\xb7\x0eX\xb2\xf2\xcb\xc1\xbc;\x9c\x8b\x12\xe2F?\xa9\xc8\xc2P\xc6*\xb3~F\xd1] \xa8\xc1\xe0X1\x8dC5)u=E\xfb\x95pqļ\x1bDs^nch\xf8v8$eay\xb6Xʉ\x93\"l\xa8\xa0\x98\x1c\x8e\xc4\x11\xb5ԠQ\x84\x8eBz\x87\x85\xc3\f]@\xbd\xb0kq\xc2n\xe1\x1d@\x8f\x83;6ԣ\x06\xe5b\xa5D\xac\x7f\xff\xc4\x00'\x11\x01\x01\x01\x00\x03\x00\x02\x03\x00\x02\x02\x02\x03\x00\x00\x00\x01\x00\x11\x10!1 A0@QaqP\x81\x91\xa1\xb1\xc1\xe1\xff\xda\x00\b\x01\x02\x01\x01?\x10\xfd\x81<\xf4g\x1av\xce<\xb4ݳ\xd7Iq\xad\xbd초v6N0\x9e\xde\xc7n\xff\x00\x1a\x0f\xbcX\x93\xf6;\xc08Cf<\x83\x98p\x15\xc9\xc2<o\xf0X\xe0<`\xf5\x1d\xfb\x8c\xf0\xfc\xbb\xc9\xfa\x87~G\xf7y\xf2A\xf6P[@-\xa5\xfd\x88opo\x10A\r=\xff\x00\xc1\x89\xb1\xf86\xd6N\xae\xa6od|\x8f\xea\f\xb2ܕ\x18\xf6\xe8@\xfb\xe1{\xcf\xde\b\xfe\xa0\x1f5\x0e\x1b\x7f\xb9\a\xb3\xbbg \x1d}\xb2\xcf\xf5\x1f\xd8\x0e\xae\xce\xec\xdb^\a\xed{\xc6~D\x87\xf3\x86\xc4\f\xf9\xbfĿ\xb9\xc2\x12>\xed\xfa \x80\x9d\xd9\xfc\xb3=\xb7<\x96\x1d\xf7\x02G\xbfi=\xa5E\x18\xd96\xcb#\x85\x8c<\xbc\xf7+-\xfb\x84cӕF\xca\xf6\x87\x7f \x9e\x19\xf1ܱjö;c\xa8\xc0\xfe\xc2Y\xf5u/\xa9\xdc\xc2\xd7I\x81\xe4#}\xdb\xe1-\xb7\xf5\v\xdaO\xdbz\x06\xff\x00$7M\xa9 ,\x0eW=\x90f\xf1\xd7\xfd\xd9\x13\xa4b}\x9d{\n\xff\x00\xcf\xfe\xe4\xf5\x0e\xfcs\xe0C\xf0\x0fs\fgm\x87\xdd\xd5>\xac\xfe\xdd\xfa!\x03&\xb6m\x96\xe4\x8b\xdf'\xaf,\x86\xc8D]\xb1q\xb7\xf2O\xf6O\r\x9cz\xdba\x1cN^\xfeM\xa1v\xb7\x86\xda(\x9d<\xf9f\xc6\xe0\xfcI\xa6I'\x8c\x83\xb1\xf55\xecm\x1e\xdb+&!\xc9u\xea\xd0t\x8fD\x82<\x82\xe9c\v\xfbl\xfb\x91\xfb\x8f\xa7\x19'y\x0e\xfe\x16w\x10@\xff\x00X\x0ey=I\xf7\xe1\x9b\x1f\x99\xa7r`I\b\xf7\xcb\xe8\x93-\x13\xa5\xea\v\xa4\x1d]2\x04\x1b\xae\xd7\xf8ŋ\xdc\x1e\x10\xfb7o\xa8\xb5k\xe4\xba\xcbQ\xea\u05cd\xcbߋ\x83[`s\xb6\xc9\xc8\x0f\xb9\b\xe0\xff\x00\xb7\xcf\xff\x00auu\xfe\xc4\x7fP\a\xe84gD6\xbe\xa1\x97\xb6\xfd \xe4\x81\xdc\xf7aq\x80\xac\n!\xdcu\x80\x1d\x12\xc2?\x03\xa6\xcb\x1dO}\xbc\xff\x00XG\xe4\x9b\x06q\x93G\x96$=\xc1\x9f\xa46l\xfaC\x9d^\x16\x96`\xa7r\\\x1e\xe4\x1f\xdcvn\xd8\xf6\xb0\xd9%\xff\x00D\x13\xe4\x9b\x04\xf8l2Zsݶ\xef\x92mی\xbc\xfc\xfb\xf1t\xc0̙\xc3\xc9\x1e\xcfn\xfe\xec\x0fwD\xec\v\xb0\x1d\xbd\xf0\x82\xff\x00\xa2\x01\xf8}\x9f\xe6ݻ\x7f\xd80g\x9f\x04\x1fn\x9eN\xde\xdd|\x97-\xd3\xf5T;a\xebb\xac]\xea\x1ff\x1f\xce\x12ug\xa7P\x0f?+\xb9ԗO\x03^ك\x9f\xa3\x8f\x1c\x19g\xeb&\x97\x8e1\xefrn\x12p\xe1\x90x\xfd\x04>\xc0//m\xe3$\xb7\xa9\xee\x14\x8dpw\xdc~W\xa2>cH=}EvJ\xcf\xd4\xddg=\x12mޯ\xaf\x04A0\xa9y\xe4\xb4\xf9\xbf\x9b\xdb֬\x01\x1f\xa8\xb0\xb7\x19\xf6W\xa8u\xbc\x11=\x10l\x99\f\xb3\xf6\x0f\xd4XY$\x03\xa4\x19\xc04\xce\x15\xb1\xf6E\xa1=\xfbm\xa6:;\xff\x00\x8b9\xdcO!\xdb\xce\x18:\xdf\xe3k3yX\xfe\x86\bG\xcfɖ\xff\x00\x7f2\xe1\xd2P؆\x91,\xf8\xa9i\xf9\x81X6\xec\x0e\xa1\x1d\xa6\xc6y\xb0\xeb\x8fO\xe4˾6\xdf\xc2\xfbC\x1b\x16\x7f\xb3z\xe1\xc2[~\x03\xf1\x0f\xc0\x86\r\xbb{D:\xeaŃ\xd9O.\xca;-\xa5\xf3\xeb\xf4\xb2\xcb.\xee\xf8Cݝ\xa3\x82\xde\xf8\x1aK\xf5\x1c\x8c\xb5\xecD:~\x15\xc2Ǝ\xbf\xb1\x90\xf4\xc8\xc7\xff\x00)\x01\xad\xb2n\x8fl>\xc1\xa7\x89\x0e\xfe\xa8\xff\x00\xbc\xcfp\xbc\b{\xb6\xdcl\xb6\xe9\xb7\xec\xe4\xebǨ\xbb1\xf8\x1f\x10^\x91\xe6\xbaC\v\xa3\r\xde\x19\x83u\xf4\xdd\xf0\xeb;\xdd\xf6\x90\xe9\xf87\xf1\xe1\xd4U\xf6;\xb3\x96\xc33\xd0n\x8f\\\x04\xf4\x86\xd8y\xc0\xf8\x860쁿\x81\xd2=\x84\xfa\x86\x9b?Wu\xff\x00_P\xaf\xba;\x05\xff\x00\xcf\xff\x00PAv\xb1|\x81\xf7\xc66\xfe\xa3\xfb\x93\x1f\xd1\x11\xac\x9dO,\x8f\xbc\x00Ol\x16\xf03>\xae\xae\xc2\x1d,\x12g\x91\xc0\x0e\x91\x1d\xcb\xeb\xe7\xd1\xed\xa3\\.\xf1i0\xd7M\xf7\x8c֎\x8e\x0eC\xe4˰=f76\x1f\xa7ῌ\xba\x9e\xca\xf5\x829\x1e\xfc\x0e\xafo\xe6>\xb8\xef\xeb\xd8\xcf#\xa8q\xce\x160\xe9\xf2\xf7ٳX\xa3\xaf\xdch\xd6u\xedU\x8a\xa3Ճ\xea\xdd\f9\rm\x0eK\xad9\xcd\xf7\xf1\xfdw\xb6\f\x8e7\x91\xe1x\x1b\xd5\xd9M\xe4{\xc3vM\x93Hco\x1fW\xcf\u0590\xbb\x9fpvz\x96\x16\xb6\xa7ݷO\tl@\b\xef\xbe6\f\xb7|\xfc\"\xecn\xbfP|\xb6>\x06Ww\x86\xb8\xb9+\xb2\x14&-\xbb\x87\xea\x1b\xa3\x1f>Ńgݧ\x1e\xf9\xf3\xdb\xfe\x84\xb9v\xde_\xef\xf0\x97S\xb6S\xaf\xccx#\x87\x80\xac$\x99oݛ\xc9N\xf8\x93\xc0\xd2pwm\xa1\xf8\x0e\xfb\xf4ý\xb2\x05\xf2\xc0\xb2DW\xd4\xeb\xc8?\xb7\xf8[\xf8\x14\rfz\xe3\xf5\xf1\\5\x98\x88\x8e]8^W\xb0\xe4\xbaA\xecƸ\x12q\xe9g9$\xfb=>\x7fY\x06\x01\xe7\x16Wh\xab\xd7.\xa0/\xb7D\xbf\b\x8doS\x86O\x1epOw\xec\xbb\x10\xdb\x17E\xa4}OP\x84\xc71\x1d\x83\xe8\x86|\vB\x10\xe4\xd0\x04\xb3\xf0t\x85u'<\x0e\x17\xd1g\xdb?Ϳ\x84\xbaz\x92\xb5\x83\xe2M\xbb<m\xb7\xf9e\xc9Vn\xad\x8e\xb0d\xb7r\xf3\x81\x1f\x01\xa5\xa9m\x88el|\xfc\xe3\xcfd[\b\xbb\xef\x1b\xde\x16?q\xd7\xc7r^\x91\x8f\x96\xf0\xcc\xc1\x1dOԏm\x98e,\x1b\x1c\xcf\x13\xb1\x1f\x02\xdeZF\x11\x86\xcb\xe1\x8b\x1du\xcb\xf4 \xfbg\xf8\x97\x87z\xb3u\x97\x899\xf0\x11\xdd\xec\xd9d\x1f#\x96\t\a\xb2؆mŖ\x0f\xbe7\xea\xf0\x8e\x82:\x8f\x89m\x06\x1081\xdc\xc3^3\x84\xb7\xfbj\xd8G\xbfg\xb8\xedɞ\xea\xf4\xa6[7u\xe5\xd8\x16\xf0'QUׇ\xc8\xe5\xfc\x1fBS\xecD6\r\xbc\xae\xd1vz\x86\x01\x7f\x9e7\x8d\xf8{\x18\xf7\x0e9lYG\xc1\xe9\x97莥\xcb$^$\x83\xae\x8b\xae\x83O[\xae\xb3\xa9\x1d\x99\xfa\x1d\x1cgv峋m\xb7\xe4\xa1i\xd1/\x03xF\x1b\x045\xb6\xdd\xd9^;\xb4{\xca\xdb\f\xfc\v\ueebaC\xd6\xc3\r\xd5\x1c?ſ|\x8dA\xf7\x19\xc7 lu\xb9d&Wݻn\xdb,<o'\xc0D\xb7\xde\b\xd4x?\x91\x81ܻ\x11\x89\x8f\xa3\xc1<\xec\xbf\x1fo\xef\x1dul1\x108\x17\x83\xb2\\'\xd5|\x87{/u}Qk[\xea,ɿ|o\xd7\vm\xb8L\x18\xf9m\xe4X\xe0@@\xd6\xf2\xedn\x90\xe2x\xe1չ\xd9c\xe0[K\x18\xee8-\xf8\x0eI0:\x19w\xde\f\xeb?S\xcbWȎw\x87\x829\xd0;\x9f\xa1\xc3Ƒ\x06\xf0\xf1b\xac\xcf.ܙ>9%\x8f\x8b\xe5\xdf<\xea!\xbf\xaf\x8b\xd9\xc3\xc1\xf5=\x9dklq\xaf9\r\xe4냔\xaf_\r,\xc8\xefp}\x1c\x05a\xeb\b,។\xb6\xbb\x1b\xa4<\x0f\x81\u0087m\xbfW\xefx4둵\xf6\xc4>\x1c\xfb\xc0\xe5\xf4\x93\xdfm\xbc\xfd\xactD\br\xf6#\xa8b89\xdbG\xf0\xbd\x91\xc6ЉG$\xc6w\xec\xa7~^7\x80\xfe^yϩ\x04\xf0\xf8.\x1a\xca\xf4Bz\xe4\" \xbf\x88#\xbe\xaf:\x88\x86-\x8f>\x19\xbcl;\xf2#\xd7\x00\xc3.5\x9aBl\xe9\x81ܕ\xd6:\x87\xab>\x1fr\xddg|g\t,l\xa8\xce\xe5Έ6\f\x8bż\x16\x1fp\xeb\x16G\x1d;\x96\xfe\x1f\xff\xc4\x00'\x10\x01\x00\x02\x02\x02\x02\x01\x04\x03\x01\x01\x01\x00\x00\x00\x00\x01\x00\x11!1AQaq\x81\x91\xa1\xc1\xd1\x10\xb1\xf0\xe1\xf1 \xff\xda\x00\b\x01\x01\x00\x01?\x10ۦ9\xdc,\xab\xe7\xe0\x99R\x9a\x87\x05U\xe6{\x12\x82\xda\x17\xb7P\x18.~\xf3\v\x03\x1c\xf1\x00\xe5\x98\xeb\fWw\x89G\xd4\xd3\xfeC[U9c\x11\xe0\x85\xd6<\x9a\x96\xe5\x80;\x88\xc9쨵T\x0ej\x1a\xad(\xfd\xc6\xec\xebĸR\x86mfs\xedS\xf5\x9a}\x13l\xafv2\xf0\xe0\x16\xbe%T\xbb\x90\xc6\xeb\xd4U\xfcG\xc8\xf0<\xb1jZ\x022\xdbA\xe6\x06v`?g\x83\xeb\x02X\xb4\xd2\xdf.e\x029\b\x16z\x88\xdcrRs7$\xc0\x18\xef\x1beZ.j\x17\xd4R\xad\xce\xd1\xfd>\xdf1\r\x87\x86\xbd\x9cK-\x0e\x9a\x91\xc5.\x81\xb3\xf0\xe23v0\x99\x1b\xde#\x01\xb9\x9f\xcd\xe4\xf3.\x1bZ\xee.e\x83H)\x836\xb7\xd12[\xa7\xcb\xfeʇ\xd1\xe1\x9ef\x16\xad]E\x8cg\x15y\xb2\xb2\x8e\v8d\xfb\xe3\xe5\x80(>Fѡk\xc3U\xfd\xc6Ϳ\x98\xadi_\x10*\xc4G\x94^Œ\xeb\xafq\xce \x89\x03\x14u\xf136\xbe\xa0\x9a\xc1/\xd5\x12\xa6\xf35\xcd\x11\xdd\xdf\xf7\x18\x17\xa3\xccB\xd5*\x9c4n0\xabSe1\x15V\xa8\x84\x00\xfc\xe2\x9d4K[\x1b\x8a\x03\xf8\x03\xfe\xc5\b<\t\xee\x1b~j6\x00\xbf\xf3\f}nf\x06{rͳ\x1c\x83\xf2w\xe6[\xd6af\xfavB5ޯ\x10 \x9b\xa2\xd5sY\x03\x17\x93E\x1c\xe1~\x93\xa5\x19\x80\xfb\xf1\x16Q\xb6ƣ\xc2\r|\x16ѕx\xc4E\xb0\xb1N\xe3\x14\x88\xc8`\xf2\x04v\ue76a\x7f\xa9\x96)}\x1a\x8f\x83K\xbf\xe9+\xe0\xed8}\xcd^UM\x84\xb4\b\xbd,\xa9\xdc\xcc{\xbcx\xa8-@v\xc3\xe5\v\xfe\xa1\x940\x84\x83\"\x87\rh}\xb4|\xc2\xe8+6\xf9\x84\xc6/79\xff\x00(\xee8\xef\x923\xefW\x11\xba3\xe1\x80\x19\x02\x18\xe9ǯ\xfa\xdf\xd6+[\x97\xb9\x81]\x0e%\xfe|M\x86߭\xc4]\x9aoܴ\xcaߙ~73PV\x86\xb5\x10\r\xa7j\xfd\xc4|\xfdfE\x82ʆ\xd4u8ۖ\x0e\x17\xd5\xc6e\xa5e\xd0c\xb9T\xaan\xd8+\xb8\x85\x83\xd84\xc6\xca\xf1\x962\x1c\x96%\xbf\x0f\xcd\x11\x93\x93\x18~\x13G\xdere\xe6\x7f\xdf\x12\xa6\x00\xf5\x02\xb7\x1f\xe2\xd8\xdaĤc\xc5\x1d\x94Y\x06\xb1\x82Ts\xed\xfa\xc4$\x99`@\xbb\xaa08\xba\x8b\xc5j\xe0\x0fp\xde\xf5Ɂ)PY(y\xd7\x11\xafR<\xf8\x7fs-\xb0Q\xbea\x82\x0eC\xfd\x18,,ԡN\xa3\xc8G\xc1\x7f\xdc˘v\xbf\x82\x18\xcaF\xf1~\xa2\x97\xec\x95\xfdB\x94S\x91\x7fy\x8b8\xb8\x03yB\xdb\xef\x03\x98\x91\x03\x11,W\x98: \x00A\x87\x93|0\xae\xa7K\xaa\x88\x84\xaf\xe9|\x90/\x19\x1f\xb4v\xde8\xee\x00ߧ\x92W;\xc7O\xec\x8e\xee\xeb\xc1\x1f\xb4\x1d\xe8\x85տ\x1ee\x1cY\x9f\xb4\xc06\xafu\x1cs\x98ߚU\\\n\x95\xd0\x14\xd4!\x94\x1fq\x8br\xe8\x88\xd0\x1ee\x91/\xbd\x10|%\f(\xbe%I\xf5J\xe2e%\x14\xf3\x98\xb8C\x8e\x1f\xbd~\x97\f;r\xc4\xfb\xdb\xe9Qj\u061c\xab;\xbf\x8d\x12\x88\xc0\xc7F\x02\xd7\xe2U6\x95;_\x1c\xfdj\t\x9bŹ\xf0q\xf5\xb8\xe0\xe2*L\x1d\x01Tz\x8d\xb6\x1cپ\xcc\xe5>\xd2\xd4\x06t\x94\xbeG>\xb7\xee\x0e-\xe0s\xae\b\n)\x14\x97Bz\xe6\x06\x037\xcab\x8fdX]\x13\xb8p\xfb\x1f\xa4=SӤ!A\\\x7fc\xf7\x06\xd3[^\"U]\xdc\x15\x17\f\xbe\xa5D8\xb8\x9e\x1dj\xa6\xd6a\xfe\xe0\xaf\v\xf6\x94H\x91\x1b\x97\x89B\x12\xa2\xfdz\x82,\x18fD\xc88\xf3\xea\x1bL5\xfa\x96(RB\x8cOV\xa8\xe4\xd6\xe5\xde\x0f\xb10D8\xb5X\x038w\xdc\x12١\xcd:\x8b\xc1\x13\xb4\"\xb9\xa5\x8b\x9eΥ\xb5\xb0=\xee!\xc9\xe5\x98\xd3\x19\xaf\xa1\x10\xb4\xf9\xcc[V;\\E\xb4\x85\xd2\xfa6\xfc\x10f\xf5\x17{\x03\x94\xf7R\xcfk\xb0}\x10\xc7̬\xc0\xa9\x87\xf0\xb0\x98e\xc0o\b\xbd\xaa#\x97:e_\x96[\xf0|\xca\xca<\xb7c\xee\xec\xf8\xbf\x89I\x88\xbb\x00\xf4\x16D%\xc0\x11\xa2\xd1\xf5F\x9f\xb6.ߴ5\x06\x7fߙ\x91\a:\x8d\xee\xef\x05g\x03ԣ\xa7\x9b\xfa\x0f.y\xee;T\xa4\x19\x19M\x9eͻ}M\x02ƞ\xc7\xee\x10\xab\xb5Ǐ\x11̏\xfdS\x04R\xe5le\xae'l\x02h2\xf1ש\xa7\xbc\xc3X!)\xa6\xa1\xa0\xdaf*\x16$6\x19\x81\xb9\x86ͧ\x99\x83N\xe6-\xe5g}\xc70kP\\8\xfa0V7I\xe4\xeb\xd4,\xa5\xb4\x7fJ\x12/\x87\x98\xdb0ُq\xa0\x8b/\x17\xa8\xe1A\xd9DE0\x83\xc1\x96( \xcdx\x8a\xa61\x12\xc5\xda\xca\x14,\xd6Y\x81\xf1\xa6\x10\xa0\x03\xfc̭@\xae\xf3\xb9QY(2\xf5F\xbeR\x18\xe36\x03\xff\x00\x8fd\xdeD\x96\xff\x00/\xc5F\xd5]\xb9\x9a\x8es\x16\xa2\xcd\b!J\xfa\bqz^\xc4\xf02|\xd44(\x19_\xacq\x16\xc2\xe3\x98\xf6\xe8\x83dA\x85\xe3\xe4=\xdb\x1fj5\xa7\xa2\x17^\x9f3u\xec\x05\xe4/\xe5R[\xa2\x13\xc3\xc2\"\xd0p\xd6x9~\t\x88hͥ\xf08 \xa8\xe6\xd6\xf4s\xa3/\xbd\xcbG\\\xc1\xb3\xacn\xa8\xc1Z\xbe\xe0\xcd=\xd1\x02\xb4\x8c%\u07b5\x16\xe3L\x0eO\x1d\xc0(\x0f3\xb5B7\xf2\xd7\xf79\xc6?\xa1\xd3\x12\xa2\x8e\x1f\xc3\x19<\v\xf6\x99\xf2.)\x8b\x8d\xbb\x8fLd\xf8\x82>@\xca(Qq\xd0%:?\xb4\x15v;\x01\xf6\x97-\x18UW3\r%\xa5\xb4et\xca\b\fUY\xf5\xb28\x00@\b\x02\x9c\xb4\x99q2\x82\xfc\x06\xbf\xb9J\xc8wm\x7fqz\x94\nپ\"e\x94\xd0\x18\x92\v\xb9\r\xe3Ǹ\x9b\xddAR\xf0\x1eb\xf1\xed)ڒ\x0e\x14[\xe0B\xe1\xbeKNQ\xe2\xf2\xc4\x02\x9d\x95d\xf2\x15\xe3p\x80\xa86w\tdZ\x00\x0f+\x82\x10\x91\b\x04\xbd\x98\xfa\\\xbds\xf7\x01\xd2\xfc\x86\xa2\x1b\x89H\xd1\xcb<\xbf+\x18q\x8d\x7f\x80\"%+E\xad\x19\xf9\x97TtA\x1c\xae\x96^1\x97\xa8\xf3'.\xdfbǠ\x98\x17a\x95]\x17v=6\xf1\x062\xe0U|\roœ\xa1h\x95\xb8~\x80B\xa0j\x18\xc2)t\x168\x15\xef\xc4>WI\x81a\x90>\"\xd6\xeae\x1e\a\xcdUK+\xd7\b\xa5$\xdbT\xe5(\xab\x021\x15\xc6\xd8Q\x8a\xe0-y\xa4j\xb3\x15\x9d\xc4\x14\x1d\xd0\x04Y\xa9\xd6\xd1tg\x0f#G\xcc$e\xa0\x9a\x13\xa1\xed\x87R\xd7!~\x19~e0l\x95\f\xa1\xb1\f\x15֟3\x04VJD^\x8d\xfc\x04n]\x85h\b\xa2\x89sGX\x83\x10\xe2\xc0\x03\xf3S9\xf6\x7f-\xd4EB\xf0\xd8\xc7\xe2\x1d\xe1\xb3\xe9\x12\xfeBv%6U\xb6\x8a\xe4,=)*\xad\f}忼\xf4Jy\v\x06\x86\xe4t\x8f\t\x88\x03Xj\x18\xc2\xf2D\x04\"\xc4Ⴊ\xc8[e_\xdfp\x1eJ\xa449b\x81U\x9e\xe1p,\xda\x15%\x99\xf8\x8dfV\xa0U\x96\xb7\xe7|\xc4\bYEr\x1d}\x7f\xd5/\x9a\xd9_w\x01\xed\xa2\xd3_XK \xe3\x19\x16\xdb\x053\n\xab\x9bM\xa9n\xa0\xc8\xde?\x0f$\x0e\xa1\xf6\n䍚\xb6uQ.E\xe8\x95vW\x97\x11\x1dn\x0eע\x18շ\xba\x97n\xdf\x10\xa2Σ\f{Y\xb2\xc7A_\x80m\xf8\x87q\x9c\xdfsT/\xdb\xc71\n\xe2\xbfFG\xb5l\xd9,\x8e\x9a4x\x01\x80\xf0F\xb1\xfeAs\x94\t\xd4\v\xf9\x80\xb8ÿdj\x06\x86\xf44\x16\xa7\x1bǏP\xd1)\x16\xc1Ñ\xed\xfa\xc1\x8d\xf4]\x17e8\xb3\r\x94X9\xc3\n\xfc\x96\x15\x9en\xf8\bx\x8b\x02X\f\xb4\xd8g\x8a.\xdf\x17\f:\f&\xfd#\x18\xd5W\x9b\xa3`\x05\xb4\xe3\x80\xd5\x1a9q\xd4I\xb3\xea,:\xec+B\xab\n\xc9`\x8f7\xd7g\b\xcc\x19\xa7\rs\xd4>\x11r*\x95\x8a\x1d՚V\xb7>\x8f\xcajכ\xc4\x14c$!śl9\xe3g2\x82N\xac\x10\xd5W\xe1O\xbe\xcc-)x_n\xfc_PF\x95\xa4P|\xb2\xac(\xca&\n\xca\xc5aۃU\t\x06\b\xcd\xc1E\x0f\xd5\xf4J\xa2\x19\"5\xcbp^R\x1f\xbeMw\xec\xab\xfaLR_\b\x0f\x89T\v\xc2\xe6|\xd4\x06%\xb6\xa7{\xb1W\xe6\xae$Y\xec\x96\xf2\x0e\xbe\xb0ɮ\xda\xfc\x9e߭\xf2:\x83F\xba\x05~\xb8zς\t\x91i\xf1?\x10\xef\x12=Jڪ\xba\x8a\x80\xd1\xe0\xbc\xf3\x06\xee\xe2\xd0P\xf2\xdcI\n$\x8c\x84\x1cTX\x80\xd1W\xbf\x89J\x0f\xc2\xe3\v!\xce!mQ\xc2GC\xaf:\x9b\x9e\xb7N{\xbdEG\xb8\x05\xdcYy\xdc3\xbb\xa3\x17\xe6\\2Ww\x1d\xe7\xf4d\x17\x8a5\xf3S0\x8a)\xf2ݎ\x8arq\xccZ\x15\xaa\xbd\xbeX+\x84\a̹b\xe5K_\x9f\xe6H\x90D\x9a\xe3\x7f\xc1\xc02\xc7\xf1\xeaPV\xf9*\x89j\xbaxX\x1a\xb2\x0e5\xabX-\r.\xf2\x0e\f\xbd@8\x8b#{0\xf8\xccG\t\xcd`u\x92\xcb\x12\xa0\xfc\xb1\xa6\f\xd5\x15\x03z\x98\xdd\x04ʀd\xee\xd9\x1bQ\xf3\bt%\x01t\x18h\x03c\xbcZ\x8d\x15t\xd4%6\xa8|J\a\x96<,j\x97\xfe\x1fR \xcc\n\xd9\u0fffp\xb5d\t\xd3\x1a-\xad|\xe1\xb8\x1c\x99c\x1e\x8c9_^\xa1\xc5\x01\xdela\xa5\xf6\u05f9~[f\x10\xf8\xe3\xe8>\xe2\xc9>\x85\xac&ݛX\xed5\x98\xd1\x17.\xde{\x94tu]{#\xc5W\xacJ~\x02\x17-wK\x83\xac\x00\xfe\xa5I\xb5\xd4\n\xab\xa3\x97\xe7,\x06n.\xb7\xf7/\x91\xc8X/$Js\xfc\x06$\v\xa8\x95\xbd\xc6\f\x149.\xae?X\xd0-Ƚy\xfb@\t[`\xab\xed\xdf\xf5-}Q\x15\xf4'\xef\aǒ*eY^\xd89\x16;\x8eJ\xdfk\x00\x10\xadU\x03\xcb\x1e\"\xadJ\xf6\x18\x0f%\x91\xcc\xd3ڹ\xe1s\xf0Z\xf1\x1b\xa1\xc1\xa7\x0eU\xae\xe9k\xc1\n\x12\x89Q.V\"D\x82&\x18!\xa7\xf8*l\xa9\x9a\xb3\xf2l\x81z\x81\x8d\a\x14\xe7\xe3ķ\bY`S\"\x90]\x9b:\xe3\x1a\x98h\xd7\x0f)nj\xe6Z\n\x1f\xd1\xe9\x13d\x03sk\xe1\xeb\x92e.\x15T\x17@T\x9b\xc9+\x17\x98K1 [Qb\x85j\xe2q\x06\x00\xaf\xb1\xb8\x00*\x0e\n\x9b\xa5\xd7\xfb2\x84\x1a\x01d\xc0\xddՕ\xe3'rˣ(qn\x98\xbc\xed\xb7\x1a\x8ddPZ\x91\xaa\x1b\xddu\\\xc4&\xbb\xfa\xc0\x14%u\xf7\xe1\xb5L\x8eX\xd9FSͶd\x97\r\xc2y\U000bad97\xe6Z\xe8\x8d;\xfa\xf5\xfc\x1fp\xf3\xe7\xdc,f\xae\x8f\xa8\xf8 \xdc!H\xe1-1tz\xf3qZ\x89\x81\xe4\x0e\xfd\xc4N\xcf\x1c@\xb4t0\"\xab\xc3\xff\x00QE\x85\xb7\x98\x839\xca2Nt\xae\xa8y\xa0O\xee-Q\xec!K\xc2\x00\xde\xf4}\xbb\x8b3uV|.\v⡑\t̟zߖ\x1f\xa8rR\x1f\x06\"d<U\xa9\xea\x1b\x9e\xc7S\xcaY\xf2ψ\x0e\x17\x9daڼ\xaf\x7fJ1\x16\x13\xe6\xea\xbf\x01\xb5\xf0\x11ɐ\xa2\xef}\x9f\xd1\xea\x17\x1a0\xa8\xfaS뇲*eTA\x0e\xd0`~ !\x84L\xca̩Q\x83\xcf\xf0?\xc1\x17\x88y\x83\xe6\x1c\xd2\x14(\xdc)\xee\xcc\xdf\xeam5Z\x86\x13\xda\xd3\xe3Q\x8b\x89\x0e\x0f`\xec\xaa|\x90\xe2\xbc\xd1\xd4\v\xe3WZ\xdde\xa3F\xb1\x11DR\xa9^Ԯ\x92\x89\x8a\x045a\x11b+\xc0՝\x91\x86C\x80\xfbY\xd6>=iЋ\x8e\xc0\xb6\rZ\xc9\xe0\xf3/ 45\xc1\x85y:\xcfp\xce\x19\xd1%e\xd7\xf4}b$\x02\xd4\xecR\xcd\xe6\xab{pp\xae\xa6\x1bm\bq\xf6\xacj\x0f4\xedY\xa3D@\xd3.3(\x0fQG\xc6~\xc7\xcco#j#\xca\xcb:\xfbM\xb4\x13|U.\t~9K\xe7\nd/\xea|\xff\x00\x04\xa9hٲ9%U\x94\x9f\xb9p\xa7\xb26\xc4\xfdu\xdb\xf2\xb3\xc772\xbb\xc9\x14i\xfb\x13\xb2\xbb\"\x00\xbb\xad\\\xbc\xc9؆Ox\xd3\x04G\x1c\x9c\x91\xc0-h\a\xc3\x1b\xf3\xff\x00\xb2\x94\x1b+.\xf23\xeac\xcb\x13\xbd\x14`\x1e\x83\x01\xea^\xb0|\b\x1e\x14\xf4\x98\xf5\xaf\xf1\x88r\xc4]E\xff\x00A !\x00\xfa:8\x0f\x19\xae㤚\xcboF\xe0?\x81\x84\xe3\xf8\xacėSl\xda0\\\x19\x99\xb9\xcd`\x870\xb5Po\xee\xf6s}\x7f\xd4o\x19\xd2t\xc2\f\x19\xf4\x19\xb6\xfd*\xd6\xc9C`\x14*\xf7J;j\xb8q\xcdG\x99\x8ah\xe8Ak9\xb5\xa6.1\x8d\xb4\xe3aˇ\xc1\xb8}D݂\x9eZ(O\x98ՊKl-i\\Z\xd4Mm\xedN%\x81\xa6v\xe3[\xd4D8\x8a\xe8稳U\xe4\xd3\va\x02\x16\xb0\xa2l\x1a@j\xc1m\x88\x9bBK\xcf/\xfa*Z\xe8\x98\x17\x9at\xf9\x87\xa7\xccU\aw\xfa\x86\x17'#+\xfc\xf2z\x82k\xc0\x15A\xac\x1b\xf9\xb9L\xcd\xc6!\x12\xa2\xd8g\x91\xdc\xdaG#\xb2\x00P\\V\xb0\x96q2\x1bψc\x81x\x8e\x96\xbe\xa1\x1bG\xdb\xcc\"\bV#\xf6\x88\x93JW'\x8b\x1f\x8f\xa47J\xe9\xad\xf94\xf8i\x97\x04\x1e\x12\xaafV\xf0\xee\x03\xb3\xc5\x0f\xe6\t\xe9ND\xeb\xe6\xa6@\xbd\xfcL\\\x9e\xf9\x8c\xd5BR\xeaTJ\x99J\x8b\x1c\xc1\xaas\xccu\x19\x83\x10\xb4\x1a;\x8a\xacM\xe0\x0fVa\xf8\xb3\xcc\x10K\xc29J\xc7#\x9a\x93\x8a\xe6f\x82\x02\x89\xe0\xc1\xf1\x1e\x06؎\r\x15\r\xf9\xafr\xda\x1c\x17\x10\x11\xa5k\x8a0W\x17\x91\x86\xeb\x91u,\xa6\x05\xa0v\xeb\x12\x9f\x8a\x8aڼ\xe1_\x14_\xcc,f\xf2³\x8bP\x8b\x9dk\a7\x9d\xa90\x05U0Um;E\x89\xe9E\xe8֭\xb7\xfa\xf1\x1bJ\xa9\x95a\xba\x86֬\xeeك):\xadx\xff\x00=\xc7\x16\r\xb3\x01\xf1\xf9\x7f\x8a\xf3\xfc+\xf8\x7f\x84\x84\x95\x13\x92\x00\x13\xb4e\x01\x8b\xe4\x8f\x17\xd7\"\xfa\xf7A\xf4\x17\xcb\x15\x96\xd6\x10\bJN~\x7f\xa9\xa7m\xc5\xcd\xe1\x17\x1a\xa5\x03 \xe94\x9e\x19\x97\xd3\x1f{\xbc\xe7ޢ\x88\xd0\t\xf7\xb6ׄ\x18m1ik\xa9\x82$\xb2\xb5P\x02\x80'\x87_j\x972\x8b\xddJ\xe5\xe0\xf8c\bp\xc4\x1a\xfe/\x13\x98\xca\xee1\xde5\x1bd*K{90\v\xb7\xbf\x99\xdb\xd1N\xea\x10\xc9\xcc\x1d\xc1\x880\xcbUW\xd6(\x10\xa0Z\xc5\x04\xea\xbcM\x94k\x8d\xe1\xceb\x94\xd0\xc00\x01\xcey\xe7\xe9\b\xdc\xc7(v\x942\x89\x9biV\xc4apR(\xf3\xc3\xe2\x19\x8d\\\xda\xeb}\xc7`\xa4\x88E\x03A\x9d\xe6\x18\rxB\u05cd\xbf\xcc\xcbQ-\x0f\x841\x04\xac\x7f\"W\xf0\xe7\xf8s\xfc\x18\x82\xbf{\x1b \x05O\x0f\xa3\x89I\xc3H\n\x18\xddmR\x1b4\xb2%\xca\xc1X\x89[\x96!\xcb\xc4<\x91\xf4\x8eb\xae\xf9\x10o:S\x9a_\x1eb\xad\xf2N\x1fR&\x00\x16x\x1e\xa5'*\x00\xe1\x8d\x1e\xb5\xf1\x19m\xd5=\xb3\a-~\x0f\x11:\x867.3\x10\x8cJ\x8e\xd6\xc2\xda4v\xf4J\x8a)Z\x8e\xe9\xa4C*\x1a9\x8d[Y\"C\x189\x9eS#\x11\x7f\x85\xd1\xdbC\x04e\xab\x05C\x06\xf9\x16ߖb\x02\xb2}n;ذ\a\x1c\xa2\x85\x9dn \xad\x9c\\\x00X\xc37\x93i\x01\xe0\aPǭ\x9fP\xc1*T\n\x8f\xf2\xab2\xff\x00\xf8\x7f\x8d\xa3-\xe8\xec\xe1\x8cU\x9d\"/%\xbc\xac\xa0\x7f\xe3\n\xdcˉe#W\x9c \x9b(\r\x12\x89\x84\x8c\x157\x06%*\xbaa:\xf6\xcc\xf9^P4p\xce\x18\xdcTj\xa2\x8e\xd7\xfd\xf7\x84\xcbI\x157\xb4\xf9\xf3\x06\xbf\x9a\xf58\x89\x92\x82\xd4\xc0\x1d\xae\x83\xcb)!Z(\xa8tZ\xc9\xe9\xf4Ɛ\xb6\x87B\xf9\xae]e\xb7\x06q\x15\n\xadgSam\xbby\x94i\x0f\x12\xd9U\xb9z9bn\xacb\xa9\xddq\x1fH\xa2Gq\xb4\xe5%\x85\xd8}\x1eO$F\x98a r\xf6\xfd\xa5ߌZ\xc9\xc9\xe9\xbc~a&\xa8\x15\xd8g\xf7\xe3\xe6\x15,\\\x8d\u05ce\xa5݇{(\xff\x00{˘\xa2\x91\x1f%L#\xaa\xff\x00\xed\x8a \n\xa8\x05Z\xc4\xcd\xff\x00\ftF\x05@\xdb+B\x95\xafq\x8aQ\xd7r\x91\xbd\x98\x85b\xbaB\xe8\xcbD\vX\xb8\x94\xdf[\xc4\x19\xe1x\xbd\xdb\xe0\x86\xdes\xe7lV\xd02\xa5\xe1\x8f4\xb7zu\xea,\xa3g\xd6\aQ YO\xc1)nk\xfbz\xfak\xe2^\xb0h\xf8\xee-\xe9\xb9\x7fŐ\x05f\xae\x1ea\xa5\x03`\x81\xf0s\xe7~c8\xc92\x11\xf7\x17s8\xb5\b\r(\x94\xd4\xd3Ps\x18\xa8\xcdD\xeaX:\xe5\x88\x00k\x866&K\x82\xd3m\xd6u\x14\xbav\xf0z4G\xe61\xb1E\xe4\xfd\xc4:\"\x1bU\xe62\xbf\x96?\xfcq8\x8e\xe5\xd1?\xa8\xbf\xc2]-\xadT\x17\xf8\x96}\x00#g\x8a\x82\x10\f\x15YCGq\xe3\x7f\x829<\xc7\xfb\xef\x1c\x9b\xba\xe5\xd4b\x1a\xb5\xe5\xfc@\vU\x9cʲ\x14^/\xb1,\xd7U\x1a\x821;\r\x1e9\xfau2\x89N\x03c\xb5\xfcB-b\xe1\xb4?#1E@\xf0\xd4\rF\xb4\xecB\x91h\xb1\x8d\x8e\x17\xcf\xf3\xe6,yp\\W\x17\xf8,\"\xca\x11\xdcc\xdf1ܩ\x93\x01\aS\"u)a\xbd\x99S\x12\xa2-\xff\x00\x038\xc5\xc3\xdcIQ\x8a\xdc\xfd\x8a\xfe1\xc7\xff\x00\x1c˸\xb1\x7f\x91BX\x19\xbfM\xfe\xef\xea\x14\vӯ$\x17`\xe7-Gfn8\x96\xc3NɽEk\x81\xfbc\x88\aK\xb6\n\x99\x0eG\x984\n\x9bY\x95P儰ՙFYQB\xf3\f\xd2`Y<=\x7f\xec\xb3J6\xdd/W\x06!\x96:\xe8\x9ab\xde\xf0z\x1eI\xcf\xf1qh\xa8\xa2\xf7\xd4Q\x8a/\xe0\xc62\xead\x988Wj\x8c]%\xd3~!j\x1bZ>bS\xcfI\xa4\xf170c7+\xf9\xba\x82\x9e\x1e\"\xdf\xff\x00)QVR\xeb\xd1ܯ\xc5.\r\xb7\xff\x00!\x1a\x06\xa0\xea\xbae\x1bG\xadB\x8c\xa6\xcc>\xa0]Kި\x00\xdb\xeb\r\xcdpAl\x89e\xa0\xaa\xcf\xd3\xe2\aHgAS\x14e\xf3\x13;\xbe\xa2\xe4a\xa5\x1f\x98\xb88\xe4\x83\x12\xbf\x8a\x80k\xf0\xbc?\x8f\xa4e\xcd\xff\x00\n&7\x15\xc5\xee(\xb1\x83:\x98\x97j\xb7}Sy\xe3\x19\x84\xda\x193\xda\f$5\x1b\\ˈ\xc2ϙpYd>\x02\v\xeeR0V\xec\xf7\x9f!\x80\xc4\xe5\xd3\xe2\xe7\xe24\x94\xe1\x12\x92?\xc2ˀ\xb7\xd1\xccZ\xd7\xd6e\x7f\x9a\x95\xfc\\j\x17\x15\xba v\xf8\xe7\xe6g\x02\x03F\x02V\xa2\xa0s\x9a\x84\xa9p?\x91\x16J/}@\xdc\x0e\x1f0\xa8\xad\xb79\xcds\xb8\xf8\b`tx\xff\x00j\r\x14\xe0\x8a\xb8̠UR\x1cs\xee`\x17ƪ\xaa+\xedƪ\xaa~\xb9\x82\x8a\xb0\xa6\xee\\\x1c@:Ц6\xcf½\xf4\xc5\xc4q\x17\x12\xccŌ,\\\xdc~!@Z\xbd\x01\x11\xda\x10\xab\b6\xb1\x8c\x86\xe3\x02\xef\x9bSEd\x19\xe1\xdcG\xc9\x1eG\xb6\xf5\xafA\xd5\xc5\xcc,\xbdޡ\x99ژ\x9f\xbe_S\xcc\xfbT\x9b\x11L\xc5I\xcb0\xe8\x85\xf5\x14\x8b}D36'\fg\xbe\xe7\x98\x001\xe0#Y\x93\xe7\x7fy\x90G\xd6\xfa?\xb8\xee\xe7\x87?\xab\x86c}\xb5\x1b,\xf0\x86,\x02\xc1\xa0n\x00\xcfК_\xee\x14l\xa9_\xc5D\x8e8\"\xa4\xb8\xcb,lvrʀG}\xb1\xe3T\xe4\xd1\r\xc9Zy1\xce\xf5\xf2\xdea{\xe95,\xb8\xed߈\xb7[k\xb8\xabv\x871[\xb9\xf5-F(Z\x1f\xd4\xc0\x9c\x1b\xbf\xf7\xda\x19(W\x18\xafp\x16\fp\x04VCP\xfb@\x80\xbc]\xff\x00\xc6\xfe\xb0\xea9'\xf5\x169\xb8 \xf6\x95\x89\x99S$\x15_g\xcb\xdd\xe6%Ѣ\x99\x97/\xd1.Ê\x8c\xad\xd8\x7fqr\xf8\xf1Q=}Q\x8d\x01m\x01\xb5\xf0\x10\x84\x03a\xb7\xcf\xfc\xfaİ@\x96\x17\x9aX\n\x1a\xd3\xd21\x1a\x1b\xb4\xa7Te\x87\x05mTB\xfb\x89\x9b\x83Cܿ\xa4s*&\"\x1b\x94&\xbf\x85\x85w\x15\x15Pq\aaXf\xf5\x1b0\x93\xb8=)EO\xd2Ϸ\xb3\x9e\xa0o\xbc\x85\x9b-{_\xe8\xfbG\xef'\xa4\x1f\x0f\xe5\x11\xad\xea\xfc3&\x97̶\x81\x06\xd5w\x92+q\x98+\xaf\xee&qW\x96\xc6S\xe0\x9f\x04s\x91\x9e\xa6r.\x85\xb3\x1a\x98MR\xc8\xf9\xee\x9c\b\x86\v\x1e\x95*\x85Ѿ\x1e\x82PX\xb5\xe3\xf7\x1c\xaf'\x8fQ\x8bqÁ*\x19\xa0\x03T\xf3[\x97\xb0+\x85\xe0\x81J\xd5Ը3\v\xf2s\x05\x87\x9b\xf1\xd7ļ\xca#h\xaf\x99ZL\xe0X\xa3ٖ;\xafR\xcc\x01 \x950k\x83Xw\xc5e7\xab\xbdC\xa0\xd0\x1d\x11\xad\xdc\bl4\x9e\xa0\xcb'\xe0\x82\xe8s_s\xb2\v\v\v\xbf\x11\x151Q\xec\x1d\xb0i~\xb3\x1a5Y\x94\x1e\xdb=Ѽ2\xfdh.H\x97\xac\xbcg^\v\x99\xa2\x1aW\x84\xa4\xad\x9a\xfas\r\xbfd\x94\xe7\xc0\x99\xab뱊\xe5\xd4Y\xf3\x89w\xfc-\xc1\xc4\xd2\"z2\xabA\x01_V\x8ao\xe7_ߩq\x82\xac\x1c\xfa\xfd\xb1\x12\x83\xc0J\x95\xfc0\xfe*\fK\xe6\xadߣ\x98}\x8a\x18;{І\x80o\x91\xac=v\xf9\x84\x12D\xda^~X\x96\xb2\x1dVX\xe4>o_/3\v\x16\x0fՊ\x83*\xa8\xff\x00\x7fpCRX\xba\x95\n\xe9\x88ḵ>\x96\xfd\x1e\xa3\x9a\xdf'\xe2\aX\x03\xbd\x12\xca\xd9\xd8\xed\x89\xe5\xb2\"lٲp\x1d\xb2\xb7#\x8cjP\xc0к\xe33\x1dA\xa9\xda'r\xbf\xd3\xe6\x15\b\xe29}\xcb\xc93\xc2\xf3\x81jͣ\xc9\xca\x1cł\x95\xb6\xabO\x05\xccw\x94MKyܨ&\x8aY\xf2\xd7f\xfd_r\x89\xec\xed\x8bp\xe3\x8f\x0f\x88\x89F<dp\xd8n\x15L\x1a\x95\x16s\xdb\xdec{\xb0D~,-\xafl\xae\xaf\x16E\xc1i\n\xa9\xeao\xb7\xcaJ\x1e\n\xff\x00\xc4+\x18tpmү\b\xc2\xe8\xfe\x9f#.\xe2\xe2\x19-\x94\x19\x97\x95\x8f\x99\xc6u\x15\xba\xa5\x165Gk\xc7灁\x89O\x02\x83\xc9\xfbs\xeapS\x9d\xe1\xfb\xfe\xbdĪ\xab\\\xab\xfc{\x8c\xaf\xe2\xa0*\x00\xdb\xc1\f\xec\xa7(\xb4\x12*\x8d\xbe\x17\x89H\x10\xf1\xba\xbf\a,Xi\xbb\xbb\xdb\xed\xe3\xd1\x10`\xcai\xe3\xfeͲ\xc2\xf1\xca~\"p\f\x1a\xe0\x7f,\xd3\xc0>\x84\xb4B\x8ar\xf9\xf1\xe2Y&\x85qy\"5r\x9b1\x8c|\xb1\x19\r\x98\xfc\x184\xf4\xd5~(%\x15\xe3\xf6\x80׃D\xeekgjm\xbd\x15ў\xbdK\xdb\x16\xc2d\xf1= d\x9bP4\xf7\x06\xe0\xd4r\xe6\x12𬿗\xfb\xdcX\xdcێ!c-\x93=[կ\x00\xf7\x1f\xe2S\x02\xa6\x9d\xb9f\xf8\xa9q\x18df\xc8*\x98\xb6\xa8\xbe\xe1A\"\x11R\x8d%\x1a\xd7Ù\x98\xe1\xb8me\xbb\x10wt9\xbaf\x1df\x9c\fQG\xb5\xf0e\x880\xcal\xd0ƑO\xc0\x0e\x98\x103C\xa0:\b\xe1\x13w/\xa5\xe8\x17\xc5f$\xac\xd7L\xafj\xb7Є4\xe55\x0ei\xbb|}P\x18k\x06\xce\xdbz\xb8W\x017qj\xee/\x9f\xe0X\xfd\xe5\xdf\x11h\xd0<\xbcB\xce\x03\x93\x87\xe0\xeb\xdb=\x04\xce;\x9b\x06m\xec\xed\xf2\xfde\xa2>\x1b\xcb\xed\xe61\xfe+\xb8\xc3q\x85\xd4\xfdh\xf7\x04\x80\xb3{\xf8&\x90\xa4h\xdf\xe8\"\xa8n\xb3\x8c\x0f\xdc\x1e\xdfWp\xab\x91\xb6t\xf7\xdc\x1alz\xe6\xfa'0\xaf\x9f\xee1C\xd5\xf4\xff\x00\xc2\x19\xc0\x1d\xfe\xbfqޭ*\xcasq\x0e\x19v\xd5\xd4e[\xa5[\xa7\xae\xbd\xcb\x1dFG\xfb{\x97\xa3\xa4\xc7\x00\x96\x7f\xd1+Pb\x83\x19\x94\x19*\xa1}k\xe3\xfd\x96?\xb7ae\xbb\xdb\xdc\xd3x\\\xde\x10L\xfc\xf1\r\xa5c\xf0\xc4X\x98\xfe\x01V\xaf\x9e\x9e\xe2\x84\nF\x92a\xcc@GO\t\xea\xd8_\x06a)\x11\xba\x00\x88\xfb\xaf\xcc\x11BاOL['\x8fE\x06ӕ\x1a\xd8q3J'F\x01\xc9\xeb\x1a\xf1\x02o\x00\x955\x93\x01@\xf04c;Ȳ\x8eK\x94\x0ee\xcc\xdbk\xc5\x16\xb3\x98Ь@\xf1!\xa2\"\x0e.\xc1u\xa4\xe2]\xdae\xb4\xb7خX\x19Ոl\x14J\x01*h\xf8\x9bE\xea\xe9-^\xa3@\x1e\x88Ϲ*\xdb;\xf3\x85?fZu}\xd6\xcey\x95\x98Y\xc4Ln\x19\x16\x89c\x86v\xf4\xf2\xfc\f\xe8T\x86\x87:6\xbb\xca\xd9\xcfGCm\xe5\xe5\xf6\xf2\xea;\xb11o\xfbP39\xb8\xca\xeb\xff\x00\x82\xac\xb5\x9a\xe5\xf5\xfb\x94\xe4\x06h\xc0\x84ҵ\x8f\xc1\x17\x1b\x9e;}\xb0v\x9e\xb0x\x0ea\x1b\x11|\x1b\xf9ḙ\x8ctM\xa3(]\xff\x00\x98!\xb4͝\x87\xf5\x1f;Vk\x83\xdb\xcco\n\x87\xac~!kG>\b-Jm\xa5g'\xfc\x940T\xd75\xc4!A\x0e\xff\x00u\x8d\xa2\x05\xb5ߡħ\x05\xbf\xc3\xf3\x05fV\xdc\x00D\xfby\xf5\x10U\xb20\xda\xd7\xfb\xcc?K\xd0\x19\xcf\xc6\xe2\xa4LU\xb5\xddp\x10*\xe86\xaf\xb4m\x97\x1c\xff\x00\x01\x98G\xe0\x1c~\x0f\xe3\xe9\t\x880=\v\x94\x88\xf0\x99\xe6\xdbn\xc4\r\"\xe5J\"\xed\xbdNkT`\x86\xc6\x01\xc3\x00\x19\x16\xad\xc1Awv\xb6^\xc2\xf1\x17\xc0\x82\xa9GV\xfe>\xf1\a\xb1$\xb4ve\x05\xd7\x16\xe2)\x90\x17l'\x1f\x1f\xa83\f)$\x14\x19,\xb3\xe6\x1bg\x04iʌ\xb9\xc0\xaa\x0et\x03\xa8\xd9\x00i;L\x0e\xea\x1a\x87=`t\x93@\x9doEu(j\xff\x00E\xc1\xfd\xba\f\xb8\x99$\xbf\x9ea\xb1T\x00\x94\f\xed\xe3\xder\x12\x06Q\x97FUk\x98\x988m\xa8n\xad8\vC\xdb\x17{\x95\x1f\x9b^\xd9\xd5\x04\xb65\xad\u038b\xaer\x1f\\\xd1\fE\xb8߽׃\x1e\xea\xe1\x14\xcc\xdcHW1\xf9\x8c\b\xa1V\x80#Yoa\xfd\xb1\x10Q\xa2\xb4\x84\xbc\xb1(σԫ\xc0-\xca\xf5\n1o7\xf9\x7f\x12\x8cW5\x86\xbf\xa2\x18\x06\x83\x83\xfd\xb2\xecV\x83\xe5\xf5\x02\x98\fg\xc1\xfbbQ\x86߫\xfa\x81\xbb\xa1\xf1\x83\xf74\x00\xb4\x1b\x84\x04\x01\x8cq\x1a\xabT\x83kN߉{n\x86\x1aQ\xc7\xe6=R\xd5<\x8b\xf3\xee\x05m٦\xbd\xa1\x19\x97\xed\x16\xdcq\xc9+&\x19\v\xb7\x0f\x9a\xfa\\x\xd0\x0e\x1b\x95\x9a\xa3uB\x8c\x81\x19[n\xcd\xc58k\xd4t~\xe6>\xf3,\x9b\x98\x11! ,J\x8cU\xe1\xdf~be\x9f\xb5\x00\xde<B\x8d\x98\xca\x0fm\x87\xca\xf8\x96$\xd6OH\xd1ei\xbc\x14\xcd]U\x04\x05\xe0\f\x8d\x8bR\x1a1r\x94\b\xe4\xa0\x1bN\x05\x99\xf2\x05\xb1\x19\x1d\xb3#\xe0\x0eOfQ\x024u\xfa\xa1\xfb\xc7\xfd\xc8/\xc0aA\xb1\xe4\x1b\xc9jB25c[o\xaf\x95\xb9\x8e\xc6\xf3XzA\xf6\x8e\x12\xa2\xc5š\xea\xda\xc1E\xb1\xaaI\xf2\f\x04n\xa8?b<\xfa\xb4\xd3\xdaʤ\xe0*\f,Wf\xac\x19\xb1\b\xae~\xaa\xa5\xb5\\\xf9\x9bx1Dq\x83\xa9\xb9\xbfS\x97X|\xa2T%p\x06\n\x01\xa0\x18\x0f\x04\xa7\xa8\xf8\x8e%[\x9e\xa3\xe5\b.\x1bg3\xc0\xf3.u\x1c\xbd\x0f_\xb4QW\xe0\x82U\xac\xba._r\x9e@\x86\x8c\x8fYO\a\a\x99^\n\xf4\xd1\xfbbf\xb3u\xed\xf3*Ы\f\xe3G\xee1\x9d/a\xb4\xfc\x10AU\xc1Z?ql[\xe3\xb8)\x84}\xe8\x82Ԩc9}\xf4J),\xe5_\xe5\x84j\x00\x96\xe66Ʃ`s\f\xba\x88\x8bJ໙\xa1v({\xba\xcc\xc7\xeb\x9b#\xea\x04\xac\xf6\xd7=\xfc\x1e\x88\x1du\x02\xceb\x1c*\xbd\x1e\x17\x8d\xd7\xd6f\x82\x1bZߟ\xbca0\xa3\x9e\xa0\xafF\xb3,\x1b\xe6\x12\x13\x88\x92\xc0mw\r\xa4n1@`\xea7\xdcr\x84(\xb0\xb4<R\xfeO1\x8f*+w\xd2\x1fvq\x00M\xae\x174\xa1<a\xf6Qk>L\x04\x97Aȼ\xeaT\r9\x95\x19h\xbc7~\x87\xe6V \xa2r\xc4\x0f\x7f\xbfP1\xa1\xc4P\x94\x97\xc8\x0f\x8e\xe8\xd9v\x88\xb3\x97`/\x95\xe6<r.\xd3<,zo\xa1-\xb5\xad\x166^\\\xa6\xb4[\xce\v\x8e\xc3\x15\x145wX\xf3\x1c\xa2\xf0\xebR\xb2^&\v\xa9X\x8c\xf5\x89\xa9\x03\x99B\x17\xe6gЅD+F\xdf*\"\x86\x91\xcb\a\xa7p\b\x81e\x87Ê\x7f\x12\x86\x14\xbc\xd0\xe5\xed\xe2\x15V\xd5-\xff\x00Gl\xcb\x0f*_\xba\xfcB\xa9r5\xfa\x84r\xa7o`\xe7\xe5\x86Z\x1c8<\x0e\xe5\x91A\xe1r\xfb\xeb\xd4i\xcb_\x03\xd1\b\xe5\x9e9}\xb0\xa8i_\bX\xe0\x9a\x7f\x8d\x11O\xaabs\xe8\xfc\xb3y\x8cU\x17\xc0@u6/jS\xde\xd1\xe3\x13\n[\xc3\x04Eh\xb5\xb0\x80\x80n\xe5\x04\xb0\x8d\xc6'\x86\x94\xcd\x16\x04\xaf\x15\x7f\xe7\xe6 \x14,\xda@\v7N\x03\x99\xc2\xd5\xf0q\x1aoZ\x99C$N&8\xc2\xc8~ \xc0\bW\xa9\x06Ăz+\xc0\xecǛ\xbf#\xc9q,<V]%\x1b\xb4ձ3\xb2\x11\f\xc5n\x9d\xe9\x9a/\x86\\\x87\x06Kt\x1f\x9d\x1c\xd4gA\x89XZ=\xb9U\xe5\xf0\x11\x06\x97-\xd7H\xab퇭\xf41\"\x85\x8c\x8d\xa6\xbd\x95\xf5\xc9\x14g\xa4\x91\xdaK\x8a\xeb\xc1P0\xbf\x16(\xd2\xc4^^N\x80!\xfe\x8bu\xc5aï\xcc\x16D\xcaU%\xd5\xd2\xc0\xbb\xd6ܶ\xe6i\x02Uje\x13\x12\xbb\x8eJ\x8e\xe2\xa3j2+\x06\xa6\xf2\x7fa\xf8\x80OT*\xbc\te\n\xb5C\x9f+\x12\xe6\a\x86&\xd7j\xb9c\xe0&\n\xaa\\\x1c\xbe\xe0\x04@\xd0x<\ap\x0f\x9dD\x80ڦ\x12\xdcw\xfa\x8e\xc2'\xa3\x97\xb7\x88D\x90\xa7*\xc7\xc3\xf3\x00\x0e\x03\xa3\xbf+\b\x0e\x15\xd3\x1e\x8e\xe5\xc3:t6\xbec\xb7H\xa6\x0e\x8f]\xc1d\xac\xb4\xac\x83\xb8\x94P\xbap5\xf5m\xf3\x01\x17T0\x1a\x0f\x11\x9e\xcbU\xe5\x8e\x1d*\xba\xda\xfee\xe8\xb7\xe4\xdc\x06\x96\x1b\xe6%\xaa\xe2q\xac\xb0H\x12\x0eaq\xc7S\x02\x98~u\x11܉~\xb1;\x0f\\aʮ؆\xd4\xf8E\fè\f]<>e\xd6\x1dé\xa5p\xce\xe6\aP\"t\xec<T\xe744=b\"x\x1bl\x90V\xcbeUޣMW\x7f㵷\xe5\x94\xf4\xda\a\xe8i\xfa Ս9o.\xd3\xe8\x83\xd9{O\xbc^=\xa1\xe2\f\x95\xc3v\xc3\x06\x969\xc3y\x10%\xcejt\x15\x9bY^\xe8\xb5\xc1-\xc2\x1a#\x91\xc3p\xe8U\xcd\x1a\x80\n\n\"\\`#\xe5\a\xf0\ve.sX\x82\xc0= [\xd1\xc1r\x99P\x1dp{\xed\x86%EŶ\xf8\x94\x00\x87M\xa7\x99n\x01\x83``\xf6`\x90\xb6q\xd1\xe9\xcb\xe66\xa0\xf2~XQU8?\xe1\xc4\xd3y\xa5b\xaa\x97\xaa\xe2\x01\xab\xb5\xd3/]K\x16\x8d\xbah\xf6\xf32ܧ\x87>\x13\x00\x7fe\xf2\xc1H\x00\vz\x91ī+\x7fꀁ\x86\x8f\x11\x84\x10\x9d\xe9X\xf6\xd0|\xca\xcf`\x9d\xabO\xa8 r\xc9\xe0\xe6$n\xd2Y\xe6\x89h/+\xdd1\xb0}G\xaf\x0e*Zc_\xc0\tm\xc8ɔ\xc1|\x03\t\xf5\xfb\xcfk\x15Z\x93J\xbe\aQ\xec\xeb`h\x99\x1e\xa0稳\x8dø\xd0T\xa4\x81\x9c>\xe5\xfd\bU\xde#\xd6\xed\xe4\x15\x1d\xb4\x8e\x1a\xd9*\x0e\x93{$!A\xcd\x18\xbc\xa9\x9bn\xfa=\x87\x02\xba#\xd5m\xb1\xfdV;\x94\x14\x025\x96\x1f\xf5\x11\x88:,\x91\xc1\xa79S̀Gw\xd7E\xb3\xf55\xdc_F:\"p%\xd7\x17\x97n\xd5e7@\xac\xe5\xfaP\xa9X\xc4\xdc\x19\x9bG\x98\xfa\x8b\x89Y\r#\xdf\x04\xc1\xfbLPz\xed\xf3-\f\x17A\x95祖\xccY\xfe7\x05Q\xcb\xfcQ*\x82\xb6>\xc8K\x02\xb8\xa3\x8f\x9e\xfdM\xc4C\x80k\x1f\x82Z\x00\ac\a\xa2q\x0e\x19,\xf6_\x13<\x9c\x0e\x87\xc1\xcb\x1b\x16\x176\xc1Ÿ[\xc9\xf2¥F2\xb1\xeb\xee\x11u\x11n_#G^c\xa1\xe6\x1d.\xde\xd9jUmf\x11%|[b\n\x1a\xc4\xfc\x17he\x1a\rN*ן\xac\x176\x94t\x9c\x8f\xfb\xfe\x0e\x9f`\xd5\xd2X\x02\x10.\xf9e\xa2\x94\ue860J\xd9up\x16rC\x11\x19`\xec\x84\xf1\xa4(\x01\xb6WYa\xfa3\x15\x06i3\xb8\xa6\xb1\b1\xb2(\xe3_\xc5a\x00\x1b\x96ac8\xf1 \xe1\x94\x19\xb9E\xf6\x8f\x93\xb6\xa5~X\x9e\xadb\x80M\xa6\x85\xf2\xb5\x01d`\xeac\x81\x9fMub\xe1Ab\xd03\xcaڔѕ\xd0q-\xba\x15\xc7\f\x0e>v9-\"}\xdb\xf6\xc2%y\xb3#\xbd\x8f\xbc\x1eba\xcf\xc0\xd5\x17\x8fwu\xf7ʛfCw\xe0\xd9Q\x89\xf5=+\xcc\x1735j\x1e6\xf8\x8c\x91(\x02V\xa5=\x0f\x87\xee[\x9f\xa5\xbe\x0f?\xf2$\xa3\xcb.+Ԣ\x85/\x9d\x91.\x16\xaa\x85ar\xe8\x8e\x14\xb3\xd11\xe0mU\xc9\xed\x80\x01\x87A\xbf\x8e\b\x8dP\x13\xb3\v8\x1aa\xf1\xe0\x89\x9ar\x16\x9c|\xb0\x1d\x83\xa5\x18=\x1c\xc5m\xbb\x7f\xf08\x824a0\xd8<\xc1\xaa\xd0\x7f\x95\xb0\x15\xb8\xa7\x05\x14\x9e\x04k\xd3\x00\b\xabN.1\"\x83CG\xfd\x94\xd4\xf0\xdc\xfdk\xfa\x81l\x03+f\xf5+F\xc4^\x01\xb9R0Nv\xb0\b\x04殢\xb1es\bcw-g}F\x10Ц\xa6<|\xd2W\xfd\xf5\x8d \xc5K\xcb\xe6=Q\xdbR\xaa\xb2\xfbL\xaf\x8b\x87]\xc1\xa6% _n\x83\xdb\x01i\xc5U\x81\xf5\xfb\xfaL\x01\xc7f\xab\x8d\xfd&\x87\x11[\x8c\xbcLf\x02V\x05ر\xa3A\x9c^\xa5\x16`\x97\"9\x1c\xee˂\xf0\x10\x19\xf8\xdd\xed9)\xe3+\xa0n\x0e1\xeb\xe19\xcbڵ\xca\xc3JA\xedN\x80\x87&\xe9o\xb5\xfdM\x7fbD\xd4ـ\r\xb9\xc0;\x8b'*\xec\x05ՊS\xeb\xfb\x82^R\x01r\x00\xff\x00\x85\xfd6\xa9\x98h\xbe\x0fP\xc120\x03\bm\xb2}\xc6r\x91\x99oB5F\xcf\a?,p\xc0\xbb7H͜\x8d+A\xe0\x84.\xa4\xa56\xcb\x17R\xf1x=\xf9\xf16\x87\x03\xf6\x17\xd2!:\a\x8c|v\xf9\x80\x1c\x17~O\x95\xe0\x88\x02\xd0l\x14?p\xc0%\xbc\xf2\xf8\x83d\xbcr\xc7\xcb\xf8\x85@\xb3\xd5虙k(\xb5\xf5\x17\xbbK\x06\x9b_+\x0f\xa7\x98\x1f\x82\x16-M\x1a\x8b(xV\xa2\x9f%K\xcd@U\xbf\x1a\x95\xd3\xd2\xe7\x8f̻\x9dv\x90+\xaeh\a\xa0\xdd\x11h\xe9\xa7\xd3\n2+\x96%\xec\xcb*Ի\xb6]\x90\xc3P#\xb1\x15\xb0\v\xfeu\x12\xb86\xd45\x8c$\xa62\xa9q댑\x02\xac\xf2PEm\x03Xn\acƭW\xb7\x8f\xee\\\x1f\xa2\xfa\x7f\xae,ջ\xc83P\xb7\a\t\x17a\tg\x81j\xf4\x06U\xc6⯨\x81\xa9\xc8e\x1eX\xb3\x03\x86X\xee\x0e\xdd\xed\xed\xfbމ\x80\x8d\xa2\xfe\x83\xe5\xc3\x11e\xa5J\xd5m^U\xe6#V\x98\xf0\xff\x00\xa7\a\x98\x94#\x88G\xa1\xa1\xf0kN\xe2\xd16\xc7c\xcb\xf9\x86C\xac\x92V\n}\x90\x96\x00\"\xb2\x81\xf3\x82\xfc{\x96\x03\xd1\x03Gk2(Ә\x83XF\xc4\xc2K\x18\xccq\xcf%?\xf3!\xd3\xd3F\x87\x97\x94;\xd6\xed\xb7ĸ\xa8M\x86_lG\x1e\xc1y\xe6d\x83\x91\x13\x11\xc4ǧ\x7f2̄\xbb\xeb\xd0\xfc\xc5b\xa0\xc1\xab{b\x01\x80Z\xedkt\xfc\xcb!\x8a\xc0\xdb\xed\x8a\xc5\xf0\x7f\xb7\xcc:\xc0\xb7}\xfatJ\xd6;\xc1\xb8p\x90\x1a\x1a=\xf6̦\\\x1d?\x11\xeb\x1fO\x10\xd8(6\xf9\xf5,=\x0fsJ\xe5\xc4]B\xa1\x80`\xc2&\xac\x9a\xbfԽ\a&\xe6\xc1\xbdO0\xc1g\x85q\x8a \x83\x95\x04\x96\xb3W,\xe2\xbc\xce\xc3\xed)\xc6*]\x10Y\x89\x94\x02\xf6\x17\xd0\xc2\xe3\xc8\x18\xe4{\xc3+1\xa2\x17%R\xfa\b\xd1y\b\xf7\x00[\xd5\xf2@, pw.\xe1e멃j\xa6\x98\x82\x9f\x9e#\x1b\xe4\x00X\xc0m\xf1w\x1d:!T\x92\xc7!\x93\x9b|F\xc2e\x16W!\xb5\xf2\xb6\x1c9r\x96\x16ׂ\x1cXU\x8dM7l\x17|:wQ\xa6\x94i\xc1\xd0\xe2\r\xc8\xec8K?Z\x81\x94\v\x81g\t\xd2J\xca \x1b\x87j\xd5\xd5\x1a|\xc5\xdfF\xb2\x98`\xcf^\xee\xef\xa8\xc5\v:\xb0\x95{\xc1\\9\xdcp\xd6.V\xf6\xaf\xe6$\x89,\xec~\x0e_,\x1bB\xf7}\xce`N\xaf*V|\xaceZ\x97l\x9e\x80\x88\x91\a\x8c\xed\xf1\x015 V٣\xb9}\x8f\x81\x06\xfc\x04Złڟ\xed\x04\bm\x87Ǩ̠\x1d\xd9a\xedϨ](\x1c\x97ߢa\x00ށ\xc1\xda\xf0@\x81\x02⑻\x17j\xad\xa1\xf1;F\x15\xd7\xc9\xc1\xe6*c\x84+\xc2~\xd8 \xa1\xf4c]֍\r\x1f\xb8\xa5\xe5\xd5\x7f\xbf\xde%\xd4\xc9f\x05ڶ\x9c\xa8L\x14u\x10\x92\b\x91M\xdcUD&\x11<\xe6\xe5$\xb2\xd4N\xa0)so\xbb\n\x8a\xee\xa6\x04B\xb4\xf7\x16\xceL\"\x9bF4\x8e\xf14*,\xe6%\x0eb\xa0\xbdK7\x8d|n)\xaa\"\x86\x94\x9bĨ\x14Z\x94m\xfaM\x85\\\xd5\xe9\xdc\v\x06n\x96\xf1\x06\xee\x8c\xc3 [Wπr\xc3-ء\x7fY\xeb\xc1\xf3q\x00\xbbݜGl\x14\x05\x9cf\xa6\x9e\xae\x8f\xafR\x84\x05\x81Vm\x86`\x80<S\xb7\xf4yq2 \xc3\x10\x01g\x12a\xc7\x18\xee \xa2\xe2\xb8\xf2\xe1\xf7f:A\x9b\x12\xf2۵\xb1A\xc2\xe2ߧ\xbf\xea%\xa0\xc2\xe9Jp1h\x9b`\xcfȃ\xe6\xdc\x11Y! \v\x19\xc1\xcc\x03k\xf3\xfa<\xc3n\x06WW\xf7^f\x04\xa18N}Bd\xe1\xb4\xd0y_\x88ܼ&\x1d>\x83\x82\x15\x81\xe8\xe0\x0e\xd6\r-9\x1e\x8f\x04\xadV\x05└\x86흉\xf3\xa1S\x03\xa3\xb6$B\xec\xe4JZ\x91\xab\xed\xf5(K\x7f\xef̦\xc5\xe3/\x99p҃\xdb\x1bfӇ\xcf\xfc\x84,\xa1\xae\xe1*\xe0\xfb%\xc5P\xf2\xa3RT1\xb7\xf2\xc5\xe9\n \x8d\xe1\x85\f\xaa\xedi\x9cJ\x87p\x97\xb8)o\xcc\b\x04B\x92\x1a\xaa\x98-\xe1_Q\xa8\xfb\xa2F\x19\v\x80\x93\xa4#\x98Ǥ:\x15d\x03\x97\x18>\xcc\xe2\x89\xd8ݴ?\x98,}R\xb5Iu\xf1r\xa6*a\fF\xd6\xc3a\xaf\xee\bQ\x18:\x0e\x83\x82\v\xcea\xbe\xed\xba\xeaY\xb3\x13l\x05\xb5\xe5\xd7\xcc\xc2\x03\x13`o\x91E\xdf\xe6k*\xc1\xb26\xb6\xf8\x95\xaf\x831\xd0\a\x92\xbf`\xd7\xceYtP\xdd\xf2\x9bf2\x17e\xbe\x8f\xd4V\f)i\xfc\a\xde\x10I\x98c\xaf\xdc\xc9ۛ\xfe\x91,MlU\x81\"d\x18d\x8a\x06\xb7#>\xcf\x12\x84\xb7\x9fW\xf6\xc1\x94a\xc3/\xae\x88\x10w\xab~E\x84\x16\xb8-\x87\xf0\b\x04@\xe8\xc9\xe4\xb2\xc8\xd1\xf1\a\xa2%\x90\xd2\xdd\x1b\xf6\x94\x1c\xc3N\xa2\xf9\xdf\x05\xe4\x8c\xe5\xe3\xb7\xd4\xeb\xd4ͷ]\x99\x8d\xaa\x17\x03\xfd\xfe\xa5\x02\xe3\xf4@Q\xbf\x16!\x12\xc0\xb2\xe9(c\xa4\xb5A\xb4BҪ\xbeYRR\xba\x8dл7{\x95\xbe5\xe5\x88e\xb3\x06\x12\x15\tcF~\xb1\x15:|J\x9d\xdcE\x8c.\x1b\xa4\x97 \x9c\fAI\xa9x\xae,\"\xe1\x947\x15\x930\xc4\xf4\fA\xa2\x16\x8d\xff\x00\xbfR\xa3^\xb9\x0f8\x86\xc1%\x92\xa3\xf2\x1cD\xc5J\xf0\x14&\xd2\x15\x7fM@\n\xea\v|@\xa8V\x94\xf0\xf4\xc7\xf2\xfeT\x0f1y\"\xdbh\x1c<\xe8\xf9\x88s\xaa\x80\xbbM\x1c\xe7.\x0e\xe3\xba\xc6o\a\x9d\xbf\xa9p\x97)VՃ\xddq\x7f\xd8esԲ¾\x0f\x18\xe5\x83\x06\xec\xdf~D@W/|\x03\xb7\xf5\x12\x8e\xea-\xc6Z\xefg\xda\x13 7\xf4\x9f\xf2aR0\xd7\xe8\x0e\"\x9bܘ\x1e<\xb3$\x90xW\ue01a\xe0\x91\xf4\x8e\x0f2\x82\x80\x1b\xcb\a\xcb\xcaY\"\xabj\xfb3[\a\x1d\xe1Mu\xc2\x1a\x84$\\K\xa3\xc1+\x16\x1e\r\xfb<\x12\xb1\br\x8fI`}' ΐ\x00h\xf5\xe7\xcf\xf7\x04m\xb5(\x7f\xbf\xbf\xee5-w\xe0\x1e\xbfr\x8amoye\x169\xc2e\xaa\xb7M\xdcA(\x81\xd7d\xa0P!\xae\xa2;\x9a\xe2\x05`<\x04U\xae\xfa.[d\xcdq2\xab\n\x97\xb8Ϻ\x96\n\xea$0FN\xecHŀ\x87\a\xf00jq\x139\x81\xb4\xe2<kF\xe1\xf7\x8a\xd6H\x98)H:\xc0{\x97hݩbJ*U\xa2\xc7\xe4\x96Ce\xb8pĴ\b\x9b\x868\xfe\x12ȕ.\xba{\xe6]G\x13\x11\xae\xb17\x84\xc5+\xf3\x10\x10\xfe\xee\xbe\x11\xe2\xf8\xf30\xbb\x8c\xb0\xbd>^bV\xb4\x05\\AX8\xee5\x80\xeb\xcc-\x01-\x99\x7f\xba\x8d\xa9i\x00-\xad\xe3+\x7f\u0530*\x05R\xbf\x01)p\x03c\x9ev|Eu\xab\xb1k\xed\x84,SV}.\xd9L\x9b\xe5G\xea\xf4x\x85\x11\xaf쌲)\xe4#a\xa2n-\x15\xb0\xc0m\xbc`\xcb\x1d\xc6\x18\xdf\xf8\xb6W\x946\xeb\xfb̪.\xe93\x00\x02\xd7\xe4\x7f\xddG\x15\x15\xcd#\xbb\xfd\xf8\xfa\xf5\x00\xf3=\xd7\xef\xfa\x89e2\xe6\xdc{X\xa2 \xec\x15\x87\xfeFȁ\xfa\xfa\x81\xae\x87\x1d\xa6?\xa45(U\x06A\x8e\x88-\xfe\xa6C+߈\xa4i\x8b\xb56\xb7\x13\x9ah#\xb8\xf3\xcchu\xf6\x83\x13\x98\xd172\x18)\x8a\xea3\x89{\x971\xaa\xd1){|bQYw\xdb\x10\xf3E\x92\xba4\x1e%\x18'f\xe0@\xb7\xd4/\xa9W<\xae\x1bb\x809\xf17Z,L\xcd@Z\xb1#d\a\x1e\xe6&rE{\x9c\xfa\x82\xb4\x16\xb8}\x1f\x98\xf5\x8cQ\xc3_\x8f\x1a\x89zA\u009fL\xe0\x80\x14/O\x97\xdaq\xa3*5\xee\x7f\x13F\xab\x86\xb4\xf8&X\x90\xaeW\x06>\xff\x00H\t\xf3\x8e9\xcb\xef\xc1(\xc6\xd5\xe0<!\x85\bk\xbfDrW\t\xfd\xbe#\xa8tR\x94y`%\x8a\x1d\x7f\xa5\x8e\xb0\xb2\xb3\xb7\xe8\x8e\b\x1d\xd2\xfcKBk\x03\x928g]\x18\xafpш\xdd\xe0\xf9\xe8\xfb\xb0K,ec\x1f\xaf\x12\x98\x84\xdf/\x89a\xa8\ne\xaef1.\xb0\xb8\x81\x1b\xe0r\xc4@\x15\x80D\v\x82\xbc\x107\xc3E\xfe\xa2\x06\xaf,\xab\xaa\xe5%\xf4j\xfb\x88\x01\x1c\xc3e\xc7NF\xa3t\x9daY\xfaEޮ\xac\xdb\uf3fcF\xbd\xb8\x90\xcc\xe5Q\xd71Y\x82a\x12\xe1p\x04\x94\x06\xa3[\xaaxc\xe1P`o7U\xf1.\xecd\aQ\xad\xa9\x81\xb4\x83\xa3\xef\a\x12\xf2DJ\xb1\x06\x9c\xb4\xc8\xe7\xf5\f\"\x87'ܕau\a\a\xa4\xbd\xde\xda\x13\xee=\x13\x14Ɯ\f{\xf2\xc5-\x17[\x1fqJ&s\xfb\x1d\xb1\"چ\xac\xfe\x89\x99\x00VC\x94\xf2\xf1\x02\xa4\x8e\x86&)l\xa7\x82\xf8\x1c\xb2\xf7\x8bU\x7f\xa4\xf1\x10\x95Kld\xf4j0*.\x97\xfbf2\xfb+\x89\xd0\xc0\xbd\x87\xa3\xb8£8\xbf{\xc1\nR\xf7\xa5\xf3\x10\x8aycT\u0090\x9a<s\x98\x9c\x04h\xe0\x7f\x7f\xecG\x17^\x8dEҡ\xd0\xe7\xdb\xfb\x99\xdd]\x1a\xff\x00\xa8jռ\xee$\f\xb6+\xa8\xa8\xe59v\x82*\x10\xe3\x89`\xed\xac\xb1\xc6\xdb,4\xb1\x16T4\xb9z\xf1\xf8x\x80\xdb?$\xcdVμJ\xc1\xbc\xbc\xccI\xb3\xb88\xcc\x1a7\x03\x19\x9b\x81<\xf2?\x0e%\xaat2\xbc\xbf\x10b\xac\xecƥC\xb81\a\fy\x89\x99\xd2XM\xe7\xa6>\xbf/\xee&\xad\v\xbf\xf7\xa9f\x9c\xb0\x93z!\x16\xc0\x1c\xc5vFNxk\xe6R\x00WJ\x1f\x87\x99mʱ)\xf8Y@vb\xa2\xfc\xf7\x11\x17\x85\x7f]AE`˘\x86\xc0-y\xbdL\xeesWya\xeb\x1ch\x18\xe6wz\xadg\xe2%\x05\x84\xae\x04R\x9aX\xe3=ũ\b\xb1\x18\xf4#\x81K=\xe7Q\b$\xac\x1fe\xb0P\x0e\x1cW\xee;\x045\x00sE\xc5\"\x8a/&\aA, \xb3\xbb\x04\x03f\xb8\b[Sf2\x90L\f\xad\x89\xd1\xe6\x00\xb6YE莯0R\x967\x97\x9d\xe2RL^}\xcb-.\xb5h\xebO\xc1\x1d\x83\xe4\xea<\xc8\x16c\xde\xde\xdd\x12\x94\x16\x81c\xa8\xc8p\xad<%\xe8i\x0f/\x98\xea@\xe1\xd4\x18\xb1\xd9e\xf9J\xa8fW\xcc1@\xbf\x10\x1e\v\xf9\x97\xf4̹\xbd\xc5yO\xff\xd9"),
+	"static/img/favicon.ico":             []byte("\x00\x00\x01\x00\x02\x00  \x00\x00\x01\x00 \x00\xa8\x10\x00\x00&\x00\x00\x00\x10\x10\x00\x00\x01\x00\b\x00h\x05\x00\x00\xce\x10\x00\x00(\x00\x00\x00 \x00\x00\x00@\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xda\xc1e\xffư\\\xffư\\\xff\xdf\xc6h\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xe6\xe1\xcd\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xff\xe2ڼ\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdcs\xff\xe6\xcck\xff\xf1\xf0\xea\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xff\xe9\xe5\xd7\xff\xe4\xcaj\xff\xf8\xdcs\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfb\xdeu\xff\xa9\x9a`\xff\x94\x93|\xff\x94\x9f\xb7\xff\x9a\xa6\xc1\xff\x9b\xa7\xc2\xff\x93\x9c\xb0\xff\x96\x93z\xff\xb0\x9f_\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdcy\xffv\x8d\xc0\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xff|\x8f\xb7\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe2{\xff\xfe\xee\xb1\xff\xff\xf7\xd9\xff\xff\xf9\xe3\xff\xff\xf5\xcf\xff\xa0\xa9\xb5\xfft\x8c\xc3\xffSb\x85\xff39I\xff5<L\xffYj\x90\xfft\x8c\xc3\xff\xb0\xb4\xb2\xff\xff\xf2\xc3\xff\xff\xf3\xc9\xff\xfe\xee\xaf\xff\xfe\xe3\x7f\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe4\x84\xff\xff\xfa\xe8\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfe\xff\xff\xff\xccʽ\xff\x1f #\xff\x1f #\xff\x1f #\xff'(*\xff\xdd\xde\xd7\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf4\xff\xfe\xe8\x98\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xf8\xde\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xa0\x97v\xffLG4\xffQK5\xff\xb3\xad\x9a\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf7\xff\xfe\xe4\x85\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xf3TN8\xff\xf8\xdct\xff\xfe\xe8\x95\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf6\xf6\xf6\xff_ac\xff(*.\xff{}\x7f\xff\xfe\xfb\xef\xff\xfe\xe1w\xff\xfe\xe7\x91\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xd4\xd4\xd5\xff@AD\xff126\xff\xb3\xb4\xb5\xff\xff\xf1\xc1\xff\xfe\xe1v\xff\xf8\xdct\xffTN8\xffTN8\xf3\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xdaTN8\xff\xeb\xd0o\xff\xfe\xee\xb3\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x97\x98\x9a\xff\x11\x13\x17\xff\x11\x13\x17\xff\x11\x13\x17\xff\xc1\xc2\xc3\xff\xfe\xe5\x86\xff\xfe\xee\xb1\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xffPQT\xff\x11\x13\x17\xff\x11\x13\x17\xff\x1f!%\xff\xfc\xf5\xdd\xff\xfe\xe1v\xff\xea\xd0o\xffTN8\xffSN8\xd9\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00UO7\xafTN8\xffʵc\xff\xfe\xef\xb4\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x96\x96\x98\xff\x11\x13\x17\xff\x11\x13\x17\xff\x11\x13\x17\xff\xc0\xc0\xc1\xff\xfe\xe5\x87\xff\xfe\xee\xb1\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xffOPS\xff\x11\x13\x17\xff\x11\x13\x17\xff\x1e $\xff\xfb\xf4\xdc\xff\xfe\xe1v\xffɳb\xffTN8\xffTN8\xb1\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN9UWR9\xf4\x90\x81N\xff\xe2\xc9l\xff\xfe\xe8\x97\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf5\xf5\xf5\xff[\\_\xff%&*\xffvwy\xff\xfe\xfb\xf1\xff\xfe\xe1w\xff\xfe\xe7\x93\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xd1\xd2\xd2\xff;=@\xff,.1\xff\xb0\xb0\xb2\xff\xff\xf2\xc2\xff\xfe\xe1v\xff\xd8\xc0h\xffxmE\xffTN8\xf8TO9g\x00\x00\x00\x00TL9CWP9\xfeһf\xff\xed\xd2p\xff\xfc\xdfv\xff\xfd\xe0v\xff\xff\xf8\xe0\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfe\xee\xb3\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xf7\xd9\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf8\xff\xfe\xe5\x86\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf7\xdbs\xff\xa2\x91T\xffTN8\xffUO8WUN8\xbb\x82vI\xff\xf3\xd8s\xff\x9d\x8dS\xff\x9d\x8eR\xff\xf6\xdas\xff\xfd\xe3\x84\xff\xff\xfa\xea\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf4\xcb\xff\xfe\xe1w\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe3\x81\xff\xff\xf8\xe0\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf6\xff\xfe\xe9\x9a\xff\xfe\xe0v\xff־g\xff\x98\x89Q\xff\xb1\x9fY\xff\xf5\xd9s\xffTN8\xffUN8\xd0TO8\xe0kb@\xff\xfa\xdet\xff\xf9\xddu\xff\xfe\xe1v\xffɳb\xff\x92\x83N\xff\xf5\xdby\xff\xfe\xef\xb4\xff\xff\xf7\xdd\xff\xff\xf9\xe5\xff\xff\xf5\xd2\xff\xfe\xea\xa0\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1w\xff\xfe\xeb\xa3\xff\xff\xf3\xc7\xff\xff\xf4\xcc\xff\xfe\xee\xb3\xff\xfe\xe3\x80\xff\xf5\xd9s\xff\xa1\x91T\xff\xe9\xcfn\xff\xf7\xdbs\xff\xed\xd2p\xff\xdb\xc3i\xffTN8\xffTN8\xf5UN7\xc1TN8\xff~rG\xff\xb2\xa0[\xff\x8c~M\xffTN8\xffTN8\xffh_?\xff\xc0\xac_\xff\xf9\xdct\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdct\xff\xc0\xac_\xffg_?\xffTN8\xff]U;\xff\xa3\x92U\xff\xaa\x99W\xffe]>\xffTN8\xffSM8\xd6TL9CTN8\xfcTN8\xffTN8\xffTN8\xffTN8\xffTO8\xe3TN8\xffTN8\xff_W;\xff\x92\x84O\xff\xb4\xa1[\xffԽg\xff\xe7\xcdm\xff\xf1\xd6q\xff\xfa\xdet\xff\xfa\xdet\xff\xf1\xd6q\xff\xe7\xcdm\xffԽg\xff\xb4\xa1[\xff\x92\x84O\xff_W;\xffTN8\xffTN8\xffUN9\xdcTN8\xffTN8\xffTN8\xffTN8\xffTN8\xfeUO8W\x00\x00\x00\x00TO6=TO9\xb9TN8\xdeUN8\xcaUN8i\x00\x00\x00\x02SM9YSN8\xdfTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffSN8\xdcSM8V\x00\x00\x00\x01TN8[TN8\xc3SN8\xdfTN8\xc0TM8I\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00UUU\x03RN7ATO8\x92TO8\xbcTN8\xdeTN8\xeeTN8\xeeTN8\xffTN8\xffTN8\xf1TN8\xeeTN8\xe3TO8\xbcTN7\x8fTO6=\x80\x80\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\x80\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x01\xff\x00\x00\xff\xff\xff\xff\xff(\x00\x00\x00\x10\x00\x00\x00 \x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x13\x17\x00\x1f #\x00.,'\x00WN1\x00TN8\x00ue7\x00xh4\x00{sN\x00\xaa\x92H\x00\xa5\x92T\x00t\x8c\xc3\x00ȧN\x00\x8e\x99\xa6\x00\xa6\xa3\x89\x00ȳr\x00²z\x00ϻy\x00ʿ\x8f\x00\xcc\xc1\x96\x00\xf3\xd5t\x00\xff\xddw\x00\xff\xdfw\x00\xff\xdfx\x00\xff\xe1u\x00\xff\xe0y\x00\xfe\xe1v\x00\xe7\xe1\xd2\x00\xf5\xf6\xfb\x00\xf7\xfa\xff\x00\xfb\xfc\xff\x00\xfb\xfe\xff\x00\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04  \x04\x19\x19\x19\x19\x19\x10\x0f\x19\x15\x14\x14\x19\x04  \x04\x19\x19\x19\x18\n\x1d\x1d\n\x19\x14\x14\x19\x04  \x04\x19\x15\x19\x19\r\n\n\f\x19\x17\x16\x19\x04  \x04\x19\x13\x1f\x1f\x0e\x01\x01\x0e\x1f\x1f\x13\x19\x04  \x04\x19\x1a\x1e\x00\x02\x19\x19\x1a\x1d\x00\x02\x19\x04 \x04\x06\v\x1a\x1f\x00\x02\x19\x19\x1a\x1f\x00\x02\v\x05\x04\x04\v\b\x0e\x1b\x1c\x0e\x19\x19\x0e\x1f\x1f\x0e\b\v\x04 \x04\a\b\v\v\x19\x19\x19\x19\x12\x11\t\a\x04     \a\a\x03\x04\x04\x03\a\a                    \x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x01\x00\x00\xf0\x0f\x00\x00\xff\xff\x00\x00"),
 	"static/img/go-logo-white.svg":       []byte("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<!-- Copyright 2018 The Go Authors. All rights reserved. -->\n<!-- Generator: Adobe Illustrator 22.1.0, SVG Export Plug-In . SVG Version: 6.00 Build 0)  -->\n<svg version=\"1.1\" id=\"Layer_1\" xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" x=\"0px\" y=\"0px\"\n\t viewBox=\"0 0 254.5 225\" style=\"enable-background:new 0 0 254.5 225;\" xml:space=\"preserve\">\n<style type=\"text/css\">\n\t.st0{fill:#2DBCAF;}\n\t.st1{fill:#5DC9E1;}\n\t.st2{fill:#FDDD00;}\n\t.st3{fill:#CE3262;}\n\t.st4{fill:#00ACD7;}\n\t.st5{fill:#FFFFFF;}\n</style>\n<g>\n\t<g>\n\t\t<g>\n\t\t\t<g>\n\t\t\t\t<path class=\"st5\" d=\"M40.2,101.1c-0.4,0-0.5-0.2-0.3-0.5l2.1-2.7c0.2-0.3,0.7-0.5,1.1-0.5l35.7,0c0.4,0,0.5,0.3,0.3,0.6\n\t\t\t\t\tl-1.7,2.6c-0.2,0.3-0.7,0.6-1,0.6L40.2,101.1z\"/>\n\t\t\t</g>\n\t\t</g>\n\t</g>\n\t<g>\n\t\t<g>\n\t\t\t<g>\n\t\t\t\t<path class=\"st5\" d=\"M25.1,110.3c-0.4,0-0.5-0.2-0.3-0.5l2.1-2.7c0.2-0.3,0.7-0.5,1.1-0.5l45.6,0c0.4,0,0.6,0.3,0.5,0.6\n\t\t\t\t\tl-0.8,2.4c-0.1,0.4-0.5,0.6-0.9,0.6L25.1,110.3z\"/>\n\t\t\t</g>\n\t\t</g>\n\t</g>\n\t<g>\n\t\t<g>\n\t\t\t<g>\n\t\t\t\t<path class=\"st5\" d=\"M49.3,119.5c-0.4,0-0.5-0.3-0.3-0.6l1.4-2.5c0.2-0.3,0.6-0.6,1-0.6l20,0c0.4,0,0.6,0.3,0.6,0.7l-0.2,2.4\n\t\t\t\t\tc0,0.4-0.4,0.7-0.7,0.7L49.3,119.5z\"/>\n\t\t\t</g>\n\t\t</g>\n\t</g>\n\t<g>\n\t\t<g id=\"CXHf1q_2_\">\n\t\t\t<g>\n\t\t\t\t<g>\n\t\t\t\t\t<path class=\"st5\" d=\"M153.1,99.3c-6.3,1.6-10.6,2.8-16.8,4.4c-1.5,0.4-1.6,0.5-2.9-1c-1.5-1.7-2.6-2.8-4.7-3.8\n\t\t\t\t\t\tc-6.3-3.1-12.4-2.2-18.1,1.5c-6.8,4.4-10.3,10.9-10.2,19c0.1,8,5.6,14.6,13.5,15.7c6.8,0.9,12.5-1.5,17-6.6\n\t\t\t\t\t\tc0.9-1.1,1.7-2.3,2.7-3.7c-3.6,0-8.1,0-19.3,0c-2.1,0-2.6-1.3-1.9-3c1.3-3.1,3.7-8.3,5.1-10.9c0.3-0.6,1-1.6,2.5-1.6\n\t\t\t\t\t\tc5.1,0,23.9,0,36.4,0c-0.2,2.7-0.2,5.4-0.6,8.1c-1.1,7.2-3.8,13.8-8.2,19.6c-7.2,9.5-16.6,15.4-28.5,17\n\t\t\t\t\t\tc-9.8,1.3-18.9-0.6-26.9-6.6c-7.4-5.6-11.6-13-12.7-22.2c-1.3-10.9,1.9-20.7,8.5-29.3c7.1-9.3,16.5-15.2,28-17.3\n\t\t\t\t\t\tc9.4-1.7,18.4-0.6,26.5,4.9c5.3,3.5,9.1,8.3,11.6,14.1C154.7,98.5,154.3,99,153.1,99.3z\"/>\n\t\t\t\t</g>\n\t\t\t\t<g>\n\t\t\t\t\t<path class=\"st5\" d=\"M186.2,154.6c-9.1-0.2-17.4-2.8-24.4-8.8c-5.9-5.1-9.6-11.6-10.8-19.3c-1.8-11.3,1.3-21.3,8.1-30.2\n\t\t\t\t\t\tc7.3-9.6,16.1-14.6,28-16.7c10.2-1.8,19.8-0.8,28.5,5.1c7.9,5.4,12.8,12.7,14.1,22.3c1.7,13.5-2.2,24.5-11.5,33.9\n\t\t\t\t\t\tc-6.6,6.7-14.7,10.9-24,12.8C191.5,154.2,188.8,154.3,186.2,154.6z M210,114.2c-0.1-1.3-0.1-2.3-0.3-3.3\n\t\t\t\t\t\tc-1.8-9.9-10.9-15.5-20.4-13.3c-9.3,2.1-15.3,8-17.5,17.4c-1.8,7.8,2,15.7,9.2,18.9c5.5,2.4,11,2.1,16.3-0.6\n\t\t\t\t\t\tC205.2,129.2,209.5,122.8,210,114.2z\"/>\n\t\t\t\t</g>\n\t\t\t</g>\n\t\t</g>\n\t</g>\n</g>\n</svg>\n"),
-	"static/img/gopherpi.jpg":            []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x01\x00H\x00H\x00\x00\xff\xe1O Exif\x00\x00II*\x00\b\x00\x00\x00\v\x00\x0f\x01\x02\x00\a\x00\x00\x00\x92\x00\x00\x00\x10\x01\x02\x00\b\x00\x00\x00\x9a\x00\x00\x00\x12\x01\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x1a\x01\x05\x00\x01\x00\x00\x00\xa2\x00\x00\x00\x1b\x01\x05\x00\x01\x00\x00\x00\xaa\x00\x00\x00(\x01\x03\x00\x01\x00\x00\x00\x02\x00\x00\x001\x01\x02\x00\f\x00\x00\x00\xb2\x00\x00\x002\x01\x02\x00\x14\x00\x00\x00\xbe\x00\x00\x00\x13\x02\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00i\x87\x04\x00\x01\x00\x00\x00\xd2\x00\x00\x00%\x88\x04\x00\x01\x00\x00\x00\x1cN\x00\x00\x00\x00\x00\x00Google\x00\x00Pixel 3\x00H\x00\x00\x00\x01\x00\x00\x00H\x00\x00\x00\x01\x00\x00\x00GIMP 2.8.22\x002020:05:08 19:29:47\x00&\x00\x9a\x82\x05\x00\x01\x00\x00\x00\xa0\x02\x00\x00\x9d\x82\x05\x00\x01\x00\x00\x00\xa8\x02\x00\x00\"\x88\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00'\x88\x03\x00\x01\x00\x00\x00\x03\x01\x00\x00\x00\x90\a\x00\x04\x00\x00\x000231\x03\x90\x02\x00\x14\x00\x00\x00\xb0\x02\x00\x00\x04\x90\x02\x00\x14\x00\x00\x00\xc4\x02\x00\x00\x01\x91\a\x00\x04\x00\x00\x00\x01\x02\x03\x00\x01\x92\n\x00\x01\x00\x00\x00\xd8\x02\x00\x00\x02\x92\x05\x00\x01\x00\x00\x00\xe0\x02\x00\x00\x03\x92\n\x00\x01\x00\x00\x00\xe8\x02\x00\x00\x04\x92\n\x00\x01\x00\x00\x00\xf0\x02\x00\x00\x05\x92\x05\x00\x01\x00\x00\x00\xf8\x02\x00\x00\x06\x92\x05\x00\x01\x00\x00\x00\x00\x03\x00\x00\a\x92\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\t\x92\x03\x00\x01\x00\x00\x00\x10\x00\x00\x00\n\x92\x05\x00\x01\x00\x00\x00\b\x03\x00\x00|\x92\a\x00\xceJ\x00\x00\x10\x03\x00\x00\x90\x92\x02\x00\a\x00\x00\x00\xdeM\x00\x00\x91\x92\x02\x00\a\x00\x00\x00\xe6M\x00\x00\x92\x92\x02\x00\a\x00\x00\x00\xeeM\x00\x00\x00\xa0\a\x00\x04\x00\x00\x000100\x01\xa0\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa0\x04\x00\x01\x00\x00\x00\xc8\x01\x00\x00\x03\xa0\x04\x00\x01\x00\x00\x00V\x01\x00\x00\x05\xa0\x04\x00\x01\x00\x00\x00\xfeM\x00\x00\x17\xa2\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\x01\xa3\a\x00\x01\x00\x00\x00\x01\x00\x00\x00\x01\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x03\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x04\xa4\x05\x00\x01\x00\x00\x00\xf6M\x00\x00\x05\xa4\x03\x00\x01\x00\x00\x00\x1b\x00\x00\x00\x06\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\b\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\t\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\n\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\f\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00â\x00\x00@B\x0f\x00\xb4\x00\x00\x00d\x00\x00\x002020:04:04 17:50:22\x002020:04:04 17:50:22\x00\xca\x01\x00\x00d\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00\xf7\xff\xff\xffd\x00\x00\x00\x00\x00\x00\x00\x06\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00_\x00\x00\x00\xe8\x03\x00\x00X\x11\x00\x00\xe8\x03\x00\x00HDRP\x02\xefd5m^p\x1e,\xea\xe3LW։\x11\xd5\xca,k\x851k\x1d\xd2\xc9zK\x9f\x02<\xd53p\x13\"\x9aWO\x15|t\xc3\xceK\x90VQ\x85\x8e\xa3\xce}#HP'k8\xb4\x17H\xabk\xac\xbdi\x15\xeab,3x\xbb\x9d\x1a\xad\xfc3e\x88w6\x9e=ӊ\xee\x1059\x1f>\x05ʼ\xbc\xe8\x92\xe7\xddpl\x969\xce~6\x82}d\x85+\xff߲\xc2\x01\xdbn\x04\x19\xaaW\xc8\xcc`\xa7pBm\t\xfa\xf7\x80\\\x86\xab\x0f\xa6\xd0\f\xac\x8d\xd7\xe4l\x94-\xf9;\x81\u007f\xfc\xb0\xbe\xfc\x920N䁭\xd7\u0380\xf1d\xd8\x14\xfczy\xf0\x89\xd7}of-r\xa7\a\x92\xb7U\xa8\x9d\x9d\xa1\x94z2\xf7\x9asz<\xcdw\xfb\xa7\x88F\xbfo\x1b\xda\x18a\xa3w\x81Z\x92\x94V\xab\x06HֿD\n\xb2\xe0\x8e\xde,\xb09S\x11r\x94\xc8\x10\xaf.\xfa\x90\xf1\x80\xc7?8\xb2\xe0\x87y\xaf\xbf`\xa6\x8aS\x06\xa9*sO\xc5\x1d8\xc3\xdaoӐ\x89\xf8\x8ai\x19z\x02pw\xab\xbem\x8axѷ\x15\x89\xb9\x80\b\x8d\x89v\xe7\x01C\x16\xa9\x18`Ϣy\x00\xfc\x9a\a\fW\xbb\xf9J\xaeLH*7ך\x97E\xaf6\xfaL\xf4T\xbeB7\x9f\x99\xed\x03<5\x1b\xbe6\x99B^EzF\xf47\x81߳E\xd4\xe6O\x05,\x9f˪Gt\xbdz\xd7q\x00\xe9\x8exv\x88&H\xcbF\x14\xd0*)+\xae=\xa32\xca+s\x8dxLU\xfb\xc1[\xfe\x8c8\x02?\xef\xb3\v֍JgP\x87ގ\x19#[-x3Z\x1d\xc0\xf7<=J\x1f\x91ӊ\xb4\xdb\xec\xee\x15\x88-\xfb@gU\xda\xd2\xf1\xa5\xc9\x1b| \x9d\xe4\x1fB\x87\x18x\x83\\u{\t\x8b\x85\xb2%d\x01\x0e2Y\x15)\x86S\x1c\xe9Fɢ\xf9:\xb8\x80\xfa\r\xde&Q\u007f\xc4h<j\xc12^\x9ae\xe3!\x0e\x8a\xa9i8`\xce\xdfWF\x93\\3\x1c#\xac7\xb7\x88qc\xb6\x85\a\xc6z\xd6\xfb˳\xb0\xf6\xc4\x01ƹY5\xe7\xbc\"\xcd(\xf0\xff\xc9'\xf9h\x8a\x18\x19Ί\xc2+_\xea\x87w\x8e%`vv\xe5pa\xce\xdd(\xc5(\xae\xd6;\x8e\x9d\xb0\xcal\x18W\xaa\xb7\xb2~\x19/\xf0\xa4M\xbfb\xcb\xc4xD\nу\xb3\xaaʡhbj\x94<K\xact+\xdf\x0f\xa6\xe3L^|\xf6\xc4\aSR-\xac\x0e\xb6\x8e\x17<sF\x1d\x86)\xea}\xd2\x10Ho\xc0\xb1\xb8\x96#\xc4Q\xb4me/\x9d,/<\x85\xb1%^fB\xd0\xef^A\xedni8N\xe4\xc9\x1a\xaa]\xdf7wg\xbcj\xce&\x84\xac\x9d\xaf\xcay\x80*\xb7\x18#\xde\a\x1e\xaf\x9c{d\xabk\xef4&5\\\t\xf6D\xca\xc8tOwB\xb6eeF,\x01\x16\xf1m{\xa8j\xc32\x1b\x0f<\u008a\xdc;\x9c\xcf\xe8bgA\xbc\xbc3jj7\xbe\xa7\xe0\xfb\x84x\x11\xa8\x8b\\\xf3\xc6gt\xaa\n\xb9Y\xab\xe1\xcaU\xce\x01l\x95\t\xfe\x9dG\x80iU\x1f\x8a\x94\xfdu7\xc9\\U\vJ\xf0\x04\x96\xf0&\xfbv\xa0q\x02\xfa\xd0\xd4\xf8<\xc6\x0e\x8a=\xad\xc6\xe4r\x99ڊ\xae\x1fF\x86H=\xdf\x04\xb8\x1eM\x06\xb8\xe7\xec\xf5/Ur\xbd)\x88\xf8\xeb\x9d\xddE\xd7,s\x80\xbd\x8f\xdb\xd7\xfc\x10\xa3\x11N\xa5k\x19\xd41\x91\xc2*\x854d4Zw\xa4\xcb(\x8dB\x98<{E-&\xa9\xb2\xe2\xd3\xc2/\xf1\xb6]+\xd1{U\x9f\xef\x04\xed^\x88\x1f\xe0I8˾'zR\xc5\xe4\xa4\xd5z`\xd0\xf4r\xe13\"\xc7z\x1c\xd1*`\x01\xaa\x1b\xed\x0ej\xe9t\x950D\xb5\x91\xe5(D\xa3\x82\xc7\x14\x8aH\xf9Y\xd17\xaeI\x05\xe1\x8c\xfb\xb1\xa5\u0090\x95\xb3ȗ嵑\xc8\xcd\xc31\x1b\x17>\v'a \xad+'\xe3K\xcbY\xdb\xd4g]$x\x1d\x17\xae\x1f\xf0\x10_\xc2|P\f^h'\x00\xd5W\x98j\f}\x1c\xf6\xc1\xfa\x8e\xddC\xf8z\x1a(#\xba(6\xb4\xf2*r:ׇ\x8d\x96o\"I\xf17o\xd9\xd2\x00\xe2\x03K\x8dy(\x0e\x8e\xc1%^C\xf1\x81k(\t\xa9}\x86\xf7:\xc0\x1b\xee\xdd$\vHe\xf6\x81\xc5\xeeV\x1b.=\xe1\b\x16\xbe2\x9d\x9eN\xe0\x18\xfd\xf2\x83\xb7Ԉ\xf9\x8a{(X(\xbd'a\xb3c\xa8\xe6p\xe73Ŏ\xbe/%s\x1b\xbfL\xc3O\xb7\xa1Uғ\x1c\x12\xa4\x8c`\xe3\x11\xdbڼ\xbc\x1c\x0eL\x9b\xc6\x15s\xd6of\xab\xaa~}\x91V\xb3\x13\x0e\xfb\xa8\x03\xa6\x9d\xe1\x9cؼ\xfcg\x93\xfb\vjnAl\vp\xa7髀7\x91\xfc\xb2\x16\xb2\xc5\x06Q\xe3\xd7Z\xd4YUtyI,墳\r\xefͦ\xcb\xe6\xfa\x17\x15\xc0\xb0\xf9V\xc1:\x13\xebE#\xa2?V\xbb\xfb5\xfe\x8e\xc1=\xfeO`u\xa4\xdcz\xdd=\xcbV\xb2ro\xbcbxh\xb0\xc3\xe9\x88ՂZ0\xe8y,\x14\x02\xfa\xc6\xecB'\xd2\xd6\x01\xd9\x1cKx\xa7ƽ\u007f+\xd8$\xd3E\x8f\xa5\xf6\xec\xfc2I/9_q[\f\xeb\xe5\x9c\xd9t\xd9\xf7b\xf7\xd6\x03&\xba\x85#ٷ\x9f\x04\xdbUa=%\xe2Q\xbd\x1c\x9c\xa6\x90\xf59ӧp<?\xc1\x17\xbcm~\xd1\x05\x17\xaf6\xa4Ȏ\xa4\x83\x06]\xca\xf44ZMP\xe7\x93N\x81\xa4_\xc10\xe1\xe1&ނ\xe5^o\xdf@\xd3?\xe1w\x89ۓ\x8a?\x1b\xafM\xc2P\vx\xa4\x89\xa32A3*l\xbe\xe3\x06\xbev\xad4\xedn=HC\xed\xc8-D\x94\tw\xdd\vu\xfe\x8d\x93=\xfe\xc2\\s\x93\xf7\x98\x83&\xfa\xd1zH\x90\xa5\"\xd40\x8b\x8f9o%H\xbf\xc9{R\xe5z\n\xf2\fjj\xc3\xc7{IlN֥\x97\xc7?A\xbd']\xf0\xd1\xf7\x1a\x1e\xb7r\x0f\xe8\xbcn\xffk\xf6)jh\xbc\x16\x8b歺Fܺ\x19*\xfa\xb6ԍU\xad&I\xf2\xafj\xae\xcb@\xa9\xa8Lǹ1\xf4\xc5&%eS\xf3\x99\xe6\x01\x19\x87\tz\xd3\xcf;B\xa8\xbb3j^\xc8T!\x04\xea{\x11\x00\x82>\xcd\xf6q7\xfd\xad\xbb\xa1J\xfb`{\xc3Mr\x9d\x02\v\x8d\xd7oo.\xc5\xd9=\xfa\xbd}\x16\x03\xe4\xdem\b\x14\x81\x19\\P\x14\x93\xc6\xe6`\x0e\xfb\x15\xb4\x05\x8e\xa8T\x9f\xe38\t!A\xfa\xb4˅O\xc8l\xb5\x8dr\x9e\xbcz\x9e\xc9L\xc6O\x84a\x92\xc1\f\xbe\x8a\xdf4\n\xad\xd5\xcd\xed\xb4\x15\x8a\xb6)t\xdd\xc0\xff\xdeķ\x84\x9b*\x1c\b?\xd1(f\x12<h\xb6\xf1\x18:}T\x91\xe1~0eq\xce\x0e3\xbf\xb7\xd6\xcc\x00\x9e\xba\xcf\xeb%\xcdg\xd0\x1dyL\x83l\xb3y\x1fW\xdct\xd8\uef0e\xf3=\x91r'\xca\r\xec\x18\xbf\xb6u\x10\x8e\x1boy\xeb\xd2U\x80\x1b#\xfd\xcfq\x9f\xb4\x0eg\x117\x15\x83\x8eg\xd9\xf8Y\xa8\xf1Jbs\x17\xf9I\xfd\x85ݟ\xc5\xe7\xb3>\xb2\x1b׃x\xcbB\x00k\x9fQ\xa8`Q\x94\xb1\xe7-\xef?\xa6\xdf\xfe\xb0H[\x85\xb2aعY\xb0-\x18,\xcaD]\fP\x1e\xa0\xb8n\xf3\xd0q\xd54\xb6\xf9\xb1\x00\xd6S\x9e\x85,\xc9\xcc{\x98\x9a/\xc0\xcaJT\xd8՚\xfb\xb6\xc6\xef\x1c\x1dΨ\x16\xd1\xe8\"!\x1b\xa6:\x86;\xc6\x17\xd3`\x99\x12y\xfegEq\x9b\xc1<*!\xbe\xa1M\xe7\xf73I5\x15\xb7С\x0f\f\f\xba\xda\xed\xcf9\x80\xee\x1f\v\v\xf0\x9a\x01D\x1eSt\x1b\xcc[g\r\x06\\S\xfcc\xdcߴ\x83=\xbcr\x93yd\x9e=\xa7T\x1eI\xc3\x13\x81@\xceG\xa4,\xb9@\xc9&V\x00c\xbfB\xfcN>4\x89\xf5\xda\xcdt3\n\xcc\x1a\x1f\x12\x9b%G\x85\xf5c\x9b\x91Q\v\x83V\x95'\b\x1d\xfa\xf7\xc3\v\xc5\xdc\xc1\xbb\x0f\x95\xe9\xdeŊ\xd3O\xec\xf8\\\xbb\xf2W0%\xd0\xd0\xf7\x89|\xd7|\xcc\x17\x9f!\x03p/b0\x8b1\xdba\x9a\xf9d\v\xb4A\xb9^\xbb3v\xc7\x12C@\x905M\xed\xff\xac\xeczYX\xd7k\xd0+?\xbd;\xa0]\xeec\xd5G}\x93\x1e\xef0\xa8\xe9w\xaa\xf7΄GJ\xd6JYU\r\xd5\xc44\x88'\xadK=P\xbf\x10M\x12\xf7\xf3\x96R\xe8\xcc\x1f\xc5U䌻\xae_`\x1f \x84\x9a\xf2Y\x8e\xb8\x8a\xe6\xcfyA/\xee\x10\v1\u007f\x92\x16\x12\xf6\x8d\xe8\x86\xf5\xaa#\x97\x80\x82\x84.P7\x03:\x99\xaa;\x1c+qA\u007f:\xf9Qk0\x12\xff\xa4\xcap\xc9\xfcF\x98\xd6yZ\x01\xb0\u007f\x05\xd1_\x1b\xddRZ\xbc\xcd\xc6\xd6_\xdd>\xbfo]!\x91\xc6\xfe\xc7ס\x17ο\xc1d]e\xafiJ\x88\vAa\x18pGT\xc8ʚ\x94\x9fލ\x92x\x04_\x9bG\xa9Z\xa0-\xba\\\xe2\xb7,\xc6\x14\x9f\t\xe4v,\xce\x04\xb1Ë\xff\x04\x17:\xf3M\xf8\xed0\xe0\x94\xa8\xa7\x9c\xedWD\xa1d\xf5\xcb0h\xf1\xd2뚵\x9fz\xe9\\\rǪ\nm\x11\x9c\x88\xa6\x1d)4\xd2\xc2~\xb8\x99\xa8Ӊ=\xfb\xaa\xcb?hU!\"\xb6P\rF\xb4\xb4b!ZU\xd04\xc0+\x97\xc7թo\xac\xeb]\xdc|\x99jǁ\x9b\xb6\x8c\x9d EOY\x1fk\xb7,\xc9k\xc9\x03\xce.\u007fZ\xc5ں\x8dW\xa4\x883Ru7q\xba\xc4\xfc36\xd3=\xa1\xa7s\xe2\x9c\xd0\xd5<깜\xba\xcd `\xe6(rw\xe7t&\x0e\x9d\xca,\xd1\x06\tq\xbd\xb3ko\xbaMP\nh\xf2N\xd8\x1d\x9e\x8b>\x18\x1f4(\x8d\x03sT\x9e>\xf8\x1c\xaf}\xa0\x9e\x020\"\xfaR\x11X\xde!M\x8af\xcf\xc1\xa5ո\xd9\x1a\xa1\x12\x8eS\xca\x16c\xbf\xf0\xe7\x8f\xd4\xe81\xa0\x05\xdbt\x9e-\u007f\xaf\x99рO\xfb\x1c\x14\x87mgJe>S\x06B^5\xeb\r\x04\x92⩞\a4@\xbb\x9c͋\xad\xc1k\xb3p\xe8U\xdb\xd6y\xafA\xbf\x87H\xcd\r\x0ew\x96(\xaf\xacQe\n\x92D\x9f\xde\xeb\x8c\x03\xdd\xdc\xc0\xdf\x1a\xa3\xaa1\x81\x80Q\xa1h\xfb^ŎQ\xbcv\\\xba2\xaf\x80W\x91\xc5*\xcf\x13\xf5\xeb\xf2\x8e\x12\t\rt\x85am*\x1aU\xe0^0\xb71\xff\xf4\v\xc5U\x8b\xbf0\xdd\xf8\xac\xaa\t\xa0&\xcd\x15\xe1\xcf\xec\x1fa\xe9r\x00\n\x10Ω\xa9\xce\r\x9c\xb0l\x161\x9e\x98\x1f\xe0/\x1bt\x16\xa6\x84j\x94\xcda\x1e\x8ev\x10, \x92A\n\xfe\xcd\xec\xf8\xb2\xae\x1eF \x9b\x8d<,,~\xf1=\xa1/S\xf0_\x99\xa5]8\x90\xc7+\x8a\xd6\xd5\xd7^\xbcS:;\x1eu\uec23\xb8U\x18\x84\xfc\x1b\xbc\xb2\xd8^t\xed\u05fdh\xb6:F\x84;c\xd3(\x8bN\xbd\u007f\x04\x9b\xe0\x06\xb9\x9b+\x0f\x82\x0fL;\x82\x99+\rm\x88{\xbb\x8a\x8e\x95kr\xaa5\xaa(\x9d\x00\xefR\xfaHß\x01\xac3\xec\xfeu{\xbboʝ\xdf\xe9\xbb{ũc\x94\xc9Ѝ\xb3<\x14Z\x11T\xa9ٹ\xe5x`^4\xbe\xa4\x86\xf8\x0e\x0e\x8b\x90Z\x1b\x82g-\xccSk\xa9\x9a\nOK\xad\x15\x85\xa2\x9a\x13\xe5\xd8\xe8~5\xfd\x84\x9c\x90\x1d\x89\xa4c0\xb8q\x87s\x0eߊ\x9c.\x92\x8c\xd6\x13\xd0$\xfe\xa9f\x89q\xd9\xc15\x87\b\xf5V\xf4\xfa[F\xc4\xe4\xef\x8dTF7B/\x84\xf3\v\xfb\xa5p\xad\xb9\xa9\xfb\x05F\x99\xa7f\tj \xf0焥\xdb\xdfz_\x05\x8d\xa0\xf5yZ\x82b\x99\xb9-lr\xf7XΤ\xb2_6f\xe30f٦\xd9\xf9Q\x97\xd9͑\xc2.Q+\xb5Qֵ\u007f`\xaca5\\\xa0\xd3g\xb6\xc30\aj%\x99d\xf9\x1c\xc2+tB$\x91\xa6\xce\x1bj\xd2\xc3\x1f\xfaZ\xbe\x0f\xe5\x82%\xfa\xfb۪\x1d\xcaψ\x8c\x15}&\xb4t\af\xc2b\x82*\xa0\x8fȳ(\x8a=T%\xc5\xc0QW\x82\x8ak\x06\x19$g\x06\xa1\xec@Kc=\xeb\x8d\x16\x03\x92\x93C\xa7\x13\xecjUK\xe4Ȼ\x1f\xce\x10\xa7V/p\x9d\x04\xd9>\xa7:/\xe2\xb9\xe6QŇ~\x9d\x9fM\xdb\x05\xf8\xe6\x10T\x81\xd6>F-\x02\x17\xd4\u007f\xa1\xa5\xf3I\x93\xbb\xa7\xe4M<IE&֠&/Y\xa3\xe92Z\x9d\xf0\r\r\xac\x1b\xd2j\x9f4\xb3\xb0y\xceQ~!qr#\xb0\xa8\xd1\x1a4\x9dF\xf3\xf61\x1a\xa2dV\xdf\xd8\xe9r\xec\xda\xe2vvD\xf1\xe5|\x88`X\xa3)Bs\xb5\x84\x81\xe9*\xe2\xb9bܪW\xeeţ\x13{\xadv\x05#\xf2뭇M\xf75\x12\x06U\xe4\xd8n<\xd7m\u007f\xf8I0\a\xec\xc1\xa9Ps{\xea3\xbdh<\r\u007f\x15\x94f\xe6\f\x16\nzr\x99\x89\x0f\x92K\x1c\xa8\xeaBC\xb1\xae̞\xd7fqyŵ\xcb\xf8.❦\xaaj\x864\xaaB\x8f`\xa2\xd7F\x98Ŧ\x82\xa5\x0e\xa8\xbe3\x01\xe1\xabtD\x8b0\xfaK\xb9s<\xc2\xdc<\xfc \xe3-\xb7\x9b\x18\"\x93\x86\x89\xd0Q?œ\x06\x18\xb7X\x89⡠W\xe3\x0f~cǶ2\x12\xeb\xb5Y\r\xe7\x81S\xa7F+\u17ed\b\x9d\n\xe8`\x9d\x12\xd0\xf8C\xec\x03\x85\xdaH%\x1e\xf0ұ=\x8dL\x989\xef8:?\x90\xd9\x1bκ\xad\x1f\xf3\xcdGI\xa2:\x86\x10\x91m\t%\x8bcٽ\x80\x86U\fГ<\xf7Lj\x98\xf2\x06G\xa4\x8d\xddG\xb4N\x04\xde\nj\x9b\xe0\n\xbc\x10.y\xe1p2١\xbcj\xeep\xb4\xbf9\xbe\xf1\xea\xf2G\x16jF\xc0\xb4\x00\x835\xd9<\xa3\x1b\x01\x17\x02\xaa\xdfݞk_O\b\xf2\xcf=\x91\xc8\x11\xc0\x81;AF\xa0\xafe\x96mȚlM\xe8/dn\xaa\x04\xb6\x80\xc0\xa4\xd4:\x833\x84%\x1fy\xa9\x9c\xedl\xd6V9\x90\xbf{\xae\xf4\x1c\xb7\xe2\xaf\xe2\xe4}\x8dJZ\xe6\x18nM\x94py\rY\xbcr\xe2\xda(+\x04\xc7\x1cY\xdd\x11kŔD\xe6\x87P\x92\xfc\xe1\x8d\xc8\xf8\x16\xdeԑ8T\xc6\x0e\xc2\\ z\x8acD\vR\xbf\vB\x98u\xe3aV\xd6\xc6;\x9d\x15ݑJ\xda3R\a\x92}\xf32U\xb0\xd3\r\a{i\x02\xf4\\\v\xc0\xe2Bg\x17e\x85\xc1B\x16\xf2\x1eKؿg}\x13\x12`Е\xcbK\xbe\\\x81+\xe5,\x8fV\vISvލ\xf68\xedV \xa2\xb4刨֞+N\xf4 SG;l\x19\x9cs9n\xbb\x16\xe98\xb7\x05P\\~\x9b!\u008e4\xf5\xf3\u007fCG\x02\xfa@\xc8\x10\xac\xc0*ܪ\xcda\x12\xf6\xd4\xf2\xd2c\xf1\xa3b\xb6\x1b\x9e\x04T\x0f\xb2\xd3\xff\t\xc6`\x86\xfd^\x18^\x97?\xffS\x17\xb46B\x05\xec\xf5Y\xaf\xd4߲Sp\xaa\xe6J\xb0º;\xae\xcb5v\xb7\xc2Y\xe2w\x8e\u008e\x86*\x86\x80\x93\xadL\x1b\xd8@\xf9\x90\x05`\xc1\xfb\x12\xe4;T2\x8b\x19ϖ@\x18\n\xd4Xh\xbe*<8a\x85\b\xbb\xfb~b}wh\xaeqL\xa6\x9f/\xeeV_\x98ޏz\x052\xc0Q{ٵ\x9c\xf3\xfa\xfc\xed\xea+\x8by-\xee\xdc\xf8z\xa4e\xe2\x8d\xe4\xcc%_\n\x83\xa7\x81\x0f\x1c\n\x9a\xc6\xcbE\xe3b\xec\xc7\x16'F\x00d\xfcs\x85f\x80\xb79\x9c\r\xe4]er\xf2\xe3B\x84N\xe23\xea\xeb#\xec`A\x8c7\xf3\xb0\u0381\xf0>ʃ\xe2p\xf3}\xdd\xc6\x15\x83itH\x832Y\xe3?\x188\xd6QU\x90(\x12)\x03\x9b\xb0\xb0\x88\xc2x:\xd24hݺu\xc6\x12\x12^_\xc4N\x11s\x10\xeaS`y\x96\xda\xf5C\x02\xe2\x83[\xa2\a\x9ccь\x1d\xb3\xcfKN\x15g\xd8HqŘ\xcf\x1c\xf2\tJau\xcb\xfd\xd0:\xcd\xfd\xb2v\x13.\xa2\x92\v\x8d(l\xef[\xee\x1e\xf0\xd5i\xba\x1e)`\xa2\xcf\xdb\aQ!\x82\xa1\xcf\x1a\xad\tgG+\x15^\xd1y\x02\n\xfbB\xb8\xb4\xcd|\xfa\xe7\x03\x11\xaa\x04\x86\x17\x97\x86\x98S\x1f\xbd?pH+\u0557\xf3\x18\xcc]\xad\x8b\x12m\xa2\xeb\x0eS~\x9f\x8e\xe6\x1ag\xfen\xbbB\xc1+h\xf8\xae\xee\xcc\xf5\xc8\xcb\xfb\x14\x8bS!\xd2\xe6̋\xa6\xd8\x1d\xe6\x16ܾ\x91{X\u007f\x9e\x8d9\xa8DOF\x11\b\x1c̵t\x01\xcaH\xaa\xb1\xbf\xd3\xf6OS\xb0 \aۅ\xd9\x1fJ\x13\xb2\xeef\x82\x19\xb2\xcdoI\x89\xbcs\xdf\x06\x19\xc6l\xadp\xban\x16q\x98\x86|\x00?(\aH)Y\xbcҠ3\xbf\x98z\xc6NBP\x86.\xac\x9c-U\xb4\n&$\x0e\x96\xc3Eӂ\xca\xeb\xeb*5\xc4Zhc\x10n\x02i\x85T\xa5\x9a\xacbNO\xb5\xba\x9bG\xd1\xd3\x15T\xe6\xf5+oTo\x0e\xbf\xf0\x9d8Hۦ\xae\x10.\x1d/\xe7\xb1ا}/\x19QϺ\x14Zv\xc8\xfde2\xc8C\xb0\xbc\x94N\x8b\x98\xa5\x83-\x90\xa3\x8f\xc9S\xcc\xc0\x9f\xbb\xab\xcan\x13\xacI\xf4ں6G\xc0Y\xc8\xcb\xc3(L\x03\xf3\x93\xbf\x17\x19\xd3\x15\x9b\x88\xac[\x0e\fQ\xd3n|a)\x87\xf6\x8d\xc8\\!c\x92:E\xfa\xee:\x98\x81K\x96\xa5\x9f'\xc5\x0e\xdc\xdc\x15`6e\x03\xc9\tx\x19B\x86D|\xc0\r$\x8e\x10\x9c\xdc\xfc\x1c\xb3\rI\x8f\xe5i½\xca\xddt\xeax\x82\xe8\x85I\xe3\rl@\xa9\xbe)\xeb\xb3\x17=ED\xf2E\x8f\xd5`\xacS+\xec\x957\xf8p:3P,i\xb2\xe3\xe5\xf4|Oa\xbf\xd4\n\x03\x9d8 \x84V\xe2\xabē~\x9e\x0eO2\xcc\xce\xfc\xa0\xa0\ve\xcb|D/K`\xad&D&%\x12\xc7_\x82Q\xf1g\x89l\xd5!\xe6,@\xda;Lcj\xd8\xe23\xc4\xe4\x11-\u007f\x0f\xb4Vd\xdf)\t\x84\xf7Ӧ\b\x86*\x12\x99\xb9\x8azS\xd2\x1b ru!s\x8a\xab\x0e\x02\xc5\x06S%\x96\"*\xe7\xffEhn\xee\x8c\xd5\x00\x97\b\x98 c]U\x95YP\x1b&\x88V*\x1cXD\x9e\x91\xcf3O\xbb&\xacq\xd9*\x86R3\xfb\xe6G\x83U\x91Q\xa3\"\xeb\x90{?\x83\xd7a\xba\xbb\xc4^\xbc\x96\xbb;bv\xe6A\xae뷟/\xf7n\xed\xad]\xdb\x1f_\a\x85\r\x1f\xaaȚ\x9diDs\x1e\xf6\x95܂?h\xa9\xdbی\x18\x18\xb9<\xe1\x85k:\xee\xe6\x1e\xe4\xb5\x12\xb8\x9c\xc0\xd7a\xbc\xb2\"P\xa4\xa2\xf9\xe7bRm\xe8\xdduʀ\x11<\xc5$a슗\x98\xa0\xa3\x02E\xee\xf9bБ^\x9c\x1d(\xf8N/\"\xf8\xde)\r\x96fu\xcek\xef\x17\xa9\x93\xfc7\xf9j\xbe\x9c\xee\x81\xd9\x18\xf7R\x00\xaf\x8aA2\x0e\xd5M\xcaQS\t2%\xc5\"\x19\xf3\xddW\x18;a\fa\xbb(\xec\xf5t`\x9d\xca\xd2\xfdu\xca{¿\x81\x12fǒu\x04\xf9\x8fb\xa6\xc0S\xf4\xa7K\x9a\xdd0\x85)\x00ǄS/<\xbd\xf0\xa9\xf1{\xa7\xa8\xc4\xf7\x8d\x9c\xba#\xa8 \xc4%\x8d\xc5\xffm\xd0߲\\\xd2\xda\xd0w\xe0̑\x8d\x85\xc3s\n<*ȩ\x81CsZZ\xd8\xe0*\xc9\xda\xc8\xfa\xb1\xc8:\xb3\xcfq\x82M\x9b\xce{>-\xe5+\xb3j\xb4IǺ\xbb\xb0\f4\x9a\xae\xb6=gvߎ\xfc\xff\xcc\x13\x16\xcd-\xf9\x0f\xda\n\xb9\xbe\xe2\xd9dZY]o\x1f\xd4o\xe2Na\xe8\U000573ea4\x95X\x9e\u07bd\xbb\x03v\xcc9\x9c\xc2uz\x8a\xf2\xe9J\x04\x8d\xe8^\xa2\xd6\xf0_\xfd\xbd\x15\xf6rDe\x1f%)r\x82\xf4\xae\xc4\xe3&\xa7\x17_3\xb3e\xb3\xf8V\x80pCS\xc1\xe3\xed\vSQ @\xe9\x84&\x9e~\xd4}g~C_R#R\x15j9\xaf\x88\x96\r\xd8W\x9c^\x9a<Q\x9b\x9e-\xffO\xf7\x1eo\xf5\xbfq\xdc(\xf9\"\x10\xa0b\x9e\x0e\xe0\x9a\x8a\xe3\U0009ca69p\u007f\x92\xaeIv\xf4\xc7\xc0\x19\xe1\xe2=\x9e\f[\xe1FKɇJ-\xc3lyȘ\x9c\xbf\xc7]4\xb8\xb7\xa0\xc1b(\xf4\xd3\f\x8dqa\x02L\x03\xf4=\xfaCj\x9d\x9c2\xefL@r\xa7\xa5e~f\x03+\xf6\xd8g7ѻ\xaa\xa6\xaab\x89\xf6\x1a\x15\xf6\xaf\x82\xe7\xf7/M\x81\xd45`\x1cs2w-Fr\xa4\xed&\x0fh3\xdaC\xfc\x1d\x05^ƴ\x97\xba\x95>\x92?)d\x13;\x1cx=\x01\x85\x9c@\f\vŁ\x0f\xb1\xc0\xbd\xdb\"\xcf/\xf9\x11\xa1\x8b\x17\xba\xad\xab\x94?\x9e\xec\xcd\u0088X\x81\a\x1dӣ\x8b쯮\x06\t\x8e\xeaT\x87\xf8\xe6ᘜ\x16\x99\x9bc{^\xa0\x8c\xfds\xe0\xc4\xecm-;\x90\xeag>,B\xbe\xb0R\xc4\xe9ܝ!Oڐ\xca\x19\x9e\tF\\\x0f\xd9,\x06\xb6z@\x0f\x91\xe8\xff0\xf0\r\xf3V \x0e\xf4\x8f\xa8߽\xe9\xf2j\xe4\xab\xc7w\u0095 \xe8QDJ\xbd3\x82\xa6^\x13\xfe\xc1+N\x9e\xd3\v\xa8\xd4lK\x80\xf3Ĵ\x9cۘ\u058c\xba\x88u\x1c\xc9fX\xdfN\xe6J׃|\\\xf0\xd0\t\xe4\x05\xe6\x9b_\x1e\x17c\xa2y\x04\xa6\x04\x12\x14\x9e\x94\xaa\x8e\xcf\xf5\x14\x13\xb4\xde\xe9\x03\xaa\xe6\xe6V\xb4\x8aa\xa0~\x06\x84\x9b?\xf9\xf5\xd9\x1c\t\x86\xd2\x11|\xa4kuRj:[ެn\x95]\xbd\x1f\xc1\xa1Ͼe\x8b\xfb\xaf*Wg\x14\x8d\u007f\xe8\r\xe8\xeel\xec\xf59k֍\x1b!\xadM\x15\x15\x91\xf09\xe5/\x96f\xa2a\x85\x88\xcbK\x19gy\xbb\xcb#^#m\xd7-\x80\x03\xe7\x86\xdev\xd5;\x80:I\xfb*\x181r\v0\xc8bё\x923\x92\xa8\xa3\xe6d1\x11\xb6\xb1*B\xa9\x87\"O|\xd3SQ\xbb\x8a{\x154\xeaMK\xed\x97\xcf!\xc2覙[#\x01\x1e\x11Ķ\xef\x17\xfeG3\xba\xef9\x06T=\xde:\xa7=\xe6\xcbh\xaa\xac\x17\xa2iiD\x11\x83\x88\xfc\x04e\xd3p\x89v\x14=\xafc\xc2i'\x11ۼ\x90C\xd4\n\x96\xa7\xc0\x0e\xa4\x86\xf6\xe9b\x8bM\u007f\xbe\t\xac\x88+|lLp\x86N\xa2@7\xb3\x1a*\xa5\xe4Y\xf5\xc8SC\x8dڠoA\x17\xbe0\xe4\xdbl\x9cx\xdc5\x1e6v܇vI\x00\xcbf\x02`B\xb8\x85\xb4\xc6\x1f*\xfe#a\x8b\xfe\xf5\x81\x14\a\xa0~9Ē\x8f=W\x9b\x0e\xe9\x9alMI\xfa\x14\xc29\xae\xc7ē\x13zq\xd6\xe4\xcd\xe6l(\x12\xa73\xa7\xa98\xc7\xf7?\xc1K+O\xa4\xef\x8c{\xb0\u0530\xf83\xda\xed\xff\xb6\fd\x96%\xe5\xf1\xf1\x8c\xb9\">\xaai.E\x16\a1\xceG\xf3_\xafT\x9f\xf9*\x0fh<[\x1d \r\x9b9k_\x02\"3\x95\x84\xee\xec\xb5\xdb@\x06]\xfaT#*M~p\xa0O\xc1\x8b-M'\x88a\x02\x8f\xe9\xe0L\x9f.\x90\xc8B\x99\xb2\xf0\v\x89rm\b\x17\x87\x88\x14a\x8f\xac\xb7\xa5똱8\x05\xbeJ;\xa5Y\a\x8f\xfd\x1e\x9b\f\x18\x18Ȫ\x84\x1bA\xb9\v\xccyo\u0557Ř\x86\xd6\xf0\x94\x03\\\xa3$\xfaY\xdbJ\x031\xf8\xfen\xa5\x94\xe2W8\x80\xfb\xa0\xa1\x8fWf\xb2\x1b2\x04}\xfa\x8bR\x15\x17\x9d\xef\xec\x0f\xac!A\x9a^į\xb4\xfb)\x9c\xf8G^\xa5\xac\xf3M\xe2q`Z\xb1\xf2\xbd\xf7\x1dYk\xa3[\r#\x0f3\xe3\"\xb9\xcb\xf9]\xb1\xc7\xcd8\xb4\xb7W\x130 \xa2uBem\x96r\xbe\x82\\\x14\xce\xc5\v\xf8\xec\xe5ن\xb8\t\x83\xde&Gӈ8\x88ϓp\xec\xe69֭\xb4ҢZҒ\xe4\xcd\x0e\xde\xfa\x0f1IƅJE\x9fSz\xf7V\xffW\x9f5c\x9fП\xfc6\x96A\xcdڞ*\xf1\xfe\xf3\xe4\x9e\xf3\x00\xc7V\xdc\xeaQtb\xc6za|\x0e\xf8\xc0\x8d.:냜L\xba,\x98>\xb4\\\x00\xd5żW\xdd0\xf4\x91x\xae\xf1\xf1\xa6\"\xd6\xe5\x8e\xe9\xea\xfb*RBE\xd4<n\xb1o\xc5c\xfb2\x8c\f\x85\xe6\x1d@̊\xe7i\x9c\xb3O\xac_0Q\xbc\a\x14\x03\xc8\x06o\x10J\xb8\xd5˃\xcdf\xf0*`mݛ\xa0H\xfeia\x1d\x05!}\x98Q\x82\bğ\x8aLd\xdc\x1d\xf4rQ3\x9f\xe71\x03\x97t\x89=+\x00\x10\x02ݦi<\xff\x8d\xa2!\xb1Z\xe5\xd6\x11\b{\xdd\xc8f3\x17D\xfc\xa7\xfd\x9e\x0fW\xbfO\xf3\f\xad\x95\u007f\x14\x05{=\x89\xf1\x9b\xc1\x94\xba'\xb2PO\xb4[\x8e\xf7\",o.\x1e\xe6\x86E\xf6\xf0\nX?V\xfe\x8dr`\xbc\xcd\x14\x9dF\xf5\xd8pfTH\x8f\xdeﾋ\xb7\x18\x91}\x90\xdc\x1c^\x90?\x02\xd7sH\xcd\xe3\x8cA?\x8f\x96\xdbȣ\xfd\xe0\xd8T{\x82\x9eD\xf0\x8aZ\u05f7\xba\x965p\x11\xf6\xf5\xa4\xd1\x16\xf4h\x0ep\xb2ap\xefī\r\xbf\xb2[\xea\xf1\xe5-\x02gd^\r\x87ً\x8a\xa5\b#l\x95\x9b\x13\x11m\x84\x86E9\xfc\xe5\xf2S.\xb5\u007fR}i\xf0\x86\x04\x89\xcfu\x8a\xf7D\xea\x99䆢@0\xeah\x1b\n\xceY\xd9|\xba]\xbd\xa2\xdb\xf8\xb2\x88Rj@S+\x8b\xa9\xe6\xf2\x16\xb4Tn\xbf{\xde\xfa\x12\xc9NW\x9c\xb5\xf1R\xd7\xc7\xd8~\xe9yAٜ\f̥\xff 5\xa8t%\x97,G\xdaŤ\xed\xa8T\xe6Q\x19\x1b\xb8m\x05>[nl\xa6<\t\x8f\xf0\xc1\xaa\x18\x0e\xa8\xa8g\xb2\xa3\xdeZ\xedy\r\xc8\xd6\xfc(\r\x99\x83\x86\xf9=\x86\xa2\xd1\x11K\xff\x9b%\x90\xdf\xf3\xbd%J%\xfc\xb5>tkV\xf1a\xf4\x16\xc5\x04p\xd22\xb9Bf\xf4i\x1dT<e^4c벦@2{\xdf}\xf9KS\x8f\xbf:\xd9\xfd\x80\xdb\xcfAiį\xffe½\x95\xf4*\xeajWJ3\xd7,\xfeky\xe6 \x8b,.F\xb5\xa94z7\xee\xc8\r\xafS\xb5Y\xf1\aF\xb3\x06\x19\x1fi|Yɑ\xbe\xc94g\xbc\"\xc3LB\xb5\xe1\xbdQ\xa9F:\xdeb\xa4\x1f\nH\xef+:^\xdf\xd6\xd80S\x82:^^\x9a{;\xd5\xe9蠫\x1a\f\xa8\xfbyNi|\xf5\xa8\xfb@Y\xb8\x1e\x84eY\x05,\f\xc9j\xeb4vf\xa63z\x94&\xa9ف\xab\xae}\xa4r\x1c\u007f\x02\xeaP\xbe;\xb2\x95ά̭\xf3\xb5v\xc1\x8a\x82\u05f8\xb0\x9ec;)=\x9cH\xa9\fi\x9e\xbe\x97\xbcC\xb04\v3\x19\bL\x9bj\xda$\ny\x10|x6\xbbP\x91R\"\xc4\r\x0fB\xad,\xa5\xb8Y\x8e\xe4T\xdbW\x00\xf0c\x8c\xe7%\x1e\\\x8a\x18\xcaH\xce\xf8\xdc\nA\x05Jy\xefX\"\xaeI\x96\xfd\xc76\x1d\vl\x9c\xf8\x9cF\xf9B@\xd4%\xb6\xfeȐ\x82\xeb\x02ك;\xf0,?\xc8\ue65dT*a\x8f\\\x17#\x17V\x8a\x8d%\xff\x15\xe4!\xefc8\x87Q\x9f,\xab\xbe\xb4KhrE\x81\xb11A\x9c~\x81A GW\x84s*\xc2\x1a\xf6\x00\xd6И\xfe\xa8\x8f\f8\xf4\xe7&\x84\xe0\x8d\xf3\xa5+\v.M^\xe5\x81\x11\tH\x1f[z$$/\x83\xa25\xf9\xec\x19ͯ\x9cp\xd4҆\xc1,\xb1\x061\x00Bdz\uf7abP\x11\xbe\x19\x97\x9d\x1b?\xfcF\xa4\xf0\xad\xf7&\x13\x91(\x1d%\xa1\x02۞\xad\xe8\xe7\xa5\x15G\xb8\x19\x166\u07b2\xf0\xc0\xc5LR#\x8a\x03|\x96JP\xa6\x81H\xa9\x9f\xe0\x1d\x81\xe6e\x8a\xf5\x86~\xb8\xfc+\xc7`\xacS0tAk)Yx'\xffR\x9c\xa6\xbc\xf8\xac\x04\xd7\xde>)\x02p\xbdS}\"\xb2\xf5\x01\xbd#\v\xb7\xad\x10\xf5\x1c\xb1\xf08\xd1b\xf4\xccޭV\x87\xb8ꀒ\xfcP\x05\x15\xf1\x81\r\x15Dc\xf3rƥU!\xe0\v\x17u\xa7\xe3\x95\x14u\xc0/\xeb(H\x8b\x01\xcaQ\xbd\x1a\x1c-\x99S\xf5\xe8\x8e*\x86EQ\x01#\xfe\xe9\x1c\xf0\xca,\xefծ\x96lkȩ\xc5s\xc3\x06\xe13S\xceL\xe0\x11T\xf2\xcb\a\xba\r#D\x91%\a\xb6\xfar\xfa\x87\xf2\xd7g\xd5\xec\xeem\b\x8bԚ\x1e\xe6h\xa4\xdc\x006\x86ʁ\x04\xbd\x01k\x04\xcf\x17\x06d.A/\xe8\xe9\xcb>]n\xb8\xb5\xc7\xdc\xdcT\xbd\xff\xdc\xfe\x9fVjM\xd7r0\x89\xc0+\x97'\x90^Xtd+\xa9\xb4:\x85\xc2?\x10KIx\x911g\xc5\x03\x03\xfe-\xfc\xf4\xbc\xe3;\x93\x94b.\xb7\xc6\xc8c\xa9\xa2E\xb6\x0f*\x94\xb7\x13ޏ\xa7S\xb9!p`\xd5q\xf7^\xa4\xa6Q\x90O\xc0AА\xa6Buիi\xc42\x06\x14\x8bQ\f\xdb\x1f\x1a\x18\xd1\xe3\xff\xfe\xe5\xea7\xe6\x0eH\xde\x05\xd8b\xe3\xeag\xe4\x1f\xcd\x136ju\xc8K\xa1a\xca1o\x15\xf4\xaf\x1bޞ\x0f\xb7@\xb6\xca\xf7~\xd5\xd8\r\xb6SX\\b\x05\x9f\x98\x92\xac\x14\xc3\xe8!r\xe5\xd8\x19\xa2\x801Qn\x98p2D\xefiw\x01Tv\x87\xc13e\x048a\xafקlTOc\x8b\xb2\xa8\x85\x13\x156\x16\x0f7\xda?f$\xbd\xbd\n\xfcR\xa6\x98\xd0G\x19=\xec0\a\x1f\xe1\rZ\xeb\xc7ʘ\xea\x01n\xd7\xf9\x95\xc3\xf6o\x18\xf7\xca\xf4\x80U\x8f\x90X\x9c;\xa6\nc\x02\b\xa7M\x90X'8\x1b\x8a\xc1\xe1ԑb-\x9d>\\&\xde\xc9Ô\x86JG{\xb2ͩu\xbf\x93\xdd\xd3\xdbm\xd56\x90>.RC\xc2\v56,c\x17\xb883\n8\x0e-\x9d\x01\xf9\xfaG;\x94\xec\xd2\xc5\v\x1d\xd2]8\"p\xc4o\x18\xda(\x12R@G8\x1b6IdB#\xbc\xe5\x878\xae\xdc\x01\xd7̤\xafw\r \x8e\x9f\xa3(\xf1\x01=\xac\xbc\xa5z\x90\x80\x1c7\x0e\xd8\x1c\xb8\x13\xcd<\x00\xe3\xfd\x04)\xe5\b\x1a\x8f\xf9h}\x83ȿ\xae\x80r\xc2\xfd\xa0+3dQ\xaa\xddkbzi\xfb\xf4\x02\xf8:\xdfג֑\xcc\u007f}\xd62\xed\xb5\xfaNV\f\x10l7q\xecȗ\x1c\x0e\xfe\x92@\xb0%nu\x8f\xd4\xe67F\x98\xad\xba\r5\x16\xb3@\xa46\xbb\xf8\xf0\xb2}\xe0\xfd^ko\x83\xa0\xa7W\tt\xb7\x8b\xe8s{O\x9b\x01֖\xedX\x05jxA\x0f\x80\x95\xc1Ν@\xd0E\x05̹S\xf5\xbf\x9d\xb2{\x96ޔ\xb4\xcb<\xcbTMY\x87/\xa3\xe2\x95\xf6_\xfc<\x02\b\x1b\x9b\xf5\x1b\xcb\xfcZ\xb9\x9fY\xfd\xa3\vY\xd6N@\xff9Sl+Ȼ\xf0\xae\xf4cٗ\xbeo7\x1a?\xf0\xdbz\xb0\xb7\x9e\xd3|(0a\x9f\xf4pqZA\x8fsj\xd91\x8dA\xba\xe5-\fͫΎ\x1c\xa1\xa4\xa7\xf6\xa8D\xb1\xf5\xd7ac\x05\xb4bU\x9a\xa5\x88%h\x8f2\xdbR\x9c`̕\x01B\x15tNn\xed\xb85\xf2Uͩ\xb9\x93fh\x9f̝\xeb\xecs\x86F\xd0\xf7p)\xd8\xdc5\nB\xdb=\x1a}#\xad\xf7i\u007fq,\xc9\xe0\xc6\x10\xa8\x16\x9d\xb4\xd2\xf5]\x12[\xfdr\xfet\xa9\xf0\x932J\"@*#J\xf3tq\x1d\xbfB\xbe\xa0\x8f\xe5\xe3M\xb1\xe2\x12\xa3G\xf7bE\x12\xa6\xbd\xf4\xfdM\xa2.P\x1a\u0605\xc6)\xa4ge-\xb0\xa1D\xaf\x0e@\x13Y\x87\xd7!J\xban\xf4\xbf\x99\xc4\x00\x82\b\x8e\xb7\xde\xd7D\x14\xa7%\x16o\x8f\x8fr</\x9e\x9c\xe22\x14~\xbc\x92\xceYJ\xfd\x85^#\xe6\xab\xe1\xcayÂ\xa6}\x97Hh\xbev\xf2*>\x9f\xd2\xee\xfaA'\xd5*\xfa\x8a\x89\"n\x0f1;\xf4\xa7\xc4\xca\xd4g\xad\x8f0m\u007fn\xa7\xcf\x0er\xbf\x89+\xb6\u007f5&fo\xc7`\xf0\xd4V\xde\v\xcf즎\xbfwN\xdf\xe6\xc1\x14I\xaf\xd1Y\xba\xbc\xd0\x11\xcf\xf0\xe7_\xf6j>\x86n\f\xff\x14\xc5\xcb!)\xfe\xb18\x80\xa6\xfc\xabƛ\"`\xe6\x16\"{\xa3\x95E~U\xafE\xba\xbal\x9a\xfeL\xcaw\xfa\x8dp\xbf\xc5V\xec\xb4\xc7Ʌ2\xf0\xc9Ӕӟ!Ŀ \xa2A8\xc1\x18\x94aaN\xcao\xe8\x9dI\x81\f!\xff\xb2\xf6\x1f\xbf;\aX\x81\x8b+p\xa4?Z釀\xb3?\x90G\xfd\xf9\\\x0fZ\xbej\t\x8bj\xeb7\x06u\xf4v\xc6\x1b\xaf\xf0\xdf\x19\x9b\x05\xf3\xe4\xd33#\uf83c\xc5.\x88\x1dx\xc5\xe0GZL\xd4OE\xda\xd5!\xc6\xd1\a\\\xad\xa3\xd4S\xd8N\r\xf3\xc0\xdd\"\x16;\u0085\x1e\xd61\xd0p\xa1\x8a\u07baaCdCa?\x9d\xcd\xe7:7\xbdb#\\\xfeW\xca*\xbdQ\xb3#\x9e,\xfaQ\x96\xa9\xbc\xf0\tQ?xyk\v\x11P\xe7\xeb\xb3\x19\f\r?\xa1\xb9\x1d\x97\xb9\x12\xde\xf9\x11\x88p@]\x8f~4\xa0X\x84T\xeb\x942h>Ⱦ^\xf0\x9e\x9d}\U00099db1\x10X\xc7\a\xc7\xdc]\x97Yu\xe2s\xe3f\xa7\x1fF\x14\xae\xeb\x9b\xfdi[\xb8\xf4\xa8\x12\x1dX\u007f@\xf0\f\xfc\x03\xa0x\x0e\x80\\w\x95X\b\x8a\x9a\xdfH\xee\xeen\xd3\x19B±\v\xefN\xe9\\Gq\xeer\xfe\x13\xe8\xdc\xe4\xe3\x92T\x1fv\x1f\x04h\x03<\xf7\x1fa\xffNX\xb3\n\xffl\x19\xd2\xc5\\\xb5\f\xc6\xdb\xf5\xc7K}\xed\x1c\x1c\x83\xbd-\xa1\xed\t\x85O<Xa\x8d\x93\x05$x\r\x9bV\xfb\U000f0837\x1e\x92\xf6bN\x0f\x97\t\x8c\x1c\xf3Қ\xd4\xdd)\xa2q\xeca\xc7\vd\x8b\x17\xae\x12%\x8a\xc1Ӆ\f$\xa5\xbb5\x1eiͨ-\x8a\xd5j\x89S\aHB\x04\xc2\xe2\x98}Ej?\x8f\\\x8c\x86\xbd'}\xa66~\x05I\xf4E\xa5\x8dt\x11w\x14\xc9It\xeb\x15\xd1\xf27U\xf3\x11\a\x83\x1c>?\x9b\x0f\x144\x97/94\x11J=\xc0l39\xd3\x11l\xc48\xf9\x15-\xd5\xe4do%I|D\x92\xb6U\x18)q\xdfa-,O\x12\x8d\x89\x17c/8 T5\xa9b()\xacȊ\xb8\x18\x89i\xac%of\xc7\xfd\x18\xf5\x8f\xc4}V\x90X\xe1\xc2\xdd'=[]\xab\x88\xe3Hm\xc2\xd8o\xbf\x11\x96{\x1c\x89\x1e\xf5H\x9c\xda=\xbe\x9b\xaf\xc2T\x10Bm\xbbb\xe5\xa5\x10O\x9b\x96\xc4^\u007f\xf14Cx)\xf26\xe1\v\x05\xfaQ\x85y4\x98\xd4\x1ax\x84<\xc0p\xa9$\x1b\xafv\xf1c\xf3\x85o\xcbЮ\xf0K\x95\x1e\xe5\x88\x05\x9c\x89Xgs^\x90<\xf2۩\"\"\xdd2\xbb\xe2H\x17͛٥\x9d\r\x8dW\xfb\x18\x1b~\xbd\xff\xbe@W\x8e\xc0#\xdc'\xb2\x1e\x1eK+\x01곿w\xf6֕\xb9\x88\xa8t.5\xc8yt>!\x9a\t\xd4\xf0 \xa9\xa7\xb7\\ǒ\x04GM\xeco\xe6\xe6\x8b\x067i\x1d\xef\x8f\xe35\x8c\xee\xd9\xf5\x9d\x1ahĿ\xc6дd\xec\xec\xd1\t&\x14\x97\x17~8\xffN\xfc\x82\xc81\\\x17\xb9\x8f&\xf2\xe3u\x1e\xf4@\xac\xaeC8\n4\xd9\u007f\x86\x1d/\xb7\xe0\x93\x15HU\x1b\xa8\x8c\xbbo\xb7ۯl\x14i\xe2\xee\xe2\xdc\xc1\"Q\"ˬ\xa5\xb2W\xe9\x1d7\xf6\x89b\xcb>\xe0\\o\x06\b}$\x15\\}\x89\xfcv\x19U\xef$\x17\xb0\"\xab\xc9;\x00|\xd7\xca\x12C\x1f\a\xb0\x01\xcb3\xcf\xf5i<\xf9%\xe9R\xec\xda<\x97\x1d>K\x9bݚ8\xb5\xc8\xc5\xc5N<\xca\xc3#Qa*\x05\x8f:NR>\xef ?^\xb7y\u007f\xa6\xe9\xebln\xe1ں\x85\xcd;\x82kMȐ<a嘕\xac\xa0\xc5\x00i}\xaebD\x94Y&\x9e\xfb\xa0T\xc5H\x97\xc3\xee\xfddIS\n\xcd\x11\xbe\xe8kel\xd76\xab\xe7\"\xe5\xbe\x1e\xb0\xd0\xe0\xb5\xf7ft\xbe\xa9v\xe0\x8b^Y\x8b\x01\x9f\x04\x10A\x8a\x89\xef\x822ŷ\xbc\xa0\x93\x12\xa2\x1c\xe4\xb5q\xae\xecH!\xce<\xfc\xa6hFz\xc8\x19\x8d\x8co\x96\x11\x95\x94\r\xb7\xbf\xa47!\x15%*\npw\\\xa3\xf5\xfcC\xa2\xda\xe3\x1e\xbe\xb9\r*\xbe0u\x88\xd1J}\vW):\xb6_\x87\x1a\xa5ꍽ\xcc\xfa\xab\xcb9\xd43p6-\xf2>\x8e#\xbc\xfd\xa2v\xd6ul\xb1\x16$KO/e5:\xcc\xf5\xc4\xf8\x9a\x96\t\xc0\xeb\r\xde}σ\xb6\xed:\x98s\x88s\xd5\x19\xe9\xb7Q\x1f\x9e\x13\x9et:\xaa\xf0\xde\x19\xb7\xa8\x8fH\x89\f\x88\x12FE\xad\xae\xc5M\x82\xaf\x94\xbdG\xe7\xf0a\xb0\x18\xf4\x13\xecǟ\x92\xfa\x03\xc5\xd3\n\xa3|\xec\xf7W\xb5\xacrYU\xe4츈\xbd\n+\xce\xf5=T~P \x8d\xfdne\x95G**\xa2\xa6ۨ(&\x11\xef\xf6\x02\x99p\x89\x98ޗ\x82c\x8d\xea5\xb3R@Q\xb1\xca\xdd4\xe3\xa3`\x04\x88q\xe9\x11\x12\n\x06ZS\x106\x94'3R o^h\xf8q\xad\v\xedҩg^\x9c\x1bF\x06\xcfu\xa4~\x0e\x8b\u009d\x14\x15\tE.w\xe3|\xc66$\xb9\n\x0eM\xe7rw\x12V߱\xa2\v28\x89\x90\x84\x14֗\v\x18Zs5\xf60\xf5U\xd7\xd7e۷.\x1e:\x90r\xa2ٖ .\xf9\x19\xee\x1f\xe9\a\xab\xa6\xb6i\x97\xa0\xcc6Dj\xef\xa9oD\xa6\x14,\x03\v\x96\x0fa\x01\x82\xe1\ba\f5\xa4E*\x9b#j\xf6\xdb\xf0\xf3\xa2\xf1\v!hd\xb7\xbb֗_س\xe8\xd3\x1b\xb9\xcb #'W\x90\xb01\v\xd2\xc2<\x9f\xefX\xe4\x8fb\xb4\xb7\x9dΡ\xbcZ\xde\xf6aY\x19\x8e\x025\x15\x89ϴ\xe4\x9d!\"\x91\ue834\xde\xc4\xdbhL\x98\xcd}\xdc\nI%N\xf6\x85\xf9\x91\xe3\x00\xda\x00\x9d\v\xa6\xa3\xb3\xb5@\xbeE|\v\x1b\x9e\x13n\xcf\v\xacO\xe7ڬ ~ڠ\"\arwo\xf5\x88,\xa4D\x827_u\x89\xfe1\xc0Ky-\x8d\x1b\xa3\xeb֛\xff\x19\xef\xe3bH&v\x89\x15\xd2Q\xa2\x03\x11/\x87,\x93-\x87\xe9\xaaEX\xaf\x03r\xc7J'P\x9f\xa8sN\xbd\\O\x8f\x9eMv5D\x90\x8a\xb5eOԧ\xef-\xe1\xa6\x0fJh\x8f\x95\x13t\xe5 \xa8\xdc$Jx\xab\xbb\x87\x99\x1b,\xdf\xe0\x931\x89\x91\xc1KW\"\xa0\x13ӵF~\t\u07fb\x1e\xb1\xb3\xf2\xbc6y\x94,\xb0\x94{\xcf\x01S\xaf\x83\xb0y\x0e$\xa5\xe0'\xab߈\x8d:\x16\x9c\v\xac\xf0̮\xb0\x8b\x8e\xa9{\xe4,\xa5\xd5ާ!v\x1dM\x98\xf1\xe7\xdd\a\xe1\x89\x1e\xbdA\x01\xbbW'\x178\xaaT\xfb\xd3M\x1bFG\xfc\xde\f0\xd7\xd6f\xdcR$\xf9\xb2\x0e\x8e\x98\x82\x02\xd5ߦ7D\xb8\x1bMY\x87R\xd4\xf8\x8d\x1cm\x1b\xe2\x9d\xd2QL\xf3\xed\xac\xfb\x02\x1d]\x049=˅\xcd\x17}\x98I\x93\xcbl\xe8o\xbc=\x8db\x90\xd4:[\xbd\xc2PaŌn1\a8\xde\xe9\xaa)\xc1\xd4D\xef\x19\xce\a=\\\x8eu\xb4'\xa8\xfa\xba\x96\xb5(,7\r^\xfd\t\x81\xc7\xe0\x06\xfd\xff\xea\xf1\xcb\x04\xbb~\xa6{nX\xb3<\b!\x13~\xa7\x800Ne\x98\xcfv\x1ct%\xe6G\xe5]\x14=vg\xff4\x01%\xa1\x9e\x1f\x8b},L\x16\xb0\x81LK\x14!\a,\xb3\x02\xb0\x9d\x84G}r\xac\x1c\xb6\xfbk\xd7\xd5\xd3'\x85uJ\r\xf7@\xac)x3\xe3\xb8\xc7-&U^\xf3UҪ\xbc\xd3\x04HzLm*\x19\xe9\x05\xe8\xe9p8\x83\xc1*\xfff[[\x15\xa9\xcd\xfc\x11\xe9ͺ\xf3\x02O\xbe|G\x84\xfb)ٛ\x1e\x9d\x9b-\x90&\x99~\xf9Ye\x1a/Oq\x0f\xff4\r\xd7\xc8S\x89\xb3z\xaa\xd3u\xe5=\xbf\xfdXRIv\u007f\x15\xb2\xb1\xdaӟ\x03x0\xb5\x16N\x1bWu\x1a\xc8\x18RJ\xe7U\n\x98\b\xa9\x03\xb3\xbc\xc3\xfe\x1cΒ\xa9\xa4\xb8\r*\x1fi\"1\x81u\b\xbd\f\x98I@\xe6$\xd900\xefW(\xae\xda,\xa1\xdd\x1f\x0e3\x9b\v\x11\x95\xbd\xff\xb4\xca\xfa/;\xbb\x83FS\xc0${\xba6\xf2\xbf&؈\xe2\xcee\x98D>\"U-7Q(<4n]\xddZ]\x1f\x18y\x94t\x0f\xe6Nv*\xdac\xfc\x1a\x81\xd0\xc9;\xfa]\x9d\\\xb8\x8a9\x87\xdbӋ\xdf\x19\x996\u009ch\x82[\xb5h\xe11\x97\xc0\xec\xec\xdbyO'\xd3\xd4`\xb6\xa5\xeeЧ\x8a\xa2\xe4\xa1w\xe1J3\xb1o^M\x02\xe8\xb1\xe0N\x15\x0e+\u007f\x01q\xebg\xb3\x15kyk\xe44\x12\xf8\x01\xa2\xfb\x90C\xbe$M휋s3\xb0(\x14\xa3I\x98>\xc0ĚU\\J\xb4w\xf7\xf7\xea\x84\xd1\x05#˺v͢\x9a\xeaR=\xf6a߄\xd8\xf9\xa5E\xb9[\xa3\x9b\xe4I\xccHk\x92\xf5%\\C\xa1/\xb7\x80\x13h\x06\nڃϥ\xca\x00\xfd\v\xc14\xd6\xe7r`䰞\xf4\x0f\xaeq\xc0~܈@\xadLo\x8a{\x84¡\xc2\xf4/hk(Q\xb2\xd3\x1d\x82L=\xa7\x8e\xaeta\xb8\xdc\xc2^F\xa7JO\xa9\xd59(\xb6*\xe2\xa0S]\xea\x13\xdd.\x92\x9fu\xd0cx\xd0\n\xc9\xd0F\xf7/Q\x90\u0601I|J\xd6\x03\xafҺ2X\xd9\xc1\x94\xa2)R\x84\x03\xe1{ \xb0:\x9a\x18\x8a\x00ŗMH\xf4\x12y\xed\xd0\r\x03y\xaf\x16\xd6\x10e{\xf0QuYeŵ\xfb\v}\xf8\xb3H\x00\x93Y\xc7n\x9a\xfcU:о\x0eƌa\x19|8\xac,Ș\xc7)\xb5\x8c*B\xbc\xf0⯷\xd5K\x03\xcd}\xf3_U\xab\x80\xbb\xb1\x97\x12\x14\x15\xf4\x83\x8b\xde\x19\x13V\f\xd0uE\x12\x19(\xd89\xb9\x126\xab\xfc\xadwC\xed\x9e\x16x\x1cvch)D2\xd6\n\x04P\xcah\xa3\x1b\x1b\xff\xa1R\x82͕\x95\x9de\xe6Ү\r\x84r\xbe\xb9\xe7O\x82)\xca\xd5\xc0#\x97*7\xc7\xe5Ǝ\xa5\x18\xd3\xd6\u007f\u07bfr\xc3J\xa9\xd2\x15S\x9e\xa1\xb0\u07fbN\xbfd\xc9wŀE\xf4\u0530\x9b\xdb\xca\xe7\tM\xa9\x92\x96HW\x95?\x16\xc1\xa5\r7\xa6\x9c]\x82\x8c\xef`\x9eK\x83ߩoul\xe6$Խu\xaa\xbc\xc5\xe4\xc7ZT\xc3\b\x96\x1e\x0f\xb2ԲE\x01\xd3L\x05\x03Lst\xda\xcad\b<\xb1\xe98\x15v\xcfpN_\xbd\xf3\xf3*TOڗ\x87͖\xcd(\x02\xe3ּ谤\x92]\xfbB!\xa5\x92r\x16\xc0C\xa3P^4m\xe6\xebJ\x9c\xf4\xea\xe5\xf0\xed\x1d\u05f7\x01\x04ۧ\xa1v\xbfo\x00w\f\xe5̥}l\x1af\x90;\xfa\xfe\x1c\xfd\x87\x8a\x98\x02@J\xe3<\xc8\x1d\\m\xec\x06 &snb/\x89ʘۊ\xf7>M\xd2Qq\xfa\xfbYq\xeatl,\xe1\x96\x10\xc0\v\x061\x00Sd\xce\x1a\\\xfbŁ\x06\x12CP\x04\xd0\x1a\xd8)>\v\xdf\xc3~}n\f\xe8\x13\xb3.\"\xf8\xbc\xad\x89\x9c4\x84\x00\xea\x12\x1f4\xf8\xf6\x1f\xa7֜\xa8!\x8f3\x01\xbd ]}\xfch\x92i!\xc1\n\xad\xa0\xc2v\r~\x9e\tm1\xe5ץ\x02\xc3\xfd\xf7ʹ_\xbe\xf7\x9d(\v\xc8k\xe6\xe5\xbc\xcc9I\xe0οim\f\xa3\x9fK\x19\x9b\xac%\x83\xa1҃D\xa7¨\xf7\xc0nm\x00R\xfbO\x10\xa9uHz0 \x8f\x13\xb2%\x1d'\x99\xdf\xe61\xe6)\x9aw\x114\x18\x83\xd4\xd0߬\xe3\xe2*z8RD\xc2\xe0\xc0\xc7`\xbepPH\xf8\xbf\xb6\x89\xe6DT\xb2L\fl\x88,~\x86\xcb3\xf2\xa6\xbcqs\x87,\x039c\x13\tP8\xd1\x19\x8d\xe5\xe7\xc0\xbf\xadI\xc9\xf3\x1d\x96\xb0\x8d\xbb\xec\xb9\xebܭ\x1c~\xaa\x89{{\x81\xe9U\xef\xf1\x01m\xa4l\xd6\x13,R\xd0?D8\xee\v`\x88B4E:O\v@:2\xc1\x81\x0eg\x9cQ߷b\u007f\r\xe7\xef\xe6ɘoi܊\xf7\xbec\x82\x19郃\xb4\r\xb8\x18\u007f>\x9b\xa0\xae\xfany\\L\x16\xba\r#i\b!;\xfdQ\xb8\xdb\xc8\b\xe9{\xcf\x1eWן\xd6S\x84(\xcf\xd8k\x0f\xb5pX@\u08c7̦Рӽd_\xf5m⠿k^i|M\xc3_\xfc\xd5\x04\x80\f\xf4\xb1#\x1b\xc9?\xc9\x16$\xa5\xa5\xcazB\xba_3\xc0\xb3\xc0\xcd\a\x1d\x01\xfd\xfb\xfe\xbaX\x8c{$\x0f\x87\x82uG%\xbf\x88\x90\x8dU\xe6\x1a\xee\x91\xc4\\\t!i\t\xf9\xac\x14\xd7\xe8\xeb\x9d=\x1d\xe2\xf4\xc5-h\xed4\xfa\xb3Ӭ\xea\x15\xa6\xdc\xf5,\xdc\x1cŗ䕝H\xd0\xf9u\x11\xd7b\xc0b\x8dU\f\x9f\x8b\xef\xcb\xceY\U00054107\x0f֭\xfbD\xf0\xb0\xb5)\xd0\x15\xfb\x83\xc2L\x8f\xff\xb7P'\x9c\x83\b\xa4\xc0\x81\\=,\xf1\xa3\x83:\x1c\x94\x88ْ\xab\xe5\x8a\xcd\xc2\x00\x12gr\xb2X\x1c\xb7\x87J\xac\xc4[\tG\xe2\x04\xcdl\xe5}S\x1d|\xa2\xa8pݮc\x83\xd1\x0e\xc6\xe3\x98?\xf8k}X\x91\xccޤ\xdc/\xb5\xf2^\xbbG\x1c\x9f\xbc\x11\xa4ι*\x01\x99\xfd*\r$\x1e\xac\x83%\xe9\xa8x\xf3?\xb7\xe3\x1b\xc7\xe6\x8d\xfa\xb6\xabv\xac\xf6|\xe3\xec\xc6ûk\xd5\bxTcM\x84\x19n\x14y\xbd\xe5\x92\x1e\x88\xb9\xcf\xcf\x12\x8b`M\u070f7\xe5\f\xf6\x9bP\xf2\x1ekB]\xb0\xc2\xc7\x129,\x98\xd4{+\xe9\x02=\xd1\xeaX\x1bkמ\xfc\tʻy\x02\xce\x1fu\x94\u007f\x8d\xda#\xbd\xe6g\x92\xa5\xe5\xa5\xd7\b\xb4\xbcg\xe4\xc2\xe7\xb7<е\xc8\x15%\x93\xe8\xc9\x00\xc3@\xfaʰ\xae\xda-\xa9\a\x9ca\xfb\x1fE\xfc\xb7OLH`\xa9\xfe\x93\xce\x19f9|\xff\xe3\u007fZjR̹\xe2ĺ\xb6_[]\x00q\x18\xf2\x8e\x10H\xf8OeIv\x8c\t\xde\x0eٺ\x8e\x12\n\xcb\xf8\x1es\x9b-\xfe\xfa\x13i\xb5\xac\xdb\x1b\xa0\f%k\xd9n\x04\xbc\xb5\x98\x9d|\x18\x12\xc7՝\xf1\xf3\x9b\xe5cO\xa9\xab\xfb\x19l\x8eּC\xec\x18{>\u058baOً\x85\x91X3\x86\x05!\xa0h\xf8t\x88\xbcQ\xdd\b.Ł\xd8rQG\x9e\"\x05\xadw\xe6\xa7zI\x8b\x1aE\xce\xc9C\x94\xfcW\x85\x96r\x89-̭ZH9=\xbdP\n\n\xafm\x8a\xff\vޅԧ@5\xda%4\xf0v\x9b`=\x82`x\xa8#\v[\xdcWŽ߿I\r\xdc\xff\xe8/\xa2\x8a\xc6@x\x8a\x96=\xeaȪ\xe53dk\x83\xaf\xb9\x82\xbdԋU\xd7\xfe\xcci\x96hqi8\xebf\xbd\b%7\x96W苡\x1f\xde\u007f\xe3Ц\n\xb7b\xfd\xcd_\x94o\x15-gD\xeeA\xb9Iɀ\xdadL\x9dp\xb3\x9c)\x93e\x82~\xffDL\x8cE\xc2\x1fdڴ\xe0W\x03!5\x1a-\xa1O.\xd8;\xbc\x17\xbd7\x17\xa3\xac\x1d\xedRYV\x85\xa4\xa2*gK\xc5\xd8d\f\xb3a֮?\xf5\xf6\xc1\xf7_\xc7l+\x8e\x19\x856\x03\xf5w$\xb0\x8b5uǦ\x16\xc2\xcdf~\x12\xa0\xb2\xa2\xaf\xa4<\x9aUڏ\x12䚢d\x9d\xf7w*\x97\xd4W^\xf3\xccл@^\xaf\xd5\x15\x0fr-/\x0f\xb4\xa7\xd2\x18\x05\xc4f\xf8}\a\x8b81\xc8\xec_yԜ/\xd0r\xb1\n\x15z\xb0\xb6\x87\xc8\xf4\x05\x11\x83\xbb\xb8g\xc31\xfd\xde>\xfcI\xf9Ѓ{9?9}\xb2K\xe2\x96W\xa7c\x15\xcbA\x97\xea\xa3V\x1b\xf2\x9b5\xc2<|\xc1Rx\xd8\x03\xec\x9c\u06039<\x92\u008d\x8d\xc5\xca\a&\x84ǖ\xe7G\x91\xc4L\xafk!\x0f\xa9\xae\xe8O\xae\x97\xc8Vul\v\xf8[\xf8\x95\x85\xf0-(>\xdd\xff\xbd\xdb\xe2\xc2\xc3Jo\xa9\x85\xfdI|3dK[ZI\xcb\xc3\x04\xa1ƺi\xec1\x91\xf4(\x90\xb1&6c\xf3\xca\x01h\xd6\x02\xb4fԹL\xf4a\x9f\xd7j\xfd\x18\x17d+v\x9eV\xdf\xcb\xc1\x8e\xc0\x1d<\xdf&\\~\xf3\xf4\xa6\xc2\x11>\xaa\xecG\xbc\xe8\x81>%\x16\xfa\x0fS\xbf&\xf6\xfe\x11\xe9\xf3\x89]\t\v\xae\aã\xe7Șr\x00\v\x99\xfd\x9a\xfc\x03Fur\xb3\x01R\xff\xd0T\xc5c\xb1\x95\xf8N:\tx6\x9e\xfc\xa2'ޞ\xc2$\x812m\xd2.\x96|\xa6̗\x91\x00[1ߥm\xf0\xca|\x02\xb6{G?\xb8V\x18\x04@\xf9\xffZ\x85\xeaF6B+\x1a\xcf\xce\xc3j\x8f\xe8\xb3%\xc0ڜ\x8f\xcdFMw\xf2D\xf5\xb1\x13\x8e\x83-\x9do\x8c\xc3\x14\xc8\xcfsŕM\xbe\xb5\xa9\r\xe3\x82\xe2]G\xfc\x04\xd5etd\x82?\xa8\xaa\x8bc\f\xe8\xac\xc0 \xcf\xe0\xc0\x88\x82\x17\a\x0fƈ\xdc\xe7\v\x8b\x90>mK\xd8=&\xfa\xff?bРe:acc\xd5AW\xd3K\xcdg\xa9r\xf5+\xda-=\x81(\x91\x1bl\xce\xf1\xae\xed\x10\x15?\xa6\x12\x99\xa8\x13\x8e\xe5z+f\b$Pm\x84Q\x87NGG-41\xa5\xb6\x04c\xaa\x04tUt=\xbf\x84\xf5\x04K\xcb\xf6\x96\x820Z\t\xa0Lؾ\xa8n\x14\xce3]\xd6*r\xff\xc4Sel\xb9\xfd\xbb\x9c߮û~\xa5'q\xc6\xc7\xc1\x9f9\xe3qB\xa4\xc5\xf6\xbe\v\x1f\x8c\xf8\x9dy\x8b\x1d\xb5=\x8f\x9f\x1cc\xa0\x10D0\x1e\x18W\xa8y\xea\x8b\xd2\xf0\a\x1a\n\xcc\xf2~\xf8\baU˂\x16\xb2>\x950r\a\xff\xd0C\xb0\x002\v\xb7\xb3\xea\x88y\u007f\xb2o9\xf7\x03\n\xcf\xfcy\x03\x8bR;[\xca\xf7X\xb9Y\x9b\xec)\x8dX ;\x04\x91\xcdI߾d9y\xa7t\a^\xe4\x96\x1b/e\x83\x91\x05f\xe8\x19[O\x18\x01+\x95㸭\xe2\x9aQ8u\xca\xe1\xaf\xfdW\xdd\x12\xc9`~\xf2\x1aR1\xd6l\x9dDs\xa4\\\xff\x85P\n\xa7\a\x16\xb1\xea\x88\xd0\xf1\xa5\xbe|\x84(\x88 \x9e\x85\xae\xb7LoC\xe0בD\xe1ȐM\xb5\xc2n\x96\xc7\\Ԟ&r\xcd˃z\x94\x84\xd9f\x00}\xb0\xa5\xcd\xef\xed=\xffcT˘\x16\xe9\xa6\x0e\x02]m^IW1\x05\xd3\"QL\xdcY\"\xfau%,\x92\xfc=\x01\x93-C\x1a\xa9\xa4?ߏ\xd0YQ\x02o\xad0\x91\x95}\xf5(`kuZ\xaa\xa6\x97\x00R\xa3e\x1d\\\"\b\xc3p\xb7&\xe0\xa00Ro\v\xacj\xc4\f\xa4\x8f\x1c\x03\t_n\x85@\xcb~\x00ϛ\xd3\xc9\xf5f\x96\xe9ʖ\xba\x8fU\te\x9e\x1b\x04\xf9\xe7\xd6/\x81\x8ep\x19vZ?4\x8e\xe9s\xa6|\xa4\tՓWW\xda\xfc.\x9e\x99-\xfd\xabUYn\xfe\xf1P\x1d-\x1d\xbc}\x9b\x1c\xab\x05\xb4\x97\xf4\xe9]\xbeb\xf1\n\x10\xa4\x13\xc2\xe4\xae\x15W\xec\xb2Ѕ\xb0Vm\xcc䏄\xa7\x03f\xf9\n\aa\xbbl\xe9\x15i\xf8\xfb\xb7\x95\xa7\x93/\xbe\xe0a\x0f#p}eA\xb4\xd2\x03S\xf3ky\xfa\x06\x89\x1eyyx\xff\x9b\x86v\x1b\xa7a\xf1\xa9]\xe7Ȏ\x83Ԉ\x10\xdf~\xa3&\f8ہG\x9f\xcci&\xc5\xfcG\xe3!Ū\xc0%\xa6\xe3[\xb9\xaf\xee\xb9;vl\xcck\x9bA\xc2\x1b\x87\t\xa4~\xd8(3U\x807\xe4\xbd\x14\xe4F\xfe\xd2\x00\xb9o\x9e\x0f&\xf7\x919聦j8\xec\xfb<a\xd0F\xcdIŨpkzB\xd5\xdf_\xd1:\x14\x89FX\xb1\xed\xb7\xcf\xfa\xe1p\xceDt\xb4ѵ\x0e\x16\rj\x10\x93\x01^\xd2Ic\xce\xfa\xb8f\xfb\xd5}\\$\xa9B.\x9b\xe24;\xf7\xd1\xe3A\xb2\x13'͕J\xf2)1L\x8e\x97\xf9\xbb\x8dTs\x19HT#W6ׂ\a9N\x87\xe60\xedg\x05Rk\xad\xae\xd0oz\x06\xa0>\xdf\xef\x96\xf6\x9b\xc6C\n\xaaڳ\xd8\xf0\xdc\xeed\xb9\x19\xa1\xe0\xb5\x00ʯ\x1e\xa0\x01b\x9e|\xd5z\xc8B\x82ZZ\xac\xf4\x15\xf7\xc3S\xac\x9d\x1a\xed\xbd'\x02d\x98\xfeTJsxޖ\x15\xc7\xff\x83\xf86\x8b\xe7\x89\xf694q?;k\xe6\xde:\x8b\xb7\xf4.3\x0fD\xa7\xf4~C煒_\xd1\xd2\vQA[\x86.\xb6{e\x86\n'u\xad\xf8-\x88\xdb\xd0E\x10\xfe\xbczм\x9c\x12ڈ\x15\x88\xb1\\\xc4\xd0\aI\xf2\xcdw\xac\xc8\x0f\xc2BO\x97\xaa\xe2\xe2\xe8\"AM\x15\xa1\xe4\x83.+\x8fb\n\x05\\DˣDk\xd74ظ\x96\xe4D\x96!\x81)JÚZY\x1e\b\xfd4\xc8\xce\xe8C28s&G8L'\x9c \x14\xac\x87\x8e\x9cH\xa7\x88I\x1aE\xabI\xe5\x03\b\xbe7\x9e\xf1\xfb)\u007f\xf0\xf8sŸ\xb1\x16\x8a\xcc\xe7f\xf12\xcea\xe0\x81\xe3<\x99%\x9e\x88\xb1\xc6[\xfdE\xd1\xe2\x17\xde\t\xea\xad\xf7\x1a\xfde\xb9\xbc\xbb\x82\x85]N\x98\x85.u\xdd':P\x82\x03\x81\x9a\x1ae\xdf$\x88\xd5k۠\xd8IS\x82t\xe19\x9c\n\x97\x8a\x88\xa6\x1f\xea\xa2GL\xd0\xefs\xb4\xf7\xf5+\xfd\xe3|^`u\x1c\u007f\xcfK?\u05ffqQ\r\x93\x80\xd4\xe4\xae\xf8+\xcc \xfc\x86\xbf\x9d\xce\x1e\xa9\x00E\xfd\x01g\xfe\x02\xae\xc6\x05\xfc\x94\b\x98\x18K\xd1\xd5X\x8c\xabo9\xe7\xca\x19\uf443b\x90d\xcfx\x12L\xfc\xd5לҺ)Y<\x82\x80\x14@\x846rxU\xe2\x94<\xd1bXC\x856%:\xf7C\x8cA\xb6\xb0\x13\x87\xfaa\xfe\x94B\xef\xb2F\xf5:\xde\x06Bk\x1d[ \xffi\xf1\\\at~\"x\x18]Ɓ,p\xf7~\u05f8\x87z\x9e\xe7\xa62\v\x80\x9e\rf\vT\xa4=Ƕ\xf5\x97/f\x9f:6\x10\xae\x94/\xffa\xff\xb3\x96\xfc@\xfe~8\xd5\xfb\x8d\x87\f\xdc\xea\u0379\xf9M\x00\xba\xd78\xd2\"\xac\x18A\xfc\xc9\xc5\xf7\x10\xc4\xdb\xc5S\x1f?a\x10\xbcl\xd7\x15U\x1a\xa2\x16\xf3\xeb\xa6\xf6x\x8b\x9fD#\x19vR\xbd\x06\xff_\x80\xae\x85\x06\xae\xdbG\xadC\xfe\x94\t\xcd[\x01=\x05?M\xd7\xca\xceT\x84\x8fS\r\xb4\x04\x98\xab\xbfr\x8bM\xed;\x1a\xc3\xcf\x0eT\x1d\x81\xc5T=O%\x81/n\xf8σ\xaaB\xa3\x8fU\xa7M\xba\xd4*\\\xd3v\xcfW\x90&D<\xb3\x84d,\xdf\xe7A\xe4\x9e\\8>f}Up\x17\xcdh\a\xb8@\xdc;چKc@\xecMr\x1fA\xe7\x17H\xec׃\x8f~\x13\x11\xe2:K\xc1\x18{\xc2\xe1\xda\x01MK(\x1b\x8bH\xcbc\x90\xff\xf3\xde\xf7\xadV\xc9]\xfe\x83\x18J^l\xf67a+ˊf\xafF~\xf4\xd2%\xf6\xac&\"!ώaC\x0e\xf1ɬ(\xc9u\xa0\xfeL\x85U^\xb5C\x94\xc9:\xd3视9\xf9\xa6\rI\x11]3\x1bE\x95\xf5\xa8\xfa\x1e&l'\x95B9V\xe5P\xddo\x06l\x86b\xbaS\x1db\xbe\xb4%\xb5\x9c\xba\xc3Ǻ\x83;\x93\x99\x92\x9c萲\u007f\xba;2\xe9\x89,^\x8aJ6\xd46ɛ\xc7w!\xfb\x12\xdb\xdc\x1aC\x97,\x14&Z\xb0\x91\x0f\xe6\x01z\xe9.\x18\x87[\x18l:\xe0G·+\x96נݗ\x00\xbdʤn\xe7\x04v,ۧ\xe5\xd5\xe4\xf7\xbe\x9b\x17&$J@\xfdс\xe3\xf8\x95j\xc0xwY\xf5\xf1\xc5P\xb6d\xf3\xe3\a0&Z>\ueef7\xd1\"_\xbf\xbf\x1d\x82\xd2Ӵ\xa2\xf5\a\xc8Q\x02@\x17\xa8R\xb6\x18܇\x1d[hŲ\x11\xf7b\xf1ґ\xf96\xe3\xcb\t\xe8\xff\xf3S}Rg\xa4g\x93\rd\xe8^\x93\x8e\x1c{\xfe\n[\x15K\x1e\xe3\x15[\xcf$\xc4y\x88ý\xdcf\xcc \xf6\x81;;\b\u007f{\xc9A\x8ef{i\x8eD\x83hY\x16E\xa2nl$U\xd9m-ʨO\xc6\x1f\xf7F\x8bTȷۘ2x\x18\xb2\x19\x12\xa6\x16\x19\x9e\x03\xbejT\x1c\xf0\x1bOʊ\xb4K\xccP:r16K\xc25\x92mm\x11\xd8G\xd9\x19\xf5\xde\xd9\xf6g\x94=\xea\x83\x00\x80\xaa\xa9ư\x98B\xa9\x9d\x93(\x9b~\t\xa6\xa8[\x9fV\xd43A\xa1\a\x1dӍ\x0f\x1c\xe0\xf7]\xe6O$\xb16C= 5\a/rq\xb8\x18I\xa2\xa97W\xeb\xf7+\x82\xb34\xfe\x1f\xce\xef\xee\xc3f\xfdo\xd6\xe7S\x8c\xb08K\xe1\xf1:ޒ\xd3=\xc9\b7,\x9c\xd18\x1d1\x9aW*\xca\xe9,cأk:\"\xbc\xf8]\xcf\xe5\xed\x14\xf7\x1e\\\x81\x13gR\xb7\xb2\x05U\"\t\x9d\xe4\xa7\x1e\x8e\xc0H\x8b\xd3\x03\x10\xb9ѡ\v\xfdl\x92f\xb5\x0e\xd6\xdak\x9e\x17p\x9e\x19Fh\xac\xb0\xf4/%\xf6\x1f\x89\x1ec\x19L\xf1q-\x00͞c\x8e\xe8Xn\xd1_%\xa1x\xc6{{\xd8\xf4P\xa5vm\xf6\xe9.\x8b\xee\x13N\xf86\xfa\xe7tq\xb8gИ\x18\xdf\xf62\xf0(\u007f\x03\x19\v\x17qx\xb0<\xc2\x1ej\x1c\xf5m\xd9c\xa3\xdd\xe7\xea\t\xeaV\x9a'9Z\x01\xf4.+o?\xb1\x1d@-sL^j\xfc\xd2*\x87\x92E\x18d\x11Q\x93ǎ%\x01\xfe\xe5\xc8v\xa2\x88ME\u007f\xfd?\x1f\x04o\x9b\f[\xfa\u007f\xe0\xc9\xc0Jm\xd9\x03\x82\x9ea*\xbd\xea\x99va\xeez\x83\x8a\xbaA1\xa7D\xbe\xbd\xe6\xefs\x95\bq\xc2&zۈ\xab\xdcזL\xc4ho\xb94b\x83\x19v\xedXݷ{m\xb8\x05\xeb\xd7\xfa\xe8z \xd7H|\xae\xa2!\x98t\x93\xe8?\xf6\x99=&j\xecK\xc7\xd7\xef\xbf[D\x11Y\xb8>\x8aI\x1a\xc0>M\xbe6+FO\vOb\x10}\xab\xa8R\x85!\xf5\b\xf3\xa4\u0093I\t\xe6\xc8\xc4&\xc1|5./\xbfR\n\xd8\"\x03\xf4\xb2I\xf1\xf3mx_\x8bJ\x18\x91{\xdc\xf5W\xe0\x96q-\xa0\x1f>QU\xc1\xa6\x83E\xf4\x16\xf8\xe9\x05n\x9d@ir\xbe\x14\xd3x\x17\xcf\x10\xbc\x19\x1f-\x89k\x1b,i\x8eM  r\xec\x8cG\x8c\x10\x1a\xe7P\xdexe\xdd\xe7\x9a\xd0O\xf010A\fl\xb0\xdc\n\x1b@1iIUw\\\xa49\x8f\x1c\xde\xd3T057t\xe0\xfaq4xZo?y\x8f\\\xa3\xdf\x04\xbcL\x91yTX\x89\x19̔\xa7(\x04\x12\xcd_\x91\xa1kV\xb7\xc9R\xcd\x05\x8d\xb5\xecG\f\x0eW\xc4;f\x1d9g\x11\x1e\x87q\xaa\xb6\x82\x06\xc0\xd1'\x83\x1f\f\xa6\xaf\x97\xf3\x04\xe4\x16\xc9:\x8f\x9e]\xe7y!=\xad\u007f\x94i\xed\xf8ntl\x98\xec\x83\x01aSUv\xbf3Sf)\xaeC\tZn\xc1'\xa6s\x1a\x8a\xf0\xecB\x87c\xd0P\x05oQC\xa6\xa5\x92w\xf0\xaa\xceY\xa0\x9aL\x0e\x9a9\x90\u007f\xf0A\n̗,\\\xfa'\x97\xacms\xaa\xb2\xbfrI\xef\x8b\xce\xc1\x95\x97\x15\xab\x19W\xd8\xcciN<戯bQ\xf2\x96\x04\xda\n,ƀ4`\x15\xadx\b\nHl\x9ea\xd5h\xa6\xc7\n\x17\xc0\x9e\xa2\xbeW\xa2\r\xfd\x03\xc5=mM\xbe<\x00\x86\xb0\xba\x17\xba1\xe1\x8d\xe9\xce\xe2C\x99\xa0\v{y/.G\x13\xcfɖ۹\xa3=\xd5\xec\x10\xf6\xcd\x0e\x88\xdd\xef\x16\a\xee(2\xb2~\x82\x03_\xd0aX\xa9\xd7\xe1O[\x96\xb6M\xd8uhu\x98\x03\u05cb\x9291\xb5\xc3?\x0f\xf6\x05O\"\x97GJ7\x10\xf6Y\xd3\x06\\L\xfe\x17\xc0\x88V+\xf3(\x85\xc9\xf1\xc1H\xd2U'\x1d\xee^\xfc\x97PDf\x98\xae\x15.\xcd\xe3\\\n\x15\x06-y\xc2\xd1\xd4 \x10b\xf42\x04\x043\xfd\x85{\xf49B\xeb\xd7\b\xf9m6\x9f\x8b$\xbbVB\x1e\f\xdcz7\xdb\xeb q\xe5\xbby\n&\xf6\x8a(\xcd+1M\x05S1\x02\xcfK\x18)\x15\xc0\x9b\xeaZd\x9f\x1d\xb5\x8fЏ\xa9lo9\x929F*\xd1sOV/\xe9\xcb\xdc\x00\xb4t\xean\x00p4d\x19\xf8gEB\xacK)\x116\x90\x8a\x8b\xd8ô|\xf4\xd6t\x8c\xfe\xa3\x8f0j\xf1\xa7t\xe6ŕ_\xf0O\x05ȧ\xd5J\x19y\xcbz ,\f\x8c\x00[\x16\xc4\xf2Oօi[\xa3\x15\x9d\xa1\x98?=\xc9)[ͬh\xea\xaf\xf2\xabF\xca\x1a\x06\xa7R\x9f\xb6\x9e\x1bbM\xf2\xb2\xac\x0f\x85\xd9@I\xa6\xb7\xa2\xc5k\x8a\x8b\x12\xb7\xb7\xe3*\x9eוQ\x1e\xd3\xc1%\xb2_\xc3]_\xc52\x12\xa4\xf5ó\x8d\x13~)M;\xb1K\x91\xbf\x90ޖF^ڻ\x8b\xbf\x86!&N Ôv\U00045e80\x94\\ݞ\xc1\xdfg\x82\x99\x11`q\xf1\xf1?\xfb\xaf\xb3\x9b\x13\xdc\xec\x12\x87\x11F,\xe2f\xe0\xa13{Y\x1f\x02\x93<t6-\x01\xea\xe1C\xbe8\xef:\xe4\x84Z\xa2l\"\xea\xe81^1\n\xab\x84M2\xa0\x91\x8f!-\x05\xcf\xd0E\x160\xac\x8f\x98N\xc5\x19\x1f5\xfa)\xa3V,R\x12:\xf7\x96\xcb\xd18\x91\x9fD\xaf\xb2@\\D\x99\xa3դ\xae+\xea\xc8\x1d\xbc<\xff\\\xbf\xbc\x9e\xf5\xa0\xab\xe7\xf2\xec\xb9\xf9$\x82\xa9ad\x1a3\xa6]\xd6]\xb9\x1e/\x00h\x88\xbe\xba\x89MU\xd2Δ\xf9ݽS\xa5\xd4\xf4F.p\x80M\xc8M\xf6\xa3d\xf0\x96\x8b\x10\x0f\xf0\xf3sڀW\x99\xd2A\x14\xce{Km|˷\"|\xddh^\xe31\x97ˢ~\xbc\x94\xbc0\xbb͈_U\x98\x96\xd2\"\xdb\x044f/\xbe\xc5P\x05\xe8|\xc1F\xe8Ȋ\x90/\xe2;\xe9\x9dKn\xd1H\xb5ј\xc1Ql\x13B!\f\xc2Zκ\xd96V\xa8$\xc5\xd8W\x12\xf2\xa7\xe6I\x16\n\xac\x91\xb6δ\xfa\x0e\x9aU\xea\xe0FUk~f\xb152^V>űͥ\x16Du]6r\xaf\xb1\xc5\xfe\x87\xe0\x11\x10\xcbT`[\x1b\xfbƼ\xae\xe3n%\x14\xc5\xee-\x8b\x1d\x88۹\x9bRTdl\xc1\x0em\xdf;\x84\n\b\xc2t4\x12\xb0h\xa1I=\x18p\x97\x01\x1f\xaa\x19:\xcd\xdd\xc1,\x92\x10Z\xbf}8\r\xb4hw\x03;y\x17lv \x84gݧh\xba\x05\xf0\xc8\xc2=\x8b;\x8c\u007foq)\xa0I\xdd+L&\t\xef4 \\\x1b+rg0K\xa69\xf3\xb3\x1a\xbe\x10\xf5\x99Q'\xae\xc4(\xe2\x14=Ob\"\x8ca\xe4\xf7\x91\x05\x94\xae\xd1\a\x00\xfcI\xbd3ٕ$\f\xcd);\xe1j\x92\xadI\x115\xa9Y&\x1e\x8aV\xe5\xb5:\x1bZ3\x1b\xe3'\x93\x9c+&\xb4g\x03\x83\xe0܀->\x9c2\x84\xc5ץ\xc5t\xf6\x16\x1b\xb6ߖRS~\x11Δ+U\x1b\"\x94ѵ\xdf\xd8TJ\xaaq\xec\xe1|\xec\x83c`\x16z\xe0\xbd/\xa4leu\x98.a\xce\xd5Dq\xd4\xc0\x1a*\x87\xc8\xffèV\x81z\x8dv\x9bL\xa9$!l\xed\x81\xde\x1c\xb0쬧ŗ\xf0\xf2\x91<\x83q\xbb\x94\x02I$\u0379\x81\x83D?}\aN\x999\xcc\xf6\x94\xd0\x04\xce\\uwEwz\x17)CF\x83||P\xc9F\xb4Ǵ\x1c\xa7\xfaШ\x94#\x8b#j0X3\xa8\x9cкD\xf6\x15\xa1鍽\xabK\xfc\xddā\xff˚\x99\u007f1\rjÂ\xae\x912E\xbda(Y\x16\x97b\x10S$]\xfc\xdeҒ\xac\xefvd\x80\xb1[\x00\xbd\xe4N`\xb1\xa3^xQII\x87\xf0?\x1c,\x15\xfe}\xe0rz\xe1\xfdh\xfd\r\x0f=\xaf\xc1@\x8e\x14HX\xebx\xb0\xd8c+\xa2\xfe\bQٯ>⟾B]X*\xc5=ӥ[\v\xf0\xbd?\x03(\xbe\xee\x9c\xc2\x04fEc\x06I\x99\xe9λƿ\x95q\x91\xb6\xb2\x8d~\x93\x15 P3\xea\x1f\xb4\xb1\xe4\x1f\xca\xc3{=\xe7Q\xcb9!\x10>D\xca\xd1\x03\xabi\x93\x95[+\xc7\n\x83\x19\x0fM\xb5q\fڂ.\xbf\u007f{\x15\xaa\xad\xba:\x8do\x84kV\b%o\xd6\b\xc1\x03\xa2$\x1a\x0fR\xae]H{X\b\xc3D\xac\xd7y!\x1c%:#b\xca\xe9\x13E\x91\x8a\x9f\xf50\xf6\xc3S\x01|\u007f\xaf\x17%\x1a\xb5\xde3\xd5\r\x163\x82N\x91.xI\xf0f\x14\xb5\xc1erd\xa4\x9b1tI6\x95҉I\xcb+\x03\x1e+\xf8)\a\xa6\xe78\xa5Z\x91\u007f\xd0\x03\x8frJ\x17xِ\x10zɹ\xb4E\x8d\x0f\xef\xc67\xebB}\x1f\x04\x05ݬY\xf4\x05\xe9\xfa!\xbf\n8\x02J1\n\xcaHCX\xadI\xfdJt\xf6\xf4\xb4S[C?簃\xf1(j\x8d\xe5\xb5\xce\x18O3\x1a>l\tk\x9f\xe8\x89\xc8Q\xda40H&Z\xeb35\xbc2\xe1\xf2\xeb#\x87\x01\xe9,\xe4\v\x1a+XQ\xd6\x1f\xb8\x17@\x0f\xcb\xeb\xe4\xcf#\x89\a'I\xd0\x12\xdbҾ\xe6T\xb9\b\xc6\xf2h\xf1\xbbL\x9f\xaaK\xf0\x89\x80\x1bPʋ&tړ@\xd2 \xd3\xd1\f\xf0b\x1d\xb7[\x02uL\xd1\xf20\xb7\xe1ka\xa0\xc4\xe5Ԏ\xf7\x9c\x11\xfd\xe1ٖ\x91@\xc1\xdc\xe2\n?\x8a\xe5\xf2PEG\b\xed\xdb\x13\xf3aD\xe6\xb4C\u061d%ҳWb\xa1\x17MX{\xb0\xdfs#\xda\xe2\x05լ\x00Ah\xdf\x1f\xbf9I\xa0\x91\xebzW\x8b\xd69\xfe\xdbC\xd2K\xdebkLH\xab\xbc\xd6\xf7=N;\x9dۦ\xffS\xfb\xb1\xb61\x8b\xdb\x02\x02q|c\xf0\xabmc\xadbRj\x9a}\xb9\fO^\x15\xea\b\x89\xeb\xf1\x15\x92Š:9#\xbb\xdf\xc76*\x15\x17\x02\x1f\xf7u\x86W\x95h\xb0u\xd1a\xc64\xf6\xee<\xd0\xdf\xf6\x02\x18\aN\xc8t,\xa6\x85\xc3\xff5G\x12\x874v\xb3\xe1\x98\xdeA\xd9C\xfe\xeaj\x97ۄ\rܠ\xb4I\xbf\xc1\x8a(\xab\x12\xa0\xb8\x0f\x99l٬\x12\xd4MW\x13\n'\x8f\x89\xd5\r\xb6\r\x80Y3\x88oW\a0\xb2\xb9\x84\x89.i$\xa8\xb8\xa2\x85Z#\xbb\x12\xbaS\a1(\xb4q\x96\xb9P3i8\x17;\xf0\xbc\t\x82\xe3\xd7C\xaba\x80\xe4\xbd\x16\x16\xd7\xf0\xf7\xd6Ig\xfaVZeܤ\xe4fD;xA\xd7[\xfdnʱ\x1fۦ\xc1\x94OE\x19E\x03\x0f\x9e78\x13&\xae\x8c\xc6%\xb2)\xfaK\xbeL\xb8\xc4\xcb\xf5\xb0\xa3W\n\xddG\xa3\xf6\x82Z\t.\xcek\x14\xc0\xecA\xf45I\tg\x16\xe0r\x86\xb0\x80\x17 [\xd9\xeb\xe84\xd3\xe9M\x9ep\xa2_\x92Ը\x82[\xd3É4P\xf3\x81\x14cY\x11\x9d-SR\xb55\x9b\xc7K\xe5#tg\xb6\xa3# \a\x02\x02K,\x81\xd4R?\\\xef\xb0(\x84\xa9\xa1\u2063t\xfe\x95.9L\x9a\xd1g\x0fr\x18m\xdb\xd2? D\xcdf\xa9\r\xa0\x98=\xdb2r\xf9\xe2HX\xb0\x88\xc8\x13]\xeap\xe3\x8d%\xad\xa0\x1dL\xcc;\xfa\xe8\x1d\x99\xe7\x06<\x98\nɻN1\xac{I@\x1b\xd3\x12\xbf\x91b\x8b\x11\xea\xfbt.˄9x\xf3_\xb9\x1a!\xfc\x86ƙ%\xdb\xca\x1cg0@I\xd518\xc4\u007f\v\x99!/=\xf5\x1d\x938\xc0c\xf3]\xaf\x93\xb6\xcb\x01`\x92i\xf4\xb6\x92\xaf\x9b\xd6X\xc5~\xddp\xfc\x10\xd8U\x11\x87\x87O\x9a\xe1+\xe4B\xcco\xfeX1\xe2q*{\xac|\xa3\xf4\xe0\xabDܬ\xbf\x84,\xec=\x9f\xf4)$'\x84A[\xb8\x98\x8c\ar<\xfe\t?\a\x8e\xf3=g\va\x19{\u007f\xfd\xa2\x19Fѳ\xd7=e\x86\x81\xcc\xf9\xd6\xd3n;\a!\x03K\x1b\xd3\x0eS\xa6\x17\x8bp\x99\x91\xa2\xf6Z\xa3*\xben-?\xf3\xf6\xda$`\xfcFV\xe7.\\ANI0Px\xedY$5c\x8c;\x05(\x9b\"#\xdc_\xf0\xe6\x9bM1\v<\x01\x0f\xfc\xe7\xaf\xc6;cJ\xfc\v\x9fN\x040u^\x02\xf8\x1a>\xc2d\xbe\xa2>\x83+,͖\xe03i\n\x02\xc9\"\xf6\xad6#SR̡\xe4\xfd\x82${d\xa7\xd3\xed5\xd8^\a\xc0a\xf1\xd7A\x81\xf1f\xb9>*9\xe4G\x91\xdc|^\xa0_\xbf\xfc\xe0\xec\xfeJ\xa2\xf8\xf9A\xe5O^\xde\x0f\xddȿq\xf4\xfbьx\x8b\x10bC~\x96-|2\xc5\xe1g@k8\xd7\xf3\x14N\x13Mk\xa6d\x99a\xb7\xd1i'ڲ`/\xff\x01\xc19We\xbe\\\x88\xaa\v9{\xa6i\x96\x12\xd8Cp\xf9\xeb]\xff\xd7>l\xb4\xa5uMf^V\xce\xe6\xa1|\xb4\t\xff\xbe+\xc5u\xfcɠ`\x97y\xa6\xa8\x98rҥAո\x17\xc5\x1f\x81\x9f\xce\xe0ox\xf1\xc0\xf1\x87\xf0)l\xc95\xa1\xfe^}\xa6\xa1\x9c\xa9Ƭ\x15x\x8e\x92A\x93:\x06]w\xc8͔\xa0=\xd7]+\x94\xfbm\x87\xed\xf2\xd3\xed\xa5\xc6r\xd7B~E\x8f\xe3~n\xae^є\xe6\xcc,\x86|\xe7\x8a.\x84\x99\x10\xfd\xafS\xac`1\xb3dfѱ9\x8f79ԭ\xaf\xbak\x10w-'dD\xb3\x93\xe8$\x05!\x9c\x06\xa8Wb\xfe\x17\xd4W\u007f}\xef\xcdq:\\q\xc1\xaf\t\xe0\x18\x15d\xe4\\\xba\xb7s\xfe[\x93\x15L\xf6ٗ\x14K\x1c6\xb4\x11D|\xf9~\xd0\x05\xdf\xe6^\xb6\xcbGnk:\x10*W#Ll\xe2V\x1bALw\xa6\x15\x18\x13\xc7\xd7x\xe2\xea\x97\xfa\xa8\x9e3\\;\x89BY\xf3+\"\x05N)\xffs\xf8\xbf;o\xdbGI\xc0\x0fB\x96\x8c\x9b\x15\x06\xf3\x9d\xcbX\ueaeć^\xd9\x02V\xb0.\xc4R\x17.\x90/\u0382{$\xf2\xe9\xd9\x04r\xe1hZ\x03*\xfaM>\xa8z\xf9_\x01?\x95G\xb6AQ\x10.\xd8\xf4\xac#\x89\xbd\xa7\xc2\x1a\xd0\xf0\xe2\xe6\xb8\xdb\xf8A+\xfc\xe2s\xe5L\xf7W\xf3\x19\xe8\xe2P:\xea8\x81\xf8\xc7L\x882\x9eC\xed\xc9~\x80p\v\x88\xa5\xc5\a\f\xbat.\xfc\xe4%q[\x19b9r\x87\xdf=\xf8So\xd7\xec\x1a\xe0\xa7vwkq\x1f\xa0\x19\xbc\x97\xa2\xd2<\x12\xea\xae\xfcQr\x8fi\xccE\xa6\xb8\r\xf7\x01\x9815k\xd0\x04\x91t\x1b\xfb\xc58}uz=\x80p\xa9/\"\xc5\xe6\xfd\x16r\x8b\x98X\xb5\xb5\t\xaa\xa8\xd9\x05|\xc6u$+\xae\xeb\xb7^\xc1n\xba\xc9\x11IG0W\v1L\xf0\xc3\xef#{DN\xfc\xa2\xf8K\xb5\xd6d\x16\xce\u0097p\xbe߁\xc9bl\xbfy\xff\xd0M\xd43\xd3r\xa6\xb0\x9d@(q\x1e\f$f\xc9\x18\xd7f,\x94T\f\x97V\x88Gc}P\xaeq\x82m?\x04i\x80\xb4{\xe3\xc7cJ\x12\xa9\x19\xaaUH]73\xd9\x1f\xf5\"\x1b\xd4%N\xde$\x8a\xe0\xa3\x00_\xe7O\r\xba>\xbf\x05\xf5\xed\x83?Ԙ\xdcG\x13'\x93V\xf8\xd4\x04\x02\xf5\xd2ma\xab\x14+W\xe2!\x82\xfb\xaa\xda\xc6x\xb4y\x9a\xefb\xde\xd0H'\xff\x02\xe7&\xa85^\xcbU\x86-\xfd\"[mpJ9\xb9\xa5\xac1v7\xd1m\x19\xcet\x9f@\x01F\xa6\xa2:\x1aoU~__\x1aR(\xcfm&\xde1Ϝ=\xc5wc\xb2\xeb\x02\xb3\x95CK\x19\x13ثcl\x81j\xf1J\x95\xc0k\x98\x04\xc5'\x92h\x91&G\x1b2\xc1y\x83\x06\xdf[\x93g\xa1 K\xd8nD\x80\x8bq}C%\xbb\x11t{w\x06\x9c\xa8Tnr\x15}\xf58+\xb4\xb2\xdc\xd3|EU~\xe7\xed\xee\xd2qhP@\xfddn\x14l2\x00\xc5N\x90\x8f\x87\xd5\xe0\f&3*k\v\xf1\x03\\\xc0EC\xbc\x1b\x14\xa2\x93{\xbc\xf9:\xdeL\x9dZ\xef\xa6P\x05\xf1^\xdetZ\xcb \x01\x11\xe2\xcfe\xba\xa4[\x88h`\xf5\x9e\xfb/\xa3\xcf\xc2\xcc>\xd5\xf5\xcf\xcd)\xc1Ή\xaaa\xf9\x12\xcf\xf7\xe9\x02\xadz\xf7ٿeSYݾ\xe3Bx\xd5\f5?\xd1\x1c\xfa%\x1bv\x02ö\x1a\xcb\xef\x19\x8aR\xd9b\xb5\xce{}\xefs\xc7g\xdb-\xb5\xa5\x17\xd3o\xe5\xe7\x9b~\xf5\xa1e\xe1\xa42\xc1\x023\xa1\xeb(?\x95\xaf\u007f\x9d\x96K\x18Չ\f\xd58\xcdI\xff7i.\x11\x87\xc0\xd9\u07bd\x96\xf3\xb5O\x1d\x8c\xc1\xbfx\r\xa3E\x91\xdd\"e'ց\xb6\x90\xa3\xa1S<\x13\x8ce\xb7>A\x11\xc9\xebY\xa9<\xb3\x91QN\x9b(Dw\x83)\x14~\xe4Xe\x93)\b\xb0 \x1b\x86\xec/\xa2\xb3\x90\xadBP\x99\xc9i\x02b?\x1bv\x03\xa9*@\xf4\x15\xaa\x10e\xec\x8e;\x064E+,3&5A\xd8\xcf&@\xf6\x0e\xd0j >\x80l\xa5\x00\x0ee\xfa\"*\xe8٤\xec\xd4(\xca\xf0\xa8\\\\\xbcO\xadU9\xab\xeb^Jq\xad\x03)5\x8f\xe8\xbd\x18\xb1\u007f\x01V\xf9\x14\x8a\xbf\x9d\x1f|\xddBŋ\x06\x1d\xd3TN\x89\t\xea\xe4\x14WZ\x96\xda\xf9\xe3A\xf2\x9f=bss.\xa4\x88\x01*9\xc8\xc935uS\xba\t\a\x88\x1cǾ\xa70\xa8\xd0\xf8\xcbv\x93\xeb`(n\xdfS\x97\xf0ոs[\x1d\xd15\x0f\xdbb\x11\xa1\xa6\xc9\xeeQ&x\xba5\x1a\xff6\xeaF\xc7\x0f\xb8s\xca=\xa6D/l6j\xb4\xa4\xc3\xc4.z\xfb]Bѥ\xfa\xce\xfa\xabR\xd4\xeeǎ\xb5\xbc\x89%]\xa3\xa9~_\xa3\xc8J\xf9]m\x02\xcd\xd9^ň\xd1\xff\xebDW$\xa0\xcd\xe7\xc3\xe5r\x9fv\xedJ\xf9\xf5\x8f\xfcFu\xf0/\xac\xef\xd0\xea\x13u=\xb2\xb3\x9aY\xc2q%\xebΊ\xa5օ\xf8\x96\x87\xc0\x8ar\xd9\x12ѸVY\x1a\xf1\b\xaf8\x1dw\x10\xb2l\xb8\xbc\x81)]\x1f\xd7\xcawo\x0e\xfc\xe3\xe1hw\xa0W\x17\xd3\x05_\x1f}\x12'vr\xbdo\x0f\xa0\xe7\xfa\x90\xc7\xee\x9c2\x92mIL\x0e\xd4Q\x00\xa6\xb4!\x94\x8a\xfcr)\xfautY\x9a\xb5\xb1q\xaf\xdb1$\x18\u007f\u007f=bP\x12HX\xd4\x04\xf0\xce\t\xd7?\xe3\xc67F\xc8I\xca\x15b\xa4}\xe1'@B\x9c\xfe\xf2\xf4\xc92\xeaV\xf8\xcd\xc8\x11\xa1\x9eB\xf9\xf8z\xf7\x96\x00\xe1;\xe1\xd7\xf2\x9f\x874\u007fO\xf97\xcb\xc1\xaa\xeeCtձ\xd7BB\xbc\x15\U000ab94c\xfeu\x89\xe3)\xb2w/\xdf\xcfe\xb7\xd5i\xffq\xb0&x\xeaƃ\xf0j\xda\xc2\x19\xf7\xeb\x98\xc8x\x19\xfa2f\xb1\x80\xd1LY\xde#\x97k\xbc\x1e'c\x11\xfb۪$+\xebҦs3\x1dw\x1e\xef\x9a\xef;\xc5\xcaD9\x11\xbb\xb3<J\x1d\xd0\x06\xe4\xf5GU\xcdwՐ\xd5\n\x94/*\xf8\x81\xe7\x9a\n\xf1\rL\xfd\xfasAk\xd9P\x81\xffU\xce\xe3\xda\xca\x0e\xb5\xc0T.j{\xdf\xc3Ι\xfc\xbc\xc3\xc3\xd9\xca\xd1\xc0a\x0f\x8c4\x1a\r\xa5n\x99\x9d\u007fC\xafWٚ\x1aņ\x83\x9d\xb9]\xb2\xf5\x91\x12\x03\x15\x9f\xb0N\xe8\xedu\xb6\x88\x84\x18\xb1\x87֑%\xe1<\xb8\x918\x1d{h\xf2\b\x04\x9d{f,\x8e\xc41\x8d5M\\\xb0n~\x1b\x87ppT\x00\xb8T\xff\xac\xed\xc7\xe6Tv\x06\xec\xae*\xa72\xcf\x10l\x12\xf3GM6\x14`\xf6\xaf\x95u\xb8%\x13n\xcb\xd83\x18͋3TQ㐥&v\x93\x9f\x8b\xee\xc6\x01\xfd\x9b,q\xbd(\xe8`\x8cK\xf9\x83t\xa7L(39;(\xd51\xc7c{\bh\vf\x1f\xa2'\x9c}x\x9b\xfe\xea\v\x03b\x98E\xc6\r\xc5:\xe0Nvv\x9ek\xe1\n\xd9\x01\xfc\x04\xd0\xc1/(\xa5\xf5\xfe^F\u007f\xa3\x97He\xb9A\xe4QE\xa81\x17\xfa\xb4\xc2_\x16N\xd3Ǳ\xad\x95\xb7r&V\xb5\xfaՒ\xef/#\x8fU\x9d\x99\t\x16ɩ\xf0o\xa5\xf9\x1aI7\x9dح\xd0|\x929\x99}\xc9\xc5\xe1nO\xc1\x96k\x92~p \xf55[\x06\xe8\x10]\xc3x\xb0-P\x98\xfb\x99\x9c`@\x05υ\xdcه\xcc;q42\xd8|\xb0\v`\x838l[\xd9\nl\xab\x9d\xae\xe8S\xd5\xda\x11?\xfbT\x8dvbe>\x9c\x99\xb1;\xf4#D\xdbQ\x86s\xe3Y>\xd1g\xe5-\u007f\fj\xa8\xaa\x19\xf2\x94]\x14r~\xfa\xa4\xa2I\xba(\xa6\xe3\uf79dn\xbc\f\xee\xce6ǳU\xbeC\xe8\xec\x11\xb0\xd5\xc4#\xcaر9\x16@\xf6375231\x00\x00375231\x00\x00375231\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\x00\x04\x00\x00\x00R98\x00\x02\x00\a\x00\x04\x00\x00\x000100\x00\x00\x00\x00\v\x00\x00\x00\x01\x00\x04\x00\x00\x00\x02\x02\x00\x00\x01\x00\x02\x00\x02\x00\x00\x00N\x00\x00\x00\x02\x00\x05\x00\x03\x00\x00\x00\xa6N\x00\x00\x03\x00\x02\x00\x02\x00\x00\x00W\x00\x00\x00\x04\x00\x05\x00\x03\x00\x00\x00\xbeN\x00\x00\x05\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x06\x00\x05\x00\x01\x00\x00\x00\xd6N\x00\x00\a\x00\x05\x00\x03\x00\x00\x00\xdeN\x00\x00\v\x00\x05\x00\x01\x00\x00\x00\xf6N\x00\x00\x1b\x00\a\x00\r\x00\x00\x00\xfeN\x00\x00\x1d\x00\x02\x00\v\x00\x00\x00\fO\x00\x00\x00\x00\x00\x00%\x00\x00\x00\x01\x00\x00\x00/\x00\x00\x00\x01\x00\x00\x00\x91\x01\x00\x00d\x00\x00\x00z\x00\x00\x00\x01\x00\x00\x00\x1a\x00\x00\x00\x01\x00\x00\x00\xc3\x0f\x00\x00d\x00\x00\x00\xa4\v\x00\x00d\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x002\x00\x00\x00\x01\x00\x00\x00\x16\x00\x00\x00\x01\x00\x00\x00\xbbi\x00\x00\xe8\x03\x00\x00ASCII\x00\x00\x00fused\x002020:04:05\x00\x00\xff\xe1\x0e\xcahttp://ns.adobe.com/xap/1.0/\x00<?xpacket begin='\ufeff' id='W5M0MpCehiHzreSzNTczkc9d'?>\n<x:xmpmeta xmlns:x='adobe:ns:meta/'>\n<rdf:RDF xmlns:rdf='http://www.w3.org/1999/02/22-rdf-syntax-ns#'>\n\n <rdf:Description xmlns:exif='http://ns.adobe.com/exif/1.0/'>\n  <exif:ImageWidth>4032</exif:ImageWidth>\n  <exif:ImageLength>3024</exif:ImageLength>\n  <exif:Make>Google</exif:Make>\n  <exif:Model>Pixel 3</exif:Model>\n  <exif:Orientation>Top-left</exif:Orientation>\n  <exif:XResolution>72</exif:XResolution>\n  <exif:YResolution>72</exif:YResolution>\n  <exif:ResolutionUnit>Inch</exif:ResolutionUnit>\n  <exif:Software>HDR+ 1.0.300173574zdh</exif:Software>\n  <exif:DateTime>2020:04:04 17:50:22</exif:DateTime>\n  <exif:YCbCrPositioning>Centered</exif:YCbCrPositioning>\n  <exif:ExposureTime>1/24 sec.</exif:ExposureTime>\n  <exif:FNumber>f/1.8</exif:FNumber>\n  <exif:ExposureProgram>Normal program</exif:ExposureProgram>\n  <exif:ISOSpeedRatings>\n   <rdf:Seq>\n    <rdf:li>259</rdf:li>\n   </rdf:Seq>\n  </exif:ISOSpeedRatings>\n  <exif:ExifVersion>Unknown Exif Version</exif:ExifVersion>\n  <exif:DateTimeOriginal>2020:04:04 17:50:22</exif:DateTimeOriginal>\n  <exif:DateTimeDigitized>2020:04:04 17:50:22</exif:DateTimeDigitized>\n  <exif:ComponentsConfiguration>\n   <rdf:Seq>\n    <rdf:li>Y Cb Cr -</rdf:li>\n   </rdf:Seq>\n  </exif:ComponentsConfiguration>\n  <exif:ShutterSpeedValue>4.58 EV (1/23 sec.)</exif:ShutterSpeedValue>\n  <exif:ApertureValue>1.70 EV (f/1.8)</exif:ApertureValue>\n  <exif:BrightnessValue>-0.09 EV (3.22 cd/m^2)</exif:BrightnessValue>\n  <exif:ExposureBiasValue>0.00 EV</exif:ExposureBiasValue>\n  <exif:MaxApertureValue>1.70 EV (f/1.8)</exif:MaxApertureValue>\n  <exif:SubjectDistance>0.1 m</exif:SubjectDistance>\n  <exif:MeteringMode>Center-weighted average</exif:MeteringMode>\n  <exif:Flash rdf:parseType='Resource'>\n  </exif:Flash>\n  <exif:FocalLength>4.4 mm</exif:FocalLength>\n  <exif:MakerNote>19150 bytes undefined data</exif:MakerNote>\n  <exif:SubsecTime>375231</exif:SubsecTime>\n  <exif:SubSecTimeOriginal>375231</exif:SubSecTimeOriginal>\n  <exif:SubSecTimeDigitized>375231</exif:SubSecTimeDigitized>\n  <exif:FlashPixVersion>FlashPix Version 1.0</exif:FlashPixVersion>\n  <exif:ColorSpace>sRGB</exif:ColorSpace>\n  <exif:PixelXDimension>4032</exif:PixelXDimension>\n  <exif:PixelYDimension>3024</exif:PixelYDimension>\n  <exif:SensingMethod>One-chip color area sensor</exif:SensingMethod>\n  <exif:SceneType>Directly photographed</exif:SceneType>\n  <exif:CustomRendered>Custom process</exif:CustomRendered>\n  <exif:ExposureMode>Auto exposure</exif:ExposureMode>\n  <exif:WhiteBalance>Auto white balance</exif:WhiteBalance>\n  <exif:DigitalZoomRatio> 0</exif:DigitalZoomRatio>\n  <exif:FocalLengthIn35mmFilm>27</exif:FocalLengthIn35mmFilm>\n  <exif:SceneCaptureType>Standard</exif:SceneCaptureType>\n  <exif:Contrast>Normal</exif:Contrast>\n  <exif:Saturation>Normal</exif:Saturation>\n  <exif:Sharpness>Normal</exif:Sharpness>\n  <exif:SubjectDistanceRange>Macro</exif:SubjectDistanceRange>\n  <exif:GPSVersionID>2.2.0.0</exif:GPSVersionID>\n  <exif:InteroperabilityIndex>N</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>37, 47, 4.01</exif:InteroperabilityVersion>\n  <exif:GPSLongitudeRef>W</exif:GPSLongitudeRef>\n  <exif:GPSLongitude>122, 26, 40.35</exif:GPSLongitude>\n  <exif:GPSAltitudeRef>Sea level</exif:GPSAltitudeRef>\n  <exif:GPSAltitude>29.80</exif:GPSAltitude>\n  <exif:GPSTimeStamp>00:50:22.00</exif:GPSTimeStamp>\n  <exif:GPSDOP>27.067</exif:GPSDOP>\n  <exif:GPSProcessingMethod>13 bytes undefined data</exif:GPSProcessingMethod>\n  <exif:GPSDateStamp>2020:04:05</exif:GPSDateStamp>\n  <exif:InteroperabilityIndex>R98</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>0100</exif:InteroperabilityVersion>\n </rdf:Description>\n\n</rdf:RDF>\n</x:xmpmeta>\n<?xpacket end='r'?>\n\xff\xdb\x00C\x00\b\x06\x06\a\x06\x05\b\a\a\a\t\t\b\n\f\x14\r\f\v\v\f\x19\x12\x13\x0f\x14\x1d\x1a\x1f\x1e\x1d\x1a\x1c\x1c $.' \",#\x1c\x1c(7),01444\x1f'9=82<.342\xff\xdb\x00C\x01\t\t\t\f\v\f\x18\r\r\x182!\x1c!22222222222222222222222222222222222222222222222222\xff\xc2\x00\x11\b\x01V\x01\xc8\x03\x01\x11\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00\x1b\x00\x00\x02\x03\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x03\x00\x01\x04\x05\x06\a\xff\xc4\x00\x18\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\xff\xda\x00\f\x03\x01\x00\x02\x10\x03\x10\x00\x00\x01\xe9\xf6\xe6e\xc1%\x14\xa2Y\x01\x81\x04P\x98:TR\xd9\x02\x16\bD\x17\v(\x11+\x97:\xc94\xb9jZ\x8a,\x85\x10\x8b\bI-aD\xaa,\xb2\x12un==^|վ*\x15\xa9\xed\xbd\x9e; \x04,\xa0C\x02\x14)i!Qj\xa2\xc2\x16QE\x82P\xa2E\x82gTM&UK@\xc5,\x89C\x04E\xa2ˁ\b\x8a6B\xca\f\x84*R\x96\x8fc\xe9\xf36\xc2*!A\x10\xa3*\xd9Q(d\x8ab\xc1\f\x11a\x00Qb\x8a\x8a\xa5\xcafU\x01r\x88\x12Ȁ\x94\xb0\x85K\t\x00\xa4\t\"U\x90\xa2\xc2SC(\xf5=\xb86\xa8\x11\x85\xa1\x8b\x00D\xa4$\xd2(\x02\x8a!d\x04\xb0!d(\xa0U$\x04\xa5X\x12\xc0 \x14\x81\x06-l\xedr铧>r\x91E\x82Qqd\xa3\f\xb4u\x9d\xbe\x9c\xd8B\x88Q\v(\x02\x14\te\x8b,Qc\x01\x17\x10e&\x00\x05\xb2\x14,\xa1k@@\xa8\x8c\x8a*XjVy}\n\xed\xcb\x1f^f\x04XuQ\x02\xaa\x86Q#\x06֭a\xca \fK\x16\x12\x82\x18X\xd1cH\xebΨ\xcc\xf0\xa0ũ%\x84QDZ\x06X\\\xb6T\xa0\xb2\x04\xa9L\x83\x06\xcaر\xd5\xcc\xf3\xf7\xd1߉u\xe7ɰ\xc6\v,\x84\t\b\xa0\x824X\xea \x83A\\PB\x86\x99\xb9u\xcbǯG\xd1\xe7\xdd\xd3.\x8cr䔍\xd6v\xae[5\x9a\\\x11%\xcd6\xbb\x9c\xf6YdR,(\xb5\xea\\p\xf3\xa6\xac$E\"\x8eg\x9f\xbfK\xbf\a\xeb8\xb50k$\x10C\bQ\x02(\xa5\xd9se\x02Pf`\xc36Y\xa3\x8fo/ǯ\u007f\xb7.\xff\x00LhNt\xb8\xec;6\x9b%`dA\x95\x15\x96o<\xdek\xc8f\xaa\x16\"Z\x85.\xa8E\x94Q\b\xb2\x17\x8d&k\u007f^Wc\x12[\xc9\xde\x04\x83\b\tcJZ\x8dz\x84,\x12\"\x85\x960\xea\x0e\x97\xcd\xf9=Z\xbar\xee\xfa8iκ\x069Q\x8e\xb8'M\xfdy:4I˅\xeas\xb8\xfa\v;ˮ5p\xda*m\x8e\xcd\xcff*\x85U\xa1\xe3e\x8dlɂ&\xb5k.\xb1\x1d0\xade\xa9\xa9\xae&\xf3\x95\ta\x11\x85\x16Z\x9a2\x80\x10H\x01\x03Oe\xbct\xb1\xbf1ñtϪ\xb8\xa5\x87\x8bθ\x16l\xd6~\x8f\x01/\x0f\x97^>\xb1\xd3\xdex<=\x01\xaeuDi\xe7\xb0\xde\x06\xcd\x1cz\xe3\xd6p\xfax\xadTH\xd15\xb7\x96\xf4\xe2\xe9\xa5\xcaE\xeb:.G\xa6s\xdc\xd9S|\xed\xe5\x16ZB\x10i\x01SH-M \x90N\x96\xa7\xb3\xb9\xdbϤ\x12HI\xb2\xbc\x8c\xbeSQ\x96}J9\xb8ה\xf2zs\xeaj\xeb\xcd\xeb\x9f7jsu\x02[\x1b\xa8\xacޥ\x9c\x1d\xe36\xe5\x16\x04\xbdN{>z Ԑ\xacf\xb2\xdb/R\xce*\xbdkXɬ\xd8bƔ\x12\xd1E\x80\b\xb1Ǭ\xae̚e\x86<\xdf1cu=t\xbey|\x9as\xac\xfa\xb8r\xf9.=P\xa5\xbc&\xc3ư\xeb8\xf3\xadyэ\xde\v\x1b\xeb\xf6㋟N\x1e\xb2\x91U\xd0Ν\x8do\xd4u\xce<\xe9Y\xad\x19\xac\xb2\xc8,T\xd7?y1\xd6f\xde@\xa8e\ta\x90\x01`\x16Q\xf4j|h\x88q1\xaf\x13gS\xa6}\xeeo\x1d\xaf\x03&z\xfaf\xb3\xba^n5\xe3\xb8{+\\[\xbeg\x9a\x8e{\xbdg=;:\xe9\x19\x13W\\h\xcdNu\xcd\xde5ܢ9mnNuz\xadNF4\xbc\xd6U%\vS\x95;\xc2\xf7\x96\xca\xd5Ϭ\tc\x80\x1bK\x04\x01A\x9b+\xe83\\V{\xd1K\xe5\xf9\xeb\xca\xc9\xd7\xeb\x9fY\x8dy\xfd<\xe5\x94u.z7%˶N=\x1f\x9b\x9a\x85uY\xcc\x15\xac\x98\xf3D\xa0tu\x9cY\xd3z\xf2Fu̸\xe35\xa4\xab7/\xa3\xb9\xf22\xa1s\xa0ӆ\x9a%\u05ec\xadE:9\xd9ܔf\xdeT\rY`D.\xdd\x11\xa6\x93rjh<\xbay\xe9=N\xa4\xf3\xf6g\xa7\x87\x98\xa7\x1d\r`3\xad8\xdfC\x9fL\xb6Itr\xdd\xd72\xc5\xd8ã(u\xe71\xac\x19\xd6\xc9S\xbc?\xa6<\xf6q\xccޤ\xbd\x11'j͝1\xc1\xe5ն\x1a\x10\x9alh\xce\xd0'\xa64\xc3\xf8\xf5\x0e{\x0e\xbcÿ!\x16\x10\xc4\x05\x03iv.\xac\xebY\xc7\xf3\xf5\xe6Y\xeb.x<\xf5\xec{s\xe2\xda\xeb5\\><\xff\x00/GG\xcdו\xd3;l˝H\x86]d\x97Y\xb0\xb9e\x9c\xa9\xad\x9b\xc7/%k\x1cݤ\xb1:\x8bͳ\xd1\xef6s\xb1\xba\xb9E1\x0e\u0097L\xa1Ϫ5\x9a\xe5ӟ\xd3=k\x94u\xe6D(X#MP\xbd\x1e\x83^\x9e\xe5\xbcz\xf9~]\x1fӀ\xb5\xec\xf7\x9eUs\xf55\xdc퓋\xcfy<ޤY\xac\x01\x90\xaaV\xb3c\x10\x94,)q\xe7z\xb7\xcf2h\x976\xa7/\x1a\xcbӎ\xce}YמU\xf5\x9d3\xcdΰʽe\xb1\xacl3\x1b\\\xae\x9a\xe2Qo\x1d\x0e\x98\xc4\x10\xb2\x06Q\xaa\x06\xa8]>\xcfuy\x965\xf3\x8c\xf4מz\xa7n\x9e\xb1\xcc\xe7\xbc\x12\x04;y\xe9t\xe6\xae\x1e\xbei\xd3A\xb3^\xf3\x8aTEjh\x83\x95\x99\xd6\xdb9y\xd5\xf4\xe66j\x9a\xa9y\x18\xe5\x92\xe0:S\xcf^\x8d\xb9\xec#\x12\xd8\x02\x80\xb2\xa9ѳ\x9fFY\xd6Gz8q3\xa0\x1bH\x1e,ߛ\xba\xb9\xc6k\n\xbd\x97N'\x1e\x0f\x8fm\x18\x9a'W\xef\x9f'\x9645\xd1\xcf[\xd6Q\xbez\xf9\xfa9\xd9\xd7GY皺c4r\xb5\x9a\xb3Vu\xd9\xce\xf5f\x84\xbcz_NX\xcd\x18\xd8\x19\x9c\x89\x8a-ެZ9\xa1\xd1\xd6iW\xc3\xebP\xe4й1\xa2F\xf5\xe5\xe4\xed\xa2\x06\x18'\xa1\xf1z\x15\xa8\x9fG\x1ef\xe1V\x8d\xe0\x04\xf1\xd1\xe4\xdc\xf5\x9d9\x0f<\xb7;d\xd1ي\xe7\\\xe9\x8eo\xa4\x89\xb3\x15\xce]\xc4\\\x99P)\xa3\x9f]S|ޜѼ\xb9@\xe8\xe3N\xcc\xe8XZ\xa6\x9a\xad\xe4o\x1d1\x98\xe86f\\\x99\xb9\xb5\x95˞3*\xecѾlQ,\xa2\x87\x83\x19y\xf4ż\xf78\xf4gn\x19\xb5{\xb2\xb10Ȉ\xce\xd9\xdc\x1f8\x84\u05cd\xa91\xef74\xab\xa0\xb9\xaa\x88$M\xf3F\xd33uM견\xb5-\xe7=\x81aK\xd32\xada\x8e\xcc\xf3Y\xac\xe7Z\x9b\x04\xa8\x1b\x1f\x9dT\xb5[5\x87j\x19\f\xc1\x96C^j\xb9uNl\xde:\xbb\xc6\x1d\x12K\x19\x9c\xec\xe7\xbeV\xda,n5\xa7\x1aϩ56g\x0eTK\xaam\xbd2\xac\xdaα؍M\x99\xd7C\x1aN\xf2\xfc\xdc\xf6p\xfa\xf3E\x9dl\xeb%\x98\xa6[ͧ\x9e\xf3\xf4\xd5\xcc/[V\xa6mB\x00Ҏ\xb1\x18\xe8\xb9GY\u07fcJ\x83%\xc9aˤ\x13Vo:\xc7r\xeb,\xe8\xe6\xf3;\xf2\xb2͙\xce\xfcTn\xbbP\x95\xf0:\x8d\x1f\x17*9\xf4\xb93ي\xe3:fd\nU\xb3\xb3\x1d7r\xf4#y\xe3z8\xaa=\x967f\x19s\xe7:|\xfe\x84\xf5\xf3\xf3;V\xe78\xb7J\x1f\x8e\x98z\xf2|\xc1\xd38z\xf0v\xe4\xfdgN\xf3H\xd5\\\xb4(igNU\x8e\xe7\xbcY\xdfK\\\xf7\xef:\xa9\xb2\xe2\xb1i\xbcU%r\xa2q\x1d7\x83<qk\xa4\xc6m\x81ֶ\xe7m\xceJU\x8c\xd7@\\\xcd3\x11\xb9ߜ\xf6yk44r\xabY\xeby\xbbk\xe5\xd5[\xf1\xa2\xd6J\xa9\xe8\xb9\xcfF6x\x15\xce\x1fO<\xae\x99\xba\xe7^\xf0\xfb K\x9aW*\xd2\x00zx\xe9\x01\x9b\xc0\xe5\xd8\xfarӨ\x1a\x80\x06c\xb9\x11\x9e\xbc\ue715\xd3F1\x9d\x19\xa8\xe5\x8ewn\x853\xd8\xe7\xd1\xfc\xbb\xa6\xb3\xf4\xf0&\xeaLhϩ<\xfdV;^a\x93\x8d\xea\xe3\xd4\xf3\xef\x83\xeb\xc3eE\xcfC\x8b\xa7\xe7\xea\xe9-\x15\xba\x9d\x17\xba\xbdD\xf4\xc2,\xa5>}\f\xd5ә\x04\x11\x9b:\xb0ԀK\xa7\x19\x8cy\xa2j\xe7\xbdZ\x87Z\xb1\x9e\x97=\xf3\xb72\xf4\xc16\xd3=\x9a2.\\\xf3\xcdCK\xba\xb8z\xe6\xb2\xde\xfe\x0e7\\\xf6|Ү\xb9\xdd;\xae\xe4\xd9\xe8y\xf0\xbdUi\xcc\xee\xcd\xd3\x14j縹\xbar\x1a1\xc8*C\aB3\xb3\xc6\xf7k+\xde!\a\x99\xb3\xb8\n\x18\x05\x95E\t\\\xd0R\xf6\xfc\xfe\x8c\x9e\x9f>\xecc\xa7\xc3Ԫ\xcdׇ;Zu\x94\x8c\x86s\xe6\xae|\xf5g\xa2:t\xe6t\xe8\x1a˳\x9d<p6\xabz\xc1\xda2\\\xbb\xc0\xa2\xb5!\v,\xe9\xf3\xeb\x93XϬ\x941tA\x10\xb5tu\xfc\xfe\x85k!߃W\x15\t!sP\x14%1H5!\xabρ:<z\x8fn[\xf8s|\xf4c\xac\xbb\xc3:3\xdc\xe7\xb1i\xda\xf3챕\xdda\xea\xabl\xc1טY\bB\x10\xb2\x17\x10\xb5\x85\x84\x1c\xb0d\xa4\x10cE\xe7]^{\xdc7\xaf3\xd6|\xe1\x023\xcbk`\x86X\xb0\xc1\x11\x19\xc6\xe6\xeb\x87L;ϰ\xba\xcd\xd78;⪁EYU\bB\x10\xb2\xc9\x16E\xa4%9l9i-lvt\xc9l;\x9d\x86\xcb4\xcdhΦ\xb3\x92\xb9\xd1Z\xcalY\x03\x135\n!\x06\b-\x18\xab2KC\x13@9\xb9\xe85\x9c\xfb\xc4!\bB\xe2\xd6\xc8Qe\x85)\xc0\xd5Ŭ\b\xb1\xe3͒\xeb\x86ӡfk3\xd6\x11\x12\x80c\tZ\x11vXB\xe6\xa88U0Q\x06\x10\x88\x8co6\xa3a\xf4\b\xd9p\xef\x9a,\xb5\x84,9a\bXR\xb2\x04\xb2\xa9\xd6k\x87V\xa8ٝ\xe7\xde1ٜ\xa9\xa4F`\xa52\x06\x01E\xd9cU\xe5!\x8a\xb1\x82f\xa0\x02\xd1\xcbe\x80)4\xa8\xe3y\x90l}\x8a\xb1\x16-\rmi\x049`\xe9n+Q\xfa\x9aS`\xeb\x18$\xcf\bT˞\x02iV\x18!\r\x94J, \xcb\x0e\x03R\xa9\x90d\x04\x81&f\x85\rH\xb2Ŕ\x84\xae\x97F6\xeez\xc5\xd3\x1aӑ\xbc\x89\b*\xc6٦͆\xb3F\xa3\xecI\x935\x12\xe51\xca\x12\xd1\v\x19\x16\xa4\x18\xab\nZ\xb0\xe5\x02\x82\x04\xa2\xcd \xd8e\x90X\xc1C\x04-\x94\x19D\f\x10\x13nv\x19\xd7w\xcf\xd7\x1e\xf3\x8b\xb7&\xea34\xf5\x9dr\xbf\xa6\x13\xac\xe62FysK\x9eR\x96\x01d\x18YE\x06Pp\xaal\xa9\xb2\f\x96\x81,\xa2\xcd\x12\xaa±\xe2\xc6\x02\tA\f\x10\xa0\x10\xe9B\xc0\x18\b\xd9t\x1d\x18\u05cdf\x15۞A9\xa92\xd82\x85\x82:ZA\xa3\x86\xad$\xa0(\xb2\xe5\x1b\nP\xb2\x12X\x19@\x10 ,\xb9X\x18A\f\xb1%\x86PBƙU\x82\xc2\x1d*ll\xa9\xb1\x8a\xa4`\x98\x02\x14X\xc5R0\xa2X\xd9F\xca$\xac,PEʛ\x06Ö\x8b\x15e\x0e\x96\x10 @F(\x844m\x1cU\x82\x00B\xa8\xa1\xa2\xe92\x91e\x1ae\xcfe\x92*\xaeT$-b:U\xd9E\x82\x18\xc9Wd\nQ*\xc0,\x04\x8b\b\x8e\x9aU\x90\x1b\x0eP\xb1\x92\xc1\xd2\xe7\xb2\xc1F-\xd3\vG(\xa5\x80Y\x00,r\xe4,X\xf9H\x02\x15a\xcb`˖\xe4\xd4\xe1v\t(\xa2\x83-`\t\x06ʻ\x00|\xa8\xb2\x16Qa\x14\bD(d\xac\x95ZȖ0\v\x1c\xabJ\x18\x10A\x06\xa9K\x04Z\xd8r\xd1e\x00\x8cVJ\x8b\x04\x00\xa0i\xb9\xa3e\x12\xae(\xb162Yce\xcfe\x16B\x10\xb2\x8d\x19\xd0ث\x04\"\xca\x0e[*\xc1\xad\x10\x9b\b0\xc8\x00A\x14\x1a\xe7C\x02i\x84\x04\x84 \xc5Z\b%\x88K-Y\v\xb2\xc2Y\x16.\xca,\xb2\x16P\x14\xd9J\x13a\x10\x80\x96\x05\x97+\xa5\xab!\tVZY\xa6U\xd9\x05\x8c4ʊ\x14\x8aq\x9dh\x81\x10|\xa2.Õ\x17&\xab(\x12$,\xb0\xe5M\x962Z\xaa\x8b\x06\xc3[\x82\x11a\x02\x1c\xab\xb0\x8b\x04\v\x19-\x80\x1865Y\n\xb2\x86\x10j\xd1H\xe9D\x94%\x8b\x94\v\x0e\x06\x8a*\x960\x02\x12(U\x96\xb0\x14b\xb2ZA\x02\u0095\xb2\xe7\xd6h\xb1҅\x8a\x19(Xe\xca\x16\x11p\x15d,\x1b\t]\x12\x81(\xa0\x87\x16\xb6A\x91@U\b\x96\x8d2\xaa˔l]\x87,\x16Dl\xa0.\x8e,\x84\xa0J\x0eU\xd8e\xa9Blt\xb6QE%P\x946T\xd8\xfcв\fYJC\r\x06\x99(\xd9\b9D8YT\xc8!V\u007f\xff\xc4\x00+\x10\x00\x02\x02\x01\x03\x04\x03\x00\x03\x00\x02\x03\x01\x00\x00\x00\x01\x02\x00\x03\x11\x04\x12!\x10\x13\"1\x05\x14A #23B\x15$04\xff\xda\x00\b\x01\x01\x00\x01\x05\x02\xfe\x1f\xad\x9e\x86bg\xa1\x84\xcf\xd8Vbc\x1f\xc7\xf3\xf0\xc3\xd0\xc2#$+6\xcc\u007f\xf0\xc7Lu\xc4\xc7\xf0\xc4\xe4Ad\xefWZ\x9dd:\xa6\x9d\xf70t\xfc\xcc\xcc\xfd-\x83\xef\xaex\xe9\x9cO\xd8b\xf3\x18p\x16\x18:\x1e\x9e\xe0\x9f\xb1\x84\xc4\"bbbbbm\x98\x98\x98\x98\xe9\x8f\xe1\x8f\xe5\x816\xf5<@x\xcc\xf51?p'\x10\x99\xc9$\xcc\xf1\x88\xc6~\xf1\f\x18\x10\xcc\xc3\xd0\xcf\xcfbz\x821\xe7\x19\x06\x11\x98GLLLu\x1f\xfcq\xd0\xff\x00\fLM\xbd\x06#4\x06\x06\x06p`\x1d\tʎO\xb1\x89\xb8a\xb1?\xd4\xf5\xd0\xc0Оg\xe6\xdf\xe1\x91\xd0\xc3\xd7\x1f\xc7\x1dq1>\x88\xdbv\x99k\xa6bc\xa6&:b\x016\xc11\x16\x06\xdd3\xd3#\x13t\xeeB\xfco\x06n\xc4\xdd\x19\xb8\x1e\x89\x80\xed\x05\xa0a\x82\xf0s1\f$\xc0g\xe7\xef\x04\xed\xc9\x1cN:\xe2s=\xc311\x00\x85f\xc3;o)\xa7s\xb2ة\xb5\x8dx\xe9\xfb\xb7\xa6&\u07b8\x98\x9bf\x00\nx\x19\x00\xb9\x9c\xe4\x9c\xcc\x1cg\xcbp\xce\xee}\xca\u0097\xbbb\x9c\xe2\x03\x89\xb8\x18\xdd13\xb6o\x9b\xb9\xccɜ\xce\u007f\x87\xa3\xd3\x13\x02`L\t\xe30:\t\x99\x98\x9c\xa3\xe9\x99eU\x8a^\xfd8\xc4\x02`t\x02bc\xa6a\xe6z\x9cş\x8aa\".\xd8\xcd\xce[8\xcc>\xc3b[k\xa9:\xab\x9e\xcd:\xd8\xe1t\x9a\x83?\xf1\xf7\x99f\x9e\xcaf֛Zi\xe9KO\xd0\xd3\t\xf5\xb4\xa2[^\x9c\x05É@\xccy\x86\x9b^v\xac3\xeb\xd9>\xabϬ\xd3\xeaϫ/ю\xc8\\L\t\x8e\x99\xe9a\xc5\x06\xc6.\x06 2ʦ`\x9e\xff\x00\x8e \x10\xe6~\x1c\xe4e\x8f\xae\x87\"d\xe0\xb7L\x89\xa3\xc1\xbb\xe4W\xbbY{\f\xf8\xdd\xf50\xd5\u0ba0\xbc\xbfOu\xc7\xff\x00\x1fq\x83㬕\xfc~\xd9\xd8A\x06\x9e\xb3>\xb5s\xea\xd5\r\x14\b~\xaaƿL!\xd4ӷ\xee$\xfb&w\xdc\xc3u\x93\xb9i\x84\xd8G\x94]C\xe5\x8e\xe7\xfe-\xccJ\xdaˊ\xb4\x1d\x0e\x9d.\x96VԴ\xe4\xcf]D\xdd3\xd1q\x8c\x9c\x1e`\xc8\a\xcene\xe9\xcc\xfc\xa9J\xa1\x16v\x8b\x99U\x8e\xf6\n\xac\xc5OٟpF\xf9-\xa4\xfc\x9bN\xe6\xa3T\xe3\xe3I\v\xf1\x80FҺ\xa9O+iEQ\x89\xe1\v\xa8\x82\xd9\xde-2Һ\xda\u05fa\x81Y\x15\xbb\xcd\xe2o\x82\xc1;\x91\xd8Dl\xf4\x03\x96^i\x1d\xb0\xbc\xc7H:e.[\xf4\xedI\xfc\x1dD\xfd tc\x88\xac1\xbb\x13vb\x9e3\v\x9c\xef\x8aw\xcaPm\xd81\xa9\xf8\xa6kt\x9f\x1c\xd4\xd911>c5\xb7q\x8e\x9f\xe3\x9f:\x91\xe9\xdcV\x97j\x1a\xe4\xee\xdd\xdc{\xaaej\xac'j'LY\x11*\x12\xc0\x82Wf\xc8\xf75zju\r\xbaҦ\xe9\x8e}JX\a\u008b\x01\x89ù\xf2\xdd\xe2\xa6)\x8e\x9d\t\xe1o\U000728b0\x19\xee\b}\xcc\xe6~\xe2z\x87\x88A\x11G\x04pA\xdd\xfb\xa2\xab~\xa6\xa4\xc1\xe9g\x00o\x8e\x1c,\xf9\xb5\xcdi\xff\x00\x06\x81\xb1\xaaO\xf3\xae[\x1a\xb3oj\xa1\xe7\x1e\xb5\xa1(\xd2\xd8\xebvh+\xadn\xdb\xeb,\xdd\xdf{\x00\xb1\f\t\x86\xf37%^\x17\xae\xdb&&\xde%$\x11\xbdg}D>C\x99\x83\x02\x99Ȋ75ˁ\xceQʇ\xa7\xc4\x1cL\xf3\xc67N1\xc4\xc4\xf6N!l\x9e!\xf4\xa6p\x0fƯ\xf4\xd2ۇMKl\xa4|\xa5\xed+\xd5\xddm\xb3\xe5k/E5\xe6\xbd?\x86\xa6\x9ek\xb1\x03\xa6\xa3Mmr\xa5\x1d\xeb[\x1a\x83k\xb1Ԣ\xbe\xa3g%\x94\x14Z\xd9V\xbc=\x87\x16\x85sj\xd9\xff\x00\xb1\xa9\xa8Z\xb5Tl>\x8eI\x89\xa7%k\xed\x9b\x06\x96\xa84\xd5\x15`j|\xed9\x06\x06\x02n\x81\xb1\r\xa2:b\x1e\nZ\xcaY;\xa3\x81\x01\x19c\x83\x91\f\x06q\x0f0\x98pg\xe90\x12Ɗ\xf6i\x90m=>I\xf6\xe9\xe9|\r\x17\x96\xae|\x87\xfc\x159\x8f\xe1~\x92\xc0\xd5oY\xacl\xd5s\xbfq,m\xea\xc1\xe1`\x82\xb3ݎ\x11#5l\xdbM\x83\xebڊ,J\xd9<Ҷ\xc9`\xab\x16\x80-J\xd2\xcb-\xd4v\xa7\xd8\xc4k\xec\xecӯ\xb48۩\xa4\xa1\f\x1an\xcc\xdcf\xf3;\xa4\x1f\xb0\x18\x97\xdd3\x15ʖ\xf3\x85f`\x19\x80px\x9b\xf1=\x1eL\xe2~\xe6i\x13~\xab\xb8\x04\x1a\xf5\xfb\x02\xc5`mA>Z\xcc\xd5_\xfa\xd38\xafQ\xf7C\x9df\xbf}zd\xde55\xec}=̚Gv\x81\xb3\x17\x87j\xd2\xca\xef{{\x95\xd8\x11\x89\xad\xe5\x8bV\xe1e\n\xa9\xa9\nT=\x90\xd3\xfd\xb7\xd2\xea\xd4[]\x8a\xf5>\xee\xc1\xb7Nl\xc9Օw9\x94\xdak7T\x11\xb4\x9a\x9d\x86\xd4\x17\xd6\xf6܌nxli\xb8\x98+c\r.%U<\xec\x10\x19p\x11\x88=\xc1b\xfdV\x15\xa5\x15\x93m}\xa7ș0\x81\x95\xff\x00F\xb6\x83\x10\x9e(=\xb9\xf6\x1e\x11\x03\xb8\x1b\xdcϕl-O\xcd\x15\x8ci\xef].\xa2\xddڽ-\x15\xdc\xcdv\x96\xf4\xafO\xf1\xc6\xdd.\xbbH\xdaGЯ\x86\xa0p\xcfj;f\x82-\xa6\xe0\xd4\xd4\xd2\xed\x18\xdc4\x80\x16\xd2;C\xa9ZҬ\xf6\xdc瘝\xd8ڭF\xa2UcG\xc7ٳ\x9b\x18\xf9\x06\x19\xaa\xc0c\xa7m\xb4\x9a\x99\xab\xd3\xf7\xeb\xecֵ\xf8\xac\x16\x917\x18ۢ \x9d\xaaBG\\Jk\xdcN\xb3\xfb\xad\xf9%\xb5\xad\xd4\xf7a\xe2\x05\x9c\x92\x1b\x11\x9b\xc7vTyC\xe2\x85\xc6I$\xcd\x12-\x8d\xf2\x1b\xac\xb2\x9a\x8fr\xee\xd7\xd7u;~.\xb2\x95v\xfb\x1f!\xa8\xf2O\x8e\xff\x00\xf0\xfc\x86\x9c^\xb67xW\xa9J\xeaKm\xb1\xce\n\xfd}\xd0QrF\xfb0\xb6\xa2l\xb4\xc1Zg\xf0\x98\xe1^[E\rmKUi\xdd%ޓ\x9ca\xb6\x91?́\x85\xb5ٔ\x9a-Wuu\x9aQj\xede\xa9\xb9\\\x99\x9c\xc0 \x06v\xadi\x92\xa5\x98,/\xa7+\x8a\x99\x8d\r]@\xc0g\xb3\xbf\xa6b\x86-g/\xc4\x1bf\xc9\xf1\x89\x85\xd5\xe9t\xfb_\xb5Qk3\xa4\xd2V\xae4\xfe\xfeB\xbf\xedu\x9f\x1f\xe35\x984YB\xe2\xf5Լ\xae\x86W9\x15\x86\xe31\xac\x8bn \xb4\x98\t\x9b\x8c\xbb.\x1a\xa3\a\xff\x00\xa9\a\xf4\nU.\x15`[Gr\x9f\xae\xdb;x\x95\x8a\xd6X;\x8bS\x95jn\x16&\xbbK\xe3\xe7Z\xe6\x01\x02\x13\x16\xb2'\x98\x99\xab9\xa1\xaazb\xe8\x9c\xceA\x1e3-\xb8\xa3M\xb8\"\x06\x18\xa2pa\x9b\xa2\xb4Ц\xdd>\xb6\x9e\ue647,\xec(\xd3\x1d\xba{uV\xe9\xe5ڽS\xce\xfd\xa4\x9b\x0ek\xdeYN\x16\xff\x00j\b\xb8!\xc5\x03&\xc1\f1mX\xaf\xce#e\x14\xb7\x8a\x01\xf6n\xb3\x15R\xf0\xb8(_\xfb.\xdb\xdd3\x02V\xfbl\xb5t\xee\xaa\xe2\xa9v\xa1\x9e\xb3{\xac\xfbO\x0e\xa2\xc3;\x8ef矪DB\xf9\xd9t\xa6\xab\xed_\xa9t\xeerq\x9c\x90\x91@\x84\xcd:o\xab\xeb邺*L\xc0f\x9bX\xabA\xf9\x14\x96\x1f\xec\u007f\xf8\xe9?\xfa\x97\x9e\xed;\x04\xac\b\x89\xb6\u007f_r\xd6d\xb5\x80\xb4={nݵW8Wȶ\xf5\x11\xad,T\xc4$\x94Ɍ\xa0ǲ\xb1\x11\xf0ͨ\xae\xe9\xc9Cw\x8f\xd9\xfe\xc2CY\x82f0\xa8\xa2\x1c\xce`Fht\xb7\xb3\x0f\x8f\xbc\xc1\xf1\xaf\a\xc7$\x1a\x1a\x04\x1aZ\x16\x05Q\x01\x9a\xa7M\x9a[k\xa6\xbf\xbdP\x19\x18 \xc0p\x138\xf03'v\x8e\xb4&\xe7\xa9]\xad\xa7a\\\x11\xcc,@\"熛\x14\xb7\xfcU\xff\x00\xf8ؒ\x96\xa6٧l@\xa0\xc0<n\xb8\x11Qޗ{\x05{mf\x9e5\x9aX\xd7Q;\xd5\xc1q\xc0\xbe\xf6\x86\xdb%\a/\xa9r\xb0\xb6\xf9\xba__\x95u\x9d\xcbS-\xbf_-V\x93d\x1a\x05\x83G@#KH\x8e\xa4\xcd&\xe5\xac\xce\xea\xacm]\x02\x1f\x90\xa1a\xf9D\x87\xe5\x1e\x1f\x90\xbc\xc6\xd4\xde\xd1w9\xc6\"\xe0\xaeF\xd0N=\xcc\xed%\xd0ʐ;\x1b]!b\xcdO\x95\x16Y\x90\xa4ļmmMrˑ\xa1\xe2\xa5 \x16\u007f\x13\xfd\xa3g\x92\xee\xc3s\x0e2\x03F\x16GR Y\xb22\x816\xee\x8d[\xbdt\xd6@\xfa6\x184\xc1`\xa2\xbb\x10\xe8\xe8I\xa6\xaa\xb6a\xed\xbd\xc5\xc6\xe6\xd4Ы\xf7\xf4\xeb\x1b]^Oȹ/\xa8\xb9cjn\xdalv\x87\x9f\xe1\x8e:W\xc4\xff\x00Qx\x8eF:n\x87&S\xe3Jv\xb3e\xd4\x03^\xa1\x99\xa9\xabr\xa9\x85\x81 D\xff\x00N\x81识ߐ\xb6d\xadd\x86|@\xd64]3\xd8\x17Fv٦\xc1\x1aT3\xe9S;)\xb8\x9d\xb1\xb7\x99\xe5+\xdd\x12\xc0\xa4\xdd^i\xbds\xa9\xd7*\xdb\xf7ڶ\xfb\xb7Nŗ%\xda\x1bEt\x90@\xaf\x85\xadH\xb3P\xec\x1d2\x14\x17\x8f\u0089\x88eUo\x03D\xf1\xab\xda\xcd\xc4\x1e\xd78\x138\x81F\t\x82`a\xa1\xf1\xab\x04\x9f\xac\x18\xb6\x9dk\x95۶\x8f\xdc\x18+\xb0ʴ\xd6\xe5t\xee\x0f\xfe<\x86\xfa\x95\x84Jh\xad\xb7*\xcd\xd1M\x86m\xb6-l\xb2\xe46\x05\xc2-\x97V'\xdeHu\xa4\xc6\xd5\xdagr\xe36\xda`8$b\xca8:\xe5̸et\xe1Zچ\xc5̶\xca\xc2\xd0\x05\xb2ـ\xd5o\x15\xb9\x1b\x19\xff\x00Ҏ\x02\xf0ŷ\"ZѪ+\x11\x04c\x93P\x19*s\xec\xcfA\xcc\xf7\x13\x13<ۧ\xb1\x8fҷ)\xa2\xb1\r\xf5\r\xda}-{E5,\x01f#\x8b\v\n.\xcfm\xb1\x84\x13\xeci\x84:ڄ\x1a\xfe~Ղ\x1du\xe6\x1dE\xaf*\xb4\x82Y\x96\x00ָѨ\xbb\xb5R\xcdԬ\xef\xf1\xdcnڊ\xc0\xc9\xdc\x0e&\xabkQ\xdd̯\xc1\x9a\xeb\v\x81\xc3\n\xdahq\xb7\xb8\r\xa1\xff\x00\xb0ҥ\x8a\xf8\n\x84Z\xd3*\xab;lKXR=\xc0\xc3he\x8a0\x10\x99ʙ\xee\x16\xe2\x0e'\xb3\xa3\xb4\xbdq\x88\v{VeZڔ\x9d}\x13\xff\x00$\xa2\x1f\x93\xb8\xca\xf5\xb7\xd8\xf6[w~\xdb\x19W9=ĞR\xb3\xe4\xfe\f\xe7s\x87f\x99u\xb0\x9f\xe8\xee\x01\x14\xedk|-\x0f\xbag$\x1d\xeb\xce6\x933\xe1s\u007f\xebW\xfe\x14/n\x05{zQ[8\xfa\x99\x82\xad\x93uBw\xa8\x9fj\xb1>\xe3ϵkM\xe5\xa1\x10\x9c\xc1\xc0\x1e\xe6\xe8\x1b\x9epx\x99\xc9\x02g0\x18\xae\xd51\xd4\xdcc1hzU\xe4\x02N\xd3JkŕR\xcfv\xa2\x9b\xbb\xf5\xd6\xe6\xc0\xe4\n\xabK\x0e\xeeۖ\xddXE\x95\xae\xd2\xd5+\xd8\xe7}8Ü\x04\xb4\x06+n\xdb+\xff\x00\x90\x02\x96\xfd\x9a\xe1լ[͍\xdbޝ\xb4\xaewiUsF\xd5m\xb0\x98\xb7X\x03]f71\xe8 \xcc\xcc\x1d\x16\xa6\xb1ڲ\xb6\x11\x12\x91\xb4\x8cDY\x8eB\xf8x\x81\xf8}\x03\xb5}\xc28\a\x04\xe36\xa8\x1d\x11\xb6\xbb\x9f\xeb\a'O\xb4Yg\x0fM\x9c\xef\aPA0\x05\x11\x8eT\x03\xdb\xd3y\x92%\x00Gn\xc5'T\xb3\xed1\x8bc1\xfe\xb1;Ԥ}b\x18\x86\x8cw\xa9X֒\xdd\xc7\xc7\xf0\x1c\x10(an\xdd\xd0\b\x16\f\t\x89\xb7\x80\xb2\x8b\rW]\xa68\xabGe\xb1\x81\xa6\xe7Qec\x98D\xe4+d\xcc\xe2m\xcc\xc6'\x18=\x06fܧJ\xec]\xb3O̳U>\xce\xd9_\x81mbC\xacxu\x16\x19\xbaƔY\xb0\x9bi\x13\xeeT\xb2\xddJ\xd9\x05ԅ\xfbdB\xc4\xff\x00\xf1\xc4\xc4\xc4\xc4\xc4\xc4\x18\xe8\x04\xc7\\\x8d\xbbKJ\xfe\xe0Z\x8e\xa5\x8f\xd1\x1b\xae\xae\xbd5\n\xd30n\xc6\x0e30'\xef\x19\xe5\x95H\x99\xdc\x06c\x8eeg\x93\x11\xb6\xc3\xcc\xf0\x11ޭ\xbf\xd0&\xea\xe7t\xc3k\x9f\xfeX\x98\x98鉌LM\xb3\x10\b\"(3\xb6\xa0틦\xb5\xa2\xfcu\xc6\x0f\x8d1~>\xa1;\x1at\x9fgO\\\xb7\xe4*0k]!\xd7\\a\xb5\xdc\f\xc1\xcc\xd8T\xa99l\x88\xbe\x8cU\xe5\xb12\x00\x10\xfanA\xe8\x87\x0e\xde\xc9*7\x13\x1c\x1d\xbf\xcb\x13l\xc4\xc4\xc7\xf0\xfc\x13\x198\x82ba\x8c\x14Zg\xd4x\x9a\x1d\u008d\x1dq\x05bw\xe8B~B\x85\x8d\xf2\xb1\xbeB\xe3\x1bSkO\xf5\b\xc1\r\x83\xee*\xcc\r\x9c\xe3\x063\xf3\xec\xe2n;G\xb0H\x87\xdc\xc7D\xe6\xc7\xf7\x15\x82O\xf4\t\xc4:\x95\x96\xda֙\x8e\x98\x98\x98\x98눫\xbav\xc0\x9bD\"\n٧մ\xc5Ҵ\x1aD\x83M\\\xedT\x8a\xf7T\xb0\xea\xaa\x10\xeb'ܶ}\x8b\xcc9\x9cN6\xf0f\xd8}㉷\x85\x84ƛ\x86\xdfe\xb9\x84\x19\x9ex3\x99\x9f\x1e\b<,8\x94\x8f'X뉎\x11\x0f`\xc6\xf76\xf18\x98\x9f\xbcE\x02\x10\x04\x159\x03KtM\x13\x884k\x06\x96\xa16%q\xaf\xa5gܪ\x1dl:\xab\x8c7Z`B\xf3n:~\u007f\xa3\xb4\xac\xf76\xf0x\x10d1䒅\x88\xc1\xe4\x1c\xe2\f\xbc\xce&3\x0f\x8c\xff\x00\xaf\xec\xdb\x0f3\x00\x18\xc6s6\x83+\xf5R\xef\xba\xdd\v\x89^\x92\xcb\x1fS\x8a\xe9\xc4\xc4\xdb1\xe3\x16\x8b\x1a\r\x1d\xc6/Ǽ\x1a\x14\x8b\xa5\xa9f\xc5H\xd7Ұ\xebj\x10\xeb\xe3k/\x86\xfbZc3\x1c\x9c\t\xf8\xa2~\xfe\r\xb8ϗ\xb8\xab9\x03\x8c\x0fd\xc2pI\xcc\xc73\x889a=\x81\xcc\xceKq\x16z\x18\xcc\xdd\xc1<\xfb\x9caFW\x95\x9c\x96\xacmEm\xadV\xb2\xa6\x97j|\xcd\x1a\x8dD\xfaN\x02\xe8\x83\ntu0]5Ba\x14\x1dE\v\x0e\xbe\x91\x1b\xe4a\xd7^a\xd4\\\xd3\xdfA\xcc\xe6g\x85\xf6\xc3\xcb\x19?\xa0p\x0f$\xe4\xe7\a#\x19\xc1\xe9\xfb\x8eXr'\xe2,\xfd\x1c\x1fp\f\x9228\x9bx\xdd\xe5\x91\x06X\xfb\x99\xc4\x001\b!\xc6s\x0f\x13~a\x00\xae\x9c\x80\rj\xacB\xaa\x9b\xaa\xad\xed\xd6P\xd3\xed\xd1>\xebO\xb3t6\xbbN\v~\xfb#9\xdarç≌O]37\x18Zn\xe33p\x80\xe2\t\x89\xeeg\xa0\xe9\xfaG\x01\xb1\x13\x04\x85;y\xc7\x02`\x95\r\xe3\x98g\x8c\xc9\xc4\xc6g\x1d0 8b\xc1\xa1\x1bO\xb4>\xfb\xd64&m\x9c\bz\x14\xc1\x98\xdb\n\xe2\t\x82!\xcc\xfd'$\x15\x13\xfd\x1fS\x11\xb9\\\xf4\xc7;\x8c$\x133\x0e'\x02\x02f311\x0f\xaf\xcc\xf0\xb8\xc0ț\xb7NL>\xbd@FO\xb0q8\xd8a3\xf3\x18\x8d\xec`,\xdc\x18\x9f#\xfb\xfe\xa1\xf7\xfa\xc3\x11D\xf6\x0f\xb9\x89\xc6\x00\x13\t\xbd\x98O\xfa\xe0\x19\xb4\x9e\x99\xc4?虒zs1\xe2xl\x10#\x00G3\x1b\x89ȋ\xc89\x06~\xe2\fC\xec7\x91\xc4\xceك8\x83\x067\x81\xc13\x1bO\xfd!\xe2n0\x1c\x8f@fc\x9c\x18}p\xa3\x01\x8f\xe9\x04\xc1\x812a$\x8c\xe2fs\x01\xda\x01\x98\x83\x88\x0f*fH\x827\xb9\x838\x99\xccɞ\xe6Nу\x0f\xbfJ\"\xb0\xc40\x1cOҦ\b\f\xc7#$\xed&\f\xc3\xccU\x8cI\x80\xed%\xe1%\x8f\xf9\x9e\xce3\x06!#\x05\x8cf$\xfa\x18\x02\x1f\"\x0fL퀒B\x89\x81\xbb\x19\x998\x13\x10\xf8\xc0a\x13\xd1\xe3\a\xdf\xeeL\xcc\xcef|}\xaa\xfb\a\x8cpG O\xf511\x89\x9c\xa8䕆{P&\xd9\xc4b\xb3\a\v\x8312\xc9\x18\xe6\fc\x92s\x17Ȑ\x15kb\xb0s\t\xe4\xc0\f\xc8i\x80J;9\xc2\xfa \xf1\xbebd\x90\x0e\a\xb1\xeaf\x13\x82\xa6s\x8c\xf0I\xc0\xc9\xe9\xc6T\x007\xcd\xc4B!8\x9b\xbc|\xb1\xc1\xe9\x9eK\x18\xa7\x11\xa0c=\xce@\xdd\x14\xf2v\xc0\xbc\xe3\x10s6\x99\xb0\xc6\xe5\xbd\x1d\xb0bc\x13\xdc\xe4A\x90\xb0\x12\xcaW\x04\xcfU\xe6`\x92s6\xe4\xccE\xf5\xb7\xa6\xd8\u007f\xd7\xe7\xa0\x013\xfd\x1c\t\xee\x01\xc7\x1bk@I\xc6\xee1\x8e\x02\xe0z\x980\xe4O\xfas1\xcc\xfd\xfc\x8a0\xc4s\x81\x062\xc6(\xe1X䧖wEl\xa8;\xa1\xe6\x02@\t\xc8;\x860Bͦ0\x80\xed\x99\xc4\fOE3!@;\xa61=4s\x96\xf5?\xd4\xdc&3\xd3\xfe۰=\xcc\x1e\x83\x83\aBy'$N\f\xe6~\xec0\x8cNg3\xfe\xa3\x18\x00\x16#\x06 \xc30\n\xd33i1\x8f\x8f\x93*/\n\x06q\xcbr'\xf9\x80\xe6g\x8c\x92A-=\x1fPz,I\xcf9\xf3<\x8d\xd9\x19\x13\xf3\x1c\xf6̬x\xfaf<\x83\x95\xfd\xfdOdA\x8c{\x8aNG0\b\x00\x9bD=\x00\xca\x1e\"\x12C{\xdcD_~\xa7\xe2\xe2s\x8fo\x8f\"\f\a3\xdc\xc1\n'&2\xed;\xa0s\x00\r?\u007f\xff\xc4\x00,\x11\x00\x02\x02\x00\x05\x03\x04\x02\x02\x02\x03\x00\x00\x00\x00\x00\x00\x01\x02\x11\x10\x12 !1\x030A\x13@PQ\"2Ba\x04`\x14bq\xff\xda\x00\b\x01\x03\x01\x01?\x01\xf98\xf5\xa5\x12?\xe5/'S\xa8\x99\x9c\xcef\u007f\r\u007f\xe9\x97\xf1q\x83j\xcf\xc9>\xd5\xfce\x14$\xd2:\x8d\xfb\x88\xc5ˁ\xf4\xda\xee\xd1E\x19Y\x95\x99L\xa6S*)\x14\x8d\x8d\x8b,\xbcj\x86\xeck\xdb\xf4\xf632k|r\xb3)\x94\xcaQ\x94\xd8\xd8R3\x19\x8b,\xbd\x14Q\x1dk\x91\xb1\xe1^\xda\f\xb6I\x99\x8b,\xb2\xcď\xa2\x8a2\x99X\xbard\xba2\x8a\xbci\x94\xcae2\x84\x92\xd7\x12OG>\xd5V\x1dN0XQ\x0e\x9a\u007f\xb3%8\xc3\xf4G\xaf\xd4=i\x9e\xa7\xf4z\xc2\xeb6F7\xe0q\x8a\xe4r\xfa\xc3r\xcb\x12\xfb\x1bKJ\xd3%\x83ǒ\xbd\x9aDfI\xe6\xd1e\x92x\xe4%\x15\xf4B-x\x1fVf\xef\r\x8a\x88̬LqM\vB\x16\x87\x8b\xd0װbZ\xfc`\xc4G\x9c\x1bFm\xc71S=4e\x12D\xbf\xa23hl\xcc5\xa5vk\x17\x1e\xfaЇ\x8f\x82\x86,#\"O\x1b\xd8V̦C+7+\x0f\x04clk\x04?\xeb\x0f%\xf6l\xcb}\xe5\xa1\x0fZ܉\x0e\x82\x92\xfd\x87\xd3qt\xc6%\xf7\x85=\r#.V3\xa7\xf61\xa12\xcb\xd5E2\xb0\x96\t\xd0\xf7\xee\xd0\xf0\xa1\fJƲ\xf2Y\xe4X\xc4CvQF\xe3/\nXrel\xe0o|\x1e\x94\xcc\xd4[/SXr(\x8e/\xc7j\xf4.\x06G\x93\xa8\x93\x17#t-\xc7'b\x16\xc5}\x96)^姃FS)\xb0\xf9\x10\x87\xb1-Ї\xce<\xe0\xd6\x15\xa52<\x1c\x8dP\x88lgGU\xae\xed\r1F\x87=\xab\t\xf0D\x97\xecf\xa2+ȷ6\x12(\xca\xcd\xcd\xf4\xaeIo\x85$M\b\xac\x18\xc6&V\x9b\x16\xee\x8evbT\\|\x9f\x8f\xd9(\xf9\xeey\"\xc9lY%\xf8\xe0\xf8\"K\x93\xc1қ\xba\x1f\xf4Uce\x97\xa5\x1eF!\xee\x8a+nF'\x12QLQQ\x1a\x13\xb1\xach\xca\xc5\x19\x0e-\xefe\r\rћ\xb9\x1f\xbcb?\xd4H\xa2\xe8l\x96\xe8J\x9e\r`\xf4^(X\xacf\xb5e\xac,\xcc[\xc5\v\t\xa6ʭi\x15\xa3x\x97#\xc1\x13\xf8\x8f\b\xb2\x86\xac\x94Zb\x1e\x97\x82\xc6(\x91{\xd6\v\trQD*\xb4QE\x14m\x85\x96D\x94\x86\xb5\xc5Rؔ\x11(\xd3ƍ\x91\xe0\x89\xfcph\xe9\x9f\xf6B\xfb$\xfc\x10\xddch\xb8\x8eH̋-\x96t\xc7'\x12]G'\xbe\x84\xe9\x92I\x91T=\xcaXX\xb4^\x97\xd9\xcc\xd0ݟ\xc7\x14M&=\xa2!\f\xe4\xa3\u007f\xb3\xff\x00FEљ\x92\x11C\xc3z؊g\xa6Ű\xd9H\xd8Z\x9e\xc2\xdcp\xa2\xbb/\xb3\x16\xbc\x8eQ\xfe(\xcdze\xc1\x15hXF\xbc\x90[\x99\xaaV\x89<\xc6[\x14L\x85\x1b\x15\xa5N\x8b\xb1!\xba\x1c\xac\xb2\x8a2\xd9\xe0\x8d\xc9X\xdd\xf2IV\xe8[\x8fE\x96Gq\xf72\x19h\xad\xb4I\tV\x8bа\xb1\xb3\xd4G\xa8z\x8c\xcd#\xf2,\xf2D\xea/#dqN\x85\x1bd\xe3Ka\u007fx-\x9e*\x0e\xac{\x16\xcb:IV\xe3\xe4}\xb82[\xe8CEjʨ\xb1D{\x17B\xb93\xd2ܤ\x8c\xd1G\xa9\xca\x13tz\u007fH]9\b\x9e\xf1\xc2\x03{\xe3\xd2i\x13\x92d\xde\xfb\n\x9f%\xa4Ģ\xf8d\xdc\x13ّ\xeax\xa2y\xa5\xc22\xc9r\xcaY\x8bX>\xda\xc1\xf3\xa1߂v\xa5H\x8b\xa5\xf9\x0f\x9d2\xfd\x84J\xfc\x90\x8d\xad\x85ӑ\xe9}\xb2J\x98\xc7\xc9і\xfb\x1fL\xcb{\x13\xdbs=Ş\b\xc9%\x86\xef\r\xfc\n\x12\x1fJO\x93\xd2^Y\x93\xa6\\\x17\b\xf5~\x91\xea\xc8\xcc\xf0B\x1f~;\x8e&Q\xadЯ94\xec\xad\x1e\x06\x91ӑ7|\x1d)YU!\xad\x99ԏ\xe4X\x96\xe4\x13L\xf5\xd0\xfa\xe3y\xb6\x17NL\xf4\xab\x96e\x8a\xf2<\x9e\x04\xf0\x8c\x98\xe4\xcb\xec-\xf0\x97}:gQ~6\xb0n\xa4\x8e\x9e\xe4\xf8\x1a\xdcx\xa1+\xc2\aM\xe4\xdc\xff\x00\x90\xcfVL\x92\xbd\x99\xe9\u007fb\x84W\x934W\x02q3\xc5x\x1c\x8bz\x95\x12ƊБ\x92\x89l\xac\xbb\xdf\xd8)\xaa\xdf\t~\xc8\u03a2\xcfP\x8f69\x19\x8c\xc6\xe5nzq\xf2Ġ\x8c\xd1\xe1#<~\x8fW\xe8\xbfo\x9d\xad\x87\xf9\x14IW}a(\xa9\x11S\x1c~ٖ(\xfcKFc3\xf65\xa1#)E\x15\x85\x1b\x16\x8b/\xda]\x16K\xb1^ǃ3,\xcc[+\xdb\xf1\x8ea\xbe\xdd\x15\xa6\xcb/\a\x8da^\xe1v+\xb3fc1x\xd1E\x14W\xbeB2\x14=\xb1\xad\x16\x8c\xc6c3ƙ\x94\xcaW¬#(\xf9%%\xe0n\xf96\x12\xb1\xe0𢙔\xa4V\x8b\xf8{\"\xc9=4m\xef/\xdb֊\xff\x00@\xa2\xbd\x82\xf8\x95߿\x80\xaf\x8d]\xcb\xf6\x17\xf2\xe9|c\xf6\xb5\xefo\xb1BԻu\xb6\b~\xd3\xff\xc4\x00+\x11\x00\x02\x02\x01\x03\x03\x04\x02\x03\x00\x03\x01\x00\x00\x00\x00\x00\x01\x02\x11\x12\x10!1\x03 A\x130@QPa\"2B\x04\x14`q\xff\xda\x00\b\x01\x02\x01\x01?\x01\xf9\xd4QE|'\x14\xc7\x01E\xb3\xd3=3\x05\xf8Z+J+\xff\x00i.\xaa[\tůj\xbf\x17e\x966\x9b:I|\x89MG\x91uS\xf7\xac\xb4d\x8c\x91\x91\x99\x91\x93-\x96\xcd\xcd\xcaҵ\xc92;\t\xfc~\xaaO\x91t\xe2\x96\xc4\x1e\xda䌌\x85\"̋f\xe3E\x14QE\x15\xad\xe8\xfb\xdf\x02\x8d\tV\x89\xfci\xab\xdcQD\nҍ\x8aF\xc6HȲ̌\x8cк\x89\x96Y\x922FH\xc9\x19\x0e\xfb\xe4Ȯ˯\x8aӭ:\\\x9b\x8e\xcae\x92\x9dpEeɄLb`\x8c\a\n\x1c\xbffM\xeb\xb1E\r\xaf\x02ܢ\x8a(c\xb2\xf5Z'\xaf\x1f\x11\xb2P\xfa!\x1c{\x1a\xb6bEj\xfa\xaa茉\xcd\n(\xd9i\xb8\xf3D[\xf2d8oh\xb6\x9fk%\xab\x16\xa9\xf6'\xf0\x10\xde\xdd\xfetC$\x9b[\r[\xdcQv8\xec.\x98\xee'\xa9!M\x8d\xb2\x1fL\x94\x13\x14|\x18\x8a]\xafٽT\xbe\x03Ջr\xb5lC\xd2PdV\xfa\xd6\xf66\xa2dz\x9bФ\x87O\x82\xf7\xd3\xc9)b\xacR\xbd\x1d\xf8\x17\xefJ\xd8k\xba\x8e4\xa3*\xf7\x9fd\x88>ǣ(\x90\xfa\x94f\x9e\xe2\xb1\xcb\xe8\xe3\x91Ih\x96\xe5\t\xbb/$#\xa9|\bC\xed\xae\xcbE\xe9\x1d\x1a\xb1m\xee-\x16\xb3:ct\x88\xcb-\xc6x\x1e\xb2\x18\xad\x0f~K\x18\x8a(m\xb1#\x83$\x95\xb2\xecI5܆\xac\xc4\xc5\x15\xd9e\x89ޜ\x0eT)\xaf>\xf4\xb9\x12\xdc\xea:\x89іǁn4$\xa8r\xa1\xbb2^4q\xad\x8ah\xb333$n\xc5Ə\xf6EnF\x94\x87\xcfrz_t\x9b\xb3\x81;\x195\x96\xc4z2\x8a\xe4\xe8\xc5\xc7\xd8\xf3ܚ$\xf2\xe0\xf4\xebq*D\t\v\x81\xc6\xc9IpI\x1c\"O\xe8\xc8\xc9\x1f\xc4\xfe&ݒ\xe0\x8bih\xdb\x14\xb6\xd7ƈLk\xc9}\xdb%g\xed\r\xd8\xd4\xfc\x17%\xe0\x84\xbc?avx$\xa9\t\xb2'\xfa\xd1rH\x8f\a\x93\xa9\x15V-\xb7h\xc9=(\xa3\x12\xbbY\xe0CBD\u007fc\xean)\x12r\xb2\x12%&Ȼ\x1a\xa1kh\xcd\x0eq\x14\x92T\x91\x90\xa4-\xcc{\xdfl\xfe\xb4dO\xf4Iј؈ʘ\xde\xda'\xb6\x8b\xb2\xb5d\x8ev\x17d\x84-\xce\x04\xcb2\xb3\x93\x13\x04b\x8aZ\xb4l\x88\xca1\xe4˽\xb4Yz\xb5\x96\xe6(\x91\x03\xfd\x0f\x82\x86\x8d\x84\xe8R\x18\xb5\xb3\x92\xb4zQ&-\x18\xcd\xcf\x1a^\xe4\xa5o\xb1\x16Yf\xfaQD\x88\xc3qw\xf5&\xc8M\xb4BV\xb7\xd6\xcd\xd9\"\a\xfa\xd6G\xebD=h\xdc\xdc߲db\xa5\xb0\xa3\x8e\x88c\x8d\xa3\x16b\xccL\n(~\xca\xf6\x19\x8abC\xfe\xfa\xbb \xda$DkΖ_n\xc2}\xb66f\x8eH\xaa\x1b7\x1fe\xa2\xcfP\xcc]K2\xf6W\xb0ɩ\u007f\x920\x9f\xfaf\to\xdb.\v\xa1\xe8\xd3\x18\xa1f\bƊ\xd5-V\x8cp\xb2\xa8r\xa2*Պ%\x16dLre\xbf$a\x15\xc0\x98\xdd\v\xb6\x89:\x17\xb5\xe7L\xe8S\xb3\xfd\vV8\xf6R\xecg\x9dh\xc4\xc5\x1b\x16\x86&u>\u038b\xf1\xa4\x9d\"\xf4j\xc9* \xec\x8f#V!\x0eFb\xaa.$e\x16ugn\x90\xb8\x17\xb2\xb4\x9a\"\xe8\xe7V'\xdd)\xd1\xea36\xca$\x88\x97Fvd\xcbz$\x8c\x90\xe5\x1f\x03:n\xa41\ue136\u05ect\xe0\xe2HS,s\xfd\x19\xb1\xc9\xfd\x99\x897\xc1\xd3鴬]6\x9d\xbd\x17\xb6\xf4\x8a\xd8ZlJT\x8c\x9bBM\xf08\xebD\xb4\xff\x00\xe9\xb3[\x1b.L\x91\xea}\x144\x90\xe8\x91\xf6\x8c\xab}!\xfd\xc9\r便\xa7Rj'\xae\xcc\xef\xc1S\xfa=>\xa1\xff\x00^^X\xbf\xe3GȺ\x10\x14\"\xbch\xc6/~{n)ldN[\x0f\x83\xa6\xd2FI鿍$=\x86\xecΑv]\xa1[h\xbd\x8aؘ\x8fE\x8b\xa28$\x8c\xa9\x99I\xf0c7\xe0\x8e~J\xd2P\x88\xa1\x1f\xa2\x97\xb0\xf6\xd2>\xfc\x95\xa3\xa5\xfd\xa9\xe9-ή\xc7O\xfb\n\xb9\x16\xb2'\xa4\x85\x1c\xb6=\x13Ҋ\x1dV\xc7\xf2\xfa0\x9b#ї\x91\xa9\xf80\x93\xe5\x8a(\xc5w;\x16\xb7\xda\xd9\xea\u061d\xba*\xb6\xf8\x12\x8b\xbd\xb4\xeb:?\xb209\xd8Q116'\xfa1\x9b\xf0zSd:N>L%|\x9e\x92\xf2R\xf8\xef\xa7\x16\xec\xfe\xba/~|\x10gR7Ɗ\xc8)\xd9\xfc\x8a\u007ff&+\xe0\xd9e\xe8ّe\x97\xae\xe5\x14W\xc4j\xcch_\x06\xfd\x8eLQF%\"\xfe?:\xe2%\xed\xb6_m\x14QB\xd2\xcb\xd2\xfeC\xd1w_s֙\x89\x89H\xad,\xb2\xcb/\xe71\x9e\xa7ِ\x9d\xeb}\x94\xccLLc\xa5\x96\x8c\x8c\x8b/\xf0\x92\xd2p\x97\x82\x11\x95n$\x97\x052N\xb5E\x96\x8bFFL\xbe\xca\xfcD\x91\x04+ѥ\xa5\x9b\xfc\xca\xf8\xf7\xd9\u007f\x95_\xf9F\xbf\x01\u007f\x8d~\xe5|\n\xfc\xbb\xfcb\xf8\xb7\xf3kر\xea\xfb\xdf}\xef\xa3\xf8\xbf\xff\xc4\x007\x10\x00\x02\x01\x02\x03\a\x03\x03\x02\x04\a\x01\x01\x00\x00\x00\x00\x01\x11\x02!\x10\x121\x03 \"2AQaq\x81\x91\x133B#\xa10@RbPr\x82\x92\xb1\xc1\xe1C\xd1\xff\xda\x00\b\x01\x01\x00\x06?\x02\xff\x00\x14\xb9\xa9\xa1\xa7\xf8\xdc\xee\xeb\xfe(\xa7\vn\xdfv7\xaf\xb9?\xcap\xd51\xab\x16\xd1U3\xfc\x8d\x8b\x96\xc7O\xe7\xf448\x95\x88U|\x1cU\xe9\xa2\xfeFKod\xa8\x85E\u007f\xcajk\xb9\xa1\xa1\xa65^0\xe2I\x9fSg\xa7\xf1\uf148\xc3\\Ui\x19\xaa\xa9\xc8\xe2\x96\xceCDq\x1a\x1a\x1cl\xbb48i44X\xe8hh\xcd1\xd4ԣ\"\xbcK\xdf\xf7\x15\xd9|&\x9f\xe2hh\\\xb6\xe4\x97D\xd7\xca'\xb1Q\x97X2\xb7\xfb\x19\xff\x00\x162\xc8\xd1\xe39\x8e-\xa1\xac\x9a\x1c\xa5\xd5'\xe0kHڹd\xcbl\xd9\xf6\xceTt9ϸŚ\xb6\xe0oy\x16\xdc\xedQ\x15/\xe0_\rnAÅ\xc8\xc6\xe4ƥP\xaei\xc4R\x8eC\x8a\x87\xafB\xdb=\xa3\xf6>\xd3\xf7-\xb3_$U\xb4\x85\xd9\x17\xac\xfb\x95\x16\xdb\xed>H{J߹79M\x16\x16,\xcdL\xb4\x8b#u\xf7\x1a\xa59[\xb3N\x9b\x8c\xa9\xe1+\x1c\x9b_j\x8f\x1d\xf0\x9d\xff\x00'\x11l4/\x8aX\xba\xb6MC\xe8g\xdaF\xe5-\x13%\xf0u=\x11;\x16\xd2\xee(j\f\xb9Ԝ\xf4\xb5ܻ\xccp\xec\xdf\xc1\xf6\xaa8\xa9\xda#\xf4\xaauw\x9e\x85yZ\xa9\xb5r\x98\x86\xea\xefЗW\xfbJ\xaa\xa6\xcat\xdcJ\xbeA\xe4\xe5\xc5\xeeJ\xc7%ji%r\xff\x00\x02\xc5\xcdq\xd4\xf1\x87\x8ao\xb9+Q^\x92s_\xc6\x14\xbf#E\x02\x17ӿ\xf5S\xdd\rP\xf6\xd4>\xa5*\x8aj\xcd\xd5\xd4\xce%\x9a\xb9\xbc=\f\xd5\xed2.\x968\xf2\xd5\xda\x0f\xfe\x8b\xd93\x9bi\xf0uk\xcb!\xd1\xfe\x92vv\x16\xcdҽ\f\xc9[F\xbb\x0f\xce3\x84T\xe3\xc9\xccjM\uf1b0s\x1a\xb2\x12r̽p\x8e\x86m\x9d\xd1}\xe9\x94H\xb0e\xb1\xabh֣Ųʒ\x9a]Vo\vjWxk\xa3)\xff\x000\x87K\x1cS\x9a\x9e\xf2l\xfbM\xca\xf8e\xaa\x8e*\xad\xe0\x9a\\\xd0\xf4\"\x99o\xaf\x82\xef\xe0\x97\xb4t\xfa\xa15]5AS\x8c\x92왙\xe8m{?\xf9\x81ƣ\xf1\xa9\x04\x19\xdd6\x12i\xaaz\xe1M\xae̵\xcb\xecC\xdc՞\xa45\x18\xe6\xa3^\xc5\xc8,\\\xb63\xb9w\x85;?\x04bƊ=pe|Wn\xe3\xf9\x11\xa8\xb2\xbdY\x14\xd9z\x0en\xfdG4UBCI5?\x8aՐ\xda\xd9Ƌ\xa1Ͳ\xf9%8\xb4X\xfd:_\x96s\xaf)\x8b2\xcb:d>\x9d\x15N[\xf9b\xa5\xf76\xaa\xcaz\x99+z\xae\x16U4\xf1S\xd0ʴ\xc2voAK\xb1\f\xfam^u5î\x13\x9e\x92j\xad6p鄢O;\x9eH?\xb4\xb1|4(^o\x84t&Mq\xa6\xae\xc3\xfaT\xba\xa3Q\xa4\x8d\xaf\x82\x976\xaa\x92\x9a\xb3E\xe0\xa5\xe7\xebr\xaasH\xf3h&\x9d\xfaXt\xec\x94\xe5\xb4\xf6&r\xf8e\xeaز\xefg\x1e\xa3\\\x13\xdd\x15d\xaasv\xa4䪯\x15;\x1cy[ˢ\xe8S\xb5\xd8٣;\xa7\xf5?\xa7\xfeɕs\x9a\x99\xa3Dl\xf6\x8b\xee\xf5]Ǘ\xe0\x82\x19\xf5(\xe4\u007f\xb0\xa9n\xc7\xf7\x199`\xe6g3\xc2U\x152\xd41\xda\tl\xd6\xd8D\\\xce\xfa\xe8\x88\xdaM\x1f\xb9\x13+\xa3F\xa5\x99b\x11=0\xd2\n\xab\xa6\xcfB\xf5X\x92ճVSO\xa1q\xed\xa5e\xd0ufU&\xba\x1b]\xa5\x1b>9\xe8U\xf4\xf5\xea*\xabv]\x0f\xa9\xf5c\xba)\xa2\x8a\xdbMMʞӆ\x9e\xe2\xfa4\xa8\u007f\x93W)\xcbx}\x0e\xcf[\x99k\xb3-AjU\xc5\xc3H\xdd\x10\xa9k\xbe\x8cK\x9fk\x1a\"\xaaۚ\xaaՙYj\xb2T\xbf\xa8J6~\xa7\xea\xbabyWQx\xa4\xa9\xf9\xc2\xe8t\xbd\x19\x1f\x02\xa2\xadz\x19\xe8\xe7B|-\xf5\x92\xdb:~\x0f\xfc55\xb1\xc7\xfb\xb1\xe4\xa9\xe6!\xeaJО\x83\xab\xf6>\xdc\t%\xa6\f\xb9cS\\)^ⱥ\xb0}`P\x85\x9dp\xf52\xec\xbb]\xb2\x9e+\x15f\xeaT\xba1/꤭\x1b:\xddQ\x14\x8a\x16Z)\x85\x1e\aK\xd9\xe6}.[g\v\xc9i\xa9>\xe6\xa7\x06ѯs\x9e}\x8eg\xedIř\xaf&\xa6\x964H\xe2\x81')\xf6CtSs3\xda\xd3&|\xd3K\xecC4\xc3#ף#\xa9\x0f\x9dk\xe4\xcfO\xb9O\x12\xab\x1b\x97-\x1f'-\x10e\xa9C\xff\x00\x91e\x94N\xd15W\xf6\x9fr=P\x9d0\xe9\xeey\xc6\xd4\xe2\x94D\x8d\xc98j6:\xdc\xe6\xf5?\x12\xae\x1d^\xa6j\xef\xc42\x9a͟\x83iK\xd2JRk\xd2E\xb4\xa2\xbc\x955\xf2(\xdb\xd8\xfdM\xa6qGL:\x9dG\xe8_\x1a}p^\n\x98\xba\xec\xaaV\x1eŷ\xe1\x99U\xb6\x94hM[E\xf2]ɫ\x92i\xe6Fn\xc4\xfc\x99\xe9\xb1Nm_l,\x99{\x16\x89>\xec~\xc4\xd4\xf3>\xe3Q\x99\x9c7\x15\\0x\xdc\xd6\xe4\xb1\xd8o\xa2\xdc\xf2'ܩ-p\xc9\xd0o\xfb\xc5\xf4\xe1\xc9\xc4\xe9^\xa5\xf6\xb4\x9fu\x89ә\xb3%R\x9f\x91h_\xa8\xc8x\xcb,F\b\xcb\x1a\xa9\x93\xab\xb0\xd4E\x03\xd9\xd5\xec)~\x82\xfe\xb1\xb5bK\x14\xbe\x86ef\xce\n\x8aiuy)\xba\xcbزH\xd4\xe6#3\xc3Bi\x9fb]5{\xa3\x86\x11\xc5Z\xf8\"0\xd5\n`\x8dF\x88n$\xe2ڹ&\x8a\xb3,R\xab\xa6\x16=\xcf\xf5\x97\xf1\x83\xa5/rj\xe3\xf0h\xb3\u007fKd\xbb\xaf\xd8ZIM\xd7\xc9n\xa5]\v\xb2\xd7ݸ\xf8\x94\xc4\x0e\xb6\xf8Wc\xe9\xaaa>\xact\xbedR\x9c,\xbeL\xd9\xd4\xf8%2\xd8^\xacy[\xf6-\xb3f\x94\xafs\x8bh\xbd\x8b\xd7Q\xf9?r\xdb$rR\xbd\xb0\xb2y\x86\xaap\xfa\\\x87]\xc6\xfa\x9a\xea\\\xe8[R\xf0qU\x03Y\x90\xe8\xa6-y\xee]\x16BJ\x96G\xd3\u007f\x04\xd5I\xee/\xf3\x90\x9fQE\x8f\xeeW\xf5\x1e։uO\xe4=\xa7ӥ\xd7=\x18\xa9Q\xdd\xc7qC\xba\xd4O\xa9Nv\x91}\xa29\xaa~\x85\xa9\xda2\xdb/\x96p\xec\xe9\xf88\x1f\xc2\"\xaa\xea\xff\x00v\x14\xedRW\xb5^\xa4\xe8-I\xbdB\xfd7\x1e\x84\xd3\xca\xcdP۩\x17\xdaT˧\xf2}\xb4p\xdbЪ\x9aי4/U+\xdc\xfb\xb4\x9a\xb7\xecp\xec\xd9m\x9a:/b\xfbZ\x8b\xb6\xf0}\xcd0\xb6\x85\xd1Թ\x96t%\x91\x1du\x12\xa5h1:\xaaE\xb6\xd0B\xa9\xd4kԊ\u007faK\xeaJ\xa4\x86\xe0\xfb\xbe\xce\xe7\x15O/\x82\xd3\x1eN\x13\x8a\xaa\xbeK\xb3\x9a$\xeaD2\xd4\x14䲋\xa2\xaaj\xa7Rl\x84\xf3/d:j\x96\x99\xcb\xf25\xf4\xe9\x1a\xed\x8d\xcb\u058bT\xd9jGJ٥\xea\xcd)>\xe3^\x85\xf6\x95?|t\xfe\x02e\xb0\x86i\x05u{\x11\xb6R\xbb\x9f\xa3\xb1\xf7fZ\xadIUOB0\xbe/й\x96\x98\x9f%QZE\xa4\xb5-\x8e\xaa\xac\xfb\x1a\xb9\xecMU\x1a\xfe\xc4\xe79=\xcbP\xbe0њA/i>\x06\xe6Y%[?\xa4\xe5\x11N\xc9{\x96ʅW\xd7jF\xe9\xda\xd5[\xecES=\t\x96\xee:\x95+7b\x9c\xfdt%sS\xa9$nhsн\xc8̪\xf4\xc6\xc4u \xff\x00\xdc.X\xd6\xe54\xe1\xccs\x0e\x1c\xbc4-K9\v\xb4O\xd4\xd4\xcbULUR\x9c\xa1\xc5\a\r\v\xe0\xe5:|\x97g\v\x16j\x917~\x85\xa9e\xa8\xfdΆ\xb5\x0e\xd5[\\\x13\xe8\xf5\x1d&\xcfh\xb9՚\xee\x89]\x0e>S'cRV҅Qz\x93Hʩ\xb9\x16\x9a|\x11$\xfe\x15~\xd8\xe8B\xa6N[a58\xc2勐\x8b\x8b\v\x97\x88,\xa5a9\xd1\xc5\\\xb3\xa9j\x11ʰyil\x9b|\xe1\xc7U?'2\xf8-Kg\xdb\xfd\xcd(^\xac|q\xec^\xb6;\x96\xa8\xa6\x9a\xaapqV\xb2\x15}\xb9O\xf2)\xb5.\xa4\xff\x00\x1az\x1bJ\x14\xf1r\xf83j\xf2\xc0\xd5Y\x15^\a5J\xe9b{\x0e\xae\xd7E\xa9*\x1f\x1d_$\xb2\xcdI\x97:\xa6\xaf\xee\xb0\xdc\xd1\xfe\xe1\xb4\xfd\xccϹ\x12sB\x1c\xcb!l\xa5y9`\xe2\xad\x1d\xc8X\xe5f\x86f\xbd\x84Ӿ\b\x99RK\xb9\x95갻&Qv~G\x06\xceKQI\x96R2\xd1Smv)\xce\xea\xcd\xd6\xe7c\xb9\xf6\xab\xf88\xf2\xd3\xfe\xa4QWEQU\x9a\xf5DS\xb3o\xd0J\xbar\x94UL*\x86\xb36\x8c\xd3TyeH\xb2М\xb0m)\xa7\xa5\xd1K\xe8\xc7H\xaa]W\xeeV\x9f\xb0\xd9u\xeeI\u009b\x8c4Ù\"\xfbZNi8v\u007f%\xa9\xa5\x1c\xdf\a\x15O\u007f\xa4\x1e\x10\xa3\x1c\xb4\x91bip^\xa6^\xa7\x8b\xa4\x88~\xc5\xd3\xf7\xb1\xd3ٕU\x14B\xb1TR\xaa\xf4b\xfa\x89Ga\xfe\xa3\xa7\xc5#\xcf\\\x0f/\xc8\xef\xf9+\x95MWZ\x15/\xa9U)\xde\xc4Ӛ\xaf,̿\x1a\x8b\xf6+_\x055\xcaSH\x88v\xf4\x1b\x8b\x1f\x91j\x1f\xbb\x151\x14\xf8.]\xa3/Ա\xc12Y\xb5\xe8Y\x16q\xe8s\xb3\x99\xe3\xa6\xe6Tdxy\x1ah\xd4}\xc9\xdc\xd6\xe7m\xc4\xd6\t\x9c,\xb9\xa9\nU\x84\x9b\xf0T\xa7E\xa7l4\xa9\x8e\x95e:\x15IVh\x9f84T\xddӱj\x1b\xf5e\xa8\xa4\xa9m=\xbc\x17\xad\x1c̴\x9cJ\xa98v_$\xabx#3\x8d\xeegI\x146\xd6\xec\xad\xcc\xce_\x92\xed@\xe9&\x9b2\xf8e\x8cd\xe8\u007f\xfb\x85\xc9\x1c\xe3ě\xc2\xe6J\xb6s\x1eG\x92\x88\x9e\xb2=\xa3\xb28v~婥\x1c\xff\x00\x05\x9dL\x96և9\u0099\xc8}\x9b\xfa\x9c\x14R\xbd\x8d\u007f\x95в\x93-*\xa7O\x92-K&\xbd\xa4\xb2\xaa\xaeޗ\xc3\xc9cCIe\xe4\xb1w\U000461bah^^\xec3\xa1vE\xcdv\x8f\xf6-\xb3\xf9\xa8\xb54\xafc\x99\xff\x00-\xa1jKl\xea.\x92\xf5g\x16\xd5{\"\xf9\x99\xcbO\xb9\xcfO\xb1\x14\xe6g\r?%\xebK\xd1\x12\xea\x9f\\4?\xf7\vĒy\xc5\xe1)X\x94\xb7\xaeL[\xf8\xfaoY\x1c\xaf\xdc\xd5!\xcdzx*\xaa\xa9\xb1ʑz\xe9E\xa5\x9c;/\x96[*/\xb4e\xea\xf9\xdc\xd2\xc4\xce1ز$\x87j|ni\x86\x82\x1e\x12\xe9\x92p\xfbT\xcfr\xfb\xd7\xde\xd7\x1b*\x99\xcb\xf2^\xa4j\xceS\x92\x93\xeeS\xecj\xd9m\x9f\xc8\xd2ʈ\xfa\x8c\xe2s\x87)\xe3\x0e\x86\x9b\x9a\x0e\x11\xd3\xd3\vv3(\x1fS\xb2X\xb9;\x1d\x88\xd7\xc9=\x8bb\xeb\xf3\x1b\x9f\xf3\xbfb\xd43\x96=Y\xcd\xf0]\xb3\x96Oƒ\xfbDY6[g\xf2Z\x11}\xa3\x1f}\xc4wF\xbb\xba\x9c*Ű\xb5\xcb\xe3é\xabOs\xc9ܖ[N\xd8AP\x93\xd0n\x8b\xa1*\xb8O\xa7J\xb2\xb4\xef[gQ\xcb\x1e\xe4\xd5R.\xd9e'J\u007fb\xfbJK*\x9f\xb1ó\xf9g\xe3O\xa2/\xb4\xa8\x97\x85\xb1\x93[\xe1\xa6\x0f\xb9\xae:\x1d\xc8\xc2\xd7\"!\x8aG\xd2\x06+\xef\xf4éfC\xb6\f\x95\xaa8\xdeS\xf4\xa9\xaa\xafc\x8a\x87\v\xa1\xc4\xe8\xa7\xdco\xea|#\x89\xb2\xdb4~4\x97\xdaS\xff\x00%\xb3?Dp\xec\xbeYeM>\xc7\x16ү\x92\xe7\x8cc\b.Z\xe4\x92;\x9e\a\x86\xa3<\xe1\xa9\xe3\v_\vܔk\x85\xb5&0\x92\xc5\xf1\xbb\xd7\x15\x84\xcd̵\xa9D}:E\t\\\xe6\xa7\xe4\xfb\x8f\xd9\x16\xa2\xb7\xeapQJ\xf59\xe3\xd0\xe2\xdaT\xfd\xcf\x05\xb0\x95\xa6>\x06X\xd4\U000cf05ep\xe9\x8c\xce\xf6\xa7\x82\xc4A\xa10I\x98D\x1e0\xd1N\x1f\xf7\x84\x93L\x8eE\x83\x8249\xea\x82\xed\xb2p\xf0X\xd7s\xc1\xa9\xa6\x17\xc1\xa92\xac:XV\xc6\xe4\xf4'\x1bN\xed\xb1킦]\xb0\xb8\x9fC\x8bC\xc1\fԱ\xa1\xe4\xf2x\xc2O\x18x,i\xb9\xe1a\xae\xe2\xecX\xeaN\x1e7\xee\xa3\x0f\x18[\x19<\xef\xdfC\x84\xb1\xa4\xe1\u008d,Jи\xd2܃Tx,v W-\x8d\xf7-N2I\xae\x10\xc8\xc2c\x1b\xe8x<\x1a\x1aᩡ\"\xf0N6D\xf4\x1c\xeeٞI!\x93I\xa5\xcbcԾ\xe7\x14\x1f\xf4hB$p\x8e\x871\xa1\xe8\u007f٦\xf7\xae\xed\xcd\r0\x8c4\xb1\xa9\x18I\x04\xf6&M\va|\x16S\x9a0\x82\r\x06\xe3\v\x17\xc6q\x9a\x88\xa8\xd7\xe4xF\x1ao[\v\\\xbe\xa5\xc9\xc2Mq\xd2\xe3$\x8c'\v\x1aclQ\xe3\t\xd1\x0f\b\xeej]\u0602:\x17f\xbb\x8e\xc7c\xa1\x12\xcd\x05\x86\x98뇜<\x92X\x8c54\xd0\xd7\xd8\xd4\xf1\x83͡m\r0\xb6\xa7\x92۽q\xb0\x86F\x1d\xc9\xe9\x8c\x19d\x8e¾\x17\xd0\xd7\x18\x9c;\x0f\xa9\x94\x82\x19\x1a\xb6C\xd0o\x0fSL'\xb1\x06\xa2XX̵\x1b-\x85Ǆ\xf5\xde\xf0D\xe1\xe0\xf1\x85\x8bc\x05\xb0\x94hBD\x8aG\xd0\xd7wR\xe4\x16'\x1b\x92hi\x8e\xa4\xbd\vcr\xda\x16\x1f\x8c._\xf8\bxI;\x9a\xe1bp\xd0nI\xecN\x98F0\u007f\xff\xc4\x00(\x10\x01\x00\x02\x02\x02\x02\x02\x02\x02\x03\x01\x01\x01\x00\x00\x00\x01\x00\x11!1AQaq\x81\x91\x10\xa1\xb1\xc1\xd1\xe1\xf0 0\xf1\xff\xda\x00\b\x01\x01\x00\x01?!\xb4q3\xcc\xd1+\x95\x98\xd2h\xea\x1b\x80\xf9\x99f \xc5@ \xc6\xe5Z\x95pӉKr\xbc\xcaFF\xe5\x89\x16\xa5\xf3\x14\x81\x95s\b\v-u.\x81\xf9Lԩ_\x86\xe1\xf9W\xe0bW\xe4\xa8L\xcc\xe0\xebnf+P.\x82\xc1\xe1Ej_\x1a s\xb9~'fYщ\x993\xcf\xf1\x8bhX\\j\x95\xdco\x19\xd2\xcb.\xa5\x9e\xa5ܭ\xb2\xddA!\x84\xc1\x05\x9b\x85\xadD*iD\xc96bnT \xb4ʘ\xcd1\xfc\xab\xfe:~\a㼯\xc0%T\xaf¥~\b\xd7\xe2\xc2&&Ȱ\xef\x1d\xb5\xcb%A0\x83K%\\,|\xcc#\x85q\x17c\x12\xadFт2\xd4İ\xb4\xbd\xa28\x89 7\f%.\rD\\q\x16Q*\xa3\x1d\xff\x00\x05\x80S-A\"b?\x8a\x92\xa5A\x13\xf1A\x1c\x92\x92q\xf9ɕ\x04\xe2Uʸ)J\x99\xe2Ud&\xec\xe2f\rb\xe6ˊS\x8f\x11\x1a\x88%\xc6\xed\xe1\x06\x16\xb2\xb1\x10\xc9\x17\x88\r҂\xc8\x14\xbf\xc3y!vJ\x8a\x800\x88Kҿ\x05\xb7\xe2YX\x8dn\xf3\x1eh\x96bm-R\xbb\x96:\x8b\xdcF\xael\x8b\x12\xd8~E\xa1t\r\xb1\xa6\xd9\x17\xc5,0Z\x81\vF\xb2\x84\xc4k\xf9\"\xa5\xb0\x91X\x84\x01b\v\xa9\x96\xe5\x06]G\x13yA\x91\x99ClJ\xf19/\xe4\x1a&X\x1c\xb32\x8e\t\xac\x9d\xe4\xf6r\xfdL\xf1\x1d\xc5U)DI\x88\xc4&\x04y\x13*B̲\xe34\x8d'\x94ډ\x97\x12\xd7_\x87\xa0\x97\xc1f\x1cZ\x18\x06\xe3GS\u008b\xee,m\xccń\xa5\x8d\xa5F\f\xa2\x1a\x94\xf5\aZ\x95ܥ\f R\xa9\x8e\xcc.%\xbc4D|\xe0\x1a@\xea{\x89\xe5\x99\xdeQ\ni\xf5\x01\xa2A\xf3\xd7\x1fİB\xc4\xeen0\u008f\xac\xc1\xbc2\xb5D[\xa2a\x1b\x88\xa6:\b#{\x81\x17\x89\xa7q3\x03\x82P\xe6Q\x1fļ\xb2\x98\x1d&X\xa3\x88\xa4zK͉\xb1Y\x82X\xe5\x8enS$B\xf6\b\xf5\x00\xdci\x89\\K\xb7\x1a\xb1\xf0\x87\xa8T\x82\xff\x00\x86D\x06nS\x81D\x1aՊ\x13\x88\xe8h\x91U\xb1(p\x16\xbc\xa7%\xc6Σ[\xb3\xa9\xf4FK\xb47qL/\x99b\xea\xf9\x8cQ3\xaacn\xf0\x1cüY\xd4߳\xe6\x1c4\xc4\v\x9c6aP\x05\xe8\x99\x18.u\xfe\x90~s\x8d\v\xf9Boy\aȔ\xb9\xe1\xe7ܖ\xb3\xbf\xc1H\xe3\xf0ܻ/qj=Oe\x141̿\x17\xc4P\xe6;\x95i̠\x89\x8a\x8aT\xd7\xc4\xe8\xe2sA`\x00\x17\xe6au \x05\xe2\xcepĹ\xc9p\xf7\"-}\xca\xe1\x88Q\x02\xbai,\\<\xb1\xc4pV;\xca\x04<\rn\x17\"cspK\x95\x12\xa6\xbfq\xbbk\xdb\x1d\xdb8\xe9?s]\xfa\xa7z\x9f\xf3f\x88{a\xe5\xfb@\xe8\xf8\xa0(\x01\xd1;\x1f\xc7\xe2<o\xdaWpN\xf0\x99 \"r\xf8\xa6(\x82\x8fR\x98\xe5\xfc_\xe2\xaef^\x8e\xa5\x94]g\x12գ\x04&\x14\xff\x00<\xc5d\x11:\x80\x9e#J\xb7.ޣ\x03\xb9\x89\x88\n\xed1\xd4\xcf\xcb\xc2T,y\xa8tO\xa9\x94\xa2\x06\x8a\xfa\x96\x1e=\xc6\xd7Q\xa2o\xd4Ψt+\xcaV+db:6ί\xe0\xa9\xca\"\x1b\xb3\x91K\x8d7\x10tV\xf9T\xa3\xb5\x19\x00\xd0\xc3\t\x91\xa9\xf0\xc48G\x11\xfbH\x85\xb5i\xe6[\xaf\xb4jf\f\xa0W\xa8\xe3\x83^\xa5\x84LLWx\x12\xc5v\xf0Ce9\x1a\x88\xaa\xb9\xaa\xe2P͠\x1d\xc4\xdcP\x1b\x873\x9f\x00\x97P%\xf2~\xe4\xa9R\xca\u007f\bcB\x97\x0e\xc5\xc6\v!E\x8f\x89\\\x9a\x880\xcb0g\x02\x04\x1a\x8azC\xa4z\xa9\xbd\xca\xe2\xeej\xa2dFI\xc2\x1e\x86\xa0\xc3$\xb5vVc:\xc40\xaam\x1e\xaf\xc4+\x94h\x19D\xa7D\xa7D\xb6\xf5}G=]\xee\xe3\x88\xd6\xce扨r\xd8;\x91\xc4m\xd5l\xdbǙ\\\x1b<\xb5)\xa7@q\r\xcd}\x10\xdf\xe8\xc1\x98j\xf7_\xd43+\xe3?\xd4P<)_&Q\xc0%\xb5^\b\xe0\xc8\xfd\xdd¡\x94he\xf1\x01\xcblt\x82^b4fp\xb9n\ns\x14\x1c\x98\xfc\n\x8f\xc7/\tD\xb7\x13\f\xc6W\x9c;u\xae\xc9\xccoL\xcd\x16ډ\xba\xe2.\f\x02\xad\x95\x03\x02\xc1p\x02d\xc0U\b1yKG5,YaӜ\x15\x88\xc9GR\xb9\xe0\x87k\xfc\xa7\xd6%\xa7C8\x99\xe7J\xf0\x9cO\x02@ky\x9e\xc9\x15\xb7\x88\xf5}\x80`\xaa\xf3\x011(\x17\xf8\xa3)AY\xd0\x1f\xe62\xbci\x1b\xd5YJ(\xaeM$\xb8K/\xc0\x9b\xcf\x1a\x8b\x8e\x8a+\xe0Np\xb8\xae +\x16\xe1\xb4,'F\xf2\x14\xcf9\\\xc5\xe2*\xa6\x17Gs\x12B)\xe1-]\xfe\xa2B\x94\xd2\x18U\x1f,\xb7F;\x9f\xa8]\xb6\x04*,\u0091,\xb8\xa1\xb6\xb6G\xca\xec:\x8f`|\xc7\xe0\x98k\xb9\x96\x13\x11\xdcn\b\xdc\x000<\x8cJ\xac\xc6\xeb:\x8b~\x90\x8eH\x86\x1b\xf9\x8c\x04U-D\xb1=\xfek-\x84\xbd\xa0z\x9c\xf7\x80\x1f\x8a\x1c2\xa1\xb3\x05n\xf5H\xa2D\x10y77\xa2\xe3\x14\x97\x1c\x87\x88\x1bJ\xae\xa7\x18\xdb#\bBѧ\x1f\xcc\xcdPt?\x93ġ\x1d\xf5f|-\x83\xfd\xca\xdeU\x94\xd3\xfb\x95\x8d\n\xe0b\x1a\xd6\xd5\xeef\xea\xaa\x1f\x8a\xe0\x00\xa3\x92\x19ꐖ-\x92\xe5\xae\xe5\xd1I\xea\xf0Np\xbf0`\x17\xd4qWR\xdd\xd9\x00u(\v\x94y\x97m\xb88%\x8aE\xf4ܹqo1\xb5T\xb1\x8f\xc4\xd2U\xdc4!\xccM\r\xca#&\x0e\x9aN6\xb8c\\K\x1a\xcc8\x03\a\x11\x1d\x1f\x13\x11\xac\xc1\xd0\xc4\xc4Qg\xe2\a\xc9\x19\xfb\x84\xa7\xff\x00\x10\x06\x8b\xb3\x11\xfe\vo\xc4\xcb(\xd0r@Qt\x82e>\"[\x10\ra\x96e\nq\x9d\xbecZ\x9f\x1a\xe7\x10\xc2\xf6/Dؾ?\xb6\xeb\xe2\x0e:\xff\x00\xaa\xa1\xbc\x97\xe5)\x87s\xb4M\x02\u07bf\xeehu`\xe2\x1c\xb2Y/\xebS'7s+\xb1\xf7\x13l\xe5\t\xdb\xf3\x1e>\xafK0\x8ca\xe8s)7E\xf3\xa2\xa6#\xe3\x93Ħ4td\x8a\xc1ma\x98\xb0\xe4\xbcJ!\xb2\xe9_Q\x0e!3a\xed\x80(\x93\xccb\xf2[n\vზ\xbcý/\xb8\x81\x82\x84Ë`*\xbb\x80?\xe2\x04\x17T\xf3\xb9^\x86\xb37\xb7jP\xad\x99T\xa5\x98_\xac\xda\xc1Pn\xf1\x94\x02[\x97\xe9\xe9r\xb80\r`\x858H\xa8F\xbd\xd2̤t$F\xfb\xd3\xe2Q/\"ɹP\x1e1]}LK\x16\xb4\xea0\xc1wr\xa0\x169\xb9f\x1eB\x8c\xd6\xf3q\xfc%sjR7\xfb\x96\x99\xbf4\u0087\xb2&\xd3\x14n\xc98\xd0,\xb0\x94\xf6\x0f\x03\xe8L*pF'֊\x8a\x80\xadh\x1eR\xfd\xf6\x8e%\x86\fḘN\x9d\t}i\xe7\xa4t93\xf5\x9b\xfa\x9f\xed\n\x9b\xb6\xe9\xe9\x96\xecWQ\x17\x8bQ{\x93\x1c\xa7\xcc0-\x84\x00=E\x0fb\rTO\x99\x85g\x82=壤L\x80\aI+r:;N\xe5\xe8W\x92\x13\x1dU\xf2&\x87\x87\x04n\xb0W\xb32\xb0H\xf3\x16\xcd\xfcL\xe5\xf2\x9e%!4\x82x\x04\xb1\xad\xb5X\b5\x89)\x14z\xb9Lq\xca\x17\x8a}\x13\b\xe0Ų\xb6c\xe7\x99\u06020\fL\x888\x81\xd5\u0083\n&\x8c\xb5*\xd4n\x16\xb1\x11Q\nk\xcf\x16dYG\xfb\b\xec14Ǻ\x82\xa5\xa8\xd5\xe5\xe1\x94\xe4\xe9\u007f\xcc\xfe\xbdX\x1a\x00\x18\xb3Q\x00\xc5\xc5n&R\x1d*\x8c4\xd8[/\xdc\x15\x9b\xb0\xeb\xd1.\x1b.\xa2\x89\xa0&\x8cf~\x8d(\xfe\xe0\x8c\x8c\x97ʠ\x1bf\x15\x86\xc9\xe66i\adz\xa8\x18\xf8\x92-\xfdmw\x16\xd1Cn\xe0\xb0\u007fd\x10\xcb\x14Z70`\xfcf\x00\x03\xe9/\xf2\x89\x1b\x03\xcdC#\xa3\x1f\xdb\x03>\xa1~_\x1d\xc5\u007f\xfe\tG\xc0\xe6V\xb3\x1cp\x87?L\x04\tZRQe\xa1\xe0\"\x06\x19\xd8E\xc1h\xe6\x05{EE6*\x8a\x12\x98\x8cG7\xdad\xc0\xf5)$,\xe5\x0fR\xd1\x1a&`&X~e\xca,}\a\xf8)BmlQ\x0f\xb4\xcak\xd5eO\u007f>\xe6};\x9b\xb3\xefz\x8d\xa9\x96\xb7\xcb\xfb\xcc\aU\xe4B\\\x16n\v\x94\x86\xff\x00x\x83\xa7\xad\xc7l\x0f\x11y\x92\rc-\xd4\xd3e\xc8f\xa9r\xf1\xcc\xd94|@\xc5AI\x91\x96Dt\x93\nOs\x17\vy\x85\xc0\x88\xba\xab郬W3gp\xa2\x94̮\x19\a3\x84t!\t\xe7`\x83\xd4\x0e\x17\xfa\x82\xb0\xac~\xa1uj\x1a\xe0գ\xae%\x9c~o\xfcF\x9d\xda\xe4\\F\x96\x8f\xe9\x18\xc9\xed\xf7\x14\xf6/)\xd0\xdeY\xfc\xa7\xbf\xfa\x8dN\xff\x00\xa4{\x1c߂5g=Jh\x0fs-\xbb\xf5\x1b`\xd4\x06Q\x1c\x01X\x80\xf2c\x06\tw8\x12\xfa%=\x0f^Q\x06ϥΐ\x06\x9cF\xf6R\xa6u\x17\xd70s\xc34o\x94\xacbӉg&\xa6\x06\x89y\x17\xf5\x05\a٪a;\x87W\xb8AX\x80rϒ`r\xf4\x90\x80\xcbz\x81nm\xc2\xe1)\xab\xd4!3\xe6`Z\xe96\xcc\x15\xdc/5\xf6\xf5\n\xcd\xd5g3\f9\aQs\xf3=\fJ\n\x19vA\x85\xdb\xc6\xf2\xe107\x02\x19\a\xe2\xce\xe5_\x88\xacyӨ\x19\xb4\xbcxcuJr\xc6H\xff\x00Q\x16\xd1\xf1*\x90S\xb8\x02\xf8\x18\xba\x95\x9d_\xa2[Mnm39\x1eO\xe22j}\xea\x11\x95\xe2\xdb22\x9a\aS2\xd6|A8|\x10\bӘ\x0f\xa8\x19@K}L\x80\a1&\xfa\xb9\x9b\xaePq\x9fQz\xb60[\x9d&&\xceS\x83\x82\xc8\x14\x031\x0e\xd78\x9ci6s\xaa\xcaS\xdd\x024\x02\xf5\x98\x1c\x9b\xba\x19Z8\x8aD\xad\x0fikk\xf8J\xba\xd3\x19\x8a\u007f2\xeb\x15w(\xd0L\vH\U00060683\xe5\x80\xd9\x14\xbf\x80]\xfdT\x99\xed\x8dn\x0e\x05QG\xb8`\x906\x98\xd2\x17t\xbb\x84\x89xf\xa3f\xf9\x1eeo,Ƭ\xb7\x98\xfccgq%j\xd5\xd4A\xa0\xbflJ\to\x84\xa1\tg>g\xf41:,w\x97ܹ6;f\f\xad\xf3\x04\xc61\xb9\xaaKT+\xf0JY-o\x983\xfav\x99\v\x14\xe2\\0\xdd\xcb\x10OlNA|\x91\xd7c\xce\xe6$\xc7QK3\xfdG\b)\x06\xbf\xe9\xcc\xd3\xcc\x030t\xd2\x10D\xc5C\xf72\xb7o4\xe6P_8]\xbe\\\xf3<\xccs@\xd5\xf3\xafp\x01bu\xdf\xe1\x86/\x01\xc8\xfb\x8b\b\\\x15\x98\n\xb03\x9ea`|CM\xca\xfb\x8aŪ\xe6l\x8fs\xb3N\xa3+ԣ\xf1\x82\x05\x92\x84\xachc`\xbdB#\xa3,X\xa3\xdb/\x89}\x10\xfb%\xb8\xcd\xc9\xcc\xca\xd3\xd3\x05\x8a\x10\x9c\xa9S\"\x8b^\"\xbc\f\xa1y%\x85Y\b\xc1\x9e\x94\x1bh\a8\x9b\xafb8\xd0\xf0\x9c\x95\xe8\x9cg\xb2\t\xc9|\xe6j=\x038\xb30(\xef+\x02\x97\xe4\x86_\xf8\v\x04̮\xaaS\xb3\xe6\xdcxR\xc8\xf9E\n\xb1\xdex\x87l\xe4h\x80\x94<\xcd jr\xeau'\xb8\xba\xa1\x14g\x9c\f\xa5\x1e\x986\x93\x96\xe5\xd9\xfe\xf6d\bE\xe7\x1c\xc2B\x96=\xc2\xc6݀\xd9\xeaTsY1\xa9\xfaJ\x03˙\xa8'\x84\xc5ؒ\xa1\xcc\b\xc1\v\xa9^@\xceZ\x81\xc7\xc0\x85\xfe2S\xc0|\x12\x9d\xf1Rp\xbc\x98\xcdQ\xe8&Jo\x8c\"%\xfb\x18\x85sx\xfbK\xaa,\x1a5sU@\xbd\xb1\xf3\xb6f\xfb&\xcc-ޱ8c\xe8)\x97\xba\xb5ݱ\xf7!\x845*\xb5\xabu\xa9TO\xb8\xd1\xfc\xb9\x8b:W\x14\xa8n\x85\x14\x85z\x9a\u007fw\x17\x97g\x89\xaa\xf4\xa0\x9b~\xd2p?\xb6\xe3x}qi\xf4\x1a\xfe&\xe8|\xb1\xb7\xa6f\x02\xf6\x86U\xef\x86\x13$\xfb\x85\xd9\xd9\xc35\xae\x9db24\xa5b\xcd\xdc%\xc0\x84\r\x990\x005\xfbL\xb3\xf4\x18'\xee\xed\x12\xe8\xfc\xc6\xee\x8f5(\x16\x0f9l\n\v\x82Z\xec;\u007f\xb8R]\x18\xbb\x98֣\xce\"up\xbd\x13kbx\x15\x9fP\x86x\x1c\xa3\xb3q9\"P\xb0\xea湹|\xc3\x1a\xad\x1d\\E\x00&\xfa\x8d\x9bE\xe5\x9a\x05=A\x15U4\xe6\vJ\xe5\xbc˭\xcb\xc1\x17P\xae \xebJ7g\xda\x04\xb0\xeaɸ\f\xb8\x96\xb6e0\x00\xb0<\xb2\x81L\xeb3\xf8\xe2JkW\xb7\x11s\xd0\x14(<\x17-\xa8U\xe1\x0e\xfd\xc6[f\xe5\x1c\xc5\x03Yv\xf0\x1e\tʏ\xd4\x0e\u007f\x01\x16D]\xdc\x19\x98\xbfR\xe5ύK\xa2\xc1]T\xc8\r\xe7\xdc3\xd9\xf1\n\xdbT\xed\x17\x84Cļ\xa62To\xa0\x98\xa0\x9c3\x94\x1d\xb0K\x01\xe6\xd9\xfe7;\x18\x8a\a\x8e\xeeʨ\xfd\x18A\xe9\x9d\xe6\x00\nX\xc3\x1ef(-b\xeaXT\x9a\xacbq\x19E\xa5\xcfg@\xdc\r\x8b?_\x98\xbd\x87\xdc6\xda_PbF\xcc\xd2ԡ\x9fcsVS\xee\xf8 9\x83\xb0?q\x15\xa3\xf0\x8d\xa0\xe7eCT\xf0OdEܸ5\xedA\xaf\xd8\x03p\xac\x90\xbc\x10\x02\x0e\\\xc4X3\xed\xcc\x10>&\xcc2\x83qu?\xb9\x9a\x95\xd3\x1b=\xe6L\x03\aQ\xf9\f\x1f\x80\xa9\x93}\x16l>\xf4\xab{%d\xe0\x86\xe5\x1a\xfc\xe2ű\xe5:\x8ey\x96\x0e\x1fqC\x9cԭh\x0fp\x1c\xc3\xec\x97\x0e\xce+S\x8d^ټ\xb8kḈ\xb7\x85L\xb2\xcf\x11K&\xe5\xc5\xe4\xc5\xf2\xfe&M\xa1\\ΰur\xc5\x03\xa0N\xf2.ȸi\x85`\xec+s\xe6`\xe0\xfa\x8d\x1a\xfa\x83s\x06\xaf\x9a8\xa1\xaf3\xf9\x96\x8c6\x00\xb6\x9a\x88m\xfc\xcdf{\x8e\x89\xe8\x85ZoDD\xdar\xf0\x8c\x0e\xe0\x93\xae\xbf\xe6e\x1f\xf6\u007f\xd5\v\x01\b\x0f-\aº4Ԥ\x1a4\\\xc1\xc8=\xceh\t\x93q\x9b\xb1\x948\x84Z4\x06\xe9.g\x98\x1a\xa6\x0f\xf3\v\x1f=_\xf33C\xe0\xda\x1d8\x99ML\x9f\xa6e\x8cq\xeb\x1e\x812I\xf1\x0e8\xee*X\xb1E\xd2f\x95\x87$/\x04\x95\xf2w.\xac\xfdA\xbb\x18:\x8a.^\xa2#\xf5;\x81u/\x88\r\xc4a!\xd2=\xb2\x99/\x10Y\x89\x11dV~\x04\x1f_D9\x04\x18\xfe&\x1a\xc0}\xa6\x05\xd5\xfb\x8al\xa7\x98m\x16WV\x9f\xc5e@hz\x10ج\x1b\x1f\xe8\x81p:\x8b\xc1\a\x96\rr*X\x8c\x0f\x12\xf6\x8b\xc7\x13\"v\xaem\x8d`\xe0Kl\x81ۖt}\xa3{\r\xb5\xc3\v\xb0\x19\x13\xba\x82\xc5M\xc5\xd4~\xb1\xf5\x1d\x17\xcbο\xccȅ\xd5;\xf1\v\xd3\v\x8c\xb0hY\x82\x13b\xba\xc4\x06}\xf9X\x06\x04\"NUEG\xc5ʐo\x1a2\xcc\xe8p%\xe0\x05ɫ}\xc0v\x0eJ\x9c\x1f\x16\xad\x85\xb2\\A}\t3\x013\x0e\x1f\x01/\x97\xe5\x18\x03}ʼM\x06\xe6P\xb2\xdd\x16\xad\x182\xb0\xf3c:}\xf3\x15{J\xba\xb0\x97\xc8 \x1e\x85\x8eY\x8d\a\xccP\xce<̪\xa9hA\x0f\x88\xee\x14\xed\x86b@\xf4\x9e\b\x84Z0%\xe5\xd2КY\xa5\xf3\x99CCn\x82Y1\xf3Е7\xe7\xa7\xf1\b\x1er(\x82\x90\x8e\x8a\"\n\xb3\xa5\xcbKU`\x956@j\xeb\xcc\tg\x18j\xa18DV\xc0n>\x82\xec\xf5-Z\xa0\xbay\x83\xb1WP\xb0\x8bf\xfd̨\xd2S,݄\xb2>\xa9>\x91.\xf4\n샼\xac\x06\xca\xf0\xd4\x1c\xb0s\xe1\xc1\x82\x19j\tr!WS\x95\xed1\a\xe91=/X\xc0\x92,O\x05\xc7A\x84,m\xfcʳr\xb1\x05\x10\xdck\x91\xf8\x9d\xddu4\x1bp\x97\x90\xfeiK\x89\r\x8a\xf8\":P\xf7\x17z(2\xd6\xe0%\xdfi|\xaa9\xd3\xd16q\xf2\xc1\x9a\u007f\x15CfI`e\xf8N\xb7\xed\x1a\x92\xd1\xe64\xb8/\xfb\x98\xa6\x05\xd6\"*\x98\x8a\x0e\xea\x00=0\xa3\xf5\x18?\xb7)Cm\xec\xc4SF\xc6\x13\x97w\xca\x1bI4n\x00\xa9\n\x8b.\x06ɏ\xc1\xb1Q\x1e\xaa\xd8y\x95@e\x1eS\x88#\xd25\xdc99lۺ\x82\x8bZ\xa8\xe64\xe3\x1b\xba\xa8\x1d\xa81k\x97Yf\\\x8d\rz\x9bf\xf7,\x15\xf9\x01.\x81Ա-`\xe3(4\x14w\xe6Vk\x9a\x86g\xf7\xc7s\xec\x97ns\x1es\x15\xac\xd2m\x93\x10[\x8dC\xaa\xcc%E\xf3\xe2-\x1c\x8c\xc9\x1d\x93t\xba\x8b`\xe8\x97)\x87\xb8Ҍt#P\xf3\xe6^\x8d\xaf0\xca\xcb|K`8\x94\x8b\xe8\xb9}-\x1b/\xdd\xc5\x1eOr˥\xdf\xe0\x03<S\xb8ɳ\x1b\as\x05\xaf\x824\xa3\rL\x94\xb3:\xe69\x90e\xfa\x8d\x05Z\vC\x94\v)\x1aR\x90\x99\x9e\xd3\x10C\xba\x0eL̚\x99ޫ\x88\"\xee\xe0<\x92ޫ\x04\x17\x01\xbeّ*\xad\xf1\xf0\x89\x98\xbe\xa5\xeb\x11:\x974\xbb\xb6\xf7,op\xa6v\x1f.\xe2j\vÉc\xccf\xbf\xf2\xa8z\x87[<\x86IM\x01\xe5\x952N\xc6\x11\xcd=f&\xa8\xf72\xb5\xfc\\L\xdc\x10\xf7\x88\xa2X08\x8e\b{;\x84\xd6\xe9\xc3*U;\x81е\x82\x1a\xd6%\xd4\xdb7a\x98\xb8C\xba\xd4\xe5\xc4\x01Rw\x96i\xfd\xa2:\x0e\xbe\xd2\xcei\xc7z\x99r\x06\x0e\xca\xf1\x05Y\x8e#\x18j\xe2*\x86Q^X\v\x0f\x17/\\\xb7\x0e\fv\xb7\xe43(\xd2\xe7\xe6T\xab\xeb\x93Q\xff\x00\xe4\xa6ڞ\x90e\xb4nc2\xe9\x98\xcbi\xf5,\xaa\x1fp\n\xd2\x05*\xf3U\xf8.Z\xd5\xff\x00¿\xf2\a\x87\xe3V\x12\x01S\x06\xaf\xf1,w\n\xccX]>a\xb2z\x11\xf5\xe8!\x85\xfb\xcd\xcb\x1a\xdbh@\x80\x92\x96\x8f\xb0\xa6\xb2w\x16ݞ\xd2\xd3k?q]\x81\xeaf\xf1\nĲ\x90&\x1ae{\xe0\x9ee8\x87f\xbb\x8ek\xf6\x99?\x03\xb8x*\xd9p\x8cL13\x00\xab\xccʂ\x1aR\xae!گqs}\x04kx\xbb\xa9H\xe0\xf5G\xf9D\xca\xf9\xd9\xfe*\u007fA\x99\x82j\xf7-y\xff\x00\xe1_\x97\xed+\xc4'G3l~\xe1\xdfQc\xe2^y\x83=K\xab\xdf\xe0\r[f7h\xbf\x1f\x8b#j\xe47/k\xfe!\x92\x8f\x98x\xc4~\xb1\n\x96(|\xa7\xd5\x043$\xea\r\xde\xe7h\xb9RT\x0e\xf9\xc7\x105Ѷ&\xea{`\f7\x1aI}\xa4\x02\xb3gS\xfa\x84n\xec' 9y\x80\xc6*v\x9ag/\x89\x9aw\xf30\x9a\x8dAj\x00%m?\xfa\xa9hCY\xa4\x1ab\x015\x88Ug\x98\x95\xd9>\x19\x97\xa01\xc4\xc3D>>\xe6\xe4\x1d\x91\xf5\f\xe2} \x95\xfb3\x8b}!\xc1\x17\x1c\xd5͠;g\x00\x9d3n\xf5\x92\xbd\x90\x0fG\xc1s\x8f\xfe10\xed-\xf6\x81\xe0\xf1\x19+\x13\xb5\xe5\xd9\x12\x9boh\xa1\x18\x1d\xcb_-KA\xa59\xa9Pg\x83̲\xba#M/ܯ\xe5\x03q\x143\xf3\x10\xc54\xf53\x15~\xa5Y\xf5\x94\xc0Г\xfa\xe0\xb8j\xd0\x17\x01\xe2\x8c* \xe2\xe9\xe2-\n\xe6v\xaf\x14\x15\xc4\xd1\x03\xf0\xa8g0\x81\xb1\xc0\x8def\xc1\xa9\xb2\a\xa9\x95HVc\x12\x86\xe0>\xe3U\xe8\bt\x95ʨ]_Y\x97\xad\u007fP\x99\xbf\xcb\v\xca\xf1\xb6\x03T\x1d\u007f\xa4\xd6zd\xa5\xa6\xf9J@ټD\b/\x06%\xa9b{b_\xe2a\xd92\xe7\xe5(\xf3\xae\xa3Q\x0e\xac\x12\x17\x91\x97mP\"5\x9e&\xa7\x0e\xc2\x01\x80\xaap}\x98]F\x8c\xd2\xcb\xe8k\x84E\x00_\xb8y\x80\x96\xf9hO\xa5\xc1\xd0\r\xf6Ի\xabd\xa2\x80\xf4\x06Qc˘\x8a\x8d\n\x84ɡpW\xfbK\x03v3\xb7\x98Z\x18\x97\x9f\xcc\xce\xc1*\xf8\x8f\x05\xd1\xf4\x95\x89\x99ZaWy\x94F\xd1\xd9I\xe6Rԗ\xb0\x8bK\x1e\x84\xd8\x10x\x179o\xf5\x107ygK\xddD\xde\n\xe0\xccmO\x82\xa9\x9e\x88\xf6\x9b\x87]\x12\xe3㘘\xa2\xbe\\\xc6\xd3\x1fp\x13\x89\x93Ne`@׀s\xe2%\xff\x00K\x8b(9\xeaX\x8e!\x9c8?\x89AE\xf9\x95\xbb\x87\x138Ώ\x10\xadW\xd3(\x9d1K\x0f\fܼ_/\xeegqF\xb1\f\xd6\t\x06\x19[\xcc]\xf8\x18\x89\x86|¯\xafP\xa5\xd3\xfb\xd4F\xd6\xea\xe2$Ъ\xeeU\x95\xe3\x9b%\xb9\xb7H\xb5\xbdʙcw\v\xbb\x8cC\xcb\xf9y\x80\xa0\xe2\x19\x86\x03\xb5\x8b{^\xd6fq9\xc0\x85\xca\n\x1cs\xd4b\xd1\xf1\x15\xc8\xf6\x86'\xec\xa7,}\x13\x11\xf2\x95\x9c\xe3\xddP\x16g\xcd\xcb\xf7\xd4\xd26\xa0Us\xff\x00\a3\xf5b\xe5\xe8\vܡG^e\x82\x96\xde\xe5\"uq\x12\xee\xe8İڼ\x91h&\x8e\xe6ҷ\x05`\x15(e\x99\xe1\x88\xf4\x9cէs\xb1\xa6Y\xb6,\xe2\x1bIl\xe6\xe2\xe8\x04,\u05f9`\v\x8f3\x16Xz\x8eY:&\x1b?\xe6+]\xf4\\\x1c\x92\xb9:\x8a\xcb\a\xd5\xc4U\x8f8\xa8\nW=\xcc:\x18{\n\x98\x0f\x12\xf8V\x1c\xb2\xeb`\x1cB\xfcn<H\xf5\a\x10Wl8E*\x9d\xb7\xbc@\xf6ȥ\x891\x02\xa4P\x1au\x8c%aѡ?`\x90E4n\b.\xa7\x17\x00\xfd\xac\xc3|\x1e\xc2\u007f\x16Xſ\x85\x8a\xed|\xce\xf3\a\xc1o\xe6\x1f\xad\xd1BSU,\xc0j\x12\x1a\xaf\xa8\x8b?ĵ\x17\xfb\x95=\xa066\x13 \xbd\xf8\"\a\x81(\xbb\x89Fj\xf19\x00\x84\f\x81\f\x9f\xd4s\xe14ek\xbb\x8e2\xccez*Qx*Y\x98\xc3j\xa0\xe6\x17:<D\xcb\x15\x9dʽ)\x1a\xb8\x1dJj\xa5y\x87q\x85\xe7\x86!(0\xaeEn\n\x96\x1eْ\xc9੩\xd9\xc6bR\xf0\xae\xd9\xc1G\x96`7l\xff\x00\x88̣LA\x88^=C\x98\xf9\x8aa_3(/)\x1e\xcc1m\xaf;\xb8\xab\xdbb\xa6Z\x15\xed+\xeb\x0ffbK\xf4\x02bC_&V-}g\xe2\xa1ľ\xcb)\x13\xa9\aef\x17\xc4j;\x94\xce\x1e`\xe9k\n\x9b\xaa\x90ߘ\x94\xbf\xb1*\x80\xb3\xea)\xc6\xe3\x15y:\xaef'L\xe53\xe5)\xb0R\xf4\xc2\xdc<\xa2\xc3D\x13\x15\xa4\xc8Ξe\x8c8 |\xc3+\x81\xe9%\xbe`կ\xc3\x17.\x99f\xaa!R\x11)\xf2\x94\fne\x98?\xa9\xf2Lcpm\xa0\xad\x15\n1n5\x8bH\x88y\xeaPx1\x06\f\xf2\xb2r>\xa7\x16\x86\xde\xe3K\xe2\x04\xf1]%\x1c\xaex\xf3,\x1b\x1a\xd91\xa5o3\x99*U:\xf3(\x1e\u007fS(\x1b\xab\x9c-\b\xec\x10;\x8e\xbf\xba\x89hm\xfdJM\f*\xe1\xca!`\xb35\xc1\xe6\xa3w\xd4ZF\xf5\x1fO\xe6\x1a\xd8pjX*)\xbb\xf9L\x1cV`\x16\tpZb\bF\x0f\x12\xd5h\xfcF\xc7\np\xccJh3Q\x17j?\xccU\xae\xa5\x04s*j\x95\xb9\x82+\xc29P\x11K\x99\xa3\xfc\xce\x0f\xb2\x00\xc1^ \xac\xeeo\xb3Ԧ3d\x1aR\xc2\x19\xa2n_\n\xaa\xd4\b\xb7oP[\xd5nbZ\x15\x97+5{n&\x83\xf9\x9967j\xfb\x9c\x02ܐW긝G\x9e\xa5Ykx\xc4\xc8+\x83\xb6Y\x82\x93\x15\xacZ\x1a\x919\\\x17\x85ےt\x136\xd3]J\vע'\xc6g2\xb4\xb8\xceZ\xea\xebq\xae\b\xae!\xc0/\xc4ju\xcea\xdd\xd7\xeeS\x92\xf9\t\x90\x88\xbb\xfbD\x86\xedtLy\x0f\x89\x85\x9f\x99`*sy\x99m\xb7\x88\xa9fǞ \xe2\xd5-V\xce\xe5])\x87d2\x05J6\xba\xc7\x11L\x92\x87\x01\xd4s\xb8\xb6,\xb3\xd4[\xb0eh\xc2L\xe7)\x853\x82}\x81\x04i\x87\"\xa7 W\x88̛\x94L\xd3)\xb4y\xeaR\xe0\xff\x00\xa9\x89Vnd\xcf\xd3\x068\xe6\x16Z\xbaco#\xf8\x94\r7\xdc|g\x88T+\\\xc0\xda3\xa8-\xf9It\xff\x00V\x1b\f\u007f0\xac&\xefR\x9c; \xae\x1c\xf9\x8d6\xbb\x8c\x0f\x93lE/\x01,:\x1b\x8d\tv\xfcG\xd0\xf2\xcc;9{\x8e\xf5\x9c\xc5\a\xf8Au\xc32\x05J\x8eW,ަR\xf8\x96\xa1MsP\xcf\ue211\xe2`G{;\x89\x9b{fxa\xeedV\x1f\xd6gS\x1d\xf5\x1b?\xb4\xb7\t\xf30\xc0<EW3u\xddM\xc0X\xeb\xf0\x1e̸\x94\x8b#\x02\xd68\x96z\xce\xe0\x04\x18p\xc5\t\xb6~\xa6V\xb9\x94\xc7jap\xdc\xe5\x9e#\xbb\xfa\x8eo\x93\xa9[\v\x1eb\xb6\x9c\x9c\xc1\x0f/\f\xb1\x86\xa0\x1ef\x80jc;'.\xed)D\xa5\xf3\xc4S\x001.>;\x96U\x8aL\x05\xf5\xa8\x88\xde&n\x94T\x89\xe2\xa6\x01\xb6\"\x81\x97\xb8\n\a\x171\x05\x0e\xa5\"\xec\x8arg\xb4\xb54\xa3\xa9\xda1\x17\xfef\x10\x17.[\xb2[\xba7\xdfr\x8d>n]9\xae\x91J\x95\x8e\x10\t\x9f\x94\xdd\xe5\xd4\xf8\x89Jҗ\x00\xa0o\x92\x15Jי\x8d\x8f\xb4ұ\x85\x8dbo\x9d\xea4d\xb1\xa8\x0f\xbc\x14\x00z\x81*\xbcq\x13\x16\xa3\xd5y\xdcJT\x05\xbd'\x00?0\xa0\x96%\xee]\xaa\xbd\xc1U5\x98p\xb7\xfbE\xbb\x1b\x8a\x8b\xda^\xfa5\xf7\x17-\xc2\xceX\xad\xc1\x0e\u007fSEK>\xe1f\x9e\x1a\x9a\x15\xf3\x03H\x19\x94\x159\xb8\x14\xaa!\xa8\xb1,\x12\xf4\xee\x0e\xafC\x88m\xfaD6\xe5q\x12\xdf\x13\v]v\xc0B7SZ\xe6V\xfa%b\xecs4K\x11\x82?s\x91\xefq\\\xc1\xf1\x1c\x90\x04\xaa\xf8\x96\x03\xfcʎ\xef\x11B\xb4s\x03\xc9\x1dD\xb1U\xef\xccm\xba a\r\xec\x9b\x1f\x82Q\xd9\\J\x84e;\x94\x02b\r\v\xf7\x13\x18\xf6'\x03\x9cL\xa5'@E\xaeа6\xd5\xd3(\xd3\xde%\xb0\xb5\xb8\x8c/\xb9\x85\xbd\x9a\x8a\r\x88`\x11r\x85w\fU\xe6\xf5Qj\xde\xe3\f\x93u\x1c\xb35t=JVr\xb7\xe2\rs\x8f0[\x91\x88F\rtͭ|\xf5)r\v\xfa@ҩ\xeed\xfd\x18,\\E\xbb!C%\xa0\xab\x98M\xd9r[R\x9c\xee\xfa\x8e\x8e\x19t0\x9ao\xe9\x14\tfQ\x02k+\x1f:\xfaĳ\x02\x9ef\xd6\\\xd0/\xead\x16\xa7IZ\xe6%Y\xaf\xb8\x15]D+\xe8\xcb7d\xabÎ\x19\x94pZ\x96\xbb\x83\x16\xec\xfdAh\xa1\xa9\xa1\x04\xcf\xe8 #\x8e\x1f\x94]t\x94,\xcd&]&\xb1\x10*\xe9r\x946\x8fܼ\x1b1M\xb9\xe6l]\xb39Zx@6\x0f\fV\x8dW\x88\xe57^!J\x94r\xe3U68\x15\xab\x98F#I\xea]{˚[y#\xaaq\xd4\x15\xc3> \xbbg\xa8@f\x91\x05\xb7\xb4\xa41\\\x90\f\x1c\x1cD+b\xb57\xcc\xd4\x19\xed\rp\x15\x8d̘\xae\x19\xb3\xb2`ܦ@\x18\x88\xa6\xea]\xd1\xc9\x1f\r5\xabc\xab\xe5\x89\\\x0eR\xbe\x96Di\u007f\xb8\b\xfc\x11n\x9d\x89\x8a\xb4?Pi0jpP\x93V\x1a\x8c\xd55Ơ\xb8J\xb64yO\xb9\v\x90\x12\xb0\x8eev\xa9\xc0\xe4\xe6\x1c˭Q\x1b0\xe2+aC\xb8\x90\fW\x1dͶS\x12\x8d\x17\x1f\xc4F\xec\xe3l\xb4\f\xc0\xc2\xf7Q\xaa\xf6\x9c\xea q^\xe5\xbbz\xa9c\x8bc\xfb\x14)\xfe\xa3\xb9\x9e\x92\xb3w\xd2`c\xccΔy\x8e\x1bL\xdeu\xb8\x83\x9a\xbf\xc7\x19\x1b\x18vC\x04\xc1(\xe2*[@\x17\xba\x8d\v\rpj%\xae\x0f$K\x05\xb9\xb5T;\"ͤ\xb6\xf7X\xe6.\xc4G\x99b\x9dF\xc7\x06`\x16%ڳ.\x9c\x19\x8d\x06Ijf\x96\xa6I\xf8N\"\xbb\xf3\x11K˸\xdb\xe30\xdbLl\x1b\xa8\xa5\xbd&REȹ;\xf1\x19\xb3\x9e!\x91\xb9\x1d\xb5\xeb\x05d\xab\x89eq\x17d@\xa6^\xa1\x1e\rJp\xac\xc1\x14\xcd\xf3\x1d\x8a\xeeQv\xb9\xe2\x0eH\x1bb\x96\xaaY.e\xe0\xeb\x9eb\xb0\xea`\x99a[\x1c\x13KX\xaa\xa1#\x868^\xd5\a\x05\xb53z\x13\x98\xb8A\xbb\xa3r\xc7|%I\x83\xc4Ȧ\xfbL\xd9[X\x8dU\xc2ů\x12\xb1ϸ\x05\xb7\x9e\xa5\xb6\xe1?\xff\xda\x00\f\x03\x01\x00\x02\x00\x03\x00\x00\x00\x10\x91w\x84\x1f\x92\xcbX\x1a\x00\xff\x00\xb9\xa4\x1f\x9bL\x93养\xfb\x06\xabi\x05%\x91KYa\x00\x84\xb6\xca\xc88\xefz\x17\xde&\xd3HLZ\xff\x00m\xc0\x83Ki- \xd7R\x17\x19\xbf\x90\xa0\xc4>\xd3h6\xa0\x00핂[h$\xad\xf7\xd6\xefM\x1c\x13\xa4\xb6T\xda\xfd\x8b\xe6\xdfoe\xb0\x03\tD\xbd\x9f\xf6ɽ\x1c/\xb3\t\x81\xb2\xd9q\xe1D\x14\xd9k|\x0f\xde\xef\xbe*:\x91\x8aT\xcak\xa4,\x94\x16\x9aU\x03\x84\x96\x13\xcdQ=\xd3\xc5&\xe4>VO\x05\xb8\x96\xbf\xfe\xdaG\xfd\xf7\xf2?\xa8\xf6d\x18s\xe9\xaeC\x11\xb7\xe7r\x9f\x1f\xed\x92f\vJm\xe0g\xbb\xbf\x0f݉3\x01\xc9J\x83x\xa984JI\x1c@ \x1fM\x1c\x9c\x97\x81\xfd\xe26\xfe\xdb\xe7\xec\x95\xc7d\x02m&?\x9f\xec\x99/\xe1\xc0JJ]\xb4\x0ex(\x9b\x02I\x87\xcbm\xa6\xc1$\x01\v\"~\x1cQ\xe2\xd5P\x896\xda\b;Qˮܘ\x00(\xa6\x8b\xa4V@\xc0\x0e\xe0\x13¹\x82nwV\x96\xa5\xfb/mɶ\xda+\x12\xf7\xa1\xed\u007f$\x86\xd1̇\xaac!\x93ќ\x95=\xa5\xf0\a\x8ep\xc7\xfac\xa2Z\xb5+\x8f\xbbL\xc6\x02\xe7\x14&\x02[}\xae\x82v\xa5O\x92ʔT*\xd9%\xa3'Q\xc7/\xac`\x1f$\xa3\x1a`~OhE\x81J\xde\xf4a\x88v\xd3A\xc0\xcd\xee\x96\xe5\xa3JG\xd5<\x16@\x99\xac\xc7Y\x13\xeaA\"\\%9y\x17\xfaM!pw\xc4kQ$\xa2\xd9s\xdb:\xb8\xe1\xfd\xdc9<A̒\x8c\xcc&|f\xb7\u05f7\xe5\x18\xe3DUS\xb2/EW\x90̵}\xa5\xecb\xc9w\xf3k\n\xfeL\xfeH\xaai&\x01NB\xc9駏\xf2\xc6\xde6\\\x1b\xa9\xee\x91\x13/3\x9c\xe4\xed\xb6Y\xb9\x11]/\xaa\xa8\xb7\xae\xe6'\x14Q\xa8F\xe1\xe5\xec\xaa\xf2!S\xa1a\xa9\x18\x8c\xdfZ\x9c\x1f\\;S\xb0qS\xd6\v\u007f\x9f\xa6\x80\x9bl0\xd0\xcf\f撢O!*\xd9t\x99\x03\x13\xb4y\xa2\xbc\xec\x89/\xed\xcd4\xac\x04vH\xb3\x93\x0f\xf2?3%\x86\x10>ա\x10'&\xff\x00.\x1a\x17e\x01\xea\xac\xd0\xf2\"\a$\x04\x00\xc7\u007fq\x94\x01e\xd6g\x8a\x8d\xafi\x83\x85\x06ĒH\x03\xf9CnYZB\xb9.\x96\xb7-|\x045\xc0jI$\a\xb5\xe2\t\xaa \xed\xa5B\xf5\xa6\x16H焖\xe5@\x00\x0f\x92\xd2\xe6>\x96X\xaa}\xadR3Z\xcd\xea̓R\x84\x9bI\x1a\x00\xb1\xf7\x93\r\x1fF\xc0ڱ\"tM1\xd9g1\x93K\xa0\xfd\xa7\x82\x9b\xb3'\xccM\x8b$\xef\xd3~\xb3]!3\xd4\xdd\xf2\xc9SP\x03[\x8c\xa3\xacJ)+\xb6K;\xea\x90h\x05\x8cŊ\x12\x04\x1f\xd4\xc3m\xa8Gp+%\x91\xc6\xff\x00\xd6Pè'-\xdc9fj\xe5\xcfF8\xe2r\xb5(q\xa9\x1c\xbf\xb8\x03*\xa8J;{\rQD\xafUʬ\xac\xa6\x91ʘ+s\x1b\xc8m\xa6\U000fb11by\xce\xecU{<\x91\x04\x92\x97R\x10o\xaf\xb0N\xc9c\xae\xe1|>0v\xee\x8bD\xad\xc1l\xaa\xdcŵ\xa6w\xc9Z/P\x13D\xd0Y,N\xd9dv\xb1#V\xa0\xd7I\xb4\xd9\xde#l\x85\xd0w\x1f\xa1\x13\xedfɩ\xb7\x03\x91*C\xf1<\xf0f\xd9eU\xccI\x0fe\xaa\x86T'\xebؔ]H\xc4\xd7\xe0\x17\x80\x15.]Ɨ̌.\xae\xcffvE\"\xd0\xf4\xc4\x16\\\xc8?\x96\xe5S(f\xfc\u007f\xd6\xfb\xb3\xe2V\xf6\v\xbbh$ʭɰH\xff\x00\xff\xc4\x00%\x11\x01\x01\x01\x00\x03\x00\x02\x03\x01\x01\x01\x00\x03\x01\x00\x00\x01\x00\x11\x10!1AQ 0aq@\x81P\x91\xa1\xb1\xff\xda\x00\b\x01\x03\x01\x01?\x10\xff\x00\xb0a\x86\xdbm\xb7\xfe\x03O/\x9eه\\\x9dݱ\u007f\x8f\xca\xcf\xfaF\xd8m\xb6\xdbm\xe1\xb6\xdbo\xee\xdf\xfe\aa\xe3m\xb7\xf0\xdbm\xe3m\xfd;o\xe0\xcb,\xff\x00\xb3m\xe7m\xe7m\xe1D\xc0\x9d[m\xb6\xdbm\xb6\xdb\xc3m\x97\xf2\xcb,\xb3\xf4g\xfc\xd8\xd8څ\xf3fK`6\xdbm\xb6\xdbm\xb6\xcbm\xb6\xf3\x9f\xa1L\x9e\xa4\x99\xfa\xf2իq\xc0n.~\xef\xe9\xce\u007f\x8b~\x96~\xa2j\xd8\xed\xc9c\xb8\xbd\x16\x1eq\xb6\xdb6\xdb\xff\x00\x03t\x96\x8c\x84\xc6\xc6#w\xfa\x9f\xea;{b\xc1\xec\xa3\xe2-\xf2cûV\xb7v6\xb8\aV\xcf\xc8\xe9\x95\xf6Z\xef\x0f\xd3\xfeV\x13\xa2[\xab\xbbl|Ƽ\x96ڵv\x85\xef%\x9e\xf0\xff\x00w\xf1\x11\xe6\x16\x90F\xd8\xfd_\xc2\xfe<\x1f\xda\xfe\xde!\xfc\x87͉\xdf\v\xc6\x1f\xeaL\xff\x00\x8f\xac\x98Hęٺb\x03i\t\xf0\xbb\xbc\bO\xff\x00V\xdf\xc6\xdf\xd4\u007f\xf2Y\xda?\xfa\x8c\x9d\x01\xff\x00\x928\xb3y\xdcn\xf5\u007f\x97\xc1\xe2\xd6٩ğ\xcf\x19\xb6\xdbm\x88\v\x0e\vL\x98d\xf1\xd7FQ\xef\xea\xcf\xc7,\x92{x@1\xb3gŜ\xe8:\xb7\xb31\xe4\x10\xc3^\xe4\x11\xcd?\xfbY;\xff\x00\xf2\xde믖.\x9e0\a\tL\xd9]\x19\x80\xda\xce\xff\x00\x05\x92\xe0\x89\xcc\xc38#\x13\x19O\xf8<K\xbb\xc8\xdd_\x1c3\xe6\xf3x\x90v\x8cN\xa0\x1d\xdd\xcf\xd5\xd9\xd1!v\xf9I#\xd3\xc2\t\xdcB\x1d\xdb\x1b\x8d\x89\xd7\xe3\xae4\x84\x96e\xe3\x0f.\xbcoY`i\xfbƷ\xcf#n\x92\xbc\xb4\xde/\x10\xe4\x1eZ\x1bgFp#\x10^\xad\x1e\xb0\xb3Fp\xfb\x8c8\x93\xa3\xb97\xa2\r\xc3&E\x8b$O[\x012\x17\xa4h\xe1g\x82\x10\xf6M㥮ę\xd7\xed\xe8OO\xe1\x13\xc0i\x1d٤u}\x10\xef\x18WA\xfa\x93{\x16>\v^\xe0uђ\x1d\xb1\xed\xbd[\xf7`\xbeG\x8dб\xba\x86\xf9iݟM\x8bD?\x16\xe3\x0f\xcf:\xfa\xbf\x94\"\xed\xf5\xbayl\xabK\xe7\xf9\x93?ZoWi\x1bdj\x17\x9bF[\xb2\x03\xc2\xdc\xc4\xf6\xed\xd2\xcc\xf2\xf3\xb2\xee\x1e\x8b\x00t\xbe\xc3\x1f\xc4\x13\xd2\xc1\v\x01\xec\xb9w\xd2\x11\x87\x91\xd32S\xb1\xc6\x06<\xbf\x8ctɶ\f!\x85\xfd\xad}\xdb\xc029b\xb9!\xec9h1\xb6bC\xf4\x1fv\xb9\u05cb\xb1w3\x027I\xb3\xb9\xfc #\xea+\xed\xbe\x90\xccj\x0e\xf2\xf0\xa3\xa8ɾK\xc1O\x96\x8e\xbed\xf4\x92\x1a\xd8\xeb$\x0e\xbb\xd88\x85\xfdq\x1eBor|\"\xf9$\x1flf\x9co\x1b\x92@ڽ\xda\x1c}\x91A^\x1f\x86Fg\xe8|\xb3\x9f\x9c\x85\x92\x8e\xa4\x1a\xdd\x05\xe4:\xec47\xa6\xf4\xb5\x84\x93\xd44;\xe5\xa9z\x9b;,\xb0\x1e3\xfd\xcf\xf7c\xf3\x00@\x1e\x16\x13\xc8\x05?Q\xe8f;\xf8\x94\xb6\fr\x1f6z\x80\xf9hc/6xx\x05\xac\x04\x9fA\x99F\x13\x13>7\x9f\xb0v*\x83\x03\xe5\x1br\x17M\xf1\xc7\xde-N\x9b\vO,=MtۻK\x13U\xf1km\xad\xdaݛ\xb8\xbe\xef_\xc8tl\xe02\xf9\x91}\x8b\x0f\xb2\x1d\xf9\xc7d\xf5f\xc9\x0e\xf9\xd7\xd4;\xe5\xa8s%\xf5\xda\xdfF\xdfLP\xfc\xc797uS\xd7e\xbd[\x86\xdf嶼B\a\xd2\x1f\xbf\xff\x00 \xcb\x1cH<x\xe7r\xefo\a\x05\x8e\xbb.\x1b!\xf3tr\x0e\x00t\x87\x1d\x94;<\x8cC\xeaBC\xcb\vIR\xc9\xe1k\xe2\xfeֿsz\x92vZ\xac.\x04\x95\xef揄\xa98Ht-\xba\x8d(\xeaK\xe2\xdbg!\x06\xbd±m\x03/!\xe7\x0f\x96^Ib\xed\xc0\xf5\x93w\x041\x93\b\xa6|\xcf\x0e\xed36'\xca\xda\xe1(1\xbc\xfb;\xc0쥵\x1fՋ'G\xc7\rZۡ\b\x1e\xcf\xc85\xe1\x00z\xbb\xf1\xde^\xd6zK\xdd/\x1c^ȏ%\x9f\xed\x83\xf20\xf5\x9dƘ\x9e2uo\xdc\xfd\xd7\xf6\xbe\f\xfd\x16\xfe\b\u007f%x˻\xbc8\x03\xa7\xb9\x18;\xbeC\xa9] z\xb6\x060?\x16\xf5\xc3\xd6\xce\xd9\x19b\xd8l8X~\x83\xd8\xe8H\xb5\xba\xf7\x1e\xf1\x81\xd6lK(\x17\x99u\x97\x89\x1f$-\xc8\xe9\x91\xfe\xba\xb1\xbdL\xba\x9f\x91\xb4\xba\xc3\xfb=,\x1dd\xf7\xe1\"\x10B%\xbfp\x9b\x19\r\xe9 %\xa4\xf0Z=XC\xe7a\xe4?\b'\x96\xb7\xb1)\x9e[\x19g\v\xf4\x1dO\xe8Ǎulǌ\xe4,\x1b\xe7=\xc0\xc0R\x1aF\xc0\x95kk\xb5\x91\xbb)\xed`\xb2\a\xc8\x0fp\x12]\xc3\x04v\xd9X^e\xe0\xc8\xfa@g\xe9 k/V*\xe90\t\xab\xe8\xbat\xba\x11\xca\a\xb8\xfa\x91F\xc3:\x99y\xcf\xc7\xe2,=\x8c\xd7m\xcf\x18\xc8\xf92\xd9\xdd\xd8\xc0\xb6\x11\xe79b\xd3 {\f\x85\xfc\x92\xf0\x92C\x1dÁ<o\xad\xd4D̀\xbdٝA\xc2\xea\x9f Py-\x01y)\xe2\xde'\xc6N\xef\xf2\xf1\x92Wd\xa7\x90\xf7\x18\xdas\xd4\xcb\xf1\u007f\a\x85\x1e\xa1\xadg\xa7&x\xa2\xdf\xeaH\xeb\xde;\x94\x1cg\xb9mr\xfe\x97\xcaB\xf1\f\x1f\x12\x8d/W{\x13\xff\x00`F\xe6\xff\x00#X|\xf9 ?>B\xf2\x11\xe9l\xff\x00\xc8\t/R\xf9\x91\x99u m֒\x1b\x8c5A\x8f\xa7\xccV\x86HM\bsM\x88\x86a\xf6\xdf\x00,5\xbb.a\xef\v\xbf\xd6\xfb\xb4Ɏ\x89\x1b\xb8ؤ>L~Q>\x9e\xad\xba\"\xef\xeaM{ Dl\x9a\t/\x84~\f\x9c\x93ܫ`\xb1\xd0G\xb1Ή\x1d\x82\x15\x13\\\xf8\x1bI\x8f\xcb\xe60\x19qXԏ\xb8<b\xc7\xe1d\x9eJj\b9\xc3\xd5bn\x8f\x02W\xe6W\xd6\xf6\x19\f%\x87\xe3\xe7\x19\u007f\x0fϣ$\x1bS\xf4}\xc7e\xeb&i\x9e\xc6ɝBowc\x1a\xab\xb1\xafp\x82.A\xf33\x05%\x82\xf8\x90\a\xff\x00\xb0\x8f\\\x90\xf5\aY\ny(fL\xfcK\xdd\xd4'\xd4@\x13\x1a\xf1\x9e\xac<\x94\xf8\xb0ud\xf6S\xeb\xf9\xbdFd>?G\x9cg'\x19\x0f\x00\xdd\xf6\xe9\x17s\xbf1\xf9I\xd6\xf5\xc3xKb7\x8d\x97I\x83_\x80\x97a&\xfd-\xfc\x82KW\v]m\x87\xb2\xf8\x11\x17K\xab7\xf1!\x9fe\xde\x16YɖYj\x83\x19kz\x90\xab7\x8c\xe1\xe3?\x10\x8ez\x8e\x00M\xf203\xb9\xed\xd1,N\x17\xc9r\x9bW\x90\xdd(Y;>\x1e\xb3\x87B\x00\x9a:\x12\x9f\u007fNYe\x96Y\x19d\x16YdA\xb0o\xa5\xa1\x8d\x8d\x93\xc7\xe1\xdf\x19\xc7W\xc4~*G\xe6\x13\x18\x9d(۟\xff\x00\v\x1f\xad\x83徒\xd7\xc0_\xd2\xdfіYe\x90Y\x96p\xc8\"&\xc0\xc8-]-\x80\xc0N\xf8=Ḵ\xb3\xf0\x16c\x80\xfcO\xc1\x85\x16\xf4˯\xcf8e\x96Y\xc1|E\x8bdYwƐ\x8c\xbe\x88Ol|\xdd<\x96\xb5d\x05\xe5\xb6L\xf9\xf8\xe5\xbdg/\x19\xcb\xc0\x9d\x92{\xec\x97$\xfdZue\x96YfA%\x9cdjIa\xc6N}\xc1/\xe4\xb5\t\x9c2\xc6\x11f\xdd'.\xb3˦ɓ\x91\xcb\u007f\x1f\xef\x1e\xfe90\x92L\xb3H:ٛ6\xfe,\xb0\x83,\xba\x80\x93<\x90;l\xfd\xc0<\x95\xf5.\xd6\xc6\x13\xc3|\r\xf9fs\xed\xd9{g&\x8c\xf7)\xf1?\x8eOែ\xf1\x9cF\xb3\xa3K{\xdc\x10\xd9dL뎏f\xb1\xf5/\u0fec\xab\xeb\a\xd1\x11\xab\xa7\xb0,\xb0\x9c\xf8\xe0\xb7\xe6\xd8Kx\v\xbeW\x87\xbe\x1f\xcd#\x8c\xb7\xf0\v\xcb\xd60\xe3bɷ\xa4\x9e\xd6\xfd\xa0\xed,rޤ}\xd8|\x16\xbe\xad\xa3\xef\x05\x02\xe8\x86mfC=\xb7\xfb\xfas\x8c\x928\x0fѿ\x8em\x9fsl\xdbd)*H\xa4\"\x16M\xf6\xc7͚\xea\xf9\xeaݵ\u07b8m\xe0\xb3\xf0\xef\xf0؈>l\xb7\x83\x8c\x9f.\x9e[|~\xac\xe7\x0f\x8b\xe6ў\xaf\x8e2\xf2~WD\xdf\xe4\xe2n\xc98\xcf\xc0\x8e\xef:\xb2N\xa78̶Q\xee\xfe\xdbuƶYd\xff\x008\xdew\u007fK|Y<\xe8\xde\xf1\xec\xf0\x99\x05\xec\xd9g\x19a\xb3\x97\x85\x8fmq\xb3\xed\xb6\xf1ݝOM\x8f\b>]ٳ\xbeݧG\xf1a\xe3\xceK\xcb>\u007f\x06\xd6,\xe3,x\xf2\xf6\xf9\x91c\x0fxޭ\xcb~\xae\xe1ȳ{\x88ejp\xf1\x8dջ-\x9bk\x91\x8c\xfbxD\v\xfb0\xe4\xfb#\r\xbc{dq\x9cyo\r\xed\x87;.\xdf헰\xf09\xe4*\xc0\xfb\xb0\xdb-\x8c\xb2z\x86K\xe6\xea}\x9b[m\xb7\xab\xb4\x8d\xd8\xfa\x92K/z\xb2\xcc\xee\xdd2\rdx\xdd:\xb2\xce?\xce\x0e;'\x9d\x8e\xe4\xc2Y{3\x03\xebh\xfbfŝ[\xd6p<2\xd8r\xf6\xff\x00-\x97\xbe\xa1\xbb\xf9\xb6X\x16x\x0e\xb8jI\xf3.uoR>\xdd7\xf9\f\xac3\xfc\xb5\xe3\xe2\xdf\xcb,\x9e2?\x03\x81\xd2̛\xe3\x8c\xe37\x8c\x8b8\xc2}\xe4\x16\xf6\xc3\xee\xf6<\xbaȾg7\xae2\f8ϩR\xf8\xbb\xb3\xf1=\x9b\xae\x18\xb6\xcb\xd8c\xbe<\xb2\xf6\xcb,d\x87-\x85\xf3\x82\xdc2;\xe9\x83/\x9e\x16\xbcf\xf7if\xf1\xf3n\x1d_\xdb>y\xff\x00b\xc9{\xd9v'\x1b\xbe5&u\xc7vu\xc6l\x9c\x1eɏ\xe0\xbc\a,I\x90\xed\xb7\xcckx\xe5\xe4}έ\xb7\xb9\xb6ӏ^\x03\xae\x16;,\xfb\x9f\xe4>\xa7\xfb\x1eY\xf7\x1b\xf3\xc6FY'\x02xj\xf5nw\x1f|1\x9c\xe4\xc7\xe3\xe5\xb6\xf1\xb7\xff\xc4\x00#\x11\x00\x03\x00\x02\x02\x02\x03\x00\x03\x01\x00\x00\x00\x00\x00\x00\x00\x01\x11!1\x10A Q0aq@\x81\x91\xa1\xff\xda\x00\b\x01\x02\x01\x01?\x10\xf9\xe1>F\x86\x87\xc0\xf8!<g\x94'\x887\xa1\xb6P\x9f\x82G\xf3\x9a \xd1\x06\x88NI\xc9\bO\x8d\xa2q\xf6_\xe7A\xa2\r\x10\x9e0\x84!>(N[)~\v\xfcI\xe1\t\xcc \xedv.\x93\xc9\bB\x10\x84!8B\x13ʔ\xa5)\u007f\x90\xfc*\x1a\x10A~ђ\xd6\xc8B\x10\x84!\bB\x13\xc2\xfc\n\xe8Ρ;\xf2TG\x13\xe0\xa4b\xfd\x1fO;\xfa'\xb1^ƸB\xc4\u061c\x82<\x90\x87\xc4'3\xe3^HR\x12\xc1N\x17\\U\xechp\x83\xf0{\x10\xfe\x9c\x1b4/\xb0\xdf|Q\x92H#\x8a\x88)\x92Hl\xbc>^:\x11\xa2 \x9c{\u007f\x8a\xb0?\"\xa29D,>\xca\x1aKbB\bvT\x1a\x84\x8f\\?\x1c h\x19D4%v}\x87\xdb\xc0\xfdEt\x86w\xa1}\xf9b\x82t\x8a\x9b'\t\xb6/ľ\x18\xd8\xe3!\xa6\x9c\xe8I\x80\xd0Ȕ>\x81\xa2\xdb\x19\xec(Α\xe8~\xb2\x9a\xa6\xe4\x15!\xcba5\x81/dBLH\x91\xf0*\xfc@\x8cd(\xcb\x1e\x18\xe2(.\x13\u007f\x814\xd6>;\xe3E\x96i\rc\x1b\xa1-[\xc9YJ#!\x10Bc\xd8\xdcTc\xa0j{!\x8d\xff\x00\x83/\f\xb8\f\xf4\x87\x8e\x06І\x96\x10I\x98`2\x10\x9a\xc8\xfc\x13\x14ɐH\xd8AqM\xf3\x94\xea\x12\xff\x00\x81\xbd5\xb9\xa6N\xf9=\x1b\x9b\xf0\x03b\x1d\xdb\x04]v`ȌI28\xc0¬}\x1f\xa5\x8bGJe\"\x8e?\x19\xef\x88ƞ\xc4!!!7\xcaf\x94\xc3\xf9ۈL.^#\x15\x12q\xda\x10\xb6lnJQz!\x06\x9b\xbc5v\x15V*\xd2\x1c`\x1bu\xe0l\x8c.\x81ŖYB\xe8$\xa2m\x8e\x14\xd8s\xd0ш\xe0i\xa1!sOBoadȌ\x18\x9d_.\xc3\xca\xe5\xf0YAp\xdc0,bTa\xb2\xe1F\x86\x82C\x05o\x04\xb1\xfeǗj1\x84\x87\xad\x14Ȏ\x8f\xb4\x82\xfd\xa6N\x18\xa0\xc0n\x84oDt\x94xɴ>\x9c\xd5\xec\xfb\b?\x10\xbe\xc8%#1\xc4[\xf1\xec=\x1adjq\xac\x1f0\xa0\xc9!\x1a\x12ذ\xd2C7hD\x90\x8c\xd6\x06\x99\x8a)$\x9a\xbf\x83&\xae\x87\xba\xbf\xe1l\xc2`\xd58uҬ\x98\xfb\xe0l֘\x03/\xfa&\x99\xd9\xf6\x86\xea\x1a\x10\r[ȑ\xd1\x1e\x88\x8a6\x84\x94\x89D\x06\xa9\x1bաIx\x8a\xba\xf8\x1f\xa2.!\x11\x99\x95\x14\x18Ւ\x16JÄ\x16F\x91\x8ah#1\xb1\xb5\xd4I\xbcRZ,\xc0\xa3bN\v\xb8ɍ\x11\x90\xe3q\x16\xea\x15\xcb\x13\xd1\r\xef\x899\xc3N`M\xad\x8c\xeafQV3\xfb!\b\x86\x91\x05%\x827\xabB\x10t\x90僰\x04\x8e\xbb~\x04)x鱵iVD4\r\x9e6\xc7A\x986\x8d\rb\xaa\xf41\x86\xc6*\xbd\xeb\xe8j\r\xb1\x17\"\x90ٴ/\xa0\x90\xc3Clm\xbe\xca\xd1\x0e\x8d\xe3\x02w#y\x82\xb0쩪6\x9e\x18\x90O\xa3\"Xd\x9c\t(\xb1\xe0\xd0\xe8g\x81c=B\x82O\xd7\xec\x83?\xf4U\xe8b\xf3Ls\x06\xa1^\x05\x91އ\xa8oA\v\x02HAyd\x87\x81\xd7a\xfa>\x80\xe3(\xb6&\x12\xf6DDD(\x91\x8e\xc6R\x99H6\x98\xcd`%BBX\x8cA\xa9}\xf0\xf1dSѓ\xc1\n\x91.ƞ\xc9f\xcdh\n+\x90\xf7\x1e\x9e\xc7\x02\xf2\xd2sD<\x82\xe2\b>`كc\xbd1\xaf\xd8\xcf\xd1\x06\bl\x86!\xe0`\xc9\xc2q)\x80\xd4\xe1\xebHh\xa0\x96\xc1\x9e\x8d\xa1\xb5\x06\xf2%x\x17\x10\xc3\x1e\xcf\xfa((\xca\x1b$\x12\xc8\xfb\xb3\xd8$t}\x02\x1eP\xa6\xa3\x12D\x1e\xd6ةT\x8b\xe4\x93\xc9\"\xa2\x89\xc1\x00\xd7ف\xb5\x1b)x0Q:\xc1\xb4\x90\x86\xb1\xc1\xdeS\xe2ǁ`e\x84\x88|\x9eU\x96\x84\xdc\x12d\xc3#7 \xd6Ѝe\xb3\x02eCt\b\xa4c$\xb2@\xdb\xd1\\3\xef\x83A\x14\x85Q\xbe\xb2R\xf2n!Gan\xd0\xcc\b\xcb\xc2i\x83Ќj\xe0\xcbX1H\x82\xc6C}\\\v\xd8\xf1\x89\xd78LN\xa2]\xc9\xecOli-\x91t.\x05\x14/\x14D:ђ7K&\bX\xb7\xb1.\xd9\x04\x98((&:$\xc82\x94\xc9\xfc\r\x11\xb0!(\x8aT\xb4'ŔB\xa8\xcc\xf2j)8\x1b\xc1\x83\x1c#\x16\xc11e\x0ev'\xd5\n\xe8o\xe8\xacM\xb2ͱ\"y\x15\xb4=Tb\xaa\x86\xbe\xc4\xea!#\x17\r\xa1{\x0f\xb1\x0fH\xc1T?VS'\fV\xecHw\x95\xef\xe0\xcd\xc1,\xbeGU\xff\x001\xe06\\^/3\xc7\rQ\x93hy\x86)2z\xc4\xc1\xbf\xa1U\x83(kV\x8e\xa1\xaaA\x8fb&3\r\n\x11\nHƪ\x88f\x8d\x18\xddi\v\xd8\xc3(\xd0\x1d\x12g\x18ш?\xa6#\x01k\xa3\xe1\x897\xa1\xb7lF\x03\xdc\xf0\x94\xf8\x13\xb41\xeah荴\x88lqL\xd6\x06\xb4%T\x12\xecj\xf1D`\xbfC1\x86\x98\x96\v\x18H\x1aN\r\n(̅\xe9\xd3\x1d\x80\xcb#cT\x85\xb6?/bKdU\rT\xecd\xb2d\x1a\xd83Spi\xec{\x10ȴX\x88L\xfc8\x14SYD\x11!>\xc2\x17\x14%\x91\xbfHE\xfb!\x83\xaa\xb8\x1bí\xb2yCU\x91\xaaS\x14[\xe2n3\x865\xb1ml\x92\bx}\x8eJ\xfe\x84\x8b#\xc8RL\x17&Qm?B\xc4\xdfC<1\x92\r\xee\x06\xf7\x90\xe3\x8c\x1bA\xa7n\x9a\x13\x1d\x9be\x81\xc2\xe3\xe1|-BN\xc1\x8a\x18\xcbL\x88\xfc\x19\xa4+He\x18b\x8d\xf1\x8fdZ\xc7MDD\xa3\xa3\x8b-\aEQ\xbc\x01\xfaƍ\xa4\x99\x1a\xb7I\x8f\xa1\xeb\fJ\xab1{\x11\b\x83o+е\x1a\x1b)\x0e\xc12b\x0fg\x87\x12\xe4\x96\x04ę\xa2\x9d\xbc\t\x83\xd86#ѠBM\rF\xacJ\xc9\xf2k\x8a\x80\xb6 `\x89\xecp\x88V\xa4,\x8d@\x89\xac\x88\xac\xfa\x12p-Sj\xc14\x16\x06\x91Ej[Lf\u007f钳*\x13\xb1\xeaȐ\xe9\x1e\xc7\aؗ\x10\xb5E\xd4%\xa70u\xb1'\xd8ӭR\xe0\x91\xa5\xe1y\xa3\x84ǿ+\xe2\x9a\x1a{\x06\xb4.\t\x8b\x8c\xe8\xc1\xa6\x9eED\xfd\x8d9\xe8\\Bp\xa7\x92hbY\x96$+\x11\x15\x94\xdb\t\x8fHE\xd6,\xcb\x03 \x92\x8c\xcbg\x8b\x1a\xf4.+\xe2\xf0\xa5)I*\x8c\xb1\x18\x81\n,\xf0_\x03\xe1\vV\"tt4kȑ\xa8\xd8\xf0J5l\x81 \xd2l\xb7\x84\xa2\xd0\vy\xc1\xd5a\xb6\xb5-\x96\xa2V\x97\xc3JR\x94\xa3\xac\xa5)K\xc3p\xc3\x19\x12OP\x9b\x94t\xf2\xfe'\xcbE\x11\xbaC\x9a\x8cb^\x82SHG\xd22\x85~\x90\xbb\u007f\xe0\x8e\xdb>\x92/\x86\x94\xa5\x1b-/#\r\x8eE1Q&Dcm\x0e\x98\x9a\x1alKiK\xcd\xe5\xf0ߋX\x11\xb2c\x84\xec$\xd0\\\xf9Ҕ\xa5/\f\xecd$Q\x94\xc7\x11\xfa\x1ahJ\xa3$_F[\x12\x88\xf4Q\xb6,\x90\xa2\x17\x86\x8aL\xdeW\x92\x1ax&,a\x90O\xec\x86JR\x94\xa3~\x8a^)\r\x89\xd9X\x8a+\xe8n\xcb\xed\x8d}\x8d\xae\x05)\x03FT\x8c\x85LݙE\x11y\x94K\u008b\xd7\x19\xf1\xa2\xa3`\xa3Rǁ\xb5`\x84X}\x8aVR\x99\x19\x89݊\xbd\v\xd46{\x12\xfb\x11G\xa2\x8d\x11\x04\x99\x0ev'yX0\xcd\x17\x97\x1a\x10\x93\xec^7\xc6\xf1xK\x8aj4F\x0e!\x12\xa1l\xbd\x94\xa3\x17<WнE\xfb\x12\xf6ϠI-$?f?a\x1d\n\xf4W\n\xe0\xab\xdf\f\x9d\x10i\x93\x86\xfc\x12 \xb0Qy\xa1\xf3<\x19\xbe\x18j\xa8WN1\xc78dM\xe8c\xc1*\xa8\x96Ek\xa2\xbbg\xd8H~\xa3f\x86\xc6XЍ\xa8а\x8f\xce_\x95\xe2\x89\xe0|7\xec^s\xca\xfa\xe5\x13\x86\x93\x12M\v\x18@\xfe\xc66&\x96\x8a豓\xac\x92\x11H\xf8D\xf3Ǆ\x1f\x17\xaf\a\xcaٖ\xc8w\xe19\xc9\x05\xaf\v\xd3\xe2N;\xe3F_\f\xb1\x1f\xa2\xa2\x18bw\x8b\xe0\xcb\r\xe4\xa2y撉4\x8f\xa2\x192Ɛ\xdc)X\x9es\xc4\xe6L\xf0\xbc{\xf0\xa2;\xe2C\\k\x86d6h\\S%+\x98\x156\xca\xd6\n\x91IE\xa2s\x81<\xe0YEO\x84\xe6\xc7\v\f\x0f\x02\x8f\xc5\rz\x11\xbf\r\x9f\\w\xc2\xc9\x06^)xٮ\x13Hu\xe8\x82P\x94\x9eف\xab\xc5\x18\xd6\x06\x88\x9ex^\xb8\xa6z$\x12E\x84Tu\v(\xdb\x1f\xa1\x86\xb0!\xaa-\t\xa1\xf8Q\xf1x\xde\xc9\xc6\xcd\x14d \x91\xf8Zhk\x86\xaeƒ\x1bz+\x85\x84\xc8\xfe\x8a,\x8d\t\x9d\x19\xa2Ј\x88HL\xec\xef#\x8d\rf\x89\x89\xa1\xb3Y)i#\xa3qA5ƞJ^\x12}\xf0\xf8\x89\x8b\xc1\xe0\xdb\x11>\x10\x86\xfaDkF\x86\\\xf2\xd7\nA\xaah\x97d\x82Udh\xc7D\x12\x1e\v\xc3n\xf0\x89\x89\xf4%rL\x98є~\x8d\t!\xa6/\xb1\xa5\xc6\xd98υ(\xb8\xa6Ky|H\xcbDvB\xa5ŜQ\x97\x8aŬ\xf2\xdaF\x10\xdb\xf4hl\xcd\x19\xcc\n\xcc\xf1F\xeb\xcf\r\xaeĩق\xf8\xbd\t\x99\xe1\f\x8545\xce\xf8\xd1JT&5H53\xc3#c\xc6QN\xb8H\xb8\xd6\b\xcb\xc7D\xf6k\f\xabG\xe8\xcf\xc1\xf0\x94PJ!\x8a\xa3\x04#\xb1;\x93\x06\x0e\xf8\xb8\x13\xe1\xe8N\xf8$a\r\x8f\x8dxC\t\x0e-\x12\xe4\x94~\x85á`\x84|^\r\x93\xac\x89\x183\xf0\xfd\x1b\xf6'\xd8\xcb莍\"⍔Llh\xa3\x89LDO\x03\xce\bh\xa2\xd9\xd1D\xf8\xd7\fНW\x88Q\xb8\xa9\xff\xc4\x00'\x10\x01\x00\x02\x02\x02\x02\x02\x02\x03\x01\x01\x01\x01\x00\x00\x00\x01\x00\x11!1AQaq\x81\x91\xa1\xb1\xc1\xd1\xf0\xe1\x10\xf1 \xff\xda\x00\b\x01\x01\x00\x01?\x10@Ne\xb0\xb6\xf8\x85\xf1u\x98\x9a2:\x97\x90K\xa9w\xd2X\xad0Y\xc4T\x14\xcc9Yz\x8a\xb6\xe9\xf7\b\xbb\x94PX\xa4\x98H;\xcc\x02 \x98\x8e\xd8{7\x13\xd9E\xc4\xe8\xebs5dk;&X\x15\x13{\xa6\v\x9b\x98\x98\xdd\xd4\xf2f!s\x88\x95\xb3\x98\xc1j\x99㏄J\x98\x9a\x82\xea\x105,3\x03W)q\x1aj\x12Ȇ\xcc]\xe0\x96\n\xa9h\x10\xc4/\x11\x91\x8fQm\x0e\x98A \xbc\xb5.\x88\x17\x86\xe2w> \x1935Ao0]ƙ\x80E22DZ\x15\x9b\x89\xee\xe1\xdc+`\xb9@.=E\xd6E\xb5\xe7\x88s\x18%iC5c\xa8\xe3a\xe7\x11\xa9L\xba\x8dNC\x06\x88c\xc4]G\xc4WW\b\x18.<\xccE\x8cA\xed\\b\x1c\t\xb1㨄\xf0@\x1bm\x88\xa8\x82\xa7\x13re\x88pLn\xc6[eT3u/\xd1\x1ct\xca\x12\xe7\x12Ǹ\xac\xa2\xb4\x12ɼdp\x95\v`\xae'\x82Q\x19e\x81\x1c\xca\x06%S\b\xb9!8Ix`\x8e\xc2\x06\x15\x98\x05\b\x95\x14ܮ\x15\n\xe0\xbe%\xfa\xd4\r\xb2\x8c\xb5\xb8#\xe6\t\xb0Ts\x13*\xd4\x16\x19\xb2\xdf=2ٱ\xdc\xc0\x98\xb9p\fw\x03\x11\x87\x88\x9bLs\v(\xee1JS\xcc\x00\x1b\x99\xc37\x17\x85\x11\x82\xde\xe6>0˚nP\x97q*\xd0.\x8ea\xe1\x15*O\x99AG\x98w\xa9\u007f\xfa,\xa5s\f\xa72\xda\xc4*\xe26f<\rC\x16P\xb1\aļUM\x86V\x82\fĤ\xf2\x8d\x98\xcc.\xb5\f\x86 \xc4P\xb0\x85噂\xde \xd7P\x89y%\xbaf\x00P\xcb\x06Ŋ\xc8\x1a\x807ͩ\x980\xc5B\xaa\xf7\x04\xb8\x17\xe6\x05\xad=\xcd\xc3&\xa5\b0\xd4\xd80x\x8e\x81S\x88\xe3!\xe0\x83mt~`[T\xdb0\x9a\x84\xc6F\x8e\b\xd0\xd3L\x11\x81E\xc3a\xcfs\x19[\x00m\x14\xab\xa9{\xa1*]\xb3SE\x8a \x85|\b\xb2\xb7\x81m\xa06\x1b\x81\xe2\xa1\x1eBPQS/(+\b\x1bJ\x94:\x8d`ū\x8bN\xa2\xd3A\xff\x00\x856\xa0\b\xf2\x19\x95\x83\xd1\x15\xd5\x03\xd6\x03\x99F\x9306\x95\x86\xd1\x05\xd4)\x97\x98\n\xd4\xc8Tβ\x88ȜJ\x18\x12\xf6\x8d\xf5(\x15U\a\f\xf9I`\x00\x0f\x10\xbb\x9dDfU\u0530}\x94n\xb6AB\x05`!,q\x01 \x8cj\xa2\x82\x81n\x02P\xb6>\bP\x16Z\x9bi\x93P\x10\xad\xe2Y\xd4\xcc\x04T\xaf\x10W@\xe1\x17`\x1d\xca\x1bdj\x80k\x98D\x05ː\bHF\xd8U\x88_\x98ҍK\xdaj\x05\a\xda]\x97\x12\xe9\xc2\xf5(ebQP\xe6 `S\x1eC$,a\v\x92\xc5\f\xc1\x803\x10)f1E\x80lo\xc4U ~\xac\xa50<\\\xbd\xdb\xceǸ\f܆\xc9|N\x04\x18\xba\xcck\xa9\x83\x02|\x90\xb6\x11a\xbc@\xe1\xeeU\xc32\xb0\f!m\xa4M\x12\x13\f3\xe2+@\xb7ls\x03\x8a\xcf\x10\x90Eu\xe2fU\x8c\xebp\x1e\xdeF\x01\xd9Y\xcc^\xb8\xf9\x86\xd3+\x84\xc4\xcaz\x800\x97G$9\xc5E\xe4F\x8a-}L\xb6\f*\xd1A\xb6\x00\xa4}J\r\xab\xcc?*za\x06h\xbd\xc16\xa5\xc7$\xb6pQ\x96\"S\x9c\xe6Z/\fS\x0e\x18ez\xf5\x013V\xa2\x15\xab\x96\xb1H\xc2\xf0(\x8dAG\x98\xe8\xeb6\x85=E\x95\x10\x96\xe5\x10\x03FP\xb4\xfdT\xb1{\x8cWhg\x19\xf7\nl`\x14\x80}A\x85\x00\xf8\x9d\x15\x03I\U000bf120\xb7H9b\xa0\x00\xea\xf9\xee/b\xf3\xd0\xf9\x94[\x19\xff\x00Ύ\xe2,\xe2F\x81\x8f\x87PDU\xee\x00,\xb7\x99\xc4a\x94tVu\x02\xd5z\x8a\x96\xb1\x04\x8b-\xe6\x00\x1cR\xcc\xe65\x95\ar\xcdW\x1c\xc57h\xbc\xc0\x91\xc8T5\x00_\xa8\x82_\x02=W\xac\x04z\x99V.\x16%⌏\f\x04\x85+C\xf8\x96\x97\xdbR\xe5\x8c\x19\xbe\x10\xbc\xab\x9b\x9a\xa8ۑ\x04\xdc>\xa6q\xf0\xb0\x83\xd7M\f(#\xc2\n-\xbdţ\xb8\xa3(\xb6y\x82\x1bS\x13Y\x16\x8dx\"\x99Z\x80h\xb7\xc1,~\x93\x0e;,\xaaW\xccP\b\x1e\xe0J\x14\n\xc8a̋g\xc2\x02\x86\x86ąX\x02U\x98\x8a@j\x01\x81\v0C\x03\x92?-@]\xc4\x14\x95\xe5]\xc4C\xc0\xa4\xe1\x82\x1a\x8eؠ\x1aIiQ\x82\xa1h!A\x1cy\x95\x86\xf2\xcbl\xaa\x83\xc4\xf0\xb3 \x89}\xd4\x139m,)_1ֺ\x18\f\xb933ʄ+j\x98\xae%\x06k\xda\xe3&CJ\x97\x14(6\xe6Q\xc1k\x93\x14\x02\xd2\xe2\xaa\x02i\xad\xc2\x01\x06\x93p\x17R\x86\xd6+5\xbc\xd4X\xd0P\x81\xe8\xb5luų\xc8L\xe4\x8b\r刦\xf7\x1b\x16\x06\x04\x1b.\x8f\xe64\xad+X\xc0v:\x85}\x1f&(\x01N\x1a~ْ\xf7E\u007f\x12\xd7+\xe6\xbfR\xb6\xe9\xf2\xa6\x0f\xe1\x19}g\x9e\xac\xbf\r<.!\x89n\xfa\x98m\xdf\x18ˋB\xf6@\x99E\xee\x13WVؚ\x9fL\x84/_\x12ݫ\xc4UIW\xe2\x87\x1ea\xe0\x97:\x88\xf7\x92^\xe9`\xd9n\xa6+ \x13\x02\xc7r\x02\xae\xd8\xd2\xcb%p\x11\n\x98s(\xc93\x84`\xe0\xea\x14 ^\x1a\xc39҂\xf5\x14\x10v\xee\x9c\xc7f\x05`\xc1j\x02\x90\x0e\xa2Y%\x15A\x0e!h\x85,\\Jх\xf8!\x17\x05˙\x97\"qp\xc4\x04,\x16\xa0\xd8\x12l\xb7\xdd\xd6\xe3\"\x9fPWy_\xa8\xe0\x83w\x83\x83\xf9\x84t\x8a/\x89i&\xfd\xe3\xd4\xc6\xf5\xb0\x1a_pY\"\xc0\v\x90\x8b\x87'\xe6~1\x93\xf6\xcb7\xa4\x18SK\xc0S\x06s\x82\xfa\x03\xe2\x1er\xb9m\u007fq\xa2\x99\xdc@*f\xce_\xe6{ \x90\xe5\xdaض\x9e\xf4\x98\xab\xde!\xc0\x0f\vp@\x03\xb6\xed@\x83>\x151\xf2|1\x96\xd9\x0e\x0e\xd8\x13\tf\xa0\xf8y\x84\xe3\x11\xf0r<¥ra\"G\xc1?eL\x10\xa9\xdc\xc1\x00_\xed\n\xa4\b(*!ZX\xf6\x82\xf5\x99q3\x9d\x18\n_O1\xec\x18䎚\xe6hn\x93I\xc4\xd4\xe1T~\xe3\xf1.ђ\xa1\xcdG;%\r\x8f\x10\x15\xe9\xd5K)\x10\x87.\x92_\x8ct\xcb\xe0\x1a\xad\xc1\xab4KbW?\xc6V\xfcq\xc9*@\xf49j\x0e\vF\xbb\x81\xb4\x17\x9b\xdc]\xde\x1b\xc4aP5\x8c\xceg\xbbXF\x8c:\x97\f-\x14\xae\x88k\nUj\x1aR\xdb\n\xbc<\x91建\x80\x14\x05Ew\xf5L5\xf8\"\xa0$\x89\x87\x11\x05ї\xb2X\xf4v\xa51j\xa2V\x8eC\xa9\xbc\x8dA\x92\xf3\x8a\xc7\xdc\x01n\x8c\xf7\xa6Tc9*;\xa3\x84A](T\xbc3\xaat\xf9\xc9\x14x\xaa\x05\x9fy\xfcK6W\x02\x9f\xcdǶ^\xf3O\xd4S\x169\xbd\xff\x00#\xf7\x18\x15\x9a\x19\xf9\x89\xf0gįۙ\xa0O\x16\xeb\xb7\xf1\x16\fT\x19\xa21҂r̀\xfb\\\x0fr\xe5%\xa1\x97\x11y1\x1c\xda\x06]\x99 \xaa\x06\xa6\xc2\r\xad\xf6\x94\xcdC\xa9}㈂:\\J\x0e1U\x98\x82\xedW)A\xec\x8c4\x9a\x85\x80\xd4\xceFm\x17\xb3\xa6Ïq\xf0\x05:\x80\xe5\xa8\xf1\x02kR\b\xd6M\xe4[\xb6 \b&*\x1b\xae\u07b9\x97ғOr\x80\x01\xce\xe5\x8aI\xa5@+\xb3i\b\x01^\xae[\x02\xac!6\xa0o3\xcc\x19\xa8\x06\x8b\x80\xf3\x18\x16\r\x9e\u007f\xf6\x97ZA|y\x81\xa4\x1eV\xb7\xe31\x8dDU\x86\xd9+\xa6\r\x86X\x18\xa3?\fm\x13t\x87\xe5\x93\xf1\xff\x00\x8f+\xba\x1a\tc'\x9fS\x020\xeb\xebj~e\xe0\xf6\x85\xc9\xe0\xc1\xf3\x05\x04\x8fB\x9b\x03\xb5N3\x8cʤKK-\xf8\xddyev\xba\xd2\xd6\xf0UX\xf8\xbe#YDB<^\x04\xfbe\x83fW\u007f\xe8X\xb3\x88\xd9}MT\xb6\xf2\xa4Qq\xc1\x85\xcd\xe7̣`\xfa-\x0f\x1e\xa5\xec\x01\xb0\x0e\x0e\xaf\x88\x86\xba\xa9\x00\x9b\x12\n\x82\b\x1a\xder|G8\xec\xa8k\x0e \xf4\x87S\x01P\x9d\x18\xad.\xc0\xc1*\xde\x0e \x05\x84\xea\x15\xa5\x92\xd4J\t\xa8\xff\x00:\x80\x8f\x82.\x0e\x95\x9f\x00\x8a\xde\x1f\xa8\x15$\xc5\xeb\xdc\\\x84\xf2\x92\xfd\x8c\xf7\x00g\xd4>_,7\x06&>\x13\xacG <\x19\x90\xb2\n\xc4\x00\x83R\xeaV\xa5\x1e\x12T\xd8\x1b\xa7q\x14\xc2\f\x15d4u\\\xf8\x80m\xa0\vi\x82\n)\xd3\x03+A\x83\x98\xa1Iu\x15\x97kW\x05\xe2\xf8\x02&\xd4\xdb\xff\x00_b\t\xef_\xccuڰgP9\xd1\x04\x04\xbd\u007f\xe3!\x10\x88<\xd3+\xb6\xab\xb0\xf6\x1ebT(\xb6\xf3R\xd7|@k\xb5m\x0fQ\xdb< \x01\xe4\xd9\n\xb3\xb6\xd8;\xf8\x8e\xed\xf28=\xbe\x8a\xff\x00\x10b\x83\n\xf8\xe71í\xb2\xe8\x01\x8f\xf31`\x81[\xaf\xe9z]\xf8\x9e\xae\x81o\xde%NkU\xf8B\xff\x00\x88CG\xdeCe\x15\x1fW\n\x91$.\xcf\x0e\x9et\xb2\x81J\x14\xcbW%f^\x92\xbe\xaa\x10\x9eF\xc7̯\b\xbf\xb6\v\xc0ޜVj\xa3S\xeci5\x88\x18V\xb6ߘu!\xd3\x18$\xbb,\xe0e\xa1\xb0\x8c!\xc4^C\x19\xfd\xfdJ\xa6\bw\x8d\xc6\x17Gc)b\xae\xc9lQ\xd1l\xa4\xe8\xa6\xe1\x88V.\xfc\xf5s7UUup\xef\f\xa1\xc2,\xf8\x13\x98\n\xbd\xe1Zeڌ\x95\xef\xe2`\xe3\xd2\\\xbd\xb5\xe2\x10*7q3=~elT\xbey\x84,\x17\xaaLD9P\xd9X\x84J\xaaސ\xfd\xb8\xe1/\x93\x01\x9e.:*\xa7=@z\xc7P\x10;@ \xa0\xa0|\xd9f1\x00?\xf6\xb84\xa7\xf0\xc5װ8\x84\x1bsj\xff\x00\xc3TV\x93D \x00\xe8\xb6q\x146\"\xf8\x98\xa3IF\xfcFk\xedC8\x01N\xa9\u007fd\xb1XmzwA\xa84+\x00\xaatS\xdf\xefܱ\xbe)\xe2\xb6\x06W\xc7\x1c\xa4\xb4\xd2s\x986\x8c\x1e̼ʽg\x14^\xa8\xe7\xcb6\vck\x9f&\xe0 \b\x02\xa24\x94a\xacB\x89\x82\x14((\xbfɕ\xea\xfa\x87\xe7\x8fĢ\x17\x84\xac47\xb4/ \\U \xa4\xcc-N\xed\xbfD]n\x8f$\x96\x19*V\xb4\xb16m\xbb\xd3O\xf1\x0f\x1b\x88\xf6\x14\x8f\x98!\xf2`]EU\xb0\xd2\xd6K䙰nB\xd5\xe93\x06\xf9\xfa\x8f!\x03\x01l/\xe4\x86\xec)j\xdd1\xbfL\xc3\x02\xd3\xdd̀m7ji\xbeLO\xc0\x83\x11\x01Y\x86\x19]\x18\xc3wG\x88\n\x88\xe5N\xe1Y\v\xee)\x14b\x8dF\x03\xcbz??\xdcʄ]\x9c\x92\x98+\x95\x96\xf7\xd9\x0e\x16\xe9\xa0@\x1at\xd4\x1a\x83&\x82-\x83%\xe1\xe6\x1a\x89p\xe0%\xa2\x9fAԨ9\xcb!(\xd04\x16'r\x02\xf0e\x8d\xf2\x1b\xf8\x95N_&\xa1Zw\xb6>WN\x06i\x81W\xcb\xff\x00\x19\xac\xd8\xe2T\xf2\xe4X,n\x9bO\x89Js\nU4\xc1j\xb5/YĤiM\xad\xd3\x00\x8a+\x18\x13\xd4\xd9{\xa6V\xb5\xeabc\xc1\x9a\xb1\xfdܾ\x149\x91>\x18_\x11\v\x95Z\x010ߘ\fl쁆\xc7.rF\xa5\xcc}\xed\x1b\xf7PD\x00\xc6f\xf7i)\xf8\x0e%\xaes\xfe\xea\x16_\x81\xbe\x04\xc4K\xbc\x03̜\x9a\xa6T\x17\xb6*\xde\xda\x06\x10\x00\xa8\x04%\xa0\r\u007f\xf20JƅW\x8a\xe4\xfdK\x90\x91kP-\x0e\xefD\xb1Qpo\x16\xf68x\x80\xa7.DI\xba_Ըb\xc84s\xf5.\xb0Kcga9\x190\x10`dŹ9Q&n\x13\xe4%\xd0\t\x93\xfdTL\x006\xbb\xff\x00\x93)\x92\xb1?0\x17;\xddL\x0e=\xe2Ja)\xbc\xe3\xc4tA\xab\xbeee,\xbb&\x19\xa0\x10\xcf\x1f14\x85\xec\xb5\xf8\x80\x94\xce/\x10\x13\xc3#z\x88\x82,\x8a\xbf_\xd4B\xe6j\xea\xf6\xdd\x11V\x0e\x18\a\xbdNOA`y\xea5\xe5\x0fE\xab\xee\x06\xc2=\b\U000c4db4D.\xc8b\xa2\x80\x97d\x15\x0ej.\x0e\x16\x02 hy#JX\xa8\xd5\xed%\rW\x02\x9a\x8e\xb0\v\xab7\xfe\xdc20ᤶ0+l\xb9\x18?\xe4\xf7*s\x00߈TV\xf04\xa1F}\tV\xdb\x17\x9c\xa8\xad\xb5\xb9\xf5\x0f\xa1jUc\x14\x8c\x83\x95\x1f\xfd\x97\xb3@wXx\x98\x8b~\x16\xddFi\xf6S 6\xf9s\xc4\x14\x12Z\xf31\x90\xc07\xc4\xd4\xd0\x11\nU\x0e\xf7\xea.\xf6,\a\xe8\x86F\xd3(6u\xd2)B\xf9\x11\xf8`{TVX\xde\xe1T\x02\x95\xb3\xb9y\"*\xbf\x02\xf5\xa6/j\x0fd\x9e\x045\x8b\x80\xab\x1a\a\x82\x12\xcdǺz\x97\xd3B\x97\xd6\xdf\x1f\x92 \x85\xa0[\x0f\x8f\xe2K\xc8k,*\xaa\xac\xcf\xd3\xd72\xc2\x02\xb1Ȯ/\xe2:\x85(\xf4\xea%+X/\x98\xc5}P\xda\xfb\x97\x8e̸{\x8b\xac^\x8e\x92\x82\xf0\x9b\x8fO\x98p\xc2\xd1ǯ\xea|q\xca\xe2\x17*\xe5\x06vW\xdd\xcf8\xba\a\xe8M\xb5[\xcd\xfe [Qa\u007f\xd2\x01@{[G\xe6\xe2\xedE\xb1\x12\x9f\f\x15\xe65\x800/G\xdfp\xceA\xc7+\xa6g\x8ce\xf1\xddL\x1a\x06\x83(4$\nm\n\xcb\x10\x10\x83\xc8̢\x12\x8c\x960\t\xa5\x1aX\"\xebG2\xe5\xb47e\xa7P\x10\x03\x8b\xbf\x88i\x80\xdbZ\x9a\x94NS~c,\x14(\xd3:\x96\x02\xabC\xf8\x99F\x0f8\xbf\x88\x8a\xa0V\x1f\xf3\x11\xd2\xc6B\xf0\xb5d\x17\xdeq\x1ej&\xd3Ѝ}\xc75\xe2\x05q^\xd0\x15\u07b8(\xa7ul\xdb\xd3A鎖_\x13\x98\x05rR\xf4\x8a\xefr[\x17\x95\x17E\xb1\alv\x80\x02\xcd\x19\xca\x17\xaf\x11\x89\x95\x9c\xa0=b_ڛ\xd6\x01\x9b\xc8\x15\xf7*&\x1a\xb6\xd7\xe1wR\x90\xd6i\x05=7\x89\xe1\x005}W\xf3\x01\a\x1b\xb27\x1b\u007fֿ\xa8\xd0\xef\x14@\xf8X\xa0mi1\x17l\xb0W\xe6\xbc:\xff\x00\x10\xf6 \xc6\x16\xbe\xd8\x12O\xd0\xd7\xd42pTC\xf8\xa3\xf10'\x1an\xeb\xcb\xc4]\x1d\x85-gT\x10\xf1Galyz\x8fn>\xc3\xc4*5\xa3\x91j\x96\xdf\xea\"@62\u007f\xbfڇ4\x16\xc6ǈÄ\xd2hw3\xd8\x1c\x85\xd6\xfdÌ\xb4\xf0\x8f\x83\xba\xee\xa1Q\\)\x1bt\xf3\xf5\x88\nr9Z\x8f\xb2\xdf\xdcDMa\xa6x\xb78\xf8\x8f\xb2ې%\xff\x007>\xd9*V\xa1\xe2\xf5\xd0\xceE,\x95^\x1d⣡\xcd\x01\x8f$\xd4\x12j\x8f\x1b\xf5\x89u\xe5T\x05\xf9WЖ\x91\xa4\xb6\xd1\xf8K\xf2܋\xaf\xdc/\xa8\xbd\xa7~\xa3\x8e\x97\xc8\xcd\xc0\xc4\xd0ݵ\x18\x00\xa2\\0\x03\x8e9n_\x00`\x16\x8a?\xecTQx\xc3\xfe\xe6dx֯y\xff\x00\xe4k\x80\xdc=\xc0\xac]C\xa5\x06\xb7\x056\xfcnd\xa47\x9b>\xe3`R\x95\x00\xbc\x15\xb8Q1\xe7P\xf5,1\xc0(\x80:\xb7!\x10l5\r\xf20#\x81,/\f\x02g\xa4\x04[^q.)$y\fV^M\xc5s\xc6\xc3\xf8\x16\xfej \x9e\xa4420\xf4B\x84\x159\xd6e\u007f!İ\x00\x88\xf6'\xe9G\xf1\x1f\x86v\x87\x1e\xe5\xc1F\x04;\xe2f\x88\x10\a<Jq\x89h\x1d\x97\xa4\x95\xea\x00Y\x81\x0e\x0ed\x8bG\xf3(J\xc1p\xc6;CS\xacS\x95{\xee=\xe6\xa5ݿ\x925\xb5\xd4k\x82\xbc$\xcb\xc9$瘆\x06W\x99\x0fHAxZsa\xdc^\xf2\x8a(\x18\xa36\x15\x19\x1d\u007f_P]\xda\x06\xaaޡ\xea\xe9U\xbbE\xda%\xe9\x8f\xf8\xbf\xb8hU\x95\xd8\xfb{\xee-\xc2\xe8\xdbk\x83\xac\xcf\b\b-\x88\xd8\xcb\xf1\xa9`\x84\x8a\u007f\x00\xc3\xf2C\nF¾S\x04n\xd5\xe5\x1f\x8dǉ\x92!8\xb4u\f(\rp}\xa3i\xc0R\xb35I\xc7\xcct\x97\x95\xbe\x82u-@n\xd2T~f&\xe9F\x01\u007f\x98},\x8dUF\x8d\x96\xf2\x87\xe6S\x10\xaa\xa8\xa4\x012\n\xb9s9\xb2\xbc\xc5Ukw\x89H\xf1j\t\x88^\\TR\xea\x14\x16\xf1\x01@\x06\x92\xbbg\x9fu\x9a\x99\xb8\xb5\x05\xdd\xe6<i[\xb9\xe2\xe2\b\xe5A\xab\xacK\x84iq{\xd3N\xb5̠\x12\xf0\x04?s\x16K\x87\a\xc5\f\xa6\x06\xeco\xddGb\x15P\x99\xf2\xb2\xe8\x04\xeaz\x0f0\xd1\xd1I\xbbEV\xb2Z\xd0D\x89c\f\xb5\x14Y\xd2\x01Ll`\x83\x19\xf5\x00T\x14bf\xb8\x83\xe4\xa5\xd3+\x81.ˇvs3\x9b#[M\x1dJ}\xe2\xd43\x8c@`g#\x90[\xde\xd8|Ž@\xed/\xa4\xcc*j\x89\x9c\xf5U\a0\xec\x06\x1a\xe7\xe6V\\\xb5\u007f\xc9<J\b\xb02\x1aN\xa0\xc2逭\x1d@\xe1\xdbq\xeag\x05\x88\xdf͑\xa6\xadIv~*=d«\xc3\xf3p;\xca#v\xae\xba\x94j#\xc9=\n\x850\x9c\xd5 \xb5\xa9\\.՚\xb6\x13L\xfb\xb5\x99\x1a\xd0E\xbf\xa8\xac\x8a\xb5\xa5\xfcJ\x19u\xd1\xd9\xc5\xe7\x88\f\nb\xbfc\x10u\xf8\xe5\x89]\xa6\xe53\x88\x98Y\x83a\r\xa3#e\x84x(iS\xf1*\xd0\x14AwR\xc4+\x15\xcbH\x92\x9b\x95\x95\x98\xec\xed\xc2\xe0\x95my\xf6n=kW\x90\xd4r\x83E\xde!\n\x12\xa7\x88\x85{\xcbg\x05\xe2\x02\xf7\x80\x0e\x1a\xea\xe5`\xa5\xd1W\xd3\x02Z\xefD\x88\xa3\xb6e\xe6\x19\f\xaf\x11s\xb5$\x116\xdb\x01\x04\rR\u05fd\x9a\x87\x1a\xc3@K\xf2\xfcY2\x96\x05\xa9G\xbc'S\x14A\x83\x03\xdc@ʴ\x01O\x81Xf:\xe7\x06?\xe4\ns2 \x8c6\xa32\xca\xda\x01\xa1\xf3/\xa6X\x01\xc0E\xcc\xdc\vB\xfcK\x1d\xc6\xf1\x03=,\xa1a\x1a\xf6\x85\xda:C\xcc_*d\xb6\x97\x18\xc6\xd9BP\x9f\xad\x18\v\x86\x1c>+\xc9\xfcB\x00\xc5F`\xe7\fF*\xa8a~\xa7{>\x8b\xe7\x12\xf54[\\&\x9c\x8bcX\xbf\x12\xddYXUDd\xb5\xab3\x18iO\x82U\xf0\x91F\xbe\x88\xe0\xf4\f\x01\xf6\x93\xf62\xfe\x86)\xbeD*\xfea[\xf1\xa0Mۻ\xa7\xf5.\x9d\x92\xf7\xfb``)\xaa\x9f\xc4\x06\x82+8\x99\x82Z\xa3eT\x14\xe8\x83\\\xfc_\x17\x13\x04?\xac\xd4l\x17*\xe4O7\bN\xdb+Q\xee$\xb5\v\xba\xcb.\x94\xb3~1\xc2\xecv\xbe\xa5\xcd\x14\xe1Kc\x0f<\xbe<C\xa0Tȍ)\\\v4~\x19\x96)r\xe8\x93!\xe6\xd4\xe68J\xacoB\x80\x95@u) 8\x01_\x13My:\xbe\x9f\x89Qq\x9c\x8dg\f\xa2 \"\x88\xc7F\xa2C\x91A\xa2\xcb \rS\x82k\xf3\xf7\x01\xd0̯\xbb\xc5ԽÆ\xe9\xe7\xa3\b\x0e\xd5/\xbd\xeb\xe27Y\x94\xab\x9a\xeaTҸn9X\x18tǙl9濢%\u007f\xe2\xf7\ni8\x16\x88\xe6(y\xfe\xb2\x14\xd82\xe0\xfb\xcc\xd3h\xb48=\xd6>\xe3\xddL\xc9\xfa5\x14B\x88~\"_\x866.\x06\xda\xe1\xf2\x8c%R%!\x9c\xdcԱJ+]J$5\xa3\x98\xc2\x04\x12\x18\xd6];\xc9\xc4KA\x16\xe8]\x1dc\xf3\f\x9e\xb6\xad_0K\x15J[\xec\x9c@\xa0\x00|@\xcdG\xab\xf5\x12-\xbb\xb2\xfe\xd0\xfc\x8cS\x00\xbf\x113\x043vu\x14[\xd5\xdeY\xf8\x10\x8f\xdb\v\xb4^o\xea\x04\x8d\x87Q\xf9\x82-\x1f&\x8a/\xa3\xb7\xfaM\xcf\xf4\x1b\xfb\xb9H\x18\xe1?\xae\x99^\x19{\xfb\x8f\x81\xa8\x8c\x06.\v\xa8\xca\xfag~w\x14\xac\xa6\x9e\a\xc4\x06\x85\xc8d`\xb1\xa3\x9dq\xe2P\xd2\x01\x83\x14|E\"\x89\x1f\x1d\xfb\x89\v\x9eW\xb25e\xdfqj\x83\x84\xc2s*J\xdc\xd6_o\x89\x98\x9e\xf0(\x95r\x00)\xa3\x8f\x10P|\xc0\xe5\xf5\x14a@\x18\x0f\x9b\x8d\xf2՜o\x1d\xc1\x17r\x8a\x81\xb31\x9a\x02\xc6\xf8\x1cZ\xbf\x883\xcdj\xb2\xf9\xb2\t\x91\x9c\xee\xab\xe3q\xa1R\x01\x81\xce\xe9\x12P\x14\x8c\x00\xdb\xe8s\x98\\\xe4ѧ\x98\x8e\x8a\xed/\x04_\x0eT\xf3\xee-D2\xb7wY\xfa\x97\xc8\bAX\xeb\xec\x8ba\xf0\n\xd8\xff\x00\xd9Ũl\xa7Z\x8b\x00\xe5\xaeY\xea\xe6` [v^\xe6>]\x13\xa3\xb0r@!^\xd0=\x12\x92\xea\xacVz\xb5\x8c\xd0K5\x93I\\\xcd\xe6\xd7+\x1d\x05\xbe\a\xf3\x18\x8b\xd8P(>\"\xa1j'\x91\xfa\x98\xf5\xaa@7*\x80\x19\xb2\xa2*AЯ\xee\x18Җ\x00\x0fx\x96xuh\xb5\xaf\x10Jъ\x9f\xe6\xe2\xc7#\"\xfe5\x18\xa8\xbc8>%\x85e\xda\xdb2`\xa7CS\x1cCʿ\xccY\bN\x00\x81-g\x0e\a\xe6T\xbdb\xde9\x80\xad\x10\xce&\xd8W\x12\xa9d/\xa4~Ds,\xfdL܇<\x10\xa2\xe2-\xe2u\x8e\xe2\x06\x83\xba\xe4\xfb\x95\x18F\x82\x88,\xf28x\xff\x00\\\xb1\xe2\xd0`u\x13\x14\x1e\xdb\xf5\x0e\x16\\\x11\xe2`\b\x8eu\x1f\xc47@\xa5\xfc\x11\x8d\u0590Eg\x89\x82\xf187p\x1cJ\xbbh\xea\x00x\xb6[Ug7\xf8\x96N\xeb\xf8\x80\x9aBd4\xe2\xd5y\xe2\xa0[;*,>ު|\x8b7\xcbUO\xccL\x8d-\x83\xcat\xc0\x01!fm6-\xf3\x99o\x1c\x14Z\a\xc1(d\x16\xa6\x84k\a4\xf8\x85\x10q\x11\xa1\xd6X\xb8\xa4\xb5P\x90h\x06\xa3\x15\u00ad\xa1ȷ0R\x13Ue\xe3\xcbU]S\x15)OU()\xd9\x15\xb8\x1cF\xa1 >6\xca\xfd\r\xee\xa7\xc0Kb\xed\x05\x01-vE\xa7A,J\xf6E\x00\xf7mR\xe5\xe6\xe6\xf2#\xc2\xcaM\x9b@٪+\xf7\x05\x1d\xdaȏ\x19ϩ\xbc*\xc1\xc0p\xae\xf9\x88\xa0S\x86\x15\xab\xe6\x9fܸ\x81U\x8f\xc6I\x9e%\x8b\xa9v\xc3u\xfe\xb8@\x00\x86\x06\xfeLT\x14\xbd/l\x89\xc6\xe6\xf4\xd9L\xb9k\x82]\xe2:\x82\xa3w\xa2_\x1c\xd4\x00\x933C\xfcD\xa5\x0f\xfb\xc8\n\x16\xf9}\xca\x0f\x11y\r\xe0s\xee2\x11\xc9\xe1/K\x0e\x0fH\x01\xd2\xd72_RƊ\xc3\x05z\x95\x8ey\x82\xa7\x99\x8aݙ\xa1e[Tj\xb6?)q[\f\xc71}\t\xbb\xb4\x8a\xd1\xc3B\xcfP\u05ec4\x95\x05\x8bR\xe4U+\xe2T\x9b\xb5\x93\xacM\xd6X$\xf4\x8e\x98\xf2¥\xa0\xb8\u0083`Z<B\xe3\x02\xe8\x83\x11\xd3+\xa8\xea\xaa\xfa\x96\xb0\xe20\xa6\xa5\xd6b\xec\by \xd8\xe5x\x04\x1e\x92\xbe\xc7\xe8\x94>\x9c\x8b\xaf\xc9(\x8ay\xa0W\xe66y`\xeb}\x15\x8b\x9c\xe9\b)\xe5\xe2\x1dx\xbe\x87\xdc\xce!\u008f⥈&\xe2\xe8O\xb8\xb0q\xc0A\xaaB\xc4p\xed\x9a~\x1c\xdcslȦ\x179k\xf4\x80\x18/\x1d\x8f\xbd\x02 \xf5c\x1av\x0fX\xcfܫ\x17\x89y\b\xf9\x89\x90E\xe0q\xd4利\xa9\xdd̴\x9a\xbc\xea4\x10\xb2\x12\xfc\xab\xb8\x10\xc5Y\xc8-2`\xba\xfc@l\xd9y-\x95G\xed\x95\x04ёY\xbc\xa9c\x83\xd4\xcaM\x97\n\xb7\xac,\xbe\xc8`NN^\xcf\xf6\"\xb5\xa2\x1d\x8e\x1f\x99M\xca^\xfcDQ\xa5\x99\x03\xf3\x89P \xac\xa3\xb8!\x02\x80\x80Ni\x1c=0\x94Us\xa0\xb4\xb8\x8c\nl\xd4\x00Ԝ\x0f\x88\xf1/B\u0083\x8bl\x8a=E\x99\xdcw)\xb8\xe2\xebiA\xee7P\r\xaf/D^t\xdeK\xca\x02E\x00\xab5r\xdf(\xb8UD$\xc8F\x8a\x1eAbu,iT\x81\x82\x1c\x19\x92\xdf\xc4\x12\x97\x9eK\x86\x14\xbdBR\x10\xe8 媬\xc0\xf9\xd4.\xb2\ue4bf\x172\xe1\x03\xa1\x8f\x98u\xefT˵\xee\xb3[\x1b\"hq\xa6\x96\x8a1V\x82\xad\xba\xbc0\xd2:\x01\xaei\xc02\xc0\n\v-G\xf1\n\xb2\xd7K(\xe4T_\"R~b\x01fF\x9f\x8dJ\xf8\xf8\xa3\x1eU\xa9R`(>\x16B\x8f\xb8:w\n6\xd26-\xd0ָ\x9763A+\x13\x02\xf0\xfd\x90Y\x06u:\x86<\xd7\xe6\n\x8fj^jF\xb6\xd2\xea\x18P\xa8l>\xd6\xff\x002\x8dL\x14Pt\xa0+\\\x92\x81\xca\xc1h\x869\xc6\xce\xc8\x12X\x88\xe9ͼ\x8c\xb1]\xc6\xca\x1fD\xcbՅ\xe4YW\x83@\x81F\tp\x94\x1e\x17\xdc\xfdG\xe3\x14!HGuE\x8b\xa0\xdb\x14]X\x17\x8a\xae\xe5\xfb\x19c\xe2ɲ\x1b\x17/{q\a$\xcf\b\xa3\a\xe2\r\xd6U~\xf1`\x0f\x12\xb6\xfc@@\x82\xa2\x83\xf7q0ӍTsQ\xa7`\x8b2\x9c\xf2\xb8\\\xcbi\x99\x0e\xccasF\x87\x89\x83\xc51x\xbe\xa3|7c\x92\xee/\xa4֎\x1f\x88\xc3\x02\xdbf`F\xc9@\xf2\\\xdcL.\r\x0e5\xfdn&i%\x96\x1f\x15.\xb8\x87L\xc8\vQ|p\xc2\xdak\x88\x86ʲ4\x94\x84kkiu\x1e\x8d\x16M\xc0\xa5\x94\x97\xc8\\\xa4L\f,[j\x17\x9c\x02\xa2H$k\x03W\xfaw\x00<t\xa2U\xbc|F\xf2\xa8\x9avs\xe7pA9\xcbLs\x93\x9b\u007f\xb9\x80\x10\f\x17\x17}%\xfcC,\xa3\xbe\xb9:B\xdbH\xe72\xec\x1998\x98\xe3#j\xfc\x12\xecu\x84\x93\xd3\xea\x1d\x12\x04\x87\x02\xdft\xcbO\b\xb6\x0f\x8dT$\x06X\x83c~\xaa\x11\xa7\r\xe5\x93\xf0\xc2\xebr4\xd7\x1a\x8c\x0e\xa1\x83\u007fr\xf2\xa8\x10\xc0\xd7\x0e\xa62\x90\xc1T\xff\x00\xf4`\xccU\xa2\xe98\x8a<\xe2\xb4\x1a\xf6\x1dL\x94\xe2\r\xbaǋ\xa8\xc0\x02\x80<\x15o\xe4\x80\xd5`!T\xde|\x8ds*V\xee\xd9zNM\xa1\xf3/k\xe6\v\x15\xa9\x9eH\x00\xe5n\x96\x06Br\x80Ez\xfd,b\x93\xf2\v\xf8R^\n\xa7i\xe6\xa8\xe0L\xa6\x8b\x85K!\xab\n\xe30\xd9|\xdc Vy\x95\xa7nXcm\x1ea\xb6AT4ĥ\x9cNWDɹrw\x12[+T\xa1\x00Z\f5\x8a\xf7\x11\xcaAE\x01&\xb5N܃\xf9\x98\f\xc4\xe9\x84\xc8\xe03J\xfc\xca+n\x1e\xa2Z\xfc\x02\xae(\t6\"\x87\x98\x83\xdcw\x10\xacJ ?[\xcf\xf1\x14\x1c\xdb\xc8\xff\x00\xf6`j\\\xa8\x0f̦P\x80\x00_\x94\xd1\n\vM6y\xe3v\xbc\xcaYc)i\x9d\xe7A\x01D8\xda\x13m\x94\x90\xf8J\x9d\xa5\xe1\xdcK ]\xb9\x01\xbey\xf3,̪\x97\x9ap\x80\xe1\x8a\x18\xc29;\u007f\x11V\xec\x03\x8d.\xd7}B\xc3 Yny<D\x15\x9a\vς\\`\xa8\x1d\x89\x9f\x86\xb5\x1bR\x87\xb1\xe6\"B\x97\x16\xdb\u007f\x98\x8f\xa8\xac\xf1\x11\x8d\xd2}B\xc5߄\xa6\xf4\x9c\x90\x1e0\vE\xd0\xff\x00\x04\xae.\x9a\x16*\xf5\xea'\x8af\xbf\xbb\xb9`\xa5\xe0?\x01\f;\x8cO\x03o\xcc\xc1\xdb2\x1b-\x9a~s\xf3/W3w\r\xe2\xa2\xcap\x9e~C\xf9\x94=Z_\xf6\bƬ\xd1g#\x16\\\x85+ϴ3dP\x15D\xc9\x06qT\x83+\xfd\xb8d%;\xb6\x06\x00e`\x1ae\x1c\xcc\xd06tB\x12‱\xd8\xe2\xf7\x045^\x1av\xcdo3\\\x9d\x91\x04W\xc4OS\xd9\xd7\xc9-\x80\x1f\x04\xadCE\xadXL\xc4\xdeB\xbb%Z\xb3\f~\xf1l(2\x1b\x0f?\xe2!\x82\xaa\xc0\xac\u007fp\xd0ռ\x1f\xdcBE4d+\xe2\x05\x8a\xac\xac\x14E\x90speU\x9b\xc5-\xf3*\xad\x1eS\u007f\x98\x82\x8ap\x87\x1dEz\x89\xc7,;9\x8eZU\x10\x0f\xf8\x86I\x83\f>\xc2k\xcf\x0f\x0f\x98\x87f\xfc\xaa\xed\xb7c\xce\xee,\xa5\xd0r\xd3'\xe6\\z\xb2\x05\x86\xfel>\xe6\xc2\x05k\xa9z\xb2 4[\xdcT#emxe\xce#\t\x8b\xa6\xab[\xfe\xe3h\xc9l5\x93\x19\xae\xa5\xe0T(<\x91-\xb0\x04(\xdb\xe4DLN\x01F4\x98\xf7\x8b\x81\xb7\x00\x13\x1c\x1c˘-\x16/\xee\x12-\xa2\x94nip\xe2d\xa9\xb6\x9c\xc0\x94\xa5\x94\x03\xea^x\xfa\xcd+<r\xc1\xb5\xb7D*\x03\x00f\x90\xbf\x15\bT\xac\x1czE\x9d\x1cIŚ|m\x8a\xbb\u007f\xfccѵ\xd2X\xc5j\x19\xa5g;\xc9\r^\x1c9`\xba\x80\xe5t\xea\xa0\xd9Du\x99\xc2,Z\x0e\x89\xcc*\x9a\xe7\x0fOR\xc6\x11\xa8\x16\xc9[>\xf1\v`\x80\xb6\xdbC\xd9\x05\x81y\xa9\xf1\r~s\xf1\xe1\xf1\v\xb949\x1e\xbdt\xcc\xfd\"\x8a\xba\xf6\x93\xba\x19\x13d.\xeeM\x8b\xaf\x88\xd6Fp\x80\x96V\x10\x14\xe9\fb\x04NK.cJ\x1b*\xea\xd7̼\v\x17t\x15\xf1\xb8ڈ\r\x9a\xf4\xa8\x16\xbc\xaccP\xc6b\x89\x84°<[\xed\xc4\b\xbc$Y\xdc1CB\x91|\\,\xa8\t\x82\xdbc\x98\x88\xaf\x1b\x983\xd1\xf6\xc3\x18\x81E\xedJ\x9f\xa5?0\xa2\xc0\x8e\xaf6Ǻ\x18\x94\x9d[\xef<\xc2\x03\xa9\u008f\xcc-\xb2\xdd\x04\xa6\xd0\xc8W\xee\n-$$\xcd\xdd\xe1\"\xc3\xcbh\xeb\xfd\xf7,\xc6\x15w,\x8d\xeb\x128k'1\x80\xa1\x9c\v\xe0\x12T\x01\xcdQk\xe5\xb6'ɶ\x97\x15v\xff\x00\xf8\xaf\xfc\xa6\xa0\x9fR\xd0U|@<\xd3\xe6,4\xc5\xc7\a\x99eƢV\xc1Ui\x1fcw\xaa\x8b\x9bS\xbcd\x94\xa2\x9c\x9a\\Ǫ\x13a\x12q\xa3\xba\x87\x18\x86\x13oq\xd3y\x8b\x1f\xd4\x1d\xc1f\xab\xd2\xc2`E*\xab\xe8\xdc\x17%\xba;}\xb3hr-\xf9\xad@-M\x02`<\xe2\x13\xa7Ы\x8a\xb3'F\a\xbf\x11_\x85r\x0ey\x81PT&\x94\x1c\xe0\x99\xe4\xd5\xeaϒ\x05\xc2\xea\xf0h\xf1\x89n\x81\x90\a5\x8b\xb2\v\x95P\xe3\xba\r=K\x01 [K\x0f0\xbchafު[\xab\x8d\xf2\xbf\xe4Fը\xaa\xaf\x12\x95Q\x11\x95\xa5\xf7\x1br/D\xbfr\x94\x9a\xb9\xed\x8c\x17\t\x02|\x13=\xcc\xc9\xf9\x99\x85\xd6\a\xe7/\xe9\x1d-{A\xf4\x112\xba\x86\xe1\xf2\xdb\x03m\xc5!A\x8e\xe2}\xbf\xfe\xc2\r̾.X\xab\xd3̣@\xda\r\xbaQ\x919\xab\xf5\x01w,\xd4\x05T\x03\xc4\"Z\xc1\x05\xd9\xeei\x02\xb4\xb1h\x1c\x8b\a\x98!\xe5Z\x8bځ\xc0\x9b\x82\x96\xa9\xc8͈qA( \xd2\xd0}\xb3D\xb5\xb1\xc7\xd5\xc7\x00\x14\xaa\xb9\xfb\u007f\xa9n\xfc.~\x937\xb3t_\xe6&\x98\xdc\x1b\xfd@\xf3\xf5\x8eW\xb9e\xc5n\xd9a\xe2:]!%\x8d\xa1VX\xfa\x84j\n\"]S\xbds\x1b{I\v,\xfdAR\x83\x9b\xa3\x88\xa3!\x81\xe5\xe6Pc\x88=\xf9\xe2b\x86\xd0ش\xb1B\xear\x8dW\xb9F\x10\x16\x8dx\xf7\x19\x02sJ\x80\x86\xcb0\x1d\xbe\xe1\x02\x85w\x86\"\xe8\x98\\e\xee#Eז\xaf\xd0\xc0\xb413x\xdd8{\x8a\x16\xad\x81^\x1d@\xb1\x93\xf4\x8ey\x82È\xea)\xa1\xd4\"\x05D(k\xa9\xb8\x11\x95\x02Z\r\xc4F\xb2y\xa6=9\xbe\xa5rZ\xf7\xcc@\xe5[\x96\xaa\xea\xb9a\x96\x99\xe4c\xc0.\xd47\tKS\x17x\xae\xe1\x17 \xa5\x8a\xea<\xe0\xec\xe2Y\")\x8d\xd4\f\xae\xa6\x11w\x01`\x97\xb5\\_\x88\xc3\xcb\xd1\u007f\x13@sV\x03\xee\xa1iq\u0094\xfa\x84\xd4ĽϷ\xc7P\xb5\xc0ùz\xf8\x86XO\xf7\x99P\x8c4J\xfdC)ᠯ\xf6\xc0§\u007f\xd5-!\xa6\x1b\xdfl\u007f/\x90\xbf\x84rgp\xd0\xc3&\xf8\x18\x11\xa2\x9c\r1\xce\xc4\xe27䖔\x06-\xaf\xb9s\n@t\x9d䘱m\xb5\xb9\xe9\x85@\xedjG\xa6 \xefi˫b\x11V\xc5q\xe2Py\x14+\x9e`\x9b\xaa\xe8\xf0\xcej\xe6l\xb0e7\x03\xa9\xeeR\xc0z\xe63\x16\xbbVg\u05c8\x84\x02\xa3\xa3r\xa1X\xe6\x9c?PDͯ\x06\x98\xf3\xf5,\xa00\n\xaa\x94\x1a\x04\xa9郈 \fh]\x91\x99̋̈ L\xa0\xedki\xa2\x97\xba\x8d\f0\x81TK~%\xec%\\\x06b(2\xc6\xf0s\xddG\x91\x86\xdbk\x1e  \x1b+uw9\x90m\xe9\xfe!hU:\xdc\xd9\tfE\xbf\x98\xa8\x14,\xbc|\xc0\r\xd3u\x04\x02\xe1\xb2\x00\x19\u008a\xe2\t\xc60\xab\xb8\x89\xa7\n9~\xff\x00\x12\xb50(\x03\xfd&]\xf7mhfա\x85\xfb&\xcd|\x1e\xbcŗGe\xc6\xf8w\xc7\xf9\x85r^\x9d\xbfQ?\x1aS$\xfb\x96\xb8\xf7\x84\xfdFPΙ\x9b\xabV\x95V}\xcc\x1b\xa0n\xe0T\xa85\xfd\xa0\x11\x18p_\x9a\xe6\x12\xd54.\x06=\x88\xc8ÿP\x837\x1a7p\xc2\x02G\xe5\v\xb1c\xe2\r\x9aβ~jR\xae0\xd0]\x1d\xc7\xef\xe8kA\xee,\xf6\xcf\t\xeeT\x10l\xa7\xdee\xca2U\xe5\r7\x98\xe0\x0f\x012WE\x19\xcb\n\x85\xa0\x03\u007fǘ\x9c\x90o\x00\xee(\x92\x85\x03\x8a\xeb\xe6Z@\xa2\xe2Wl\xb9\xe5\x826\xc1\xc71\xdb0\x01\xc3\fE\xb2\x1e*\x9dK\xe2\xa0ɮ \b\x01\xb3\x86Uc\xaa\xd1p\x92S`\xf1F\xe2\xc6BC\xd1\n\xcdJ\xebF\xf4D.VTk\xdawU8\x1b\xcb*\xc1\xe9MTb\xb4&\x8a\x95R\xd2۱\u05c8S\xc0\xd05,U\xba\x11\xba\x94\xae\n\xee6nȗ\xd3*J\xaa\u007f\xa5\x98\xc5\xee\xe4\xfeRݵ\xceKO\xba\x04\xceU\x1c\xd8_\xb6\x00\x8a`\xab\xfab\t\f\x17\x98/ϔ'\xe2\x1e\xb9\x9a\xd9\xfc\xfcDz\\\xa8n\xba\x982\x9c\r~\xdfP\x82\xa2\xf3[\x12\x91\x02\xdd%q\xfd͇\xc0\x98\x8d\x12\xa7]\xd7r\x8b2\x05\x904\xf3ۇ\xcc@[6\xc2F\xbd3\x88\x9cѝ\x1bS\x96C:_\x98w+\xda\xfb\xe6\xa5#\x06n\xb5s\x9d\xc7b\x06S#\xee\n\xa8\xe1k\x8f\x12\xf0\x99m\xb0\xfd\xc6\xc5A\xce>\xda`\x8bR[lEk!E~\xeb\xcc̪\x17t\xbf#2\x1d\xe5\x80\xc2u\xe6!^\r\x9cj\agmSr\xc2\x02\xe9\xb5@f\x00\xb3M\xf1\x11J,\xb8_\x13*\x80n\x83\xff\x00\xd8O\xe9,{\x82PV\x8e.X\xbaX\xd0f\xbf3\x9a\u008b\u0084T\xa9\xb4/\x01\xc4\x1b\xac\xb3S\x05\xbbײ\x11\x13\xea\xa5ɗ\xe6).\x97\xac\xcaU\x15\xb3<GhƯ\x89\x95\x97M\xe3P*)\xbe\x86k\xe2\b\n\xe4V\x0f\xdc*\xfa?\xf8\x81\v\x9b@PX3\x90\xc9\xfb\x8c\xe4\x9c6\x0f\xc1R\x8a\x80\x1b\x1f\xbb1\xb7\xa4\x17\xeb\x88\x1d\x11\xd7\xfb\xc7 E\xd3u\xfc\x1f\xdcf\x18t\xbf\xcal\xd8pa\xf4b`\x93\xbc\xb35\xa8\xadA\xc4L\xd0\xe5J\xf3\x03[\x19#\xf8\xfdG\xd6 E\xf3\xe2V&\xc5[U\x88\f\xedK\x96\x0f2\xb3\\\xbb亯ܰE\xab'\xfa\xa5(\x82'\x03\xe2\x024\x18\xc8f\x19E\\\x0ee]@\xde\x13\xf0\xc0j\xb0\xa1@\xf9\xb0\x9c\x8f\xf5,\x00\x9f\xcc\xc1\xa0ҋcqÜ\xab\xcc\x01Pp\xf5\xee\vF\xe7;\xb7\xf8\x85\x04\x8b\xaa\xaa\xb7\xc4\xc6\x13\x91\xed\xd3\rf\x90\xa0\xbbz\u007fqEVX\x96c\x8fQPB\x86\x8f\x94\x1b\"\xec\x1e<B\xa1[6=\x1e{\x86\xecݖr@\xaaꗿ\xe0\x8d\xearYt\xe6\x00*\x11(\xee\n\x11\xc9w\xc5\xc7@\xa0\xd3T\x13\x98m\tX\xf2z\x9b\xa6\xc5\xd5\xfcb!\x14\xf4\x02e\xd5P\xb4\x1b\xdb\xfdF/0+pk\xe1T\x8a_\xb2a\xf4\xc0*`Eb\xa9B\xf3\xff\x00e\xc2!\x8d\x8f\xe2-\xce\x11\xb1\xf6\xb1nŪC\xf0\\Uf\xb9\x0f\xed\x19_HQ\x8de'\b\xbf\x11\xcb'\x80~R \x9cx\x87\xf0\x1f\xcc\x01l\x94S\xf3x\xa5]m\xfdCR\x8a\x06\xd5V\xe2_\xd1E\xd6r~\xa0\xadaɠ@\x15\xa0j\x84\xce|\x19\xaf\xda\x05\xb6<\x16\xec|\x97\x9f\xfb\x04\x85CZ\x98F\x8ctffURӏ\xf9(*\xa4\x95\xdc+\x95]\xf4\x9c\xd2\x19\xd5\xc7F\xae\xc0\x86\xeb\xdc\x00K+tB\xa1j\xe3\v\x96\xd4\xe5{\xc8B\xf0\x9e\x11g\x89a\xcf\x16\x1e\xa5\xae\x02v5\xf1\x0fx%ٿQ\x05\xc8\xe5wpSb\x13\v\x1f\x10\x03\x86\xda\xf5\xef\xf5)\xb2\xce\x16\xaa\xfb\x94\xa2\x12\xb2\xfeD\x8e\xf2\xd1k\xdb\x1c\x89e\xd9\xd30\x05n\xc8>\x8en\x05!\xbb\xbc\x18\xfb\x81\x00!cU\x00ڕE\x95\\˓\x0f\x05~=T\x05@L-\xad\xd6ॉp\x18\xea\x17\xeb\x0f\x95:\xf3\x0e\xd46\n\xbf\xc4\x01D\x83\x15\x8b\x1f\xa9\xbaߊy\xef\xcc0e\x01`h\xdaK\xc0̬\xd13ݮ0\xca֊\xabS\b\x0e\xa5\x95Þ\xff\x00\xec\x15\x80\xb4\xd86mw\b\ue684|\xf1.Ĭ\u007f\xd1e\b\a\x06\u007f\b\x8c\xc9y\xab\xfc\xca\xf4ֲ\xbf\x90\x8d\x14Zk\x9d\xe4\xd4j\xcax\xb4AY\xd4\x15\x9f;\x83\x00\x1b\x82\xee6\xf0[\b\xadk\x05116\x1f6\xa6\xff\x00\xb8wP\xa6Z\xd4@%\x96\x0e/\xcclT\x03G/\xb9[\xb06\xb8CW\xb8_;\x95\xd3;\x8d\x1a*>}Ev\b\x8bE[\xcf\xe6\x15hU\xaaw^\xfe\xa5\xed\\\x95\xb5@Jb'D\xbdD\x02\xf0A\xf1\x0e\xad\x0f\v\xfe\xeed!\x86Q\xb7\xd4\x00\x846\x0eB\xf4\x93\xb2<\x9f\xedD\x02\x1aՌ\x12\xe9\xcdZ\x16,\xa5\x15\xc1\xd0q\x12\xc3Y\xe8\xf9\x83\x97(q\x9f\xb3\xfe\xc5\xc1,;\xbd\xca\xe0#\xb5\xd1=\x91F\x9e3\xe6%eŊ\xe5:\x81V\xedͥ\xa1(*U\x1c\x8f\x89CR\xda\xff\x00\x0e\x19\xb9\xcbTb\x06\xa5\xb6\x83\x83\xea!(\xfc\x9f?q\t\xd9m\xe7?\x11U\x02<\xa3\xdf\xfa\xe1aT\xbb\xcc\xd7S\x97\xac\xa4\xa3\xe1\xdcF\xc0mkp\xfa\x82\x94%\xb7\xe6\t\xa3V\x81\x9awr\xbd=\v\xb1\x80,X-\x01OG\xf7\x06\x85B\x800\xfb\x82\x069\xdd/\xd7q3]\x1e^\xa6n*\xd0\xd5u\x13@r(\xd8\xd6\xe1\xc1P\n\xc9\xfe\xa6\xdc\x1c\xdd\xf8e\x85n\xbc\x94B0\x13\x16\xcb\xd2`\xbb0K\xa2\xb2\r\xf1\xf3(\vC\x06\xa6ؙ\n_2ċ\x90d\xcc\xc1;j\xeb\x91\xfd\xc3\xd1\f\xc3R\x9a\xd9\x1a \xb4Y\x02\xdb>\xa6\x93\x83Kw\xde\xe21\xfdY\x9a\xce\u0530Lf\xd9{\x9b\x1b\xd9g\x18\x851n\x95\xc4\xefԩ\n\x11\\\xbb\xb7\a\xc4\xd0\xcbV\xf3\xef\xc4&\x00\xe5\xe1\x17Fn\xd5\xe6\xbe#|8\r\r\x04\xb3%\x82\ax\x84\xdds\x85\xdb\x15-4_\xf1\x03(\xb0\xd3\xcc\x14\xbd\xf1\xbb\x19\x95\x94\v\xbbU\xca_b\x99J\xe5\x98\xc4FSK\xe2[bu\xeb\xdc\xdej\xba\xd5Cj\xe4\n͐\x8fn\xd5gr\x87F\x16\xbc\u05c8\x97\b\xbb\xfaCCNDR\x9dD\x1c\xec\x1a>I\x9c\x85\v\x81\x9f\xf7\xb9\x82\xca4JM\xddw\x1a\xb0\xdcgK\u007f\x1e\xa2\b\xdd\xf8(\xf9?\x9fܷ\x06\xd1l^<$1-\x15[DF\xf1V@y\xeeg,\xf2\bCU\x91\x82\xa5\"VR\x1f\xb5A\xf7\x02\x85\xc8\xc8Y.0\x80\xcer\x18\xccu\x02\xf6\xab\x8e\xe6>\f\x8bb@֞\x90\x0e\xae^\xa9\x14\xdd\xd8=Q\x10\xac\xd0]\x0e.VE\xc7\xc1\x89\nP\xb6ܾ\xa1x\b\n\x19z\x88\b\x17\x9a\xda]\x15T\xa3\xcc`\x95\xa3U\x81\xf3r\xd5Q3\x95/߉\x84\x10dO\xd7\xdcN@\xe3E\x96\xb5)V\xfc\xcbOf\xbb\x15\xe2%\xa4ld`\x83\x89\x14-\x10A%\xa8U\xef\xe6d\xc5a\x83^\xc6\f\v\xa3\x931r\x9bk\xfc\xcdcAe\xd5\xc7\xe2l\xd2\xdc\x0e\xca\xee$&\x80\x82\x8b\xf3\x10\x14\x192\xb0\xcfbU`g\x88t<\x85\x1aǩdD\x06=\x90\xb6|\x84@\xb1\xe1\xe5/\x88\x85*ڗm\xc2B\xca\xcd,\v\xb2\x06\x03#P\"\x03A\x8d\x01\x98h\x1f7\xe2\x01\xda\xec\xa3\xfb\x9c\xf1C\x19Y1\b\x1a\xa3\x1e\x95\x14\xa9\r\xad\xc3\rBlr\x9b\xf9\x9cS<\xb5\\f\x14\x15%\x1d\x1fԾ\xe7\x97uT\xe9\x85\xc4l\v\x95_>\"i\x18\x17\x97\x118,d\xbc\xf8\x80\x9c*\xf3\xf7\x02\xb5\x9a\xe3hz\xf1\x1c\xd3M\n\x14\xfaz\xf7:ّ\xa6\x87\x88\x88\x02\xec\x11g\xa7\xf8\x8b\x8d\x81\xa4\x95-A\xb0R\xc6\xc6\xd7+\x00\xcc`F8^\u0086e\x1a\x8eP\xceo\xb8\xcf\xfa\xcd\a\x1cA\x95Y1\xa0bj\x19d\x9a\xc2b\x17h\x80\x90\xd6-V\\\xd6(y2Kk\x02\x8bH\xac\xc1\xef0\x0e#s3\x87\xc4\x15M\r\ai+\x1bWD\x06\x97^\xceYo\x04U[u\x15\x17n\xa8\xfd%\x8a\xc2Z\x1c\xac\th\x1c\xb6/\x98SZ6<\xc3+\xac\xb3\x9f\xdcF\xc1\x95R\xcf)\\\xd0-\xab7\x88\xdbd\xce\x0f&\x05\x05\x82\x93\x9a\xfb\x89\x83\x81\xc3_\xb8\xa0+ⷘ\xb9g\x0eU~\"\x12]\xed\xe4\x8f\x10^e\xe3\x89r\xf5\xaa\xc1U[aYJ\xd5j\x0f_\xee\"\t\x82\xec.\v\xe5\x8f\x06|̀9\u007f\t\x9b\x8aj\xbcÃw2}\xc7\x18/;\xb9z-\xab\n\xf3\x03!,r.38T[w\xd9\x16\x87 \xbby\x84\x0e\xa3\x98\x91Yv\x15U\xfa!ku~\x0f<LX\x1c\x8ej\xcf\xf1\x11\xaf\xa7\xd6\xc9B\xe6,^\xa5\x15\xb2R\x8e\xe0\xd8*\x94b\xe1\xdcai\xf69=J\U000fb9e7\xd4\x00Ć+^eDn\x95>\xc6Vr|Ŵ\xe8-\x97\xa4\x99\a\x1e\xa6!\x14k\x19\x0f\x9d\xcf@\x87p\x1d\x15\xa8\xb4\x00\x9aW\x10y\xf4\x06\xdfq\x03_\x15\xf1\x88TG\xd1\xc7\xccd\xa6\x8b1.U\x95\x86ߓ\x1b\x10^\xaf1epd\fP\xbe\xb2Q\xbf\b\x82\xaa+d\xd5\xc0\x86L\xab\xd74sP\x05\x10\xa0\x05\xbeq\x1a\aX\x13\xbb\xf9\x98\x8d\x9a\x00b\xb8\xcc\x0e(ќ\t\xf1\xe6d\x8ck\rQ\xe6\vgC\xc9{\xcc4a2\x9b\xc9\xf7\x1b\xad\x9b\b7\x12\x16\xe0\xbb\x1d\x846F\x8e~\xe0G=O0k\xbe\x058\xa9`S5W\x85\xf7\b\x15\x03<\x87\xb82\x81\x86\x97\xf3R\xcf\f\x80\x1b\x8e\x17av\x1b\xcd\xe6b bʾa\xf8F\x0ee\x01\xb6\xf1\x9b\xc4\\\x91M6\x96\xce2Z\xeb\xcc\x1a\tO\x85\xf8\x95\xe4\x9f\xeb0]l\fV)\x13pڨ\xccQT\xb7\x13=\xc9-\x05x\xd7pv\n\xed7\xff\x00fA6\x14\xc9\xd4\x140\x11\xbbW\xbb\x8e,4!\x9c \xf9j\xb7!Ԡ:\r\xabk\xf1\bޠ\x8d\xd9~ J\x01\x80\xb2\xba\x87<@\xe8G\x02\xaah\xc1|\xb3\t\xa8\xd5p\u007f\x10\x16G\xa1\xa3\xa8`ʋN\x88\x00\x1b\x93u-\xb8\x16.\xa3t\xc4\xe6\x16\xceSZ\x94\x00\xc5\xd7\x06\xae\x06\xf06Z\xf6y\x86p\x0f-@4T\xbb7hk\xea!P\xc8\xd55\x06X\x01\xca\xfa\xff\x00\x90M\xaeY\xfd#\x14\xado{\x97\xad\xf0\x8d\x1f\x12ślS\x87\xae#w[].\xeahH\u061c\xc2 d]\xdf2\u0083T!\x87<\xc0V\x16ˇ\xd4z\xa99j\xeaZ9\xfa\xfa\xaf\f\xc8Fڦ\xe2\xf2\xc2\xd6\xe6Ja6Q\x84\xb0<\xd6\xcf\xf9\x0e\x19\x01\\\xae\x18\xf1!\x83Sbe\xb5f\xfb\x89[\x99\xbawՒ\xb4\xafJ\x10ܧ鶾e[\x01\xac\x8a%\xf2\x8e\xac2{\x8e\r+\x0fR\xf1P(\xd2\x00\"\x82\xfc\x8eb\x05\x06\v^c\x16\x00\xea\xaeY⺴_\xa9LT`\x84cA\xb6響Q1k\xb5\f\xd4V\x84\f\x81\x83\xdcm\xc1\xed\x8d+\xcbԯ/\x8cʌ\xbaĮVy\x8e\xae\xb0(\xd9\u007f\xef\xdc\xda@\tCL\xb8vRZs\xd6\x1c\x12Y\\Ө\f\xa1\xc0\xeb4@un\xe5DBe\x85\xc9P\x02wE]\xff\x00\xc9x\x81\xc89\xa7\x82\x0e\x10\xd3\xea\n\x90x8\x8a\x12ƭġu\x03퍀=\xab*\xd2\xe4m\xcckz\x82\x82\xfe\xfa\x80\xb4\x02Q?\xe91ع\x9a\t\xecbH ݚ\xb8\x17\x14\xb8\xaa\xd45\x90ț1Ԩc/3-\x01\xb3K\xf9\x81p\xa1\xe3oDB\xd1\v\xbd\x8f\xf4\xc04\xc9mA\xf5\f\xba\xa6hh\xc70/g\xa0)\xf7r\xb2\x06\xd7[\xf5\x1e\x94c.\xe2\x15:ݲ\xc0\xa9\x82\xebr\xb9\x02\xf4\xfe\xa5^\xbbNN\x8f\x12\xed4\x0f\x03\xb6\b8\x8d\x15\x8cw_\xb8Օ\x9e\v\xa8\xbc\xb4\xe4\x06\xfb\xb8\x84p\x02\xb1\x19Qr\xdc\xc5\xc4;\x94/1\x01Θ`\x97|jPϩ\x8b\x10\xaf\x96\be\xd3V\n\x18h{\x8c\xa1:\xb8\xc3\xf9\x84\xceQb\xdekC\x81\xf7\x15\a!Fe\\R\x8c\xa1\xbf\x98\x94Q\r+\x89\x83\v\xb7;\xa9[\xc8,h\xf9\xaf\x88\xa0\b\xb28\x11V\xf7\x9f\x11\x80\xa0k\x06\xfe\xe6cS\x86\x8b>8\x99R\r\x0e.H\xaa\xfc,\n\xc64\x05tx\xeaR$o\xb7\x10\x02\x83\x9b\xbdbUBpq\xfe\xc8\xe0\x14\xa8<\xa5\r\x1b\xb3I(\t\x8bbf \xda.\xbcE\xaaW\xb7l\x02\xcbKuqvjf\fL\x0f\xb1\x15n\x16k\x15\xea \xa2\xc5\x0f$\"\x85\xb4\x8ee@rS\\\x91\xe3\n+\x85\xc0\x9e\x01,\xd9\t\xb8\xd51\xb3-\x97H\xba\x8a\x05\x902\xc2\xf5m\x8crA\xa6M\x00\xe8+\xeea\x16/\x98\x10\xa8\x14\xad\xb3\xe6\r\x85\x00,t\xbe!\r\x95\u0080\xdf\x10\xb8!_\x1b\x98ѯ\x94ep\xaanq\x06Ԭ\xc3\xf7{\xfb\x8e\b\x85\xadD]\x89\x83\xa6[\rג\xda-\xe9KcI\xc7\xcc$\x8d\x0e4\xbb\xdc\x10[\xb6Ig\x10.\x82\xaa\a*\xbck?\x12\xf4҂\xfb\xa8\x06\x81\tC/\xeeYp_(\x15\x03Um.b\x9f.\x8c\x9f\x11/\x92\xf5\xd24\xabr\xe5\x1cw\x01I\x03\x93\x98\xd8+ft\xa7\xb8\xc0\xb6̈́\x80\x8a\xfaʢ8\x8b\xa3j\xd8\xc0&\xb6\xf25LF\xb5\xb9\xb9\xba\vٺ\x94J;\x8e`_44\xbb\xf5)ϡA)\x15P\xc3\xf9\x89\xccI\xadNe*f9}ʹ\x05\x9dL\xd1e\x8d\x89\x11\boV\x1a\xf6C\ra\xc0ʣ\x1br\xf3+-\xcfP\xa8\x05A\xf0\xb2J1>\x93\x89\t\xc9\x10\xe8\xb1\xcb\x19T\n\xc6(F\x8c=\xb0\xa1\x02\r\xd3*\"\xd34\xe2Uŗt`%\xca\xc9\xe5\x15|;\\J\xc1F\xf1\x10\xfa;\f\xfb\x82W\xab#Y}\xcc\xf0^\x10\xdb\x12\xb5\xa0qs'a\x9b\xc2\x19\xae\x88\x04\xb2\x01\x01\x17 \xe5!\xf8E\xb2\xd43\x1a\xcaq.\x1d\xc1\x8f[\x97+<\r\xe6(\x97Yl2C\x15K\x16c\x12\xfd\n\xc9iܷ\x18\x10p\x9b\x85\x8f\x06ޙP\xa3\x06\x9aek\x88\rLwg\x89\x83\r\x1c\xf2\xd7q`\x18pG\xfc\x1b\x06\ba\xb5\xb5\xbdK\x88\xca\xe4\xe1\x96\x1a_\xfa`P\x86ƀ\xfd\xc6|p\xb1\x02\xc50ޡ\xca\xea\xd4\xf1\x02\xca:)\x83\xb1\t\xd5LFV\xed\xde#1\xc8@\xef\xc4\xcf\x00\x1e3\x88a^\xab\x1c\\x\x02\xabx7.\x88\xa8q\x94\xc4\xf9\xf6'\x10k\x00(FfAW]\"C\x16\x84\x9bHh\x9f\xff\xd9"),
+	"static/img/gopherpi.jpg":            []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x01\x00H\x00H\x00\x00\xff\xe1O Exif\x00\x00II*\x00\b\x00\x00\x00\v\x00\x0f\x01\x02\x00\a\x00\x00\x00\x92\x00\x00\x00\x10\x01\x02\x00\b\x00\x00\x00\x9a\x00\x00\x00\x12\x01\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x1a\x01\x05\x00\x01\x00\x00\x00\xa2\x00\x00\x00\x1b\x01\x05\x00\x01\x00\x00\x00\xaa\x00\x00\x00(\x01\x03\x00\x01\x00\x00\x00\x02\x00\x00\x001\x01\x02\x00\f\x00\x00\x00\xb2\x00\x00\x002\x01\x02\x00\x14\x00\x00\x00\xbe\x00\x00\x00\x13\x02\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00i\x87\x04\x00\x01\x00\x00\x00\xd2\x00\x00\x00%\x88\x04\x00\x01\x00\x00\x00\x1cN\x00\x00\x00\x00\x00\x00Google\x00\x00Pixel 3\x00H\x00\x00\x00\x01\x00\x00\x00H\x00\x00\x00\x01\x00\x00\x00GIMP 2.8.22\x002020:05:08 19:29:47\x00&\x00\x9a\x82\x05\x00\x01\x00\x00\x00\xa0\x02\x00\x00\x9d\x82\x05\x00\x01\x00\x00\x00\xa8\x02\x00\x00\"\x88\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00'\x88\x03\x00\x01\x00\x00\x00\x03\x01\x00\x00\x00\x90\a\x00\x04\x00\x00\x000231\x03\x90\x02\x00\x14\x00\x00\x00\xb0\x02\x00\x00\x04\x90\x02\x00\x14\x00\x00\x00\xc4\x02\x00\x00\x01\x91\a\x00\x04\x00\x00\x00\x01\x02\x03\x00\x01\x92\n\x00\x01\x00\x00\x00\xd8\x02\x00\x00\x02\x92\x05\x00\x01\x00\x00\x00\xe0\x02\x00\x00\x03\x92\n\x00\x01\x00\x00\x00\xe8\x02\x00\x00\x04\x92\n\x00\x01\x00\x00\x00\xf0\x02\x00\x00\x05\x92\x05\x00\x01\x00\x00\x00\xf8\x02\x00\x00\x06\x92\x05\x00\x01\x00\x00\x00\x00\x03\x00\x00\a\x92\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\t\x92\x03\x00\x01\x00\x00\x00\x10\x00\x00\x00\n\x92\x05\x00\x01\x00\x00\x00\b\x03\x00\x00|\x92\a\x00\xceJ\x00\x00\x10\x03\x00\x00\x90\x92\x02\x00\a\x00\x00\x00\xdeM\x00\x00\x91\x92\x02\x00\a\x00\x00\x00\xe6M\x00\x00\x92\x92\x02\x00\a\x00\x00\x00\xeeM\x00\x00\x00\xa0\a\x00\x04\x00\x00\x000100\x01\xa0\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa0\x04\x00\x01\x00\x00\x00\xc8\x01\x00\x00\x03\xa0\x04\x00\x01\x00\x00\x00V\x01\x00\x00\x05\xa0\x04\x00\x01\x00\x00\x00\xfeM\x00\x00\x17\xa2\x03\x00\x01\x00\x00\x00\x02\x00\x00\x00\x01\xa3\a\x00\x01\x00\x00\x00\x01\x00\x00\x00\x01\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x02\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x03\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\x04\xa4\x05\x00\x01\x00\x00\x00\xf6M\x00\x00\x05\xa4\x03\x00\x01\x00\x00\x00\x1b\x00\x00\x00\x06\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\b\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\t\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\n\xa4\x03\x00\x01\x00\x00\x00\x00\x00\x00\x00\f\xa4\x03\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00â\x00\x00@B\x0f\x00\xb4\x00\x00\x00d\x00\x00\x002020:04:04 17:50:22\x002020:04:04 17:50:22\x00\xca\x01\x00\x00d\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00\xf7\xff\xff\xffd\x00\x00\x00\x00\x00\x00\x00\x06\x00\x00\x00\xaa\x00\x00\x00d\x00\x00\x00_\x00\x00\x00\xe8\x03\x00\x00X\x11\x00\x00\xe8\x03\x00\x00HDRP\x02\xefd5m^p\x1e,\xea\xe3LW։\x11\xd5\xca,k\x851k\x1d\xd2\xc9zK\x9f\x02<\xd53p\x13\"\x9aWO\x15|t\xc3\xceK\x90VQ\x85\x8e\xa3\xce}#HP'k8\xb4\x17H\xabk\xac\xbdi\x15\xeab,3x\xbb\x9d\x1a\xad\xfc3e\x88w6\x9e=ӊ\xee\x1059\x1f>\x05ʼ\xbc\xe8\x92\xe7\xddpl\x969\xce~6\x82}d\x85+\xff߲\xc2\x01\xdbn\x04\x19\xaaW\xc8\xcc`\xa7pBm\t\xfa\xf7\x80\\\x86\xab\x0f\xa6\xd0\f\xac\x8d\xd7\xe4l\x94-\xf9;\x81\x7f\xfc\xb0\xbe\xfc\x920N䁭\xd7\u0380\xf1d\xd8\x14\xfczy\xf0\x89\xd7}of-r\xa7\a\x92\xb7U\xa8\x9d\x9d\xa1\x94z2\xf7\x9asz<\xcdw\xfb\xa7\x88F\xbfo\x1b\xda\x18a\xa3w\x81Z\x92\x94V\xab\x06HֿD\n\xb2\xe0\x8e\xde,\xb09S\x11r\x94\xc8\x10\xaf.\xfa\x90\xf1\x80\xc7?8\xb2\xe0\x87y\xaf\xbf`\xa6\x8aS\x06\xa9*sO\xc5\x1d8\xc3\xdaoӐ\x89\xf8\x8ai\x19z\x02pw\xab\xbem\x8axѷ\x15\x89\xb9\x80\b\x8d\x89v\xe7\x01C\x16\xa9\x18`Ϣy\x00\xfc\x9a\a\fW\xbb\xf9J\xaeLH*7ך\x97E\xaf6\xfaL\xf4T\xbeB7\x9f\x99\xed\x03<5\x1b\xbe6\x99B^EzF\xf47\x81߳E\xd4\xe6O\x05,\x9f˪Gt\xbdz\xd7q\x00\xe9\x8exv\x88&H\xcbF\x14\xd0*)+\xae=\xa32\xca+s\x8dxLU\xfb\xc1[\xfe\x8c8\x02?\xef\xb3\v֍JgP\x87ގ\x19#[-x3Z\x1d\xc0\xf7<=J\x1f\x91ӊ\xb4\xdb\xec\xee\x15\x88-\xfb@gU\xda\xd2\xf1\xa5\xc9\x1b| \x9d\xe4\x1fB\x87\x18x\x83\\u{\t\x8b\x85\xb2%d\x01\x0e2Y\x15)\x86S\x1c\xe9Fɢ\xf9:\xb8\x80\xfa\r\xde&Q\x7f\xc4h<j\xc12^\x9ae\xe3!\x0e\x8a\xa9i8`\xce\xdfWF\x93\\3\x1c#\xac7\xb7\x88qc\xb6\x85\a\xc6z\xd6\xfb˳\xb0\xf6\xc4\x01ƹY5\xe7\xbc\"\xcd(\xf0\xff\xc9'\xf9h\x8a\x18\x19Ί\xc2+_\xea\x87w\x8e%`vv\xe5pa\xce\xdd(\xc5(\xae\xd6;\x8e\x9d\xb0\xcal\x18W\xaa\xb7\xb2~\x19/\xf0\xa4M\xbfb\xcb\xc4xD\nу\xb3\xaaʡhbj\x94<K\xact+\xdf\x0f\xa6\xe3L^|\xf6\xc4\aSR-\xac\x0e\xb6\x8e\x17<sF\x1d\x86)\xea}\xd2\x10Ho\xc0\xb1\xb8\x96#\xc4Q\xb4me/\x9d,/<\x85\xb1%^fB\xd0\xef^A\xedni8N\xe4\xc9\x1a\xaa]\xdf7wg\xbcj\xce&\x84\xac\x9d\xaf\xcay\x80*\xb7\x18#\xde\a\x1e\xaf\x9c{d\xabk\xef4&5\\\t\xf6D\xca\xc8tOwB\xb6eeF,\x01\x16\xf1m{\xa8j\xc32\x1b\x0f<\u008a\xdc;\x9c\xcf\xe8bgA\xbc\xbc3jj7\xbe\xa7\xe0\xfb\x84x\x11\xa8\x8b\\\xf3\xc6gt\xaa\n\xb9Y\xab\xe1\xcaU\xce\x01l\x95\t\xfe\x9dG\x80iU\x1f\x8a\x94\xfdu7\xc9\\U\vJ\xf0\x04\x96\xf0&\xfbv\xa0q\x02\xfa\xd0\xd4\xf8<\xc6\x0e\x8a=\xad\xc6\xe4r\x99ڊ\xae\x1fF\x86H=\xdf\x04\xb8\x1eM\x06\xb8\xe7\xec\xf5/Ur\xbd)\x88\xf8\xeb\x9d\xddE\xd7,s\x80\xbd\x8f\xdb\xd7\xfc\x10\xa3\x11N\xa5k\x19\xd41\x91\xc2*\x854d4Zw\xa4\xcb(\x8dB\x98<{E-&\xa9\xb2\xe2\xd3\xc2/\xf1\xb6]+\xd1{U\x9f\xef\x04\xed^\x88\x1f\xe0I8˾'zR\xc5\xe4\xa4\xd5z`\xd0\xf4r\xe13\"\xc7z\x1c\xd1*`\x01\xaa\x1b\xed\x0ej\xe9t\x950D\xb5\x91\xe5(D\xa3\x82\xc7\x14\x8aH\xf9Y\xd17\xaeI\x05\xe1\x8c\xfb\xb1\xa5\u0090\x95\xb3ȗ嵑\xc8\xcd\xc31\x1b\x17>\v'a \xad+'\xe3K\xcbY\xdb\xd4g]$x\x1d\x17\xae\x1f\xf0\x10_\xc2|P\f^h'\x00\xd5W\x98j\f}\x1c\xf6\xc1\xfa\x8e\xddC\xf8z\x1a(#\xba(6\xb4\xf2*r:ׇ\x8d\x96o\"I\xf17o\xd9\xd2\x00\xe2\x03K\x8dy(\x0e\x8e\xc1%^C\xf1\x81k(\t\xa9}\x86\xf7:\xc0\x1b\xee\xdd$\vHe\xf6\x81\xc5\xeeV\x1b.=\xe1\b\x16\xbe2\x9d\x9eN\xe0\x18\xfd\xf2\x83\xb7Ԉ\xf9\x8a{(X(\xbd'a\xb3c\xa8\xe6p\xe73Ŏ\xbe/%s\x1b\xbfL\xc3O\xb7\xa1Uғ\x1c\x12\xa4\x8c`\xe3\x11\xdbڼ\xbc\x1c\x0eL\x9b\xc6\x15s\xd6of\xab\xaa~}\x91V\xb3\x13\x0e\xfb\xa8\x03\xa6\x9d\xe1\x9cؼ\xfcg\x93\xfb\vjnAl\vp\xa7髀7\x91\xfc\xb2\x16\xb2\xc5\x06Q\xe3\xd7Z\xd4YUtyI,墳\r\xefͦ\xcb\xe6\xfa\x17\x15\xc0\xb0\xf9V\xc1:\x13\xebE#\xa2?V\xbb\xfb5\xfe\x8e\xc1=\xfeO`u\xa4\xdcz\xdd=\xcbV\xb2ro\xbcbxh\xb0\xc3\xe9\x88ՂZ0\xe8y,\x14\x02\xfa\xc6\xecB'\xd2\xd6\x01\xd9\x1cKx\xa7ƽ\x7f+\xd8$\xd3E\x8f\xa5\xf6\xec\xfc2I/9_q[\f\xeb\xe5\x9c\xd9t\xd9\xf7b\xf7\xd6\x03&\xba\x85#ٷ\x9f\x04\xdbUa=%\xe2Q\xbd\x1c\x9c\xa6\x90\xf59ӧp<?\xc1\x17\xbcm~\xd1\x05\x17\xaf6\xa4Ȏ\xa4\x83\x06]\xca\xf44ZMP\xe7\x93N\x81\xa4_\xc10\xe1\xe1&ނ\xe5^o\xdf@\xd3?\xe1w\x89ۓ\x8a?\x1b\xafM\xc2P\vx\xa4\x89\xa32A3*l\xbe\xe3\x06\xbev\xad4\xedn=HC\xed\xc8-D\x94\tw\xdd\vu\xfe\x8d\x93=\xfe\xc2\\s\x93\xf7\x98\x83&\xfa\xd1zH\x90\xa5\"\xd40\x8b\x8f9o%H\xbf\xc9{R\xe5z\n\xf2\fjj\xc3\xc7{IlN֥\x97\xc7?A\xbd']\xf0\xd1\xf7\x1a\x1e\xb7r\x0f\xe8\xbcn\xffk\xf6)jh\xbc\x16\x8b歺Fܺ\x19*\xfa\xb6ԍU\xad&I\xf2\xafj\xae\xcb@\xa9\xa8Lǹ1\xf4\xc5&%eS\xf3\x99\xe6\x01\x19\x87\tz\xd3\xcf;B\xa8\xbb3j^\xc8T!\x04\xea{\x11\x00\x82>\xcd\xf6q7\xfd\xad\xbb\xa1J\xfb`{\xc3Mr\x9d\x02\v\x8d\xd7oo.\xc5\xd9=\xfa\xbd}\x16\x03\xe4\xdem\b\x14\x81\x19\\P\x14\x93\xc6\xe6`\x0e\xfb\x15\xb4\x05\x8e\xa8T\x9f\xe38\t!A\xfa\xb4˅O\xc8l\xb5\x8dr\x9e\xbcz\x9e\xc9L\xc6O\x84a\x92\xc1\f\xbe\x8a\xdf4\n\xad\xd5\xcd\xed\xb4\x15\x8a\xb6)t\xdd\xc0\xff\xdeķ\x84\x9b*\x1c\b?\xd1(f\x12<h\xb6\xf1\x18:}T\x91\xe1~0eq\xce\x0e3\xbf\xb7\xd6\xcc\x00\x9e\xba\xcf\xeb%\xcdg\xd0\x1dyL\x83l\xb3y\x1fW\xdct\xd8\uef0e\xf3=\x91r'\xca\r\xec\x18\xbf\xb6u\x10\x8e\x1boy\xeb\xd2U\x80\x1b#\xfd\xcfq\x9f\xb4\x0eg\x117\x15\x83\x8eg\xd9\xf8Y\xa8\xf1Jbs\x17\xf9I\xfd\x85ݟ\xc5\xe7\xb3>\xb2\x1b׃x\xcbB\x00k\x9fQ\xa8`Q\x94\xb1\xe7-\xef?\xa6\xdf\xfe\xb0H[\x85\xb2aعY\xb0-\x18,\xcaD]\fP\x1e\xa0\xb8n\xf3\xd0q\xd54\xb6\xf9\xb1\x00\xd6S\x9e\x85,\xc9\xcc{\x98\x9a/\xc0\xcaJT\xd8՚\xfb\xb6\xc6\xef\x1c\x1dΨ\x16\xd1\xe8\"!\x1b\xa6:\x86;\xc6\x17\xd3`\x99\x12y\xfegEq\x9b\xc1<*!\xbe\xa1M\xe7\xf73I5\x15\xb7С\x0f\f\f\xba\xda\xed\xcf9\x80\xee\x1f\v\v\xf0\x9a\x01D\x1eSt\x1b\xcc[g\r\x06\\S\xfcc\xdcߴ\x83=\xbcr\x93yd\x9e=\xa7T\x1eI\xc3\x13\x81@\xceG\xa4,\xb9@\xc9&V\x00c\xbfB\xfcN>4\x89\xf5\xda\xcdt3\n\xcc\x1a\x1f\x12\x9b%G\x85\xf5c\x9b\x91Q\v\x83V\x95'\b\x1d\xfa\xf7\xc3\v\xc5\xdc\xc1\xbb\x0f\x95\xe9\xdeŊ\xd3O\xec\xf8\\\xbb\xf2W0%\xd0\xd0\xf7\x89|\xd7|\xcc\x17\x9f!\x03p/b0\x8b1\xdba\x9a\xf9d\v\xb4A\xb9^\xbb3v\xc7\x12C@\x905M\xed\xff\xac\xeczYX\xd7k\xd0+?\xbd;\xa0]\xeec\xd5G}\x93\x1e\xef0\xa8\xe9w\xaa\xf7΄GJ\xd6JYU\r\xd5\xc44\x88'\xadK=P\xbf\x10M\x12\xf7\xf3\x96R\xe8\xcc\x1f\xc5U䌻\xae_`\x1f \x84\x9a\xf2Y\x8e\xb8\x8a\xe6\xcfyA/\xee\x10\v1\x7f\x92\x16\x12\xf6\x8d\xe8\x86\xf5\xaa#\x97\x80\x82\x84.P7\x03:\x99\xaa;\x1c+qA\x7f:\xf9Qk0\x12\xff\xa4\xcap\xc9\xfcF\x98\xd6yZ\x01\xb0\x7f\x05\xd1_\x1b\xddRZ\xbc\xcd\xc6\xd6_\xdd>\xbfo]!\x91\xc6\xfe\xc7ס\x17ο\xc1d]e\xafiJ\x88\vAa\x18pGT\xc8ʚ\x94\x9fލ\x92x\x04_\x9bG\xa9Z\xa0-\xba\\\xe2\xb7,\xc6\x14\x9f\t\xe4v,\xce\x04\xb1Ë\xff\x04\x17:\xf3M\xf8\xed0\xe0\x94\xa8\xa7\x9c\xedWD\xa1d\xf5\xcb0h\xf1\xd2뚵\x9fz\xe9\\\rǪ\nm\x11\x9c\x88\xa6\x1d)4\xd2\xc2~\xb8\x99\xa8Ӊ=\xfb\xaa\xcb?hU!\"\xb6P\rF\xb4\xb4b!ZU\xd04\xc0+\x97\xc7թo\xac\xeb]\xdc|\x99jǁ\x9b\xb6\x8c\x9d EOY\x1fk\xb7,\xc9k\xc9\x03\xce.\x7fZ\xc5ں\x8dW\xa4\x883Ru7q\xba\xc4\xfc36\xd3=\xa1\xa7s\xe2\x9c\xd0\xd5<깜\xba\xcd `\xe6(rw\xe7t&\x0e\x9d\xca,\xd1\x06\tq\xbd\xb3ko\xbaMP\nh\xf2N\xd8\x1d\x9e\x8b>\x18\x1f4(\x8d\x03sT\x9e>\xf8\x1c\xaf}\xa0\x9e\x020\"\xfaR\x11X\xde!M\x8af\xcf\xc1\xa5ո\xd9\x1a\xa1\x12\x8eS\xca\x16c\xbf\xf0\xe7\x8f\xd4\xe81\xa0\x05\xdbt\x9e-\x7f\xaf\x99рO\xfb\x1c\x14\x87mgJe>S\x06B^5\xeb\r\x04\x92⩞\a4@\xbb\x9c͋\xad\xc1k\xb3p\xe8U\xdb\xd6y\xafA\xbf\x87H\xcd\r\x0ew\x96(\xaf\xacQe\n\x92D\x9f\xde\xeb\x8c\x03\xdd\xdc\xc0\xdf\x1a\xa3\xaa1\x81\x80Q\xa1h\xfb^ŎQ\xbcv\\\xba2\xaf\x80W\x91\xc5*\xcf\x13\xf5\xeb\xf2\x8e\x12\t\rt\x85am*\x1aU\xe0^0\xb71\xff\xf4\v\xc5U\x8b\xbf0\xdd\xf8\xac\xaa\t\xa0&\xcd\x15\xe1\xcf\xec\x1fa\xe9r\x00\n\x10Ω\xa9\xce\r\x9c\xb0l\x161\x9e\x98\x1f\xe0/\x1bt\x16\xa6\x84j\x94\xcda\x1e\x8ev\x10, \x92A\n\xfe\xcd\xec\xf8\xb2\xae\x1eF \x9b\x8d<,,~\xf1=\xa1/S\xf0_\x99\xa5]8\x90\xc7+\x8a\xd6\xd5\xd7^\xbcS:;\x1eu\uec23\xb8U\x18\x84\xfc\x1b\xbc\xb2\xd8^t\xed\u05fdh\xb6:F\x84;c\xd3(\x8bN\xbd\x7f\x04\x9b\xe0\x06\xb9\x9b+\x0f\x82\x0fL;\x82\x99+\rm\x88{\xbb\x8a\x8e\x95kr\xaa5\xaa(\x9d\x00\xefR\xfaHß\x01\xac3\xec\xfeu{\xbboʝ\xdf\xe9\xbb{ũc\x94\xc9Ѝ\xb3<\x14Z\x11T\xa9ٹ\xe5x`^4\xbe\xa4\x86\xf8\x0e\x0e\x8b\x90Z\x1b\x82g-\xccSk\xa9\x9a\nOK\xad\x15\x85\xa2\x9a\x13\xe5\xd8\xe8~5\xfd\x84\x9c\x90\x1d\x89\xa4c0\xb8q\x87s\x0eߊ\x9c.\x92\x8c\xd6\x13\xd0$\xfe\xa9f\x89q\xd9\xc15\x87\b\xf5V\xf4\xfa[F\xc4\xe4\xef\x8dTF7B/\x84\xf3\v\xfb\xa5p\xad\xb9\xa9\xfb\x05F\x99\xa7f\tj \xf0焥\xdb\xdfz_\x05\x8d\xa0\xf5yZ\x82b\x99\xb9-lr\xf7XΤ\xb2_6f\xe30f٦\xd9\xf9Q\x97\xd9͑\xc2.Q+\xb5Qֵ\x7f`\xaca5\\\xa0\xd3g\xb6\xc30\aj%\x99d\xf9\x1c\xc2+tB$\x91\xa6\xce\x1bj\xd2\xc3\x1f\xfaZ\xbe\x0f\xe5\x82%\xfa\xfb۪\x1d\xcaψ\x8c\x15}&\xb4t\af\xc2b\x82*\xa0\x8fȳ(\x8a=T%\xc5\xc0QW\x82\x8ak\x06\x19$g\x06\xa1\xec@Kc=\xeb\x8d\x16\x03\x92\x93C\xa7\x13\xecjUK\xe4Ȼ\x1f\xce\x10\xa7V/p\x9d\x04\xd9>\xa7:/\xe2\xb9\xe6QŇ~\x9d\x9fM\xdb\x05\xf8\xe6\x10T\x81\xd6>F-\x02\x17\xd4\x7f\xa1\xa5\xf3I\x93\xbb\xa7\xe4M<IE&֠&/Y\xa3\xe92Z\x9d\xf0\r\r\xac\x1b\xd2j\x9f4\xb3\xb0y\xceQ~!qr#\xb0\xa8\xd1\x1a4\x9dF\xf3\xf61\x1a\xa2dV\xdf\xd8\xe9r\xec\xda\xe2vvD\xf1\xe5|\x88`X\xa3)Bs\xb5\x84\x81\xe9*\xe2\xb9bܪW\xeeţ\x13{\xadv\x05#\xf2뭇M\xf75\x12\x06U\xe4\xd8n<\xd7m\x7f\xf8I0\a\xec\xc1\xa9Ps{\xea3\xbdh<\r\x7f\x15\x94f\xe6\f\x16\nzr\x99\x89\x0f\x92K\x1c\xa8\xeaBC\xb1\xae̞\xd7fqyŵ\xcb\xf8.❦\xaaj\x864\xaaB\x8f`\xa2\xd7F\x98Ŧ\x82\xa5\x0e\xa8\xbe3\x01\xe1\xabtD\x8b0\xfaK\xb9s<\xc2\xdc<\xfc \xe3-\xb7\x9b\x18\"\x93\x86\x89\xd0Q?œ\x06\x18\xb7X\x89⡠W\xe3\x0f~cǶ2\x12\xeb\xb5Y\r\xe7\x81S\xa7F+\u17ed\b\x9d\n\xe8`\x9d\x12\xd0\xf8C\xec\x03\x85\xdaH%\x1e\xf0ұ=\x8dL\x989\xef8:?\x90\xd9\x1bκ\xad\x1f\xf3\xcdGI\xa2:\x86\x10\x91m\t%\x8bcٽ\x80\x86U\fГ<\xf7Lj\x98\xf2\x06G\xa4\x8d\xddG\xb4N\x04\xde\nj\x9b\xe0\n\xbc\x10.y\xe1p2١\xbcj\xeep\xb4\xbf9\xbe\xf1\xea\xf2G\x16jF\xc0\xb4\x00\x835\xd9<\xa3\x1b\x01\x17\x02\xaa\xdfݞk_O\b\xf2\xcf=\x91\xc8\x11\xc0\x81;AF\xa0\xafe\x96mȚlM\xe8/dn\xaa\x04\xb6\x80\xc0\xa4\xd4:\x833\x84%\x1fy\xa9\x9c\xedl\xd6V9\x90\xbf{\xae\xf4\x1c\xb7\xe2\xaf\xe2\xe4}\x8dJZ\xe6\x18nM\x94py\rY\xbcr\xe2\xda(+\x04\xc7\x1cY\xdd\x11kŔD\xe6\x87P\x92\xfc\xe1\x8d\xc8\xf8\x16\xdeԑ8T\xc6\x0e\xc2\\ z\x8acD\vR\xbf\vB\x98u\xe3aV\xd6\xc6;\x9d\x15ݑJ\xda3R\a\x92}\xf32U\xb0\xd3\r\a{i\x02\xf4\\\v\xc0\xe2Bg\x17e\x85\xc1B\x16\xf2\x1eKؿg}\x13\x12`Е\xcbK\xbe\\\x81+\xe5,\x8fV\vISvލ\xf68\xedV \xa2\xb4刨֞+N\xf4 SG;l\x19\x9cs9n\xbb\x16\xe98\xb7\x05P\\~\x9b!\u008e4\xf5\xf3\x7fCG\x02\xfa@\xc8\x10\xac\xc0*ܪ\xcda\x12\xf6\xd4\xf2\xd2c\xf1\xa3b\xb6\x1b\x9e\x04T\x0f\xb2\xd3\xff\t\xc6`\x86\xfd^\x18^\x97?\xffS\x17\xb46B\x05\xec\xf5Y\xaf\xd4߲Sp\xaa\xe6J\xb0º;\xae\xcb5v\xb7\xc2Y\xe2w\x8e\u008e\x86*\x86\x80\x93\xadL\x1b\xd8@\xf9\x90\x05`\xc1\xfb\x12\xe4;T2\x8b\x19ϖ@\x18\n\xd4Xh\xbe*<8a\x85\b\xbb\xfb~b}wh\xaeqL\xa6\x9f/\xeeV_\x98ޏz\x052\xc0Q{ٵ\x9c\xf3\xfa\xfc\xed\xea+\x8by-\xee\xdc\xf8z\xa4e\xe2\x8d\xe4\xcc%_\n\x83\xa7\x81\x0f\x1c\n\x9a\xc6\xcbE\xe3b\xec\xc7\x16'F\x00d\xfcs\x85f\x80\xb79\x9c\r\xe4]er\xf2\xe3B\x84N\xe23\xea\xeb#\xec`A\x8c7\xf3\xb0\u0381\xf0>ʃ\xe2p\xf3}\xdd\xc6\x15\x83itH\x832Y\xe3?\x188\xd6QU\x90(\x12)\x03\x9b\xb0\xb0\x88\xc2x:\xd24hݺu\xc6\x12\x12^_\xc4N\x11s\x10\xeaS`y\x96\xda\xf5C\x02\xe2\x83[\xa2\a\x9ccь\x1d\xb3\xcfKN\x15g\xd8HqŘ\xcf\x1c\xf2\tJau\xcb\xfd\xd0:\xcd\xfd\xb2v\x13.\xa2\x92\v\x8d(l\xef[\xee\x1e\xf0\xd5i\xba\x1e)`\xa2\xcf\xdb\aQ!\x82\xa1\xcf\x1a\xad\tgG+\x15^\xd1y\x02\n\xfbB\xb8\xb4\xcd|\xfa\xe7\x03\x11\xaa\x04\x86\x17\x97\x86\x98S\x1f\xbd?pH+\u0557\xf3\x18\xcc]\xad\x8b\x12m\xa2\xeb\x0eS~\x9f\x8e\xe6\x1ag\xfen\xbbB\xc1+h\xf8\xae\xee\xcc\xf5\xc8\xcb\xfb\x14\x8bS!\xd2\xe6̋\xa6\xd8\x1d\xe6\x16ܾ\x91{X\x7f\x9e\x8d9\xa8DOF\x11\b\x1c̵t\x01\xcaH\xaa\xb1\xbf\xd3\xf6OS\xb0 \aۅ\xd9\x1fJ\x13\xb2\xeef\x82\x19\xb2\xcdoI\x89\xbcs\xdf\x06\x19\xc6l\xadp\xban\x16q\x98\x86|\x00?(\aH)Y\xbcҠ3\xbf\x98z\xc6NBP\x86.\xac\x9c-U\xb4\n&$\x0e\x96\xc3Eӂ\xca\xeb\xeb*5\xc4Zhc\x10n\x02i\x85T\xa5\x9a\xacbNO\xb5\xba\x9bG\xd1\xd3\x15T\xe6\xf5+oTo\x0e\xbf\xf0\x9d8Hۦ\xae\x10.\x1d/\xe7\xb1ا}/\x19QϺ\x14Zv\xc8\xfde2\xc8C\xb0\xbc\x94N\x8b\x98\xa5\x83-\x90\xa3\x8f\xc9S\xcc\xc0\x9f\xbb\xab\xcan\x13\xacI\xf4ں6G\xc0Y\xc8\xcb\xc3(L\x03\xf3\x93\xbf\x17\x19\xd3\x15\x9b\x88\xac[\x0e\fQ\xd3n|a)\x87\xf6\x8d\xc8\\!c\x92:E\xfa\xee:\x98\x81K\x96\xa5\x9f'\xc5\x0e\xdc\xdc\x15`6e\x03\xc9\tx\x19B\x86D|\xc0\r$\x8e\x10\x9c\xdc\xfc\x1c\xb3\rI\x8f\xe5i½\xca\xddt\xeax\x82\xe8\x85I\xe3\rl@\xa9\xbe)\xeb\xb3\x17=ED\xf2E\x8f\xd5`\xacS+\xec\x957\xf8p:3P,i\xb2\xe3\xe5\xf4|Oa\xbf\xd4\n\x03\x9d8 \x84V\xe2\xabē~\x9e\x0eO2\xcc\xce\xfc\xa0\xa0\ve\xcb|D/K`\xad&D&%\x12\xc7_\x82Q\xf1g\x89l\xd5!\xe6,@\xda;Lcj\xd8\xe23\xc4\xe4\x11-\x7f\x0f\xb4Vd\xdf)\t\x84\xf7Ӧ\b\x86*\x12\x99\xb9\x8azS\xd2\x1b ru!s\x8a\xab\x0e\x02\xc5\x06S%\x96\"*\xe7\xffEhn\xee\x8c\xd5\x00\x97\b\x98 c]U\x95YP\x1b&\x88V*\x1cXD\x9e\x91\xcf3O\xbb&\xacq\xd9*\x86R3\xfb\xe6G\x83U\x91Q\xa3\"\xeb\x90{?\x83\xd7a\xba\xbb\xc4^\xbc\x96\xbb;bv\xe6A\xae뷟/\xf7n\xed\xad]\xdb\x1f_\a\x85\r\x1f\xaaȚ\x9diDs\x1e\xf6\x95܂?h\xa9\xdbی\x18\x18\xb9<\xe1\x85k:\xee\xe6\x1e\xe4\xb5\x12\xb8\x9c\xc0\xd7a\xbc\xb2\"P\xa4\xa2\xf9\xe7bRm\xe8\xdduʀ\x11<\xc5$a슗\x98\xa0\xa3\x02E\xee\xf9bБ^\x9c\x1d(\xf8N/\"\xf8\xde)\r\x96fu\xcek\xef\x17\xa9\x93\xfc7\xf9j\xbe\x9c\xee\x81\xd9\x18\xf7R\x00\xaf\x8aA2\x0e\xd5M\xcaQS\t2%\xc5\"\x19\xf3\xddW\x18;a\fa\xbb(\xec\xf5t`\x9d\xca\xd2\xfdu\xca{¿\x81\x12fǒu\x04\xf9\x8fb\xa6\xc0S\xf4\xa7K\x9a\xdd0\x85)\x00ǄS/<\xbd\xf0\xa9\xf1{\xa7\xa8\xc4\xf7\x8d\x9c\xba#\xa8 \xc4%\x8d\xc5\xffm\xd0߲\\\xd2\xda\xd0w\xe0̑\x8d\x85\xc3s\n<*ȩ\x81CsZZ\xd8\xe0*\xc9\xda\xc8\xfa\xb1\xc8:\xb3\xcfq\x82M\x9b\xce{>-\xe5+\xb3j\xb4IǺ\xbb\xb0\f4\x9a\xae\xb6=gvߎ\xfc\xff\xcc\x13\x16\xcd-\xf9\x0f\xda\n\xb9\xbe\xe2\xd9dZY]o\x1f\xd4o\xe2Na\xe8\U000573ea4\x95X\x9e\u07bd\xbb\x03v\xcc9\x9c\xc2uz\x8a\xf2\xe9J\x04\x8d\xe8^\xa2\xd6\xf0_\xfd\xbd\x15\xf6rDe\x1f%)r\x82\xf4\xae\xc4\xe3&\xa7\x17_3\xb3e\xb3\xf8V\x80pCS\xc1\xe3\xed\vSQ @\xe9\x84&\x9e~\xd4}g~C_R#R\x15j9\xaf\x88\x96\r\xd8W\x9c^\x9a<Q\x9b\x9e-\xffO\xf7\x1eo\xf5\xbfq\xdc(\xf9\"\x10\xa0b\x9e\x0e\xe0\x9a\x8a\xe3\U0009ca69p\x7f\x92\xaeIv\xf4\xc7\xc0\x19\xe1\xe2=\x9e\f[\xe1FKɇJ-\xc3lyȘ\x9c\xbf\xc7]4\xb8\xb7\xa0\xc1b(\xf4\xd3\f\x8dqa\x02L\x03\xf4=\xfaCj\x9d\x9c2\xefL@r\xa7\xa5e~f\x03+\xf6\xd8g7ѻ\xaa\xa6\xaab\x89\xf6\x1a\x15\xf6\xaf\x82\xe7\xf7/M\x81\xd45`\x1cs2w-Fr\xa4\xed&\x0fh3\xdaC\xfc\x1d\x05^ƴ\x97\xba\x95>\x92?)d\x13;\x1cx=\x01\x85\x9c@\f\vŁ\x0f\xb1\xc0\xbd\xdb\"\xcf/\xf9\x11\xa1\x8b\x17\xba\xad\xab\x94?\x9e\xec\xcd\u0088X\x81\a\x1dӣ\x8b쯮\x06\t\x8e\xeaT\x87\xf8\xe6ᘜ\x16\x99\x9bc{^\xa0\x8c\xfds\xe0\xc4\xecm-;\x90\xeag>,B\xbe\xb0R\xc4\xe9ܝ!Oڐ\xca\x19\x9e\tF\\\x0f\xd9,\x06\xb6z@\x0f\x91\xe8\xff0\xf0\r\xf3V \x0e\xf4\x8f\xa8߽\xe9\xf2j\xe4\xab\xc7w\u0095 \xe8QDJ\xbd3\x82\xa6^\x13\xfe\xc1+N\x9e\xd3\v\xa8\xd4lK\x80\xf3Ĵ\x9cۘ\u058c\xba\x88u\x1c\xc9fX\xdfN\xe6J׃|\\\xf0\xd0\t\xe4\x05\xe6\x9b_\x1e\x17c\xa2y\x04\xa6\x04\x12\x14\x9e\x94\xaa\x8e\xcf\xf5\x14\x13\xb4\xde\xe9\x03\xaa\xe6\xe6V\xb4\x8aa\xa0~\x06\x84\x9b?\xf9\xf5\xd9\x1c\t\x86\xd2\x11|\xa4kuRj:[ެn\x95]\xbd\x1f\xc1\xa1Ͼe\x8b\xfb\xaf*Wg\x14\x8d\x7f\xe8\r\xe8\xeel\xec\xf59k֍\x1b!\xadM\x15\x15\x91\xf09\xe5/\x96f\xa2a\x85\x88\xcbK\x19gy\xbb\xcb#^#m\xd7-\x80\x03\xe7\x86\xdev\xd5;\x80:I\xfb*\x181r\v0\xc8bё\x923\x92\xa8\xa3\xe6d1\x11\xb6\xb1*B\xa9\x87\"O|\xd3SQ\xbb\x8a{\x154\xeaMK\xed\x97\xcf!\xc2覙[#\x01\x1e\x11Ķ\xef\x17\xfeG3\xba\xef9\x06T=\xde:\xa7=\xe6\xcbh\xaa\xac\x17\xa2iiD\x11\x83\x88\xfc\x04e\xd3p\x89v\x14=\xafc\xc2i'\x11ۼ\x90C\xd4\n\x96\xa7\xc0\x0e\xa4\x86\xf6\xe9b\x8bM\x7f\xbe\t\xac\x88+|lLp\x86N\xa2@7\xb3\x1a*\xa5\xe4Y\xf5\xc8SC\x8dڠoA\x17\xbe0\xe4\xdbl\x9cx\xdc5\x1e6v܇vI\x00\xcbf\x02`B\xb8\x85\xb4\xc6\x1f*\xfe#a\x8b\xfe\xf5\x81\x14\a\xa0~9Ē\x8f=W\x9b\x0e\xe9\x9alMI\xfa\x14\xc29\xae\xc7ē\x13zq\xd6\xe4\xcd\xe6l(\x12\xa73\xa7\xa98\xc7\xf7?\xc1K+O\xa4\xef\x8c{\xb0\u0530\xf83\xda\xed\xff\xb6\fd\x96%\xe5\xf1\xf1\x8c\xb9\">\xaai.E\x16\a1\xceG\xf3_\xafT\x9f\xf9*\x0fh<[\x1d \r\x9b9k_\x02\"3\x95\x84\xee\xec\xb5\xdb@\x06]\xfaT#*M~p\xa0O\xc1\x8b-M'\x88a\x02\x8f\xe9\xe0L\x9f.\x90\xc8B\x99\xb2\xf0\v\x89rm\b\x17\x87\x88\x14a\x8f\xac\xb7\xa5똱8\x05\xbeJ;\xa5Y\a\x8f\xfd\x1e\x9b\f\x18\x18Ȫ\x84\x1bA\xb9\v\xccyo\u0557Ř\x86\xd6\xf0\x94\x03\\\xa3$\xfaY\xdbJ\x031\xf8\xfen\xa5\x94\xe2W8\x80\xfb\xa0\xa1\x8fWf\xb2\x1b2\x04}\xfa\x8bR\x15\x17\x9d\xef\xec\x0f\xac!A\x9a^į\xb4\xfb)\x9c\xf8G^\xa5\xac\xf3M\xe2q`Z\xb1\xf2\xbd\xf7\x1dYk\xa3[\r#\x0f3\xe3\"\xb9\xcb\xf9]\xb1\xc7\xcd8\xb4\xb7W\x130 \xa2uBem\x96r\xbe\x82\\\x14\xce\xc5\v\xf8\xec\xe5ن\xb8\t\x83\xde&Gӈ8\x88ϓp\xec\xe69֭\xb4ҢZҒ\xe4\xcd\x0e\xde\xfa\x0f1IƅJE\x9fSz\xf7V\xffW\x9f5c\x9fП\xfc6\x96A\xcdڞ*\xf1\xfe\xf3\xe4\x9e\xf3\x00\xc7V\xdc\xeaQtb\xc6za|\x0e\xf8\xc0\x8d.:냜L\xba,\x98>\xb4\\\x00\xd5żW\xdd0\xf4\x91x\xae\xf1\xf1\xa6\"\xd6\xe5\x8e\xe9\xea\xfb*RBE\xd4<n\xb1o\xc5c\xfb2\x8c\f\x85\xe6\x1d@̊\xe7i\x9c\xb3O\xac_0Q\xbc\a\x14\x03\xc8\x06o\x10J\xb8\xd5˃\xcdf\xf0*`mݛ\xa0H\xfeia\x1d\x05!}\x98Q\x82\bğ\x8aLd\xdc\x1d\xf4rQ3\x9f\xe71\x03\x97t\x89=+\x00\x10\x02ݦi<\xff\x8d\xa2!\xb1Z\xe5\xd6\x11\b{\xdd\xc8f3\x17D\xfc\xa7\xfd\x9e\x0fW\xbfO\xf3\f\xad\x95\x7f\x14\x05{=\x89\xf1\x9b\xc1\x94\xba'\xb2PO\xb4[\x8e\xf7\",o.\x1e\xe6\x86E\xf6\xf0\nX?V\xfe\x8dr`\xbc\xcd\x14\x9dF\xf5\xd8pfTH\x8f\xdeﾋ\xb7\x18\x91}\x90\xdc\x1c^\x90?\x02\xd7sH\xcd\xe3\x8cA?\x8f\x96\xdbȣ\xfd\xe0\xd8T{\x82\x9eD\xf0\x8aZ\u05f7\xba\x965p\x11\xf6\xf5\xa4\xd1\x16\xf4h\x0ep\xb2ap\xefī\r\xbf\xb2[\xea\xf1\xe5-\x02gd^\r\x87ً\x8a\xa5\b#l\x95\x9b\x13\x11m\x84\x86E9\xfc\xe5\xf2S.\xb5\x7fR}i\xf0\x86\x04\x89\xcfu\x8a\xf7D\xea\x99䆢@0\xeah\x1b\n\xceY\xd9|\xba]\xbd\xa2\xdb\xf8\xb2\x88Rj@S+\x8b\xa9\xe6\xf2\x16\xb4Tn\xbf{\xde\xfa\x12\xc9NW\x9c\xb5\xf1R\xd7\xc7\xd8~\xe9yAٜ\f̥\xff 5\xa8t%\x97,G\xdaŤ\xed\xa8T\xe6Q\x19\x1b\xb8m\x05>[nl\xa6<\t\x8f\xf0\xc1\xaa\x18\x0e\xa8\xa8g\xb2\xa3\xdeZ\xedy\r\xc8\xd6\xfc(\r\x99\x83\x86\xf9=\x86\xa2\xd1\x11K\xff\x9b%\x90\xdf\xf3\xbd%J%\xfc\xb5>tkV\xf1a\xf4\x16\xc5\x04p\xd22\xb9Bf\xf4i\x1dT<e^4c벦@2{\xdf}\xf9KS\x8f\xbf:\xd9\xfd\x80\xdb\xcfAiį\xffe½\x95\xf4*\xeajWJ3\xd7,\xfeky\xe6 \x8b,.F\xb5\xa94z7\xee\xc8\r\xafS\xb5Y\xf1\aF\xb3\x06\x19\x1fi|Yɑ\xbe\xc94g\xbc\"\xc3LB\xb5\xe1\xbdQ\xa9F:\xdeb\xa4\x1f\nH\xef+:^\xdf\xd6\xd80S\x82:^^\x9a{;\xd5\xe9蠫\x1a\f\xa8\xfbyNi|\xf5\xa8\xfb@Y\xb8\x1e\x84eY\x05,\f\xc9j\xeb4vf\xa63z\x94&\xa9ف\xab\xae}\xa4r\x1c\x7f\x02\xeaP\xbe;\xb2\x95ά̭\xf3\xb5v\xc1\x8a\x82\u05f8\xb0\x9ec;)=\x9cH\xa9\fi\x9e\xbe\x97\xbcC\xb04\v3\x19\bL\x9bj\xda$\ny\x10|x6\xbbP\x91R\"\xc4\r\x0fB\xad,\xa5\xb8Y\x8e\xe4T\xdbW\x00\xf0c\x8c\xe7%\x1e\\\x8a\x18\xcaH\xce\xf8\xdc\nA\x05Jy\xefX\"\xaeI\x96\xfd\xc76\x1d\vl\x9c\xf8\x9cF\xf9B@\xd4%\xb6\xfeȐ\x82\xeb\x02ك;\xf0,?\xc8\ue65dT*a\x8f\\\x17#\x17V\x8a\x8d%\xff\x15\xe4!\xefc8\x87Q\x9f,\xab\xbe\xb4KhrE\x81\xb11A\x9c~\x81A GW\x84s*\xc2\x1a\xf6\x00\xd6И\xfe\xa8\x8f\f8\xf4\xe7&\x84\xe0\x8d\xf3\xa5+\v.M^\xe5\x81\x11\tH\x1f[z$$/\x83\xa25\xf9\xec\x19ͯ\x9cp\xd4҆\xc1,\xb1\x061\x00Bdz\uf7abP\x11\xbe\x19\x97\x9d\x1b?\xfcF\xa4\xf0\xad\xf7&\x13\x91(\x1d%\xa1\x02۞\xad\xe8\xe7\xa5\x15G\xb8\x19\x166\u07b2\xf0\xc0\xc5LR#\x8a\x03|\x96JP\xa6\x81H\xa9\x9f\xe0\x1d\x81\xe6e\x8a\xf5\x86~\xb8\xfc+\xc7`\xacS0tAk)Yx'\xffR\x9c\xa6\xbc\xf8\xac\x04\xd7\xde>)\x02p\xbdS}\"\xb2\xf5\x01\xbd#\v\xb7\xad\x10\xf5\x1c\xb1\xf08\xd1b\xf4\xccޭV\x87\xb8ꀒ\xfcP\x05\x15\xf1\x81\r\x15Dc\xf3rƥU!\xe0\v\x17u\xa7\xe3\x95\x14u\xc0/\xeb(H\x8b\x01\xcaQ\xbd\x1a\x1c-\x99S\xf5\xe8\x8e*\x86EQ\x01#\xfe\xe9\x1c\xf0\xca,\xefծ\x96lkȩ\xc5s\xc3\x06\xe13S\xceL\xe0\x11T\xf2\xcb\a\xba\r#D\x91%\a\xb6\xfar\xfa\x87\xf2\xd7g\xd5\xec\xeem\b\x8bԚ\x1e\xe6h\xa4\xdc\x006\x86ʁ\x04\xbd\x01k\x04\xcf\x17\x06d.A/\xe8\xe9\xcb>]n\xb8\xb5\xc7\xdc\xdcT\xbd\xff\xdc\xfe\x9fVjM\xd7r0\x89\xc0+\x97'\x90^Xtd+\xa9\xb4:\x85\xc2?\x10KIx\x911g\xc5\x03\x03\xfe-\xfc\xf4\xbc\xe3;\x93\x94b.\xb7\xc6\xc8c\xa9\xa2E\xb6\x0f*\x94\xb7\x13ޏ\xa7S\xb9!p`\xd5q\xf7^\xa4\xa6Q\x90O\xc0AА\xa6Buիi\xc42\x06\x14\x8bQ\f\xdb\x1f\x1a\x18\xd1\xe3\xff\xfe\xe5\xea7\xe6\x0eH\xde\x05\xd8b\xe3\xeag\xe4\x1f\xcd\x136ju\xc8K\xa1a\xca1o\x15\xf4\xaf\x1bޞ\x0f\xb7@\xb6\xca\xf7~\xd5\xd8\r\xb6SX\\b\x05\x9f\x98\x92\xac\x14\xc3\xe8!r\xe5\xd8\x19\xa2\x801Qn\x98p2D\xefiw\x01Tv\x87\xc13e\x048a\xafקlTOc\x8b\xb2\xa8\x85\x13\x156\x16\x0f7\xda?f$\xbd\xbd\n\xfcR\xa6\x98\xd0G\x19=\xec0\a\x1f\xe1\rZ\xeb\xc7ʘ\xea\x01n\xd7\xf9\x95\xc3\xf6o\x18\xf7\xca\xf4\x80U\x8f\x90X\x9c;\xa6\nc\x02\b\xa7M\x90X'8\x1b\x8a\xc1\xe1ԑb-\x9d>\\&\xde\xc9Ô\x86JG{\xb2ͩu\xbf\x93\xdd\xd3\xdbm\xd56\x90>.RC\xc2\v56,c\x17\xb883\n8\x0e-\x9d\x01\xf9\xfaG;\x94\xec\xd2\xc5\v\x1d\xd2]8\"p\xc4o\x18\xda(\x12R@G8\x1b6IdB#\xbc\xe5\x878\xae\xdc\x01\xd7̤\xafw\r \x8e\x9f\xa3(\xf1\x01=\xac\xbc\xa5z\x90\x80\x1c7\x0e\xd8\x1c\xb8\x13\xcd<\x00\xe3\xfd\x04)\xe5\b\x1a\x8f\xf9h}\x83ȿ\xae\x80r\xc2\xfd\xa0+3dQ\xaa\xddkbzi\xfb\xf4\x02\xf8:\xdfג֑\xcc\x7f}\xd62\xed\xb5\xfaNV\f\x10l7q\xecȗ\x1c\x0e\xfe\x92@\xb0%nu\x8f\xd4\xe67F\x98\xad\xba\r5\x16\xb3@\xa46\xbb\xf8\xf0\xb2}\xe0\xfd^ko\x83\xa0\xa7W\tt\xb7\x8b\xe8s{O\x9b\x01֖\xedX\x05jxA\x0f\x80\x95\xc1Ν@\xd0E\x05̹S\xf5\xbf\x9d\xb2{\x96ޔ\xb4\xcb<\xcbTMY\x87/\xa3\xe2\x95\xf6_\xfc<\x02\b\x1b\x9b\xf5\x1b\xcb\xfcZ\xb9\x9fY\xfd\xa3\vY\xd6N@\xff9Sl+Ȼ\xf0\xae\xf4cٗ\xbeo7\x1a?\xf0\xdbz\xb0\xb7\x9e\xd3|(0a\x9f\xf4pqZA\x8fsj\xd91\x8dA\xba\xe5-\fͫΎ\x1c\xa1\xa4\xa7\xf6\xa8D\xb1\xf5\xd7ac\x05\xb4bU\x9a\xa5\x88%h\x8f2\xdbR\x9c`̕\x01B\x15tNn\xed\xb85\xf2Uͩ\xb9\x93fh\x9f̝\xeb\xecs\x86F\xd0\xf7p)\xd8\xdc5\nB\xdb=\x1a}#\xad\xf7i\x7fq,\xc9\xe0\xc6\x10\xa8\x16\x9d\xb4\xd2\xf5]\x12[\xfdr\xfet\xa9\xf0\x932J\"@*#J\xf3tq\x1d\xbfB\xbe\xa0\x8f\xe5\xe3M\xb1\xe2\x12\xa3G\xf7bE\x12\xa6\xbd\xf4\xfdM\xa2.P\x1a\u0605\xc6)\xa4ge-\xb0\xa1D\xaf\x0e@\x13Y\x87\xd7!J\xban\xf4\xbf\x99\xc4\x00\x82\b\x8e\xb7\xde\xd7D\x14\xa7%\x16o\x8f\x8fr</\x9e\x9c\xe22\x14~\xbc\x92\xceYJ\xfd\x85^#\xe6\xab\xe1\xcayÂ\xa6}\x97Hh\xbev\xf2*>\x9f\xd2\xee\xfaA'\xd5*\xfa\x8a\x89\"n\x0f1;\xf4\xa7\xc4\xca\xd4g\xad\x8f0m\x7fn\xa7\xcf\x0er\xbf\x89+\xb6\x7f5&fo\xc7`\xf0\xd4V\xde\v\xcf즎\xbfwN\xdf\xe6\xc1\x14I\xaf\xd1Y\xba\xbc\xd0\x11\xcf\xf0\xe7_\xf6j>\x86n\f\xff\x14\xc5\xcb!)\xfe\xb18\x80\xa6\xfc\xabƛ\"`\xe6\x16\"{\xa3\x95E~U\xafE\xba\xbal\x9a\xfeL\xcaw\xfa\x8dp\xbf\xc5V\xec\xb4\xc7Ʌ2\xf0\xc9Ӕӟ!Ŀ \xa2A8\xc1\x18\x94aaN\xcao\xe8\x9dI\x81\f!\xff\xb2\xf6\x1f\xbf;\aX\x81\x8b+p\xa4?Z釀\xb3?\x90G\xfd\xf9\\\x0fZ\xbej\t\x8bj\xeb7\x06u\xf4v\xc6\x1b\xaf\xf0\xdf\x19\x9b\x05\xf3\xe4\xd33#\uf83c\xc5.\x88\x1dx\xc5\xe0GZL\xd4OE\xda\xd5!\xc6\xd1\a\\\xad\xa3\xd4S\xd8N\r\xf3\xc0\xdd\"\x16;\u0085\x1e\xd61\xd0p\xa1\x8a\u07baaCdCa?\x9d\xcd\xe7:7\xbdb#\\\xfeW\xca*\xbdQ\xb3#\x9e,\xfaQ\x96\xa9\xbc\xf0\tQ?xyk\v\x11P\xe7\xeb\xb3\x19\f\r?\xa1\xb9\x1d\x97\xb9\x12\xde\xf9\x11\x88p@]\x8f~4\xa0X\x84T\xeb\x942h>Ⱦ^\xf0\x9e\x9d}\U00099db1\x10X\xc7\a\xc7\xdc]\x97Yu\xe2s\xe3f\xa7\x1fF\x14\xae\xeb\x9b\xfdi[\xb8\xf4\xa8\x12\x1dX\x7f@\xf0\f\xfc\x03\xa0x\x0e\x80\\w\x95X\b\x8a\x9a\xdfH\xee\xeen\xd3\x19B±\v\xefN\xe9\\Gq\xeer\xfe\x13\xe8\xdc\xe4\xe3\x92T\x1fv\x1f\x04h\x03<\xf7\x1fa\xffNX\xb3\n\xffl\x19\xd2\xc5\\\xb5\f\xc6\xdb\xf5\xc7K}\xed\x1c\x1c\x83\xbd-\xa1\xed\t\x85O<Xa\x8d\x93\x05$x\r\x9bV\xfb\U000f0837\x1e\x92\xf6bN\x0f\x97\t\x8c\x1c\xf3Қ\xd4\xdd)\xa2q\xeca\xc7\vd\x8b\x17\xae\x12%\x8a\xc1Ӆ\f$\xa5\xbb5\x1eiͨ-\x8a\xd5j\x89S\aHB\x04\xc2\xe2\x98}Ej?\x8f\\\x8c\x86\xbd'}\xa66~\x05I\xf4E\xa5\x8dt\x11w\x14\xc9It\xeb\x15\xd1\xf27U\xf3\x11\a\x83\x1c>?\x9b\x0f\x144\x97/94\x11J=\xc0l39\xd3\x11l\xc48\xf9\x15-\xd5\xe4do%I|D\x92\xb6U\x18)q\xdfa-,O\x12\x8d\x89\x17c/8 T5\xa9b()\xacȊ\xb8\x18\x89i\xac%of\xc7\xfd\x18\xf5\x8f\xc4}V\x90X\xe1\xc2\xdd'=[]\xab\x88\xe3Hm\xc2\xd8o\xbf\x11\x96{\x1c\x89\x1e\xf5H\x9c\xda=\xbe\x9b\xaf\xc2T\x10Bm\xbbb\xe5\xa5\x10O\x9b\x96\xc4^\x7f\xf14Cx)\xf26\xe1\v\x05\xfaQ\x85y4\x98\xd4\x1ax\x84<\xc0p\xa9$\x1b\xafv\xf1c\xf3\x85o\xcbЮ\xf0K\x95\x1e\xe5\x88\x05\x9c\x89Xgs^\x90<\xf2۩\"\"\xdd2\xbb\xe2H\x17͛٥\x9d\r\x8dW\xfb\x18\x1b~\xbd\xff\xbe@W\x8e\xc0#\xdc'\xb2\x1e\x1eK+\x01곿w\xf6֕\xb9\x88\xa8t.5\xc8yt>!\x9a\t\xd4\xf0 \xa9\xa7\xb7\\ǒ\x04GM\xeco\xe6\xe6\x8b\x067i\x1d\xef\x8f\xe35\x8c\xee\xd9\xf5\x9d\x1ahĿ\xc6дd\xec\xec\xd1\t&\x14\x97\x17~8\xffN\xfc\x82\xc81\\\x17\xb9\x8f&\xf2\xe3u\x1e\xf4@\xac\xaeC8\n4\xd9\x7f\x86\x1d/\xb7\xe0\x93\x15HU\x1b\xa8\x8c\xbbo\xb7ۯl\x14i\xe2\xee\xe2\xdc\xc1\"Q\"ˬ\xa5\xb2W\xe9\x1d7\xf6\x89b\xcb>\xe0\\o\x06\b}$\x15\\}\x89\xfcv\x19U\xef$\x17\xb0\"\xab\xc9;\x00|\xd7\xca\x12C\x1f\a\xb0\x01\xcb3\xcf\xf5i<\xf9%\xe9R\xec\xda<\x97\x1d>K\x9bݚ8\xb5\xc8\xc5\xc5N<\xca\xc3#Qa*\x05\x8f:NR>\xef ?^\xb7y\x7f\xa6\xe9\xebln\xe1ں\x85\xcd;\x82kMȐ<a嘕\xac\xa0\xc5\x00i}\xaebD\x94Y&\x9e\xfb\xa0T\xc5H\x97\xc3\xee\xfddIS\n\xcd\x11\xbe\xe8kel\xd76\xab\xe7\"\xe5\xbe\x1e\xb0\xd0\xe0\xb5\xf7ft\xbe\xa9v\xe0\x8b^Y\x8b\x01\x9f\x04\x10A\x8a\x89\xef\x822ŷ\xbc\xa0\x93\x12\xa2\x1c\xe4\xb5q\xae\xecH!\xce<\xfc\xa6hFz\xc8\x19\x8d\x8co\x96\x11\x95\x94\r\xb7\xbf\xa47!\x15%*\npw\\\xa3\xf5\xfcC\xa2\xda\xe3\x1e\xbe\xb9\r*\xbe0u\x88\xd1J}\vW):\xb6_\x87\x1a\xa5ꍽ\xcc\xfa\xab\xcb9\xd43p6-\xf2>\x8e#\xbc\xfd\xa2v\xd6ul\xb1\x16$KO/e5:\xcc\xf5\xc4\xf8\x9a\x96\t\xc0\xeb\r\xde}σ\xb6\xed:\x98s\x88s\xd5\x19\xe9\xb7Q\x1f\x9e\x13\x9et:\xaa\xf0\xde\x19\xb7\xa8\x8fH\x89\f\x88\x12FE\xad\xae\xc5M\x82\xaf\x94\xbdG\xe7\xf0a\xb0\x18\xf4\x13\xecǟ\x92\xfa\x03\xc5\xd3\n\xa3|\xec\xf7W\xb5\xacrYU\xe4츈\xbd\n+\xce\xf5=T~P \x8d\xfdne\x95G**\xa2\xa6ۨ(&\x11\xef\xf6\x02\x99p\x89\x98ޗ\x82c\x8d\xea5\xb3R@Q\xb1\xca\xdd4\xe3\xa3`\x04\x88q\xe9\x11\x12\n\x06ZS\x106\x94'3R o^h\xf8q\xad\v\xedҩg^\x9c\x1bF\x06\xcfu\xa4~\x0e\x8b\u009d\x14\x15\tE.w\xe3|\xc66$\xb9\n\x0eM\xe7rw\x12V߱\xa2\v28\x89\x90\x84\x14֗\v\x18Zs5\xf60\xf5U\xd7\xd7e۷.\x1e:\x90r\xa2ٖ .\xf9\x19\xee\x1f\xe9\a\xab\xa6\xb6i\x97\xa0\xcc6Dj\xef\xa9oD\xa6\x14,\x03\v\x96\x0fa\x01\x82\xe1\ba\f5\xa4E*\x9b#j\xf6\xdb\xf0\xf3\xa2\xf1\v!hd\xb7\xbb֗_س\xe8\xd3\x1b\xb9\xcb #'W\x90\xb01\v\xd2\xc2<\x9f\xefX\xe4\x8fb\xb4\xb7\x9dΡ\xbcZ\xde\xf6aY\x19\x8e\x025\x15\x89ϴ\xe4\x9d!\"\x91\ue834\xde\xc4\xdbhL\x98\xcd}\xdc\nI%N\xf6\x85\xf9\x91\xe3\x00\xda\x00\x9d\v\xa6\xa3\xb3\xb5@\xbeE|\v\x1b\x9e\x13n\xcf\v\xacO\xe7ڬ ~ڠ\"\arwo\xf5\x88,\xa4D\x827_u\x89\xfe1\xc0Ky-\x8d\x1b\xa3\xeb֛\xff\x19\xef\xe3bH&v\x89\x15\xd2Q\xa2\x03\x11/\x87,\x93-\x87\xe9\xaaEX\xaf\x03r\xc7J'P\x9f\xa8sN\xbd\\O\x8f\x9eMv5D\x90\x8a\xb5eOԧ\xef-\xe1\xa6\x0fJh\x8f\x95\x13t\xe5 \xa8\xdc$Jx\xab\xbb\x87\x99\x1b,\xdf\xe0\x931\x89\x91\xc1KW\"\xa0\x13ӵF~\t\u07fb\x1e\xb1\xb3\xf2\xbc6y\x94,\xb0\x94{\xcf\x01S\xaf\x83\xb0y\x0e$\xa5\xe0'\xab߈\x8d:\x16\x9c\v\xac\xf0̮\xb0\x8b\x8e\xa9{\xe4,\xa5\xd5ާ!v\x1dM\x98\xf1\xe7\xdd\a\xe1\x89\x1e\xbdA\x01\xbbW'\x178\xaaT\xfb\xd3M\x1bFG\xfc\xde\f0\xd7\xd6f\xdcR$\xf9\xb2\x0e\x8e\x98\x82\x02\xd5ߦ7D\xb8\x1bMY\x87R\xd4\xf8\x8d\x1cm\x1b\xe2\x9d\xd2QL\xf3\xed\xac\xfb\x02\x1d]\x049=˅\xcd\x17}\x98I\x93\xcbl\xe8o\xbc=\x8db\x90\xd4:[\xbd\xc2PaŌn1\a8\xde\xe9\xaa)\xc1\xd4D\xef\x19\xce\a=\\\x8eu\xb4'\xa8\xfa\xba\x96\xb5(,7\r^\xfd\t\x81\xc7\xe0\x06\xfd\xff\xea\xf1\xcb\x04\xbb~\xa6{nX\xb3<\b!\x13~\xa7\x800Ne\x98\xcfv\x1ct%\xe6G\xe5]\x14=vg\xff4\x01%\xa1\x9e\x1f\x8b},L\x16\xb0\x81LK\x14!\a,\xb3\x02\xb0\x9d\x84G}r\xac\x1c\xb6\xfbk\xd7\xd5\xd3'\x85uJ\r\xf7@\xac)x3\xe3\xb8\xc7-&U^\xf3UҪ\xbc\xd3\x04HzLm*\x19\xe9\x05\xe8\xe9p8\x83\xc1*\xfff[[\x15\xa9\xcd\xfc\x11\xe9ͺ\xf3\x02O\xbe|G\x84\xfb)ٛ\x1e\x9d\x9b-\x90&\x99~\xf9Ye\x1a/Oq\x0f\xff4\r\xd7\xc8S\x89\xb3z\xaa\xd3u\xe5=\xbf\xfdXRIv\x7f\x15\xb2\xb1\xdaӟ\x03x0\xb5\x16N\x1bWu\x1a\xc8\x18RJ\xe7U\n\x98\b\xa9\x03\xb3\xbc\xc3\xfe\x1cΒ\xa9\xa4\xb8\r*\x1fi\"1\x81u\b\xbd\f\x98I@\xe6$\xd900\xefW(\xae\xda,\xa1\xdd\x1f\x0e3\x9b\v\x11\x95\xbd\xff\xb4\xca\xfa/;\xbb\x83FS\xc0${\xba6\xf2\xbf&؈\xe2\xcee\x98D>\"U-7Q(<4n]\xddZ]\x1f\x18y\x94t\x0f\xe6Nv*\xdac\xfc\x1a\x81\xd0\xc9;\xfa]\x9d\\\xb8\x8a9\x87\xdbӋ\xdf\x19\x996\u009ch\x82[\xb5h\xe11\x97\xc0\xec\xec\xdbyO'\xd3\xd4`\xb6\xa5\xeeЧ\x8a\xa2\xe4\xa1w\xe1J3\xb1o^M\x02\xe8\xb1\xe0N\x15\x0e+\x7f\x01q\xebg\xb3\x15kyk\xe44\x12\xf8\x01\xa2\xfb\x90C\xbe$M휋s3\xb0(\x14\xa3I\x98>\xc0ĚU\\J\xb4w\xf7\xf7\xea\x84\xd1\x05#˺v͢\x9a\xeaR=\xf6a߄\xd8\xf9\xa5E\xb9[\xa3\x9b\xe4I\xccHk\x92\xf5%\\C\xa1/\xb7\x80\x13h\x06\nڃϥ\xca\x00\xfd\v\xc14\xd6\xe7r`䰞\xf4\x0f\xaeq\xc0~܈@\xadLo\x8a{\x84¡\xc2\xf4/hk(Q\xb2\xd3\x1d\x82L=\xa7\x8e\xaeta\xb8\xdc\xc2^F\xa7JO\xa9\xd59(\xb6*\xe2\xa0S]\xea\x13\xdd.\x92\x9fu\xd0cx\xd0\n\xc9\xd0F\xf7/Q\x90\u0601I|J\xd6\x03\xafҺ2X\xd9\xc1\x94\xa2)R\x84\x03\xe1{ \xb0:\x9a\x18\x8a\x00ŗMH\xf4\x12y\xed\xd0\r\x03y\xaf\x16\xd6\x10e{\xf0QuYeŵ\xfb\v}\xf8\xb3H\x00\x93Y\xc7n\x9a\xfcU:о\x0eƌa\x19|8\xac,Ș\xc7)\xb5\x8c*B\xbc\xf0⯷\xd5K\x03\xcd}\xf3_U\xab\x80\xbb\xb1\x97\x12\x14\x15\xf4\x83\x8b\xde\x19\x13V\f\xd0uE\x12\x19(\xd89\xb9\x126\xab\xfc\xadwC\xed\x9e\x16x\x1cvch)D2\xd6\n\x04P\xcah\xa3\x1b\x1b\xff\xa1R\x82͕\x95\x9de\xe6Ү\r\x84r\xbe\xb9\xe7O\x82)\xca\xd5\xc0#\x97*7\xc7\xe5Ǝ\xa5\x18\xd3\xd6\x7f\u07bfr\xc3J\xa9\xd2\x15S\x9e\xa1\xb0\u07fbN\xbfd\xc9wŀE\xf4\u0530\x9b\xdb\xca\xe7\tM\xa9\x92\x96HW\x95?\x16\xc1\xa5\r7\xa6\x9c]\x82\x8c\xef`\x9eK\x83ߩoul\xe6$Խu\xaa\xbc\xc5\xe4\xc7ZT\xc3\b\x96\x1e\x0f\xb2ԲE\x01\xd3L\x05\x03Lst\xda\xcad\b<\xb1\xe98\x15v\xcfpN_\xbd\xf3\xf3*TOڗ\x87͖\xcd(\x02\xe3ּ谤\x92]\xfbB!\xa5\x92r\x16\xc0C\xa3P^4m\xe6\xebJ\x9c\xf4\xea\xe5\xf0\xed\x1d\u05f7\x01\x04ۧ\xa1v\xbfo\x00w\f\xe5̥}l\x1af\x90;\xfa\xfe\x1c\xfd\x87\x8a\x98\x02@J\xe3<\xc8\x1d\\m\xec\x06 &snb/\x89ʘۊ\xf7>M\xd2Qq\xfa\xfbYq\xeatl,\xe1\x96\x10\xc0\v\x061\x00Sd\xce\x1a\\\xfbŁ\x06\x12CP\x04\xd0\x1a\xd8)>\v\xdf\xc3~}n\f\xe8\x13\xb3.\"\xf8\xbc\xad\x89\x9c4\x84\x00\xea\x12\x1f4\xf8\xf6\x1f\xa7֜\xa8!\x8f3\x01\xbd ]}\xfch\x92i!\xc1\n\xad\xa0\xc2v\r~\x9e\tm1\xe5ץ\x02\xc3\xfd\xf7ʹ_\xbe\xf7\x9d(\v\xc8k\xe6\xe5\xbc\xcc9I\xe0οim\f\xa3\x9fK\x19\x9b\xac%\x83\xa1҃D\xa7¨\xf7\xc0nm\x00R\xfbO\x10\xa9uHz0 \x8f\x13\xb2%\x1d'\x99\xdf\xe61\xe6)\x9aw\x114\x18\x83\xd4\xd0߬\xe3\xe2*z8RD\xc2\xe0\xc0\xc7`\xbepPH\xf8\xbf\xb6\x89\xe6DT\xb2L\fl\x88,~\x86\xcb3\xf2\xa6\xbcqs\x87,\x039c\x13\tP8\xd1\x19\x8d\xe5\xe7\xc0\xbf\xadI\xc9\xf3\x1d\x96\xb0\x8d\xbb\xec\xb9\xebܭ\x1c~\xaa\x89{{\x81\xe9U\xef\xf1\x01m\xa4l\xd6\x13,R\xd0?D8\xee\v`\x88B4E:O\v@:2\xc1\x81\x0eg\x9cQ߷b\x7f\r\xe7\xef\xe6ɘoi܊\xf7\xbec\x82\x19郃\xb4\r\xb8\x18\x7f>\x9b\xa0\xae\xfany\\L\x16\xba\r#i\b!;\xfdQ\xb8\xdb\xc8\b\xe9{\xcf\x1eWן\xd6S\x84(\xcf\xd8k\x0f\xb5pX@ࣇ̦Рӽd_\xf5m⠿k^i|M\xc3_\xfc\xd5\x04\x80\f\xf4\xb1#\x1b\xc9?\xc9\x16$\xa5\xa5\xcazB\xba_3\xc0\xb3\xc0\xcd\a\x1d\x01\xfd\xfb\xfe\xbaX\x8c{$\x0f\x87\x82uG%\xbf\x88\x90\x8dU\xe6\x1a\xee\x91\xc4\\\t!i\t\xf9\xac\x14\xd7\xe8\xeb\x9d=\x1d\xe2\xf4\xc5-h\xed4\xfa\xb3Ӭ\xea\x15\xa6\xdc\xf5,\xdc\x1cŗ䕝H\xd0\xf9u\x11\xd7b\xc0b\x8dU\f\x9f\x8b\xef\xcb\xceY\U00054107\x0f֭\xfbD\xf0\xb0\xb5)\xd0\x15\xfb\x83\xc2L\x8f\xff\xb7P'\x9c\x83\b\xa4\xc0\x81\\=,\xf1\xa3\x83:\x1c\x94\x88ْ\xab\xe5\x8a\xcd\xc2\x00\x12gr\xb2X\x1c\xb7\x87J\xac\xc4[\tG\xe2\x04\xcdl\xe5}S\x1d|\xa2\xa8pݮc\x83\xd1\x0e\xc6\xe3\x98?\xf8k}X\x91\xccޤ\xdc/\xb5\xf2^\xbbG\x1c\x9f\xbc\x11\xa4ι*\x01\x99\xfd*\r$\x1e\xac\x83%\xe9\xa8x\xf3?\xb7\xe3\x1b\xc7\xe6\x8d\xfa\xb6\xabv\xac\xf6|\xe3\xec\xc6ûk\xd5\bxTcM\x84\x19n\x14y\xbd\xe5\x92\x1e\x88\xb9\xcf\xcf\x12\x8b`M\u070f7\xe5\f\xf6\x9bP\xf2\x1ekB]\xb0\xc2\xc7\x129,\x98\xd4{+\xe9\x02=\xd1\xeaX\x1bkמ\xfc\tʻy\x02\xce\x1fu\x94\x7f\x8d\xda#\xbd\xe6g\x92\xa5\xe5\xa5\xd7\b\xb4\xbcg\xe4\xc2\xe7\xb7<е\xc8\x15%\x93\xe8\xc9\x00\xc3@\xfaʰ\xae\xda-\xa9\a\x9ca\xfb\x1fE\xfc\xb7OLH`\xa9\xfe\x93\xce\x19f9|\xff\xe3\x7fZjR̹\xe2ĺ\xb6_[]\x00q\x18\xf2\x8e\x10H\xf8OeIv\x8c\t\xde\x0eٺ\x8e\x12\n\xcb\xf8\x1es\x9b-\xfe\xfa\x13i\xb5\xac\xdb\x1b\xa0\f%k\xd9n\x04\xbc\xb5\x98\x9d|\x18\x12\xc7՝\xf1\xf3\x9b\xe5cO\xa9\xab\xfb\x19l\x8eּC\xec\x18{>\u058baOً\x85\x91X3\x86\x05!\xa0h\xf8t\x88\xbcQ\xdd\b.Ł\xd8rQG\x9e\"\x05\xadw\xe6\xa7zI\x8b\x1aE\xce\xc9C\x94\xfcW\x85\x96r\x89-̭ZH9=\xbdP\n\n\xafm\x8a\xff\vޅԧ@5\xda%4\xf0v\x9b`=\x82`x\xa8#\v[\xdcWŽ߿I\r\xdc\xff\xe8/\xa2\x8a\xc6@x\x8a\x96=\xeaȪ\xe53dk\x83\xaf\xb9\x82\xbdԋU\xd7\xfe\xcci\x96hqi8\xebf\xbd\b%7\x96W苡\x1f\xde\x7f\xe3Ц\n\xb7b\xfd\xcd_\x94o\x15-gD\xeeA\xb9Iɀ\xdadL\x9dp\xb3\x9c)\x93e\x82~\xffDL\x8cE\xc2\x1fdڴ\xe0W\x03!5\x1a-\xa1O.\xd8;\xbc\x17\xbd7\x17\xa3\xac\x1d\xedRYV\x85\xa4\xa2*gK\xc5\xd8d\f\xb3a֮?\xf5\xf6\xc1\xf7_\xc7l+\x8e\x19\x856\x03\xf5w$\xb0\x8b5uǦ\x16\xc2\xcdf~\x12\xa0\xb2\xa2\xaf\xa4<\x9aUڏ\x12䚢d\x9d\xf7w*\x97\xd4W^\xf3\xccл@^\xaf\xd5\x15\x0fr-/\x0f\xb4\xa7\xd2\x18\x05\xc4f\xf8}\a\x8b81\xc8\xec_yԜ/\xd0r\xb1\n\x15z\xb0\xb6\x87\xc8\xf4\x05\x11\x83\xbb\xb8g\xc31\xfd\xde>\xfcI\xf9Ѓ{9?9}\xb2K\xe2\x96W\xa7c\x15\xcbA\x97\xea\xa3V\x1b\xf2\x9b5\xc2<|\xc1Rx\xd8\x03\xec\x9c\u06039<\x92\u008d\x8d\xc5\xca\a&\x84ǖ\xe7G\x91\xc4L\xafk!\x0f\xa9\xae\xe8O\xae\x97\xc8Vul\v\xf8[\xf8\x95\x85\xf0-(>\xdd\xff\xbd\xdb\xe2\xc2\xc3Jo\xa9\x85\xfdI|3dK[ZI\xcb\xc3\x04\xa1ƺi\xec1\x91\xf4(\x90\xb1&6c\xf3\xca\x01h\xd6\x02\xb4fԹL\xf4a\x9f\xd7j\xfd\x18\x17d+v\x9eV\xdf\xcb\xc1\x8e\xc0\x1d<\xdf&\\~\xf3\xf4\xa6\xc2\x11>\xaa\xecG\xbc\xe8\x81>%\x16\xfa\x0fS\xbf&\xf6\xfe\x11\xe9\xf3\x89]\t\v\xae\aã\xe7Șr\x00\v\x99\xfd\x9a\xfc\x03Fur\xb3\x01R\xff\xd0T\xc5c\xb1\x95\xf8N:\tx6\x9e\xfc\xa2'ޞ\xc2$\x812m\xd2.\x96|\xa6̗\x91\x00[1ߥm\xf0\xca|\x02\xb6{G?\xb8V\x18\x04@\xf9\xffZ\x85\xeaF6B+\x1a\xcf\xce\xc3j\x8f\xe8\xb3%\xc0ڜ\x8f\xcdFMw\xf2D\xf5\xb1\x13\x8e\x83-\x9do\x8c\xc3\x14\xc8\xcfsŕM\xbe\xb5\xa9\r\xe3\x82\xe2]G\xfc\x04\xd5etd\x82?\xa8\xaa\x8bc\f\xe8\xac\xc0 \xcf\xe0\xc0\x88\x82\x17\a\x0fƈ\xdc\xe7\v\x8b\x90>mK\xd8=&\xfa\xff?bРe:acc\xd5AW\xd3K\xcdg\xa9r\xf5+\xda-=\x81(\x91\x1bl\xce\xf1\xae\xed\x10\x15?\xa6\x12\x99\xa8\x13\x8e\xe5z+f\b$Pm\x84Q\x87NGG-41\xa5\xb6\x04c\xaa\x04tUt=\xbf\x84\xf5\x04K\xcb\xf6\x96\x820Z\t\xa0Lؾ\xa8n\x14\xce3]\xd6*r\xff\xc4Sel\xb9\xfd\xbb\x9c߮û~\xa5'q\xc6\xc7\xc1\x9f9\xe3qB\xa4\xc5\xf6\xbe\v\x1f\x8c\xf8\x9dy\x8b\x1d\xb5=\x8f\x9f\x1cc\xa0\x10D0\x1e\x18W\xa8y\xea\x8b\xd2\xf0\a\x1a\n\xcc\xf2~\xf8\baU˂\x16\xb2>\x950r\a\xff\xd0C\xb0\x002\v\xb7\xb3\xea\x88y\x7f\xb2o9\xf7\x03\n\xcf\xfcy\x03\x8bR;[\xca\xf7X\xb9Y\x9b\xec)\x8dX ;\x04\x91\xcdI߾d9y\xa7t\a^\xe4\x96\x1b/e\x83\x91\x05f\xe8\x19[O\x18\x01+\x95㸭\xe2\x9aQ8u\xca\xe1\xaf\xfdW\xdd\x12\xc9`~\xf2\x1aR1\xd6l\x9dDs\xa4\\\xff\x85P\n\xa7\a\x16\xb1\xea\x88\xd0\xf1\xa5\xbe|\x84(\x88 \x9e\x85\xae\xb7LoC\xe0בD\xe1ȐM\xb5\xc2n\x96\xc7\\Ԟ&r\xcd˃z\x94\x84\xd9f\x00}\xb0\xa5\xcd\xef\xed=\xffcT˘\x16\xe9\xa6\x0e\x02]m^IW1\x05\xd3\"QL\xdcY\"\xfau%,\x92\xfc=\x01\x93-C\x1a\xa9\xa4?ߏ\xd0YQ\x02o\xad0\x91\x95}\xf5(`kuZ\xaa\xa6\x97\x00R\xa3e\x1d\\\"\b\xc3p\xb7&\xe0\xa00Ro\v\xacj\xc4\f\xa4\x8f\x1c\x03\t_n\x85@\xcb~\x00ϛ\xd3\xc9\xf5f\x96\xe9ʖ\xba\x8fU\te\x9e\x1b\x04\xf9\xe7\xd6/\x81\x8ep\x19vZ?4\x8e\xe9s\xa6|\xa4\tՓWW\xda\xfc.\x9e\x99-\xfd\xabUYn\xfe\xf1P\x1d-\x1d\xbc}\x9b\x1c\xab\x05\xb4\x97\xf4\xe9]\xbeb\xf1\n\x10\xa4\x13\xc2\xe4\xae\x15W\xec\xb2Ѕ\xb0Vm\xcc䏄\xa7\x03f\xf9\n\aa\xbbl\xe9\x15i\xf8\xfb\xb7\x95\xa7\x93/\xbe\xe0a\x0f#p}eA\xb4\xd2\x03S\xf3ky\xfa\x06\x89\x1eyyx\xff\x9b\x86v\x1b\xa7a\xf1\xa9]\xe7Ȏ\x83Ԉ\x10\xdf~\xa3&\f8ہG\x9f\xcci&\xc5\xfcG\xe3!Ū\xc0%\xa6\xe3[\xb9\xaf\xee\xb9;vl\xcck\x9bA\xc2\x1b\x87\t\xa4~\xd8(3U\x807\xe4\xbd\x14\xe4F\xfe\xd2\x00\xb9o\x9e\x0f&\xf7\x919聦j8\xec\xfb<a\xd0F\xcdIŨpkzB\xd5\xdf_\xd1:\x14\x89FX\xb1\xed\xb7\xcf\xfa\xe1p\xceDt\xb4ѵ\x0e\x16\rj\x10\x93\x01^\xd2Ic\xce\xfa\xb8f\xfb\xd5}\\$\xa9B.\x9b\xe24;\xf7\xd1\xe3A\xb2\x13'͕J\xf2)1L\x8e\x97\xf9\xbb\x8dTs\x19HT#W6ׂ\a9N\x87\xe60\xedg\x05Rk\xad\xae\xd0oz\x06\xa0>\xdf\xef\x96\xf6\x9b\xc6C\n\xaaڳ\xd8\xf0\xdc\xeed\xb9\x19\xa1\xe0\xb5\x00ʯ\x1e\xa0\x01b\x9e|\xd5z\xc8B\x82ZZ\xac\xf4\x15\xf7\xc3S\xac\x9d\x1a\xed\xbd'\x02d\x98\xfeTJsxޖ\x15\xc7\xff\x83\xf86\x8b\xe7\x89\xf694q?;k\xe6\xde:\x8b\xb7\xf4.3\x0fD\xa7\xf4~C煒_\xd1\xd2\vQA[\x86.\xb6{e\x86\n'u\xad\xf8-\x88\xdb\xd0E\x10\xfe\xbczм\x9c\x12ڈ\x15\x88\xb1\\\xc4\xd0\aI\xf2\xcdw\xac\xc8\x0f\xc2BO\x97\xaa\xe2\xe2\xe8\"AM\x15\xa1\xe4\x83.+\x8fb\n\x05\\DˣDk\xd74ظ\x96\xe4D\x96!\x81)JÚZY\x1e\b\xfd4\xc8\xce\xe8C28s&G8L'\x9c \x14\xac\x87\x8e\x9cH\xa7\x88I\x1aE\xabI\xe5\x03\b\xbe7\x9e\xf1\xfb)\x7f\xf0\xf8sŸ\xb1\x16\x8a\xcc\xe7f\xf12\xcea\xe0\x81\xe3<\x99%\x9e\x88\xb1\xc6[\xfdE\xd1\xe2\x17\xde\t\xea\xad\xf7\x1a\xfde\xb9\xbc\xbb\x82\x85]N\x98\x85.u\xdd':P\x82\x03\x81\x9a\x1ae\xdf$\x88\xd5k۠\xd8IS\x82t\xe19\x9c\n\x97\x8a\x88\xa6\x1f\xea\xa2GL\xd0\xefs\xb4\xf7\xf5+\xfd\xe3|^`u\x1c\x7f\xcfK?\u05ffqQ\r\x93\x80\xd4\xe4\xae\xf8+\xcc \xfc\x86\xbf\x9d\xce\x1e\xa9\x00E\xfd\x01g\xfe\x02\xae\xc6\x05\xfc\x94\b\x98\x18K\xd1\xd5X\x8c\xabo9\xe7\xca\x19\uf443b\x90d\xcfx\x12L\xfc\xd5לҺ)Y<\x82\x80\x14@\x846rxU\xe2\x94<\xd1bXC\x856%:\xf7C\x8cA\xb6\xb0\x13\x87\xfaa\xfe\x94B\xef\xb2F\xf5:\xde\x06Bk\x1d[ \xffi\xf1\\\at~\"x\x18]Ɓ,p\xf7~\u05f8\x87z\x9e\xe7\xa62\v\x80\x9e\rf\vT\xa4=Ƕ\xf5\x97/f\x9f:6\x10\xae\x94/\xffa\xff\xb3\x96\xfc@\xfe~8\xd5\xfb\x8d\x87\f\xdc\xea\u0379\xf9M\x00\xba\xd78\xd2\"\xac\x18A\xfc\xc9\xc5\xf7\x10\xc4\xdb\xc5S\x1f?a\x10\xbcl\xd7\x15U\x1a\xa2\x16\xf3\xeb\xa6\xf6x\x8b\x9fD#\x19vR\xbd\x06\xff_\x80\xae\x85\x06\xae\xdbG\xadC\xfe\x94\t\xcd[\x01=\x05?M\xd7\xca\xceT\x84\x8fS\r\xb4\x04\x98\xab\xbfr\x8bM\xed;\x1a\xc3\xcf\x0eT\x1d\x81\xc5T=O%\x81/n\xf8σ\xaaB\xa3\x8fU\xa7M\xba\xd4*\\\xd3v\xcfW\x90&D<\xb3\x84d,\xdf\xe7A\xe4\x9e\\8>f}Up\x17\xcdh\a\xb8@\xdc;چKc@\xecMr\x1fA\xe7\x17H\xec׃\x8f~\x13\x11\xe2:K\xc1\x18{\xc2\xe1\xda\x01MK(\x1b\x8bH\xcbc\x90\xff\xf3\xde\xf7\xadV\xc9]\xfe\x83\x18J^l\xf67a+ˊf\xafF~\xf4\xd2%\xf6\xac&\"!ώaC\x0e\xf1ɬ(\xc9u\xa0\xfeL\x85U^\xb5C\x94\xc9:\xd3视9\xf9\xa6\rI\x11]3\x1bE\x95\xf5\xa8\xfa\x1e&l'\x95B9V\xe5P\xddo\x06l\x86b\xbaS\x1db\xbe\xb4%\xb5\x9c\xba\xc3Ǻ\x83;\x93\x99\x92\x9c萲\x7f\xba;2\xe9\x89,^\x8aJ6\xd46ɛ\xc7w!\xfb\x12\xdb\xdc\x1aC\x97,\x14&Z\xb0\x91\x0f\xe6\x01z\xe9.\x18\x87[\x18l:\xe0G·+\x96נݗ\x00\xbdʤn\xe7\x04v,ۧ\xe5\xd5\xe4\xf7\xbe\x9b\x17&$J@\xfdс\xe3\xf8\x95j\xc0xwY\xf5\xf1\xc5P\xb6d\xf3\xe3\a0&Z>\ueef7\xd1\"_\xbf\xbf\x1d\x82\xd2Ӵ\xa2\xf5\a\xc8Q\x02@\x17\xa8R\xb6\x18܇\x1d[hŲ\x11\xf7b\xf1ґ\xf96\xe3\xcb\t\xe8\xff\xf3S}Rg\xa4g\x93\rd\xe8^\x93\x8e\x1c{\xfe\n[\x15K\x1e\xe3\x15[\xcf$\xc4y\x88ý\xdcf\xcc \xf6\x81;;\b\x7f{\xc9A\x8ef{i\x8eD\x83hY\x16E\xa2nl$U\xd9m-ʨO\xc6\x1f\xf7F\x8bTȷۘ2x\x18\xb2\x19\x12\xa6\x16\x19\x9e\x03\xbejT\x1c\xf0\x1bOʊ\xb4K\xccP:r16K\xc25\x92mm\x11\xd8G\xd9\x19\xf5\xde\xd9\xf6g\x94=\xea\x83\x00\x80\xaa\xa9ư\x98B\xa9\x9d\x93(\x9b~\t\xa6\xa8[\x9fV\xd43A\xa1\a\x1dӍ\x0f\x1c\xe0\xf7]\xe6O$\xb16C= 5\a/rq\xb8\x18I\xa2\xa97W\xeb\xf7+\x82\xb34\xfe\x1f\xce\xef\xee\xc3f\xfdo\xd6\xe7S\x8c\xb08K\xe1\xf1:ޒ\xd3=\xc9\b7,\x9c\xd18\x1d1\x9aW*\xca\xe9,cأk:\"\xbc\xf8]\xcf\xe5\xed\x14\xf7\x1e\\\x81\x13gR\xb7\xb2\x05U\"\t\x9d\xe4\xa7\x1e\x8e\xc0H\x8b\xd3\x03\x10\xb9ѡ\v\xfdl\x92f\xb5\x0e\xd6\xdak\x9e\x17p\x9e\x19Fh\xac\xb0\xf4/%\xf6\x1f\x89\x1ec\x19L\xf1q-\x00͞c\x8e\xe8Xn\xd1_%\xa1x\xc6{{\xd8\xf4P\xa5vm\xf6\xe9.\x8b\xee\x13N\xf86\xfa\xe7tq\xb8gИ\x18\xdf\xf62\xf0(\x7f\x03\x19\v\x17qx\xb0<\xc2\x1ej\x1c\xf5m\xd9c\xa3\xdd\xe7\xea\t\xeaV\x9a'9Z\x01\xf4.+o?\xb1\x1d@-sL^j\xfc\xd2*\x87\x92E\x18d\x11Q\x93ǎ%\x01\xfe\xe5\xc8v\xa2\x88ME\x7f\xfd?\x1f\x04o\x9b\f[\xfa\x7f\xe0\xc9\xc0Jm\xd9\x03\x82\x9ea*\xbd\xea\x99va\xeez\x83\x8a\xbaA1\xa7D\xbe\xbd\xe6\xefs\x95\bq\xc2&zۈ\xab\xdcזL\xc4ho\xb94b\x83\x19v\xedXݷ{m\xb8\x05\xeb\xd7\xfa\xe8z \xd7H|\xae\xa2!\x98t\x93\xe8?\xf6\x99=&j\xecK\xc7\xd7\xef\xbf[D\x11Y\xb8>\x8aI\x1a\xc0>M\xbe6+FO\vOb\x10}\xab\xa8R\x85!\xf5\b\xf3\xa4\u0093I\t\xe6\xc8\xc4&\xc1|5./\xbfR\n\xd8\"\x03\xf4\xb2I\xf1\xf3mx_\x8bJ\x18\x91{\xdc\xf5W\xe0\x96q-\xa0\x1f>QU\xc1\xa6\x83E\xf4\x16\xf8\xe9\x05n\x9d@ir\xbe\x14\xd3x\x17\xcf\x10\xbc\x19\x1f-\x89k\x1b,i\x8eM  r\xec\x8cG\x8c\x10\x1a\xe7P\xdexe\xdd\xe7\x9a\xd0O\xf010A\fl\xb0\xdc\n\x1b@1iIUw\\\xa49\x8f\x1c\xde\xd3T057t\xe0\xfaq4xZo?y\x8f\\\xa3\xdf\x04\xbcL\x91yTX\x89\x19̔\xa7(\x04\x12\xcd_\x91\xa1kV\xb7\xc9R\xcd\x05\x8d\xb5\xecG\f\x0eW\xc4;f\x1d9g\x11\x1e\x87q\xaa\xb6\x82\x06\xc0\xd1'\x83\x1f\f\xa6\xaf\x97\xf3\x04\xe4\x16\xc9:\x8f\x9e]\xe7y!=\xad\x7f\x94i\xed\xf8ntl\x98\xec\x83\x01aSUv\xbf3Sf)\xaeC\tZn\xc1'\xa6s\x1a\x8a\xf0\xecB\x87c\xd0P\x05oQC\xa6\xa5\x92w\xf0\xaa\xceY\xa0\x9aL\x0e\x9a9\x90\x7f\xf0A\n̗,\\\xfa'\x97\xacms\xaa\xb2\xbfrI\xef\x8b\xce\xc1\x95\x97\x15\xab\x19W\xd8\xcciN<戯bQ\xf2\x96\x04\xda\n,ƀ4`\x15\xadx\b\nHl\x9ea\xd5h\xa6\xc7\n\x17\xc0\x9e\xa2\xbeW\xa2\r\xfd\x03\xc5=mM\xbe<\x00\x86\xb0\xba\x17\xba1\xe1\x8d\xe9\xce\xe2C\x99\xa0\v{y/.G\x13\xcfɖ۹\xa3=\xd5\xec\x10\xf6\xcd\x0e\x88\xdd\xef\x16\a\xee(2\xb2~\x82\x03_\xd0aX\xa9\xd7\xe1O[\x96\xb6M\xd8uhu\x98\x03\u05cb\x9291\xb5\xc3?\x0f\xf6\x05O\"\x97GJ7\x10\xf6Y\xd3\x06\\L\xfe\x17\xc0\x88V+\xf3(\x85\xc9\xf1\xc1H\xd2U'\x1d\xee^\xfc\x97PDf\x98\xae\x15.\xcd\xe3\\\n\x15\x06-y\xc2\xd1\xd4 \x10b\xf42\x04\x043\xfd\x85{\xf49B\xeb\xd7\b\xf9m6\x9f\x8b$\xbbVB\x1e\f\xdcz7\xdb\xeb q\xe5\xbby\n&\xf6\x8a(\xcd+1M\x05S1\x02\xcfK\x18)\x15\xc0\x9b\xeaZd\x9f\x1d\xb5\x8fЏ\xa9lo9\x929F*\xd1sOV/\xe9\xcb\xdc\x00\xb4t\xean\x00p4d\x19\xf8gEB\xacK)\x116\x90\x8a\x8b\xd8ô|\xf4\xd6t\x8c\xfe\xa3\x8f0j\xf1\xa7t\xe6ŕ_\xf0O\x05ȧ\xd5J\x19y\xcbz ,\f\x8c\x00[\x16\xc4\xf2Oօi[\xa3\x15\x9d\xa1\x98?=\xc9)[ͬh\xea\xaf\xf2\xabF\xca\x1a\x06\xa7R\x9f\xb6\x9e\x1bbM\xf2\xb2\xac\x0f\x85\xd9@I\xa6\xb7\xa2\xc5k\x8a\x8b\x12\xb7\xb7\xe3*\x9eוQ\x1e\xd3\xc1%\xb2_\xc3]_\xc52\x12\xa4\xf5ó\x8d\x13~)M;\xb1K\x91\xbf\x90ޖF^ڻ\x8b\xbf\x86!&N Ôv\U00045e80\x94\\ݞ\xc1\xdfg\x82\x99\x11`q\xf1\xf1?\xfb\xaf\xb3\x9b\x13\xdc\xec\x12\x87\x11F,\xe2f\xe0\xa13{Y\x1f\x02\x93<t6-\x01\xea\xe1C\xbe8\xef:\xe4\x84Z\xa2l\"\xea\xe81^1\n\xab\x84M2\xa0\x91\x8f!-\x05\xcf\xd0E\x160\xac\x8f\x98N\xc5\x19\x1f5\xfa)\xa3V,R\x12:\xf7\x96\xcb\xd18\x91\x9fD\xaf\xb2@\\D\x99\xa3դ\xae+\xea\xc8\x1d\xbc<\xff\\\xbf\xbc\x9e\xf5\xa0\xab\xe7\xf2\xec\xb9\xf9$\x82\xa9ad\x1a3\xa6]\xd6]\xb9\x1e/\x00h\x88\xbe\xba\x89MU\xd2Δ\xf9ݽS\xa5\xd4\xf4F.p\x80M\xc8M\xf6\xa3d\xf0\x96\x8b\x10\x0f\xf0\xf3sڀW\x99\xd2A\x14\xce{Km|˷\"|\xddh^\xe31\x97ˢ~\xbc\x94\xbc0\xbb͈_U\x98\x96\xd2\"\xdb\x044f/\xbe\xc5P\x05\xe8|\xc1F\xe8Ȋ\x90/\xe2;\xe9\x9dKn\xd1H\xb5ј\xc1Ql\x13B!\f\xc2Zκ\xd96V\xa8$\xc5\xd8W\x12\xf2\xa7\xe6I\x16\n\xac\x91\xb6δ\xfa\x0e\x9aU\xea\xe0FUk~f\xb152^V>űͥ\x16Du]6r\xaf\xb1\xc5\xfe\x87\xe0\x11\x10\xcbT`[\x1b\xfbƼ\xae\xe3n%\x14\xc5\xee-\x8b\x1d\x88۹\x9bRTdl\xc1\x0em\xdf;\x84\n\b\xc2t4\x12\xb0h\xa1I=\x18p\x97\x01\x1f\xaa\x19:\xcd\xdd\xc1,\x92\x10Z\xbf}8\r\xb4hw\x03;y\x17lv \x84gݧh\xba\x05\xf0\xc8\xc2=\x8b;\x8c\x7foq)\xa0I\xdd+L&\t\xef4 \\\x1b+rg0K\xa69\xf3\xb3\x1a\xbe\x10\xf5\x99Q'\xae\xc4(\xe2\x14=Ob\"\x8ca\xe4\xf7\x91\x05\x94\xae\xd1\a\x00\xfcI\xbd3ٕ$\f\xcd);\xe1j\x92\xadI\x115\xa9Y&\x1e\x8aV\xe5\xb5:\x1bZ3\x1b\xe3'\x93\x9c+&\xb4g\x03\x83\xe0܀->\x9c2\x84\xc5ץ\xc5t\xf6\x16\x1b\xb6ߖRS~\x11Δ+U\x1b\"\x94ѵ\xdf\xd8TJ\xaaq\xec\xe1|\xec\x83c`\x16z\xe0\xbd/\xa4leu\x98.a\xce\xd5Dq\xd4\xc0\x1a*\x87\xc8\xffèV\x81z\x8dv\x9bL\xa9$!l\xed\x81\xde\x1c\xb0쬧ŗ\xf0\xf2\x91<\x83q\xbb\x94\x02I$\u0379\x81\x83D?}\aN\x999\xcc\xf6\x94\xd0\x04\xce\\uwEwz\x17)CF\x83||P\xc9F\xb4Ǵ\x1c\xa7\xfaШ\x94#\x8b#j0X3\xa8\x9cкD\xf6\x15\xa1鍽\xabK\xfc\xddā\xff˚\x99\x7f1\rjÂ\xae\x912E\xbda(Y\x16\x97b\x10S$]\xfc\xdeҒ\xac\xefvd\x80\xb1[\x00\xbd\xe4N`\xb1\xa3^xQII\x87\xf0?\x1c,\x15\xfe}\xe0rz\xe1\xfdh\xfd\r\x0f=\xaf\xc1@\x8e\x14HX\xebx\xb0\xd8c+\xa2\xfe\bQٯ>⟾B]X*\xc5=ӥ[\v\xf0\xbd?\x03(\xbe\xee\x9c\xc2\x04fEc\x06I\x99\xe9λƿ\x95q\x91\xb6\xb2\x8d~\x93\x15 P3\xea\x1f\xb4\xb1\xe4\x1f\xca\xc3{=\xe7Q\xcb9!\x10>D\xca\xd1\x03\xabi\x93\x95[+\xc7\n\x83\x19\x0fM\xb5q\fڂ.\xbf\x7f{\x15\xaa\xad\xba:\x8do\x84kV\b%o\xd6\b\xc1\x03\xa2$\x1a\x0fR\xae]H{X\b\xc3D\xac\xd7y!\x1c%:#b\xca\xe9\x13E\x91\x8a\x9f\xf50\xf6\xc3S\x01|\x7f\xaf\x17%\x1a\xb5\xde3\xd5\r\x163\x82N\x91.xI\xf0f\x14\xb5\xc1erd\xa4\x9b1tI6\x95҉I\xcb+\x03\x1e+\xf8)\a\xa6\xe78\xa5Z\x91\x7f\xd0\x03\x8frJ\x17xِ\x10zɹ\xb4E\x8d\x0f\xef\xc67\xebB}\x1f\x04\x05ݬY\xf4\x05\xe9\xfa!\xbf\n8\x02J1\n\xcaHCX\xadI\xfdJt\xf6\xf4\xb4S[C?簃\xf1(j\x8d\xe5\xb5\xce\x18O3\x1a>l\tk\x9f\xe8\x89\xc8Q\xda40H&Z\xeb35\xbc2\xe1\xf2\xeb#\x87\x01\xe9,\xe4\v\x1a+XQ\xd6\x1f\xb8\x17@\x0f\xcb\xeb\xe4\xcf#\x89\a'I\xd0\x12\xdbҾ\xe6T\xb9\b\xc6\xf2h\xf1\xbbL\x9f\xaaK\xf0\x89\x80\x1bPʋ&tړ@\xd2 \xd3\xd1\f\xf0b\x1d\xb7[\x02uL\xd1\xf20\xb7\xe1ka\xa0\xc4\xe5Ԏ\xf7\x9c\x11\xfd\xe1ٖ\x91@\xc1\xdc\xe2\n?\x8a\xe5\xf2PEG\b\xed\xdb\x13\xf3aD\xe6\xb4C؝%ҳWb\xa1\x17MX{\xb0\xdfs#\xda\xe2\x05լ\x00Ah\xdf\x1f\xbf9I\xa0\x91\xebzW\x8b\xd69\xfe\xdbC\xd2K\xdebkLH\xab\xbc\xd6\xf7=N;\x9dۦ\xffS\xfb\xb1\xb61\x8b\xdb\x02\x02q|c\xf0\xabmc\xadbRj\x9a}\xb9\fO^\x15\xea\b\x89\xeb\xf1\x15\x92Š:9#\xbb\xdf\xc76*\x15\x17\x02\x1f\xf7u\x86W\x95h\xb0u\xd1a\xc64\xf6\xee<\xd0\xdf\xf6\x02\x18\aN\xc8t,\xa6\x85\xc3\xff5G\x12\x874v\xb3\xe1\x98\xdeA\xd9C\xfe\xeaj\x97ۄ\rܠ\xb4I\xbf\xc1\x8a(\xab\x12\xa0\xb8\x0f\x99l٬\x12\xd4MW\x13\n'\x8f\x89\xd5\r\xb6\r\x80Y3\x88oW\a0\xb2\xb9\x84\x89.i$\xa8\xb8\xa2\x85Z#\xbb\x12\xbaS\a1(\xb4q\x96\xb9P3i8\x17;\xf0\xbc\t\x82\xe3\xd7C\xaba\x80\xe4\xbd\x16\x16\xd7\xf0\xf7\xd6Ig\xfaVZeܤ\xe4fD;xA\xd7[\xfdnʱ\x1fۦ\xc1\x94OE\x19E\x03\x0f\x9e78\x13&\xae\x8c\xc6%\xb2)\xfaK\xbeL\xb8\xc4\xcb\xf5\xb0\xa3W\n\xddG\xa3\xf6\x82Z\t.\xcek\x14\xc0\xecA\xf45I\tg\x16\xe0r\x86\xb0\x80\x17 [\xd9\xeb\xe84\xd3\xe9M\x9ep\xa2_\x92Ը\x82[\xd3É4P\xf3\x81\x14cY\x11\x9d-SR\xb55\x9b\xc7K\xe5#tg\xb6\xa3# \a\x02\x02K,\x81\xd4R?\\\xef\xb0(\x84\xa9\xa1\u2063t\xfe\x95.9L\x9a\xd1g\x0fr\x18m\xdb\xd2? D\xcdf\xa9\r\xa0\x98=\xdb2r\xf9\xe2HX\xb0\x88\xc8\x13]\xeap\xe3\x8d%\xad\xa0\x1dL\xcc;\xfa\xe8\x1d\x99\xe7\x06<\x98\nɻN1\xac{I@\x1b\xd3\x12\xbf\x91b\x8b\x11\xea\xfbt.˄9x\xf3_\xb9\x1a!\xfc\x86ƙ%\xdb\xca\x1cg0@I\xd518\xc4\x7f\v\x99!/=\xf5\x1d\x938\xc0c\xf3]\xaf\x93\xb6\xcb\x01`\x92i\xf4\xb6\x92\xaf\x9b\xd6X\xc5~\xddp\xfc\x10\xd8U\x11\x87\x87O\x9a\xe1+\xe4B\xcco\xfeX1\xe2q*{\xac|\xa3\xf4\xe0\xabDܬ\xbf\x84,\xec=\x9f\xf4)$'\x84A[\xb8\x98\x8c\ar<\xfe\t?\a\x8e\xf3=g\va\x19{\x7f\xfd\xa2\x19Fѳ\xd7=e\x86\x81\xcc\xf9\xd6\xd3n;\a!\x03K\x1b\xd3\x0eS\xa6\x17\x8bp\x99\x91\xa2\xf6Z\xa3*\xben-?\xf3\xf6\xda$`\xfcFV\xe7.\\ANI0Px\xedY$5c\x8c;\x05(\x9b\"#\xdc_\xf0\xe6\x9bM1\v<\x01\x0f\xfc\xe7\xaf\xc6;cJ\xfc\v\x9fN\x040u^\x02\xf8\x1a>\xc2d\xbe\xa2>\x83+,͖\xe03i\n\x02\xc9\"\xf6\xad6#SR̡\xe4\xfd\x82${d\xa7\xd3\xed5\xd8^\a\xc0a\xf1\xd7A\x81\xf1f\xb9>*9\xe4G\x91\xdc|^\xa0_\xbf\xfc\xe0\xec\xfeJ\xa2\xf8\xf9A\xe5O^\xde\x0f\xddȿq\xf4\xfbьx\x8b\x10bC~\x96-|2\xc5\xe1g@k8\xd7\xf3\x14N\x13Mk\xa6d\x99a\xb7\xd1i'ڲ`/\xff\x01\xc19We\xbe\\\x88\xaa\v9{\xa6i\x96\x12\xd8Cp\xf9\xeb]\xff\xd7>l\xb4\xa5uMf^V\xce\xe6\xa1|\xb4\t\xff\xbe+\xc5u\xfcɠ`\x97y\xa6\xa8\x98rҥAո\x17\xc5\x1f\x81\x9f\xce\xe0ox\xf1\xc0\xf1\x87\xf0)l\xc95\xa1\xfe^}\xa6\xa1\x9c\xa9Ƭ\x15x\x8e\x92A\x93:\x06]w\xc8͔\xa0=\xd7]+\x94\xfbm\x87\xed\xf2\xd3\xed\xa5\xc6r\xd7B~E\x8f\xe3~n\xae^є\xe6\xcc,\x86|\xe7\x8a.\x84\x99\x10\xfd\xafS\xac`1\xb3dfѱ9\x8f79ԭ\xaf\xbak\x10w-'dD\xb3\x93\xe8$\x05!\x9c\x06\xa8Wb\xfe\x17\xd4W\x7f}\xef\xcdq:\\q\xc1\xaf\t\xe0\x18\x15d\xe4\\\xba\xb7s\xfe[\x93\x15L\xf6ٗ\x14K\x1c6\xb4\x11D|\xf9~\xd0\x05\xdf\xe6^\xb6\xcbGnk:\x10*W#Ll\xe2V\x1bALw\xa6\x15\x18\x13\xc7\xd7x\xe2\xea\x97\xfa\xa8\x9e3\\;\x89BY\xf3+\"\x05N)\xffs\xf8\xbf;o\xdbGI\xc0\x0fB\x96\x8c\x9b\x15\x06\xf3\x9d\xcbX\ueaeć^\xd9\x02V\xb0.\xc4R\x17.\x90/\u0382{$\xf2\xe9\xd9\x04r\xe1hZ\x03*\xfaM>\xa8z\xf9_\x01?\x95G\xb6AQ\x10.\xd8\xf4\xac#\x89\xbd\xa7\xc2\x1a\xd0\xf0\xe2\xe6\xb8\xdb\xf8A+\xfc\xe2s\xe5L\xf7W\xf3\x19\xe8\xe2P:\xea8\x81\xf8\xc7L\x882\x9eC\xed\xc9~\x80p\v\x88\xa5\xc5\a\f\xbat.\xfc\xe4%q[\x19b9r\x87\xdf=\xf8So\xd7\xec\x1a\xe0\xa7vwkq\x1f\xa0\x19\xbc\x97\xa2\xd2<\x12\xea\xae\xfcQr\x8fi\xccE\xa6\xb8\r\xf7\x01\x9815k\xd0\x04\x91t\x1b\xfb\xc58}uz=\x80p\xa9/\"\xc5\xe6\xfd\x16r\x8b\x98X\xb5\xb5\t\xaa\xa8\xd9\x05|\xc6u$+\xae\xeb\xb7^\xc1n\xba\xc9\x11IG0W\v1L\xf0\xc3\xef#{DN\xfc\xa2\xf8K\xb5\xd6d\x16\xce\u0097p\xbe߁\xc9bl\xbfy\xff\xd0M\xd43\xd3r\xa6\xb0\x9d@(q\x1e\f$f\xc9\x18\xd7f,\x94T\f\x97V\x88Gc}P\xaeq\x82m?\x04i\x80\xb4{\xe3\xc7cJ\x12\xa9\x19\xaaUH]73\xd9\x1f\xf5\"\x1b\xd4%N\xde$\x8a\xe0\xa3\x00_\xe7O\r\xba>\xbf\x05\xf5\xed\x83?Ԙ\xdcG\x13'\x93V\xf8\xd4\x04\x02\xf5\xd2ma\xab\x14+W\xe2!\x82\xfb\xaa\xda\xc6x\xb4y\x9a\xefb\xde\xd0H'\xff\x02\xe7&\xa85^\xcbU\x86-\xfd\"[mpJ9\xb9\xa5\xac1v7\xd1m\x19\xcet\x9f@\x01F\xa6\xa2:\x1aoU~__\x1aR(\xcfm&\xde1Ϝ=\xc5wc\xb2\xeb\x02\xb3\x95CK\x19\x13ثcl\x81j\xf1J\x95\xc0k\x98\x04\xc5'\x92h\x91&G\x1b2\xc1y\x83\x06\xdf[\x93g\xa1 K\xd8nD\x80\x8bq}C%\xbb\x11t{w\x06\x9c\xa8Tnr\x15}\xf58+\xb4\xb2\xdc\xd3|EU~\xe7\xed\xee\xd2qhP@\xfddn\x14l2\x00\xc5N\x90\x8f\x87\xd5\xe0\f&3*k\v\xf1\x03\\\xc0EC\xbc\x1b\x14\xa2\x93{\xbc\xf9:\xdeL\x9dZ\xef\xa6P\x05\xf1^\xdetZ\xcb \x01\x11\xe2\xcfe\xba\xa4[\x88h`\xf5\x9e\xfb/\xa3\xcf\xc2\xcc>\xd5\xf5\xcf\xcd)\xc1Ή\xaaa\xf9\x12\xcf\xf7\xe9\x02\xadz\xf7ٿeSYݾ\xe3Bx\xd5\f5?\xd1\x1c\xfa%\x1bv\x02ö\x1a\xcb\xef\x19\x8aR\xd9b\xb5\xce{}\xefs\xc7g\xdb-\xb5\xa5\x17\xd3o\xe5\xe7\x9b~\xf5\xa1e\xe1\xa42\xc1\x023\xa1\xeb(?\x95\xaf\x7f\x9d\x96K\x18Չ\f\xd58\xcdI\xff7i.\x11\x87\xc0\xd9\u07bd\x96\xf3\xb5O\x1d\x8c\xc1\xbfx\r\xa3E\x91\xdd\"e'ց\xb6\x90\xa3\xa1S<\x13\x8ce\xb7>A\x11\xc9\xebY\xa9<\xb3\x91QN\x9b(Dw\x83)\x14~\xe4Xe\x93)\b\xb0 \x1b\x86\xec/\xa2\xb3\x90\xadBP\x99\xc9i\x02b?\x1bv\x03\xa9*@\xf4\x15\xaa\x10e\xec\x8e;\x064E+,3&5A\xd8\xcf&@\xf6\x0e\xd0j >\x80l\xa5\x00\x0ee\xfa\"*\xe8٤\xec\xd4(\xca\xf0\xa8\\\\\xbcO\xadU9\xab\xeb^Jq\xad\x03)5\x8f\xe8\xbd\x18\xb1\x7f\x01V\xf9\x14\x8a\xbf\x9d\x1f|\xddBŋ\x06\x1d\xd3TN\x89\t\xea\xe4\x14WZ\x96\xda\xf9\xe3A\xf2\x9f=bss.\xa4\x88\x01*9\xc8\xc935uS\xba\t\a\x88\x1cǾ\xa70\xa8\xd0\xf8\xcbv\x93\xeb`(n\xdfS\x97\xf0ոs[\x1d\xd15\x0f\xdbb\x11\xa1\xa6\xc9\xeeQ&x\xba5\x1a\xff6\xeaF\xc7\x0f\xb8s\xca=\xa6D/l6j\xb4\xa4\xc3\xc4.z\xfb]Bѥ\xfa\xce\xfa\xabR\xd4\xeeǎ\xb5\xbc\x89%]\xa3\xa9~_\xa3\xc8J\xf9]m\x02\xcd\xd9^ň\xd1\xff\xebDW$\xa0\xcd\xe7\xc3\xe5r\x9fv\xedJ\xf9\xf5\x8f\xfcFu\xf0/\xac\xef\xd0\xea\x13u=\xb2\xb3\x9aY\xc2q%\xebΊ\xa5օ\xf8\x96\x87\xc0\x8ar\xd9\x12ѸVY\x1a\xf1\b\xaf8\x1dw\x10\xb2l\xb8\xbc\x81)]\x1f\xd7\xcawo\x0e\xfc\xe3\xe1hw\xa0W\x17\xd3\x05_\x1f}\x12'vr\xbdo\x0f\xa0\xe7\xfa\x90\xc7\xee\x9c2\x92mIL\x0e\xd4Q\x00\xa6\xb4!\x94\x8a\xfcr)\xfautY\x9a\xb5\xb1q\xaf\xdb1$\x18\x7f\x7f=bP\x12HX\xd4\x04\xf0\xce\t\xd7?\xe3\xc67F\xc8I\xca\x15b\xa4}\xe1'@B\x9c\xfe\xf2\xf4\xc92\xeaV\xf8\xcd\xc8\x11\xa1\x9eB\xf9\xf8z\xf7\x96\x00\xe1;\xe1\xd7\xf2\x9f\x874\x7fO\xf97\xcb\xc1\xaa\xeeCtձ\xd7BB\xbc\x15\U000ab94c\xfeu\x89\xe3)\xb2w/\xdf\xcfe\xb7\xd5i\xffq\xb0&x\xeaƃ\xf0j\xda\xc2\x19\xf7\xeb\x98\xc8x\x19\xfa2f\xb1\x80\xd1LY\xde#\x97k\xbc\x1e'c\x11\xfb۪$+\xebҦs3\x1dw\x1e\xef\x9a\xef;\xc5\xcaD9\x11\xbb\xb3<J\x1d\xd0\x06\xe4\xf5GU\xcdwՐ\xd5\n\x94/*\xf8\x81\xe7\x9a\n\xf1\rL\xfd\xfasAk\xd9P\x81\xffU\xce\xe3\xda\xca\x0e\xb5\xc0T.j{\xdf\xc3Ι\xfc\xbc\xc3\xc3\xd9\xca\xd1\xc0a\x0f\x8c4\x1a\r\xa5n\x99\x9d\x7fC\xafWٚ\x1aņ\x83\x9d\xb9]\xb2\xf5\x91\x12\x03\x15\x9f\xb0N\xe8\xedu\xb6\x88\x84\x18\xb1\x87֑%\xe1<\xb8\x918\x1d{h\xf2\b\x04\x9d{f,\x8e\xc41\x8d5M\\\xb0n~\x1b\x87ppT\x00\xb8T\xff\xac\xed\xc7\xe6Tv\x06\xec\xae*\xa72\xcf\x10l\x12\xf3GM6\x14`\xf6\xaf\x95u\xb8%\x13n\xcb\xd83\x18͋3TQ㐥&v\x93\x9f\x8b\xee\xc6\x01\xfd\x9b,q\xbd(\xe8`\x8cK\xf9\x83t\xa7L(39;(\xd51\xc7c{\bh\vf\x1f\xa2'\x9c}x\x9b\xfe\xea\v\x03b\x98E\xc6\r\xc5:\xe0Nvv\x9ek\xe1\n\xd9\x01\xfc\x04\xd0\xc1/(\xa5\xf5\xfe^F\x7f\xa3\x97He\xb9A\xe4QE\xa81\x17\xfa\xb4\xc2_\x16N\xd3Ǳ\xad\x95\xb7r&V\xb5\xfaՒ\xef/#\x8fU\x9d\x99\t\x16ɩ\xf0o\xa5\xf9\x1aI7\x9dح\xd0|\x929\x99}\xc9\xc5\xe1nO\xc1\x96k\x92~p \xf55[\x06\xe8\x10]\xc3x\xb0-P\x98\xfb\x99\x9c`@\x05υ\xdcه\xcc;q42\xd8|\xb0\v`\x838l[\xd9\nl\xab\x9d\xae\xe8S\xd5\xda\x11?\xfbT\x8dvbe>\x9c\x99\xb1;\xf4#D\xdbQ\x86s\xe3Y>\xd1g\xe5-\x7f\fj\xa8\xaa\x19\xf2\x94]\x14r~\xfa\xa4\xa2I\xba(\xa6\xe3\uf79dn\xbc\f\xee\xce6ǳU\xbeC\xe8\xec\x11\xb0\xd5\xc4#\xcaر9\x16@\xf6375231\x00\x00375231\x00\x00375231\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\x00\x04\x00\x00\x00R98\x00\x02\x00\a\x00\x04\x00\x00\x000100\x00\x00\x00\x00\v\x00\x00\x00\x01\x00\x04\x00\x00\x00\x02\x02\x00\x00\x01\x00\x02\x00\x02\x00\x00\x00N\x00\x00\x00\x02\x00\x05\x00\x03\x00\x00\x00\xa6N\x00\x00\x03\x00\x02\x00\x02\x00\x00\x00W\x00\x00\x00\x04\x00\x05\x00\x03\x00\x00\x00\xbeN\x00\x00\x05\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00\x06\x00\x05\x00\x01\x00\x00\x00\xd6N\x00\x00\a\x00\x05\x00\x03\x00\x00\x00\xdeN\x00\x00\v\x00\x05\x00\x01\x00\x00\x00\xf6N\x00\x00\x1b\x00\a\x00\r\x00\x00\x00\xfeN\x00\x00\x1d\x00\x02\x00\v\x00\x00\x00\fO\x00\x00\x00\x00\x00\x00%\x00\x00\x00\x01\x00\x00\x00/\x00\x00\x00\x01\x00\x00\x00\x91\x01\x00\x00d\x00\x00\x00z\x00\x00\x00\x01\x00\x00\x00\x1a\x00\x00\x00\x01\x00\x00\x00\xc3\x0f\x00\x00d\x00\x00\x00\xa4\v\x00\x00d\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x002\x00\x00\x00\x01\x00\x00\x00\x16\x00\x00\x00\x01\x00\x00\x00\xbbi\x00\x00\xe8\x03\x00\x00ASCII\x00\x00\x00fused\x002020:04:05\x00\x00\xff\xe1\x0e\xcahttp://ns.adobe.com/xap/1.0/\x00<?xpacket begin='\ufeff' id='W5M0MpCehiHzreSzNTczkc9d'?>\n<x:xmpmeta xmlns:x='adobe:ns:meta/'>\n<rdf:RDF xmlns:rdf='http://www.w3.org/1999/02/22-rdf-syntax-ns#'>\n\n <rdf:Description xmlns:exif='http://ns.adobe.com/exif/1.0/'>\n  <exif:ImageWidth>4032</exif:ImageWidth>\n  <exif:ImageLength>3024</exif:ImageLength>\n  <exif:Make>Google</exif:Make>\n  <exif:Model>Pixel 3</exif:Model>\n  <exif:Orientation>Top-left</exif:Orientation>\n  <exif:XResolution>72</exif:XResolution>\n  <exif:YResolution>72</exif:YResolution>\n  <exif:ResolutionUnit>Inch</exif:ResolutionUnit>\n  <exif:Software>HDR+ 1.0.300173574zdh</exif:Software>\n  <exif:DateTime>2020:04:04 17:50:22</exif:DateTime>\n  <exif:YCbCrPositioning>Centered</exif:YCbCrPositioning>\n  <exif:ExposureTime>1/24 sec.</exif:ExposureTime>\n  <exif:FNumber>f/1.8</exif:FNumber>\n  <exif:ExposureProgram>Normal program</exif:ExposureProgram>\n  <exif:ISOSpeedRatings>\n   <rdf:Seq>\n    <rdf:li>259</rdf:li>\n   </rdf:Seq>\n  </exif:ISOSpeedRatings>\n  <exif:ExifVersion>Unknown Exif Version</exif:ExifVersion>\n  <exif:DateTimeOriginal>2020:04:04 17:50:22</exif:DateTimeOriginal>\n  <exif:DateTimeDigitized>2020:04:04 17:50:22</exif:DateTimeDigitized>\n  <exif:ComponentsConfiguration>\n   <rdf:Seq>\n    <rdf:li>Y Cb Cr -</rdf:li>\n   </rdf:Seq>\n  </exif:ComponentsConfiguration>\n  <exif:ShutterSpeedValue>4.58 EV (1/23 sec.)</exif:ShutterSpeedValue>\n  <exif:ApertureValue>1.70 EV (f/1.8)</exif:ApertureValue>\n  <exif:BrightnessValue>-0.09 EV (3.22 cd/m^2)</exif:BrightnessValue>\n  <exif:ExposureBiasValue>0.00 EV</exif:ExposureBiasValue>\n  <exif:MaxApertureValue>1.70 EV (f/1.8)</exif:MaxApertureValue>\n  <exif:SubjectDistance>0.1 m</exif:SubjectDistance>\n  <exif:MeteringMode>Center-weighted average</exif:MeteringMode>\n  <exif:Flash rdf:parseType='Resource'>\n  </exif:Flash>\n  <exif:FocalLength>4.4 mm</exif:FocalLength>\n  <exif:MakerNote>19150 bytes undefined data</exif:MakerNote>\n  <exif:SubsecTime>375231</exif:SubsecTime>\n  <exif:SubSecTimeOriginal>375231</exif:SubSecTimeOriginal>\n  <exif:SubSecTimeDigitized>375231</exif:SubSecTimeDigitized>\n  <exif:FlashPixVersion>FlashPix Version 1.0</exif:FlashPixVersion>\n  <exif:ColorSpace>sRGB</exif:ColorSpace>\n  <exif:PixelXDimension>4032</exif:PixelXDimension>\n  <exif:PixelYDimension>3024</exif:PixelYDimension>\n  <exif:SensingMethod>One-chip color area sensor</exif:SensingMethod>\n  <exif:SceneType>Directly photographed</exif:SceneType>\n  <exif:CustomRendered>Custom process</exif:CustomRendered>\n  <exif:ExposureMode>Auto exposure</exif:ExposureMode>\n  <exif:WhiteBalance>Auto white balance</exif:WhiteBalance>\n  <exif:DigitalZoomRatio> 0</exif:DigitalZoomRatio>\n  <exif:FocalLengthIn35mmFilm>27</exif:FocalLengthIn35mmFilm>\n  <exif:SceneCaptureType>Standard</exif:SceneCaptureType>\n  <exif:Contrast>Normal</exif:Contrast>\n  <exif:Saturation>Normal</exif:Saturation>\n  <exif:Sharpness>Normal</exif:Sharpness>\n  <exif:SubjectDistanceRange>Macro</exif:SubjectDistanceRange>\n  <exif:GPSVersionID>2.2.0.0</exif:GPSVersionID>\n  <exif:InteroperabilityIndex>N</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>37, 47, 4.01</exif:InteroperabilityVersion>\n  <exif:GPSLongitudeRef>W</exif:GPSLongitudeRef>\n  <exif:GPSLongitude>122, 26, 40.35</exif:GPSLongitude>\n  <exif:GPSAltitudeRef>Sea level</exif:GPSAltitudeRef>\n  <exif:GPSAltitude>29.80</exif:GPSAltitude>\n  <exif:GPSTimeStamp>00:50:22.00</exif:GPSTimeStamp>\n  <exif:GPSDOP>27.067</exif:GPSDOP>\n  <exif:GPSProcessingMethod>13 bytes undefined data</exif:GPSProcessingMethod>\n  <exif:GPSDateStamp>2020:04:05</exif:GPSDateStamp>\n  <exif:InteroperabilityIndex>R98</exif:InteroperabilityIndex>\n  <exif:InteroperabilityVersion>0100</exif:InteroperabilityVersion>\n </rdf:Description>\n\n</rdf:RDF>\n</x:xmpmeta>\n<?xpacket end='r'?>\n\xff\xdb\x00C\x00\b\x06\x06\a\x06\x05\b\a\a\a\t\t\b\n\f\x14\r\f\v\v\f\x19\x12\x13\x0f\x14\x1d\x1a\x1f\x1e\x1d\x1a\x1c\x1c $.' \",#\x1c\x1c(7),01444\x1f'9=82<.342\xff\xdb\x00C\x01\t\t\t\f\v\f\x18\r\r\x182!\x1c!22222222222222222222222222222222222222222222222222\xff\xc2\x00\x11\b\x01V\x01\xc8\x03\x01\x11\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00\x1b\x00\x00\x02\x03\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x03\x00\x01\x04\x05\x06\a\xff\xc4\x00\x18\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\xff\xda\x00\f\x03\x01\x00\x02\x10\x03\x10\x00\x00\x01\xe9\xf6\xe6e\xc1%\x14\xa2Y\x01\x81\x04P\x98:TR\xd9\x02\x16\bD\x17\v(\x11+\x97:\xc94\xb9jZ\x8a,\x85\x10\x8b\bI-aD\xaa,\xb2\x12un==^|վ*\x15\xa9\xed\xbd\x9e; \x04,\xa0C\x02\x14)i!Qj\xa2\xc2\x16QE\x82P\xa2E\x82gTM&UK@\xc5,\x89C\x04E\xa2ˁ\b\x8a6B\xca\f\x84*R\x96\x8fc\xe9\xf36\xc2*!A\x10\xa3*\xd9Q(d\x8ab\xc1\f\x11a\x00Qb\x8a\x8a\xa5\xcafU\x01r\x88\x12Ȁ\x94\xb0\x85K\t\x00\xa4\t\"U\x90\xa2\xc2SC(\xf5=\xb86\xa8\x11\x85\xa1\x8b\x00D\xa4$\xd2(\x02\x8a!d\x04\xb0!d(\xa0U$\x04\xa5X\x12\xc0 \x14\x81\x06-l\xedr铧>r\x91E\x82Qqd\xa3\f\xb4u\x9d\xbe\x9c\xd8B\x88Q\v(\x02\x14\te\x8b,Qc\x01\x17\x10e&\x00\x05\xb2\x14,\xa1k@@\xa8\x8c\x8a*XjVy}\n\xed\xcb\x1f^f\x04XuQ\x02\xaa\x86Q#\x06֭a\xca \fK\x16\x12\x82\x18X\xd1cH\xebΨ\xcc\xf0\xa0ũ%\x84QDZ\x06X\\\xb6T\xa0\xb2\x04\xa9L\x83\x06\xcaر\xd5\xcc\xf3\xf7\xd1߉u\xe7ɰ\xc6\v,\x84\t\b\xa0\x824X\xea \x83A\\PB\x86\x99\xb9u\xcbǯG\xd1\xe7\xdd\xd3.\x8cr䔍\xd6v\xae[5\x9a\\\x11%\xcd6\xbb\x9c\xf6YdR,(\xb5\xea\\p\xf3\xa6\xac$E\"\x8eg\x9f\xbfK\xbf\a\xeb8\xb50k$\x10C\bQ\x02(\xa5\xd9se\x02Pf`\xc36Y\xa3\x8fo/ǯ\x7f\xb7.\xff\x00LhNt\xb8\xec;6\x9b%`dA\x95\x15\x96o<\xdek\xc8f\xaa\x16\"Z\x85.\xa8E\x94Q\b\xb2\x17\x8d&k\x7f^Wc\x12[\xc9\xde\x04\x83\b\tcJZ\x8dz\x84,\x12\"\x85\x960\xea\x0e\x97\xcd\xf9=Z\xbar\xee\xfa8iκ\x069Q\x8e\xb8'M\xfdy:4I˅\xeas\xb8\xfa\v;ˮ5p\xda*m\x8e\xcd\xcff*\x85U\xa1\xe3e\x8dlɂ&\xb5k.\xb1\x1d0\xade\xa9\xa9\xae&\xf3\x95\ta\x11\x85\x16Z\x9a2\x80\x10H\x01\x03Oe\xbct\xb1\xbf1ñtϪ\xb8\xa5\x87\x8bθ\x16l\xd6~\x8f\x01/\x0f\x97^>\xb1\xd3\xdex<=\x01\xaeuDi\xe7\xb0\xde\x06\xcd\x1cz\xe3\xd6p\xfax\xadTH\xd15\xb7\x96\xf4\xe2\xe9\xa5\xcaE\xeb:.G\xa6s\xdc\xd9S|\xed\xe5\x16ZB\x10i\x01SH-M \x90N\x96\xa7\xb3\xb9\xdbϤ\x12HI\xb2\xbc\x8c\xbeSQ\x96}J9\xb8ה\xf2zs\xeaj\xeb\xcd\xeb\x9f7jsu\x02[\x1b\xa8\xacޥ\x9c\x1d\xe36\xe5\x16\x04\xbdN{>z Ԑ\xacf\xb2\xdb/R\xce*\xbdkXɬ\xd8bƔ\x12\xd1E\x80\b\xb1Ǭ\xae̚e\x86<\xdf1cu=t\xbey|\x9as\xac\xfa\xb8r\xf9.=P\xa5\xbc&\xc3ư\xeb8\xf3\xadyэ\xde\v\x1b\xeb\xf6㋟N\x1e\xb2\x91U\xd0Ν\x8do\xd4u\xce<\xe9Y\xad\x19\xac\xb2\xc8,T\xd7?y1\xd6f\xde@\xa8e\ta\x90\x01`\x16Q\xf4j|h\x88q1\xaf\x13gS\xa6}\xeeo\x1d\xaf\x03&z\xfaf\xb3\xba^n5\xe3\xb8{+\\[\xbeg\x9a\x8e{\xbdg=;:\xe9\x19\x13W\\h\xcdNu\xcd\xde5ܢ9mnNuz\xadNF4\xbc\xd6U%\vS\x95;\xc2\xf7\x96\xca\xd5Ϭ\tc\x80\x1bK\x04\x01A\x9b+\xe83\\V{\xd1K\xe5\xf9\xeb\xca\xc9\xd7\xeb\x9fY\x8dy\xfd<\xe5\x94u.z7%˶N=\x1f\x9b\x9a\x85uY\xcc\x15\xac\x98\xf3D\xa0tu\x9cY\xd3z\xf2Fu̸\xe35\xa4\xab7/\xa3\xb9\xf22\xa1s\xa0ӆ\x9a%\u05ec\xadE:9\xd9ܔf\xdeT\rY`D.\xdd\x11\xa6\x93rjh<\xbay\xe9=N\xa4\xf3\xf6g\xa7\x87\x98\xa7\x1d\r`3\xad8\xdfC\x9fL\xb6Itr\xdd\xd72\xc5\xd8ã(u\xe71\xac\x19\xd6\xc9S\xbc?\xa6<\xf6q\xccޤ\xbd\x11'j͝1\xc1\xe5ն\x1a\x10\x9alh\xce\xd0'\xa64\xc3\xf8\xf5\x0e{\x0e\xbcÿ!\x16\x10\xc4\x05\x03iv.\xac\xebY\xc7\xf3\xf5\xe6Y\xeb.x<\xf5\xec{s\xe2\xda\xeb5\\><\xff\x00/GG\xcdו\xd3;l˝H\x86]d\x97Y\xb0\xb9e\x9c\xa9\xad\x9b\xc7/%k\x1cݤ\xb1:\x8bͳ\xd1\xef6s\xb1\xba\xb9E1\x0e\u0097L\xa1Ϫ5\x9a\xe5ӟ\xd3=k\x94u\xe6D(X#MP\xbd\x1e\x83^\x9e\xe5\xbcz\xf9~]\x1fӀ\xb5\xec\xf7\x9eUs\xf55\xdc퓋\xcfy<ޤY\xac\x01\x90\xaaV\xb3c\x10\x94,)q\xe7z\xb7\xcf2h\x976\xa7/\x1a\xcbӎ\xce}YמU\xf5\x9d3\xcdΰʽe\xb1\xacl3\x1b\\\xae\x9a\xe2Qo\x1d\x0e\x98\xc4\x10\xb2\x06Q\xaa\x06\xa8]>\xcfuy\x965\xf3\x8c\xf4מz\xa7n\x9e\xb1\xcc\xe7\xbc\x12\x04;y\xe9t\xe6\xae\x1e\xbei\xd3A\xb3^\xf3\x8aTEjh\x83\x95\x99\xd6\xdb9y\xd5\xf4\xe66j\x9a\xa9y\x18\xe5\x92\xe0:S\xcf^\x8d\xb9\xec#\x12\xd8\x02\x80\xb2\xa9ѳ\x9fFY\xd6Gz8q3\xa0\x1bH\x1e,ߛ\xba\xb9\xc6k\n\xbd\x97N'\x1e\x0f\x8fm\x18\x9a'W\xef\x9f'\x9645\xd1\xcf[\xd6Q\xbez\xf9\xfa9\xd9\xd7GY皺c4r\xb5\x9a\xb3Vu\xd9\xce\xf5f\x84\xbcz_NX\xcd\x18\xd8\x19\x9c\x89\x8a-ެZ9\xa1\xd1\xd6iW\xc3\xebP\xe4й1\xa2F\xf5\xe5\xe4\xed\xa2\x06\x18'\xa1\xf1z\x15\xa8\x9fG\x1ef\xe1V\x8d\xe0\x04\xf1\xd1\xe4\xdc\xf5\x9d9\x0f<\xb7;d\xd1ي\xe7\\\xe9\x8eo\xa4\x89\xb3\x15\xce]\xc4\\\x99P)\xa3\x9f]S|ޜѼ\xb9@\xe8\xe3N\xcc\xe8XZ\xa6\x9a\xad\xe4o\x1d1\x98\xe86f\\\x99\xb9\xb5\x95˞3*\xecѾlQ,\xa2\x87\x83\x19y\xf4ż\xf78\xf4gn\x19\xb5{\xb2\xb10Ȉ\xce\xd9\xdc\x1f8\x84\u05cd\xa91\xef74\xab\xa0\xb9\xaa\x88$M\xf3F\xd33uM견\xb5-\xe7=\x81aK\xd32\xada\x8e\xcc\xf3Y\xac\xe7Z\x9b\x04\xa8\x1b\x1f\x9dT\xb5[5\x87j\x19\f\xc1\x96C^j\xb9uNl\xde:\xbb\xc6\x1d\x12K\x19\x9c\xec\xe7\xbeV\xda,n5\xa7\x1aϩ56g\x0eTK\xaam\xbd2\xac\xdaα؍M\x99\xd7C\x1aN\xf2\xfc\xdc\xf6p\xfa\xf3E\x9dl\xeb%\x98\xa6[ͧ\x9e\xf3\xf4\xd5\xcc/[V\xa6mB\x00Ҏ\xb1\x18\xe8\xb9GY\u07fcJ\x83%\xc9aˤ\x13Vo:\xc7r\xeb,\xe8\xe6\xf3;\xf2\xb2͙\xce\xfcTn\xbbP\x95\xf0:\x8d\x1f\x17*9\xf4\xb93ي\xe3:fd\nU\xb3\xb3\x1d7r\xf4#y\xe3z8\xaa=\x967f\x19s\xe7:|\xfe\x84\xf5\xf3\xf3;V\xe78\xb7J\x1f\x8e\x98z\xf2|\xc1\xd38z\xf0v\xe4\xfdgN\xf3H\xd5\\\xb4(igNU\x8e\xe7\xbcY\xdfK\\\xf7\xef:\xa9\xb2\xe2\xb1i\xbcU%r\xa2q\x1d7\x83<qk\xa4\xc6m\x81ֶ\xe7m\xceJU\x8c\xd7@\\\xcd3\x11\xb9ߜ\xf6yk44r\xabY\xeby\xbbk\xe5\xd5[\xf1\xa2\xd6J\xa9\xe8\xb9\xcfF6x\x15\xce\x1fO<\xae\x99\xba\xe7^\xf0\xfb K\x9aW*\xd2\x00zx\xe9\x01\x9b\xc0\xe5\xd8\xfarӨ\x1a\x80\x06c\xb9\x11\x9e\xbc\ue715\xd3F1\x9d\x19\xa8\xe5\x8ewn\x853\xd8\xe7\xd1\xfc\xbb\xa6\xb3\xf4\xf0&\xeaLhϩ<\xfdV;^a\x93\x8d\xea\xe3\xd4\xf3\xef\x83\xeb\xc3eE\xcfC\x8b\xa7\xe7\xea\xe9-\x15\xba\x9d\x17\xba\xbdD\xf4\xc2,\xa5>}\f\xd5ә\x04\x11\x9b:\xb0ԀK\xa7\x19\x8cy\xa2j\xe7\xbdZ\x87Z\xb1\x9e\x97=\xf3\xb72\xf4\xc16\xd3=\x9a2.\\\xf3\xcdCK\xba\xb8z\xe6\xb2\xde\xfe\x0e7\\\xf6|Ү\xb9\xdd;\xae\xe4\xd9\xe8y\xf0\xbdUi\xcc\xee\xcd\xd3\x14j縹\xbar\x1a1\xc8*C\aB3\xb3\xc6\xf7k+\xde!\a\x99\xb3\xb8\n\x18\x05\x95E\t\\\xd0R\xf6\xfc\xfe\x8c\x9e\x9f>\xecc\xa7\xc3Ԫ\xcdׇ;Zu\x94\x8c\x86s\xe6\xae|\xf5g\xa2:t\xe6t\xe8\x1a˳\x9d<p6\xabz\xc1\xda2\\\xbb\xc0\xa2\xb5!\v,\xe9\xf3\xeb\x93XϬ\x941tA\x10\xb5tu\xfc\xfe\x85k!߃W\x15\t!sP\x14%1H5!\xabρ:<z\x8fn[\xf8s|\xf4c\xac\xbb\xc3:3\xdc\xe7\xb1i\xda\xf3챕\xdda\xea\xabl\xc1טY\bB\x10\xb2\x17\x10\xb5\x85\x84\x1c\xb0d\xa4\x10cE\xe7]^{\xdc7\xaf3\xd6|\xe1\x023\xcbk`\x86X\xb0\xc1\x11\x19\xc6\xe6\xeb\x87L;ϰ\xba\xcd\xd78;⪁EYU\bB\x10\xb2\xc9\x16E\xa4%9l9i-lvt\xc9l;\x9d\x86\xcb4\xcdhΦ\xb3\x92\xb9\xd1Z\xcalY\x03\x135\n!\x06\b-\x18\xab2KC\x13@9\xb9\xe85\x9c\xfb\xc4!\bB\xe2\xd6\xc8Qe\x85)\xc0\xd5Ŭ\b\xb1\xe3͒\xeb\x86ӡfk3\xd6\x11\x12\x80c\tZ\x11vXB\xe6\xa88U0Q\x06\x10\x88\x8co6\xa3a\xf4\b\xd9p\xef\x9a,\xb5\x84,9a\bXR\xb2\x04\xb2\xa9\xd6k\x87V\xa8ٝ\xe7\xde1ٜ\xa9\xa4F`\xa52\x06\x01E\xd9cU\xe5!\x8a\xb1\x82f\xa0\x02\xd1\xcbe\x80)4\xa8\xe3y\x90l}\x8a\xb1\x16-\rmi\x049`\xe9n+Q\xfa\x9aS`\xeb\x18$\xcf\bT˞\x02iV\x18!\r\x94J, \xcb\x0e\x03R\xa9\x90d\x04\x81&f\x85\rH\xb2Ŕ\x84\xae\x97F6\xeez\xc5\xd3\x1aӑ\xbc\x89\b*\xc6٦͆\xb3F\xa3\xecI\x935\x12\xe51\xca\x12\xd1\v\x19\x16\xa4\x18\xab\nZ\xb0\xe5\x02\x82\x04\xa2\xcd \xd8e\x90X\xc1C\x04-\x94\x19D\f\x10\x13nv\x19\xd7w\xcf\xd7\x1e\xf3\x8b\xb7&\xea34\xf5\x9dr\xbf\xa6\x13\xac\xe62FysK\x9eR\x96\x01d\x18YE\x06Pp\xaal\xa9\xb2\f\x96\x81,\xa2\xcd\x12\xaa±\xe2\xc6\x02\tA\f\x10\xa0\x10\xe9B\xc0\x18\b\xd9t\x1d\x18\u05cdf\x15۞A9\xa92\xd82\x85\x82:ZA\xa3\x86\xad$\xa0(\xb2\xe5\x1b\nP\xb2\x12X\x19@\x10 ,\xb9X\x18A\f\xb1%\x86PBƙU\x82\xc2\x1d*ll\xa9\xb1\x8a\xa4`\x98\x02\x14X\xc5R0\xa2X\xd9F\xca$\xac,PEʛ\x06Ö\x8b\x15e\x0e\x96\x10 @F(\x844m\x1cU\x82\x00B\xa8\xa1\xa2\xe92\x91e\x1ae\xcfe\x92*\xaeT$-b:U\xd9E\x82\x18\xc9Wd\nQ*\xc0,\x04\x8b\b\x8e\x9aU\x90\x1b\x0eP\xb1\x92\xc1\xd2\xe7\xb2\xc1F-\xd3\vG(\xa5\x80Y\x00,r\xe4,X\xf9H\x02\x15a\xcb`˖\xe4\xd4\xe1v\t(\xa2\x83-`\t\x06ʻ\x00|\xa8\xb2\x16Qa\x14\bD(d\xac\x95ZȖ0\v\x1c\xabJ\x18\x10A\x06\xa9K\x04Z\xd8r\xd1e\x00\x8cVJ\x8b\x04\x00\xa0i\xb9\xa3e\x12\xae(\xb162Yce\xcfe\x16B\x10\xb2\x8d\x19\xd0ث\x04\"\xca\x0e[*\xc1\xad\x10\x9b\b0\xc8\x00A\x14\x1a\xe7C\x02i\x84\x04\x84 \xc5Z\b%\x88K-Y\v\xb2\xc2Y\x16.\xca,\xb2\x16P\x14\xd9J\x13a\x10\x80\x96\x05\x97+\xa5\xab!\tVZY\xa6U\xd9\x05\x8c4ʊ\x14\x8aq\x9dh\x81\x10|\xa2.Õ\x17&\xab(\x12$,\xb0\xe5M\x962Z\xaa\x8b\x06\xc3[\x82\x11a\x02\x1c\xab\xb0\x8b\x04\v\x19-\x80\x1865Y\n\xb2\x86\x10j\xd1H\xe9D\x94%\x8b\x94\v\x0e\x06\x8a*\x960\x02\x12(U\x96\xb0\x14b\xb2ZA\x02\u0095\xb2\xe7\xd6h\xb1҅\x8a\x19(Xe\xca\x16\x11p\x15d,\x1b\t]\x12\x81(\xa0\x87\x16\xb6A\x91@U\b\x96\x8d2\xaa˔l]\x87,\x16Dl\xa0.\x8e,\x84\xa0J\x0eU\xd8e\xa9Blt\xb6QE%P\x946T\xd8\xfcв\fYJC\r\x06\x99(\xd9\b9D8YT\xc8!V\x7f\xff\xc4\x00+\x10\x00\x02\x02\x01\x03\x04\x03\x00\x03\x00\x02\x03\x01\x00\x00\x00\x01\x02\x00\x03\x11\x04\x12!\x10\x13\"1\x05\x14A #23B\x15$04\xff\xda\x00\b\x01\x01\x00\x01\x05\x02\xfe\x1f\xad\x9e\x86bg\xa1\x84\xcf\xd8Vbc\x1f\xc7\xf3\xf0\xc3\xd0\xc2#$+6\xcc\x7f\xf0\xc7Lu\xc4\xc7\xf0\xc4\xe4Ad\xefWZ\x9dd:\xa6\x9d\xf70t\xfc\xcc\xcc\xfd-\x83\xef\xaex\xe9\x9cO\xd8b\xf3\x18p\x16\x18:\x1e\x9e\xe0\x9f\xb1\x84\xc4\"bbbbbm\x98\x98\x98\x98\xe9\x8f\xe1\x8f\xe5\x816\xf5<@x\xcc\xf51?p'\x10\x99\xc9$\xcc\xf1\x88\xc6~\xf1\f\x18\x10\xcc\xc3\xd0\xcf\xcfbz\x821\xe7\x19\x06\x11\x98GLLLu\x1f\xfcq\xd0\xff\x00\fLM\xbd\x06#4\x06\x06\x06p`\x1d\tʎO\xb1\x89\xb8a\xb1?\xd4\xf5\xd0\xc0Оg\xe6\xdf\xe1\x91\xd0\xc3\xd7\x1f\xc7\x1dq1>\x88\xdbv\x99k\xa6bc\xa6&:b\x016\xc11\x16\x06\xdd3\xd3#\x13t\xeeB\xfco\x06n\xc4\xdd\x19\xb8\x1e\x89\x80\xed\x05\xa0a\x82\xf0s1\f$\xc0g\xe7\xef\x04\xed\xc9\x1cN:\xe2s=\xc311\x00\x85f\xc3;o)\xa7s\xb2ة\xb5\x8dx\xe9\xfb\xb7\xa6&\u07b8\x98\x9bf\x00\nx\x19\x00\xb9\x9c\xe4\x9c\xcc\x1cg\xcbp\xce\xee}\xca\u0097\xbbb\x9c\xe2\x03\x89\xb8\x18\xdd13\xb6o\x9b\xb9\xccɜ\xce\x7f\x87\xa3\xd3\x13\x02`L\t\xe30:\t\x99\x98\x9c\xa3\xe9\x99eU\x8a^\xfd8\xc4\x02`t\x02bc\xa6a\xe6z\x9cş\x8aa\".\xd8\xcd\xce[8\xcc>\xc3b[k\xa9:\xab\x9e\xcd:\xd8\xe1t\x9a\x83?\xf1\xf7\x99f\x9e\xcaf֛Zi\xe9KO\xd0\xd3\t\xf5\xb4\xa2[^\x9c\x05É@\xccy\x86\x9b^v\xac3\xeb\xd9>\xabϬ\xd3\xeaϫ/ю\xc8\\L\t\x8e\x99\xe9a\xc5\x06\xc6.\x06 2ʦ`\x9e\xff\x00\x8e \x10\xe6~\x1c\xe4e\x8f\xae\x87\"d\xe0\xb7L\x89\xa3\xc1\xbb\xe4W\xbbY{\f\xf8\xdd\xf50\xd5\u0ba0\xbc\xbfOu\xc7\xff\x00\x1fq\x83㬕\xfc~\xd9\xd8A\x06\x9e\xb3>\xb5s\xea\xd5\r\x14\b~\xaaƿL!\xd4ӷ\xee$\xfb&w\xdc\xc3u\x93\xb9i\x84\xd8G\x94]C\xe5\x8e\xe7\xfe-\xccJ\xdaˊ\xb4\x1d\x0e\x9d.\x96VԴ\xe4\xcf]D\xdd3\xd1q\x8c\x9c\x1e`\xc8\a\xcene\xe9\xcc\xfc\xa9J\xa1\x16v\x8b\x99U\x8e\xf6\n\xac\xc5OٟpF\xf9-\xa4\xfc\x9bN\xe6\xa3T\xe3\xe3I\v\xf1\x80FҺ\xa9O+iEQ\x89\xe1\v\xa8\x82\xd9\xde-2Һ\xda\u05fa\x81Y\x15\xbb\xcd\xe2o\x82\xc1;\x91\xd8Dl\xf4\x03\x96^i\x1d\xb0\xbc\xc7H:e.[\xf4\xedI\xfc\x1dD\xfd tc\x88\xac1\xbb\x13vb\x9e3\v\x9c\xef\x8aw\xcaPm\xd81\xa9\xf8\xa6kt\x9f\x1c\xd4\xd911>c5\xb7q\x8e\x9f\xe3\x9f:\x91\xe9\xdcV\x97j\x1a\xe4\xee\xdd\xdc{\xaaej\xac'j'LY\x11*\x12\xc0\x82Wf\xc8\xf75zju\r\xbaҦ\xe9\x8e}JX\a\u008b\x01\x89ù\xf2\xdd\xe2\xa6)\x8e\x9d\t\xe1o\U000728b0\x19\xee\b}\xcc\xe6~\xe2z\x87\x88A\x11G\x04pA\xdd\xfb\xa2\xab~\xa6\xa4\xc1\xe9g\x00o\x8e\x1c,\xf9\xb5\xcdi\xff\x00\x06\x81\xb1\xaaO\xf3\xae[\x1a\xb3oj\xa1\xe7\x1e\xb5\xa1(\xd2\xd8\xebvh+\xadn\xdb\xeb,\xdd\xdf{\x00\xb1\f\t\x86\xf37%^\x17\xae\xdb&&\xde%$\x11\xbdg}D>C\x99\x83\x02\x99Ȋ75ˁ\xceQʇ\xa7\xc4\x1cL\xf3\xc67N1\xc4\xc4\xf6N!l\x9e!\xf4\xa6p\x0fƯ\xf4\xd2ۇMKl\xa4|\xa5\xed+\xd5\xddm\xb3\xe5k/E5\xe6\xbd?\x86\xa6\x9ek\xb1\x03\xa6\xa3Mmr\xa5\x1d\xeb[\x1a\x83k\xb1Ԣ\xbe\xa3g%\x94\x14Z\xd9V\xbc=\x87\x16\x85sj\xd9\xff\x00\xb1\xa9\xa8Z\xb5Tl>\x8eI\x89\xa7%k\xed\x9b\x06\x96\xa84\xd5\x15`j|\xed9\x06\x06\x02n\x81\xb1\r\xa2:b\x1e\nZ\xcaY;\xa3\x81\x01\x19c\x83\x91\f\x06q\x0f0\x98pg\xe90\x12Ɗ\xf6i\x90m=>I\xf6\xe9\xe9|\r\x17\x96\xae|\x87\xfc\x159\x8f\xe1~\x92\xc0\xd5oY\xacl\xd5s\xbfq,m\xea\xc1\xe1`\x82\xb3ݎ\x11#5l\xdbM\x83\xebڊ,J\xd9<Ҷ\xc9`\xab\x16\x80-J\xd2\xcb-\xd4v\xa7\xd8\xc4k\xec\xecӯ\xb48۩\xa4\xa1\f\x1an\xcc\xdcf\xf3;\xa4\x1f\xb0\x18\x97\xdd3\x15ʖ\xf3\x85f`\x19\x80px\x9b\xf1=\x1eL\xe2~\xe6i\x13~\xab\xb8\x04\x1a\xf5\xfb\x02\xc5`mA>Z\xcc\xd5_\xfa\xd38\xafQ\xf7C\x9df\xbf}zd\xde55\xec}=̚Gv\x81\xb3\x17\x87j\xd2\xca\xef{{\x95\xd8\x11\x89\xad\xe5\x8bV\xe1e\n\xa9\xa9\nT=\x90\xd3\xfd\xb7\xd2\xea\xd4[]\x8a\xf5>\xee\xc1\xb7Nl\xc9Օw9\x94\xdak7T\x11\xb4\x9a\x9d\x86\xd4\x17\xd6\xf6܌nxli\xb8\x98+c\r.%U<\xec\x10\x19p\x11\x88=\xc1b\xfdV\x15\xa5\x15\x93m}\xa7ș0\x81\x95\xff\x00F\xb6\x83\x10\x9e(=\xb9\xf6\x1e\x11\x03\xb8\x1b\xdcϕl-O\xcd\x15\x8ci\xef].\xa2\xddڽ-\x15\xdc\xcdv\x96\xf4\xafO\xf1\xc6\xdd.\xbbH\xdaGЯ\x86\xa0p\xcfj;f\x82-\xa6\xe0\xd4\xd4\xd2\xed\x18\xdc4\x80\x16\xd2;C\xa9ZҬ\xf6\xdc瘝\xd8ڭF\xa2UcG\xc7ٳ\x9b\x18\xf9\x06\x19\xaa\xc0c\xa7m\xb4\x9a\x99\xab\xd3\xf7\xeb\xecֵ\xf8\xac\x16\x917\x18ۢ \x9d\xaaBG\\Jk\xdcN\xb3\xfb\xad\xf9%\xb5\xad\xd4\xf7a\xe2\x05\x9c\x92\x1b\x11\x9b\xc7vTyC\xe2\x85\xc6I$\xcd\x12-\x8d\xf2\x1b\xac\xb2\x9a\x8fr\xee\xd7\xd7u;~.\xb2\x95v\xfb\x1f!\xa8\xf2O\x8e\xff\x00\xf0\xfc\x86\x9c^\xb67xW\xa9J\xeaKm\xb1\xce\n\xfd}\xd0QrF\xfb0\xb6\xa2l\xb4\xc1Zg\xf0\x98\xe1^[E\rmKUi\xdd%ޓ\x9ca\xb6\x91?́\x85\xb5ٔ\x9a-Wuu\x9aQj\xede\xa9\xb9\\\x99\x9c\xc0 \x06v\xadi\x92\xa5\x98,/\xa7+\x8a\x99\x8d\r]@\xc0g\xb3\xbf\xa6b\x86-g/\xc4\x1bf\xc9\xf1\x89\x85\xd5\xe9t\xfb_\xb5Qk3\xa4\xd2V\xae4\xfe\xfeB\xbf\xedu\x9f\x1f\xe35\x984YB\xe2\xf5Լ\xae\x86W9\x15\x86\xe31\xac\x8bn \xb4\x98\t\x9b\x8c\xbb.\x1a\xa3\a\xff\x00\xa9\a\xf4\nU.\x15`[Gr\x9f\xae\xdb;x\x95\x8a\xd6X;\x8bS\x95jn\x16&\xbbK\xe3\xe7Z\xe6\x01\x02\x13\x16\xb2'\x98\x99\xab9\xa1\xaazb\xe8\x9c\xceA\x1e3-\xb8\xa3M\xb8\"\x06\x18\xa2pa\x9b\xa2\xb4Ц\xdd>\xb6\x9e\ue647,\xec(\xd3\x1d\xba{uV\xe9\xe5ڽS\xce\xfd\xa4\x9b\x0ek\xdeYN\x16\xff\x00j\b\xb8!\xc5\x03&\xc1\f1mX\xaf\xce#e\x14\xb7\x8a\x01\xf6n\xb3\x15R\xf0\xb8(_\xfb.\xdb\xdd3\x02V\xfbl\xb5t\xee\xaa\xe2\xa9v\xa1\x9e\xb3{\xac\xfbO\x0e\xa2\xc3;\x8ef矪DB\xf9\xd9t\xa6\xab\xed_\xa9t\xeerq\x9c\x90\x91@\x84\xcd:o\xab\xeb邺*L\xc0f\x9bX\xabA\xf9\x14\x96\x1f\xec\x7f\xf8\xe9?\xfa\x97\x9e\xed;\x04\xac\b\x89\xb6\x7f_r\xd6d\xb5\x80\xb4={nݵW8Wȶ\xf5\x11\xad,T\xc4$\x94Ɍ\xa0ǲ\xb1\x11\xf0ͨ\xae\xe9\xc9Cw\x8f\xd9\xfe\xc2CY\x82f0\xa8\xa2\x1c\xce`Fht\xb7\xb3\x0f\x8f\xbc\xc1\xf1\xaf\a\xc7$\x1a\x1a\x04\x1aZ\x16\x05Q\x01\x9a\xa7M\x9a[k\xa6\xbf\xbdP\x19\x18 \xc0p\x138\xf03'v\x8e\xb4&\xe7\xa9]\xad\xa7a\\\x11\xcc,@\"熛\x14\xb7\xfcU\xff\x00\xf8ؒ\x96\xa6٧l@\xa0\xc0<n\xb8\x11Qޗ{\x05{mf\x9e5\x9aX\xd7Q;\xd5\xc1q\xc0\xbe\xf6\x86\xdb%\a/\xa9r\xb0\xb6\xf9\xba__\x95u\x9d\xcbS-\xbf_-V\x93d\x1a\x05\x83G@#KH\x8e\xa4\xcd&\xe5\xac\xce\xea\xacm]\x02\x1f\x90\xa1a\xf9D\x87\xe5\x1e\x1f\x90\xbc\xc6\xd4\xde\xd1w9\xc6\"\xe0\xaeF\xd0N=\xcc\xed%\xd0ʐ;\x1b]!b\xcdO\x95\x16Y\x90\xa4ļmmMrˑ\xa1\xe2\xa5 \x16\x7f\x13\xfd\xa3g\x92\xee\xc3s\x0e2\x03F\x16GR Y\xb22\x816\xee\x8d[\xbdt\xd6@\xfa6\x184\xc1`\xa2\xbb\x10\xe8\xe8I\xa6\xaa\xb6a\xed\xbd\xc5\xc6\xe6\xd4Ы\xf7\xf4\xeb\x1b]^Oȹ/\xa8\xb9cjn\xdalv\x87\x9f\xe1\x8e:W\xc4\xff\x00Qx\x8eF:n\x87&S\xe3Jv\xb3e\xd4\x03^\xa1\x99\xa9\xabr\xa9\x85\x81 D\xff\x00N\x81识ߐ\xb6d\xadd\x86|@\xd64]3\xd8\x17Fv٦\xc1\x1aT3\xe9S;)\xb8\x9d\xb1\xb7\x99\xe5+\xdd\x12\xc0\xa4\xdd^i\xbds\xa9\xd7*\xdb\xf7ڶ\xfb\xb7Nŗ%\xda\x1bEt\x90@\xaf\x85\xadH\xb3P\xec\x1d2\x14\x17\x8f\u0089\x88eUo\x03D\xf1\xab\xda\xcd\xc4\x1e\xd78\x138\x81F\t\x82`a\xa1\xf1\xab\x04\x9f\xac\x18\xb6\x9dk\x95۶\x8f\xdc\x18+\xb0ʴ\xd6\xe5t\xee\x0f\xfe<\x86\xfa\x95\x84Jh\xad\xb7*\xcd\xd1M\x86m\xb6-l\xb2\xe46\x05\xc2-\x97V'\xdeHu\xa4\xc6\xd5\xdagr\xe36\xda`8$b\xca8:\xe5̸et\xe1Zچ\xc5̶\xca\xc2\xd0\x05\xb2ـ\xd5o\x15\xb9\x1b\x19\xff\x00Ҏ\x02\xf0ŷ\"ZѪ+\x11\x04c\x93P\x19*s\xec\xcfA\xcc\xf7\x13\x13<ۧ\xb1\x8fҷ)\xa2\xb1\r\xf5\r\xda}-{E5,\x01f#\x8b\v\n.\xcfm\xb1\x84\x13\xeci\x84:ڄ\x1a\xfe~Ղ\x1du\xe6\x1dE\xaf*\xb4\x82Y\x96\x00ָѨ\xbb\xb5R\xcdԬ\xef\xf1\xdcnڊ\xc0\xc9\xdc\x0e&\xabkQ\xdd̯\xc1\x9a\xeb\v\x81\xc3\n\xdahq\xb7\xb8\r\xa1\xff\x00\xb0ҥ\x8a\xf8\n\x84Z\xd3*\xab;lKXR=\xc0\xc3he\x8a0\x10\x99ʙ\xee\x16\xe2\x0e'\xb3\xa3\xb4\xbdq\x88\v{VeZڔ\x9d}\x13\xff\x00$\xa2\x1f\x93\xb8\xca\xf5\xb7\xd8\xf6[w~\xdb\x19W9=ĞR\xb3\xe4\xfe\f\xe7s\x87f\x99u\xb0\x9f\xe8\xee\x01\x14\xedk|-\x0f\xbag$\x1d\xeb\xce6\x933\xe1s\x7f\xebW\xfe\x14/n\x05{zQ[8\xfa\x99\x82\xad\x93uBw\xa8\x9fj\xb1>\xe3ϵkM\xe5\xa1\x10\x9c\xc1\xc0\x1e\xe6\xe8\x1b\x9epx\x99\xc9\x02g0\x18\xae\xd51\xd4\xdcc1hzU\xe4\x02N\xd3JkŕR\xcfv\xa2\x9b\xbb\xf5\xd6\xe6\xc0\xe4\n\xabK\x0e\xeeۖ\xddXE\x95\xae\xd2\xd5+\xd8\xe7}8Ü\x04\xb4\x06+n\xdb+\xff\x00\x90\x02\x96\xfd\x9a\xe1լ[͍\xdbޝ\xb4\xaewiUsF\xd5m\xb0\x98\xb7X\x03]f71\xe8 \xcc\xcc\x1d\x16\xa6\xb1ڲ\xb6\x11\x12\x91\xb4\x8cDY\x8eB\xf8x\x81\xf8}\x03\xb5}\xc28\a\x04\xe36\xa8\x1d\x11\xb6\xbb\x9f\xeb\a'O\xb4Yg\x0fM\x9c\xef\aPA0\x05\x11\x8eT\x03\xdb\xd3y\x92%\x00Gn\xc5'T\xb3\xed1\x8bc1\xfe\xb1;Ԥ}b\x18\x86\x8cw\xa9X֒\xdd\xc7\xc7\xf0\x1c\x10(an\xdd\xd0\b\x16\f\t\x89\xb7\x80\xb2\x8b\rW]\xa68\xabGe\xb1\x81\xa6\xe7Qec\x98D\xe4+d\xcc\xe2m\xcc\xc6'\x18=\x06fܧJ\xec]\xb3O̳U>\xce\xd9_\x81mbC\xacxu\x16\x19\xbaƔY\xb0\x9bi\x13\xeeT\xb2\xddJ\xd9\x05ԅ\xfbdB\xc4\xff\x00\xf1\xc4\xc4\xc4\xc4\xc4\xc4\x18\xe8\x04\xc7\\\x8d\xbbKJ\xfe\xe0Z\x8e\xa5\x8f\xd1\x1b\xae\xae\xbd5\n\xd30n\xc6\x0e30'\xef\x19\xe5\x95H\x99\xdc\x06c\x8eeg\x93\x11\xb6\xc3\xcc\xf0\x11ޭ\xbf\xd0&\xea\xe7t\xc3k\x9f\xfeX\x98\x98鉌LM\xb3\x10\b\"(3\xb6\xa0틦\xb5\xa2\xfcu\xc6\x0f\x8d1~>\xa1;\x1at\x9fgO\\\xb7\xe4*0k]!\xd7\\a\xb5\xdc\f\xc1\xcc\xd8T\xa99l\x88\xbe\x8cU\xe5\xb12\x00\x10\xfanA\xe8\x87\x0e\xde\xc9*7\x13\x1c\x1d\xbf\xcb\x13l\xc4\xc4\xc7\xf0\xfc\x13\x198\x82ba\x8c\x14Zg\xd4x\x9a\x1d\u008d\x1dq\x05bw\xe8B~B\x85\x8d\xf2\xb1\xbeB\xe3\x1bSkO\xf5\b\xc1\r\x83\xee*\xcc\r\x9c\xe3\x063\xf3\xec\xe2n;G\xb0H\x87\xdc\xc7D\xe6\xc7\xf7\x15\x82O\xf4\t\xc4:\x95\x96\xda֙\x8e\x98\x98\x98\x98눫\xbav\xc0\x9bD\"\n٧մ\xc5Ҵ\x1aD\x83M\\\xedT\x8a\xf7T\xb0\xea\xaa\x10\xeb'ܶ}\x8b\xcc9\x9cN6\xf0f\xd8}㉷\x85\x84ƛ\x86\xdfe\xb9\x84\x19\x9ex3\x99\x9f\x1e\b<,8\x94\x8f'X뉎\x11\x0f`\xc6\xf76\xf18\x98\x9f\xbcE\x02\x10\x04\x159\x03KtM\x13\x884k\x06\x96\xa16%q\xaf\xa5gܪ\x1dl:\xab\x8c7Z`B\xf3n:~\x7f\xa3\xb4\xac\xf76\xf0x\x10d1䒅\x88\xc1\xe4\x1c\xe2\f\xbc\xce&3\x0f\x8c\xff\x00\xaf\xec\xdb\x0f3\x00\x18\xc6s6\x83+\xf5R\xef\xba\xdd\v\x89^\x92\xcb\x1fS\x8a\xe9\xc4\xc4\xdb1\xe3\x16\x8b\x1a\r\x1d\xc6/Ǽ\x1a\x14\x8b\xa5\xa9f\xc5H\xd7Ұ\xebj\x10\xeb\xe3k/\x86\xfbZc3\x1c\x9c\t\xf8\xa2~\xfe\r\xb8ϗ\xb8\xab9\x03\x8c\x0fd\xc2pI\xcc\xc73\x889a=\x81\xcc\xceKq\x16z\x18\xcc\xdd\xc1<\xfb\x9caFW\x95\x9c\x96\xacmEm\xadV\xb2\xa6\x97j|\xcd\x1a\x8dD\xfaN\x02\xe8\x83\ntu0]5Ba\x14\x1dE\v\x0e\xbe\x91\x1b\xe4a\xd7^a\xd4\\\xd3\xdfA\xcc\xe6g\x85\xf6\xc3\xcb\x19?\xa0p\x0f$\xe4\xe7\a#\x19\xc1\xe9\xfb\x8eXr'\xe2,\xfd\x1c\x1fp\f\x9228\x9bx\xdd\xe5\x91\x06X\xfb\x99\xc4\x001\b!\xc6s\x0f\x13~a\x00\xae\x9c\x80\rj\xacB\xaa\x9b\xaa\xad\xed\xd6P\xd3\xed\xd1>\xebO\xb3t6\xbbN\v~\xfb#9\xdarç≌O]37\x18Zn\xe33p\x80\xe2\t\x89\xeeg\xa0\xe9\xfaG\x01\xb1\x13\x04\x85;y\xc7\x02`\x95\r\xe3\x98g\x8c\xc9\xc4\xc6g\x1d0 8b\xc1\xa1\x1bO\xb4>\xfb\xd64&m\x9c\bz\x14\xc1\x98\xdb\n\xe2\t\x82!\xcc\xfd'$\x15\x13\xfd\x1fS\x11\xb9\\\xf4\xc7;\x8c$\x133\x0e'\x02\x02f311\x0f\xaf\xcc\xf0\xb8\xc0ț\xb7NL>\xbd@FO\xb0q8\xd8a3\xf3\x18\x8d\xec`,\xdc\x18\x9f#\xfb\xfe\xa1\xf7\xfa\xc3\x11D\xf6\x0f\xb9\x89\xc6\x00\x13\t\xbd\x98O\xfa\xe0\x19\xb4\x9e\x99\xc4?虒zs1\xe2xl\x10#\x00G3\x1b\x89ȋ\xc89\x06~\xe2\fC\xec7\x91\xc4\xceك8\x83\x067\x81\xc13\x1bO\xfd!\xe2n0\x1c\x8f@fc\x9c\x18}p\xa3\x01\x8f\xe9\x04\xc1\x812a$\x8c\xe2fs\x01\xda\x01\x98\x83\x88\x0f*fH\x827\xb9\x838\x99\xccɞ\xe6Nу\x0f\xbfJ\"\xb0\xc40\x1cOҦ\b\f\xc7#$\xed&\f\xc3\xccU\x8cI\x80\xed%\xe1%\x8f\xf9\x9e\xce3\x06!#\x05\x8cf$\xfa\x18\x02\x1f\"\x0fL퀒B\x89\x81\xbb\x19\x998\x13\x10\xf8\xc0a\x13\xd1\xe3\a\xdf\xeeL\xcc\xcef|}\xaa\xfb\a\x8cpG O\xf511\x89\x9c\xa8䕆{P&\xd9\xc4b\xb3\a\v\x8312\xc9\x18\xe6\fc\x92s\x17Ȑ\x15kb\xb0s\t\xe4\xc0\f\xc8i\x80J;9\xc2\xfa \xf1\xbebd\x90\x0e\a\xb1\xeaf\x13\x82\xa6s\x8c\xf0I\xc0\xc9\xe9\xc6T\x007\xcd\xc4B!8\x9b\xbc|\xb1\xc1\xe9\x9eK\x18\xa7\x11\xa0c=\xce@\xdd\x14\xf2v\xc0\xbc\xe3\x10s6\x99\xb0\xc6\xe5\xbd\x1d\xb0bc\x13\xdc\xe4A\x90\xb0\x12\xcaW\x04\xcfU\xe6`\x92s6\xe4\xccE\xf5\xb7\xa6\xd8\x7f\xd7\xe7\xa0\x013\xfd\x1c\t\xee\x01\xc7\x1bk@I\xc6\xee1\x8e\x02\xe0z\x980\xe4O\xfas1\xcc\xfd\xfc\x8a0\xc4s\x81\x062\xc6(\xe1X䧖wEl\xa8;\xa1\xe6\x02@\t\xc8;\x860Bͦ0\x80\xed\x99\xc4\fOE3!@;\xa61=4s\x96\xf5?\xd4\xdc&3\xd3\xfe۰=\xcc\x1e\x83\x83\aBy'$N\f\xe6~\xec0\x8cNg3\xfe\xa3\x18\x00\x16#\x06 \xc30\n\xd33i1\x8f\x8f\x93*/\n\x06q\xcbr'\xf9\x80\xe6g\x8c\x92A-=\x1fPz,I\xcf9\xf3<\x8d\xd9\x19\x13\xf3\x1c\xf6̬x\xfaf<\x83\x95\xfd\xfdOdA\x8c{\x8aNG0\b\x00\x9bD=\x00\xca\x1e\"\x12C{\xdcD_~\xa7\xe2\xe2s\x8fo\x8f\"\f\a3\xdc\xc1\n'&2\xed;\xa0s\x00\r?\x7f\xff\xc4\x00,\x11\x00\x02\x02\x00\x05\x03\x04\x02\x02\x02\x03\x00\x00\x00\x00\x00\x00\x01\x02\x11\x10\x12 !1\x030A\x13@PQ\"2Ba\x04`\x14bq\xff\xda\x00\b\x01\x03\x01\x01?\x01\xf98\xf5\xa5\x12?\xe5/'S\xa8\x99\x9c\xcef\x7f\r\x7f\xe9\x97\xf1q\x83j\xcf\xc9>\xd5\xfce\x14$\xd2:\x8d\xfb\x88\xc5ˁ\xf4\xda\xee\xd1E\x19Y\x95\x99L\xa6S*)\x14\x8d\x8d\x8b,\xbcj\x86\xeck\xdb\xf4\xf632k|r\xb3)\x94\xcaQ\x94\xd8\xd8R3\x19\x8b,\xbd\x14Q\x1dk\x91\xb1\xe1^\xda\f\xb6I\x99\x8b,\xb2\xcď\xa2\x8a2\x99X\xbard\xba2\x8a\xbci\x94\xcae2\x84\x92\xd7\x12OG>\xd5V\x1dN0XQ\x0e\x9a\x7f\xb3%8\xc3\xf4G\xaf\xd4=i\x9e\xa7\xf4z\xc2\xeb6F7\xe0q\x8a\xe4r\xfa\xc3r\xcb\x12\xfb\x1bKJ\xd3%\x83ǒ\xbd\x9aDfI\xe6\xd1e\x92x\xe4%\x15\xf4B-x\x1fVf\xef\r\x8a\x88̬LqM\vB\x16\x87\x8b\xd0װbZ\xfc`\xc4G\x9c\x1bFm\xc71S=4e\x12D\xbf\xa23hl\xcc5\xa5vk\x17\x1e\xfaЇ\x8f\x82\x86,#\"O\x1b\xd8V̦C+7+\x0f\x04clk\x04?\xeb\x0f%\xf6l\xcb}\xe5\xa1\x0fZ܉\x0e\x82\x92\xfd\x87\xd3qt\xc6%\xf7\x85=\r#.V3\xa7\xf61\xa12\xcb\xd5E2\xb0\x96\t\xd0\xf7\xee\xd0\xf0\xa1\fJƲ\xf2Y\xe4X\xc4CvQF\xe3/\nXrel\xe0o|\x1e\x94\xcc\xd4[/SXr(\x8e/\xc7j\xf4.\x06G\x93\xa8\x93\x17#t-\xc7'b\x16\xc5}\x96)^姃FS)\xb0\xf9\x10\x87\xb1-Ї\xce<\xe0\xd6\x15\xa52<\x1c\x8dP\x88lgGU\xae\xed\r1F\x87=\xab\t\xf0D\x97\xecf\xa2+ȷ6\x12(\xca\xcd\xcd\xf4\xaeIo\x85$M\b\xac\x18\xc6&V\x9b\x16\xee\x8evbT\\|\x9f\x8f\xd9(\xf9\xeey\"\xc9lY%\xf8\xe0\xf8\"K\x93\xc1қ\xba\x1f\xf4Uce\x97\xa5\x1eF!\xee\x8a+nF'\x12QLQQ\x1a\x13\xb1\xach\xca\xc5\x19\x0e-\xefe\r\rћ\xb9\x1f\xbcb?\xd4H\xa2\xe8l\x96\xe8J\x9e\r`\xf4^(X\xacf\xb5e\xac,\xcc[\xc5\v\t\xa6ʭi\x15\xa3x\x97#\xc1\x13\xf8\x8f\b\xb2\x86\xac\x94Zb\x1e\x97\x82\xc6(\x91{\xd6\v\trQD*\xb4QE\x14m\x85\x96D\x94\x86\xb5\xc5Rؔ\x11(\xd3ƍ\x91\xe0\x89\xfcph\xe9\x9f\xf6B\xfb$\xfc\x10\xddch\xb8\x8eH̋-\x96t\xc7'\x12]G'\xbe\x84\xe9\x92I\x91T=\xcaXX\xb4^\x97\xd9\xcc\xd0ݟ\xc7\x14M&=\xa2!\f\xe4\xa3\x7f\xb3\xff\x00FEљ\x92\x11C\xc3z؊g\xa6Ű\xd9H\xd8Z\x9e\xc2\xdcp\xa2\xbb/\xb3\x16\xbc\x8eQ\xfe(\xcdze\xc1\x15hXF\xbc\x90[\x99\xaaV\x89<\xc6[\x14L\x85\x1b\x15\xa5N\x8b\xb1!\xba\x1c\xac\xb2\x8a2\xd9\xe0\x8d\xc9X\xdd\xf2IV\xe8[\x8fE\x96Gq\xf72\x19h\xad\xb4I\tV\x8bа\xb1\xb3\xd4G\xa8z\x8c\xcd#\xf2,\xf2D\xea/#dqN\x85\x1bd\xe3Ka\x7fx-\x9e*\x0e\xac{\x16\xcb:IV\xe3\xe4}\xb82[\xe8CEjʨ\xb1D{\x17B\xb93\xd2ܤ\x8c\xd1G\xa9\xca\x13tz\x7fH]9\b\x9e\xf1\xc2\x03{\xe3\xd2i\x13\x92d\xde\xfb\n\x9f%\xa4Ģ\xf8d\xdc\x13ّ\xeax\xa2y\xa5\xc22\xc9r\xcaY\x8bX>\xda\xc1\xf3\xa1߂v\xa5H\x8b\xa5\xf9\x0f\x9d2\xfd\x84J\xfc\x90\x8d\xad\x85ӑ\xe9}\xb2J\x98\xc7\xc9і\xfb\x1fL\xcb{\x13\xdbs=Ş\b\xc9%\x86\xef\r\xfc\n\x12\x1fJO\x93\xd2^Y\x93\xa6\\\x17\b\xf5~\x91\xea\xc8\xcc\xf0B\x1f~;\x8e&Q\xadЯ94\xec\xad\x1e\x06\x91ӑ7|\x1d)YU!\xad\x99ԏ\xe4X\x96\xe4\x13L\xf5\xd0\xfa\xe3y\xb6\x17NL\xf4\xab\x96e\x8a\xf2<\x9e\x04\xf0\x8c\x98\xe4\xcb\xec-\xf0\x97}:gQ~6\xb0n\xa4\x8e\x9e\xe4\xf8\x1a\xdcx\xa1+\xc2\aM\xe4\xdc\xff\x00\x90\xcfVL\x92\xbd\x99\xe9\x7fb\x84W\x934W\x02q3\xc5x\x1c\x8bz\x95\x12ƊБ\x92\x89l\xac\xbb\xdf\xd8)\xaa\xdf\t~\xc8\u03a2\xcfP\x8f69\x19\x8c\xc6\xe5nzq\xf2Ġ\x8c\xd1\xe1#<~\x8fW\xe8\xbfo\x9d\xad\x87\xf9\x14IW}a(\xa9\x11S\x1c~ٖ(\xfcKFc3\xf65\xa1#)E\x15\x85\x1b\x16\x8b/\xda]\x16K\xb1^ǃ3,\xcc[+\xdb\xf1\x8ea\xbe\xdd\x15\xa6\xcb/\a\x8da^\xe1v+\xb3fc1x\xd1E\x14W\xbeB2\x14=\xb1\xad\x16\x8c\xc6c3ƙ\x94\xcaW¬#(\xf9%%\xe0n\xf96\x12\xb1\xe0𢙔\xa4V\x8b\xf8{\"\xc9=4m\xef/\xdb֊\xff\x00@\xa2\xbd\x82\xf8\x95߿\x80\xaf\x8d]\xcb\xf6\x17\xf2\xe9|c\xf6\xb5\xefo\xb1BԻu\xb6\b~\xd3\xff\xc4\x00+\x11\x00\x02\x02\x01\x03\x03\x04\x02\x03\x00\x03\x01\x00\x00\x00\x00\x00\x01\x02\x11\x12\x10!1\x03 A\x130@QPa\"2B\x04\x14`q\xff\xda\x00\b\x01\x02\x01\x01?\x01\xf9\xd4QE|'\x14\xc7\x01E\xb3\xd3=3\x05\xf8Z+J+\xff\x00i.\xaa[\tůj\xbf\x17e\x966\x9b:I|\x89MG\x91uS\xf7\xac\xb4d\x8c\x91\x91\x99\x91\x93-\x96\xcd\xcd\xcaҵ\xc92;\t\xfc~\xaaO\x91t\xe2\x96\xc4\x1e\xda䌌\x85\"̋f\xe3E\x14QE\x15\xad\xe8\xfb\xdf\x02\x8d\tV\x89\xfci\xab\xdcQD\nҍ\x8aF\xc6HȲ̌\x8cк\x89\x96Y\x922FH\xc9\x19\x0e\xfb\xe4Ȯ˯\x8aӭ:\\\x9b\x8e\xcae\x92\x9dpEeɄLb`\x8c\a\n\x1c\xbffM\xeb\xb1E\r\xaf\x02ܢ\x8a(c\xb2\xf5Z'\xaf\x1f\x11\xb2P\xfa!\x1c{\x1a\xb6bEj\xfa\xaa茉\xcd\n(\xd9i\xb8\xf3D[\xf2d8oh\xb6\x9fk%\xab\x16\xa9\xf6'\xf0\x10\xde\xdd\xfetC$\x9b[\r[\xdcQv8\xec.\x98\xee'\xa9!M\x8d\xb2\x1fL\x94\x13\x14|\x18\x8a]\xafٽT\xbe\x03Ջr\xb5lC\xd2PdV\xfa\xd6\xf66\xa2dz\x9bФ\x87O\x82\xf7\xd3\xc9)b\xacR\xbd\x1d\xf8\x17\xefJ\xd8k\xba\x8e4\xa3*\xf7\x9fd\x88>ǣ(\x90\xfa\x94f\x9e\xe2\xb1\xcb\xe8\xe3\x91Ih\x96\xe5\t\xbb/$#\xa9|\bC\xed\xae\xcbE\xe9\x1d\x1a\xb1m\xee-\x16\xb3:ct\x88\xcb-\xc6x\x1e\xb2\x18\xad\x0f~K\x18\x8a(m\xb1#\x83$\x95\xb2\xecI5܆\xac\xc4\xc5\x15\xd9e\x89ޜ\x0eT)\xaf>\xf4\xb9\x12\xdc\xea:\x89іǁn4$\xa8r\xa1\xbb2^4q\xad\x8ah\xb333$n\xc5Ə\xf6EnF\x94\x87\xcfrz_t\x9b\xb3\x81;\x195\x96\xc4z2\x8a\xe4\xe8\xc5\xc7\xd8\xf3ܚ$\xf2\xe0\xf4\xebq*D\t\v\x81\xc6\xc9IpI\x1c\"O\xe8\xc8\xc9\x1f\xc4\xfe&ݒ\xe0\x8bih\xdb\x14\xb6\xd7ƈLk\xc9}\xdb%g\xed\r\xd8\xd4\xfc\x17%\xe0\x84\xbc?avx$\xa9\t\xb2'\xfa\xd1rH\x8f\a\x93\xa9\x15V-\xb7h\xc9=(\xa3\x12\xbbY\xe0CBD\x7fc\xean)\x12r\xb2\x12%&Ȼ\x1a\xa1kh\xcd\x0eq\x14\x92T\x91\x90\xa4-\xcc{\xdfl\xfe\xb4dO\xf4Iј؈ʘ\xde\xda'\xb6\x8b\xb2\xb5d\x8ev\x17d\x84-\xce\x04\xcb2\xb3\x93\x13\x04b\x8aZ\xb4l\x88\xca1\xe4˽\xb4Yz\xb5\x96\xe6(\x91\x03\xfd\x0f\x82\x86\x8d\x84\xe8R\x18\xb5\xb3\x92\xb4zQ&-\x18\xcd\xcf\x1a^\xe4\xa5o\xb1\x16Yf\xfaQD\x88\xc3qw\xf5&\xc8M\xb4BV\xb7\xd6\xcd\xd9\"\a\xfa\xd6G\xebD=h\xdc\xdc߲db\xa5\xb0\xa3\x8e\x88c\x8d\xa3\x16b\xccL\n(~\xca\xf6\x19\x8abC\xfe\xfa\xbb \xda$DkΖ_n\xc2}\xb66f\x8eH\xaa\x1b7\x1fe\xa2\xcfP\xcc]K2\xf6W\xb0ɩ\x7f\x920\x9f\xfaf\to\xdb.\v\xa1\xe8\xd3\x18\xa1f\bƊ\xd5-V\x8cp\xb2\xa8r\xa2*Պ%\x16dLre\xbf$a\x15\xc0\x98\xdd\v\xb6\x89:\x17\xb5\xe7L\xe8S\xb3\xfd\vV8\xf6R\xecg\x9dh\xc4\xc5\x1b\x16\x86&u>\u038b\xf1\xa4\x9d\"\xf4j\xc9* \xec\x8f#V!\x0eFb\xaa.$e\x16ugn\x90\xb8\x17\xb2\xb4\x9a\"\xe8\xe7V'\xdd)\xd1\xea36\xca$\x88\x97Fvd\xcbz$\x8c\x90\xe5\x1f\x03:n\xa41\ue136\u05ect\xe0\xe2HS,s\xfd\x19\xb1\xc9\xfd\x99\x897\xc1\xd3鴬]6\x9d\xbd\x17\xb6\xf4\x8a\xd8ZlJT\x8c\x9bBM\xf08\xebD\xb4\xff\x00\xe9\xb3[\x1b.L\x91\xea}\x144\x90\xe8\x91\xf6\x8c\xab}!\xfd\xc9\r便\xa7Rj'\xae\xcc\xef\xc1S\xfa=>\xa1\xff\x00^^X\xbf\xe3GȺ\x10\x14\"\xbch\xc6/~{n)ldN[\x0f\x83\xa6\xd2FI鿍$=\x86\xecΑv]\xa1[h\xbd\x8aؘ\x8fE\x8b\xa28$\x8c\xa9\x99I\xf0c7\xe0\x8e~J\xd2P\x88\xa1\x1f\xa2\x97\xb0\xf6\xd2>\xfc\x95\xa3\xa5\xfd\xa9\xe9-ή\xc7O\xfb\n\xb9\x16\xb2'\xa4\x85\x1c\xb6=\x13Ҋ\x1dV\xc7\xf2\xfa0\x9b#ї\x91\xa9\xf80\x93\xe5\x8a(\xc5w;\x16\xb7\xda\xd9\xea؝\xba*\xb6\xf8\x12\x8b\xbd\xb4\xeb:?\xb209\xd8Q116'\xfa1\x9b\xf0zSd:N>L%|\x9e\x92\xf2R\xf8\xef\xa7\x16\xec\xfe\xba/~|\x10gR7Ɗ\xc8)\xd9\xfc\x8a\x7ff&+\xe0\xd9e\xe8ّe\x97\xae\xe5\x14W\xc4j\xcch_\x06\xfd\x8eLQF%\"\xfe?:\xe2%\xed\xb6_m\x14QB\xd2\xcb\xd2\xfeC\xd1w_s֙\x89\x89H\xad,\xb2\xcb/\xe71\x9e\xa7ِ\x9d\xeb}\x94\xccLLc\xa5\x96\x8c\x8c\x8b/\xf0\x92\xd2p\x97\x82\x11\x95n$\x97\x052N\xb5E\x96\x8bFFL\xbe\xca\xfcD\x91\x04+ѥ\xa5\x9b\xfc\xca\xf8\xf7\xd9\x7f\x95_\xf9F\xbf\x01\x7f\x8d~\xe5|\n\xfc\xbb\xfcb\xf8\xb7\xf3kر\xea\xfb\xdf}\xef\xa3\xf8\xbf\xff\xc4\x007\x10\x00\x02\x01\x02\x03\a\x03\x03\x02\x04\a\x01\x01\x00\x00\x00\x00\x01\x11\x02!\x10\x121\x03 \"2AQaq\x81\x91\x133B#\xa10@RbPr\x82\x92\xb1\xc1\xe1C\xd1\xff\xda\x00\b\x01\x01\x00\x06?\x02\xff\x00\x14\xb9\xa9\xa1\xa7\xf8\xdc\xee\xeb\xfe(\xa7\vn\xdfv7\xaf\xb9?\xcap\xd51\xab\x16\xd1U3\xfc\x8d\x8b\x96\xc7O\xe7\xf448\x95\x88U|\x1cU\xe9\xa2\xfeFKod\xa8\x85E\x7f\xcajk\xb9\xa1\xa1\xa65^0\xe2I\x9fSg\xa7\xf1\uf148\xc3\\Ui\x19\xaa\xa9\xc8\xe2\x96\xceCDq\x1a\x1a\x1cl\xbb48i44X\xe8hh\xcd1\xd4ԣ\"\xbcK\xdf\xf7\x15\xd9|&\x9f\xe2hh\\\xb6\xe4\x97D\xd7\xca'\xb1Q\x97X2\xb7\xfb\x19\xff\x00\x162\xc8\xd1\xe39\x8e-\xa1\xac\x9a\x1c\xa5\xd5'\xe0kHڹd\xcbl\xd9\xf6\xceTt9ϸŚ\xb6\xe0oy\x16\xdc\xedQ\x15/\xe0_\rnAÅ\xc8\xc6\xe4ƥP\xaei\xc4R\x8eC\x8a\x87\xafB\xdb=\xa3\xf6>\xd3\xf7-\xb3_$U\xb4\x85\xd9\x17\xac\xfb\x95\x16\xdb\xed>H{J߹79M\x16\x16,\xcdL\xb4\x8b#u\xf7\x1a\xa59[\xb3N\x9b\x8c\xa9\xe1+\x1c\x9b_j\x8f\x1d\xf0\x9d\xff\x00'\x11l4/\x8aX\xba\xb6MC\xe8g\xdaF\xe5-\x13%\xf0u=\x11;\x16\xd2\xee(j\f\xb9Ԝ\xf4\xb5ܻ\xccp\xec\xdf\xc1\xf6\xaa8\xa9\xda#\xf4\xaauw\x9e\x85yZ\xa9\xb5r\x98\x86\xea\xefЗW\xfbJ\xaa\xa6\xcat\xdcJ\xbeA\xe4\xe5\xc5\xeeJ\xc7%ji%r\xff\x00\x02\xc5\xcdq\xd4\xf1\x87\x8ao\xb9+Q^\x92s_\xc6\x14\xbf#E\x02\x17ӿ\xf5S\xdd\rP\xf6\xd4>\xa5*\x8aj\xcd\xd5\xd4\xce%\x9a\xb9\xbc=\f\xd5\xed2.\x968\xf2\xd5\xda\x0f\xfe\x8b\xd93\x9bi\xf0uk\xcb!\xd1\xfe\x92vv\x16\xcdҽ\f\xc9[F\xbb\x0f\xce3\x84T\xe3\xc9\xccjM\uf1b0s\x1a\xb2\x12r̽p\x8e\x86m\x9d\xd1}\xe9\x94H\xb0e\xb1\xabh֣Ųʒ\x9a]Vo\vjWxk\xa3)\xff\x000\x87K\x1cS\x9a\x9e\xf2l\xfbM\xca\xf8e\xaa\x8e*\xad\xe0\x9a\\\xd0\xf4\"\x99o\xaf\x82\xef\xe0\x97\xb4t\xfa\xa15]5AS\x8c\x92왙\xe8m{?\xf9\x81ƣ\xf1\xa9\x04\x19\xdd6\x12i\xaaz\xe1M\xae̵\xcb\xecC\xdc՞\xa45\x18\xe6\xa3^\xc5\xc8,\\\xb63\xb9w\x85;?\x04bƊ=pe|Wn\xe3\xf9\x11\xa8\xb2\xbdY\x14\xd9z\x0en\xfdG4UBCI5?\x8aՐ\xda\xd9Ƌ\xa1Ͳ\xf9%8\xb4X\xfd:_\x96s\xaf)\x8b2\xcb:d>\x9d\x15N[\xf9b\xa5\xf76\xaa\xcaz\x99+z\xae\x16U4\xf1S\xd0ʴ\xc2voAK\xb1\f\xfam^u5î\x13\x9e\x92j\xad6p鄢O;\x9eH?\xb4\xb1|4(^o\x84t&Mq\xa6\xae\xc3\xfaT\xba\xa3Q\xa4\x8d\xaf\x82\x976\xaa\x92\x9a\xb3E\xe0\xa5\xe7\xebr\xaasH\xf3h&\x9d\xfaXt\xec\x94\xe5\xb4\xf6&r\xf8e\xeaز\xefg\x1e\xa3\\\x13\xdd\x15d\xaasv\xa4䪯\x15;\x1cy[ˢ\xe8S\xb5\xd8٣;\xa7\xf5?\xa7\xfeɕs\x9a\x99\xa3Dl\xf6\x8b\xee\xf5]Ǘ\xe0\x82\x19\xf5(\xe4\x7f\xb0\xa9n\xc7\xf7\x199`\xe6g3\xc2U\x152\xd41\xda\tl\xd6\xd8D\\\xce\xfa\xe8\x88\xdaM\x1f\xb9\x13+\xa3F\xa5\x99b\x11=0\xd2\n\xab\xa6\xcfB\xf5X\x92ճVSO\xa1q\xed\xa5e\xd0ufU&\xba\x1b]\xa5\x1b>9\xe8U\xf4\xf5\xea*\xabv]\x0f\xa9\xf5c\xba)\xa2\x8a\xdbMMʞӆ\x9e\xe2\xfa4\xa8\x7f\x93W)\xcbx}\x0e\xcf[\x99k\xb3-AjU\xc5\xc3H\xdd\x10\xa9k\xbe\x8cK\x9fk\x1a\"\xaaۚ\xaaՙYj\xb2T\xbf\xa8J6~\xa7\xea\xbabyWQx\xa4\xa9\xf9\xc2\xe8t\xbd\x19\x1f\x02\xa2\xadz\x19\xe8\xe7B|-\xf5\x92\xdb:~\x0f\xfc55\xb1\xc7\xfb\xb1\xe4\xa9\xe6!\xeaJО\x83\xab\xf6>\xdc\t%\xa6\f\xb9cS\\)^ⱥ\xb0}`P\x85\x9dp\xf52\xec\xbb]\xb2\x9e+\x15f\xeaT\xba1/꤭\x1b:\xddQ\x14\x8a\x16Z)\x85\x1e\aK\xd9\xe6}.[g\v\xc9i\xa9>\xe6\xa7\x06ѯs\x9e}\x8eg\xedIř\xaf&\xa6\x964H\xe2\x81')\xf6CtSs3\xda\xd3&|\xd3K\xecC4\xc3#ף#\xa9\x0f\x9dk\xe4\xcfO\xb9O\x12\xab\x1b\x97-\x1f'-\x10e\xa9C\xff\x00\x91e\x94N\xd15W\xf6\x9fr=P\x9d0\xe9\xeey\xc6\xd4\xe2\x94D\x8d\xc98j6:\xdc\xe6\xf5?\x12\xae\x1d^\xa6j\xef\xc42\x9a͟\x83iK\xd2JRk\xd2E\xb4\xa2\xbc\x955\xf2(\xdb\xd8\xfdM\xa6qGL:\x9dG\xe8_\x1a}p^\n\x98\xba\xec\xaaV\x1eŷ\xe1\x99U\xb6\x94hM[E\xf2]ɫ\x92i\xe6Fn\xc4\xfc\x99\xe9\xb1Nm_l,\x99{\x16\x89>\xec~\xc4\xd4\xf3>\xe3Q\x99\x9c7\x15\\0x\xdc\xd6\xe4\xb1\xd8o\xa2\xdc\xf2'ܩ-p\xc9\xd0o\xfb\xc5\xf4\xe1\xc9\xc4\xe9^\xa5\xf6\xb4\x9fu\x89ә\xb3%R\x9f\x91h_\xa8\xc8x\xcb,F\b\xcb\x1a\xa9\x93\xab\xb0\xd4E\x03\xd9\xd5\xec)~\x82\xfe\xb1\xb5bK\x14\xbe\x86ef\xce\n\x8aiuy)\xba\xcbزH\xd4\xe6#3\xc3Bi\x9fb]5{\xa3\x86\x11\xc5Z\xf8\"0\xd5\n`\x8dF\x88n$\xe2ڹ&\x8a\xb3,R\xab\xa6\x16=\xcf\xf5\x97\xf1\x83\xa5/rj\xe3\xf0h\xb3\x7fKd\xbb\xaf\xd8ZIM\xd7\xc9n\xa5]\v\xb2\xd7ݸ\xf8\x94\xc4\x0e\xb6\xf8Wc\xe9\xaaa>\xact\xbedR\x9c,\xbeL\xd9\xd4\xf8%2\xd8^\xacy[\xf6-\xb3f\x94\xafs\x8bh\xbd\x8b\xd7Q\xf9?r\xdb$rR\xbd\xb0\xb2y\x86\xaap\xfa\\\x87]\xc6\xfa\x9a\xea\\\xe8[R\xf0qU\x03Y\x90\xe8\xa6-y\xee]\x16BJ\x96G\xd3\x7f\x04\xd5I\xee/\xf3\x90\x9fQE\x8f\xeeW\xf5\x1e։uO\xe4=\xa7ӥ\xd7=\x18\xa9Q\xdd\xc7qC\xba\xd4O\xa9Nv\x91}\xa29\xaa~\x85\xa9\xda2\xdb/\x96p\xec\xe9\xf88\x1f\xc2\"\xaa\xea\xff\x00v\x14\xedRW\xb5^\xa4\xe8-I\xbdB\xfd7\x1e\x84\xd3\xca\xcdP۩\x17\xdaT˧\xf2}\xb4p\xdbЪ\x9aי4/U+\xdc\xfb\xb4\x9a\xb7\xecp\xec\xd9m\x9a:/b\xfbZ\x8b\xb6\xf0}\xcd0\xb6\x85\xd1Թ\x96t%\x91\x1du\x12\xa5h1:\xaaE\xb6\xd0B\xa9\xd4kԊ\x7faK\xeaJ\xa4\x86\xe0\xfb\xbe\xce\xe7\x15O/\x82\xd3\x1eN\x13\x8a\xaa\xbeK\xb3\x9a$\xeaD2\xd4\x14䲋\xa2\xaaj\xa7Rl\x84\xf3/d:j\x96\x99\xcb\xf25\xf4\xe9\x1a\xed\x8d\xcb\u058bT\xd9jGJ٥\xea\xcd)>\xe3^\x85\xf6\x95?|t\xfe\x02e\xb0\x86i\x05u{\x11\xb6R\xbb\x9f\xa3\xb1\xf7fZ\xadIUOB0\xbe/й\x96\x98\x9f%QZE\xa4\xb5-\x8e\xaa\xac\xfb\x1a\xb9\xecMU\x1a\xfe\xc4\xe79=\xcbP\xbe0њA/i>\x06\xe6Y%[?\xa4\xe5\x11N\xc9{\x96ʅW\xd7jF\xe9\xda\xd5[\xecES=\t\x96\xee:\x95+7b\x9c\xfdt%sS\xa9$nhsн\xc8̪\xf4\xc6\xc4u \xff\x00\xdc.X\xd6\xe54\xe1\xccs\x0e\x1c\xbc4-K9\v\xb4O\xd4\xd4\xcbULUR\x9c\xa1\xc5\a\r\v\xe0\xe5:|\x97g\v\x16j\x917~\x85\xa9e\xa8\xfdΆ\xb5\x0e\xd5[\\\x13\xe8\xf5\x1d&\xcfh\xb9՚\xee\x89]\x0e>S'cRV҅Qz\x93Hʩ\xb9\x16\x9a|\x11$\xfe\x15~\xd8\xe8B\xa6N[a58\xc2勐\x8b\x8b\v\x97\x88,\xa5a9\xd1\xc5\\\xb3\xa9j\x11ʰyil\x9b|\xe1\xc7U?'2\xf8-Kg\xdb\xfd\xcd(^\xac|q\xec^\xb6;\x96\xa8\xa6\x9a\xaapqV\xb2\x15}\xb9O\xf2)\xb5.\xa4\xff\x00\x1az\x1bJ\x14\xf1r\xf83j\xf2\xc0\xd5Y\x15^\a5J\xe9b{\x0e\xae\xd7E\xa9*\x1f\x1d_$\xb2\xcdI\x97:\xa6\xaf\xee\xb0\xdc\xd1\xfe\xe1\xb4\xfd\xccϹ\x12sB\x1c\xcb!l\xa5y9`\xe2\xad\x1d\xc8X\xe5f\x86f\xbd\x84Ӿ\b\x99RK\xb9\x95갻&Qv~G\x06\xceKQI\x96R2\xd1Smv)\xce\xea\xcd\xd6\xe7c\xb9\xf6\xab\xf88\xf2\xd3\xfe\xa4QWEQU\x9a\xf5DS\xb3o\xd0J\xbar\x94UL*\x86\xb36\x8c\xd3TyeH\xb2М\xb0m)\xa7\xa5\xd1K\xe8\xc7H\xaa]W\xeeV\x9f\xb0\xd9u\xeeI\u009b\x8c4Ù\"\xfbZNi8v\x7f%\xa9\xa5\x1c\xdf\a\x15O\x7f\xa4\x1e\x10\xa3\x1c\xb4\x91bip^\xa6^\xa7\x8b\xa4\x88~\xc5\xd3\xf7\xb1\xd3ٕU\x14B\xb1TR\xaa\xf4b\xfa\x89Ga\xfe\xa3\xa7\xc5#\xcf\\\x0f/\xc8\xef\xf9+\x95MWZ\x15/\xa9U)\xde\xc4Ӛ\xaf,̿\x1a\x8b\xf6+_\x055\xcaSH\x88v\xf4\x1b\x8b\x1f\x91j\x1f\xbb\x151\x14\xf8.]\xa3/Ա\xc12Y\xb5\xe8Y\x16q\xe8s\xb3\x99\xe3\xa6\xe6Tdxy\x1ah\xd4}\xc9\xdc\xd6\xe7m\xc4\xd6\t\x9c,\xb9\xa9\nU\x84\x9b\xf0T\xa7E\xa7l4\xa9\x8e\x95e:\x15IVh\x9f84T\xddӱj\x1b\xf5e\xa8\xa4\xa9m=\xbc\x17\xad\x1c̴\x9cJ\xa98v_$\xabx#3\x8d\xeegI\x146\xd6\xec\xad\xcc\xce_\x92\xed@\xe9&\x9b2\xf8e\x8cd\xe8\x7f\xfb\x85\xc9\x1c\xe3ě\xc2\xe6J\xb6s\x1eG\x92\x88\x9e\xb2=\xa3\xb28v~婥\x1c\xff\x00\x05\x9dL\x96և9\u0099\xc8}\x9b\xfa\x9c\x14R\xbd\x8d\x7f\x95в\x93-*\xa7O\x92-K&\xbd\xa4\xb2\xaa\xaeޗ\xc3\xc9cCIe\xe4\xb1w\U000461bah^^\xec3\xa1vE\xcdv\x8f\xf6-\xb3\xf9\xa8\xb54\xafc\x99\xff\x00-\xa1jKl\xea.\x92\xf5g\x16\xd5{\"\xf9\x99\xcbO\xb9\xcfO\xb1\x14\xe6g\r?%\xebK\xd1\x12\xea\x9f\\4?\xf7\vĒy\xc5\xe1)X\x94\xb7\xaeL[\xf8\xfaoY\x1c\xaf\xdc\xd5!\xcdzx*\xaa\xa9\xb1ʑz\xe9E\xa5\x9c;/\x96[*/\xb4e\xea\xf9\xdc\xd2\xc4\xce1ز$\x87j|ni\x86\x82\x1e\x12\xe9\x92p\xfbT\xcfr\xfb\xd7\xde\xd7\x1b*\x99\xcb\xf2^\xa4j\xceS\x92\x93\xeeS\xecj\xd9m\x9f\xc8\xd2ʈ\xfa\x8c\xe2s\x87)\xe3\x0e\x86\x9b\x9a\x0e\x11\xd3\xd3\vv3(\x1fS\xb2X\xb9;\x1d\x88\xd7\xc9=\x8bb\xeb\xf3\x1b\x9f\xf3\xbfb\xd43\x96=Y\xcd\xf0]\xb3\x96Oƒ\xfbDY6[g\xf2Z\x11}\xa3\x1f}\xc4wF\xbb\xba\x9c*Ű\xb5\xcb\xe3é\xabOs\xc9ܖ[N\xd8AP\x93\xd0n\x8b\xa1*\xb8O\xa7J\xb2\xb4\xef[gQ\xcb\x1e\xe4\xd5R.\xd9e'J\x7fb\xfbJK*\x9f\xb1ó\xf9g\xe3O\xa2/\xb4\xa8\x97\x85\xb1\x93[\xe1\xa6\x0f\xb9\xae:\x1d\xc8\xc2\xd7\"!\x8aG\xd2\x06+\xef\xf4éfC\xb6\f\x95\xaa8\xdeS\xf4\xa9\xaa\xafc\x8a\x87\v\xa1\xc4\xe8\xa7\xdco\xea|#\x89\xb2\xdb4~4\x97\xdaS\xff\x00%\xb3?Dp\xec\xbeYeM>\xc7\x16ү\x92\xe7\x8cc\b.Z\xe4\x92;\x9e\a\x86\xa3<\xe1\xa9\xe3\v_\vܔk\x85\xb5&0\x92\xc5\xf1\xbb\xd7\x15\x84\xcd̵\xa9D}:E\t\\\xe6\xa7\xe4\xfb\x8f\xd9\x16\xa2\xb7\xeapQJ\xf59\xe3\xd0\xe2\xdaT\xfd\xcf\x05\xb0\x95\xa6>\x06X\xd4\U000cf05ep\xe9\x8c\xce\xf6\xa7\x82\xc4A\xa10I\x98D\x1e0\xd1N\x1f\xf7\x84\x93L\x8eE\x83\x8249\xea\x82\xed\xb2p\xf0X\xd7s\xc1\xa9\xa6\x17\xc1\xa92\xac:XV\xc6\xe4\xf4'\x1bN\xed\xb1킦]\xb0\xb8\x9fC\x8bC\xc1\fԱ\xa1\xe4\xf2x\xc2O\x18x,i\xb9\xe1a\xae\xe2\xecX\xeaN\x1e7\xee\xa3\x0f\x18[\x19<\xef\xdfC\x84\xb1\xa4\xe1\u008d,Jи\xd2܃Tx,v W-\x8d\xf7-N2I\xae\x10\xc8\xc2c\x1b\xe8x<\x1a\x1aᩡ\"\xf0N6D\xf4\x1c\xeeٞI!\x93I\xa5\xcbcԾ\xe7\x14\x1f\xf4hB$p\x8e\x871\xa1\xe8\x7f٦\xf7\xae\xed\xcd\r0\x8c4\xb1\xa9\x18I\x04\xf6&M\va|\x16S\x9a0\x82\r\x06\xe3\v\x17\xc6q\x9a\x88\xa8\xd7\xe4xF\x1ao[\v\\\xbe\xa5\xc9\xc2Mq\xd2\xe3$\x8c'\v\x1aclQ\xe3\t\xd1\x0f\b\xeej]\u0602:\x17f\xbb\x8e\xc7c\xa1\x12\xcd\x05\x86\x98뇜<\x92X\x8c54\xd0\xd7\xd8\xd4\xf1\x83͡m\r0\xb6\xa7\x92۽q\xb0\x86F\x1d\xc9\xe9\x8c\x19d\x8e¾\x17\xd0\xd7\x18\x9c;\x0f\xa9\x94\x82\x19\x1a\xb6C\xd0o\x0fSL'\xb1\x06\xa2XX̵\x1b-\x85Ǆ\xf5\xde\xf0D\xe1\xe0\xf1\x85\x8bc\x05\xb0\x94hBD\x8aG\xd0\xd7wR\xe4\x16'\x1b\x92hi\x8e\xa4\xbd\vcr\xda\x16\x1f\x8c._\xf8\bxI;\x9a\xe1bp\xd0nI\xecN\x98F0\x7f\xff\xc4\x00(\x10\x01\x00\x02\x02\x02\x02\x02\x02\x02\x03\x01\x01\x01\x00\x00\x00\x01\x00\x11!1AQaq\x81\x91\x10\xa1\xb1\xc1\xd1\xe1\xf0 0\xf1\xff\xda\x00\b\x01\x01\x00\x01?!\xb4q3\xcc\xd1+\x95\x98\xd2h\xea\x1b\x80\xf9\x99f \xc5@ \xc6\xe5Z\x95pӉKr\xbc\xcaFF\xe5\x89\x16\xa5\xf3\x14\x81\x95s\b\v-u.\x81\xf9Lԩ_\x86\xe1\xf9W\xe0bW\xe4\xa8L\xcc\xe0\xebnf+P.\x82\xc1\xe1Ej_\x1a s\xb9~'fYщ\x993\xcf\xf1\x8bhX\\j\x95\xdco\x19\xd2\xcb.\xa5\x9e\xa5ܭ\xb2\xddA!\x84\xc1\x05\x9b\x85\xadD*iD\xc96bnT \xb4ʘ\xcd1\xfc\xab\xfe:~\a㼯\xc0%T\xaf¥~\b\xd7\xe2\xc2&&Ȱ\xef\x1d\xb5\xcb%A0\x83K%\\,|\xcc#\x85q\x17c\x12\xadFт2\xd4İ\xb4\xbd\xa28\x89 7\f%.\rD\\q\x16Q*\xa3\x1d\xff\x00\x05\x80S-A\"b?\x8a\x92\xa5A\x13\xf1A\x1c\x92\x92q\xf9ɕ\x04\xe2Uʸ)J\x99\xe2Ud&\xec\xe2f\rb\xe6ˊS\x8f\x11\x1a\x88%\xc6\xed\xe1\x06\x16\xb2\xb1\x10\xc9\x17\x88\r҂\xc8\x14\xbf\xc3y!vJ\x8a\x800\x88Kҿ\x05\xb7\xe2YX\x8dn\xf3\x1eh\x96bm-R\xbb\x96:\x8b\xdcF\xael\x8b\x12\xd8~E\xa1t\r\xb1\xa6\xd9\x17\xc5,0Z\x81\vF\xb2\x84\xc4k\xf9\"\xa5\xb0\x91X\x84\x01b\v\xa9\x96\xe5\x06]G\x13yA\x91\x99ClJ\xf19/\xe4\x1a&X\x1c\xb32\x8e\t\xac\x9d\xe4\xf6r\xfdL\xf1\x1d\xc5U)DI\x88\xc4&\x04y\x13*B̲\xe34\x8d'\x94ډ\x97\x12\xd7_\x87\xa0\x97\xc1f\x1cZ\x18\x06\xe3GS\u008b\xee,m\xccń\xa5\x8d\xa5F\f\xa2\x1a\x94\xf5\aZ\x95ܥ\f R\xa9\x8e\xcc.%\xbc4D|\xe0\x1a@\xea{\x89\xe5\x99\xdeQ\ni\xf5\x01\xa2A\xf3\xd7\x1fİB\xc4\xeen0\u008f\xac\xc1\xbc2\xb5D[\xa2a\x1b\x88\xa6:\b#{\x81\x17\x89\xa7q3\x03\x82P\xe6Q\x1fļ\xb2\x98\x1d&X\xa3\x88\xa4zK͉\xb1Y\x82X\xe5\x8enS$B\xf6\b\xf5\x00\xdci\x89\\K\xb7\x1a\xb1\xf0\x87\xa8T\x82\xff\x00\x86D\x06nS\x81D\x1aՊ\x13\x88\xe8h\x91U\xb1(p\x16\xbc\xa7%\xc6Σ[\xb3\xa9\xf4FK\xb47qL/\x99b\xea\xf9\x8cQ3\xaacn\xf0\x1cüY\xd4߳\xe6\x1c4\xc4\v\x9c6aP\x05\xe8\x99\x18.u\xfe\x90~s\x8d\v\xf9Boy\aȔ\xb9\xe1\xe7ܖ\xb3\xbf\xc1H\xe3\xf0ܻ/qj=Oe\x141̿\x17\xc4P\xe6;\x95i̠\x89\x8a\x8aT\xd7\xc4\xe8\xe2sA`\x00\x17\xe6au \x05\xe2\xcepĹ\xc9p\xf7\"-}\xca\xe1\x88Q\x02\xbai,\\<\xb1\xc4pV;\xca\x04<\rn\x17\"cspK\x95\x12\xa6\xbfq\xbbk\xdb\x1d\xdb8\xe9?s]\xfa\xa7z\x9f\xf3f\x88{a\xe5\xfb@\xe8\xf8\xa0(\x01\xd1;\x1f\xc7\xe2<o\xdaWpN\xf0\x99 \"r\xf8\xa6(\x82\x8fR\x98\xe5\xfc_\xe2\xaef^\x8e\xa5\x94]g\x12գ\x04&\x14\xff\x00<\xc5d\x11:\x80\x9e#J\xb7.ޣ\x03\xb9\x89\x88\n\xed1\xd4\xcf\xcb\xc2T,y\xa8tO\xa9\x94\xa2\x06\x8a\xfa\x96\x1e=\xc6\xd7Q\xa2o\xd4Ψt+\xcaV+db:6ί\xe0\xa9\xca\"\x1b\xb3\x91K\x8d7\x10tV\xf9T\xa3\xb5\x19\x00\xd0\xc3\t\x91\xa9\xf0\xc48G\x11\xfbH\x85\xb5i\xe6[\xaf\xb4jf\f\xa0W\xa8\xe3\x83^\xa5\x84LLWx\x12\xc5v\xf0Ce9\x1a\x88\xaa\xb9\xaa\xe2P͠\x1d\xc4\xdcP\x1b\x873\x9f\x00\x97P%\xf2~\xe4\xa9R\xca\x7f\bcB\x97\x0e\xc5\xc6\v!E\x8f\x89\\\x9a\x880\xcb0g\x02\x04\x1a\x8azC\xa4z\xa9\xbd\xca\xe2\xeej\xa2dFI\xc2\x1e\x86\xa0\xc3$\xb5vVc:\xc40\xaam\x1e\xaf\xc4+\x94h\x19D\xa7D\xa7D\xb6\xf5}G=]\xee\xe3\x88\xd6\xce扨r\xd8;\x91\xc4m\xd5l\xdbǙ\\\x1b<\xb5)\xa7@q\r\xcd}\x10\xdf\xe8\xc1\x98j\xf7_\xd43+\xe3?\xd4P<)_&Q\xc0%\xb5^\b\xe0\xc8\xfd\xdd¡\x94he\xf1\x01\xcblt\x82^b4fp\xb9n\ns\x14\x1c\x98\xfc\n\x8f\xc7/\tD\xb7\x13\f\xc6W\x9c;u\xae\xc9\xccoL\xcd\x16ډ\xba\xe2.\f\x02\xad\x95\x03\x02\xc1p\x02d\xc0U\b1yKG5,YaӜ\x15\x88\xc9GR\xb9\xe0\x87k\xfc\xa7\xd6%\xa7C8\x99\xe7J\xf0\x9cO\x02@ky\x9e\xc9\x15\xb7\x88\xf5}\x80`\xaa\xf3\x011(\x17\xf8\xa3)AY\xd0\x1f\xe62\xbci\x1b\xd5YJ(\xaeM$\xb8K/\xc0\x9b\xcf\x1a\x8b\x8e\x8a+\xe0Np\xb8\xae +\x16\xe1\xb4,'F\xf2\x14\xcf9\\\xc5\xe2*\xa6\x17Gs\x12B)\xe1-]\xfe\xa2B\x94\xd2\x18U\x1f,\xb7F;\x9f\xa8]\xb6\x04*,\u0091,\xb8\xa1\xb6\xb6G\xca\xec:\x8f`|\xc7\xe0\x98k\xb9\x96\x13\x11\xdcn\b\xdc\x000<\x8cJ\xac\xc6\xeb:\x8b~\x90\x8eH\x86\x1b\xf9\x8c\x04U-D\xb1=\xfek-\x84\xbd\xa0z\x9c\xf7\x80\x1f\x8a\x1c2\xa1\xb3\x05n\xf5H\xa2D\x10y77\xa2\xe3\x14\x97\x1c\x87\x88\x1bJ\xae\xa7\x18\xdb#\bBѧ\x1f\xcc\xcdPt?\x93ġ\x1d\xf5f|-\x83\xfd\xca\xdeU\x94\xd3\xfb\x95\x8d\n\xe0b\x1a\xd6\xd5\xeef\xea\xaa\x1f\x8a\xe0\x00\xa3\x92\x19ꐖ-\x92\xe5\xae\xe5\xd1I\xea\xf0Np\xbf0`\x17\xd4qWR\xdd\xd9\x00u(\v\x94y\x97m\xb88%\x8aE\xf4ܹqo1\xb5T\xb1\x8f\xc4\xd2U\xdc4!\xccM\r\xca#&\x0e\x9aN6\xb8c\\K\x1a\xcc8\x03\a\x11\x1d\x1f\x13\x11\xac\xc1\xd0\xc4\xc4Qg\xe2\a\xc9\x19\xfb\x84\xa7\xff\x00\x10\x06\x8b\xb3\x11\xfe\vo\xc4\xcb(\xd0r@Qt\x82e>\"[\x10\ra\x96e\nq\x9d\xbecZ\x9f\x1a\xe7\x10\xc2\xf6/Dؾ?\xb6\xeb\xe2\x0e:\xff\x00\xaa\xa1\xbc\x97\xe5)\x87s\xb4M\x02\u07bf\xeehu`\xe2\x1c\xb2Y/\xebS'7s+\xb1\xf7\x13l\xe5\t\xdb\xf3\x1e>\xafK0\x8ca\xe8s)7E\xf3\xa2\xa6#\xe3\x93Ħ4td\x8a\xc1ma\x98\xb0\xe4\xbcJ!\xb2\xe9_Q\x0e!3a\xed\x80(\x93\xccb\xf2[n\vზ\xbcý/\xb8\x81\x82\x84Ë`*\xbb\x80?\xe2\x04\x17T\xf3\xb9^\x86\xb37\xb7jP\xad\x99T\xa5\x98_\xac\xda\xc1Pn\xf1\x94\x02[\x97\xe9\xe9r\xb80\r`\x858H\xa8F\xbd\xd2̤t$F\xfb\xd3\xe2Q/\"ɹP\x1e1]}LK\x16\xb4\xea0\xc1wr\xa0\x169\xb9f\x1eB\x8c\xd6\xf3q\xfc%sjR7\xfb\x96\x99\xbf4\u0087\xb2&\xd3\x14n\xc98\xd0,\xb0\x94\xf6\x0f\x03\xe8L*pF'֊\x8a\x80\xadh\x1eR\xfd\xf6\x8e%\x86\fḘN\x9d\t}i\xe7\xa4t93\xf5\x9b\xfa\x9f\xed\n\x9b\xb6\xe9\xe9\x96\xecWQ\x17\x8bQ{\x93\x1c\xa7\xcc0-\x84\x00=E\x0fb\rTO\x99\x85g\x82=壤L\x80\aI+r:;N\xe5\xe8W\x92\x13\x1dU\xf2&\x87\x87\x04n\xb0W\xb32\xb0H\xf3\x16\xcd\xfcL\xe5\xf2\x9e%!4\x82x\x04\xb1\xad\xb5X\b5\x89)\x14z\xb9Lq\xca\x17\x8a}\x13\b\xe0Ų\xb6c\xe7\x99\u06020\fL\x888\x81\xd5\u0083\n&\x8c\xb5*\xd4n\x16\xb1\x11Q\nk\xcf\x16dYG\xfb\b\xec14Ǻ\x82\xa5\xa8\xd5\xe5\xe1\x94\xe4\xe9\x7f\xcc\xfe\xbdX\x1a\x00\x18\xb3Q\x00\xc5\xc5n&R\x1d*\x8c4\xd8[/\xdc\x15\x9b\xb0\xeb\xd1.\x1b.\xa2\x89\xa0&\x8cf~\x8d(\xfe\xe0\x8c\x8c\x97ʠ\x1bf\x15\x86\xc9\xe66i\adz\xa8\x18\xf8\x92-\xfdmw\x16\xd1Cn\xe0\xb0\x7fd\x10\xcb\x14Z70`\xfcf\x00\x03\xe9/\xf2\x89\x1b\x03\xcdC#\xa3\x1f\xdb\x03>\xa1~_\x1d\xc5\x7f\xfe\tG\xc0\xe6V\xb3\x1cp\x87?L\x04\tZRQe\xa1\xe0\"\x06\x19\xd8E\xc1h\xe6\x05{EE6*\x8a\x12\x98\x8cG7\xdad\xc0\xf5)$,\xe5\x0fR\xd1\x1a&`&X~e\xca,}\a\xf8)BmlQ\x0f\xb4\xcak\xd5eO\x7f>\xe6};\x9b\xb3\xefz\x8d\xa9\x96\xb7\xcb\xfb\xcc\aU\xe4B\\\x16n\v\x94\x86\xff\x00x\x83\xa7\xad\xc7l\x0f\x11y\x92\rc-\xd4\xd3e\xc8f\xa9r\xf1\xcc\xd94|@\xc5AI\x91\x96Dt\x93\nOs\x17\vy\x85\xc0\x88\xba\xab郬W3gp\xa2\x94̮\x19\a3\x84t!\t\xe7`\x83\xd4\x0e\x17\xfa\x82\xb0\xac~\xa1uj\x1a\xe0գ\xae%\x9c~o\xfcF\x9d\xda\xe4\\F\x96\x8f\xe9\x18\xc9\xed\xf7\x14\xf6/)\xd0\xdeY\xfc\xa7\xbf\xfa\x8dN\xff\x00\xa4{\x1c߂5g=Jh\x0fs-\xbb\xf5\x1b`\xd4\x06Q\x1c\x01X\x80\xf2c\x06\tw8\x12\xfa%=\x0f^Q\x06ϥΐ\x06\x9cF\xf6R\xa6u\x17\xd70s\xc34o\x94\xacbӉg&\xa6\x06\x89y\x17\xf5\x05\a٪a;\x87W\xb8AX\x80rϒ`r\xf4\x90\x80\xcbz\x81nm\xc2\xe1)\xab\xd4!3\xe6`Z\xe96\xcc\x15\xdc/5\xf6\xf5\n\xcd\xd5g3\f9\aQs\xf3=\fJ\n\x19vA\x85\xdb\xc6\xf2\xe107\x02\x19\a\xe2\xce\xe5_\x88\xacyӨ\x19\xb4\xbcxcuJr\xc6H\xff\x00Q\x16\xd1\xf1*\x90S\xb8\x02\xf8\x18\xba\x95\x9d_\xa2[Mnm39\x1eO\xe22j}\xea\x11\x95\xe2\xdb22\x9a\aS2\xd6|A8|\x10\bӘ\x0f\xa8\x19@K}L\x80\a1&\xfa\xb9\x9b\xaePq\x9fQz\xb60[\x9d&&\xceS\x83\x82\xc8\x14\x031\x0e\xd78\x9ci6s\xaa\xcaS\xdd\x024\x02\xf5\x98\x1c\x9b\xba\x19Z8\x8aD\xad\x0fikk\xf8J\xba\xd3\x19\x8a\x7f2\xeb\x15w(\xd0L\vH\U00060683\xe5\x80\xd9\x14\xbf\x80]\xfdT\x99\xed\x8dn\x0e\x05QG\xb8`\x906\x98\xd2\x17t\xbb\x84\x89xf\xa3f\xf9\x1eeo,Ƭ\xb7\x98\xfccgq%j\xd5\xd4A\xa0\xbflJ\to\x84\xa1\tg>g\xf41:,w\x97ܹ6;f\f\xad\xf3\x04\xc61\xb9\xaaKT+\xf0JY-o\x983\xfav\x99\v\x14\xe2\\0\xdd\xcb\x10OlNA|\x91\xd7c\xce\xe6$\xc7QK3\xfdG\b)\x06\xbf\xe9\xcc\xd3\xcc\x030t\xd2\x10D\xc5C\xf72\xb7o4\xe6P_8]\xbe\\\xf3<\xccs@\xd5\xf3\xafp\x01bu\xdf\xe1\x86/\x01\xc8\xfb\x8b\b\\\x15\x98\n\xb03\x9ea`|CM\xca\xfb\x8aŪ\xe6l\x8fs\xb3N\xa3+ԣ\xf1\x82\x05\x92\x84\xachc`\xbdB#\xa3,X\xa3\xdb/\x89}\x10\xfb%\xb8\xcd\xc9\xcc\xca\xd3\xd3\x05\x8a\x10\x9c\xa9S\"\x8b^\"\xbc\f\xa1y%\x85Y\b\xc1\x9e\x94\x1bh\a8\x9b\xafb8\xd0\xf0\x9c\x95\xe8\x9cg\xb2\t\xc9|\xe6j=\x038\xb30(\xef+\x02\x97\xe4\x86_\xf8\v\x04̮\xaaS\xb3\xe6\xdcxR\xc8\xf9E\n\xb1\xdex\x87l\xe4h\x80\x94<\xcd jr\xeau'\xb8\xba\xa1\x14g\x9c\f\xa5\x1e\x986\x93\x96\xe5\xd9\xfe\xf6d\bE\xe7\x1c\xc2B\x96=\xc2\xc6݀\xd9\xeaTsY1\xa9\xfaJ\x03˙\xa8'\x84\xc5ؒ\xa1\xcc\b\xc1\v\xa9^@\xceZ\x81\xc7\xc0\x85\xfe2S\xc0|\x12\x9d\xf1Rp\xbc\x98\xcdQ\xe8&Jo\x8c\"%\xfb\x18\x85sx\xfbK\xaa,\x1a5sU@\xbd\xb1\xf3\xb6f\xfb&\xcc-ޱ8c\xe8)\x97\xba\xb5ݱ\xf7!\x845*\xb5\xabu\xa9TO\xb8\xd1\xfc\xb9\x8b:W\x14\xa8n\x85\x14\x85z\x9a\x7fw\x17\x97g\x89\xaa\xf4\xa0\x9b~\xd2p?\xb6\xe3x}qi\xf4\x1a\xfe&\xe8|\xb1\xb7\xa6f\x02\xf6\x86U\xef\x86\x13$\xfb\x85\xd9\xd9\xc35\xae\x9db24\xa5b\xcd\xdc%\xc0\x84\r\x990\x005\xfbL\xb3\xf4\x18'\xee\xed\x12\xe8\xfc\xc6\xee\x8f5(\x16\x0f9l\n\v\x82Z\xec;\x7f\xb8R]\x18\xbb\x98֣\xce\"up\xbd\x13kbx\x15\x9fP\x86x\x1c\xa3\xb3q9\"P\xb0\xea湹|\xc3\x1a\xad\x1d\\E\x00&\xfa\x8d\x9bE\xe5\x9a\x05=A\x15U4\xe6\vJ\xe5\xbc˭\xcb\xc1\x17P\xae \xebJ7g\xda\x04\xb0\xeaɸ\f\xb8\x96\xb6e0\x00\xb0<\xb2\x81L\xeb3\xf8\xe2JkW\xb7\x11s\xd0\x14(<\x17-\xa8U\xe1\x0e\xfd\xc6[f\xe5\x1c\xc5\x03Yv\xf0\x1e\tʏ\xd4\x0e\x7f\x01\x16D]\xdc\x19\x98\xbfR\xe5ύK\xa2\xc1]T\xc8\r\xe7\xdc3\xd9\xf1\n\xdbT\xed\x17\x84Cļ\xa62To\xa0\x98\xa0\x9c3\x94\x1d\xb0K\x01\xe6\xd9\xfe7;\x18\x8a\a\x8e\xeeʨ\xfd\x18A\xe9\x9d\xe6\x00\nX\xc3\x1ef(-b\xeaXT\x9a\xacbq\x19E\xa5\xcfg@\xdc\r\x8b?_\x98\xbd\x87\xdc6\xda_PbF\xcc\xd2ԡ\x9fcsVS\xee\xf8 9\x83\xb0?q\x15\xa3\xf0\x8d\xa0\xe7eCT\xf0OdEܸ5\xedA\xaf\xd8\x03p\xac\x90\xbc\x10\x02\x0e\\\xc4X3\xed\xcc\x10>&\xcc2\x83qu?\xb9\x9a\x95\xd3\x1b=\xe6L\x03\aQ\xf9\f\x1f\x80\xa9\x93}\x16l>\xf4\xab{%d\xe0\x86\xe5\x1a\xfc\xe2ű\xe5:\x8ey\x96\x0e\x1fqC\x9cԭh\x0fp\x1c\xc3\xec\x97\x0e\xce+S\x8d^ټ\xb8kḈ\xb7\x85L\xb2\xcf\x11K&\xe5\xc5\xe4\xc5\xf2\xfe&M\xa1\\ΰur\xc5\x03\xa0N\xf2.ȸi\x85`\xec+s\xe6`\xe0\xfa\x8d\x1a\xfa\x83s\x06\xaf\x9a8\xa1\xaf3\xf9\x96\x8c6\x00\xb6\x9a\x88m\xfc\xcdf{\x8e\x89\xe8\x85ZoDD\xdar\xf0\x8c\x0e\xe0\x93\xae\xbf\xe6e\x1f\xf6\x7f\xd5\v\x01\b\x0f-\aº4Ԥ\x1a4\\\xc1\xc8=\xceh\t\x93q\x9b\xb1\x948\x84Z4\x06\xe9.g\x98\x1a\xa6\x0f\xf3\v\x1f=_\xf33C\xe0\xda\x1d8\x99ML\x9f\xa6e\x8cq\xeb\x1e\x812I\xf1\x0e8\xee*X\xb1E\xd2f\x95\x87$/\x04\x95\xf2w.\xac\xfdA\xbb\x18:\x8a.^\xa2#\xf5;\x81u/\x88\r\xc4a!\xd2=\xb2\x99/\x10Y\x89\x11dV~\x04\x1f_D9\x04\x18\xfe&\x1a\xc0}\xa6\x05\xd5\xfb\x8al\xa7\x98m\x16WV\x9f\xc5e@hz\x10ج\x1b\x1f\xe8\x81p:\x8b\xc1\a\x96\rr*X\x8c\x0f\x12\xf6\x8b\xc7\x13\"v\xaem\x8d`\xe0Kl\x81ۖt}\xa3{\r\xb5\xc3\v\xb0\x19\x13\xba\x82\xc5M\xc5\xd4~\xb1\xf5\x1d\x17\xcbο\xccȅ\xd5;\xf1\v\xd3\v\x8c\xb0hY\x82\x13b\xba\xc4\x06}\xf9X\x06\x04\"NUEG\xc5ʐo\x1a2\xcc\xe8p%\xe0\x05ɫ}\xc0v\x0eJ\x9c\x1f\x16\xad\x85\xb2\\A}\t3\x013\x0e\x1f\x01/\x97\xe5\x18\x03}ʼM\x06\xe6P\xb2\xdd\x16\xad\x182\xb0\xf3c:}\xf3\x15{J\xba\xb0\x97\xc8 \x1e\x85\x8eY\x8d\a\xccP\xce<̪\xa9hA\x0f\x88\xee\x14\xed\x86b@\xf4\x9e\b\x84Z0%\xe5\xd2КY\xa5\xf3\x99CCn\x82Y1\xf3Е7\xe7\xa7\xf1\b\x1er(\x82\x90\x8e\x8a\"\n\xb3\xa5\xcbKU`\x956@j\xeb\xcc\tg\x18j\xa18DV\xc0n>\x82\xec\xf5-Z\xa0\xbay\x83\xb1WP\xb0\x8bf\xfd̨\xd2S,݄\xb2>\xa9>\x91.\xf4\n샼\xac\x06\xca\xf0\xd4\x1c\xb0s\xe1\xc1\x82\x19j\tr!WS\x95\xed1\a\xe91=/X\xc0\x92,O\x05\xc7A\x84,m\xfcʳr\xb1\x05\x10\xdck\x91\xf8\x9d\xddu4\x1bp\x97\x90\xfeiK\x89\r\x8a\xf8\":P\xf7\x17z(2\xd6\xe0%\xdfi|\xaa9\xd3\xd16q\xf2\xc1\x9a\x7f\x15CfI`e\xf8N\xb7\xed\x1a\x92\xd1\xe64\xb8/\xfb\x98\xa6\x05\xd6\"*\x98\x8a\x0e\xea\x00=0\xa3\xf5\x18?\xb7)Cm\xec\xc4SF\xc6\x13\x97w\xca\x1bI4n\x00\xa9\n\x8b.\x06ɏ\xc1\xb1Q\x1e\xaa\xd8y\x95@e\x1eS\x88#\xd25\xdc99lۺ\x82\x8bZ\xa8\xe64\xe3\x1b\xba\xa8\x1d\xa81k\x97Yf\\\x8d\rz\x9bf\xf7,\x15\xf9\x01.\x81Ա-`\xe3(4\x14w\xe6Vk\x9a\x86g\xf7\xc7s\xec\x97ns\x1es\x15\xac\xd2m\x93\x10[\x8dC\xaa\xcc%E\xf3\xe2-\x1c\x8c\xc9\x1d\x93t\xba\x8b`\xe8\x97)\x87\xb8Ҍt#P\xf3\xe6^\x8d\xaf0\xca\xcb|K`8\x94\x8b\xe8\xb9}-\x1b/\xdd\xc5\x1eOr˥\xdf\xe0\x03<S\xb8ɳ\x1b\as\x05\xaf\x824\xa3\rL\x94\xb3:\xe69\x90e\xfa\x8d\x05Z\vC\x94\v)\x1aR\x90\x99\x9e\xd3\x10C\xba\x0eL̚\x99ޫ\x88\"\xee\xe0<\x92ޫ\x04\x17\x01\xbeّ*\xad\xf1\xf0\x89\x98\xbe\xa5\xeb\x11:\x974\xbb\xb6\xf7,op\xa6v\x1f.\xe2j\vÉc\xccf\xbf\xf2\xa8z\x87[<\x86IM\x01\xe5\x952N\xc6\x11\xcd=f&\xa8\xf72\xb5\xfc\\L\xdc\x10\xf7\x88\xa2X08\x8e\b{;\x84\xd6\xe9\xc3*U;\x81е\x82\x1a\xd6%\xd4\xdb7a\x98\xb8C\xba\xd4\xe5\xc4\x01Rw\x96i\xfd\xa2:\x0e\xbe\xd2\xcei\xc7z\x99r\x06\x0e\xca\xf1\x05Y\x8e#\x18j\xe2*\x86Q^X\v\x0f\x17/\\\xb7\x0e\fv\xb7\xe43(\xd2\xe7\xe6T\xab\xeb\x93Q\xff\x00\xe4\xa6ڞ\x90e\xb4nc2\xe9\x98\xcbi\xf5,\xaa\x1fp\n\xd2\x05*\xf3U\xf8.Z\xd5\xff\x00¿\xf2\a\x87\xe3V\x12\x01S\x06\xaf\xf1,w\n\xccX]>a\xb2z\x11\xf5\xe8!\x85\xfb\xcd\xcb\x1a\xdbh@\x80\x92\x96\x8f\xb0\xa6\xb2w\x16ݞ\xd2\xd3k?q]\x81\xeaf\xf1\nĲ\x90&\x1ae{\xe0\x9ee8\x87f\xbb\x8ek\xf6\x99?\x03\xb8x*\xd9p\x8cL13\x00\xab\xccʂ\x1aR\xae!گqs}\x04kx\xbb\xa9H\xe0\xf5G\xf9D\xca\xf9\xd9\xfe*\x7fA\x99\x82j\xf7-y\xff\x00\xe1_\x97\xed+\xc4'G3l~\xe1\xdfQc\xe2^y\x83=K\xab\xdf\xe0\r[f7h\xbf\x1f\x8b#j\xe47/k\xfe!\x92\x8f\x98x\xc4~\xb1\n\x96(|\xa7\xd5\x043$\xea\r\xde\xe7h\xb9RT\x0e\xf9\xc7\x105Ѷ&\xea{`\f7\x1aI}\xa4\x02\xb3gS\xfa\x84n\xec' 9y\x80\xc6*v\x9ag/\x89\x9aw\xf30\x9a\x8dAj\x00%m?\xfa\xa9hCY\xa4\x1ab\x015\x88Ug\x98\x95\xd9>\x19\x97\xa01\xc4\xc3D>>\xe6\xe4\x1d\x91\xf5\f\xe2} \x95\xfb3\x8b}!\xc1\x17\x1c\xd5͠;g\x00\x9d3n\xf5\x92\xbd\x90\x0fG\xc1s\x8f\xfe10\xed-\xf6\x81\xe0\xf1\x19+\x13\xb5\xe5\xd9\x12\x9boh\xa1\x18\x1d\xcb_-KA\xa59\xa9Pg\x83̲\xba#M/ܯ\xe5\x03q\x143\xf3\x10\xc54\xf53\x15~\xa5Y\xf5\x94\xc0Г\xfa\xe0\xb8j\xd0\x17\x01\xe2\x8c* \xe2\xe9\xe2-\n\xe6v\xaf\x14\x15\xc4\xd1\x03\xf0\xa8g0\x81\xb1\xc0\x8def\xc1\xa9\xb2\a\xa9\x95HVc\x12\x86\xe0>\xe3U\xe8\bt\x95ʨ]_Y\x97\xad\x7fP\x99\xbf\xcb\v\xca\xf1\xb6\x03T\x1d\x7f\xa4\xd6zd\xa5\xa6\xf9J@ټD\b/\x06%\xa9b{b_\xe2a\xd92\xe7\xe5(\xf3\xae\xa3Q\x0e\xac\x12\x17\x91\x97mP\"5\x9e&\xa7\x0e\xc2\x01\x80\xaap}\x98]F\x8c\xd2\xcb\xe8k\x84E\x00_\xb8y\x80\x96\xf9hO\xa5\xc1\xd0\r\xf6Ի\xabd\xa2\x80\xf4\x06Qc˘\x8a\x8d\n\x84ɡpW\xfbK\x03v3\xb7\x98Z\x18\x97\x9f\xcc\xce\xc1*\xf8\x8f\x05\xd1\xf4\x95\x89\x99ZaWy\x94F\xd1\xd9I\xe6Rԗ\xb0\x8bK\x1e\x84\xd8\x10x\x179o\xf5\x107ygK\xddD\xde\n\xe0\xccmO\x82\xa9\x9e\x88\xf6\x9b\x87]\x12\xe3㘘\xa2\xbe\\\xc6\xd3\x1fp\x13\x89\x93Ne`@׀s\xe2%\xff\x00K\x8b(9\xeaX\x8e!\x9c8?\x89AE\xf9\x95\xbb\x87\x138Ώ\x10\xadW\xd3(\x9d1K\x0f\fܼ_/\xeegqF\xb1\f\xd6\t\x06\x19[\xcc]\xf8\x18\x89\x86|¯\xafP\xa5\xd3\xfb\xd4F\xd6\xea\xe2$Ъ\xeeU\x95\xe3\x9b%\xb9\xb7H\xb5\xbdʙcw\v\xbb\x8cC\xcb\xf9y\x80\xa0\xe2\x19\x86\x03\xb5\x8b{^\xd6fq9\xc0\x85\xca\n\x1cs\xd4b\xd1\xf1\x15\xc8\xf6\x86'\xec\xa7,}\x13\x11\xf2\x95\x9c\xe3\xddP\x16g\xcd\xcb\xf7\xd4\xd26\xa0Us\xff\x00\a3\xf5b\xe5\xe8\vܡG^e\x82\x96\xde\xe5\"uq\x12\xee\xe8İڼ\x91h&\x8e\xe6ҷ\x05`\x15(e\x99\xe1\x88\xf4\x9cէs\xb1\xa6Y\xb6,\xe2\x1bIl\xe6\xe2\xe8\x04,\u05f9`\v\x8f3\x16Xz\x8eY:&\x1b?\xe6+]\xf4\\\x1c\x92\xb9:\x8a\xcb\a\xd5\xc4U\x8f8\xa8\nW=\xcc:\x18{\n\x98\x0f\x12\xf8V\x1c\xb2\xeb`\x1cB\xfcn<H\xf5\a\x10Wl8E*\x9d\xb7\xbc@\xf6ȥ\x891\x02\xa4P\x1au\x8c%aѡ?`\x90E4n\b.\xa7\x17\x00\xfd\xac\xc3|\x1e\xc2\x7f\x16Xſ\x85\x8a\xed|\xce\xf3\a\xc1o\xe6\x1f\xad\xd1BSU,\xc0j\x12\x1a\xaf\xa8\x8b?ĵ\x17\xfb\x95=\xa066\x13 \xbd\xf8\"\a\x81(\xbb\x89Fj\xf19\x00\x84\f\x81\f\x9f\xd4s\xe14ek\xbb\x8e2\xccez*Qx*Y\x98\xc3j\xa0\xe6\x17:<D\xcb\x15\x9dʽ)\x1a\xb8\x1dJj\xa5y\x87q\x85\xe7\x86!(0\xaeEn\n\x96\x1eْ\xc9੩\xd9\xc6bR\xf0\xae\xd9\xc1G\x96`7l\xff\x00\x88̣LA\x88^=C\x98\xf9\x8aa_3(/)\x1e\xcc1m\xaf;\xb8\xab\xdbb\xa6Z\x15\xed+\xeb\x0ffbK\xf4\x02bC_&V-}g\xe2\xa1ľ\xcb)\x13\xa9\aef\x17\xc4j;\x94\xce\x1e`\xe9k\n\x9b\xaa\x90ߘ\x94\xbf\xb1*\x80\xb3\xea)\xc6\xe3\x15y:\xaef'L\xe53\xe5)\xb0R\xf4\xc2\xdc<\xa2\xc3D\x13\x15\xa4\xc8Ξe\x8c8 |\xc3+\x81\xe9%\xbe`կ\xc3\x17.\x99f\xaa!R\x11)\xf2\x94\fne\x98?\xa9\xf2Lcpm\xa0\xad\x15\n1n5\x8bH\x88y\xeaPx1\x06\f\xf2\xb2r>\xa7\x16\x86\xde\xe3K\xe2\x04\xf1]%\x1c\xaex\xf3,\x1b\x1a\xd91\xa5o3\x99*U:\xf3(\x1e\x7fS(\x1b\xab\x9c-\b\xec\x10;\x8e\xbf\xba\x89hm\xfdJM\f*\xe1\xca!`\xb35\xc1\xe6\xa3w\xd4ZF\xf5\x1fO\xe6\x1a\xd8pjX*)\xbb\xf9L\x1cV`\x16\tpZb\bF\x0f\x12\xd5h\xfcF\xc7\np\xccJh3Q\x17j?\xccU\xae\xa5\x04s*j\x95\xb9\x82+\xc29P\x11K\x99\xa3\xfc\xce\x0f\xb2\x00\xc1^ \xac\xeeo\xb3Ԧ3d\x1aR\xc2\x19\xa2n_\n\xaa\xd4\b\xb7oP[\xd5nbZ\x15\x97+5{n&\x83\xf9\x9967j\xfb\x9c\x02ܐW긝G\x9e\xa5Ykx\xc4\xc8+\x83\xb6Y\x82\x93\x15\xacZ\x1a\x919\\\x17\x85ےt\x136\xd3]J\vע'\xc6g2\xb4\xb8\xceZ\xea\xebq\xae\b\xae!\xc0/\xc4ju\xcea\xdd\xd7\xeeS\x92\xf9\t\x90\x88\xbb\xfbD\x86\xedtLy\x0f\x89\x85\x9f\x99`*sy\x99m\xb7\x88\xa9fǞ \xe2\xd5-V\xce\xe5])\x87d2\x05J6\xba\xc7\x11L\x92\x87\x01\xd4s\xb8\xb6,\xb3\xd4[\xb0eh\xc2L\xe7)\x853\x82}\x81\x04i\x87\"\xa7 W\x88̛\x94L\xd3)\xb4y\xeaR\xe0\xff\x00\xa9\x89Vnd\xcf\xd3\x068\xe6\x16Z\xbaco#\xf8\x94\r7\xdc|g\x88T+\\\xc0\xda3\xa8-\xf9It\xff\x00V\x1b\f\x7f0\xac&\xefR\x9c; \xae\x1c\xf9\x8d6\xbb\x8c\x0f\x93lE/\x01,:\x1b\x8d\tv\xfcG\xd0\xf2\xcc;9{\x8e\xf5\x9c\xc5\a\xf8Au\xc32\x05J\x8eW,ަR\xf8\x96\xa1MsP\xcf\ue211\xe2`G{;\x89\x9b{fxa\xeedV\x1f\xd6gS\x1d\xf5\x1b?\xb4\xb7\t\xf30\xc0<EW3u\xddM\xc0X\xeb\xf0\x1e̸\x94\x8b#\x02\xd68\x96z\xce\xe0\x04\x18p\xc5\t\xb6~\xa6V\xb9\x94\xc7jap\xdc\xe5\x9e#\xbb\xfa\x8eo\x93\xa9[\v\x1eb\xb6\x9c\x9c\xc1\x0f/\f\xb1\x86\xa0\x1ef\x80jc;'.\xed)D\xa5\xf3\xc4S\x001.>;\x96U\x8aL\x05\xf5\xa8\x88\xde&n\x94T\x89\xe2\xa6\x01\xb6\"\x81\x97\xb8\n\a\x171\x05\x0e\xa5\"\xec\x8arg\xb4\xb54\xa3\xa9\xda1\x17\xfef\x10\x17.[\xb2[\xba7\xdfr\x8d>n]9\xae\x91J\x95\x8e\x10\t\x9f\x94\xdd\xe5\xd4\xf8\x89Jҗ\x00\xa0o\x92\x15Jי\x8d\x8f\xb4ұ\x85\x8dbo\x9d\xea4d\xb1\xa8\x0f\xbc\x14\x00z\x81*\xbcq\x13\x16\xa3\xd5y\xdcJT\x05\xbd'\x00?0\xa0\x96%\xee]\xaa\xbd\xc1U5\x98p\xb7\xfbE\xbb\x1b\x8a\x8b\xda^\xfa5\xf7\x17-\xc2\xceX\xad\xc1\x0e\x7fSEK>\xe1f\x9e\x1a\x9a\x15\xf3\x03H\x19\x94\x159\xb8\x14\xaa!\xa8\xb1,\x12\xf4\xee\x0e\xafC\x88m\xfaD6\xe5q\x12\xdf\x13\v]v\xc0B7SZ\xe6V\xfa%b\xecs4K\x11\x82?s\x91\xefq\\\xc1\xf1\x1c\x90\x04\xaa\xf8\x96\x03\xfcʎ\xef\x11B\xb4s\x03\xc9\x1dD\xb1U\xef\xccm\xba a\r\xec\x9b\x1f\x82Q\xd9\\J\x84e;\x94\x02b\r\v\xf7\x13\x18\xf6'\x03\x9cL\xa5'@E\xaeа6\xd5\xd3(\xd3\xde%\xb0\xb5\xb8\x8c/\xb9\x85\xbd\x9a\x8a\r\x88`\x11r\x85w\fU\xe6\xf5Qj\xde\xe3\f\x93u\x1c\xb35t=JVr\xb7\xe2\rs\x8f0[\x91\x88F\rtͭ|\xf5)r\v\xfa@ҩ\xeed\xfd\x18,\\E\xbb!C%\xa0\xab\x98M\xd9r[R\x9c\xee\xfa\x8e\x8e\x19t0\x9ao\xe9\x14\tfQ\x02k+\x1f:\xfaĳ\x02\x9ef\xd6\\\xd0/\xead\x16\xa7IZ\xe6%Y\xaf\xb8\x15]D+\xe8\xcb7d\xabÎ\x19\x94pZ\x96\xbb\x83\x16\xec\xfdAh\xa1\xa9\xa1\x04\xcf\xe8 #\x8e\x1f\x94]t\x94,\xcd&]&\xb1\x10*\xe9r\x946\x8fܼ\x1b1M\xb9\xe6l]\xb39Zx@6\x0f\fV\x8dW\x88\xe57^!J\x94r\xe3U68\x15\xab\x98F#I\xea]{˚[y#\xaaq\xd4\x15\xc3> \xbbg\xa8@f\x91\x05\xb7\xb4\xa41\\\x90\f\x1c\x1cD+b\xb57\xcc\xd4\x19\xed\rp\x15\x8d̘\xae\x19\xb3\xb2`ܦ@\x18\x88\xa6\xea]\xd1\xc9\x1f\r5\xabc\xab\xe5\x89\\\x0eR\xbe\x96Di\x7f\xb8\b\xfc\x11n\x9d\x89\x8a\xb4?Pi0jpP\x93V\x1a\x8c\xd55Ơ\xb8J\xb64yO\xb9\v\x90\x12\xb0\x8eev\xa9\xc0\xe4\xe6\x1c˭Q\x1b0\xe2+aC\xb8\x90\fW\x1dͶS\x12\x8d\x17\x1f\xc4F\xec\xe3l\xb4\f\xc0\xc2\xf7Q\xaa\xf6\x9c\xea q^\xe5\xbbz\xa9c\x8bc\xfb\x14)\xfe\xa3\xb9\x9e\x92\xb3w\xd2`c\xccΔy\x8e\x1bL\xdeu\xb8\x83\x9a\xbf\xc7\x19\x1b\x18vC\x04\xc1(\xe2*[@\x17\xba\x8d\v\rpj%\xae\x0f$K\x05\xb9\xb5T;\"ͤ\xb6\xf7X\xe6.\xc4G\x99b\x9dF\xc7\x06`\x16%ڳ.\x9c\x19\x8d\x06Ijf\x96\xa6I\xf8N\"\xbb\xf3\x11K˸\xdb\xe30\xdbLl\x1b\xa8\xa5\xbd&REȹ;\xf1\x19\xb3\x9e!\x91\xb9\x1d\xb5\xeb\x05d\xab\x89eq\x17d@\xa6^\xa1\x1e\rJp\xac\xc1\x14\xcd\xf3\x1d\x8a\xeeQv\xb9\xe2\x0eH\x1bb\x96\xaaY.e\xe0\xeb\x9eb\xb0\xea`\x99a[\x1c\x13KX\xaa\xa1#\x868^\xd5\a\x05\xb53z\x13\x98\xb8A\xbb\xa3r\xc7|%I\x83\xc4Ȧ\xfbL\xd9[X\x8dU\xc2ů\x12\xb1ϸ\x05\xb7\x9e\xa5\xb6\xe1?\xff\xda\x00\f\x03\x01\x00\x02\x00\x03\x00\x00\x00\x10\x91w\x84\x1f\x92\xcbX\x1a\x00\xff\x00\xb9\xa4\x1f\x9bL\x93养\xfb\x06\xabi\x05%\x91KYa\x00\x84\xb6\xca\xc88\xefz\x17\xde&\xd3HLZ\xff\x00m\xc0\x83Ki- \xd7R\x17\x19\xbf\x90\xa0\xc4>\xd3h6\xa0\x00핂[h$\xad\xf7\xd6\xefM\x1c\x13\xa4\xb6T\xda\xfd\x8b\xe6\xdfoe\xb0\x03\tD\xbd\x9f\xf6ɽ\x1c/\xb3\t\x81\xb2\xd9q\xe1D\x14\xd9k|\x0f\xde\xef\xbe*:\x91\x8aT\xcak\xa4,\x94\x16\x9aU\x03\x84\x96\x13\xcdQ=\xd3\xc5&\xe4>VO\x05\xb8\x96\xbf\xfe\xdaG\xfd\xf7\xf2?\xa8\xf6d\x18s\xe9\xaeC\x11\xb7\xe7r\x9f\x1f\xed\x92f\vJm\xe0g\xbb\xbf\x0f݉3\x01\xc9J\x83x\xa984JI\x1c@ \x1fM\x1c\x9c\x97\x81\xfd\xe26\xfe\xdb\xe7\xec\x95\xc7d\x02m&?\x9f\xec\x99/\xe1\xc0JJ]\xb4\x0ex(\x9b\x02I\x87\xcbm\xa6\xc1$\x01\v\"~\x1cQ\xe2\xd5P\x896\xda\b;Qˮܘ\x00(\xa6\x8b\xa4V@\xc0\x0e\xe0\x13¹\x82nwV\x96\xa5\xfb/mɶ\xda+\x12\xf7\xa1\xed\x7f$\x86\xd1̇\xaac!\x93ќ\x95=\xa5\xf0\a\x8ep\xc7\xfac\xa2Z\xb5+\x8f\xbbL\xc6\x02\xe7\x14&\x02[}\xae\x82v\xa5O\x92ʔT*\xd9%\xa3'Q\xc7/\xac`\x1f$\xa3\x1a`~OhE\x81J\xde\xf4a\x88v\xd3A\xc0\xcd\xee\x96\xe5\xa3JG\xd5<\x16@\x99\xac\xc7Y\x13\xeaA\"\\%9y\x17\xfaM!pw\xc4kQ$\xa2\xd9s\xdb:\xb8\xe1\xfd\xdc9<A̒\x8c\xcc&|f\xb7\u05f7\xe5\x18\xe3DUS\xb2/EW\x90̵}\xa5\xecb\xc9w\xf3k\n\xfeL\xfeH\xaai&\x01NB\xc9駏\xf2\xc6\xde6\\\x1b\xa9\xee\x91\x13/3\x9c\xe4\xed\xb6Y\xb9\x11]/\xaa\xa8\xb7\xae\xe6'\x14Q\xa8F\xe1\xe5\xec\xaa\xf2!S\xa1a\xa9\x18\x8c\xdfZ\x9c\x1f\\;S\xb0qS\xd6\v\x7f\x9f\xa6\x80\x9bl0\xd0\xcf\f撢O!*\xd9t\x99\x03\x13\xb4y\xa2\xbc\xec\x89/\xed\xcd4\xac\x04vH\xb3\x93\x0f\xf2?3%\x86\x10>ա\x10'&\xff\x00.\x1a\x17e\x01\xea\xac\xd0\xf2\"\a$\x04\x00\xc7\x7fq\x94\x01e\xd6g\x8a\x8d\xafi\x83\x85\x06ĒH\x03\xf9CnYZB\xb9.\x96\xb7-|\x045\xc0jI$\a\xb5\xe2\t\xaa \xed\xa5B\xf5\xa6\x16H焖\xe5@\x00\x0f\x92\xd2\xe6>\x96X\xaa}\xadR3Z\xcd\xea̓R\x84\x9bI\x1a\x00\xb1\xf7\x93\r\x1fF\xc0ڱ\"tM1\xd9g1\x93K\xa0\xfd\xa7\x82\x9b\xb3'\xccM\x8b$\xef\xd3~\xb3]!3\xd4\xdd\xf2\xc9SP\x03[\x8c\xa3\xacJ)+\xb6K;\xea\x90h\x05\x8cŊ\x12\x04\x1f\xd4\xc3m\xa8Gp+%\x91\xc6\xff\x00\xd6Pè'-\xdc9fj\xe5\xcfF8\xe2r\xb5(q\xa9\x1c\xbf\xb8\x03*\xa8J;{\rQD\xafUʬ\xac\xa6\x91ʘ+s\x1b\xc8m\xa6\U000fb11by\xce\xecU{<\x91\x04\x92\x97R\x10o\xaf\xb0N\xc9c\xae\xe1|>0v\xee\x8bD\xad\xc1l\xaa\xdcŵ\xa6w\xc9Z/P\x13D\xd0Y,N\xd9dv\xb1#V\xa0\xd7I\xb4\xd9\xde#l\x85\xd0w\x1f\xa1\x13\xedfɩ\xb7\x03\x91*C\xf1<\xf0f\xd9eU\xccI\x0fe\xaa\x86T'\xebؔ]H\xc4\xd7\xe0\x17\x80\x15.]Ɨ̌.\xae\xcffvE\"\xd0\xf4\xc4\x16\\\xc8?\x96\xe5S(f\xfc\x7f\xd6\xfb\xb3\xe2V\xf6\v\xbbh$ʭɰH\xff\x00\xff\xc4\x00%\x11\x01\x01\x01\x00\x03\x00\x02\x03\x01\x01\x01\x00\x03\x01\x00\x00\x01\x00\x11\x10!1AQ 0aq@\x81P\x91\xa1\xb1\xff\xda\x00\b\x01\x03\x01\x01?\x10\xff\x00\xb0a\x86\xdbm\xb7\xfe\x03O/\x9eه\\\x9dݱ\x7f\x8f\xca\xcf\xfaF\xd8m\xb6\xdbm\xe1\xb6\xdbo\xee\xdf\xfe\aa\xe3m\xb7\xf0\xdbm\xe3m\xfd;o\xe0\xcb,\xff\x00\xb3m\xe7m\xe7m\xe1D\xc0\x9d[m\xb6\xdbm\xb6\xdb\xc3m\x97\xf2\xcb,\xb3\xf4g\xfc\xd8\xd8څ\xf3fK`6\xdbm\xb6\xdbm\xb6\xcbm\xb6\xf3\x9f\xa1L\x9e\xa4\x99\xfa\xf2իq\xc0n.~\xef\xe9\xce\x7f\x8b~\x96~\xa2j\xd8\xed\xc9c\xb8\xbd\x16\x1eq\xb6\xdb6\xdb\xff\x00\x03t\x96\x8c\x84\xc6\xc6#w\xfa\x9f\xea;{b\xc1\xec\xa3\xe2-\xf2cûV\xb7v6\xb8\aV\xcf\xc8\xe9\x95\xf6Z\xef\x0f\xd3\xfeV\x13\xa2[\xab\xbbl|Ƽ\x96ڵv\x85\xef%\x9e\xf0\xff\x00w\xf1\x11\xe6\x16\x90F\xd8\xfd_\xc2\xfe<\x1f\xda\xfe\xde!\xfc\x87͉\xdf\v\xc6\x1f\xeaL\xff\x00\x8f\xac\x98Hęٺb\x03i\t\xf0\xbb\xbc\bO\xff\x00V\xdf\xc6\xdf\xd4\x7f\xf2Y\xda?\xfa\x8c\x9d\x01\xff\x00\x928\xb3y\xdcn\xf5\x7f\x97\xc1\xe2\xd6٩ğ\xcf\x19\xb6\xdbm\x88\v\x0e\vL\x98d\xf1\xd7FQ\xef\xea\xcf\xc7,\x92{x@1\xb3gŜ\xe8:\xb7\xb31\xe4\x10\xc3^\xe4\x11\xcd?\xfbY;\xff\x00\xf2\xde믖.\x9e0\a\tL\xd9]\x19\x80\xda\xce\xff\x00\x05\x92\xe0\x89\xcc\xc38#\x13\x19O\xf8<K\xbb\xc8\xdd_\x1c3\xe6\xf3x\x90v\x8cN\xa0\x1d\xdd\xcf\xd5\xd9\xd1!v\xf9I#\xd3\xc2\t\xdcB\x1d\xdb\x1b\x8d\x89\xd7\xe3\xae4\x84\x96e\xe3\x0f.\xbcoY`i\xfbƷ\xcf#n\x92\xbc\xb4\xde/\x10\xe4\x1eZ\x1bgFp#\x10^\xad\x1e\xb0\xb3Fp\xfb\x8c8\x93\xa3\xb97\xa2\r\xc3&E\x8b$O[\x012\x17\xa4h\xe1g\x82\x10\xf6M㥮ę\xd7\xed\xe8OO\xe1\x13\xc0i\x1d٤u}\x10\xef\x18WA\xfa\x93{\x16>\v^\xe0uђ\x1d\xb1\xed\xbd[\xf7`\xbeG\x8dб\xba\x86\xf9iݟM\x8bD?\x16\xe3\x0f\xcf:\xfa\xbf\x94\"\xed\xf5\xbayl\xabK\xe7\xf9\x93?ZoWi\x1bdj\x17\x9bF[\xb2\x03\xc2\xdc\xc4\xf6\xed\xd2\xcc\xf2\xf3\xb2\xee\x1e\x8b\x00t\xbe\xc3\x1f\xc4\x13\xd2\xc1\v\x01\xec\xb9w\xd2\x11\x87\x91\xd32S\xb1\xc6\x06<\xbf\x8ctɶ\f!\x85\xfd\xad}\xdb\xc029b\xb9!\xec9h1\xb6bC\xf4\x1fv\xb9\u05cb\xb1w3\x027I\xb3\xb9\xfc #\xea+\xed\xbe\x90\xccj\x0e\xf2\xf0\xa3\xa8ɾK\xc1O\x96\x8e\xbed\xf4\x92\x1a\xd8\xeb$\x0e\xbb\xd88\x85\xfdq\x1eBor|\"\xf9$\x1flf\x9co\x1b\x92@ڽ\xda\x1c}\x91A^\x1f\x86Fg\xe8|\xb3\x9f\x9c\x85\x92\x8e\xa4\x1a\xdd\x05\xe4:\xec47\xa6\xf4\xb5\x84\x93\xd44;\xe5\xa9z\x9b;,\xb0\x1e3\xfd\xcf\xf7c\xf3\x00@\x1e\x16\x13\xc8\x05?Q\xe8f;\xf8\x94\xb6\fr\x1f6z\x80\xf9hc/6xx\x05\xac\x04\x9fA\x99F\x13\x13>7\x9f\xb0v*\x83\x03\xe5\x1br\x17M\xf1\xc7\xde-N\x9b\vO,=MtۻK\x13U\xf1km\xad\xdaݛ\xb8\xbe\xef_\xc8tl\xe02\xf9\x91}\x8b\x0f\xb2\x1d\xf9\xc7d\xf5f\xc9\x0e\xf9\xd7\xd4;\xe5\xa8s%\xf5\xda\xdfF\xdfLP\xfc\xc797uS\xd7e\xbd[\x86\xdf嶼B\a\xd2\x1f\xbf\xff\x00 \xcb\x1cH<x\xe7r\xefo\a\x05\x8e\xbb.\x1b!\xf3tr\x0e\x00t\x87\x1d\x94;<\x8cC\xeaBC\xcb\vIR\xc9\xe1k\xe2\xfeֿsz\x92vZ\xac.\x04\x95\xef揄\xa98Ht-\xba\x8d(\xeaK\xe2\xdbg!\x06\xbd±m\x03/!\xe7\x0f\x96^Ib\xed\xc0\xf5\x93w\x041\x93\b\xa6|\xcf\x0e\xed36'\xca\xda\xe1(1\xbc\xfb;\xc0쥵\x1fՋ'G\xc7\rZۡ\b\x1e\xcf\xc85\xe1\x00z\xbb\xf1\xde^\xd6zK\xdd/\x1c^ȏ%\x9f\xed\x83\xf20\xf5\x9dƘ\x9e2uo\xdc\xfd\xd7\xf6\xbe\f\xfd\x16\xfe\b\x7f%x˻\xbc8\x03\xa7\xb9\x18;\xbeC\xa9] z\xb6\x060?\x16\xf5\xc3\xd6\xce\xd9\x19b\xd8l8X~\x83\xd8\xe8H\xb5\xba\xf7\x1e\xf1\x81\xd6lK(\x17\x99u\x97\x89\x1f$-\xc8\xe9\x91\xfe\xba\xb1\xbdL\xba\x9f\x91\xb4\xba\xc3\xfb=,\x1dd\xf7\xe1\"\x10B%\xbfp\x9b\x19\r\xe9 %\xa4\xf0Z=XC\xe7a\xe4?\b'\x96\xb7\xb1)\x9e[\x19g\v\xf4\x1dO\xe8Ǎulǌ\xe4,\x1b\xe7=\xc0\xc0R\x1aF\xc0\x95kk\xb5\x91\xbb)\xed`\xb2\a\xc8\x0fp\x12]\xc3\x04v\xd9X^e\xe0\xc8\xfa@g\xe9 k/V*\xe90\t\xab\xe8\xbat\xba\x11\xca\a\xb8\xfa\x91F\xc3:\x99y\xcf\xc7\xe2,=\x8c\xd7m\xcf\x18\xc8\xf92\xd9\xdd\xd8\xc0\xb6\x11\xe79b\xd3 {\f\x85\xfc\x92\xf0\x92C\x1dÁ<o\xad\xd4D̀\xbdٝA\xc2\xea\x9f Py-\x01y)\xe2\xde'\xc6N\xef\xf2\xf1\x92Wd\xa7\x90\xf7\x18\xdas\xd4\xcb\xf1\x7f\a\x85\x1e\xa1\xadg\xa7&x\xa2\xdf\xeaH\xeb\xde;\x94\x1cg\xb9mr\xfe\x97\xcaB\xf1\f\x1f\x12\x8d/W{\x13\xff\x00`F\xe6\xff\x00#X|\xf9 ?>B\xf2\x11\xe9l\xff\x00\xc8\t/R\xf9\x91\x99u m֒\x1b\x8c5A\x8f\xa7\xccV\x86HM\bsM\x88\x86a\xf6\xdf\x00,5\xbb.a\xef\v\xbf\xd6\xfb\xb4Ɏ\x89\x1b\xb8ؤ>L~Q>\x9e\xad\xba\"\xef\xeaM{ Dl\x9a\t/\x84~\f\x9c\x93ܫ`\xb1\xd0G\xb1Ή\x1d\x82\x15\x13\\\xf8\x1bI\x8f\xcb\xe60\x19qXԏ\xb8<b\xc7\xe1d\x9eJj\b9\xc3\xd5bn\x8f\x02W\xe6W\xd6\xf6\x19\f%\x87\xe3\xe7\x19\x7f\x0fϣ$\x1bS\xf4}\xc7e\xeb&i\x9e\xc6ɝBowc\x1a\xab\xb1\xafp\x82.A\xf33\x05%\x82\xf8\x90\a\xff\x00\xb0\x8f\\\x90\xf5\aY\ny(fL\xfcK\xdd\xd4'\xd4@\x13\x1a\xf1\x9e\xac<\x94\xf8\xb0ud\xf6S\xeb\xf9\xbdFd>?G\x9cg'\x19\x0f\x00\xdd\xf6\xe9\x17s\xbf1\xf9I\xd6\xf5\xc3xKb7\x8d\x97I\x83_\x80\x97a&\xfd-\xfc\x82KW\v]m\x87\xb2\xf8\x11\x17K\xab7\xf1!\x9fe\xde\x16YɖYj\x83\x19kz\x90\xab7\x8c\xe1\xe3?\x10\x8ez\x8e\x00M\xf203\xb9\xed\xd1,N\x17\xc9r\x9bW\x90\xdd(Y;>\x1e\xb3\x87B\x00\x9a:\x12\x9f\x7fNYe\x96Y\x19d\x16YdA\xb0o\xa5\xa1\x8d\x8d\x93\xc7\xe1\xdf\x19\xc7W\xc4~*G\xe6\x13\x18\x9d(۟\xff\x00\v\x1f\xad\x83徒\xd7\xc0_\xd2\xdfіYe\x90Y\x96p\xc8\"&\xc0\xc8-]-\x80\xc0N\xf8=Ḵ\xb3\xf0\x16c\x80\xfcO\xc1\x85\x16\xf4˯\xcf8e\x96Y\xc1|E\x8bdYwƐ\x8c\xbe\x88Ol|\xdd<\x96\xb5d\x05\xe5\xb6L\xf9\xf8\xe5\xbdg/\x19\xcb\xc0\x9d\x92{\xec\x97$\xfdZue\x96YfA%\x9cdjIa\xc6N}\xc1/\xe4\xb5\t\x9c2\xc6\x11f\xdd'.\xb3˦ɓ\x91\xcb\x7f\x1f\xef\x1e\xfe90\x92L\xb3H:ٛ6\xfe,\xb0\x83,\xba\x80\x93<\x90;l\xfd\xc0<\x95\xf5.\xd6\xc6\x13\xc3|\r\xf9fs\xed\xd9{g&\x8c\xf7)\xf1?\x8eOែ\xf1\x9cF\xb3\xa3K{\xdc\x10\xd9dL뎏f\xb1\xf5/\u0fec\xab\xeb\a\xd1\x11\xab\xa7\xb0,\xb0\x9c\xf8\xe0\xb7\xe6\xd8Kx\v\xbeW\x87\xbe\x1f\xcd#\x8c\xb7\xf0\v\xcb\xd60\xe3bɷ\xa4\x9e\xd6\xfd\xa0\xed,rޤ}\xd8|\x16\xbe\xad\xa3\xef\x05\x02\xe8\x86mfC=\xb7\xfb\xfas\x8c\x928\x0fѿ\x8em\x9fsl\xdbd)*H\xa4\"\x16M\xf6\xc7͚\xea\xf9\xeaݵ\u07b8m\xe0\xb3\xf0\xef\xf0؈>l\xb7\x83\x8c\x9f.\x9e[|~\xac\xe7\x0f\x8b\xe6ў\xaf\x8e2\xf2~WD\xdf\xe4\xe2n\xc98\xcf\xc0\x8e\xef:\xb2N\xa78̶Q\xee\xfe\xdbuƶYd\xff\x008\xdew\x7fK|Y<\xe8\xde\xf1\xec\xf0\x99\x05\xec\xd9g\x19a\xb3\x97\x85\x8fmq\xb3\xed\xb6\xf1ݝOM\x8f\b>]ٳ\xbeݧG\xf1a\xe3\xceK\xcb>\x7f\x06\xd6,\xe3,x\xf2\xf6\xf9\x91c\x0fxޭ\xcb~\xae\xe1ȳ{\x88ejp\xf1\x8dջ-\x9bk\x91\x8c\xfbxD\v\xfb0\xe4\xfb#\r\xbc{dq\x9cyo\r\xed\x87;.\xdf헰\xf09\xe4*\xc0\xfb\xb0\xdb-\x8c\xb2z\x86K\xe6\xea}\x9b[m\xb7\xab\xb4\x8d\xd8\xfa\x92K/z\xb2\xcc\xee\xdd2\rdx\xdd:\xb2\xce?\xce\x0e;'\x9d\x8e\xe4\xc2Y{3\x03\xebh\xfbfŝ[\xd6p<2\xd8r\xf6\xff\x00-\x97\xbe\xa1\xbb\xf9\xb6X\x16x\x0e\xb8jI\xf3.uoR>\xdd7\xf9\f\xac3\xfc\xb5\xe3\xe2\xdf\xcb,\x9e2?\x03\x81\xd2̛\xe3\x8c\xe37\x8c\x8b8\xc2}\xe4\x16\xf6\xc3\xee\xf6<\xbaȾg7\xae2\f8ϩR\xf8\xbb\xb3\xf1=\x9b\xae\x18\xb6\xcb\xd8c\xbe<\xb2\xf6\xcb,d\x87-\x85\xf3\x82\xdc2;\xe9\x83/\x9e\x16\xbcf\xf7if\xf1\xf3n\x1d_\xdb>y\xff\x00b\xc9{\xd9v'\x1b\xbe5&u\xc7vu\xc6l\x9c\x1eɏ\xe0\xbc\a,I\x90\xed\xb7\xcckx\xe5\xe4}έ\xb7\xb9\xb6ӏ^\x03\xae\x16;,\xfb\x9f\xe4>\xa7\xfb\x1eY\xf7\x1b\xf3\xc6FY'\x02xj\xf5nw\x1f|1\x9c\xe4\xc7\xe3\xe5\xb6\xf1\xb7\xff\xc4\x00#\x11\x00\x03\x00\x02\x02\x02\x03\x00\x03\x01\x00\x00\x00\x00\x00\x00\x00\x01\x11!1\x10A Q0aq@\x81\x91\xa1\xff\xda\x00\b\x01\x02\x01\x01?\x10\xf9\xe1>F\x86\x87\xc0\xf8!<g\x94'\x887\xa1\xb6P\x9f\x82G\xf3\x9a \xd1\x06\x88NI\xc9\bO\x8d\xa2q\xf6_\xe7A\xa2\r\x10\x9e0\x84!>(N[)~\v\xfcI\xe1\t\xcc \xedv.\x93\xc9\bB\x10\x84!8B\x13ʔ\xa5)\x7f\x90\xfc*\x1a\x10A~ђ\xd6\xc8B\x10\x84!\bB\x13\xc2\xfc\n\xe8Ρ;\xf2TG\x13\xe0\xa4b\xfd\x1fO;\xfa'\xb1^ƸB\xc4\u061c\x82<\x90\x87\xc4'3\xe3^HR\x12\xc1N\x17\\U\xechp\x83\xf0{\x10\xfe\x9c\x1b4/\xb0\xdf|Q\x92H#\x8a\x88)\x92Hl\xbc>^:\x11\xa2 \x9c{\x7f\x8a\xb0?\"\xa29D,>\xca\x1aKbB\bvT\x1a\x84\x8f\\?\x1c h\x19D4%v}\x87\xdb\xc0\xfdEt\x86w\xa1}\xf9b\x82t\x8a\x9b'\t\xb6/ľ\x18\xd8\xe3!\xa6\x9c\xe8I\x80\xd0Ȕ>\x81\xa2\xdb\x19\xec(Α\xe8~\xb2\x9a\xa6\xe4\x15!\xcba5\x81/dBLH\x91\xf0*\xfc@\x8cd(\xcb\x1e\x18\xe2(.\x13\x7f\x814\xd6>;\xe3E\x96i\rc\x1b\xa1-[\xc9YJ#!\x10Bc\xd8\xdcTc\xa0j{!\x8d\xff\x00\x83/\f\xb8\f\xf4\x87\x8e\x06І\x96\x10I\x98`2\x10\x9a\xc8\xfc\x13\x14ɐH\xd8AqM\xf3\x94\xea\x12\xff\x00\x81\xbd5\xb9\xa6N\xf9=\x1b\x9b\xf0\x03b\x1d\xdb\x04]v`ȌI28\xc0¬}\x1f\xa5\x8bGJe\"\x8e?\x19\xef\x88ƞ\xc4!!!7\xcaf\x94\xc3\xf9ۈL.^#\x15\x12q\xda\x10\xb6lnJQz!\x06\x9b\xbc5v\x15V*\xd2\x1c`\x1bu\xe0l\x8c.\x81ŖYB\xe8$\xa2m\x8e\x14\xd8s\xd0ш\xe0i\xa1!sOBoadȌ\x18\x9d_.\xc3\xca\xe5\xf0YAp\xdc0,bTa\xb2\xe1F\x86\x82C\x05o\x04\xb1\xfeǗj1\x84\x87\xad\x14Ȏ\x8f\xb4\x82\xfd\xa6N\x18\xa0\xc0n\x84oDt\x94xɴ>\x9c\xd5\xec\xfb\b?\x10\xbe\xc8%#1\xc4[\xf1\xec=\x1adjq\xac\x1f0\xa0\xc9!\x1a\x12ذ\xd2C7hD\x90\x8c\xd6\x06\x99\x8a)$\x9a\xbf\x83&\xae\x87\xba\xbf\xe1l\xc2`\xd58uҬ\x98\xfb\xe0l֘\x03/\xfa&\x99\xd9\xf6\x86\xea\x1a\x10\r[ȑ\xd1\x1e\x88\x8a6\x84\x94\x89D\x06\xa9\x1bաIx\x8a\xba\xf8\x1f\xa2.!\x11\x99\x95\x14\x18Ւ\x16JÄ\x16F\x91\x8ah#1\xb1\xb5\xd4I\xbcRZ,\xc0\xa3bN\v\xb8ɍ\x11\x90\xe3q\x16\xea\x15\xcb\x13\xd1\r\xef\x899\xc3N`M\xad\x8c\xeafQV3\xfb!\b\x86\x91\x05%\x827\xabB\x10t\x90僰\x04\x8e\xbb~\x04)x鱵iVD4\r\x9e6\xc7A\x986\x8d\rb\xaa\xf41\x86\xc6*\xbd\xeb\xe8j\r\xb1\x17\"\x90ٴ/\xa0\x90\xc3Clm\xbe\xca\xd1\x0e\x8d\xe3\x02w#y\x82\xb0쩪6\x9e\x18\x90O\xa3\"Xd\x9c\t(\xb1\xe0\xd0\xe8g\x81c=B\x82O\xd7\xec\x83?\xf4U\xe8b\xf3Ls\x06\xa1^\x05\x91އ\xa8oA\v\x02HAyd\x87\x81\xd7a\xfa>\x80\xe3(\xb6&\x12\xf6DDD(\x91\x8e\xc6R\x99H6\x98\xcd`%BBX\x8cA\xa9}\xf0\xf1dSѓ\xc1\n\x91.ƞ\xc9f\xcdh\n+\x90\xf7\x1e\x9e\xc7\x02\xf2\xd2sD<\x82\xe2\b>`كc\xbd1\xaf\xd8\xcf\xd1\x06\bl\x86!\xe0`\xc9\xc2q)\x80\xd4\xe1\xebHh\xa0\x96\xc1\x9e\x8d\xa1\xb5\x06\xf2%x\x17\x10\xc3\x1e\xcf\xfa((\xca\x1b$\x12\xc8\xfb\xb3\xd8$t}\x02\x1eP\xa6\xa3\x12D\x1e\xd6ةT\x8b\xe4\x93\xc9\"\xa2\x89\xc1\x00\xd7ف\xb5\x1b)x0Q:\xc1\xb4\x90\x86\xb1\xc1\xdeS\xe2ǁ`e\x84\x88|\x9eU\x96\x84\xdc\x12d\xc3#7 \xd6Ѝe\xb3\x02eCt\b\xa4c$\xb2@\xdb\xd1\\3\xef\x83A\x14\x85Q\xbe\xb2R\xf2n!Gan\xd0\xcc\b\xcb\xc2i\x83Ќj\xe0\xcbX1H\x82\xc6C}\\\v\xd8\xf1\x89\xd78LN\xa2]\xc9\xecOli-\x91t.\x05\x14/\x14D:ђ7K&\bX\xb7\xb1.\xd9\x04\x98((&:$\xc82\x94\xc9\xfc\r\x11\xb0!(\x8aT\xb4'ŔB\xa8\xcc\xf2j)8\x1b\xc1\x83\x1c#\x16\xc11e\x0ev'\xd5\n\xe8o\xe8\xacM\xb2ͱ\"y\x15\xb4=Tb\xaa\x86\xbe\xc4\xea!#\x17\r\xa1{\x0f\xb1\x0fH\xc1T?VS'\fV\xecHw\x95\xef\xe0\xcd\xc1,\xbeGU\xff\x001\xe06\\^/3\xc7\rQ\x93hy\x86)2z\xc4\xc1\xbf\xa1U\x83(kV\x8e\xa1\xaaA\x8fb&3\r\n\x11\nHƪ\x88f\x8d\x18\xddi\v\xd8\xc3(\xd0\x1d\x12g\x18ш?\xa6#\x01k\xa3\xe1\x897\xa1\xb7lF\x03\xdc\xf0\x94\xf8\x13\xb41\xeah荴\x88lqL\xd6\x06\xb4%T\x12\xecj\xf1D`\xbfC1\x86\x98\x96\v\x18H\x1aN\r\n(̅\xe9\xd3\x1d\x80\xcb#cT\x85\xb6?/bKdU\rT\xecd\xb2d\x1a\xd83Spi\xec{\x10ȴX\x88L\xfc8\x14SYD\x11!>\xc2\x17\x14%\x91\xbfHE\xfb!\x83\xaa\xb8\x1bí\xb2yCU\x91\xaaS\x14[\xe2n3\x865\xb1ml\x92\bx}\x8eJ\xfe\x84\x8b#\xc8RL\x17&Qm?B\xc4\xdfC<1\x92\r\xee\x06\xf7\x90\xe3\x8c\x1bA\xa7n\x9a\x13\x1d\x9be\x81\xc2\xe3\xe1|-BN\xc1\x8a\x18\xcbL\x88\xfc\x19\xa4+He\x18b\x8d\xf1\x8fdZ\xc7MDD\xa3\xa3\x8b-\aEQ\xbc\x01\xfaƍ\xa4\x99\x1a\xb7I\x8f\xa1\xeb\fJ\xab1{\x11\b\x83o+е\x1a\x1b)\x0e\xc12b\x0fg\x87\x12\xe4\x96\x04ę\xa2\x9d\xbc\t\x83\xd86#ѠBM\rF\xacJ\xc9\xf2k\x8a\x80\xb6 `\x89\xecp\x88V\xa4,\x8d@\x89\xac\x88\xac\xfa\x12p-Sj\xc14\x16\x06\x91Ej[Lf\x7f钳*\x13\xb1\xeaȐ\xe9\x1e\xc7\aؗ\x10\xb5E\xd4%\xa70u\xb1'\xd8ӭR\xe0\x91\xa5\xe1y\xa3\x84ǿ+\xe2\x9a\x1a{\x06\xb4.\t\x8b\x8c\xe8\xc1\xa6\x9eED\xfd\x8d9\xe8\\Bp\xa7\x92hbY\x96$+\x11\x15\x94\xdb\t\x8fHE\xd6,\xcb\x03 \x92\x8c\xcbg\x8b\x1a\xf4.+\xe2\xf0\xa5)I*\x8c\xb1\x18\x81\n,\xf0_\x03\xe1\vV\"tt4kȑ\xa8\xd8\xf0J5l\x81 \xd2l\xb7\x84\xa2\xd0\vy\xc1\xd5a\xb6\xb5-\x96\xa2V\x97\xc3JR\x94\xa3\xac\xa5)K\xc3p\xc3\x19\x12OP\x9b\x94t\xf2\xfe'\xcbE\x11\xbaC\x9a\x8cb^\x82SHG\xd22\x85~\x90\xbb\x7f\xe0\x8e\xdb>\x92/\x86\x94\xa5\x1b-/#\r\x8eE1Q&Dcm\x0e\x98\x9a\x1alKiK\xcd\xe5\xf0ߋX\x11\xb2c\x84\xec$\xd0\\\xf9Ҕ\xa5/\f\xecd$Q\x94\xc7\x11\xfa\x1ahJ\xa3$_F[\x12\x88\xf4Q\xb6,\x90\xa2\x17\x86\x8aL\xdeW\x92\x1ax&,a\x90O\xec\x86JR\x94\xa3~\x8a^)\r\x89\xd9X\x8a+\xe8n\xcb\xed\x8d}\x8d\xae\x05)\x03FT\x8c\x85LݙE\x11y\x94K\u008b\xd7\x19\xf1\xa2\xa3`\xa3Rǁ\xb5`\x84X}\x8aVR\x99\x19\x89݊\xbd\v\xd46{\x12\xfb\x11G\xa2\x8d\x11\x04\x99\x0ev'yX0\xcd\x17\x97\x1a\x10\x93\xec^7\xc6\xf1xK\x8aj4F\x0e!\x12\xa1l\xbd\x94\xa3\x17<WнE\xfb\x12\xf6ϠI-$?f?a\x1d\n\xf4W\n\xe0\xab\xdf\f\x9d\x10i\x93\x86\xfc\x12 \xb0Qy\xa1\xf3<\x19\xbe\x18j\xa8WN1\xc78dM\xe8c\xc1*\xa8\x96Ek\xa2\xbbg\xd8H~\xa3f\x86\xc6XЍ\xa8а\x8f\xce_\x95\xe2\x89\xe0|7\xec^s\xca\xfa\xe5\x13\x86\x93\x12M\v\x18@\xfe\xc66&\x96\x8a豓\xac\x92\x11H\xf8D\xf3Ǆ\x1f\x17\xaf\a\xcaٖ\xc8w\xe19\xc9\x05\xaf\v\xd3\xe2N;\xe3F_\f\xb1\x1f\xa2\xa2\x18bw\x8b\xe0\xcb\r\xe4\xa2y撉4\x8f\xa2\x192Ɛ\xdc)X\x9es\xc4\xe6L\xf0\xbc{\xf0\xa2;\xe2C\\k\x86d6h\\S%+\x98\x156\xca\xd6\n\x91IE\xa2s\x81<\xe0YEO\x84\xe6\xc7\v\f\x0f\x02\x8f\xc5\rz\x11\xbf\r\x9f\\w\xc2\xc9\x06^)xٮ\x13Hu\xe8\x82P\x94\x9eف\xab\xc5\x18\xd6\x06\x88\x9ex^\xb8\xa6z$\x12E\x84Tu\v(\xdb\x1f\xa1\x86\xb0!\xaa-\t\xa1\xf8Q\xf1x\xde\xc9\xc6\xcd\x14d \x91\xf8Zhk\x86\xaeƒ\x1bz+\x85\x84\xc8\xfe\x8a,\x8d\t\x9d\x19\xa2Ј\x88HL\xec\xef#\x8d\rf\x89\x89\xa1\xb3Y)i#\xa3qA5ƞJ^\x12}\xf0\xf8\x89\x8b\xc1\xe0\xdb\x11>\x10\x86\xfaDkF\x86\\\xf2\xd7\nA\xaah\x97d\x82Udh\xc7D\x12\x1e\v\xc3n\xf0\x89\x89\xf4%rL\x98є~\x8d\t!\xa6/\xb1\xa5\xc6\xd98υ(\xb8\xa6Ky|H\xcbDvB\xa5ŜQ\x97\x8aŬ\xf2\xdaF\x10\xdb\xf4hl\xcd\x19\xcc\n\xcc\xf1F\xeb\xcf\r\xaeĩق\xf8\xbd\t\x99\xe1\f\x8545\xce\xf8\xd1JT&5H53\xc3#c\xc6QN\xb8H\xb8\xd6\b\xcb\xc7D\xf6k\f\xabG\xe8\xcf\xc1\xf0\x94PJ!\x8a\xa3\x04#\xb1;\x93\x06\x0e\xf8\xb8\x13\xe1\xe8N\xf8$a\r\x8f\x8dxC\t\x0e-\x12\xe4\x94~\x85á`\x84|^\r\x93\xac\x89\x183\xf0\xfd\x1b\xf6'\xd8\xcb莍\"⍔Llh\xa3\x89LDO\x03\xce\bh\xa2\xd9\xd1D\xf8\xd7\fНW\x88Q\xb8\xa9\xff\xc4\x00'\x10\x01\x00\x02\x02\x02\x02\x02\x02\x03\x01\x01\x01\x01\x00\x00\x00\x01\x00\x11!1AQaq\x81\x91\xa1\xb1\xc1\xd1\xf0\xe1\x10\xf1 \xff\xda\x00\b\x01\x01\x00\x01?\x10@Ne\xb0\xb6\xf8\x85\xf1u\x98\x9a2:\x97\x90K\xa9w\xd2X\xad0Y\xc4T\x14\xcc9Yz\x8a\xb6\xe9\xf7\b\xbb\x94PX\xa4\x98H;\xcc\x02 \x98\x8e\xd8{7\x13\xd9E\xc4\xe8\xebs5dk;&X\x15\x13{\xa6\v\x9b\x98\x98\xdd\xd4\xf2f!s\x88\x95\xb3\x98\xc1j\x99㏄J\x98\x9a\x82\xea\x105,3\x03W)q\x1aj\x12Ȇ\xcc]\xe0\x96\n\xa9h\x10\xc4/\x11\x91\x8fQm\x0e\x98A \xbc\xb5.\x88\x17\x86\xe2w> \x1935Ao0]ƙ\x80E22DZ\x15\x9b\x89\xee\xe1\xdc+`\xb9@.=E\xd6E\xb5\xe7\x88s\x18%iC5c\xa8\xe3a\xe7\x11\xa9L\xba\x8dNC\x06\x88c\xc4]G\xc4WW\b\x18.<\xccE\x8cA\xed\\b\x1c\t\xb1㨄\xf0@\x1bm\x88\xa8\x82\xa7\x13re\x88pLn\xc6[eT3u/\xd1\x1ct\xca\x12\xe7\x12Ǹ\xac\xa2\xb4\x12ɼdp\x95\v`\xae'\x82Q\x19e\x81\x1c\xca\x06%S\b\xb9!8Ix`\x8e\xc2\x06\x15\x98\x05\b\x95\x14ܮ\x15\n\xe0\xbe%\xfa\xd4\r\xb2\x8c\xb5\xb8#\xe6\t\xb0Ts\x13*\xd4\x16\x19\xb2\xdf=2ٱ\xdc\xc0\x98\xb9p\fw\x03\x11\x87\x88\x9bLs\v(\xee1JS\xcc\x00\x1b\x99\xc37\x17\x85\x11\x82\xde\xe6>0˚nP\x97q*\xd0.\x8ea\xe1\x15*O\x99AG\x98w\xa9\x7f\xfa,\xa5s\f\xa72\xda\xc4*\xe26f<\rC\x16P\xb1\aļUM\x86V\x82\fĤ\xf2\x8d\x98\xcc.\xb5\f\x86 \xc4P\xb0\x85噂\xde \xd7P\x89y%\xbaf\x00P\xcb\x06Ŋ\xc8\x1a\x807ͩ\x980\xc5B\xaa\xf7\x04\xb8\x17\xe6\x05\xad=\xcd\xc3&\xa5\b0\xd4\xd80x\x8e\x81S\x88\xe3!\xe0\x83mt~`[T\xdb0\x9a\x84\xc6F\x8e\b\xd0\xd3L\x11\x81E\xc3a\xcfs\x19[\x00m\x14\xab\xa9{\xa1*]\xb3SE\x8a \x85|\b\xb2\xb7\x81m\xa06\x1b\x81\xe2\xa1\x1eBPQS/(+\b\x1bJ\x94:\x8d`ū\x8bN\xa2\xd3A\xff\x00\x856\xa0\b\xf2\x19\x95\x83\xd1\x15\xd5\x03\xd6\x03\x99F\x9306\x95\x86\xd1\x05\xd4)\x97\x98\n\xd4\xc8Tβ\x88ȜJ\x18\x12\xf6\x8d\xf5(\x15U\a\f\xf9I`\x00\x0f\x10\xbb\x9dDfU\u0530}\x94n\xb6AB\x05`!,q\x01 \x8cj\xa2\x82\x81n\x02P\xb6>\bP\x16Z\x9bi\x93P\x10\xad\xe2Y\xd4\xcc\x04T\xaf\x10W@\xe1\x17`\x1d\xca\x1bdj\x80k\x98D\x05ː\bHF\xd8U\x88_\x98ҍK\xdaj\x05\a\xda]\x97\x12\xe9\xc2\xf5(ebQP\xe6 `S\x1eC$,a\v\x92\xc5\f\xc1\x803\x10)f1E\x80lo\xc4U ~\xac\xa50<\\\xbd\xdb\xceǸ\f܆\xc9|N\x04\x18\xba\xcck\xa9\x83\x02|\x90\xb6\x11a\xbc@\xe1\xeeU\xc32\xb0\f!m\xa4M\x12\x13\f3\xe2+@\xb7ls\x03\x8a\xcf\x10\x90Eu\xe2fU\x8c\xebp\x1e\xdeF\x01\xd9Y\xcc^\xb8\xf9\x86\xd3+\x84\xc4\xcaz\x800\x97G$9\xc5E\xe4F\x8a-}L\xb6\f*\xd1A\xb6\x00\xa4}J\r\xab\xcc?*za\x06h\xbd\xc16\xa5\xc7$\xb6pQ\x96\"S\x9c\xe6Z/\fS\x0e\x18ez\xf5\x013V\xa2\x15\xab\x96\xb1H\xc2\xf0(\x8dAG\x98\xe8\xeb6\x85=E\x95\x10\x96\xe5\x10\x03FP\xb4\xfdT\xb1{\x8cWhg\x19\xf7\nl`\x14\x80}A\x85\x00\xf8\x9d\x15\x03I\U000bf120\xb7H9b\xa0\x00\xea\xf9\xee/b\xf3\xd0\xf9\x94[\x19\xff\x00Ύ\xe2,\xe2F\x81\x8f\x87PDU\xee\x00,\xb7\x99\xc4a\x94tVu\x02\xd5z\x8a\x96\xb1\x04\x8b-\xe6\x00\x1cR\xcc\xe65\x95\ar\xcdW\x1c\xc57h\xbc\xc0\x91\xc8T5\x00_\xa8\x82_\x02=W\xac\x04z\x99V.\x16%⌏\f\x04\x85+C\xf8\x96\x97\xdbR\xe5\x8c\x19\xbe\x10\xbc\xab\x9b\x9a\xa8ۑ\x04\xdc>\xa6q\xf0\xb0\x83\xd7M\f(#\xc2\n-\xbdţ\xb8\xa3(\xb6y\x82\x1bS\x13Y\x16\x8dx\"\x99Z\x80h\xb7\xc1,~\x93\x0e;,\xaaW\xccP\b\x1e\xe0J\x14\n\xc8a̋g\xc2\x02\x86\x86ąX\x02U\x98\x8a@j\x01\x81\v0C\x03\x92?-@]\xc4\x14\x95\xe5]\xc4C\xc0\xa4\xe1\x82\x1a\x8eؠ\x1aIiQ\x82\xa1h!A\x1cy\x95\x86\xf2\xcbl\xaa\x83\xc4\xf0\xb3 \x89}\xd4\x139m,)_1ֺ\x18\f\xb933ʄ+j\x98\xae%\x06k\xda\xe3&CJ\x97\x14(6\xe6Q\xc1k\x93\x14\x02\xd2\xe2\xaa\x02i\xad\xc2\x01\x06\x93p\x17R\x86\xd6+5\xbc\xd4X\xd0P\x81\xe8\xb5luų\xc8L\xe4\x8b\r刦\xf7\x1b\x16\x06\x04\x1b.\x8f\xe64\xad+X\xc0v:\x85}\x1f&(\x01N\x1a~ْ\xf7E\x7f\x12\xd7+\xe6\xbfR\xb6\xe9\xf2\xa6\x0f\xe1\x19}g\x9e\xac\xbf\r<.!\x89n\xfa\x98m\xdf\x18ˋB\xf6@\x99E\xee\x13WVؚ\x9fL\x84/_\x12ݫ\xc4UIW\xe2\x87\x1ea\xe0\x97:\x88\xf7\x92^\xe9`\xd9n\xa6+ \x13\x02\xc7r\x02\xae\xd8\xd2\xcb%p\x11\n\x98s(\xc93\x84`\xe0\xea\x14 ^\x1a\xc39҂\xf5\x14\x10v\xee\x9c\xc7f\x05`\xc1j\x02\x90\x0e\xa2Y%\x15A\x0e!h\x85,\\Jх\xf8!\x17\x05˙\x97\"qp\xc4\x04,\x16\xa0\xd8\x12l\xb7\xdd\xd6\xe3\"\x9fPWy_\xa8\xe0\x83w\x83\x83\xf9\x84t\x8a/\x89i&\xfd\xe3\xd4\xc6\xf5\xb0\x1a_pY\"\xc0\v\x90\x8b\x87'\xe6~1\x93\xf6\xcb7\xa4\x18SK\xc0S\x06s\x82\xfa\x03\xe2\x1er\xb9m\x7fq\xa2\x99\xdc@*f\xce_\xe6{ \x90\xe5\xdaض\x9e\xf4\x98\xab\xde!\xc0\x0f\vp@\x03\xb6\xed@\x83>\x151\xf2|1\x96\xd9\x0e\x0e\xd8\x13\tf\xa0\xf8y\x84\xe3\x11\xf0r<¥ra\"G\xc1?eL\x10\xa9\xdc\xc1\x00_\xed\n\xa4\b(*!ZX\xf6\x82\xf5\x99q3\x9d\x18\n_O1\xec\x18䎚\xe6hn\x93I\xc4\xd4\xe1T~\xe3\xf1.ђ\xa1\xcdG;%\r\x8f\x10\x15\xe9\xd5K)\x10\x87.\x92_\x8ct\xcb\xe0\x1a\xad\xc1\xab4KbW?\xc6V\xfcq\xc9*@\xf49j\x0e\vF\xbb\x81\xb4\x17\x9b\xdc]\xde\x1b\xc4aP5\x8c\xceg\xbbXF\x8c:\x97\f-\x14\xae\x88k\nUj\x1aR\xdb\n\xbc<\x91建\x80\x14\x05Ew\xf5L5\xf8\"\xa0$\x89\x87\x11\x05ї\xb2X\xf4v\xa51j\xa2V\x8eC\xa9\xbc\x8dA\x92\xf3\x8a\xc7\xdc\x01n\x8c\xf7\xa6Tc9*;\xa3\x84A](T\xbc3\xaat\xf9\xc9\x14x\xaa\x05\x9fy\xfcK6W\x02\x9f\xcdǶ^\xf3O\xd4S\x169\xbd\xff\x00#\xf7\x18\x15\x9a\x19\xf9\x89\xf0gįۙ\xa0O\x16\xeb\xb7\xf1\x16\fT\x19\xa21҂r̀\xfb\\\x0fr\xe5%\xa1\x97\x11y1\x1c\xda\x06]\x99 \xaa\x06\xa6\xc2\r\xad\xf6\x94\xcdC\xa9}㈂:\\J\x0e1U\x98\x82\xedW)A\xec\x8c4\x9a\x85\x80\xd4\xceFm\x17\xb3\xa6Ïq\xf0\x05:\x80\xe5\xa8\xf1\x02kR\b\xd6M\xe4[\xb6 \b&*\x1b\xae\u07b9\x97ғOr\x80\x01\xce\xe5\x8aI\xa5@+\xb3i\b\x01^\xae[\x02\xac!6\xa0o3\xcc\x19\xa8\x06\x8b\x80\xf3\x18\x16\r\x9e\x7f\xf6\x97ZA|y\x81\xa4\x1eV\xb7\xe31\x8dDU\x86\xd9+\xa6\r\x86X\x18\xa3?\fm\x13t\x87\xe5\x93\xf1\xff\x00\x8f+\xba\x1a\tc'\x9fS\x020\xeb\xebj~e\xe0\xf6\x85\xc9\xe0\xc1\xf3\x05\x04\x8fB\x9b\x03\xb5N3\x8cʤKK-\xf8\xddyev\xba\xd2\xd6\xf0UX\xf8\xbe#YDB<^\x04\xfbe\x83fW\x7f\xe8X\xb3\x88\xd9}MT\xb6\xf2\xa4Qq\xc1\x85\xcd\xe7̣`\xfa-\x0f\x1e\xa5\xec\x01\xb0\x0e\x0e\xaf\x88\x86\xba\xa9\x00\x9b\x12\n\x82\b\x1a\xder|G8\xec\xa8k\x0e \xf4\x87S\x01P\x9d\x18\xad.\xc0\xc1*\xde\x0e \x05\x84\xea\x15\xa5\x92\xd4J\t\xa8\xff\x00:\x80\x8f\x82.\x0e\x95\x9f\x00\x8a\xde\x1f\xa8\x15$\xc5\xeb\xdc\\\x84\xf2\x92\xfd\x8c\xf7\x00g\xd4>_,7\x06&>\x13\xacG <\x19\x90\xb2\n\xc4\x00\x83R\xeaV\xa5\x1e\x12T\xd8\x1b\xa7q\x14\xc2\f\x15d4u\\\xf8\x80m\xa0\vi\x82\n)\xd3\x03+A\x83\x98\xa1Iu\x15\x97kW\x05\xe2\xf8\x02&\xd4\xdb\xff\x00_b\t\xef_\xccuڰgP9\xd1\x04\x04\xbd\x7f\xe3!\x10\x88<\xd3+\xb6\xab\xb0\xf6\x1ebT(\xb6\xf3R\xd7|@k\xb5m\x0fQ\xdb< \x01\xe4\xd9\n\xb3\xb6\xd8;\xf8\x8e\xed\xf28=\xbe\x8a\xff\x00\x10b\x83\n\xf8\xe71í\xb2\xe8\x01\x8f\xf31`\x81[\xaf\xe9z]\xf8\x9e\xae\x81o\xde%NkU\xf8B\xff\x00\x88CG\xdeCe\x15\x1fW\n\x91$.\xcf\x0e\x9et\xb2\x81J\x14\xcbW%f^\x92\xbe\xaa\x10\x9eF\xc7̯\b\xbf\xb6\v\xc0ޜVj\xa3S\xeci5\x88\x18V\xb6ߘu!\xd3\x18$\xbb,\xe0e\xa1\xb0\x8c!\xc4^C\x19\xfd\xfdJ\xa6\bw\x8d\xc6\x17Gc)b\xae\xc9lQ\xd1l\xa4\xe8\xa6\xe1\x88V.\xfc\xf5s7UUup\xef\f\xa1\xc2,\xf8\x13\x98\n\xbd\xe1Zeڌ\x95\xef\xe2`\xe3\xd2\\\xbd\xb5\xe2\x10*7q3=~elT\xbey\x84,\x17\xaaLD9P\xd9X\x84J\xaaސ\xfd\xb8\xe1/\x93\x01\x9e.:*\xa7=@z\xc7P\x10;@ \xa0\xa0|\xd9f1\x00?\xf6\xb84\xa7\xf0\xc5װ8\x84\x1bsj\xff\x00\xc3TV\x93D \x00\xe8\xb6q\x146\"\xf8\x98\xa3IF\xfcFk\xedC8\x01N\xa9\x7fd\xb1XmzwA\xa84+\x00\xaatS\xdf\xefܱ\xbe)\xe2\xb6\x06W\xc7\x1c\xa4\xb4\xd2s\x986\x8c\x1e̼ʽg\x14^\xa8\xe7\xcb6\vck\x9f&\xe0 \b\x02\xa24\x94a\xacB\x89\x82\x14((\xbfɕ\xea\xfa\x87\xe7\x8fĢ\x17\x84\xac47\xb4/ \\U \xa4\xcc-N\xed\xbfD]n\x8f$\x96\x19*V\xb4\xb16m\xbb\xd3O\xf1\x0f\x1b\x88\xf6\x14\x8f\x98!\xf2`]EU\xb0\xd2\xd6K䙰nB\xd5\xe93\x06\xf9\xfa\x8f!\x03\x01l/\xe4\x86\xec)j\xdd1\xbfL\xc3\x02\xd3\xdd̀m7ji\xbeLO\xc0\x83\x11\x01Y\x86\x19]\x18\xc3wG\x88\n\x88\xe5N\xe1Y\v\xee)\x14b\x8dF\x03\xcbz??\xdcʄ]\x9c\x92\x98+\x95\x96\xf7\xd9\x0e\x16\xe9\xa0@\x1at\xd4\x1a\x83&\x82-\x83%\xe1\xe6\x1a\x89p\xe0%\xa2\x9fAԨ9\xcb!(\xd04\x16'r\x02\xf0e\x8d\xf2\x1b\xf8\x95N_&\xa1Zw\xb6>WN\x06i\x81W\xcb\xff\x00\x19\xac\xd8\xe2T\xf2\xe4X,n\x9bO\x89Js\nU4\xc1j\xb5/YĤiM\xad\xd3\x00\x8a+\x18\x13\xd4\xd9{\xa6V\xb5\xeabc\xc1\x9a\xb1\xfdܾ\x149\x91>\x18_\x11\v\x95Z\x010ߘ\fl쁆\xc7.rF\xa5\xcc}\xed\x1b\xf7PD\x00\xc6f\xf7i)\xf8\x0e%\xaes\xfe\xea\x16_\x81\xbe\x04\xc4K\xbc\x03̜\x9a\xa6T\x17\xb6*\xde\xda\x06\x10\x00\xa8\x04%\xa0\r\x7f\xf20JƅW\x8a\xe4\xfdK\x90\x91kP-\x0e\xefD\xb1Qpo\x16\xf68x\x80\xa7.DI\xba_Ըb\xc84s\xf5.\xb0Kcga9\x190\x10`dŹ9Q&n\x13\xe4%\xd0\t\x93\xfdTL\x006\xbb\xff\x00\x93)\x92\xb1?0\x17;\xddL\x0e=\xe2Ja)\xbc\xe3\xc4tA\xab\xbeee,\xbb&\x19\xa0\x10\xcf\x1f14\x85\xec\xb5\xf8\x80\x94\xce/\x10\x13\xc3#z\x88\x82,\x8a\xbf_\xd4B\xe6j\xea\xf6\xdd\x11V\x0e\x18\a\xbdNOA`y\xea5\xe5\x0fE\xab\xee\x06\xc2=\b\U000c4db4D.\xc8b\xa2\x80\x97d\x15\x0ej.\x0e\x16\x02 hy#JX\xa8\xd5\xed%\rW\x02\x9a\x8e\xb0\v\xab7\xfe\xdc20ᤶ0+l\xb9\x18?\xe4\xf7*s\x00߈TV\xf04\xa1F}\tV\xdb\x17\x9c\xa8\xad\xb5\xb9\xf5\x0f\xa1jUc\x14\x8c\x83\x95\x1f\xfd\x97\xb3@wXx\x98\x8b~\x16\xddFi\xf6S 6\xf9s\xc4\x14\x12Z\xf31\x90\xc07\xc4\xd4\xd0\x11\nU\x0e\xf7\xea.\xf6,\a\xe8\x86F\xd3(6u\xd2)B\xf9\x11\xf8`{TVX\xde\xe1T\x02\x95\xb3\xb9y\"*\xbf\x02\xf5\xa6/j\x0fd\x9e\x045\x8b\x80\xab\x1a\a\x82\x12\xcdǺz\x97\xd3B\x97\xd6\xdf\x1f\x92 \x85\xa0[\x0f\x8f\xe2K\xc8k,*\xaa\xac\xcf\xd3\xd72\xc2\x02\xb1Ȯ/\xe2:\x85(\xf4\xea%+X/\x98\xc5}P\xda\xfb\x97\x8e̸{\x8b\xac^\x8e\x92\x82\xf0\x9b\x8fO\x98p\xc2\xd1ǯ\xea|q\xca\xe2\x17*\xe5\x06vW\xdd\xcf8\xba\a\xe8M\xb5[\xcd\xfe [Qa\x7f\xd2\x01@{[G\xe6\xe2\xedE\xb1\x12\x9f\f\x15\xe65\x800/G\xdfp\xceA\xc7+\xa6g\x8ce\xf1\xddL\x1a\x06\x83(4$\nm\n\xcb\x10\x10\x83\xc8̢\x12\x8c\x960\t\xa5\x1aX\"\xebG2\xe5\xb47e\xa7P\x10\x03\x8b\xbf\x88i\x80\xdbZ\x9a\x94NS~c,\x14(\xd3:\x96\x02\xabC\xf8\x99F\x0f8\xbf\x88\x8a\xa0V\x1f\xf3\x11\xd2\xc6B\xf0\xb5d\x17\xdeq\x1ej&\xd3Ѝ}\xc75\xe2\x05q^\xd0\x15\u07b8(\xa7ul\xdb\xd3A鎖_\x13\x98\x05rR\xf4\x8a\xefr[\x17\x95\x17E\xb1\alv\x80\x02\xcd\x19\xca\x17\xaf\x11\x89\x95\x9c\xa0=b_ڛ\xd6\x01\x9b\xc8\x15\xf7*&\x1a\xb6\xd7\xe1wR\x90\xd6i\x05=7\x89\xe1\x005}W\xf3\x01\a\x1b\xb27\x1b\x7fֿ\xa8\xd0\xef\x14@\xf8X\xa0mi1\x17l\xb0W\xe6\xbc:\xff\x00\x10\xf6 \xc6\x16\xbe\xd8\x12O\xd0\xd7\xd42pTC\xf8\xa3\xf10'\x1an\xeb\xcb\xc4]\x1d\x85-gT\x10\xf1Galyz\x8fn>\xc3\xc4*5\xa3\x91j\x96\xdf\xea\"@62\x7f\xbfڇ4\x16\xc6ǈÄ\xd2hw3\xd8\x1c\x85\xd6\xfdÌ\xb4\xf0\x8f\x83\xba\xee\xa1Q\\)\x1bt\xf3\xf5\x88\nr9Z\x8f\xb2\xdf\xdcDMa\xa6x\xb78\xf8\x8f\xb2ې%\xff\x007>\xd9*V\xa1\xe2\xf5\xd0\xceE,\x95^\x1d⣡\xcd\x01\x8f$\xd4\x12j\x8f\x1b\xf5\x89u\xe5T\x05\xf9WЖ\x91\xa4\xb6\xd1\xf8K\xf2܋\xaf\xdc/\xa8\xbd\xa7~\xa3\x8e\x97\xc8\xcd\xc0\xc4\xd0ݵ\x18\x00\xa2\\0\x03\x8e9n_\x00`\x16\x8a?\xecTQx\xc3\xfe\xe6dx֯y\xff\x00\xe4k\x80\xdc=\xc0\xac]C\xa5\x06\xb7\x056\xfcnd\xa47\x9b>\xe3`R\x95\x00\xbc\x15\xb8Q1\xe7P\xf5,1\xc0(\x80:\xb7!\x10l5\r\xf20#\x81,/\f\x02g\xa4\x04[^q.)$y\fV^M\xc5s\xc6\xc3\xf8\x16\xfej \x9e\xa4420\xf4B\x84\x159\xd6e\x7f!İ\x00\x88\xf6'\xe9G\xf1\x1f\x86v\x87\x1e\xe5\xc1F\x04;\xe2f\x88\x10\a<Jq\x89h\x1d\x97\xa4\x95\xea\x00Y\x81\x0e\x0ed\x8bG\xf3(J\xc1p\xc6;CS\xacS\x95{\xee=\xe6\xa5ݿ\x925\xb5\xd4k\x82\xbc$\xcb\xc9$瘆\x06W\x99\x0fHAxZsa\xdc^\xf2\x8a(\x18\xa36\x15\x19\x1d\x7f_P]\xda\x06\xaaޡ\xea\xe9U\xbbE\xda%\xe9\x8f\xf8\xbf\xb8hU\x95\xd8\xfb{\xee-\xc2\xe8\xdbk\x83\xac\xcf\b\b-\x88\xd8\xcb\xf1\xa9`\x84\x8a\x7f\x00\xc3\xf2C\nF¾S\x04n\xd5\xe5\x1f\x8dǉ\x92!8\xb4u\f(\rp}\xa3i\xc0R\xb35I\xc7\xcct\x97\x95\xbe\x82u-@n\xd2T~f&\xe9F\x01\x7f\x98},\x8dUF\x8d\x96\xf2\x87\xe6S\x10\xaa\xa8\xa4\x012\n\xb9s9\xb2\xbc\xc5Ukw\x89H\xf1j\t\x88^\\TR\xea\x14\x16\xf1\x01@\x06\x92\xbbg\x9fu\x9a\x99\xb8\xb5\x05\xdd\xe6<i[\xb9\xe2\xe2\b\xe5A\xab\xacK\x84iq{\xd3N\xb5̠\x12\xf0\x04?s\x16K\x87\a\xc5\f\xa6\x06\xeco\xddGb\x15P\x99\xf2\xb2\xe8\x04\xeaz\x0f0\xd1\xd1I\xbbEV\xb2Z\xd0D\x89c\f\xb5\x14Y\xd2\x01Ll`\x83\x19\xf5\x00T\x14bf\xb8\x83\xe4\xa5\xd3+\x81.ˇvs3\x9b#[M\x1dJ}\xe2\xd43\x8c@`g#\x90[\xde\xd8|Ž@\xed/\xa4\xcc*j\x89\x9c\xf5U\a0\xec\x06\x1a\xe7\xe6V\\\xb5\x7f\xc9<J\b\xb02\x1aN\xa0\xc2逭\x1d@\xe1\xdbq\xeag\x05\x88\xdf͑\xa6\xadIv~*=d«\xc3\xf3p;\xca#v\xae\xba\x94j#\xc9=\n\x850\x9c\xd5 \xb5\xa9\\.՚\xb6\x13L\xfb\xb5\x99\x1a\xd0E\xbf\xa8\xac\x8a\xb5\xa5\xfcJ\x19u\xd1\xd9\xc5\xe7\x88\f\nb\xbfc\x10u\xf8\xe5\x89]\xa6\xe53\x88\x98Y\x83a\r\xa3#e\x84x(iS\xf1*\xd0\x14AwR\xc4+\x15\xcbH\x92\x9b\x95\x95\x98\xec\xed\xc2\xe0\x95my\xf6n=kW\x90\xd4r\x83E\xde!\n\x12\xa7\x88\x85{\xcbg\x05\xe2\x02\xf7\x80\x0e\x1a\xea\xe5`\xa5\xd1W\xd3\x02Z\xefD\x88\xa3\xb6e\xe6\x19\f\xaf\x11s\xb5$\x116\xdb\x01\x04\rR\u05fd\x9a\x87\x1a\xc3@K\xf2\xfcY2\x96\x05\xa9G\xbc'S\x14A\x83\x03\xdc@ʴ\x01O\x81Xf:\xe7\x06?\xe4\ns2 \x8c6\xa32\xca\xda\x01\xa1\xf3/\xa6X\x01\xc0E\xcc\xdc\vB\xfcK\x1d\xc6\xf1\x03=,\xa1a\x1a\xf6\x85\xda:C\xcc_*d\xb6\x97\x18\xc6\xd9BP\x9f\xad\x18\v\x86\x1c>+\xc9\xfcB\x00\xc5F`\xe7\fF*\xa8a~\xa7{>\x8b\xe7\x12\xf54[\\&\x9c\x8bcX\xbf\x12\xddYXUDd\xb5\xab3\x18iO\x82U\xf0\x91F\xbe\x88\xe0\xf4\f\x01\xf6\x93\xf62\xfe\x86)\xbeD*\xfea[\xf1\xa0Mۻ\xa7\xf5.\x9d\x92\xf7\xfb``)\xaa\x9f\xc4\x06\x82+8\x99\x82Z\xa3eT\x14\xe8\x83\\\xfc_\x17\x13\x04?\xac\xd4l\x17*\xe4O7\bN\xdb+Q\xee$\xb5\v\xba\xcb.\x94\xb3~1\xc2\xecv\xbe\xa5\xcd\x14\xe1Kc\x0f<\xbe<C\xa0Tȍ)\\\v4~\x19\x96)r\xe8\x93!\xe6\xd4\xe68J\xacoB\x80\x95@u) 8\x01_\x13My:\xbe\x9f\x89Qq\x9c\x8dg\f\xa2 \"\x88\xc7F\xa2C\x91A\xa2\xcb \rS\x82k\xf3\xf7\x01\xd0̯\xbb\xc5ԽÆ\xe9\xe7\xa3\b\x0e\xd5/\xbd\xeb\xe27Y\x94\xab\x9a\xeaTҸn9X\x18tǙl9濢%\x7f\xe2\xf7\ni8\x16\x88\xe6(y\xfe\xb2\x14\xd82\xe0\xfb\xcc\xd3h\xb48=\xd6>\xe3\xddL\xc9\xfa5\x14B\x88~\"_\x866.\x06\xda\xe1\xf2\x8c%R%!\x9c\xdcԱJ+]J$5\xa3\x98\xc2\x04\x12\x18\xd6];\xc9\xc4KA\x16\xe8]\x1dc\xf3\f\x9e\xb6\xad_0K\x15J[\xec\x9c@\xa0\x00|@\xcdG\xab\xf5\x12-\xbb\xb2\xfe\xd0\xfc\x8cS\x00\xbf\x113\x043vu\x14[\xd5\xdeY\xf8\x10\x8f\xdb\v\xb4^o\xea\x04\x8d\x87Q\xf9\x82-\x1f&\x8a/\xa3\xb7\xfaM\xcf\xf4\x1b\xfb\xb9H\x18\xe1?\xae\x99^\x19{\xfb\x8f\x81\xa8\x8c\x06.\v\xa8\xca\xfag~w\x14\xac\xa6\x9e\a\xc4\x06\x85\xc8d`\xb1\xa3\x9dq\xe2P\xd2\x01\x83\x14|E\"\x89\x1f\x1d\xfb\x89\v\x9eW\xb25e\xdfqj\x83\x84\xc2s*J\xdc\xd6_o\x89\x98\x9e\xf0(\x95r\x00)\xa3\x8f\x10P|\xc0\xe5\xf5\x14a@\x18\x0f\x9b\x8d\xf2՜o\x1d\xc1\x17r\x8a\x81\xb31\x9a\x02\xc6\xf8\x1cZ\xbf\x883\xcdj\xb2\xf9\xb2\t\x91\x9c\xee\xab\xe3q\xa1R\x01\x81\xce\xe9\x12P\x14\x8c\x00\xdb\xe8s\x98\\\xe4ѧ\x98\x8e\x8a\xed/\x04_\x0eT\xf3\xee-D2\xb7wY\xfa\x97\xc8\bAX\xeb\xec\x8ba\xf0\n\xd8\xff\x00\xd9Ũl\xa7Z\x8b\x00\xe5\xaeY\xea\xe6` [v^\xe6>]\x13\xa3\xb0r@!^\xd0=\x12\x92\xea\xacVz\xb5\x8c\xd0K5\x93I\\\xcd\xe6\xd7+\x1d\x05\xbe\a\xf3\x18\x8b\xd8P(>\"\xa1j'\x91\xfa\x98\xf5\xaa@7*\x80\x19\xb2\xa2*AЯ\xee\x18Җ\x00\x0fx\x96xuh\xb5\xaf\x10Jъ\x9f\xe6\xe2\xc7#\"\xfe5\x18\xa8\xbc8>%\x85e\xda\xdb2`\xa7CS\x1cCʿ\xccY\bN\x00\x81-g\x0e\a\xe6T\xbdb\xde9\x80\xad\x10\xce&\xd8W\x12\xa9d/\xa4~Ds,\xfdL܇<\x10\xa2\xe2-\xe2u\x8e\xe2\x06\x83\xba\xe4\xfb\x95\x18F\x82\x88,\xf28x\xff\x00\\\xb1\xe2\xd0`u\x13\x14\x1e\xdb\xf5\x0e\x16\\\x11\xe2`\b\x8eu\x1f\xc47@\xa5\xfc\x11\x8d\u0590Eg\x89\x82\xf187p\x1cJ\xbbh\xea\x00x\xb6[Ug7\xf8\x96N\xeb\xf8\x80\x9aBd4\xe2\xd5y\xe2\xa0[;*,>ު|\x8b7\xcbUO\xccL\x8d-\x83\xcat\xc0\x01!fm6-\xf3\x99o\x1c\x14Z\a\xc1(d\x16\xa6\x84k\a4\xf8\x85\x10q\x11\xa1\xd6X\xb8\xa4\xb5P\x90h\x06\xa3\x15\u00ad\xa1ȷ0R\x13Ue\xe3\xcbU]S\x15)OU()\xd9\x15\xb8\x1cF\xa1 >6\xca\xfd\r\xee\xa7\xc0Kb\xed\x05\x01-vE\xa7A,J\xf6E\x00\xf7mR\xe5\xe6\xe6\xf2#\xc2\xcaM\x9b@٪+\xf7\x05\x1d\xdaȏ\x19ϩ\xbc*\xc1\xc0p\xae\xf9\x88\xa0S\x86\x15\xab\xe6\x9fܸ\x81U\x8f\xc6I\x9e%\x8b\xa9v\xc3u\xfe\xb8@\x00\x86\x06\xfeLT\x14\xbd/l\x89\xc6\xe6\xf4\xd9L\xb9k\x82]\xe2:\x82\xa3w\xa2_\x1c\xd4\x00\x933C\xfcD\xa5\x0f\xfb\xc8\n\x16\xf9}\xca\x0f\x11y\r\xe0s\xee2\x11\xc9\xe1/K\x0e\x0fH\x01\xd2\xd72_RƊ\xc3\x05z\x95\x8ey\x82\xa7\x99\x8aݙ\xa1e[Tj\xb6?)q[\f\xc71}\t\xbb\xb4\x8a\xd1\xc3B\xcfP\u05ec4\x95\x05\x8bR\xe4U+\xe2T\x9b\xb5\x93\xacM\xd6X$\xf4\x8e\x98\xf2¥\xa0\xb8\u0083`Z<B\xe3\x02\xe8\x83\x11\xd3+\xa8\xea\xaa\xfa\x96\xb0\xe20\xa6\xa5\xd6b\xec\by \xd8\xe5x\x04\x1e\x92\xbe\xc7\xe8\x94>\x9c\x8b\xaf\xc9(\x8ay\xa0W\xe66y`\xeb}\x15\x8b\x9c\xe9\b)\xe5\xe2\x1dx\xbe\x87\xdc\xce!\u008f⥈&\xe2\xe8O\xb8\xb0q\xc0A\xaaB\xc4p\xed\x9a~\x1c\xdcslȦ\x179k\xf4\x80\x18/\x1d\x8f\xbd\x02 \xf5c\x1av\x0fX\xcfܫ\x17\x89y\b\xf9\x89\x90E\xe0q\xd4利\xa9\xdd̴\x9a\xbc\xea4\x10\xb2\x12\xfc\xab\xb8\x10\xc5Y\xc8-2`\xba\xfc@l\xd9y-\x95G\xed\x95\x04ёY\xbc\xa9c\x83\xd4\xcaM\x97\n\xb7\xac,\xbe\xc8`NN^\xcf\xf6\"\xb5\xa2\x1d\x8e\x1f\x99M\xca^\xfcDQ\xa5\x99\x03\xf3\x89P \xac\xa3\xb8!\x02\x80\x80Ni\x1c=0\x94Us\xa0\xb4\xb8\x8c\nl\xd4\x00Ԝ\x0f\x88\xf1/B\u0083\x8bl\x8a=E\x99\xdcw)\xb8\xe2\xebiA\xee7P\r\xaf/D^t\xdeK\xca\x02E\x00\xab5r\xdf(\xb8UD$\xc8F\x8a\x1eAbu,iT\x81\x82\x1c\x19\x92\xdf\xc4\x12\x97\x9eK\x86\x14\xbdBR\x10\xe8 媬\xc0\xf9\xd4.\xb2\ue4bf\x172\xe1\x03\xa1\x8f\x98u\xefT˵\xee\xb3[\x1b\"hq\xa6\x96\x8a1V\x82\xad\xba\xbc0\xd2:\x01\xaei\xc02\xc0\n\v-G\xf1\n\xb2\xd7K(\xe4T_\"R~b\x01fF\x9f\x8dJ\xf8\xf8\xa3\x1eU\xa9R`(>\x16B\x8f\xb8:w\n6\xd26-\xd0ָ\x9763A+\x13\x02\xf0\xfd\x90Y\x06u:\x86<\xd7\xe6\n\x8fj^jF\xb6\xd2\xea\x18P\xa8l>\xd6\xff\x002\x8dL\x14Pt\xa0+\\\x92\x81\xca\xc1h\x869\xc6\xce\xc8\x12X\x88\xe9ͼ\x8c\xb1]\xc6\xca\x1fD\xcbՅ\xe4YW\x83@\x81F\tp\x94\x1e\x17\xdc\xfdG\xe3\x14!HGuE\x8b\xa0\xdb\x14]X\x17\x8a\xae\xe5\xfb\x19c\xe2ɲ\x1b\x17/{q\a$\xcf\b\xa3\a\xe2\r\xd6U~\xf1`\x0f\x12\xb6\xfc@@\x82\xa2\x83\xf7q0ӍTsQ\xa7`\x8b2\x9c\xf2\xb8\\\xcbi\x99\x0e\xccasF\x87\x89\x83\xc51x\xbe\xa3|7c\x92\xee/\xa4֎\x1f\x88\xc3\x02\xdbf`F\xc9@\xf2\\\xdcL.\r\x0e5\xfdn&i%\x96\x1f\x15.\xb8\x87L\xc8\vQ|p\xc2\xdak\x88\x86ʲ4\x94\x84kkiu\x1e\x8d\x16M\xc0\xa5\x94\x97\xc8\\\xa4L\f,[j\x17\x9c\x02\xa2H$k\x03W\xfaw\x00<t\xa2U\xbc|F\xf2\xa8\x9avs\xe7pA9\xcbLs\x93\x9b\x7f\xb9\x80\x10\f\x17\x17}%\xfcC,\xa3\xbe\xb9:B\xdbH\xe72\xec\x1998\x98\xe3#j\xfc\x12\xecu\x84\x93\xd3\xea\x1d\x12\x04\x87\x02\xdft\xcbO\b\xb6\x0f\x8dT$\x06X\x83c~\xaa\x11\xa7\r\xe5\x93\xf0\xc2\xebr4\xd7\x1a\x8c\x0e\xa1\x83\x7fr\xf2\xa8\x10\xc0\xd7\x0e\xa62\x90\xc1T\xff\x00\xf4`\xccU\xa2\xe98\x8a<\xe2\xb4\x1a\xf6\x1dL\x94\xe2\r\xbaǋ\xa8\xc0\x02\x80<\x15o\xe4\x80\xd5`!T\xde|\x8ds*V\xee\xd9zNM\xa1\xf3/k\xe6\v\x15\xa9\x9eH\x00\xe5n\x96\x06Br\x80Ez\xfd,b\x93\xf2\v\xf8R^\n\xa7i\xe6\xa8\xe0L\xa6\x8b\x85K!\xab\n\xe30\xd9|\xdc Vy\x95\xa7nXcm\x1ea\xb6AT4ĥ\x9cNWDɹrw\x12[+T\xa1\x00Z\f5\x8a\xf7\x11\xcaAE\x01&\xb5N܃\xf9\x98\f\xc4\xe9\x84\xc8\xe03J\xfc\xca+n\x1e\xa2Z\xfc\x02\xae(\t6\"\x87\x98\x83\xdcw\x10\xacJ ?[\xcf\xf1\x14\x1c\xdb\xc8\xff\x00\xf6`j\\\xa8\x0f̦P\x80\x00_\x94\xd1\n\vM6y\xe3v\xbc\xcaYc)i\x9d\xe7A\x01D8\xda\x13m\x94\x90\xf8J\x9d\xa5\xe1\xdcK ]\xb9\x01\xbey\xf3,̪\x97\x9ap\x80\xe1\x8a\x18\xc29;\x7f\x11V\xec\x03\x8d.\xd7}B\xc3 Yny<D\x15\x9a\vς\\`\xa8\x1d\x89\x9f\x86\xb5\x1bR\x87\xb1\xe6\"B\x97\x16\xdb\x7f\x98\x8f\xa8\xac\xf1\x11\x8d\xd2}B\xc5߄\xa6\xf4\x9c\x90\x1e0\vE\xd0\xff\x00\x04\xae.\x9a\x16*\xf5\xea'\x8af\xbf\xbb\xb9`\xa5\xe0?\x01\f;\x8cO\x03o\xcc\xc1\xdb2\x1b-\x9a~s\xf3/W3w\r\xe2\xa2\xcap\x9e~C\xf9\x94=Z_\xf6\bƬ\xd1g#\x16\\\x85+ϴ3dP\x15D\xc9\x06qT\x83+\xfd\xb8d%;\xb6\x06\x00e`\x1ae\x1c\xcc\xd06tB\x12‱\xd8\xe2\xf7\x045^\x1av\xcdo3\\\x9d\x91\x04W\xc4OS\xd9\xd7\xc9-\x80\x1f\x04\xadCE\xadXL\xc4\xdeB\xbb%Z\xb3\f~\xf1l(2\x1b\x0f?\xe2!\x82\xaa\xc0\xac\x7fp\xd0ռ\x1f\xdcBE4d+\xe2\x05\x8a\xac\xac\x14E\x90speU\x9b\xc5-\xf3*\xad\x1eS\x7f\x98\x82\x8ap\x87\x1dEz\x89\xc7,;9\x8eZU\x10\x0f\xf8\x86I\x83\f>\xc2k\xcf\x0f\x0f\x98\x87f\xfc\xaa\xed\xb7c\xce\xee,\xa5\xd0r\xd3'\xe6\\z\xb2\x05\x86\xfel>\xe6\xc2\x05k\xa9z\xb2 4[\xdcT#emxe\xce#\t\x8b\xa6\xab[\xfe\xe3h\xc9l5\x93\x19\xae\xa5\xe0T(<\x91-\xb0\x04(\xdb\xe4DLN\x01F4\x98\xf7\x8b\x81\xb7\x00\x13\x1c\x1c˘-\x16/\xee\x12-\xa2\x94nip\xe2d\xa9\xb6\x9c\xc0\x94\xa5\x94\x03\xea^x\xfa\xcd+<r\xc1\xb5\xb7D*\x03\x00f\x90\xbf\x15\bT\xac\x1czE\x9d\x1cIŚ|m\x8a\xbb\x7f\xfccѵ\xd2X\xc5j\x19\xa5g;\xc9\r^\x1c9`\xba\x80\xe5t\xea\xa0\xd9Du\x99\xc2,Z\x0e\x89\xcc*\x9a\xe7\x0fOR\xc6\x11\xa8\x16\xc9[>\xf1\v`\x80\xb6\xdbC\xd9\x05\x81y\xa9\xf1\r~s\xf1\xe1\xf1\v\xb949\x1e\xbdt\xcc\xfd\"\x8a\xba\xf6\x93\xba\x19\x13d.\xeeM\x8b\xaf\x88\xd6Fp\x80\x96V\x10\x14\xe9\fb\x04NK.cJ\x1b*\xea\xd7̼\v\x17t\x15\xf1\xb8ڈ\r\x9a\xf4\xa8\x16\xbc\xaccP\xc6b\x89\x84°<[\xed\xc4\b\xbc$Y\xdc1CB\x91|\\,\xa8\t\x82\xdbc\x98\x88\xaf\x1b\x983\xd1\xf6\xc3\x18\x81E\xedJ\x9f\xa5?0\xa2\xc0\x8e\xaf6Ǻ\x18\x94\x9d[\xef<\xc2\x03\xa9\u008f\xcc-\xb2\xdd\x04\xa6\xd0\xc8W\xee\n-$$\xcd\xdd\xe1\"\xc3\xcbh\xeb\xfd\xf7,\xc6\x15w,\x8d\xeb\x128k'1\x80\xa1\x9c\v\xe0\x12T\x01\xcdQk\xe5\xb6'ɶ\x97\x15v\xff\x00\xf8\xaf\xfc\xa6\xa0\x9fR\xd0U|@<\xd3\xe6,4\xc5\xc7\a\x99eƢV\xc1Ui\x1fcw\xaa\x8b\x9bS\xbcd\x94\xa2\x9c\x9a\\Ǫ\x13a\x12q\xa3\xba\x87\x18\x86\x13oq\xd3y\x8b\x1f\xd4\x1d\xc1f\xab\xd2\xc2`E*\xab\xe8\xdc\x17%\xba;}\xb3hr-\xf9\xad@-M\x02`<\xe2\x13\xa7Ы\x8a\xb3'F\a\xbf\x11_\x85r\x0ey\x81PT&\x94\x1c\xe0\x99\xe4\xd5\xeaϒ\x05\xc2\xea\xf0h\xf1\x89n\x81\x90\a5\x8b\xb2\v\x95P\xe3\xba\r=K\x01 [K\x0f0\xbchafު[\xab\x8d\xf2\xbf\xe4Fը\xaa\xaf\x12\x95Q\x11\x95\xa5\xf7\x1br/D\xbfr\x94\x9a\xb9\xed\x8c\x17\t\x02|\x13=\xcc\xc9\xf9\x99\x85\xd6\a\xe7/\xe9\x1d-{A\xf4\x112\xba\x86\xe1\xf2\xdb\x03m\xc5!A\x8e\xe2}\xbf\xfe\xc2\r̾.X\xab\xd3̣@\xda\r\xbaQ\x919\xab\xf5\x01w,\xd4\x05T\x03\xc4\"Z\xc1\x05\xd9\xeei\x02\xb4\xb1h\x1c\x8b\a\x98!\xe5Z\x8bځ\xc0\x9b\x82\x96\xa9\xc8͈qA( \xd2\xd0}\xb3D\xb5\xb1\xc7\xd5\xc7\x00\x14\xaa\xb9\xfb\x7f\xa9n\xfc.~\x937\xb3t_\xe6&\x98\xdc\x1b\xfd@\xf3\xf5\x8eW\xb9e\xc5n\xd9a\xe2:]!%\x8d\xa1VX\xfa\x84j\n\"]S\xbds\x1b{I\v,\xfdAR\x83\x9b\xa3\x88\xa3!\x81\xe5\xe6Pc\x88=\xf9\xe2b\x86\xd0ش\xb1B\xear\x8dW\xb9F\x10\x16\x8dx\xf7\x19\x02sJ\x80\x86\xcb0\x1d\xbe\xe1\x02\x85w\x86\"\xe8\x98\\e\xee#Eז\xaf\xd0\xc0\xb413x\xdd8{\x8a\x16\xad\x81^\x1d@\xb1\x93\xf4\x8ey\x82È\xea)\xa1\xd4\"\x05D(k\xa9\xb8\x11\x95\x02Z\r\xc4F\xb2y\xa6=9\xbe\xa5rZ\xf7\xcc@\xe5[\x96\xaa\xea\xb9a\x96\x99\xe4c\xc0.\xd47\tKS\x17x\xae\xe1\x17 \xa5\x8a\xea<\xe0\xec\xe2Y\")\x8d\xd4\f\xae\xa6\x11w\x01`\x97\xb5\\_\x88\xc3\xcb\xd1\x7f\x13@sV\x03\xee\xa1iq\u0094\xfa\x84\xd4ĽϷ\xc7P\xb5\xc0ùz\xf8\x86XO\xf7\x99P\x8c4J\xfdC)ᠯ\xf6\xc0§\x7f\xd5-!\xa6\x1b\xdfl\x7f/\x90\xbf\x84rgp\xd0\xc3&\xf8\x18\x11\xa2\x9c\r1\xce\xc4\xe27䖔\x06-\xaf\xb9s\n@t\x9d䘱m\xb5\xb9\xe9\x85@\xedjG\xa6 \xefi˫b\x11V\xc5q\xe2Py\x14+\x9e`\x9b\xaa\xe8\xf0\xcej\xe6l\xb0e7\x03\xa9\xeeR\xc0z\xe63\x16\xbbVg\u05c8\x84\x02\xa3\xa3r\xa1X\xe6\x9c?PDͯ\x06\x98\xf3\xf5,\xa00\n\xaa\x94\x1a\x04\xa9郈 \fh]\x91\x99̋̈ L\xa0\xedki\xa2\x97\xba\x8d\f0\x81TK~%\xec%\\\x06b(2\xc6\xf0s\xddG\x91\x86\xdbk\x1e  \x1b+uw9\x90m\xe9\xfe!hU:\xdc\xd9\tfE\xbf\x98\xa8\x14,\xbc|\xc0\r\xd3u\x04\x02\xe1\xb2\x00\x19\u008a\xe2\t\xc60\xab\xb8\x89\xa7\n9~\xff\x00\x12\xb50(\x03\xfd&]\xf7mhfա\x85\xfb&\xcd|\x1e\xbcŗGe\xc6\xf8w\xc7\xf9\x85r^\x9d\xbfQ?\x1aS$\xfb\x96\xb8\xf7\x84\xfdFPΙ\x9b\xabV\x95V}\xcc\x1b\xa0n\xe0T\xa85\xfd\xa0\x11\x18p_\x9a\xe6\x12\xd54.\x06=\x88\xc8ÿP\x837\x1a7p\xc2\x02G\xe5\v\xb1c\xe2\r\x9aβ~jR\xae0\xd0]\x1d\xc7\xef\xe8kA\xee,\xf6\xcf\t\xeeT\x10l\xa7\xdee\xca2U\xe5\r7\x98\xe0\x0f\x012WE\x19\xcb\n\x85\xa0\x03\x7fǘ\x9c\x90o\x00\xee(\x92\x85\x03\x8a\xeb\xe6Z@\xa2\xe2Wl\xb9\xe5\x826\xc1\xc71\xdb0\x01\xc3\fE\xb2\x1e*\x9dK\xe2\xa0ɮ \b\x01\xb3\x86Uc\xaa\xd1p\x92S`\xf1F\xe2\xc6BC\xd1\n\xcdJ\xebF\xf4D.VTk\xdawU8\x1b\xcb*\xc1\xe9MTb\xb4&\x8a\x95R\xd2۱\u05c8S\xc0\xd05,U\xba\x11\xba\x94\xae\n\xee6nȗ\xd3*J\xaa\x7f\xa5\x98\xc5\xee\xe4\xfeRݵ\xceKO\xba\x04\xceU\x1c\xd8_\xb6\x00\x8a`\xab\xfab\t\f\x17\x98/ϔ'\xe2\x1e\xb9\x9a\xd9\xfc\xfcDz\\\xa8n\xba\x982\x9c\r~\xdfP\x82\xa2\xf3[\x12\x91\x02\xdd%q\xfd͇\xc0\x98\x8d\x12\xa7]\xd7r\x8b2\x05\x904\xf3ۇ\xcc@[6\xc2F\xbd3\x88\x9cѝ\x1bS\x96C:_\x98w+\xda\xfb\xe6\xa5#\x06n\xb5s\x9d\xc7b\x06S#\xee\n\xa8\xe1k\x8f\x12\xf0\x99m\xb0\xfd\xc6\xc5A\xce>\xda`\x8bR[lEk!E~\xeb\xcc̪\x17t\xbf#2\x1d\xe5\x80\xc2u\xe6!^\r\x9cj\agmSr\xc2\x02\xe9\xb5@f\x00\xb3M\xf1\x11J,\xb8_\x13*\x80n\x83\xff\x00\xd8O\xe9,{\x82PV\x8e.X\xbaX\xd0f\xbf3\x9a\u008b\u0084T\xa9\xb4/\x01\xc4\x1b\xac\xb3S\x05\xbbײ\x11\x13\xea\xa5ɗ\xe6).\x97\xac\xcaU\x15\xb3<GhƯ\x89\x95\x97M\xe3P*)\xbe\x86k\xe2\b\n\xe4V\x0f\xdc*\xfa?\xf8\x81\v\x9b@PX3\x90\xc9\xfb\x8c\xe4\x9c6\x0f\xc1R\x8a\x80\x1b\x1f\xbb1\xb7\xa4\x17\xeb\x88\x1d\x11\xd7\xfb\xc7 E\xd3u\xfc\x1f\xdcf\x18t\xbf\xcal\xd8pa\xf4b`\x93\xbc\xb35\xa8\xadA\xc4L\xd0\xe5J\xf3\x03[\x19#\xf8\xfdG\xd6 E\xf3\xe2V&\xc5[U\x88\f\xedK\x96\x0f2\xb3\\\xbb亯ܰE\xab'\xfa\xa5(\x82'\x03\xe2\x024\x18\xc8f\x19E\\\x0ee]@\xde\x13\xf0\xc0j\xb0\xa1@\xf9\xb0\x9c\x8f\xf5,\x00\x9f\xcc\xc1\xa0ҋcqÜ\xab\xcc\x01Pp\xf5\xee\vF\xe7;\xb7\xf8\x85\x04\x8b\xaa\xaa\xb7\xc4\xc6\x13\x91\xed\xd3\rf\x90\xa0\xbbz\x7fqEVX\x96c\x8fQPB\x86\x8f\x94\x1b\"\xec\x1e<B\xa1[6=\x1e{\x86\xecݖr@\xaaꗿ\xe0\x8d\xearYt\xe6\x00*\x11(\xee\n\x11\xc9w\xc5\xc7@\xa0\xd3T\x13\x98m\tX\xf2z\x9b\xa6\xc5\xd5\xfcb!\x14\xf4\x02e\xd5P\xb4\x1b\xdb\xfdF/0+pk\xe1T\x8a_\xb2a\xf4\xc0*`Eb\xa9B\xf3\xff\x00e\xc2!\x8d\x8f\xe2-\xce\x11\xb1\xf6\xb1nŪC\xf0\\Uf\xb9\x0f\xed\x19_HQ\x8de'\b\xbf\x11\xcb'\x80~R \x9cx\x87\xf0\x1f\xcc\x01l\x94S\xf3x\xa5]m\xfdCR\x8a\x06\xd5V\xe2_\xd1E\xd6r~\xa0\xadaɠ@\x15\xa0j\x84\xce|\x19\xaf\xda\x05\xb6<\x16\xec|\x97\x9f\xfb\x04\x85CZ\x98F\x8ctffURӏ\xf9(*\xa4\x95\xdc+\x95]\xf4\x9c\xd2\x19\xd5\xc7F\xae\xc0\x86\xeb\xdc\x00K+tB\xa1j\xe3\v\x96\xd4\xe5{\xc8B\xf0\x9e\x11g\x89a\xcf\x16\x1e\xa5\xae\x02v5\xf1\x0fx%ٿQ\x05\xc8\xe5wpSb\x13\v\x1f\x10\x03\x86\xda\xf5\xef\xf5)\xb2\xce\x16\xaa\xfb\x94\xa2\x12\xb2\xfeD\x8e\xf2\xd1k\xdb\x1c\x89e\xd9\xd30\x05n\xc8>\x8en\x05!\xbb\xbc\x18\xfb\x81\x00!cU\x00ڕE\x95\\˓\x0f\x05~=T\x05@L-\xad\xd6ॉp\x18\xea\x17\xeb\x0f\x95:\xf3\x0e\xd46\n\xbf\xc4\x01D\x83\x15\x8b\x1f\xa9\xbaߊy\xef\xcc0e\x01`h\xdaK\xc0̬\xd13ݮ0\xca֊\xabS\b\x0e\xa5\x95Þ\xff\x00\xec\x15\x80\xb4\xd86mw\b\ue684|\xf1.Ĭ\x7f\xd1e\b\a\x06\x7f\b\x8c\xc9y\xab\xfc\xca\xf4ֲ\xbf\x90\x8d\x14Zk\x9d\xe4\xd4j\xcax\xb4AY\xd4\x15\x9f;\x83\x00\x1b\x82\xee6\xf0[\b\xadk\x05116\x1f6\xa6\xff\x00\xb8wP\xa6Z\xd4@%\x96\x0e/\xcclT\x03G/\xb9[\xb06\xb8CW\xb8_;\x95\xd3;\x8d\x1a*>}Ev\b\x8bE[\xcf\xe6\x15hU\xaaw^\xfe\xa5\xed\\\x95\xb5@Jb'D\xbdD\x02\xf0A\xf1\x0e\xad\x0f\v\xfe\xeed!\x86Q\xb7\xd4\x00\x846\x0eB\xf4\x93\xb2<\x9f\xedD\x02\x1aՌ\x12\xe9\xcdZ\x16,\xa5\x15\xc1\xd0q\x12\xc3Y\xe8\xf9\x83\x97(q\x9f\xb3\xfe\xc5\xc1,;\xbd\xca\xe0#\xb5\xd1=\x91F\x9e3\xe6%eŊ\xe5:\x81V\xedͥ\xa1(*U\x1c\x8f\x89CR\xda\xff\x00\x0e\x19\xb9\xcbTb\x06\xa5\xb6\x83\x83\xea!(\xfc\x9f?q\t\xd9m\xe7?\x11U\x02<\xa3\xdf\xfa\xe1aT\xbb\xcc\xd7S\x97\xac\xa4\xa3\xe1\xdcF\xc0mkp\xfa\x82\x94%\xb7\xe6\t\xa3V\x81\x9awr\xbd=\v\xb1\x80,X-\x01OG\xf7\x06\x85B\x800\xfb\x82\x069\xdd/\xd7q3]\x1e^\xa6n*\xd0\xd5u\x13@r(\xd8\xd6\xe1\xc1P\n\xc9\xfe\xa6\xdc\x1c\xdd\xf8e\x85n\xbc\x94B0\x13\x16\xcb\xd2`\xbb0K\xa2\xb2\r\xf1\xf3(\vC\x06\xa6ؙ\n_2ċ\x90d\xcc\xc1;j\xeb\x91\xfd\xc3\xd1\f\xc3R\x9a\xd9\x1a \xb4Y\x02\xdb>\xa6\x93\x83Kw\xde\xe21\xfdY\x9a\xce\u0530Lf\xd9{\x9b\x1b\xd9g\x18\x851n\x95\xc4\xefԩ\n\x11\\\xbb\xb7\a\xc4\xd0\xcbV\xf3\xef\xc4&\x00\xe5\xe1\x17Fn\xd5\xe6\xbe#|8\r\r\x04\xb3%\x82\ax\x84\xdds\x85\xdb\x15-4_\xf1\x03(\xb0\xd3\xcc\x14\xbd\xf1\xbb\x19\x95\x94\v\xbbU\xca_b\x99J\xe5\x98\xc4FSK\xe2[bu\xeb\xdc\xdej\xba\xd5Cj\xe4\n͐\x8fn\xd5gr\x87F\x16\xbc\u05c8\x97\b\xbb\xfaCCNDR\x9dD\x1c\xec\x1a>I\x9c\x85\v\x81\x9f\xf7\xb9\x82\xca4JM\xddw\x1a\xb0\xdcgK\x7f\x1e\xa2\b\xdd\xf8(\xf9?\x9fܷ\x06\xd1l^<$1-\x15[DF\xf1V@y\xeeg,\xf2\bCU\x91\x82\xa5\"VR\x1f\xb5A\xf7\x02\x85\xc8\xc8Y.0\x80\xcer\x18\xccu\x02\xf6\xab\x8e\xe6>\f\x8bb@֞\x90\x0e\xae^\xa9\x14\xdd\xd8=Q\x10\xac\xd0]\x0e.VE\xc7\xc1\x89\nP\xb6ܾ\xa1x\b\n\x19z\x88\b\x17\x9a\xda]\x15T\xa3\xcc`\x95\xa3U\x81\xf3r\xd5Q3\x95/߉\x84\x10dO\xd7\xdcN@\xe3E\x96\xb5)V\xfc\xcbOf\xbb\x15\xe2%\xa4ld`\x83\x89\x14-\x10A%\xa8U\xef\xe6d\xc5a\x83^\xc6\f\v\xa3\x931r\x9bk\xfc\xcdcAe\xd5\xc7\xe2l\xd2\xdc\x0e\xca\xee$&\x80\x82\x8b\xf3\x10\x14\x192\xb0\xcfbU`g\x88t<\x85\x1aǩdD\x06=\x90\xb6|\x84@\xb1\xe1\xe5/\x88\x85*ڗm\xc2B\xca\xcd,\v\xb2\x06\x03#P\"\x03A\x8d\x01\x98h\x1f7\xe2\x01\xda\xec\xa3\xfb\x9c\xf1C\x19Y1\b\x1a\xa3\x1e\x95\x14\xa9\r\xad\xc3\rBlr\x9b\xf9\x9cS<\xb5\\f\x14\x15%\x1d\x1fԾ\xe7\x97uT\xe9\x85\xc4l\v\x95_>\"i\x18\x17\x97\x118,d\xbc\xf8\x80\x9c*\xf3\xf7\x02\xb5\x9a\xe3hz\xf1\x1c\xd3M\n\x14\xfaz\xf7:ّ\xa6\x87\x88\x88\x02\xec\x11g\xa7\xf8\x8b\x8d\x81\xa4\x95-A\xb0R\xc6\xc6\xd7+\x00\xcc`F8^\u0086e\x1a\x8eP\xceo\xb8\xcf\xfa\xcd\a\x1cA\x95Y1\xa0bj\x19d\x9a\xc2b\x17h\x80\x90\xd6-V\\\xd6(y2Kk\x02\x8bH\xac\xc1\xef0\x0e#s3\x87\xc4\x15M\r\ai+\x1bWD\x06\x97^\xceYo\x04U[u\x15\x17n\xa8\xfd%\x8a\xc2Z\x1c\xac\th\x1c\xb6/\x98SZ6<\xc3+\xac\xb3\x9f\xdcF\xc1\x95R\xcf)\\\xd0-\xab7\x88\xdbd\xce\x0f&\x05\x05\x82\x93\x9a\xfb\x89\x83\x81\xc3_\xb8\xa0+ⷘ\xb9g\x0eU~\"\x12]\xed\xe4\x8f\x10^e\xe3\x89r\xf5\xaa\xc1U[aYJ\xd5j\x0f_\xee\"\t\x82\xec.\v\xe5\x8f\x06|̀9\x7f\t\x9b\x8aj\xbcÃw2}\xc7\x18/;\xb9z-\xab\n\xf3\x03!,r.38T[w\xd9\x16\x87 \xbby\x84\x0e\xa3\x98\x91Yv\x15U\xfa!ku~\x0f<LX\x1c\x8ej\xcf\xf1\x11\xaf\xa7\xd6\xc9B\xe6,^\xa5\x15\xb2R\x8e\xe0\xd8*\x94b\xe1\xdcai\xf69=J\U000fb9e7\xd4\x00Ć+^eDn\x95>\xc6Vr|Ŵ\xe8-\x97\xa4\x99\a\x1e\xa6!\x14k\x19\x0f\x9d\xcf@\x87p\x1d\x15\xa8\xb4\x00\x9aW\x10y\xf4\x06\xdfq\x03_\x15\xf1\x88TG\xd1\xc7\xccd\xa6\x8b1.U\x95\x86ߓ\x1b\x10^\xaf1epd\fP\xbe\xb2Q\xbf\b\x82\xaa+d\xd5\xc0\x86L\xab\xd74sP\x05\x10\xa0\x05\xbeq\x1a\aX\x13\xbb\xf9\x98\x8d\x9a\x00b\xb8\xcc\x0e(ќ\t\xf1\xe6d\x8ck\rQ\xe6\vgC\xc9{\xcc4a2\x9b\xc9\xf7\x1b\xad\x9b\b7\x12\x16\xe0\xbb\x1d\x846F\x8e~\xe0G=O0k\xbe\x058\xa9`S5W\x85\xf7\b\x15\x03<\x87\xb82\x81\x86\x97\xf3R\xcf\f\x80\x1b\x8e\x17av\x1b\xcd\xe6b bʾa\xf8F\x0ee\x01\xb6\xf1\x9b\xc4\\\x91M6\x96\xce2Z\xeb\xcc\x1a\tO\x85\xf8\x95\xe4\x9f\xeb0]l\fV)\x13pڨ\xccQT\xb7\x13=\xc9-\x05x\xd7pv\n\xed7\xff\x00fA6\x14\xc9\xd4\x140\x11\xbbW\xbb\x8e,4!\x9c \xf9j\xb7!Ԡ:\r\xabk\xf1\bޠ\x8d\xd9~ J\x01\x80\xb2\xba\x87<@\xe8G\x02\xaah\xc1|\xb3\t\xa8\xd5p\x7f\x10\x16G\xa1\xa3\xa8`ʋN\x88\x00\x1b\x93u-\xb8\x16.\xa3t\xc4\xe6\x16\xceSZ\x94\x00\xc5\xd7\x06\xae\x06\xf06Z\xf6y\x86p\x0f-@4T\xbb7hk\xea!P\xc8\xd55\x06X\x01\xca\xfa\xff\x00\x90M\xaeY\xfd#\x14\xado{\x97\xad\xf0\x8d\x1f\x12ślS\x87\xae#w[].\xeahH\u061c\xc2 d]\xdf2\u0083T!\x87<\xc0V\x16ˇ\xd4z\xa99j\xeaZ9\xfa\xfa\xaf\f\xc8Fڦ\xe2\xf2\xc2\xd6\xe6Ja6Q\x84\xb0<\xd6\xcf\xf9\x0e\x19\x01\\\xae\x18\xf1!\x83Sbe\xb5f\xfb\x89[\x99\xbawՒ\xb4\xafJ\x10ܧ鶾e[\x01\xac\x8a%\xf2\x8e\xac2{\x8e\r+\x0fR\xf1P(\xd2\x00\"\x82\xfc\x8eb\x05\x06\v^c\x16\x00\xea\xaeY⺴_\xa9LT`\x84cA\xb6響Q1k\xb5\f\xd4V\x84\f\x81\x83\xdcm\xc1\xed\x8d+\xcbԯ/\x8cʌ\xbaĮVy\x8e\xae\xb0(\xd9\x7f\xef\xdc\xda@\tCL\xb8vRZs\xd6\x1c\x12Y\\Ө\f\xa1\xc0\xeb4@un\xe5DBe\x85\xc9P\x02wE]\xff\x00\xc9x\x81\xc89\xa7\x82\x0e\x10\xd3\xea\n\x90x8\x8a\x12ƭġu\x03퍀=\xab*\xd2\xe4m\xcckz\x82\x82\xfe\xfa\x80\xb4\x02Q?\xe91ع\x9a\t\xecbH ݚ\xb8\x17\x14\xb8\xaa\xd45\x90ț1Ԩc/3-\x01\xb3K\xf9\x81p\xa1\xe3oDB\xd1\v\xbd\x8f\xf4\xc04\xc9mA\xf5\f\xba\xa6hh\xc70/g\xa0)\xf7r\xb2\x06\xd7[\xf5\x1e\x94c.\xe2\x15:ݲ\xc0\xa9\x82\xebr\xb9\x02\xf4\xfe\xa5^\xbbNN\x8f\x12\xed4\x0f\x03\xb6\b8\x8d\x15\x8cw_\xb8Օ\x9e\v\xa8\xbc\xb4\xe4\x06\xfb\xb8\x84p\x02\xb1\x19Qr\xdc\xc5\xc4;\x94/1\x01Θ`\x97|jPϩ\x8b\x10\xaf\x96\be\xd3V\n\x18h{\x8c\xa1:\xb8\xc3\xf9\x84\xceQb\xdekC\x81\xf7\x15\a!Fe\\R\x8c\xa1\xbf\x98\x94Q\r+\x89\x83\v\xb7;\xa9[\xc8,h\xf9\xaf\x88\xa0\b\xb28\x11V\xf7\x9f\x11\x80\xa0k\x06\xfe\xe6cS\x86\x8b>8\x99R\r\x0e.H\xaa\xfc,\n\xc64\x05tx\xeaR$o\xb7\x10\x02\x83\x9b\xbdbUBpq\xfe\xc8\xe0\x14\xa8<\xa5\r\x1b\xb3I(\t\x8bbf \xda.\xbcE\xaaW\xb7l\x02\xcbKuqvjf\fL\x0f\xb1\x15n\x16k\x15\xea \xa2\xc5\x0f$\"\x85\xb4\x8ee@rS\\\x91\xe3\n+\x85\xc0\x9e\x01,\xd9\t\xb8\xd51\xb3-\x97H\xba\x8a\x05\x902\xc2\xf5m\x8crA\xa6M\x00\xe8+\xeea\x16/\x98\x10\xa8\x14\xad\xb3\xe6\r\x85\x00,t\xbe!\r\x95\u0080\xdf\x10\xb8!_\x1b\x98ѯ\x94ep\xaanq\x06Ԭ\xc3\xf7{\xfb\x8e\b\x85\xadD]\x89\x83\xa6[\rג\xda-\xe9KcI\xc7\xcc$\x8d\x0e4\xbb\xdc\x10[\xb6Ig\x10.\x82\xaa\a*\xbck?\x12\xf4҂\xfb\xa8\x06\x81\tC/\xeeYp_(\x15\x03Um.b\x9f.\x8c\x9f\x11/\x92\xf5\xd24\xabr\xe5\x1cw\x01I\x03\x93\x98\xd8+ft\xa7\xb8\xc0\xb6̈́\x80\x8a\xfaʢ8\x8b\xa3j\xd8\xc0&\xb6\xf25LF\xb5\xb9\xb9\xba\vٺ\x94J;\x8e`_44\xbb\xf5)ϡA)\x15P\xc3\xf9\x89\xccI\xadNe*f9}ʹ\x05\x9dL\xd1e\x8d\x89\x11\boV\x1a\xf6C\ra\xc0ʣ\x1br\xf3+-\xcfP\xa8\x05A\xf0\xb2J1>\x93\x89\t\xc9\x10\xe8\xb1\xcb\x19T\n\xc6(F\x8c=\xb0\xa1\x02\r\xd3*\"\xd34\xe2Uŗt`%\xca\xc9\xe5\x15|;\\J\xc1F\xf1\x10\xfa;\f\xfb\x82W\xab#Y}\xcc\xf0^\x10\xdb\x12\xb5\xa0qs'a\x9b\xc2\x19\xae\x88\x04\xb2\x01\x01\x17 \xe5!\xf8E\xb2\xd43\x1a\xcaq.\x1d\xc1\x8f[\x97+<\r\xe6(\x97Yl2C\x15K\x16c\x12\xfd\n\xc9iܷ\x18\x10p\x9b\x85\x8f\x06ޙP\xa3\x06\x9aek\x88\rLwg\x89\x83\r\x1c\xf2\xd7q`\x18pG\xfc\x1b\x06\ba\xb5\xb5\xbdK\x88\xca\xe4\xe1\x96\x1a_\xfa`P\x86ƀ\xfd\xc6|p\xb1\x02\xc50ޡ\xca\xea\xd4\xf1\x02\xca:)\x83\xb1\t\xd5LFV\xed\xde#1\xc8@\xef\xc4\xcf\x00\x1e3\x88a^\xab\x1c\\x\x02\xabx7.\x88\xa8q\x94\xc4\xf9\xf6'\x10k\x00(FfAW]\"C\x16\x84\x9bHh\x9f\xff\xd9"),
 }
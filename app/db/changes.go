@@ -5,12 +5,18 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/mmcloughlin/goperf/app/change"
 	"github.com/mmcloughlin/goperf/app/db/internal/db"
 	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/app/trace"
+	"github.com/mmcloughlin/goperf/internal/errutil"
 )
 
 // StoreChangesBatch writes the given changes to the database in a single batch.
@@ -21,18 +27,217 @@ func (d *DB) StoreChangesBatch(ctx context.Context, cs []*entity.Change) error {
 	})
 }
 
-// ReplaceChanges transactionally deletes changes in a range and inserts supplied changes.
-func (d *DB) ReplaceChanges(ctx context.Context, r entity.CommitIndexRange, cs []*entity.Change) error {
-	return d.tx(ctx, func(tx *sql.Tx) error {
-		if err := d.q.WithTx(tx).DeleteChangesCommitRange(ctx, db.DeleteChangesCommitRangeParams{
-			CommitIndexMin: int32(r.Min),
-			CommitIndexMax: int32(r.Max),
+// ReplaceTraceChanges transactionally replaces changes for a single trace in
+// the commit range r, and marks the trace update u as processed. If the trace
+// has been updated again since u was read, the update is left pending. Changes
+// that moved to a different commit, as determined by change.Match, are the
+// same change: their triage and pending alerts follow them. Returns the
+// changes that match no previous change, which are queued for alerting to each
+// of the named subscriptions subs.
+func (d *DB) ReplaceTraceChanges(ctx context.Context, u *entity.TraceUpdate, r entity.CommitIndexRange, cs []*entity.Change, subs []string) ([]*entity.Change, error) {
+	var added []*entity.Change
+	err := d.tx(ctx, func(tx *sql.Tx) error {
+		q := d.q.WithTx(tx)
+
+		// Determine existing changes.
+		rows, err := q.TraceChanges(ctx, db.TraceChangesParams{
+			BenchmarkUUID:   u.BenchmarkUUID,
+			EnvironmentUUID: u.EnvironmentUUID,
+			CommitIndexMin:  int32(r.Min),
+			CommitIndexMax:  int32(r.Max),
+		})
+		if err != nil {
+			return err
+		}
+
+		prev := make([]change.Change, len(rows))
+		for i, row := range rows {
+			prev[i] = change.Change{
+				CommitIndex: int(row.CommitIndex),
+				EffectSize:  row.EffectSize,
+			}
+		}
+
+		next := make([]change.Change, len(cs))
+		for i, c := range cs {
+			next[i] = c.Change
+		}
+
+		moves := change.Match(prev, next)
+		matched := map[int]bool{}
+		for _, dst := range moves {
+			matched[dst] = true
+		}
+
+		added = nil
+		for _, c := range cs {
+			if !matched[c.CommitIndex] {
+				added = append(added, c)
+			}
+		}
+
+		// Replace.
+		if err := q.DeleteTraceChangesCommitRange(ctx, db.DeleteTraceChangesCommitRangeParams{
+			BenchmarkUUID:   u.BenchmarkUUID,
+			EnvironmentUUID: u.EnvironmentUUID,
+			CommitIndexMin:  int32(r.Min),
+			CommitIndexMax:  int32(r.Max),
 		}); err != nil {
 			return err
 		}
 
-		return d.storeChangesBatch(ctx, tx, cs)
+		if len(cs) > 0 {
+			if err := d.storeChangesBatch(ctx, tx, cs); err != nil {
+				return err
+			}
+		}
+
+		id := trace.ID{
+			BenchmarkUUID:   u.BenchmarkUUID,
+			EnvironmentUUID: u.EnvironmentUUID,
+		}
+		if err := moveChangeTriage(ctx, q, id, moves); err != nil {
+			return err
+		}
+
+		if err := replaceChangeAlerts(ctx, q, id, r, moves, added, subs); err != nil {
+			return err
+		}
+
+		return q.DeleteTraceUpdate(ctx, db.DeleteTraceUpdateParams{
+			BenchmarkUUID:   u.BenchmarkUUID,
+			EnvironmentUUID: u.EnvironmentUUID,
+			CommitIndexMin:  int32(u.CommitIndexRange.Min),
+			CommitIndexMax:  int32(u.CommitIndexRange.Max),
+		})
 	})
+	return added, err
+}
+
+// replaceChangeAlerts updates pending alerts for changes to trace id in the
+// commit range r. Pending alerts follow moved changes and are dropped for
+// changes that no longer exist. Alerts are queued for added changes to every
+// subscription in subs.
+func replaceChangeAlerts(ctx context.Context, q *db.Queries, id trace.ID, r entity.CommitIndexRange, moves map[int]int, added []*entity.Change, subs []string) error {
+	pending, err := q.DeleteTraceChangeAlertsCommitRange(ctx, db.DeleteTraceChangeAlertsCommitRangeParams{
+		BenchmarkUUID:   id.BenchmarkUUID,
+		EnvironmentUUID: id.EnvironmentUUID,
+		CommitIndexMin:  int32(r.Min),
+		CommitIndexMax:  int32(r.Max),
+	})
+	if err != nil {
+		return err
+	}
+
+	var alerts []db.DeleteTraceChangeAlertsCommitRangeRow
+	for _, a := range pending {
+		if dst, ok := moves[int(a.CommitIndex)]; ok {
+			a.CommitIndex = int32(dst)
+			alerts = append(alerts, a)
+		}
+	}
+	for _, c := range added {
+		for _, sub := range subs {
+			alerts = append(alerts, db.DeleteTraceChangeAlertsCommitRangeRow{
+				CommitIndex:  int32(c.CommitIndex),
+				Subscription: sub,
+			})
+		}
+	}
+
+	for _, a := range alerts {
+		if err := q.InsertChangeAlert(ctx, db.InsertChangeAlertParams{
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndex:     a.CommitIndex,
+			Subscription:    a.Subscription,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListPendingChangeAlerts returns changes that have not yet been alerted to
+// each subscription, keyed by subscription name.
+func (d *DB) ListPendingChangeAlerts(ctx context.Context) (map[string][]*entity.Change, error) {
+	pending := map[string][]*entity.Change{}
+	err := d.txq(ctx, func(q *db.Queries) error {
+		rows, err := q.ChangeAlerts(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			pending[row.Subscription] = append(pending[row.Subscription], mapChange(db.Change{
+				BenchmarkUUID:   row.BenchmarkUUID,
+				EnvironmentUUID: row.EnvironmentUUID,
+				CommitIndex:     row.CommitIndex,
+				EffectSize:      row.EffectSize,
+				PreN:            row.PreN,
+				PreMean:         row.PreMean,
+				PreStddev:       row.PreStddev,
+				PostN:           row.PostN,
+				PostMean:        row.PostMean,
+				PostStddev:      row.PostStddev,
+				PValue:          row.PValue,
+				PercentCILower:  row.PercentCILower,
+				PercentCIUpper:  row.PercentCIUpper,
+				Confirmation:    row.Confirmation,
+			}))
+		}
+		return nil
+	})
+	return pending, err
+}
+
+// MarkChangeAlertsDelivered records that alerts for the changes cs have been
+// delivered to the named subscription, removing them from its pending list.
+func (d *DB) MarkChangeAlertsDelivered(ctx context.Context, sub string, cs []*entity.Change) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		for _, c := range cs {
+			if err := q.DeleteChangeAlert(ctx, db.DeleteChangeAlertParams{
+				BenchmarkUUID:   c.BenchmarkUUID,
+				EnvironmentUUID: c.EnvironmentUUID,
+				CommitIndex:     int32(c.CommitIndex),
+				Subscription:    sub,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func mapChange(c db.Change) *entity.Change {
+	return &entity.Change{
+		ID: trace.ID{
+			BenchmarkUUID:   c.BenchmarkUUID,
+			EnvironmentUUID: c.EnvironmentUUID,
+		},
+		Change: change.Change{
+			CommitIndex: int(c.CommitIndex),
+			EffectSize:  c.EffectSize,
+			Pre: change.Stats{
+				N:        int(c.PreN),
+				Mean:     c.PreMean,
+				Variance: c.PreStddev * c.PreStddev,
+			},
+			Post: change.Stats{
+				N:        int(c.PostN),
+				Mean:     c.PostMean,
+				Variance: c.PostStddev * c.PostStddev,
+			},
+			Significance: change.Significance{
+				PValue: c.PValue,
+				PercentCI: change.Interval{
+					Lower: c.PercentCILower,
+					Upper: c.PercentCIUpper,
+				},
+			},
+		},
+	}
 }
 
 func (d *DB) storeChangesBatch(ctx context.Context, tx *sql.Tx, cs []*entity.Change) error {
@@ -47,6 +252,9 @@ func (d *DB) storeChangesBatch(ctx context.Context, tx *sql.Tx, cs []*entity.Cha
 		"post_n",
 		"post_mean",
 		"post_stddev",
+		"p_value",
+		"percent_ci_lower",
+		"percent_ci_upper",
 	}
 	values := []interface{}{}
 	for _, c := range cs {
@@ -61,6 +269,9 @@ func (d *DB) storeChangesBatch(ctx context.Context, tx *sql.Tx, cs []*entity.Cha
 			c.Post.N,
 			c.Post.Mean,
 			c.Post.Stddev(),
+			c.PValue,
+			c.PercentCI.Lower,
+			c.PercentCI.Upper,
 		)
 	}
 	return d.insert(ctx, tx, "changes", fields, values)
@@ -69,15 +280,24 @@ func (d *DB) storeChangesBatch(ctx context.Context, tx *sql.Tx, cs []*entity.Cha
 // BuildChangesRanked derives the ranked changes table from the changes table.
 func (d *DB) BuildChangesRanked(ctx context.Context) error {
 	return d.txq(ctx, func(q *db.Queries) error {
+		// Clear the existing ranking, since changes may have been deleted or
+		// replaced since it was last built.
+		if err := q.DeleteChangesRanked(ctx); err != nil {
+			return err
+		}
 		return q.BuildChangesRanked(ctx)
 	})
 }
 
 // ChangeFilter specifies thresholds for change listings.
 type ChangeFilter struct {
+	RepositoryUUID            uuid.UUID // zero means the Go repository
 	MinEffectSize             float64
 	MaxRankByEffectSize       int
 	MaxRankByAbsPercentChange int
+	MaxPValue                 float64              // zero means no limit
+	TriageStates              []entity.TriageState // empty means all states
+	Types                     []change.Type        // empty means all types
 }
 
 // ListChangeSummariesForCommitIndex returns changes at a specific commit.
@@ -97,25 +317,52 @@ func (d *DB) ListChangeSummaries(ctx context.Context, r entity.CommitIndexRange,
 }
 
 func listChangeSummaries(ctx context.Context, q *db.Queries, r entity.CommitIndexRange, filter ChangeFilter) ([]*entity.ChangeSummary, error) {
+	states := filter.TriageStates
+	if len(states) == 0 {
+		states = entity.TriageStateValues()
+	}
+	triagestates, err := toTriageStates(states)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := filter.RepositoryUUID
+	if repo == uuid.Nil {
+		repo = entity.GoRepository.UUID()
+	}
+
 	rows, err := q.ChangeSummaries(ctx, db.ChangeSummariesParams{
+		RepositoryUUID:            repo,
 		EffectSizeMin:             filter.MinEffectSize,
 		CommitIndexMin:            int32(r.Min),
 		CommitIndexMax:            int32(r.Max),
 		RankByEffectSizeMax:       zeroToMax32(filter.MaxRankByEffectSize),
 		RankByAbsPercentChangeMax: zeroToMax32(filter.MaxRankByAbsPercentChange),
+		PValueMax:                 zeroToNaN(filter.MaxPValue),
+		TriageStates:              triagestates,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	cs := make([]*entity.ChangeSummary, len(rows))
-	for i, row := range rows {
+	cs := make([]*entity.ChangeSummary, 0, len(rows))
+	for _, row := range rows {
 		params := map[string]string{}
 		if err := json.Unmarshal(row.Parameters, &params); err != nil {
 			return nil, fmt.Errorf("decode parameters: %w", err)
 		}
 
-		cs[i] = &entity.ChangeSummary{
+		confirmation, err := mapChangeConfirmation(row.Confirmation)
+		if err != nil {
+			return nil, err
+		}
+
+		state, err := mapTriageState(row.TriageState)
+		if err != nil {
+			return nil, err
+		}
+
+		c := &entity.ChangeSummary{
 			Benchmark: &entity.Benchmark{
 				Package: &entity.Package{
 					Module: &entity.Module{
@@ -145,16 +392,360 @@ func listChangeSummaries(ctx context.Context, q *db.Queries, r entity.CommitInde
 					Mean:     row.PostMean,
 					Variance: row.PostStddev * row.PostStddev,
 				},
+				Significance: change.Significance{
+					PValue: row.PValue,
+					PercentCI: change.Interval{
+						Lower: row.PercentCILower,
+						Upper: row.PercentCIUpper,
+					},
+				},
+			},
+			Confirmation: confirmation,
+			Triage: entity.Triage{
+				State:    state,
+				IssueURL: row.TriageIssueURL,
+				Note:     row.TriageNote,
 			},
 		}
+
+		if !hasChangeType(filter.Types, c.Type()) {
+			continue
+		}
+
+		cs = append(cs, c)
 	}
 
 	return cs, nil
 }
 
+// hasChangeType reports whether t is one of types. An empty list matches all
+// types.
+func hasChangeType(types []change.Type, t change.Type) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, u := range types {
+		if u == t {
+			return true
+		}
+	}
+	return false
+}
+
 func zeroToMax32(x int) int32 {
 	if x == 0 {
 		return math.MaxInt32
 	}
 	return int32(x)
 }
+
+// zeroToNaN maps zero to NaN, for use as an upper bound that admits all
+// values. Postgres orders NaN above all other floating point values.
+func zeroToNaN(x float64) float64 {
+	if x == 0 {
+		return math.NaN()
+	}
+	return x
+}
+
+// ChangeRepeats is a change with repeat samples either side of it.
+type ChangeRepeats struct {
+	Change  *entity.Change
+	Repeats []change.Repeat
+}
+
+// ListUnconfirmedChangeRepeats returns unconfirmed changes in the commit range
+// r of the given repository, together with repeat samples from workers other
+// than those that produced the change's results. Repeat samples are taken from
+// the change commit and the previous commit with results in the change's trace,
+// and grouped by the worker that ran them. Workers are compared rather than
+// environments, since a rerun on a second identical machine shares the
+// original environment.
+func (d *DB) ListUnconfirmedChangeRepeats(ctx context.Context, repo uuid.UUID, r entity.CommitIndexRange) ([]*ChangeRepeats, error) {
+	var crs []*ChangeRepeats
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		crs, err = listUnconfirmedChangeRepeats(ctx, q, repo, r)
+		return err
+	})
+	return crs, err
+}
+
+func listUnconfirmedChangeRepeats(ctx context.Context, q *db.Queries, repo uuid.UUID, r entity.CommitIndexRange) ([]*ChangeRepeats, error) {
+	rows, err := q.UnconfirmedChanges(ctx, db.UnconfirmedChangesParams{
+		RepositoryUUID: repo,
+		CommitIndexMin: int32(r.Min),
+		CommitIndexMax: int32(r.Max),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	crs := make([]*ChangeRepeats, len(rows))
+	for i, row := range rows {
+		c := &entity.Change{
+			ID: trace.ID{
+				BenchmarkUUID:   row.BenchmarkUUID,
+				EnvironmentUUID: row.EnvironmentUUID,
+			},
+			Change: change.Change{
+				CommitIndex: int(row.CommitIndex),
+				EffectSize:  row.EffectSize,
+				Pre: change.Stats{
+					N:        int(row.PreN),
+					Mean:     row.PreMean,
+					Variance: row.PreStddev * row.PreStddev,
+				},
+				Post: change.Stats{
+					N:        int(row.PostN),
+					Mean:     row.PostMean,
+					Variance: row.PostStddev * row.PostStddev,
+				},
+				Significance: change.Significance{
+					PValue: row.PValue,
+					PercentCI: change.Interval{
+						Lower: row.PercentCILower,
+						Upper: row.PercentCIUpper,
+					},
+				},
+			},
+		}
+
+		// Fetch repeat samples, grouped by worker.
+		points, err := q.ChangeRepeatPoints(ctx, db.ChangeRepeatPointsParams{
+			BenchmarkUUID:   row.BenchmarkUUID,
+			EnvironmentUUID: row.EnvironmentUUID,
+			PreCommitIndex:  row.PreCommitIndex,
+			PostCommitIndex: row.CommitIndex,
+			RepositoryUUID:  repo,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var repeats []change.Repeat
+		for j, p := range points {
+			if j == 0 || p.Worker != points[j-1].Worker {
+				repeats = append(repeats, change.Repeat{})
+			}
+			r := &repeats[len(repeats)-1]
+			if p.CommitIndex == row.CommitIndex {
+				r.Post = append(r.Post, p.Value)
+			} else {
+				r.Pre = append(r.Pre, p.Value)
+			}
+		}
+
+		crs[i] = &ChangeRepeats{
+			Change:  c,
+			Repeats: repeats,
+		}
+	}
+
+	return crs, nil
+}
+
+// SetChangeConfirmation records the confirmation status of the change to trace
+// id at commit index idx.
+func (d *DB) SetChangeConfirmation(ctx context.Context, id trace.ID, idx int, c change.Confirmation) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		confirmation, err := toChangeConfirmation(c)
+		if err != nil {
+			return err
+		}
+		return q.UpdateChangeConfirmation(ctx, db.UpdateChangeConfirmationParams{
+			Confirmation:    confirmation,
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndex:     int32(idx),
+		})
+	})
+}
+
+func toChangeConfirmation(c change.Confirmation) (db.ChangeConfirmation, error) {
+	if !c.IsAConfirmation() {
+		return "", errutil.AssertionFailure("invalid change confirmation")
+	}
+	switch c {
+	case change.ConfirmationUnconfirmed:
+		return db.ChangeConfirmationUnconfirmed, nil
+	case change.ConfirmationConfirmed:
+		return db.ChangeConfirmationConfirmed, nil
+	case change.ConfirmationRefuted:
+		return db.ChangeConfirmationRefuted, nil
+	default:
+		return "", errutil.UnhandledCase(c)
+	}
+}
+
+func mapChangeConfirmation(c db.ChangeConfirmation) (change.Confirmation, error) {
+	switch c {
+	case db.ChangeConfirmationUnconfirmed:
+		return change.ConfirmationUnconfirmed, nil
+	case db.ChangeConfirmationConfirmed:
+		return change.ConfirmationConfirmed, nil
+	case db.ChangeConfirmationRefuted:
+		return change.ConfirmationRefuted, nil
+	default:
+		return 0, errutil.UnhandledCase(c)
+	}
+}
+
+// FindChangeTriage returns the triage of the change to trace id at commit
+// index idx. Returns an untriaged state if none has been recorded.
+func (d *DB) FindChangeTriage(ctx context.Context, id trace.ID, idx int) (*entity.Triage, error) {
+	var t *entity.Triage
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		t, err = findChangeTriage(ctx, q, id, idx)
+		return err
+	})
+	return t, err
+}
+
+func findChangeTriage(ctx context.Context, q *db.Queries, id trace.ID, idx int) (*entity.Triage, error) {
+	t, err := q.ChangeTriage(ctx, db.ChangeTriageParams{
+		BenchmarkUUID:   id.BenchmarkUUID,
+		EnvironmentUUID: id.EnvironmentUUID,
+		CommitIndex:     int32(idx),
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return &entity.Triage{State: entity.TriageStateUntriaged}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	state, err := mapTriageState(t.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Triage{
+		State:       state,
+		IssueURL:    t.IssueURL,
+		Note:        t.Note,
+		LastUpdated: t.LastUpdated,
+	}, nil
+}
+
+// SetChangeTriage records the triage of the change to trace id at commit index
+// idx, replacing any existing triage. The last updated time is set to the
+// current time.
+func (d *DB) SetChangeTriage(ctx context.Context, id trace.ID, idx int, t *entity.Triage) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		state, err := toTriageState(t.State)
+		if err != nil {
+			return err
+		}
+		return q.UpsertChangeTriage(ctx, db.UpsertChangeTriageParams{
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndex:     int32(idx),
+			State:           state,
+			IssueURL:        t.IssueURL,
+			Note:            t.Note,
+			LastUpdated:     time.Now(),
+		})
+	})
+}
+
+// moveChangeTriage moves triage of changes to trace id according to moves, a
+// map from previous to new commit index. Triage at a destination is replaced.
+func moveChangeTriage(ctx context.Context, q *db.Queries, id trace.ID, moves map[int]int) error {
+	// Read all triage to be moved before writing any, since destinations may
+	// also be sources.
+	var triages []db.ChangeTriage
+	for src, dst := range moves {
+		if src == dst {
+			continue
+		}
+		t, err := q.ChangeTriage(ctx, db.ChangeTriageParams{
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndex:     int32(src),
+		})
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			continue
+		case err != nil:
+			return err
+		}
+
+		if err := q.DeleteChangeTriage(ctx, db.DeleteChangeTriageParams{
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndex:     int32(src),
+		}); err != nil {
+			return err
+		}
+
+		t.CommitIndex = int32(dst)
+		triages = append(triages, t)
+	}
+
+	for _, t := range triages {
+		if err := q.UpsertChangeTriage(ctx, db.UpsertChangeTriageParams{
+			BenchmarkUUID:   t.BenchmarkUUID,
+			EnvironmentUUID: t.EnvironmentUUID,
+			CommitIndex:     t.CommitIndex,
+			State:           t.State,
+			IssueURL:        t.IssueURL,
+			Note:            t.Note,
+			LastUpdated:     t.LastUpdated,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toTriageStates(states []entity.TriageState) ([]db.TriageState, error) {
+	ss := make([]db.TriageState, 0, len(states))
+	for _, state := range states {
+		s, err := toTriageState(state)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+	return ss, nil
+}
+
+func toTriageState(s entity.TriageState) (db.TriageState, error) {
+	if !s.IsATriageState() {
+		return "", errutil.AssertionFailure("invalid triage state")
+	}
+	switch s {
+	case entity.TriageStateUntriaged:
+		return db.TriageStateUntriaged, nil
+	case entity.TriageStateAcknowledged:
+		return db.TriageStateAcknowledged, nil
+	case entity.TriageStateExpected:
+		return db.TriageStateExpected, nil
+	case entity.TriageStateFalsePositive:
+		return db.TriageStateFalsePositive, nil
+	case entity.TriageStateFixed:
+		return db.TriageStateFixed, nil
+	default:
+		return "", errutil.UnhandledCase(s)
+	}
+}
+
+func mapTriageState(s db.TriageState) (entity.TriageState, error) {
+	switch s {
+	case db.TriageStateUntriaged:
+		return entity.TriageStateUntriaged, nil
+	case db.TriageStateAcknowledged:
+		return entity.TriageStateAcknowledged, nil
+	case db.TriageStateExpected:
+		return entity.TriageStateExpected, nil
+	case db.TriageStateFalsePositive:
+		return entity.TriageStateFalsePositive, nil
+	case db.TriageStateFixed:
+		return entity.TriageStateFixed, nil
+	default:
+		return 0, errutil.UnhandledCase(s)
+	}
+}
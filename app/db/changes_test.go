@@ -2,11 +2,14 @@ package db_test
 
 import (
 	"context"
+	"math"
 	"testing"
 
+	"github.com/mmcloughlin/goperf/app/db"
 	"github.com/mmcloughlin/goperf/app/db/dbtest"
 	"github.com/mmcloughlin/goperf/app/entity"
 	"github.com/mmcloughlin/goperf/app/internal/fixture"
+	"github.com/mmcloughlin/goperf/app/trace"
 )
 
 func TestDBStoreChangesBatch(t *testing.T) {
@@ -19,11 +22,11 @@ func TestDBStoreChangesBatch(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = db.StoreCommit(ctx, fixture.Commit); err != nil {
+	if err = db.StoreCommit(ctx, entity.GoRepository.UUID(), fixture.Commit); err != nil {
 		t.Fatal(err)
 	}
 
-	if err = db.StoreCommitPosition(ctx, fixture.CommitPosition); err != nil {
+	if err = db.StoreCommitPosition(ctx, entity.GoRepository.UUID(), fixture.CommitPosition); err != nil {
 		t.Fatal(err)
 	}
 
@@ -37,3 +40,366 @@ func TestDBStoreChangesBatch(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDBReplaceTraceChanges(t *testing.T) {
+	db := dbtest.Open(t)
+
+	// Store a result at a known commit position.
+	ctx := context.Background()
+	if err := db.StoreCommit(ctx, entity.GoRepository.UUID(), fixture.Commit); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.StoreCommitPosition(ctx, entity.GoRepository.UUID(), fixture.CommitPosition); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.StoreResult(ctx, fixture.Result); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect a pending update for the trace.
+	id := fixture.Change.ID
+	u := findTraceUpdate(t, db, id)
+	if u == nil {
+		t.Fatal("expected trace update")
+	}
+
+	if u.CommitIndexRange.Min > fixture.CommitPosition.Index || u.CommitIndexRange.Max < fixture.CommitPosition.Index {
+		t.Fatalf("trace update range %s does not contain commit index %d", u.CommitIndexRange, fixture.CommitPosition.Index)
+	}
+
+	// Replace changes and confirm the update is cleared.
+	added, err := db.ReplaceTraceChanges(ctx, u, u.CommitIndexRange, []*entity.Change{fixture.Change}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(added) != 1 || added[0] != fixture.Change {
+		t.Fatalf("expected fixture change to be reported as new; got %v", added)
+	}
+
+	if findTraceUpdate(t, db, id) != nil {
+		t.Fatal("expected trace update to be cleared")
+	}
+}
+
+func TestDBChangeTriage(t *testing.T) {
+	d := dbtest.Open(t)
+
+	// Store a change.
+	ctx := context.Background()
+	if err := d.StoreBenchmark(ctx, fixture.Benchmark); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.StoreCommit(ctx, entity.GoRepository.UUID(), fixture.Commit); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.StoreCommitPosition(ctx, entity.GoRepository.UUID(), fixture.CommitPosition); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.StoreProperties(ctx, fixture.Environment); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.StoreChangesBatch(ctx, []*entity.Change{fixture.Change}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.BuildChangesRanked(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect untriaged before any triage is recorded.
+	id, idx := fixture.Change.ID, fixture.Change.CommitIndex
+	got, err := d.FindChangeTriage(ctx, id, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != entity.TriageStateUntriaged {
+		t.Fatalf("got state %s; expect untriaged", got.State)
+	}
+
+	// Record triage.
+	expect := &entity.Triage{
+		State:    entity.TriageStateExpected,
+		IssueURL: "https://github.com/golang/go/issues/1",
+		Note:     "trade-off for smaller binaries",
+	}
+	if err := d.SetChangeTriage(ctx, id, idx, expect); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = d.FindChangeTriage(ctx, id, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != expect.State || got.IssueURL != expect.IssueURL || got.Note != expect.Note {
+		t.Fatalf("got triage %#v; expect %#v", got, expect)
+	}
+	if got.LastUpdated.IsZero() {
+		t.Fatal("expected last updated time to be set")
+	}
+
+	// Confirm the change listing filters on triage state.
+	r := entity.SingleCommitIndexRange(idx)
+	for _, c := range []struct {
+		States []entity.TriageState
+		Expect int
+	}{
+		{States: nil, Expect: 1},
+		{States: []entity.TriageState{entity.TriageStateUntriaged}, Expect: 0},
+		{States: []entity.TriageState{entity.TriageStateExpected}, Expect: 1},
+	} {
+		cs, err := d.ListChangeSummaries(ctx, r, db.ChangeFilter{TriageStates: c.States})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cs) != c.Expect {
+			t.Fatalf("triage states %v: got %d changes; expect %d", c.States, len(cs), c.Expect)
+		}
+		for _, cs := range cs {
+			if cs.Triage.State != expect.State {
+				t.Fatalf("got listed triage state %s; expect %s", cs.Triage.State, expect.State)
+			}
+		}
+	}
+}
+
+func TestDBReplaceTraceChangesMovesTriage(t *testing.T) {
+	d := dbtest.Open(t)
+	ctx := context.Background()
+	u := storeAdjacentCommits(t, d)
+
+	// Detect a change and triage it.
+	if _, err := d.ReplaceTraceChanges(ctx, u, u.CommitIndexRange, []*entity.Change{fixture.Change}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := &entity.Triage{
+		State: entity.TriageStateAcknowledged,
+		Note:  "investigating",
+	}
+	id := fixture.Change.ID
+	if err := d.SetChangeTriage(ctx, id, fixture.Change.CommitIndex, expect); err != nil {
+		t.Fatal(err)
+	}
+
+	// Re-detect the change one commit earlier.
+	moved := *fixture.Change
+	moved.CommitIndex = u.CommitIndexRange.Min
+	if _, err := d.ReplaceTraceChanges(ctx, u, u.CommitIndexRange, []*entity.Change{&moved}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect triage to have followed the change.
+	got, err := d.FindChangeTriage(ctx, id, moved.CommitIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != expect.State || got.Note != expect.Note {
+		t.Fatalf("got triage %#v at new commit; expect %#v", got, expect)
+	}
+
+	got, err = d.FindChangeTriage(ctx, id, fixture.Change.CommitIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != entity.TriageStateUntriaged {
+		t.Fatalf("got state %s at old commit; expect untriaged", got.State)
+	}
+}
+
+func TestDBReplaceTraceChangesAlerts(t *testing.T) {
+	d := dbtest.Open(t)
+	ctx := context.Background()
+	u := storeAdjacentCommits(t, d)
+	subs := []string{"a", "b"}
+
+	// Detect a change and expect it to be queued for alerting to every
+	// subscription.
+	added, err := d.ReplaceTraceChanges(ctx, u, u.CommitIndexRange, []*entity.Change{fixture.Change}, subs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 1 {
+		t.Fatalf("got %d new changes; expect 1", len(added))
+	}
+	assertPendingChangeAlerts(t, d, "a", fixture.Change.CommitIndex)
+	assertPendingChangeAlerts(t, d, "b", fixture.Change.CommitIndex)
+
+	// Deliver to one subscription only.
+	if err := d.MarkChangeAlertsDelivered(ctx, "a", []*entity.Change{fixture.Change}); err != nil {
+		t.Fatal(err)
+	}
+	assertPendingChangeAlerts(t, d, "a")
+
+	// Re-detect the change one commit earlier. It is not new, and the alert
+	// still pending for the other subscription follows it.
+	moved := *fixture.Change
+	moved.CommitIndex = u.CommitIndexRange.Min
+	added, err = d.ReplaceTraceChanges(ctx, u, u.CommitIndexRange, []*entity.Change{&moved}, subs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 0 {
+		t.Fatalf("got %d new changes after move; expect 0", len(added))
+	}
+	assertPendingChangeAlerts(t, d, "a")
+	pending := assertPendingChangeAlerts(t, d, "b", moved.CommitIndex)
+
+	// Deliver, then move back. Expect no further alerts.
+	if err := d.MarkChangeAlertsDelivered(ctx, "b", pending); err != nil {
+		t.Fatal(err)
+	}
+	assertPendingChangeAlerts(t, d, "b")
+
+	if _, err := d.ReplaceTraceChanges(ctx, u, u.CommitIndexRange, []*entity.Change{fixture.Change}, subs); err != nil {
+		t.Fatal(err)
+	}
+	assertPendingChangeAlerts(t, d, "a")
+	assertPendingChangeAlerts(t, d, "b")
+}
+
+func TestDBListUnconfirmedChangeRepeats(t *testing.T) {
+	d := dbtest.Open(t)
+	ctx := context.Background()
+	u := storeAdjacentCommits(t, d)
+
+	// The original worker and a rerun on an identical second worker produce
+	// results in the same environment either side of the change.
+	prev := *fixture.Commit
+	prev.SHA = "60b9ae4cf3a0428668748a53f278a80d41fbfc38"
+
+	rerun := &entity.DataFile{Name: "rerun.txt"}
+	for _, w := range []struct {
+		Worker string
+		File   *entity.DataFile
+		Pre    float64
+		Post   float64
+	}{
+		{Worker: fixture.Worker, File: fixture.DataFile, Pre: 100, Post: 200},
+		{Worker: "rerun", File: rerun, Pre: 100, Post: 190},
+	} {
+		pre := *fixture.Result
+		pre.File, pre.Commit, pre.Line, pre.Value = w.File, &prev, 1, w.Pre
+		post := *fixture.Result
+		post.File, post.Line, post.Value = w.File, 2, w.Post
+		if err := d.StoreResults(ctx, []*entity.Result{&pre, &post}); err != nil {
+			t.Fatal(err)
+		}
+
+		task, err := d.CreateTask(ctx, w.Worker, fixture.TaskSpec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := d.TransitionTaskStatus(ctx, task.UUID, []entity.TaskStatus{entity.TaskStatusCreated}, entity.TaskStatusResultUploadStarted); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.RecordTaskDataUpload(ctx, task.UUID, w.File); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := d.StoreChangesBatch(ctx, []*entity.Change{fixture.Change}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect only the rerun worker's samples as a repeat.
+	crs, err := d.ListUnconfirmedChangeRepeats(ctx, entity.GoRepository.UUID(), u.CommitIndexRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(crs) != 1 {
+		t.Fatalf("got %d unconfirmed changes; expect 1", len(crs))
+	}
+	repeats := crs[0].Repeats
+	if len(repeats) != 1 {
+		t.Fatalf("got %d repeats; expect 1", len(repeats))
+	}
+	r := repeats[0]
+	if len(r.Pre) != 1 || r.Pre[0] != 100 || len(r.Post) != 1 || r.Post[0] != 190 {
+		t.Fatalf("got repeat %#v; expect rerun worker samples", r)
+	}
+}
+
+// storeAdjacentCommits stores dependencies for changes to the fixture trace at
+// the fixture commit and the one before it. Returns a trace update spanning
+// both commits.
+func storeAdjacentCommits(t *testing.T, d *db.DB) *entity.TraceUpdate {
+	t.Helper()
+	ctx := context.Background()
+
+	if err := d.StoreBenchmark(ctx, fixture.Benchmark); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.StoreProperties(ctx, fixture.Environment); err != nil {
+		t.Fatal(err)
+	}
+
+	prev := *fixture.Commit
+	prev.SHA = "60b9ae4cf3a0428668748a53f278a80d41fbfc38"
+	prevpos := &entity.CommitPosition{
+		SHA:        prev.SHA,
+		CommitTime: prev.CommitTime,
+		Index:      fixture.CommitPosition.Index - 1,
+	}
+
+	for _, c := range []struct {
+		Commit   *entity.Commit
+		Position *entity.CommitPosition
+	}{
+		{fixture.Commit, fixture.CommitPosition},
+		{&prev, prevpos},
+	} {
+		if err := d.StoreCommit(ctx, entity.GoRepository.UUID(), c.Commit); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.StoreCommitPosition(ctx, entity.GoRepository.UUID(), c.Position); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &entity.TraceUpdate{
+		ID:               fixture.Change.ID,
+		CommitIndexRange: entity.CommitIndexRange{Min: prevpos.Index, Max: fixture.CommitPosition.Index},
+	}
+}
+
+// assertPendingChangeAlerts asserts that pending alerts for the subscription
+// sub are exactly for changes at the given commit indexes, in order.
+func assertPendingChangeAlerts(t *testing.T, d *db.DB, sub string, idxs ...int) []*entity.Change {
+	t.Helper()
+	pending, err := d.ListPendingChangeAlerts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := pending[sub]
+	if len(cs) != len(idxs) {
+		t.Fatalf("got %d pending alerts; expect %d", len(cs), len(idxs))
+	}
+	for i, c := range cs {
+		if c.CommitIndex != idxs[i] {
+			t.Fatalf("got pending alert at commit index %d; expect %d", c.CommitIndex, idxs[i])
+		}
+	}
+	return cs
+}
+
+func findTraceUpdate(t *testing.T, d *db.DB, id trace.ID) *entity.TraceUpdate {
+	t.Helper()
+	us, err := d.ListTraceUpdates(context.Background(), math.MaxInt32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range us {
+		if u.ID == id {
+			return u
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/entity"
+)
+
+func TestChangeConfirmationMapping(t *testing.T) {
+	for _, c := range change.ConfirmationValues() {
+		c := c // scopelint
+		t.Run(c.String(), func(t *testing.T) {
+			dbc, err := toChangeConfirmation(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			roundtrip, err := mapChangeConfirmation(dbc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if roundtrip != c {
+				t.Fatal("roundtrip mismatch")
+			}
+		})
+	}
+}
+
+func TestTriageStateMapping(t *testing.T) {
+	for _, s := range entity.TriageStateValues() {
+		s := s // scopelint
+		t.Run(s.String(), func(t *testing.T) {
+			dbs, err := toTriageState(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			roundtrip, err := mapTriageState(dbs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if roundtrip != s {
+				t.Fatal("roundtrip mismatch")
+			}
+		})
+	}
+}
@@ -6,20 +6,21 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 
 	"github.com/mmcloughlin/goperf/app/db/internal/db"
 	"github.com/mmcloughlin/goperf/app/entity"
 )
 
-// StoreCommit writes commit to the database.
-func (d *DB) StoreCommit(ctx context.Context, c *entity.Commit) error {
+// StoreCommit writes commit in the given repository to the database.
+func (d *DB) StoreCommit(ctx context.Context, repo uuid.UUID, c *entity.Commit) error {
 	return d.txq(ctx, func(q *db.Queries) error {
-		return storeCommit(ctx, q, c)
+		return storeCommit(ctx, q, repo, c)
 	})
 }
 
-func storeCommit(ctx context.Context, q *db.Queries, c *entity.Commit) error {
+func storeCommit(ctx context.Context, q *db.Queries, repo uuid.UUID, c *entity.Commit) error {
 	sha, err := hex.DecodeString(c.SHA)
 	if err != nil {
 		return fmt.Errorf("invalid sha: %w", err)
@@ -49,11 +50,13 @@ func storeCommit(ctx context.Context, q *db.Queries, c *entity.Commit) error {
 		CommitterEmail: c.Committer.Email,
 		CommitTime:     c.CommitTime,
 		Message:        c.Message,
+		RepositoryUUID: repo,
 	})
 }
 
-// StoreCommits writes the given commits to the database in a single batch.
-func (d *DB) StoreCommits(ctx context.Context, cs []*entity.Commit) error {
+// StoreCommits writes the given commits in a repository to the database in a
+// single batch.
+func (d *DB) StoreCommits(ctx context.Context, repo uuid.UUID, cs []*entity.Commit) error {
 	fields := []string{
 		"sha",
 		"tree",
@@ -65,6 +68,7 @@ func (d *DB) StoreCommits(ctx context.Context, cs []*entity.Commit) error {
 		"committer_email",
 		"commit_time",
 		"message",
+		"repository_uuid",
 	}
 	values := []interface{}{}
 	for _, c := range cs {
@@ -97,6 +101,7 @@ func (d *DB) StoreCommits(ctx context.Context, cs []*entity.Commit) error {
 			c.Committer.Email,
 			c.CommitTime,
 			c.Message,
+			repo,
 		)
 	}
 	return d.tx(ctx, func(tx *sql.Tx) error {
@@ -149,19 +154,23 @@ func mostRecentCommit(ctx context.Context, q *db.Queries) (*entity.Commit, error
 	return mapCommit(c), nil
 }
 
-// MostRecentCommitWithRef returns the most recent commit by commit time having the supplied ref.
-func (d *DB) MostRecentCommitWithRef(ctx context.Context, ref string) (*entity.Commit, error) {
+// MostRecentCommitWithRef returns the most recent commit by commit time having
+// the supplied ref in the given repository.
+func (d *DB) MostRecentCommitWithRef(ctx context.Context, repo uuid.UUID, ref string) (*entity.Commit, error) {
 	var c *entity.Commit
 	err := d.txq(ctx, func(q *db.Queries) error {
 		var err error
-		c, err = mostRecentCommitWithRef(ctx, q, ref)
+		c, err = mostRecentCommitWithRef(ctx, q, repo, ref)
 		return err
 	})
 	return c, err
 }
 
-func mostRecentCommitWithRef(ctx context.Context, q *db.Queries, ref string) (*entity.Commit, error) {
-	c, err := q.MostRecentCommitWithRef(ctx, ref)
+func mostRecentCommitWithRef(ctx context.Context, q *db.Queries, repo uuid.UUID, ref string) (*entity.Commit, error) {
+	c, err := q.MostRecentCommitWithRef(ctx, db.MostRecentCommitWithRefParams{
+		Ref:            ref,
+		RepositoryUUID: repo,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -193,18 +202,20 @@ func mapCommit(c db.Commit) *entity.Commit {
 	}
 }
 
-// StoreCommitRef writes a commit ref pair to the database.
-func (d *DB) StoreCommitRef(ctx context.Context, r *entity.CommitRef) error {
+// StoreCommitRef writes a commit ref pair in the given repository to the
+// database.
+func (d *DB) StoreCommitRef(ctx context.Context, repo uuid.UUID, r *entity.CommitRef) error {
 	return d.txq(ctx, func(q *db.Queries) error {
-		return storeCommitRef(ctx, q, r)
+		return storeCommitRef(ctx, q, repo, r)
 	})
 }
 
-// StoreCommitRefs writes the given commit refs to the database.
-func (d *DB) StoreCommitRefs(ctx context.Context, rs []*entity.CommitRef) error {
+// StoreCommitRefs writes the given commit refs in a repository to the database.
+func (d *DB) StoreCommitRefs(ctx context.Context, repo uuid.UUID, rs []*entity.CommitRef) error {
 	fields := []string{
 		"sha",
 		"ref",
+		"repository_uuid",
 	}
 	values := []interface{}{}
 	for _, r := range rs {
@@ -216,6 +227,7 @@ func (d *DB) StoreCommitRefs(ctx context.Context, rs []*entity.CommitRef) error
 		values = append(values,
 			sha,
 			r.Ref,
+			repo,
 		)
 	}
 	return d.tx(ctx, func(tx *sql.Tx) error {
@@ -223,52 +235,59 @@ func (d *DB) StoreCommitRefs(ctx context.Context, rs []*entity.CommitRef) error
 	})
 }
 
-func storeCommitRef(ctx context.Context, q *db.Queries, r *entity.CommitRef) error {
+func storeCommitRef(ctx context.Context, q *db.Queries, repo uuid.UUID, r *entity.CommitRef) error {
 	sha, err := hex.DecodeString(r.SHA)
 	if err != nil {
 		return fmt.Errorf("invalid sha: %w", err)
 	}
 
 	return q.InsertCommitRef(ctx, db.InsertCommitRefParams{
-		SHA: sha,
-		Ref: r.Ref,
+		SHA:            sha,
+		Ref:            r.Ref,
+		RepositoryUUID: repo,
 	})
 }
 
-// StoreCommitPosition writes a commit position to the database. This should be
-// rarely needed outside of testing; prefer BuildCommitPositions.
-func (d *DB) StoreCommitPosition(ctx context.Context, p *entity.CommitPosition) error {
+// StoreCommitPosition writes a commit position in the given repository to the
+// database. This should be rarely needed outside of testing; prefer
+// BuildCommitPositions.
+func (d *DB) StoreCommitPosition(ctx context.Context, repo uuid.UUID, p *entity.CommitPosition) error {
 	return d.txq(ctx, func(q *db.Queries) error {
-		return storeCommitPosition(ctx, q, p)
+		return storeCommitPosition(ctx, q, repo, p)
 	})
 }
 
-func storeCommitPosition(ctx context.Context, q *db.Queries, p *entity.CommitPosition) error {
+func storeCommitPosition(ctx context.Context, q *db.Queries, repo uuid.UUID, p *entity.CommitPosition) error {
 	sha, err := hex.DecodeString(p.SHA)
 	if err != nil {
 		return fmt.Errorf("invalid sha: %w", err)
 	}
 
 	return q.InsertCommitPosition(ctx, db.InsertCommitPositionParams{
-		SHA:        sha,
-		CommitTime: p.CommitTime,
-		Index:      int32(p.Index),
+		SHA:            sha,
+		CommitTime:     p.CommitTime,
+		Index:          int32(p.Index),
+		RepositoryUUID: repo,
 	})
 }
 
-// BuildCommitPositions creates the commit positions table. The table is
-// completely rebuilt from the source tables.
-func (d *DB) BuildCommitPositions(ctx context.Context) error {
+// BuildCommitPositions creates the commit positions for repository r. Positions
+// are completely rebuilt from the commits on the repository's tracked ref.
+func (d *DB) BuildCommitPositions(ctx context.Context, r *entity.Repository) error {
 	return d.txq(ctx, func(q *db.Queries) error {
-		return q.BuildCommitPositions(ctx)
+		return q.BuildCommitPositions(ctx, db.BuildCommitPositionsParams{
+			Ref:            r.Ref,
+			RepositoryUUID: r.UUID(),
+		})
 	})
 }
 
-// MostRecentCommitIndex returns the most recent commit index.
-func (d *DB) MostRecentCommitIndex(ctx context.Context) (int, error) {
+// MostRecentCommitIndex returns the most recent commit index in the given
+// repository.
+func (d *DB) MostRecentCommitIndex(ctx context.Context, repo uuid.UUID) (int, error) {
 	var idx int
 	err := d.txq(ctx, func(q *db.Queries) error {
-		i, err := q.MostRecentCommitIndex(ctx)
+		i, err := q.MostRecentCommitIndex(ctx, repo)
 		idx = int(i)
 		return err
 	})
@@ -8,9 +8,11 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -154,6 +156,67 @@ func findModuleByUUID(ctx context.Context, q *db.Queries, id uuid.UUID) (*entity
 	return mapModule(m), nil
 }
 
+// FindModuleJobSettings returns job settings for the module with the given
+// UUID. Returns the default settings if the module has none configured.
+func (d *DB) FindModuleJobSettings(ctx context.Context, id uuid.UUID) (entity.JobSettings, error) {
+	var s entity.JobSettings
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		s, err = findModuleJobSettings(ctx, q, id)
+		return err
+	})
+	return s, err
+}
+
+func findModuleJobSettings(ctx context.Context, q *db.Queries, id uuid.UUID) (entity.JobSettings, error) {
+	s, err := q.ModuleJobSettings(ctx, id)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return entity.DefaultJobSettings, nil
+	case err != nil:
+		return entity.JobSettings{}, err
+	}
+
+	return entity.JobSettings{
+		Short:          s.Short,
+		BenchTime:      time.Duration(s.BenchtimeNs),
+		Count:          int(s.Count),
+		Interleave:     s.Interleave,
+		Tests:          s.Tests,
+		Benchmarks:     s.Benchmarks,
+		BenchmarksSkip: s.BenchmarksSkip,
+		Timeout:        time.Duration(s.TimeoutNs),
+		Requires:       nilempty(s.Requires),
+	}, nil
+}
+
+// StoreModuleJobSettings writes job settings for the module with the given
+// UUID, replacing any existing settings.
+func (d *DB) StoreModuleJobSettings(ctx context.Context, id uuid.UUID, s entity.JobSettings) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		return q.UpsertModuleJobSettings(ctx, db.UpsertModuleJobSettingsParams{
+			ModuleUUID:     id,
+			Short:          s.Short,
+			BenchtimeNs:    int64(s.BenchTime),
+			Count:          int32(s.Count),
+			Interleave:     s.Interleave,
+			Tests:          s.Tests,
+			Benchmarks:     s.Benchmarks,
+			BenchmarksSkip: s.BenchmarksSkip,
+			TimeoutNs:      int64(s.Timeout),
+			Requires:       append([]string{}, s.Requires...), // NULL not permitted
+		})
+	})
+}
+
+// nilempty returns nil for an empty slice.
+func nilempty(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
 // ListModules returns all modules.
 func (d *DB) ListModules(ctx context.Context) ([]*entity.Module, error) {
 	var ms []*entity.Module
@@ -480,19 +543,79 @@ func listBenchmarkPoints(ctx context.Context, q *db.Queries, b *entity.Benchmark
 	return output, nil
 }
 
-// ListTracePoints returns trace points for the given commit range.
-func (d *DB) ListTracePoints(ctx context.Context, r entity.CommitIndexRange) ([]trace.Point, error) {
+// ListCommitBenchmarkPoints returns all points recorded at the given commit,
+// together with their benchmarks.
+func (d *DB) ListCommitBenchmarkPoints(ctx context.Context, sha string) ([]*entity.BenchmarkPoint, error) {
+	shabytes, err := hex.DecodeString(sha)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sha: %w", err)
+	}
+
+	var ps []*entity.BenchmarkPoint
+	err = d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		ps, err = listCommitBenchmarkPoints(ctx, q, shabytes)
+		return err
+	})
+	return ps, err
+}
+
+func listCommitBenchmarkPoints(ctx context.Context, q *db.Queries, sha []byte) ([]*entity.BenchmarkPoint, error) {
+	rows, err := q.CommitPoints(ctx, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]*entity.BenchmarkPoint, len(rows))
+	for i, row := range rows {
+		params := map[string]string{}
+		if err := json.Unmarshal(row.Parameters, &params); err != nil {
+			return nil, fmt.Errorf("decode parameters: %w", err)
+		}
+
+		output[i] = &entity.BenchmarkPoint{
+			Benchmark: &entity.Benchmark{
+				Package: &entity.Package{
+					Module: &entity.Module{
+						Path:    row.Path,
+						Version: row.Version,
+					},
+					RelativePath: row.RelativePath,
+				},
+				FullName:   row.FullName,
+				Name:       row.Name,
+				Parameters: params,
+				Unit:       row.Unit,
+			},
+			Point: entity.Point{
+				ResultUUID:      row.ResultUUID,
+				BenchmarkUUID:   row.UUID,
+				EnvironmentUUID: row.EnvironmentUUID,
+				CommitSHA:       hex.EncodeToString(sha),
+				CommitIndex:     int(row.CommitIndex),
+				Value:           row.Value,
+			},
+		}
+	}
+
+	return output, nil
+}
+
+// ListTracePoints returns trace points for the given commit range of a
+// repository.
+func (d *DB) ListTracePoints(ctx context.Context, repo uuid.UUID, r entity.CommitIndexRange) ([]trace.Point, error) {
 	var ps []trace.Point
 	err := d.txq(ctx, func(q *db.Queries) error {
 		var err error
-		ps, err = listTracePoints(ctx, q, r)
+		ps, err = listTracePoints(ctx, q, repo, r)
 		return err
 	})
 	return ps, err
 }
 
-func listTracePoints(ctx context.Context, q *db.Queries, r entity.CommitIndexRange) ([]trace.Point, error) {
+func listTracePoints(ctx context.Context, q *db.Queries, repo uuid.UUID, r entity.CommitIndexRange) ([]trace.Point, error) {
 	ps, err := q.TracePoints(ctx, db.TracePointsParams{
+		RepositoryUUID: repo,
 		CommitIndexMin: int32(r.Min),
 		CommitIndexMax: int32(r.Max),
 	})
@@ -518,7 +641,64 @@ func listTracePoints(ctx context.Context, q *db.Queries, r entity.CommitIndexRan
 	return output, nil
 }
 
-// Trace returns a specific trace between the given commit range.
+// ListTraceUpdates returns up to num pending trace updates, most recent first.
+func (d *DB) ListTraceUpdates(ctx context.Context, num int) ([]*entity.TraceUpdate, error) {
+	var us []*entity.TraceUpdate
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		us, err = listTraceUpdates(ctx, q, num)
+		return err
+	})
+	return us, err
+}
+
+func listTraceUpdates(ctx context.Context, q *db.Queries, num int) ([]*entity.TraceUpdate, error) {
+	rows, err := q.TraceUpdates(ctx, int32(num))
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]*entity.TraceUpdate, len(rows))
+	for i, row := range rows {
+		output[i] = &entity.TraceUpdate{
+			ID: trace.ID{
+				BenchmarkUUID:   row.BenchmarkUUID,
+				EnvironmentUUID: row.EnvironmentUUID,
+			},
+			CommitIndexRange: entity.CommitIndexRange{
+				Min: int(row.CommitIndexMin),
+				Max: int(row.CommitIndexMax),
+			},
+		}
+	}
+
+	return output, nil
+}
+
+// TraceExpandRange extends the commit index range r to include up to n points
+// commits of the trace either side.
+func (d *DB) TraceExpandRange(ctx context.Context, id trace.ID, r entity.CommitIndexRange, n int) (entity.CommitIndexRange, error) {
+	var expanded entity.CommitIndexRange
+	err := d.txq(ctx, func(q *db.Queries) error {
+		row, err := q.TraceExpandRange(ctx, db.TraceExpandRangeParams{
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndexMin:  int32(r.Min),
+			CommitIndexMax:  int32(r.Max),
+			NumPoints:       int32(n),
+		})
+		if err != nil {
+			return err
+		}
+		expanded.Min = int(row.CommitIndexMin)
+		expanded.Max = int(row.CommitIndexMax)
+		return nil
+	})
+	return expanded, err
+}
+
+// Trace returns a specific trace between the given commit range. Results at the
+// same commit index are averaged.
 func (d *DB) Trace(ctx context.Context, id trace.ID, r entity.CommitIndexRange) (*trace.Trace, error) {
 	var t *trace.Trace
 	err := d.txq(ctx, func(q *db.Queries) error {
@@ -540,14 +720,21 @@ func getTrace(ctx context.Context, q *db.Queries, id trace.ID, r entity.CommitIn
 		return nil, err
 	}
 
-	// Convert to trace series.
-	t := &trace.Trace{ID: id}
+	// Convert to trace points. Repeated results at the same commit are
+	// averaged, so the trace has one value per commit index.
+	ps := make([]trace.Point, 0, len(rows))
 	for _, row := range rows {
-		t.Series = append(t.Series, trace.IndexedValue{
-			CommitIndex: int(row.CommitIndex),
-			Value:       row.Value,
+		ps = append(ps, trace.Point{
+			ID: id,
+			IndexedValue: trace.IndexedValue{
+				CommitIndex: int(row.CommitIndex),
+				Value:       row.Value,
+			},
 		})
 	}
 
-	return t, nil
+	if t, ok := trace.Traces(ps)[id]; ok {
+		return t, nil
+	}
+	return &trace.Trace{ID: id}, nil
 }
@@ -3,6 +3,7 @@ package db_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -16,7 +17,7 @@ func TestDBCommit(t *testing.T) {
 
 	// Store.
 	ctx := context.Background()
-	err := db.StoreCommit(ctx, fixture.Commit)
+	err := db.StoreCommit(ctx, entity.GoRepository.UUID(), fixture.Commit)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -37,7 +38,7 @@ func TestDBCommitBatch(t *testing.T) {
 
 	// Store in batch mode.
 	ctx := context.Background()
-	err := db.StoreCommits(ctx, []*entity.Commit{fixture.Commit})
+	err := db.StoreCommits(ctx, entity.GoRepository.UUID(), []*entity.Commit{fixture.Commit})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -53,6 +54,46 @@ func TestDBCommitBatch(t *testing.T) {
 	}
 }
 
+func TestDBRepository(t *testing.T) {
+	db := dbtest.Open(t)
+
+	// The Go repository is always registered.
+	ctx := context.Background()
+	got, err := db.FindRepositoryByUUID(ctx, entity.GoRepository.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(entity.GoRepository, got); diff != "" {
+		t.Errorf("mismatch\n%s", diff)
+	}
+
+	// Store.
+	expect := &entity.Repository{
+		URL:          "https://github.com/mmcloughlin/avo",
+		Ref:          "master",
+		ModulePath:   "github.com/mmcloughlin/avo",
+		ToolchainRef: "e0ad5e6f79c4fbd7f5e0b4d0b28fd5d3a5c8e9a3",
+	}
+	if err := db.StoreRepository(ctx, expect); err != nil {
+		t.Fatal(err)
+	}
+
+	// Commits are recorded against the repository.
+	if err := db.StoreCommit(ctx, expect.UUID(), fixture.Commit); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = db.FindRepositoryByCommitSHA(ctx, fixture.Commit.SHA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expect, got); diff != "" {
+		t.Errorf("mismatch\n%s", diff)
+	}
+}
+
 func TestDBModule(t *testing.T) {
 	db := dbtest.Open(t)
 
@@ -75,6 +116,54 @@ func TestDBModule(t *testing.T) {
 	}
 }
 
+func TestDBModuleJobSettings(t *testing.T) {
+	db := dbtest.Open(t)
+
+	ctx := context.Background()
+	err := db.StoreModule(ctx, fixture.Module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect defaults before any settings are stored.
+	id := fixture.Module.UUID()
+	got, err := db.FindModuleJobSettings(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(entity.DefaultJobSettings, got); diff != "" {
+		t.Errorf("mismatch\n%s", diff)
+	}
+
+	// Store.
+	expect := entity.JobSettings{
+		Short:          false,
+		BenchTime:      time.Second,
+		Count:          5,
+		Interleave:     true,
+		Tests:          "^$",
+		Benchmarks:     "Compress",
+		BenchmarksSkip: "Slow",
+		Timeout:        30 * time.Minute,
+		Requires:       []string{"github.com/klauspost/cpuid@v1.2.3"},
+	}
+	err = db.StoreModuleJobSettings(ctx, id, expect)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Find.
+	got, err = db.FindModuleJobSettings(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expect, got); diff != "" {
+		t.Errorf("mismatch\n%s", diff)
+	}
+}
+
 func TestDBPackage(t *testing.T) {
 	db := dbtest.Open(t)
 
@@ -197,3 +286,43 @@ func TestDBResult(t *testing.T) {
 		t.Errorf("mismatch\n%s", diff)
 	}
 }
+
+func TestDBTraceAveragesCommit(t *testing.T) {
+	db := dbtest.Open(t)
+
+	// Store two results at the same commit.
+	ctx := context.Background()
+	if err := db.StoreCommit(ctx, entity.GoRepository.UUID(), fixture.Commit); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.StoreCommitPosition(ctx, entity.GoRepository.UUID(), fixture.CommitPosition); err != nil {
+		t.Fatal(err)
+	}
+
+	repeat := *fixture.Result
+	repeat.Line++
+	repeat.Value = fixture.Result.Value + 2
+
+	for _, r := range []*entity.Result{fixture.Result, &repeat} {
+		if err := db.StoreResult(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Expect a single averaged value at the commit index.
+	idx := fixture.CommitPosition.Index
+	tr, err := db.Trace(ctx, fixture.Change.ID, entity.CommitIndexRange{Min: idx, Max: idx})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tr.Series) != 1 {
+		t.Fatalf("got %d trace values; expect 1", len(tr.Series))
+	}
+
+	v := tr.Series[0]
+	if v.CommitIndex != idx || v.N != 2 || v.Value != fixture.Result.Value+1 {
+		t.Fatalf("got trace value %#v; expect mean of both results at commit index %d", v, idx)
+	}
+}
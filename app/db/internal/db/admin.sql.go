@@ -10,18 +10,22 @@ import (
 const truncateAll = `-- name: TruncateAll :exec
 TRUNCATE
     benchmarks,
+    change_triage,
     changes,
     changes_ranked,
     commit_positions,
     commit_refs,
     commits,
     datafiles,
+    module_job_settings,
     modules,
     packages,
     points,
     properties,
     results,
-    tasks
+    tasks,
+    trace_updates,
+    workers
 `
 
 func (q *Queries) TruncateAll(ctx context.Context) error {
@@ -6,24 +6,39 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const buildChangesRanked = `-- name: BuildChangesRanked :exec
 INSERT INTO changes_ranked (
     SELECT
-        benchmark_uuid, environment_uuid, commit_index, effect_size, pre_n, pre_mean, pre_stddev, post_n, post_mean, post_stddev,
+        chg.benchmark_uuid, chg.environment_uuid, chg.commit_index, chg.effect_size, chg.pre_n, chg.pre_mean, chg.pre_stddev, chg.post_n, chg.post_mean, chg.post_stddev, chg.p_value, chg.percent_ci_lower, chg.percent_ci_upper, chg.confirmation,
         ROW_NUMBER() OVER (
-            PARTITION BY commit_index
-            ORDER BY ABS(effect_size) DESC
+            PARTITION BY r.repository_uuid, chg.commit_index
+            ORDER BY ABS(chg.effect_size) DESC
         ) AS rank_by_effect_size,
         ROW_NUMBER() OVER (
-            PARTITION BY commit_index
-            ORDER BY ABS((post_mean/pre_mean)-1.0) DESC
+            PARTITION BY r.repository_uuid, chg.commit_index
+            ORDER BY ABS((chg.post_mean/chg.pre_mean)-1.0) DESC
         ) AS rank_by_abs_percent_change
     FROM
-        changes
+        changes AS chg
+        -- Commit indexes are per-repository, so rank changes among those in
+        -- the same repository.
+        LEFT JOIN LATERAL (
+            SELECT cp.repository_uuid
+            FROM points AS pt
+                INNER JOIN commit_positions AS cp
+                    ON pt.commit_sha=cp.sha
+            WHERE 1=1
+                AND pt.benchmark_uuid = chg.benchmark_uuid
+                AND pt.environment_uuid = chg.environment_uuid
+                AND pt.commit_index = chg.commit_index
+            LIMIT 1
+        ) AS r ON true
 )
 ON CONFLICT (benchmark_uuid, environment_uuid, commit_index)
 DO UPDATE SET
@@ -36,20 +51,178 @@ func (q *Queries) BuildChangesRanked(ctx context.Context) error {
 	return err
 }
 
+const changeAlerts = `-- name: ChangeAlerts :many
+SELECT
+    a.subscription,
+    chg.benchmark_uuid, chg.environment_uuid, chg.commit_index, chg.effect_size, chg.pre_n, chg.pre_mean, chg.pre_stddev, chg.post_n, chg.post_mean, chg.post_stddev, chg.p_value, chg.percent_ci_lower, chg.percent_ci_upper, chg.confirmation
+FROM
+    change_alerts AS a
+    INNER JOIN changes AS chg
+        ON 1=1
+        AND a.benchmark_uuid=chg.benchmark_uuid
+        AND a.environment_uuid=chg.environment_uuid
+        AND a.commit_index=chg.commit_index
+ORDER BY
+    a.subscription,
+    chg.commit_index
+`
+
+type ChangeAlertsRow struct {
+	Subscription    string
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	EffectSize      float64
+	PreN            int32
+	PreMean         float64
+	PreStddev       float64
+	PostN           int32
+	PostMean        float64
+	PostStddev      float64
+	PValue          float64
+	PercentCILower  float64
+	PercentCIUpper  float64
+	Confirmation    ChangeConfirmation
+}
+
+func (q *Queries) ChangeAlerts(ctx context.Context) ([]ChangeAlertsRow, error) {
+	rows, err := q.query(ctx, q.changeAlertsStmt, changeAlerts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeAlertsRow
+	for rows.Next() {
+		var i ChangeAlertsRow
+		if err := rows.Scan(
+			&i.Subscription,
+			&i.BenchmarkUUID,
+			&i.EnvironmentUUID,
+			&i.CommitIndex,
+			&i.EffectSize,
+			&i.PreN,
+			&i.PreMean,
+			&i.PreStddev,
+			&i.PostN,
+			&i.PostMean,
+			&i.PostStddev,
+			&i.PValue,
+			&i.PercentCILower,
+			&i.PercentCIUpper,
+			&i.Confirmation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const changeRepeatPoints = `-- name: ChangeRepeatPoints :many
+SELECT
+    t.worker,
+    pt.commit_index,
+    pt.value
+FROM
+    points AS pt
+    INNER JOIN commit_positions AS cp
+        ON pt.commit_sha=cp.sha
+    INNER JOIN results AS r
+        ON pt.result_uuid=r.uuid
+    INNER JOIN tasks AS t
+        ON r.datafile_uuid=t.datafile_uuid
+WHERE 1=1
+    AND pt.benchmark_uuid = $1
+    AND pt.commit_index IN ($2, $3)
+    AND cp.repository_uuid = $4
+    -- Exclude workers that produced the original results.
+    AND NOT EXISTS (
+        SELECT opt.result_uuid, opt.benchmark_uuid, opt.environment_uuid, opt.commit_sha, opt.commit_index, opt.value, ort.uuid, ort.datafile_uuid, ort.line, ort.benchmark_uuid, ort.commit_sha, ort.environment_uuid, ort.metadata_uuid, ort.iterations, ort.value, ot.uuid, ot.worker, ot.commit_sha, ot.type, ot.target_uuid, ot.status, ot.last_status_update, ot.datafile_uuid
+        FROM points AS opt
+            INNER JOIN results AS ort
+                ON opt.result_uuid=ort.uuid
+            INNER JOIN tasks AS ot
+                ON ort.datafile_uuid=ot.datafile_uuid
+        WHERE 1=1
+            AND opt.benchmark_uuid = pt.benchmark_uuid
+            AND opt.environment_uuid = $5
+            AND opt.commit_index IN ($2, $3)
+            AND ot.worker = t.worker
+    )
+ORDER BY
+    t.worker,
+    pt.commit_index
+`
+
+type ChangeRepeatPointsParams struct {
+	BenchmarkUUID   uuid.UUID
+	PreCommitIndex  int32
+	PostCommitIndex int32
+	RepositoryUUID  uuid.UUID
+	EnvironmentUUID uuid.UUID
+}
+
+type ChangeRepeatPointsRow struct {
+	Worker      string
+	CommitIndex int32
+	Value       float64
+}
+
+func (q *Queries) ChangeRepeatPoints(ctx context.Context, arg ChangeRepeatPointsParams) ([]ChangeRepeatPointsRow, error) {
+	rows, err := q.query(ctx, q.changeRepeatPointsStmt, changeRepeatPoints,
+		arg.BenchmarkUUID,
+		arg.PreCommitIndex,
+		arg.PostCommitIndex,
+		arg.RepositoryUUID,
+		arg.EnvironmentUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeRepeatPointsRow
+	for rows.Next() {
+		var i ChangeRepeatPointsRow
+		if err := rows.Scan(&i.Worker, &i.CommitIndex, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const changeSummaries = `-- name: ChangeSummaries :many
 SELECT
-    chg.benchmark_uuid, chg.environment_uuid, chg.commit_index, chg.effect_size, chg.pre_n, chg.pre_mean, chg.pre_stddev, chg.post_n, chg.post_mean, chg.post_stddev, chg.rank_by_effect_size, chg.rank_by_abs_percent_change,
+    chg.benchmark_uuid, chg.environment_uuid, chg.commit_index, chg.effect_size, chg.pre_n, chg.pre_mean, chg.pre_stddev, chg.post_n, chg.post_mean, chg.post_stddev, chg.p_value, chg.percent_ci_lower, chg.percent_ci_upper, chg.confirmation, chg.rank_by_effect_size, chg.rank_by_abs_percent_change,
     c.sha AS commit_sha,
     SPLIT_PART(c.message, E'\n', 1)::TEXT AS commit_subject,
 
     b.uuid, b.package_uuid, b.full_name, b.name, b.unit, b.parameters,
     pkg.relative_path,
     mod.path,
-    mod.version
+    mod.version,
+
+    COALESCE(t.state, 'untriaged')::triage_state AS triage_state,
+    COALESCE(t.issue_url, '')::TEXT AS triage_issue_url,
+    COALESCE(t.note, '')::TEXT AS triage_note
 FROM
     changes_ranked AS chg
     INNER JOIN commit_positions AS p
-        ON chg.commit_index=p.index
+        ON 1=1
+        AND p.repository_uuid = $1
+        AND chg.commit_index=p.index
     INNER JOIN commits AS c
         ON p.sha=c.sha
     INNER JOIN benchmarks AS b
@@ -58,21 +231,41 @@ FROM
         ON b.package_uuid=pkg.uuid
     INNER JOIN modules AS mod
         ON pkg.module_uuid=mod.uuid
+    LEFT JOIN change_triage AS t
+        ON 1=1
+        AND chg.benchmark_uuid=t.benchmark_uuid
+        AND chg.environment_uuid=t.environment_uuid
+        AND chg.commit_index=t.commit_index
 WHERE 1=1
-    AND ABS(chg.effect_size) > $1
-    AND chg.commit_index BETWEEN $2 AND $3
-    AND chg.rank_by_effect_size <= $4
-    AND chg.rank_by_abs_percent_change <= $5
+    AND ABS(chg.effect_size) > $2
+    AND chg.commit_index BETWEEN $3 AND $4
+    AND chg.rank_by_effect_size <= $5
+    AND chg.rank_by_abs_percent_change <= $6
+    AND chg.p_value <= $7
+    AND COALESCE(t.state, 'untriaged') = ANY ($8::triage_state[])
+    -- Commit indexes are per-repository, so require the change to be in a trace
+    -- with results at this commit.
+    AND EXISTS (
+        SELECT result_uuid, benchmark_uuid, environment_uuid, commit_sha, commit_index, value
+        FROM points AS pt
+        WHERE 1=1
+            AND pt.benchmark_uuid = chg.benchmark_uuid
+            AND pt.environment_uuid = chg.environment_uuid
+            AND pt.commit_sha = p.sha
+    )
 ORDER BY
     commit_index DESC
 `
 
 type ChangeSummariesParams struct {
+	RepositoryUUID            uuid.UUID
 	EffectSizeMin             float64
 	CommitIndexMin            int32
 	CommitIndexMax            int32
 	RankByEffectSizeMax       int32
 	RankByAbsPercentChangeMax int32
+	PValueMax                 float64
+	TriageStates              []TriageState
 }
 
 type ChangeSummariesRow struct {
@@ -86,6 +279,10 @@ type ChangeSummariesRow struct {
 	PostN                  int32
 	PostMean               float64
 	PostStddev             float64
+	PValue                 float64
+	PercentCILower         float64
+	PercentCIUpper         float64
+	Confirmation           ChangeConfirmation
 	RankByEffectSize       int32
 	RankByAbsPercentChange int32
 	CommitSHA              []byte
@@ -99,15 +296,21 @@ type ChangeSummariesRow struct {
 	RelativePath           string
 	Path                   string
 	Version                string
+	TriageState            TriageState
+	TriageIssueURL         string
+	TriageNote             string
 }
 
 func (q *Queries) ChangeSummaries(ctx context.Context, arg ChangeSummariesParams) ([]ChangeSummariesRow, error) {
 	rows, err := q.query(ctx, q.changeSummariesStmt, changeSummaries,
+		arg.RepositoryUUID,
 		arg.EffectSizeMin,
 		arg.CommitIndexMin,
 		arg.CommitIndexMax,
 		arg.RankByEffectSizeMax,
 		arg.RankByAbsPercentChangeMax,
+		arg.PValueMax,
+		pq.Array(arg.TriageStates),
 	)
 	if err != nil {
 		return nil, err
@@ -127,6 +330,10 @@ func (q *Queries) ChangeSummaries(ctx context.Context, arg ChangeSummariesParams
 			&i.PostN,
 			&i.PostMean,
 			&i.PostStddev,
+			&i.PValue,
+			&i.PercentCILower,
+			&i.PercentCIUpper,
+			&i.Confirmation,
 			&i.RankByEffectSize,
 			&i.RankByAbsPercentChange,
 			&i.CommitSHA,
@@ -140,6 +347,9 @@ func (q *Queries) ChangeSummaries(ctx context.Context, arg ChangeSummariesParams
 			&i.RelativePath,
 			&i.Path,
 			&i.Version,
+			&i.TriageState,
+			&i.TriageIssueURL,
+			&i.TriageNote,
 		); err != nil {
 			return nil, err
 		}
@@ -154,18 +364,415 @@ func (q *Queries) ChangeSummaries(ctx context.Context, arg ChangeSummariesParams
 	return items, nil
 }
 
-const deleteChangesCommitRange = `-- name: DeleteChangesCommitRange :exec
+const changeTriage = `-- name: ChangeTriage :one
+SELECT benchmark_uuid, environment_uuid, commit_index, state, issue_url, note, last_updated FROM change_triage
+WHERE 1=1
+    AND benchmark_uuid = $1
+    AND environment_uuid = $2
+    AND commit_index = $3
+LIMIT 1
+`
+
+type ChangeTriageParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+}
+
+func (q *Queries) ChangeTriage(ctx context.Context, arg ChangeTriageParams) (ChangeTriage, error) {
+	row := q.queryRow(ctx, q.changeTriageStmt, changeTriage, arg.BenchmarkUUID, arg.EnvironmentUUID, arg.CommitIndex)
+	var i ChangeTriage
+	err := row.Scan(
+		&i.BenchmarkUUID,
+		&i.EnvironmentUUID,
+		&i.CommitIndex,
+		&i.State,
+		&i.IssueURL,
+		&i.Note,
+		&i.LastUpdated,
+	)
+	return i, err
+}
+
+const deleteChangeAlert = `-- name: DeleteChangeAlert :exec
+DELETE FROM change_alerts
+WHERE 1=1
+    AND benchmark_uuid = $1
+    AND environment_uuid = $2
+    AND commit_index = $3
+    AND subscription = $4
+`
+
+type DeleteChangeAlertParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	Subscription    string
+}
+
+func (q *Queries) DeleteChangeAlert(ctx context.Context, arg DeleteChangeAlertParams) error {
+	_, err := q.exec(ctx, q.deleteChangeAlertStmt, deleteChangeAlert,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndex,
+		arg.Subscription,
+	)
+	return err
+}
+
+const deleteChangeTriage = `-- name: DeleteChangeTriage :exec
+DELETE FROM change_triage
+WHERE 1=1
+    AND benchmark_uuid = $1
+    AND environment_uuid = $2
+    AND commit_index = $3
+`
+
+type DeleteChangeTriageParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+}
+
+func (q *Queries) DeleteChangeTriage(ctx context.Context, arg DeleteChangeTriageParams) error {
+	_, err := q.exec(ctx, q.deleteChangeTriageStmt, deleteChangeTriage, arg.BenchmarkUUID, arg.EnvironmentUUID, arg.CommitIndex)
+	return err
+}
+
+const deleteChangesRanked = `-- name: DeleteChangesRanked :exec
+DELETE FROM changes_ranked
+`
+
+func (q *Queries) DeleteChangesRanked(ctx context.Context) error {
+	_, err := q.exec(ctx, q.deleteChangesRankedStmt, deleteChangesRanked)
+	return err
+}
+
+const deleteTraceChangeAlertsCommitRange = `-- name: DeleteTraceChangeAlertsCommitRange :many
+DELETE FROM change_alerts
+WHERE 1=1
+    AND benchmark_uuid = $1
+    AND environment_uuid = $2
+    AND commit_index BETWEEN $3 AND $4
+RETURNING commit_index, subscription
+`
+
+type DeleteTraceChangeAlertsCommitRangeParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndexMin  int32
+	CommitIndexMax  int32
+}
+
+type DeleteTraceChangeAlertsCommitRangeRow struct {
+	CommitIndex  int32
+	Subscription string
+}
+
+func (q *Queries) DeleteTraceChangeAlertsCommitRange(ctx context.Context, arg DeleteTraceChangeAlertsCommitRangeParams) ([]DeleteTraceChangeAlertsCommitRangeRow, error) {
+	rows, err := q.query(ctx, q.deleteTraceChangeAlertsCommitRangeStmt, deleteTraceChangeAlertsCommitRange,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndexMin,
+		arg.CommitIndexMax,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteTraceChangeAlertsCommitRangeRow
+	for rows.Next() {
+		var i DeleteTraceChangeAlertsCommitRangeRow
+		if err := rows.Scan(&i.CommitIndex, &i.Subscription); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteTraceChangesCommitRange = `-- name: DeleteTraceChangesCommitRange :exec
 DELETE FROM changes
 WHERE 1=1
-    AND commit_index BETWEEN $1 AND $2
+    AND benchmark_uuid = $1
+    AND environment_uuid = $2
+    AND commit_index BETWEEN $3 AND $4
+`
+
+type DeleteTraceChangesCommitRangeParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndexMin  int32
+	CommitIndexMax  int32
+}
+
+func (q *Queries) DeleteTraceChangesCommitRange(ctx context.Context, arg DeleteTraceChangesCommitRangeParams) error {
+	_, err := q.exec(ctx, q.deleteTraceChangesCommitRangeStmt, deleteTraceChangesCommitRange,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndexMin,
+		arg.CommitIndexMax,
+	)
+	return err
+}
+
+const insertChangeAlert = `-- name: InsertChangeAlert :exec
+INSERT INTO change_alerts (
+    benchmark_uuid,
+    environment_uuid,
+    commit_index,
+    subscription
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT DO NOTHING
+`
+
+type InsertChangeAlertParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	Subscription    string
+}
+
+func (q *Queries) InsertChangeAlert(ctx context.Context, arg InsertChangeAlertParams) error {
+	_, err := q.exec(ctx, q.insertChangeAlertStmt, insertChangeAlert,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndex,
+		arg.Subscription,
+	)
+	return err
+}
+
+const traceChanges = `-- name: TraceChanges :many
+SELECT
+    commit_index,
+    effect_size
+FROM
+    changes
+WHERE 1=1
+    AND benchmark_uuid = $1
+    AND environment_uuid = $2
+    AND commit_index BETWEEN $3 AND $4
+`
+
+type TraceChangesParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndexMin  int32
+	CommitIndexMax  int32
+}
+
+type TraceChangesRow struct {
+	CommitIndex int32
+	EffectSize  float64
+}
+
+func (q *Queries) TraceChanges(ctx context.Context, arg TraceChangesParams) ([]TraceChangesRow, error) {
+	rows, err := q.query(ctx, q.traceChangesStmt, traceChanges,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndexMin,
+		arg.CommitIndexMax,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TraceChangesRow
+	for rows.Next() {
+		var i TraceChangesRow
+		if err := rows.Scan(&i.CommitIndex, &i.EffectSize); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unconfirmedChanges = `-- name: UnconfirmedChanges :many
+SELECT
+    chg.benchmark_uuid, chg.environment_uuid, chg.commit_index, chg.effect_size, chg.pre_n, chg.pre_mean, chg.pre_stddev, chg.post_n, chg.post_mean, chg.post_stddev, chg.p_value, chg.percent_ci_lower, chg.percent_ci_upper, chg.confirmation,
+    COALESCE((
+        SELECT MAX(pt.commit_index)
+        FROM points AS pt
+            INNER JOIN commit_positions AS cp
+                ON pt.commit_sha=cp.sha
+        WHERE 1=1
+            AND pt.benchmark_uuid = chg.benchmark_uuid
+            AND pt.environment_uuid = chg.environment_uuid
+            AND pt.commit_index < chg.commit_index
+            AND cp.repository_uuid = $1
+    ), chg.commit_index)::INT AS pre_commit_index
+FROM
+    changes AS chg
+WHERE 1=1
+    AND chg.confirmation = 'unconfirmed'
+    AND chg.commit_index BETWEEN $2 AND $3
+    AND EXISTS (
+        SELECT result_uuid, benchmark_uuid, environment_uuid, commit_sha, commit_index, value, sha, commit_time, index, repository_uuid
+        FROM points AS pt
+            INNER JOIN commit_positions AS cp
+                ON pt.commit_sha=cp.sha
+        WHERE 1=1
+            AND pt.benchmark_uuid = chg.benchmark_uuid
+            AND pt.environment_uuid = chg.environment_uuid
+            AND pt.commit_index = chg.commit_index
+            AND cp.repository_uuid = $1
+    )
 `
 
-type DeleteChangesCommitRangeParams struct {
+type UnconfirmedChangesParams struct {
+	RepositoryUUID uuid.UUID
 	CommitIndexMin int32
 	CommitIndexMax int32
 }
 
-func (q *Queries) DeleteChangesCommitRange(ctx context.Context, arg DeleteChangesCommitRangeParams) error {
-	_, err := q.exec(ctx, q.deleteChangesCommitRangeStmt, deleteChangesCommitRange, arg.CommitIndexMin, arg.CommitIndexMax)
+type UnconfirmedChangesRow struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	EffectSize      float64
+	PreN            int32
+	PreMean         float64
+	PreStddev       float64
+	PostN           int32
+	PostMean        float64
+	PostStddev      float64
+	PValue          float64
+	PercentCILower  float64
+	PercentCIUpper  float64
+	Confirmation    ChangeConfirmation
+	PreCommitIndex  int32
+}
+
+func (q *Queries) UnconfirmedChanges(ctx context.Context, arg UnconfirmedChangesParams) ([]UnconfirmedChangesRow, error) {
+	rows, err := q.query(ctx, q.unconfirmedChangesStmt, unconfirmedChanges, arg.RepositoryUUID, arg.CommitIndexMin, arg.CommitIndexMax)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnconfirmedChangesRow
+	for rows.Next() {
+		var i UnconfirmedChangesRow
+		if err := rows.Scan(
+			&i.BenchmarkUUID,
+			&i.EnvironmentUUID,
+			&i.CommitIndex,
+			&i.EffectSize,
+			&i.PreN,
+			&i.PreMean,
+			&i.PreStddev,
+			&i.PostN,
+			&i.PostMean,
+			&i.PostStddev,
+			&i.PValue,
+			&i.PercentCILower,
+			&i.PercentCIUpper,
+			&i.Confirmation,
+			&i.PreCommitIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChangeConfirmation = `-- name: UpdateChangeConfirmation :exec
+UPDATE changes
+SET
+    confirmation = $1
+WHERE 1=1
+    AND benchmark_uuid = $2
+    AND environment_uuid = $3
+    AND commit_index = $4
+`
+
+type UpdateChangeConfirmationParams struct {
+	Confirmation    ChangeConfirmation
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+}
+
+func (q *Queries) UpdateChangeConfirmation(ctx context.Context, arg UpdateChangeConfirmationParams) error {
+	_, err := q.exec(ctx, q.updateChangeConfirmationStmt, updateChangeConfirmation,
+		arg.Confirmation,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndex,
+	)
+	return err
+}
+
+const upsertChangeTriage = `-- name: UpsertChangeTriage :exec
+INSERT INTO change_triage (
+    benchmark_uuid,
+    environment_uuid,
+    commit_index,
+    state,
+    issue_url,
+    note,
+    last_updated
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+ON CONFLICT (benchmark_uuid, environment_uuid, commit_index)
+DO UPDATE SET
+    state = EXCLUDED.state,
+    issue_url = EXCLUDED.issue_url,
+    note = EXCLUDED.note,
+    last_updated = EXCLUDED.last_updated
+`
+
+type UpsertChangeTriageParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	State           TriageState
+	IssueURL        string
+	Note            string
+	LastUpdated     time.Time
+}
+
+func (q *Queries) UpsertChangeTriage(ctx context.Context, arg UpsertChangeTriageParams) error {
+	_, err := q.exec(ctx, q.upsertChangeTriageStmt, upsertChangeTriage,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndex,
+		arg.State,
+		arg.IssueURL,
+		arg.Note,
+		arg.LastUpdated,
+	)
 	return err
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
@@ -15,23 +16,31 @@ INSERT INTO commit_positions (
     SELECT
         c.sha,
         c.commit_time,
-        (ROW_NUMBER() OVER (ORDER BY c.commit_time))-1 AS index
+        (ROW_NUMBER() OVER (ORDER BY c.commit_time))-1 AS index,
+        r.repository_uuid
     FROM
         commits AS c
         INNER JOIN commit_refs AS r
-            ON c.sha=r.sha AND r.ref = 'master'
+            ON c.sha=r.sha AND r.ref = $1
+    WHERE 1=1
+        AND r.repository_uuid = $2
 )
 ON CONFLICT (sha)
 DO UPDATE SET index = EXCLUDED.index
 `
 
-func (q *Queries) BuildCommitPositions(ctx context.Context) error {
-	_, err := q.exec(ctx, q.buildCommitPositionsStmt, buildCommitPositions)
+type BuildCommitPositionsParams struct {
+	Ref            string
+	RepositoryUUID uuid.UUID
+}
+
+func (q *Queries) BuildCommitPositions(ctx context.Context, arg BuildCommitPositionsParams) error {
+	_, err := q.exec(ctx, q.buildCommitPositionsStmt, buildCommitPositions, arg.Ref, arg.RepositoryUUID)
 	return err
 }
 
 const commit = `-- name: Commit :one
-SELECT sha, tree, parents, author_name, author_email, author_time, committer_name, committer_email, commit_time, message FROM commits
+SELECT sha, tree, parents, author_name, author_email, author_time, committer_name, committer_email, commit_time, message, repository_uuid FROM commits
 WHERE sha = $1 LIMIT 1
 `
 
@@ -49,6 +58,7 @@ func (q *Queries) Commit(ctx context.Context, sha []byte) (Commit, error) {
 		&i.CommitterEmail,
 		&i.CommitTime,
 		&i.Message,
+		&i.RepositoryUUID,
 	)
 	return i, err
 }
@@ -80,7 +90,8 @@ INSERT INTO commits (
     committer_name,
     committer_email,
     commit_time,
-    message
+    message,
+    repository_uuid
 ) VALUES (
     $1,
     $2,
@@ -91,7 +102,8 @@ INSERT INTO commits (
     $7,
     $8,
     $9,
-    $10
+    $10,
+    $11
 ) ON CONFLICT DO NOTHING
 `
 
@@ -106,6 +118,7 @@ type InsertCommitParams struct {
 	CommitterEmail string
 	CommitTime     time.Time
 	Message        string
+	RepositoryUUID uuid.UUID
 }
 
 func (q *Queries) InsertCommit(ctx context.Context, arg InsertCommitParams) error {
@@ -120,6 +133,7 @@ func (q *Queries) InsertCommit(ctx context.Context, arg InsertCommitParams) erro
 		arg.CommitterEmail,
 		arg.CommitTime,
 		arg.Message,
+		arg.RepositoryUUID,
 	)
 	return err
 }
@@ -128,47 +142,58 @@ const insertCommitPosition = `-- name: InsertCommitPosition :exec
 INSERT INTO commit_positions (
     sha,
     commit_time,
-    index
+    index,
+    repository_uuid
 ) VALUES (
     $1,
     $2,
-    $3
+    $3,
+    $4
 ) ON CONFLICT DO NOTHING
 `
 
 type InsertCommitPositionParams struct {
-	SHA        []byte
-	CommitTime time.Time
-	Index      int32
+	SHA            []byte
+	CommitTime     time.Time
+	Index          int32
+	RepositoryUUID uuid.UUID
 }
 
 func (q *Queries) InsertCommitPosition(ctx context.Context, arg InsertCommitPositionParams) error {
-	_, err := q.exec(ctx, q.insertCommitPositionStmt, insertCommitPosition, arg.SHA, arg.CommitTime, arg.Index)
+	_, err := q.exec(ctx, q.insertCommitPositionStmt, insertCommitPosition,
+		arg.SHA,
+		arg.CommitTime,
+		arg.Index,
+		arg.RepositoryUUID,
+	)
 	return err
 }
 
 const insertCommitRef = `-- name: InsertCommitRef :exec
 INSERT INTO commit_refs (
     sha,
-    ref
+    ref,
+    repository_uuid
 ) VALUES (
     $1,
-    $2
+    $2,
+    $3
 ) ON CONFLICT DO NOTHING
 `
 
 type InsertCommitRefParams struct {
-	SHA []byte
-	Ref string
+	SHA            []byte
+	Ref            string
+	RepositoryUUID uuid.UUID
 }
 
 func (q *Queries) InsertCommitRef(ctx context.Context, arg InsertCommitRefParams) error {
-	_, err := q.exec(ctx, q.insertCommitRefStmt, insertCommitRef, arg.SHA, arg.Ref)
+	_, err := q.exec(ctx, q.insertCommitRefStmt, insertCommitRef, arg.SHA, arg.Ref, arg.RepositoryUUID)
 	return err
 }
 
 const mostRecentCommit = `-- name: MostRecentCommit :one
-SELECT sha, tree, parents, author_name, author_email, author_time, committer_name, committer_email, commit_time, message FROM commits
+SELECT sha, tree, parents, author_name, author_email, author_time, committer_name, committer_email, commit_time, message, repository_uuid FROM commits
 ORDER BY commit_time DESC
 LIMIT 1
 `
@@ -187,6 +212,7 @@ func (q *Queries) MostRecentCommit(ctx context.Context) (Commit, error) {
 		&i.CommitterEmail,
 		&i.CommitTime,
 		&i.Message,
+		&i.RepositoryUUID,
 	)
 	return i, err
 }
@@ -196,10 +222,12 @@ SELECT
     MAX(index)::INT
 FROM
     commit_positions
+WHERE 1=1
+    AND repository_uuid = $1
 `
 
-func (q *Queries) MostRecentCommitIndex(ctx context.Context) (int32, error) {
-	row := q.queryRow(ctx, q.mostRecentCommitIndexStmt, mostRecentCommitIndex)
+func (q *Queries) MostRecentCommitIndex(ctx context.Context, repositoryUUID uuid.UUID) (int32, error) {
+	row := q.queryRow(ctx, q.mostRecentCommitIndexStmt, mostRecentCommitIndex, repositoryUUID)
 	var column_1 int32
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -207,18 +235,25 @@ func (q *Queries) MostRecentCommitIndex(ctx context.Context) (int32, error) {
 
 const mostRecentCommitWithRef = `-- name: MostRecentCommitWithRef :one
 SELECT
-    c.sha, c.tree, c.parents, c.author_name, c.author_email, c.author_time, c.committer_name, c.committer_email, c.commit_time, c.message
+    c.sha, c.tree, c.parents, c.author_name, c.author_email, c.author_time, c.committer_name, c.committer_email, c.commit_time, c.message, c.repository_uuid
 FROM
     commits AS c
     INNER JOIN commit_refs AS r
         ON c.sha=r.sha AND r.ref = $1
+WHERE 1=1
+    AND r.repository_uuid = $2
 ORDER BY
     c.commit_time DESC
 LIMIT 1
 `
 
-func (q *Queries) MostRecentCommitWithRef(ctx context.Context, ref string) (Commit, error) {
-	row := q.queryRow(ctx, q.mostRecentCommitWithRefStmt, mostRecentCommitWithRef, ref)
+type MostRecentCommitWithRefParams struct {
+	Ref            string
+	RepositoryUUID uuid.UUID
+}
+
+func (q *Queries) MostRecentCommitWithRef(ctx context.Context, arg MostRecentCommitWithRefParams) (Commit, error) {
+	row := q.queryRow(ctx, q.mostRecentCommitWithRefStmt, mostRecentCommitWithRef, arg.Ref, arg.RepositoryUUID)
 	var i Commit
 	err := row.Scan(
 		&i.SHA,
@@ -231,6 +266,7 @@ func (q *Queries) MostRecentCommitWithRef(ctx context.Context, ref string) (Comm
 		&i.CommitterEmail,
 		&i.CommitTime,
 		&i.Message,
+		&i.RepositoryUUID,
 	)
 	return i, err
 }
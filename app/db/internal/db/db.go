@@ -37,9 +37,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.buildCommitPositionsStmt, err = db.PrepareContext(ctx, buildCommitPositions); err != nil {
 		return nil, fmt.Errorf("error preparing query BuildCommitPositions: %w", err)
 	}
+	if q.changeAlertsStmt, err = db.PrepareContext(ctx, changeAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query ChangeAlerts: %w", err)
+	}
+	if q.changeBisectionCommitModulePairsStmt, err = db.PrepareContext(ctx, changeBisectionCommitModulePairs); err != nil {
+		return nil, fmt.Errorf("error preparing query ChangeBisectionCommitModulePairs: %w", err)
+	}
+	if q.changeConfirmationCommitModulePairsStmt, err = db.PrepareContext(ctx, changeConfirmationCommitModulePairs); err != nil {
+		return nil, fmt.Errorf("error preparing query ChangeConfirmationCommitModulePairs: %w", err)
+	}
+	if q.changeRepeatPointsStmt, err = db.PrepareContext(ctx, changeRepeatPoints); err != nil {
+		return nil, fmt.Errorf("error preparing query ChangeRepeatPoints: %w", err)
+	}
 	if q.changeSummariesStmt, err = db.PrepareContext(ctx, changeSummaries); err != nil {
 		return nil, fmt.Errorf("error preparing query ChangeSummaries: %w", err)
 	}
+	if q.changeTriageStmt, err = db.PrepareContext(ctx, changeTriage); err != nil {
+		return nil, fmt.Errorf("error preparing query ChangeTriage: %w", err)
+	}
 	if q.commitStmt, err = db.PrepareContext(ctx, commit); err != nil {
 		return nil, fmt.Errorf("error preparing query Commit: %w", err)
 	}
@@ -49,18 +64,42 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.commitModuleWorkerErrorsStmt, err = db.PrepareContext(ctx, commitModuleWorkerErrors); err != nil {
 		return nil, fmt.Errorf("error preparing query CommitModuleWorkerErrors: %w", err)
 	}
+	if q.commitPointsStmt, err = db.PrepareContext(ctx, commitPoints); err != nil {
+		return nil, fmt.Errorf("error preparing query CommitPoints: %w", err)
+	}
+	if q.commitRepositoryStmt, err = db.PrepareContext(ctx, commitRepository); err != nil {
+		return nil, fmt.Errorf("error preparing query CommitRepository: %w", err)
+	}
 	if q.createTaskStmt, err = db.PrepareContext(ctx, createTask); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTask: %w", err)
 	}
 	if q.dataFileStmt, err = db.PrepareContext(ctx, dataFile); err != nil {
 		return nil, fmt.Errorf("error preparing query DataFile: %w", err)
 	}
-	if q.deleteChangesCommitRangeStmt, err = db.PrepareContext(ctx, deleteChangesCommitRange); err != nil {
-		return nil, fmt.Errorf("error preparing query DeleteChangesCommitRange: %w", err)
+	if q.deleteChangeAlertStmt, err = db.PrepareContext(ctx, deleteChangeAlert); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteChangeAlert: %w", err)
+	}
+	if q.deleteChangeTriageStmt, err = db.PrepareContext(ctx, deleteChangeTriage); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteChangeTriage: %w", err)
+	}
+	if q.deleteChangesRankedStmt, err = db.PrepareContext(ctx, deleteChangesRanked); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteChangesRanked: %w", err)
+	}
+	if q.deleteTraceChangeAlertsCommitRangeStmt, err = db.PrepareContext(ctx, deleteTraceChangeAlertsCommitRange); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTraceChangeAlertsCommitRange: %w", err)
+	}
+	if q.deleteTraceChangesCommitRangeStmt, err = db.PrepareContext(ctx, deleteTraceChangesCommitRange); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTraceChangesCommitRange: %w", err)
+	}
+	if q.deleteTraceUpdateStmt, err = db.PrepareContext(ctx, deleteTraceUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTraceUpdate: %w", err)
 	}
 	if q.insertBenchmarkStmt, err = db.PrepareContext(ctx, insertBenchmark); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertBenchmark: %w", err)
 	}
+	if q.insertChangeAlertStmt, err = db.PrepareContext(ctx, insertChangeAlert); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertChangeAlert: %w", err)
+	}
 	if q.insertCommitStmt, err = db.PrepareContext(ctx, insertCommit); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertCommit: %w", err)
 	}
@@ -88,6 +127,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.moduleStmt, err = db.PrepareContext(ctx, module); err != nil {
 		return nil, fmt.Errorf("error preparing query Module: %w", err)
 	}
+	if q.moduleJobSettingsStmt, err = db.PrepareContext(ctx, moduleJobSettings); err != nil {
+		return nil, fmt.Errorf("error preparing query ModuleJobSettings: %w", err)
+	}
 	if q.modulePkgsStmt, err = db.PrepareContext(ctx, modulePkgs); err != nil {
 		return nil, fmt.Errorf("error preparing query ModulePkgs: %w", err)
 	}
@@ -115,6 +157,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.recentCommitModulePairsWithoutWorkerTasksStmt, err = db.PrepareContext(ctx, recentCommitModulePairsWithoutWorkerTasks); err != nil {
 		return nil, fmt.Errorf("error preparing query RecentCommitModulePairsWithoutWorkerTasks: %w", err)
 	}
+	if q.recentRepositoryCommitsWithoutWorkerTasksStmt, err = db.PrepareContext(ctx, recentRepositoryCommitsWithoutWorkerTasks); err != nil {
+		return nil, fmt.Errorf("error preparing query RecentRepositoryCommitsWithoutWorkerTasks: %w", err)
+	}
+	if q.recordTraceUpdateStmt, err = db.PrepareContext(ctx, recordTraceUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query RecordTraceUpdate: %w", err)
+	}
+	if q.refreshWorkerTasksStmt, err = db.PrepareContext(ctx, refreshWorkerTasks); err != nil {
+		return nil, fmt.Errorf("error preparing query RefreshWorkerTasks: %w", err)
+	}
+	if q.repositoriesStmt, err = db.PrepareContext(ctx, repositories); err != nil {
+		return nil, fmt.Errorf("error preparing query Repositories: %w", err)
+	}
+	if q.repositoryStmt, err = db.PrepareContext(ctx, repository); err != nil {
+		return nil, fmt.Errorf("error preparing query Repository: %w", err)
+	}
 	if q.resultStmt, err = db.PrepareContext(ctx, result); err != nil {
 		return nil, fmt.Errorf("error preparing query Result: %w", err)
 	}
@@ -130,9 +187,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.traceStmt, err = db.PrepareContext(ctx, trace); err != nil {
 		return nil, fmt.Errorf("error preparing query Trace: %w", err)
 	}
+	if q.traceChangesStmt, err = db.PrepareContext(ctx, traceChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query TraceChanges: %w", err)
+	}
+	if q.traceExpandRangeStmt, err = db.PrepareContext(ctx, traceExpandRange); err != nil {
+		return nil, fmt.Errorf("error preparing query TraceExpandRange: %w", err)
+	}
 	if q.tracePointsStmt, err = db.PrepareContext(ctx, tracePoints); err != nil {
 		return nil, fmt.Errorf("error preparing query TracePoints: %w", err)
 	}
+	if q.traceUpdatesStmt, err = db.PrepareContext(ctx, traceUpdates); err != nil {
+		return nil, fmt.Errorf("error preparing query TraceUpdates: %w", err)
+	}
+	if q.transitionInactiveTaskStatusesStmt, err = db.PrepareContext(ctx, transitionInactiveTaskStatuses); err != nil {
+		return nil, fmt.Errorf("error preparing query TransitionInactiveTaskStatuses: %w", err)
+	}
 	if q.transitionTaskStatusStmt, err = db.PrepareContext(ctx, transitionTaskStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query TransitionTaskStatus: %w", err)
 	}
@@ -142,9 +211,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.truncateAllStmt, err = db.PrepareContext(ctx, truncateAll); err != nil {
 		return nil, fmt.Errorf("error preparing query TruncateAll: %w", err)
 	}
+	if q.unconfirmedChangesStmt, err = db.PrepareContext(ctx, unconfirmedChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query UnconfirmedChanges: %w", err)
+	}
+	if q.updateChangeConfirmationStmt, err = db.PrepareContext(ctx, updateChangeConfirmation); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateChangeConfirmation: %w", err)
+	}
+	if q.upsertChangeTriageStmt, err = db.PrepareContext(ctx, upsertChangeTriage); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertChangeTriage: %w", err)
+	}
+	if q.upsertModuleJobSettingsStmt, err = db.PrepareContext(ctx, upsertModuleJobSettings); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertModuleJobSettings: %w", err)
+	}
+	if q.upsertRepositoryStmt, err = db.PrepareContext(ctx, upsertRepository); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertRepository: %w", err)
+	}
+	if q.upsertWorkerStmt, err = db.PrepareContext(ctx, upsertWorker); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertWorker: %w", err)
+	}
+	if q.workerStmt, err = db.PrepareContext(ctx, worker); err != nil {
+		return nil, fmt.Errorf("error preparing query Worker: %w", err)
+	}
 	if q.workerTasksWithStatusStmt, err = db.PrepareContext(ctx, workerTasksWithStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query WorkerTasksWithStatus: %w", err)
 	}
+	if q.workersStmt, err = db.PrepareContext(ctx, workers); err != nil {
+		return nil, fmt.Errorf("error preparing query Workers: %w", err)
+	}
 	return &q, nil
 }
 
@@ -175,11 +268,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing buildCommitPositionsStmt: %w", cerr)
 		}
 	}
+	if q.changeAlertsStmt != nil {
+		if cerr := q.changeAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing changeAlertsStmt: %w", cerr)
+		}
+	}
+	if q.changeBisectionCommitModulePairsStmt != nil {
+		if cerr := q.changeBisectionCommitModulePairsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing changeBisectionCommitModulePairsStmt: %w", cerr)
+		}
+	}
+	if q.changeConfirmationCommitModulePairsStmt != nil {
+		if cerr := q.changeConfirmationCommitModulePairsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing changeConfirmationCommitModulePairsStmt: %w", cerr)
+		}
+	}
+	if q.changeRepeatPointsStmt != nil {
+		if cerr := q.changeRepeatPointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing changeRepeatPointsStmt: %w", cerr)
+		}
+	}
 	if q.changeSummariesStmt != nil {
 		if cerr := q.changeSummariesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing changeSummariesStmt: %w", cerr)
 		}
 	}
+	if q.changeTriageStmt != nil {
+		if cerr := q.changeTriageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing changeTriageStmt: %w", cerr)
+		}
+	}
 	if q.commitStmt != nil {
 		if cerr := q.commitStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing commitStmt: %w", cerr)
@@ -195,6 +313,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing commitModuleWorkerErrorsStmt: %w", cerr)
 		}
 	}
+	if q.commitPointsStmt != nil {
+		if cerr := q.commitPointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing commitPointsStmt: %w", cerr)
+		}
+	}
+	if q.commitRepositoryStmt != nil {
+		if cerr := q.commitRepositoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing commitRepositoryStmt: %w", cerr)
+		}
+	}
 	if q.createTaskStmt != nil {
 		if cerr := q.createTaskStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTaskStmt: %w", cerr)
@@ -205,9 +333,34 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing dataFileStmt: %w", cerr)
 		}
 	}
-	if q.deleteChangesCommitRangeStmt != nil {
-		if cerr := q.deleteChangesCommitRangeStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing deleteChangesCommitRangeStmt: %w", cerr)
+	if q.deleteChangeAlertStmt != nil {
+		if cerr := q.deleteChangeAlertStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteChangeAlertStmt: %w", cerr)
+		}
+	}
+	if q.deleteChangeTriageStmt != nil {
+		if cerr := q.deleteChangeTriageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteChangeTriageStmt: %w", cerr)
+		}
+	}
+	if q.deleteChangesRankedStmt != nil {
+		if cerr := q.deleteChangesRankedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteChangesRankedStmt: %w", cerr)
+		}
+	}
+	if q.deleteTraceChangeAlertsCommitRangeStmt != nil {
+		if cerr := q.deleteTraceChangeAlertsCommitRangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTraceChangeAlertsCommitRangeStmt: %w", cerr)
+		}
+	}
+	if q.deleteTraceChangesCommitRangeStmt != nil {
+		if cerr := q.deleteTraceChangesCommitRangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTraceChangesCommitRangeStmt: %w", cerr)
+		}
+	}
+	if q.deleteTraceUpdateStmt != nil {
+		if cerr := q.deleteTraceUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTraceUpdateStmt: %w", cerr)
 		}
 	}
 	if q.insertBenchmarkStmt != nil {
@@ -215,6 +368,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing insertBenchmarkStmt: %w", cerr)
 		}
 	}
+	if q.insertChangeAlertStmt != nil {
+		if cerr := q.insertChangeAlertStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertChangeAlertStmt: %w", cerr)
+		}
+	}
 	if q.insertCommitStmt != nil {
 		if cerr := q.insertCommitStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing insertCommitStmt: %w", cerr)
@@ -260,6 +418,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing moduleStmt: %w", cerr)
 		}
 	}
+	if q.moduleJobSettingsStmt != nil {
+		if cerr := q.moduleJobSettingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing moduleJobSettingsStmt: %w", cerr)
+		}
+	}
 	if q.modulePkgsStmt != nil {
 		if cerr := q.modulePkgsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing modulePkgsStmt: %w", cerr)
@@ -305,6 +468,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing recentCommitModulePairsWithoutWorkerTasksStmt: %w", cerr)
 		}
 	}
+	if q.recentRepositoryCommitsWithoutWorkerTasksStmt != nil {
+		if cerr := q.recentRepositoryCommitsWithoutWorkerTasksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recentRepositoryCommitsWithoutWorkerTasksStmt: %w", cerr)
+		}
+	}
+	if q.recordTraceUpdateStmt != nil {
+		if cerr := q.recordTraceUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recordTraceUpdateStmt: %w", cerr)
+		}
+	}
+	if q.refreshWorkerTasksStmt != nil {
+		if cerr := q.refreshWorkerTasksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing refreshWorkerTasksStmt: %w", cerr)
+		}
+	}
+	if q.repositoriesStmt != nil {
+		if cerr := q.repositoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing repositoriesStmt: %w", cerr)
+		}
+	}
+	if q.repositoryStmt != nil {
+		if cerr := q.repositoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing repositoryStmt: %w", cerr)
+		}
+	}
 	if q.resultStmt != nil {
 		if cerr := q.resultStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing resultStmt: %w", cerr)
@@ -330,11 +518,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing traceStmt: %w", cerr)
 		}
 	}
+	if q.traceChangesStmt != nil {
+		if cerr := q.traceChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing traceChangesStmt: %w", cerr)
+		}
+	}
+	if q.traceExpandRangeStmt != nil {
+		if cerr := q.traceExpandRangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing traceExpandRangeStmt: %w", cerr)
+		}
+	}
 	if q.tracePointsStmt != nil {
 		if cerr := q.tracePointsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing tracePointsStmt: %w", cerr)
 		}
 	}
+	if q.traceUpdatesStmt != nil {
+		if cerr := q.traceUpdatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing traceUpdatesStmt: %w", cerr)
+		}
+	}
+	if q.transitionInactiveTaskStatusesStmt != nil {
+		if cerr := q.transitionInactiveTaskStatusesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing transitionInactiveTaskStatusesStmt: %w", cerr)
+		}
+	}
 	if q.transitionTaskStatusStmt != nil {
 		if cerr := q.transitionTaskStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing transitionTaskStatusStmt: %w", cerr)
@@ -350,11 +558,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing truncateAllStmt: %w", cerr)
 		}
 	}
+	if q.unconfirmedChangesStmt != nil {
+		if cerr := q.unconfirmedChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unconfirmedChangesStmt: %w", cerr)
+		}
+	}
+	if q.updateChangeConfirmationStmt != nil {
+		if cerr := q.updateChangeConfirmationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateChangeConfirmationStmt: %w", cerr)
+		}
+	}
+	if q.upsertChangeTriageStmt != nil {
+		if cerr := q.upsertChangeTriageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertChangeTriageStmt: %w", cerr)
+		}
+	}
+	if q.upsertModuleJobSettingsStmt != nil {
+		if cerr := q.upsertModuleJobSettingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertModuleJobSettingsStmt: %w", cerr)
+		}
+	}
+	if q.upsertRepositoryStmt != nil {
+		if cerr := q.upsertRepositoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertRepositoryStmt: %w", cerr)
+		}
+	}
+	if q.upsertWorkerStmt != nil {
+		if cerr := q.upsertWorkerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertWorkerStmt: %w", cerr)
+		}
+	}
+	if q.workerStmt != nil {
+		if cerr := q.workerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing workerStmt: %w", cerr)
+		}
+	}
 	if q.workerTasksWithStatusStmt != nil {
 		if cerr := q.workerTasksWithStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing workerTasksWithStatusStmt: %w", cerr)
 		}
 	}
+	if q.workersStmt != nil {
+		if cerr := q.workersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing workersStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -399,14 +647,27 @@ type Queries struct {
 	benchmarkResultsStmt                          *sql.Stmt
 	buildChangesRankedStmt                        *sql.Stmt
 	buildCommitPositionsStmt                      *sql.Stmt
+	changeAlertsStmt                              *sql.Stmt
+	changeBisectionCommitModulePairsStmt          *sql.Stmt
+	changeConfirmationCommitModulePairsStmt       *sql.Stmt
+	changeRepeatPointsStmt                        *sql.Stmt
 	changeSummariesStmt                           *sql.Stmt
+	changeTriageStmt                              *sql.Stmt
 	commitStmt                                    *sql.Stmt
 	commitIndexForSHAStmt                         *sql.Stmt
 	commitModuleWorkerErrorsStmt                  *sql.Stmt
+	commitPointsStmt                              *sql.Stmt
+	commitRepositoryStmt                          *sql.Stmt
 	createTaskStmt                                *sql.Stmt
 	dataFileStmt                                  *sql.Stmt
-	deleteChangesCommitRangeStmt                  *sql.Stmt
+	deleteChangeAlertStmt                         *sql.Stmt
+	deleteChangeTriageStmt                        *sql.Stmt
+	deleteChangesRankedStmt                       *sql.Stmt
+	deleteTraceChangeAlertsCommitRangeStmt        *sql.Stmt
+	deleteTraceChangesCommitRangeStmt             *sql.Stmt
+	deleteTraceUpdateStmt                         *sql.Stmt
 	insertBenchmarkStmt                           *sql.Stmt
+	insertChangeAlertStmt                         *sql.Stmt
 	insertCommitStmt                              *sql.Stmt
 	insertCommitPositionStmt                      *sql.Stmt
 	insertCommitRefStmt                           *sql.Stmt
@@ -416,6 +677,7 @@ type Queries struct {
 	insertPropertiesStmt                          *sql.Stmt
 	insertResultStmt                              *sql.Stmt
 	moduleStmt                                    *sql.Stmt
+	moduleJobSettingsStmt                         *sql.Stmt
 	modulePkgsStmt                                *sql.Stmt
 	modulesStmt                                   *sql.Stmt
 	mostRecentCommitStmt                          *sql.Stmt
@@ -425,62 +687,110 @@ type Queries struct {
 	pkgStmt                                       *sql.Stmt
 	propertiesStmt                                *sql.Stmt
 	recentCommitModulePairsWithoutWorkerTasksStmt *sql.Stmt
+	recentRepositoryCommitsWithoutWorkerTasksStmt *sql.Stmt
+	recordTraceUpdateStmt                         *sql.Stmt
+	refreshWorkerTasksStmt                        *sql.Stmt
+	repositoriesStmt                              *sql.Stmt
+	repositoryStmt                                *sql.Stmt
 	resultStmt                                    *sql.Stmt
 	setTaskDataFileStmt                           *sql.Stmt
 	taskStmt                                      *sql.Stmt
 	tasksWithStatusStmt                           *sql.Stmt
 	traceStmt                                     *sql.Stmt
+	traceChangesStmt                              *sql.Stmt
+	traceExpandRangeStmt                          *sql.Stmt
 	tracePointsStmt                               *sql.Stmt
+	traceUpdatesStmt                              *sql.Stmt
+	transitionInactiveTaskStatusesStmt            *sql.Stmt
 	transitionTaskStatusStmt                      *sql.Stmt
 	transitionTaskStatusesBeforeStmt              *sql.Stmt
 	truncateAllStmt                               *sql.Stmt
+	unconfirmedChangesStmt                        *sql.Stmt
+	updateChangeConfirmationStmt                  *sql.Stmt
+	upsertChangeTriageStmt                        *sql.Stmt
+	upsertModuleJobSettingsStmt                   *sql.Stmt
+	upsertRepositoryStmt                          *sql.Stmt
+	upsertWorkerStmt                              *sql.Stmt
+	workerStmt                                    *sql.Stmt
 	workerTasksWithStatusStmt                     *sql.Stmt
+	workersStmt                                   *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                           tx,
-		tx:                           tx,
-		benchmarkStmt:                q.benchmarkStmt,
-		benchmarkPointsStmt:          q.benchmarkPointsStmt,
-		benchmarkResultsStmt:         q.benchmarkResultsStmt,
-		buildChangesRankedStmt:       q.buildChangesRankedStmt,
-		buildCommitPositionsStmt:     q.buildCommitPositionsStmt,
-		changeSummariesStmt:          q.changeSummariesStmt,
-		commitStmt:                   q.commitStmt,
-		commitIndexForSHAStmt:        q.commitIndexForSHAStmt,
-		commitModuleWorkerErrorsStmt: q.commitModuleWorkerErrorsStmt,
-		createTaskStmt:               q.createTaskStmt,
-		dataFileStmt:                 q.dataFileStmt,
-		deleteChangesCommitRangeStmt: q.deleteChangesCommitRangeStmt,
-		insertBenchmarkStmt:          q.insertBenchmarkStmt,
-		insertCommitStmt:             q.insertCommitStmt,
-		insertCommitPositionStmt:     q.insertCommitPositionStmt,
-		insertCommitRefStmt:          q.insertCommitRefStmt,
-		insertDataFileStmt:           q.insertDataFileStmt,
-		insertModuleStmt:             q.insertModuleStmt,
-		insertPkgStmt:                q.insertPkgStmt,
-		insertPropertiesStmt:         q.insertPropertiesStmt,
-		insertResultStmt:             q.insertResultStmt,
-		moduleStmt:                   q.moduleStmt,
-		modulePkgsStmt:               q.modulePkgsStmt,
-		modulesStmt:                  q.modulesStmt,
-		mostRecentCommitStmt:         q.mostRecentCommitStmt,
-		mostRecentCommitIndexStmt:    q.mostRecentCommitIndexStmt,
-		mostRecentCommitWithRefStmt:  q.mostRecentCommitWithRefStmt,
-		packageBenchmarksStmt:        q.packageBenchmarksStmt,
-		pkgStmt:                      q.pkgStmt,
-		propertiesStmt:               q.propertiesStmt,
+		db:                                      tx,
+		tx:                                      tx,
+		benchmarkStmt:                           q.benchmarkStmt,
+		benchmarkPointsStmt:                     q.benchmarkPointsStmt,
+		benchmarkResultsStmt:                    q.benchmarkResultsStmt,
+		buildChangesRankedStmt:                  q.buildChangesRankedStmt,
+		buildCommitPositionsStmt:                q.buildCommitPositionsStmt,
+		changeAlertsStmt:                        q.changeAlertsStmt,
+		changeBisectionCommitModulePairsStmt:    q.changeBisectionCommitModulePairsStmt,
+		changeConfirmationCommitModulePairsStmt: q.changeConfirmationCommitModulePairsStmt,
+		changeRepeatPointsStmt:                  q.changeRepeatPointsStmt,
+		changeSummariesStmt:                     q.changeSummariesStmt,
+		changeTriageStmt:                        q.changeTriageStmt,
+		commitStmt:                              q.commitStmt,
+		commitIndexForSHAStmt:                   q.commitIndexForSHAStmt,
+		commitModuleWorkerErrorsStmt:            q.commitModuleWorkerErrorsStmt,
+		commitPointsStmt:                        q.commitPointsStmt,
+		commitRepositoryStmt:                    q.commitRepositoryStmt,
+		createTaskStmt:                          q.createTaskStmt,
+		dataFileStmt:                            q.dataFileStmt,
+		deleteChangeAlertStmt:                   q.deleteChangeAlertStmt,
+		deleteChangeTriageStmt:                  q.deleteChangeTriageStmt,
+		deleteChangesRankedStmt:                 q.deleteChangesRankedStmt,
+		deleteTraceChangeAlertsCommitRangeStmt:  q.deleteTraceChangeAlertsCommitRangeStmt,
+		deleteTraceChangesCommitRangeStmt:       q.deleteTraceChangesCommitRangeStmt,
+		deleteTraceUpdateStmt:                   q.deleteTraceUpdateStmt,
+		insertBenchmarkStmt:                     q.insertBenchmarkStmt,
+		insertChangeAlertStmt:                   q.insertChangeAlertStmt,
+		insertCommitStmt:                        q.insertCommitStmt,
+		insertCommitPositionStmt:                q.insertCommitPositionStmt,
+		insertCommitRefStmt:                     q.insertCommitRefStmt,
+		insertDataFileStmt:                      q.insertDataFileStmt,
+		insertModuleStmt:                        q.insertModuleStmt,
+		insertPkgStmt:                           q.insertPkgStmt,
+		insertPropertiesStmt:                    q.insertPropertiesStmt,
+		insertResultStmt:                        q.insertResultStmt,
+		moduleStmt:                              q.moduleStmt,
+		moduleJobSettingsStmt:                   q.moduleJobSettingsStmt,
+		modulePkgsStmt:                          q.modulePkgsStmt,
+		modulesStmt:                             q.modulesStmt,
+		mostRecentCommitStmt:                    q.mostRecentCommitStmt,
+		mostRecentCommitIndexStmt:               q.mostRecentCommitIndexStmt,
+		mostRecentCommitWithRefStmt:             q.mostRecentCommitWithRefStmt,
+		packageBenchmarksStmt:                   q.packageBenchmarksStmt,
+		pkgStmt:                                 q.pkgStmt,
+		propertiesStmt:                          q.propertiesStmt,
 		recentCommitModulePairsWithoutWorkerTasksStmt: q.recentCommitModulePairsWithoutWorkerTasksStmt,
-		resultStmt:                       q.resultStmt,
-		setTaskDataFileStmt:              q.setTaskDataFileStmt,
-		taskStmt:                         q.taskStmt,
-		tasksWithStatusStmt:              q.tasksWithStatusStmt,
-		traceStmt:                        q.traceStmt,
-		tracePointsStmt:                  q.tracePointsStmt,
-		transitionTaskStatusStmt:         q.transitionTaskStatusStmt,
-		transitionTaskStatusesBeforeStmt: q.transitionTaskStatusesBeforeStmt,
-		truncateAllStmt:                  q.truncateAllStmt,
-		workerTasksWithStatusStmt:        q.workerTasksWithStatusStmt,
+		recentRepositoryCommitsWithoutWorkerTasksStmt: q.recentRepositoryCommitsWithoutWorkerTasksStmt,
+		recordTraceUpdateStmt:                         q.recordTraceUpdateStmt,
+		refreshWorkerTasksStmt:                        q.refreshWorkerTasksStmt,
+		repositoriesStmt:                              q.repositoriesStmt,
+		repositoryStmt:                                q.repositoryStmt,
+		resultStmt:                                    q.resultStmt,
+		setTaskDataFileStmt:                           q.setTaskDataFileStmt,
+		taskStmt:                                      q.taskStmt,
+		tasksWithStatusStmt:                           q.tasksWithStatusStmt,
+		traceStmt:                                     q.traceStmt,
+		traceChangesStmt:                              q.traceChangesStmt,
+		traceExpandRangeStmt:                          q.traceExpandRangeStmt,
+		tracePointsStmt:                               q.tracePointsStmt,
+		traceUpdatesStmt:                              q.traceUpdatesStmt,
+		transitionInactiveTaskStatusesStmt:            q.transitionInactiveTaskStatusesStmt,
+		transitionTaskStatusStmt:                      q.transitionTaskStatusStmt,
+		transitionTaskStatusesBeforeStmt:              q.transitionTaskStatusesBeforeStmt,
+		truncateAllStmt:                               q.truncateAllStmt,
+		unconfirmedChangesStmt:                        q.unconfirmedChangesStmt,
+		updateChangeConfirmationStmt:                  q.updateChangeConfirmationStmt,
+		upsertChangeTriageStmt:                        q.upsertChangeTriageStmt,
+		upsertModuleJobSettingsStmt:                   q.upsertModuleJobSettingsStmt,
+		upsertRepositoryStmt:                          q.upsertRepositoryStmt,
+		upsertWorkerStmt:                              q.upsertWorkerStmt,
+		workerStmt:                                    q.workerStmt,
+		workerTasksWithStatusStmt:                     q.workerTasksWithStatusStmt,
+		workersStmt:                                   q.workersStmt,
 	}
 }
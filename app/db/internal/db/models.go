@@ -11,6 +11,26 @@ import (
 	"github.com/lib/pq"
 )
 
+type ChangeConfirmation string
+
+const (
+	ChangeConfirmationUnconfirmed ChangeConfirmation = "unconfirmed"
+	ChangeConfirmationConfirmed   ChangeConfirmation = "confirmed"
+	ChangeConfirmationRefuted     ChangeConfirmation = "refuted"
+)
+
+func (e *ChangeConfirmation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ChangeConfirmation(s)
+	case string:
+		*e = ChangeConfirmation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ChangeConfirmation: %T", src)
+	}
+	return nil
+}
+
 type TaskStatus string
 
 const (
@@ -22,6 +42,7 @@ const (
 	TaskStatusResultUploaded      TaskStatus = "result_uploaded"
 	TaskStatusHalted              TaskStatus = "halted"
 	TaskStatusStaleTimeout        TaskStatus = "stale_timeout"
+	TaskStatusNoisy               TaskStatus = "noisy"
 )
 
 func (e *TaskStatus) Scan(src interface{}) error {
@@ -39,7 +60,10 @@ func (e *TaskStatus) Scan(src interface{}) error {
 type TaskType string
 
 const (
-	TaskTypeModule TaskType = "module"
+	TaskTypeModule     TaskType = "module"
+	TaskTypePackage    TaskType = "package"
+	TaskTypeBenchmark  TaskType = "benchmark"
+	TaskTypeRepository TaskType = "repository"
 )
 
 func (e *TaskType) Scan(src interface{}) error {
@@ -54,6 +78,28 @@ func (e *TaskType) Scan(src interface{}) error {
 	return nil
 }
 
+type TriageState string
+
+const (
+	TriageStateUntriaged     TriageState = "untriaged"
+	TriageStateAcknowledged  TriageState = "acknowledged"
+	TriageStateExpected      TriageState = "expected"
+	TriageStateFalsePositive TriageState = "false_positive"
+	TriageStateFixed         TriageState = "fixed"
+)
+
+func (e *TriageState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TriageState(s)
+	case string:
+		*e = TriageState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TriageState: %T", src)
+	}
+	return nil
+}
+
 type Benchmark struct {
 	UUID        uuid.UUID
 	PackageUUID uuid.UUID
@@ -74,6 +120,27 @@ type Change struct {
 	PostN           int32
 	PostMean        float64
 	PostStddev      float64
+	PValue          float64
+	PercentCILower  float64
+	PercentCIUpper  float64
+	Confirmation    ChangeConfirmation
+}
+
+type ChangeAlert struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	Subscription    string
+}
+
+type ChangeTriage struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	State           TriageState
+	IssueURL        string
+	Note            string
+	LastUpdated     time.Time
 }
 
 type ChangesRanked struct {
@@ -87,6 +154,10 @@ type ChangesRanked struct {
 	PostN                  int32
 	PostMean               float64
 	PostStddev             float64
+	PValue                 float64
+	PercentCILower         float64
+	PercentCIUpper         float64
+	Confirmation           ChangeConfirmation
 	RankByEffectSize       int32
 	RankByAbsPercentChange int32
 }
@@ -102,17 +173,20 @@ type Commit struct {
 	CommitterEmail string
 	CommitTime     time.Time
 	Message        string
+	RepositoryUUID uuid.UUID
 }
 
 type CommitPosition struct {
-	SHA        []byte
-	CommitTime time.Time
-	Index      int32
+	SHA            []byte
+	CommitTime     time.Time
+	Index          int32
+	RepositoryUUID uuid.UUID
 }
 
 type CommitRef struct {
-	SHA []byte
-	Ref string
+	SHA            []byte
+	Ref            string
+	RepositoryUUID uuid.UUID
 }
 
 type Datafile struct {
@@ -127,6 +201,19 @@ type Module struct {
 	Version string
 }
 
+type ModuleJobSetting struct {
+	ModuleUUID     uuid.UUID
+	Short          bool
+	BenchtimeNs    int64
+	Count          int32
+	Tests          string
+	Benchmarks     string
+	BenchmarksSkip string
+	TimeoutNs      int64
+	Interleave     bool
+	Requires       []string
+}
+
 type Package struct {
 	UUID         uuid.UUID
 	ModuleUUID   uuid.UUID
@@ -147,6 +234,14 @@ type Property struct {
 	Fields json.RawMessage
 }
 
+type Repository struct {
+	UUID         uuid.UUID
+	URL          string
+	Ref          string
+	ModulePath   string
+	ToolchainRef string
+}
+
 type Result struct {
 	UUID            uuid.UUID
 	DatafileUUID    uuid.UUID
@@ -169,3 +264,17 @@ type Task struct {
 	LastStatusUpdate time.Time
 	DatafileUUID     uuid.UUID
 }
+
+type TraceUpdate struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndexMin  int32
+	CommitIndexMax  int32
+}
+
+type Worker struct {
+	Name          string
+	Platform      string
+	Version       string
+	LastHeartbeat time.Time
+}
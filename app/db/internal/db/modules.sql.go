@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const insertModule = `-- name: InsertModule :exec
@@ -44,6 +45,29 @@ func (q *Queries) Module(ctx context.Context, uuid uuid.UUID) (Module, error) {
 	return i, err
 }
 
+const moduleJobSettings = `-- name: ModuleJobSettings :one
+SELECT module_uuid, short, benchtime_ns, count, tests, benchmarks, benchmarks_skip, timeout_ns, interleave, requires FROM module_job_settings
+WHERE module_uuid = $1 LIMIT 1
+`
+
+func (q *Queries) ModuleJobSettings(ctx context.Context, moduleUUID uuid.UUID) (ModuleJobSetting, error) {
+	row := q.queryRow(ctx, q.moduleJobSettingsStmt, moduleJobSettings, moduleUUID)
+	var i ModuleJobSetting
+	err := row.Scan(
+		&i.ModuleUUID,
+		&i.Short,
+		&i.BenchtimeNs,
+		&i.Count,
+		&i.Tests,
+		&i.Benchmarks,
+		&i.BenchmarksSkip,
+		&i.TimeoutNs,
+		&i.Interleave,
+		pq.Array(&i.Requires),
+	)
+	return i, err
+}
+
 const modules = `-- name: Modules :many
 SELECT
     uuid, path, version
@@ -79,3 +103,69 @@ func (q *Queries) Modules(ctx context.Context) ([]Module, error) {
 	}
 	return items, nil
 }
+
+const upsertModuleJobSettings = `-- name: UpsertModuleJobSettings :exec
+INSERT INTO module_job_settings (
+    module_uuid,
+    short,
+    benchtime_ns,
+    count,
+    tests,
+    benchmarks,
+    benchmarks_skip,
+    timeout_ns,
+    interleave,
+    requires
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+)
+ON CONFLICT (module_uuid)
+DO UPDATE SET
+    short = EXCLUDED.short,
+    benchtime_ns = EXCLUDED.benchtime_ns,
+    count = EXCLUDED.count,
+    tests = EXCLUDED.tests,
+    benchmarks = EXCLUDED.benchmarks,
+    benchmarks_skip = EXCLUDED.benchmarks_skip,
+    timeout_ns = EXCLUDED.timeout_ns,
+    interleave = EXCLUDED.interleave,
+    requires = EXCLUDED.requires
+`
+
+type UpsertModuleJobSettingsParams struct {
+	ModuleUUID     uuid.UUID
+	Short          bool
+	BenchtimeNs    int64
+	Count          int32
+	Tests          string
+	Benchmarks     string
+	BenchmarksSkip string
+	TimeoutNs      int64
+	Interleave     bool
+	Requires       []string
+}
+
+func (q *Queries) UpsertModuleJobSettings(ctx context.Context, arg UpsertModuleJobSettingsParams) error {
+	_, err := q.exec(ctx, q.upsertModuleJobSettingsStmt, upsertModuleJobSettings,
+		arg.ModuleUUID,
+		arg.Short,
+		arg.BenchtimeNs,
+		arg.Count,
+		arg.Tests,
+		arg.Benchmarks,
+		arg.BenchmarksSkip,
+		arg.TimeoutNs,
+		arg.Interleave,
+		pq.Array(arg.Requires),
+	)
+	return err
+}
@@ -5,6 +5,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
@@ -106,6 +107,82 @@ func (q *Queries) BenchmarkResults(ctx context.Context, benchmarkUuid uuid.UUID)
 	return items, nil
 }
 
+const commitPoints = `-- name: CommitPoints :many
+SELECT
+    pt.result_uuid,
+    pt.environment_uuid,
+    pt.commit_index,
+    pt.value,
+
+    b.uuid, b.package_uuid, b.full_name, b.name, b.unit, b.parameters,
+    pkg.relative_path,
+    mod.path,
+    mod.version
+FROM
+    points AS pt
+    INNER JOIN benchmarks AS b
+        ON pt.benchmark_uuid=b.uuid
+    INNER JOIN packages AS pkg
+        ON b.package_uuid=pkg.uuid
+    INNER JOIN modules AS mod
+        ON pkg.module_uuid=mod.uuid
+WHERE 1=1
+    AND pt.commit_sha = $1
+`
+
+type CommitPointsRow struct {
+	ResultUUID      uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndex     int32
+	Value           float64
+	UUID            uuid.UUID
+	PackageUUID     uuid.UUID
+	FullName        string
+	Name            string
+	Unit            string
+	Parameters      json.RawMessage
+	RelativePath    string
+	Path            string
+	Version         string
+}
+
+func (q *Queries) CommitPoints(ctx context.Context, commitSha []byte) ([]CommitPointsRow, error) {
+	rows, err := q.query(ctx, q.commitPointsStmt, commitPoints, commitSha)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommitPointsRow
+	for rows.Next() {
+		var i CommitPointsRow
+		if err := rows.Scan(
+			&i.ResultUUID,
+			&i.EnvironmentUUID,
+			&i.CommitIndex,
+			&i.Value,
+			&i.UUID,
+			&i.PackageUUID,
+			&i.FullName,
+			&i.Name,
+			&i.Unit,
+			&i.Parameters,
+			&i.RelativePath,
+			&i.Path,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertResult = `-- name: InsertResult :exec
 INSERT INTO results (
     uuid,
@@ -233,19 +310,79 @@ func (q *Queries) Trace(ctx context.Context, arg TraceParams) ([]TraceRow, error
 	return items, nil
 }
 
+const traceExpandRange = `-- name: TraceExpandRange :one
+SELECT
+    COALESCE((
+        SELECT MIN(pre.commit_index)
+        FROM (
+            SELECT DISTINCT commit_index
+            FROM points
+            WHERE 1=1
+                AND benchmark_uuid = $1
+                AND environment_uuid = $2
+                AND commit_index < $3
+            ORDER BY commit_index DESC
+            LIMIT $4
+        ) AS pre
+    ), $3)::INT AS commit_index_min,
+    COALESCE((
+        SELECT MAX(post.commit_index)
+        FROM (
+            SELECT DISTINCT commit_index
+            FROM points
+            WHERE 1=1
+                AND benchmark_uuid = $1
+                AND environment_uuid = $2
+                AND commit_index > $5
+            ORDER BY commit_index
+            LIMIT $4
+        ) AS post
+    ), $5)::INT AS commit_index_max
+`
+
+type TraceExpandRangeParams struct {
+	BenchmarkUUID   uuid.UUID
+	EnvironmentUUID uuid.UUID
+	CommitIndexMin  int32
+	NumPoints       int32
+	CommitIndexMax  int32
+}
+
+type TraceExpandRangeRow struct {
+	CommitIndexMin int32
+	CommitIndexMax int32
+}
+
+func (q *Queries) TraceExpandRange(ctx context.Context, arg TraceExpandRangeParams) (TraceExpandRangeRow, error) {
+	row := q.queryRow(ctx, q.traceExpandRangeStmt, traceExpandRange,
+		arg.BenchmarkUUID,
+		arg.EnvironmentUUID,
+		arg.CommitIndexMin,
+		arg.NumPoints,
+		arg.CommitIndexMax,
+	)
+	var i TraceExpandRangeRow
+	err := row.Scan(&i.CommitIndexMin, &i.CommitIndexMax)
+	return i, err
+}
+
 const tracePoints = `-- name: TracePoints :many
 SELECT
-    benchmark_uuid,
-    environment_uuid,
-    commit_index,
-    value
+    pt.benchmark_uuid,
+    pt.environment_uuid,
+    pt.commit_index,
+    pt.value
 FROM
-    points
+    points AS pt
+    INNER JOIN commit_positions AS cp
+        ON pt.commit_sha=cp.sha
 WHERE 1=1
-    AND commit_index BETWEEN $1 AND $2
+    AND cp.repository_uuid = $1
+    AND pt.commit_index BETWEEN $2 AND $3
 `
 
 type TracePointsParams struct {
+	RepositoryUUID uuid.UUID
 	CommitIndexMin int32
 	CommitIndexMax int32
 }
@@ -258,7 +395,7 @@ type TracePointsRow struct {
 }
 
 func (q *Queries) TracePoints(ctx context.Context, arg TracePointsParams) ([]TracePointsRow, error) {
-	rows, err := q.query(ctx, q.tracePointsStmt, tracePoints, arg.CommitIndexMin, arg.CommitIndexMax)
+	rows, err := q.query(ctx, q.tracePointsStmt, tracePoints, arg.RepositoryUUID, arg.CommitIndexMin, arg.CommitIndexMax)
 	if err != nil {
 		return nil, err
 	}
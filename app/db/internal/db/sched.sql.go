@@ -11,6 +11,250 @@ import (
 	"github.com/lib/pq"
 )
 
+const changeBisectionCommitModulePairs = `-- name: ChangeBisectionCommitModulePairs :many
+SELECT
+    p.sha AS commit_sha,
+    p.commit_time,
+    pkg.module_uuid
+FROM
+    (
+        SELECT
+            chg.benchmark_uuid,
+            chg.commit_index,
+            chg.effect_size,
+            COALESCE((
+                SELECT MAX(pt.commit_index)
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index < chg.commit_index
+                    AND cp.repository_uuid = $1
+            ), chg.commit_index)::INT AS prev_commit_index
+        FROM
+            changes AS chg
+        WHERE 1=1
+            AND chg.commit_index >= $2
+            AND EXISTS (
+                SELECT result_uuid, benchmark_uuid, environment_uuid, commit_sha, commit_index, value, sha, commit_time, index, repository_uuid
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index = chg.commit_index
+                    AND cp.repository_uuid = $1
+            )
+            -- Only bisect traces the worker produces results for, so the new
+            -- point lands in the trace with the change.
+            AND EXISTS (
+                SELECT uuid, datafile_uuid, line, benchmark_uuid, commit_sha, environment_uuid, metadata_uuid, iterations, value
+                FROM results AS r
+                WHERE 1=1
+                    AND r.benchmark_uuid = chg.benchmark_uuid
+                    AND r.environment_uuid = chg.environment_uuid
+                    AND r.datafile_uuid IN (
+                        SELECT datafile_uuid
+                        FROM tasks
+                        WHERE worker = $3
+                    )
+            )
+    ) AS g
+    INNER JOIN benchmarks AS b
+        ON g.benchmark_uuid=b.uuid
+    INNER JOIN packages AS pkg
+        ON b.package_uuid=pkg.uuid
+    INNER JOIN commit_positions AS p
+        ON 1=1
+        AND p.repository_uuid = $1
+        AND p.index=(g.prev_commit_index + g.commit_index) / 2
+WHERE 1=1
+    AND g.commit_index - g.prev_commit_index > 1
+    AND NOT EXISTS (
+        SELECT uuid, worker, commit_sha, type, target_uuid, status, last_status_update, datafile_uuid
+        FROM tasks AS t
+        WHERE 1=1
+            AND t.commit_sha = p.sha
+            AND t.type = 'module'
+            AND t.target_uuid = pkg.module_uuid
+            AND t.status = ANY ($4::task_status[])
+            AND t.worker = $3
+    )
+ORDER BY
+    ABS(g.effect_size) DESC
+LIMIT
+    $5
+`
+
+type ChangeBisectionCommitModulePairsParams struct {
+	RepositoryUUID uuid.UUID
+	CommitIndexMin int32
+	Worker         string
+	Statuses       []TaskStatus
+	Num            int32
+}
+
+type ChangeBisectionCommitModulePairsRow struct {
+	CommitSHA  []byte
+	CommitTime time.Time
+	ModuleUUID uuid.UUID
+}
+
+func (q *Queries) ChangeBisectionCommitModulePairs(ctx context.Context, arg ChangeBisectionCommitModulePairsParams) ([]ChangeBisectionCommitModulePairsRow, error) {
+	rows, err := q.query(ctx, q.changeBisectionCommitModulePairsStmt, changeBisectionCommitModulePairs,
+		arg.RepositoryUUID,
+		arg.CommitIndexMin,
+		arg.Worker,
+		pq.Array(arg.Statuses),
+		arg.Num,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeBisectionCommitModulePairsRow
+	for rows.Next() {
+		var i ChangeBisectionCommitModulePairsRow
+		if err := rows.Scan(&i.CommitSHA, &i.CommitTime, &i.ModuleUUID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const changeConfirmationCommitModulePairs = `-- name: ChangeConfirmationCommitModulePairs :many
+SELECT
+    p.sha AS commit_sha,
+    p.commit_time,
+    pkg.module_uuid
+FROM
+    (
+        SELECT
+            chg.benchmark_uuid,
+            chg.environment_uuid,
+            chg.commit_index,
+            chg.effect_size,
+            COALESCE((
+                SELECT MAX(pt.commit_index)
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index < chg.commit_index
+                    AND cp.repository_uuid = $1
+            ), chg.commit_index)::INT AS pre_commit_index
+        FROM
+            changes AS chg
+        WHERE 1=1
+            AND chg.confirmation = 'unconfirmed'
+            AND chg.commit_index >= $2
+            AND EXISTS (
+                SELECT result_uuid, benchmark_uuid, environment_uuid, commit_sha, commit_index, value, sha, commit_time, index, repository_uuid
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index = chg.commit_index
+                    AND cp.repository_uuid = $1
+            )
+    ) AS g
+    INNER JOIN benchmarks AS b
+        ON g.benchmark_uuid=b.uuid
+    INNER JOIN packages AS pkg
+        ON b.package_uuid=pkg.uuid
+    INNER JOIN commit_positions AS p
+        ON 1=1
+        AND p.repository_uuid = $1
+        AND p.index IN (g.pre_commit_index, g.commit_index)
+WHERE 1=1
+    AND g.pre_commit_index < g.commit_index
+    -- Exclude workers that produced the original results.
+    AND NOT EXISTS (
+        SELECT result_uuid, benchmark_uuid, environment_uuid, commit_sha, commit_index, value, uuid, datafile_uuid, line, benchmark_uuid, commit_sha, environment_uuid, metadata_uuid, iterations, value, uuid, worker, commit_sha, type, target_uuid, status, last_status_update, datafile_uuid
+        FROM points AS pt
+            INNER JOIN results AS r
+                ON pt.result_uuid=r.uuid
+            INNER JOIN tasks AS t
+                ON r.datafile_uuid=t.datafile_uuid
+        WHERE 1=1
+            AND pt.benchmark_uuid = g.benchmark_uuid
+            AND pt.environment_uuid = g.environment_uuid
+            AND pt.commit_index = g.commit_index
+            AND t.worker = $3
+    )
+    AND NOT EXISTS (
+        SELECT uuid, worker, commit_sha, type, target_uuid, status, last_status_update, datafile_uuid
+        FROM tasks AS t
+        WHERE 1=1
+            AND t.commit_sha = p.sha
+            AND t.type = 'module'
+            AND t.target_uuid = pkg.module_uuid
+            AND t.status = ANY ($4::task_status[])
+            AND t.worker = $3
+    )
+ORDER BY
+    ABS(g.effect_size) DESC
+LIMIT
+    $5
+`
+
+type ChangeConfirmationCommitModulePairsParams struct {
+	RepositoryUUID uuid.UUID
+	CommitIndexMin int32
+	Worker         string
+	Statuses       []TaskStatus
+	Num            int32
+}
+
+type ChangeConfirmationCommitModulePairsRow struct {
+	CommitSHA  []byte
+	CommitTime time.Time
+	ModuleUUID uuid.UUID
+}
+
+func (q *Queries) ChangeConfirmationCommitModulePairs(ctx context.Context, arg ChangeConfirmationCommitModulePairsParams) ([]ChangeConfirmationCommitModulePairsRow, error) {
+	rows, err := q.query(ctx, q.changeConfirmationCommitModulePairsStmt, changeConfirmationCommitModulePairs,
+		arg.RepositoryUUID,
+		arg.CommitIndexMin,
+		arg.Worker,
+		pq.Array(arg.Statuses),
+		arg.Num,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeConfirmationCommitModulePairsRow
+	for rows.Next() {
+		var i ChangeConfirmationCommitModulePairsRow
+		if err := rows.Scan(&i.CommitSHA, &i.CommitTime, &i.ModuleUUID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const commitModuleWorkerErrors = `-- name: CommitModuleWorkerErrors :many
 SELECT
     target_uuid AS module_uuid,
@@ -90,27 +334,30 @@ SELECT
 FROM
     commit_positions AS p,
     modules AS m
-WHERE NOT EXISTS (
+WHERE 1=1
+    AND p.repository_uuid = $1
+    AND NOT EXISTS (
         SELECT uuid, worker, commit_sha, type, target_uuid, status, last_status_update, datafile_uuid
         FROM tasks AS t
         WHERE 1=1
             AND t.commit_sha = p.sha
             AND t.type = 'module'
             AND t.target_uuid = m.uuid
-            AND t.status = ANY ($1::task_status[])
-            AND t.worker = $2
+            AND t.status = ANY ($2::task_status[])
+            AND t.worker = $3
     )
 ORDER BY
     p.commit_time DESC,
     m.uuid
 LIMIT
-    $3
+    $4
 `
 
 type RecentCommitModulePairsWithoutWorkerTasksParams struct {
-	Statuses []TaskStatus
-	Worker   string
-	Num      int32
+	RepositoryUUID uuid.UUID
+	Statuses       []TaskStatus
+	Worker         string
+	Num            int32
 }
 
 type RecentCommitModulePairsWithoutWorkerTasksRow struct {
@@ -120,7 +367,12 @@ type RecentCommitModulePairsWithoutWorkerTasksRow struct {
 }
 
 func (q *Queries) RecentCommitModulePairsWithoutWorkerTasks(ctx context.Context, arg RecentCommitModulePairsWithoutWorkerTasksParams) ([]RecentCommitModulePairsWithoutWorkerTasksRow, error) {
-	rows, err := q.query(ctx, q.recentCommitModulePairsWithoutWorkerTasksStmt, recentCommitModulePairsWithoutWorkerTasks, pq.Array(arg.Statuses), arg.Worker, arg.Num)
+	rows, err := q.query(ctx, q.recentCommitModulePairsWithoutWorkerTasksStmt, recentCommitModulePairsWithoutWorkerTasks,
+		arg.RepositoryUUID,
+		pq.Array(arg.Statuses),
+		arg.Worker,
+		arg.Num,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -141,3 +393,66 @@ func (q *Queries) RecentCommitModulePairsWithoutWorkerTasks(ctx context.Context,
 	}
 	return items, nil
 }
+
+const recentRepositoryCommitsWithoutWorkerTasks = `-- name: RecentRepositoryCommitsWithoutWorkerTasks :many
+SELECT
+    p.sha AS commit_sha,
+    p.commit_time,
+    r.uuid AS repository_uuid
+FROM
+    commit_positions AS p
+    INNER JOIN repositories AS r
+        ON p.repository_uuid=r.uuid
+WHERE 1=1
+    AND r.toolchain_ref <> ''
+    AND NOT EXISTS (
+        SELECT uuid, worker, commit_sha, type, target_uuid, status, last_status_update, datafile_uuid
+        FROM tasks AS t
+        WHERE 1=1
+            AND t.commit_sha = p.sha
+            AND t.type = 'repository'
+            AND t.target_uuid = r.uuid
+            AND t.status = ANY ($1::task_status[])
+            AND t.worker = $2
+    )
+ORDER BY
+    p.commit_time DESC,
+    r.uuid
+LIMIT
+    $3
+`
+
+type RecentRepositoryCommitsWithoutWorkerTasksParams struct {
+	Statuses []TaskStatus
+	Worker   string
+	Num      int32
+}
+
+type RecentRepositoryCommitsWithoutWorkerTasksRow struct {
+	CommitSHA      []byte
+	CommitTime     time.Time
+	RepositoryUUID uuid.UUID
+}
+
+func (q *Queries) RecentRepositoryCommitsWithoutWorkerTasks(ctx context.Context, arg RecentRepositoryCommitsWithoutWorkerTasksParams) ([]RecentRepositoryCommitsWithoutWorkerTasksRow, error) {
+	rows, err := q.query(ctx, q.recentRepositoryCommitsWithoutWorkerTasksStmt, recentRepositoryCommitsWithoutWorkerTasks, pq.Array(arg.Statuses), arg.Worker, arg.Num)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecentRepositoryCommitsWithoutWorkerTasksRow
+	for rows.Next() {
+		var i RecentRepositoryCommitsWithoutWorkerTasksRow
+		if err := rows.Scan(&i.CommitSHA, &i.CommitTime, &i.RepositoryUUID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
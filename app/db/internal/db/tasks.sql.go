@@ -62,6 +62,28 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 	return i, err
 }
 
+const refreshWorkerTasks = `-- name: RefreshWorkerTasks :exec
+UPDATE
+    tasks
+SET
+    last_status_update = NOW()
+WHERE 1=1
+    AND worker = $1
+    AND uuid = ANY ($2::UUID[])
+    AND status = ANY ($3::task_status[])
+`
+
+type RefreshWorkerTasksParams struct {
+	Worker   string
+	UUIDs    []uuid.UUID
+	Statuses []TaskStatus
+}
+
+func (q *Queries) RefreshWorkerTasks(ctx context.Context, arg RefreshWorkerTasksParams) error {
+	_, err := q.exec(ctx, q.refreshWorkerTasksStmt, refreshWorkerTasks, arg.Worker, pq.Array(arg.UUIDs), pq.Array(arg.Statuses))
+	return err
+}
+
 const setTaskDataFile = `-- name: SetTaskDataFile :exec
 UPDATE
     tasks
@@ -143,6 +165,40 @@ func (q *Queries) TasksWithStatus(ctx context.Context, statuses []TaskStatus) ([
 	return items, nil
 }
 
+const transitionInactiveTaskStatuses = `-- name: TransitionInactiveTaskStatuses :exec
+UPDATE
+    tasks
+SET
+    status = $1,
+    last_status_update = NOW()
+WHERE 1=1
+    AND status = ANY ($2::task_status[])
+    AND (
+        last_status_update < $3
+        OR COALESCE(
+            (SELECT w.last_heartbeat < $4 FROM workers AS w WHERE w.name = tasks.worker),
+            FALSE
+        )
+    )
+`
+
+type TransitionInactiveTaskStatusesParams struct {
+	ToStatus       TaskStatus
+	FromStatuses   []TaskStatus
+	Until          time.Time
+	HeartbeatUntil time.Time
+}
+
+func (q *Queries) TransitionInactiveTaskStatuses(ctx context.Context, arg TransitionInactiveTaskStatusesParams) error {
+	_, err := q.exec(ctx, q.transitionInactiveTaskStatusesStmt, transitionInactiveTaskStatuses,
+		arg.ToStatus,
+		pq.Array(arg.FromStatuses),
+		arg.Until,
+		arg.HeartbeatUntil,
+	)
+	return err
+}
+
 const transitionTaskStatus = `-- name: TransitionTaskStatus :one
 UPDATE
     tasks
@@ -1,16 +1,20 @@
 -- name: TruncateAll :exec
 TRUNCATE
     benchmarks,
+    change_triage,
     changes,
     changes_ranked,
     commit_positions,
     commit_refs,
     commits,
     datafiles,
+    module_job_settings,
     modules,
     packages,
     points,
     properties,
     results,
-    tasks
+    tasks,
+    trace_updates,
+    workers
 ;
@@ -1,6 +1,20 @@
--- name: DeleteChangesCommitRange :exec
+-- name: DeleteTraceChangesCommitRange :exec
 DELETE FROM changes
 WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
+;
+
+-- name: TraceChanges :many
+SELECT
+    commit_index,
+    effect_size
+FROM
+    changes
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
     AND commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
 ;
 
@@ -13,11 +27,17 @@ SELECT
     b.*,
     pkg.relative_path,
     mod.path,
-    mod.version
+    mod.version,
+
+    COALESCE(t.state, 'untriaged')::triage_state AS triage_state,
+    COALESCE(t.issue_url, '')::TEXT AS triage_issue_url,
+    COALESCE(t.note, '')::TEXT AS triage_note
 FROM
     changes_ranked AS chg
     INNER JOIN commit_positions AS p
-        ON chg.commit_index=p.index
+        ON 1=1
+        AND p.repository_uuid = sqlc.arg(repository_uuid)
+        AND chg.commit_index=p.index
     INNER JOIN commits AS c
         ON p.sha=c.sha
     INNER JOIN benchmarks AS b
@@ -26,32 +46,234 @@ FROM
         ON b.package_uuid=pkg.uuid
     INNER JOIN modules AS mod
         ON pkg.module_uuid=mod.uuid
+    LEFT JOIN change_triage AS t
+        ON 1=1
+        AND chg.benchmark_uuid=t.benchmark_uuid
+        AND chg.environment_uuid=t.environment_uuid
+        AND chg.commit_index=t.commit_index
 WHERE 1=1
     AND ABS(chg.effect_size) > sqlc.arg(effect_size_min)
     AND chg.commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
     AND chg.rank_by_effect_size <= sqlc.arg(rank_by_effect_size_max)
     AND chg.rank_by_abs_percent_change <= sqlc.arg(rank_by_abs_percent_change_max)
+    AND chg.p_value <= sqlc.arg(p_value_max)
+    AND COALESCE(t.state, 'untriaged') = ANY (sqlc.arg(triage_states)::triage_state[])
+    -- Commit indexes are per-repository, so require the change to be in a trace
+    -- with results at this commit.
+    AND EXISTS (
+        SELECT *
+        FROM points AS pt
+        WHERE 1=1
+            AND pt.benchmark_uuid = chg.benchmark_uuid
+            AND pt.environment_uuid = chg.environment_uuid
+            AND pt.commit_sha = p.sha
+    )
 ORDER BY
     commit_index DESC
 ;
 
+-- name: DeleteChangesRanked :exec
+DELETE FROM changes_ranked;
+
 -- name: BuildChangesRanked :exec
 INSERT INTO changes_ranked (
     SELECT
-        *,
+        chg.*,
         ROW_NUMBER() OVER (
-            PARTITION BY commit_index
-            ORDER BY ABS(effect_size) DESC
+            PARTITION BY r.repository_uuid, chg.commit_index
+            ORDER BY ABS(chg.effect_size) DESC
         ) AS rank_by_effect_size,
         ROW_NUMBER() OVER (
-            PARTITION BY commit_index
-            ORDER BY ABS((post_mean/pre_mean)-1.0) DESC
+            PARTITION BY r.repository_uuid, chg.commit_index
+            ORDER BY ABS((chg.post_mean/chg.pre_mean)-1.0) DESC
         ) AS rank_by_abs_percent_change
     FROM
-        changes
+        changes AS chg
+        -- Commit indexes are per-repository, so rank changes among those in
+        -- the same repository.
+        LEFT JOIN LATERAL (
+            SELECT cp.repository_uuid
+            FROM points AS pt
+                INNER JOIN commit_positions AS cp
+                    ON pt.commit_sha=cp.sha
+            WHERE 1=1
+                AND pt.benchmark_uuid = chg.benchmark_uuid
+                AND pt.environment_uuid = chg.environment_uuid
+                AND pt.commit_index = chg.commit_index
+            LIMIT 1
+        ) AS r ON true
 )
 ON CONFLICT (benchmark_uuid, environment_uuid, commit_index)
 DO UPDATE SET
     rank_by_effect_size = EXCLUDED.rank_by_effect_size,
     rank_by_abs_percent_change = EXCLUDED.rank_by_abs_percent_change
 ;
+
+-- name: UnconfirmedChanges :many
+SELECT
+    chg.*,
+    COALESCE((
+        SELECT MAX(pt.commit_index)
+        FROM points AS pt
+            INNER JOIN commit_positions AS cp
+                ON pt.commit_sha=cp.sha
+        WHERE 1=1
+            AND pt.benchmark_uuid = chg.benchmark_uuid
+            AND pt.environment_uuid = chg.environment_uuid
+            AND pt.commit_index < chg.commit_index
+            AND cp.repository_uuid = sqlc.arg(repository_uuid)
+    ), chg.commit_index)::INT AS pre_commit_index
+FROM
+    changes AS chg
+WHERE 1=1
+    AND chg.confirmation = 'unconfirmed'
+    AND chg.commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
+    AND EXISTS (
+        SELECT *
+        FROM points AS pt
+            INNER JOIN commit_positions AS cp
+                ON pt.commit_sha=cp.sha
+        WHERE 1=1
+            AND pt.benchmark_uuid = chg.benchmark_uuid
+            AND pt.environment_uuid = chg.environment_uuid
+            AND pt.commit_index = chg.commit_index
+            AND cp.repository_uuid = sqlc.arg(repository_uuid)
+    )
+;
+
+-- name: ChangeRepeatPoints :many
+SELECT
+    t.worker,
+    pt.commit_index,
+    pt.value
+FROM
+    points AS pt
+    INNER JOIN commit_positions AS cp
+        ON pt.commit_sha=cp.sha
+    INNER JOIN results AS r
+        ON pt.result_uuid=r.uuid
+    INNER JOIN tasks AS t
+        ON r.datafile_uuid=t.datafile_uuid
+WHERE 1=1
+    AND pt.benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND pt.commit_index IN (sqlc.arg(pre_commit_index), sqlc.arg(post_commit_index))
+    AND cp.repository_uuid = sqlc.arg(repository_uuid)
+    -- Exclude workers that produced the original results.
+    AND NOT EXISTS (
+        SELECT *
+        FROM points AS opt
+            INNER JOIN results AS ort
+                ON opt.result_uuid=ort.uuid
+            INNER JOIN tasks AS ot
+                ON ort.datafile_uuid=ot.datafile_uuid
+        WHERE 1=1
+            AND opt.benchmark_uuid = pt.benchmark_uuid
+            AND opt.environment_uuid = sqlc.arg(environment_uuid)
+            AND opt.commit_index IN (sqlc.arg(pre_commit_index), sqlc.arg(post_commit_index))
+            AND ot.worker = t.worker
+    )
+ORDER BY
+    t.worker,
+    pt.commit_index
+;
+
+-- name: UpdateChangeConfirmation :exec
+UPDATE changes
+SET
+    confirmation = sqlc.arg(confirmation)
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index = sqlc.arg(commit_index)
+;
+
+-- name: ChangeTriage :one
+SELECT * FROM change_triage
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index = sqlc.arg(commit_index)
+LIMIT 1;
+
+-- name: DeleteChangeTriage :exec
+DELETE FROM change_triage
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index = sqlc.arg(commit_index)
+;
+
+-- name: UpsertChangeTriage :exec
+INSERT INTO change_triage (
+    benchmark_uuid,
+    environment_uuid,
+    commit_index,
+    state,
+    issue_url,
+    note,
+    last_updated
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+ON CONFLICT (benchmark_uuid, environment_uuid, commit_index)
+DO UPDATE SET
+    state = EXCLUDED.state,
+    issue_url = EXCLUDED.issue_url,
+    note = EXCLUDED.note,
+    last_updated = EXCLUDED.last_updated
+;
+
+-- name: ChangeAlerts :many
+SELECT
+    a.subscription,
+    chg.*
+FROM
+    change_alerts AS a
+    INNER JOIN changes AS chg
+        ON 1=1
+        AND a.benchmark_uuid=chg.benchmark_uuid
+        AND a.environment_uuid=chg.environment_uuid
+        AND a.commit_index=chg.commit_index
+ORDER BY
+    a.subscription,
+    chg.commit_index
+;
+
+-- name: InsertChangeAlert :exec
+INSERT INTO change_alerts (
+    benchmark_uuid,
+    environment_uuid,
+    commit_index,
+    subscription
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT DO NOTHING
+;
+
+-- name: DeleteChangeAlert :exec
+DELETE FROM change_alerts
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index = sqlc.arg(commit_index)
+    AND subscription = sqlc.arg(subscription)
+;
+
+-- name: DeleteTraceChangeAlertsCommitRange :many
+DELETE FROM change_alerts
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
+RETURNING commit_index, subscription
+;
@@ -14,6 +14,8 @@ FROM
     commits AS c
     INNER JOIN commit_refs AS r
         ON c.sha=r.sha AND r.ref = sqlc.arg(ref)
+WHERE 1=1
+    AND r.repository_uuid = sqlc.arg(repository_uuid)
 ORDER BY
     c.commit_time DESC
 LIMIT 1;
@@ -29,7 +31,8 @@ INSERT INTO commits (
     committer_name,
     committer_email,
     commit_time,
-    message
+    message,
+    repository_uuid
 ) VALUES (
     $1,
     $2,
@@ -40,27 +43,32 @@ INSERT INTO commits (
     $7,
     $8,
     $9,
-    $10
+    $10,
+    $11
 ) ON CONFLICT DO NOTHING;
 
 -- name: InsertCommitRef :exec
 INSERT INTO commit_refs (
     sha,
-    ref
+    ref,
+    repository_uuid
 ) VALUES (
     $1,
-    $2
+    $2,
+    $3
 ) ON CONFLICT DO NOTHING;
 
 -- name: InsertCommitPosition :exec
 INSERT INTO commit_positions (
     sha,
     commit_time,
-    index
+    index,
+    repository_uuid
 ) VALUES (
     $1,
     $2,
-    $3
+    $3,
+    $4
 ) ON CONFLICT DO NOTHING;
 
 -- name: BuildCommitPositions :exec
@@ -68,11 +76,14 @@ INSERT INTO commit_positions (
     SELECT
         c.sha,
         c.commit_time,
-        (ROW_NUMBER() OVER (ORDER BY c.commit_time))-1 AS index
+        (ROW_NUMBER() OVER (ORDER BY c.commit_time))-1 AS index,
+        r.repository_uuid
     FROM
         commits AS c
         INNER JOIN commit_refs AS r
-            ON c.sha=r.sha AND r.ref = 'master'
+            ON c.sha=r.sha AND r.ref = sqlc.arg(ref)
+    WHERE 1=1
+        AND r.repository_uuid = sqlc.arg(repository_uuid)
 )
 ON CONFLICT (sha)
 DO UPDATE SET index = EXCLUDED.index
@@ -83,6 +94,8 @@ SELECT
     MAX(index)::INT
 FROM
     commit_positions
+WHERE 1=1
+    AND repository_uuid = sqlc.arg(repository_uuid)
 ;
 
 -- name: CommitIndexForSHA :one
@@ -25,3 +25,44 @@ INSERT INTO modules (
     $2,
     $3
 ) ON CONFLICT DO NOTHING;
+
+-- name: ModuleJobSettings :one
+SELECT * FROM module_job_settings
+WHERE module_uuid = $1 LIMIT 1;
+
+-- name: UpsertModuleJobSettings :exec
+INSERT INTO module_job_settings (
+    module_uuid,
+    short,
+    benchtime_ns,
+    count,
+    tests,
+    benchmarks,
+    benchmarks_skip,
+    timeout_ns,
+    interleave,
+    requires
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+)
+ON CONFLICT (module_uuid)
+DO UPDATE SET
+    short = EXCLUDED.short,
+    benchtime_ns = EXCLUDED.benchtime_ns,
+    count = EXCLUDED.count,
+    tests = EXCLUDED.tests,
+    benchmarks = EXCLUDED.benchmarks,
+    benchmarks_skip = EXCLUDED.benchmarks_skip,
+    timeout_ns = EXCLUDED.timeout_ns,
+    interleave = EXCLUDED.interleave,
+    requires = EXCLUDED.requires
+;
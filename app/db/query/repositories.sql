@@ -0,0 +1,44 @@
+-- name: Repository :one
+SELECT * FROM repositories
+WHERE uuid = $1 LIMIT 1;
+
+-- name: Repositories :many
+SELECT
+    *
+FROM
+    repositories
+ORDER BY
+    url
+;
+
+-- name: UpsertRepository :exec
+INSERT INTO repositories (
+    uuid,
+    url,
+    ref,
+    module_path,
+    toolchain_ref
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (uuid)
+DO UPDATE SET
+    ref = EXCLUDED.ref,
+    module_path = EXCLUDED.module_path,
+    toolchain_ref = EXCLUDED.toolchain_ref
+;
+
+-- name: CommitRepository :one
+SELECT
+    r.*
+FROM
+    commits AS c
+    INNER JOIN repositories AS r
+        ON c.repository_uuid=r.uuid
+WHERE 1=1
+    AND c.sha = sqlc.arg(sha)
+;
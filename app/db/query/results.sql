@@ -22,16 +22,42 @@ ORDER BY
     commit_index
 ;
 
+-- name: CommitPoints :many
+SELECT
+    pt.result_uuid,
+    pt.environment_uuid,
+    pt.commit_index,
+    pt.value,
+
+    b.*,
+    pkg.relative_path,
+    mod.path,
+    mod.version
+FROM
+    points AS pt
+    INNER JOIN benchmarks AS b
+        ON pt.benchmark_uuid=b.uuid
+    INNER JOIN packages AS pkg
+        ON b.package_uuid=pkg.uuid
+    INNER JOIN modules AS mod
+        ON pkg.module_uuid=mod.uuid
+WHERE 1=1
+    AND pt.commit_sha = sqlc.arg(commit_sha)
+;
+
 -- name: TracePoints :many
 SELECT
-    benchmark_uuid,
-    environment_uuid,
-    commit_index,
-    value
+    pt.benchmark_uuid,
+    pt.environment_uuid,
+    pt.commit_index,
+    pt.value
 FROM
-    points
+    points AS pt
+    INNER JOIN commit_positions AS cp
+        ON pt.commit_sha=cp.sha
 WHERE 1=1
-    AND commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
+    AND cp.repository_uuid = sqlc.arg(repository_uuid)
+    AND pt.commit_index BETWEEN sqlc.arg(commit_index_min) AND sqlc.arg(commit_index_max)
 ;
 
 -- name: Trace :many
@@ -48,6 +74,36 @@ ORDER BY
     commit_index
 ;
 
+-- name: TraceExpandRange :one
+SELECT
+    COALESCE((
+        SELECT MIN(pre.commit_index)
+        FROM (
+            SELECT DISTINCT commit_index
+            FROM points
+            WHERE 1=1
+                AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+                AND environment_uuid = sqlc.arg(environment_uuid)
+                AND commit_index < sqlc.arg(commit_index_min)
+            ORDER BY commit_index DESC
+            LIMIT sqlc.arg(num_points)
+        ) AS pre
+    ), sqlc.arg(commit_index_min))::INT AS commit_index_min,
+    COALESCE((
+        SELECT MAX(post.commit_index)
+        FROM (
+            SELECT DISTINCT commit_index
+            FROM points
+            WHERE 1=1
+                AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+                AND environment_uuid = sqlc.arg(environment_uuid)
+                AND commit_index > sqlc.arg(commit_index_max)
+            ORDER BY commit_index
+            LIMIT sqlc.arg(num_points)
+        ) AS post
+    ), sqlc.arg(commit_index_max))::INT AS commit_index_max
+;
+
 -- name: InsertResult :exec
 INSERT INTO results (
     uuid,
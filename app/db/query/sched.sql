@@ -6,7 +6,9 @@ SELECT
 FROM
     commit_positions AS p,
     modules AS m
-WHERE NOT EXISTS (
+WHERE 1=1
+    AND p.repository_uuid = sqlc.arg(repository_uuid)
+    AND NOT EXISTS (
         SELECT *
         FROM tasks AS t
         WHERE 1=1
@@ -46,3 +48,189 @@ ORDER BY
 LIMIT
     sqlc.arg(num)
 ;
+
+-- name: ChangeBisectionCommitModulePairs :many
+SELECT
+    p.sha AS commit_sha,
+    p.commit_time,
+    pkg.module_uuid
+FROM
+    (
+        SELECT
+            chg.benchmark_uuid,
+            chg.commit_index,
+            chg.effect_size,
+            COALESCE((
+                SELECT MAX(pt.commit_index)
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index < chg.commit_index
+                    AND cp.repository_uuid = sqlc.arg(repository_uuid)
+            ), chg.commit_index)::INT AS prev_commit_index
+        FROM
+            changes AS chg
+        WHERE 1=1
+            AND chg.commit_index >= sqlc.arg(commit_index_min)
+            AND EXISTS (
+                SELECT *
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index = chg.commit_index
+                    AND cp.repository_uuid = sqlc.arg(repository_uuid)
+            )
+            -- Only bisect traces the worker produces results for, so the new
+            -- point lands in the trace with the change.
+            AND EXISTS (
+                SELECT *
+                FROM results AS r
+                WHERE 1=1
+                    AND r.benchmark_uuid = chg.benchmark_uuid
+                    AND r.environment_uuid = chg.environment_uuid
+                    AND r.datafile_uuid IN (
+                        SELECT datafile_uuid
+                        FROM tasks
+                        WHERE worker = sqlc.arg(worker)
+                    )
+            )
+    ) AS g
+    INNER JOIN benchmarks AS b
+        ON g.benchmark_uuid=b.uuid
+    INNER JOIN packages AS pkg
+        ON b.package_uuid=pkg.uuid
+    INNER JOIN commit_positions AS p
+        ON 1=1
+        AND p.repository_uuid = sqlc.arg(repository_uuid)
+        AND p.index=(g.prev_commit_index + g.commit_index) / 2
+WHERE 1=1
+    AND g.commit_index - g.prev_commit_index > 1
+    AND NOT EXISTS (
+        SELECT *
+        FROM tasks AS t
+        WHERE 1=1
+            AND t.commit_sha = p.sha
+            AND t.type = 'module'
+            AND t.target_uuid = pkg.module_uuid
+            AND t.status = ANY (sqlc.arg(statuses)::task_status[])
+            AND t.worker = sqlc.arg(worker)
+    )
+ORDER BY
+    ABS(g.effect_size) DESC
+LIMIT
+    sqlc.arg(num)
+;
+
+-- name: ChangeConfirmationCommitModulePairs :many
+SELECT
+    p.sha AS commit_sha,
+    p.commit_time,
+    pkg.module_uuid
+FROM
+    (
+        SELECT
+            chg.benchmark_uuid,
+            chg.environment_uuid,
+            chg.commit_index,
+            chg.effect_size,
+            COALESCE((
+                SELECT MAX(pt.commit_index)
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index < chg.commit_index
+                    AND cp.repository_uuid = sqlc.arg(repository_uuid)
+            ), chg.commit_index)::INT AS pre_commit_index
+        FROM
+            changes AS chg
+        WHERE 1=1
+            AND chg.confirmation = 'unconfirmed'
+            AND chg.commit_index >= sqlc.arg(commit_index_min)
+            AND EXISTS (
+                SELECT *
+                FROM points AS pt
+                    INNER JOIN commit_positions AS cp
+                        ON pt.commit_sha=cp.sha
+                WHERE 1=1
+                    AND pt.benchmark_uuid = chg.benchmark_uuid
+                    AND pt.environment_uuid = chg.environment_uuid
+                    AND pt.commit_index = chg.commit_index
+                    AND cp.repository_uuid = sqlc.arg(repository_uuid)
+            )
+    ) AS g
+    INNER JOIN benchmarks AS b
+        ON g.benchmark_uuid=b.uuid
+    INNER JOIN packages AS pkg
+        ON b.package_uuid=pkg.uuid
+    INNER JOIN commit_positions AS p
+        ON 1=1
+        AND p.repository_uuid = sqlc.arg(repository_uuid)
+        AND p.index IN (g.pre_commit_index, g.commit_index)
+WHERE 1=1
+    AND g.pre_commit_index < g.commit_index
+    -- Exclude workers that produced the original results.
+    AND NOT EXISTS (
+        SELECT *
+        FROM points AS pt
+            INNER JOIN results AS r
+                ON pt.result_uuid=r.uuid
+            INNER JOIN tasks AS t
+                ON r.datafile_uuid=t.datafile_uuid
+        WHERE 1=1
+            AND pt.benchmark_uuid = g.benchmark_uuid
+            AND pt.environment_uuid = g.environment_uuid
+            AND pt.commit_index = g.commit_index
+            AND t.worker = sqlc.arg(worker)
+    )
+    AND NOT EXISTS (
+        SELECT *
+        FROM tasks AS t
+        WHERE 1=1
+            AND t.commit_sha = p.sha
+            AND t.type = 'module'
+            AND t.target_uuid = pkg.module_uuid
+            AND t.status = ANY (sqlc.arg(statuses)::task_status[])
+            AND t.worker = sqlc.arg(worker)
+    )
+ORDER BY
+    ABS(g.effect_size) DESC
+LIMIT
+    sqlc.arg(num)
+;
+
+-- name: RecentRepositoryCommitsWithoutWorkerTasks :many
+SELECT
+    p.sha AS commit_sha,
+    p.commit_time,
+    r.uuid AS repository_uuid
+FROM
+    commit_positions AS p
+    INNER JOIN repositories AS r
+        ON p.repository_uuid=r.uuid
+WHERE 1=1
+    AND r.toolchain_ref <> ''
+    AND NOT EXISTS (
+        SELECT *
+        FROM tasks AS t
+        WHERE 1=1
+            AND t.commit_sha = p.sha
+            AND t.type = 'repository'
+            AND t.target_uuid = r.uuid
+            AND t.status = ANY (sqlc.arg(statuses)::task_status[])
+            AND t.worker = sqlc.arg(worker)
+    )
+ORDER BY
+    p.commit_time DESC,
+    r.uuid
+LIMIT
+    sqlc.arg(num)
+;
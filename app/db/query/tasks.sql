@@ -73,3 +73,31 @@ SET
 WHERE
     uuid = $2
 ;
+
+-- name: RefreshWorkerTasks :exec
+UPDATE
+    tasks
+SET
+    last_status_update = NOW()
+WHERE 1=1
+    AND worker = sqlc.arg(worker)
+    AND uuid = ANY (sqlc.arg(uuids)::UUID[])
+    AND status = ANY (sqlc.arg(statuses)::task_status[])
+;
+
+-- name: TransitionInactiveTaskStatuses :exec
+UPDATE
+    tasks
+SET
+    status = sqlc.arg(to_status),
+    last_status_update = NOW()
+WHERE 1=1
+    AND status = ANY (sqlc.arg(from_statuses)::task_status[])
+    AND (
+        last_status_update < sqlc.arg(until)
+        OR COALESCE(
+            (SELECT w.last_heartbeat < sqlc.arg(heartbeat_until) FROM workers AS w WHERE w.name = tasks.worker),
+            FALSE
+        )
+    )
+;
@@ -0,0 +1,34 @@
+-- name: RecordTraceUpdate :exec
+INSERT INTO trace_updates (
+    benchmark_uuid,
+    environment_uuid,
+    commit_index_min,
+    commit_index_max
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT (benchmark_uuid, environment_uuid)
+DO UPDATE SET
+    commit_index_min = LEAST(trace_updates.commit_index_min, EXCLUDED.commit_index_min),
+    commit_index_max = GREATEST(trace_updates.commit_index_max, EXCLUDED.commit_index_max)
+;
+
+-- name: TraceUpdates :many
+SELECT * FROM trace_updates
+ORDER BY
+    commit_index_max DESC
+LIMIT
+    sqlc.arg(num)
+;
+
+-- name: DeleteTraceUpdate :exec
+DELETE FROM trace_updates
+WHERE 1=1
+    AND benchmark_uuid = sqlc.arg(benchmark_uuid)
+    AND environment_uuid = sqlc.arg(environment_uuid)
+    AND commit_index_min = sqlc.arg(commit_index_min)
+    AND commit_index_max = sqlc.arg(commit_index_max)
+;
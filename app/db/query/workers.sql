@@ -0,0 +1,31 @@
+-- name: Worker :one
+SELECT * FROM workers
+WHERE name = $1 LIMIT 1;
+
+-- name: Workers :many
+SELECT
+    *
+FROM
+    workers
+ORDER BY
+    name
+;
+
+-- name: UpsertWorker :exec
+INSERT INTO workers (
+    name,
+    platform,
+    version,
+    last_heartbeat
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT (name)
+DO UPDATE SET
+    platform = EXCLUDED.platform,
+    version = EXCLUDED.version,
+    last_heartbeat = EXCLUDED.last_heartbeat
+;
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/db/internal/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+)
+
+// StoreRepository writes repository r to the database, replacing the
+// configuration of an existing repository with the same URL.
+func (d *DB) StoreRepository(ctx context.Context, r *entity.Repository) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		return q.UpsertRepository(ctx, db.UpsertRepositoryParams{
+			UUID:         r.UUID(),
+			URL:          r.URL,
+			Ref:          r.Ref,
+			ModulePath:   r.ModulePath,
+			ToolchainRef: r.ToolchainRef,
+		})
+	})
+}
+
+// FindRepositoryByUUID looks up the repository with the given UUID.
+func (d *DB) FindRepositoryByUUID(ctx context.Context, id uuid.UUID) (*entity.Repository, error) {
+	var r *entity.Repository
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		r, err = findRepositoryByUUID(ctx, q, id)
+		return err
+	})
+	return r, err
+}
+
+func findRepositoryByUUID(ctx context.Context, q *db.Queries, id uuid.UUID) (*entity.Repository, error) {
+	r, err := q.Repository(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapRepository(r), nil
+}
+
+// FindRepositoryByCommitSHA looks up the repository the given commit belongs
+// to.
+func (d *DB) FindRepositoryByCommitSHA(ctx context.Context, sha string) (*entity.Repository, error) {
+	shabytes, err := hex.DecodeString(sha)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sha: %w", err)
+	}
+
+	var r *entity.Repository
+	err = d.txq(ctx, func(q *db.Queries) error {
+		row, err := q.CommitRepository(ctx, shabytes)
+		if err != nil {
+			return err
+		}
+		r = mapRepository(row)
+		return nil
+	})
+	return r, err
+}
+
+// ListRepositories returns all repositories.
+func (d *DB) ListRepositories(ctx context.Context) ([]*entity.Repository, error) {
+	var rs []*entity.Repository
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		rs, err = listRepositories(ctx, q)
+		return err
+	})
+	return rs, err
+}
+
+func listRepositories(ctx context.Context, q *db.Queries) ([]*entity.Repository, error) {
+	rs, err := q.Repositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]*entity.Repository, len(rs))
+	for i, r := range rs {
+		output[i] = mapRepository(r)
+	}
+
+	return output, nil
+}
+
+func mapRepository(r db.Repository) *entity.Repository {
+	return &entity.Repository{
+		URL:          r.URL,
+		Ref:          r.Ref,
+		ModulePath:   r.ModulePath,
+		ToolchainRef: r.ToolchainRef,
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
 
 	"github.com/mmcloughlin/goperf/app/db/internal/db"
 	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/app/trace"
 )
 
 // FindResultByUUID looks up a result in the database given the ID.
@@ -132,9 +134,11 @@ func (d *DB) storeResults(ctx context.Context, tx *sql.Tx, rs []*entity.Result)
 		}
 	}
 
-	// Commits.
+	// Commits. Results are assumed to be for Go toolchain commits unless the
+	// commit was previously registered with another repository, in which case
+	// the existing record is preserved.
 	for _, c := range b.Commits {
-		if err := storeCommit(ctx, q, c); err != nil {
+		if err := storeCommit(ctx, q, entity.GoRepository.UUID(), c); err != nil {
 			return err
 		}
 	}
@@ -192,6 +196,7 @@ func (d *DB) storeResults(ctx context.Context, tx *sql.Tx, rs []*entity.Result)
 	}
 	values = []interface{}{}
 	idxs := map[string]int{}
+	updates := map[trace.ID]*entity.CommitIndexRange{}
 	for _, r := range b.Results {
 		sha := r.Commit.SHA
 		shabytes, err := hex.DecodeString(r.Commit.SHA)
@@ -224,6 +229,21 @@ func (d *DB) storeResults(ctx context.Context, tx *sql.Tx, rs []*entity.Result)
 			idxs[sha],
 			r.Value,
 		)
+
+		// Extend the updated range for this trace.
+		id := trace.ID{
+			BenchmarkUUID:   r.Benchmark.UUID(),
+			EnvironmentUUID: r.Environment.UUID(),
+		}
+		u, ok := updates[id]
+		switch {
+		case !ok:
+			updates[id] = &entity.CommitIndexRange{Min: idxs[sha], Max: idxs[sha]}
+		case idxs[sha] < u.Min:
+			u.Min = idxs[sha]
+		case idxs[sha] > u.Max:
+			u.Max = idxs[sha]
+		}
 	}
 
 	if len(values) > 0 {
@@ -232,6 +252,26 @@ func (d *DB) storeResults(ctx context.Context, tx *sql.Tx, rs []*entity.Result)
 		}
 	}
 
+	// Record trace updates, for incremental change detection. Apply in sorted
+	// order to avoid deadlocks between concurrent ingestion transactions.
+	ids := make([]trace.ID, 0, len(updates))
+	for id := range updates {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	for _, id := range ids {
+		u := updates[id]
+		if err := q.RecordTraceUpdate(ctx, db.RecordTraceUpdateParams{
+			BenchmarkUUID:   id.BenchmarkUUID,
+			EnvironmentUUID: id.EnvironmentUUID,
+			CommitIndexMin:  int32(u.Min),
+			CommitIndexMax:  int32(u.Max),
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
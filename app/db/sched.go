@@ -19,7 +19,8 @@ type CommitModule struct {
 }
 
 // ListCommitModulesWithoutCompleteTasks searches for n recent commit module
-// pairs without completed tasks for the given worker.
+// pairs without completed tasks for the given worker. Commits are taken from
+// the Go repository.
 func (d *DB) ListCommitModulesWithoutCompleteTasks(ctx context.Context, worker string, n int) ([]CommitModule, error) {
 	var cms []CommitModule
 	err := d.txq(ctx, func(q *db.Queries) error {
@@ -37,9 +38,10 @@ func listCommitModulesWithoutTasksInStatus(ctx context.Context, q *db.Queries, w
 	}
 
 	rows, err := q.RecentCommitModulePairsWithoutWorkerTasks(ctx, db.RecentCommitModulePairsWithoutWorkerTasksParams{
-		Worker:   worker,
-		Statuses: s,
-		Num:      int32(n),
+		RepositoryUUID: entity.GoRepository.UUID(),
+		Worker:         worker,
+		Statuses:       s,
+		Num:            int32(n),
 	})
 	if err != nil {
 		return nil, err
@@ -103,3 +105,139 @@ func listCommitModuleErrors(ctx context.Context, q *db.Queries, worker string, m
 
 	return results, nil
 }
+
+// ListChangeBisectionCommitModules returns up to n commit module pairs that
+// would bisect changes at or after the given Go repository commit index. For
+// each change, the proposed commit is the midpoint between the change and the
+// previous commit with results for the same trace. Only changes in traces the
+// worker has produced results for are considered, and pairs with completed
+// tasks for the worker are excluded.
+func (d *DB) ListChangeBisectionCommitModules(ctx context.Context, worker string, idx int, n int) ([]CommitModule, error) {
+	var cms []CommitModule
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		cms, err = listChangeBisectionCommitModules(ctx, q, worker, idx, n)
+		return err
+	})
+	return cms, err
+}
+
+func listChangeBisectionCommitModules(ctx context.Context, q *db.Queries, worker string, idx int, n int) ([]CommitModule, error) {
+	s, err := toTaskStatuses(entity.TaskStatusCompleteValues())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.ChangeBisectionCommitModulePairs(ctx, db.ChangeBisectionCommitModulePairsParams{
+		CommitIndexMin: int32(idx),
+		RepositoryUUID: entity.GoRepository.UUID(),
+		Statuses:       s,
+		Worker:         worker,
+		Num:            int32(n),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cms := make([]CommitModule, len(rows))
+	for i, row := range rows {
+		cms[i] = CommitModule{
+			CommitSHA:  hex.EncodeToString(row.CommitSHA),
+			CommitTime: row.CommitTime,
+			ModuleUUID: row.ModuleUUID,
+		}
+	}
+
+	return cms, nil
+}
+
+// ListChangeConfirmationCommitModules returns up to n commit module pairs that
+// would repeat measurements either side of unconfirmed changes at or after the
+// given Go repository commit index. Pairs are only proposed for a worker that
+// did not produce the original results, and that has no completed task for the
+// pair.
+func (d *DB) ListChangeConfirmationCommitModules(ctx context.Context, worker string, idx int, n int) ([]CommitModule, error) {
+	var cms []CommitModule
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		cms, err = listChangeConfirmationCommitModules(ctx, q, worker, idx, n)
+		return err
+	})
+	return cms, err
+}
+
+func listChangeConfirmationCommitModules(ctx context.Context, q *db.Queries, worker string, idx int, n int) ([]CommitModule, error) {
+	s, err := toTaskStatuses(entity.TaskStatusCompleteValues())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.ChangeConfirmationCommitModulePairs(ctx, db.ChangeConfirmationCommitModulePairsParams{
+		CommitIndexMin: int32(idx),
+		RepositoryUUID: entity.GoRepository.UUID(),
+		Worker:         worker,
+		Statuses:       s,
+		Num:            int32(n),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cms := make([]CommitModule, len(rows))
+	for i, row := range rows {
+		cms[i] = CommitModule{
+			CommitSHA:  hex.EncodeToString(row.CommitSHA),
+			CommitTime: row.CommitTime,
+			ModuleUUID: row.ModuleUUID,
+		}
+	}
+
+	return cms, nil
+}
+
+// RepositoryCommit represents a commit in a repository.
+type RepositoryCommit struct {
+	CommitSHA      string
+	CommitTime     time.Time
+	RepositoryUUID uuid.UUID
+}
+
+// ListRepositoryCommitsWithoutCompleteTasks searches for n recent commits in
+// repositories with a pinned toolchain that have no completed tasks for the
+// given worker.
+func (d *DB) ListRepositoryCommitsWithoutCompleteTasks(ctx context.Context, worker string, n int) ([]RepositoryCommit, error) {
+	var rcs []RepositoryCommit
+	err := d.txq(ctx, func(q *db.Queries) error {
+		var err error
+		rcs, err = listRepositoryCommitsWithoutCompleteTasks(ctx, q, worker, n)
+		return err
+	})
+	return rcs, err
+}
+
+func listRepositoryCommitsWithoutCompleteTasks(ctx context.Context, q *db.Queries, worker string, n int) ([]RepositoryCommit, error) {
+	s, err := toTaskStatuses(entity.TaskStatusCompleteValues())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.RecentRepositoryCommitsWithoutWorkerTasks(ctx, db.RecentRepositoryCommitsWithoutWorkerTasksParams{
+		Statuses: s,
+		Worker:   worker,
+		Num:      int32(n),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rcs := make([]RepositoryCommit, len(rows))
+	for i, row := range rows {
+		rcs[i] = RepositoryCommit{
+			CommitSHA:      hex.EncodeToString(row.CommitSHA),
+			CommitTime:     row.CommitTime,
+			RepositoryUUID: row.RepositoryUUID,
+		}
+	}
+
+	return rcs, nil
+}
@@ -0,0 +1,55 @@
+-- +goose Up
+ALTER TABLE changes ADD COLUMN p_value DOUBLE PRECISION NOT NULL DEFAULT 'NaN';
+ALTER TABLE changes ADD COLUMN percent_ci_lower DOUBLE PRECISION NOT NULL DEFAULT 'NaN';
+ALTER TABLE changes ADD COLUMN percent_ci_upper DOUBLE PRECISION NOT NULL DEFAULT 'NaN';
+
+-- The ranked changes table must have the same column layout as changes,
+-- followed by ranking columns. Since it is derived, recreate it rather than
+-- altering.
+DROP TABLE changes_ranked;
+
+CREATE TABLE changes_ranked (
+    -- "LIKE changes INCLUDING ALL" not supported by sqlc (https://github.com/kyleconroy/sqlc/issues/481)
+    -- Therefore copying the changes schema here.
+    benchmark_uuid UUID NOT NULL REFERENCES benchmarks,
+    environment_uuid UUID NOT NULL REFERENCES properties,
+    commit_index INT NOT NULL REFERENCES commit_positions (index),
+    effect_size DOUBLE PRECISION NOT NULL,
+    pre_n INT NOT NULL,
+    pre_mean DOUBLE PRECISION NOT NULL,
+    pre_stddev DOUBLE PRECISION NOT NULL,
+    post_n INT NOT NULL,
+    post_mean DOUBLE PRECISION NOT NULL,
+    post_stddev DOUBLE PRECISION NOT NULL,
+    p_value DOUBLE PRECISION NOT NULL,
+    percent_ci_lower DOUBLE PRECISION NOT NULL,
+    percent_ci_upper DOUBLE PRECISION NOT NULL,
+    UNIQUE(benchmark_uuid, environment_uuid, commit_index),
+
+    -- Ranking columns.
+    rank_by_effect_size INT NOT NULL,
+    rank_by_abs_percent_change INT NOT NULL
+);
+
+-- +goose Down
+DROP TABLE changes_ranked;
+
+CREATE TABLE changes_ranked (
+    benchmark_uuid UUID NOT NULL REFERENCES benchmarks,
+    environment_uuid UUID NOT NULL REFERENCES properties,
+    commit_index INT NOT NULL REFERENCES commit_positions (index),
+    effect_size DOUBLE PRECISION NOT NULL,
+    pre_n INT NOT NULL,
+    pre_mean DOUBLE PRECISION NOT NULL,
+    pre_stddev DOUBLE PRECISION NOT NULL,
+    post_n INT NOT NULL,
+    post_mean DOUBLE PRECISION NOT NULL,
+    post_stddev DOUBLE PRECISION NOT NULL,
+    UNIQUE(benchmark_uuid, environment_uuid, commit_index),
+    rank_by_effect_size INT NOT NULL,
+    rank_by_abs_percent_change INT NOT NULL
+);
+
+ALTER TABLE changes DROP COLUMN percent_ci_upper;
+ALTER TABLE changes DROP COLUMN percent_ci_lower;
+ALTER TABLE changes DROP COLUMN p_value;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE trace_updates (
+    benchmark_uuid UUID NOT NULL REFERENCES benchmarks,
+    environment_uuid UUID NOT NULL REFERENCES properties,
+    commit_index_min INT NOT NULL,
+    commit_index_max INT NOT NULL,
+    PRIMARY KEY (benchmark_uuid, environment_uuid)
+);
+
+-- Seed with the 512 most recent commits, the window previously searched by full
+-- change detection. Older history is deliberately excluded, since the first
+-- incremental pass would otherwise report long-known changes there as new.
+INSERT INTO trace_updates (
+    SELECT
+        benchmark_uuid,
+        environment_uuid,
+        MIN(commit_index),
+        MAX(commit_index)
+    FROM
+        points
+    WHERE
+        commit_index > (SELECT MAX(index) FROM commit_positions) - 512
+    GROUP BY
+        benchmark_uuid,
+        environment_uuid
+);
+
+-- +goose Down
+DROP TABLE trace_updates;
@@ -0,0 +1,5 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+ALTER TYPE task_type ADD VALUE 'package';
+ALTER TYPE task_type ADD VALUE 'benchmark';
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE module_job_settings (
+    module_uuid UUID PRIMARY KEY REFERENCES modules,
+    short BOOLEAN NOT NULL,
+    benchtime_ns BIGINT NOT NULL,
+    count INT NOT NULL,
+    tests TEXT NOT NULL,
+    benchmarks TEXT NOT NULL,
+    benchmarks_skip TEXT NOT NULL,
+    timeout_ns BIGINT NOT NULL
+);
+
+-- +goose Down
+DROP TABLE module_job_settings;
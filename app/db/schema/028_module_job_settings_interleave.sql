@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE module_job_settings ADD COLUMN interleave BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE module_job_settings DROP COLUMN interleave;
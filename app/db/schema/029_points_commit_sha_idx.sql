@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX points_commit_sha_idx ON points (commit_sha);
+
+-- +goose Down
+DROP INDEX points_commit_sha_idx;
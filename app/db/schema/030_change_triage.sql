@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TYPE triage_state AS ENUM (
+  'untriaged',
+  'acknowledged',
+  'expected',
+  'false_positive',
+  'fixed'
+);
+
+-- Triage is keyed by trace and commit index rather than referencing the
+-- changes table, since changes are deleted and replaced as detection is
+-- re-evaluated.
+CREATE TABLE change_triage (
+    benchmark_uuid UUID NOT NULL REFERENCES benchmarks,
+    environment_uuid UUID NOT NULL REFERENCES properties,
+    commit_index INT NOT NULL REFERENCES commit_positions (index),
+    state triage_state NOT NULL,
+    issue_url TEXT NOT NULL,
+    note TEXT NOT NULL,
+    last_updated TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (benchmark_uuid, environment_uuid, commit_index)
+);
+
+-- +goose Down
+DROP TABLE change_triage;
+DROP TYPE triage_state;
@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE repositories (
+    uuid UUID PRIMARY KEY,
+    url TEXT NOT NULL,
+    ref TEXT NOT NULL,
+    module_path TEXT NOT NULL,
+    toolchain_ref TEXT NOT NULL
+);
+
+-- Register the Go repository, which all existing commits belong to. The UUID
+-- must match entity.GoRepository.
+INSERT INTO repositories VALUES (
+    '103e726d-0186-5290-b355-74059fc4f763',
+    'https://go.googlesource.com/go',
+    'master',
+    'std',
+    ''
+);
+
+ALTER TABLE commits ADD COLUMN repository_uuid UUID NOT NULL DEFAULT '103e726d-0186-5290-b355-74059fc4f763' REFERENCES repositories;
+ALTER TABLE commits ALTER COLUMN repository_uuid DROP DEFAULT;
+
+ALTER TABLE commit_refs ADD COLUMN repository_uuid UUID NOT NULL DEFAULT '103e726d-0186-5290-b355-74059fc4f763' REFERENCES repositories;
+ALTER TABLE commit_refs ALTER COLUMN repository_uuid DROP DEFAULT;
+
+ALTER TABLE commit_positions ADD COLUMN repository_uuid UUID NOT NULL DEFAULT '103e726d-0186-5290-b355-74059fc4f763' REFERENCES repositories;
+ALTER TABLE commit_positions ALTER COLUMN repository_uuid DROP DEFAULT;
+
+-- Commit indexes are now only unique within a repository. Dropping the global
+-- key also drops foreign keys from trace data tables that referenced it.
+ALTER TABLE commit_positions DROP CONSTRAINT commit_positions_index_key CASCADE;
+ALTER TABLE commit_positions ADD CONSTRAINT commit_positions_repository_uuid_index_key UNIQUE (repository_uuid, index);
+
+-- +goose Down
+DELETE FROM commit_positions WHERE repository_uuid <> '103e726d-0186-5290-b355-74059fc4f763';
+DELETE FROM commit_refs WHERE repository_uuid <> '103e726d-0186-5290-b355-74059fc4f763';
+
+ALTER TABLE commit_positions DROP CONSTRAINT commit_positions_repository_uuid_index_key;
+ALTER TABLE commit_positions ADD CONSTRAINT commit_positions_index_key UNIQUE (index);
+
+ALTER TABLE changes ADD FOREIGN KEY (commit_index) REFERENCES commit_positions (index);
+ALTER TABLE changes_ranked ADD FOREIGN KEY (commit_index) REFERENCES commit_positions (index);
+ALTER TABLE change_triage ADD FOREIGN KEY (commit_index) REFERENCES commit_positions (index);
+ALTER TABLE points ADD FOREIGN KEY (commit_index) REFERENCES commit_positions (index);
+
+ALTER TABLE commit_positions DROP COLUMN repository_uuid;
+ALTER TABLE commit_refs DROP COLUMN repository_uuid;
+ALTER TABLE commits DROP COLUMN repository_uuid;
+
+DROP TABLE repositories;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE module_job_settings ADD COLUMN requires TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE module_job_settings DROP COLUMN requires;
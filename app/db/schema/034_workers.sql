@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE workers (
+    name TEXT PRIMARY KEY,
+    platform TEXT NOT NULL,
+    version TEXT NOT NULL,
+    last_heartbeat TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE workers;
@@ -0,0 +1,4 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+ALTER TYPE task_status ADD VALUE 'noisy';
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Outbox of alerts that have not yet been delivered to each subscription. Rows
+-- are added for every subscription when a change is first detected, follow the
+-- change if it moves to a different commit, and are removed once the alert has
+-- been delivered to that subscription.
+CREATE TABLE change_alerts (
+    benchmark_uuid UUID NOT NULL REFERENCES benchmarks,
+    environment_uuid UUID NOT NULL REFERENCES properties,
+    commit_index INT NOT NULL,
+    subscription TEXT NOT NULL,
+    PRIMARY KEY (benchmark_uuid, environment_uuid, commit_index, subscription)
+);
+
+-- +goose Down
+DROP TABLE change_alerts;
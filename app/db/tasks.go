@@ -88,9 +88,39 @@ func transitionTaskStatus(ctx context.Context, q *db.Queries, id uuid.UUID, from
 	return nil
 }
 
-// TimeoutStaleTasks marks stale all pending tasks with last update before until.
-func (d *DB) TimeoutStaleTasks(ctx context.Context, until time.Time) error {
-	return d.TransitionTaskStatusesBefore(ctx, entity.TaskStatusPendingValues(), entity.TaskStatusStaleTimeout, until)
+// TimeoutStaleTasks marks stale all pending tasks that were last updated before
+// until, or are assigned to workers whose last heartbeat was before heartbeat.
+// Heartbeats refresh the tasks they report in progress, so until only applies
+// to tasks their worker has stopped reporting.
+func (d *DB) TimeoutStaleTasks(ctx context.Context, heartbeat, until time.Time) error {
+	return d.TransitionInactiveTaskStatuses(ctx, entity.TaskStatusPendingValues(), entity.TaskStatusStaleTimeout, heartbeat, until)
+}
+
+// TransitionInactiveTaskStatuses applies a task status transition to all
+// inactive tasks. Tasks are inactive if they were last updated before until, or
+// are assigned to a worker whose last heartbeat was before heartbeat. A worker
+// heartbeat alone does not keep its tasks alive: a worker that restarts under
+// the same name keeps sending heartbeats, so tasks it abandoned must still
+// expire. Only tasks the heartbeat reports in progress are refreshed.
+func (d *DB) TransitionInactiveTaskStatuses(ctx context.Context, from []entity.TaskStatus, to entity.TaskStatus, heartbeat, until time.Time) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		fromStatuses, err := toTaskStatuses(from)
+		if err != nil {
+			return err
+		}
+
+		toStatus, err := toTaskStatus(to)
+		if err != nil {
+			return err
+		}
+
+		return q.TransitionInactiveTaskStatuses(ctx, db.TransitionInactiveTaskStatusesParams{
+			FromStatuses:   fromStatuses,
+			ToStatus:       toStatus,
+			Until:          until,
+			HeartbeatUntil: heartbeat,
+		})
+	})
 }
 
 // TransitionTaskStatusesBefore applies a task status transition to all tasks
@@ -236,6 +266,12 @@ func toTaskType(t entity.TaskType) (db.TaskType, error) {
 	switch t {
 	case entity.TaskTypeModule:
 		return db.TaskTypeModule, nil
+	case entity.TaskTypePackage:
+		return db.TaskTypePackage, nil
+	case entity.TaskTypeBenchmark:
+		return db.TaskTypeBenchmark, nil
+	case entity.TaskTypeRepository:
+		return db.TaskTypeRepository, nil
 	default:
 		return "", errutil.UnhandledCase(t)
 	}
@@ -274,6 +310,8 @@ func toTaskStatus(status entity.TaskStatus) (db.TaskStatus, error) {
 		return db.TaskStatusHalted, nil
 	case entity.TaskStatusStaleTimeout:
 		return db.TaskStatusStaleTimeout, nil
+	case entity.TaskStatusNoisy:
+		return db.TaskStatusNoisy, nil
 	default:
 		return "", errutil.UnhandledCase(status)
 	}
@@ -318,6 +356,12 @@ func mapTaskType(t db.TaskType) (entity.TaskType, error) {
 	switch t {
 	case db.TaskTypeModule:
 		return entity.TaskTypeModule, nil
+	case db.TaskTypePackage:
+		return entity.TaskTypePackage, nil
+	case db.TaskTypeBenchmark:
+		return entity.TaskTypeBenchmark, nil
+	case db.TaskTypeRepository:
+		return entity.TaskTypeRepository, nil
 	default:
 		return 0, errutil.UnhandledCase(t)
 	}
@@ -341,6 +385,8 @@ func mapTaskStatus(status db.TaskStatus) (entity.TaskStatus, error) {
 		return entity.TaskStatusHalted, nil
 	case db.TaskStatusStaleTimeout:
 		return entity.TaskStatusStaleTimeout, nil
+	case db.TaskStatusNoisy:
+		return entity.TaskStatusNoisy, nil
 	default:
 		return 0, errutil.UnhandledCase(status)
 	}
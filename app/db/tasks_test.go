@@ -3,8 +3,10 @@ package db_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 
 	"github.com/mmcloughlin/goperf/app/db/dbtest"
 	"github.com/mmcloughlin/goperf/app/entity"
@@ -68,3 +70,126 @@ func TestDBTransitionTaskStatusNoChange(t *testing.T) {
 		t.Fatalf("expected task to be unchanged\n%s", diff)
 	}
 }
+
+func TestDBTimeoutStaleTasksHeartbeat(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+
+	// Create tasks for a worker that sends heartbeats and one that doesn't.
+	live, err := db.CreateTask(ctx, "live", fixture.TaskSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	legacy, err := db.CreateTask(ctx, "legacy", fixture.TaskSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	if err := db.RecordWorkerHeartbeat(ctx, &entity.Worker{
+		Name:          "live",
+		Platform:      "linux/amd64",
+		Version:       "v0.0.0",
+		LastHeartbeat: now,
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expectStatuses := func(t *testing.T, expect map[*entity.Task]entity.TaskStatus) {
+		t.Helper()
+		for task, status := range expect {
+			got, err := db.FindTaskByUUID(ctx, task.UUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != status {
+				t.Errorf("task for worker %s has status %s; expect %s", task.Worker, got.Status, status)
+			}
+		}
+	}
+
+	// Neither task has timed out.
+	if err := db.TimeoutStaleTasks(ctx, now.Add(-time.Minute), now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	expectStatuses(t, map[*entity.Task]entity.TaskStatus{
+		live:   entity.TaskStatusCreated,
+		legacy: entity.TaskStatusCreated,
+	})
+
+	// Missed heartbeats should mark the live worker's task stale before the
+	// inactivity timeout.
+	if err := db.TimeoutStaleTasks(ctx, now.Add(time.Minute), now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	expectStatuses(t, map[*entity.Task]entity.TaskStatus{
+		live:   entity.TaskStatusStaleTimeout,
+		legacy: entity.TaskStatusCreated,
+	})
+
+	// A worker that restarts keeps sending heartbeats, but tasks it abandoned
+	// should still time out after inactivity.
+	restarted, err := db.CreateTask(ctx, "live", fixture.TaskSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.TimeoutStaleTasks(ctx, now.Add(-time.Minute), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	expectStatuses(t, map[*entity.Task]entity.TaskStatus{
+		restarted: entity.TaskStatusStaleTimeout,
+		legacy:    entity.TaskStatusStaleTimeout,
+	})
+}
+
+func TestDBTimeoutStaleTasksReported(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx := context.Background()
+
+	// Create two tasks for a worker, and report only one of them in progress.
+	reported, err := db.CreateTask(ctx, "live", fixture.TaskSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	abandoned, err := db.CreateTask(ctx, "live", fixture.TaskSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	until := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	if err := db.RecordWorkerHeartbeat(ctx, &entity.Worker{
+		Name:          "live",
+		Platform:      "linux/amd64",
+		Version:       "v0.0.0",
+		LastHeartbeat: time.Now(),
+	}, []uuid.UUID{reported.UUID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect only the task the worker stopped reporting to time out.
+	if err := db.TimeoutStaleTasks(ctx, until.Add(-time.Hour), until); err != nil {
+		t.Fatal(err)
+	}
+
+	for task, expect := range map[*entity.Task]entity.TaskStatus{
+		reported:  entity.TaskStatusCreated,
+		abandoned: entity.TaskStatusStaleTimeout,
+	} {
+		got, err := db.FindTaskByUUID(ctx, task.UUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Status != expect {
+			t.Errorf("task %s has status %s; expect %s", task.UUID, got.Status, expect)
+		}
+	}
+}
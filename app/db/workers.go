@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/db/internal/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+)
+
+// RecordWorkerHeartbeat records a heartbeat from worker w, creating the worker
+// if it does not exist. The heartbeat reports the tasks the worker has in
+// progress, which are refreshed so they do not time out from inactivity.
+func (d *DB) RecordWorkerHeartbeat(ctx context.Context, w *entity.Worker, tasks []uuid.UUID) error {
+	return d.txq(ctx, func(q *db.Queries) error {
+		if err := q.UpsertWorker(ctx, db.UpsertWorkerParams{
+			Name:          w.Name,
+			Platform:      w.Platform,
+			Version:       w.Version,
+			LastHeartbeat: w.LastHeartbeat,
+		}); err != nil {
+			return err
+		}
+
+		if len(tasks) == 0 {
+			return nil
+		}
+
+		statuses, err := toTaskStatuses(entity.TaskStatusPendingValues())
+		if err != nil {
+			return err
+		}
+
+		return q.RefreshWorkerTasks(ctx, db.RefreshWorkerTasksParams{
+			Worker:   w.Name,
+			UUIDs:    tasks,
+			Statuses: statuses,
+		})
+	})
+}
+
+// FindWorkerByName looks up the named worker.
+func (d *DB) FindWorkerByName(ctx context.Context, name string) (*entity.Worker, error) {
+	var w *entity.Worker
+	err := d.txq(ctx, func(q *db.Queries) error {
+		row, err := q.Worker(ctx, name)
+		if err != nil {
+			return err
+		}
+		w = mapWorker(row)
+		return nil
+	})
+	return w, err
+}
+
+// ListWorkers returns all workers.
+func (d *DB) ListWorkers(ctx context.Context) ([]*entity.Worker, error) {
+	var ws []*entity.Worker
+	err := d.txq(ctx, func(q *db.Queries) error {
+		rows, err := q.Workers(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			ws = append(ws, mapWorker(row))
+		}
+		return nil
+	})
+	return ws, err
+}
+
+func mapWorker(w db.Worker) *entity.Worker {
+	return &entity.Worker{
+		Name:          w.Name,
+		Platform:      w.Platform,
+		Version:       w.Version,
+		LastHeartbeat: w.LastHeartbeat,
+	}
+}
@@ -15,4 +15,17 @@ type ChangeSummary struct {
 	CommitSubject string
 
 	change.Change
+	Confirmation change.Confirmation
+	Triage       Triage
+}
+
+// Type classifies the change as an improvement or regression.
+func (c *ChangeSummary) Type() change.Type {
+	return change.Classify(c.Pre.Mean, c.Post.Mean, c.Benchmark.Unit)
+}
+
+// BenchmarkPoint is a point with its associated benchmark.
+type BenchmarkPoint struct {
+	Benchmark *Benchmark
+	Point
 }
@@ -30,6 +30,47 @@ type Commit struct {
 	Message    string
 }
 
+// Repository is a git repository under test. The repository is either the Go
+// toolchain itself, or a module benchmarked across its commit history with a
+// pinned toolchain.
+type Repository struct {
+	URL        string // git clone URL
+	Ref        string // branch to track
+	ModulePath string // path of the go module in the repository
+
+	// ToolchainRef is the git sha or ref of the Go toolchain to benchmark
+	// module commits with. Refs are resolved to a commit in the Go repository
+	// when jobs are built. Empty if the repository is the Go toolchain.
+	ToolchainRef string
+}
+
+var repositorynamespace = uuid.MustParse("b3f4ae73-1a7c-4b41-a7d3-0c6e9a8d5f21")
+
+func (r *Repository) UUID() uuid.UUID {
+	return id.Strings(repositorynamespace, []string{r.URL})
+}
+
+// IsToolchain reports whether the repository is the Go toolchain, rather than
+// a module benchmarked with a pinned toolchain.
+func (r *Repository) IsToolchain() bool {
+	return r.ToolchainRef == ""
+}
+
+// Module returns the module under test in repository commits. The version is
+// empty since it varies with the commit.
+func (r *Repository) Module() *Module {
+	return &Module{Path: r.ModulePath}
+}
+
+func (r *Repository) String() string { return r.URL }
+
+// GoRepository is the Go toolchain repository.
+var GoRepository = &Repository{
+	URL:        "https://go.googlesource.com/go",
+	Ref:        "master",
+	ModulePath: "std",
+}
+
 type CommitRef struct {
 	SHA string
 	Ref string
@@ -174,3 +215,9 @@ type Change struct {
 	trace.ID
 	change.Change
 }
+
+// TraceUpdate records that points were added to a trace within a commit range.
+type TraceUpdate struct {
+	trace.ID
+	CommitIndexRange CommitIndexRange
+}
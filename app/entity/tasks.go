@@ -11,7 +11,10 @@ type TaskType uint
 
 // Supported task types.
 const (
-	TaskTypeModule TaskType = iota + 1 // benchmark a go module
+	TaskTypeModule     TaskType = iota + 1 // benchmark a go module
+	TaskTypePackage                        // benchmark a single package
+	TaskTypeBenchmark                      // run a single benchmark
+	TaskTypeRepository                     // benchmark a repository commit with a pinned toolchain
 )
 
 //go:generate enumer -type TaskType -output tasktype_enum.go -trimprefix TaskType -transform snake
@@ -29,6 +32,7 @@ const (
 	TaskStatusCompleteError                             // completed with error
 	TaskStatusHalted                                    // worker stopped processing the task
 	TaskStatusStaleTimeout                              // timed out due to inactivity
+	TaskStatusNoisy                                     // worker system never quiet enough to benchmark
 )
 
 //go:generate enumer -type TaskStatus -output taskstatus_enum.go -trimprefix TaskStatus -transform snake
@@ -41,9 +45,9 @@ func (s TaskStatus) IsComplete() bool {
 // IsTerminal reports whether the task is in a final state, meaning no further
 // changes will happen to it. This could be because processing was completed
 // (success or error), or processing could have stopped for some reason (halted
-// by the worker, marked stale after inactivity).
+// by the worker, marked stale after inactivity, system too noisy).
 func (s TaskStatus) IsTerminal() bool {
-	return s.IsComplete() || s == TaskStatusHalted || s == TaskStatusStaleTimeout
+	return s.IsComplete() || s == TaskStatusHalted || s == TaskStatusStaleTimeout || s == TaskStatusNoisy
 }
 
 // IsPending reports whether this task is in a pending state.
@@ -85,3 +89,32 @@ type Task struct {
 	LastStatusUpdate time.Time
 	DatafileUUID     uuid.UUID
 }
+
+// Worker is a benchmark worker, as last reported by its heartbeat.
+type Worker struct {
+	Name          string
+	Platform      string // operating system and architecture
+	Version       string // worker software version
+	LastHeartbeat time.Time
+}
+
+// JobSettings configures benchmark jobs for a module.
+type JobSettings struct {
+	Short          bool          // short test mode
+	BenchTime      time.Duration // minimum time per benchmark
+	Count          int           // number of times to run each benchmark
+	Interleave     bool          // interleave benchmark repetitions across packages
+	Tests          string        // tests regular expression
+	Benchmarks     string        // benchmarks regular expression
+	BenchmarksSkip string        // regular expression for benchmarks to skip
+	Timeout        time.Duration // timeout for the test binary
+	Requires       []string      // pinned module versions in "path@version" form
+}
+
+// DefaultJobSettings are used for modules without their own settings.
+var DefaultJobSettings = JobSettings{
+	Short:     true,
+	BenchTime: 100 * time.Millisecond,
+	Count:     1,
+	Timeout:   2 * time.Hour,
+}
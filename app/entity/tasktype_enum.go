@@ -7,9 +7,9 @@ import (
 	"fmt"
 )
 
-const _TaskTypeName = "module"
+const _TaskTypeName = "modulepackagebenchmarkrepository"
 
-var _TaskTypeIndex = [...]uint8{0, 6}
+var _TaskTypeIndex = [...]uint8{0, 6, 13, 22, 32}
 
 func (i TaskType) String() string {
 	i -= 1
@@ -19,10 +19,13 @@ func (i TaskType) String() string {
 	return _TaskTypeName[_TaskTypeIndex[i]:_TaskTypeIndex[i+1]]
 }
 
-var _TaskTypeValues = []TaskType{1}
+var _TaskTypeValues = []TaskType{1, 2, 3, 4}
 
 var _TaskTypeNameToValueMap = map[string]TaskType{
-	_TaskTypeName[0:6]: 1,
+	_TaskTypeName[0:6]:   1,
+	_TaskTypeName[6:13]:  2,
+	_TaskTypeName[13:22]: 3,
+	_TaskTypeName[22:32]: 4,
 }
 
 // TaskTypeString retrieves an enum value from the enum constants string name.
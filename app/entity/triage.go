@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// TriageState describes the outcome of a human review of a change.
+type TriageState uint
+
+// Supported triage states.
+const (
+	TriageStateUntriaged     TriageState = iota + 1 // not yet reviewed
+	TriageStateAcknowledged                         // reviewed and accepted as a real change
+	TriageStateExpected                             // intentional trade-off
+	TriageStateFalsePositive                        // not a real change
+	TriageStateFixed                                // regression has since been fixed
+)
+
+//go:generate enumer -type TriageState -output triagestate_enum.go -trimprefix TriageState -transform snake
+
+// Triage is the annotation of a change by a human reviewer.
+type Triage struct {
+	State       TriageState
+	IssueURL    string
+	Note        string
+	LastUpdated time.Time
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"math"
 	"net/http"
 
 	"github.com/golang/gddo/httputil/header"
@@ -62,3 +63,13 @@ func (j *JSONEncoder) EncodeResponse(w http.ResponseWriter, v interface{}) error
 
 	return e.Encode(v)
 }
+
+// Finite returns a pointer to x, or nil if x is not a finite number. JSON has
+// no representation for NaN or infinities, so this allows such values to be
+// encoded as null.
+func Finite(x float64) *float64 {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return nil
+	}
+	return &x
+}
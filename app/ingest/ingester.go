@@ -70,6 +70,12 @@ func (i *Ingester) Task(ctx context.Context, id uuid.UUID) error {
 		}
 	}
 
+	// Flag results from runs where the system throttled. They are still stored,
+	// with the throttling recorded in their metadata.
+	if rs := throttled(i.loader, rs); len(rs) > 0 {
+		i.log.Warn("results recorded while throttled", zap.Int("num_results", len(rs)))
+	}
+
 	// Write to storage.
 	if err := i.db.StoreResults(ctx, rs); err != nil {
 		return err
@@ -86,3 +92,14 @@ func (i *Ingester) Task(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// throttled returns the results recorded while the system throttled.
+func throttled(l *results.Loader, rs []*entity.Result) []*entity.Result {
+	var out []*entity.Result
+	for _, r := range rs {
+		if l.Throttled(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
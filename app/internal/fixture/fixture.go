@@ -118,6 +118,10 @@ var (
 			EffectSize:  4.72,
 			Pre:         change.Stats{N: 30, Mean: 42, Variance: 3},
 			Post:        change.Stats{N: 30, Mean: 50, Variance: 3.5},
+			Significance: change.Significance{
+				PValue:    1.7e-11,
+				PercentCI: change.Interval{Lower: 17.0, Upper: 21.1},
+			},
 		},
 	}
 )
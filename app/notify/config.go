@@ -0,0 +1,158 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/smtp"
+	"os"
+	"regexp"
+
+	"github.com/mmcloughlin/goperf/app/httputil"
+)
+
+// Config is a notification configuration, typically loaded from JSON.
+type Config struct {
+	DashboardURL  string                `json:"dashboard_url"`
+	Sinks         map[string]SinkConfig `json:"sinks"`
+	Subscriptions []SubscriptionConfig  `json:"subscriptions"`
+}
+
+// SinkConfig configures a sink. Type must be one of "webhook", "email" or
+// "file", and determines which other fields are used.
+type SinkConfig struct {
+	Type string `json:"type"`
+
+	// Webhook.
+	URL string `json:"url,omitempty"`
+
+	// Email.
+	Addr     string   `json:"addr,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from,omitempty"`
+	To       []string `json:"to,omitempty"`
+
+	// File. Path "-" writes to standard output.
+	Path string `json:"path,omitempty"`
+}
+
+// SubscriptionConfig configures a subscription. Regular expressions may be
+// empty to match everything. Sink refers to a sink by name.
+type SubscriptionConfig struct {
+	Name         string  `json:"name"`
+	Module       string  `json:"module,omitempty"`
+	Package      string  `json:"package,omitempty"`
+	Benchmark    string  `json:"benchmark,omitempty"`
+	MinPercent   float64 `json:"min_percent,omitempty"`
+	Improvements bool    `json:"improvements,omitempty"`
+	Sink         string  `json:"sink"`
+}
+
+// LoadConfig reads JSON configuration from r.
+func LoadConfig(r io.Reader) (*Config, error) {
+	c := &Config{}
+	if err := httputil.DecodeJSON(r, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Notifier builds a notifier from the configuration.
+func (c *Config) Notifier() (*Notifier, error) {
+	// Build sinks.
+	sinks := map[string]Sink{}
+	for name, cfg := range c.Sinks {
+		s, err := cfg.Sink()
+		if err != nil {
+			return nil, fmt.Errorf("sink %q: %w", name, err)
+		}
+		sinks[name] = s
+	}
+
+	// Build subscriptions.
+	var subs []*Subscription
+	for _, cfg := range c.Subscriptions {
+		s, err := cfg.Subscription(sinks)
+		if err != nil {
+			return nil, fmt.Errorf("subscription %q: %w", cfg.Name, err)
+		}
+		subs = append(subs, s)
+	}
+
+	n := New(subs...)
+	n.SetDashboardURL(c.DashboardURL)
+	return n, nil
+}
+
+// Sink builds the configured sink.
+func (c SinkConfig) Sink() (Sink, error) {
+	switch c.Type {
+	case "webhook":
+		if c.URL == "" {
+			return nil, errors.New("webhook sink requires url")
+		}
+		return &Webhook{URL: c.URL}, nil
+	case "email":
+		if c.Addr == "" || c.From == "" || len(c.To) == 0 {
+			return nil, errors.New("email sink requires addr, from and to")
+		}
+		e := &Email{Addr: c.Addr, From: c.From, To: c.To}
+		if c.Username != "" {
+			host, _, err := net.SplitHostPort(c.Addr)
+			if err != nil {
+				return nil, err
+			}
+			e.Auth = smtp.PlainAuth("", c.Username, c.Password, host)
+		}
+		return e, nil
+	case "file":
+		switch c.Path {
+		case "":
+			return nil, errors.New("file sink requires path")
+		case "-":
+			return NewWriter(os.Stdout), nil
+		default:
+			return &File{Path: c.Path}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", c.Type)
+	}
+}
+
+// Subscription builds the configured subscription, referring to sinks by name.
+func (c SubscriptionConfig) Subscription(sinks map[string]Sink) (*Subscription, error) {
+	sink, ok := sinks[c.Sink]
+	if !ok {
+		return nil, fmt.Errorf("unknown sink %q", c.Sink)
+	}
+
+	s := &Subscription{
+		Name:         c.Name,
+		MinPercent:   c.MinPercent,
+		Improvements: c.Improvements,
+		Sink:         sink,
+	}
+
+	exprs := []struct {
+		Expr string
+		Dst  **regexp.Regexp
+	}{
+		{c.Module, &s.Module},
+		{c.Package, &s.Package},
+		{c.Benchmark, &s.Benchmark},
+	}
+	for _, e := range exprs {
+		if e.Expr == "" {
+			continue
+		}
+		r, err := regexp.Compile(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		*e.Dst = r
+	}
+
+	return s, nil
+}
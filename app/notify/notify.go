@@ -0,0 +1,172 @@
+// Package notify delivers alerts for newly detected changes to subscribers.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/internal/errutil"
+)
+
+// Alert describes a newly detected change.
+type Alert struct {
+	Benchmark       *entity.Benchmark
+	EnvironmentUUID uuid.UUID
+	change.Change
+
+	// URL is a link to the change on the dashboard, if known.
+	URL string
+}
+
+// Type classifies the change as an improvement or regression.
+func (a *Alert) Type() change.Type {
+	return change.Classify(a.Pre.Mean, a.Post.Mean, a.Benchmark.Unit)
+}
+
+// String returns a single line summary of the alert.
+func (a *Alert) String() string {
+	s := fmt.Sprintf("%s: %s %s %s %+.2f%% at commit index %d (effect size %.2f, p=%.3g)",
+		a.Type(),
+		a.Benchmark.Package.ImportPath(),
+		a.Benchmark.FullName,
+		a.Benchmark.Unit,
+		a.Percent(),
+		a.CommitIndex,
+		a.EffectSize,
+		a.PValue,
+	)
+	if a.URL != "" {
+		s += " " + a.URL
+	}
+	return s
+}
+
+// Sink delivers alerts.
+type Sink interface {
+	Send(ctx context.Context, subject string, alerts []*Alert) error
+}
+
+// Subscription selects alerts of interest to deliver to a sink. Nil regular
+// expressions match everything.
+type Subscription struct {
+	Name string
+
+	Module    *regexp.Regexp // module path
+	Package   *regexp.Regexp // package import path
+	Benchmark *regexp.Regexp // benchmark full name
+
+	MinPercent   float64 // minimum absolute percent change
+	Improvements bool    // also alert on improvements, not just regressions
+
+	Sink Sink
+}
+
+// Match reports whether the subscription is interested in alert a.
+func (s *Subscription) Match(a *Alert) bool {
+	switch a.Type() {
+	case change.TypeRegression:
+	case change.TypeImprovement:
+		if !s.Improvements {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if math.Abs(a.Percent()) < s.MinPercent {
+		return false
+	}
+
+	b := a.Benchmark
+	return matches(s.Module, b.Package.Module.Path) &&
+		matches(s.Package, b.Package.ImportPath()) &&
+		matches(s.Benchmark, b.FullName)
+}
+
+func matches(r *regexp.Regexp, s string) bool {
+	return r == nil || r.MatchString(s)
+}
+
+// Notifier sends alerts to matching subscriptions.
+type Notifier struct {
+	subs         []*Subscription
+	dashboardURL string
+}
+
+// New builds a notifier for the given subscriptions.
+func New(subs ...*Subscription) *Notifier {
+	return &Notifier{subs: subs}
+}
+
+// SetDashboardURL configures the base URL of the dashboard, used to link
+// alerts to the change.
+func (n *Notifier) SetDashboardURL(u string) {
+	n.dashboardURL = strings.TrimSuffix(u, "/")
+}
+
+// Subscriptions returns the names of all subscriptions.
+func (n *Notifier) Subscriptions() []string {
+	names := make([]string, len(n.subs))
+	for i, s := range n.subs {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// Notify sends alerts to every subscription with at least one matching alert.
+// Delivery is attempted for all subscriptions, even if some fail.
+func (n *Notifier) Notify(ctx context.Context, alerts []*Alert) error {
+	var errs errutil.Errors
+	for _, s := range n.subs {
+		if err := n.send(ctx, s, alerts); err != nil {
+			errs.Add(err)
+		}
+	}
+	return errs.Err()
+}
+
+// Send delivers the alerts matching the named subscription to its sink. Alerts
+// for an unknown subscription, for example one since removed from
+// configuration, are discarded.
+func (n *Notifier) Send(ctx context.Context, name string, alerts []*Alert) error {
+	for _, s := range n.subs {
+		if s.Name == name {
+			return n.send(ctx, s, alerts)
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) send(ctx context.Context, s *Subscription, alerts []*Alert) error {
+	var matched []*Alert
+	for _, a := range alerts {
+		if s.Match(a) {
+			matched = append(matched, a)
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil
+	}
+
+	if n.dashboardURL != "" {
+		for _, a := range matched {
+			if a.URL == "" {
+				a.URL = fmt.Sprintf("%s/bench/%s?c=%d", n.dashboardURL, a.Benchmark.UUID(), a.CommitIndex)
+			}
+		}
+	}
+
+	subject := fmt.Sprintf("[%s] %d new changes", s.Name, len(matched))
+	if err := s.Sink.Send(ctx, subject, matched); err != nil {
+		return fmt.Errorf("subscription %q: %w", s.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mmcloughlin/goperf/app/change"
+	"github.com/mmcloughlin/goperf/app/internal/fixture"
+)
+
+// alert builds an alert for the fixture benchmark with the given pre and post
+// means. The fixture benchmark is in MB/s, so larger is better.
+func alert(pre, post float64) *Alert {
+	return &Alert{
+		Benchmark: fixture.Benchmark,
+		Change: change.Change{
+			CommitIndex: 42,
+			Pre:         change.Stats{N: 10, Mean: pre},
+			Post:        change.Stats{N: 10, Mean: post},
+		},
+	}
+}
+
+func TestSubscriptionMatch(t *testing.T) {
+	regression := alert(100, 80)
+	improvement := alert(100, 120)
+
+	cases := []struct {
+		Name   string
+		Sub    Subscription
+		Alert  *Alert
+		Expect bool
+	}{
+		{Name: "all_regression", Sub: Subscription{}, Alert: regression, Expect: true},
+		{Name: "all_improvement", Sub: Subscription{}, Alert: improvement, Expect: false},
+		{Name: "improvements", Sub: Subscription{Improvements: true}, Alert: improvement, Expect: true},
+		{Name: "min_percent_below", Sub: Subscription{MinPercent: 25}, Alert: regression, Expect: false},
+		{Name: "min_percent_above", Sub: Subscription{MinPercent: 15}, Alert: regression, Expect: true},
+		{Name: "module_match", Sub: Subscription{Module: regexp.MustCompile(`klauspost`)}, Alert: regression, Expect: true},
+		{Name: "module_mismatch", Sub: Subscription{Module: regexp.MustCompile(`^std$`)}, Alert: regression, Expect: false},
+		{Name: "package_match", Sub: Subscription{Package: regexp.MustCompile(`/huff0$`)}, Alert: regression, Expect: true},
+		{Name: "benchmark_mismatch", Sub: Subscription{Benchmark: regexp.MustCompile(`Decompress`)}, Alert: regression, Expect: false},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			if got := c.Sub.Match(c.Alert); got != c.Expect {
+				t.Fatalf("Match() = %v; expect %v", got, c.Expect)
+			}
+		})
+	}
+}
+
+func TestNotifierWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	n := New(
+		&Subscription{Name: "all", Sink: NewWriter(buf)},
+		&Subscription{Name: "none", MinPercent: 90, Sink: NewWriter(buf)},
+	)
+	n.SetDashboardURL("https://perf.example.com/")
+
+	if err := n.Notify(context.Background(), []*Alert{alert(100, 80), alert(100, 120)}); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	t.Log(out)
+
+	if !strings.HasPrefix(out, "[all] 1 new changes\n") {
+		t.Errorf("unexpected subject")
+	}
+	if strings.Contains(out, "[none]") {
+		t.Errorf("unexpected output for non-matching subscription")
+	}
+	if !strings.Contains(out, "https://perf.example.com/bench/"+fixture.Benchmark.UUID().String()+"?c=42") {
+		t.Errorf("expected dashboard link")
+	}
+}
+
+func TestNotifierSend(t *testing.T) {
+	a, b := new(bytes.Buffer), new(bytes.Buffer)
+	n := New(
+		&Subscription{Name: "a", Sink: NewWriter(a)},
+		&Subscription{Name: "b", Sink: NewWriter(b)},
+	)
+
+	if got := n.Subscriptions(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("Subscriptions() = %v; expect [a b]", got)
+	}
+
+	ctx := context.Background()
+	alerts := []*Alert{alert(100, 80)}
+	if err := n.Send(ctx, "b", alerts); err != nil {
+		t.Fatal(err)
+	}
+	if a.Len() != 0 || b.Len() == 0 {
+		t.Fatalf("expected delivery to the named subscription only")
+	}
+
+	// Unknown subscriptions are discarded.
+	if err := n.Send(ctx, "removed", alerts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	var got WebhookPayload
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer s.Close()
+
+	w := &Webhook{URL: s.URL}
+	if err := w.Send(context.Background(), "subject", []*Alert{alert(100, 80)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Subject != "subject" || len(got.Alerts) != 1 {
+		t.Fatalf("unexpected payload %#v", got)
+	}
+	a := got.Alerts[0]
+	if a.Type != "regression" || a.Percent == nil || *a.Percent != -20 {
+		t.Fatalf("unexpected alert %#v", a)
+	}
+}
+
+func TestWebhookErrorStatus(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+
+	w := &Webhook{URL: s.URL}
+	if err := w.Send(context.Background(), "subject", []*Alert{alert(100, 80)}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEmail(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// Minimal SMTP server that records the message data.
+	data := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		tp.PrintfLine("220 localhost ready")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.Fields(line)[0]); cmd {
+			case "EHLO", "HELO", "MAIL", "RCPT":
+				tp.PrintfLine("250 ok")
+			case "DATA":
+				tp.PrintfLine("354 go ahead")
+				b, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				data <- string(b)
+				tp.PrintfLine("250 ok")
+			case "QUIT":
+				tp.PrintfLine("221 bye")
+				return
+			default:
+				tp.PrintfLine("502 unknown command %s", cmd)
+			}
+		}
+	}()
+
+	e := &Email{
+		Addr: l.Addr().String(),
+		From: "perf@example.com",
+		To:   []string{"dev@example.com"},
+	}
+	if err := e.Send(context.Background(), "subject", []*Alert{alert(100, 80)}); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := <-data
+	if !strings.Contains(msg, "Subject: subject") || !strings.Contains(msg, "regression") {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestEmailTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// Server that accepts connections but never responds.
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			bufio.NewReader(conn).ReadString(0)
+		}
+	}()
+
+	e := &Email{
+		Addr:    l.Addr().String(),
+		From:    "perf@example.com",
+		To:      []string{"dev@example.com"},
+		Timeout: 50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	err = e.Send(context.Background(), "subject", []*Alert{alert(100, 80)})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded; got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("send took %s", elapsed)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg := `{
+		"dashboard_url": "https://perf.example.com",
+		"sinks": {
+			"hook": {"type": "webhook", "url": "https://hooks.example.com/perf"},
+			"out": {"type": "file", "path": "-"}
+		},
+		"subscriptions": [
+			{"name": "std", "module": "^std$", "min_percent": 5, "sink": "hook"},
+			{"name": "all", "sink": "out"}
+		]
+	}`
+	c, err := LoadConfig(strings.NewReader(cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := c.Notifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(n.subs) != 2 {
+		t.Fatalf("got %d subscriptions; expect 2", len(n.subs))
+	}
+	std := n.subs[0]
+	if std.Module == nil || std.Module.String() != "^std$" || std.MinPercent != 5 {
+		t.Errorf("unexpected subscription %#v", std)
+	}
+	if _, ok := std.Sink.(*Webhook); !ok {
+		t.Errorf("expected webhook sink")
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	cases := map[string]string{
+		"unknown_sink":      `{"subscriptions": [{"name": "x", "sink": "missing"}]}`,
+		"unknown_type":      `{"sinks": {"s": {"type": "pigeon"}}}`,
+		"bad_regexp":        `{"sinks": {"s": {"type": "file", "path": "-"}}, "subscriptions": [{"name": "x", "module": "(", "sink": "s"}]}`,
+		"email_missing_to":  `{"sinks": {"s": {"type": "email", "addr": "smtp.example.com:587", "from": "a@example.com"}}}`,
+		"unknown_json_keys": `{"sinks": {}, "extra": 1}`,
+	}
+	for name, cfg := range cases {
+		cfg := cfg // scopelint
+		t.Run(name, func(t *testing.T) {
+			c, err := LoadConfig(strings.NewReader(cfg))
+			if err == nil {
+				_, err = c.Notifier()
+			}
+			if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/httputil"
+	"github.com/mmcloughlin/goperf/internal/errutil"
+)
+
+// Webhook sends alerts as a JSON POST request.
+type Webhook struct {
+	URL    string
+	Client *http.Client
+}
+
+// WebhookPayload is the JSON request body sent by the webhook sink.
+type WebhookPayload struct {
+	Subject string          `json:"subject"`
+	Alerts  []*WebhookAlert `json:"alerts"`
+}
+
+// WebhookAlert is the JSON representation of an alert. Statistics that are
+// not finite are represented as null.
+type WebhookAlert struct {
+	BenchmarkUUID   uuid.UUID `json:"benchmark_uuid"`
+	Package         string    `json:"package"`
+	Benchmark       string    `json:"benchmark"`
+	Unit            string    `json:"unit"`
+	EnvironmentUUID uuid.UUID `json:"environment_uuid"`
+	CommitIndex     int       `json:"commit_index"`
+	EffectSize      *float64  `json:"effect_size"`
+	PreMean         *float64  `json:"pre_mean"`
+	PostMean        *float64  `json:"post_mean"`
+	Percent         *float64  `json:"percent"`
+	PValue          *float64  `json:"p_value"`
+	Type            string    `json:"type"`
+	URL             string    `json:"url,omitempty"`
+}
+
+// Send alerts to the webhook URL.
+func (w *Webhook) Send(ctx context.Context, subject string, alerts []*Alert) error {
+	payload := &WebhookPayload{Subject: subject}
+	for _, a := range alerts {
+		payload.Alerts = append(payload.Alerts, &WebhookAlert{
+			BenchmarkUUID:   a.Benchmark.UUID(),
+			Package:         a.Benchmark.Package.ImportPath(),
+			Benchmark:       a.Benchmark.FullName,
+			Unit:            a.Benchmark.Unit,
+			EnvironmentUUID: a.EnvironmentUUID,
+			CommitIndex:     a.CommitIndex,
+			EffectSize:      httputil.Finite(a.EffectSize),
+			PreMean:         httputil.Finite(a.Pre.Mean),
+			PostMean:        httputil.Finite(a.Post.Mean),
+			Percent:         httputil.Finite(a.Percent()),
+			PValue:          httputil.Finite(a.PValue),
+			Type:            a.Type().String(),
+			URL:             a.URL,
+		})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return httputil.ExpectStatus(res.StatusCode, http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+}
+
+// DefaultEmailTimeout is the time allowed to deliver email, if the sink does
+// not specify one.
+const DefaultEmailTimeout = time.Minute
+
+// Email sends alerts as a plain text email via an SMTP server.
+type Email struct {
+	Addr    string // SMTP server address in host:port form
+	Auth    smtp.Auth
+	From    string
+	To      []string
+	Timeout time.Duration // zero means DefaultEmailTimeout
+}
+
+// Send alerts by email.
+func (e *Email) Send(ctx context.Context, subject string, alerts []*Alert) error {
+	msg := new(bytes.Buffer)
+	fmt.Fprintf(msg, "From: %s\r\n", e.From)
+	fmt.Fprintf(msg, "To: %s\r\n", strings.Join(e.To, ", "))
+	fmt.Fprintf(msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(msg, "Content-Type: text/plain; charset=utf-8\r\n")
+	fmt.Fprintf(msg, "\r\n")
+	for _, a := range alerts {
+		fmt.Fprintf(msg, "%s\r\n", a)
+	}
+
+	timeout := e.Timeout
+	if timeout == 0 {
+		timeout = DefaultEmailTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := e.send(ctx, msg.Bytes()); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+// send delivers msg in the same way as smtp.SendMail, but aborts when ctx is
+// done.
+func (e *Email) send(ctx context.Context, msg []byte) error {
+	host, _, err := net.SplitHostPort(e.Addr)
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", e.Addr)
+	if err != nil {
+		return err
+	}
+
+	// Closing the connection interrupts any blocked SMTP operation.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+
+	if e.Auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support authentication")
+		}
+		if err := c.Auth(e.Auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(e.From); err != nil {
+		return err
+	}
+	for _, to := range e.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// Writer writes alerts as lines of text, for example to a file or standard
+// output.
+type Writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriter builds a sink that writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Send writes alerts.
+func (w *Writer) Send(ctx context.Context, subject string, alerts []*Alert) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, err := fmt.Fprintln(w.w, subject); err != nil {
+		return err
+	}
+	for _, a := range alerts {
+		if _, err := fmt.Fprintf(w.w, "\t%s\n", a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// File appends alerts to a file.
+type File struct {
+	Path string
+}
+
+// Send appends alerts to the file.
+func (f *File) Send(ctx context.Context, subject string, alerts []*Alert) (err error) {
+	w, err := os.OpenFile(f.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer errutil.CheckClose(&err, w)
+
+	return NewWriter(w).Send(ctx, subject, alerts)
+}
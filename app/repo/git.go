@@ -2,24 +2,72 @@ package repo
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 
 	"github.com/mmcloughlin/goperf/app/entity"
 )
 
-// Git is a cloned local git repository.
+// DefaultLogPageSize is the default maximum number of commits returned by a
+// single call to Git.Log, mirroring the page sizes of hosted implementations.
+const DefaultLogPageSize = 100
+
+// Git is a local git repository.
 type Git struct {
 	r   *git.Repository
 	dir string
+	tmp bool // whether dir is a temporary clone owned by Git
+
+	firstparent bool
+	pagesize    int
+}
+
+// GitOption configures a local git repository.
+type GitOption func(*Git)
+
+// WithFirstParent configures log methods to only follow the first parent of
+// merge commits, equivalent to "git log --first-parent".
+func WithFirstParent() GitOption {
+	return func(g *Git) { g.firstparent = true }
+}
+
+// WithLogPageSize sets the maximum number of commits returned by a single call
+// to Log. Zero means no limit.
+func WithLogPageSize(n int) GitOption {
+	return func(g *Git) { g.pagesize = n }
+}
+
+func newGit(r *git.Repository, dir string, tmp bool, opts []GitOption) *Git {
+	g := &Git{
+		r:        r,
+		dir:      dir,
+		tmp:      tmp,
+		pagesize: DefaultLogPageSize,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Open an existing git repository on disk. The directory may be a bare
+// repository or a working tree.
+func Open(dir string, opts ...GitOption) (*Git, error) {
+	r, err := git.PlainOpen(dir)
+	if err != nil {
+		return nil, err
+	}
+	return newGit(r, dir, false, opts), nil
 }
 
 // Clone a repository to a temporary directory.
-func Clone(ctx context.Context, url string) (*Git, error) {
+func Clone(ctx context.Context, url string, opts ...GitOption) (*Git, error) {
 	d, err := ioutil.TempDir("", "clone")
 	if err != nil {
 		return nil, err
@@ -33,30 +81,90 @@ func Clone(ctx context.Context, url string) (*Git, error) {
 		return nil, err
 	}
 
-	return &Git{
-		r:   r,
-		dir: d,
-	}, nil
+	return newGit(r, d, true, opts), nil
 }
 
-// Close deletes the cloned repository.
+// Close deletes the repository if it was cloned. Opened repositories are left
+// in place.
 func (g *Git) Close() error {
+	if !g.tmp {
+		return nil
+	}
 	return os.RemoveAll(g.dir)
 }
 
-// Log is equivalent to "git log".
-func (g *Git) Log() (CommitIterator, error) {
-	ref, err := g.r.Head()
+// Commits is equivalent to "git log <ref>", iterating over the full history
+// reachable from ref.
+func (g *Git) Commits(ref string) (CommitIterator, error) {
+	h, err := g.resolve(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := g.r.Log(&git.LogOptions{From: h})
+	if err != nil {
+		return nil, err
+	}
+
+	var it CommitIterator = commitIterator{l}
+	if g.firstparent {
+		it = FirstParent(it)
+	}
+
+	return it, nil
+}
+
+// Log returns up to the configured page size of commits reachable from ref, in
+// "git log" order.
+func (g *Git) Log(ctx context.Context, ref string) (_ []*entity.Commit, err error) {
+	it, err := g.Commits(ref)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
+
+	var commits []*entity.Commit
+	for g.pagesize == 0 || len(commits) < g.pagesize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 
-	l, err := g.r.Log(&git.LogOptions{From: ref.Hash()})
+		c, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		commits = append(commits, c)
+	}
+
+	return commits, nil
+}
+
+// Revision looks up the commit for a sha, branch or tag.
+func (g *Git) Revision(ctx context.Context, ref string) (*entity.Commit, error) {
+	h, err := g.resolve(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := g.r.CommitObject(h)
 	if err != nil {
 		return nil, err
 	}
 
-	return commitIterator{l}, nil
+	return mapGitCommit(c), nil
+}
+
+// resolve the given ref to a commit hash.
+func (g *Git) resolve(ref string) (plumbing.Hash, error) {
+	h, err := g.r.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("resolve revision %q: %w", ref, err)
+	}
+	return *h, nil
 }
 
 // CommitIterator provides iterative access to a sequence of commits.
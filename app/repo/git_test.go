@@ -0,0 +1,192 @@
+package repo
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+)
+
+// testrepo is a local git repository with history:
+//
+//	c1 -- c2 -- m   (master)
+//	  \        /
+//	   -- s1 --
+//
+// with the annotated tag v1.0.0 at c2.
+type testrepo struct {
+	Dir               string
+	C1, C2, S1, Merge string
+}
+
+func newtestrepo(t *testing.T) *testrepo {
+	t.Helper()
+
+	dir := test.TempDir(t)
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	commit := func(i int, parents ...plumbing.Hash) plumbing.Hash {
+		t.Helper()
+
+		name := filepath.Join(dir, "file.txt")
+		if err := ioutil.WriteFile(name, []byte{byte(i)}, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Add("file.txt"); err != nil {
+			t.Fatal(err)
+		}
+
+		sig := &object.Signature{
+			Name:  "Gopher",
+			Email: "gopher@golang.org",
+			When:  base.Add(time.Duration(i) * time.Hour),
+		}
+		h, err := w.Commit("commit", &git.CommitOptions{
+			Author:  sig,
+			Parents: parents,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+
+	c1 := commit(1)
+	c2 := commit(2, c1)
+	s1 := commit(3, c1)
+	m := commit(4, c2, s1)
+
+	if _, err := r.CreateTag("v1.0.0", c2, &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "Gopher", Email: "gopher@golang.org", When: base},
+		Message: "release",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	return &testrepo{
+		Dir:   dir,
+		C1:    c1.String(),
+		C2:    c2.String(),
+		S1:    s1.String(),
+		Merge: m.String(),
+	}
+}
+
+// testgit builds a test repository, returning its directory and the sha of the
+// master branch.
+func testgit(t *testing.T) (string, string) {
+	tr := newtestrepo(t)
+	return tr.Dir, tr.Merge
+}
+
+func TestGitRevision(t *testing.T) {
+	tr := newtestrepo(t)
+
+	g, err := Open(tr.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.AssertClose(t, g)
+
+	cases := []struct {
+		Ref    string
+		Expect string
+	}{
+		{"master", tr.Merge},
+		{"HEAD", tr.Merge},
+		{tr.S1, tr.S1},
+		{"v1.0.0", tr.C2},
+	}
+	for _, c := range cases {
+		commit, err := g.Revision(context.Background(), c.Ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if commit.SHA != c.Expect {
+			t.Errorf("revision %q: got %s; expect %s", c.Ref, commit.SHA, c.Expect)
+		}
+	}
+
+	if _, err := g.Revision(context.Background(), "unknown"); err == nil {
+		t.Fatal("expected error for unknown revision")
+	}
+}
+
+func TestGitLog(t *testing.T) {
+	tr := newtestrepo(t)
+
+	cases := []struct {
+		Name   string
+		Opts   []GitOption
+		Ref    string
+		Expect []string
+	}{
+		{
+			Name:   "all",
+			Ref:    "master",
+			Expect: []string{tr.Merge, tr.C2, tr.C1, tr.S1},
+		},
+		{
+			Name:   "first_parent",
+			Opts:   []GitOption{WithFirstParent()},
+			Ref:    "master",
+			Expect: []string{tr.Merge, tr.C2, tr.C1},
+		},
+		{
+			Name:   "page_size",
+			Opts:   []GitOption{WithFirstParent(), WithLogPageSize(2)},
+			Ref:    "master",
+			Expect: []string{tr.Merge, tr.C2},
+		},
+		{
+			Name:   "tag",
+			Ref:    "v1.0.0",
+			Expect: []string{tr.C2, tr.C1},
+		},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			g, err := Open(tr.Dir, c.Opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer test.AssertClose(t, g)
+
+			commits, err := g.Log(context.Background(), c.Ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, commit := range commits {
+				got = append(got, commit.SHA)
+			}
+
+			if len(got) != len(c.Expect) {
+				t.Fatalf("got %d commits; expect %d", len(got), len(c.Expect))
+			}
+			for i := range got {
+				if got[i] != c.Expect[i] {
+					t.Errorf("commit %d: got %s; expect %s", i, got[i], c.Expect[i])
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -68,6 +69,28 @@ func (d dbrevisions) Revision(ctx context.Context, ref string) (*entity.Commit,
 	return d.d.FindCommitBySHA(ctx, ref)
 }
 
+type compositerevisions []Revisions
+
+// NewCompositeRevisions builds a Revisions fetcher backed by one or more
+// Revisions implementations, returning the first successful result. Panics if
+// none are provided.
+func NewCompositeRevisions(rs ...Revisions) Revisions {
+	if len(rs) == 0 {
+		panic("no revisions provided")
+	}
+	return compositerevisions(rs)
+}
+
+func (c compositerevisions) Revision(ctx context.Context, ref string) (commit *entity.Commit, err error) {
+	for _, r := range c {
+		commit, err = r.Revision(ctx, ref)
+		if err == nil {
+			return
+		}
+	}
+	return
+}
+
 // Repository provides access to git repository properties.
 type Repository interface {
 	Revisions
@@ -115,6 +138,39 @@ func Go(c *http.Client) Repository {
 	return NewCompositeRepository(canonical, fallback)
 }
 
+// New builds a Repository implementation for the git repository at the given
+// URL. Supports the Go repository, Github and Gitiles hosts, and local
+// repositories with file URLs. API calls are made with the provided HTTP
+// client. Local repository logs follow first parents only, consistent with the
+// linear history of the hosted implementations.
+func New(c *http.Client, rawurl string) (Repository, error) {
+	if rawurl == GoURL {
+		return Go(c), nil
+	}
+
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	switch {
+	case u.Scheme == "file":
+		return Open(u.Path, WithFirstParent())
+	case u.Host == "github.com":
+		parts := strings.Split(path, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected github url of the form https://github.com/owner/repo: got %q", rawurl)
+		}
+		return NewGithub(github.NewClient(c), parts[0], parts[1]), nil
+	case strings.HasSuffix(u.Host, ".googlesource.com"):
+		gitilesclient := gitiles.NewClient(c, u.Scheme+"://"+u.Host)
+		return NewGitiles(gitilesclient, path), nil
+	default:
+		return nil, fmt.Errorf("unsupported repository host %q", u.Host)
+	}
+}
+
 type gitilesrepo struct {
 	client *gitiles.Client
 	repo   string
@@ -88,3 +88,91 @@ func TestRepositoryImplementationsSameRevisions(t *testing.T) {
 		}
 	}
 }
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		URL    string
+		Expect Repository
+	}{
+		{
+			URL:    "https://github.com/mmcloughlin/avo",
+			Expect: &githubrepo{owner: "mmcloughlin", repo: "avo"},
+		},
+		{
+			URL:    "https://github.com/mmcloughlin/avo.git",
+			Expect: &githubrepo{owner: "mmcloughlin", repo: "avo"},
+		},
+		{
+			URL:    "https://go.googlesource.com/crypto",
+			Expect: &gitilesrepo{repo: "crypto"},
+		},
+	}
+	for _, c := range cases {
+		r, err := New(http.DefaultClient, c.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch got := r.(type) {
+		case *githubrepo:
+			got.client = nil
+		case *gitilesrepo:
+			got.client = nil
+		}
+		if diff := cmp.Diff(c.Expect, r, cmp.AllowUnexported(githubrepo{}, gitilesrepo{})); diff != "" {
+			t.Errorf("%s: mismatch\n%s", c.URL, diff)
+		}
+	}
+}
+
+func TestNewFile(t *testing.T) {
+	dir, sha := testgit(t)
+
+	r, err := New(http.DefaultClient, "file://"+dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := r.Revision(context.Background(), "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.SHA != sha {
+		t.Fatalf("got sha %s; expect %s", c.SHA, sha)
+	}
+}
+
+func TestNewFileLogFirstParent(t *testing.T) {
+	tr := newtestrepo(t)
+
+	r, err := New(http.DefaultClient, "file://"+tr.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commits, err := r.Log(context.Background(), "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{tr.Merge, tr.C2, tr.C1}
+	if len(commits) != len(expect) {
+		t.Fatalf("got %d commits; expect %d", len(commits), len(expect))
+	}
+	for i, c := range commits {
+		if c.SHA != expect[i] {
+			t.Errorf("commit %d: got %s; expect %s", i, c.SHA, expect[i])
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, u := range []string{
+		"https://github.com/mmcloughlin",
+		"https://gitlab.com/mmcloughlin/avo",
+	} {
+		if _, err := New(http.DefaultClient, u); err == nil {
+			t.Errorf("%s: expected error", u)
+		}
+	}
+}
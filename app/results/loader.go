@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/mmcloughlin/goperf/app/entity"
@@ -23,14 +24,20 @@ import (
 // loading.
 type Keys struct {
 	ToolchainRef string // git ref for the go toolchain version under test
+	CommitRef    string // optional git ref for the repository commit under test
 	Module       string // go module under test
 	Package      string // package under test
+
+	// Throttled is an optional boolean key reporting whether the system
+	// throttled during the run. It is retained in result metadata.
+	Throttled string
 }
 
-// All returns all special keys.
+// All returns all special keys removed from result properties.
 func (k Keys) All() []string {
 	return []string{
 		k.ToolchainRef,
+		k.CommitRef,
 		k.Module,
 		k.Package,
 	}
@@ -40,8 +47,10 @@ func (k Keys) All() []string {
 // parameters.
 var DefaultKeys = Keys{
 	ToolchainRef: "toolchain-ref",
+	CommitRef:    "task-commitref",
 	Module:       "suite-mod",
 	Package:      "pkg",
+	Throttled:    "telemetry-throttled",
 }
 
 // Loader loads benchmark result files and associated data.
@@ -179,8 +188,23 @@ func (l *Loader) convert(ctx context.Context, r *parse.Result) (*entity.Result,
 	}, nil
 }
 
-// commit looks up the commit associated with the given result.
+// Throttled reports whether the system throttled during the run that produced
+// the result.
+func (l *Loader) Throttled(r *entity.Result) bool {
+	if l.keys.Throttled == "" {
+		return false
+	}
+	throttled, err := strconv.ParseBool(r.Metadata[l.keys.Throttled])
+	return err == nil && throttled
+}
+
+// commit looks up the commit associated with the given result. This is the
+// repository commit under test if present, otherwise the toolchain commit.
 func (l *Loader) commit(ctx context.Context, r *parse.Result) (*entity.Commit, error) {
+	if ref, ok := r.Labels[l.keys.CommitRef]; ok {
+		return l.rev.Revision(ctx, ref)
+	}
+
 	ref, err := lookup(r.Labels, l.keys.ToolchainRef)
 	if err != nil {
 		return nil, err
@@ -203,10 +227,15 @@ func (l *Loader) benchmark(ctx context.Context, r *parse.Result) (*entity.Benchm
 		return nil, err
 	}
 
-	// Resolve canonical version.
-	v, err := l.version(ctx, m.Path, m.Version)
-	if err != nil {
-		return nil, err
+	// Resolve canonical version. Results for a repository commit are
+	// attributed to the commit itself, so the module version is dropped to keep
+	// benchmarks comparable across commits.
+	v := ""
+	if _, ok := r.Labels[l.keys.CommitRef]; !ok {
+		v, err = l.version(ctx, m.Path, m.Version)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Extract package path relative to module.
@@ -120,6 +120,79 @@ func TestLoader(t *testing.T) {
 	}
 }
 
+func TestLoaderCommitRef(t *testing.T) {
+	keys := results.Keys{
+		ToolchainRef: "ref",
+		CommitRef:    "commitref",
+		Module:       "mod",
+		Package:      "pkg",
+	}
+
+	c := cfg.Configuration{
+		cfg.KeyValue(cfg.Key(keys.ToolchainRef), cfg.StringValue("go1.23.4")),
+		cfg.KeyValue(cfg.Key(keys.CommitRef), cfg.StringValue(fixture.ModuleSHA)),
+		cfg.KeyValue(cfg.Key(keys.Module), module.Version{Path: fixture.Module.Path, Version: fixture.ModuleSHA}),
+		cfg.KeyValue(cfg.Key(keys.Package), cfg.StringValue(fixture.Package.ImportPath())),
+	}
+
+	// Write a datafile to an in-memory filesystem.
+	ctx := context.Background()
+	m := fs.NewMem()
+	w, err := m.Create(ctx, fixture.DataFile.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cfg.Write(w, c); err != nil {
+		t.Fatal(err)
+	}
+
+	r := fixture.Result
+	_, err = fmt.Fprintln(w, r.Benchmark.FullName, r.Iterations, r.Value, r.Benchmark.Unit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Construct loader. Module information is unavailable, since the version
+	// should not be resolved for repository commits.
+	loader, err := results.NewLoader(
+		results.WithFilesystem(m),
+		results.WithRevisions(&SingleRevision{Ref: fixture.ModuleSHA, Commit: fixture.Commit}),
+		results.WithModuleInfo(&SingleModule{Mod: "unknown"}),
+		results.WithKeys(keys),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Load results.
+	rs, err := loader.Load(ctx, fixture.DataFile.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rs) != 1 {
+		t.Fatalf("got %d results; expect one", len(rs))
+	}
+	r = rs[0]
+
+	if r.Commit != fixture.Commit {
+		t.Errorf("got commit %s; expect %s", r.Commit.SHA, fixture.Commit.SHA)
+	}
+
+	if v := r.Benchmark.Package.Module.Version; v != "" {
+		t.Errorf("got module version %q; expect empty", v)
+	}
+
+	if _, ok := r.Metadata[keys.CommitRef]; ok {
+		t.Errorf("commit ref key should be removed from metadata")
+	}
+}
+
 func TestLoadSHA256Hash(t *testing.T) {
 	// This test purely checks that the loader correctly computes the SHA-256 hash of the file.
 	//
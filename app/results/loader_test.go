@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/mmcloughlin/goperf/app/entity"
 	"github.com/mmcloughlin/goperf/internal/test"
 	"github.com/mmcloughlin/goperf/pkg/fs"
 )
@@ -82,3 +83,26 @@ func TestRelErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestLoaderThrottled(t *testing.T) {
+	l, err := NewLoader(WithFilesystem(fs.NewMem()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		Metadata entity.Properties
+		Expect   bool
+	}{
+		{Metadata: entity.Properties{}, Expect: false},
+		{Metadata: entity.Properties{"telemetry-throttled": "false"}, Expect: false},
+		{Metadata: entity.Properties{"telemetry-throttled": "true"}, Expect: true},
+		{Metadata: entity.Properties{"telemetry-throttled": "garbage"}, Expect: false},
+	}
+	for _, c := range cases {
+		r := &entity.Result{Metadata: c.Metadata}
+		if got := l.Throttled(r); got != c.Expect {
+			t.Errorf("Throttled(%v) = %v; expect %v", c.Metadata, got, c.Expect)
+		}
+	}
+}
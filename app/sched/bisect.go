@@ -0,0 +1,60 @@
+package sched
+
+import (
+	"context"
+
+	"github.com/mmcloughlin/goperf/app/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+)
+
+type bisect struct {
+	db  *db.DB
+	n   int
+	pri float64
+}
+
+// NewBisect builds a scheduler that narrows down changes in the n most recent
+// commits. Change detection only sees commits with results, so a change may be
+// attributed to a commit when the culprit is any commit since the previous
+// result. The scheduler proposes tasks at the midpoint of such gaps, with
+// priority pri, so that repeated detection and bisection converges on a single
+// commit. Workers are only asked to bisect changes in traces they produce
+// results for, since results in other environments would not narrow down the
+// change.
+func NewBisect(d *db.DB, n int, pri float64) Scheduler {
+	return &bisect{
+		db:  d,
+		n:   n,
+		pri: pri,
+	}
+}
+
+func (b *bisect) Tasks(ctx context.Context, req *Request) ([]*Task, error) {
+	idx, err := b.db.MostRecentCommitIndex(ctx, entity.GoRepository.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	cms, err := b.db.ListChangeBisectionCommitModules(ctx, req.Worker, idx-b.n+1, req.Num)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multiple changes may propose the same commit module pair.
+	var tasks []*Task
+	seen := map[entity.TaskSpec]bool{}
+	for _, cm := range cms {
+		spec := entity.TaskSpec{
+			CommitSHA:  cm.CommitSHA,
+			Type:       entity.TaskTypeModule,
+			TargetUUID: cm.ModuleUUID,
+		}
+		if seen[spec] {
+			continue
+		}
+		seen[spec] = true
+		tasks = append(tasks, NewTask(b.pri, spec))
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,113 @@
+package sched
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/app/db/dbtest"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/app/internal/fixture"
+)
+
+func TestBisect(t *testing.T) {
+	d := dbtest.Open(t)
+	ctx := context.Background()
+
+	// Three consecutive commits, ending with the fixture commit.
+	commits := make([]*entity.Commit, 3)
+	for i := range commits {
+		c := *fixture.Commit
+		commits[i] = &c
+	}
+	commits[0].SHA = "1111111111111111111111111111111111111111"
+	commits[1].SHA = "2222222222222222222222222222222222222222"
+	commits[2] = fixture.Commit
+
+	for i, c := range commits {
+		pos := &entity.CommitPosition{
+			SHA:        c.SHA,
+			CommitTime: c.CommitTime,
+			Index:      fixture.CommitPosition.Index - len(commits) + 1 + i,
+		}
+		if err := d.StoreCommit(ctx, entity.GoRepository.UUID(), c); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.StoreCommitPosition(ctx, entity.GoRepository.UUID(), pos); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The worker uploads results for the first and last commits, leaving a gap
+	// at the middle commit.
+	prev := *fixture.Result
+	prev.Commit = commits[0]
+	prev.Line++
+	if err := d.StoreResults(ctx, []*entity.Result{&prev, fixture.Result}); err != nil {
+		t.Fatal(err)
+	}
+
+	task, err := d.CreateTask(ctx, fixture.Worker, fixture.TaskSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.TransitionTaskStatus(ctx, task.UUID, []entity.TaskStatus{entity.TaskStatusCreated}, entity.TaskStatusResultUploadStarted); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.RecordTaskDataUpload(ctx, task.UUID, fixture.DataFile); err != nil {
+		t.Fatal(err)
+	}
+
+	// Change detected at the last commit.
+	if err := d.StoreChangesBatch(ctx, []*entity.Change{fixture.Change}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewBisect(d, 16, 1)
+
+	// Expect the worker to be asked to bisect the gap.
+	tasks, err := s.Tasks(ctx, &Request{Worker: fixture.Worker, Num: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 {
+		t.Fatalf("got %d tasks; expect 1", len(tasks))
+	}
+	expect := entity.TaskSpec{
+		CommitSHA:  commits[1].SHA,
+		Type:       entity.TaskTypeModule,
+		TargetUUID: fixture.Module.UUID(),
+	}
+	if tasks[0].Spec != expect {
+		t.Fatalf("got task spec %v; expect %v", tasks[0].Spec, expect)
+	}
+
+	// Another worker has no results in the trace, so its results would not
+	// narrow down the change.
+	tasks, err = s.Tasks(ctx, &Request{Worker: "other", Num: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 0 {
+		t.Fatalf("got %d tasks for worker in another environment; expect 0", len(tasks))
+	}
+}
+
+func TestBisectNoChanges(t *testing.T) {
+	d := dbtest.Open(t)
+	ctx := context.Background()
+
+	if err := d.StoreCommit(ctx, entity.GoRepository.UUID(), fixture.Commit); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.StoreCommitPosition(ctx, entity.GoRepository.UUID(), fixture.CommitPosition); err != nil {
+		t.Fatal(err)
+	}
+
+	tasks, err := NewBisect(d, 16, 1).Tasks(ctx, &Request{Worker: fixture.Worker, Num: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 0 {
+		t.Fatalf("got %d tasks; expect none", len(tasks))
+	}
+}
@@ -15,11 +15,23 @@ func NewDefault(d *db.DB) Scheduler {
 	)
 	recent := NewRecentCommits(d, pri)
 
+	// Recent commits in other repositories.
+	repositories := NewRecentRepositoryCommits(d, pri)
+
 	// Retries.
 	retries := NewRetry(d, 5, time.Hour)
 
+	// Bisect changes in recent commits.
+	bisect := NewBisect(d, 512, PriorityHighest)
+
+	// Confirm changes in recent commits.
+	confirm := NewConfirm(d, 512, PriorityHigh)
+
 	return CompositeScheduler(
 		recent,
+		repositories,
 		retries,
+		bisect,
+		confirm,
 	)
 }
@@ -0,0 +1,57 @@
+package sched
+
+import (
+	"context"
+
+	"github.com/mmcloughlin/goperf/app/db"
+	"github.com/mmcloughlin/goperf/app/entity"
+)
+
+type confirm struct {
+	db  *db.DB
+	n   int
+	pri float64
+}
+
+// NewConfirm builds a scheduler that proposes repeat runs either side of
+// unconfirmed changes in the n most recent commits, with priority pri. Repeat
+// runs are only proposed to workers other than the one that produced the
+// original results, so that a spurious change from one noisy run can be
+// refuted.
+func NewConfirm(d *db.DB, n int, pri float64) Scheduler {
+	return &confirm{
+		db:  d,
+		n:   n,
+		pri: pri,
+	}
+}
+
+func (c *confirm) Tasks(ctx context.Context, req *Request) ([]*Task, error) {
+	idx, err := c.db.MostRecentCommitIndex(ctx, entity.GoRepository.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	cms, err := c.db.ListChangeConfirmationCommitModules(ctx, req.Worker, idx-c.n+1, req.Num)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multiple changes may propose the same commit module pair.
+	var tasks []*Task
+	seen := map[entity.TaskSpec]bool{}
+	for _, cm := range cms {
+		spec := entity.TaskSpec{
+			CommitSHA:  cm.CommitSHA,
+			Type:       entity.TaskTypeModule,
+			TargetUUID: cm.ModuleUUID,
+		}
+		if seen[spec] {
+			continue
+		}
+		seen[spec] = true
+		tasks = append(tasks, NewTask(c.pri, spec))
+	}
+
+	return tasks, nil
+}
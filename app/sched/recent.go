@@ -44,6 +44,43 @@ func (r *recent) Tasks(ctx context.Context, req *Request) ([]*Task, error) {
 	return tasks, nil
 }
 
+type repositories struct {
+	db  *db.DB
+	pri TimePriority
+}
+
+// NewRecentRepositoryCommits builds a scheduler that proposes tasks for recent
+// commits in repositories with a pinned toolchain, that have no completed tasks
+// in the database. Priority is computed with the commit time and supplied
+// priority function.
+func NewRecentRepositoryCommits(d *db.DB, pri TimePriority) Scheduler {
+	return &repositories{
+		db:  d,
+		pri: pri,
+	}
+}
+
+func (r *repositories) Tasks(ctx context.Context, req *Request) ([]*Task, error) {
+	rcs, err := r.db.ListRepositoryCommitsWithoutCompleteTasks(ctx, req.Worker, req.Num)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*Task, len(rcs))
+	for i, rc := range rcs {
+		tasks[i] = &Task{
+			Priority: r.pri(rc.CommitTime),
+			Spec: entity.TaskSpec{
+				CommitSHA:  rc.CommitSHA,
+				Type:       entity.TaskTypeRepository,
+				TargetUUID: rc.RepositoryUUID,
+			},
+		}
+	}
+
+	return tasks, nil
+}
+
 // TimePriority is a method of determining priority based on a time.
 type TimePriority func(time.Time) float64
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"github.com/blendle/zapdriver"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/mmcloughlin/goperf/app/db"
 	"github.com/mmcloughlin/goperf/app/gcs"
+	"github.com/mmcloughlin/goperf/app/notify"
 	"github.com/mmcloughlin/goperf/pkg/fs"
 )
 
@@ -77,6 +79,39 @@ func DB(ctx context.Context, l *zap.Logger) (*db.DB, error) {
 	return d, nil
 }
 
+// Notifier builds a notifier from configuration stored in secret manager. If no
+// configuration secret is specified, returns a notifier with no subscriptions.
+func Notifier(ctx context.Context, l *zap.Logger) (*notify.Notifier, error) {
+	name, err := env("NOTIFY_CONFIG_SECRET_NAME")
+	if err != nil {
+		l.Info("notification config not specified")
+		return notify.New(), nil
+	}
+
+	data, err := secret(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := notify.LoadConfig(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("load notification config: %w", err)
+	}
+
+	return cfg.Notifier()
+}
+
+// AdminPassword returns the dashboard admin password stored in secret manager,
+// or the empty string if no password secret is specified.
+func AdminPassword(ctx context.Context, l *zap.Logger) (string, error) {
+	name, err := env("ADMIN_PASSWORD_SECRET_NAME")
+	if err != nil {
+		l.Info("admin password not specified")
+		return "", nil
+	}
+	return secret(ctx, name)
+}
+
 func secret(ctx context.Context, name string) (string, error) {
 	// Secrets client.
 	client, err := secretmanager.NewClient(ctx)
@@ -3,6 +3,7 @@ package trace
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/google/uuid"
@@ -18,10 +19,20 @@ func (i ID) String() string {
 	return fmt.Sprintf("%s/%s", i.BenchmarkUUID, i.EnvironmentUUID)
 }
 
-// IndexedValue is a measured value at some commit index.
+// IndexedValue is a measured value at some commit index. Values aggregated
+// from repeated measurements at the same commit index record the number of
+// measurements N and their sample variance.
 type IndexedValue struct {
 	CommitIndex int     `json:"i"`
 	Value       float64 `json:"v"`
+	N           int     `json:"n,omitempty"`
+	Variance    float64 `json:"var,omitempty"`
+}
+
+// Stddev returns the sample standard deviation of the measurements aggregated
+// into the value.
+func (v IndexedValue) Stddev() float64 {
+	return math.Sqrt(v.Variance)
 }
 
 // Series is a series of (commit index, value) pairs in sorted order.
@@ -48,36 +59,32 @@ type Trace struct {
 }
 
 // Traces gathers points into distinct traces. Values for the same trace ID and
-// commit index are averaged.
+// commit index are averaged, and their within-commit variance recorded.
 func Traces(ps []Point) map[ID]*Trace {
 	// Gather by (ID, index).
 	type key struct {
 		ID
 		CommitIndex int
 	}
-	type value struct {
-		sum float64
-		n   int
-	}
-	agg := map[key]value{}
+	agg := map[key][]float64{}
 	for _, p := range ps {
 		k := key{ID: p.ID, CommitIndex: p.CommitIndex}
-		v := agg[k]
-		v.sum += p.Value
-		v.n++
-		agg[k] = v
+		agg[k] = append(agg[k], p.Value)
 	}
 
 	// Rebuild traces.
 	traces := map[ID]*Trace{}
-	for k, v := range agg {
+	for k, xs := range agg {
 		if _, ok := traces[k.ID]; !ok {
 			traces[k.ID] = &Trace{ID: k.ID}
 		}
 		t := traces[k.ID]
+		mean, variance := meanvariance(xs)
 		t.Series = append(t.Series, IndexedValue{
 			CommitIndex: k.CommitIndex,
-			Value:       v.sum / float64(v.n),
+			Value:       mean,
+			N:           len(xs),
+			Variance:    variance,
 		})
 	}
 
@@ -91,3 +98,25 @@ func Traces(ps []Point) map[ID]*Trace {
 
 	return traces
 }
+
+// meanvariance returns the mean and sample variance of xs. The variance of a
+// single value is defined to be zero.
+func meanvariance(xs []float64) (float64, float64) {
+	n := float64(len(xs))
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	mean := sum / n
+
+	if len(xs) < 2 {
+		return mean, 0
+	}
+
+	var ss float64
+	for _, x := range xs {
+		d := x - mean
+		ss += d * d
+	}
+	return mean, ss / (n - 1)
+}
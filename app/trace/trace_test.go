@@ -0,0 +1,38 @@
+package trace
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestTracesWithinCommitVariance(t *testing.T) {
+	id := ID{
+		BenchmarkUUID:   uuid.MustParse("8c5b3b8e-6c2a-4b4e-9d0f-3f8a7c1e2d4b"),
+		EnvironmentUUID: uuid.MustParse("1f0e9d8c-7b6a-4594-8372-615049382716"),
+	}
+	ps := []Point{
+		{ID: id, IndexedValue: IndexedValue{CommitIndex: 2, Value: 5}},
+		{ID: id, IndexedValue: IndexedValue{CommitIndex: 1, Value: 2}},
+		{ID: id, IndexedValue: IndexedValue{CommitIndex: 1, Value: 4}},
+		{ID: id, IndexedValue: IndexedValue{CommitIndex: 1, Value: 6}},
+	}
+
+	traces := Traces(ps)
+
+	if len(traces) != 1 {
+		t.Fatalf("got %d traces; expect 1", len(traces))
+	}
+
+	expect := Series{
+		{CommitIndex: 1, Value: 4, N: 3, Variance: 4},
+		{CommitIndex: 2, Value: 5, N: 1, Variance: 0},
+	}
+	if diff := cmp.Diff(expect, traces[id].Series); diff != "" {
+		t.Errorf("mismatch\n%s", diff)
+	}
+	if sd := traces[id].Series[0].Stddev(); sd != 2 {
+		t.Errorf("stddev = %v; expect 2", sd)
+	}
+}
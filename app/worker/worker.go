@@ -3,18 +3,38 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"runtime"
+	"runtime/debug"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/mmcloughlin/goperf/app/coordinator"
+	"github.com/mmcloughlin/goperf/app/entity"
+	"github.com/mmcloughlin/goperf/pkg/runner"
 )
 
-// Processor executes jobs, returning the output file.
+// Processor executes jobs, returning the output file. Jobs are identified with
+// the worker slot they run in; processors must support concurrent jobs in
+// different slots. The context is cancelled if the job is stopped by the
+// coordinator, in which case processing should return promptly. Errors
+// wrapping runner.ErrNoisy are reported to the coordinator as a noisy system
+// rather than a job failure.
 type Processor interface {
-	Process(context.Context, *coordinator.Job) (io.ReadCloser, error)
+	Process(ctx context.Context, slot int, j *coordinator.Job) (io.ReadCloser, error)
+}
+
+// TelemetryProcessor is implemented by processors that record system
+// telemetry while processing jobs.
+type TelemetryProcessor interface {
+	// Telemetry opens the telemetry recorded while processing the job. Returns
+	// nil if there is none.
+	Telemetry(ctx context.Context, j *coordinator.Job) (io.ReadCloser, error)
 }
 
 type PollingConfig struct {
@@ -37,13 +57,33 @@ var DefaultPollingConfig = PollingConfig{
 	Max:        time.Minute,
 }
 
+// DefaultHeartbeatInterval is the default interval between heartbeats sent to
+// the coordinator.
+const DefaultHeartbeatInterval = time.Minute
+
+// DefaultStatusInterval is the default interval between job status checks
+// while a job is in progress.
+const DefaultStatusInterval = 30 * time.Second
+
 type Worker struct {
 	client    *coordinator.Client
 	processor Processor
 	poll      PollingConfig
+	heartbeat time.Duration
+	status    time.Duration
+	slots     int
 	log       *zap.Logger
 
+	// Jobs are fetched one request at a time and shared between slots. The
+	// coordinator assigns work based on the tasks already pending for the
+	// worker, so concurrent requests could be handed the same task.
+	mu    sync.Mutex
 	queue []*coordinator.Job
+
+	// Jobs in progress in any slot, reported with each heartbeat so the
+	// coordinator does not time them out while they run.
+	activemu sync.Mutex
+	active   map[uuid.UUID]bool
 }
 
 type Option func(*Worker)
@@ -53,7 +93,11 @@ func New(c *coordinator.Client, p Processor, opts ...Option) *Worker {
 		client:    c,
 		processor: p,
 		poll:      DefaultPollingConfig,
+		heartbeat: DefaultHeartbeatInterval,
+		status:    DefaultStatusInterval,
+		slots:     1,
 		log:       zap.NewNop(),
+		active:    map[uuid.UUID]bool{},
 	}
 	for _, opt := range opts {
 		opt(w)
@@ -65,48 +109,106 @@ func WithPollingConfig(poll PollingConfig) Option {
 	return func(w *Worker) { w.poll = poll }
 }
 
+// WithHeartbeatInterval configures how often the worker sends heartbeats to the
+// coordinator.
+func WithHeartbeatInterval(d time.Duration) Option {
+	return func(w *Worker) { w.heartbeat = d }
+}
+
+// WithStatusInterval configures how often the worker checks with the
+// coordinator whether an in-progress job has been stopped.
+func WithStatusInterval(d time.Duration) Option {
+	return func(w *Worker) { w.status = d }
+}
+
+// WithSlots configures the worker to process up to n jobs concurrently.
+func WithSlots(n int) Option {
+	return func(w *Worker) { w.slots = n }
+}
+
 func WithLogger(l *zap.Logger) Option {
 	return func(w *Worker) { w.log = l.Named("worker") }
 }
 
 func (w *Worker) Run(ctx context.Context) error {
-	w.log.Info("starting worker loop")
+	w.log.Info("starting worker loop", zap.Int("slots", w.slots))
+
+	// Send heartbeats for the lifetime of the worker, so the coordinator knows
+	// in-progress jobs are still alive.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go w.heartbeats(ctx)
+
+	// Process jobs in each slot concurrently. Slots only return on an
+	// unrecoverable error, which stops the whole worker.
+	errc := make(chan error, w.slots)
+	for i := 0; i < w.slots; i++ {
+		s := &slot{
+			Worker: w,
+			id:     i,
+			log:    w.log.With(zap.Int("slot", i)),
+		}
+		go func() { errc <- s.run(ctx) }()
+	}
+
+	err := <-errc
+	cancel()
+	for i := 1; i < w.slots; i++ {
+		<-errc
+	}
+
+	return err
+}
 
+// slot processes jobs one at a time.
+type slot struct {
+	*Worker
+
+	id  int
+	log *zap.Logger
+}
+
+func (s *slot) run(ctx context.Context) error {
 	for {
 		// Fetch next job. THe next function polls indefinitely for work, so an
 		// error here means the context was cancelled or something else
 		// unrecoverable happened. Bail out.
-		j, err := w.next(ctx)
+		j, err := s.next(ctx)
 		if err != nil {
 			return err
 		}
 
 		// Process the job. Errors are simply logged and we move onto the next
 		// one. The process function will report status to coordinator.
-		if err := w.process(ctx, j); err != nil {
-			w.log.Error("job processing error", zap.Error(err))
+		if err := s.process(ctx, j); err != nil {
+			s.log.Error("job processing error", zap.Error(err))
 		}
 	}
 }
 
-// next polls indefinitely for more work.
-func (w *Worker) next(ctx context.Context) (*coordinator.Job, error) {
-	interval := w.poll.Initial
+// next polls indefinitely for more work. Only one slot polls the coordinator
+// at a time; others wait for it to finish and take from the shared queue.
+func (s *slot) next(ctx context.Context) (*coordinator.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	for len(w.queue) == 0 {
-		w.log.Debug("fetch jobs")
+	interval := s.poll.Initial
 
-		res, err := w.client.Jobs(ctx)
+	for len(s.queue) == 0 {
+		s.log.Debug("fetch jobs")
+
+		res, err := s.client.Jobs(ctx)
 		if err == nil && len(res.Jobs) > 0 {
-			w.queue = append(w.queue, res.Jobs...)
+			s.queue = append(s.queue, res.Jobs...)
 			break
 		}
 		if err != nil {
-			w.log.Error("jobs request error", zap.Error(err))
+			s.log.Error("jobs request error", zap.Error(err))
 		}
 
 		// Sleep before polling again.
-		w.log.Debug("wait", zap.Duration("interval", interval))
+		s.log.Debug("wait", zap.Duration("interval", interval))
 
 		select {
 		case <-time.After(interval):
@@ -114,47 +216,189 @@ func (w *Worker) next(ctx context.Context) (*coordinator.Job, error) {
 			return nil, ctx.Err()
 		}
 
-		interval = w.poll.Next(interval)
+		interval = s.poll.Next(interval)
 	}
 
-	j := w.queue[0]
-	w.queue = w.queue[1:]
+	j := s.queue[0]
+	s.queue = s.queue[1:]
 	return j, nil
 }
 
-func (w *Worker) process(ctx context.Context, j *coordinator.Job) (err error) {
+func (s *slot) process(ctx context.Context, j *coordinator.Job) (err error) {
 	// Record start of work.
-	if err := w.client.Start(ctx, j.UUID); err != nil {
-		w.halt(ctx, j)
+	if err := s.client.Start(ctx, j.UUID); err != nil {
+		s.halt(ctx, j)
 		return fmt.Errorf("report job start: %w", err)
 	}
 
-	// Delegate to worker processor.
-	r, err := w.processor.Process(ctx, j)
+	s.begin(j.UUID)
+	defer s.end(j.UUID)
+
+	// Delegate to worker processor. Processing is cancelled if the coordinator
+	// reports the job has reached a terminal state, for example if it was
+	// marked stale.
+	jobctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stopped := make(chan entity.TaskStatus, 1)
+	go s.watch(jobctx, cancel, j, stopped)
+
+	r, err := s.processor.Process(jobctx, s.id, j)
+	cancel()
 	if err != nil {
-		w.fail(ctx, j)
+		select {
+		case status := <-stopped:
+			return fmt.Errorf("job stopped by coordinator with status %s: %w", status, err)
+		default:
+		}
+		if errors.Is(err, runner.ErrNoisy) {
+			s.noisy(ctx, j)
+		} else {
+			s.fail(ctx, j)
+		}
 		return fmt.Errorf("process job: %w", err)
 	}
 
+	// Upload telemetry ahead of the result, so the result can reference it.
+	// Telemetry is not critical, so failures are only logged.
+	if err := s.telemetry(ctx, j); err != nil {
+		s.log.Warn("telemetry upload error", zap.Stringer("uuid", j.UUID), zap.Error(err))
+	}
+
 	// Upload. Note the upload will close the reader.
-	if err := w.client.UploadResult(ctx, j.UUID, r); err != nil {
-		w.halt(ctx, j)
+	if err := s.client.UploadResult(ctx, j.UUID, r); err != nil {
+		s.halt(ctx, j)
 		return fmt.Errorf("upload result: %w", err)
 	}
 
 	return nil
 }
 
-func (w *Worker) fail(ctx context.Context, j *coordinator.Job) {
-	w.log.Info("reporting job failure", zap.Stringer("uuid", j.UUID))
-	if err := w.client.Fail(ctx, j.UUID); err != nil {
-		w.log.Error("error reporting job failure", zap.Error(err))
+// telemetry uploads the telemetry recorded for j, if the processor supports it.
+func (s *slot) telemetry(ctx context.Context, j *coordinator.Job) error {
+	p, ok := s.processor.(TelemetryProcessor)
+	if !ok {
+		return nil
+	}
+
+	r, err := p.Telemetry(ctx, j)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return nil
+	}
+
+	// Note the upload will close the reader.
+	return s.client.UploadTelemetry(ctx, j.UUID, r)
+}
+
+// heartbeats reports liveness to the coordinator periodically until ctx is done.
+func (w *Worker) heartbeats(ctx context.Context) {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	version := buildversion()
+
+	ticker := time.NewTicker(w.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		w.log.Debug("heartbeat")
+		if err := w.client.Heartbeat(ctx, platform, version, w.jobs()); err != nil && ctx.Err() == nil {
+			w.log.Error("heartbeat error", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// begin records that job id is in progress.
+func (w *Worker) begin(id uuid.UUID) {
+	w.activemu.Lock()
+	defer w.activemu.Unlock()
+	w.active[id] = true
+}
+
+// end records that job id is no longer in progress.
+func (w *Worker) end(id uuid.UUID) {
+	w.activemu.Lock()
+	defer w.activemu.Unlock()
+	delete(w.active, id)
+}
+
+// jobs returns the jobs in progress.
+func (w *Worker) jobs() []uuid.UUID {
+	w.activemu.Lock()
+	defer w.activemu.Unlock()
+	var ids []uuid.UUID
+	for id := range w.active {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// buildversion returns the module version of the worker binary, if known.
+func buildversion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "" {
+		return "unknown"
+	}
+	return info.Main.Version
+}
+
+// watch polls the coordinator for the status of job j until ctx is done. If the
+// job reaches a terminal state its status is sent on stopped and the job is
+// cancelled.
+func (s *slot) watch(ctx context.Context, cancel context.CancelFunc, j *coordinator.Job, stopped chan<- entity.TaskStatus) {
+	log := s.log.With(zap.Stringer("uuid", j.UUID))
+
+	ticker := time.NewTicker(s.status)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		status, err := s.client.Status(ctx, j.UUID)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Error("job status request error", zap.Error(err))
+			}
+			continue
+		}
+
+		if status.IsTerminal() {
+			log.Info("job stopped by coordinator", zap.Stringer("status", status))
+			stopped <- status
+			cancel()
+			return
+		}
+	}
+}
+
+func (s *slot) fail(ctx context.Context, j *coordinator.Job) {
+	s.log.Info("reporting job failure", zap.Stringer("uuid", j.UUID))
+	if err := s.client.Fail(ctx, j.UUID); err != nil {
+		s.log.Error("error reporting job failure", zap.Error(err))
+	}
+}
+
+func (s *slot) noisy(ctx context.Context, j *coordinator.Job) {
+	s.log.Info("reporting noisy system", zap.Stringer("uuid", j.UUID))
+	if err := s.client.Noisy(ctx, j.UUID); err != nil {
+		s.log.Error("error reporting noisy system", zap.Error(err))
 	}
 }
 
-func (w *Worker) halt(ctx context.Context, j *coordinator.Job) {
-	w.log.Info("halt job", zap.Stringer("uuid", j.UUID))
-	if err := w.client.Halt(ctx, j.UUID); err != nil {
-		w.log.Error("error halting job", zap.Error(err))
+func (s *slot) halt(ctx context.Context, j *coordinator.Job) {
+	s.log.Info("halt job", zap.Stringer("uuid", j.UUID))
+	if err := s.client.Halt(ctx, j.UUID); err != nil {
+		s.log.Error("error halting job", zap.Error(err))
 	}
 }
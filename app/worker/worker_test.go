@@ -0,0 +1,175 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mmcloughlin/goperf/app/coordinator"
+)
+
+func TestWorkerSerializesJobRequests(t *testing.T) {
+	const numjobs = 32
+
+	var (
+		mu       sync.Mutex
+		inflight int
+		maxjobs  int
+		issued   = map[uuid.UUID]int{}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !(r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/jobs")) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		inflight++
+		if inflight > maxjobs {
+			maxjobs = inflight
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		inflight--
+		mu.Unlock()
+
+		res := &coordinator.JobsResponse{
+			Jobs: []*coordinator.Job{{UUID: uuid.New()}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := ProcessorFunc(func(ctx context.Context, slot int, j *coordinator.Job) (io.ReadCloser, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		issued[j.UUID]++
+		if len(issued) >= numjobs {
+			cancel()
+		}
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	})
+
+	c := coordinator.NewClient(srv.Client(), srv.URL, "worker")
+	w := New(c, p, WithSlots(8), WithStatusInterval(time.Hour))
+	if err := w.Run(ctx); err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if maxjobs != 1 {
+		t.Errorf("up to %d concurrent jobs requests; expect 1", maxjobs)
+	}
+	for id, n := range issued {
+		if n != 1 {
+			t.Errorf("job %s processed %d times", id, n)
+		}
+	}
+}
+
+func TestWorkerUploadsTelemetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Serve a single job, and record uploads.
+	id := uuid.New()
+	var (
+		mu      sync.Mutex
+		served  bool
+		uploads []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/jobs"):
+			res := coordinator.NoJobsAvailable()
+			if !served {
+				res.Jobs = append(res.Jobs, &coordinator.Job{UUID: id})
+				served = true
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(res); err != nil {
+				t.Error(err)
+			}
+			return
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/telemetry"),
+			r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/result"):
+			b, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Error(err)
+			}
+			uploads = append(uploads, path.Base(r.URL.Path)+":"+string(b))
+			if path.Base(r.URL.Path) == "result" {
+				cancel()
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	p := &telemetryProcessor{
+		ProcessorFunc: func(context.Context, int, *coordinator.Job) (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("result")), nil
+		},
+		telemetry: "telemetry",
+	}
+
+	c := coordinator.NewClient(srv.Client(), srv.URL, "worker")
+	w := New(c, p, WithStatusInterval(time.Hour))
+	if err := w.Run(ctx); err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	expect := []string{"telemetry:telemetry", "result:result"}
+	if !reflect.DeepEqual(uploads, expect) {
+		t.Fatalf("uploads %q; expect %q", uploads, expect)
+	}
+}
+
+// telemetryProcessor is a processor that also records telemetry.
+type telemetryProcessor struct {
+	ProcessorFunc
+	telemetry string
+}
+
+// Telemetry returns a reader for the configured telemetry.
+func (p *telemetryProcessor) Telemetry(context.Context, *coordinator.Job) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(p.telemetry)), nil
+}
+
+// ProcessorFunc adapts a function to the Processor interface.
+type ProcessorFunc func(context.Context, int, *coordinator.Job) (io.ReadCloser, error)
+
+// Process calls p.
+func (p ProcessorFunc) Process(ctx context.Context, slot int, j *coordinator.Job) (io.ReadCloser, error) {
+	return p(ctx, slot, j)
+}
@@ -24,6 +24,7 @@ func run(ctx context.Context, l *zap.Logger) int {
 	// Runner.
 	r := NewRun(base, p)
 	subcommands.Register(r, "benchmark execution")
+	subcommands.Register(NewVendor(base), "benchmark execution")
 
 	// Wrappers.
 	for _, wrapper := range p.Wrappers() {
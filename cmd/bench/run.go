@@ -33,6 +33,7 @@ type Run struct {
 	output   string
 	preserve bool
 	goproxy  string
+	modproxy string
 }
 
 func NewRun(b command.Base, p *platform.Platform) *Run {
@@ -65,11 +66,12 @@ func (cmd *Run) SetFlags(f *flag.FlagSet) {
 	f.StringVar(&cmd.output, "output", "", "output path")
 	f.BoolVar(&cmd.preserve, "preserve", false, "preserve working directory")
 	f.StringVar(&cmd.goproxy, "goproxy", "", "GOPROXY value for the benchark runner")
+	f.StringVar(&cmd.modproxy, "modproxy", "", "file-based module proxy directory for offline runs (overrides -goproxy)")
 
 	cmd.Platform.SetFlags(f)
 }
 
-func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
 	// Build toolchain.
 	tc, err := runner.NewToolchain(cmd.toolchainconfig.Type, cmd.toolchainconfig.Params.Map())
 	if err != nil {
@@ -93,11 +95,28 @@ func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 		r.SetGoProxy(cmd.goproxy)
 	}
 
+	if cmd.modproxy != "" {
+		if err := r.SetModuleProxy(cmd.modproxy); err != nil {
+			return cmd.Error(err)
+		}
+	}
+
 	if err := cmd.ConfigureRunner(r); err != nil {
 		return cmd.Error(err)
 	}
 
-	r.Init(ctx)
+	// Clean.
+	if !cmd.preserve {
+		defer func() {
+			if err := r.Clean(context.Background()); err != nil {
+				status = cmd.Error(err)
+			}
+		}()
+	}
+
+	if err := r.Init(ctx); err != nil {
+		return cmd.Error(err)
+	}
 
 	// Run benchmark.
 	suite := job.Suite{
@@ -106,12 +125,5 @@ func (cmd *Run) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{})
 		BenchTime: 10 * time.Millisecond,
 	}
 	output := fmt.Sprintf("%s.out", uuid.New())
-	r.Benchmark(ctx, suite, output)
-
-	// Clean.
-	if !cmd.preserve {
-		r.Clean(ctx)
-	}
-
-	return cmd.Status(w.Error())
+	return cmd.Status(r.Benchmark(ctx, suite, output))
 }
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/subcommands"
+
+	"github.com/mmcloughlin/goperf/internal/errutil"
+	"github.com/mmcloughlin/goperf/pkg/command"
+	"github.com/mmcloughlin/goperf/pkg/mod"
+	"github.com/mmcloughlin/goperf/pkg/runner"
+)
+
+type Vendor struct {
+	command.Base
+
+	dir     string
+	output  string
+	goproxy string
+}
+
+func NewVendor(b command.Base) *Vendor {
+	return &Vendor{
+		Base: b,
+	}
+}
+
+func (*Vendor) Name() string { return "vendor" }
+
+func (*Vendor) Synopsis() string {
+	return "populate a file-based module proxy for offline benchmark runs"
+}
+
+func (*Vendor) Usage() string {
+	return `Usage: vendor [flags] <module@version> ...
+
+Download the given benchmark suite modules and their dependencies into a
+file-based module proxy directory, suitable for use as GOPROXY on isolated
+benchmark machines. Existing proxy contents are preserved.
+
+The complete list of module versions required by the suites is written in
+"path@version" form, for pinning in benchmark job settings.
+
+`
+}
+
+func (cmd *Vendor) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.dir, "dir", "", "module proxy directory")
+	f.StringVar(&cmd.output, "output", "", "write pinned module versions to file (default stdout)")
+	f.StringVar(&cmd.goproxy, "goproxy", "https://proxy.golang.org", "upstream GOPROXY to download modules from")
+}
+
+func (cmd *Vendor) Execute(ctx context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
+	// Process arguments.
+	if cmd.dir == "" {
+		return cmd.UsageError("must provide module proxy directory")
+	}
+
+	if f.NArg() == 0 {
+		return cmd.UsageError("no modules provided")
+	}
+
+	for _, arg := range f.Args() {
+		if m := mod.Parse(arg); m.Version == "" {
+			return cmd.UsageError("module %q has no version", arg)
+		}
+	}
+
+	// Construct workspace with a fresh module cache.
+	w, err := runner.NewWorkspace(
+		runner.WithLogger(cmd.Log),
+		runner.InheritEnviron(),
+	)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	defer func() {
+		if err := w.Clean(); err != nil {
+			status = cmd.Error(err)
+		}
+	}()
+
+	w.SetEnv("GO111MODULE", "on")
+	w.SetEnv("GOPROXY", cmd.goproxy)
+	w.SetEnv("GOFLAGS", "-mod=mod")
+
+	list, err := vendor(ctx, w, f.Args(), cmd.dir)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	// Output pinned module versions. The first line of the list is the main
+	// module, which has no version.
+	out := os.Stdout
+	if cmd.output != "" {
+		out, err = os.Create(cmd.output)
+		if err != nil {
+			return cmd.Error(err)
+		}
+		defer cmd.CheckClose(&status, out)
+	}
+
+	for _, line := range strings.Split(list, "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		fmt.Fprintf(out, "%s@%s\n", fields[0], fields[1])
+	}
+
+	return subcommands.ExitSuccess
+}
+
+// vendor downloads modules into a fresh module cache in the workspace, in the
+// same way as the benchmark runner, and copies them to the module proxy
+// directory dst. Returns the list of all required modules, as reported by "go
+// list -m all".
+func vendor(ctx context.Context, w *runner.Workspace, mods []string, dst string) (_ string, err error) {
+	gopath, err := w.EnsureDir("gopath")
+	if err != nil {
+		return "", err
+	}
+	w.SetEnv("GOPATH", gopath)
+
+	// The module cache is read-only, so must be removed by the go command.
+	defer func() {
+		if cerr := w.Exec(context.Background(), exec.Command("go", "clean", "-modcache")); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if _, err := w.Sandbox("vendor"); err != nil {
+		return "", err
+	}
+
+	if err := w.Exec(ctx, exec.CommandContext(ctx, "go", "mod", "init", "vendor")); err != nil {
+		return "", err
+	}
+
+	for _, m := range mods {
+		if err := w.Exec(ctx, exec.CommandContext(ctx, "go", "get", "-t", "-d", m)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Exec(ctx, exec.CommandContext(ctx, "go", "mod", "download", "all")); err != nil {
+		return "", err
+	}
+
+	list, err := w.Output(ctx, exec.CommandContext(ctx, "go", "list", "-m", "all"))
+	if err != nil {
+		return "", err
+	}
+
+	// The download cache has the layout of a module proxy.
+	download := filepath.Join(gopath, "pkg", "mod", "cache", "download")
+	if err := copytree(dst, download); err != nil {
+		return "", fmt.Errorf("populate module proxy: %w", err)
+	}
+
+	return list, nil
+}
+
+// copytree copies files under src into dst, skipping files that already exist.
+func copytree(dst, src string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, 0o777)
+		case !info.Mode().IsRegular():
+			return nil
+		}
+
+		if _, err := os.Stat(target); err == nil {
+			return nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return copyfile(target, path)
+	})
+}
+
+// copyfile copies src to dst via a temporary file, so dst is never partially
+// written.
+func copyfile(dst, src string) (err error) {
+	s, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer errutil.CheckClose(&err, s)
+
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), ".tmp")
+	if err != nil {
+		return err
+	}
+
+	if err := tmp.Chmod(0o644); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if _, err := io.Copy(tmp, s); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
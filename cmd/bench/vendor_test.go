@@ -0,0 +1,89 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+)
+
+func TestCopyTree(t *testing.T) {
+	// Fake module download cache.
+	src := test.TempDir(t)
+	files := map[string]string{
+		"golang.org/x/text/@v/list":           "v0.3.2\n",
+		"golang.org/x/text/@v/v0.3.2.info":    `{"Version":"v0.3.2"}`,
+		"golang.org/x/text/@v/v0.3.2.mod":     "module golang.org/x/text\n",
+		"golang.org/x/text/@v/v0.3.2.zip":     "zip",
+		"github.com/pkg/errors/@v/list":       "v0.9.1\n",
+		"github.com/pkg/errors/@v/v0.9.1.mod": "module github.com/pkg/errors\n",
+	}
+	for name, data := range files {
+		writefile(t, filepath.Join(src, name), data)
+	}
+
+	// Lock files are not regular files in a real cache; symlinks stand in for
+	// them here.
+	if err := os.Symlink("list", filepath.Join(src, "golang.org/x/text/@v/v0.3.2.lock")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Proxy directory with existing contents that should be preserved.
+	dst := test.TempDir(t)
+	existing := filepath.Join(dst, "golang.org/x/text/@v/list")
+	writefile(t, existing, "v0.3.0\n")
+
+	if err := copytree(dst, src); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range files {
+		if name == "golang.org/x/text/@v/list" {
+			data = "v0.3.0\n"
+		}
+		if got := readfile(t, filepath.Join(dst, name)); got != data {
+			t.Errorf("%s: got %q; expect %q", name, got, data)
+		}
+	}
+
+	if _, err := os.Lstat(filepath.Join(dst, "golang.org/x/text/@v/v0.3.2.lock")); !os.IsNotExist(err) {
+		t.Errorf("non-regular file copied")
+	}
+
+	// No temporary files should remain.
+	tmps, err := filepath.Glob(filepath.Join(dst, "*", "*", "*", "@v", ".tmp*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tmps) > 0 {
+		t.Errorf("temporary files remain: %v", tmps)
+	}
+}
+
+func TestCopyTreeMissingSource(t *testing.T) {
+	dir := test.TempDir(t)
+	if err := copytree(filepath.Join(dir, "dst"), filepath.Join(dir, "src")); err == nil {
+		t.Fatal("expected error for missing source")
+	}
+}
+
+func writefile(t *testing.T, filename, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), 0o777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readfile(t *testing.T, filename string) string {
+	t.Helper()
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
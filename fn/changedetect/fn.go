@@ -2,27 +2,38 @@ package watch
 
 import (
 	"context"
+	"math"
 	"net/http"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/mmcloughlin/goperf/app/change"
 	"github.com/mmcloughlin/goperf/app/db"
 	"github.com/mmcloughlin/goperf/app/entity"
 	"github.com/mmcloughlin/goperf/app/httputil"
+	"github.com/mmcloughlin/goperf/app/notify"
 	"github.com/mmcloughlin/goperf/app/service"
-	"github.com/mmcloughlin/goperf/app/trace"
+	"github.com/mmcloughlin/goperf/internal/errutil"
 )
 
-// NumCommits is the number of most recent commits to look for changes in.
-const NumCommits = 512
+// Incremental change detection parameters.
+const (
+	// MaxTraceUpdates is the maximum number of trace updates to process in one
+	// invocation.
+	MaxTraceUpdates = 2048
+
+	// ConfirmCommits is the number of most recent commits to confirm changes
+	// in.
+	ConfirmCommits = 512
+)
 
 // Initialization.
 var (
 	logger   *zap.Logger
 	database *db.DB
+	notifier *notify.Notifier
 	handler  http.Handler
-	detector = change.DefaultDetector
 )
 
 func initialize(ctx context.Context, l *zap.Logger) error {
@@ -35,6 +46,11 @@ func initialize(ctx context.Context, l *zap.Logger) error {
 		return err
 	}
 
+	notifier, err = service.Notifier(ctx, l)
+	if err != nil {
+		return err
+	}
+
 	handler = httputil.ErrorHandler{
 		Handler: httputil.HandlerFunc(handle),
 		Log:     l,
@@ -55,67 +71,200 @@ func Handle(w http.ResponseWriter, r *http.Request) {
 func handle(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
-	// Determine commit range.
-	idx, err := database.MostRecentCommitIndex(ctx)
+	// Select change detector.
+	name := r.URL.Query().Get("detector")
+	detector, err := change.NamedDetector(name)
 	if err != nil {
-		return err
+		return httputil.BadRequest(err)
 	}
-	logger.Info("most recent commit index", zap.Int("index", idx))
+	logger.Info("change detector", zap.String("name", name))
 
-	cr := entity.CommitIndexRange{
-		Min: idx - NumCommits + 1,
-		Max: idx,
+	inc := change.NewIncremental(detector)
+	logger.Info("incremental detection", zap.Int("radius", inc.Radius))
+
+	// Fetch traces that have new points.
+	updates, err := database.ListTraceUpdates(ctx, MaxTraceUpdates)
+	if err != nil {
+		return err
 	}
+	logger.Info("fetched trace updates", zap.Int("num_updates", len(updates)))
+
+	// Re-evaluate the affected region of each trace.
+	numchanges := 0
+	var added []*entity.Change
+	for _, u := range updates {
+		log := logger.With(
+			zap.Stringer("trace", u.ID),
+			zap.Stringer("updated", u.CommitIndexRange),
+		)
 
-	// Query for trace points.
-	logger.Info("fetching traces",
-		zap.Int("min_commit_index", cr.Min),
-		zap.Int("max_commit_index", cr.Max),
+		changes, fresh, err := detect(ctx, inc, u, log)
+		if err != nil {
+			return err
+		}
+		numchanges += len(changes)
+		added = append(added, fresh...)
+	}
+	logger.Info("inserted changes",
+		zap.Int("num_changes", numchanges),
+		zap.Int("num_new", len(added)),
 	)
 
-	ps, err := database.ListTracePoints(ctx, cr)
-	if err != nil {
+	// Confirm changes with repeat samples.
+	if err := confirm(ctx); err != nil {
 		return err
 	}
 
-	traces := trace.Traces(ps)
+	// Update the ranking.
+	if err := database.BuildChangesRanked(ctx); err != nil {
+		return err
+	}
+	logger.Info("updated changes ranking")
+
+	// Alert subscribers to new changes.
+	if err := alert(ctx); err != nil {
+		return err
+	}
+
+	// Report ok.
+	httputil.OK(w)
+
+	return nil
+}
+
+// detect re-evaluates changes in the region of a trace affected by an update,
+// and replaces them in the database. Returns all changes found in the affected
+// region, and the subset that were not previously recorded.
+func detect(ctx context.Context, inc *change.Incremental, u *entity.TraceUpdate, log *zap.Logger) ([]*entity.Change, []*entity.Change, error) {
+	// Fetch the points required for the affected region. Global detectors
+	// require the whole trace.
+	required := entity.CommitIndexRange{Min: 0, Max: math.MaxInt32}
+	if !inc.Global() {
+		var err error
+		required, err = database.TraceExpandRange(ctx, u.ID, u.CommitIndexRange, inc.Context())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	t, err := database.Trace(ctx, u.ID, required)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var affected entity.CommitIndexRange
+	affected.Min, affected.Max = inc.Affected(t.Series, u.CommitIndexRange.Min, u.CommitIndexRange.Max)
 
 	// Find change points.
 	var changes []*entity.Change
-	for id, trc := range traces {
-		log := logger.With(zap.Stringer("trace", id))
+	for _, chg := range inc.Detect(t.Series, u.CommitIndexRange.Min, u.CommitIndexRange.Max) {
+		log.Debug("change found",
+			zap.Int("commit_index", chg.CommitIndex),
+			zap.Float64("effect_size", chg.EffectSize),
+			zap.Float64("p_value", chg.PValue),
+		)
+		changes = append(changes, &entity.Change{
+			ID:     u.ID,
+			Change: chg,
+		})
+	}
+
+	// Replace in the database.
+	added, err := database.ReplaceTraceChanges(ctx, u, affected, changes, notifier.Subscriptions())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, added, nil
+}
+
+// alert notifies subscribers of changes with pending alerts. Alerts remain
+// pending for each subscription until delivery to it succeeds, so they are
+// retried by later invocations without repeating successful deliveries.
+func alert(ctx context.Context) error {
+	pending, err := database.ListPendingChangeAlerts(ctx)
+	if err != nil {
+		return err
+	}
+
+	benchmarks := map[uuid.UUID]*entity.Benchmark{}
+	var errs errutil.Errors
+	for sub, changes := range pending {
+		var alerts []*notify.Alert
+		for _, c := range changes {
+			b, ok := benchmarks[c.BenchmarkUUID]
+			if !ok {
+				var err error
+				b, err = database.FindBenchmarkByUUID(ctx, c.BenchmarkUUID)
+				if err != nil {
+					return err
+				}
+				benchmarks[c.BenchmarkUUID] = b
+			}
 
-		chgs := detector.Detect(trc.Series)
-		if len(chgs) == 0 {
+			alerts = append(alerts, &notify.Alert{
+				Benchmark:       b,
+				EnvironmentUUID: c.EnvironmentUUID,
+				Change:          c.Change,
+			})
+		}
+
+		if err := notifier.Send(ctx, sub, alerts); err != nil {
+			errs.Add(err)
 			continue
 		}
+		logger.Info("sent alerts", zap.String("subscription", sub), zap.Int("num_alerts", len(alerts)))
 
-		for _, chg := range chgs {
-			log.Debug("change found",
-				zap.Int("commit_index", chg.CommitIndex),
-				zap.Float64("effect_size", chg.EffectSize),
-			)
-			changes = append(changes, &entity.Change{
-				ID:     id,
-				Change: chg,
-			})
+		if err := database.MarkChangeAlertsDelivered(ctx, sub, changes); err != nil {
+			return err
 		}
 	}
 
-	// Insert into database.
-	if err := database.ReplaceChanges(ctx, cr, changes); err != nil {
+	return errs.Err()
+}
+
+// confirm updates the confirmation status of recent changes, based on repeat
+// samples from other workers.
+func confirm(ctx context.Context) error {
+	repo := entity.GoRepository.UUID()
+	idx, err := database.MostRecentCommitIndex(ctx, repo)
+	if err != nil {
 		return err
 	}
-	logger.Info("inserted changes", zap.Int("num_changes", len(changes)))
 
-	// Update the ranking.
-	if err := database.BuildChangesRanked(ctx); err != nil {
+	r := entity.CommitIndexRange{
+		Min: idx - ConfirmCommits + 1,
+		Max: idx,
+	}
+
+	crs, err := database.ListUnconfirmedChangeRepeats(ctx, repo, r)
+	if err != nil {
 		return err
 	}
-	logger.Info("updated changes ranking")
 
-	// Report ok.
-	httputil.OK(w)
+	counts := map[change.Confirmation]int{}
+	for _, cr := range crs {
+		c := change.Confirm(cr.Change.Change, cr.Repeats)
+		counts[c]++
+		if c == change.ConfirmationUnconfirmed {
+			continue
+		}
+
+		logger.Debug("change confirmation",
+			zap.Stringer("trace", cr.Change.ID),
+			zap.Int("commit_index", cr.Change.CommitIndex),
+			zap.Stringer("confirmation", c),
+		)
+		if err := database.SetChangeConfirmation(ctx, cr.Change.ID, cr.Change.CommitIndex, c); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("confirmed changes",
+		zap.Int("num_unconfirmed", counts[change.ConfirmationUnconfirmed]),
+		zap.Int("num_confirmed", counts[change.ConfirmationConfirmed]),
+		zap.Int("num_refuted", counts[change.ConfirmationRefuted]),
+	)
 
 	return nil
 }
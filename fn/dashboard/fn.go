@@ -28,15 +28,26 @@ func initialize(ctx context.Context, l *zap.Logger) error {
 		return err
 	}
 
+	// Admin password.
+	password, err := service.AdminPassword(ctx, l)
+	if err != nil {
+		return err
+	}
+
 	// Handlers.
-	handler = dashboard.NewHandlers(d,
+	opts := []dashboard.Option{
 		dashboard.WithLogger(l),
 		dashboard.WithDataFileSystem(datafs),
 		dashboard.WithCacheControl(httputil.CacheControl{
 			MaxAge:     10 * time.Minute,
 			Directives: []string{"public"},
 		}),
-	)
+	}
+	if password != "" {
+		opts = append(opts, dashboard.WithAdminPassword(password))
+	}
+
+	handler = dashboard.NewHandlers(d, opts...)
 
 	if err := handler.Init(ctx); err != nil {
 		return err
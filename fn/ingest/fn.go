@@ -3,14 +3,17 @@ package ingest
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"path"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/mmcloughlin/goperf/app/coordinator"
 	"github.com/mmcloughlin/goperf/app/db"
 	"github.com/mmcloughlin/goperf/app/gcs"
 	"github.com/mmcloughlin/goperf/app/ingest"
+	"github.com/mmcloughlin/goperf/app/repo"
 	"github.com/mmcloughlin/goperf/app/results"
 	"github.com/mmcloughlin/goperf/app/service"
 )
@@ -51,6 +54,13 @@ func Handle(ctx context.Context, e GCSEvent) error {
 		zap.String("name", e.Name),
 	)
 
+	// Telemetry sidecar files are stored alongside data files, and referenced
+	// from them. There is nothing to ingest.
+	if coordinator.IsTelemetryFile(e.Name) {
+		logger.Info("skipping telemetry file")
+		return nil
+	}
+
 	// Extract task ID from the object name.
 	id, err := uuid.Parse(path.Base(e.Name))
 	if err != nil {
@@ -63,7 +73,16 @@ func Handle(ctx context.Context, e GCSEvent) error {
 		return err
 	}
 
-	loader, err := results.NewLoader(results.WithFilesystem(bucket))
+	// Commits in repositories other than Go are only known to the database.
+	rev := repo.NewRevisionsCache(repo.NewCompositeRevisions(
+		repo.NewRevisionsFromDatabase(database),
+		repo.Go(http.DefaultClient),
+	), 16)
+
+	loader, err := results.NewLoader(
+		results.WithFilesystem(bucket),
+		results.WithRevisions(rev),
+	)
 	if err != nil {
 		return err
 	}
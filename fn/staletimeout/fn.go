@@ -12,7 +12,12 @@ import (
 	"github.com/mmcloughlin/goperf/app/service"
 )
 
+// timeout tasks after this duration without a heartbeat from their worker.
+const heartbeattimeout = 10 * time.Minute
+
 // timeout tasks after this duration of inactivity while in a pending state.
+// Heartbeats refresh the tasks the worker reports in progress, so this only
+// applies to tasks the worker has stopped reporting.
 const timeout = 6 * time.Hour
 
 // Initialization.
@@ -50,8 +55,10 @@ func handle(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
 	// Timeout stale tasks.
-	until := time.Now().Add(-timeout)
-	if err := database.TimeoutStaleTasks(ctx, until); err != nil {
+	now := time.Now()
+	heartbeat := now.Add(-heartbeattimeout)
+	until := now.Add(-timeout)
+	if err := database.TimeoutStaleTasks(ctx, heartbeat, until); err != nil {
 		return err
 	}
 
@@ -2,9 +2,12 @@ package watch
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"io"
 	"net/http"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/mmcloughlin/goperf/app/entity"
@@ -15,16 +18,13 @@ import (
 
 // Initialization.
 var (
-	logger     *zap.Logger
-	repository repo.Repository
-	handler    http.Handler
+	logger  *zap.Logger
+	handler http.Handler
 )
 
 func initialize(ctx context.Context, l *zap.Logger) error {
 	logger = l
 
-	repository = repo.Go(http.DefaultClient)
-
 	handler = httputil.ErrorHandler{
 		Handler: httputil.HandlerFunc(handle),
 		Log:     logger,
@@ -52,30 +52,57 @@ func handle(w http.ResponseWriter, r *http.Request) error {
 	}
 	defer d.Close()
 
-	// Get most recent commit on master in the database.
-	latest, err := d.MostRecentCommitWithRef(ctx, "master")
+	// Determine the repository to watch, defaulting to Go.
+	id := entity.GoRepository.UUID()
+	if s := r.URL.Query().Get("repo"); s != "" {
+		id, err = uuid.Parse(s)
+		if err != nil {
+			return httputil.BadRequest(err)
+		}
+	}
+
+	rp, err := d.FindRepositoryByUUID(ctx, id)
 	if err != nil {
 		return err
 	}
-	logger.Info("found latest commit on master in database", zap.String("sha", latest.SHA))
+
+	repository, err := repo.New(http.DefaultClient, rp.URL)
+	if err != nil {
+		return err
+	}
+
+	log := logger.With(zap.String("repo", rp.URL), zap.String("ref", rp.Ref))
+
+	// Get most recent commit on the ref in the database. A repository with no
+	// commits yet is seeded with the first batch.
+	latest, err := d.MostRecentCommitWithRef(ctx, rp.UUID(), rp.Ref)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		latest = nil
+		log.Info("no commits in database")
+	case err != nil:
+		return err
+	default:
+		log.Info("found latest commit in database", zap.String("sha", latest.SHA))
+	}
 
 	// Fetch commits until we get to the latest one.
 	commits := []*entity.Commit{}
-	start := "master"
+	start := rp.Ref
 	for {
 		// Fetch commits.
-		logger.Info("git log", zap.String("start", start))
+		log.Info("git log", zap.String("start", start))
 		batch, err := repository.Log(ctx, start)
 		if err != nil {
-			logger.Error("error fetching recent commits", zap.Error(err))
+			log.Error("error fetching recent commits", zap.Error(err))
 			return err
 		}
 
-		logger.Info("fetched recent commits", zap.Int("num_commits", len(batch)))
+		log.Info("fetched recent commits", zap.Int("num_commits", len(batch)))
 		commits = append(commits, batch...)
 
 		// Look to see if we've hit the latest one.
-		if containsCommit(commits, latest) {
+		if latest == nil || containsCommit(commits, latest) {
 			break
 		}
 
@@ -84,10 +111,10 @@ func handle(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	// Store new commits in the database.
-	if err := d.StoreCommits(ctx, commits); err != nil {
+	if err := d.StoreCommits(ctx, rp.UUID(), commits); err != nil {
 		return err
 	}
-	logger.Info("inserted commits", zap.Int("num_commits", len(commits)))
+	log.Info("inserted commits", zap.Int("num_commits", len(commits)))
 
 	// Record refs.
 	it := repo.FirstParent(repo.CommitsIterator(commits))
@@ -103,20 +130,20 @@ func handle(w http.ResponseWriter, r *http.Request) error {
 
 		refs = append(refs, &entity.CommitRef{
 			SHA: c.SHA,
-			Ref: "master",
+			Ref: rp.Ref,
 		})
 	}
 
-	if err := d.StoreCommitRefs(ctx, refs); err != nil {
+	if err := d.StoreCommitRefs(ctx, rp.UUID(), refs); err != nil {
 		return err
 	}
-	logger.Info("recorded commit refs")
+	log.Info("recorded commit refs")
 
 	// Rebuild commit positions table.
-	if err := d.BuildCommitPositions(ctx); err != nil {
+	if err := d.BuildCommitPositions(ctx, rp); err != nil {
 		return err
 	}
-	logger.Info("built commit positions")
+	log.Info("built commit positions")
 
 	// Report ok.
 	httputil.OK(w)
@@ -3,6 +3,7 @@ module github.com/mmcloughlin/goperf/fn/watch
 go 1.13
 
 require (
+	github.com/google/uuid v1.1.1
 	github.com/mmcloughlin/goperf v0.0.0
 	go.uber.org/zap v1.14.1
 	gonum.org/v1/netlib v0.0.0-20200317120129-c5a04cffd98a // indirect
@@ -80,6 +80,17 @@ func (s Set) Difference(t Set) Set {
 	return d
 }
 
+// Intersection returns a new set with elements in both s and t.
+func (s Set) Intersection(t Set) Set {
+	i := NewSet()
+	for n := range s {
+		if t[n] {
+			i[n] = true
+		}
+	}
+	return i
+}
+
 // Reference: https://github.com/mkerrisk/man-pages/blob/ffea2c14f25042b1904e95da73d165cb25672a08/man7/cpuset.7#L866-L906
 //
 //	.\" ================== Mask Format ==================
@@ -62,6 +62,16 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetIntersection(t *testing.T) {
+	a := NewSet(1, 2, 3)
+	b := NewSet(2, 3, 4)
+	i := a.Intersection(b)
+	expect := NewSet(2, 3)
+	if !i.Equals(expect) {
+		t.Fail()
+	}
+}
+
 func TestSetMaskFormatBidirectional(t *testing.T) {
 	cases := []struct {
 		Mask    string
@@ -3,6 +3,7 @@ package job
 
 import (
 	"encoding/json"
+	"path"
 	"time"
 
 	"github.com/mmcloughlin/goperf/pkg/mod"
@@ -23,11 +24,16 @@ const SkipTests = "none^"
 
 type Suite struct {
 	Module     Module        `json:"module"`
+	Package    string        `json:"package,omitempty"` // relative path of a single package to run, if set
 	Tests      string        `json:"tests,omitempty"`
 	Short      bool          `json:"short,omitempty"`
 	Benchmarks string        `json:"benchmarks,omitempty"`
+	Skip       string        `json:"skip,omitempty"`
 	BenchTime  time.Duration `json:"benchtime_ns,omitempty"`
+	Count      int           `json:"count,omitempty"`
+	Interleave bool          `json:"interleave,omitempty"` // run repetitions in rounds across all packages
 	Timeout    time.Duration `json:"timeout_ns,omitempty"`
+	Requires   []Module      `json:"requires,omitempty"` // pinned module versions in the build list
 }
 
 // TestRegex returns the regular expression controlling which tests are run.
@@ -46,6 +52,20 @@ func (s *Suite) BenchmarkRegex() string {
 	return s.Benchmarks
 }
 
+// Target returns the package pattern to pass to go test.
+func (s *Suite) Target() string {
+	switch {
+	case s.Package != "" && s.Module.IsMeta():
+		return s.Package
+	case s.Package != "":
+		return path.Join(s.Module.Path, s.Package)
+	case s.Module.IsMeta():
+		return s.Module.Path
+	default:
+		return s.Module.Path + "/..."
+	}
+}
+
 // BenchmarkTime returns the minimum amount of time each benchmark is run for.
 func (s *Suite) BenchmarkTime() time.Duration {
 	if s.BenchTime == 0 {
@@ -54,6 +74,32 @@ func (s *Suite) BenchmarkTime() time.Duration {
 	return s.BenchTime
 }
 
+// BenchmarkCount returns the number of times each benchmark is run.
+func (s *Suite) BenchmarkCount() int {
+	if s.Count < 1 {
+		return 1
+	}
+	return s.Count
+}
+
+// Rounds returns the number of separate "go test" executions required for the
+// suite. Without interleaving, all repetitions are performed by a single
+// execution.
+func (s *Suite) Rounds() int {
+	if !s.Interleave {
+		return 1
+	}
+	return s.BenchmarkCount()
+}
+
+// RoundCount returns the "go test" count parameter for each round.
+func (s *Suite) RoundCount() int {
+	if s.Interleave {
+		return 1
+	}
+	return s.BenchmarkCount()
+}
+
 type Module struct {
 	Path    string `json:"path"`
 	Version string `json:"version"`
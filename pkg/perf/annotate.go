@@ -0,0 +1,101 @@
+// Package perf implements hardware performance counter measurement of
+// benchmark executables.
+package perf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcloughlin/goperf/pkg/units"
+)
+
+// Sample is a reading of event counters at a point in time.
+type Sample struct {
+	Time   time.Time
+	Counts []float64
+}
+
+// Annotate copies Go benchmark output from r to w, appending per-operation
+// event counts to each benchmark result line. The sample function reads
+// counters for events reported in the given units.
+//
+// Counts per operation are estimated from the event rate since the previous
+// result line, multiplied by the reported runtime per operation. This accounts
+// for the calibration runs the testing package performs before the reported
+// run, assuming the event rate is steady over a benchmark. Counts are therefore
+// estimates, and eventunits should say so.
+func Annotate(w io.Writer, r io.Reader, eventunits []string, sample func() (Sample, error)) error {
+	prev, err := sample()
+	if err != nil {
+		return err
+	}
+
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if errors.Is(err, io.EOF) && line == "" {
+			return nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if ns, ok := nsperop(line); ok {
+			cur, err := sample()
+			if err != nil {
+				return err
+			}
+			line = annotate(line, eventunits, prev, cur, ns)
+			prev = cur
+		}
+
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+}
+
+// annotate appends per-operation event counts to the result line, given
+// samples before and after the benchmark and its runtime per operation.
+func annotate(line string, eventunits []string, prev, cur Sample, ns float64) string {
+	elapsed := cur.Time.Sub(prev.Time)
+	if elapsed <= 0 || len(cur.Counts) != len(eventunits) {
+		return line
+	}
+
+	trimmed := strings.TrimRight(line, "\n")
+	var b strings.Builder
+	b.WriteString(trimmed)
+	for i, unit := range eventunits {
+		rate := (cur.Counts[i] - prev.Counts[i]) / float64(elapsed)
+		fmt.Fprintf(&b, "\t%s %s", strconv.FormatFloat(rate*ns, 'f', 2, 64), unit)
+	}
+	if len(trimmed) < len(line) {
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// nsperop parses a benchmark result line, returning its runtime per operation.
+func nsperop(line string) (float64, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || len(fields)%2 != 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return 0, false
+	}
+	for i := 2; i+1 < len(fields); i += 2 {
+		if fields[i+1] != units.Runtime {
+			continue
+		}
+		ns, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return 0, false
+		}
+		return ns, true
+	}
+	return 0, false
+}
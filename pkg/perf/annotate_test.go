@@ -0,0 +1,79 @@
+package perf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mmcloughlin/goperf/pkg/parse"
+	"github.com/mmcloughlin/goperf/pkg/units"
+)
+
+func TestAnnotate(t *testing.T) {
+	input := strings.Join([]string{
+		"goos: linux",
+		"BenchmarkEncode-8   \t 1000000\t      1000 ns/op\t  16 B/op",
+		"PASS",
+		"BenchmarkDecode-8   \t  500000\t      2000 ns/op",
+		"ok  \texample.com/codec\t3.000s",
+	}, "\n")
+
+	// Fake samples one second apart, with counts increasing by a fixed rate.
+	base := time.Unix(0, 0)
+	n := 0
+	sample := func() (Sample, error) {
+		s := Sample{
+			Time:   base.Add(time.Duration(n) * time.Second),
+			Counts: []float64{float64(n) * 3e9, float64(n) * 1e6},
+		}
+		n++
+		return s, nil
+	}
+
+	var buf bytes.Buffer
+	if err := Annotate(&buf, strings.NewReader(input), []string{units.Instructions, units.CacheMisses}, sample); err != nil {
+		t.Fatal(err)
+	}
+
+	// Confirm the parser sees the extra units.
+	c, err := parse.Reader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Errors) > 0 {
+		t.Fatal(c.Errors[0])
+	}
+
+	expect := map[string]float64{
+		"Encode ns/op":               1000,
+		"Encode B/op":                16,
+		"Encode est-instructions/op": 3000,
+		"Encode est-cache-misses/op": 1,
+		"Decode ns/op":               2000,
+		"Decode est-instructions/op": 6000,
+		"Decode est-cache-misses/op": 2,
+	}
+	if len(c.Results) != len(expect) {
+		t.Fatalf("got %d results; expect %d", len(c.Results), len(expect))
+	}
+	for _, r := range c.Results {
+		key := r.Name + " " + r.Unit
+		if v, ok := expect[key]; !ok || v != r.Value {
+			t.Errorf("%s = %v; expect %v", key, r.Value, v)
+		}
+	}
+}
+
+func TestAnnotatePassthrough(t *testing.T) {
+	input := "=== RUN   TestFoo\n--- PASS: TestFoo (0.00s)\nno trailing newline"
+	sample := func() (Sample, error) { return Sample{Time: time.Now()}, nil }
+
+	var buf bytes.Buffer
+	if err := Annotate(&buf, strings.NewReader(input), nil, sample); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != input {
+		t.Fatalf("got %q; expect %q", got, input)
+	}
+}
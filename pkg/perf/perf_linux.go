@@ -0,0 +1,166 @@
+package perf
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/mmcloughlin/goperf/internal/errutil"
+	"github.com/mmcloughlin/goperf/pkg/units"
+)
+
+// Event is a hardware event to count, reported per benchmark operation in the
+// given unit.
+type Event struct {
+	Unit   string
+	Type   uint32
+	Config uint64
+}
+
+// Events counted for benchmarks.
+var Events = []Event{
+	{Unit: units.Instructions, Type: unix.PERF_TYPE_HARDWARE, Config: unix.PERF_COUNT_HW_INSTRUCTIONS},
+	{Unit: units.Cycles, Type: unix.PERF_TYPE_HARDWARE, Config: unix.PERF_COUNT_HW_CPU_CYCLES},
+	{Unit: units.BranchMisses, Type: unix.PERF_TYPE_HARDWARE, Config: unix.PERF_COUNT_HW_BRANCH_MISSES},
+	{Unit: units.CacheMisses, Type: unix.PERF_TYPE_HARDWARE, Config: unix.PERF_COUNT_HW_CACHE_MISSES},
+}
+
+// Units returns the units the events are reported in.
+func Units(events []Event) []string {
+	u := make([]string, len(events))
+	for i, e := range events {
+		u[i] = e.Unit
+	}
+	return u
+}
+
+// Counters count user-space events for a process and all threads it creates.
+type Counters struct {
+	fds []int
+}
+
+// Available reports whether counters for events can be opened, which depends
+// on hardware support and the perf_event_paranoid setting.
+func Available(events []Event) bool {
+	c, err := open(0, events)
+	if err != nil {
+		return false
+	}
+	return c.Close() == nil
+}
+
+// Start starts cmd with events counted from the start of its execution. The
+// process is stopped at exec with ptrace so counters can be attached before
+// it creates any threads. On error the process is killed.
+func Start(cmd *exec.Cmd, events []Event) (*Counters, error) {
+	// Ptrace requests must come from the thread that started the process.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Ptrace = true
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	pid := cmd.Process.Pid
+
+	// Wait for the process to stop at exec.
+	var ws unix.WaitStatus
+	if _, err := unix.Wait4(pid, &ws, 0, nil); err != nil {
+		return nil, kill(cmd, err)
+	}
+	if !ws.Stopped() {
+		return nil, kill(cmd, fmt.Errorf("process not stopped at exec: status %#x", ws))
+	}
+
+	// Attach counters and resume.
+	c, err := open(pid, events)
+	if derr := unix.PtraceDetach(pid); derr != nil && err == nil {
+		err = derr
+	}
+	if err != nil {
+		if c != nil {
+			_ = c.Close()
+		}
+		return nil, kill(cmd, err)
+	}
+
+	return c, nil
+}
+
+// kill the process started by cmd, returning err.
+func kill(cmd *exec.Cmd, err error) error {
+	_ = cmd.Process.Kill()
+	_ = cmd.Wait()
+	return err
+}
+
+// open counters for events on the process pid, or the calling process if pid
+// is 0.
+func open(pid int, events []Event) (*Counters, error) {
+	c := &Counters{}
+	for _, e := range events {
+		attr := &unix.PerfEventAttr{
+			Type:        e.Type,
+			Size:        uint32(unsafe.Sizeof(unix.PerfEventAttr{})),
+			Config:      e.Config,
+			Read_format: unix.PERF_FORMAT_TOTAL_TIME_ENABLED | unix.PERF_FORMAT_TOTAL_TIME_RUNNING,
+			Bits:        unix.PerfBitInherit | unix.PerfBitExcludeKernel | unix.PerfBitExcludeHv,
+		}
+		fd, err := unix.PerfEventOpen(attr, pid, -1, -1, unix.PERF_FLAG_FD_CLOEXEC)
+		if err != nil {
+			_ = c.Close()
+			return nil, fmt.Errorf("open %s counter: %w", e.Unit, err)
+		}
+		c.fds = append(c.fds, fd)
+	}
+	return c, nil
+}
+
+// Read samples the counters. Counts are scaled to account for time the
+// counters were not running due to multiplexing.
+func (c *Counters) Read() (Sample, error) {
+	s := Sample{
+		Time:   time.Now(),
+		Counts: make([]float64, len(c.fds)),
+	}
+
+	for i, fd := range c.fds {
+		var v struct {
+			Value   uint64
+			Enabled uint64
+			Running uint64
+		}
+		buf := (*[unsafe.Sizeof(v)]byte)(unsafe.Pointer(&v))[:]
+		if _, err := unix.Read(fd, buf); err != nil {
+			return Sample{}, err
+		}
+
+		if v.Running == 0 {
+			continue
+		}
+		s.Counts[i] = float64(v.Value) * float64(v.Enabled) / float64(v.Running)
+	}
+
+	return s, nil
+}
+
+// Close the counters.
+func (c *Counters) Close() error {
+	var errs errutil.Errors
+	for _, fd := range c.fds {
+		if err := unix.Close(fd); err != nil {
+			errs.Add(err)
+		}
+	}
+	c.fds = nil
+	return errs.Err()
+}
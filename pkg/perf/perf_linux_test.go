@@ -0,0 +1,38 @@
+package perf
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestStart(t *testing.T) {
+	if !Available(Events) {
+		t.Skip("performance counters unavailable")
+	}
+
+	cmd := exec.Command("true")
+	c, err := Start(cmd, Events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := c.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := cmd.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := c.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Counts) != len(Events) {
+		t.Fatalf("got %d counts; expect %d", len(s.Counts), len(Events))
+	}
+	if s.Counts[0] == 0 {
+		t.Fatal("expected non-zero instruction count")
+	}
+}
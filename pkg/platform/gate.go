@@ -0,0 +1,54 @@
+package platform
+
+import (
+	"errors"
+	"flag"
+	"time"
+
+	"github.com/mmcloughlin/goperf/pkg/proc"
+	"github.com/mmcloughlin/goperf/pkg/runner"
+	"github.com/mmcloughlin/goperf/pkg/sys"
+)
+
+// gates configures checks that the system is quiet before benchmarking.
+type gates struct {
+	load    float64
+	temp    float64
+	procs   int
+	timeout time.Duration
+}
+
+func (g *gates) SetFlags(f *flag.FlagSet) {
+	f.Float64Var(&g.load, "gateload", 0, "wait for 1-minute load average to be at most this value before benchmarking (0 to disable)")
+	f.Float64Var(&g.temp, "gatetemp", 0, "wait for thermal zone temperatures to be at most this many degrees celsius before benchmarking (0 to disable)")
+	f.IntVar(&g.procs, "gateprocs", -1, "wait for at most this many other running processes before benchmarking (-1 to disable)")
+	f.DurationVar(&g.timeout, "gatetimeout", runner.DefaultGateTimeout, "give up if the system is not quiet after this long")
+}
+
+// enabled reports whether any gate is enabled.
+func (g *gates) enabled() bool {
+	return g.load > 0 || g.temp > 0 || g.procs >= 0
+}
+
+// CheckSlots returns an error if any gate is enabled. Gates are not suitable
+// for concurrent slots, since benchmarks in other slots are expected load.
+func (g *gates) CheckSlots() error {
+	if g.enabled() {
+		return errors.New("quietness gates are not supported with multiple slots")
+	}
+	return nil
+}
+
+// ConfigureRunner adds enabled gates to the runner.
+func (g *gates) ConfigureRunner(r *runner.Runner) {
+	if g.load > 0 {
+		r.Gate(sys.LoadGate{Max: g.load})
+	}
+	if g.temp > 0 {
+		r.Gate(sys.ThermalGate{Max: g.temp})
+	}
+	if g.procs >= 0 {
+		r.Gate(proc.RunningGate{Max: g.procs})
+	}
+	r.SetGateTimeout(g.timeout)
+}
@@ -4,6 +4,7 @@ package platform
 
 import (
 	"flag"
+	"fmt"
 
 	"github.com/google/subcommands"
 
@@ -13,6 +14,9 @@ import (
 )
 
 type Platform struct {
+	gates     gates
+	telemetry telemetry
+
 	base     command.Base
 	wrappers []subcommands.Command
 }
@@ -29,10 +33,23 @@ func (p *Platform) Wrappers() []subcommands.Command {
 	return p.wrappers
 }
 
-func (Platform) SetFlags(f *flag.FlagSet) {}
+func (p *Platform) SetFlags(f *flag.FlagSet) {
+	p.gates.SetFlags(f)
+	p.telemetry.SetFlags(f)
+}
 
 // ConfigureRunner sets benchmark runner options.
 func (p *Platform) ConfigureRunner(r *runner.Runner) error {
+	if err := p.wrap(r); err != nil {
+		return err
+	}
+	p.gates.ConfigureRunner(r)
+	p.telemetry.ConfigureRunner(r, nil)
+	return nil
+}
+
+// wrap applies static wrappers to the runner.
+func (p *Platform) wrap(r *runner.Runner) error {
 	for _, wrapper := range p.wrappers {
 		w, err := wrap.RunUnder(wrapper)
 		if err != nil {
@@ -42,3 +59,35 @@ func (p *Platform) ConfigureRunner(r *runner.Runner) error {
 	}
 	return nil
 }
+
+// Slots partitions the machine into n benchmark slots, for running multiple
+// benchmarks concurrently. No isolation is available on this platform.
+// Quietness gates are not supported with slots, and must not be enabled.
+func (p *Platform) Slots(n int) (*Slots, error) {
+	if err := p.gates.CheckSlots(); err != nil {
+		return nil, err
+	}
+	return &Slots{p: p, n: n}, nil
+}
+
+// Slots is a partition of the machine into benchmark slots.
+type Slots struct {
+	p *Platform
+	n int
+}
+
+// Len returns the number of slots.
+func (s *Slots) Len() int { return s.n }
+
+// ConfigureRunner sets benchmark runner options for a run in the given slot.
+func (s *Slots) ConfigureRunner(r *runner.Runner, slot int) error {
+	if slot < 0 || slot >= s.n {
+		return fmt.Errorf("slot %d out of range", slot)
+	}
+	r.AddConfigurationProvider(slotconfig(slot, s.n))
+	s.p.telemetry.ConfigureRunner(r, nil)
+	return s.p.wrap(r)
+}
+
+// Reset undoes slot configuration.
+func (s *Slots) Reset() error { return nil }
@@ -2,10 +2,15 @@ package platform
 
 import (
 	"flag"
+	"fmt"
 
 	"github.com/google/subcommands"
+	"go.uber.org/zap"
 
+	"github.com/mmcloughlin/goperf/internal/errutil"
 	"github.com/mmcloughlin/goperf/pkg/command"
+	"github.com/mmcloughlin/goperf/pkg/cpuset"
+	"github.com/mmcloughlin/goperf/pkg/perf"
 	"github.com/mmcloughlin/goperf/pkg/runner"
 	"github.com/mmcloughlin/goperf/pkg/shield"
 	"github.com/mmcloughlin/goperf/pkg/sys"
@@ -15,14 +20,19 @@ import (
 type Platform struct {
 	shieldname string
 	shieldn    int
+	placement  string
 	sysname    string
 	sysn       int
 	freqpcnt   float64
+	perfcount  bool
+	gates      gates
+	telemetry  telemetry
 
 	base   command.Base
 	cfg    subcommands.Command
 	pri    subcommands.Command
 	cpuset subcommands.Command
+	perf   subcommands.Command
 }
 
 func New(b command.Base) *Platform {
@@ -33,20 +43,135 @@ func (p *Platform) Wrappers() []subcommands.Command {
 	p.cfg = wrap.NewConfigDefault(p.base)
 	p.pri = wrap.NewPrioritize(p.base)
 	p.cpuset = wrap.NewCPUSet(p.base)
-	return []subcommands.Command{p.cfg, p.pri, p.cpuset}
+	p.perf = wrap.NewPerfCount(p.base)
+	return []subcommands.Command{p.cfg, p.pri, p.cpuset, p.perf}
 }
 
 func (p *Platform) SetFlags(f *flag.FlagSet) {
 	f.StringVar(&p.shieldname, "shield", "shield", "shield cpuset name")
 	f.IntVar(&p.shieldn, "shieldnumcpu", 0, "number of cpus in shield cpuset (0 for max)")
+	f.StringVar(&p.placement, "shieldplacement", "any", "confine shield cpus to a single topology domain (any, package, node or cache)")
 	f.StringVar(&p.sysname, "sys", "sys", "system cpuset name")
 	f.IntVar(&p.sysn, "sysnumcpu", 1, "minimum number of cpus in system cpuset")
 	f.Float64Var(&p.freqpcnt, "freqpcnt", 20, "set frequency to this percent between min and max")
+	f.BoolVar(&p.perfcount, "perfcounters", false, "report estimated hardware performance counts per benchmark operation")
+	p.gates.SetFlags(f)
+	p.telemetry.SetFlags(f)
 }
 
 // ConfigureRunner sets benchmark runner options.
 func (p *Platform) ConfigureRunner(r *runner.Runner) error {
 	// Apply static wrappers.
+	if err := p.wrap(r); err != nil {
+		return err
+	}
+
+	// Apply tuning methods and CPU shield.
+	for _, t := range p.tuners() {
+		r.Tune(t)
+	}
+
+	s, err := p.shield()
+	if err != nil {
+		return err
+	}
+	r.Tune(s)
+
+	// Wait for the system to be quiet, and monitor it while benchmarking.
+	p.gates.ConfigureRunner(r)
+	p.telemetry.ConfigureRunner(r, cpusetcpus(s.ShieldName()))
+
+	return p.wrapcpuset(r, s.ShieldName())
+}
+
+// Slots partitions the machine into n isolated benchmark slots, for running
+// multiple benchmarks concurrently. Each slot is given an exclusive portion of
+// the CPU shield. Since tuning is machine-wide, it is applied once for all
+// slots rather than for each run, and must be undone with Reset. Quietness
+// gates are not supported with slots, and must not be enabled.
+func (p *Platform) Slots(n int) (*Slots, error) {
+	if err := p.gates.CheckSlots(); err != nil {
+		return nil, err
+	}
+
+	sh, err := p.shield(shield.WithSlots(n))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Slots{
+		p:      p,
+		n:      n,
+		shield: sh,
+	}
+
+	for _, t := range append(p.tuners(), s.shield) {
+		log := p.base.Log.With(zap.String("tuner", t.Name()))
+		if !t.Available() {
+			log.Info("tuner unavailable")
+			continue
+		}
+		log.Info("applying tuner")
+		if err := t.Apply(); err != nil {
+			if rerr := s.Reset(); rerr != nil {
+				log.Error("reset slots", zap.Error(rerr))
+			}
+			return nil, fmt.Errorf("apply tuner %s: %w", t.Name(), err)
+		}
+		s.applied = append(s.applied, t)
+	}
+
+	return s, nil
+}
+
+// Slots is a partition of the machine into isolated benchmark slots.
+type Slots struct {
+	p       *Platform
+	n       int
+	shield  *shield.Shield
+	applied []runner.Tuner
+}
+
+// Len returns the number of slots.
+func (s *Slots) Len() int { return s.n }
+
+// ConfigureRunner sets benchmark runner options for a run in the given slot.
+func (s *Slots) ConfigureRunner(r *runner.Runner, slot int) error {
+	if slot < 0 || slot >= s.n {
+		return fmt.Errorf("slot %d out of range", slot)
+	}
+
+	if err := s.p.wrap(r); err != nil {
+		return err
+	}
+
+	r.AddConfigurationProvider(slotconfig(slot, s.n))
+
+	name := s.shield.SlotName(slot)
+	s.p.telemetry.ConfigureRunner(r, cpusetcpus(name))
+
+	return s.p.wrapcpuset(r, name)
+}
+
+// Reset undoes tuning applied for the slots, in reverse order.
+func (s *Slots) Reset() error {
+	var errs errutil.Errors
+	for i := len(s.applied) - 1; i >= 0; i-- {
+		if err := s.applied[i].Reset(); err != nil {
+			errs.Add(err)
+		}
+	}
+	s.applied = nil
+	return errs.Err()
+}
+
+// wrap applies static wrappers to the runner, including performance counters
+// if enabled.
+func (p *Platform) wrap(r *runner.Runner) error {
+	if err := p.wrapperf(r); err != nil {
+		return err
+	}
+
 	for _, wrapper := range []subcommands.Command{p.cfg, p.pri} {
 		w, err := wrap.RunUnder(wrapper)
 		if err != nil {
@@ -54,29 +179,74 @@ func (p *Platform) ConfigureRunner(r *runner.Runner) error {
 		}
 		r.Wrap(w)
 	}
+	return nil
+}
 
-	// Apply tuning methods. Note SMT deactivation needs to come early since it
-	// changes the number of CPUs on the platform.
-	r.Tune(sys.DeactivateSMT{})
-	r.Tune(sys.DisableIntelTurbo{})
-	r.Tune(sys.SetScalingGovernor{Governor: "performance"})
-	r.Tune(&sys.SetFrequency{Percent: p.freqpcnt})
-
-	// Setup CPU shield.
-	s := shield.NewShield(
-		shield.WithShieldName(p.shieldname),
-		shield.WithShieldNumCPU(p.shieldn),
-		shield.WithSystemName(p.sysname),
-		shield.WithSystemNumCPU(p.sysn),
-		shield.WithLogger(p.base.Log),
-	)
-	r.Tune(s)
+// wrapperf configures the runner to execute benchmark binaries with hardware
+// performance counters, if enabled and available.
+func (p *Platform) wrapperf(r *runner.Runner) error {
+	if !p.perfcount {
+		return nil
+	}
+	if !perf.Available(perf.Events) {
+		p.base.Log.Warn("performance counters unavailable")
+		return nil
+	}
+	args, err := wrap.ExecUnder(p.perf)
+	if err != nil {
+		return err
+	}
+	r.SetTestExec(args)
+	return nil
+}
 
-	w, err := wrap.RunUnderCPUSet(p.cpuset, p.shieldname)
+// wrapcpuset configures the runner to execute benchmarks in the named cpuset.
+func (p *Platform) wrapcpuset(r *runner.Runner, name string) error {
+	w, err := wrap.RunUnderCPUSet(p.cpuset, name)
 	if err != nil {
 		return err
 	}
 	r.Wrap(w)
-
 	return nil
 }
+
+// cpusetcpus returns a function listing the CPUs in the named cpuset. The
+// cpuset is read when called, since it may not exist until tuning is applied.
+func cpusetcpus(name string) func() ([]uint, error) {
+	return func() ([]uint, error) {
+		cpus, err := cpuset.NewCPUSet(name).CPUs()
+		if err != nil {
+			return nil, err
+		}
+		return cpus.SortedMembers(), nil
+	}
+}
+
+// tuners returns machine tuning methods. Note SMT deactivation needs to come
+// early since it changes the number of CPUs on the platform.
+func (p *Platform) tuners() []runner.Tuner {
+	return []runner.Tuner{
+		sys.DeactivateSMT{},
+		sys.DisableIntelTurbo{},
+		sys.SetScalingGovernor{Governor: "performance"},
+		&sys.SetFrequency{Percent: p.freqpcnt},
+	}
+}
+
+// shield builds the CPU shield.
+func (p *Platform) shield(opts ...shield.Option) (*shield.Shield, error) {
+	placement, err := shield.ParsePlacement(p.placement)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]shield.Option{
+		shield.WithShieldName(p.shieldname),
+		shield.WithShieldNumCPU(p.shieldn),
+		shield.WithSystemName(p.sysname),
+		shield.WithSystemNumCPU(p.sysn),
+		shield.WithPlacement(placement),
+		shield.WithLogger(p.base.Log),
+	}, opts...)
+	return shield.NewShield(opts...), nil
+}
@@ -0,0 +1,17 @@
+package platform
+
+import (
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+// slotconfig describes the benchmark slot a run executes in. Properties are
+// performance critical, so results from different slots are treated as
+// separate environments.
+func slotconfig(slot, n int) cfg.Provider {
+	return cfg.Section(
+		"slot",
+		"concurrent benchmark slot",
+		cfg.PerfProperty("index", "index of the slot the benchmark ran in", cfg.IntValue(slot)),
+		cfg.PerfProperty("num", "number of concurrent benchmark slots", cfg.IntValue(n)),
+	)
+}
@@ -0,0 +1,31 @@
+package platform
+
+import (
+	"flag"
+	"time"
+
+	"github.com/mmcloughlin/goperf/pkg/runner"
+	"github.com/mmcloughlin/goperf/pkg/sys"
+)
+
+// telemetry configures system sampling while benchmarks run.
+type telemetry struct {
+	interval time.Duration
+}
+
+func (t *telemetry) SetFlags(f *flag.FlagSet) {
+	f.DurationVar(&t.interval, "sampleinterval", runner.DefaultSampleInterval, "interval between system telemetry samples while benchmarks run (0 to disable)")
+}
+
+// ConfigureRunner adds telemetry samplers to the runner. Per-CPU samplers
+// are restricted to the CPUs returned by cpus, or all CPUs if nil.
+func (t *telemetry) ConfigureRunner(r *runner.Runner, cpus func() ([]uint, error)) {
+	if t.interval <= 0 {
+		return
+	}
+	r.SetSampleInterval(t.interval)
+	r.Sample(sys.FrequencySampler{CPUs: cpus})
+	r.Sample(sys.ThrottleSampler{CPUs: cpus})
+	r.Sample(sys.ThermalSampler{})
+	r.Sample(sys.MemorySampler{})
+}
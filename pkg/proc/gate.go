@@ -0,0 +1,45 @@
+package proc
+
+import (
+	"os"
+
+	"github.com/c9s/goprocinfo/linux"
+
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+const statfile = "/proc/stat"
+
+// RunningGate passes when at most Max processes other than the caller are
+// running, as reported by the `/proc/stat` file.
+type RunningGate struct {
+	Max int
+}
+
+// Name returns "running".
+func (RunningGate) Name() string { return "running" }
+
+// Available checks for the /proc/stat file.
+func (RunningGate) Available() bool {
+	_, err := os.Stat(statfile)
+	return err == nil
+}
+
+// Check counts running processes.
+func (g RunningGate) Check() (bool, cfg.Configuration, error) {
+	stat, err := linux.ReadStat(statfile)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// Exclude the calling process, which is running as it reads the file.
+	n := int(stat.ProcsRunning) - 1
+	if n < 0 {
+		n = 0
+	}
+
+	return n <= g.Max, cfg.Configuration{
+		cfg.Property("procs", "number of other running processes", cfg.IntValue(n)),
+		cfg.Property("max", "maximum permitted number of other running processes", cfg.IntValue(g.Max)),
+	}, nil
+}
@@ -0,0 +1,398 @@
+package runner
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mholt/archiver"
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/internal/errutil"
+	"github.com/mmcloughlin/goperf/pkg/lg"
+)
+
+// ToolchainCache is an on-disk cache of installed toolchains shared between
+// runs. Entries are addressed by toolchain identity, verified against a
+// recorded checksum before use, and evicted least recently used first once the
+// total size exceeds a limit.
+//
+// The cache may be shared by concurrent installs, in this process or others.
+// Reading an entry holds a shared lock on the cache directory, and modifying
+// entries holds an exclusive lock.
+type ToolchainCache struct {
+	dir     string
+	maxsize int64
+
+	mu sync.RWMutex
+}
+
+// flock locks the open file f, shared or exclusive. The lock is released when
+// f is closed. Where file locks are not supported, the cache is only locked
+// within this process.
+var flock = func(f *os.File, shared bool) error { return nil }
+
+// NewToolchainCache builds a toolchain cache in dir, holding at most maxsize
+// bytes of archived toolchains. A non-positive maxsize disables eviction.
+func NewToolchainCache(dir string, maxsize int64) *ToolchainCache {
+	return &ToolchainCache{
+		dir:     dir,
+		maxsize: maxsize,
+	}
+}
+
+// ToolchainCacheKey returns the cache key for the toolchain.
+func ToolchainCacheKey(tc Toolchain) string {
+	h := sha256.New()
+	for _, s := range []string{tc.Type(), tc.String(), tc.Ref()} {
+		_, _ = io.WriteString(h, s)
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// toolchainCacheable reports whether tc should be installed via the toolchain
+// cache. Source toolchains maintain their own cache keyed by tree hash.
+func toolchainCacheable(tc Toolchain) bool {
+	switch tc.(type) {
+	case *snapshot, *release:
+		return true
+	default:
+		return false
+	}
+}
+
+// cacheentry is the metadata recorded alongside a cached toolchain archive. The
+// modification time of the metadata file records when the entry was last used.
+type cacheentry struct {
+	Toolchain string `json:"toolchain"`
+	Ref       string `json:"ref"`
+	Root      string `json:"root"` // top-level directory in the archive
+	SHA256    string `json:"sha256"`
+	Size      int64  `json:"size"`
+}
+
+// Install tc to root within the workspace, using a cached copy if one is
+// available. Otherwise the toolchain is installed and saved to the cache.
+// Failures to save to the cache are logged but do not fail the install.
+func (c *ToolchainCache) Install(ctx context.Context, w *Workspace, tc Toolchain, root string) error {
+	key := ToolchainCacheKey(tc)
+	log := w.Log.With(zap.Stringer("toolchain", tc), zap.String("key", key))
+
+	defer lg.Scope(log, "toolchain_cache_install")()
+
+	hit, err := c.restore(w, log, key, root)
+	if err != nil {
+		log.Warn("toolchain cache restore failed", zap.Error(err))
+		if err := os.RemoveAll(root); err != nil {
+			return err
+		}
+	}
+	if hit {
+		return nil
+	}
+
+	if err := tc.Install(ctx, w, root); err != nil {
+		return err
+	}
+
+	if err := c.store(w, tc, key, root); err != nil {
+		log.Warn("toolchain cache store failed", zap.Error(err))
+	}
+
+	if err := c.evict(log); err != nil {
+		log.Warn("toolchain cache eviction failed", zap.Error(err))
+	}
+
+	return nil
+}
+
+// restore attempts to install the cache entry key to root, reporting whether
+// it was found.
+func (c *ToolchainCache) restore(w *Workspace, log *zap.Logger, key, root string) (_ bool, err error) {
+	l, err := c.lock(true)
+	if err != nil {
+		return false, err
+	}
+	defer errutil.CheckClose(&err, l)
+
+	e, err := c.entry(key)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Info("toolchain cache miss")
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("toolchain cache entry unreadable: %w", err)
+	}
+
+	// Verify integrity. Invalid entries are left for eviction, since removal
+	// requires an exclusive lock.
+	archive := c.archivepath(key)
+	if err := verify(archive, e.SHA256); err != nil {
+		return false, fmt.Errorf("toolchain cache integrity check failed: %w", err)
+	}
+
+	log.Info("toolchain cache hit", zap.String("archive", archive))
+
+	// Record use.
+	now := time.Now()
+	if err := os.Chtimes(c.metapath(key), now, now); err != nil {
+		log.Warn("toolchain cache access time update failed", zap.Error(err))
+	}
+
+	// Extract.
+	dir, err := w.Sandbox("cache")
+	if err != nil {
+		return false, err
+	}
+	if err := w.Uncompress(archive, dir); err != nil {
+		return false, err
+	}
+	if err := w.Move(filepath.Join(dir, e.Root), root); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// store the toolchain installed at root to the cache entry key.
+func (c *ToolchainCache) store(w *Workspace, tc Toolchain, key, root string) (err error) {
+	defer lg.Scope(w.Log, "toolchain_cache_store")()
+
+	if err := os.MkdirAll(c.dir, 0o777); err != nil {
+		return err
+	}
+
+	// Build the archive in a temporary directory within the cache, so it can be
+	// moved into place atomically.
+	tmp, err := ioutil.TempDir(c.dir, "tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr := os.RemoveAll(tmp); rerr != nil && err == nil {
+			err = rerr
+		}
+	}()
+
+	partial := filepath.Join(tmp, "toolchain.tar.gz")
+	if err := archiver.Archive([]string{root}, partial); err != nil {
+		return fmt.Errorf("archive toolchain: %w", err)
+	}
+
+	sum, size, err := checksum(partial)
+	if err != nil {
+		return err
+	}
+
+	// Write metadata.
+	e := cacheentry{
+		Toolchain: tc.String(),
+		Ref:       tc.Ref(),
+		Root:      filepath.Base(root),
+		SHA256:    sum,
+		Size:      size,
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	meta := filepath.Join(tmp, "meta.json")
+	if err := ioutil.WriteFile(meta, b, 0o644); err != nil {
+		return err
+	}
+
+	// Move into place. The archive goes first, since an entry is only
+	// considered present if its metadata exists.
+	l, err := c.lock(false)
+	if err != nil {
+		return err
+	}
+	defer errutil.CheckClose(&err, l)
+
+	if err := os.Rename(partial, c.archivepath(key)); err != nil {
+		return err
+	}
+	return os.Rename(meta, c.metapath(key))
+}
+
+// evict least recently used entries until the cache is within its size limit.
+func (c *ToolchainCache) evict(log *zap.Logger) (err error) {
+	if c.maxsize <= 0 {
+		return nil
+	}
+
+	l, err := c.lock(false)
+	if err != nil {
+		return err
+	}
+	defer errutil.CheckClose(&err, l)
+
+	type usage struct {
+		Key      string
+		Size     int64
+		LastUsed time.Time
+	}
+
+	metas, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	var entries []usage
+	var total int64
+	for _, meta := range metas {
+		key := strings.TrimSuffix(filepath.Base(meta), ".json")
+		info, err := os.Stat(meta)
+		if err != nil {
+			continue
+		}
+		e, err := c.entry(key)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, usage{
+			Key:      key,
+			Size:     e.Size,
+			LastUsed: info.ModTime(),
+		})
+		total += e.Size
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LastUsed.Before(entries[j].LastUsed)
+	})
+
+	for _, u := range entries {
+		if total <= c.maxsize {
+			break
+		}
+		log.Info("toolchain cache evict",
+			zap.String("key", u.Key),
+			zap.Int64("size", u.Size),
+			zap.Time("last_used", u.LastUsed),
+		)
+		c.remove(log, u.Key)
+		total -= u.Size
+	}
+
+	return nil
+}
+
+// cachelock is a held lock on the cache directory.
+type cachelock struct {
+	f      *os.File
+	unlock func()
+}
+
+// Close releases the lock.
+func (l *cachelock) Close() error {
+	defer l.unlock()
+	return l.f.Close()
+}
+
+// lock the cache directory, shared or exclusive.
+func (c *ToolchainCache) lock(shared bool) (*cachelock, error) {
+	lock, unlock := c.mu.Lock, c.mu.Unlock
+	if shared {
+		lock, unlock = c.mu.RLock, c.mu.RUnlock
+	}
+
+	lock()
+	f, err := c.lockfile(shared)
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+
+	return &cachelock{f: f, unlock: unlock}, nil
+}
+
+func (c *ToolchainCache) lockfile(shared bool) (*os.File, error) {
+	if err := os.MkdirAll(c.dir, 0o777); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(c.dir, "lock"), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := flock(f, shared); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("lock toolchain cache: %w", err)
+	}
+
+	return f, nil
+}
+
+// entry reads the metadata for the cache entry key.
+func (c *ToolchainCache) entry(key string) (*cacheentry, error) {
+	b, err := ioutil.ReadFile(c.metapath(key))
+	if err != nil {
+		return nil, err
+	}
+	e := &cacheentry{}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// remove the cache entry key. Metadata is removed first, so concurrent readers
+// see the entry as absent.
+func (c *ToolchainCache) remove(log *zap.Logger, key string) {
+	for _, path := range []string{c.metapath(key), c.archivepath(key)} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Warn("toolchain cache remove failed", zap.String("path", path), zap.Error(err))
+		}
+	}
+}
+
+func (c *ToolchainCache) metapath(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+func (c *ToolchainCache) archivepath(key string) string {
+	return filepath.Join(c.dir, key+".tar.gz")
+}
+
+// verify that the file at path has the expected SHA-256 checksum.
+func verify(path, expect string) error {
+	sum, _, err := checksum(path)
+	if err != nil {
+		return err
+	}
+	if sum != expect {
+		return fmt.Errorf("checksum mismatch for %s: got %s expect %s", path, sum, expect)
+	}
+	return nil
+}
+
+// checksum returns the hex SHA-256 checksum and size of the file at path.
+func checksum(path string) (sum string, size int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer errutil.CheckClose(&err, f)
+
+	h := sha256.New()
+	size, err = io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
@@ -0,0 +1,21 @@
+package runner
+
+import (
+	"os"
+	"syscall"
+)
+
+func init() {
+	flock = func(f *os.File, shared bool) error {
+		how := syscall.LOCK_EX
+		if shared {
+			how = syscall.LOCK_SH
+		}
+		for {
+			err := syscall.Flock(int(f.Fd()), how)
+			if err != syscall.EINTR {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+// fake is a toolchain that writes a single file on install, and counts the
+// number of times it was installed.
+type fake struct {
+	name     string
+	contents []byte
+	installs int
+}
+
+func (f *fake) Type() string                              { return "fake" }
+func (f *fake) String() string                            { return "fake/" + f.name }
+func (f *fake) Ref() string                               { return f.name }
+func (f *fake) Configuration() (cfg.Configuration, error) { return nil, nil }
+
+func (f *fake) Install(ctx context.Context, w *Workspace, root string) error {
+	f.installs++
+	if err := os.MkdirAll(filepath.Join(root, "bin"), 0o777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(root, "bin", "go"), f.contents, 0o755)
+}
+
+// install tc with the cache into a fresh workspace, and confirm the installed
+// contents.
+func install(t *testing.T, c *ToolchainCache, tc *fake) {
+	t.Helper()
+
+	w, err := NewWorkspace(WithWorkDir(test.TempDir(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := w.Path("goroot")
+	if err := c.Install(context.Background(), w, tc, root); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ioutil.ReadFile(filepath.Join(root, "bin", "go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, tc.contents) {
+		t.Fatalf("installed contents %q; expect %q", got, tc.contents)
+	}
+}
+
+func TestToolchainCacheHit(t *testing.T) {
+	c := NewToolchainCache(test.TempDir(t), 0)
+	tc := &fake{name: "a", contents: []byte("a")}
+
+	for i := 0; i < 3; i++ {
+		install(t, c, tc)
+	}
+
+	if tc.installs != 1 {
+		t.Fatalf("toolchain installed %d times; expect 1", tc.installs)
+	}
+}
+
+func TestToolchainCacheIntegrity(t *testing.T) {
+	dir := test.TempDir(t)
+	c := NewToolchainCache(dir, 0)
+	tc := &fake{name: "a", contents: []byte("a")}
+
+	install(t, c, tc)
+
+	// Corrupt the archive.
+	archive := c.archivepath(ToolchainCacheKey(tc))
+	if err := ioutil.WriteFile(archive, []byte("corrupt"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect a reinstall.
+	install(t, c, tc)
+	if tc.installs != 2 {
+		t.Fatalf("toolchain installed %d times; expect 2", tc.installs)
+	}
+}
+
+func TestToolchainCacheEviction(t *testing.T) {
+	dir := test.TempDir(t)
+	c := NewToolchainCache(dir, 0)
+
+	// Populate cache.
+	tcs := []*fake{
+		{name: "a", contents: bytes.Repeat([]byte("a"), 1024)},
+		{name: "b", contents: bytes.Repeat([]byte("b"), 1024)},
+		{name: "c", contents: bytes.Repeat([]byte("c"), 1024)},
+	}
+	for _, tc := range tcs {
+		install(t, c, tc)
+	}
+
+	// Set access times so that "b" is least recently used.
+	base := time.Now().Add(-time.Hour)
+	for i, name := range []string{"b", "a", "c"} {
+		tc := &fake{name: name}
+		when := base.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(c.metapath(ToolchainCacheKey(tc)), when, when); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Limit the cache to the size of entries "a" and "c" and evict.
+	for _, tc := range []*fake{tcs[0], tcs[2]} {
+		e, err := c.entry(ToolchainCacheKey(tc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.maxsize += e.Size
+	}
+	if err := c.evict(zap.NewNop()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Confirm "b" was evicted.
+	for _, tc := range tcs {
+		_, err := c.entry(ToolchainCacheKey(tc))
+		present := err == nil
+		if expect := tc.name != "b"; present != expect {
+			t.Errorf("entry %s: present=%v; expect %v", tc.name, present, expect)
+		}
+	}
+}
+
+func TestToolchainCacheRestoreFallback(t *testing.T) {
+	dir := test.TempDir(t)
+	c := NewToolchainCache(dir, 0)
+	tc := &fake{name: "a", contents: []byte("a")}
+
+	install(t, c, tc)
+
+	// Point the entry metadata at a directory that is not in the archive, so
+	// extraction fails after the integrity check passes.
+	key := ToolchainCacheKey(tc)
+	e, err := c.entry(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Root = "missing"
+	b, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(c.metapath(key), b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expect a fresh install.
+	install(t, c, tc)
+	if tc.installs != 2 {
+		t.Fatalf("toolchain installed %d times; expect 2", tc.installs)
+	}
+}
+
+func TestToolchainCacheConcurrent(t *testing.T) {
+	// Cache only large enough for one entry, so installs constantly evict each
+	// other's entries.
+	c := NewToolchainCache(test.TempDir(t), 2048)
+
+	const n, m = 8, 4
+	errc := make(chan error, n)
+	for i := 0; i < n; i++ {
+		tc := &fake{
+			name:     strconv.Itoa(i % 2),
+			contents: bytes.Repeat([]byte{byte('a' + i%2)}, 1024),
+		}
+		dirs := make([]string, m)
+		for j := range dirs {
+			dirs[j] = test.TempDir(t)
+		}
+		go func() {
+			for _, dir := range dirs {
+				w, err := NewWorkspace(WithWorkDir(dir))
+				if err != nil {
+					errc <- err
+					return
+				}
+				root := w.Path("goroot")
+				if err := c.Install(context.Background(), w, tc, root); err != nil {
+					errc <- err
+					return
+				}
+				got, err := ioutil.ReadFile(filepath.Join(root, "bin", "go"))
+				if err != nil {
+					errc <- err
+					return
+				}
+				if !bytes.Equal(got, tc.contents) {
+					errc <- fmt.Errorf("installed contents %q; expect %q", got, tc.contents)
+					return
+				}
+			}
+			errc <- nil
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		if err := <-errc; err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+	"github.com/mmcloughlin/goperf/pkg/lg"
+)
+
+// Gate is a pre-flight check that a system is quiet enough for benchmarking.
+type Gate interface {
+	// Name identifies the gate. Must be a valid configuration key.
+	Name() string
+
+	// Available checks whether the gate can be checked at all. Unavailable
+	// gates are skipped.
+	Available() bool
+
+	// Check takes readings of system state, reporting whether the system is
+	// quiet. Readings are returned as configuration, so they may be recorded
+	// alongside benchmark results.
+	Check() (bool, cfg.Configuration, error)
+}
+
+// ErrNoisy is returned when the system does not pass all gates within the gate
+// timeout.
+var ErrNoisy = errors.New("system too noisy for benchmarking")
+
+// Default gate timing parameters.
+const (
+	DefaultGateTimeout  = 10 * time.Minute
+	DefaultGateInterval = 5 * time.Second
+)
+
+// wait blocks until the system passes all gates, returning configuration
+// recording the wait and the final readings. Returns an error wrapping ErrNoisy
+// if the gates do not pass before the gate timeout.
+func (r *Runner) wait(ctx context.Context) (cfg.Configuration, error) {
+	defer lg.Scope(r.w.Log, "gate")()
+
+	// Determine available gates.
+	var gates []Gate
+	for _, g := range r.gates {
+		if !g.Available() {
+			r.w.Log.Info("gate unavailable", zap.String("gate", g.Name()))
+			continue
+		}
+		gates = append(gates, g)
+	}
+
+	// Check until quiet, or the timeout expires.
+	start := time.Now()
+	ticker := time.NewTicker(r.gateinterval)
+	defer ticker.Stop()
+
+	for checks := 1; ; checks++ {
+		noisy, readings, err := check(gates)
+		if err != nil {
+			return nil, err
+		}
+
+		waited := time.Since(start)
+		if len(noisy) == 0 {
+			r.w.Log.Info("system quiet", zap.Duration("waited", waited), zap.Int("checks", checks))
+			c := cfg.Configuration{
+				cfg.Property("waited", "time spent waiting for the system to become quiet", waited),
+				cfg.Property("checks", "number of system quietness checks", cfg.IntValue(checks)),
+			}
+			return append(c, readings...), nil
+		}
+
+		r.w.Log.Info("system noisy", zap.Strings("gates", noisy), zap.Duration("waited", waited))
+		if waited >= r.gatetimeout {
+			return nil, fmt.Errorf("%w: gates %v did not pass after %s", ErrNoisy, noisy, waited)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// check all gates, returning the names of gates that did not pass and readings
+// from all gates.
+func check(gates []Gate) ([]string, cfg.Configuration, error) {
+	var noisy []string
+	readings := cfg.Configuration{}
+	for _, g := range gates {
+		quiet, c, err := g.Check()
+		if err != nil {
+			return nil, nil, fmt.Errorf("check gate %s: %w", g.Name(), err)
+		}
+		if !quiet {
+			noisy = append(noisy, g.Name())
+		}
+		section := cfg.Section(
+			cfg.Key(g.Name()),
+			fmt.Sprintf("readings for %s gate", g.Name()),
+			c...,
+		)
+		readings = append(readings, section)
+	}
+	return noisy, readings, nil
+}
@@ -0,0 +1,70 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+// countdown is a gate that passes once it has been checked a given number of
+// times.
+type countdown struct {
+	remaining int
+}
+
+func (*countdown) Name() string    { return "countdown" }
+func (*countdown) Available() bool { return true }
+
+func (g *countdown) Check() (bool, cfg.Configuration, error) {
+	g.remaining--
+	c := cfg.Configuration{
+		cfg.Property("remaining", "checks remaining", cfg.IntValue(g.remaining)),
+	}
+	return g.remaining <= 0, c, nil
+}
+
+func gatedrunner(t *testing.T, g Gate, timeout time.Duration) *Runner {
+	t.Helper()
+	w, err := NewWorkspace(WithWorkDir(test.TempDir(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(w, nil)
+	r.Gate(g)
+	r.SetGateTimeout(timeout)
+	r.gateinterval = time.Millisecond
+	return r
+}
+
+func TestRunnerWaitQuiet(t *testing.T) {
+	r := gatedrunner(t, &countdown{remaining: 3}, time.Minute)
+
+	c, err := r.wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	checks, ok := c[1].(cfg.PropertyEntry)
+	if !ok || checks.Key() != "checks" {
+		t.Fatalf("unexpected entry %v", c[1])
+	}
+	if got := checks.Value.String(); got != "3" {
+		t.Fatalf("got %s checks; expect 3", got)
+	}
+}
+
+func TestRunnerWaitNoisy(t *testing.T) {
+	r := gatedrunner(t, &countdown{remaining: 1 << 30}, 20*time.Millisecond)
+
+	_, err := r.wait(context.Background())
+	if !errors.Is(err, ErrNoisy) {
+		t.Fatalf("got error %v; expect %v", err, ErrNoisy)
+	}
+}
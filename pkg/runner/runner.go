@@ -3,13 +3,20 @@ package runner
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/mmcloughlin/goperf/internal/errutil"
 	"github.com/mmcloughlin/goperf/pkg/cfg"
 	"github.com/mmcloughlin/goperf/pkg/job"
 	"github.com/mmcloughlin/goperf/pkg/lg"
@@ -18,12 +25,22 @@ import (
 type Runner struct {
 	w         *Workspace
 	tc        Toolchain
+	tccache   *ToolchainCache
 	goproxy   string
+	gosumdb   string
 	tuners    []Tuner
 	providers cfg.Providers
 
+	gates        []Gate
+	gatetimeout  time.Duration
+	gateinterval time.Duration
+
+	samplers       []Sampler
+	sampleinterval time.Duration
+
 	gobin    string
 	wrappers []Wrapper
+	testexec []string
 }
 
 func NewRunner(w *Workspace, tc Toolchain) *Runner {
@@ -31,6 +48,11 @@ func NewRunner(w *Workspace, tc Toolchain) *Runner {
 		w:       w,
 		tc:      tc,
 		goproxy: "https://proxy.golang.org",
+
+		gatetimeout:  DefaultGateTimeout,
+		gateinterval: DefaultGateInterval,
+
+		sampleinterval: DefaultSampleInterval,
 	}
 }
 
@@ -40,44 +62,105 @@ func (r *Runner) SetGoProxy(proxy string) {
 	r.goproxy = proxy
 }
 
+// SetModuleProxy configures the runner to fetch modules only from the
+// file-based module proxy in dir, such as one populated by "bench vendor".
+// Checksum database verification is disabled, since it requires network
+// access; modules are verified when they are added to the proxy.
+func (r *Runner) SetModuleProxy(dir string) error {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}
+	r.SetGoProxy(u.String())
+	r.gosumdb = "off"
+	return nil
+}
+
+// SetToolchainCache configures a cache for toolchain installs, shared between
+// runs.
+func (r *Runner) SetToolchainCache(c *ToolchainCache) {
+	r.tccache = c
+}
+
 // Init initializes the runner.
-func (r *Runner) Init(ctx context.Context) {
+func (r *Runner) Init(ctx context.Context) error {
 	defer lg.Scope(r.w.Log, "initializing")()
 
 	// Install toolchain.
 	r.w.Log.Info("install toolchain", zap.Stringer("toolchain", r.tc))
 	goroot := r.w.Path("goroot")
-	r.tc.Install(r.w, goroot)
+	var err error
+	if r.tccache != nil && toolchainCacheable(r.tc) {
+		err = r.tccache.Install(ctx, r.w, r.tc, goroot)
+	} else {
+		err = r.tc.Install(ctx, r.w, goroot)
+	}
+	if err != nil {
+		return fmt.Errorf("install toolchain: %w", err)
+	}
 
 	gorootbin := filepath.Join(goroot, "bin")
 	r.gobin = filepath.Join(gorootbin, "go")
 
 	// Configure Go environment.
 	r.w.SetEnv("GOROOT", goroot)
-	r.w.SetEnv("GOPATH", r.w.EnsureDir("gopath"))
-	r.w.SetEnv("GOCACHE", r.w.EnsureDir("gocache"))
+	for _, d := range []struct{ Key, Rel string }{
+		{"GOPATH", "gopath"},
+		{"GOCACHE", "gocache"},
+	} {
+		dir, err := r.w.EnsureDir(d.Rel)
+		if err != nil {
+			return err
+		}
+		r.w.SetEnv(d.Key, dir)
+	}
 	r.w.SetEnv("GO111MODULE", "on")
 	r.w.SetEnv("GOPROXY", r.goproxy)
-	r.w.DefineTool("AR", "ar")
-	r.w.DefineTool("CC", "gcc")
-	r.w.DefineTool("CXX", "g++")
-	r.w.DefineTool("PKG_CONFIG", "pkg-config")
+	if r.gosumdb != "" {
+		r.w.SetEnv("GOSUMDB", r.gosumdb)
+	}
+	for _, t := range []struct{ Key, Default string }{
+		{"AR", "ar"},
+		{"CC", "gcc"},
+		{"CXX", "g++"},
+		{"PKG_CONFIG", "pkg-config"},
+	} {
+		if err := r.w.DefineTool(t.Key, t.Default); err != nil {
+			return err
+		}
+	}
 
 	// Environment required by standard library tests.
 	// BenchmarkExecHostname calls "hostname".
 	// https://github.com/golang/go/blob/83610c90bbe4f5f0b18ac01da3f3921c2f7090e4/src/os/exec/bench_test.go#L11
-	r.w.ExposeTool("hostname")
+	if err := r.w.ExposeTool("hostname"); err != nil {
+		return err
+	}
 
 	// Environment checks.
-	r.GoExec(ctx, "version")
-	r.GoExec(ctx, "env")
+	if err := r.GoExec(ctx, "version"); err != nil {
+		return err
+	}
+	return r.GoExec(ctx, "env")
 }
 
-// Clean up the runner.
-func (r *Runner) Clean(ctx context.Context) {
+// Clean up the runner. Cleanup should be performed even if the benchmark was
+// cancelled, so callers will typically pass a context independent of the one
+// used for Init and Benchmark.
+func (r *Runner) Clean(ctx context.Context) error {
 	defer lg.Scope(r.w.Log, "clean")()
-	r.GoExec(ctx, "clean", "-cache", "-testcache", "-modcache")
-	r.w.Clean()
+
+	// The module cache is read-only, so it must be removed by the go command
+	// before the workspace can be deleted. This is only possible if the
+	// toolchain was installed.
+	if _, err := os.Stat(r.gobin); err == nil {
+		if err := r.GoExec(ctx, "clean", "-cache", "-testcache", "-modcache"); err != nil {
+			return err
+		}
+	}
+
+	return r.w.Clean()
 }
 
 // Go builds a command with the downloaded go version.
@@ -86,8 +169,8 @@ func (r *Runner) Go(ctx context.Context, arg ...string) *exec.Cmd {
 }
 
 // GoExec executes the go binary with the given arguments.
-func (r *Runner) GoExec(ctx context.Context, arg ...string) {
-	r.w.Exec(r.Go(ctx, arg...))
+func (r *Runner) GoExec(ctx context.Context, arg ...string) error {
+	return r.w.Exec(ctx, r.Go(ctx, arg...))
 }
 
 // AddConfigurationProvider adds a configuration provider that will be applied
@@ -101,13 +184,44 @@ func (r *Runner) Tune(t Tuner) {
 	r.tuners = append(r.tuners, t)
 }
 
+// Gate requires the system to pass the given check before benchmarks are run.
+func (r *Runner) Gate(g Gate) {
+	r.gates = append(r.gates, g)
+}
+
+// SetGateTimeout sets how long to wait for the system to pass all gates before
+// giving up.
+func (r *Runner) SetGateTimeout(d time.Duration) {
+	r.gatetimeout = d
+}
+
+// Sample records telemetry from the given sampler while benchmarks run.
+func (r *Runner) Sample(s Sampler) {
+	r.samplers = append(r.samplers, s)
+}
+
+// SetSampleInterval sets the interval between telemetry samples.
+func (r *Runner) SetSampleInterval(d time.Duration) {
+	r.sampleinterval = d
+}
+
+// SetTestExec configures test binaries to be run under the given command
+// line, via the "go test -exec" flag. Unlike wrappers, this applies only to
+// the test binaries and not the go command itself.
+func (r *Runner) SetTestExec(args []string) {
+	r.testexec = args
+}
+
 // Wrap configures the wrapper w to be applied to benchmark runs. Wrappers are applied in the order they are added.
 func (r *Runner) Wrap(w ...Wrapper) {
 	r.wrappers = append(r.wrappers, w...)
 }
 
-// Benchmark runs the benchmark suite.
-func (r *Runner) Benchmark(ctx context.Context, s job.Suite, output string) {
+// Benchmark runs the benchmark suite. Applied tuners are always reset before
+// return, including when the context is cancelled mid-benchmark. If gates are
+// configured, benchmarks only run once the system passes them; an error
+// wrapping ErrNoisy is returned if it never does.
+func (r *Runner) Benchmark(ctx context.Context, s job.Suite, output string) (err error) {
 	defer lg.Scope(r.w.Log, "benchmark")()
 
 	// Apply tuners.
@@ -119,33 +233,97 @@ func (r *Runner) Benchmark(ctx context.Context, s job.Suite, output string) {
 		}
 		log.Info("applying tuner")
 		if err := t.Apply(); err != nil {
-			r.w.seterr(err)
-			return
+			return fmt.Errorf("apply tuner %s: %w", t.Name(), err)
 		}
 		defer func(t Tuner) {
 			log.Info("reset tuner")
-			r.w.seterr(t.Reset())
+			if rerr := t.Reset(); rerr != nil {
+				log.Error("reset tuner", zap.Error(rerr))
+				if err == nil {
+					err = fmt.Errorf("reset tuner %s: %w", t.Name(), rerr)
+				}
+			}
 		}(t)
 	}
 
 	// Setup.
-	dir := r.w.Sandbox("bench")
-	r.GoExec(ctx, "mod", "init", "bench")
+	dir, err := r.w.Sandbox("bench")
+	if err != nil {
+		return err
+	}
+
+	if err := r.GoExec(ctx, "mod", "init", "bench"); err != nil {
+		return err
+	}
+
+	// Pin required module versions.
+	if len(s.Requires) > 0 {
+		args := []string{"mod", "edit"}
+		for _, m := range s.Requires {
+			args = append(args, "-require="+m.String())
+		}
+		if err := r.GoExec(ctx, args...); err != nil {
+			return err
+		}
+	}
 
 	if !s.Module.IsMeta() {
-		r.GoExec(ctx, "get", "-t", s.Module.String())
+		if err := r.GoExec(ctx, "get", "-t", s.Module.String()); err != nil {
+			return err
+		}
 	}
 
-	// Open the output.
+	// Run the benchmark.
 	outputfile := filepath.Join(dir, "bench.out")
+	telemetryfile := filepath.Join(dir, "telemetry.jsonl")
+	if err := r.run(ctx, s, outputfile, telemetryfile); err != nil {
+		return err
+	}
+
+	// Save the result.
+	if len(r.samplers) > 0 {
+		if err := r.w.Artifact(ctx, telemetryfile, TelemetryArtifactName(output)); err != nil {
+			return err
+		}
+	}
+
+	return r.w.Artifact(ctx, outputfile, output)
+}
+
+// TelemetryArtifactName returns the name of the telemetry sidecar artifact
+// saved alongside the named benchmark output.
+func TelemetryArtifactName(output string) string {
+	return output + ".telemetry.jsonl"
+}
+
+// run executes the benchmark suite, writing to outputfile. Telemetry samples
+// are written to telemetryfile if samplers are configured.
+func (r *Runner) run(ctx context.Context, s job.Suite, outputfile, telemetryfile string) (err error) {
+	// Wait for the system to be quiet.
+	var gate cfg.Configuration
+	if len(r.gates) > 0 {
+		gate, err = r.wait(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Run the benchmark. Results are written to a temporary file first, so
+	// that the configuration written ahead of them can summarize the run.
+	rawfile := outputfile + ".raw"
+	summary, err := r.execute(ctx, s, rawfile, telemetryfile)
+	if err != nil {
+		return err
+	}
+
+	// Open the output.
 	f, err := os.Create(outputfile)
 	if err != nil {
-		// TODO(mbm): cleaner seterr() mechanism on workspace
-		r.w.seterr(err)
-		return
+		return err
 	}
+	defer errutil.CheckClose(&err, f)
 
-	// Write static configuration.
+	// Write configuration.
 	providers := cfg.Providers{
 		ToolchainConfigurationProvider(r.tc),
 		suiteconfig(s),
@@ -154,28 +332,112 @@ func (r *Runner) Benchmark(ctx context.Context, s job.Suite, output string) {
 
 	c, err := providers.Configuration()
 	if err != nil {
-		r.w.seterr(err)
-		return
+		return err
+	}
+
+	if gate != nil {
+		c = append(c, cfg.Section("gate", "pre-flight system quietness checks", gate...))
+	}
+
+	if summary != nil {
+		c = append(c, cfg.Section("telemetry", "system telemetry sampled during benchmark execution", summary...))
 	}
 
 	if err := cfg.Write(f, c); err != nil {
-		r.w.seterr(err)
-		return
+		return err
 	}
 
-	// Prepare the benchmark.
-	args := testargs(s)
-	cmd := r.Go(ctx, args...)
+	// Copy benchmark results.
+	raw, err := os.Open(rawfile)
+	if err != nil {
+		return err
+	}
+	defer errutil.CheckClose(&err, raw)
+
+	_, err = io.Copy(f, raw)
+	return err
+}
 
-	for _, w := range r.wrappers {
-		w(cmd)
+// execute runs the benchmark suite, writing benchmark output to rawfile. If
+// samplers are configured, telemetry is sampled for the duration of the run
+// and written to telemetryfile, and a summary is returned.
+func (r *Runner) execute(ctx context.Context, s job.Suite, rawfile, telemetryfile string) (_ cfg.Configuration, err error) {
+	f, err := os.Create(rawfile)
+	if err != nil {
+		return nil, err
 	}
+	defer errutil.CheckClose(&err, f)
 
-	cmd.Stdout = f
-	r.w.Exec(cmd)
+	if len(r.samplers) == 0 {
+		return nil, r.rounds(ctx, s, f)
+	}
 
-	// Save the result.
-	r.w.Artifact(outputfile, output)
+	// Sample telemetry in the background while the benchmark runs.
+	tf, err := os.Create(telemetryfile)
+	if err != nil {
+		return nil, err
+	}
+	defer errutil.CheckClose(&err, tf)
+
+	t := newtelemetry(r.w.Log, r.samplers, r.sampleinterval, tf)
+
+	tctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- t.run(tctx) }()
+
+	err = r.rounds(ctx, s, f)
+	cancel()
+	if terr := <-done; terr != nil && err == nil {
+		err = fmt.Errorf("telemetry: %w", terr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Configuration(), nil
+}
+
+// rounds executes "go test" for the suite, writing output to out. Interleaved
+// suites are executed in multiple rounds, so that repetitions of each
+// benchmark are spread across the run.
+func (r *Runner) rounds(ctx context.Context, s job.Suite, out io.Writer) error {
+	// The -skip flag was added in Go 1.20, and older toolchains reject it.
+	// Exclusion regular expressions cannot be expressed as a -run or -bench
+	// selection, so fail rather than run benchmarks the suite excludes.
+	if s.Skip != "" {
+		ok, err := r.hastestflag(ctx, "skip")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("toolchain does not support -skip: cannot exclude %q", s.Skip)
+		}
+	}
+
+	args := testargs(s)
+	if len(r.testexec) > 0 {
+		testexec, err := quotefields(r.testexec)
+		if err != nil {
+			return err
+		}
+		args = append([]string{args[0], "-exec", testexec}, args[1:]...)
+	}
+	for i := 0; i < s.Rounds(); i++ {
+		cmd := r.Go(ctx, args...)
+
+		for _, w := range r.wrappers {
+			w(cmd)
+		}
+
+		cmd.Stdout = out
+		if err := r.w.Exec(ctx, cmd); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func suiteconfig(s job.Suite) cfg.Provider {
@@ -185,14 +447,30 @@ func suiteconfig(s job.Suite) cfg.Provider {
 		cfg.Property("mod", "benchmark suite module", s.Module),
 		cfg.Property("modpath", "module path for the benchmark suite", cfg.StringValue(s.Module.Path)),
 		cfg.Property("modversion", "module version for the benchmark suite", cfg.StringValue(s.Module.Version)),
+		cfg.Property("pkg", "package pattern for the benchmark suite", cfg.StringValue(s.Target())),
 		cfg.Property("benchmarks", "benchmarks regular expression", cfg.StringValue(s.BenchmarkRegex())),
+		cfg.Property("skip", "skip tests and benchmarks regular expression", cfg.StringValue(s.Skip)),
 		cfg.Property("benchtime", "minimum benchmark time", s.BenchmarkTime()),
+		cfg.Property("count", "number of times each benchmark is run", cfg.IntValue(s.BenchmarkCount())),
+		cfg.Property("interleave", "benchmark repetitions interleaved across packages", cfg.BoolValue(s.Interleave)),
 		cfg.Property("tests", "tests regular expression", cfg.StringValue(s.TestRegex())),
 		cfg.Property("short", "short test mode enabled", cfg.BoolValue(s.Short)),
 		cfg.Property("timeout", "timeout for total test binary execution time", s.Timeout),
+		cfg.Property("requires", "number of pinned module versions", cfg.IntValue(len(s.Requires))),
 	)
 }
 
+// hastestflag reports whether the toolchain's "go test" supports the named
+// flag, according to "go help testflag".
+func (r *Runner) hastestflag(ctx context.Context, name string) (bool, error) {
+	out, err := r.w.Output(ctx, r.Go(ctx, "help", "testflag"))
+	if err != nil {
+		return false, err
+	}
+	flag := regexp.MustCompile(`(?m)^\s*-` + regexp.QuoteMeta(name) + `\b`)
+	return flag.MatchString(out), nil
+}
+
 // testargs builds "go test" arguments for the given suite.
 func testargs(s job.Suite) []string {
 	if s.Module.IsMeta() {
@@ -204,16 +482,37 @@ func testargs(s job.Suite) []string {
 		args = append(args, "-short")
 	}
 	args = append(args, "-bench", s.BenchmarkRegex())
+	if s.Skip != "" {
+		args = append(args, "-skip", s.Skip)
+	}
 	args = append(args, "-benchtime", s.BenchmarkTime().String())
+	args = append(args, "-count", strconv.Itoa(s.RoundCount()))
 	args = append(args, "-timeout", durationdefault(s.Timeout, "0"))
-	if s.Module.IsMeta() {
-		args = append(args, s.Module.Path)
-	} else {
-		args = append(args, s.Module.Path+"/...")
-	}
+	args = append(args, s.Target())
 	return args
 }
 
+// quotefields joins args into a single string that the go command splits back
+// into the same fields, as it does for the "-exec" flag. Arguments containing
+// spaces or quotes are quoted with whichever quote character they do not
+// contain.
+func quotefields(args []string) (string, error) {
+	fields := make([]string, len(args))
+	for i, arg := range args {
+		switch {
+		case arg != "" && !strings.ContainsAny(arg, " \t\n\r'\""):
+			fields[i] = arg
+		case !strings.Contains(arg, "'"):
+			fields[i] = "'" + arg + "'"
+		case !strings.Contains(arg, `"`):
+			fields[i] = `"` + arg + `"`
+		default:
+			return "", fmt.Errorf("argument %q contains both single and double quotes", arg)
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
 // duration converts duration d to a string, using dflt if duration is 0.
 func durationdefault(d time.Duration, dflt string) string {
 	if d != 0 {
@@ -0,0 +1,127 @@
+package runner
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+	"github.com/mmcloughlin/goperf/pkg/job"
+)
+
+func TestRunnerSetModuleProxy(t *testing.T) {
+	w, err := NewWorkspace(WithWorkDir(test.TempDir(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(w, nil)
+
+	// Relative directories should be resolved to absolute file URLs.
+	if err := r.SetModuleProxy(filepath.Join("proxy", "dir")); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "file://" + filepath.ToSlash(filepath.Join(wd, "proxy", "dir"))
+	if r.goproxy != expect {
+		t.Errorf("GOPROXY = %q; expect %q", r.goproxy, expect)
+	}
+
+	if r.gosumdb != "off" {
+		t.Errorf("GOSUMDB = %q; expect off", r.gosumdb)
+	}
+}
+
+func TestRunnerHasTestFlag(t *testing.T) {
+	dir := test.TempDir(t)
+
+	// Stub go command printing help for a toolchain without -skip.
+	gobin := filepath.Join(dir, "go")
+	script := "#!/bin/sh\nprintf '\\t-run regexp\\n\\t    Run only those tests.\\n\\t-short\\n\\t    Tell long-running tests to shorten.\\n'\n"
+	if err := ioutil.WriteFile(gobin, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewWorkspace(WithWorkDir(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(w, nil)
+	r.gobin = gobin
+
+	ctx := context.Background()
+	for _, c := range []struct {
+		Name   string
+		Expect bool
+	}{
+		{"run", true},
+		{"short", true},
+		{"skip", false},
+		{"s", false},
+	} {
+		got, err := r.hastestflag(ctx, c.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.Expect {
+			t.Errorf("hastestflag(%q) = %v; expect %v", c.Name, got, c.Expect)
+		}
+	}
+}
+
+func TestRunnerRoundsSkipUnsupported(t *testing.T) {
+	dir := test.TempDir(t)
+
+	// Stub go command printing help for a toolchain without -skip, and failing
+	// any other command.
+	gobin := filepath.Join(dir, "go")
+	script := "#!/bin/sh\n[ \"$1\" = help ] || exit 1\nprintf '\\t-run regexp\\n'\n"
+	if err := ioutil.WriteFile(gobin, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewWorkspace(WithWorkDir(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(w, nil)
+	r.gobin = gobin
+
+	// Expect the exclusion to fail the run rather than be dropped.
+	s := job.Suite{Skip: "BenchmarkSlow"}
+	err = r.rounds(context.Background(), s, ioutil.Discard)
+	if err == nil || !strings.Contains(err.Error(), "-skip") {
+		t.Fatalf("got error %v; expect -skip unsupported", err)
+	}
+}
+
+func TestQuoteFields(t *testing.T) {
+	cases := []struct {
+		Args   []string
+		Expect string
+	}{
+		{[]string{"/bin/worker", "perfcount", "--"}, "/bin/worker perfcount --"},
+		{[]string{"/opt/my worker/bin", "--"}, "'/opt/my worker/bin' --"},
+		{[]string{"it's", "a b"}, `"it's" 'a b'`},
+		{[]string{""}, "''"},
+	}
+	for _, c := range cases {
+		got, err := quotefields(c.Args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.Expect {
+			t.Errorf("quotefields(%q) = %q; expect %q", c.Args, got, c.Expect)
+		}
+	}
+
+	if _, err := quotefields([]string{`'"`}); err == nil {
+		t.Fatal("expected error for argument with both quote characters")
+	}
+}
@@ -0,0 +1,226 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+// Sampler takes readings of system state while benchmarks run.
+type Sampler interface {
+	// Name identifies the sampler. Must be a valid configuration key.
+	Name() string
+
+	// Available checks whether the sampler can take readings at all.
+	// Unavailable samplers are skipped.
+	Available() bool
+
+	// Sample takes readings of the current system state.
+	Sample() ([]Reading, error)
+}
+
+// ThrottleCounter is implemented by samplers whose readings are cumulative
+// counts of throttling events. Any increase over a run is reported as
+// throttling in the telemetry summary.
+type ThrottleCounter interface {
+	CountsThrottling() bool
+}
+
+// Reading is a single measurement taken by a sampler.
+type Reading struct {
+	Key   cfg.Key
+	Value float64
+}
+
+// DefaultSampleInterval is the default interval between telemetry samples.
+const DefaultSampleInterval = 5 * time.Second
+
+// record is a line of the telemetry sidecar file.
+type record struct {
+	Time     time.Time          `json:"time"`
+	Sampler  string             `json:"sampler"`
+	Readings map[string]float64 `json:"readings"`
+}
+
+// series summarizes readings of one key from a sampler.
+type series struct {
+	sampler string
+	key     cfg.Key
+
+	n        int
+	min, max float64
+	sum      float64
+	first    float64
+	last     float64
+}
+
+func (s *series) add(x float64) {
+	if s.n == 0 {
+		s.min, s.max, s.first = x, x, x
+	}
+	s.n++
+	s.min = math.Min(s.min, x)
+	s.max = math.Max(s.max, x)
+	s.sum += x
+	s.last = x
+}
+
+func (s *series) configuration() cfg.Entry {
+	return cfg.Section(
+		s.key,
+		fmt.Sprintf("summary of %s readings", s.key),
+		cfg.Property("min", "minimum reading", cfg.Float64Value(s.min)),
+		cfg.Property("max", "maximum reading", cfg.Float64Value(s.max)),
+		cfg.Property("mean", "mean reading", cfg.Float64Value(s.sum/float64(s.n))),
+		cfg.Property("delta", "change from first to last reading", cfg.Float64Value(s.last-s.first)),
+	)
+}
+
+// telemetry periodically samples system state, writing every reading to a
+// sidecar file and summarizing them for the result configuration.
+type telemetry struct {
+	log      *zap.Logger
+	samplers []Sampler
+	interval time.Duration
+	out      io.Writer
+
+	samples int
+	series  map[string]*series
+	order   []*series
+}
+
+func newtelemetry(log *zap.Logger, samplers []Sampler, interval time.Duration, out io.Writer) *telemetry {
+	t := &telemetry{
+		log:      log,
+		interval: interval,
+		out:      out,
+		series:   map[string]*series{},
+	}
+	for _, s := range samplers {
+		if !s.Available() {
+			log.Info("sampler unavailable", zap.String("sampler", s.Name()))
+			continue
+		}
+		t.samplers = append(t.samplers, s)
+	}
+	return t
+}
+
+// run samples at the configured interval until ctx is done, taking a final
+// sample before returning.
+func (t *telemetry) run(ctx context.Context) error {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.sample(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return t.sample()
+		}
+	}
+}
+
+// sample takes readings from all samplers. Sampler errors are logged and
+// skipped, since telemetry should not interrupt a benchmark run.
+func (t *telemetry) sample() error {
+	t.samples++
+	enc := json.NewEncoder(t.out)
+	for _, s := range t.samplers {
+		readings, err := s.Sample()
+		if err != nil {
+			t.log.Warn("sample error", zap.String("sampler", s.Name()), zap.Error(err))
+			continue
+		}
+
+		rec := record{
+			Time:     time.Now(),
+			Sampler:  s.Name(),
+			Readings: map[string]float64{},
+		}
+		for _, reading := range readings {
+			rec.Readings[string(reading.Key)] = reading.Value
+			t.lookup(s.Name(), reading.Key).add(reading.Value)
+		}
+
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup the series for the given sampler and key, creating it if necessary.
+func (t *telemetry) lookup(sampler string, key cfg.Key) *series {
+	id := sampler + "/" + string(key)
+	if s, ok := t.series[id]; ok {
+		return s
+	}
+	s := &series{sampler: sampler, key: key}
+	t.series[id] = s
+	t.order = append(t.order, s)
+	return s
+}
+
+// Configuration returns a summary of all readings.
+func (t *telemetry) Configuration() cfg.Configuration {
+	c := cfg.Configuration{
+		cfg.Property("interval", "interval between telemetry samples", t.interval),
+		cfg.Property("samples", "number of telemetry samples", cfg.IntValue(t.samples)),
+	}
+
+	if throttled, ok := t.throttled(); ok {
+		c = append(c, cfg.Property("throttled", "whether throttling events were counted during the run", cfg.BoolValue(throttled)))
+	}
+
+	for _, s := range t.samplers {
+		var entries []cfg.Entry
+		for _, series := range t.order {
+			if series.sampler == s.Name() {
+				entries = append(entries, series.configuration())
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		c = append(c, cfg.Section(cfg.Key(s.Name()), fmt.Sprintf("summary of %s telemetry", s.Name()), entries...))
+	}
+
+	return c
+}
+
+// throttled reports whether any throttle counter increased during the run. The
+// second return value is false if no throttle counters were sampled.
+func (t *telemetry) throttled() (throttled, ok bool) {
+	for _, series := range t.order {
+		if !t.countsthrottling(series.sampler) {
+			continue
+		}
+		ok = true
+		if series.last-series.first > 0 {
+			throttled = true
+		}
+	}
+	return
+}
+
+// countsthrottling reports whether the named sampler counts throttling events.
+func (t *telemetry) countsthrottling(name string) bool {
+	for _, s := range t.samplers {
+		if tc, ok := s.(ThrottleCounter); ok && s.Name() == name {
+			return tc.CountsThrottling()
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+// counter is a sampler that reports an increasing count.
+type counter struct {
+	n int
+}
+
+func (*counter) Name() string    { return "counter" }
+func (*counter) Available() bool { return true }
+
+func (c *counter) Sample() ([]Reading, error) {
+	c.n++
+	return []Reading{{Key: "count", Value: float64(c.n)}}, nil
+}
+
+func TestSeries(t *testing.T) {
+	s := &series{key: "x"}
+	for _, x := range []float64{3, 1, 4, 1, 5} {
+		s.add(x)
+	}
+	if s.min != 1 || s.max != 5 {
+		t.Errorf("min=%v max=%v; expect min=1 max=5", s.min, s.max)
+	}
+	if mean := s.sum / float64(s.n); mean != 2.8 {
+		t.Errorf("mean=%v; expect 2.8", mean)
+	}
+	if delta := s.last - s.first; delta != 2 {
+		t.Errorf("delta=%v; expect 2", delta)
+	}
+}
+
+func TestTelemetry(t *testing.T) {
+	var buf bytes.Buffer
+	c := &counter{}
+	tm := newtelemetry(zap.NewNop(), []Sampler{c}, time.Millisecond, &buf)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := tm.run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Confirm every sample was recorded in the sidecar.
+	dec := json.NewDecoder(&buf)
+	n := 0
+	for dec.More() {
+		var rec record
+		if err := dec.Decode(&rec); err != nil {
+			t.Fatal(err)
+		}
+		n++
+		if rec.Sampler != "counter" || rec.Readings["count"] != float64(n) {
+			t.Fatalf("unexpected record %+v", rec)
+		}
+	}
+	if n != c.n || n != tm.samples {
+		t.Fatalf("got %d records; expect %d", n, c.n)
+	}
+
+	// Confirm the summary is valid.
+	summary := tm.Configuration()
+	if err := summary.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Write(&bytes.Buffer{}, summary); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// throttle is a throttle counter sampler that reports a fixed sequence of
+// counts, repeating the last.
+type throttle struct {
+	counts []float64
+}
+
+func (*throttle) Name() string           { return "throttle" }
+func (*throttle) Available() bool        { return true }
+func (*throttle) CountsThrottling() bool { return true }
+
+func (s *throttle) Sample() ([]Reading, error) {
+	x := s.counts[0]
+	if len(s.counts) > 1 {
+		s.counts = s.counts[1:]
+	}
+	return []Reading{{Key: "core", Value: x}}, nil
+}
+
+func TestTelemetryThrottled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Samplers []Sampler
+		Expect   string
+	}{
+		{Name: "none", Samplers: []Sampler{&counter{}}, Expect: ""},
+		{Name: "steady", Samplers: []Sampler{&throttle{counts: []float64{7}}}, Expect: "false"},
+		{Name: "increase", Samplers: []Sampler{&throttle{counts: []float64{7, 7, 9}}}, Expect: "true"},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			tm := newtelemetry(zap.NewNop(), c.Samplers, time.Millisecond, &bytes.Buffer{})
+			for i := 0; i < 4; i++ {
+				if err := tm.sample(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := ""
+			for _, e := range tm.Configuration() {
+				if p, ok := e.(cfg.PropertyEntry); ok && p.Key() == "throttled" {
+					got = p.Value.String()
+				}
+			}
+			if got != c.Expect {
+				t.Fatalf("throttled = %q; expect %q", got, c.Expect)
+			}
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package runner
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"runtime"
@@ -26,7 +32,7 @@ type Toolchain interface {
 	// Configuration returns configuration lines for the
 	Configuration() (cfg.Configuration, error)
 	// Install the toolchain to the given location in the workspace.
-	Install(w *Workspace, root string)
+	Install(ctx context.Context, w *Workspace, root string) error
 }
 
 func NewToolchain(typ string, params map[string]string) (Toolchain, error) {
@@ -42,6 +48,17 @@ func NewToolchain(typ string, params map[string]string) (Toolchain, error) {
 				return NewSnapshot(params["builder_type"], params["revision"]), nil
 			},
 		},
+		"source": {
+			Fields: []string{"repo", "ref", "bootstrap", "cache"},
+			Defaults: map[string]string{
+				"repo":      SourceRepositoryURL,
+				"bootstrap": defaultbootstrap(),
+				"cache":     defaultsourcecache(),
+			},
+			Make: func(params map[string]string) (Toolchain, error) {
+				return NewSource(params["repo"], params["ref"], params["bootstrap"], params["cache"]), nil
+			},
+		},
 		"release": {
 			Fields: []string{"version", "os", "arch"},
 			Defaults: map[string]string{
@@ -158,7 +175,7 @@ func (s *snapshot) Configuration() (cfg.Configuration, error) {
 	}, nil
 }
 
-func (s *snapshot) Install(w *Workspace, root string) {
+func (s *snapshot) Install(ctx context.Context, w *Workspace, root string) error {
 	defer lg.Scope(w.Log, "snapshot_install")()
 
 	// Determine download URL.
@@ -171,12 +188,17 @@ func (s *snapshot) Install(w *Workspace, root string) {
 	)
 
 	// Download.
-	dldir := w.Sandbox("dl")
+	dldir, err := w.Sandbox("dl")
+	if err != nil {
+		return err
+	}
 	archive := filepath.Join(dldir, "go.tar.gz")
-	w.Download(url, archive)
+	if err := w.Download(ctx, url, archive); err != nil {
+		return err
+	}
 
 	// Extract.
-	w.Uncompress(archive, root)
+	return w.Uncompress(archive, root)
 }
 
 // SnapshotBuilderType looks for a suitable builder type to download snapshots
@@ -234,7 +256,7 @@ func (r *release) Configuration() (cfg.Configuration, error) {
 	}, nil
 }
 
-func (r *release) Install(w *Workspace, root string) {
+func (r *release) Install(ctx context.Context, w *Workspace, root string) error {
 	defer lg.Scope(w.Log, "release_install")()
 
 	// Determine download URL.
@@ -251,14 +273,292 @@ func (r *release) Install(w *Workspace, root string) {
 	)
 
 	// Download.
-	dldir := w.Sandbox("dl")
+	dldir, err := w.Sandbox("dl")
+	if err != nil {
+		return err
+	}
 	archive := filepath.Join(dldir, filename)
-	w.Download(url, archive)
+	if err := w.Download(ctx, url, archive); err != nil {
+		return err
+	}
 
 	// Extract.
-	w.Uncompress(archive, dldir)
+	if err := w.Uncompress(archive, dldir); err != nil {
+		return err
+	}
 	extracted := filepath.Join(dldir, "go")
 
 	// Move into place.
-	w.Move(extracted, root)
+	return w.Move(extracted, root)
+}
+
+// SourceRepositoryURL is the default repository for source toolchains.
+const SourceRepositoryURL = "https://go.googlesource.com/go"
+
+type source struct {
+	repo      string
+	ref       string
+	bootstrap string
+	cache     string
+
+	// Resolved at install time.
+	commit  string
+	tree    string
+	version string
+}
+
+// NewSource constructs a toolchain built from source at the given git ref.
+// The repository may be a remote URL or the path to a local checkout; only
+// committed changes are built. The toolchain is built with make.bash using the
+// Go installation at bootstrap as GOROOT_BOOTSTRAP. If cache is non-empty,
+// built toolchains are saved in the cache directory and reused by later
+// installs of the same build. Builds are keyed by source tree hash, toolchain
+// version, platform and bootstrap toolchain.
+func NewSource(repo, ref, bootstrap, cache string) Toolchain {
+	return &source{
+		repo:      repo,
+		ref:       ref,
+		bootstrap: bootstrap,
+		cache:     cache,
+	}
+}
+
+func (s *source) Type() string { return "source" }
+
+func (s *source) String() string {
+	return path.Join(s.Type(), s.ref)
+}
+
+// Ref returns the resolved commit sha once the toolchain has been installed,
+// otherwise the configured git ref.
+func (s *source) Ref() string {
+	if s.commit != "" {
+		return s.commit
+	}
+	return s.ref
+}
+
+func (s *source) Configuration() (cfg.Configuration, error) {
+	c := cfg.Configuration{
+		cfg.Property("repo", "source git repository", cfg.StringValue(s.repo)),
+		cfg.Property("sourceref", "source git reference", cfg.StringValue(s.ref)),
+		cfg.Property("bootstrap", "bootstrap toolchain location", cfg.StringValue(s.bootstrap)),
+	}
+	if s.commit != "" {
+		c = append(c, cfg.Property("commit", "source commit sha", cfg.StringValue(s.commit)))
+	}
+	if s.tree != "" {
+		c = append(c, cfg.Property("tree", "source tree hash", cfg.StringValue(s.tree)))
+	}
+	return c, nil
+}
+
+func (s *source) Install(ctx context.Context, w *Workspace, root string) error {
+	defer lg.Scope(w.Log, "source_install")()
+
+	w.Log.Info("install source",
+		zap.String("repo", s.repo),
+		zap.String("ref", s.ref),
+		zap.String("bootstrap", s.bootstrap),
+	)
+
+	if err := w.ExposeTool("git"); err != nil {
+		return err
+	}
+
+	// Local checkouts are used in place, otherwise clone into the workspace.
+	gitdir := s.repo
+	if !isdir(s.repo) {
+		dir, err := w.Sandbox("clone")
+		if err != nil {
+			return err
+		}
+		gitdir = filepath.Join(dir, "go.git")
+		if err := w.Exec(ctx, exec.CommandContext(ctx, "git", "clone", "--bare", s.repo, gitdir)); err != nil {
+			return err
+		}
+
+		// Refs outside the default refspec, such as Gerrit changes, must be
+		// fetched explicitly.
+		if strings.HasPrefix(s.ref, "refs/") {
+			if err := w.Exec(ctx, git(ctx, gitdir, "fetch", "origin", s.ref+":"+s.ref)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Resolve the ref.
+	commit, err := w.Output(ctx, git(ctx, gitdir, "rev-parse", "--verify", s.ref+"^{commit}"))
+	if err != nil {
+		return fmt.Errorf("resolve commit: %w", err)
+	}
+	tree, err := w.Output(ctx, git(ctx, gitdir, "rev-parse", "--verify", s.ref+"^{tree}"))
+	if err != nil {
+		return fmt.Errorf("resolve tree: %w", err)
+	}
+	s.commit, s.tree = commit, tree
+
+	// Determine the version the toolchain will report. Without a VERSION file
+	// in the tree, the build is identified by commit.
+	versionfile, err := w.Output(ctx, git(ctx, gitdir, "ls-tree", "--name-only", s.commit, "VERSION"))
+	if err != nil {
+		return fmt.Errorf("check version file: %w", err)
+	}
+	s.version = ""
+	if versionfile == "" {
+		s.version = "devel " + s.commit
+	}
+
+	w.Log.Info("resolved source",
+		zap.String("commit", s.commit),
+		zap.String("tree", s.tree),
+		zap.String("version", s.version),
+	)
+
+	// Use a cached build if available.
+	if archive := s.cachepath(); archive != "" && isfile(archive) {
+		w.Log.Info("source toolchain cache hit", zap.String("archive", archive))
+		dir, err := w.Sandbox("cache")
+		if err != nil {
+			return err
+		}
+		if err := w.Uncompress(archive, dir); err != nil {
+			return err
+		}
+		return w.Move(filepath.Join(dir, "go"), root)
+	}
+
+	// Export the source tree.
+	dir, err := w.Sandbox("build")
+	if err != nil {
+		return err
+	}
+	goroot := filepath.Join(dir, "go")
+	tarball := filepath.Join(dir, "src.tar")
+	if err := w.Exec(ctx, git(ctx, gitdir, "archive", "--format=tar", "--prefix=go/", "--output="+tarball, s.commit)); err != nil {
+		return err
+	}
+	if err := w.Uncompress(tarball, dir); err != nil {
+		return err
+	}
+
+	// Without git metadata the build requires a VERSION file.
+	if s.version != "" {
+		if err := ioutil.WriteFile(filepath.Join(goroot, "VERSION"), []byte(s.version), 0o644); err != nil {
+			return err
+		}
+	}
+
+	// Build.
+	if err := w.ExposeTool("bash"); err != nil {
+		return err
+	}
+	if err := w.DefineTool("CC", "gcc"); err != nil {
+		return err
+	}
+
+	build := exec.CommandContext(ctx, filepath.Join(goroot, "src", "make.bash"))
+	build.Dir = filepath.Join(goroot, "src")
+	build.Env = []string{"GOROOT_BOOTSTRAP=" + s.bootstrap}
+	if err := w.Exec(ctx, build); err != nil {
+		return fmt.Errorf("build toolchain: %w", err)
+	}
+
+	// Save to the cache and move into place.
+	if err := s.save(w, goroot); err != nil {
+		return fmt.Errorf("save toolchain to cache: %w", err)
+	}
+	return w.Move(goroot, root)
+}
+
+// cachepath returns the location of the cached build for the resolved source
+// tree, or the empty string if caching is disabled. Besides the tree, the build
+// depends on the version written for it, the host platform and the bootstrap
+// toolchain.
+func (s *source) cachepath() string {
+	if s.cache == "" || s.tree == "" {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, part := range []string{s.version, runtime.GOOS, runtime.GOARCH, s.bootstrap, bootstrapversion(s.bootstrap)} {
+		fmt.Fprintf(h, "%q\n", part)
+	}
+	variant := hex.EncodeToString(h.Sum(nil))[:16]
+
+	return filepath.Join(s.cache, s.tree+"-"+variant+".tar.gz")
+}
+
+// save the built goroot to the cache.
+func (s *source) save(w *Workspace, goroot string) (err error) {
+	archive := s.cachepath()
+	if archive == "" {
+		return nil
+	}
+
+	defer lg.Scope(w.Log, "source_cache_save", zap.String("archive", archive))()
+
+	// Build the archive in a temporary directory alongside the cache entry,
+	// so it can be moved into place atomically.
+	if err := os.MkdirAll(s.cache, 0o777); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempDir(s.cache, "tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr := os.RemoveAll(tmp); rerr != nil && err == nil {
+			err = rerr
+		}
+	}()
+
+	partial := filepath.Join(tmp, "go.tar.gz")
+	if err := w.Archive([]string{goroot}, partial); err != nil {
+		return err
+	}
+	return w.Move(partial, archive)
+}
+
+// git builds a git command operating on the repository at dir.
+func git(ctx context.Context, dir string, arg ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "git", append([]string{"-C", dir}, arg...)...)
+}
+
+// defaultbootstrap returns the default bootstrap toolchain for source builds.
+func defaultbootstrap() string {
+	if goroot := os.Getenv("GOROOT_BOOTSTRAP"); goroot != "" {
+		return goroot
+	}
+	return runtime.GOROOT()
+}
+
+// bootstrapversion returns the contents of the VERSION file of the bootstrap
+// toolchain at goroot, or the empty string if there is none.
+func bootstrapversion(goroot string) string {
+	b, err := ioutil.ReadFile(filepath.Join(goroot, "VERSION"))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// defaultsourcecache returns the default cache directory for source builds.
+func defaultsourcecache() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "goperf", "source")
+}
+
+func isdir(name string) bool {
+	info, err := os.Stat(name)
+	return err == nil && info.IsDir()
+}
+
+func isfile(name string) bool {
+	info, err := os.Stat(name)
+	return err == nil && info.Mode().IsRegular()
 }
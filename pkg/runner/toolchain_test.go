@@ -1,11 +1,18 @@
 package runner
 
 import (
+	"context"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"reflect"
 	"runtime"
+	"strings"
 	"testing"
 
+	"github.com/mholt/archiver"
 	"golang.org/x/build/buildenv"
 
 	"github.com/mmcloughlin/goperf/internal/test"
@@ -25,6 +32,15 @@ func TestNewToolchain(t *testing.T) {
 			},
 			Expect: NewSnapshot("linux-amd64", "3eab754cd061bf90ee7b540546bc0863f3ad1d85"),
 		},
+		{
+			Type: "source",
+			Params: map[string]string{
+				"ref":       "master",
+				"bootstrap": "/usr/local/go",
+				"cache":     "/var/cache/goperf",
+			},
+			Expect: NewSource(SourceRepositoryURL, "master", "/usr/local/go", "/var/cache/goperf"),
+		},
 		{
 			Type: "release",
 			Params: map[string]string{
@@ -150,3 +166,160 @@ func TestSnapshotBuilderTypeDownload(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceInstallCached(t *testing.T) {
+	repo, gitcmd := sourcerepo(t)
+	commit := gitcmd("rev-parse", "HEAD")
+	tree := gitcmd("rev-parse", "HEAD^{tree}")
+
+	// Populate the cache with a fake build for the commit.
+	cache := test.TempDir(t)
+	tc := NewSource(repo, "HEAD", "/nonexistent", cache)
+
+	src := tc.(*source)
+	src.tree, src.version = tree, "devel "+commit
+	archive := src.cachepath()
+
+	build := filepath.Join(test.TempDir(t), "go")
+	if err := os.MkdirAll(filepath.Join(build, "bin"), 0o777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(build, "bin", "go"), []byte("fake"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := archiver.Archive([]string{build}, archive); err != nil {
+		t.Fatal(err)
+	}
+
+	// Install should use the cached build.
+	w, err := NewWorkspace(WithWorkDir(test.TempDir(t)), InheritEnviron())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := w.Path("goroot")
+	if err := tc.Install(context.Background(), w, root); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "bin", "go")); err != nil {
+		t.Fatal(err)
+	}
+	if tc.Ref() != commit {
+		t.Fatalf("Ref() = %q; expect %q", tc.Ref(), commit)
+	}
+
+	c, err := tc.Configuration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSourceInstallBuild(t *testing.T) {
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("requires gcc")
+	}
+
+	// Create a repository with a stub make.bash, which "builds" a go binary
+	// containing the VERSION file and records each build in the bootstrap
+	// directory.
+	repo, gitcmd := sourcerepo(t)
+	makebash := strings.Join([]string{
+		"#!/bin/bash",
+		"set -e",
+		"mkdir -p ../bin",
+		"cp ../VERSION ../bin/go",
+		`echo build >> "${GOROOT_BOOTSTRAP}/builds"`,
+	}, "\n")
+	if err := os.MkdirAll(filepath.Join(repo, "src"), 0o777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(repo, "src", "make.bash"), []byte(makebash), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	gitcmd("add", "src/make.bash")
+	gitcmd("commit", "-q", "-m", "make.bash")
+
+	bootstrap := test.TempDir(t)
+	cache := test.TempDir(t)
+
+	// install HEAD and confirm the version of the installed toolchain and the
+	// total number of builds.
+	install := func(expectbuilds int) {
+		t.Helper()
+
+		w, err := NewWorkspace(WithWorkDir(test.TempDir(t)), InheritEnviron())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tc := NewSource(repo, "HEAD", bootstrap, cache)
+		root := w.Path("goroot")
+		if err := tc.Install(context.Background(), w, root); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadFile(filepath.Join(root, "bin", "go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expect := "devel " + gitcmd("rev-parse", "HEAD"); string(got) != expect {
+			t.Fatalf("installed version %q; expect %q", got, expect)
+		}
+
+		builds, err := ioutil.ReadFile(filepath.Join(bootstrap, "builds"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := strings.Count(string(builds), "build"); n != expectbuilds {
+			t.Fatalf("%d builds; expect %d", n, expectbuilds)
+		}
+	}
+
+	// First install builds and saves to the cache, the second is a cache hit.
+	install(1)
+	install(1)
+
+	// A commit with the same tree reports a different version, so must be
+	// rebuilt.
+	gitcmd("commit", "-q", "--allow-empty", "-m", "empty")
+	install(2)
+	install(2)
+}
+
+// sourcerepo creates a git repository with a single commit, returning its
+// path and a function to run git commands in it.
+func sourcerepo(t *testing.T) (string, func(...string) string) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("requires git")
+	}
+
+	repo := test.TempDir(t)
+	gitcmd := func(arg ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repo}, arg...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Gopher", "GIT_AUTHOR_EMAIL=gopher@golang.org",
+			"GIT_COMMITTER_NAME=Gopher", "GIT_COMMITTER_EMAIL=gopher@golang.org",
+		)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	gitcmd("init", "-q")
+	if err := ioutil.WriteFile(filepath.Join(repo, "README"), []byte("go"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	gitcmd("add", "README")
+	gitcmd("commit", "-q", "-m", "initial")
+
+	return repo, gitcmd
+}
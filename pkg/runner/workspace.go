@@ -15,6 +15,7 @@ import (
 	"github.com/mholt/archiver"
 	"go.uber.org/zap"
 
+	"github.com/mmcloughlin/goperf/internal/errutil"
 	"github.com/mmcloughlin/goperf/pkg/fs"
 	"github.com/mmcloughlin/goperf/pkg/lg"
 )
@@ -28,7 +29,9 @@ type Workspace struct {
 	root string
 	cwd  string
 	env  map[string]string
-	err  error
+
+	// Error from applying options, reported by NewWorkspace.
+	opterr error
 }
 
 type Option func(*Workspace)
@@ -46,7 +49,11 @@ func WithWorkDir(d string) Option {
 }
 
 func WithEnviron(env []string) Option {
-	return func(w *Workspace) { w.AddEnviron(env...) }
+	return func(w *Workspace) {
+		if err := w.AddEnviron(env...); err != nil && w.opterr == nil {
+			w.opterr = err
+		}
+	}
 }
 
 func InheritEnviron() Option {
@@ -68,6 +75,9 @@ func NewWorkspace(opts ...Option) (*Workspace, error) {
 
 	// Apply options.
 	w.Options(opts...)
+	if w.opterr != nil {
+		return nil, w.opterr
+	}
 
 	// Use a temporary directory if none was specified.
 	if w.root == "" {
@@ -93,26 +103,9 @@ func (w *Workspace) Options(opts ...Option) {
 	}
 }
 
-// Error returns the first error that occurred in the workspace, if any.
-func (w *Workspace) Error() error { return w.err }
-
-func (w *Workspace) cancelled() bool {
-	return w.err != nil
-}
-
-func (w *Workspace) seterr(err error) {
-	if w.err == nil && err != nil {
-		w.err = err
-	}
-}
-
-func (w *Workspace) close(c io.Closer) {
-	w.seterr(c.Close())
-}
-
 // Clean up the workspace.
-func (w *Workspace) Clean() {
-	w.seterr(os.RemoveAll(w.root))
+func (w *Workspace) Clean() error {
+	return os.RemoveAll(w.root)
 }
 
 // SetEnv sets an environment variable for all workspace operations.
@@ -132,14 +125,15 @@ func (w *Workspace) SetEnvDefault(key, value string) string {
 // AddEnviron is a convenience for setting multiple environment variables given
 // a list of "KEY=value" strings. Provided for easy interoperability with
 // functions like os.Environ().
-func (w *Workspace) AddEnviron(env ...string) {
+func (w *Workspace) AddEnviron(env ...string) error {
 	for _, e := range env {
 		kv := strings.SplitN(e, "=", 2)
 		if len(kv) != 2 {
-			w.seterr(fmt.Errorf("invalid environment variable setting %q", e))
+			return fmt.Errorf("invalid environment variable setting %q", e)
 		}
 		w.SetEnv(kv[0], kv[1])
 	}
+	return nil
 }
 
 // InheritEnv sets the environment variable key to the same as the surrounding
@@ -178,22 +172,23 @@ func (w *Workspace) AppendPATH(path string) {
 
 // ExposeTool makes the named tool available to the workspace by looking up its
 // location and adding the directory to the PATH.
-func (w *Workspace) ExposeTool(name string) {
+func (w *Workspace) ExposeTool(name string) error {
 	path, err := exec.LookPath(name)
 	if err != nil {
-		w.seterr(err)
+		return err
 	}
 	w.AppendPATH(filepath.Dir(path))
+	return nil
 }
 
 // DefineTool defines a standard tool with environment variable key and default
 // dflt, for example "CC" with default "gcc". If the environment variable is set
 // in the host environment, it is inherited, otherwise it is set to the default
 // and the PATH is edited to ensure it is accessible within the workspace.
-func (w *Workspace) DefineTool(key, dflt string) {
+func (w *Workspace) DefineTool(key, dflt string) error {
 	w.InheritEnv(key)
 	name := w.SetEnvDefault(key, dflt)
-	w.ExposeTool(name)
+	return w.ExposeTool(name)
 }
 
 // Path relative to working directory.
@@ -202,33 +197,31 @@ func (w *Workspace) Path(rel string) string {
 }
 
 // EnsureDir ensure the relative path exists.
-func (w *Workspace) EnsureDir(rel string) string {
+func (w *Workspace) EnsureDir(rel string) (string, error) {
 	dir := w.Path(rel)
-	if !w.cancelled() {
-		w.seterr(os.MkdirAll(dir, 0o777))
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return "", err
 	}
-	return dir
+	return dir, nil
 }
 
 // Sandbox creates a fresh temporary directory, sets it as the working directory
 // and returns it.
-func (w *Workspace) Sandbox(task string) string {
-	if w.cancelled() {
-		return ""
+func (w *Workspace) Sandbox(task string) (string, error) {
+	sandbox, err := w.EnsureDir("sandbox")
+	if err != nil {
+		return "", err
 	}
-	sandbox := w.EnsureDir("sandbox")
 	dir, err := ioutil.TempDir(sandbox, task)
-	w.seterr(err)
+	if err != nil {
+		return "", err
+	}
 	w.Cd(dir)
-	return dir
+	return dir, nil
 }
 
 // Download url to path.
-func (w *Workspace) Download(url, path string) {
-	if w.cancelled() {
-		return
-	}
-
+func (w *Workspace) Download(ctx context.Context, url, path string) (err error) {
 	defer lg.Scope(w.Log, "download",
 		zap.String("url", url),
 		zap.String("path", path),
@@ -237,53 +230,57 @@ func (w *Workspace) Download(url, path string) {
 	// Open file for writing.
 	f, err := os.Create(path)
 	if err != nil {
-		w.seterr(err)
-		return
+		return err
 	}
-	defer w.close(f)
+	defer errutil.CheckClose(&err, f)
 
 	// Issue request.
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		w.seterr(err)
-		return
+		return err
 	}
 
 	res, err := w.client.Do(req)
 	if err != nil {
-		w.seterr(err)
+		return err
+	}
+	defer errutil.CheckClose(&err, res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s: unexpected status %s", url, res.Status)
 	}
-	defer w.close(res.Body)
 
 	// Copy.
 	_, err = io.Copy(f, res.Body)
-	w.seterr(err)
+	return err
 }
 
 // Uncompress archive src to the directory dst.
-func (w *Workspace) Uncompress(src, dst string) {
-	if w.cancelled() {
-		return
-	}
+func (w *Workspace) Uncompress(src, dst string) error {
 	defer lg.Scope(w.Log, "uncompress",
 		zap.String("source", src),
 		zap.String("destination", dst),
 	)()
-	w.seterr(archiver.Unarchive(src, dst))
+	return archiver.Unarchive(src, dst)
 }
 
-// Move src to dst.
-func (w *Workspace) Move(src, dst string) {
-	if w.cancelled() {
-		return
-	}
+// Archive the files and directories sources into an archive at dst. The
+// archive format is determined by the extension of dst.
+func (w *Workspace) Archive(sources []string, dst string) error {
+	defer lg.Scope(w.Log, "archive",
+		zap.Strings("sources", sources),
+		zap.String("destination", dst),
+	)()
+	return archiver.Archive(sources, dst)
+}
 
+// Move src to dst.
+func (w *Workspace) Move(src, dst string) error {
 	defer lg.Scope(w.Log, "move",
 		zap.String("source", src),
 		zap.String("destination", dst),
 	)()
-
-	w.seterr(os.Rename(src, dst))
+	return os.Rename(src, dst)
 }
 
 // Cd sets the working directory to path.
@@ -295,10 +292,12 @@ func (w *Workspace) Cd(path string) {
 // CdRoot sets the working directory to the root of the workspace.
 func (w *Workspace) CdRoot() { w.Cd(w.root) }
 
-// Exec the provided command.
-func (w *Workspace) Exec(cmd *exec.Cmd) {
-	if w.cancelled() {
-		return
+// Exec the provided command. The command should be constructed with
+// exec.CommandContext, so that it is killed if ctx is cancelled; in that case
+// the context error is returned.
+func (w *Workspace) Exec(ctx context.Context, cmd *exec.Cmd) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
 	defer lg.Scope(w.Log, "exec")()
@@ -324,7 +323,22 @@ func (w *Workspace) Exec(cmd *exec.Cmd) {
 		zap.ByteString("stderr", stderr.Bytes()),
 	)
 
-	w.seterr(err)
+	if ctxerr := ctx.Err(); err != nil && ctxerr != nil {
+		return ctxerr
+	}
+
+	return err
+}
+
+// Output executes the provided command and returns its standard output with
+// surrounding whitespace removed.
+func (w *Workspace) Output(ctx context.Context, cmd *exec.Cmd) (string, error) {
+	var buf bytes.Buffer
+	cmd.Stdout = tee(cmd.Stdout, &buf)
+	if err := w.Exec(ctx, cmd); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
 }
 
 func tee(w, t io.Writer) io.Writer {
@@ -335,11 +349,7 @@ func tee(w, t io.Writer) io.Writer {
 }
 
 // Artifact saves the given path as a named artifact.
-func (w *Workspace) Artifact(path, name string) {
-	if w.cancelled() {
-		return
-	}
-
+func (w *Workspace) Artifact(ctx context.Context, path, name string) (err error) {
 	defer lg.Scope(w.Log, "artifact",
 		zap.String("source", path),
 		zap.String("name", name),
@@ -348,20 +358,18 @@ func (w *Workspace) Artifact(path, name string) {
 	// Open file to be saved.
 	src, err := os.Open(path)
 	if err != nil {
-		w.seterr(err)
-		return
+		return err
 	}
-	defer w.close(src)
+	defer errutil.CheckClose(&err, src)
 
 	// Create destination.
-	dst, err := w.artifacts.Create(context.TODO(), name)
+	dst, err := w.artifacts.Create(ctx, name)
 	if err != nil {
-		w.seterr(err)
-		return
+		return err
 	}
-	defer w.close(dst)
+	defer errutil.CheckClose(&err, dst)
 
 	// Copy.
 	_, err = io.Copy(dst, src)
-	w.seterr(err)
+	return err
 }
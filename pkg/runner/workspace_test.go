@@ -0,0 +1,40 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/mmcloughlin/goperf/internal/test"
+)
+
+func TestWorkspaceExecCancel(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("requires sleep")
+	}
+
+	w, err := NewWorkspace(WithWorkDir(test.TempDir(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = w.Exec(ctx, exec.CommandContext(ctx, "sleep", "10"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v; expect %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("exec took %s after cancellation", elapsed)
+	}
+
+	// Subsequent execs should not start.
+	err = w.Exec(ctx, exec.CommandContext(ctx, "true"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v; expect %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,99 @@
+package shield
+
+import (
+	"fmt"
+
+	"github.com/mmcloughlin/goperf/pkg/cpuset"
+	"github.com/mmcloughlin/goperf/pkg/topology"
+)
+
+// Placement is a strategy for choosing shield CPUs.
+type Placement int
+
+// Supported placement strategies.
+const (
+	PlacementAny     Placement = iota // lowest numbered cpus, ignoring topology
+	PlacementPackage                  // within a single physical package (socket)
+	PlacementNode                     // within a single NUMA node
+	PlacementCache                    // within a single last-level cache domain
+)
+
+var placementnames = map[Placement]string{
+	PlacementAny:     "any",
+	PlacementPackage: "package",
+	PlacementNode:    "node",
+	PlacementCache:   "cache",
+}
+
+// String returns the placement name.
+func (p Placement) String() string {
+	if name, ok := placementnames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("placement(%d)", int(p))
+}
+
+// ParsePlacement parses a placement strategy from its name.
+func ParsePlacement(name string) (Placement, error) {
+	for p, n := range placementnames {
+		if n == name {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown placement %q", name)
+}
+
+// domain returns the topology domain the placement confines the shield to.
+func (p Placement) domain() (topology.Domain, error) {
+	switch p {
+	case PlacementPackage:
+		return topology.DomainPackage, nil
+	case PlacementNode:
+		return topology.DomainNode, nil
+	case PlacementCache:
+		return topology.DomainCache, nil
+	default:
+		return 0, fmt.Errorf("placement %s has no topology domain", p)
+	}
+}
+
+// assign cpus to shield and system, with the shield confined to a single
+// topology domain. Later domains are preferred, leaving CPU 0 (which typically
+// services the most interrupts) to the system.
+func (p Placement) assign(s *Shield, cpus cpuset.Set, topo *topology.Topology) (shield, sys cpuset.Set, err error) {
+	d, err := p.domain()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domains := topo.Domains(d)
+	if len(domains) == 0 {
+		return nil, nil, fmt.Errorf("topology has no %s domains", d)
+	}
+
+	for i := len(domains) - 1; i >= 0; i-- {
+		avail := domains[i].Intersection(cpus).SortedMembers()
+
+		// Reserve CPUs in this domain if the system would not have enough
+		// outside of it.
+		reserve := s.sysn - (len(cpus) - len(avail))
+		if reserve < 0 {
+			reserve = 0
+		}
+
+		// Shield CPUs 0 value means assign the max.
+		n := s.shieldn
+		if n == 0 {
+			n = len(avail) - reserve
+		}
+
+		if n < max(s.slots, 1) || n > len(avail)-reserve {
+			continue
+		}
+
+		shield = cpuset.NewSet(avail[:n]...)
+		return shield, cpus.Difference(shield), nil
+	}
+
+	return nil, nil, fmt.Errorf("no %s domain can fit shield", d)
+}
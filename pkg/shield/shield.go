@@ -4,21 +4,28 @@ package shield
 import (
 	"errors"
 	"fmt"
+	"path"
+	"sort"
+	"strconv"
 
 	"go.uber.org/zap"
 
 	"github.com/mmcloughlin/goperf/internal/errutil"
 	"github.com/mmcloughlin/goperf/pkg/cpuset"
+	"github.com/mmcloughlin/goperf/pkg/topology"
 )
 
 // Shield uses cpusets to setup exclusive access to some CPUs.
 type Shield struct {
-	root    string      // root cpuset
-	shield  string      // shield cpuset (relative to root)
-	shieldn int         // number of cpus in shield cpuset (0 for max)
-	sys     string      // system cpuset name (relative to root)
-	sysn    int         // minimum number of cpus in system cpuset
-	log     *zap.Logger // logger
+	root    string             // root cpuset
+	shield  string             // shield cpuset (relative to root)
+	shieldn int                // number of cpus in shield cpuset (0 for max)
+	sys     string             // system cpuset name (relative to root)
+	sysn    int                // minimum number of cpus in system cpuset
+	slots   int                // number of slot cpusets to partition the shield into (0 for none)
+	place   Placement          // strategy for choosing shield cpus
+	topo    *topology.Topology // system topology (nil to read from sysfs)
+	log     *zap.Logger        // logger
 
 	deferred []func() error
 }
@@ -67,6 +74,25 @@ func WithSystemNumCPU(n int) Option {
 	return func(s *Shield) { s.sysn = n }
 }
 
+// WithSlots configures the shield to be partitioned into n exclusive child
+// cpusets, allowing multiple isolated processes to run concurrently. Slots are
+// divided along cache and core boundaries where topology allows. Slot cpusets
+// are named by SlotName.
+func WithSlots(n int) Option {
+	return func(s *Shield) { s.slots = n }
+}
+
+// WithPlacement configures the strategy for choosing shield CPUs.
+func WithPlacement(p Placement) Option {
+	return func(s *Shield) { s.place = p }
+}
+
+// WithTopology configures the system topology used for placement. By default it
+// is read from sysfs.
+func WithTopology(t *topology.Topology) Option {
+	return func(s *Shield) { s.topo = t }
+}
+
 // WithLogger configures the logger for CPU shield operations.
 func WithLogger(l *zap.Logger) Option {
 	return func(s *Shield) { s.log = l.Named("shield") }
@@ -80,6 +106,11 @@ func (s *Shield) ShieldName() string {
 	return s.shield
 }
 
+// SlotName returns the name of the cpuset for slot i.
+func (s *Shield) SlotName(i int) string {
+	return path.Join(s.shield, "slot"+strconv.Itoa(i))
+}
+
 // Available reports whether the shield mechanism can be applied. Note this is a
 // rudimentary check that the environment supports cpusets at all, it is still
 // possible that applying the shield would error.
@@ -116,7 +147,12 @@ func (s *Shield) apply() error {
 	}
 
 	// Assign CPUs to the two sets.
-	shieldcpu, syscpu, err := s.assign(allcpu)
+	topo, err := s.topology()
+	if err != nil {
+		return err
+	}
+
+	shieldcpu, syscpu, err := s.assign(allcpu, topo)
 	if err != nil {
 		return fmt.Errorf("could not assign cpus: %w", err)
 	}
@@ -168,8 +204,8 @@ func (s *Shield) apply() error {
 		return err
 	}
 
-	// Memory nodes.
-	if err := shield.SetMems(mems); err != nil {
+	// Memory nodes local to the shield CPUs.
+	if err := shield.SetMems(localmems(shieldcpu, topo, mems)); err != nil {
 		return err
 	}
 
@@ -178,9 +214,71 @@ func (s *Shield) apply() error {
 		return err
 	}
 
+	// Partition into slots.
+	if s.slots > 0 {
+		if err := s.partition(shieldcpu, mems, topo); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// partition the shield cpus into slot cpusets along topology domains. Each slot
+// is assigned the NUMA memory nodes local to its CPUs, if known, otherwise all
+// of mems.
+func (s *Shield) partition(cpus, mems cpuset.Set, topo *topology.Topology) error {
+	for i, slotcpu := range split(cpus, s.slots, topo) {
+		slotmems := localmems(slotcpu, topo, mems)
+
+		s.log.Debug("chosen slot cpus",
+			zap.Int("slot", i),
+			zap.Stringer("cpus", slotcpu),
+			zap.Stringer("mems", slotmems),
+		)
+
+		slot, err := cpuset.Create(s.SlotName(i))
+		if err != nil {
+			return err
+		}
+		s.cleanup(slot.Remove)
+
+		if err := slot.SetCPUs(slotcpu); err != nil {
+			return err
+		}
+
+		if err := slot.SetMems(slotmems); err != nil {
+			return err
+		}
+
+		if err := slot.EnableCPUExclusive(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// topology returns the system topology. Topology is only required for
+// placement strategies other than PlacementAny; otherwise failure to read it is
+// logged and an empty topology returned.
+func (s *Shield) topology() (*topology.Topology, error) {
+	if s.topo != nil {
+		return s.topo, nil
+	}
+
+	topo, err := topology.Read()
+	if err != nil {
+		if s.place != PlacementAny {
+			return nil, fmt.Errorf("read topology: %w", err)
+		}
+		s.log.Warn("topology unavailable", zap.Error(err))
+		return &topology.Topology{}, nil
+	}
+
+	return topo, nil
+}
+
 // cleanup adds an operation to be called on Reset(). Cleanup functions will be
 // called in reverse order, similar to defer.
 func (s *Shield) cleanup(f func() error) {
@@ -214,11 +312,15 @@ func (s *Shield) movetasks(src, dst *cpuset.CPUSet) error {
 }
 
 // assign cpus to shield and system.
-func (s *Shield) assign(cpus cpuset.Set) (shield, sys cpuset.Set, err error) {
+func (s *Shield) assign(cpus cpuset.Set, topo *topology.Topology) (shield, sys cpuset.Set, err error) {
 	if len(cpus) < s.mincpus() {
 		return nil, nil, errors.New("not enough cpus")
 	}
 
+	if s.place != PlacementAny {
+		return s.place.assign(s, cpus, topo)
+	}
+
 	m := cpus.SortedMembers()
 
 	// Shield CPUs 0 value means assign the max.
@@ -227,12 +329,89 @@ func (s *Shield) assign(cpus cpuset.Set) (shield, sys cpuset.Set, err error) {
 		n = len(m) - s.sysn
 	}
 
+	if n < s.slots {
+		return nil, nil, fmt.Errorf("not enough cpus for %d slots", s.slots)
+	}
+
 	return cpuset.NewSet(m[:n]...), cpuset.NewSet(m[n:]...), nil
 }
 
 // mincpus returns the minimum number of CPUs required to satisfy configuration.
 func (s *Shield) mincpus() int {
-	return max(s.shieldn, 1) + s.sysn
+	return max(max(s.shieldn, s.slots), 1) + s.sysn
+}
+
+// split cpus into n groups along topology domains. Groups are built from whole
+// last-level cache domains if there are enough of them, otherwise from whole
+// physical cores, so that slots do not share caches or SMT siblings where
+// possible. CPUs of unknown topology are treated individually.
+func split(cpus cpuset.Set, n int, topo *topology.Topology) []cpuset.Set {
+	for _, d := range []topology.Domain{topology.DomainCache, topology.DomainCore} {
+		if groups := group(units(cpus, topo.Domains(d)), n); groups != nil {
+			return groups
+		}
+	}
+	return group(units(cpus, nil), n)
+}
+
+// units divides cpus into the given domains, ordered by lowest CPU. CPUs in
+// none of the domains form units of their own.
+func units(cpus cpuset.Set, domains []cpuset.Set) []cpuset.Set {
+	var us []cpuset.Set
+	rest := cpus.Clone()
+	for _, domain := range domains {
+		u := domain.Intersection(rest)
+		if len(u) == 0 {
+			continue
+		}
+		us = append(us, u)
+		rest = rest.Difference(u)
+	}
+	for _, cpu := range rest.Members() {
+		us = append(us, cpuset.NewSet(cpu))
+	}
+	sort.Slice(us, func(i, j int) bool { return us[i].SortedMembers()[0] < us[j].SortedMembers()[0] })
+	return us
+}
+
+// group consecutive units into n groups of near equal size. Returns nil if
+// there are fewer than n units.
+func group(us []cpuset.Set, n int) []cpuset.Set {
+	if len(us) < n {
+		return nil
+	}
+
+	total := 0
+	for _, u := range us {
+		total += len(u)
+	}
+
+	groups := make([]cpuset.Set, 0, n)
+	k, size := 0, 0
+	for i := 1; i <= n; i++ {
+		// Take units until the group reaches its share of the total, leaving
+		// at least one unit for each remaining group.
+		g := cpuset.NewSet()
+		for k < len(us)-(n-i) && (len(g) == 0 || (size+len(us[k]))*n <= i*total) {
+			for cpu := range us[k] {
+				g[cpu] = true
+			}
+			size += len(us[k])
+			k++
+		}
+		groups = append(groups, g)
+	}
+	return groups
+}
+
+// localmems returns the memory nodes in mems local to cpus. Falls back to mems
+// if there are none, for example if NUMA topology is unknown.
+func localmems(cpus cpuset.Set, topo *topology.Topology, mems cpuset.Set) cpuset.Set {
+	local := topo.NodesOf(cpus).Intersection(mems)
+	if len(local) == 0 {
+		return mems.Clone()
+	}
+	return local
 }
 
 // max is integer maximum.
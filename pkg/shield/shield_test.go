@@ -3,9 +3,220 @@ package shield
 import (
 	"testing"
 
+	"github.com/mmcloughlin/goperf/pkg/cpuset"
 	"github.com/mmcloughlin/goperf/pkg/runner"
+	"github.com/mmcloughlin/goperf/pkg/topology"
 )
 
 func TestShieldImplementsTuner(t *testing.T) {
 	var _ runner.Tuner = new(Shield)
 }
+
+func TestSplit(t *testing.T) {
+	cpus := cpuset.NewSet(0, 1, 2, 3, 4, 5, 6)
+	groups := split(cpus, 3, &topology.Topology{})
+
+	expect := []cpuset.Set{
+		cpuset.NewSet(0, 1),
+		cpuset.NewSet(2, 3),
+		cpuset.NewSet(4, 5, 6),
+	}
+
+	assertgroups(t, groups, expect)
+}
+
+func TestSplitTopology(t *testing.T) {
+	// Two last-level caches, each shared by two cores with two SMT siblings.
+	// Sibling CPUs are numbered 8 apart.
+	topo := &topology.Topology{
+		Caches: []topology.Cache{
+			{Level: 3, Type: "Unified", CPUs: cpuset.NewSet(0, 1, 8, 9)},
+			{Level: 3, Type: "Unified", CPUs: cpuset.NewSet(2, 3, 10, 11)},
+		},
+	}
+	for cpu := uint(0); cpu < 16; cpu++ {
+		topo.CPUs = append(topo.CPUs, topology.CPU{
+			ID:       cpu,
+			Core:     cpu % 8,
+			Siblings: cpuset.NewSet(cpu%8, cpu%8+8),
+		})
+	}
+
+	cases := []struct {
+		Name   string
+		CPUs   cpuset.Set
+		N      int
+		Expect []cpuset.Set
+	}{
+		{
+			Name: "cache",
+			CPUs: cpuset.NewSet(0, 1, 2, 3, 8, 9, 10, 11),
+			N:    2,
+			Expect: []cpuset.Set{
+				cpuset.NewSet(0, 1, 8, 9),
+				cpuset.NewSet(2, 3, 10, 11),
+			},
+		},
+		{
+			Name: "core",
+			CPUs: cpuset.NewSet(0, 1, 2, 3, 8, 9, 10, 11),
+			N:    4,
+			Expect: []cpuset.Set{
+				cpuset.NewSet(0, 8),
+				cpuset.NewSet(1, 9),
+				cpuset.NewSet(2, 10),
+				cpuset.NewSet(3, 11),
+			},
+		},
+		{
+			Name: "partial_core",
+			CPUs: cpuset.NewSet(1, 2, 3, 9, 10),
+			N:    3,
+			Expect: []cpuset.Set{
+				cpuset.NewSet(1, 9),
+				cpuset.NewSet(2, 10),
+				cpuset.NewSet(3),
+			},
+		},
+		{
+			Name: "cpus",
+			CPUs: cpuset.NewSet(0, 1, 8, 9),
+			N:    4,
+			Expect: []cpuset.Set{
+				cpuset.NewSet(0),
+				cpuset.NewSet(1),
+				cpuset.NewSet(8),
+				cpuset.NewSet(9),
+			},
+		},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			assertgroups(t, split(c.CPUs, c.N, topo), c.Expect)
+		})
+	}
+}
+
+func assertgroups(t *testing.T, groups, expect []cpuset.Set) {
+	t.Helper()
+	if len(groups) != len(expect) {
+		t.Fatalf("got %d groups; expect %d", len(groups), len(expect))
+	}
+	for i := range groups {
+		if !groups[i].Equals(expect[i]) {
+			t.Errorf("group %d: got %s; expect %s", i, groups[i], expect[i])
+		}
+	}
+}
+
+func TestLocalMems(t *testing.T) {
+	topo := &topology.Topology{
+		Nodes: map[uint]cpuset.Set{
+			0: cpuset.NewSet(0, 1, 2, 3),
+			1: cpuset.NewSet(4, 5, 6, 7),
+		},
+	}
+	mems := cpuset.NewSet(0, 1)
+
+	cases := []struct {
+		CPUs     cpuset.Set
+		Topology *topology.Topology
+		Expect   cpuset.Set
+	}{
+		{CPUs: cpuset.NewSet(0, 1), Topology: topo, Expect: cpuset.NewSet(0)},
+		{CPUs: cpuset.NewSet(6, 7), Topology: topo, Expect: cpuset.NewSet(1)},
+		{CPUs: cpuset.NewSet(3, 4), Topology: topo, Expect: cpuset.NewSet(0, 1)},
+		{CPUs: cpuset.NewSet(0, 1), Topology: &topology.Topology{}, Expect: mems},
+	}
+	for _, c := range cases {
+		if got := localmems(c.CPUs, c.Topology, mems); !got.Equals(c.Expect) {
+			t.Errorf("localmems(%s) = %s; expect %s", c.CPUs, got, c.Expect)
+		}
+	}
+}
+
+func TestAssignPlacement(t *testing.T) {
+	// Two packages with four CPUs each.
+	topo := &topology.Topology{}
+	for cpu := uint(0); cpu < 8; cpu++ {
+		topo.CPUs = append(topo.CPUs, topology.CPU{
+			ID:       cpu,
+			Package:  cpu / 4,
+			Core:     cpu % 4,
+			Siblings: cpuset.NewSet(cpu),
+		})
+	}
+	all := topo.All()
+
+	cases := []struct {
+		Name    string
+		Options []Option
+		Shield  cpuset.Set
+	}{
+		{
+			Name:    "any",
+			Options: []Option{WithSystemNumCPU(1)},
+			Shield:  cpuset.NewSet(0, 1, 2, 3, 4, 5, 6),
+		},
+		{
+			Name:    "package_max",
+			Options: []Option{WithPlacement(PlacementPackage), WithSystemNumCPU(1)},
+			Shield:  cpuset.NewSet(4, 5, 6, 7),
+		},
+		{
+			Name:    "package_count",
+			Options: []Option{WithPlacement(PlacementPackage), WithShieldNumCPU(2), WithSystemNumCPU(1)},
+			Shield:  cpuset.NewSet(4, 5),
+		},
+		{
+			Name:    "package_reserve_system",
+			Options: []Option{WithPlacement(PlacementPackage), WithSystemNumCPU(6)},
+			Shield:  cpuset.NewSet(4, 5),
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			s := NewShield(c.Options...)
+			shield, sys, err := s.assign(all, topo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !shield.Equals(c.Shield) {
+				t.Errorf("shield = %s; expect %s", shield, c.Shield)
+			}
+			if expect := all.Difference(c.Shield); !sys.Equals(expect) {
+				t.Errorf("sys = %s; expect %s", sys, expect)
+			}
+		})
+	}
+}
+
+func TestAssignPlacementNoFit(t *testing.T) {
+	topo := &topology.Topology{
+		CPUs: []topology.CPU{
+			{ID: 0, Package: 0},
+			{ID: 1, Package: 0},
+			{ID: 2, Package: 1},
+			{ID: 3, Package: 1},
+		},
+	}
+
+	s := NewShield(WithPlacement(PlacementPackage), WithShieldNumCPU(3))
+	if _, _, err := s.assign(topo.All(), topo); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParsePlacement(t *testing.T) {
+	for p := range placementnames {
+		got, err := ParsePlacement(p.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != p {
+			t.Errorf("ParsePlacement(%q) = %s", p.String(), got)
+		}
+	}
+}
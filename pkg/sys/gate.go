@@ -0,0 +1,86 @@
+package sys
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	loadutil "github.com/shirou/gopsutil/load"
+
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+)
+
+// LoadGate passes when the 1-minute load average is at most Max.
+type LoadGate struct {
+	Max float64
+}
+
+// Name returns "load".
+func (LoadGate) Name() string { return "load" }
+
+// Available always returns true.
+func (LoadGate) Available() bool { return true }
+
+// Check reads the load average.
+func (g LoadGate) Check() (bool, cfg.Configuration, error) {
+	avg, err := loadutil.Avg()
+	if err != nil {
+		return false, nil, err
+	}
+
+	return avg.Load1 <= g.Max, cfg.Configuration{
+		cfg.Property("avg1", "1-minute load average", cfg.Float64Value(avg.Load1)),
+		cfg.Property("max", "maximum permitted 1-minute load average", cfg.Float64Value(g.Max)),
+	}, nil
+}
+
+// ThermalGate passes when all thermal zones report temperatures of at most Max
+// degrees Celsius.
+type ThermalGate struct {
+	Max float64
+}
+
+// Name returns "thermal".
+func (ThermalGate) Name() string { return "thermal" }
+
+// Available checks whether the thermal sysfs files are present.
+func (ThermalGate) Available() bool { return Thermal{}.Available() }
+
+// Check reads the temperature of all thermal zones.
+func (g ThermalGate) Check() (bool, cfg.Configuration, error) {
+	filenames, err := filepath.Glob("/sys/class/thermal/thermal_zone*/temp")
+	if err != nil {
+		return false, nil, err
+	}
+
+	hottest := 0.0
+	for _, filename := range filenames {
+		t, err := readmillicelsius(filename)
+		if err != nil {
+			return false, nil, err
+		}
+		if t > hottest {
+			hottest = t
+		}
+	}
+
+	return hottest <= g.Max, cfg.Configuration{
+		cfg.Property("temp", "highest thermal zone temperature", cfg.TemperatureValue(hottest)),
+		cfg.Property("max", "maximum permitted temperature", cfg.TemperatureValue(g.Max)),
+	}, nil
+}
+
+// readmillicelsius reads a temperature in millidegrees Celsius from filename,
+// returning degrees Celsius.
+func readmillicelsius(filename string) (float64, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0, err
+	}
+	return float64(n) / 1000, nil
+}
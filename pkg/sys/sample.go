@@ -0,0 +1,229 @@
+package sys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	memutil "github.com/shirou/gopsutil/mem"
+
+	"github.com/mmcloughlin/goperf/internal/errutil"
+	"github.com/mmcloughlin/goperf/pkg/cfg"
+	"github.com/mmcloughlin/goperf/pkg/pseudofs"
+	"github.com/mmcloughlin/goperf/pkg/runner"
+)
+
+const cpuroot = "/sys/devices/system/cpu"
+
+// samplecpudirs returns sysfs directories for the CPUs returned by cpus, or all
+// CPUs if cpus is nil.
+func samplecpudirs(cpus func() ([]uint, error)) ([]string, error) {
+	if cpus == nil {
+		return filepath.Glob(filepath.Join(cpuroot, "cpu[0-9]*"))
+	}
+	ids, err := cpus()
+	if err != nil {
+		return nil, err
+	}
+	dirs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		dirs = append(dirs, filepath.Join(cpuroot, fmt.Sprintf("cpu%d", id)))
+	}
+	return dirs, nil
+}
+
+// FrequencySampler samples the current frequency of CPUs in MHz.
+type FrequencySampler struct {
+	// CPUs returns the CPUs to sample. All CPUs are sampled if nil.
+	CPUs func() ([]uint, error)
+}
+
+// Name returns "cpufreq".
+func (FrequencySampler) Name() string { return "cpufreq" }
+
+// Available checks whether cpufreq sysfs files are present.
+func (FrequencySampler) Available() bool {
+	_, err := os.Stat(filepath.Join(cpuroot, "cpu0", "cpufreq", "scaling_cur_freq"))
+	return err == nil
+}
+
+// Sample reads the current frequency of each CPU.
+func (s FrequencySampler) Sample() ([]runner.Reading, error) {
+	dirs, err := samplecpudirs(s.CPUs)
+	if err != nil {
+		return nil, err
+	}
+
+	var readings []runner.Reading
+	for _, dir := range dirs {
+		khz, err := pseudofs.Int(filepath.Join(dir, "cpufreq", "scaling_cur_freq"))
+		if err != nil {
+			return nil, err
+		}
+		readings = append(readings, runner.Reading{
+			Key:   cfg.Key(filepath.Base(dir)),
+			Value: float64(khz) / 1000,
+		})
+	}
+	return readings, nil
+}
+
+// ThrottleSampler samples the total thermal throttling event counts of CPUs.
+// Counts are cumulative, so an increase over a run indicates throttling.
+type ThrottleSampler struct {
+	// CPUs returns the CPUs to sample. All CPUs are sampled if nil.
+	CPUs func() ([]uint, error)
+}
+
+// Name returns "throttle".
+func (ThrottleSampler) Name() string { return "throttle" }
+
+// CountsThrottling returns true, since readings are throttling event counts.
+func (ThrottleSampler) CountsThrottling() bool { return true }
+
+// Available checks whether thermal throttle sysfs files are present.
+func (ThrottleSampler) Available() bool {
+	_, err := os.Stat(filepath.Join(cpuroot, "cpu0", "thermal_throttle", "core_throttle_count"))
+	return err == nil
+}
+
+// Sample reads core and package throttle counts, summed over all CPUs.
+func (s ThrottleSampler) Sample() ([]runner.Reading, error) {
+	dirs, err := samplecpudirs(s.CPUs)
+	if err != nil {
+		return nil, err
+	}
+
+	var core, pkg int
+	for _, dir := range dirs {
+		n, err := pseudofs.Int(filepath.Join(dir, "thermal_throttle", "core_throttle_count"))
+		if err != nil {
+			return nil, err
+		}
+		core += n
+
+		n, err = pseudofs.Int(filepath.Join(dir, "thermal_throttle", "package_throttle_count"))
+		if err != nil {
+			return nil, err
+		}
+		pkg += n
+	}
+
+	return []runner.Reading{
+		{Key: "core", Value: float64(core)},
+		{Key: "package", Value: float64(pkg)},
+	}, nil
+}
+
+// ThermalSampler samples the temperature of all thermal zones in degrees
+// Celsius.
+type ThermalSampler struct{}
+
+// Name returns "thermal".
+func (ThermalSampler) Name() string { return "thermal" }
+
+// Available checks whether the thermal sysfs files are present.
+func (ThermalSampler) Available() bool { return Thermal{}.Available() }
+
+// Sample reads the temperature of each thermal zone.
+func (ThermalSampler) Sample() ([]runner.Reading, error) {
+	dirs, err := filepath.Glob("/sys/class/thermal/thermal_zone*")
+	if err != nil {
+		return nil, err
+	}
+
+	var readings []runner.Reading
+	for _, dir := range dirs {
+		t, err := readmillicelsius(filepath.Join(dir, "temp"))
+		if err != nil {
+			return nil, err
+		}
+		zone := filepath.Base(dir)
+		readings = append(readings, runner.Reading{
+			Key:   cfg.Key(zone[len("thermal_"):]),
+			Value: t,
+		})
+	}
+	return readings, nil
+}
+
+const memorypressure = "/proc/pressure/memory"
+
+// MemorySampler samples memory usage and, where supported, pressure stall
+// information.
+type MemorySampler struct{}
+
+// Name returns "mem".
+func (MemorySampler) Name() string { return "mem" }
+
+// Available always returns true.
+func (MemorySampler) Available() bool { return true }
+
+// Sample reads memory usage and pressure.
+func (MemorySampler) Sample() ([]runner.Reading, error) {
+	vmem, err := memutil.VirtualMemory()
+	if err != nil {
+		return nil, err
+	}
+
+	readings := []runner.Reading{
+		{Key: "usedpercent", Value: vmem.UsedPercent},
+	}
+
+	// Pressure stall information is not available on all kernels.
+	if _, err := os.Stat(memorypressure); err != nil {
+		return readings, nil
+	}
+
+	psi, err := readpressure(memorypressure)
+	if err != nil {
+		return nil, err
+	}
+	for _, kind := range []string{"some", "full"} {
+		if avg, ok := psi[kind]; ok {
+			readings = append(readings, runner.Reading{
+				Key:   cfg.Key("psi" + kind),
+				Value: avg,
+			})
+		}
+	}
+
+	return readings, nil
+}
+
+// readpressure reads a pressure stall information file, returning the 10
+// second average stall percentage for each line kind ("some" or "full").
+//
+// Reference: https://www.kernel.org/doc/html/latest/accounting/psi.html
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readpressure(filename string) (_ map[string]float64, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer errutil.CheckClose(&err, f)
+
+	psi := map[string]float64{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 || !strings.HasPrefix(fields[1], "avg10=") {
+			return nil, fmt.Errorf("unexpected pressure line %q", s.Text())
+		}
+		avg, err := strconv.ParseFloat(strings.TrimPrefix(fields[1], "avg10="), 64)
+		if err != nil {
+			return nil, err
+		}
+		psi[fields[0]] = avg
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return psi, nil
+}
@@ -0,0 +1,31 @@
+package sys
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadPressure(t *testing.T) {
+	got, err := readpressure("testdata/pressure_memory.input")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := map[string]float64{"some": 1.25, "full": 0.75}
+	if diff := cmp.Diff(expect, got); diff != "" {
+		t.Fatalf("mismatch\n%s", diff)
+	}
+}
+
+func TestMemorySamplerReal(t *testing.T) {
+	readings, err := MemorySampler{}.Sample()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range readings {
+		if err := r.Key.Validate(); err != nil {
+			t.Errorf("reading %q: %s", r.Key, err)
+		}
+	}
+}
@@ -0,0 +1,346 @@
+// Package topology models the arrangement of processors, caches and memory
+// nodes on a Linux system.
+package topology
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mmcloughlin/goperf/pkg/cpuset"
+	"github.com/mmcloughlin/goperf/pkg/pseudofs"
+)
+
+// Topology of logical CPUs in a system.
+type Topology struct {
+	CPUs   []CPU
+	Nodes  map[uint]cpuset.Set // NUMA node to CPUs (empty if unknown)
+	Caches []Cache             // distinct cache domains
+}
+
+// CPU is a logical processor.
+type CPU struct {
+	ID       uint
+	Package  uint       // physical package (socket) identifier
+	Core     uint       // core identifier within the package
+	Siblings cpuset.Set // SMT siblings, including this CPU
+}
+
+// Cache is a processor cache shared by a set of CPUs.
+type Cache struct {
+	Level int
+	Type  string // Data, Instruction or Unified
+	Size  int    // bytes
+	CPUs  cpuset.Set
+}
+
+// Domain is a grouping of CPUs by shared hardware resource.
+type Domain int
+
+// Supported domains.
+const (
+	DomainPackage Domain = iota // physical package (socket)
+	DomainNode                  // NUMA node
+	DomainCache                 // last-level cache
+	DomainCore                  // physical core
+)
+
+// String returns the domain name.
+func (d Domain) String() string {
+	switch d {
+	case DomainPackage:
+		return "package"
+	case DomainNode:
+		return "node"
+	case DomainCache:
+		return "cache"
+	case DomainCore:
+		return "core"
+	default:
+		return "domain(" + strconv.Itoa(int(d)) + ")"
+	}
+}
+
+// Root is the standard sysfs system devices directory.
+const Root = "/sys/devices/system"
+
+// Read the topology of the running system.
+func Read() (*Topology, error) {
+	return ReadPath(Root)
+}
+
+// ReadPath reads the topology from a sysfs system devices directory at root.
+// Offline CPUs, which do not report topology, are skipped.
+func ReadPath(root string) (*Topology, error) {
+	t := &Topology{
+		Nodes: map[uint]cpuset.Set{},
+	}
+
+	// CPUs.
+	cpudirs, err := filepath.Glob(filepath.Join(root, "cpu", "cpu[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cpudir := range cpudirs {
+		id, err := index(cpudir, "cpu")
+		if err != nil {
+			return nil, err
+		}
+
+		topodir := filepath.Join(cpudir, "topology")
+		if _, err := os.Stat(topodir); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		cpu, err := readcpu(id, topodir)
+		if err != nil {
+			return nil, fmt.Errorf("cpu%d: %w", id, err)
+		}
+		t.CPUs = append(t.CPUs, cpu)
+
+		caches, err := readcaches(filepath.Join(cpudir, "cache"))
+		if err != nil {
+			return nil, fmt.Errorf("cpu%d: %w", id, err)
+		}
+		for _, c := range caches {
+			t.addcache(c)
+		}
+	}
+
+	sort.Slice(t.CPUs, func(i, j int) bool { return t.CPUs[i].ID < t.CPUs[j].ID })
+
+	// NUMA nodes.
+	nodedirs, err := filepath.Glob(filepath.Join(root, "node", "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nodedir := range nodedirs {
+		node, err := index(nodedir, "node")
+		if err != nil {
+			return nil, err
+		}
+		cpus, err := list(filepath.Join(nodedir, "cpulist"))
+		if err != nil {
+			return nil, fmt.Errorf("node%d: %w", node, err)
+		}
+		t.Nodes[node] = cpus
+	}
+
+	return t, nil
+}
+
+func readcpu(id uint, dir string) (CPU, error) {
+	pkg, err := pseudofs.Int(filepath.Join(dir, "physical_package_id"))
+	if err != nil {
+		return CPU{}, err
+	}
+
+	core, err := pseudofs.Int(filepath.Join(dir, "core_id"))
+	if err != nil {
+		return CPU{}, err
+	}
+
+	siblings, err := list(filepath.Join(dir, "thread_siblings_list"))
+	if err != nil {
+		return CPU{}, err
+	}
+
+	return CPU{
+		ID:       id,
+		Package:  uint(pkg),
+		Core:     uint(core),
+		Siblings: siblings,
+	}, nil
+}
+
+func readcaches(dir string) ([]Cache, error) {
+	cachedirs, err := filepath.Glob(filepath.Join(dir, "index[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var caches []Cache
+	for _, cachedir := range cachedirs {
+		level, err := pseudofs.Int(filepath.Join(cachedir, "level"))
+		if err != nil {
+			return nil, err
+		}
+
+		typ, err := pseudofs.String(filepath.Join(cachedir, "type"))
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := readsize(filepath.Join(cachedir, "size"))
+		if err != nil {
+			return nil, err
+		}
+
+		cpus, err := list(filepath.Join(cachedir, "shared_cpu_list"))
+		if err != nil {
+			return nil, err
+		}
+
+		caches = append(caches, Cache{
+			Level: level,
+			Type:  typ,
+			Size:  size,
+			CPUs:  cpus,
+		})
+	}
+
+	return caches, nil
+}
+
+// addcache records cache c, if it has not already been seen from another CPU
+// sharing it.
+func (t *Topology) addcache(c Cache) {
+	for _, existing := range t.Caches {
+		if existing.Level == c.Level && existing.Type == c.Type && existing.CPUs.Equals(c.CPUs) {
+			return
+		}
+	}
+	t.Caches = append(t.Caches, c)
+}
+
+// All returns the set of all CPUs.
+func (t *Topology) All() cpuset.Set {
+	s := cpuset.NewSet()
+	for _, cpu := range t.CPUs {
+		s[cpu.ID] = true
+	}
+	return s
+}
+
+// Domains returns the groups of CPUs sharing the given resource, ordered by
+// lowest CPU. Returns nil if the domain is not known for this system.
+func (t *Topology) Domains(d Domain) []cpuset.Set {
+	var groups []cpuset.Set
+	switch d {
+	case DomainPackage:
+		groups = t.group(func(cpu CPU) uint { return cpu.Package })
+	case DomainNode:
+		for _, cpus := range t.Nodes {
+			groups = append(groups, cpus.Clone())
+		}
+	case DomainCache:
+		groups = t.llcs()
+	case DomainCore:
+		for _, cpu := range t.CPUs {
+			if !contains(groups, cpu.ID) {
+				groups = append(groups, cpu.Siblings.Clone())
+			}
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return min(groups[i]) < min(groups[j]) })
+
+	return groups
+}
+
+// group CPUs by key.
+func (t *Topology) group(key func(CPU) uint) []cpuset.Set {
+	m := map[uint]cpuset.Set{}
+	for _, cpu := range t.CPUs {
+		k := key(cpu)
+		if m[k] == nil {
+			m[k] = cpuset.NewSet()
+		}
+		m[k][cpu.ID] = true
+	}
+
+	groups := make([]cpuset.Set, 0, len(m))
+	for _, cpus := range m {
+		groups = append(groups, cpus)
+	}
+	return groups
+}
+
+// llcs returns the domains of the last-level cache. Instruction caches are
+// ignored.
+func (t *Topology) llcs() []cpuset.Set {
+	level := 0
+	for _, c := range t.Caches {
+		if c.Type != "Instruction" && c.Level > level {
+			level = c.Level
+		}
+	}
+
+	var groups []cpuset.Set
+	for _, c := range t.Caches {
+		if c.Type != "Instruction" && c.Level == level {
+			groups = append(groups, c.CPUs.Clone())
+		}
+	}
+	return groups
+}
+
+// NodesOf returns the NUMA nodes containing any of cpus.
+func (t *Topology) NodesOf(cpus cpuset.Set) cpuset.Set {
+	nodes := cpuset.NewSet()
+	for node, nodecpus := range t.Nodes {
+		if len(nodecpus.Intersection(cpus)) > 0 {
+			nodes[node] = true
+		}
+	}
+	return nodes
+}
+
+// index parses the numeric suffix of a sysfs directory such as "cpu12".
+func index(dir, prefix string) (uint, error) {
+	n, err := strconv.ParseUint(strings.TrimPrefix(filepath.Base(dir), prefix), 10, 0)
+	if err != nil {
+		return 0, err
+	}
+	return uint(n), nil
+}
+
+// list reads a file in cpuset list format.
+func list(path string) (cpuset.Set, error) {
+	s, err := pseudofs.String(path)
+	if err != nil {
+		return nil, err
+	}
+	return cpuset.ParseList(s)
+}
+
+// readsize reads a cache size file, such as "32K".
+func readsize(path string) (int, error) {
+	s, err := pseudofs.String(path)
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasSuffix(s, "K") {
+		return 0, fmt.Errorf("expected size %q in K units", s)
+	}
+	kb, err := strconv.Atoi(strings.TrimSuffix(s, "K"))
+	if err != nil {
+		return 0, err
+	}
+	return kb * 1024, nil
+}
+
+// contains reports whether any of the sets contain x.
+func contains(sets []cpuset.Set, x uint) bool {
+	for _, s := range sets {
+		if s[x] {
+			return true
+		}
+	}
+	return false
+}
+
+// min returns the smallest member of s.
+func min(s cpuset.Set) uint {
+	m := s.SortedMembers()
+	if len(m) == 0 {
+		return 0
+	}
+	return m[0]
+}
@@ -0,0 +1,79 @@
+package topology
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mmcloughlin/goperf/pkg/cpuset"
+)
+
+func TestReadReal(t *testing.T) {
+	if _, err := os.Stat(Root); err != nil {
+		t.Skip("sysfs unavailable")
+	}
+
+	topo, err := Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(topo.CPUs) == 0 {
+		t.Fatal("no cpus found")
+	}
+}
+
+func TestReadPathDualSocket(t *testing.T) {
+	topo, err := ReadPath("testdata/dualsocket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(topo.CPUs); n != 8 {
+		t.Fatalf("got %d cpus; expect 8", n)
+	}
+
+	// Instruction, data and level 2 caches per core, plus level 3 per package.
+	if n := len(topo.Caches); n != 3*4+2 {
+		t.Fatalf("got %d cache domains; expect %d", n, 3*4+2)
+	}
+
+	cases := []struct {
+		Domain Domain
+		Expect []cpuset.Set
+	}{
+		{
+			Domain: DomainPackage,
+			Expect: []cpuset.Set{cpuset.NewSet(0, 1, 4, 5), cpuset.NewSet(2, 3, 6, 7)},
+		},
+		{
+			Domain: DomainNode,
+			Expect: []cpuset.Set{cpuset.NewSet(0, 1, 4, 5), cpuset.NewSet(2, 3, 6, 7)},
+		},
+		{
+			Domain: DomainCache,
+			Expect: []cpuset.Set{cpuset.NewSet(0, 1, 4, 5), cpuset.NewSet(2, 3, 6, 7)},
+		},
+		{
+			Domain: DomainCore,
+			Expect: []cpuset.Set{cpuset.NewSet(0, 4), cpuset.NewSet(1, 5), cpuset.NewSet(2, 6), cpuset.NewSet(3, 7)},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Domain.String(), func(t *testing.T) {
+			got := topo.Domains(c.Domain)
+			if len(got) != len(c.Expect) {
+				t.Fatalf("got %d domains; expect %d", len(got), len(c.Expect))
+			}
+			for i := range got {
+				if !got[i].Equals(c.Expect[i]) {
+					t.Errorf("domain %d: got %s; expect %s", i, got[i], c.Expect[i])
+				}
+			}
+		})
+	}
+
+	if nodes := topo.NodesOf(cpuset.NewSet(1, 6)); !nodes.Equals(cpuset.NewSet(0, 1)) {
+		t.Errorf("NodesOf = %s; expect 0-1", nodes)
+	}
+}
@@ -15,7 +15,7 @@ const (
 // ImprovementDirectionForUnit returns the improvement direction for the supplied unit, if known.
 func ImprovementDirectionForUnit(unit string) ImprovementDirection {
 	switch unit {
-	case Runtime, BytesAllocated, Allocs:
+	case Runtime, BytesAllocated, Allocs, Instructions, Cycles, BranchMisses, CacheMisses:
 		return ImprovementDirectionSmaller
 	case DataRate:
 		return ImprovementDirectionLarger
@@ -15,6 +15,16 @@ const (
 	Allocs         = "allocs/op"
 )
 
+// Hardware performance counter units, reported per benchmark operation. Counts
+// are estimated from event rates over the whole benchmark, rather than
+// measured for the reported run alone, hence the "est-" prefix.
+const (
+	Instructions = "est-instructions/op"
+	Cycles       = "est-cycles/op"
+	BranchMisses = "est-branch-misses/op"
+	CacheMisses  = "est-cache-misses/op"
+)
+
 var priority = map[string]int{
 	Runtime:        4,
 	DataRate:       3,
@@ -113,3 +113,23 @@ func TestScale(t *testing.T) {
 		{1234, Quantity{1.234, "M"}},
 	})
 }
+
+func TestImprovementDirectionForUnit(t *testing.T) {
+	cases := []struct {
+		Unit   string
+		Expect ImprovementDirection
+	}{
+		{Runtime, ImprovementDirectionSmaller},
+		{DataRate, ImprovementDirectionLarger},
+		{Instructions, ImprovementDirectionSmaller},
+		{Cycles, ImprovementDirectionSmaller},
+		{BranchMisses, ImprovementDirectionSmaller},
+		{CacheMisses, ImprovementDirectionSmaller},
+		{"widgets", ImprovementDirectionUnknown},
+	}
+	for _, c := range cases {
+		if got := ImprovementDirectionForUnit(c.Unit); got != c.Expect {
+			t.Errorf("ImprovementDirectionForUnit(%q) = %s; expect %s", c.Unit, got, c.Expect)
+		}
+	}
+}
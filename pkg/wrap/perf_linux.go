@@ -0,0 +1,83 @@
+package wrap
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/exec"
+
+	"github.com/google/subcommands"
+	"go.uber.org/zap"
+
+	"github.com/mmcloughlin/goperf/pkg/command"
+	"github.com/mmcloughlin/goperf/pkg/perf"
+)
+
+type perfcount struct {
+	command.Base
+}
+
+// NewPerfCount builds a wrapper for benchmark executables that reports
+// estimated hardware performance counts per operation alongside benchmark
+// results. If counters are unavailable the executable is run as normal.
+func NewPerfCount(b command.Base) subcommands.Command {
+	return &perfcount{Base: b}
+}
+
+func (*perfcount) Name() string { return "perfcount" }
+
+func (*perfcount) Synopsis() string {
+	return "execute a benchmark binary, reporting estimated hardware performance counts per operation"
+}
+
+func (*perfcount) Usage() string { return "" }
+
+func (cmd *perfcount) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) (status subcommands.ExitStatus) {
+	args := f.Args()
+	if len(args) == 0 {
+		return cmd.UsageError("no command provided")
+	}
+
+	if !perf.Available(perf.Events) {
+		cmd.Log.Info("performance counters unavailable")
+		return cmd.Status(run(args))
+	}
+
+	// Start the process with counters attached.
+	c := subprocess(args)
+	stdout, err := c.StdoutPipe()
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	counters, err := perf.Start(c, perf.Events)
+	if err != nil {
+		cmd.Log.Info("could not start performance counters", zap.Error(err))
+		return cmd.Status(run(args))
+	}
+	defer cmd.CheckClose(&status, counters)
+
+	// Annotate output with per-operation counts.
+	if err := perf.Annotate(os.Stdout, stdout, perf.Units(perf.Events), counters.Read); err != nil {
+		_ = c.Process.Kill()
+		_ = c.Wait()
+		return cmd.Error(err)
+	}
+
+	return cmd.Status(c.Wait())
+}
+
+// subprocess builds a command for args connected to standard input and error.
+func subprocess(args []string) *exec.Cmd {
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdin = os.Stdin
+	c.Stderr = os.Stderr
+	return c
+}
+
+// run args without performance counters.
+func run(args []string) error {
+	c := subprocess(args)
+	c.Stdout = os.Stdout
+	return c.Run()
+}
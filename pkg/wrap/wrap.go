@@ -68,3 +68,17 @@ func RunUnder(cmd subcommands.Command, arg ...string) (runner.Wrapper, error) {
 	args = append(args, arg...)
 	return runner.RunUnder(self, args...), nil
 }
+
+// ExecUnder returns a command line that runs a program under the given
+// subcommand, assuming that subcommand is registered on this executable.
+// Suitable for the "go test -exec" flag.
+func ExecUnder(cmd subcommands.Command, arg ...string) ([]string, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	args := []string{self, cmd.Name()}
+	args = append(args, arg...)
+	args = append(args, "--")
+	return args, nil
+}
@@ -32,7 +32,8 @@ cp -r * ${deploy_dir}
 
 artifacts_dir="${tmp_dir}/artifacts"
 athens_storage_dir="${tmp_dir}/athens"
-mkdir -p ${config_dir} ${log_dir} ${artifacts_dir} ${athens_storage_dir}
+toolchain_cache_dir="${tmp_dir}/toolchains"
+mkdir -p ${config_dir} ${log_dir} ${artifacts_dir} ${athens_storage_dir} ${toolchain_cache_dir}
 
 athens_port="3000"
 athens_config="${config_dir}/athens.toml"
@@ -59,7 +60,7 @@ cat > /etc/supervisor/conf.d/${project_name}.conf <<EOF
 programs=worker,athens
 
 [program:worker]
-command=${deploy_dir}/bin/worker run -coordinator ${coordinator} -name ${name} -shieldnumcpu 1 -artifacts ${artifacts_dir} -goproxy http://localhost:${athens_port}
+command=${deploy_dir}/bin/worker run -coordinator ${coordinator} -name ${name} -shieldnumcpu 1 -artifacts ${artifacts_dir} -goproxy http://localhost:${athens_port} -toolchaincache ${toolchain_cache_dir}
 autostart=false
 autorestart=false
 stdout_logfile=${log_dir}/worker.out